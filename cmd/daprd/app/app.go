@@ -162,10 +162,15 @@ func Run() {
 				EnableMTLS:                    opts.EnableMTLS,
 				SentryAddress:                 opts.SentryAddress,
 				MaxRequestSize:                opts.MaxRequestSize,
+				MaxRequestSizePerAPI:          opts.MaxRequestSizePerAPI,
+				DaprHTTPTLSCertFile:           opts.DaprHTTPTLSCertFile,
+				DaprHTTPTLSKeyFile:            opts.DaprHTTPTLSKeyFile,
 				ReadBufferSize:                opts.ReadBufferSize,
+				MaxReplayBufferSize:           opts.MaxReplayBufferSize,
 				UnixDomainSocket:              opts.UnixDomainSocket,
 				DaprGracefulShutdownSeconds:   opts.DaprGracefulShutdownSeconds,
 				DaprBlockShutdownDuration:     opts.DaprBlockShutdownDuration,
+				DaprDrainTimeout:              opts.DaprDrainTimeout,
 				DisableBuiltinK8sSecretStore:  opts.DisableBuiltinK8sSecretStore,
 				EnableAppHealthCheck:          opts.EnableAppHealthCheck,
 				AppHealthCheckPath:            opts.AppHealthCheckPath,
@@ -173,6 +178,19 @@ func Run() {
 				AppHealthProbeTimeout:         opts.AppHealthProbeTimeout,
 				AppHealthThreshold:            opts.AppHealthThreshold,
 				AppChannelAddress:             opts.AppChannelAddress,
+				AppUnixDomainSocket:           opts.AppUnixDomainSocket,
+				AppEndpoints:                  opts.AppEndpoints,
+				AppChannelMaxIdleConns:        opts.AppChannelMaxIdleConns,
+				AppChannelMaxConnsPerHost:     opts.AppChannelMaxConnsPerHost,
+				AppChannelIdleConnTimeout:     opts.AppChannelIdleConnTimeout,
+				AppChannelEnableHTTP2:         opts.AppChannelEnableHTTP2,
+				AppChannelTLSSource:           opts.AppChannelTLSSource,
+				AppChannelTLSCAFile:           opts.AppChannelTLSCAFile,
+				AppChannelTLSCertFile:         opts.AppChannelTLSCertFile,
+				AppChannelTLSKeyFile:          opts.AppChannelTLSKeyFile,
+				AppChannelTLSSecretStore:      opts.AppChannelTLSSecretStore,
+				AppChannelTLSSecretName:       opts.AppChannelTLSSecretName,
+				AppChannelTLSServerName:       opts.AppChannelTLSServerName,
 				EnableAPILogging:              opts.EnableAPILogging,
 				Config:                        opts.Config,
 				Metrics: metrics.Options{
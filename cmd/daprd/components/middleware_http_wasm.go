@@ -19,17 +19,18 @@ import (
 	"context"
 
 	contribmiddleware "github.com/dapr/components-contrib/middleware"
-	"github.com/dapr/components-contrib/middleware/http/wasm"
 	"github.com/dapr/dapr/pkg/components"
 	httpMiddlewareLoader "github.com/dapr/dapr/pkg/components/middleware/http"
 	"github.com/dapr/dapr/pkg/middleware"
+	"github.com/dapr/dapr/pkg/middleware/http/wasm"
+	wasmhost "github.com/dapr/dapr/pkg/runtime/wasm"
 	"github.com/dapr/kit/logger"
 )
 
 func init() {
 	httpMiddlewareLoader.DefaultRegistry.RegisterComponent(func(log logger.Logger) httpMiddlewareLoader.FactoryMethod {
 		return func(metadata contribmiddleware.Metadata) (middleware.HTTP, error) {
-			return wasm.NewMiddleware(log).GetHandler(context.TODO(), metadata)
+			return wasm.New(log, wasmhost.DefaultHost).GetHandler(context.TODO(), metadata)
 		}
 	}, "wasm")
 	components.RegisterWasmComponentType(components.CategoryMiddleware, "wasm")
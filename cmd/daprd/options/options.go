@@ -47,7 +47,10 @@ type Options struct {
 	AppMaxConcurrency             int
 	EnableMTLS                    bool
 	AppSSL                        bool
-	MaxRequestSize                int // In bytes
+	MaxRequestSize                int            // In bytes
+	MaxRequestSizePerAPI          map[string]int // In bytes, keyed by API group
+	DaprHTTPTLSCertFile           string
+	DaprHTTPTLSKeyFile            string
 	ResourcesPath                 []string
 	AppProtocol                   string
 	EnableAPILogging              *bool
@@ -64,6 +67,7 @@ type Options struct {
 	AppPort                       string
 	DaprGracefulShutdownSeconds   int
 	DaprBlockShutdownDuration     *time.Duration
+	DaprDrainTimeout              time.Duration
 	ActorsService                 string
 	RemindersService              string
 	SchedulerAddress              []string
@@ -77,9 +81,23 @@ type Options struct {
 	Config                        []string
 	UnixDomainSocket              string
 	ReadBufferSize                int // In bytes
+	MaxReplayBufferSize           int // In bytes
 	DisableBuiltinK8sSecretStore  bool
 	AppHealthCheckPath            string
 	AppChannelAddress             string
+	AppUnixDomainSocket           string
+	AppEndpoints                  []string
+	AppChannelMaxIdleConns        int
+	AppChannelMaxConnsPerHost     int
+	AppChannelIdleConnTimeout     time.Duration
+	AppChannelEnableHTTP2         bool
+	AppChannelTLSSource           string
+	AppChannelTLSCAFile           string
+	AppChannelTLSCertFile         string
+	AppChannelTLSKeyFile          string
+	AppChannelTLSSecretStore      string
+	AppChannelTLSSecretName       string
+	AppChannelTLSServerName       string
 	SentryRequestJwtAudiences     []string
 	Logger                        logger.Options
 	Metrics                       *metrics.FlagOptions
@@ -91,12 +109,15 @@ func New(origArgs []string) (*Options, error) {
 		DaprBlockShutdownDuration: new(time.Duration),
 		MaxRequestSize:            runtime.DefaultMaxRequestBodySize,
 		ReadBufferSize:            runtime.DefaultReadBufferSize,
+		MaxReplayBufferSize:       runtime.DefaultMaxReplayBufferSize,
 	}
 	var (
-		maxRequestSizeMB int
-		maxBodySize      string
-		readBufferSizeKB int
-		readBufferSize   string
+		maxRequestSizeMB    int
+		maxBodySize         string
+		maxBodySizePerAPI   map[string]string
+		readBufferSizeKB    int
+		readBufferSize      string
+		maxReplayBufferSize string
 	)
 
 	// We are using pflag to parse the CLI flags
@@ -151,12 +172,17 @@ func New(origArgs []string) (*Options, error) {
 	fs.IntVar(&maxRequestSizeMB, "dapr-http-max-request-size", runtime.DefaultMaxRequestBodySize>>20, "Max size of request body in MB")
 	fs.MarkDeprecated("dapr-http-max-request-size", "use '--max-body-size "+strconv.Itoa(runtime.DefaultMaxRequestBodySize>>20)+"Mi'")
 	fs.StringVar(&maxBodySize, "max-body-size", strconv.Itoa(runtime.DefaultMaxRequestBodySize>>20)+"Mi", "Max size of request body for the Dapr HTTP and gRPC servers, as a resource quantity")
+	fs.StringToStringVar(&maxBodySizePerAPI, "max-body-size-per-api", nil, "Max size of request body for groups of Dapr APIs, overriding max-body-size, as a comma-separated list of API names and resource quantities (e.g. 'state=4Mi,publish=256Ki,invoke=0'); 0 means no limit")
+	fs.StringVar(&opts.DaprHTTPTLSCertFile, "dapr-http-tls-cert-file", "", "Path to the PEM file with the certificate of the HTTP API server. If set with dapr-http-tls-key-file, the HTTP API is served over TLS, negotiating HTTP/2 or HTTP/1.1 with ALPN")
+	fs.StringVar(&opts.DaprHTTPTLSKeyFile, "dapr-http-tls-key-file", "", "Path to the PEM file with the private key of the certificate of the HTTP API server")
 	fs.IntVar(&readBufferSizeKB, "dapr-http-read-buffer-size", runtime.DefaultReadBufferSize>>10, "Max size of read buffer, in KB (also used to handle request headers)")
 	fs.MarkDeprecated("dapr-http-read-buffer-size", "use '--read-buffer-size "+strconv.Itoa(runtime.DefaultReadBufferSize>>10)+"Ki'")
 	fs.StringVar(&readBufferSize, "read-buffer-size", strconv.Itoa(runtime.DefaultReadBufferSize>>10)+"Ki", "Max size of read buffer, as a resource quantity (also used to handle request headers)")
+	fs.StringVar(&maxReplayBufferSize, "max-replay-buffer-size", strconv.Itoa(runtime.DefaultMaxReplayBufferSize>>20)+"Mi", "Max size of the request body buffered in memory to retry service invocation requests, as a resource quantity; larger requests are streamed and not retried. 0 means no limit")
 	fs.StringVar(&opts.UnixDomainSocket, "unix-domain-socket", "", "Path to a unix domain socket dir mount. If specified, Dapr API servers will use Unix Domain Sockets")
 	fs.IntVar(&opts.DaprGracefulShutdownSeconds, "dapr-graceful-shutdown-seconds", int(runtime.DefaultGracefulShutdownDuration/time.Second), "Graceful shutdown time in seconds")
 	fs.DurationVar(opts.DaprBlockShutdownDuration, "dapr-block-shutdown-duration", 0, "If enabled, will block graceful shutdown after terminate signal is received until either the given duration has elapsed or the app reports unhealthy. Disabled by default")
+	fs.DurationVar(&opts.DaprDrainTimeout, "dapr-drain-timeout", 0, "If enabled, will drain Dapr after terminate signal is received, waiting up to the given duration for in-flight requests, actor deactivation and outbox messages to complete before shutting down. Disabled by default")
 	fs.BoolVar(opts.EnableAPILogging, "enable-api-logging", false, "Enable API logging for API calls")
 	fs.BoolVar(&opts.DisableBuiltinK8sSecretStore, "disable-builtin-k8s-secret-store", false, "Disable the built-in Kubernetes Secret Store")
 	fs.BoolVar(&opts.EnableAppHealthCheck, "enable-app-health-check", false, "Enable health checks for the application using the protocol defined with app-protocol")
@@ -165,6 +191,19 @@ func New(origArgs []string) (*Options, error) {
 	fs.IntVar(&opts.AppHealthProbeTimeout, "app-health-probe-timeout", int(config.AppHealthConfigDefaultProbeTimeout/time.Millisecond), "Timeout for app health probes in milliseconds")
 	fs.IntVar(&opts.AppHealthThreshold, "app-health-threshold", int(config.AppHealthConfigDefaultThreshold), "Number of consecutive failures for the app to be considered unhealthy")
 	fs.StringVar(&opts.AppChannelAddress, "app-channel-address", runtime.DefaultChannelAddress, "The network address the application listens on")
+	fs.StringVar(&opts.AppUnixDomainSocket, "app-unix-domain-socket", "", "Path to the unix domain socket the application listens on. If specified, it is used instead of app-channel-address and app-port")
+	fs.IntVar(&opts.AppChannelMaxIdleConns, "app-channel-max-idle-conns", config.AppHTTPPoolDefaultMaxIdleConns, "Maximum number of idle connections kept open to the application; HTTP only")
+	fs.IntVar(&opts.AppChannelMaxConnsPerHost, "app-channel-max-conns-per-host", config.AppHTTPPoolDefaultMaxConnsPerHost, "Maximum number of connections to the application; HTTP only")
+	fs.DurationVar(&opts.AppChannelIdleConnTimeout, "app-channel-idle-conn-timeout", config.AppHTTPPoolDefaultIdleConnTimeout, "Time an idle connection to the application is kept open; HTTP only")
+	fs.BoolVar(&opts.AppChannelEnableHTTP2, "app-channel-enable-http2", false, "Allow negotiating HTTP/2 with the application when app-protocol is https")
+	fs.StringVar(&opts.AppChannelTLSSource, "app-channel-tls-source", "", "Source of the certificates to verify the application and authenticate Dapr to it when app-protocol is https or grpcs: file, secretstore or sentry. If empty, the certificate of the application is not verified")
+	fs.StringVar(&opts.AppChannelTLSCAFile, "app-channel-tls-ca-file", "", "Path to the PEM file with the CA that signed the certificate of the application, when app-channel-tls-source is file")
+	fs.StringVar(&opts.AppChannelTLSCertFile, "app-channel-tls-cert-file", "", "Path to the PEM file with the client certificate Dapr presents to the application, when app-channel-tls-source is file")
+	fs.StringVar(&opts.AppChannelTLSKeyFile, "app-channel-tls-key-file", "", "Path to the PEM file with the private key of the client certificate, when app-channel-tls-source is file")
+	fs.StringVar(&opts.AppChannelTLSSecretStore, "app-channel-tls-secret-store", "", "Name of the secret store with the certificates, when app-channel-tls-source is secretstore")
+	fs.StringVar(&opts.AppChannelTLSSecretName, "app-channel-tls-secret-name", "", "Name of the secret with the ca.crt, tls.crt and tls.key certificates, when app-channel-tls-source is secretstore")
+	fs.StringVar(&opts.AppChannelTLSServerName, "app-channel-tls-server-name", "", "Name verified in the certificate of the application; defaults to app-channel-address")
+	fs.StringArrayVar(&opts.AppEndpoints, "app-endpoint", nil, "Additional port the application listens on, with the callbacks sent to it, in the format '<protocol>:<port>=<callback>[,<callback>...]'. Callbacks are: invocation, pubsub, bindings, jobs. Can be passed multiple times")
 
 	// Add flags for actors, placement, and reminders
 	// --placement-host-address is a legacy (but not deprecated) flag that is translated to the actors-service flag
@@ -221,6 +260,21 @@ func New(origArgs []string) (*Options, error) {
 		}
 	}
 
+	// Max body size per API group
+	if len(maxBodySizePerAPI) > 0 {
+		opts.MaxRequestSizePerAPI = make(map[string]int, len(maxBodySizePerAPI))
+		for api, v := range maxBodySizePerAPI {
+			q, err := resource.ParseQuantity(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for 'max-body-size-per-api' option for API '%s': %w", api, err)
+			}
+			opts.MaxRequestSizePerAPI[api], err = getQuantityBytes(q)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for 'max-body-size-per-api' option for API '%s': %w", api, err)
+			}
+		}
+	}
+
 	// Read buffer size
 	// read-buffer-size has priority over dapr-http-read-buffer-size
 	if fs.Changed("read-buffer-size") {
@@ -240,6 +294,18 @@ func New(origArgs []string) (*Options, error) {
 		}
 	}
 
+	// Max replay buffer size
+	if fs.Changed("max-replay-buffer-size") {
+		q, err := resource.ParseQuantity(maxReplayBufferSize)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for 'max-replay-buffer-size' option: %w", err)
+		}
+		opts.MaxReplayBufferSize, err = getQuantityBytes(q)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for 'max-replay-buffer-size' option: %w", err)
+		}
+	}
+
 	opts.TrustAnchors = []byte(os.Getenv(consts.TrustAnchorsEnvVar))
 
 	if !fs.Changed("control-plane-namespace") {
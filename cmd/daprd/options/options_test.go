@@ -215,6 +215,69 @@ func TestMaxBodySize(t *testing.T) {
 	})
 }
 
+func TestMaxBodySizePerAPI(t *testing.T) {
+	t.Run("No max-body-size-per-api", func(t *testing.T) {
+		opts, err := New([]string{})
+		require.NoError(t, err)
+
+		assert.Empty(t, opts.MaxRequestSizePerAPI)
+	})
+
+	t.Run("max-body-size-per-api with units", func(t *testing.T) {
+		opts, err := New([]string{
+			"--max-body-size-per-api", "state=4Mi,publish=256Ki,invoke=0",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]int{
+			"state":   4 << 20,
+			"publish": 256 << 10,
+			"invoke":  0,
+		}, opts.MaxRequestSizePerAPI)
+	})
+
+	t.Run("invalid max-body-size-per-api", func(t *testing.T) {
+		_, err := New([]string{
+			"--max-body-size-per-api", "state=foo",
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestMaxReplayBufferSize(t *testing.T) {
+	t.Run("No max-replay-buffer-size", func(t *testing.T) {
+		opts, err := New([]string{})
+		require.NoError(t, err)
+
+		assert.Equal(t, runtime.DefaultMaxReplayBufferSize, opts.MaxReplayBufferSize)
+	})
+
+	t.Run("max-replay-buffer-size set to 0", func(t *testing.T) {
+		opts, err := New([]string{
+			"--max-replay-buffer-size", "0",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, 0, opts.MaxReplayBufferSize)
+	})
+
+	t.Run("max-replay-buffer-size with unit", func(t *testing.T) {
+		opts, err := New([]string{
+			"--max-replay-buffer-size", "16Mi",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, 16<<20, opts.MaxReplayBufferSize)
+	})
+
+	t.Run("invalid max-replay-buffer-size", func(t *testing.T) {
+		_, err := New([]string{
+			"--max-replay-buffer-size", "foo",
+		})
+		require.Error(t, err)
+	})
+}
+
 func TestReadBufferSize(t *testing.T) {
 	t.Run("No read-buffer-size", func(t *testing.T) {
 		opts, err := New([]string{})
@@ -99,7 +99,14 @@ func Run() {
 		KeepAliveTime:      opts.KeepAliveTime,
 		KeepAliveTimeout:   opts.KeepAliveTimeout,
 		DisseminateTimeout: opts.DisseminateTimeout,
+		ZoneAware:          opts.ZoneAware,
+		MaxPartitionMoves:  opts.MaxPartitionMoves,
+		DrainBudget:        opts.DrainBudget,
 		ListenAddress:      opts.PlacementListenAddress,
+		ExplicitPlacement: placement.ExplicitPlacement{
+			Singletons: opts.SingletonActorTypes,
+			HostLabels: opts.ActorTypeHostLabels,
+		},
 	}
 	placementOpts.SetMinAPILevel(opts.MinAPILevel)
 	placementOpts.SetMaxAPILevel(opts.MaxAPILevel)
@@ -79,6 +79,13 @@ type Options struct {
 	KeepAliveTime      time.Duration
 	KeepAliveTimeout   time.Duration
 	DisseminateTimeout time.Duration
+	ZoneAware          bool
+	MaxPartitionMoves  int
+	DrainBudget        time.Duration
+
+	SingletonActorTypes     []string
+	actorTypeHostLabelsFlag []string
+	ActorTypeHostLabels     map[string]map[string]string
 
 	// Log and metrics configurations
 	Logger  logger.Options
@@ -126,6 +133,12 @@ func New(origArgs []string) (*Options, error) {
 	fs.DurationVar(&opts.KeepAliveTimeout, "keepalive-timeout", keepAliveTimeoutDefault, "sets the timeout period for daprd to respond to the placement service's keepalive pings \nbefore the placement service closes the connection. \nLower values will lead to shorter actor rebalancing time in case of pod loss/restart, \nbut higher network traffic during normal operation. \nAccepts values between 1 and 10 seconds")
 	fs.DurationVar(&opts.DisseminateTimeout, "disseminate-timeout", disseminateTimeoutDefault, "sets the timeout period for dissemination to be delayed after actor membership change \nso as to avoid excessive dissemination during multiple pod restarts. \nHigher values will reduce the frequency of dissemination, but delay the table dissemination. \nAccepts values between 1 and 3 seconds")
 
+	fs.IntVar(&opts.MaxPartitionMoves, "max-partition-moves", 0, "sets the maximum number of actor partitions moving between hosts in a dissemination round. \nHosts joining beyond it are held back to later rounds, smoothing rebalances during rolling deploys. \nUnlimited when 0")
+	fs.DurationVar(&opts.DrainBudget, "drain-budget", 0, "sets the time the hosts losing actor partitions have to drain them before more partitions move to the hosts joining")
+	fs.BoolVar(&opts.ZoneAware, "zone-aware", false, "Place the actors of each actor type on the hosts in the zone most Dapr runtimes are in, using the zones the runtimes report")
+	fs.StringSliceVar(&opts.SingletonActorTypes, "singleton-actor-types", nil, "Actor types hosted by a single host of the namespace at a time, rather than sharded by actor ID")
+	fs.StringSliceVar(&opts.actorTypeHostLabelsFlag, "actor-type-host-labels", nil, "Labels the hosts of an actor type must have, in the format 'actorType:key=value'. \nThe labels of a host are reported by the Dapr runtime from the DAPR_HOST_LABELS environment variable")
+
 	fs.StringVar(&opts.TrustDomain, "trust-domain", "localhost", "Trust domain for the Dapr control plane")
 	fs.StringVar(&opts.TrustAnchorsFile, "trust-anchors-file", securityConsts.ControlPlaneDefaultTrustAnchorsPath, "Filepath to the trust anchors for the Dapr control plane")
 	fs.StringVar(&opts.SentryAddress, "sentry-address", fmt.Sprintf("dapr-sentry.%s.svc:443", security.CurrentNamespace()), "Address of the Sentry service")
@@ -145,6 +158,7 @@ func New(origArgs []string) (*Options, error) {
 	}
 
 	opts.RaftPeers = parsePeersFromFlag(opts.raftPeerFlag)
+	opts.ActorTypeHostLabels = parseHostLabelsFromFlag(opts.actorTypeHostLabelsFlag)
 	if opts.RaftLogStorePath != "" {
 		opts.RaftInMemEnabled = false
 	}
@@ -172,6 +186,33 @@ func parsePeersFromFlag(val []string) []raft.PeerInfo {
 	return peers[:i]
 }
 
+// parseHostLabelsFromFlag parses the labels of the hosts of actor types, in
+// the 'actorType:key=value' format.
+func parseHostLabelsFromFlag(val []string) map[string]map[string]string {
+	if len(val) == 0 {
+		return nil
+	}
+
+	labels := make(map[string]map[string]string)
+	for _, v := range val {
+		actorType, label, ok := strings.Cut(v, ":")
+		if !ok {
+			continue
+		}
+		key, value, ok := strings.Cut(label, "=")
+		if !ok {
+			continue
+		}
+		actorType = strings.TrimSpace(actorType)
+		if labels[actorType] == nil {
+			labels[actorType] = make(map[string]string)
+		}
+		labels[actorType][strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+
+	return labels
+}
+
 func (o *Options) Validate() error {
 	if o.KeepAliveTime < keepAliveTimeMin || o.KeepAliveTime > keepAliveTimeMax {
 		return fmt.Errorf("invalid value for keepalive-time: value should be between %s and %s, got %s", keepAliveTimeMin, keepAliveTimeMax, o.KeepAliveTime)
@@ -185,5 +226,21 @@ func (o *Options) Validate() error {
 		return fmt.Errorf("invalid value for disseminate-timeout: value should be between %s and %s, got %s", disseminateTimeoutMin, disseminateTimeoutMax, o.DisseminateTimeout)
 	}
 
+	if o.MaxPartitionMoves < 0 {
+		return fmt.Errorf("invalid value for max-partition-moves: value should be positive, got %d", o.MaxPartitionMoves)
+	}
+
+	if o.DrainBudget < 0 {
+		return fmt.Errorf("invalid value for drain-budget: value should be positive, got %s", o.DrainBudget)
+	}
+
+	for _, v := range o.actorTypeHostLabelsFlag {
+		actorType, label, _ := strings.Cut(v, ":")
+		key, _, ok := strings.Cut(label, "=")
+		if strings.TrimSpace(actorType) == "" || strings.TrimSpace(key) == "" || !ok {
+			return fmt.Errorf("invalid value for actor-type-host-labels: value should be in the format 'actorType:key=value', got %q", v)
+		}
+	}
+
 	return nil
 }
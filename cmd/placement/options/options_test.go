@@ -139,6 +139,16 @@ func TestValidateFlags(t *testing.T) {
 			"disseminate-timeout",
 			"6s",
 		},
+		{
+			"actor-type-host-labels without label",
+			"actor-type-host-labels",
+			"coordinator",
+		},
+		{
+			"actor-type-host-labels without value",
+			"actor-type-host-labels",
+			"coordinator:tier",
+		},
 	}
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
@@ -147,4 +157,27 @@ func TestValidateFlags(t *testing.T) {
 			require.Contains(t, err.Error(), "invalid value for "+tt.arg)
 		})
 	}
+
+	// Negative values are passed inline, as they would be taken for flags.
+	for arg, value := range map[string]string{"max-partition-moves": "-1", "drain-budget": "-1s"} {
+		t.Run(arg+" negative", func(t *testing.T) {
+			_, err := New([]string{"--" + arg + "=" + value})
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "invalid value for "+arg)
+		})
+	}
+}
+
+func TestExplicitPlacementFlags(t *testing.T) {
+	opts, err := New([]string{
+		"--singleton-actor-types", "coordinator,leader",
+		"--actor-type-host-labels", "coordinator:tier=control,coordinator:disk=ssd",
+		"--actor-type-host-labels", "worker:tier=data",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"coordinator", "leader"}, opts.SingletonActorTypes)
+	assert.Equal(t, map[string]map[string]string{
+		"coordinator": {"tier": "control", "disk": "ssd"},
+		"worker":      {"tier": "data"},
+	}, opts.ActorTypeHostLabels)
 }
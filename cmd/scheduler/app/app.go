@@ -126,6 +126,11 @@ func Run() {
 				EtcdClientPassword:  opts.EtcdClientPassword,
 
 				Workers: opts.Workers,
+
+				MaxJobsPerApp:              opts.MaxJobsPerApp,
+				MaxJobsPerNamespace:        opts.MaxJobsPerNamespace,
+				MaxTriggerRatePerApp:       opts.MaxTriggerRatePerApp,
+				MaxTriggerRatePerNamespace: opts.MaxTriggerRatePerNamespace,
 			})
 			if serr != nil {
 				return serr
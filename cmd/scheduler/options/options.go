@@ -73,6 +73,11 @@ type Options struct {
 
 	Workers uint32
 
+	MaxJobsPerApp              uint32
+	MaxJobsPerNamespace        uint32
+	MaxTriggerRatePerApp       float64
+	MaxTriggerRatePerNamespace float64
+
 	IdentityDirectoryWrite string
 
 	Logger  logger.Options
@@ -135,6 +140,11 @@ func New(origArgs []string) (*Options, error) {
 
 	fs.Uint32Var(&opts.Workers, "workers", 128, "Workers is the number of workers that handle job events. The higher the number the more go routines will be spawned, each working over a partition of the total job space. The higher the number, the higher the number of jobs which can be concurrently delivered to runtimes. Increasing this number increases the number of go routines for this instance. This number should be tuned to the bottleneck of job execution, i.e., CPU, I/O, memory, etc.")
 
+	fs.Uint32Var(&opts.MaxJobsPerApp, "max-jobs-per-app", 0, "The maximum number of jobs an app can have. Scheduling more jobs is rejected with a ResourceExhausted error. Actor reminders are not counted. 0 is unlimited.")
+	fs.Uint32Var(&opts.MaxJobsPerNamespace, "max-jobs-per-namespace", 0, "The maximum number of jobs all the apps of a namespace can have. Scheduling more jobs is rejected with a ResourceExhausted error. Actor reminders are not counted. 0 is unlimited.")
+	fs.Float64Var(&opts.MaxTriggerRatePerApp, "max-trigger-rate-per-app", 0, "The maximum number of job triggers per second of an app, enforced by each scheduler instance. Triggers exceeding the rate are deferred. Actor reminders are not counted. 0 is unlimited.")
+	fs.Float64Var(&opts.MaxTriggerRatePerNamespace, "max-trigger-rate-per-namespace", 0, "The maximum number of job triggers per second of all the apps of a namespace, enforced by each scheduler instance. Triggers exceeding the rate are deferred. Actor reminders are not counted. 0 is unlimited.")
+
 	if err := fs.MarkHidden("identity-directory-write"); err != nil {
 		log.Fatal(err)
 	}
@@ -186,5 +196,13 @@ func New(origArgs []string) (*Options, error) {
 		return nil, errors.New("must specify --etcd-client-endpoints when not using embedded etcd")
 	}
 
+	if opts.MaxTriggerRatePerApp < 0 {
+		return nil, errors.New("--max-trigger-rate-per-app must not be negative")
+	}
+
+	if opts.MaxTriggerRatePerNamespace < 0 {
+		return nil, errors.New("--max-trigger-rate-per-namespace must not be negative")
+	}
+
 	return &opts, nil
 }
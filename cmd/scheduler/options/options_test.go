@@ -16,6 +16,7 @@ package options
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -54,4 +55,25 @@ func TestNew(t *testing.T) {
 		})
 		require.NoError(t, err)
 	})
+
+	t.Run("quotas", func(t *testing.T) {
+		opts, err := New([]string{
+			"--max-jobs-per-app=100",
+			"--max-jobs-per-namespace=1000",
+			"--max-trigger-rate-per-app=10",
+			"--max-trigger-rate-per-namespace=0.5",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, uint32(100), opts.MaxJobsPerApp)
+		assert.Equal(t, uint32(1000), opts.MaxJobsPerNamespace)
+		assert.InDelta(t, 10.0, opts.MaxTriggerRatePerApp, 0)
+		assert.InDelta(t, 0.5, opts.MaxTriggerRatePerNamespace, 0)
+	})
+
+	t.Run("error when a trigger rate quota is negative", func(t *testing.T) {
+		_, err := New([]string{"--max-trigger-rate-per-app=-1"})
+		require.Error(t, err)
+		_, err = New([]string{"--max-trigger-rate-per-namespace=-1"})
+		require.Error(t, err)
+	})
 }
@@ -37,6 +37,7 @@ import (
 	internaltimers "github.com/dapr/dapr/pkg/actors/internal/timers"
 	"github.com/dapr/dapr/pkg/actors/internal/timers/inmemory"
 	"github.com/dapr/dapr/pkg/actors/reminders"
+	reminderstatestore "github.com/dapr/dapr/pkg/actors/reminders/statestore"
 	"github.com/dapr/dapr/pkg/actors/router"
 	actorstate "github.com/dapr/dapr/pkg/actors/state"
 	"github.com/dapr/dapr/pkg/actors/table"
@@ -56,6 +57,11 @@ import (
 
 var log = logger.NewLogger("dapr.runtime.actor")
 
+// DefaultReadReplicas is the number of hosts serving read-only invocations of
+// an actor when the ActorReadReplicas feature is enabled, unless overridden by
+// the DAPR_ACTOR_READ_REPLICAS environment variable.
+const DefaultReadReplicas = 3
+
 type Options struct {
 	AppID              string
 	Namespace          string
@@ -70,6 +76,15 @@ type Options struct {
 	StateTTLEnabled    bool
 	MaxRequestBodySize int
 	Mode               modes.DaprMode
+	// ReadReplicas is the number of hosts serving read-only invocations of an
+	// actor, including the host of the actor.
+	ReadReplicas int
+	// Zone is the zone reported to the placement service, used for zone aware
+	// placement of actors.
+	Zone string
+	// HostLabels are the labels reported to the placement service, used for
+	// placing actor types on the hosts having given labels.
+	HostLabels map[string]string
 }
 
 type InitOptions struct {
@@ -78,6 +93,10 @@ type InitOptions struct {
 	GRPC              *manager.Manager
 	SchedulerClient   schedulerv1pb.SchedulerClient
 	SchedulerReloader schedclient.Reloader
+	// ReminderStoreName is the name of the state store keeping the reminders,
+	// instead of the Scheduler service. The reminders of the hosted actor types
+	// are migrated from the Scheduler service to it on start.
+	ReminderStoreName string
 }
 
 // Interface is the main runtime for the actors subsystem.
@@ -111,8 +130,13 @@ type actors struct {
 	// TODO: @joshvanl Remove in Dapr 1.12 when ActorStateTTL is finalized.
 	stateTTLEnabled    bool
 	maxRequestBodySize int
+	readReplicas       int
+	zone               string
+	hostLabels         map[string]string
 
 	reminders       reminders.Interface
+	reminderStore   *reminderstatestore.Store
+	migrateFrom     reminders.Store
 	table           table.Interface
 	placement       placement.Interface
 	router          router.Interface
@@ -162,6 +186,9 @@ func New(opts Options) Interface {
 		initDoneCh:         make(chan struct{}),
 		registerDoneCh:     make(chan struct{}),
 		maxRequestBodySize: opts.MaxRequestBodySize,
+		readReplicas:       opts.ReadReplicas,
+		zone:               opts.Zone,
+		hostLabels:         opts.HostLabels,
 		mode:               opts.Mode,
 		reentrancyStore:    reentrancystore.New(),
 	}
@@ -188,11 +215,6 @@ func (a *actors) Init(opts InitOptions) error {
 		Client:    opts.SchedulerClient,
 		Table:     a.table,
 	})
-	a.reminders = reminders.New(reminders.Options{
-		Scheduler: a.scheduler,
-		Table:     a.table,
-	})
-
 	var err error
 	a.placement, err = placement.New(placement.Options{
 		AppID:     a.appID,
@@ -206,11 +228,40 @@ func (a *actors) Init(opts InitOptions) error {
 		Healthz:   a.healthz,
 		Mode:      a.mode,
 		Scheduler: opts.SchedulerReloader,
+
+		ReadReplicas: a.readReplicas,
+		Zone:         a.zone,
+		HostLabels:   a.hostLabels,
 	})
 	if err != nil {
 		return err
 	}
 
+	var reminderStore reminders.Store
+	if len(opts.ReminderStoreName) > 0 {
+		a.reminderStore = reminderstatestore.New(reminderstatestore.Options{
+			AppID:     a.appID,
+			StoreName: opts.ReminderStoreName,
+			CompStore: a.compStore,
+			Table:     a.table,
+			Placement: a.placement,
+			Trigger: func(ctx context.Context, reminder *api.Reminder) error {
+				return a.router.CallReminder(ctx, reminder)
+			},
+		})
+		reminderStore = a.reminderStore
+		if opts.SchedulerClient != nil {
+			a.migrateFrom = a.scheduler
+		}
+	}
+	a.reminders = reminders.New(reminders.Options{
+		Scheduler: a.scheduler,
+		Store:     reminderStore,
+		Table:     a.table,
+		Placement: a.placement,
+		Clock:     a.clock,
+	})
+
 	if storeEnabled {
 		a.state = actorstate.New(actorstate.Options{
 			AppID:           a.appID,
@@ -220,6 +271,7 @@ func (a *actors) Init(opts InitOptions) error {
 			StateTTLEnabled: a.stateTTLEnabled,
 			Table:           a.table,
 			Placement:       a.placement,
+			Clock:           a.clock,
 		})
 	}
 
@@ -237,8 +289,9 @@ func (a *actors) Init(opts InitOptions) error {
 		Router: a.router,
 	})
 	a.timers = timers.New(timers.Options{
-		Storage: a.timerStorage,
-		Table:   a.table,
+		Storage:   a.timerStorage,
+		Table:     a.table,
+		Placement: a.placement,
 	})
 
 	return nil
@@ -279,6 +332,21 @@ func (a *actors) Run(ctx context.Context) error {
 			}
 			return a.placement.Run(ctx)
 		},
+		func(ctx context.Context) error {
+			if a.reminderStore == nil {
+				<-ctx.Done()
+				return nil
+			}
+			if a.migrateFrom != nil {
+				select {
+				case <-a.registerDoneCh:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+				a.migrateReminders(ctx)
+			}
+			return a.reminderStore.Run(ctx)
+		},
 		func(ctx context.Context) error {
 			<-ctx.Done()
 			log.Info("Actor runtime shutting down")
@@ -386,25 +454,6 @@ func (a *actors) RegisterHosted(cfg hostconfig.Config) error {
 		return nil
 	}
 
-	entityConfigs := make(map[string]api.EntityConfig)
-	for _, entityConfg := range cfg.EntityConfigs {
-		config := api.TranslateEntityConfig(entityConfg)
-		for _, entity := range entityConfg.Entities {
-			var found bool
-			for _, hostedType := range cfg.HostedActorTypes {
-				if hostedType == entity {
-					entityConfigs[entity] = config
-					found = true
-					break
-				}
-			}
-
-			if !found {
-				log.Warnf("Configuration specified for non-hosted actor type: %s", entity)
-			}
-		}
-	}
-
 	drainOngoingCallTimeout := api.DefaultOngoingCallTimeout
 	if len(cfg.DrainOngoingCallTimeout) > 0 {
 		var err error
@@ -423,31 +472,73 @@ func (a *actors) RegisterHosted(cfg hostconfig.Config) error {
 		}
 	}
 
+	var lifecycleHookTimeout time.Duration
+	if cfg.LifecycleHooks.Enabled {
+		lifecycleHookTimeout = api.DefaultLifecycleHookTimeout
+		if len(cfg.LifecycleHooks.Timeout) > 0 {
+			var err error
+			lifecycleHookTimeout, err = time.ParseDuration(cfg.LifecycleHooks.Timeout)
+			if err != nil {
+				return fmt.Errorf("failed to parse actor lifecycle hook timeout: %s", err)
+			}
+		}
+	}
+
 	reentrancy := cfg.Reentrancy
 	if reentrancy.MaxStackDepth == nil {
 		reentrancy.MaxStackDepth = ptr.Of(api.DefaultReentrancyStackLimit)
 	}
 
+	// The settings of the host apply to the actor types without their own.
+	hostConfig := api.EntityConfig{
+		ActorIdleTimeout:        idleTimeout,
+		DrainOngoingCallTimeout: drainOngoingCallTimeout,
+		DrainRebalancedActors:   cfg.DrainRebalancedActors,
+		ReentrancyConfig:        reentrancy,
+	}
+
+	entityConfigs := make(map[string]api.EntityConfig)
+	for _, entityConfg := range cfg.EntityConfigs {
+		config := api.TranslateEntityConfig(entityConfg, hostConfig)
+		for _, entity := range entityConfg.Entities {
+			var found bool
+			for _, hostedType := range cfg.HostedActorTypes {
+				if hostedType == entity {
+					entityConfigs[entity] = config
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				log.Warnf("Configuration specified for non-hosted actor type: %s", entity)
+			}
+		}
+	}
+
 	factories := make([]table.ActorTypeFactory, 0, len(cfg.HostedActorTypes))
 	for _, actorType := range cfg.HostedActorTypes {
-		idleTimeout := idleTimeout
-		reentrancy := reentrancy
+		var entityConfig *api.EntityConfig
+		typeConfig := hostConfig
 		if c, ok := entityConfigs[actorType]; ok {
-			idleTimeout = c.ActorIdleTimeout
-			reentrancy = c.ReentrancyConfig
+			entityConfig = &c
+			typeConfig = c
 		}
 
 		factories = append(factories, table.ActorTypeFactory{
 			Type:       actorType,
-			Reentrancy: reentrancy,
+			Reentrancy: typeConfig.ReentrancyConfig,
 			Factory: app.New(app.Options{
 				ActorType:               actorType,
 				AppChannel:              cfg.AppChannel,
 				Resiliency:              a.resiliency,
-				IdleTimeout:             idleTimeout,
+				IdleTimeout:             typeConfig.ActorIdleTimeout,
 				Reentrancy:              a.reentrancyStore,
-				DrainOngoingCallTimeout: drainOngoingCallTimeout,
+				DrainOngoingCallTimeout: typeConfig.DrainOngoingCallTimeout,
 				Placement:               a.placement,
+				EntityConfig:            entityConfig,
+				DrainRebalancedActors:   cfg.DrainRebalancedActors,
+				LifecycleHookTimeout:    lifecycleHookTimeout,
 			}),
 		})
 	}
@@ -584,3 +675,20 @@ func ValidateHostEnvironment(mTLSEnabled bool, mode modes.DaprMode, namespace st
 	}
 	return nil
 }
+
+// migrateReminders moves the reminders of the hosted actor types from the
+// Scheduler service to the reminder store. Failures are logged, since the
+// migration is resumed on the next start.
+func (a *actors) migrateReminders(ctx context.Context) {
+	moved, err := reminders.Migrate(ctx, reminders.MigrateOptions{
+		From:       a.migrateFrom,
+		To:         a.reminderStore,
+		ActorTypes: a.table.Types(),
+	})
+	if err != nil {
+		log.Errorf("Error migrating reminders from the Scheduler service: %s", err)
+	}
+	if moved > 0 {
+		log.Infof("Migrated %d reminders from the Scheduler service", moved)
+	}
+}
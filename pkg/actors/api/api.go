@@ -23,6 +23,11 @@ import (
 
 const (
 	DaprSeparator = "||"
+
+	// TimeoutHeader is the header of the actor method invocations sent to the
+	// app holding the time left, in milliseconds, before the invocation is
+	// cancelled.
+	TimeoutHeader = "dapr-actor-timeout"
 )
 
 // ActorHostedRequest is the request object for checking if an actor is hosted on this instance.
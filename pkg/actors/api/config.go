@@ -25,6 +25,7 @@ const (
 
 	DefaultOngoingCallTimeout   = time.Second * 60
 	DefaultReentrancyStackLimit = 32
+	DefaultLifecycleHookTimeout = time.Second * 5
 )
 
 var log = logger.NewLogger("dapr.runtime.actor.config")
@@ -39,40 +40,42 @@ type EntityConfig struct {
 	RemindersStoragePartitions int
 }
 
-// TranslateEntityConfig converts a user-defined configuration into a
-// domain-specific EntityConfig.
-func TranslateEntityConfig(appConfig config.EntityConfig) EntityConfig {
+// TranslateEntityConfig converts a user-defined configuration of actor types
+// into a domain-specific EntityConfig. The settings which aren't set, or are
+// invalid, are inherited from defaults, which holds the settings of the host.
+func TranslateEntityConfig(appConfig config.EntityConfig, defaults EntityConfig) EntityConfig {
 	domainConfig := EntityConfig{
 		Entities:                   appConfig.Entities,
-		ActorIdleTimeout:           DefaultIdleTimeout,
-		DrainOngoingCallTimeout:    DefaultOngoingCallTimeout,
+		ActorIdleTimeout:           defaults.ActorIdleTimeout,
+		DrainOngoingCallTimeout:    defaults.DrainOngoingCallTimeout,
 		DrainRebalancedActors:      appConfig.DrainRebalancedActors,
 		ReentrancyConfig:           appConfig.Reentrancy,
 		RemindersStoragePartitions: appConfig.RemindersStoragePartitions,
 	}
 
-	var idleDuration time.Duration
 	if len(appConfig.ActorIdleTimeout) > 0 {
-		var err error
-		idleDuration, err = time.ParseDuration(appConfig.ActorIdleTimeout)
-		if err == nil {
-			log.Warnf("Invalid actor idle timeout value %s, using default value %s", appConfig.ActorIdleTimeout, DefaultIdleTimeout)
+		idleDuration, err := time.ParseDuration(appConfig.ActorIdleTimeout)
+		if err != nil {
+			log.Warnf("Invalid actor idle timeout value %s, using default value %s", appConfig.ActorIdleTimeout, defaults.ActorIdleTimeout)
+		} else {
 			domainConfig.ActorIdleTimeout = idleDuration
 		}
 	}
 
-	var drainCallDuration time.Duration
 	if len(appConfig.DrainOngoingCallTimeout) > 0 {
-		var err error
-		drainCallDuration, err = time.ParseDuration(appConfig.DrainOngoingCallTimeout)
-		log.Warnf("Invalid drain ongoing call timeout value %s, using default value %s", appConfig.DrainOngoingCallTimeout, DefaultOngoingCallTimeout)
-		if err == nil {
+		drainCallDuration, err := time.ParseDuration(appConfig.DrainOngoingCallTimeout)
+		if err != nil {
+			log.Warnf("Invalid drain ongoing call timeout value %s, using default value %s", appConfig.DrainOngoingCallTimeout, defaults.DrainOngoingCallTimeout)
+		} else {
 			domainConfig.DrainOngoingCallTimeout = drainCallDuration
 		}
 	}
 
 	if appConfig.Reentrancy.MaxStackDepth == nil {
 		reentrancyLimit := DefaultReentrancyStackLimit
+		if defaults.ReentrancyConfig.MaxStackDepth != nil {
+			reentrancyLimit = *defaults.ReentrancyConfig.MaxStackDepth
+		}
 		domainConfig.ReentrancyConfig.MaxStackDepth = &reentrancyLimit
 	}
 
@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/kit/ptr"
+)
+
+func TestTranslateEntityConfig(t *testing.T) {
+	t.Parallel()
+
+	defaults := EntityConfig{
+		ActorIdleTimeout:        time.Minute,
+		DrainOngoingCallTimeout: 10 * time.Second,
+		ReentrancyConfig:        config.ReentrancyConfig{MaxStackDepth: ptr.Of(8)},
+	}
+
+	t.Run("settings of the actor type", func(t *testing.T) {
+		c := TranslateEntityConfig(config.EntityConfig{
+			Entities:                []string{"a"},
+			ActorIdleTimeout:        "5s",
+			DrainOngoingCallTimeout: "2m",
+			DrainRebalancedActors:   true,
+			Reentrancy:              config.ReentrancyConfig{Enabled: true, MaxStackDepth: ptr.Of(4)},
+		}, defaults)
+		assert.Equal(t, []string{"a"}, c.Entities)
+		assert.Equal(t, 5*time.Second, c.ActorIdleTimeout)
+		assert.Equal(t, 2*time.Minute, c.DrainOngoingCallTimeout)
+		assert.True(t, c.DrainRebalancedActors)
+		assert.True(t, c.ReentrancyConfig.Enabled)
+		assert.Equal(t, 4, *c.ReentrancyConfig.MaxStackDepth)
+	})
+
+	t.Run("unset settings are inherited", func(t *testing.T) {
+		c := TranslateEntityConfig(config.EntityConfig{Entities: []string{"a"}}, defaults)
+		assert.Equal(t, time.Minute, c.ActorIdleTimeout)
+		assert.Equal(t, 10*time.Second, c.DrainOngoingCallTimeout)
+		assert.Equal(t, 8, *c.ReentrancyConfig.MaxStackDepth)
+	})
+
+	t.Run("invalid settings are inherited", func(t *testing.T) {
+		c := TranslateEntityConfig(config.EntityConfig{
+			ActorIdleTimeout:        "soon",
+			DrainOngoingCallTimeout: "later",
+		}, defaults)
+		assert.Equal(t, time.Minute, c.ActorIdleTimeout)
+		assert.Equal(t, 10*time.Second, c.DrainOngoingCallTimeout)
+	})
+
+	t.Run("default stack depth", func(t *testing.T) {
+		c := TranslateEntityConfig(config.EntityConfig{}, EntityConfig{})
+		assert.Equal(t, DefaultReentrancyStackLimit, *c.ReentrancyConfig.MaxStackDepth)
+	})
+}
@@ -17,6 +17,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	timeutils "github.com/dapr/kit/time"
@@ -71,11 +72,29 @@ func (p ReminderPeriod) HasRepeats() bool {
 		(p.years != 0 || p.months != 0 || p.days != 0 || p.period != 0)
 }
 
+// Repeats returns the number of repetitions of the period, or -1 if they are unlimited.
+func (p ReminderPeriod) Repeats() int {
+	return p.repeats
+}
+
 // GetFollowing returns the next time the periodic reminder should fire after a given time.
 func (p ReminderPeriod) GetFollowing(t time.Time) time.Time {
 	return t.AddDate(p.years, p.months, p.days).Add(p.period)
 }
 
+// interval returns the fixed interval of the period, which is the interval of
+// the "@every" schedules of the Scheduler service.
+func (p ReminderPeriod) interval() (time.Duration, bool) {
+	if every, ok := strings.CutPrefix(p.value, "@every "); ok {
+		d, err := time.ParseDuration(every)
+		return d, err == nil && d > 0
+	}
+	if p.years != 0 || p.months != 0 || p.days != 0 {
+		return 0, false
+	}
+	return p.period, p.period > 0
+}
+
 // String implements fmt.Stringer. It returns the value.
 func (p ReminderPeriod) String() string {
 	return p.value
@@ -13,11 +13,25 @@ limitations under the License.
 
 package api
 
+import (
+	"strings"
+
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	kitstrings "github.com/dapr/kit/strings"
+)
+
+// ReadOnlyMetadata is the metadata declaring an actor method invocation as
+// read-only, which can be served by a read replica of the actor.
+const ReadOnlyMetadata = "dapr-actor-read-only"
+
 // LookupActorRequest is the request for LookupActor.
 type LookupActorRequest struct {
 	ActorType string
 	ActorID   string
 	NoCache   bool
+	// ReadOnly looks up a host serving read-only invocations of the actor, which
+	// is either the host of the actor or one of its read replicas.
+	ReadOnly bool
 }
 
 // ActorKey returns the key for the actor, which is "type/id".
@@ -31,3 +45,15 @@ type LookupActorResponse struct {
 	AppID   string
 	Local   bool
 }
+
+// IsReadOnlyRequest returns true if the actor method invocation is declared
+// as read-only in its metadata.
+func IsReadOnlyRequest(req *internalv1pb.InternalInvokeRequest) bool {
+	for k, v := range req.GetMetadata() {
+		// The metadata of HTTP requests holds canonicalized header names.
+		if strings.EqualFold(k, ReadOnlyMetadata) && len(v.GetValues()) > 0 {
+			return kitstrings.IsTruthy(v.GetValues()[0])
+		}
+	}
+	return false
+}
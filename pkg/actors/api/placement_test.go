@@ -0,0 +1,35 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+)
+
+func TestIsReadOnlyRequest(t *testing.T) {
+	assert.False(t, IsReadOnlyRequest(internalv1pb.NewInternalInvokeRequest("method")))
+	assert.True(t, IsReadOnlyRequest(internalv1pb.NewInternalInvokeRequest("method").
+		WithMetadata(map[string][]string{ReadOnlyMetadata: {"true"}})))
+	assert.False(t, IsReadOnlyRequest(internalv1pb.NewInternalInvokeRequest("method").
+		WithMetadata(map[string][]string{ReadOnlyMetadata: {"false"}})))
+
+	header := http.Header{}
+	header.Set(ReadOnlyMetadata, "1")
+	assert.True(t, IsReadOnlyRequest(internalv1pb.NewInternalInvokeRequest("method").WithHTTPHeaders(header)))
+}
@@ -62,6 +62,41 @@ func (r Reminder) NextTick() (time.Time, bool) {
 	return r.RegisteredTime, active
 }
 
+// NextFireTime returns the time the reminder fires next after now. The second
+// returned value is false if the reminder doesn't fire again, or if the next
+// fire time can't be determined because the due time of the reminder is
+// relative to when it was created.
+func (r Reminder) NextFireTime(now time.Time) (time.Time, bool) {
+	start := r.RegisteredTime
+	if start.IsZero() {
+		var err error
+		start, err = time.Parse(time.RFC3339, r.DueTime)
+		if err != nil {
+			return time.Time{}, false
+		}
+	}
+
+	next := start
+	if next.Before(now) {
+		interval, ok := r.Period.interval()
+		if !ok {
+			return time.Time{}, false
+		}
+
+		ticks := int(now.Sub(start)/interval) + 1
+		if repeats := r.Period.Repeats(); repeats > 0 && ticks >= repeats {
+			return time.Time{}, false
+		}
+		next = start.Add(time.Duration(ticks) * interval)
+	}
+
+	if !r.ExpirationTime.IsZero() && next.After(r.ExpirationTime) {
+		return time.Time{}, false
+	}
+
+	return next, true
+}
+
 // HasRepeats returns true if the reminder has repeats left.
 func (r Reminder) HasRepeats() bool {
 	return r.Period.HasRepeats()
@@ -287,3 +287,55 @@ func compactJSON(t *testing.T, data []byte) []byte {
 	require.NoError(t, err)
 	return out.Bytes()
 }
+
+func TestReminderNextFireTime(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		reminder Reminder
+		next     string
+	}{
+		"due in the future": {
+			reminder: Reminder{DueTime: "2026-01-01T13:00:00Z"},
+			next:     "2026-01-01T13:00:00Z",
+		},
+		"fired without period": {
+			reminder: Reminder{DueTime: "2026-01-01T11:00:00Z"},
+		},
+		"relative due time": {
+			reminder: Reminder{DueTime: "10s", Period: NewSchedulerReminderPeriod("@every 1m0s", 0)},
+		},
+		"repeating": {
+			reminder: Reminder{DueTime: "2026-01-01T11:00:00Z", Period: NewSchedulerReminderPeriod("@every 25m0s", 0)},
+			next:     "2026-01-01T12:15:00Z",
+		},
+		"repeats left": {
+			reminder: Reminder{DueTime: "2026-01-01T11:00:00Z", Period: NewSchedulerReminderPeriod("@every 25m0s", 4)},
+			next:     "2026-01-01T12:15:00Z",
+		},
+		"no repeats left": {
+			reminder: Reminder{DueTime: "2026-01-01T11:00:00Z", Period: NewSchedulerReminderPeriod("@every 25m0s", 3)},
+		},
+		"expired": {
+			reminder: Reminder{DueTime: "2026-01-01T11:00:00Z", Period: NewSchedulerReminderPeriod("@every 25m0s", 0), ExpirationTime: now.Add(time.Minute)},
+		},
+		"registered time": {
+			reminder: Reminder{RegisteredTime: now.Add(time.Hour), DueTime: "1h"},
+			next:     "2026-01-01T13:00:00Z",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			next, ok := tc.reminder.NextFireTime(now)
+			if tc.next == "" {
+				assert.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			assert.Equal(t, tc.next, next.Format(time.RFC3339))
+		})
+	}
+}
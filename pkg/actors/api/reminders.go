@@ -44,6 +44,10 @@ type CreateReminderRequest struct {
 	TTL       string     `json:"ttl"`
 	Overwrite *bool      `json:"overwrite"`
 
+	// Schedule is a cron expression the reminder is triggered on, instead of its
+	// period. It's only supported by the Scheduler service.
+	Schedule string `json:"schedule,omitempty"`
+
 	FailurePolicy *commonv1.JobFailurePolicy `json:"failure_policy,omitempty"`
 }
 
@@ -234,6 +238,43 @@ type ListRemindersRequest struct {
 	ActorID   *string
 }
 
+// QueryRemindersRequest is the request to list or delete the reminders of an
+// actor type matching a filter.
+type QueryRemindersRequest struct {
+	ActorType string
+	ActorID   *string
+	// NextFireAfter and NextFireBefore select the reminders firing next within
+	// the time range. Reminders whose next fire time is unknown never match a
+	// time range.
+	NextFireAfter  *time.Time
+	NextFireBefore *time.Time
+}
+
+// Matches returns true if the reminder matches the filter of the request.
+func (r QueryRemindersRequest) Matches(reminder *Reminder, now time.Time) bool {
+	if reminder.ActorType != r.ActorType {
+		return false
+	}
+	if r.ActorID != nil && reminder.ActorID != *r.ActorID {
+		return false
+	}
+	if r.NextFireAfter == nil && r.NextFireBefore == nil {
+		return true
+	}
+
+	next, ok := reminder.NextFireTime(now)
+	if !ok {
+		return false
+	}
+	if r.NextFireAfter != nil && next.Before(*r.NextFireAfter) {
+		return false
+	}
+	if r.NextFireBefore != nil && !next.Before(*r.NextFireBefore) {
+		return false
+	}
+	return true
+}
+
 // DeleteTimerRequest is a request object for deleting a timer.
 type DeleteTimerRequest struct {
 	Name      string
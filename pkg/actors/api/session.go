@@ -0,0 +1,44 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"strings"
+
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+)
+
+const (
+	// SessionMetadata is the metadata of an actor method invocation holding
+	// the session token chosen by the external caller. Invocations of the same
+	// actor with the same session token are pinned to the host resolved by
+	// the first invocation, and share its reentrancy context.
+	SessionMetadata = "dapr-actor-session"
+
+	// ReentrancyIDMetadata is the metadata of an actor method invocation
+	// holding the ID of the reentrant call chain it belongs to.
+	ReentrancyIDMetadata = "Dapr-Reentrancy-Id"
+)
+
+// SessionToken returns the session token of an actor method invocation, and
+// false if the invocation isn't part of a session.
+func SessionToken(req *internalv1pb.InternalInvokeRequest) (string, bool) {
+	for k, v := range req.GetMetadata() {
+		// The metadata of HTTP requests holds canonicalized header names.
+		if strings.EqualFold(k, SessionMetadata) && len(v.GetValues()) > 0 && v.GetValues()[0] != "" {
+			return v.GetValues()[0], true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"strconv"
+
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+)
+
+const (
+	// StreamOffsetHeader is the header of the chunks of a streamed actor method
+	// response holding the offset of the chunk in the response data.
+	StreamOffsetHeader = "dapr-stream-offset"
+
+	// StreamResumeOffsetMetadata is the metadata of a streamed actor method
+	// request holding the offset the response data is resumed from, after the
+	// stream was interrupted.
+	StreamResumeOffsetMetadata = "dapr-stream-resume-offset"
+)
+
+// StreamChunkOffset returns the offset of a chunk of a streamed actor method
+// response, and false if the response isn't a chunk.
+func StreamChunkOffset(res *internalv1pb.InternalInvokeResponse) (int64, bool) {
+	return parseStreamOffset(res.GetHeaders()[StreamOffsetHeader])
+}
+
+// StreamResumeOffset returns the offset a streamed actor method response is
+// resumed from.
+func StreamResumeOffset(req *internalv1pb.InternalInvokeRequest) int64 {
+	offset, _ := parseStreamOffset(req.GetMetadata()[StreamResumeOffsetMetadata])
+	return offset
+}
+
+func parseStreamOffset(v *internalv1pb.ListStringValue) (int64, bool) {
+	if len(v.GetValues()) == 0 {
+		return 0, false
+	}
+	offset, err := strconv.ParseInt(v.GetValues()[0], 10, 64)
+	if err != nil || offset < 0 {
+		return 0, false
+	}
+	return offset, true
+}
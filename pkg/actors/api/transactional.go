@@ -21,6 +21,7 @@ import (
 	"github.com/mitchellh/mapstructure"
 
 	"github.com/dapr/components-contrib/state"
+	stateutils "github.com/dapr/components-contrib/state/utils"
 	"github.com/dapr/dapr/pkg/config"
 )
 
@@ -143,6 +144,8 @@ func (t TransactionalUpsert) StateOperation(baseKey string, opts StateOperationO
 		if _, ok := t.Metadata["ttlInSeconds"]; ok {
 			return op, fmt.Errorf("ttlInSeconds is not supported without the %q feature enabled", config.ActorStateTTL)
 		}
+	} else if _, err = stateutils.ParseTTL64(t.Metadata); err != nil {
+		return op, err
 	}
 
 	return state.SetRequest{
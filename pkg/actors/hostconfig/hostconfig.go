@@ -28,6 +28,7 @@ type Config struct {
 	HostedActorTypes        []string
 	DefaultIdleTimeout      string
 	Reentrancy              config.ReentrancyConfig
+	LifecycleHooks          config.LifecycleHooksConfig
 }
 
 type HostConfig struct {
@@ -24,6 +24,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/dapr/dapr/pkg/actors/internal/placement/client/connector"
 	"github.com/dapr/dapr/pkg/actors/internal/placement/client/connector/dnslookup"
@@ -32,6 +33,8 @@ import (
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/healthz"
 	"github.com/dapr/dapr/pkg/modes"
+	"github.com/dapr/dapr/pkg/placement/delta"
+	"github.com/dapr/dapr/pkg/placement/topology"
 	v1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
 	"github.com/dapr/dapr/pkg/security"
 	"github.com/dapr/kit/concurrency"
@@ -49,6 +52,10 @@ type Options struct {
 	Healthz   healthz.Healthz
 	BaseHost  *v1pb.Host
 	Mode      modes.DaprMode
+	// Zone and Labels are reported to the placement service when the stream is
+	// opened.
+	Zone   string
+	Labels map[string]string
 }
 
 type Client struct {
@@ -60,7 +67,10 @@ type Client struct {
 	connector connector.Interface
 	client    v1pb.Placement_ReportDaprStatusClient
 	baseHost  *v1pb.Host
+	zone      string
+	labels    map[string]string
 	sendQueue chan []string
+	syncQueue chan struct{}
 	recvQueue chan *v1pb.PlacementOrder
 
 	ready      atomic.Bool
@@ -118,10 +128,13 @@ func New(opts Options) (*Client, error) {
 		lock:      opts.Lock,
 		connector: conn,
 		sendQueue: make(chan []string),
+		syncQueue: make(chan struct{}, 1),
 		recvQueue: make(chan *v1pb.PlacementOrder),
 		table:     opts.Table,
 		htarget:   opts.Healthz.AddTarget("placement-client"),
 		baseHost:  opts.BaseHost,
+		zone:      opts.Zone,
+		labels:    opts.Labels,
 	}, nil
 }
 
@@ -150,6 +163,12 @@ func (c *Client) Run(ctx context.Context) error {
 
 						c.htarget.Ready()
 						c.ready.Store(true)
+					case <-c.syncQueue:
+						host := proto.Clone(c.baseHost).(*v1pb.Host)
+						host.FullSync = true
+						if err := c.client.Send(host); err != nil {
+							return err
+						}
 					}
 				}
 			},
@@ -263,7 +282,7 @@ func (c *Client) connect(ctx context.Context) error {
 		return err
 	}
 
-	client, err := v1pb.NewPlacementClient(conn).ReportDaprStatus(ctx)
+	client, err := v1pb.NewPlacementClient(conn).ReportDaprStatus(delta.WithSupport(topology.WithLabels(topology.WithZone(ctx, c.zone), c.labels)))
 	if err != nil {
 		err = fmt.Errorf("failed to create placement client: %w", err)
 		return err
@@ -293,3 +312,12 @@ func (c *Client) Send(ctx context.Context, actorTypes []string) error {
 		return nil
 	}
 }
+
+// RequestFullSync requests the placement service to send the full tables. The
+// request is dropped if one is already pending.
+func (c *Client) RequestFullSync() {
+	select {
+	case c.syncQueue <- struct{}{}:
+	default:
+	}
+}
@@ -25,6 +25,7 @@ type Fake struct {
 	fnLock          func(context.Context) (context.Context, context.CancelFunc, error)
 	fnLookupActor   func(context.Context, *api.LookupActorRequest) (*api.LookupActorResponse, error)
 	fnIsActorHosted func(context.Context, string, string) bool
+	fnIsReadReplica func(context.Context, string, string) bool
 }
 
 func New() *Fake {
@@ -40,6 +41,9 @@ func New() *Fake {
 		fnIsActorHosted: func(ctx context.Context, actorType, actorID string) bool {
 			return false
 		},
+		fnIsReadReplica: func(ctx context.Context, actorType, actorID string) bool {
+			return false
+		},
 	}
 }
 
@@ -68,6 +72,11 @@ func (f *Fake) WithIsActorHosted(fn func(context.Context, string, string) bool)
 	return f
 }
 
+func (f *Fake) WithIsReadReplica(fn func(context.Context, string, string) bool) *Fake {
+	f.fnIsReadReplica = fn
+	return f
+}
+
 func (f *Fake) Run(ctx context.Context) error {
 	return f.fnRun(ctx)
 }
@@ -87,3 +96,7 @@ func (f *Fake) LookupActor(ctx context.Context, req *api.LookupActorRequest) (*a
 func (f *Fake) IsActorHosted(ctx context.Context, actorType, actorID string) bool {
 	return f.fnIsActorHosted(ctx, actorType, actorID)
 }
+
+func (f *Fake) IsReadReplica(ctx context.Context, actorType, actorID string) bool {
+	return f.fnIsReadReplica(ctx, actorType, actorID)
+}
@@ -16,6 +16,8 @@ package placement
 import (
 	"context"
 	"errors"
+	"maps"
+	"math/rand/v2"
 	"strconv"
 	"strings"
 	"sync"
@@ -30,6 +32,7 @@ import (
 	"github.com/dapr/dapr/pkg/healthz"
 	"github.com/dapr/dapr/pkg/messages"
 	"github.com/dapr/dapr/pkg/modes"
+	"github.com/dapr/dapr/pkg/placement/delta"
 	"github.com/dapr/dapr/pkg/placement/hashing"
 	v1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
 	schedclient "github.com/dapr/dapr/pkg/runtime/scheduler/client"
@@ -57,6 +60,7 @@ type Interface interface {
 	Lock(context.Context) (context.Context, context.CancelFunc, error)
 	LookupActor(ctx context.Context, req *api.LookupActorRequest) (*api.LookupActorResponse, error)
 	IsActorHosted(ctx context.Context, actorType, actorID string) bool
+	IsReadReplica(ctx context.Context, actorType, actorID string) bool
 }
 
 type Options struct {
@@ -72,6 +76,16 @@ type Options struct {
 	Table     table.Interface
 	Healthz   healthz.Healthz
 	Mode      modes.DaprMode
+
+	// ReadReplicas is the number of hosts serving read-only invocations of an
+	// actor, including the host of the actor. Read replicas are disabled when
+	// lower than 2.
+	ReadReplicas int
+
+	// Zone is the zone of the host reported to the placement service.
+	Zone string
+	// HostLabels are the labels of the host reported to the placement service.
+	HostLabels map[string]string
 }
 
 type placement struct {
@@ -91,15 +105,21 @@ type placement struct {
 
 	tableUnlock context.CancelFunc
 
+	// applied are the tables last received, which tables deltas are applied to.
+	applied map[string]*hashing.Consistent
+	// appliedVersion is the version of the applied tables.
+	appliedVersion string
+
 	reloadTypes atomic.Bool
 
-	appID     string
-	namespace string
-	hostname  string
-	port      string
-	readyCh   chan struct{}
-	wg        sync.WaitGroup
-	closedCh  chan struct{}
+	appID        string
+	namespace    string
+	hostname     string
+	port         string
+	readReplicas int
+	readyCh      chan struct{}
+	wg           sync.WaitGroup
+	closedCh     chan struct{}
 }
 
 func New(opts Options) (Interface, error) {
@@ -112,6 +132,8 @@ func New(opts Options) (Interface, error) {
 		Lock:      lock,
 		Healthz:   opts.Healthz,
 		Mode:      opts.Mode,
+		Zone:      opts.Zone,
+		Labels:    opts.HostLabels,
 		BaseHost: &v1pb.Host{
 			Name:      opts.Hostname + ":" + strconv.Itoa(opts.Port),
 			Id:        opts.AppID,
@@ -134,6 +156,7 @@ func New(opts Options) (Interface, error) {
 		port:          strconv.Itoa(opts.Port),
 		namespace:     opts.Namespace,
 		hostname:      opts.Hostname,
+		readReplicas:  opts.ReadReplicas,
 		operationLock: fifo.New(),
 		apiLevel:      opts.APILevel,
 		scheduler:     opts.Scheduler,
@@ -218,6 +241,10 @@ func (p *placement) LookupActor(ctx context.Context, req *api.LookupActorRequest
 		return nil, messages.ErrActorNoAddress
 	}
 
+	if req.ReadOnly && p.readReplicas > 1 {
+		return p.lookupReadReplica(table, req.ActorID)
+	}
+
 	host, err := table.GetHost(req.ActorID)
 	if err != nil {
 		return nil, err
@@ -230,6 +257,33 @@ func (p *placement) LookupActor(ctx context.Context, req *api.LookupActorRequest
 	}, nil
 }
 
+// lookupReadReplica returns the host serving a read-only invocation of the
+// actor. This host is preferred if it's a read replica of the actor,
+// otherwise the invocations are spread across the replicas.
+func (p *placement) lookupReadReplica(table *hashing.Consistent, actorID string) (*api.LookupActorResponse, error) {
+	replicas, err := table.GetReplicas(actorID, p.readReplicas)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, host := range replicas {
+		if p.isActorLocal(host.Name, p.hostname, p.port) {
+			return &api.LookupActorResponse{
+				Address: host.Name,
+				AppID:   host.AppID,
+				Local:   true,
+			}, nil
+		}
+	}
+
+	//nolint:gosec
+	host := replicas[rand.IntN(len(replicas))]
+	return &api.LookupActorResponse{
+		Address: host.Name,
+		AppID:   host.AppID,
+	}, nil
+}
+
 func (p *placement) Ready() bool {
 	return p.client.Ready()
 }
@@ -257,6 +311,8 @@ func (p *placement) handleReceive(ctx context.Context, in *v1pb.PlacementOrder)
 		p.handleLockOperation(ctx)
 	case updateOperation:
 		p.handleUpdateOperation(ctx, in.GetTables())
+	case delta.UpdateOperation:
+		p.handleDeltaOperation(ctx, in.GetTables())
 	case unlockOperation:
 		p.handleUnlockOperation(ctx)
 	}
@@ -304,24 +360,62 @@ func (p *placement) handleLockOperation(ctx context.Context) {
 }
 
 func (p *placement) handleUpdateOperation(ctx context.Context, in *v1pb.PlacementTables) {
-	p.apiLevel.Set(in.GetApiLevel())
-
 	entries := make(map[string]*hashing.Consistent)
+	for k, v := range in.GetEntries() {
+		entries[k] = p.newConsistent(v, in.GetReplicationFactor())
+	}
+
+	p.applyTables(ctx, in, entries)
+}
+
+// handleDeltaOperation updates the tables last received with the tables of the
+// actor types which changed since. The full tables are requested when the
+// delta isn't based on the tables last received.
+func (p *placement) handleDeltaOperation(ctx context.Context, in *v1pb.PlacementTables) {
+	if p.applied == nil || in.GetBaseVersion() != p.appliedVersion {
+		log.Warnf("Ignoring placement tables delta, version: %s, base version: %s: applied tables version is %s; requesting the full tables", in.GetVersion(), in.GetBaseVersion(), p.appliedVersion)
+		p.client.RequestFullSync()
+		return
+	}
 
+	entries := maps.Clone(p.applied)
 	for k, v := range in.GetEntries() {
-		loadMap := make(map[string]*hashing.Host, len(v.GetLoadMap()))
-		for lk, lv := range v.GetLoadMap() {
-			loadMap[lk] = hashing.NewHost(lv.GetName(), lv.GetId(), lv.GetLoad(), lv.GetPort())
+		if delta.IsRemoved(v) {
+			delete(entries, k)
+			continue
 		}
+		entries[k] = p.newConsistent(v, in.GetReplicationFactor())
+	}
 
-		entries[k] = hashing.NewFromExisting(loadMap, in.GetReplicationFactor(), p.virtualNodesCache)
+	p.applyTables(ctx, in, entries)
+}
+
+func (p *placement) newConsistent(table *v1pb.PlacementTable, replicationFactor int64) *hashing.Consistent {
+	loadMap := make(map[string]*hashing.Host, len(table.GetLoadMap()))
+	for lk, lv := range table.GetLoadMap() {
+		host := hashing.NewHost(lv.GetName(), lv.GetId(), lv.GetLoad(), lv.GetPort())
+		host.Weight = int64(lv.GetWeight())
+		loadMap[lk] = host
 	}
+	return hashing.NewFromExisting(loadMap, replicationFactor, p.virtualNodesCache)
+}
+
+func (p *placement) applyTables(ctx context.Context, in *v1pb.PlacementTables, entries map[string]*hashing.Consistent) {
+	p.apiLevel.Set(in.GetApiLevel())
+
+	// Locking the tables clears the entries, so the tables deltas are applied
+	// to are kept in a separate map.
+	p.applied = entries
+	p.appliedVersion = in.GetVersion()
 
 	clear(p.hashTable.Entries)
 	p.hashTable.Version = in.GetVersion()
-	p.hashTable.Entries = entries
+	p.hashTable.Entries = maps.Clone(entries)
 
-	if err := p.actorTable.HaltNonHosted(ctx); err != nil {
+	start := time.Now()
+	err := p.actorTable.HaltNonHosted(ctx)
+	diag.DefaultActorMonitoring.PlacementRebalanced(ctx, err == nil, diag.ElapsedSince(start))
+	if err != nil {
 		log.Errorf("Error draining non-hosted actors: %s", err)
 	}
 
@@ -341,6 +435,17 @@ func (p *placement) IsActorHosted(ctx context.Context, actorType, actorID string
 	return lar != nil && p.isActorLocal(lar.Address, p.hostname, p.port)
 }
 
+// IsReadReplica returns true if the actor is activated on this host to serve
+// read-only invocations, while it's placed on another host.
+// Placement _must_ be locked before calling this method.
+func (p *placement) IsReadReplica(ctx context.Context, actorType, actorID string) bool {
+	if p.readReplicas <= 1 || !p.actorTable.ActorExists(actorType, actorID) {
+		return false
+	}
+
+	return !p.IsActorHosted(ctx, actorType, actorID)
+}
+
 func (p *placement) handleUnlockOperation(ctx context.Context) {
 	if p.updateVersion.Add(1) != p.lockVersion.Load() {
 		return
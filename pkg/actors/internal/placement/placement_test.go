@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placement
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/actors/api"
+	"github.com/dapr/dapr/pkg/actors/table"
+	"github.com/dapr/dapr/pkg/placement/hashing"
+)
+
+func TestLookupActorReadOnly(t *testing.T) {
+	loadMap := make(map[string]*hashing.Host)
+	for i := range 5 {
+		name := "10.0.0." + strconv.Itoa(i) + ":50002"
+		loadMap[name] = hashing.NewHost(name, "app", 0, 50002)
+	}
+	table := hashing.NewFromExisting(loadMap, 100, hashing.NewVirtualNodesCache())
+
+	newPlacement := func(hostname string, readReplicas int) *placement {
+		return &placement{
+			hashTable: &hashing.ConsistentHashTables{
+				Entries: map[string]*hashing.Consistent{"type": table},
+			},
+			hostname:     hostname,
+			port:         "50002",
+			readReplicas: readReplicas,
+		}
+	}
+
+	replicas, err := table.GetReplicas("id", 3)
+	require.NoError(t, err)
+	primary := replicas[0].Name
+	hostname := func(host *hashing.Host) string {
+		return strings.TrimSuffix(host.Name, ":50002")
+	}
+
+	t.Run("writes are served by the host of the actor", func(t *testing.T) {
+		p := newPlacement(hostname(replicas[1]), 3)
+		lar, err := p.LookupActor(t.Context(), &api.LookupActorRequest{ActorType: "type", ActorID: "id"})
+		require.NoError(t, err)
+		assert.Equal(t, primary, lar.Address)
+		assert.False(t, lar.Local)
+	})
+
+	t.Run("reads are served locally by a read replica", func(t *testing.T) {
+		p := newPlacement(hostname(replicas[2]), 3)
+		lar, err := p.LookupActor(t.Context(), &api.LookupActorRequest{ActorType: "type", ActorID: "id", ReadOnly: true})
+		require.NoError(t, err)
+		assert.Equal(t, replicas[2].Name, lar.Address)
+		assert.True(t, lar.Local)
+	})
+
+	t.Run("reads are spread across the read replicas", func(t *testing.T) {
+		p := newPlacement("10.0.0.100", 3)
+		for range 20 {
+			lar, err := p.LookupActor(t.Context(), &api.LookupActorRequest{ActorType: "type", ActorID: "id", ReadOnly: true})
+			require.NoError(t, err)
+			assert.False(t, lar.Local)
+			assert.Contains(t, []string{replicas[0].Name, replicas[1].Name, replicas[2].Name}, lar.Address)
+		}
+	})
+
+	t.Run("reads are served by the host of the actor without read replicas", func(t *testing.T) {
+		p := newPlacement(hostname(replicas[2]), 0)
+		lar, err := p.LookupActor(t.Context(), &api.LookupActorRequest{ActorType: "type", ActorID: "id", ReadOnly: true})
+		require.NoError(t, err)
+		assert.Equal(t, primary, lar.Address)
+		assert.False(t, lar.Local)
+	})
+}
+
+// activeTable is an actor table where the actors are active, or not.
+type activeTable struct {
+	table.Interface
+	active bool
+}
+
+func (a *activeTable) ActorExists(string, string) bool {
+	return a.active
+}
+
+func TestIsReadReplica(t *testing.T) {
+	loadMap := make(map[string]*hashing.Host)
+	for i := range 5 {
+		name := "10.0.0." + strconv.Itoa(i) + ":50002"
+		loadMap[name] = hashing.NewHost(name, "app", 0, 50002)
+	}
+	hashTable := hashing.NewFromExisting(loadMap, 100, hashing.NewVirtualNodesCache())
+
+	replicas, err := hashTable.GetReplicas("id", 3)
+	require.NoError(t, err)
+
+	newPlacement := func(host *hashing.Host, readReplicas int, active bool) *placement {
+		return &placement{
+			hashTable: &hashing.ConsistentHashTables{
+				Entries: map[string]*hashing.Consistent{"type": hashTable},
+			},
+			actorTable:   &activeTable{active: active},
+			hostname:     strings.TrimSuffix(host.Name, ":50002"),
+			port:         "50002",
+			readReplicas: readReplicas,
+		}
+	}
+
+	t.Run("actor activated on a read replica", func(t *testing.T) {
+		assert.True(t, newPlacement(replicas[1], 3, true).IsReadReplica(t.Context(), "type", "id"))
+	})
+
+	t.Run("actor activated on its host", func(t *testing.T) {
+		assert.False(t, newPlacement(replicas[0], 3, true).IsReadReplica(t.Context(), "type", "id"))
+	})
+
+	t.Run("actor not activated", func(t *testing.T) {
+		assert.False(t, newPlacement(replicas[1], 3, false).IsReadReplica(t.Context(), "type", "id"))
+	})
+
+	t.Run("read replicas disabled", func(t *testing.T) {
+		assert.False(t, newPlacement(replicas[1], 0, true).IsReadReplica(t.Context(), "type", "id"))
+	})
+}
@@ -83,6 +83,9 @@ func (s *scheduler) Create(ctx context.Context, reminder *api.CreateReminderRequ
 	if err != nil {
 		return err
 	}
+	if len(reminder.Schedule) > 0 {
+		schedule, repeats = ptr.Of(reminder.Schedule), nil
+	}
 
 	overwrite := true
 	if reminder.Overwrite != nil {
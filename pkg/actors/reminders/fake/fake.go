@@ -17,7 +17,7 @@ import (
 	"context"
 
 	"github.com/dapr/dapr/pkg/actors/api"
-	"github.com/dapr/dapr/pkg/actors/internal/scheduler"
+	"github.com/dapr/dapr/pkg/actors/reminders"
 )
 
 type Fake struct {
@@ -26,7 +26,9 @@ type Fake struct {
 	deleteFn          func(ctx context.Context, req *api.DeleteReminderRequest) error
 	deleteByActorIDFn func(ctx context.Context, req *api.DeleteRemindersByActorIDRequest) error
 	listFn            func(ctx context.Context, req *api.ListRemindersRequest) ([]*api.Reminder, error)
-	schedulerFn       func() (scheduler.Interface, error)
+	queryFn           func(ctx context.Context, req *api.QueryRemindersRequest) ([]*api.Reminder, error)
+	deleteMatchingFn  func(ctx context.Context, req *api.QueryRemindersRequest) (int, error)
+	storeFn           func() (reminders.Store, error)
 }
 
 func New() *Fake {
@@ -46,7 +48,13 @@ func New() *Fake {
 		listFn: func(ctx context.Context, req *api.ListRemindersRequest) ([]*api.Reminder, error) {
 			return nil, nil
 		},
-		schedulerFn: func() (scheduler.Interface, error) {
+		queryFn: func(ctx context.Context, req *api.QueryRemindersRequest) ([]*api.Reminder, error) {
+			return nil, nil
+		},
+		deleteMatchingFn: func(ctx context.Context, req *api.QueryRemindersRequest) (int, error) {
+			return 0, nil
+		},
+		storeFn: func() (reminders.Store, error) {
 			return nil, nil
 		},
 	}
@@ -77,6 +85,16 @@ func (f *Fake) WithList(fn func(ctx context.Context, req *api.ListRemindersReque
 	return f
 }
 
+func (f *Fake) WithQuery(fn func(ctx context.Context, req *api.QueryRemindersRequest) ([]*api.Reminder, error)) *Fake {
+	f.queryFn = fn
+	return f
+}
+
+func (f *Fake) WithDeleteMatching(fn func(ctx context.Context, req *api.QueryRemindersRequest) (int, error)) *Fake {
+	f.deleteMatchingFn = fn
+	return f
+}
+
 func (f *Fake) Get(ctx context.Context, req *api.GetReminderRequest) (*api.Reminder, error) {
 	return f.getFn(ctx, req)
 }
@@ -97,6 +115,14 @@ func (f *Fake) List(ctx context.Context, req *api.ListRemindersRequest) ([]*api.
 	return f.listFn(ctx, req)
 }
 
-func (f *Fake) Scheduler() (scheduler.Interface, error) {
-	return f.schedulerFn()
+func (f *Fake) Query(ctx context.Context, req *api.QueryRemindersRequest) ([]*api.Reminder, error) {
+	return f.queryFn(ctx, req)
+}
+
+func (f *Fake) DeleteMatching(ctx context.Context, req *api.QueryRemindersRequest) (int, error) {
+	return f.deleteMatchingFn(ctx, req)
+}
+
+func (f *Fake) Store() (reminders.Store, error) {
+	return f.storeFn()
 }
@@ -16,16 +16,21 @@ package reminders
 import (
 	"context"
 	"errors"
+	"fmt"
+
+	"k8s.io/utils/clock"
 
 	"github.com/dapr/dapr/pkg/actors/api"
+	"github.com/dapr/dapr/pkg/actors/internal/placement"
 	"github.com/dapr/dapr/pkg/actors/internal/scheduler"
 	"github.com/dapr/dapr/pkg/actors/table"
 )
 
 // TODO: @joshvanl: move errors package
 var (
-	ErrReminderOpActorNotHosted = errors.New("operations on actor reminders are only possible on hosted actor types")
-	ErrReminderStorageNotSet    = errors.New("reminder scheduler is not configured")
+	ErrReminderOpActorNotHosted   = errors.New("operations on actor reminders are only possible on hosted actor types")
+	ErrReminderOpActorReadReplica = errors.New("reminders can't be created by a read replica of an actor")
+	ErrReminderStorageNotSet      = errors.New("reminder store is not configured")
 )
 
 type Interface interface {
@@ -44,30 +49,55 @@ type Interface interface {
 	// List lists all reminders for a given actor type and actor ID.
 	List(ctx context.Context, req *api.ListRemindersRequest) ([]*api.Reminder, error)
 
-	// Scheduler returns the underlying reminder scheduler.
+	// Query lists the reminders of an actor type matching a filter.
+	Query(ctx context.Context, req *api.QueryRemindersRequest) ([]*api.Reminder, error)
+
+	// DeleteMatching deletes the reminders of an actor type matching a filter,
+	// and returns the number of reminders deleted.
+	DeleteMatching(ctx context.Context, req *api.QueryRemindersRequest) (int, error)
+
+	// Store returns the underlying reminder store.
 	// Used to bypass the actor hosted check.
-	Scheduler() (scheduler.Interface, error)
+	Store() (Store, error)
 }
 
 type Options struct {
 	Scheduler scheduler.Interface
+	// Store overrides the Scheduler service as the storage of reminders.
+	Store     Store
 	Table     table.Interface
+	Placement placement.Interface
+	Clock     clock.Clock
 }
 
 type reminders struct {
-	scheduler scheduler.Interface
+	store     Store
 	table     table.Interface
+	placement placement.Interface
+	clock     clock.Clock
 }
 
 func New(opts Options) Interface {
-	return &reminders{
-		scheduler: opts.Scheduler,
+	if opts.Clock == nil {
+		opts.Clock = clock.RealClock{}
+	}
+
+	r := &reminders{
 		table:     opts.Table,
+		placement: opts.Placement,
+		clock:     opts.Clock,
+	}
+	switch {
+	case opts.Store != nil:
+		r.store = opts.Store
+	case opts.Scheduler != nil:
+		r.store = opts.Scheduler
 	}
+	return r
 }
 
 func (r *reminders) Get(ctx context.Context, req *api.GetReminderRequest) (*api.Reminder, error) {
-	if r.scheduler == nil {
+	if r.store == nil {
 		return nil, ErrReminderStorageNotSet
 	}
 
@@ -75,11 +105,11 @@ func (r *reminders) Get(ctx context.Context, req *api.GetReminderRequest) (*api.
 		return nil, ErrReminderOpActorNotHosted
 	}
 
-	return r.scheduler.Get(ctx, req)
+	return r.store.Get(ctx, req)
 }
 
 func (r *reminders) Create(ctx context.Context, req *api.CreateReminderRequest) error {
-	if r.scheduler == nil {
+	if r.store == nil {
 		return ErrReminderStorageNotSet
 	}
 
@@ -87,11 +117,19 @@ func (r *reminders) Create(ctx context.Context, req *api.CreateReminderRequest)
 		return ErrReminderOpActorNotHosted
 	}
 
-	return r.scheduler.Create(ctx, req)
+	readReplica, err := r.isReadReplica(ctx, req.ActorType, req.ActorID)
+	if err != nil {
+		return err
+	}
+	if readReplica {
+		return ErrReminderOpActorReadReplica
+	}
+
+	return r.store.Create(ctx, req)
 }
 
 func (r *reminders) Delete(ctx context.Context, req *api.DeleteReminderRequest) error {
-	if r.scheduler == nil {
+	if r.store == nil {
 		return ErrReminderStorageNotSet
 	}
 
@@ -99,11 +137,11 @@ func (r *reminders) Delete(ctx context.Context, req *api.DeleteReminderRequest)
 		return ErrReminderOpActorNotHosted
 	}
 
-	return r.scheduler.Delete(ctx, req)
+	return r.store.Delete(ctx, req)
 }
 
 func (r *reminders) DeleteByActorID(ctx context.Context, req *api.DeleteRemindersByActorIDRequest) error {
-	if r.scheduler == nil {
+	if r.store == nil {
 		return ErrReminderStorageNotSet
 	}
 
@@ -111,11 +149,11 @@ func (r *reminders) DeleteByActorID(ctx context.Context, req *api.DeleteReminder
 		return ErrReminderOpActorNotHosted
 	}
 
-	return r.scheduler.DeleteByActorID(ctx, req)
+	return r.store.DeleteByActorID(ctx, req)
 }
 
 func (r *reminders) List(ctx context.Context, req *api.ListRemindersRequest) ([]*api.Reminder, error) {
-	if r.scheduler == nil {
+	if r.store == nil {
 		return nil, ErrReminderStorageNotSet
 	}
 
@@ -123,13 +161,68 @@ func (r *reminders) List(ctx context.Context, req *api.ListRemindersRequest) ([]
 		return nil, ErrReminderOpActorNotHosted
 	}
 
-	return r.scheduler.List(ctx, req)
+	return r.store.List(ctx, req)
+}
+
+func (r *reminders) Query(ctx context.Context, req *api.QueryRemindersRequest) ([]*api.Reminder, error) {
+	list, err := r.List(ctx, &api.ListRemindersRequest{
+		ActorType: req.ActorType,
+		ActorID:   req.ActorID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := r.clock.Now()
+	matched := make([]*api.Reminder, 0, len(list))
+	for _, reminder := range list {
+		if reminder != nil && req.Matches(reminder, now) {
+			matched = append(matched, reminder)
+		}
+	}
+	return matched, nil
+}
+
+func (r *reminders) DeleteMatching(ctx context.Context, req *api.QueryRemindersRequest) (int, error) {
+	list, err := r.Query(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	var deleted int
+	for _, reminder := range list {
+		err = r.store.Delete(ctx, &api.DeleteReminderRequest{
+			Name:      reminder.Name,
+			ActorType: reminder.ActorType,
+			ActorID:   reminder.ActorID,
+		})
+		if err != nil {
+			return deleted, fmt.Errorf("failed to delete reminder %s of actor %s: %w", reminder.Name, reminder.ActorKey(), err)
+		}
+		deleted++
+	}
+	return deleted, nil
 }
 
-func (r *reminders) Scheduler() (scheduler.Interface, error) {
-	if r.scheduler == nil {
+func (r *reminders) Store() (Store, error) {
+	if r.store == nil {
 		return nil, ErrReminderStorageNotSet
 	}
 
-	return r.scheduler, nil
+	return r.store, nil
+}
+
+// isReadReplica returns true if the actor is activated on this host as a read
+// replica, which can't create reminders.
+func (r *reminders) isReadReplica(ctx context.Context, actorType, actorID string) (bool, error) {
+	if r.placement == nil {
+		return false, nil
+	}
+
+	ctx, cancel, err := r.placement.Lock(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer cancel()
+	return r.placement.IsReadReplica(ctx, actorType, actorID), nil
 }
@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reminders
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/dapr/pkg/actors/api"
+	placementfake "github.com/dapr/dapr/pkg/actors/internal/placement/fake"
+	"github.com/dapr/dapr/pkg/actors/internal/reentrancystore"
+	"github.com/dapr/dapr/pkg/actors/table"
+	"github.com/dapr/kit/ptr"
+)
+
+func TestQuery(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	newReminders := func() (Interface, *memStore) {
+		store := &memStore{reminders: map[string][]*api.Reminder{
+			"type1": {
+				{Name: "r1", ActorType: "type1", ActorID: "a", DueTime: "2026-01-01T12:30:00Z"},
+				{Name: "r2", ActorType: "type1", ActorID: "b", DueTime: "2026-01-01T14:00:00Z"},
+				{Name: "r3", ActorType: "type1", ActorID: "a", DueTime: "10s"},
+			},
+		}}
+		tbl := table.New(table.Options{ReentrancyStore: reentrancystore.New()})
+		tbl.RegisterActorTypes(table.RegisterActorTypeOptions{
+			Factories: []table.ActorTypeFactory{{Type: "type1"}},
+		})
+		return New(Options{
+			Store: store,
+			Table: tbl,
+			Clock: clocktesting.NewFakeClock(now),
+		}), store
+	}
+
+	names := func(list []*api.Reminder) []string {
+		n := make([]string, len(list))
+		for i, r := range list {
+			n[i] = r.Name
+		}
+		return n
+	}
+
+	t.Run("filter by actor ID", func(t *testing.T) {
+		r, _ := newReminders()
+		list, err := r.Query(t.Context(), &api.QueryRemindersRequest{ActorType: "type1", ActorID: ptr.Of("a")})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"r1", "r3"}, names(list))
+	})
+
+	t.Run("filter by next fire time", func(t *testing.T) {
+		r, _ := newReminders()
+		list, err := r.Query(t.Context(), &api.QueryRemindersRequest{
+			ActorType:      "type1",
+			NextFireBefore: ptr.Of(now.Add(time.Hour)),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"r1"}, names(list))
+
+		list, err = r.Query(t.Context(), &api.QueryRemindersRequest{
+			ActorType:     "type1",
+			NextFireAfter: ptr.Of(now.Add(time.Hour)),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"r2"}, names(list))
+	})
+
+	t.Run("delete matching", func(t *testing.T) {
+		r, store := newReminders()
+		deleted, err := r.DeleteMatching(t.Context(), &api.QueryRemindersRequest{ActorType: "type1", ActorID: ptr.Of("a")})
+		require.NoError(t, err)
+		assert.Equal(t, 2, deleted)
+		assert.Equal(t, []string{"r2"}, names(store.reminders["type1"]))
+	})
+
+	t.Run("actor type not hosted", func(t *testing.T) {
+		r, _ := newReminders()
+		_, err := r.Query(t.Context(), &api.QueryRemindersRequest{ActorType: "type2"})
+		require.ErrorIs(t, err, ErrReminderOpActorNotHosted)
+	})
+}
+
+func TestCreateReadReplica(t *testing.T) {
+	store := &memStore{}
+	tbl := table.New(table.Options{ReentrancyStore: reentrancystore.New()})
+	tbl.RegisterActorTypes(table.RegisterActorTypeOptions{
+		Factories: []table.ActorTypeFactory{{Type: "type1"}},
+	})
+	r := New(Options{
+		Store: store,
+		Table: tbl,
+		Placement: placementfake.New().WithIsReadReplica(func(_ context.Context, _, actorID string) bool {
+			return actorID == "replica"
+		}),
+	})
+
+	err := r.Create(t.Context(), &api.CreateReminderRequest{Name: "r1", ActorType: "type1", ActorID: "replica"})
+	require.ErrorIs(t, err, ErrReminderOpActorReadReplica)
+	assert.Empty(t, store.created)
+
+	require.NoError(t, r.Create(t.Context(), &api.CreateReminderRequest{Name: "r1", ActorType: "type1", ActorID: "host"}))
+	assert.Len(t, store.created, 1)
+}
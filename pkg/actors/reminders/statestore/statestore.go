@@ -0,0 +1,385 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statestore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	contribstate "github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/actors/api"
+	"github.com/dapr/dapr/pkg/actors/internal/placement"
+	"github.com/dapr/dapr/pkg/actors/table"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.runtime.actors.reminders.statestore")
+
+const (
+	defaultPollInterval = time.Second
+
+	// maxUpdateAttempts is the number of times an update of the reminders of an
+	// actor type is retried when they were changed concurrently by another host.
+	maxUpdateAttempts = 5
+)
+
+type Options struct {
+	AppID     string
+	StoreName string
+	CompStore *compstore.ComponentStore
+	Table     table.Interface
+	Placement placement.Interface
+
+	// Trigger is called with the due reminders of the actors hosted locally.
+	Trigger func(ctx context.Context, reminder *api.Reminder) error
+
+	Clock clock.WithTicker
+}
+
+// Store keeps the actor reminders in a state store, in a key per actor type,
+// and triggers the due reminders of the actors placed on this host. The
+// reminders are polled from the state store, so a reminder fires on the host
+// of its actor at the time, and keeps firing after the actor is rebalanced.
+type Store struct {
+	appID     string
+	storeName string
+	compStore *compstore.ComponentStore
+	table     table.Interface
+	placement placement.Interface
+	trigger   func(ctx context.Context, reminder *api.Reminder) error
+
+	pollInterval time.Duration
+	clock        clock.WithTicker
+
+	// lock serializes the updates made by this host, so only updates of other
+	// hosts need to be retried.
+	lock sync.Mutex
+}
+
+// entry is a reminder saved in the state store, with the track of its last
+// tick.
+type entry struct {
+	Reminder *api.Reminder      `json:"reminder"`
+	Track    *api.ReminderTrack `json:"track,omitempty"`
+}
+
+func New(opts Options) *Store {
+	if opts.Clock == nil {
+		opts.Clock = clock.RealClock{}
+	}
+
+	return &Store{
+		appID:        opts.AppID,
+		storeName:    opts.StoreName,
+		compStore:    opts.CompStore,
+		table:        opts.Table,
+		placement:    opts.Placement,
+		trigger:      opts.Trigger,
+		pollInterval: defaultPollInterval,
+		clock:        opts.Clock,
+	}
+}
+
+func (s *Store) Get(ctx context.Context, req *api.GetReminderRequest) (*api.Reminder, error) {
+	entries, _, err := s.load(ctx, req.ActorType)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range entries {
+		if e.Reminder.ActorID == req.ActorID && e.Reminder.Name == req.Name {
+			return e.reminder(), nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *Store) Create(ctx context.Context, req *api.CreateReminderRequest) error {
+	if len(req.Schedule) > 0 {
+		return errors.New("reminder schedules are only supported by the Scheduler service")
+	}
+
+	reminder, err := req.NewReminder(s.clock.Now(), true)
+	if err != nil {
+		return err
+	}
+
+	return s.update(ctx, req.ActorType, func(entries []*entry) ([]*entry, error) {
+		for i, e := range entries {
+			if e.Reminder.ActorID == req.ActorID && e.Reminder.Name == req.Name {
+				if req.Overwrite != nil && !*req.Overwrite {
+					return nil, fmt.Errorf("reminder %s already exists", req.Key())
+				}
+				entries[i] = &entry{Reminder: reminder}
+				return entries, nil
+			}
+		}
+		return append(entries, &entry{Reminder: reminder}), nil
+	})
+}
+
+func (s *Store) Delete(ctx context.Context, req *api.DeleteReminderRequest) error {
+	return s.update(ctx, req.ActorType, func(entries []*entry) ([]*entry, error) {
+		return removeEntries(entries, func(r *api.Reminder) bool {
+			return r.ActorID == req.ActorID && r.Name == req.Name
+		}), nil
+	})
+}
+
+func (s *Store) DeleteByActorID(ctx context.Context, req *api.DeleteRemindersByActorIDRequest) error {
+	return s.update(ctx, req.ActorType, func(entries []*entry) ([]*entry, error) {
+		return removeEntries(entries, func(r *api.Reminder) bool {
+			if req.MatchIDAsPrefix {
+				return strings.HasPrefix(r.ActorID, req.ActorID)
+			}
+			return r.ActorID == req.ActorID
+		}), nil
+	})
+}
+
+func (s *Store) List(ctx context.Context, req *api.ListRemindersRequest) ([]*api.Reminder, error) {
+	entries, _, err := s.load(ctx, req.ActorType)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*api.Reminder, 0, len(entries))
+	for _, e := range entries {
+		if req.ActorID != nil && e.Reminder.ActorID != *req.ActorID {
+			continue
+		}
+		list = append(list, e.reminder())
+	}
+
+	return list, nil
+}
+
+// Run triggers the due reminders of the hosted actor types until the context
+// is canceled.
+func (s *Store) Run(ctx context.Context) error {
+	ticker := s.clock.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C():
+		}
+
+		for _, actorType := range s.table.Types() {
+			if err := s.triggerDue(ctx, actorType); err != nil {
+				log.Errorf("Error triggering reminders of actor type %s: %s", actorType, err)
+			}
+		}
+	}
+}
+
+// triggerDue triggers the due reminders of the actors of a type which are
+// placed on this host, and saves their next tick.
+func (s *Store) triggerDue(ctx context.Context, actorType string) error {
+	entries, _, err := s.load(ctx, actorType)
+	if err != nil {
+		return err
+	}
+
+	now := s.clock.Now()
+	for _, e := range entries {
+		reminder := e.reminder()
+		next, active := reminder.NextTick()
+		if active && next.After(now) {
+			continue
+		}
+		hosted, err := s.isActorHosted(ctx, reminder.ActorType, reminder.ActorID)
+		if err != nil {
+			return err
+		}
+		if !hosted {
+			continue
+		}
+
+		done := !active
+		if active {
+			err = s.trigger(ctx, reminder)
+			diag.DefaultMonitoring.ActorReminderFired(reminder.ActorType, err == nil)
+			if err != nil {
+				// Failed and successful executions both tick the reminder forward, as
+				// they do for timers.
+				log.Errorf("Error executing reminder %s: %s", reminder.Key(), err)
+			}
+			done = reminder.TickExecuted()
+			if _, active = reminder.NextTick(); !active {
+				done = true
+			}
+		}
+
+		err = s.update(ctx, actorType, func(entries []*entry) ([]*entry, error) {
+			for i, current := range entries {
+				// The reminder may have been replaced or deleted while it was
+				// executing.
+				if !sameEntry(current, e) {
+					continue
+				}
+				if done {
+					return append(entries[:i], entries[i+1:]...), nil
+				}
+				entries[i] = &entry{
+					Reminder: e.Reminder,
+					Track: &api.ReminderTrack{
+						LastFiredTime:  next,
+						RepetitionLeft: reminder.RepeatsLeft(),
+					},
+				}
+				return entries, nil
+			}
+			return entries, nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to save the track of reminder %s: %w", reminder.Key(), err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Store) isActorHosted(ctx context.Context, actorType, actorID string) (bool, error) {
+	ctx, cancel, err := s.placement.Lock(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer cancel()
+	return s.placement.IsActorHosted(ctx, actorType, actorID), nil
+}
+
+// update changes the reminders of an actor type, retrying when they're
+// changed concurrently by another host.
+func (s *Store) update(ctx context.Context, actorType string, fn func([]*entry) ([]*entry, error)) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	store, err := s.stateStore()
+	if err != nil {
+		return err
+	}
+
+	key := s.key(actorType)
+	for attempt := 1; ; attempt++ {
+		entries, etag, err := s.load(ctx, actorType)
+		if err != nil {
+			return err
+		}
+
+		entries, err = fn(entries)
+		if err != nil {
+			return err
+		}
+
+		if len(entries) == 0 {
+			err = store.Delete(ctx, &contribstate.DeleteRequest{
+				Key:  key,
+				ETag: etag,
+			})
+		} else {
+			err = store.Set(ctx, &contribstate.SetRequest{
+				Key:   key,
+				Value: entries,
+				ETag:  etag,
+				Options: contribstate.SetStateOption{
+					Concurrency: contribstate.FirstWrite,
+				},
+			})
+		}
+
+		var etagErr *contribstate.ETagError
+		if err == nil || !errors.As(err, &etagErr) || etagErr.Kind() != contribstate.ETagMismatch || attempt == maxUpdateAttempts {
+			return err
+		}
+	}
+}
+
+func (s *Store) load(ctx context.Context, actorType string) ([]*entry, *string, error) {
+	store, err := s.stateStore()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res, err := store.Get(ctx, &contribstate.GetRequest{Key: s.key(actorType)})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get reminders of actor type %s: %w", actorType, err)
+	}
+	if res == nil || len(res.Data) == 0 {
+		return nil, nil, nil
+	}
+
+	var entries []*entry
+	if err = json.Unmarshal(res.Data, &entries); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode reminders of actor type %s: %w", actorType, err)
+	}
+
+	return entries, res.ETag, nil
+}
+
+func (s *Store) stateStore() (contribstate.Store, error) {
+	store, ok := s.compStore.GetStateStore(s.storeName)
+	if !ok {
+		return nil, fmt.Errorf("reminder state store %s not found", s.storeName)
+	}
+	return store, nil
+}
+
+// key returns the key of the reminders of an actor type. It has one part less
+// than the keys of actor state, so it can't collide with them when the actor
+// state store is used to keep reminders too.
+func (s *Store) key(actorType string) string {
+	return s.appID + api.DaprSeparator + actorType + api.DaprSeparator + "reminders"
+}
+
+// reminder returns the reminder of the entry, scheduled at its next tick.
+func (e *entry) reminder() *api.Reminder {
+	reminder := *e.Reminder
+	reminder.UpdateFromTrack(e.Track)
+	return &reminder
+}
+
+func sameEntry(a, b *entry) bool {
+	ja, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	jb, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	return string(ja) == string(jb)
+}
+
+func removeEntries(entries []*entry, match func(*api.Reminder) bool) []*entry {
+	kept := entries[:0]
+	for _, e := range entries {
+		if !match(e.Reminder) {
+			kept = append(kept, e)
+		}
+	}
+	return kept
+}
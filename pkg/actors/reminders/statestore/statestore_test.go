@@ -0,0 +1,204 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statestore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/dapr/pkg/actors/api"
+	"github.com/dapr/dapr/pkg/actors/internal/placement/fake"
+	"github.com/dapr/dapr/pkg/actors/internal/reentrancystore"
+	"github.com/dapr/dapr/pkg/actors/table"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+	"github.com/dapr/kit/ptr"
+)
+
+func newTestStore(t *testing.T, now time.Time) (*Store, *clocktesting.FakeClock, *[]string) {
+	t.Helper()
+
+	compStore := compstore.New()
+	compStore.AddStateStore("reminders", daprt.NewFakeStateStore())
+
+	tbl := table.New(table.Options{ReentrancyStore: reentrancystore.New()})
+	tbl.RegisterActorTypes(table.RegisterActorTypeOptions{
+		Factories: []table.ActorTypeFactory{{Type: "type1"}},
+	})
+
+	clock := clocktesting.NewFakeClock(now)
+	var triggered []string
+	return New(Options{
+		AppID:     "app",
+		StoreName: "reminders",
+		CompStore: compStore,
+		Table:     tbl,
+		Placement: fake.New().WithIsActorHosted(func(_ context.Context, _, actorID string) bool {
+			return actorID == "a"
+		}),
+		Trigger: func(_ context.Context, reminder *api.Reminder) error {
+			triggered = append(triggered, reminder.Key())
+			return nil
+		},
+		Clock: clock,
+	}), clock, &triggered
+}
+
+func TestStore(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	create := func(t *testing.T, s *Store, actorID, name string) {
+		t.Helper()
+		require.NoError(t, s.Create(t.Context(), &api.CreateReminderRequest{
+			Name:      name,
+			ActorType: "type1",
+			ActorID:   actorID,
+			DueTime:   "1m",
+			Period:    "10s",
+		}))
+	}
+
+	names := func(list []*api.Reminder) []string {
+		n := make([]string, len(list))
+		for i, r := range list {
+			n[i] = r.ActorID + "/" + r.Name
+		}
+		return n
+	}
+
+	t.Run("create, get and list", func(t *testing.T) {
+		s, _, _ := newTestStore(t, now)
+		create(t, s, "a", "r1")
+		create(t, s, "b", "r2")
+
+		reminder, err := s.Get(t.Context(), &api.GetReminderRequest{Name: "r1", ActorType: "type1", ActorID: "a"})
+		require.NoError(t, err)
+		require.NotNil(t, reminder)
+		assert.Equal(t, now.Add(time.Minute), reminder.RegisteredTime)
+		assert.Equal(t, "10s", reminder.Period.String())
+
+		reminder, err = s.Get(t.Context(), &api.GetReminderRequest{Name: "r1", ActorType: "type1", ActorID: "b"})
+		require.NoError(t, err)
+		assert.Nil(t, reminder)
+
+		list, err := s.List(t.Context(), &api.ListRemindersRequest{ActorType: "type1"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a/r1", "b/r2"}, names(list))
+
+		list, err = s.List(t.Context(), &api.ListRemindersRequest{ActorType: "type1", ActorID: ptr.Of("b")})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"b/r2"}, names(list))
+	})
+
+	t.Run("create overwrites an existing reminder unless disabled", func(t *testing.T) {
+		s, _, _ := newTestStore(t, now)
+		create(t, s, "a", "r1")
+
+		req := &api.CreateReminderRequest{
+			Name:      "r1",
+			ActorType: "type1",
+			ActorID:   "a",
+			DueTime:   "2m",
+			Overwrite: ptr.Of(false),
+		}
+		require.Error(t, s.Create(t.Context(), req))
+
+		req.Overwrite = nil
+		require.NoError(t, s.Create(t.Context(), req))
+		reminder, err := s.Get(t.Context(), &api.GetReminderRequest{Name: "r1", ActorType: "type1", ActorID: "a"})
+		require.NoError(t, err)
+		assert.Equal(t, now.Add(2*time.Minute), reminder.RegisteredTime)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		s, _, _ := newTestStore(t, now)
+		create(t, s, "a", "r1")
+		create(t, s, "a", "r2")
+		create(t, s, "ab", "r1")
+		create(t, s, "b", "r1")
+
+		require.NoError(t, s.Delete(t.Context(), &api.DeleteReminderRequest{Name: "r2", ActorType: "type1", ActorID: "a"}))
+		list, err := s.List(t.Context(), &api.ListRemindersRequest{ActorType: "type1"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a/r1", "ab/r1", "b/r1"}, names(list))
+
+		require.NoError(t, s.DeleteByActorID(t.Context(), &api.DeleteRemindersByActorIDRequest{ActorType: "type1", ActorID: "a"}))
+		list, err = s.List(t.Context(), &api.ListRemindersRequest{ActorType: "type1"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ab/r1", "b/r1"}, names(list))
+
+		require.NoError(t, s.DeleteByActorID(t.Context(), &api.DeleteRemindersByActorIDRequest{ActorType: "type1", ActorID: "a", MatchIDAsPrefix: true}))
+		list, err = s.List(t.Context(), &api.ListRemindersRequest{ActorType: "type1"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"b/r1"}, names(list))
+	})
+
+	t.Run("cron schedules are not supported", func(t *testing.T) {
+		s, _, _ := newTestStore(t, now)
+		require.Error(t, s.Create(t.Context(), &api.CreateReminderRequest{
+			Name:      "r1",
+			ActorType: "type1",
+			ActorID:   "a",
+			Schedule:  "@daily",
+		}))
+	})
+}
+
+func TestTriggerDue(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	s, clock, triggered := newTestStore(t, now)
+
+	for _, actorID := range []string{"a", "b"} {
+		require.NoError(t, s.Create(t.Context(), &api.CreateReminderRequest{
+			Name:      "r1",
+			ActorType: "type1",
+			ActorID:   actorID,
+			DueTime:   "10s",
+			Period:    "R2/PT10S",
+		}))
+	}
+
+	require.NoError(t, s.triggerDue(t.Context(), "type1"))
+	assert.Empty(t, *triggered)
+
+	clock.Step(10 * time.Second)
+	require.NoError(t, s.triggerDue(t.Context(), "type1"))
+	assert.Equal(t, []string{"type1||a||r1"}, *triggered)
+
+	reminder, err := s.Get(t.Context(), &api.GetReminderRequest{Name: "r1", ActorType: "type1", ActorID: "a"})
+	require.NoError(t, err)
+	require.NotNil(t, reminder)
+	assert.Equal(t, now.Add(20*time.Second), reminder.RegisteredTime)
+	assert.Equal(t, 1, reminder.RepeatsLeft())
+
+	// The reminder isn't triggered again before its next tick.
+	require.NoError(t, s.triggerDue(t.Context(), "type1"))
+	assert.Len(t, *triggered, 1)
+
+	clock.Step(10 * time.Second)
+	require.NoError(t, s.triggerDue(t.Context(), "type1"))
+	assert.Equal(t, []string{"type1||a||r1", "type1||a||r1"}, *triggered)
+
+	// The reminder is removed once it has no repeats left, and the reminder of
+	// the actor hosted elsewhere is kept.
+	list, err := s.List(t.Context(), &api.ListRemindersRequest{ActorType: "type1"})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, "b", list[0].ActorID)
+}
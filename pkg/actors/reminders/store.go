@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reminders
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dapr/dapr/pkg/actors/api"
+	"github.com/dapr/kit/ptr"
+)
+
+// Store is the storage backend of actor reminders, which triggers them when
+// they are due. The Scheduler service is the default store.
+type Store interface {
+	Get(ctx context.Context, req *api.GetReminderRequest) (*api.Reminder, error)
+	Create(ctx context.Context, req *api.CreateReminderRequest) error
+	Delete(ctx context.Context, req *api.DeleteReminderRequest) error
+	DeleteByActorID(ctx context.Context, req *api.DeleteRemindersByActorIDRequest) error
+	List(ctx context.Context, req *api.ListRemindersRequest) ([]*api.Reminder, error)
+}
+
+type MigrateOptions struct {
+	From Store
+	To   Store
+	// ActorTypes are the actor types whose reminders are migrated.
+	ActorTypes []string
+}
+
+// Migrate moves the reminders of the given actor types from a store to
+// another, and returns the number of reminders moved. Each reminder is
+// created in the destination store before it's deleted from the source store,
+// so a failed migration can be resumed by running it again.
+func Migrate(ctx context.Context, opts MigrateOptions) (int, error) {
+	var moved int
+	for _, actorType := range opts.ActorTypes {
+		list, err := opts.From.List(ctx, &api.ListRemindersRequest{ActorType: actorType})
+		if err != nil {
+			return moved, fmt.Errorf("failed to list reminders of actor type %s: %w", actorType, err)
+		}
+
+		for _, reminder := range list {
+			if reminder == nil {
+				continue
+			}
+
+			req, err := createRequestFromReminder(reminder)
+			if err != nil {
+				return moved, fmt.Errorf("failed to migrate reminder %s of actor %s: %w", reminder.Name, reminder.ActorKey(), err)
+			}
+			if err = opts.To.Create(ctx, req); err != nil {
+				return moved, fmt.Errorf("failed to create reminder %s of actor %s: %w", reminder.Name, reminder.ActorKey(), err)
+			}
+			err = opts.From.Delete(ctx, &api.DeleteReminderRequest{
+				Name:      reminder.Name,
+				ActorType: reminder.ActorType,
+				ActorID:   reminder.ActorID,
+			})
+			if err != nil {
+				return moved, fmt.Errorf("failed to delete migrated reminder %s of actor %s: %w", reminder.Name, reminder.ActorKey(), err)
+			}
+			moved++
+		}
+	}
+	return moved, nil
+}
+
+// createRequestFromReminder returns the request to create a reminder in
+// another store.
+func createRequestFromReminder(reminder *api.Reminder) (*api.CreateReminderRequest, error) {
+	period, err := periodFromSchedule(reminder.Period)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &api.CreateReminderRequest{
+		Name:      reminder.Name,
+		ActorType: reminder.ActorType,
+		ActorID:   reminder.ActorID,
+		Data:      reminder.Data,
+		DueTime:   reminder.DueTime,
+		Period:    period,
+		Overwrite: ptr.Of(true),
+	}
+	if !reminder.ExpirationTime.IsZero() {
+		req.TTL = reminder.ExpirationTime.UTC().Format(time.RFC3339)
+	}
+	return req, nil
+}
+
+// periodFromSchedule converts the "@every" schedules of the Scheduler service
+// back to reminder periods.
+func periodFromSchedule(period api.ReminderPeriod) (string, error) {
+	every, ok := strings.CutPrefix(period.String(), "@every ")
+	if !ok {
+		return period.String(), nil
+	}
+
+	d, err := time.ParseDuration(every)
+	if err != nil {
+		return "", fmt.Errorf("unsupported schedule %q: %w", period.String(), err)
+	}
+	if period.Repeats() <= 0 {
+		return d.String(), nil
+	}
+	if d%time.Second != 0 {
+		return "", fmt.Errorf("unsupported schedule %q with repeats: interval must be a whole number of seconds", period.String())
+	}
+	return fmt.Sprintf("R%d/PT%dS", period.Repeats(), int64(d/time.Second)), nil
+}
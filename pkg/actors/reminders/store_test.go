@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reminders
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/actors/api"
+)
+
+// memStore keeps the reminders in memory, by actor type.
+type memStore struct {
+	reminders map[string][]*api.Reminder
+	created   []*api.CreateReminderRequest
+	failOn    string
+}
+
+func (s *memStore) Get(ctx context.Context, req *api.GetReminderRequest) (*api.Reminder, error) {
+	return nil, nil
+}
+
+func (s *memStore) Create(ctx context.Context, req *api.CreateReminderRequest) error {
+	if req.Name == s.failOn {
+		return errors.New("create failed")
+	}
+	s.created = append(s.created, req)
+	return nil
+}
+
+func (s *memStore) Delete(ctx context.Context, req *api.DeleteReminderRequest) error {
+	list := s.reminders[req.ActorType]
+	for i, r := range list {
+		if r.Name == req.Name && r.ActorID == req.ActorID {
+			s.reminders[req.ActorType] = append(list[:i], list[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func (s *memStore) DeleteByActorID(ctx context.Context, req *api.DeleteRemindersByActorIDRequest) error {
+	return nil
+}
+
+func (s *memStore) List(ctx context.Context, req *api.ListRemindersRequest) ([]*api.Reminder, error) {
+	return append([]*api.Reminder(nil), s.reminders[req.ActorType]...), nil
+}
+
+func TestMigrate(t *testing.T) {
+	newSource := func() *memStore {
+		return &memStore{reminders: map[string][]*api.Reminder{
+			"type1": {
+				{Name: "r1", ActorType: "type1", ActorID: "a", DueTime: "10s", Period: api.NewSchedulerReminderPeriod("@every 1m0s", 3)},
+				{Name: "r2", ActorType: "type1", ActorID: "b", Period: api.NewSchedulerReminderPeriod("@every 1.5s", 0), ExpirationTime: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+			},
+			"type2": {
+				{Name: "r3", ActorType: "type2", ActorID: "c"},
+			},
+		}}
+	}
+
+	t.Run("moves the reminders of the given actor types", func(t *testing.T) {
+		from, to := newSource(), &memStore{}
+		moved, err := Migrate(t.Context(), MigrateOptions{From: from, To: to, ActorTypes: []string{"type1"}})
+		require.NoError(t, err)
+		assert.Equal(t, 2, moved)
+
+		require.Len(t, to.created, 2)
+		assert.Equal(t, "r1", to.created[0].Name)
+		assert.Equal(t, "10s", to.created[0].DueTime)
+		assert.Equal(t, "R3/PT60S", to.created[0].Period)
+		assert.True(t, *to.created[0].Overwrite)
+		assert.Equal(t, "1.5s", to.created[1].Period)
+		assert.Equal(t, "2026-01-01T00:00:00Z", to.created[1].TTL)
+
+		assert.Empty(t, from.reminders["type1"])
+		assert.Len(t, from.reminders["type2"], 1)
+	})
+
+	t.Run("stops at the first failure", func(t *testing.T) {
+		from, to := newSource(), &memStore{failOn: "r2"}
+		moved, err := Migrate(t.Context(), MigrateOptions{From: from, To: to, ActorTypes: []string{"type1", "type2"}})
+		require.Error(t, err)
+		assert.Equal(t, 1, moved)
+		require.Len(t, from.reminders["type1"], 1)
+		assert.Equal(t, "r2", from.reminders["type1"][0].Name)
+	})
+
+	t.Run("unsupported schedule", func(t *testing.T) {
+		from := &memStore{reminders: map[string][]*api.Reminder{
+			"type1": {{Name: "r1", ActorType: "type1", ActorID: "a", Period: api.NewSchedulerReminderPeriod("@every 1.5s", 2)}},
+		}}
+		_, err := Migrate(t.Context(), MigrateOptions{From: from, To: &memStore{}, ActorTypes: []string{"type1"}})
+		require.Error(t, err)
+	})
+}
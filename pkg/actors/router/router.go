@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/cenkalti/backoff/v4"
 	"google.golang.org/grpc"
@@ -54,6 +55,9 @@ type Options struct {
 	Reminders          reminders.Interface
 	GRPC               *manager.Manager
 	MaxRequestBodySize int
+	// SessionTTL is the time a session of an external caller is kept after its
+	// last invocation. Defaults to DefaultSessionTTL.
+	SessionTTL time.Duration
 }
 
 type router struct {
@@ -64,6 +68,7 @@ type router struct {
 	resiliency resiliency.Provider
 	reminders  reminders.Interface
 	grpc       *manager.Manager
+	sessions   *sessions
 
 	clock clock.Clock
 
@@ -71,6 +76,7 @@ type router struct {
 }
 
 func New(opts Options) Interface {
+	clock := clock.RealClock{}
 	return &router{
 		namespace:  opts.Namespace,
 		table:      opts.Table,
@@ -78,7 +84,8 @@ func New(opts Options) Interface {
 		resiliency: opts.Resiliency,
 		grpc:       opts.GRPC,
 		reminders:  opts.Reminders,
-		clock:      clock.RealClock{},
+		sessions:   newSessions(clock, opts.SessionTTL),
+		clock:      clock,
 		callOptions: []grpc.CallOption{
 			grpc.MaxCallRecvMsgSize(opts.MaxRequestBodySize),
 			grpc.MaxCallSendMsgSize(opts.MaxRequestBodySize),
@@ -128,9 +135,15 @@ func (r *router) CallStream(ctx context.Context,
 	req *internalv1pb.InternalInvokeRequest,
 	stream func(*internalv1pb.InternalInvokeResponse) (bool, error),
 ) error {
+	// The actor is looked up again on each attempt, so a stream interrupted
+	// because the actor moved to another host is resumed from that host, over
+	// the internal gRPC channel. The host resuming the stream invokes the actor
+	// method again, and discards the data which was already delivered, so
+	// streamed actor methods must be idempotent.
+	resumer := newStreamResumer(req, stream)
 	policyRunner := resiliency.NewRunner[struct{}](ctx, r.resiliency.BuiltInPolicy(resiliency.BuiltInActorNotFoundRetries))
 	_, err := policyRunner(func(ctx context.Context) (struct{}, error) {
-		serr := r.callStream(ctx, req, stream)
+		serr := r.callStream(ctx, resumer.request(), resumer.send)
 		// Suppress EOF errors as this simply means the stream is closing.
 		if errors.Is(serr, io.EOF) {
 			return struct{}{}, nil
@@ -208,14 +221,33 @@ func (r *router) callActor(ctx context.Context, req *internalv1pb.InternalInvoke
 		defer cancel()
 	}
 
-	lar, err := r.placement.LookupActor(ctx, &api.LookupActorRequest{
-		ActorType: req.GetActor().GetActorType(),
-		ActorID:   req.GetActor().GetActorId(),
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to lookup actor: %w", err)
+	// Invocations of an external caller's session skip the lookup of the actor
+	// while the session is pinned to a remote host.
+	var sessionKey string
+	var lar *api.LookupActorResponse
+	if token, ok := api.SessionToken(req); ok && isAPICall {
+		var reentrancyID string
+		sessionKey = sessionKeyOf(token, req)
+		lar, reentrancyID = r.sessions.get(sessionKey)
+		withSessionReentrancy(req, reentrancyID)
 	}
 
+	if lar == nil {
+		var err error
+		lar, err = r.placement.LookupActor(ctx, &api.LookupActorRequest{
+			ActorType: req.GetActor().GetActorType(),
+			ActorID:   req.GetActor().GetActorId(),
+			ReadOnly:  api.IsReadOnlyRequest(req),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to lookup actor: %w", err)
+		}
+		if sessionKey != "" && !lar.Local {
+			r.sessions.pin(sessionKey, lar)
+		}
+	}
+
+	var err error
 	if lar.Local {
 		for {
 			var resp *internalv1pb.InternalInvokeResponse
@@ -241,6 +273,12 @@ func (r *router) callActor(ctx context.Context, req *internalv1pb.InternalInvoke
 		return res, nil
 	}
 
+	// Fail the session over to the current host of the actor on the next
+	// attempt, unless the error is returned by the actor itself.
+	if sessionKey != "" && !actorerrors.Is(err) {
+		r.sessions.unpin(sessionKey)
+	}
+
 	attempt := resiliency.GetAttempt(ctx)
 	s, ok := status.FromError(err)
 	if ok {
@@ -328,6 +366,7 @@ func (r *router) callStream(ctx context.Context,
 	lar, err := r.placement.LookupActor(ctx, &api.LookupActorRequest{
 		ActorType: req.GetActor().GetActorType(),
 		ActorID:   req.GetActor().GetActorId(),
+		ReadOnly:  api.IsReadOnlyRequest(req),
 	})
 	if err != nil {
 		return err
@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package router
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/dapr/pkg/actors/api"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+)
+
+// DefaultSessionTTL is the time a session is kept after its last invocation.
+const DefaultSessionTTL = 5 * time.Minute
+
+// session is the remote host an actor is pinned to for the invocations of a
+// session, and the reentrancy ID shared by these invocations.
+type session struct {
+	lar          *api.LookupActorResponse
+	reentrancyID string
+	expiresAt    time.Time
+}
+
+// sessions caches the sessions of external callers, by session token and
+// actor. A session expires when it isn't used for the TTL, and is dropped
+// when an invocation on its host fails so that the next invocation looks the
+// actor up again. Only remote hosts are pinned, as the remote host rejects
+// invocations of actors it no longer hosts.
+type sessions struct {
+	ttl   time.Duration
+	clock clock.Clock
+
+	lock      sync.Mutex
+	sessions  map[string]*session
+	nextSweep time.Time
+}
+
+func newSessions(clock clock.Clock, ttl time.Duration) *sessions {
+	if ttl <= 0 {
+		ttl = DefaultSessionTTL
+	}
+	return &sessions{
+		ttl:      ttl,
+		clock:    clock,
+		sessions: make(map[string]*session),
+	}
+}
+
+func sessionKeyOf(token string, req *internalv1pb.InternalInvokeRequest) string {
+	return token + "||" + req.GetActor().GetActorType() + "||" + req.GetActor().GetActorId()
+}
+
+// get returns the host the session is pinned to, if any, and the reentrancy
+// ID of the session. A session which doesn't exist or expired is started.
+// The expiry of the session is extended.
+func (s *sessions) get(key string) (*api.LookupActorResponse, string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := s.clock.Now()
+	sess, ok := s.sessions[key]
+	if !ok || !now.Before(sess.expiresAt) {
+		s.sweep(now)
+		sess = &session{reentrancyID: uuid.New().String()}
+		s.sessions[key] = sess
+	}
+	sess.expiresAt = now.Add(s.ttl)
+	return sess.lar, sess.reentrancyID
+}
+
+// pin pins the session to the given host.
+func (s *sessions) pin(key string, lar *api.LookupActorResponse) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if sess, ok := s.sessions[key]; ok {
+		sess.lar = lar
+	}
+}
+
+// unpin drops the host of the session, so that the next invocation looks the
+// actor up again. The reentrancy ID of the session is kept.
+func (s *sessions) unpin(key string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if sess, ok := s.sessions[key]; ok {
+		sess.lar = nil
+	}
+}
+
+// sweep deletes the expired sessions, at most once per TTL.
+func (s *sessions) sweep(now time.Time) {
+	if now.Before(s.nextSweep) {
+		return
+	}
+	s.nextSweep = now.Add(s.ttl)
+	for key, sess := range s.sessions {
+		if !now.Before(sess.expiresAt) {
+			delete(s.sessions, key)
+		}
+	}
+}
+
+// withSessionReentrancy adds the reentrancy ID of the session to the request,
+// unless the request is already part of a reentrant call chain.
+func withSessionReentrancy(req *internalv1pb.InternalInvokeRequest, id string) {
+	if id == "" {
+		return
+	}
+	if _, ok := req.GetMetadata()[api.ReentrancyIDMetadata]; ok {
+		return
+	}
+	if req.Metadata == nil {
+		req.Metadata = make(map[string]*internalv1pb.ListStringValue, 1)
+	}
+	req.Metadata[api.ReentrancyIDMetadata] = &internalv1pb.ListStringValue{
+		Values: []string{id},
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package router
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/dapr/pkg/actors/api"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+)
+
+func TestSessions(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Now())
+	s := newSessions(clock, time.Minute)
+
+	req := internalv1pb.NewInternalInvokeRequest("method").
+		WithActor("type", "id").
+		WithMetadata(map[string][]string{"Dapr-Actor-Session": {"token"}})
+	token, ok := api.SessionToken(req)
+	assert.True(t, ok)
+	assert.Equal(t, "token", token)
+	key := sessionKeyOf(token, req)
+
+	lar, id := s.get(key)
+	assert.Nil(t, lar)
+	assert.NotEmpty(t, id)
+
+	host := &api.LookupActorResponse{Address: "1.2.3.4:50002", AppID: "app"}
+	s.pin(key, host)
+
+	t.Run("pinned session keeps its host and reentrancy ID", func(t *testing.T) {
+		clock.Step(30 * time.Second)
+		got, gotID := s.get(key)
+		assert.Same(t, host, got)
+		assert.Equal(t, id, gotID)
+	})
+
+	t.Run("failed over session keeps its reentrancy ID", func(t *testing.T) {
+		s.unpin(key)
+		got, gotID := s.get(key)
+		assert.Nil(t, got)
+		assert.Equal(t, id, gotID)
+		s.pin(key, host)
+	})
+
+	t.Run("sessions are kept per actor", func(t *testing.T) {
+		other := sessionKeyOf(token, internalv1pb.NewInternalInvokeRequest("method").WithActor("type", "id2"))
+		got, gotID := s.get(other)
+		assert.Nil(t, got)
+		assert.NotEqual(t, id, gotID)
+	})
+
+	t.Run("session expires", func(t *testing.T) {
+		clock.Step(time.Minute)
+		got, gotID := s.get(key)
+		assert.Nil(t, got)
+		assert.NotEqual(t, id, gotID)
+		assert.Len(t, s.sessions, 1)
+	})
+}
+
+func TestWithSessionReentrancy(t *testing.T) {
+	req := internalv1pb.NewInternalInvokeRequest("method").WithActor("type", "id")
+	withSessionReentrancy(req, "session")
+	assert.Equal(t, []string{"session"}, req.GetMetadata()[api.ReentrancyIDMetadata].GetValues())
+
+	// A request which is already part of a reentrant call chain keeps it.
+	withSessionReentrancy(req, "other")
+	assert.Equal(t, []string{"session"}, req.GetMetadata()[api.ReentrancyIDMetadata].GetValues())
+
+	_, ok := api.SessionToken(req)
+	assert.False(t, ok)
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package router
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cenkalti/backoff/v4"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dapr/dapr/pkg/actors/api"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+)
+
+// streamResumer tracks the offset of the chunks of a streamed actor method
+// response delivered to the caller, so that a retried stream resumes from
+// that offset and chunks are never delivered twice. Responses which aren't
+// chunks are delivered as they are.
+type streamResumer struct {
+	req     *internalv1pb.InternalInvokeRequest
+	fn      func(*internalv1pb.InternalInvokeResponse) (bool, error)
+	offset  int64
+	started bool
+}
+
+func newStreamResumer(req *internalv1pb.InternalInvokeRequest,
+	fn func(*internalv1pb.InternalInvokeResponse) (bool, error),
+) *streamResumer {
+	return &streamResumer{
+		req:    req,
+		fn:     fn,
+		offset: api.StreamResumeOffset(req),
+	}
+}
+
+// request returns the request of the next attempt, which resumes the stream
+// from the offset delivered so far.
+func (s *streamResumer) request() *internalv1pb.InternalInvokeRequest {
+	if s.offset == api.StreamResumeOffset(s.req) {
+		return s.req
+	}
+
+	req := proto.Clone(s.req).(*internalv1pb.InternalInvokeRequest)
+	if req.Metadata == nil {
+		req.Metadata = make(map[string]*internalv1pb.ListStringValue, 1)
+	}
+	req.Metadata[api.StreamResumeOffsetMetadata] = &internalv1pb.ListStringValue{
+		Values: []string{strconv.FormatInt(s.offset, 10)},
+	}
+	return req
+}
+
+func (s *streamResumer) send(res *internalv1pb.InternalInvokeResponse) (bool, error) {
+	offset, ok := api.StreamChunkOffset(res)
+	if !ok {
+		return s.fn(res)
+	}
+
+	data := res.GetMessage().GetData().GetValue()
+	end := offset + int64(len(data))
+	switch {
+	case offset > s.offset:
+		return false, backoff.Permanent(fmt.Errorf("actor stream resumed at offset %d instead of %d", offset, s.offset))
+	case s.started && end <= s.offset:
+		// Already delivered.
+		return false, nil
+	case offset < s.offset:
+		res = proto.Clone(res).(*internalv1pb.InternalInvokeResponse)
+		res.GetMessage().GetData().Value = data[s.offset-offset:]
+		res.GetHeaders()[api.StreamOffsetHeader] = &internalv1pb.ListStringValue{
+			Values: []string{strconv.FormatInt(s.offset, 10)},
+		}
+	}
+
+	s.started = true
+	s.offset = end
+	return s.fn(res)
+}
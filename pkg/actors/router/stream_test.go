@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package router
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/dapr/dapr/pkg/actors/api"
+	placementfake "github.com/dapr/dapr/pkg/actors/internal/placement/fake"
+	"github.com/dapr/dapr/pkg/actors/internal/reentrancystore"
+	"github.com/dapr/dapr/pkg/actors/table"
+	"github.com/dapr/dapr/pkg/actors/targets"
+	targetfake "github.com/dapr/dapr/pkg/actors/targets/fake"
+	"github.com/dapr/dapr/pkg/api/grpc/manager"
+	"github.com/dapr/dapr/pkg/modes"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	securityfake "github.com/dapr/dapr/pkg/security/fake"
+	"github.com/dapr/kit/logger"
+)
+
+func chunk(offset int64, data string) *internalv1pb.InternalInvokeResponse {
+	return &internalv1pb.InternalInvokeResponse{
+		Headers: map[string]*internalv1pb.ListStringValue{
+			api.StreamOffsetHeader: {Values: []string{strconv.FormatInt(offset, 10)}},
+		},
+		Message: &commonv1pb.InvokeResponse{Data: &anypb.Any{Value: []byte(data)}},
+	}
+}
+
+func TestStreamResumer(t *testing.T) {
+	newResumer := func(req *internalv1pb.InternalInvokeRequest) (*streamResumer, *string) {
+		var got string
+		return newStreamResumer(req, func(res *internalv1pb.InternalInvokeResponse) (bool, error) {
+			got += string(res.GetMessage().GetData().GetValue())
+			return false, nil
+		}), &got
+	}
+
+	t.Run("resumes an interrupted stream", func(t *testing.T) {
+		req := internalv1pb.NewInternalInvokeRequest("method").WithActor("type", "id")
+		s, got := newResumer(req)
+		assert.Same(t, req, s.request())
+
+		_, err := s.send(chunk(0, "abc"))
+		require.NoError(t, err)
+		_, err = s.send(chunk(3, "def"))
+		require.NoError(t, err)
+
+		// The stream is interrupted, and the next attempt resumes from the
+		// delivered offset.
+		resumed := s.request()
+		assert.Equal(t, int64(6), api.StreamResumeOffset(resumed))
+		assert.Equal(t, int64(0), api.StreamResumeOffset(req))
+
+		// Chunks which were already delivered are skipped or trimmed.
+		_, err = s.send(chunk(0, "abc"))
+		require.NoError(t, err)
+		_, err = s.send(chunk(3, "defgh"))
+		require.NoError(t, err)
+		_, err = s.send(chunk(8, "ij"))
+		require.NoError(t, err)
+		assert.Equal(t, "abcdefghij", *got)
+	})
+
+	t.Run("resumes from the offset of the request", func(t *testing.T) {
+		req := internalv1pb.NewInternalInvokeRequest("method").
+			WithActor("type", "id").
+			WithMetadata(map[string][]string{api.StreamResumeOffsetMetadata: {"3"}})
+		s, got := newResumer(req)
+		assert.Same(t, req, s.request())
+
+		_, err := s.send(chunk(3, "def"))
+		require.NoError(t, err)
+		assert.Equal(t, "def", *got)
+	})
+
+	t.Run("missing data is an error", func(t *testing.T) {
+		s, _ := newResumer(internalv1pb.NewInternalInvokeRequest("method"))
+		_, err := s.send(chunk(3, "def"))
+		require.Error(t, err)
+	})
+
+	t.Run("responses which aren't chunks are delivered as they are", func(t *testing.T) {
+		s, got := newResumer(internalv1pb.NewInternalInvokeRequest("method"))
+		res := chunk(0, "abc")
+		res.Headers = nil
+		_, err := s.send(res)
+		require.NoError(t, err)
+		_, err = s.send(res)
+		require.NoError(t, err)
+		assert.Equal(t, "abcabc", *got)
+	})
+}
+
+// streamHost is a remote host of an actor, which streams the response of the
+// actor method from the offset it's resumed from.
+type streamHost struct {
+	internalv1pb.UnimplementedServiceInvocationServer
+
+	data    string
+	resumed atomic.Int64
+}
+
+func (h *streamHost) CallActorStream(req *internalv1pb.InternalInvokeRequest, stream internalv1pb.ServiceInvocation_CallActorStreamServer) error {
+	offset := api.StreamResumeOffset(req)
+	h.resumed.Store(offset)
+	return stream.Send(chunk(offset, h.data[offset:]))
+}
+
+func TestCallStreamResumesOnNewHost(t *testing.T) {
+	host := &streamHost{data: "abcdefghi"}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer()
+	internalv1pb.RegisterServiceInvocationServer(srv, host)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	// The actor is first hosted locally, where the stream is interrupted
+	// after the first chunks because the actor moves to the remote host.
+	var localCalls atomic.Int32
+	local := targetfake.New("type").(*targetfake.Fake).
+		WithInvokeStream(func(_ context.Context, _ *internalv1pb.InternalInvokeRequest, stream func(*internalv1pb.InternalInvokeResponse) (bool, error)) error {
+			localCalls.Add(1)
+			if _, err := stream(chunk(0, "abc")); err != nil {
+				return err
+			}
+			if _, err := stream(chunk(3, "def")); err != nil {
+				return err
+			}
+			return errors.New("actor moved")
+		})
+	tbl := table.New(table.Options{ReentrancyStore: reentrancystore.New()})
+	tbl.RegisterActorTypes(table.RegisterActorTypeOptions{
+		Factories: []table.ActorTypeFactory{{
+			Type: "type",
+			Factory: targetfake.NewFactory().WithGetOrCreate(func(string) targets.Interface {
+				return local
+			}),
+		}},
+	})
+
+	var moved atomic.Bool
+	placement := placementfake.New().WithLookupActor(func(context.Context, *api.LookupActorRequest) (*api.LookupActorResponse, error) {
+		if moved.Swap(true) {
+			return &api.LookupActorResponse{Address: lis.Addr().String(), AppID: "other"}, nil
+		}
+		return &api.LookupActorResponse{Local: true}, nil
+	})
+
+	r := New(Options{
+		Table:      tbl,
+		Placement:  placement,
+		Resiliency: resiliency.FromConfigurations(logger.NewLogger("test")),
+		GRPC:       manager.NewManager(securityfake.New(), modes.StandaloneMode, &manager.AppChannelConfig{}),
+	})
+
+	var got string
+	err = r.CallStream(t.Context(), internalv1pb.NewInternalInvokeRequest("method").WithActor("type", "id"),
+		func(res *internalv1pb.InternalInvokeResponse) (bool, error) {
+			got += string(res.GetMessage().GetData().GetValue())
+			return false, nil
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "abcdefghi", got)
+	assert.Equal(t, int32(1), localCalls.Load())
+	assert.Equal(t, int64(6), host.resumed.Load())
+}
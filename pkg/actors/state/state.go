@@ -18,6 +18,9 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
+
+	"k8s.io/utils/clock"
 
 	contribstate "github.com/dapr/components-contrib/state"
 	"github.com/dapr/dapr/pkg/actors/api"
@@ -27,6 +30,7 @@ import (
 	"github.com/dapr/dapr/pkg/messages"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/kit/logger"
 )
 
 const (
@@ -36,6 +40,8 @@ const (
 	errStateStoreNotConfigured = `actors: state store does not exist or incorrectly configured. Have you set the property '{"name": "actorStateStore", "value": "true"}' in your state store component file?`
 )
 
+var log = logger.NewLogger("dapr.runtime.actors.state")
+
 var ErrTransactionsTooManyOperations = errors.New("the transaction contains more operations than supported by the state store")
 
 type Interface interface {
@@ -61,6 +67,7 @@ type Options struct {
 	Resiliency resiliency.Provider
 	Table      table.Interface
 	Placement  placement.Interface
+	Clock      clock.Clock
 
 	// TODO: @joshvanl Remove in Dapr 1.12 when ActorStateTTL is finalized.
 	StateTTLEnabled bool
@@ -73,12 +80,16 @@ type state struct {
 	resiliency resiliency.Provider
 	table      table.Interface
 	placement  placement.Interface
+	clock      clock.Clock
 
 	// TODO: @joshvanl Remove in Dapr 1.12 when ActorStateTTL is finalized.
 	stateTTLEnabled bool
 }
 
 func New(opts Options) Interface {
+	if opts.Clock == nil {
+		opts.Clock = clock.RealClock{}
+	}
 	return &state{
 		appID:           opts.AppID,
 		storeName:       opts.StoreName,
@@ -86,6 +97,7 @@ func New(opts Options) Interface {
 		resiliency:      opts.Resiliency,
 		table:           opts.Table,
 		placement:       opts.Placement,
+		clock:           opts.Clock,
 		stateTTLEnabled: opts.StateTTLEnabled,
 	}
 }
@@ -131,6 +143,21 @@ func (s *state) Get(ctx context.Context, req *api.GetStateRequest, lock bool) (*
 		return &api.StateResponse{}, nil
 	}
 
+	if !nativeTTL(store) {
+		data, expireTime := unwrapTTL(resp.Data)
+		if expireTime != nil {
+			if !s.clock.Now().Before(*expireTime) {
+				s.purgeExpired(ctx, store, key, resp.ETag, metadata)
+				return &api.StateResponse{}, nil
+			}
+			resp.Data = data
+			if resp.Metadata == nil {
+				resp.Metadata = make(map[string]string, 1)
+			}
+			resp.Metadata[contribstate.GetRespMetaKeyTTLExpireTime] = expireTime.Format(time.RFC3339)
+		}
+	}
+
 	return &api.StateResponse{
 		Data:     resp.Data,
 		Metadata: resp.Metadata,
@@ -178,12 +205,23 @@ func (s *state) GetBulk(ctx context.Context, req *api.GetBulkStateRequest, lock
 	// Add the dapr separator to baseKey
 	baseKey += api.DaprSeparator
 
+	ttl := !nativeTTL(store)
+	now := s.clock.Now()
 	bulkRes := make(api.BulkStateResponse, len(res))
 	for _, r := range res {
 		if r.Error != "" {
 			return nil, fmt.Errorf("failed to retrieve key '%s': %s", r.Key, r.Error)
 		}
 
+		if ttl {
+			data, expireTime := unwrapTTL(r.Data)
+			if expireTime != nil && !now.Before(*expireTime) {
+				s.purgeExpired(ctx, store, r.Key, r.ETag, metadata)
+				data = nil
+			}
+			r.Data = data
+		}
+
 		// Trim the prefix from the key
 		bulkRes[strings.TrimPrefix(r.Key, baseKey)] = r.Data
 	}
@@ -202,8 +240,13 @@ func (s *state) TransactionalStateOperation(ctx context.Context, ignoreHosted bo
 		defer cancel()
 	}
 
-	if !ignoreHosted && !s.table.ActorExists(req.ActorType, req.ActorID) {
-		return messages.ErrActorInstanceMissing
+	if !ignoreHosted {
+		if !s.table.ActorExists(req.ActorType, req.ActorID) {
+			return messages.ErrActorInstanceMissing
+		}
+		if s.placement.IsReadReplica(ctx, req.ActorType, req.ActorID) {
+			return messages.ErrActorReadReplica.WithFormat(req.ActorKey())
+		}
 	}
 
 	operations := make([]contribstate.TransactionalStateOperation, len(req.Operations))
@@ -237,6 +280,14 @@ func (s *state) executeStateStoreTransaction(ctx context.Context, operations []c
 			return ErrTransactionsTooManyOperations
 		}
 	}
+
+	// Values with a TTL are purged lazily by stores without native TTLs.
+	if !nativeTTL(store) {
+		if err = applyTTLs(operations, s.clock.Now()); err != nil {
+			return err
+		}
+	}
+
 	stateReq := &contribstate.TransactionalStateRequest{
 		Operations: operations,
 		Metadata:   metadata,
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"maps"
+	"time"
+
+	contribstate "github.com/dapr/components-contrib/state"
+	stateutils "github.com/dapr/components-contrib/state/utils"
+)
+
+// ttlEnvelopePrefix is the prefix of the values saved with a TTL in state
+// stores which don't support TTLs natively.
+var ttlEnvelopePrefix = []byte(`{"daprActorStateExpireTime":`)
+
+// ttlEnvelope wraps the values saved with a TTL in state stores which don't
+// support TTLs natively, so that they are purged lazily when read after they
+// expired.
+type ttlEnvelope struct {
+	ExpireTime time.Time `json:"daprActorStateExpireTime"`
+	Data       []byte    `json:"data"`
+}
+
+// applyTTLs wraps the values of the upserts with a TTL in an envelope holding
+// their expiration time, for stores without native TTL support.
+func applyTTLs(operations []contribstate.TransactionalStateOperation, now time.Time) error {
+	for i, op := range operations {
+		req, ok := op.(contribstate.SetRequest)
+		if !ok {
+			continue
+		}
+		ttl, err := stateutils.ParseTTL64(req.Metadata)
+		if err != nil {
+			return err
+		}
+		if ttl == nil {
+			continue
+		}
+
+		// The metadata is shared by the operations of the transaction.
+		req.Metadata = maps.Clone(req.Metadata)
+		delete(req.Metadata, stateutils.MetadataTTLKey)
+
+		// A TTL of -1 means the value never expires.
+		if *ttl >= 0 {
+			data, err := stateutils.Marshal(req.Value, json.Marshal)
+			if err != nil {
+				return err
+			}
+			req.Value, err = json.Marshal(ttlEnvelope{
+				ExpireTime: now.Add(time.Duration(*ttl) * time.Second).UTC(),
+				Data:       data,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		operations[i] = req
+	}
+	return nil
+}
+
+// unwrapTTL returns the value wrapped in a TTL envelope and its expiration
+// time, or the data itself if it isn't wrapped.
+func unwrapTTL(data []byte) ([]byte, *time.Time) {
+	if !bytes.HasPrefix(data, ttlEnvelopePrefix) {
+		return data, nil
+	}
+	var env ttlEnvelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return data, nil
+	}
+	return env.Data, &env.ExpireTime
+}
+
+// purgeExpired deletes a value which expired, unless it was written since it
+// was read.
+func (s *state) purgeExpired(ctx context.Context, store Backend, key string, etag *string, metadata map[string]string) {
+	err := store.Delete(ctx, &contribstate.DeleteRequest{
+		Key:      key,
+		ETag:     etag,
+		Metadata: metadata,
+	})
+	if err != nil {
+		log.Debugf("Failed to purge expired actor state key %s: %s", key, err)
+	}
+}
+
+// nativeTTL returns true if the store expires values with a TTL natively.
+func nativeTTL(store Backend) bool {
+	return contribstate.FeatureTTL.IsPresent(store.Features())
+}
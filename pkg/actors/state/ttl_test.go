@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	contribstate "github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/actors/api"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+func TestLazyTTL(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	store := daprt.NewFakeStateStore()
+	compStore := compstore.New()
+	compStore.AddStateStore("store", store)
+	s := New(Options{
+		AppID:           "app",
+		StoreName:       "store",
+		CompStore:       compStore,
+		Resiliency:      resiliency.New(nil),
+		Clock:           clock,
+		StateTTLEnabled: true,
+	})
+
+	err := s.TransactionalStateOperation(t.Context(), true, &api.TransactionalRequest{
+		ActorType: "type",
+		ActorID:   "id",
+		Operations: []api.TransactionalOperation{
+			{Operation: api.Upsert, Request: api.TransactionalUpsert{Key: "short", Value: []byte("a"), Metadata: map[string]string{"ttlInSeconds": "10"}}},
+			{Operation: api.Upsert, Request: api.TransactionalUpsert{Key: "long", Value: []byte("b"), Metadata: map[string]string{"ttlInSeconds": "60"}}},
+			{Operation: api.Upsert, Request: api.TransactionalUpsert{Key: "forever", Value: []byte("c"), Metadata: map[string]string{"ttlInSeconds": "-1"}}},
+		},
+	}, false)
+	require.NoError(t, err)
+
+	res, err := s.Get(t.Context(), &api.GetStateRequest{ActorType: "type", ActorID: "id", Key: "short"}, false)
+	require.NoError(t, err)
+	assert.Equal(t, "a", string(res.Data))
+	assert.Equal(t, "2026-01-01T00:00:10Z", res.Metadata[contribstate.GetRespMetaKeyTTLExpireTime])
+
+	res, err = s.Get(t.Context(), &api.GetStateRequest{ActorType: "type", ActorID: "id", Key: "forever"}, false)
+	require.NoError(t, err)
+	assert.Equal(t, "c", string(res.Data))
+	assert.Empty(t, res.Metadata)
+
+	clock.Step(10 * time.Second)
+
+	res, err = s.Get(t.Context(), &api.GetStateRequest{ActorType: "type", ActorID: "id", Key: "short"}, false)
+	require.NoError(t, err)
+	assert.Nil(t, res.Data)
+	assert.NotContains(t, store.GetItems(), "app||type||id||short")
+
+	bulk, err := s.GetBulk(t.Context(), &api.GetBulkStateRequest{ActorType: "type", ActorID: "id", Keys: []string{"long", "forever"}}, false)
+	require.NoError(t, err)
+	assert.Equal(t, api.BulkStateResponse{"long": []byte("b"), "forever": []byte("c")}, bulk)
+
+	clock.Step(time.Minute)
+
+	bulk, err = s.GetBulk(t.Context(), &api.GetBulkStateRequest{ActorType: "type", ActorID: "id", Keys: []string{"long", "forever"}}, false)
+	require.NoError(t, err)
+	assert.Equal(t, api.BulkStateResponse{"long": nil, "forever": []byte("c")}, bulk)
+	assert.NotContains(t, store.GetItems(), "app||type||id||long")
+}
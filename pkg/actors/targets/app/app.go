@@ -18,12 +18,17 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"maps"
 	"net/http"
+	"slices"
 	"strconv"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
+	"google.golang.org/protobuf/types/known/anypb"
 	"k8s.io/utils/clock"
 
 	"github.com/dapr/dapr/pkg/actors/api"
@@ -42,6 +47,9 @@ import (
 
 var log = logger.NewLogger("dapr.runtime.actors.targets.app")
 
+// streamChunkSize is the size of the chunks of streamed actor method responses.
+const streamChunkSize = 64 << 10
+
 type app struct {
 	*factory
 
@@ -56,6 +64,14 @@ type app struct {
 	clock clock.Clock
 
 	closed atomic.Bool
+
+	// activateOnce invokes the post-activate hook before the first invocation
+	// of the actor.
+	activateOnce sync.Once
+	activated    atomic.Bool
+	// createdAt is the time at which the actor started activating, used to
+	// measure the activation latency.
+	createdAt time.Time
 }
 
 func (a *app) InvokeMethod(ctx context.Context, req *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error) {
@@ -69,6 +85,34 @@ func (a *app) InvokeMethod(ctx context.Context, req *internalv1pb.InternalInvoke
 }
 
 func (a *app) doInvokeMethod(ctx context.Context, req *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error) {
+	imRes, err := a.invokeApp(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer imRes.Close()
+
+	// Get the protobuf
+	res, err := imRes.ProtoWithData()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response data: %w", err)
+	}
+
+	// The .NET SDK indicates Actor failure via a header instead of a bad response
+	if _, ok := res.GetHeaders()["X-Daprerrorresponseheader"]; ok {
+		return res, actorerrors.NewActorError(res)
+	}
+
+	// Allow stopping a recurring reminder or timer
+	if v := res.GetHeaders()["X-Daprremindercancel"]; v != nil && len(v.GetValues()) > 0 && strings.IsTruthy(v.GetValues()[0]) {
+		return res, actorerrors.ErrReminderCanceled
+	}
+
+	return res, nil
+}
+
+// invokeApp invokes the actor method on the app, and returns the response
+// with its data still unread. The caller must close the response.
+func (a *app) invokeApp(ctx context.Context, req *internalv1pb.InternalInvokeRequest) (*invokev1.InvokeMethodResponse, error) {
 	a.idleAt.Store(ptr.Of(a.clock.Now().Add(a.idleTimeout)))
 	a.idlerQueue.Enqueue(a)
 
@@ -99,7 +143,14 @@ func (a *app) doInvokeMethod(ctx context.Context, req *internalv1pb.InternalInvo
 		return nil, fmt.Errorf("app channel for actor type %s is nil", a.actorType)
 	}
 
-	policyDef := a.resiliency.ActorPostLockPolicy(a.actorType, a.actorID)
+	var activating bool
+	a.activateOnce.Do(func() {
+		activating = true
+		a.activated.Store(true)
+		a.invokeHook(hookPostActivate, "")
+	})
+
+	policyDef := a.resiliency.ActorMethodPostLockPolicy(a.actorType, a.actorID, originalMethod)
 
 	// If the request can be retried, we need to enable replaying
 	if policyDef != nil && policyDef.HasRetries() {
@@ -111,9 +162,18 @@ func (a *app) doInvokeMethod(ctx context.Context, req *internalv1pb.InternalInvo
 			Disposer: resiliency.DisposerCloser[*invokev1.InvokeMethodResponse],
 		},
 	)
+	start := time.Now()
 	imRes, err := policyRunner(func(ctx context.Context) (*invokev1.InvokeMethodResponse, error) {
+		setTimeoutMetadata(ctx, imReq.Proto())
 		return a.appChannel.InvokeMethod(ctx, imReq, "")
 	})
+	diag.DefaultActorMonitoring.ActorMethodInvoked(ctx, a.actorType, originalMethod,
+		err == nil && imRes != nil && imRes.Status().GetCode() == http.StatusOK, diag.ElapsedSince(start))
+	if activating {
+		// The app activates the actor, loading its state, when it's first
+		// invoked, so the activation is complete once the first call returns.
+		diag.DefaultActorMonitoring.ActorActivated(ctx, a.actorType, diag.ElapsedSince(a.createdAt))
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -121,34 +181,37 @@ func (a *app) doInvokeMethod(ctx context.Context, req *internalv1pb.InternalInvo
 	if imRes == nil {
 		return nil, errors.New("error from actor service: response object is nil")
 	}
-	defer imRes.Close()
 
 	if imRes.Status().GetCode() == http.StatusNotFound {
+		imRes.Close()
 		return nil, backoff.Permanent(fmt.Errorf("actor method not found: %s", msg.GetMethod()))
 	}
 
 	if imRes.Status().GetCode() != http.StatusOK {
 		respData, _ := imRes.RawDataFull()
+		imRes.Close()
 		return nil, fmt.Errorf("error from actor service: (%d) %s", imRes.Status().GetCode(), string(respData))
 	}
 
-	// Get the protobuf
-	res, err := imRes.ProtoWithData()
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response data: %w", err)
-	}
+	return imRes, nil
+}
 
-	// The .NET SDK indicates Actor failure via a header instead of a bad response
-	if _, ok := res.GetHeaders()["X-Daprerrorresponseheader"]; ok {
-		return res, actorerrors.NewActorError(res)
+// setTimeoutMetadata propagates the time left before the invocation is
+// cancelled to the app, so that it can give up on the invocation in time.
+func setTimeoutMetadata(ctx context.Context, req *internalv1pb.InternalInvokeRequest) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		delete(req.Metadata, api.TimeoutHeader)
+		return
 	}
 
-	// Allow stopping a recurring reminder or timer
-	if v := res.GetHeaders()["X-Daprremindercancel"]; v != nil && len(v.GetValues()) > 0 && strings.IsTruthy(v.GetValues()[0]) {
-		return res, actorerrors.ErrReminderCanceled
+	if req.Metadata == nil {
+		req.Metadata = make(map[string]*internalv1pb.ListStringValue)
+	}
+	timeout := max(time.Until(deadline), 0)
+	req.Metadata[api.TimeoutHeader] = &internalv1pb.ListStringValue{
+		Values: []string{strconv.FormatInt(timeout.Milliseconds(), 10)},
 	}
-
-	return res, nil
 }
 
 func (a *app) InvokeReminder(ctx context.Context, reminder *api.Reminder) error {
@@ -170,7 +233,7 @@ func (a *app) InvokeReminder(ctx context.Context, reminder *api.Reminder) error
 	if err != nil {
 		return err
 	}
-	log.Debug("Executing reminder for actor " + reminder.Key())
+	diag.LoggerWithTraceContext(ctx, log).Debug("Executing reminder for actor " + reminder.Key())
 
 	req := internalv1pb.NewInternalInvokeRequest(invokeMethod).
 		WithActor(reminder.ActorType, reminder.ActorID).
@@ -180,7 +243,7 @@ func (a *app) InvokeReminder(ctx context.Context, reminder *api.Reminder) error
 	_, err = a.doInvokeMethod(ctx, req)
 	if err != nil {
 		if !errors.Is(err, actorerrors.ErrReminderCanceled) {
-			log.Errorf("Error executing reminder for actor %s: %v", reminder.Key(), err)
+			diag.LoggerWithTraceContext(ctx, log).Errorf("Error executing reminder for actor %s: %v", reminder.Key(), err)
 		}
 		return err
 	}
@@ -206,7 +269,7 @@ func (a *app) InvokeTimer(ctx context.Context, reminder *api.Reminder) error {
 		return err
 	}
 
-	log.Debug("Executing timer for actor " + reminder.Key())
+	diag.LoggerWithTraceContext(ctx, log).Debug("Executing timer for actor " + reminder.Key())
 
 	req := internalv1pb.NewInternalInvokeRequest(invokeMethod).
 		WithActor(reminder.ActorType, reminder.ActorID).
@@ -216,7 +279,7 @@ func (a *app) InvokeTimer(ctx context.Context, reminder *api.Reminder) error {
 	_, err = a.doInvokeMethod(ctx, req)
 	if err != nil {
 		if !errors.Is(err, actorerrors.ErrReminderCanceled) {
-			log.Errorf("Error executing timer for actor %s: %v", reminder.Key(), err)
+			diag.LoggerWithTraceContext(ctx, log).Errorf("Error executing timer for actor %s: %v", reminder.Key(), err)
 		}
 		return err
 	}
@@ -225,6 +288,10 @@ func (a *app) InvokeTimer(ctx context.Context, reminder *api.Reminder) error {
 }
 
 func (a *app) Deactivate(ctx context.Context) error {
+	return a.deactivate(ctx, deactivationReasonShutdown)
+}
+
+func (a *app) deactivate(ctx context.Context, reason deactivationReason) error {
 	if !a.closed.CompareAndSwap(false, true) {
 		return nil
 	}
@@ -232,6 +299,13 @@ func (a *app) Deactivate(ctx context.Context) error {
 	a.lock.Close(ctx)
 	a.table.Delete(a.actorID)
 
+	if a.activated.Load() {
+		a.invokeHook(hookPreDeactivate, reason)
+	}
+	diag.DefaultActorMonitoring.ActiveActors(ctx, a.actorType, int64(a.factory.Len()))
+
+	start := time.Now()
+
 	req := invokev1.NewInvokeMethodRequest("actors/"+a.actorType+"/"+a.actorID).
 		WithActor(a.actorType, a.actorID).
 		WithHTTPExtension(http.MethodDelete, "").
@@ -240,20 +314,23 @@ func (a *app) Deactivate(ctx context.Context) error {
 
 	resp, err := a.appChannel.InvokeMethod(context.Background(), req, "")
 	if err != nil {
+		diag.DefaultActorMonitoring.ActorDeactivated(ctx, a.actorType, false, diag.ElapsedSince(start))
 		diag.DefaultMonitoring.ActorDeactivationFailed(a.actorType, "invoke")
 		return err
 	}
 	defer resp.Close()
 
 	if resp.Status().GetCode() != http.StatusOK {
+		diag.DefaultActorMonitoring.ActorDeactivated(ctx, a.actorType, false, diag.ElapsedSince(start))
 		diag.DefaultMonitoring.ActorDeactivationFailed(a.actorType, "status_code_"+strconv.FormatInt(int64(resp.Status().GetCode()), 10))
 		body, _ := resp.RawDataFull()
 		return fmt.Errorf("error from actor service: (%d) %s", resp.Status().GetCode(), string(body))
 	}
 
 	a.idlerQueue.Dequeue(key.ConstructComposite(a.actorType, a.actorID))
+	diag.DefaultActorMonitoring.ActorDeactivated(ctx, a.actorType, true, diag.ElapsedSince(start))
 	diag.DefaultMonitoring.ActorDeactivated(a.actorType)
-	log.Debugf("Deactivated actor '%s'", a.Key())
+	diag.LoggerWithTraceContext(ctx, log).Debugf("Deactivated actor '%s'", a.Key())
 
 	return nil
 }
@@ -278,9 +355,80 @@ func (a *app) ScheduledTime() time.Time {
 	return *a.idleAt.Load()
 }
 
-func (a *app) InvokeStream(context.Context,
-	*internalv1pb.InternalInvokeRequest,
-	func(*internalv1pb.InternalInvokeResponse) (bool, error),
+// InvokeStream invokes the actor method, and streams the response data in
+// chunks instead of buffering it. Each chunk holds its offset in the response
+// data, so that a caller can resume an interrupted stream from the offset it
+// received up to, which is skipped here.
+func (a *app) InvokeStream(ctx context.Context,
+	req *internalv1pb.InternalInvokeRequest,
+	stream func(*internalv1pb.InternalInvokeResponse) (bool, error),
 ) error {
-	return errors.New("not implemented")
+	ctx, cancel, err := a.lock.LockRequest(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	imRes, err := a.invokeApp(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer imRes.Close()
+
+	headers := imRes.Headers()
+	if _, ok := headers["X-Daprerrorresponseheader"]; ok {
+		res, err := imRes.ProtoWithData()
+		if err != nil {
+			return fmt.Errorf("failed to read response data: %w", err)
+		}
+		return actorerrors.NewActorError(res)
+	}
+
+	// A resumed stream invokes the method again, which must therefore be
+	// idempotent, and skips the data which was delivered before the stream
+	// was interrupted.
+	data := imRes.RawData()
+	offset := api.StreamResumeOffset(req)
+	if offset > 0 {
+		if _, err = io.CopyN(io.Discard, data, offset); err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("failed to read response data: %w", err)
+		}
+	}
+
+	buf := make([]byte, streamChunkSize)
+	for first := true; ; first = false {
+		n, rerr := io.ReadFull(data, buf)
+		if rerr != nil && !errors.Is(rerr, io.EOF) && !errors.Is(rerr, io.ErrUnexpectedEOF) {
+			return fmt.Errorf("failed to read response data: %w", rerr)
+		}
+
+		// The stream holds at least one chunk, with the status and headers of
+		// the response.
+		if n == 0 && !first {
+			return nil
+		}
+
+		chunk := &internalv1pb.InternalInvokeResponse{
+			Status:  imRes.Status(),
+			Headers: map[string]*internalv1pb.ListStringValue{},
+			Message: &commonv1pb.InvokeResponse{
+				ContentType: imRes.ContentType(),
+				Data:        &anypb.Any{Value: slices.Clone(buf[:n])},
+			},
+		}
+		if first {
+			maps.Copy(chunk.Headers, headers)
+		}
+		chunk.Headers[api.StreamOffsetHeader] = &internalv1pb.ListStringValue{
+			Values: []string{strconv.FormatInt(offset, 10)},
+		}
+		offset += int64(n)
+
+		if done, err := stream(chunk); err != nil || done {
+			return err
+		}
+		if rerr != nil {
+			return nil
+		}
+	}
 }
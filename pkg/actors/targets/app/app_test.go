@@ -0,0 +1,213 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/actors/api"
+	"github.com/dapr/dapr/pkg/actors/internal/reentrancystore"
+	resiliencyapi "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+	"github.com/dapr/dapr/pkg/channel/fake"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/kit/logger"
+)
+
+func TestInvokeStream(t *testing.T) {
+	data := bytes.Repeat([]byte("0123456789"), streamChunkSize/4)
+
+	fact := New(Options{
+		ActorType:   "type",
+		Reentrancy:  reentrancystore.New(),
+		Resiliency:  resiliency.New(nil),
+		IdleTimeout: time.Second * 10,
+		AppChannel: fake.New().WithInvokeMethod(func(context.Context, *invokev1.InvokeMethodRequest, string) (*invokev1.InvokeMethodResponse, error) {
+			return invokev1.NewInvokeMethodResponse(http.StatusOK, "", nil).
+				WithRawDataBytes(data).
+				WithContentType("text/plain").
+				WithHTTPHeaders(map[string][]string{"X-Foo": {"bar"}}), nil
+		}),
+	})
+
+	invoke := func(t *testing.T, req *internalv1pb.InternalInvokeRequest) []*internalv1pb.InternalInvokeResponse {
+		t.Helper()
+		var chunks []*internalv1pb.InternalInvokeResponse
+		err := fact.GetOrCreate("id").InvokeStream(t.Context(), req, func(res *internalv1pb.InternalInvokeResponse) (bool, error) {
+			chunks = append(chunks, res)
+			return false, nil
+		})
+		require.NoError(t, err)
+		return chunks
+	}
+
+	t.Run("response is streamed in chunks", func(t *testing.T) {
+		chunks := invoke(t, internalv1pb.NewInternalInvokeRequest("method").WithActor("type", "id"))
+		require.Len(t, chunks, 3)
+
+		var got []byte
+		for i, chunk := range chunks {
+			offset, ok := api.StreamChunkOffset(chunk)
+			require.True(t, ok)
+			assert.Equal(t, int64(len(got)), offset)
+			assert.Equal(t, int32(http.StatusOK), chunk.GetStatus().GetCode())
+			assert.Equal(t, "text/plain", chunk.GetMessage().GetContentType())
+			assert.Equal(t, i == 0, chunk.GetHeaders()["X-Foo"] != nil)
+			got = append(got, chunk.GetMessage().GetData().GetValue()...)
+		}
+		assert.Equal(t, data, got)
+	})
+
+	t.Run("response is resumed from the offset", func(t *testing.T) {
+		req := internalv1pb.NewInternalInvokeRequest("method").
+			WithActor("type", "id").
+			WithMetadata(map[string][]string{api.StreamResumeOffsetMetadata: {"100000"}})
+		chunks := invoke(t, req)
+		require.Len(t, chunks, 1)
+
+		offset, ok := api.StreamChunkOffset(chunks[0])
+		require.True(t, ok)
+		assert.Equal(t, int64(100000), offset)
+		assert.Equal(t, data[100000:], chunks[0].GetMessage().GetData().GetValue())
+	})
+
+	t.Run("empty response is a single chunk", func(t *testing.T) {
+		req := internalv1pb.NewInternalInvokeRequest("method").
+			WithActor("type", "id").
+			WithMetadata(map[string][]string{api.StreamResumeOffsetMetadata: {"1000000"}})
+		chunks := invoke(t, req)
+		require.Len(t, chunks, 1)
+		assert.Empty(t, chunks[0].GetMessage().GetData().GetValue())
+	})
+}
+
+func TestLifecycleHooks(t *testing.T) {
+	var lock sync.Mutex
+	var calls []string
+	newFactory := func(timeout time.Duration) *factory {
+		calls = nil
+		return New(Options{
+			ActorType:            "type",
+			Reentrancy:           reentrancystore.New(),
+			Resiliency:           resiliency.New(nil),
+			IdleTimeout:          time.Second * 10,
+			LifecycleHookTimeout: timeout,
+			AppChannel: fake.New().WithInvokeMethod(func(_ context.Context, req *invokev1.InvokeMethodRequest, _ string) (*invokev1.InvokeMethodResponse, error) {
+				lock.Lock()
+				defer lock.Unlock()
+				call := req.Message().GetMethod()
+				if data, _ := req.RawDataFull(); len(data) > 0 {
+					call += " " + string(data)
+				}
+				calls = append(calls, call)
+				return invokev1.NewInvokeMethodResponse(http.StatusOK, "", nil), nil
+			}),
+		}).(*factory)
+	}
+
+	t.Run("hooks are invoked around the invocations", func(t *testing.T) {
+		fact := newFactory(time.Second)
+		req := internalv1pb.NewInternalInvokeRequest("method").WithActor("type", "id")
+		for range 2 {
+			_, err := fact.GetOrCreate("id").InvokeMethod(t.Context(), req)
+			require.NoError(t, err)
+		}
+		require.NoError(t, fact.HaltAll(t.Context()))
+
+		assert.Equal(t, []string{
+			`actors/type/id/hooks/postactivate {}`,
+			`actors/type/id/method/method`,
+			`actors/type/id/method/method`,
+			`actors/type/id/hooks/predeactivate {"reason":"shutdown"}`,
+			`actors/type/id`,
+		}, calls)
+	})
+
+	t.Run("pre-deactivate hook is skipped for actors never invoked", func(t *testing.T) {
+		fact := newFactory(time.Second)
+		fact.GetOrCreate("id")
+		require.NoError(t, fact.halt(t.Context(), fact.GetOrCreate("id").(*app), false, deactivationReasonIdle))
+		assert.Equal(t, []string{`actors/type/id`}, calls)
+	})
+
+	t.Run("hooks are disabled", func(t *testing.T) {
+		fact := newFactory(0)
+		_, err := fact.GetOrCreate("id").InvokeMethod(t.Context(), internalv1pb.NewInternalInvokeRequest("method").WithActor("type", "id"))
+		require.NoError(t, err)
+		require.NoError(t, fact.HaltAll(t.Context()))
+		assert.Equal(t, []string{`actors/type/id/method/method`, `actors/type/id`}, calls)
+	})
+}
+
+func TestMethodTimeout(t *testing.T) {
+	var timeouts []string
+	fact := New(Options{
+		ActorType:   "type",
+		Reentrancy:  reentrancystore.New(),
+		IdleTimeout: time.Second * 10,
+		Resiliency: resiliency.FromConfigurations(logger.NewLogger("test"), &resiliencyapi.Resiliency{
+			Spec: resiliencyapi.ResiliencySpec{
+				Policies: resiliencyapi.Policies{
+					Timeouts: map[string]string{"fast": "100ms"},
+				},
+				Targets: resiliencyapi.Targets{
+					Actors: map[string]resiliencyapi.ActorPolicyNames{
+						"type": {Methods: map[string]resiliencyapi.ActorMethodPolicyNames{
+							"slow": {Timeout: "fast"},
+						}},
+					},
+				},
+			},
+		}),
+		AppChannel: fake.New().WithInvokeMethod(func(ctx context.Context, req *invokev1.InvokeMethodRequest, _ string) (*invokev1.InvokeMethodResponse, error) {
+			timeouts = append(timeouts, strings.Join(req.Metadata()[api.TimeoutHeader].GetValues(), ","))
+			if strings.HasSuffix(req.Message().GetMethod(), "/slow") {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}
+			return invokev1.NewInvokeMethodResponse(http.StatusOK, "", nil), nil
+		}),
+	})
+
+	t.Run("method times out", func(t *testing.T) {
+		timeouts = nil
+		_, err := fact.GetOrCreate("id").InvokeMethod(t.Context(), internalv1pb.NewInternalInvokeRequest("slow").WithActor("type", "id"))
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Len(t, timeouts, 1)
+		timeout, err := strconv.Atoi(timeouts[0])
+		require.NoError(t, err)
+		assert.LessOrEqual(t, timeout, 100)
+	})
+
+	t.Run("no timeout is propagated without a deadline", func(t *testing.T) {
+		timeouts = nil
+		req := internalv1pb.NewInternalInvokeRequest("other").
+			WithActor("type", "id").
+			WithMetadata(map[string][]string{api.TimeoutHeader: {"5"}})
+		_, err := fact.GetOrCreate("id").InvokeMethod(t.Context(), req)
+		require.NoError(t, err)
+		assert.Equal(t, []string{""}, timeouts)
+	})
+}
@@ -45,6 +45,9 @@ type Options struct {
 	Placement               placement.Interface
 	EntityConfig            *api.EntityConfig
 	DrainRebalancedActors   bool
+	// LifecycleHookTimeout is the timeout of the lifecycle hooks of the actors
+	// on the app. Hooks are disabled when zero.
+	LifecycleHookTimeout time.Duration
 }
 
 type factory struct {
@@ -58,6 +61,7 @@ type factory struct {
 	placement               placement.Interface
 	entityConfig            *api.EntityConfig
 	drainRebalancedActors   bool
+	lifecycleHookTimeout    time.Duration
 
 	// idleTimeout is the configured max idle time for actors of this kind.
 	idleTimeout time.Duration
@@ -82,6 +86,7 @@ func New(opts Options) targets.Factory {
 		drainOngoingCallTimeout: opts.DrainOngoingCallTimeout,
 		entityConfig:            opts.EntityConfig,
 		drainRebalancedActors:   opts.DrainRebalancedActors,
+		lifecycleHookTimeout:    opts.LifecycleHookTimeout,
 	}
 
 	f.idlerQueue = queue.NewProcessor[string, *app](queue.Options[string, *app]{
@@ -99,7 +104,11 @@ func (f *factory) GetOrCreate(actorID string) targets.Interface {
 	a, ok := f.table.Load(actorID)
 	if !ok {
 		newApp := f.initApp(actorID)
-		a, _ = f.table.LoadOrStore(actorID, newApp)
+		var loaded bool
+		a, loaded = f.table.LoadOrStore(actorID, newApp)
+		if !loaded {
+			diag.DefaultActorMonitoring.ActiveActors(context.Background(), f.actorType, int64(f.Len()))
+		}
 	}
 
 	aa := a.(*app)
@@ -109,9 +118,10 @@ func (f *factory) GetOrCreate(actorID string) targets.Interface {
 
 func (f *factory) initApp(actorID string) *app {
 	app := &app{
-		actorID: actorID,
-		factory: f,
-		clock:   f.clock,
+		actorID:   actorID,
+		factory:   f,
+		clock:     f.clock,
+		createdAt: time.Now(),
 		lock: lock.New(lock.Options{
 			ActorType:   f.actorType,
 			ConfigStore: f.reentrancy,
@@ -128,7 +138,7 @@ func (f *factory) initApp(actorID string) *app {
 func (f *factory) HaltAll(ctx context.Context) error {
 	f.lock.Lock()
 	defer f.lock.Unlock()
-	return f.haltActors(ctx, false, func(actorID string) bool {
+	return f.haltActors(ctx, false, deactivationReasonShutdown, func(actorID string) bool {
 		return false
 	})
 }
@@ -136,12 +146,12 @@ func (f *factory) HaltAll(ctx context.Context) error {
 func (f *factory) HaltNonHosted(ctx context.Context) error {
 	f.lock.Lock()
 	defer f.lock.Unlock()
-	return f.haltActors(ctx, true, func(actorID string) bool {
+	return f.haltActors(ctx, true, deactivationReasonRebalance, func(actorID string) bool {
 		return f.placement.IsActorHosted(ctx, f.actorType, actorID)
 	})
 }
 
-func (f *factory) haltActors(ctx context.Context, drain bool, fn func(string) bool) error {
+func (f *factory) haltActors(ctx context.Context, drain bool, reason deactivationReason, fn func(string) bool) error {
 	var wg sync.WaitGroup
 	errs := slice.New[error]()
 
@@ -155,7 +165,7 @@ func (f *factory) haltActors(ctx context.Context, drain bool, fn func(string) bo
 		wg.Add(1)
 		go func(aa *app) {
 			defer wg.Done()
-			errs.Append(f.halt(ctx, aa, drain))
+			errs.Append(f.halt(ctx, aa, drain, reason))
 		}(aa)
 
 		return true
@@ -190,13 +200,13 @@ func (f *factory) handleIdleActor(target *app) {
 
 	log.Debugf("Actor %s is idle, deactivating", target.Key())
 
-	if err := f.halt(ctx, target, false); err != nil {
+	if err := f.halt(ctx, target, false, deactivationReasonIdle); err != nil {
 		log.Errorf("Failed to halt actor %s: %s", target.Key(), err)
 		return
 	}
 }
 
-func (f *factory) halt(ctx context.Context, app *app, drain bool) error {
+func (f *factory) halt(ctx context.Context, app *app, drain bool, reason deactivationReason) error {
 	if drain {
 		if f.entityConfig != nil {
 			drain = f.entityConfig.DrainRebalancedActors
@@ -217,5 +227,5 @@ func (f *factory) halt(ctx context.Context, app *app, drain bool) error {
 
 	log.Debugf("Halting actor '%s'", key)
 
-	return app.Deactivate(ctx)
+	return app.deactivate(ctx, reason)
 }
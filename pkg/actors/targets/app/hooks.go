@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+)
+
+// Lifecycle hooks of the actors, invoked on the app with
+// PUT actors/<type>/<id>/hooks/<hook>.
+const (
+	// hookPostActivate is invoked when the actor is activated, before its first
+	// invocation.
+	hookPostActivate = "postactivate"
+	// hookPreDeactivate is invoked when the actor is deactivated, after its
+	// last invocation, with the reason of the deactivation.
+	hookPreDeactivate = "predeactivate"
+)
+
+// deactivationReason is the reason an actor is deactivated.
+type deactivationReason string
+
+const (
+	// deactivationReasonIdle is the reason of actors deactivated after their
+	// idle timeout.
+	deactivationReasonIdle deactivationReason = "idle"
+	// deactivationReasonRebalance is the reason of actors deactivated because
+	// they moved to another host.
+	deactivationReasonRebalance deactivationReason = "rebalance"
+	// deactivationReasonShutdown is the reason of actors deactivated because
+	// the runtime is shutting down.
+	deactivationReasonShutdown deactivationReason = "shutdown"
+)
+
+type hookRequest struct {
+	Reason deactivationReason `json:"reason,omitempty"`
+}
+
+// invokeHook invokes a lifecycle hook of the actor on the app, if hooks are
+// enabled. A failed hook is logged, and doesn't prevent the actor from being
+// activated or deactivated.
+func (a *app) invokeHook(hook string, reason deactivationReason) {
+	if a.lifecycleHookTimeout <= 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), a.lifecycleHookTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := a.doInvokeHook(ctx, hook, reason)
+	diag.DefaultActorMonitoring.ActorLifecycleHookInvoked(ctx, a.actorType, hook, err == nil, diag.ElapsedSince(start))
+	if err != nil {
+		log.Warnf("Failed to invoke %s hook of actor %s: %s", hook, a.Key(), err)
+	}
+}
+
+func (a *app) doInvokeHook(ctx context.Context, hook string, reason deactivationReason) error {
+	data, err := json.Marshal(hookRequest{Reason: reason})
+	if err != nil {
+		return err
+	}
+
+	req := invokev1.NewInvokeMethodRequest("actors/"+a.actorType+"/"+a.actorID+"/hooks/"+hook).
+		WithActor(a.actorType, a.actorID).
+		WithHTTPExtension(http.MethodPut, "").
+		WithContentType(invokev1.JSONContentType).
+		WithRawDataBytes(data)
+	defer req.Close()
+
+	resp, err := a.appChannel.InvokeMethod(ctx, req, "")
+	if err != nil {
+		return err
+	}
+	defer resp.Close()
+
+	if code := resp.Status().GetCode(); code != http.StatusOK && code != http.StatusNoContent {
+		body, _ := resp.RawDataFull()
+		return fmt.Errorf("error from actor service: (%d) %s", code, string(body))
+	}
+
+	return nil
+}
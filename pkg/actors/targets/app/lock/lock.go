@@ -28,7 +28,7 @@ import (
 	"github.com/dapr/kit/ring"
 )
 
-const headerReentrancyID = "Dapr-Reentrancy-Id"
+const headerReentrancyID = api.ReentrancyIDMetadata
 
 var ErrLockClosed = errors.New("actor lock is closed")
 
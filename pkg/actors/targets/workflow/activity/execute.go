@@ -26,6 +26,7 @@ import (
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	wferrors "github.com/dapr/dapr/pkg/runtime/wfengine/errors"
+	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
 	"github.com/dapr/dapr/pkg/runtime/wfengine/todo"
 	"github.com/dapr/durabletask-go/api"
 	"github.com/dapr/durabletask-go/backend"
@@ -45,6 +46,19 @@ func (a *activity) executeActivity(ctx context.Context, name string, taskEvent *
 	}
 	workflowID := a.actorID[0:endIndex]
 
+	wfActorType := a.workflowActorType
+	if router := taskEvent.GetRouter(); router != nil {
+		wfActorType = a.actorTypeBuilder.Workflow(router.GetSourceAppID())
+	}
+
+	// Wait for the activity to fit within the concurrency limits of its
+	// workflow, and hold its slot until the activity completes.
+	release, err := a.throttle.Acquire(ctx, a.workflowName(ctx, wfActorType, workflowID))
+	if err != nil {
+		return wferrors.NewRecoverable(fmt.Errorf("timed-out waiting for the activity concurrency limit: %w", err))
+	}
+	defer release()
+
 	wi := &backend.ActivityWorkItem{
 		SequenceNumber: int64(taskEvent.GetEventId()),
 		InstanceID:     api.InstanceID(workflowID),
@@ -62,7 +76,7 @@ func (a *activity) executeActivity(ctx context.Context, name string, taskEvent *
 	log.Debugf("Activity actor '%s': scheduling activity '%s' for workflow with instanceId '%s'", a.actorID, name, wi.InstanceID)
 	elapsed := float64(0)
 	start := time.Now()
-	err := a.scheduler(ctx, wi)
+	err = a.scheduler(ctx, wi)
 	elapsed = diag.ElapsedSince(start)
 
 	if errors.Is(err, context.DeadlineExceeded) {
@@ -110,11 +124,6 @@ func (a *activity) executeActivity(ctx context.Context, name string, taskEvent *
 	}
 
 	// send completed event to orchestrator wf actor
-	wfActorType := a.workflowActorType
-	if router := taskEvent.GetRouter(); router != nil {
-		wfActorType = a.actorTypeBuilder.Workflow(router.GetSourceAppID())
-	}
-
 	req := internalsv1pb.
 		NewInternalInvokeRequest(todo.AddWorkflowEventMethod).
 		WithActor(wfActorType, workflowID).
@@ -143,3 +152,24 @@ func (a *activity) executeActivity(ctx context.Context, name string, taskEvent *
 
 	return nil
 }
+
+// workflowName returns the name of the workflow of the activity, when it's
+// needed to apply the concurrency limits of the workflow. Activities whose
+// workflow can't be resolved are only subject to the limits of the app.
+func (a *activity) workflowName(ctx context.Context, wfActorType, workflowID string) string {
+	if !a.throttle.LimitsWorkflows() {
+		return ""
+	}
+
+	entry, err := wfenginestate.LoadIndexEntry(ctx, a.state, workflowID, wfenginestate.Options{
+		WorkflowActorType: wfActorType,
+	})
+	if err != nil {
+		log.Warnf("Activity actor '%s': failed to resolve the workflow name to apply its concurrency limits: %s", a.actorID, err)
+		return ""
+	}
+	if entry == nil {
+		return ""
+	}
+	return entry.Name
+}
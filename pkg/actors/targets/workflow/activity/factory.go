@@ -25,6 +25,7 @@ import (
 	"github.com/dapr/dapr/pkg/actors/targets"
 	"github.com/dapr/dapr/pkg/actors/targets/workflow/common"
 	"github.com/dapr/dapr/pkg/actors/targets/workflow/common/lock"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/throttle"
 	"github.com/dapr/dapr/pkg/runtime/wfengine/todo"
 )
 
@@ -43,6 +44,9 @@ type Options struct {
 	Scheduler         todo.ActivityScheduler
 	Actors            actors.Interface
 	ActorTypeBuilder  *common.ActorTypeBuilder
+	// Throttle limits the concurrently running activities, by the name of
+	// their workflow.
+	Throttle *throttle.Throttle
 }
 
 type factory struct {
@@ -57,6 +61,7 @@ type factory struct {
 	actorTypeBuilder *common.ActorTypeBuilder
 
 	scheduler todo.ActivityScheduler
+	throttle  *throttle.Throttle
 
 	table sync.Map
 	lock  sync.Mutex
@@ -89,6 +94,7 @@ func New(ctx context.Context, opts Options) (targets.Factory, error) {
 		router:            router,
 		reminders:         reminders,
 		scheduler:         opts.Scheduler,
+		throttle:          opts.Throttle,
 		placement:         placement,
 		workflowActorType: opts.WorkflowActorType,
 		actorTypeBuilder:  opts.ActorTypeBuilder,
@@ -23,26 +23,49 @@ import (
 )
 
 func (o *orchestrator) addWorkflowEvent(ctx context.Context, historyEventBytes []byte) error {
+	var e backend.HistoryEvent
+	if err := proto.Unmarshal(historyEventBytes, &e); err != nil {
+		return err
+	}
+	return o.addToInbox(ctx, &e)
+}
+
+// addWorkflowEvents adds a batch of events to the workflow inbox at once. The
+// events of the batch are the inbox of the workflow state in the request.
+func (o *orchestrator) addWorkflowEvents(ctx context.Context, request []byte) error {
+	var batch backend.WorkflowState
+	if err := proto.Unmarshal(request, &batch); err != nil {
+		return err
+	}
+	if len(batch.GetInbox()) == 0 {
+		return nil
+	}
+	return o.addToInbox(ctx, batch.GetInbox()...)
+}
+
+func (o *orchestrator) addToInbox(ctx context.Context, events ...*backend.HistoryEvent) error {
 	state, _, err := o.loadInternalState(ctx)
 	if err != nil {
 		return err
 	}
 
 	if state == nil {
+		// External events raised before the instance is started are buffered
+		// until it starts, if enabled.
+		if o.eventBufferTTL > 0 && areExternalEvents(events) {
+			return o.bufferEvents(ctx, events)
+		}
 		log.Errorf("Workflow actor '%s': cannot add event to workflow as state has been purged. Ignoring event.", o.actorID)
 		return api.ErrInstanceNotFound
 	}
 
-	var e backend.HistoryEvent
-	err = proto.Unmarshal(historyEventBytes, &e)
-	if e.GetTaskCompleted() != nil || e.GetTaskFailed() != nil {
-		o.activityResultAwaited.CompareAndSwap(true, false)
-	}
-	if err != nil {
-		return err
+	for _, e := range events {
+		if e.GetTaskCompleted() != nil || e.GetTaskFailed() != nil {
+			o.activityResultAwaited.CompareAndSwap(true, false)
+		}
+		state.AddToInbox(e)
 	}
-	log.Debugf("Workflow actor '%s': adding event to the workflow inbox", o.actorID)
-	state.AddToInbox(&e)
+	log.Debugf("Workflow actor '%s': adding %d event(s) to the workflow inbox", o.actorID, len(events))
 
 	if err := o.saveInternalState(ctx, state); err != nil {
 		return err
@@ -51,6 +74,7 @@ func (o *orchestrator) addWorkflowEvent(ctx context.Context, historyEventBytes [
 	// For activity completion events, we want to create the reminder on the same app where this workflow actor is
 	// hosted, so use the source app from the router.
 	// For sub-orchestrator completion events we want to create the reminder on the current app.
+	e := events[len(events)-1]
 	sourceAppID := o.appID
 	returningToParent := e.GetSubOrchestrationInstanceCompleted() != nil || e.GetSubOrchestrationInstanceFailed() != nil
 	if !returningToParent && e.GetRouter() != nil {
@@ -67,3 +91,12 @@ func (o *orchestrator) addWorkflowEvent(ctx context.Context, historyEventBytes [
 
 	return nil
 }
+
+func areExternalEvents(events []*backend.HistoryEvent) bool {
+	for _, e := range events {
+		if e.GetEventRaised() == nil {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orchestrator
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
+	"github.com/dapr/durabletask-go/backend"
+)
+
+// eventBufferReminderPrefix is the prefix of the reminders dropping the
+// buffered events of an instance which wasn't started before they expired.
+const eventBufferReminderPrefix = "event-buffer"
+
+// bufferEvents buffers external events raised to an instance which hasn't
+// been started yet, until it starts or the events expire.
+func (o *orchestrator) bufferEvents(ctx context.Context, events []*backend.HistoryEvent) error {
+	buffered, _, err := o.loadEventBuffer(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, e := range events {
+		if e.GetTimestamp() == nil {
+			e.Timestamp = timestamppb.New(now)
+		}
+	}
+	buffered = append(buffered, events...)
+
+	log.Debugf("Workflow actor '%s': buffering %d event(s) until the workflow is started", o.actorID, len(events))
+	if err = o.saveEventBuffer(ctx, buffered); err != nil {
+		return err
+	}
+
+	_, err = o.createWorkflowReminder(ctx, eventBufferReminderPrefix, nil, now.Add(o.eventBufferTTL), o.appID)
+	return err
+}
+
+// loadEventBuffer loads the buffered events which haven't expired yet, and
+// whether there is a buffer.
+func (o *orchestrator) loadEventBuffer(ctx context.Context) ([]*backend.HistoryEvent, bool, error) {
+	events, err := wfenginestate.LoadEventBuffer(ctx, o.actorState, o.actorID, wfenginestate.Options{
+		AppID:             o.appID,
+		WorkflowActorType: o.actorType,
+		ActivityActorType: o.activityActorType,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	kept, expired := wfenginestate.ExpireBufferedEvents(events, o.eventBufferTTL, time.Now())
+	if len(expired) > 0 {
+		log.Warnf("Workflow actor '%s': dropping %d buffered event(s) which expired before the workflow was started", o.actorID, len(expired))
+		diag.DefaultWorkflowMonitoring.WorkflowEventsDropped(ctx, "expired", int64(len(expired)))
+	}
+
+	return kept, len(events) > 0, nil
+}
+
+// saveEventBuffer saves the buffered events, or deletes the buffer if there
+// are none.
+func (o *orchestrator) saveEventBuffer(ctx context.Context, events []*backend.HistoryEvent) error {
+	req, err := wfenginestate.GetEventBufferSaveRequest(o.actorID, wfenginestate.Options{
+		AppID:             o.appID,
+		WorkflowActorType: o.actorType,
+		ActivityActorType: o.activityActorType,
+	}, events)
+	if err != nil {
+		return err
+	}
+	return o.actorState.TransactionalStateOperation(ctx, true, req, false)
+}
+
+// expireEventBuffer drops the expired buffered events of an instance which
+// hasn't been started yet.
+func (o *orchestrator) expireEventBuffer(ctx context.Context) error {
+	kept, ok, err := o.loadEventBuffer(ctx)
+	if err != nil || !ok {
+		return err
+	}
+	return o.saveEventBuffer(ctx, kept)
+}
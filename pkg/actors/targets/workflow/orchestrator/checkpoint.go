@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orchestrator
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+	"github.com/dapr/durabletask-go/backend/runtimestate"
+)
+
+// setCheckpoint sets the named checkpoint the workflow instance pauses at.
+func (o *orchestrator) setCheckpoint(ctx context.Context, request []byte) error {
+	name := string(request)
+	if name == "" {
+		return status.Error(codes.InvalidArgument, "checkpoint name is required")
+	}
+
+	state, _, err := o.loadInternalState(ctx)
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		defer o.factory.deactivate(o)
+		return status.Errorf(codes.NotFound, "workflow instance does not exist with ID '%s'", o.actorID)
+	}
+	if runtimestate.IsCompleted(o.rstate) {
+		return status.Errorf(codes.InvalidArgument, "'%s' is in a terminal state", o.actorID)
+	}
+
+	checkpoint, err := o.loadCheckpoint(ctx)
+	if err != nil {
+		return err
+	}
+	if checkpoint != nil && checkpoint.Reached {
+		return status.Errorf(codes.FailedPrecondition, "'%s' is paused at checkpoint '%s'", o.actorID, checkpoint.Name)
+	}
+
+	log.Debugf("Workflow actor '%s': setting checkpoint '%s'", o.actorID, name)
+	return o.saveCheckpoint(ctx, &wfenginestate.Checkpoint{Name: name})
+}
+
+// applyCheckpoint returns the activities of a workflow step to call, holding
+// those scheduled from the checkpoint of the instance on. It returns true if
+// the step reached the checkpoint, so the instance must be suspended. The
+// held activities are released once the instance is resumed.
+func (o *orchestrator) applyCheckpoint(ctx context.Context, tasks, inbox []*backend.HistoryEvent) ([]*backend.HistoryEvent, bool, error) {
+	checkpoint, err := o.loadCheckpoint(ctx)
+	if err != nil || checkpoint == nil {
+		return tasks, false, err
+	}
+
+	if checkpoint.Reached {
+		if !isResumed(inbox) {
+			if len(tasks) == 0 {
+				return nil, false, nil
+			}
+			if err = checkpoint.Hold(tasks); err != nil {
+				return nil, false, err
+			}
+			return nil, false, o.saveCheckpoint(ctx, checkpoint)
+		}
+
+		held, err := checkpoint.HeldEvents()
+		if err != nil {
+			return nil, false, err
+		}
+		log.Debugf("Workflow actor '%s': resumed from checkpoint '%s', releasing %d held activities", o.actorID, checkpoint.Name, len(held))
+		if err = o.saveCheckpoint(ctx, nil); err != nil {
+			return nil, false, err
+		}
+		return append(held, tasks...), false, nil
+	}
+
+	for i, task := range tasks {
+		if task.GetTaskScheduled().GetName() != checkpoint.Name {
+			continue
+		}
+
+		log.Infof("Workflow actor '%s': reached checkpoint '%s'", o.actorID, checkpoint.Name)
+		checkpoint.Reached = true
+		if err = checkpoint.Hold(tasks[i:]); err != nil {
+			return nil, false, err
+		}
+		if err = o.saveCheckpoint(ctx, checkpoint); err != nil {
+			return nil, false, err
+		}
+		return tasks[:i], true, nil
+	}
+
+	return tasks, false, nil
+}
+
+// suspendAtCheckpoint suspends the workflow instance which reached its
+// checkpoint. The suspension is recorded in the history of the instance.
+func (o *orchestrator) suspendAtCheckpoint(ctx context.Context) error {
+	return o.addToInbox(ctx, &backend.HistoryEvent{
+		EventId:   -1,
+		Timestamp: timestamppb.Now(),
+		EventType: &protos.HistoryEvent_ExecutionSuspended{
+			ExecutionSuspended: &protos.ExecutionSuspendedEvent{
+				Input: wrapperspb.String("reached checkpoint '" + o.checkpoint.Name + "'"),
+			},
+		},
+	})
+}
+
+func isResumed(inbox []*backend.HistoryEvent) bool {
+	for _, e := range inbox {
+		if e.GetExecutionResumed() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// loadCheckpoint loads the checkpoint of the workflow instance, which is
+// cached until the actor is deactivated.
+func (o *orchestrator) loadCheckpoint(ctx context.Context) (*wfenginestate.Checkpoint, error) {
+	if o.checkpointLoaded {
+		return o.checkpoint, nil
+	}
+
+	checkpoint, err := wfenginestate.LoadCheckpoint(ctx, o.actorState, o.actorID, wfenginestate.Options{
+		AppID:             o.appID,
+		WorkflowActorType: o.actorType,
+		ActivityActorType: o.activityActorType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	o.checkpoint, o.checkpointLoaded = checkpoint, true
+	return checkpoint, nil
+}
+
+// saveCheckpoint saves the checkpoint of the workflow instance, or deletes it
+// if nil.
+func (o *orchestrator) saveCheckpoint(ctx context.Context, checkpoint *wfenginestate.Checkpoint) error {
+	req, err := wfenginestate.GetCheckpointSaveRequest(o.actorID, wfenginestate.Options{
+		AppID:             o.appID,
+		WorkflowActorType: o.actorType,
+		ActivityActorType: o.activityActorType,
+	}, checkpoint)
+	if err != nil {
+		return err
+	}
+	if err = o.actorState.TransactionalStateOperation(ctx, true, req, false); err != nil {
+		return err
+	}
+
+	o.checkpoint, o.checkpointLoaded = checkpoint, true
+	return nil
+}
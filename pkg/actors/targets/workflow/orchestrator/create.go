@@ -20,7 +20,9 @@ import (
 
 	"google.golang.org/protobuf/proto"
 
+	diag "github.com/dapr/dapr/pkg/diagnostics"
 	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/versioning"
 	"github.com/dapr/durabletask-go/api"
 	"github.com/dapr/durabletask-go/backend"
 	"github.com/dapr/durabletask-go/backend/runtimestate"
@@ -37,6 +39,10 @@ func (o *orchestrator) createWorkflowInstance(ctx context.Context, request []byt
 	if es := startEvent.GetExecutionStarted(); es == nil {
 		return errors.New("invalid execution start event")
 	} else {
+		// New instances start on the latest version of the workflow, unless
+		// started on another version.
+		versioning.Stamp(es, o.versions[es.GetName()])
+
 		if es.GetParentInstance() == nil {
 			log.Debugf("Workflow actor '%s': creating workflow '%s' with instanceId '%s'",
 				o.actorID,
@@ -69,7 +75,25 @@ func (o *orchestrator) createWorkflowInstance(ctx context.Context, request []byt
 		})
 		o.rstate = runtimestate.NewOrchestrationRuntimeState(o.actorID, state.CustomStatus, state.History)
 		o.ometa = o.ometaFromState(o.rstate, startEvent.GetExecutionStarted())
-		return o.scheduleWorkflowStart(ctx, startEvent, state)
+
+		// External events raised before the instance was created are delivered
+		// along with the start event.
+		var buffered []*backend.HistoryEvent
+		var hasBuffer bool
+		if o.eventBufferTTL > 0 {
+			if buffered, hasBuffer, err = o.loadEventBuffer(ctx); err != nil {
+				return err
+			}
+		}
+		if err = o.scheduleWorkflowStart(ctx, startEvent, state, buffered...); err != nil {
+			return err
+		}
+		if hasBuffer {
+			if err = o.saveEventBuffer(ctx, nil); err != nil {
+				log.Warnf("Workflow actor '%s': failed to delete the buffered events delivered to the workflow: %v", o.actorID, err)
+			}
+		}
+		return nil
 	}
 
 	// orchestration already existed: apply reuse id policy
@@ -113,8 +137,11 @@ func (o *orchestrator) createIfCompleted(ctx context.Context, rs *backend.Orches
 	return o.scheduleWorkflowStart(ctx, startEvent, state)
 }
 
-func (o *orchestrator) scheduleWorkflowStart(ctx context.Context, startEvent *backend.HistoryEvent, state *wfenginestate.State) error {
+func (o *orchestrator) scheduleWorkflowStart(ctx context.Context, startEvent *backend.HistoryEvent, state *wfenginestate.State, events ...*backend.HistoryEvent) error {
 	state.AddToInbox(startEvent)
+	for _, e := range events {
+		state.AddToInbox(e)
+	}
 	if err := o.saveInternalState(ctx, state); err != nil {
 		return err
 	}
@@ -131,6 +158,8 @@ func (o *orchestrator) scheduleWorkflowStart(ctx context.Context, startEvent *ba
 		return err
 	}
 
+	diag.DefaultWorkflowMonitoring.WorkflowStarted(ctx, startEvent.GetExecutionStarted().GetName())
+
 	return nil
 }
 
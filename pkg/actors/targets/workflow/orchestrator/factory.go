@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"time"
 
 	"github.com/dapr/dapr/pkg/actors"
 	"github.com/dapr/dapr/pkg/actors/internal/placement"
@@ -28,6 +29,7 @@ import (
 	"github.com/dapr/dapr/pkg/actors/targets/workflow/common/lock"
 	"github.com/dapr/dapr/pkg/config"
 	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/throttle"
 	"github.com/dapr/dapr/pkg/runtime/wfengine/todo"
 	"github.com/dapr/kit/concurrency/slice"
 )
@@ -52,6 +54,14 @@ type Options struct {
 	EventSink        EventSink
 	ActorTypeBuilder *common.ActorTypeBuilder
 	RetentionPolicy  *config.WorkflowStateRetentionPolicy
+	// Versions are the latest versions of the workflows, by workflow name.
+	Versions map[string]string
+	// EventBufferTTL is how long external events raised to an instance which
+	// hasn't been started yet are retained. Such events are rejected if zero.
+	EventBufferTTL time.Duration
+	// ChildWorkflowThrottle limits the concurrently running child workflow
+	// executions, by the name of their parent workflow.
+	ChildWorkflowThrottle *throttle.Throttle
 }
 
 type factory struct {
@@ -68,6 +78,9 @@ type factory struct {
 	eventSink        EventSink
 	actorTypeBuilder *common.ActorTypeBuilder
 	retentionPolicy  *config.WorkflowStateRetentionPolicy
+	versions         map[string]string
+	eventBufferTTL   time.Duration
+	childThrottle    *throttle.Throttle
 
 	scheduler todo.WorkflowScheduler
 
@@ -118,6 +131,9 @@ func New(ctx context.Context, opts Options) (targets.Factory, error) {
 		actorTypeBuilder:   opts.ActorTypeBuilder,
 		placement:          placement,
 		retentionPolicy:    opts.RetentionPolicy,
+		versions:           opts.Versions,
+		eventBufferTTL:     opts.EventBufferTTL,
+		childThrottle:      opts.ChildWorkflowThrottle,
 		scheduler:          opts.Scheduler,
 		deactivateCh:       deactivateCh,
 	}, nil
@@ -156,6 +172,8 @@ func (f *factory) initOrchestrator(o any, actorID string) *orchestrator {
 	or.state = nil
 	or.rstate = nil
 	or.ometa = nil
+	or.checkpoint = nil
+	or.checkpointLoaded = false
 
 	return or
 }
@@ -120,6 +120,11 @@ func (f *Fork) handleBefore(his *backend.HistoryEvent) {
 		f.newState.AddToHistory(his)
 		delete(f.unfinishedActivities, his.GetTaskCompleted().GetTaskScheduledId())
 
+	case *protos.HistoryEvent_TaskFailed:
+		f.newState.AddToHistory(f.unfinishedActivities[his.GetTaskFailed().GetTaskScheduledId()])
+		f.newState.AddToHistory(his)
+		delete(f.unfinishedActivities, his.GetTaskFailed().GetTaskScheduledId())
+
 	case *protos.HistoryEvent_TimerCreated:
 		f.activeTimers[his.GetEventId()] = his
 
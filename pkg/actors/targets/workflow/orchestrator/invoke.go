@@ -80,6 +80,9 @@ func (o *orchestrator) executeMethod(ctx context.Context, methodName string, met
 	case todo.AddWorkflowEventMethod:
 		return nil, o.addWorkflowEvent(ctx, request)
 
+	case todo.AddWorkflowEventsMethod:
+		return nil, o.addWorkflowEvents(ctx, request)
+
 	case todo.PurgeWorkflowStateMethod:
 		return nil, o.purgeWorkflowState(ctx, meta)
 
@@ -89,6 +92,12 @@ func (o *orchestrator) executeMethod(ctx context.Context, methodName string, met
 	case todo.RerunWorkflowInstance:
 		return nil, backoff.Permanent(o.rerunWorkflowInstanceRequest(ctx, request))
 
+	case todo.RewindWorkflowMethod:
+		return nil, backoff.Permanent(o.rewindWorkflow(ctx, request))
+
+	case todo.SetWorkflowCheckpointMethod:
+		return nil, backoff.Permanent(o.setCheckpoint(ctx, request))
+
 	default:
 		return nil, fmt.Errorf("no such method: %s", methodName)
 	}
@@ -42,6 +42,9 @@ type orchestrator struct {
 	rstate *backend.OrchestrationRuntimeState
 	ometa  *backend.OrchestrationMetadata
 
+	checkpoint       *wfenginestate.Checkpoint
+	checkpointLoaded bool
+
 	activityResultAwaited atomic.Bool
 	lock                  *lock.Lock
 	closed                atomic.Bool
@@ -119,6 +122,8 @@ func (o *orchestrator) Deactivate(ctx context.Context) error {
 	o.state = nil
 	o.rstate = nil
 	o.ometa = nil
+	o.checkpoint = nil
+	o.checkpointLoaded = false
 	o.lock.Close()
 	for _, stream := range o.streamFns {
 		stream.errCh <- targeterrors.NewClosed("deactivated")
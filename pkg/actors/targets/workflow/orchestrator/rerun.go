@@ -105,6 +105,16 @@ func (o *orchestrator) rerunWorkflowInstanceRequest(ctx context.Context, request
 		return fmt.Errorf("failed to unmarshal workflow history: %w", err)
 	}
 
+	return o.startForkedState(ctx, &workflowState, wfenginestate.NewState(wfenginestate.Options{
+		AppID:             o.appID,
+		WorkflowActorType: o.actorType,
+		ActivityActorType: o.activityActorType,
+	}))
+}
+
+// startForkedState saves the forked workflow state into the given state, and
+// runs the activities and timers of its inbox again.
+func (o *orchestrator) startForkedState(ctx context.Context, workflowState *backend.WorkflowState, newState *wfenginestate.State) error {
 	if len(workflowState.Inbox) == 0 {
 		return errors.New("expect rerun workflow inbox to not be empty")
 	}
@@ -141,19 +151,13 @@ func (o *orchestrator) rerunWorkflowInstanceRequest(ctx context.Context, request
 		i--
 	}
 
-	newState := wfenginestate.NewState(wfenginestate.Options{
-		AppID:             o.appID,
-		WorkflowActorType: o.actorType,
-		ActivityActorType: o.activityActorType,
-	})
-
-	newState.FromWorkflowState(&workflowState)
+	newState.FromWorkflowState(workflowState)
 
-	if err = o.saveInternalState(ctx, newState); err != nil {
+	if err := o.saveInternalState(ctx, newState); err != nil {
 		return fmt.Errorf("failed to save workflow state: %w", err)
 	}
 
-	if err = errors.Join(
+	if err := errors.Join(
 		o.callActivities(ctx, activities, newState),
 		o.createTimers(ctx, timers, newState.Generation),
 	); err != nil {
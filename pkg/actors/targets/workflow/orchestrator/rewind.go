@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orchestrator
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	actorsapi "github.com/dapr/dapr/pkg/actors/api"
+	"github.com/dapr/dapr/pkg/actors/targets/workflow/orchestrator/fork"
+	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+)
+
+// rewindWorkflow rewinds a failed workflow instance to its last successful
+// activity: the history from the activity which failed the instance on is
+// dropped, and the activity is run again. The rewind is recorded in the
+// history as a suspension of the instance followed by its resumption.
+func (o *orchestrator) rewindWorkflow(ctx context.Context, request []byte) error {
+	state, ometa, err := o.loadInternalState(ctx)
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		defer o.factory.deactivate(o)
+		return status.Errorf(codes.NotFound, "workflow instance does not exist with ID '%s'", o.actorID)
+	}
+	if ometa.GetRuntimeStatus() != api.RUNTIME_STATUS_FAILED {
+		return status.Errorf(codes.InvalidArgument, "'%s' is not in a failed state", o.actorID)
+	}
+
+	targetEventID, ok := failedActivityEventID(state.History)
+	if !ok {
+		return status.Errorf(codes.FailedPrecondition, "'%s' has no failed activity to rewind to", o.actorID)
+	}
+
+	newState, err := fork.New(fork.Options{
+		InstanceID:        o.actorID,
+		AppID:             o.appID,
+		ActorType:         o.actorType,
+		ActivityActorType: o.activityActorType,
+		TargetEventID:     targetEventID,
+		OldState:          state,
+	}).Build()
+	if err != nil {
+		return err
+	}
+
+	workflowState := newState.ToWorkflowState()
+	// The activities run again in a new generation, so they aren't flagged as
+	// duplicates of the failed ones.
+	workflowState.Generation = state.Generation + 1
+	workflowState.History = append(workflowState.History, rewindAuditEvents(string(request))...)
+
+	log.Infof("Workflow actor '%s': rewinding workflow to activity with event ID '%d'", o.actorID, targetEventID)
+
+	// The instance is running again, so it must not be purged by the retention
+	// policy of its failure.
+	err = o.reminders.DeleteByActorID(ctx, &actorsapi.DeleteRemindersByActorIDRequest{
+		ActorType: o.retentionActorType,
+		ActorID:   o.actorID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return o.startForkedState(ctx, workflowState, state)
+}
+
+// failedActivityEventID returns the event ID of the TaskScheduled event of the
+// last failed activity in the history.
+func failedActivityEventID(history []*backend.HistoryEvent) (int32, bool) {
+	for i := len(history) - 1; i >= 0; i-- {
+		if tf := history[i].GetTaskFailed(); tf != nil {
+			return tf.GetTaskScheduledId(), true
+		}
+	}
+	return 0, false
+}
+
+// rewindAuditEvents returns the history events recording a rewind. They're a
+// suspension immediately followed by a resumption of the instance, which the
+// workflow replays without effect.
+func rewindAuditEvents(reason string) []*backend.HistoryEvent {
+	input := wrapperspb.String("rewind")
+	if reason != "" {
+		input = wrapperspb.String("rewind: " + reason)
+	}
+
+	now := timestamppb.Now()
+	return []*backend.HistoryEvent{
+		{
+			EventId:   -1,
+			Timestamp: now,
+			EventType: &protos.HistoryEvent_ExecutionSuspended{
+				ExecutionSuspended: &protos.ExecutionSuspendedEvent{Input: input},
+			},
+		},
+		{
+			EventId:   -1,
+			Timestamp: now,
+			EventType: &protos.HistoryEvent_ExecutionResumed{
+				ExecutionResumed: &protos.ExecutionResumedEvent{Input: input},
+			},
+		},
+	}
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+)
+
+func TestFailedActivityEventID(t *testing.T) {
+	t.Parallel()
+
+	failed := func(scheduledID int32) *backend.HistoryEvent {
+		return &backend.HistoryEvent{EventType: &protos.HistoryEvent_TaskFailed{
+			TaskFailed: &protos.TaskFailedEvent{TaskScheduledId: scheduledID},
+		}}
+	}
+
+	t.Run("no failed activity", func(t *testing.T) {
+		_, ok := failedActivityEventID([]*backend.HistoryEvent{{
+			EventType: &protos.HistoryEvent_TaskCompleted{TaskCompleted: &protos.TaskCompletedEvent{TaskScheduledId: 1}},
+		}})
+		assert.False(t, ok)
+	})
+
+	t.Run("last failed activity", func(t *testing.T) {
+		id, ok := failedActivityEventID([]*backend.HistoryEvent{failed(1), failed(3)})
+		assert.True(t, ok)
+		assert.Equal(t, int32(3), id)
+	})
+}
+
+func TestRewindAuditEvents(t *testing.T) {
+	t.Parallel()
+
+	events := rewindAuditEvents("fixed upstream")
+	require.Len(t, events, 2)
+	assert.Equal(t, "rewind: fixed upstream", events[0].GetExecutionSuspended().GetInput().GetValue())
+	assert.Equal(t, "rewind: fixed upstream", events[1].GetExecutionResumed().GetInput().GetValue())
+
+	events = rewindAuditEvents("")
+	assert.Equal(t, "rewind", events[0].GetExecutionSuspended().GetInput().GetValue())
+}
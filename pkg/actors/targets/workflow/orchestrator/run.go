@@ -39,6 +39,9 @@ func (o *orchestrator) runWorkflow(ctx context.Context, reminder *actorapi.Remin
 		return todo.RunCompletedTrue, fmt.Errorf("error loading internal state: %w", err)
 	}
 	if state == nil {
+		if strings.HasPrefix(reminder.Name, eventBufferReminderPrefix+"-") {
+			return todo.RunCompletedTrue, o.expireEventBuffer(ctx)
+		}
 		// The assumption is that someone manually deleted the workflow state. This is non-recoverable.
 		log.Warnf("No workflow state found for actor '%s', terminating execution", o.actorID)
 		return todo.RunCompletedTrue, nil
@@ -105,13 +108,26 @@ func (o *orchestrator) runWorkflow(ctx context.Context, reminder *actorapi.Remin
 		// which will skip recording metrics for this execution.
 		executionStatus = ""
 	}
-	workflowName := o.getExecutionStartedEvent(state).GetName()
+	startedEvent := o.getExecutionStartedEvent(state)
+	workflowName := startedEvent.GetName()
+
+	// Child workflow executions wait to fit within the concurrency limits of
+	// their parent workflow, and hold their slot until the execution returns.
+	releaseChild := func() {}
+	if parent := startedEvent.GetParentInstance(); parent != nil {
+		releaseChild, err = o.childThrottle.Acquire(ctx, parent.GetName().GetValue())
+		if err != nil {
+			return todo.RunCompletedFalse, wferrors.NewRecoverable(fmt.Errorf("timed-out waiting for the child workflow concurrency limit: %w", err))
+		}
+	}
+
 	// Request to execute workflow
 	log.Debugf("Workflow actor '%s': scheduling workflow execution with instanceId '%s'", o.actorID, wi.InstanceID)
 	// Schedule the workflow execution by signaling the backend
 	// TODO: @joshvanl remove.
 	err = o.scheduler(ctx, wi)
 	if err != nil {
+		releaseChild()
 		if errors.Is(err, context.DeadlineExceeded) {
 			return todo.RunCompletedFalse, wferrors.NewRecoverable(fmt.Errorf("timed-out trying to schedule a workflow execution - this can happen if there are too many in-flight workflows or if the workflow engine isn't running: %w", err))
 		}
@@ -130,10 +146,12 @@ func (o *orchestrator) runWorkflow(ctx context.Context, reminder *actorapi.Remin
 
 	select {
 	case <-ctx.Done(): // caller is responsible for timeout management
+		releaseChild()
 		// Workflow execution failed with recoverable error
 		executionStatus = diag.StatusRecoverable
 		return todo.RunCompletedFalse, ctx.Err()
 	case completed := <-callback:
+		releaseChild()
 		if !completed {
 			// Workflow execution failed with recoverable error
 			executionStatus = diag.StatusRecoverable
@@ -156,7 +174,13 @@ func (o *orchestrator) runWorkflow(ctx context.Context, reminder *actorapi.Remin
 		}
 	}
 
-	err = o.callActivities(ctx, rs.GetPendingTasks(), state)
+	tasks, reachedCheckpoint, err := o.applyCheckpoint(ctx, rs.GetPendingTasks(), state.Inbox)
+	if err != nil {
+		executionStatus = diag.StatusRecoverable
+		return todo.RunCompletedFalse, wferrors.NewRecoverable(err)
+	}
+
+	err = o.callActivities(ctx, tasks, state)
 	if err != nil {
 		executionStatus = diag.StatusRecoverable
 		return todo.RunCompletedFalse, err
@@ -194,6 +218,12 @@ func (o *orchestrator) runWorkflow(ctx context.Context, reminder *actorapi.Remin
 		return todo.RunCompletedFalse, err
 	}
 
+	if reachedCheckpoint {
+		if err = o.suspendAtCheckpoint(ctx); err != nil {
+			return todo.RunCompletedFalse, err
+		}
+	}
+
 	rstatus := runtimestate.RuntimeStatus(rs)
 	if executionStatus != "" {
 		// If workflow is not completed, set executionStatus to empty string
@@ -212,6 +242,9 @@ func (o *orchestrator) runWorkflow(ctx context.Context, reminder *actorapi.Remin
 
 	if runtimestate.IsCompleted(rs) {
 		log.Infof("Workflow Actor '%s': workflow completed with status '%s' workflowName '%s'", o.actorID, rstatus, workflowName)
+		if executionStatus != "" {
+			diag.DefaultWorkflowMonitoring.WorkflowCompleted(ctx, workflowName, strings.ToLower(strings.TrimPrefix(rstatus.String(), "ORCHESTRATION_STATUS_")))
+		}
 		if err = o.handleRetention(ctx, rstatus); err != nil {
 			return todo.RunCompletedFalse, err
 		}
@@ -255,30 +288,7 @@ func (*orchestrator) recordWorkflowSchedulingLatency(ctx context.Context, esHist
 }
 
 func (o *orchestrator) handleRetention(ctx context.Context, status protos.OrchestrationStatus) error {
-	if o.retentionPolicy == nil {
-		return nil
-	}
-
-	var dueTime *time.Duration
-	var name string
-	switch {
-	case o.retentionPolicy.Completed != nil &&
-		status == protos.OrchestrationStatus_ORCHESTRATION_STATUS_COMPLETED:
-		dueTime = o.retentionPolicy.Completed
-		name = "completed"
-	case o.retentionPolicy.Terminated != nil &&
-		status == protos.OrchestrationStatus_ORCHESTRATION_STATUS_TERMINATED:
-		dueTime = o.retentionPolicy.Terminated
-		name = "terminated"
-	case o.retentionPolicy.Failed != nil &&
-		status == protos.OrchestrationStatus_ORCHESTRATION_STATUS_FAILED:
-		dueTime = o.retentionPolicy.Failed
-		name = "failed"
-	case o.retentionPolicy.AnyTerminal != nil:
-		dueTime = o.retentionPolicy.AnyTerminal
-		name = "anyterminal"
-	}
-
+	dueTime, name := o.retentionPolicy.TTL(wfenginestate.RuntimeStatusString(status))
 	if dueTime != nil {
 		log.Debugf("Workflow actor '%s': setting retention reminder for status '%s' with due time '%v'", o.actorID, status.String(), dueTime)
 		_, err := o.createRetentionReminder(ctx, name, time.Now().Add(*dueTime))
@@ -16,11 +16,13 @@ package orchestrator
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	actorsapi "github.com/dapr/dapr/pkg/actors/api"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
 	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
 	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
@@ -68,9 +70,11 @@ func (o *orchestrator) saveInternalState(ctx context.Context, state *wfenginesta
 
 	log.Debugf("Workflow actor '%s': saving %d keys to actor state store", o.actorID, len(req.Operations))
 
+	start := time.Now()
 	if err = o.actorState.TransactionalStateOperation(ctx, true, req, false); err != nil {
 		return err
 	}
+	diag.DefaultWorkflowMonitoring.HistoryAppendLatency(ctx, o.getExecutionStartedEvent(state).GetName(), diag.ElapsedSince(start))
 
 	// ResetChangeTracking should always be called after a save operation succeeds
 	state.ResetChangeTracking()
@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package starter
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/dapr/pkg/actors/targets"
+	"github.com/dapr/durabletask-go/api/protos"
+)
+
+var starterCache = &sync.Pool{
+	New: func() any {
+		return new(starter)
+	},
+}
+
+type Options struct {
+	ActorType string
+	// Start starts a workflow instance.
+	Start func(context.Context, *protos.CreateInstanceRequest) error
+}
+
+type factory struct {
+	actorType string
+	start     func(context.Context, *protos.CreateInstanceRequest) error
+	clock     clock.Clock
+}
+
+// New returns the factory of the actors starting the workflow instances on a
+// schedule. The ID of the actor is the ID of the schedule, which is a
+// reminder of the actor triggered by the Scheduler service.
+func New(opts Options) targets.Factory {
+	return &factory{
+		actorType: opts.ActorType,
+		start:     opts.Start,
+		clock:     clock.RealClock{},
+	}
+}
+
+func (f *factory) GetOrCreate(actorID string) targets.Interface {
+	s := starterCache.Get().(*starter)
+	s.factory = f
+	s.actorID = actorID
+	return s
+}
+
+func (f *factory) HaltAll(context.Context) error {
+	return nil
+}
+
+func (f *factory) HaltNonHosted(context.Context) error {
+	return nil
+}
+
+func (f *factory) Exists(actorID string) bool {
+	return false
+}
+
+func (f *factory) Len() int {
+	return 0
+}
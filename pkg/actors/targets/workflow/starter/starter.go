@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package starter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	actorapi "github.com/dapr/dapr/pkg/actors/api"
+	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/schedule"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.runtime.actors.targets.starter")
+
+type starter struct {
+	*factory
+	actorID string
+}
+
+func (s *starter) InvokeMethod(context.Context, *internalsv1pb.InternalInvokeRequest) (*internalsv1pb.InternalInvokeResponse, error) {
+	return nil, errors.New("invoke not implemented")
+}
+
+func (s *starter) InvokeReminder(ctx context.Context, reminder *actorapi.Reminder) error {
+	defer s.Deactivate(ctx)
+
+	var req protos.CreateInstanceRequest
+	if err := reminder.Data.UnmarshalTo(&req); err != nil {
+		// The schedule can't be recovered, so it isn't retried.
+		log.Errorf("Invalid workflow schedule %s: %s", s.actorID, err)
+		return nil
+	}
+
+	start := proto.Clone(&req).(*protos.CreateInstanceRequest)
+	start.InstanceId = schedule.InstanceID(&req, s.actorID, s.clock.Now())
+
+	log.Debugf("Starting workflow '%s' instance %s on schedule %s", start.GetName(), start.GetInstanceId(), s.actorID)
+	if err := s.start(ctx, start); err != nil {
+		return fmt.Errorf("failed to start workflow instance %s on schedule %s: %w", start.GetInstanceId(), s.actorID, err)
+	}
+
+	return nil
+}
+
+func (s *starter) InvokeTimer(ctx context.Context, reminder *actorapi.Reminder) error {
+	return errors.New("timers are not implemented")
+}
+
+func (s *starter) Deactivate(context.Context) error {
+	starterCache.Put(s)
+	return nil
+}
+
+func (s *starter) InvokeStream(ctx context.Context,
+	req *internalsv1pb.InternalInvokeRequest,
+	stream func(*internalsv1pb.InternalInvokeResponse) (bool, error),
+) error {
+	return errors.New("invoke stream is not implemented")
+}
+
+func (s *starter) Key() string {
+	return s.actorType + actorapi.DaprSeparator + s.actorID
+}
+
+func (s *starter) Type() string {
+	return s.actorType
+}
+
+func (s *starter) ID() string {
+	return s.actorID
+}
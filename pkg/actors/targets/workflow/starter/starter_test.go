@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package starter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	actorapi "github.com/dapr/dapr/pkg/actors/api"
+	"github.com/dapr/durabletask-go/api/protos"
+)
+
+func TestInvokeReminder(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC)
+
+	newFactory := func(start func(context.Context, *protos.CreateInstanceRequest) error) *factory {
+		f := New(Options{ActorType: "starter", Start: start}).(*factory)
+		f.clock = clocktesting.NewFakeClock(now)
+		return f
+	}
+
+	reminder := func(t *testing.T, req *protos.CreateInstanceRequest) *actorapi.Reminder {
+		data, err := anypb.New(req)
+		require.NoError(t, err)
+		return &actorapi.Reminder{Name: "schedule", ActorType: "starter", ActorID: "nightly", Data: data}
+	}
+
+	t.Run("unique instance IDs", func(t *testing.T) {
+		var got *protos.CreateInstanceRequest
+		f := newFactory(func(_ context.Context, req *protos.CreateInstanceRequest) error {
+			got = req
+			return nil
+		})
+
+		err := f.GetOrCreate("nightly").InvokeReminder(t.Context(), reminder(t, &protos.CreateInstanceRequest{
+			Name:  "report",
+			Input: wrapperspb.String(`"x"`),
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, "nightly-20260301123000", got.GetInstanceId())
+		assert.Equal(t, "report", got.GetName())
+		assert.Equal(t, `"x"`, got.GetInput().GetValue())
+	})
+
+	t.Run("instance ID of the schedule", func(t *testing.T) {
+		var got *protos.CreateInstanceRequest
+		f := newFactory(func(_ context.Context, req *protos.CreateInstanceRequest) error {
+			got = req
+			return nil
+		})
+
+		err := f.GetOrCreate("nightly").InvokeReminder(t.Context(), reminder(t, &protos.CreateInstanceRequest{
+			Name:       "report",
+			InstanceId: "nightly",
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, "nightly", got.GetInstanceId())
+	})
+
+	t.Run("start failure is retried", func(t *testing.T) {
+		f := newFactory(func(context.Context, *protos.CreateInstanceRequest) error {
+			return errors.New("boom")
+		})
+
+		err := f.GetOrCreate("nightly").InvokeReminder(t.Context(), reminder(t, &protos.CreateInstanceRequest{Name: "report"}))
+		require.Error(t, err)
+	})
+
+	t.Run("invalid schedule isn't retried", func(t *testing.T) {
+		var called bool
+		f := newFactory(func(context.Context, *protos.CreateInstanceRequest) error {
+			called = true
+			return nil
+		})
+
+		data, err := anypb.New(wrapperspb.String("x"))
+		require.NoError(t, err)
+		err = f.GetOrCreate("nightly").InvokeReminder(t.Context(), &actorapi.Reminder{Name: "schedule", Data: data})
+		require.NoError(t, err)
+		assert.False(t, called)
+	})
+}
@@ -21,17 +21,20 @@ import (
 	"github.com/dapr/dapr/pkg/actors/targets/workflow/executor"
 	"github.com/dapr/dapr/pkg/actors/targets/workflow/orchestrator"
 	"github.com/dapr/dapr/pkg/actors/targets/workflow/retentioner"
+	"github.com/dapr/dapr/pkg/actors/targets/workflow/starter"
 )
 
 type Options struct {
 	Orchestrator orchestrator.Options
 	Activity     activity.Options
 	Retentioner  retentioner.Options
+	Starter      starter.Options
 	Executor     *executor.Options
 
 	WorkflowActorType  string
 	ActivityActorType  string
 	RetentionActorType string
+	StarterActorType   string
 	ExecutorActorType  string
 }
 
@@ -64,6 +67,10 @@ func Factories(ctx context.Context, opts Options) ([]table.ActorTypeFactory, err
 			Factory: retentionerFactory,
 			Type:    opts.RetentionActorType,
 		},
+		{
+			Factory: starter.New(opts.Starter),
+			Type:    opts.StarterActorType,
+		},
 	}
 
 	if opts.Executor != nil {
@@ -15,37 +15,44 @@ package timers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"k8s.io/utils/clock"
 
 	"github.com/dapr/dapr/pkg/actors/api"
+	"github.com/dapr/dapr/pkg/actors/internal/placement"
 	internaltimers "github.com/dapr/dapr/pkg/actors/internal/timers"
 	"github.com/dapr/dapr/pkg/actors/table"
 )
 
+var ErrTimerOpActorReadReplica = errors.New("timers can't be created by a read replica of an actor")
+
 type Interface interface {
 	Create(ctx context.Context, req *api.CreateTimerRequest) error
 	Delete(ctx context.Context, req *api.DeleteTimerRequest)
 }
 
 type Options struct {
-	Storage internaltimers.Storage
-	Table   table.Interface
+	Storage   internaltimers.Storage
+	Table     table.Interface
+	Placement placement.Interface
 }
 
 // Implements a timers provider.
 type timers struct {
-	storage internaltimers.Storage
-	table   table.Interface
-	clock   clock.Clock
+	storage   internaltimers.Storage
+	table     table.Interface
+	placement placement.Interface
+	clock     clock.Clock
 }
 
 func New(opts Options) Interface {
 	return &timers{
-		storage: opts.Storage,
-		table:   opts.Table,
-		clock:   clock.RealClock{},
+		storage:   opts.Storage,
+		table:     opts.Table,
+		placement: opts.Placement,
+		clock:     clock.RealClock{},
 	}
 }
 
@@ -54,6 +61,18 @@ func (t *timers) Create(ctx context.Context, req *api.CreateTimerRequest) error
 		return fmt.Errorf("can't create timer for actor %s: actor type not registered", req.ActorKey())
 	}
 
+	if t.placement != nil {
+		ctx, cancel, err := t.placement.Lock(ctx)
+		if err != nil {
+			return err
+		}
+		readReplica := t.placement.IsReadReplica(ctx, req.ActorType, req.ActorID)
+		cancel()
+		if readReplica {
+			return ErrTimerOpActorReadReplica
+		}
+	}
+
 	reminder, err := req.NewReminder(t.clock.Now(), false)
 	if err != nil {
 		return err
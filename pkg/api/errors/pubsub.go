@@ -93,6 +93,51 @@ func (p *PubSubError) PublishForbidden(topic, appID string, err error) error {
 	)
 }
 
+func (p *PubSubError) SchemaValidation(topic string, err error) error {
+	return p.withTopicError(topic, err).build(
+		codes.InvalidArgument,
+		http.StatusBadRequest,
+		fmt.Sprintf("message published to topic %s in pubsub %s does not match the topic schema: %s", topic, p.name, err),
+		errorcodes.PubsubSchemaValidation,
+	)
+}
+
+func (p *PubSubError) Redrive(topic string, err error) error {
+	return p.withTopicError(topic, err).build(
+		codes.Internal,
+		http.StatusInternalServerError,
+		fmt.Sprintf("error when redriving messages from dead letter topic %s in pubsub %s: %s", topic, p.name, err),
+		errorcodes.PubsubRedrive,
+	)
+}
+
+func (p *PubSubError) RedriveRequest(topic string, err error) error {
+	return p.withTopicError(topic, err).build(
+		codes.InvalidArgument,
+		http.StatusBadRequest,
+		fmt.Sprintf("invalid redrive request for dead letter topic %s in pubsub %s: %s", topic, p.name, err),
+		errorcodes.PubsubRedriveRequest,
+	)
+}
+
+func (p *PubSubError) StreamSubscribe(topic string, err error) error {
+	return p.withTopicError(topic, err).build(
+		codes.Internal,
+		http.StatusInternalServerError,
+		fmt.Sprintf("error when subscribing to topic %s in pubsub %s: %s", topic, p.name, err),
+		errorcodes.PubsubStreamSubscribe,
+	)
+}
+
+func (p *PubSubError) StreamAck(topic string, err error) error {
+	return p.withTopicError(topic, err).build(
+		codes.InvalidArgument,
+		http.StatusBadRequest,
+		fmt.Sprintf("invalid acknowledgement of a message of topic %s in pubsub %s: %s", topic, p.name, err),
+		errorcodes.PubsubStreamAck,
+	)
+}
+
 // TestNotFound is specifically for the error we are expecting for the api_tests. The not found
 // expected error codes are different than the existing ones for PubSubNotFound, hence
 // why this one is needed
@@ -125,6 +170,16 @@ func (p *PubSubMetadataError) NotConfigured() error {
 	)
 }
 
+func (p *PubSubMetadataError) StreamNotFound(subscriptionID string) error {
+	p.skipResourceInfo = true
+	return p.build(
+		codes.NotFound,
+		http.StatusNotFound,
+		fmt.Sprintf("streaming subscription %s is not found", subscriptionID),
+		errorcodes.PubsubStreamNotFound,
+	)
+}
+
 func (p *PubSubMetadataError) WithTopic(topic string) *PubSubTopicError {
 	return &PubSubTopicError{
 		p:     p,
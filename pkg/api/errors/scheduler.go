@@ -45,14 +45,21 @@ func SchedulerScheduleJob(metadata map[string]string, err error) error {
 
 	httpCode := grpccodes.HTTPStatusFromCode(code)
 
+	// The scheduler rejects jobs exceeding the quotas of the app or namespace
+	// with ResourceExhausted.
+	errorCode := errorcodes.SchedulerScheduleJob
+	if code == codes.ResourceExhausted {
+		errorCode = errorcodes.SchedulerQuotaExceeded
+	}
+
 	return kiterrors.NewBuilder(
 		code,
 		httpCode,
 		"failed to schedule job due to: "+err.Error(),
 		"",
-		string(errorcodes.SchedulerScheduleJob.Category),
+		string(errorCode.Category),
 	).
-		WithErrorInfo(errorcodes.SchedulerScheduleJob.Code, metadata).
+		WithErrorInfo(errorCode.Code, metadata).
 		Build()
 }
 
@@ -91,3 +98,32 @@ func SchedulerDeleteJob(metadata map[string]string, err error) error {
 		WithErrorInfo(errorcodes.SchedulerDeleteJob.Code, metadata).
 		Build()
 }
+
+// SchedulerPauseJob keeps the code of the scheduler, which returns NotFound if
+// the job doesn't exist and FailedPrecondition if it's already paused.
+func SchedulerPauseJob(metadata map[string]string, err error) error {
+	return schedulerStatusError(errorcodes.SchedulerPauseJob, "failed to pause job due to: ", metadata, err)
+}
+
+// SchedulerResumeJob keeps the code of the scheduler, which returns NotFound
+// if the job isn't paused.
+func SchedulerResumeJob(metadata map[string]string, err error) error {
+	return schedulerStatusError(errorcodes.SchedulerResumeJob, "failed to resume job due to: ", metadata, err)
+}
+
+func schedulerStatusError(errorCode errorcodes.ErrorCode, message string, metadata map[string]string, err error) error {
+	code := status.Code(err)
+	if code == codes.Unknown {
+		code = codes.Internal
+	}
+
+	return kiterrors.NewBuilder(
+		code,
+		grpccodes.HTTPStatusFromCode(code),
+		message+status.Convert(err).Message(),
+		"",
+		string(errorCode.Category),
+	).
+		WithErrorInfo(errorCode.Code, metadata).
+		Build()
+}
@@ -153,6 +153,240 @@ func (s *StateStoreError) QueryFailed(detail string) error {
 	)
 }
 
+func (s *StateStoreError) QueryInvalid(detail string) error {
+	return s.build(
+		errors.NewBuilder(
+			codes.InvalidArgument,
+			http.StatusBadRequest,
+			fmt.Sprintf("invalid query for state store %s: %s", s.name, detail),
+			errorcodes.StateQueryRequest.Code,
+			string(errorcodes.StateQueryRequest.Category),
+		),
+		errorcodes.StateQueryRequest.GrpcCode,
+		nil,
+	)
+}
+
+/**** Atomic operations ****/
+
+func (s *StateStoreError) AtomicNotSupported(operation string) error {
+	return s.build(
+		errors.NewBuilder(
+			codes.Unimplemented,
+			http.StatusInternalServerError,
+			fmt.Sprintf("state store %s doesn't support the atomic %s operation", s.name, operation),
+			errorcodes.StateAtomicNotSupported.Code,
+			string(errorcodes.StateAtomicNotSupported.Category),
+		).WithHelpLink("https://docs.dapr.io/reference/components-reference/supported-state-stores/", "Check the list of state stores and the features they support"),
+		errorcodes.StateAtomicNotSupported.GrpcCode,
+		nil,
+	)
+}
+
+func (s *StateStoreError) AtomicInvalid(operation string, key string, detail string) error {
+	return s.build(
+		errors.NewBuilder(
+			codes.InvalidArgument,
+			http.StatusBadRequest,
+			fmt.Sprintf("invalid atomic %s operation on key %s in state store %s: %s", operation, key, s.name, detail),
+			errorcodes.StateAtomicRequest.Code,
+			string(errorcodes.StateAtomicRequest.Category),
+		),
+		errorcodes.StateAtomicRequest.GrpcCode,
+		nil,
+	)
+}
+
+func (s *StateStoreError) AtomicFailed(operation string, key string, detail string) error {
+	return s.build(
+		errors.NewBuilder(
+			codes.Internal,
+			http.StatusInternalServerError,
+			fmt.Sprintf("atomic %s operation on key %s in state store %s failed: %s", operation, key, s.name, detail),
+			errorcodes.StateAtomic.Code,
+			string(errorcodes.StateAtomic.Category),
+		),
+		errorcodes.StateAtomic.GrpcCode,
+		nil,
+	)
+}
+
+/**** Watch API ****/
+
+func (s *StateStoreError) WatchInvalid(detail string) error {
+	return s.build(
+		errors.NewBuilder(
+			codes.InvalidArgument,
+			http.StatusBadRequest,
+			fmt.Sprintf("invalid request to watch state store %s: %s", s.name, detail),
+			errorcodes.StateWatchRequest.Code,
+			string(errorcodes.StateWatchRequest.Category),
+		),
+		errorcodes.StateWatchRequest.GrpcCode,
+		nil,
+	)
+}
+
+func (s *StateStoreError) WatchFailed(detail string) error {
+	return s.build(
+		errors.NewBuilder(
+			codes.Internal,
+			http.StatusInternalServerError,
+			fmt.Sprintf("failed to watch state store %s: %s", s.name, detail),
+			errorcodes.StateWatch.Code,
+			string(errorcodes.StateWatch.Category),
+		),
+		errorcodes.StateWatch.GrpcCode,
+		nil,
+	)
+}
+
+/**** Re-encryption API ****/
+
+func (s *StateStoreError) ReencryptionInvalid(detail string) error {
+	return s.build(
+		errors.NewBuilder(
+			codes.InvalidArgument,
+			http.StatusBadRequest,
+			fmt.Sprintf("invalid request to re-encrypt state store %s: %s", s.name, detail),
+			errorcodes.StateReencryptionRequest.Code,
+			string(errorcodes.StateReencryptionRequest.Category),
+		),
+		errorcodes.StateReencryptionRequest.GrpcCode,
+		nil,
+	)
+}
+
+func (s *StateStoreError) ReencryptionRunning() error {
+	return s.build(
+		errors.NewBuilder(
+			codes.FailedPrecondition,
+			http.StatusConflict,
+			fmt.Sprintf("a re-encryption of state store %s is already running", s.name),
+			errorcodes.StateReencryptionRunning.Code,
+			string(errorcodes.StateReencryptionRunning.Category),
+		),
+		errorcodes.StateReencryptionRunning.GrpcCode,
+		nil,
+	)
+}
+
+func (s *StateStoreError) ReencryptionNotFound() error {
+	return s.build(
+		errors.NewBuilder(
+			codes.NotFound,
+			http.StatusNotFound,
+			fmt.Sprintf("no re-encryption of state store %s has been started", s.name),
+			errorcodes.StateReencryptionNotFound.Code,
+			string(errorcodes.StateReencryptionNotFound.Category),
+		),
+		errorcodes.StateReencryptionNotFound.GrpcCode,
+		nil,
+	)
+}
+
+/**** Migration API ****/
+
+func (s *StateStoreError) MigrationInvalid(detail string) error {
+	return s.build(
+		errors.NewBuilder(
+			codes.InvalidArgument,
+			http.StatusBadRequest,
+			fmt.Sprintf("invalid request to migrate state store %s: %s", s.name, detail),
+			errorcodes.StateMigrationRequest.Code,
+			string(errorcodes.StateMigrationRequest.Category),
+		),
+		errorcodes.StateMigrationRequest.GrpcCode,
+		nil,
+	)
+}
+
+func (s *StateStoreError) MigrationRunning() error {
+	return s.build(
+		errors.NewBuilder(
+			codes.FailedPrecondition,
+			http.StatusConflict,
+			fmt.Sprintf("a migration of state store %s is already running", s.name),
+			errorcodes.StateMigrationRunning.Code,
+			string(errorcodes.StateMigrationRunning.Category),
+		),
+		errorcodes.StateMigrationRunning.GrpcCode,
+		nil,
+	)
+}
+
+func (s *StateStoreError) MigrationNotFound() error {
+	return s.build(
+		errors.NewBuilder(
+			codes.NotFound,
+			http.StatusNotFound,
+			fmt.Sprintf("no migration of state store %s has been started", s.name),
+			errorcodes.StateMigrationNotFound.Code,
+			string(errorcodes.StateMigrationNotFound.Category),
+		),
+		errorcodes.StateMigrationNotFound.GrpcCode,
+		nil,
+	)
+}
+
+/**** Check-and-set API ****/
+
+func (s *StateStoreError) CheckAndSetNotSupported() error {
+	return s.build(
+		errors.NewBuilder(
+			codes.Unimplemented,
+			http.StatusInternalServerError,
+			fmt.Sprintf("state store %s doesn't support check-and-set", s.name),
+			errorcodes.StateCheckAndSetNotSupported.Code,
+			string(errorcodes.StateCheckAndSetNotSupported.Category),
+		).WithHelpLink("https://docs.dapr.io/reference/components-reference/supported-state-stores/", "Check the list of state stores and the features they support"),
+		errorcodes.StateCheckAndSetNotSupported.GrpcCode,
+		nil,
+	)
+}
+
+func (s *StateStoreError) CheckAndSetInvalid(detail string) error {
+	return s.build(
+		errors.NewBuilder(
+			codes.InvalidArgument,
+			http.StatusBadRequest,
+			fmt.Sprintf("invalid check-and-set request for state store %s: %s", s.name, detail),
+			errorcodes.StateCheckAndSetRequest.Code,
+			string(errorcodes.StateCheckAndSetRequest.Category),
+		),
+		errorcodes.StateCheckAndSetRequest.GrpcCode,
+		nil,
+	)
+}
+
+func (s *StateStoreError) CheckAndSetConflict(detail string) error {
+	return s.build(
+		errors.NewBuilder(
+			codes.Aborted,
+			http.StatusConflict,
+			fmt.Sprintf("check-and-set on state store %s was not applied: %s", s.name, detail),
+			errorcodes.StateCheckAndSetConflict.Code,
+			string(errorcodes.StateCheckAndSetConflict.Category),
+		),
+		errorcodes.StateCheckAndSetConflict.GrpcCode,
+		nil,
+	)
+}
+
+func (s *StateStoreError) CheckAndSetFailed(detail string) error {
+	return s.build(
+		errors.NewBuilder(
+			codes.Internal,
+			http.StatusInternalServerError,
+			fmt.Sprintf("check-and-set on state store %s failed: %s", s.name, detail),
+			errorcodes.StateCheckAndSet.Code,
+			string(errorcodes.StateCheckAndSet.Category),
+		),
+		errorcodes.StateCheckAndSet.GrpcCode,
+		nil,
+	)
+}
+
 func (s *StateStoreError) build(err *errors.ErrorBuilder, errCode string, metadata map[string]string) error {
 	if !s.skipResourceInfo {
 		err = err.WithResourceInfo("state", s.name, "", "")
@@ -9,6 +9,7 @@ import (
 
 	"github.com/dapr/dapr/pkg/api/grpc/metadata"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/dapr/pkg/security/apitoken"
 )
 
 type wrappedStream struct {
@@ -20,16 +21,16 @@ func (s *wrappedStream) Context() context.Context {
 	return s.ctx
 }
 
-func getAPIAuthenticationMiddlewares(apiToken, authHeader string) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+func getAPIAuthenticationMiddlewares(apiTokens *apitoken.Tokens, authHeader string) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
-			authCtx, err := checkAPITokenInContext(ctx, apiToken, authHeader)
+			authCtx, err := checkAPITokenInContext(ctx, apiTokens, authHeader)
 			if err != nil {
 				return nil, err
 			}
 			return handler(authCtx, req)
 		},
 		func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
-			authCtx, err := checkAPITokenInContext(stream.Context(), apiToken, authHeader)
+			authCtx, err := checkAPITokenInContext(stream.Context(), apiTokens, authHeader)
 			if err != nil {
 				return err
 			}
@@ -39,7 +40,7 @@ func getAPIAuthenticationMiddlewares(apiToken, authHeader string) (grpc.UnarySer
 }
 
 // Checks if the API token in the gRPC request's context is valid; returns an error otherwise.
-func checkAPITokenInContext(ctx context.Context, apiToken, authHeader string) (context.Context, error) {
+func checkAPITokenInContext(ctx context.Context, apiTokens *apitoken.Tokens, authHeader string) (context.Context, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return ctx, invokev1.ErrorFromHTTPResponseCode(http.StatusUnauthorized, "missing metadata in request")
@@ -49,7 +50,7 @@ func checkAPITokenInContext(ctx context.Context, apiToken, authHeader string) (c
 		return ctx, invokev1.ErrorFromHTTPResponseCode(http.StatusUnauthorized, "missing api token in request metadata")
 	}
 
-	if md[authHeader][0] != apiToken {
+	if !apiTokens.Valid(md[authHeader][0]) {
 		return ctx, invokev1.ErrorFromHTTPResponseCode(http.StatusUnauthorized, "authentication error: api token mismatch")
 	}
 
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"fmt"
+	"net/http"
+
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+)
+
+// callLocalStreamWriter forwards the response of the app to the caller of CallLocalStream as it's written, so the body is never held in memory.
+// The leading message contains the status code and headers of the response, and each write is sent in the payload of a message.
+type callLocalStreamWriter struct {
+	stream      internalv1pb.ServiceInvocation_CallLocalStreamServer //nolint:nosnakecase
+	header      http.Header
+	statusCode  int32
+	wroteHeader bool
+	seq         uint64
+	err         error
+}
+
+func (w *callLocalStreamWriter) Header() http.Header {
+	if w.header == nil {
+		w.header = make(http.Header)
+	}
+	return w.header
+}
+
+func (w *callLocalStreamWriter) WriteHeader(statusCode int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+
+	//nolint:gosec
+	w.statusCode = int32(statusCode)
+	resp := invokev1.NewInvokeMethodResponse(w.statusCode, "", nil).
+		WithHTTPHeaders(w.Header()).
+		WithContentType(w.Header().Get("content-type"))
+	defer resp.Close()
+	err := w.stream.Send(&internalv1pb.InternalInvokeResponseStream{
+		Response: resp.Proto(),
+	})
+	if err != nil {
+		w.err = fmt.Errorf("error sending message: %w", err)
+	}
+}
+
+func (w *callLocalStreamWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.err != nil {
+		return 0, w.err
+	}
+	if len(b) == 0 {
+		return 0, nil
+	}
+
+	err := w.stream.Send(&internalv1pb.InternalInvokeResponseStream{
+		Payload: &commonv1pb.StreamPayload{
+			Data: b,
+			Seq:  w.seq,
+		},
+	})
+	if err != nil {
+		w.err = fmt.Errorf("error sending message: %w", err)
+		return 0, w.err
+	}
+	w.seq++
+	return len(b), nil
+}
+
+// Flush sends the headers if they weren't sent yet; data is sent as soon as it's written.
+func (w *callLocalStreamWriter) Flush() {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+}
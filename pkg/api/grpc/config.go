@@ -15,14 +15,15 @@ package grpc
 
 // ServerConfig is the config object for a grpc server.
 type ServerConfig struct {
-	AppID              string
-	HostAddress        string
-	Port               int
-	APIListenAddresses []string
-	NameSpace          string
-	TrustDomain        string
-	MaxRequestBodySize int // In bytes
-	ReadBufferSize     int // In bytes
-	UnixDomainSocket   string
-	EnableAPILogging   bool
+	AppID                    string
+	HostAddress              string
+	Port                     int
+	APIListenAddresses       []string
+	NameSpace                string
+	TrustDomain              string
+	MaxRequestBodySize       int            // In bytes
+	MaxRequestBodySizePerAPI map[string]int // In bytes, keyed by API group; 0 means no limit
+	ReadBufferSize           int            // In bytes
+	UnixDomainSocket         string
+	EnableAPILogging         bool
 }
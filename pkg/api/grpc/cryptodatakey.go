@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/dapr/dapr/pkg/api/universal"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+)
+
+// GenerateDataKeyAlpha1 returns a data encryption key, in plaintext and
+// wrapped with a key of the vault.
+func (a *api) GenerateDataKeyAlpha1(ctx context.Context, in *runtimev1pb.GenerateDataKeyRequestAlpha1) (*runtimev1pb.GenerateDataKeyResponseAlpha1, error) {
+	key, err := a.Universal.CryptoGenerateDataKey(ctx, universal.CryptoDataKeyRequest{
+		ComponentName: in.GetComponentName(),
+		KeyName:       in.GetKeyName(),
+		Algorithm:     in.GetAlgorithm(),
+	})
+	if err != nil {
+		// Error has already been logged
+		return nil, err
+	}
+
+	return &runtimev1pb.GenerateDataKeyResponseAlpha1{
+		KeyName:      key.KeyName,
+		Algorithm:    key.Algorithm,
+		PlaintextKey: key.PlaintextKey,
+		WrappedKey:   key.WrappedKey,
+		Tag:          key.Tag,
+	}, nil
+}
+
+// RewrapKeyAlpha1 unwraps a wrapped key and wraps it again with a key of the
+// vault.
+func (a *api) RewrapKeyAlpha1(ctx context.Context, in *runtimev1pb.RewrapKeyRequestAlpha1) (*runtimev1pb.RewrapKeyResponseAlpha1, error) {
+	wrappedKey, tag, err := a.Universal.CryptoRewrapKey(ctx, universal.CryptoRewrapKeyRequest{
+		ComponentName: in.GetComponentName(),
+		KeyName:       in.GetKeyName(),
+		Algorithm:     in.GetAlgorithm(),
+		WrappedKey:    in.GetWrappedKey(),
+		Tag:           in.GetTag(),
+		NewKeyName:    in.GetNewKeyName(),
+		NewAlgorithm:  in.GetNewAlgorithm(),
+	})
+	if err != nil {
+		// Error has already been logged
+		return nil, err
+	}
+
+	return &runtimev1pb.RewrapKeyResponseAlpha1{
+		WrappedKey: wrappedKey,
+		Tag:        tag,
+	}, nil
+}
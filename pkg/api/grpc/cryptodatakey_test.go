@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dapr/dapr/pkg/api/universal"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/datakeycache"
+	daprt "github.com/dapr/dapr/pkg/testing"
+	"github.com/dapr/kit/ptr"
+)
+
+func TestCryptoDataKeyAlpha1(t *testing.T) {
+	compStore := compstore.New()
+	compStore.AddCryptoProvider("myvault", &daprt.FakeSubtleCrypto{})
+	cache, err := datakeycache.New(datakeycache.Options{Metadata: map[string]string{"dataKeyCacheTTL": "1m"}})
+	require.NoError(t, err)
+	compStore.AddCryptoDataKeyCache("myvault", cache)
+
+	lis := startTestServerAPI(t, &api{
+		logger: apiServerLogger,
+		Universal: universal.New(universal.Options{
+			Logger:     apiServerLogger,
+			Resiliency: resiliency.New(nil),
+			CompStore:  compStore,
+		}),
+	})
+
+	clientConn := createTestClient(lis)
+	defer clientConn.Close()
+
+	client := runtimev1pb.NewDaprClient(clientConn)
+
+	var dataKey *runtimev1pb.GenerateDataKeyResponseAlpha1
+	t.Run("generate data key", func(t *testing.T) {
+		dataKey, err = client.GenerateDataKeyAlpha1(t.Context(), &runtimev1pb.GenerateDataKeyRequestAlpha1{
+			ComponentName: "myvault",
+			KeyName:       "aes-passthrough",
+			Algorithm:     "A256KW",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "aes-passthrough", dataKey.GetKeyName())
+		assert.Equal(t, "A256KW", dataKey.GetAlgorithm())
+		assert.Len(t, dataKey.GetPlaintextKey(), 32)
+		assert.Equal(t, dataKey.GetPlaintextKey(), dataKey.GetWrappedKey())
+
+		// The data key is cached.
+		again, err := client.GenerateDataKeyAlpha1(t.Context(), &runtimev1pb.GenerateDataKeyRequestAlpha1{
+			ComponentName: "myvault",
+			KeyName:       "aes-passthrough",
+			Algorithm:     "A256KW",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, dataKey.GetPlaintextKey(), again.GetPlaintextKey())
+	})
+
+	t.Run("generate data key without key name", func(t *testing.T) {
+		_, err := client.GenerateDataKeyAlpha1(t.Context(), &runtimev1pb.GenerateDataKeyRequestAlpha1{
+			ComponentName: "myvault",
+			Algorithm:     "A256KW",
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("rewrap key", func(t *testing.T) {
+		res, err := client.RewrapKeyAlpha1(t.Context(), &runtimev1pb.RewrapKeyRequestAlpha1{
+			ComponentName: "myvault",
+			KeyName:       "aes-passthrough",
+			Algorithm:     "A256KW",
+			WrappedKey:    dataKey.GetWrappedKey(),
+			NewAlgorithm:  ptr.Of("A256KW"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, dataKey.GetPlaintextKey(), res.GetWrappedKey())
+		assert.Empty(t, res.GetTag())
+
+		// Re-wrapping evicts the cached data keys of the new key.
+		newKey, err := client.GenerateDataKeyAlpha1(t.Context(), &runtimev1pb.GenerateDataKeyRequestAlpha1{
+			ComponentName: "myvault",
+			KeyName:       "aes-passthrough",
+			Algorithm:     "A256KW",
+		})
+		require.NoError(t, err)
+		assert.NotEqual(t, dataKey.GetPlaintextKey(), newKey.GetPlaintextKey())
+	})
+
+	t.Run("rewrap key with provider error", func(t *testing.T) {
+		_, err := client.RewrapKeyAlpha1(t.Context(), &runtimev1pb.RewrapKeyRequestAlpha1{
+			ComponentName: "myvault",
+			KeyName:       "error",
+			Algorithm:     "A256KW",
+			WrappedKey:    []byte{1, 2, 3},
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
@@ -28,6 +28,7 @@ import (
 	actorapi "github.com/dapr/dapr/pkg/actors/api"
 	actorerrors "github.com/dapr/dapr/pkg/actors/errors"
 	"github.com/dapr/dapr/pkg/api/grpc/metadata"
+	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	diagConsts "github.com/dapr/dapr/pkg/diagnostics/consts"
 	"github.com/dapr/dapr/pkg/messages"
@@ -40,7 +41,13 @@ import (
 
 // CallLocal is used for internal dapr to dapr calls. It is invoked by another Dapr instance with a request to the local app.
 func (a *api) CallLocal(ctx context.Context, in *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error) {
-	appChannel := a.channels.AppChannel()
+	untrack, ok := a.Universal.Drainer().Track()
+	if !ok {
+		return nil, messages.ErrDraining
+	}
+	defer untrack()
+
+	appChannel := a.channels.AppChannelFor(config.AppCallbackInvocation)
 	if appChannel == nil {
 		return nil, status.Error(codes.Internal, messages.ErrChannelNotFound)
 	}
@@ -81,7 +88,13 @@ func (a *api) CallLocal(ctx context.Context, in *internalv1pb.InternalInvokeRequ
 // CallLocalStream is a variant of CallLocal that uses gRPC streams to send data in chunks, rather than in an unary RPC.
 // It is invoked by another Dapr instance with a request to the local app.
 func (a *api) CallLocalStream(stream internalv1pb.ServiceInvocation_CallLocalStreamServer) error { //nolint:nosnakecase
-	appChannel := a.channels.AppChannel()
+	untrack, ok := a.Universal.Drainer().Track()
+	if !ok {
+		return messages.ErrDraining
+	}
+	defer untrack()
+
+	appChannel := a.channels.AppChannelFor(config.AppCallbackInvocation)
 	if appChannel == nil {
 		return status.Error(codes.Internal, messages.ErrChannelNotFound)
 	}
@@ -189,6 +202,8 @@ func (a *api) CallLocalStream(stream internalv1pb.ServiceInvocation_CallLocalStr
 
 	isSSERequest, header := sse.IsSSEGrpcRequest(chunk.GetRequest())
 
+	// Channels which support it forward successful responses to the caller as they are read from the app
+	var streamWriter *callLocalStreamWriter
 	if isSSERequest {
 		req.WithHTTPResponseWriter(&streamResponseWriter{
 			logger: a.logger,
@@ -196,6 +211,9 @@ func (a *api) CallLocalStream(stream internalv1pb.ServiceInvocation_CallLocalStr
 			stream: stream,
 			appID:  a.AppID(),
 		})
+	} else {
+		streamWriter = &callLocalStreamWriter{stream: stream}
+		req.WithHTTPResponseWriter(streamWriter)
 	}
 
 	// Submit the request to the app
@@ -214,6 +232,12 @@ func (a *api) CallLocalStream(stream internalv1pb.ServiceInvocation_CallLocalStr
 		return sse.HandleSSEGrpcResponse(res)
 	}
 
+	if res == nil && streamWriter.wroteHeader {
+		// The response was already sent by the channel
+		statusCode = streamWriter.statusCode
+		return nil
+	}
+
 	if res == nil {
 		return status.Errorf(codes.Internal, messages.ErrChannelInvoke, errors.New("no response received from stream"))
 	}
@@ -297,6 +321,12 @@ func (a *api) CallLocalStream(stream internalv1pb.ServiceInvocation_CallLocalStr
 
 // CallActor invokes a virtual actor.
 func (a *api) CallActor(ctx context.Context, in *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error) {
+	untrack, ok := a.Universal.Drainer().Track()
+	if !ok {
+		return nil, messages.ErrDraining
+	}
+	defer untrack()
+
 	// We don't do resiliency here as it is handled in the API layer. See InvokeActor().
 	var res *internalv1pb.InternalInvokeResponse
 	router, err := a.ActorRouter(ctx)
@@ -351,6 +381,12 @@ func (a *api) CallActorReminder(ctx context.Context, in *internalv1pb.Reminder)
 }
 
 func (a *api) CallActorStream(req *internalv1pb.InternalInvokeRequest, stream internalv1pb.ServiceInvocation_CallActorStreamServer) error {
+	untrack, ok := a.Universal.Drainer().Track()
+	if !ok {
+		return messages.ErrDraining
+	}
+	defer untrack()
+
 	router, err := a.ActorRouter(stream.Context())
 	if err != nil {
 		return err
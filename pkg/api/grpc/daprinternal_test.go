@@ -16,7 +16,9 @@ package grpc
 import (
 	"errors"
 	"io"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -30,6 +32,7 @@ import (
 	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
 	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	"github.com/dapr/dapr/pkg/runtime/channels"
+	"github.com/dapr/dapr/pkg/runtime/drain"
 )
 
 func TestCallLocal(t *testing.T) {
@@ -99,6 +102,31 @@ func TestCallLocal(t *testing.T) {
 		_, err := client.CallLocal(t.Context(), request.Proto())
 		assert.Equal(t, codes.Internal, status.Code(err))
 	})
+
+	t.Run("sidecar is draining", func(t *testing.T) {
+		mockAppChannel := new(channelt.MockAppChannel)
+		drainer := drain.New(drain.Options{})
+		drainer.Drain(time.Second)
+		fakeAPI := &api{
+			Universal: universal.New(universal.Options{
+				AppID:   "fakeAPI",
+				Drainer: drainer,
+			}),
+			channels: (new(channels.Channels)).WithAppChannel(mockAppChannel),
+		}
+		server, lis := startInternalServer(fakeAPI)
+		defer server.Stop()
+		clientConn := createTestClient(lis)
+		defer clientConn.Close()
+
+		client := internalv1pb.NewServiceInvocationClient(clientConn)
+		request := invokev1.NewInvokeMethodRequest("method")
+		defer request.Close()
+
+		_, err := client.CallLocal(t.Context(), request.Proto())
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		mockAppChannel.AssertNotCalled(t, "InvokeMethod", mock.Anything, mock.Anything)
+	})
 }
 
 func TestCallLocalStream(t *testing.T) {
@@ -207,6 +235,65 @@ func TestCallLocalStream(t *testing.T) {
 		_, err = st.Recv()
 		assert.Equal(t, codes.Internal, status.Code(err))
 	})
+
+	t.Run("response forwarded by the app channel", func(t *testing.T) {
+		mockAppChannel := new(channelt.MockAppChannel)
+		mockAppChannel.
+			On(
+				"InvokeMethod",
+				mock.MatchedBy(matchContextInterface),
+				mock.AnythingOfType("*v1.InvokeMethodRequest"),
+			).
+			Run(func(args mock.Arguments) {
+				w := args.Get(1).(*invokev1.InvokeMethodRequest).HTTPResponseWriter()
+				w.Header().Set("Content-Type", "text/plain")
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte("hello "))
+				w.Write([]byte("world"))
+			}).
+			Return(nil, nil)
+		fakeAPI := &api{
+			Universal: universal.New(universal.Options{
+				AppID: "fakeAPI",
+			}),
+			channels: (new(channels.Channels)).WithAppChannel(mockAppChannel),
+		}
+		server, lis := startInternalServer(fakeAPI)
+		defer server.Stop()
+		clientConn := createTestClient(lis)
+		defer clientConn.Close()
+
+		client := internalv1pb.NewServiceInvocationClient(clientConn)
+		st, err := client.CallLocalStream(t.Context())
+		require.NoError(t, err)
+
+		request := invokev1.NewInvokeMethodRequest("method").
+			WithHTTPExtension(http.MethodGet, "").
+			WithMetadata(map[string][]string{invokev1.DestinationIDHeader: {"foo"}})
+		defer request.Close()
+		err = st.Send(&internalv1pb.InternalInvokeRequestStream{
+			Request: request.Proto(),
+		})
+		require.NoError(t, err)
+		err = st.CloseSend()
+		require.NoError(t, err)
+
+		msg, err := st.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, int32(http.StatusCreated), msg.GetResponse().GetStatus().GetCode())
+		assert.Equal(t, "text/plain", msg.GetResponse().GetMessage().GetContentType())
+
+		for i, data := range []string{"hello ", "world"} {
+			msg, err = st.Recv()
+			require.NoError(t, err)
+			assert.Nil(t, msg.GetResponse())
+			assert.Equal(t, data, string(msg.GetPayload().GetData()))
+			assert.Equal(t, uint64(i), msg.GetPayload().GetSeq())
+		}
+
+		_, err = st.Recv()
+		assert.ErrorIs(t, err, io.EOF)
+	})
 }
 
 func TestCallRemoteAppWithTracing(t *testing.T) {
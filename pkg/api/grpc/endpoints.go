@@ -19,17 +19,31 @@ import (
 	"strings"
 
 	"google.golang.org/grpc"
+	grpcMetadata "google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/dapr/dapr/pkg/api/policy"
+	"github.com/dapr/dapr/pkg/api/ratelimit"
 	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/messages"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/dapr/pkg/runtime/drain"
 )
 
-const daprRuntimePrefix = "/dapr.proto.runtime."
+const (
+	daprRuntimePrefix = "/dapr.proto.runtime."
+
+	// endpointGroupInvoke is the API group of service invocation, including proxied gRPC calls.
+	endpointGroupInvoke = "invoke"
+)
 
 var endpoints = map[string][]string{
 	"invoke.v1": {
 		daprRuntimePrefix + "v1.Dapr/InvokeService",
 	},
+	"invoke.v1alpha1": {
+		daprRuntimePrefix + "v1.Dapr/InvokeServiceStreamAlpha1",
+	},
 	"state.v1": {
 		daprRuntimePrefix + "v1.Dapr/GetState",
 		daprRuntimePrefix + "v1.Dapr/GetBulkState",
@@ -40,12 +54,22 @@ var endpoints = map[string][]string{
 	},
 	"state.v1alpha1": {
 		daprRuntimePrefix + "v1.Dapr/QueryStateAlpha1",
+		daprRuntimePrefix + "v1.Dapr/QueryStateAlpha2",
+		daprRuntimePrefix + "v1.Dapr/CheckAndSetStateAlpha1",
+		daprRuntimePrefix + "v1.Dapr/BulkSetStateAlpha1",
+		daprRuntimePrefix + "v1.Dapr/BulkDeleteStateAlpha1",
+		daprRuntimePrefix + "v1.Dapr/IncrementStateAlpha1",
+		daprRuntimePrefix + "v1.Dapr/AppendStateAlpha1",
+		daprRuntimePrefix + "v1.Dapr/SubscribeStateAlpha1",
+		daprRuntimePrefix + "v1.Dapr/ReencryptStateAlpha1",
+		daprRuntimePrefix + "v1.Dapr/GetReencryptStateStatusAlpha1",
 	},
 	"publish.v1": {
 		daprRuntimePrefix + "v1.Dapr/PublishEvent",
 	},
 	"publish.v1alpha1": {
 		daprRuntimePrefix + "v1.Dapr/BulkPublishEventAlpha1",
+		daprRuntimePrefix + "v1.Dapr/RedriveDeadLetterAlpha1",
 	},
 	"bindings.v1": {
 		daprRuntimePrefix + "v1.Dapr/InvokeBinding",
@@ -82,9 +106,12 @@ var endpoints = map[string][]string{
 	},
 	"lock.v1alpha1": {
 		daprRuntimePrefix + "v1.Dapr/TryLockAlpha1",
+		daprRuntimePrefix + "v1.Dapr/RenewLockAlpha1",
+		daprRuntimePrefix + "v1.Dapr/TryLockSharedAlpha1",
 	},
 	"unlock.v1alpha1": {
 		daprRuntimePrefix + "v1.Dapr/UnlockAlpha1",
+		daprRuntimePrefix + "v1.Dapr/UnlockSharedAlpha1",
 	},
 	"subtlecrypto.v1alpha1": {
 		daprRuntimePrefix + "v1.Dapr/SubtleGetKeyAlpha1",
@@ -98,6 +125,8 @@ var endpoints = map[string][]string{
 	"crypto.v1alpha1": {
 		daprRuntimePrefix + "v1.Dapr/EncryptAlpha1",
 		daprRuntimePrefix + "v1.Dapr/DecryptAlpha1",
+		daprRuntimePrefix + "v1.Dapr/GenerateDataKeyAlpha1",
+		daprRuntimePrefix + "v1.Dapr/RewrapKeyAlpha1",
 	},
 	"workflows.v1alpha1": {
 		daprRuntimePrefix + "v1.Dapr/StartWorkflowAlpha1",
@@ -107,6 +136,7 @@ var endpoints = map[string][]string{
 		daprRuntimePrefix + "v1.Dapr/PurgeWorkflowAlpha1",
 		daprRuntimePrefix + "v1.Dapr/PauseWorkflowAlpha1",
 		daprRuntimePrefix + "v1.Dapr/ResumeWorkflowAlpha1",
+		daprRuntimePrefix + "v1.Dapr/ListWorkflowsAlpha1",
 	},
 	"workflows.v1beta1": {
 		daprRuntimePrefix + "v1.Dapr/StartWorkflowBeta1",
@@ -123,6 +153,9 @@ var endpoints = map[string][]string{
 		daprRuntimePrefix + "v1.Dapr/GetJobAlpha1",
 		daprRuntimePrefix + "v1.Dapr/DeleteJobsByPrefixAlpha1",
 		daprRuntimePrefix + "v1.Dapr/ListJobsAlpha1",
+		daprRuntimePrefix + "v1.Dapr/DeleteJobsAlpha1",
+		daprRuntimePrefix + "v1.Dapr/PauseJobsAlpha1",
+		daprRuntimePrefix + "v1.Dapr/ResumeJobsAlpha1",
 	},
 	"shutdown.v1": {
 		daprRuntimePrefix + "v1.Dapr/Shutdown",
@@ -208,3 +241,175 @@ func apiAccessRuleToMap(rules config.APIAccessRules) map[string]struct{} {
 
 	return res
 }
+
+// endpointAPI is the API group of a gRPC full endpoint.
+type endpointAPI struct {
+	name    string
+	version string
+}
+
+// endpointGroups maps each gRPC full endpoint to its API group.
+var endpointGroups = func() map[string]endpointAPI {
+	res := map[string]endpointAPI{}
+	for key, list := range endpoints {
+		name, version, _ := strings.Cut(key, ".")
+		for _, method := range list {
+			res[method] = endpointAPI{name: name, version: version}
+		}
+	}
+	return res
+}()
+
+// Returns the middlewares (unary and stream) that evaluate the API access policy on each request
+func setAPIAccessPolicyMiddlewares(engine *policy.Engine) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	allow := func(ctx context.Context, method string) bool {
+		// Apply the policy only on methods that are part of the Dapr runtime, or it will interfere with gRPC proxying
+		if !strings.HasPrefix(method, daprRuntimePrefix) {
+			return true
+		}
+
+		api := endpointGroups[method]
+		md, _ := grpcMetadata.FromIncomingContext(ctx)
+		getMetadata := func(key string) string {
+			if v := md.Get(key); len(v) > 0 {
+				return v[0]
+			}
+			return ""
+		}
+		return engine.Allow(policy.Request{
+			Protocol: config.APIAccessRuleProtocolGRPC,
+			Name:     api.name,
+			Version:  api.version,
+			Method:   method,
+			Metadata: getMetadata,
+		})
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			if !allow(ctx, info.FullMethod) {
+				return nil, messages.ErrAPIAccessDenied
+			}
+
+			return handler(ctx, req)
+		},
+		func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if !allow(stream.Context(), info.FullMethod) {
+				return messages.ErrAPIAccessDenied
+			}
+
+			return handler(srv, stream)
+		}
+}
+
+// Returns the middlewares (unary and stream) that enforce the rate limits of the API groups
+func setAPIRateLimitMiddlewares(limiter *ratelimit.Limiter) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	// Map each rate-limited gRPC full endpoint to the name of its API group
+	groups := map[string]string{}
+	for method, api := range endpointGroups {
+		if limiter.Has(api.name) {
+			groups[method] = api.name
+		}
+	}
+
+	// Passthrough if no limited endpoints
+	if len(groups) == 0 {
+		return nil, nil
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			if group, ok := groups[info.FullMethod]; ok && !limiter.Allow(group) {
+				return nil, messages.ErrAPIRateLimited.WithFormat(group)
+			}
+
+			return handler(ctx, req)
+		},
+		func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if group, ok := groups[info.FullMethod]; ok && !limiter.Allow(group) {
+				return messages.ErrAPIRateLimited.WithFormat(group)
+			}
+
+			return handler(srv, stream)
+		}
+}
+
+// Returns the middleware that tracks the unary calls as in-flight requests, so
+// the drain waits for them. Calls are accepted while draining, as the app may
+// need them to complete its work in progress. Streams are long-lived, like the
+// streaming subscriptions, and aren't tracked.
+func setDrainTrackingMiddleware(drainer *drain.Drainer) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		defer drainer.TrackAlways()()
+		return handler(ctx, req)
+	}
+}
+
+// Returns the middlewares (unary and stream) that enforce the max size of request messages per API group.
+// Methods that are not part of the Dapr runtime are proxied service invocations, and use the limits of the "invoke" group.
+func setAPIBodySizeMiddlewares(maxSize int, maxSizePerAPI map[string]int) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	// Passthrough if there are no per-API limits, as the server enforces the max size
+	if len(maxSizePerAPI) == 0 {
+		return nil, nil
+	}
+
+	limitFor := func(method string) (string, int) {
+		group := endpointGroupInvoke
+		if strings.HasPrefix(method, daprRuntimePrefix) {
+			group = endpointGroups[method].name
+		}
+		if size, ok := maxSizePerAPI[group]; ok {
+			return group, size
+		}
+		return group, maxSize
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			group, size := limitFor(info.FullMethod)
+			if size > 0 && messageSize(req) > size {
+				return nil, messages.ErrBodyTooLarge.WithFormat(size, group)
+			}
+
+			return handler(ctx, req)
+		},
+		func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			group, size := limitFor(info.FullMethod)
+			if size > 0 {
+				stream = &sizeLimitedServerStream{
+					ServerStream: stream,
+					group:        group,
+					maxSize:      size,
+				}
+			}
+
+			return handler(srv, stream)
+		}
+}
+
+// sizeLimitedServerStream is a grpc.ServerStream that rejects messages larger than maxSize.
+type sizeLimitedServerStream struct {
+	grpc.ServerStream
+	group   string
+	maxSize int
+}
+
+func (s *sizeLimitedServerStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err != nil {
+		return err
+	}
+	if messageSize(m) > s.maxSize {
+		return messages.ErrBodyTooLarge.WithFormat(s.maxSize, s.group)
+	}
+	return nil
+}
+
+// messageSize returns the encoded size of a request message, including proxied frames.
+func messageSize(m any) int {
+	switch msg := m.(type) {
+	case proto.Message:
+		return proto.Size(msg)
+	case interface{ Size() int }:
+		return msg.Size()
+	default:
+		return 0
+	}
+}
@@ -17,12 +17,19 @@ package grpc
 import (
 	"context"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcMetadata "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/dapr/dapr/pkg/api/policy"
+	"github.com/dapr/dapr/pkg/api/ratelimit"
 	"github.com/dapr/dapr/pkg/config"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 )
@@ -459,3 +466,162 @@ func TestSetAPIEndpointsMiddleware(t *testing.T) {
 		}
 	})
 }
+
+func TestSetAPIRateLimitMiddlewares(t *testing.T) {
+	t.Run("no limited endpoints", func(t *testing.T) {
+		limiter, err := ratelimit.New([]config.APIRateLimit{{Name: "foo", RequestsPerSecond: 1}})
+		require.NoError(t, err)
+		u, s := setAPIRateLimitMiddlewares(limiter)
+		assert.Nil(t, u)
+		assert.Nil(t, s)
+	})
+
+	t.Run("state endpoints limited", func(t *testing.T) {
+		limiter, err := ratelimit.New([]config.APIRateLimit{{Name: "state", RequestsPerSecond: 1, Burst: 2}})
+		require.NoError(t, err)
+		u, s := setAPIRateLimitMiddlewares(limiter)
+		require.NotNil(t, u)
+		require.NotNil(t, s)
+
+		// Both state.v1 and state.v1alpha1 endpoints share the bucket
+		_, err = u(nil, nil, &grpc.UnaryServerInfo{FullMethod: "/dapr.proto.runtime.v1.Dapr/GetState"}, hUnary)
+		require.NoError(t, err)
+		_, err = u(nil, nil, &grpc.UnaryServerInfo{FullMethod: "/dapr.proto.runtime.v1.Dapr/QueryStateAlpha1"}, hUnary)
+		require.NoError(t, err)
+
+		_, err = u(nil, nil, &grpc.UnaryServerInfo{FullMethod: "/dapr.proto.runtime.v1.Dapr/SaveState"}, hUnary)
+		require.Error(t, err)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		err = s(nil, nil, &grpc.StreamServerInfo{FullMethod: "/dapr.proto.runtime.v1.Dapr/GetBulkState"}, hStream)
+		require.Error(t, err)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		// Other endpoints are not affected
+		_, err = u(nil, nil, &grpc.UnaryServerInfo{FullMethod: "/dapr.proto.runtime.v1.Dapr/PublishEvent"}, hUnary)
+		require.NoError(t, err)
+		_, err = u(nil, nil, &grpc.UnaryServerInfo{FullMethod: "/myapp.v1.Foo/Bar"}, hUnary)
+		require.NoError(t, err)
+	})
+}
+
+func TestSetAPIAccessPolicyMiddlewares(t *testing.T) {
+	engine, err := policy.New(config.APISpec{
+		Allowed: config.APIAccessRules{
+			{Name: "state", Version: "v1", Protocol: "grpc", GRPCMethods: []string{"GetState", "GetBulkState"}},
+			{Name: "publish", Version: "v1", Protocol: "grpc", Metadata: map[string]string{"x-tenant": "t1"}},
+		},
+		DefaultAction: config.APIAccessDefaultActionDeny,
+	})
+	require.NoError(t, err)
+	u, s := setAPIAccessPolicyMiddlewares(engine)
+
+	ctxWithMD := func(kv ...string) context.Context {
+		return grpcMetadata.NewIncomingContext(t.Context(), grpcMetadata.Pairs(kv...))
+	}
+
+	t.Run("allowed method", func(t *testing.T) {
+		_, err := u(t.Context(), nil, &grpc.UnaryServerInfo{FullMethod: "/dapr.proto.runtime.v1.Dapr/GetState"}, hUnary)
+		require.NoError(t, err)
+
+		err = s(nil, &fakeCtxStream{ctx: t.Context()}, &grpc.StreamServerInfo{FullMethod: "/dapr.proto.runtime.v1.Dapr/GetBulkState"}, hStream)
+		require.NoError(t, err)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, err := u(t.Context(), nil, &grpc.UnaryServerInfo{FullMethod: "/dapr.proto.runtime.v1.Dapr/SaveState"}, hUnary)
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("metadata", func(t *testing.T) {
+		_, err := u(ctxWithMD("x-tenant", "t1"), nil, &grpc.UnaryServerInfo{FullMethod: "/dapr.proto.runtime.v1.Dapr/PublishEvent"}, hUnary)
+		require.NoError(t, err)
+
+		_, err = u(ctxWithMD("x-tenant", "t2"), nil, &grpc.UnaryServerInfo{FullMethod: "/dapr.proto.runtime.v1.Dapr/PublishEvent"}, hUnary)
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("default deny", func(t *testing.T) {
+		err := s(nil, &fakeCtxStream{ctx: t.Context()}, &grpc.StreamServerInfo{FullMethod: "/dapr.proto.runtime.v1.Dapr/SubscribeTopicEventsAlpha1"}, hStream)
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("proxied invocations are not affected", func(t *testing.T) {
+		_, err := u(t.Context(), nil, &grpc.UnaryServerInfo{FullMethod: "/myapp.v1.Foo/Bar"}, hUnary)
+		require.NoError(t, err)
+	})
+}
+
+// fakeCtxStream is a grpc.ServerStream that only has a context.
+type fakeCtxStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (f *fakeCtxStream) Context() context.Context {
+	return f.ctx
+}
+
+func TestSetAPIBodySizeMiddlewares(t *testing.T) {
+	t.Run("no per-API limits", func(t *testing.T) {
+		u, s := setAPIBodySizeMiddlewares(4<<20, nil)
+		assert.Nil(t, u)
+		assert.Nil(t, s)
+	})
+
+	u, s := setAPIBodySizeMiddlewares(100, map[string]int{
+		"state":  10,
+		"invoke": 0,
+	})
+	require.NotNil(t, u)
+	require.NotNil(t, s)
+
+	small := &runtimev1pb.SaveStateRequest{StoreName: "a"}
+	large := &runtimev1pb.SaveStateRequest{StoreName: strings.Repeat("a", 50)}
+
+	t.Run("per-API limit", func(t *testing.T) {
+		_, err := u(nil, small, &grpc.UnaryServerInfo{FullMethod: "/dapr.proto.runtime.v1.Dapr/SaveState"}, hUnary)
+		require.NoError(t, err)
+
+		_, err = u(nil, large, &grpc.UnaryServerInfo{FullMethod: "/dapr.proto.runtime.v1.Dapr/SaveState"}, hUnary)
+		require.Error(t, err)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		require.ErrorContains(t, err, "request body exceeds the limit of 10 bytes for the state API")
+	})
+
+	t.Run("default limit", func(t *testing.T) {
+		_, err := u(nil, large, &grpc.UnaryServerInfo{FullMethod: "/dapr.proto.runtime.v1.Dapr/PublishEvent"}, hUnary)
+		require.NoError(t, err)
+
+		_, err = u(nil, &runtimev1pb.PublishEventRequest{Data: make([]byte, 200)}, &grpc.UnaryServerInfo{FullMethod: "/dapr.proto.runtime.v1.Dapr/PublishEvent"}, hUnary)
+		require.Error(t, err)
+		require.ErrorContains(t, err, "for the publish API")
+	})
+
+	t.Run("unlimited proxied invocations", func(t *testing.T) {
+		_, err := u(nil, &runtimev1pb.PublishEventRequest{Data: make([]byte, 200)}, &grpc.UnaryServerInfo{FullMethod: "/myapp.v1.Foo/Bar"}, hUnary)
+		require.NoError(t, err)
+	})
+
+	t.Run("stream messages", func(t *testing.T) {
+		err := s(nil, &fakeRecvStream{msg: large}, &grpc.StreamServerInfo{FullMethod: "/dapr.proto.runtime.v1.Dapr/GetBulkState"}, func(srv any, stream grpc.ServerStream) error {
+			return stream.RecvMsg(&runtimev1pb.SaveStateRequest{})
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+}
+
+// fakeRecvStream is a grpc.ServerStream that receives a single message.
+type fakeRecvStream struct {
+	grpc.ServerStream
+	msg proto.Message
+}
+
+func (f *fakeRecvStream) RecvMsg(m any) error {
+	proto.Merge(m.(proto.Message), f.msg)
+	return nil
+}
@@ -46,12 +46,15 @@ import (
 	apierrors "github.com/dapr/dapr/pkg/api/errors"
 	"github.com/dapr/dapr/pkg/api/grpc/metadata"
 	"github.com/dapr/dapr/pkg/api/universal"
+	configurationLoader "github.com/dapr/dapr/pkg/components/configuration"
 	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	"github.com/dapr/dapr/pkg/compression"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	diagConsts "github.com/dapr/dapr/pkg/diagnostics/consts"
 	diagUtils "github.com/dapr/dapr/pkg/diagnostics/utils"
 	"github.com/dapr/dapr/pkg/encryption"
+	"github.com/dapr/dapr/pkg/expr"
 	"github.com/dapr/dapr/pkg/messages"
 	"github.com/dapr/dapr/pkg/messages/errorcodes"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
@@ -64,6 +67,7 @@ import (
 	"github.com/dapr/dapr/pkg/runtime/channels"
 	"github.com/dapr/dapr/pkg/runtime/processor"
 	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/statecache"
 	"github.com/dapr/dapr/utils"
 	kiterrors "github.com/dapr/kit/errors"
 	"github.com/dapr/kit/logger"
@@ -97,6 +101,7 @@ type api struct {
 	tracingSpec           config.TracingSpec
 	accessControlList     *config.AccessControlList
 	processor             *processor.Processor
+	maxReplayBufferSize   int // In bytes
 	wg                    sync.WaitGroup
 
 	closeCh chan struct{}
@@ -116,6 +121,7 @@ type APIOpts struct {
 	TracingSpec           config.TracingSpec
 	AccessControlList     *config.AccessControlList
 	Processor             *processor.Processor
+	MaxReplayBufferSize   int // In bytes
 }
 
 // NewAPI returns a new gRPC API.
@@ -132,6 +138,7 @@ func NewAPI(opts APIOpts) API {
 		tracingSpec:           opts.TracingSpec,
 		accessControlList:     opts.AccessControlList,
 		processor:             opts.Processor,
+		maxReplayBufferSize:   opts.MaxReplayBufferSize,
 		closeCh:               make(chan struct{}),
 	}
 }
@@ -170,11 +177,11 @@ func (a *api) validateAndGetPubsubAndTopic(pubsubName, topic string, reqMeta map
 	return thepubsub.Component, pubsubName, topic, rawPayload, nil
 }
 
-func (a *api) PublishEvent(ctx context.Context, in *runtimev1pb.PublishEventRequest) (*emptypb.Empty, error) {
+func (a *api) PublishEvent(ctx context.Context, in *runtimev1pb.PublishEventRequest) (*runtimev1pb.PublishEventResponse, error) {
 	thepubsub, pubsubName, topic, rawPayload, validationErr := a.validateAndGetPubsubAndTopic(in.GetPubsubName(), in.GetTopic(), in.GetMetadata())
 	if validationErr != nil {
-		apiServerLogger.Debug(validationErr)
-		return &emptypb.Empty{}, validationErr
+		diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(validationErr)
+		return &runtimev1pb.PublishEventResponse{}, validationErr
 	}
 
 	body := []byte{}
@@ -195,14 +202,15 @@ func (a *api) PublishEvent(ctx context.Context, in *runtimev1pb.PublishEventRequ
 			Data:            body,
 			TraceID:         traceID,
 			TraceState:      traceState,
+			Baggage:         diag.BaggageFromGRPCContext(ctx),
 			Pubsub:          in.GetPubsubName(),
 		}, in.GetMetadata())
 		if err != nil {
 			nerr := apierrors.PubSub(pubsubName).WithAppError(
 				a.AppID(), err,
 			).CloudEventCreation()
-			apiServerLogger.Debug(nerr)
-			return &emptypb.Empty{}, nerr
+			diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(nerr)
+			return &runtimev1pb.PublishEventResponse{}, nerr
 		}
 
 		features := thepubsub.Features()
@@ -213,8 +221,8 @@ func (a *api) PublishEvent(ctx context.Context, in *runtimev1pb.PublishEventRequ
 			err = apierrors.PubSub(pubsubName).WithAppError(
 				a.AppID(), nil,
 			).WithTopic(topic).MarshalEnvelope()
-			apiServerLogger.Debug(err)
-			return &emptypb.Empty{}, err
+			diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(err)
+			return &runtimev1pb.PublishEventResponse{}, err
 		}
 	}
 
@@ -226,7 +234,7 @@ func (a *api) PublishEvent(ctx context.Context, in *runtimev1pb.PublishEventRequ
 	}
 
 	start := time.Now()
-	err := a.pubsubAdapter.Publish(ctx, &req)
+	result, err := runtimePubsub.PublishWithResult(ctx, a.pubsubAdapter, &req)
 	elapsed := diag.ElapsedSince(start)
 
 	diag.DefaultComponentMonitoring.PubsubEgressEvent(context.Background(), pubsubName, topic, err == nil, elapsed)
@@ -239,15 +247,23 @@ func (a *api) PublishEvent(ctx context.Context, in *runtimev1pb.PublishEventRequ
 			nerr = apierrors.PubSub(pubsubName).PublishForbidden(topic, a.AppID(), err)
 		case errors.As(err, &runtimePubsub.NotFoundError{}):
 			nerr = apierrors.PubSub(pubsubName).TestNotFound(topic, err)
+		case errors.As(err, &runtimePubsub.SchemaValidationError{}):
+			nerr = apierrors.PubSub(pubsubName).SchemaValidation(topic, err)
 		default:
 			nerr = apierrors.PubSub(pubsubName).PublishMessage(topic, err)
 		}
 
-		apiServerLogger.Debug(nerr)
-		return &emptypb.Empty{}, nerr
+		diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(nerr)
+		return &runtimev1pb.PublishEventResponse{}, nerr
 	}
 
-	return &emptypb.Empty{}, nil
+	resp := &runtimev1pb.PublishEventResponse{}
+	if result != nil {
+		resp.MessageId = result.MessageID
+		resp.Partition = result.Partition
+		resp.Offset = result.Offset
+	}
+	return resp, nil
 }
 
 type invokeServiceResp struct {
@@ -272,7 +288,7 @@ func (a *api) InvokeService(ctx context.Context, in *runtimev1pb.InvokeServiceRe
 	}
 
 	if invokeServiceDeprecationNoticeShown.CompareAndSwap(false, true) {
-		apiServerLogger.Warn("[DEPRECATION NOTICE] InvokeService is deprecated and will be removed in the future, please use proxy mode instead.")
+		diag.LoggerWithTraceContext(ctx, apiServerLogger).Warn("[DEPRECATION NOTICE] InvokeService is deprecated and will be removed in the future, please use proxy mode instead.")
 	}
 	policyDef := a.Universal.Resiliency().EndpointPolicy(in.GetId(), in.GetId()+":"+in.GetMessage().GetMethod())
 
@@ -311,7 +327,7 @@ func (a *api) InvokeService(ctx context.Context, in *runtimev1pb.InvokeServiceRe
 
 		if imr.IsHTTPResponse() {
 			if invokeServiceHTTPDeprecationNoticeShown.CompareAndSwap(false, true) {
-				apiServerLogger.Warn("[DEPRECATION NOTICE] Invocation path of gRPC -> HTTP is deprecated and will be removed in the future.")
+				diag.LoggerWithTraceContext(ctx, apiServerLogger).Warn("[DEPRECATION NOTICE] Invocation path of gRPC -> HTTP is deprecated and will be removed in the future.")
 			}
 			var errorMessage string
 			if rResp.message != nil && rResp.message.GetData() != nil {
@@ -359,7 +375,7 @@ func (a *api) BulkPublishEventAlpha1(ctx context.Context, in *runtimev1pb.BulkPu
 	thepubsub, pubsubName, topic, rawPayload, validationErr := a.validateAndGetPubsubAndTopic(in.GetPubsubName(), in.GetTopic(), in.GetMetadata())
 
 	if validationErr != nil {
-		apiServerLogger.Debug(validationErr)
+		diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(validationErr)
 		return &runtimev1pb.BulkPublishResponse{}, validationErr
 	}
 
@@ -384,7 +400,7 @@ func (a *api) BulkPublishEventAlpha1(ctx context.Context, in *runtimev1pb.BulkPu
 			err := apierrors.PubSub(pubsubName).WithAppError(
 				a.AppID(), errors.New("entryId is duplicated or not present for entry"),
 			).WithTopic(topic).MarshalEvents()
-			apiServerLogger.Debug(err)
+			diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(err)
 			return &runtimev1pb.BulkPublishResponse{}, err
 		}
 		entryIdSet[entry.GetEntryId()] = struct{}{}
@@ -392,10 +408,8 @@ func (a *api) BulkPublishEventAlpha1(ctx context.Context, in *runtimev1pb.BulkPu
 		entries[i].ContentType = entry.GetContentType()
 		entries[i].Event = entry.GetEvent()
 		// Populate entry metadata with request level metadata. Entry level metadata keys
-		// override request level metadata.
-		if entry.GetMetadata() != nil {
-			entries[i].Metadata = utils.PopulateMetadataForBulkPublishEntry(in.GetMetadata(), entry.GetMetadata())
-		}
+		// (such as the partition key or ttlInSeconds) override request level metadata.
+		entries[i].Metadata = utils.PopulateMetadataForBulkPublishEntry(in.GetMetadata(), entry.GetMetadata())
 
 		if !rawPayload {
 			// Extract trace context from context.
@@ -413,13 +427,14 @@ func (a *api) BulkPublishEventAlpha1(ctx context.Context, in *runtimev1pb.BulkPu
 				Data:            entries[i].Event,
 				TraceID:         traceID,
 				TraceState:      traceState,
+				Baggage:         diag.BaggageFromGRPCContext(ctx),
 				Pubsub:          pubsubName,
 			}, entries[i].Metadata)
 			if err != nil {
 				nerr := apierrors.PubSub(pubsubName).WithAppError(
 					a.AppID(), err,
 				).CloudEventCreation()
-				apiServerLogger.Debug(nerr)
+				diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(nerr)
 				closeChildSpans(ctx, nerr)
 				return &runtimev1pb.BulkPublishResponse{}, nerr
 			}
@@ -431,7 +446,7 @@ func (a *api) BulkPublishEventAlpha1(ctx context.Context, in *runtimev1pb.BulkPu
 				nerr := apierrors.PubSub(pubsubName).WithAppError(
 					a.AppID(), err,
 				).WithTopic(topic).MarshalEnvelope()
-				apiServerLogger.Debug(nerr)
+				diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(nerr)
 				closeChildSpans(ctx, nerr)
 				return &runtimev1pb.BulkPublishResponse{}, nerr
 			}
@@ -464,20 +479,28 @@ func (a *api) BulkPublishEventAlpha1(ctx context.Context, in *runtimev1pb.BulkPu
 
 	if err != nil {
 		var nerr error
-		// Only respond with error if it is permission denied or not found.
-		// On error, the response will be empty.
+		// Only respond with error if it is permission denied, not found, or if no
+		// per-entry status is available. On error, the response will be empty.
 		switch {
 		case errors.As(err, &runtimePubsub.NotAllowedError{}):
 			nerr = apierrors.PubSub(pubsubName).PublishForbidden(topic, a.AppID(), err)
 		case errors.As(err, &runtimePubsub.NotFoundError{}):
 			nerr = apierrors.PubSub(pubsubName).TestNotFound(topic, err)
+		case errors.As(err, &runtimePubsub.SchemaValidationError{}):
+			nerr = apierrors.PubSub(pubsubName).SchemaValidation(topic, err)
+		case len(res.FailedEntries) > 0:
+			// Retries have been exhausted for some entries only: report them in the
+			// response so the caller knows which entries to act upon.
+			diag.LoggerWithTraceContext(ctx, apiServerLogger).Debugf("bulk publish to topic %s on pubsub %s failed for %d entries: %v", topic, pubsubName, len(res.FailedEntries), err)
 		default:
 			nerr = apierrors.PubSub(pubsubName).PublishMessage(topic, err)
 		}
 
-		apiServerLogger.Debug(nerr)
-		closeChildSpans(ctx, nerr)
-		return &bulkRes, nerr
+		if nerr != nil {
+			diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(nerr)
+			closeChildSpans(ctx, nerr)
+			return &bulkRes, nerr
+		}
 	}
 
 	bulkRes.FailedEntries = make([]*runtimev1pb.BulkPublishResponseFailedEntry, 0, len(res.FailedEntries))
@@ -493,6 +516,57 @@ func (a *api) BulkPublishEventAlpha1(ctx context.Context, in *runtimev1pb.BulkPu
 	return &bulkRes, nil
 }
 
+// RedriveDeadLetterAlpha1 publishes the messages of a dead letter topic back
+// to the topics they were dead lettered from.
+func (a *api) RedriveDeadLetterAlpha1(ctx context.Context, in *runtimev1pb.RedriveDeadLetterRequestAlpha1) (*runtimev1pb.RedriveDeadLetterResponseAlpha1, error) {
+	thepubsub, pubsubName, topic, _, validationErr := a.validateAndGetPubsubAndTopic(in.GetPubsubName(), in.GetTopic(), in.GetMetadata())
+	if validationErr != nil {
+		diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(validationErr)
+		return nil, validationErr
+	}
+
+	req := runtimePubsub.RedriveRequest{
+		PubsubName:  pubsubName,
+		Topic:       topic,
+		Metadata:    in.GetMetadata(),
+		Rate:        in.GetRatePerSecond(),
+		MaxMessages: int(in.GetMaxMessages()),
+	}
+	var err error
+	switch {
+	case in.GetRatePerSecond() < 0:
+		err = errors.New("rate_per_second must not be negative")
+	case in.GetMaxMessages() < 0:
+		err = errors.New("max_messages must not be negative")
+	case in.GetIdleTimeout() != "":
+		req.IdleTimeout, err = time.ParseDuration(in.GetIdleTimeout())
+		if err == nil && req.IdleTimeout <= 0 {
+			err = errors.New("idle_timeout must be positive")
+		}
+	}
+	if err == nil && in.GetFilter() != "" {
+		req.Filter = &expr.Expr{}
+		err = req.Filter.DecodeString(in.GetFilter())
+	}
+	if err != nil {
+		nerr := apierrors.PubSub(pubsubName).RedriveRequest(topic, err)
+		diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(nerr)
+		return nil, nerr
+	}
+
+	res, err := runtimePubsub.Redrive(ctx, thepubsub, a.pubsubAdapter.Publish, req)
+	if err != nil {
+		nerr := apierrors.PubSub(pubsubName).Redrive(topic, err)
+		diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(nerr)
+		return nil, nerr
+	}
+
+	return &runtimev1pb.RedriveDeadLetterResponseAlpha1{
+		Redriven: int32(res.Redriven), //nolint:gosec
+		Skipped:  int32(res.Skipped),  //nolint:gosec
+	}, nil
+}
+
 func (a *api) InvokeBinding(ctx context.Context, in *runtimev1pb.InvokeBindingRequest) (*runtimev1pb.InvokeBindingResponse, error) {
 	req := &bindings.InvokeRequest{
 		Metadata:  make(map[string]string, len(in.GetMetadata())),
@@ -556,7 +630,7 @@ func (a *api) InvokeBinding(ctx context.Context, in *runtimev1pb.InvokeBindingRe
 
 	if err != nil {
 		richError := apierrors.Basic(codes.Internal, http.StatusInternalServerError, errorcodes.BindingInvokeOutputBinding, fmt.Sprintf(messages.ErrInvokeOutputBinding, in.GetName(), err.Error()))
-		apiServerLogger.Debug(richError)
+		diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(richError)
 		return r, richError
 	}
 
@@ -568,6 +642,42 @@ func (a *api) InvokeBinding(ctx context.Context, in *runtimev1pb.InvokeBindingRe
 	return r, nil
 }
 
+// GetBulkSecret returns the secrets of the store. When the request is
+// paginated, the continuation token of the next page is returned in the
+// response headers.
+func (a *api) GetBulkSecret(ctx context.Context, in *runtimev1pb.GetBulkSecretRequest) (*runtimev1pb.GetBulkSecretResponse, error) {
+	resp, token, err := a.Universal.GetBulkSecretPage(ctx, in)
+	if token != "" {
+		grpc.SetHeader(ctx, grpcMetadata.Pairs(metadataPrefix+universal.BulkSecretContinuationTokenKey, token))
+	}
+	return resp, err
+}
+
+// TryLockAlpha1 tries acquiring the lock. When the lock is acquired with a
+// fencing token, the token is returned in the response headers.
+func (a *api) TryLockAlpha1(ctx context.Context, in *runtimev1pb.TryLockRequest) (*runtimev1pb.TryLockResponse, error) {
+	resp, token, err := a.Universal.TryLockFenced(ctx, in)
+	if token > 0 {
+		grpc.SetHeader(ctx, grpcMetadata.Pairs(metadataPrefix+universal.LockFencingTokenKey, strconv.FormatInt(token, 10)))
+	}
+	return resp, err
+}
+
+// ScheduleJobAlpha1 schedules a job. The job options which the request has no
+// fields for, such as the failure policy applied by daprd, are read from the
+// "metadata." prefixed gRPC metadata of the request.
+func (a *api) ScheduleJobAlpha1(ctx context.Context, in *runtimev1pb.ScheduleJobRequest) (*runtimev1pb.ScheduleJobResponse, error) {
+	md := make(map[string]string)
+	if incomingMD, ok := grpcMetadata.FromIncomingContext(ctx); ok {
+		for k, v := range incomingMD {
+			if key, ok := strings.CutPrefix(k, metadataPrefix); ok && len(v) > 0 {
+				md[key] = v[0]
+			}
+		}
+	}
+	return a.Universal.ScheduleJobWithMetadata(ctx, in, md)
+}
+
 func (a *api) GetBulkState(ctx context.Context, in *runtimev1pb.GetBulkStateRequest) (*runtimev1pb.GetBulkStateResponse, error) {
 	bulkResp := &runtimev1pb.GetBulkStateResponse{}
 	store, err := a.Universal.GetStateStore(in.GetStoreName())
@@ -583,7 +693,7 @@ func (a *api) GetBulkState(ctx context.Context, in *runtimev1pb.GetBulkStateRequ
 	var key string
 	reqs := make([]state.GetRequest, len(in.GetKeys()))
 	for i, k := range in.GetKeys() {
-		key, err = stateLoader.GetModifiedStateKey(k, in.GetStoreName(), a.Universal.AppID())
+		key, err = stateLoader.GetModifiedStateKeyWithMetadata(k, in.GetStoreName(), a.Universal.AppID(), in.GetMetadata())
 		if err != nil {
 			return &runtimev1pb.GetBulkStateResponse{}, err
 		}
@@ -622,23 +732,21 @@ func (a *api) GetBulkState(ctx context.Context, in *runtimev1pb.GetBulkStateRequ
 		bulkResp.Items[i] = item
 	}
 
-	if encryption.EncryptedStateStore(in.GetStoreName()) {
-		for i := range bulkResp.GetItems() {
-			if bulkResp.GetItems()[i].GetError() != "" || len(bulkResp.GetItems()[i].GetData()) == 0 {
-				bulkResp.Items[i].Data = nil
-				continue
-			}
-
-			val, err := encryption.TryDecryptValue(in.GetStoreName(), bulkResp.GetItems()[i].GetData())
-			if err != nil {
-				apiServerLogger.Debugf("Bulk get error: %v", err)
-				bulkResp.Items[i].Data = nil
-				bulkResp.Items[i].Error = err.Error()
-				continue
-			}
+	for i := range bulkResp.GetItems() {
+		if bulkResp.GetItems()[i].GetError() != "" || len(bulkResp.GetItems()[i].GetData()) == 0 {
+			bulkResp.Items[i].Data = nil
+			continue
+		}
 
-			bulkResp.Items[i].Data = val
+		val, err := encryption.DecodeStateValue(in.GetStoreName(), responses[i].Key, bulkResp.GetItems()[i].GetData())
+		if err != nil {
+			diag.LoggerWithTraceContext(ctx, apiServerLogger).Debugf("Bulk get error: %v", err)
+			bulkResp.Items[i].Data = nil
+			bulkResp.Items[i].Error = err.Error()
+			continue
 		}
+
+		bulkResp.Items[i].Data = val
 	}
 
 	return bulkResp, nil
@@ -650,7 +758,7 @@ func (a *api) GetState(ctx context.Context, in *runtimev1pb.GetStateRequest) (*r
 		// Error has already been logged
 		return &runtimev1pb.GetStateResponse{}, err
 	}
-	key, err := stateLoader.GetModifiedStateKey(in.GetKey(), in.GetStoreName(), a.Universal.AppID())
+	key, err := stateLoader.GetModifiedStateKeyWithMetadata(in.GetKey(), in.GetStoreName(), a.Universal.AppID(), in.GetMetadata())
 	if err != nil {
 		return &runtimev1pb.GetStateResponse{}, err
 	}
@@ -666,8 +774,9 @@ func (a *api) GetState(ctx context.Context, in *runtimev1pb.GetStateRequest) (*r
 	policyRunner := resiliency.NewRunner[*state.GetResponse](ctx,
 		a.Universal.Resiliency().ComponentOutboundPolicy(in.GetStoreName(), resiliency.Statestore),
 	)
+	cache := a.Universal.CompStore().GetStateCache(in.GetStoreName())
 	getResponse, err := policyRunner(func(ctx context.Context) (*state.GetResponse, error) {
-		return store.Get(ctx, req)
+		return cache.ReadThrough(ctx, req, store.Get)
 	})
 	elapsed := diag.ElapsedSince(start)
 
@@ -688,8 +797,8 @@ func (a *api) GetState(ctx context.Context, in *runtimev1pb.GetStateRequest) (*r
 	if getResponse == nil {
 		getResponse = &state.GetResponse{}
 	}
-	if encryption.EncryptedStateStore(in.GetStoreName()) {
-		val, err := encryption.TryDecryptValue(in.GetStoreName(), getResponse.Data)
+	if len(getResponse.Data) > 0 {
+		val, err := encryption.DecodeStateValue(in.GetStoreName(), req.Key, getResponse.Data)
 		if err != nil {
 			err = apierrors.Basic(codes.Internal, http.StatusInternalServerError, errorcodes.StateGet, fmt.Sprintf(messages.ErrStateGet, in.GetKey(), in.GetStoreName(), err.Error()))
 			a.logger.Debug(err)
@@ -724,49 +833,10 @@ func (a *api) SaveState(ctx context.Context, in *runtimev1pb.SaveStateRequest) (
 
 	reqs := make([]state.SetRequest, l)
 	for i, s := range in.GetStates() {
-		if len(s.GetKey()) == 0 {
-			return empty, apierrors.Basic(codes.InvalidArgument, http.StatusBadRequest, errorcodes.StateSave, "state key cannot be empty")
-		}
-
-		var key string
-		key, err = stateLoader.GetModifiedStateKey(s.GetKey(), in.GetStoreName(), a.Universal.AppID())
+		reqs[i], err = a.stateSetRequest(in.GetStoreName(), s, s.GetMetadata())
 		if err != nil {
 			return empty, err
 		}
-		req := state.SetRequest{
-			Key:      key,
-			Metadata: s.GetMetadata(),
-		}
-
-		if req.Metadata[contribMetadata.ContentType] == contenttype.JSONContentType {
-			err = json.Unmarshal(s.GetValue(), &req.Value)
-			if err != nil {
-				return empty, err
-			}
-		} else {
-			req.Value = s.GetValue()
-		}
-
-		if s.GetEtag() != nil {
-			req.ETag = &s.Etag.Value
-		}
-		if s.GetOptions() != nil {
-			req.Options = state.SetStateOption{
-				Consistency: stateConsistencyToString(s.GetOptions().GetConsistency()),
-				Concurrency: stateConcurrencyToString(s.GetOptions().GetConcurrency()),
-			}
-		}
-		if encryption.EncryptedStateStore(in.GetStoreName()) {
-			val, encErr := encryption.TryEncryptValue(in.GetStoreName(), s.GetValue())
-			if encErr != nil {
-				a.logger.Debug(encErr)
-				return empty, encErr
-			}
-
-			req.Value = val
-		}
-
-		reqs[i] = req
 	}
 
 	start := time.Now()
@@ -778,6 +848,8 @@ func (a *api) SaveState(ctx context.Context, in *runtimev1pb.SaveStateRequest) (
 	)
 	elapsed := diag.ElapsedSince(start)
 
+	a.Universal.CompStore().GetStateCache(in.GetStoreName()).Invalidate(ctx, statecache.Keys(reqs)...)
+
 	diag.DefaultComponentMonitoring.StateInvoked(ctx, in.GetStoreName(), diag.Set, err == nil, elapsed)
 
 	if err != nil {
@@ -792,6 +864,53 @@ func (a *api) SaveState(ctx context.Context, in *runtimev1pb.SaveStateRequest) (
 	return empty, nil
 }
 
+// stateSetRequest converts a state item to a request to save it in a state
+// store, with the given metadata.
+func (a *api) stateSetRequest(storeName string, s *commonv1pb.StateItem, metadata map[string]string) (state.SetRequest, error) {
+	if len(s.GetKey()) == 0 {
+		return state.SetRequest{}, apierrors.Basic(codes.InvalidArgument, http.StatusBadRequest, errorcodes.StateSave, "state key cannot be empty")
+	}
+
+	key, err := stateLoader.GetModifiedStateKeyWithMetadata(s.GetKey(), storeName, a.Universal.AppID(), metadata)
+	if err != nil {
+		return state.SetRequest{}, err
+	}
+	req := state.SetRequest{
+		Key:      key,
+		Metadata: metadata,
+	}
+
+	if req.Metadata[contribMetadata.ContentType] == contenttype.JSONContentType {
+		err = json.Unmarshal(s.GetValue(), &req.Value)
+		if err != nil {
+			return state.SetRequest{}, err
+		}
+	} else {
+		req.Value = s.GetValue()
+	}
+
+	if s.GetEtag() != nil {
+		req.ETag = &s.Etag.Value
+	}
+	if s.GetOptions() != nil {
+		req.Options = state.SetStateOption{
+			Consistency: stateConsistencyToString(s.GetOptions().GetConsistency()),
+			Concurrency: stateConcurrencyToString(s.GetOptions().GetConcurrency()),
+		}
+	}
+	if encryption.EncryptedStateStore(storeName) || compression.CompressedStateStore(storeName) {
+		val, encErr := encryption.EncodeStateValue(storeName, req.Key, s.GetValue())
+		if encErr != nil {
+			a.logger.Debug(encErr)
+			return state.SetRequest{}, encErr
+		}
+
+		req.Value = val
+	}
+
+	return req, nil
+}
+
 // getStateErrorCode takes a state store error and returns the associated etag's grpcCode, if applicable
 func (a *api) getStateErrorCode(err error) codes.Code {
 	var etagErr *state.ETagError
@@ -816,7 +935,7 @@ func (a *api) DeleteState(ctx context.Context, in *runtimev1pb.DeleteStateReques
 		return empty, err
 	}
 
-	key, err := stateLoader.GetModifiedStateKey(in.GetKey(), in.GetStoreName(), a.Universal.AppID())
+	key, err := stateLoader.GetModifiedStateKeyWithMetadata(in.GetKey(), in.GetStoreName(), a.Universal.AppID(), in.GetMetadata())
 	if err != nil {
 		return empty, err
 	}
@@ -843,6 +962,8 @@ func (a *api) DeleteState(ctx context.Context, in *runtimev1pb.DeleteStateReques
 	})
 	elapsed := diag.ElapsedSince(start)
 
+	a.Universal.CompStore().GetStateCache(in.GetStoreName()).Invalidate(ctx, key)
+
 	diag.DefaultComponentMonitoring.StateInvoked(ctx, in.GetStoreName(), diag.Delete, err == nil, elapsed)
 
 	if err != nil {
@@ -868,24 +989,10 @@ func (a *api) DeleteBulkState(ctx context.Context, in *runtimev1pb.DeleteBulkSta
 
 	reqs := make([]state.DeleteRequest, len(in.GetStates()))
 	for i, item := range in.GetStates() {
-		key, err1 := stateLoader.GetModifiedStateKey(item.GetKey(), in.GetStoreName(), a.Universal.AppID())
-		if err1 != nil {
-			return empty, err1
-		}
-		req := state.DeleteRequest{
-			Key:      key,
-			Metadata: item.GetMetadata(),
-		}
-		if item.GetEtag() != nil {
-			req.ETag = &item.Etag.Value
-		}
-		if item.GetOptions() != nil {
-			req.Options = state.DeleteStateOption{
-				Concurrency: stateConcurrencyToString(item.GetOptions().GetConcurrency()),
-				Consistency: stateConsistencyToString(item.GetOptions().GetConsistency()),
-			}
+		reqs[i], err = a.stateDeleteRequest(in.GetStoreName(), item, item.GetMetadata())
+		if err != nil {
+			return empty, err
 		}
-		reqs[i] = req
 	}
 
 	start := time.Now()
@@ -897,6 +1004,8 @@ func (a *api) DeleteBulkState(ctx context.Context, in *runtimev1pb.DeleteBulkSta
 	)
 	elapsed := diag.ElapsedSince(start)
 
+	a.Universal.CompStore().GetStateCache(in.GetStoreName()).Invalidate(ctx, statecache.Keys(reqs)...)
+
 	diag.DefaultComponentMonitoring.StateInvoked(ctx, in.GetStoreName(), diag.BulkDelete, err == nil, elapsed)
 
 	if err != nil {
@@ -912,6 +1021,29 @@ func (a *api) DeleteBulkState(ctx context.Context, in *runtimev1pb.DeleteBulkSta
 	return empty, nil
 }
 
+// stateDeleteRequest converts a state item to a request to delete its key from
+// a state store, with the given metadata.
+func (a *api) stateDeleteRequest(storeName string, item *commonv1pb.StateItem, metadata map[string]string) (state.DeleteRequest, error) {
+	key, err := stateLoader.GetModifiedStateKeyWithMetadata(item.GetKey(), storeName, a.Universal.AppID(), metadata)
+	if err != nil {
+		return state.DeleteRequest{}, err
+	}
+	req := state.DeleteRequest{
+		Key:      key,
+		Metadata: metadata,
+	}
+	if item.GetEtag() != nil {
+		req.ETag = &item.Etag.Value
+	}
+	if item.GetOptions() != nil {
+		req.Options = state.DeleteStateOption{
+			Concurrency: stateConcurrencyToString(item.GetOptions().GetConcurrency()),
+			Consistency: stateConsistencyToString(item.GetOptions().GetConsistency()),
+		}
+	}
+	return req, nil
+}
+
 func extractEtag(req *commonv1pb.StateItem) (bool, string) {
 	if req.GetEtag() != nil {
 		return true, req.GetEtag().GetValue()
@@ -929,18 +1061,74 @@ func (a *api) ExecuteStateTransaction(ctx context.Context, in *runtimev1pb.Execu
 	transactionalStore, ok := store.(state.TransactionalStore)
 	if !ok || !state.FeatureTransactional.IsPresent(store.Features()) {
 		err := apierrors.StateStore(in.GetStoreName()).TransactionsNotSupported()
-		apiServerLogger.Debug(err)
+		diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(err)
 		return &emptypb.Empty{}, err
 	}
 
-	operations := make([]state.TransactionalStateOperation, 0, len(in.GetOperations()))
-	for _, inputReq := range in.GetOperations() {
+	operations, err := a.stateTransactionOperations(ctx, in.GetStoreName(), in.GetOperations())
+	if err != nil {
+		return &emptypb.Empty{}, err
+	}
+
+	if maxMulti, ok := store.(state.TransactionalStoreMultiMaxSize); ok {
+		max := maxMulti.MultiMaxSize()
+		if max > 0 && len(operations) > max {
+			err := apierrors.StateStore(in.GetStoreName()).TooManyTransactionalOps(len(operations), max)
+			diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(err)
+			return &emptypb.Empty{}, err
+		}
+	}
+
+	outboxEnabled := a.outbox.Enabled(in.GetStoreName())
+	if outboxEnabled {
+		span := diagUtils.SpanFromContext(ctx)
+		traceID, traceState := diag.TraceIDAndStateFromSpan(span)
+		ops, err := a.outbox.PublishInternal(ctx, in.GetStoreName(), operations, a.Universal.AppID(), traceID, traceState)
+		if err != nil {
+			nerr := apierrors.PubSubOutbox(a.AppID(), err)
+			diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(nerr)
+			return &emptypb.Empty{}, nerr
+		}
+
+		operations = ops
+	}
+
+	start := time.Now()
+	policyRunner := resiliency.NewRunner[struct{}](ctx,
+		a.Universal.Resiliency().ComponentOutboundPolicy(in.GetStoreName(), resiliency.Statestore),
+	)
+	storeReq := &state.TransactionalStateRequest{
+		Operations: operations,
+		Metadata:   in.GetMetadata(),
+	}
+	_, err = policyRunner(func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, transactionalStore.Multi(ctx, storeReq)
+	})
+	elapsed := diag.ElapsedSince(start)
+
+	a.Universal.CompStore().GetStateCache(in.GetStoreName()).Invalidate(ctx, statecache.Keys(operations)...)
+
+	diag.DefaultComponentMonitoring.StateInvoked(ctx, in.GetStoreName(), diag.StateTransaction, err == nil, elapsed)
+
+	if err != nil {
+		err = apierrors.Basic(codes.Internal, http.StatusInternalServerError, errorcodes.StateTransaction, fmt.Sprintf(messages.ErrStateTransaction, err.Error()))
+		diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(err)
+		return &emptypb.Empty{}, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// stateTransactionOperations converts the operations of a transaction to
+// operations of a state store, with their values encoded for the store.
+func (a *api) stateTransactionOperations(ctx context.Context, storeName string, in []*runtimev1pb.TransactionalStateOperation) ([]state.TransactionalStateOperation, error) {
+	operations := make([]state.TransactionalStateOperation, 0, len(in))
+	for _, inputReq := range in {
 		req := inputReq.GetRequest()
 
 		hasEtag, etag := extractEtag(req)
-		key, err := stateLoader.GetModifiedStateKey(req.GetKey(), in.GetStoreName(), a.Universal.AppID())
+		key, err := stateLoader.GetModifiedStateKeyWithMetadata(req.GetKey(), storeName, a.Universal.AppID(), req.GetMetadata())
 		if err != nil {
-			return &emptypb.Empty{}, err
+			return nil, err
 		}
 		switch state.OperationType(inputReq.GetOperationType()) {
 		case state.OperationUpsert:
@@ -985,30 +1173,20 @@ func (a *api) ExecuteStateTransaction(ctx context.Context, in *runtimev1pb.Execu
 
 		default:
 			err = apierrors.Basic(codes.Unimplemented, http.StatusInternalServerError, errorcodes.StateNotSupportedOperation, fmt.Sprintf(messages.ErrNotSupportedStateOperation, inputReq.GetOperationType()))
-			apiServerLogger.Debug(err)
-			return &emptypb.Empty{}, err
-		}
-	}
-
-	if maxMulti, ok := store.(state.TransactionalStoreMultiMaxSize); ok {
-		max := maxMulti.MultiMaxSize()
-		if max > 0 && len(operations) > max {
-			err := apierrors.StateStore(in.GetStoreName()).TooManyTransactionalOps(len(operations), max)
-			apiServerLogger.Debug(err)
-			return &emptypb.Empty{}, err
+			diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(err)
+			return nil, err
 		}
 	}
 
-	if encryption.EncryptedStateStore(in.GetStoreName()) {
+	if encryption.EncryptedStateStore(storeName) || compression.CompressedStateStore(storeName) {
 		for i, op := range operations {
 			switch req := op.(type) {
 			case state.SetRequest:
-				data := []byte(fmt.Sprintf("%v", req.Value))
-				val, err := encryption.TryEncryptValue(in.GetStoreName(), data)
+				val, err := encryption.EncodeStateValue(storeName, req.Key, req.Value)
 				if err != nil {
 					err = apierrors.Basic(codes.Internal, http.StatusInternalServerError, errorcodes.StateTransaction, fmt.Sprintf(messages.ErrStateTransaction, err.Error()))
-					apiServerLogger.Debug(err)
-					return &emptypb.Empty{}, err
+					diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(err)
+					return nil, err
 				}
 
 				req.Value = val
@@ -1017,47 +1195,13 @@ func (a *api) ExecuteStateTransaction(ctx context.Context, in *runtimev1pb.Execu
 		}
 	}
 
-	outboxEnabled := a.outbox.Enabled(in.GetStoreName())
-	if outboxEnabled {
-		span := diagUtils.SpanFromContext(ctx)
-		traceID, traceState := diag.TraceIDAndStateFromSpan(span)
-		ops, err := a.outbox.PublishInternal(ctx, in.GetStoreName(), operations, a.Universal.AppID(), traceID, traceState)
-		if err != nil {
-			nerr := apierrors.PubSubOutbox(a.AppID(), err)
-			apiServerLogger.Debug(nerr)
-			return &emptypb.Empty{}, nerr
-		}
-
-		operations = ops
-	}
-
-	start := time.Now()
-	policyRunner := resiliency.NewRunner[struct{}](ctx,
-		a.Universal.Resiliency().ComponentOutboundPolicy(in.GetStoreName(), resiliency.Statestore),
-	)
-	storeReq := &state.TransactionalStateRequest{
-		Operations: operations,
-		Metadata:   in.GetMetadata(),
-	}
-	_, err := policyRunner(func(ctx context.Context) (struct{}, error) {
-		return struct{}{}, transactionalStore.Multi(ctx, storeReq)
-	})
-	elapsed := diag.ElapsedSince(start)
-
-	diag.DefaultComponentMonitoring.StateInvoked(ctx, in.GetStoreName(), diag.StateTransaction, err == nil, elapsed)
-
-	if err != nil {
-		err = apierrors.Basic(codes.Internal, http.StatusInternalServerError, errorcodes.StateTransaction, fmt.Sprintf(messages.ErrStateTransaction, err.Error()))
-		apiServerLogger.Debug(err)
-		return &emptypb.Empty{}, err
-	}
-	return &emptypb.Empty{}, nil
+	return operations, nil
 }
 
 func (a *api) GetActorState(ctx context.Context, in *runtimev1pb.GetActorStateRequest) (*runtimev1pb.GetActorStateResponse, error) {
 	astate, err := a.ActorState(ctx)
 	if err != nil {
-		apiServerLogger.Debug(err)
+		diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(err)
 		return nil, err
 	}
 
@@ -1074,12 +1218,12 @@ func (a *api) GetActorState(ctx context.Context, in *runtimev1pb.GetActorStateRe
 	resp, err := astate.Get(ctx, &req, true)
 	if err != nil {
 		if _, ok := status.FromError(err); ok {
-			apiServerLogger.Debug(err)
+			diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(err)
 			return nil, err
 		}
 
 		err = messages.ErrActorStateGet.WithFormat(err)
-		apiServerLogger.Debug(err)
+		diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(err)
 		return nil, err
 	}
 
@@ -1092,7 +1236,7 @@ func (a *api) GetActorState(ctx context.Context, in *runtimev1pb.GetActorStateRe
 func (a *api) ExecuteActorStateTransaction(ctx context.Context, in *runtimev1pb.ExecuteActorStateTransactionRequest) (*emptypb.Empty, error) {
 	astate, err := a.ActorState(ctx)
 	if err != nil {
-		apiServerLogger.Debug(err)
+		diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(err)
 		return nil, err
 	}
 
@@ -1130,7 +1274,7 @@ func (a *api) ExecuteActorStateTransaction(ctx context.Context, in *runtimev1pb.
 
 		default:
 			err = apierrors.Basic(codes.Unimplemented, http.StatusInternalServerError, errorcodes.StateNotSupportedOperation, fmt.Sprintf(messages.ErrNotSupportedStateOperation, op.GetOperationType()))
-			apiServerLogger.Debug(err)
+			diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(err)
 			return nil, err
 		}
 
@@ -1146,12 +1290,12 @@ func (a *api) ExecuteActorStateTransaction(ctx context.Context, in *runtimev1pb.
 	err = astate.TransactionalStateOperation(ctx, false, &req, true)
 	if err != nil {
 		if _, ok := status.FromError(err); ok {
-			apiServerLogger.Debug(err)
+			diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(err)
 			return nil, err
 		}
 
 		err = messages.ErrActorStateTransactionSave.WithFormat(err)
-		apiServerLogger.Debug(err)
+		diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(err)
 		return nil, err
 	}
 
@@ -1170,6 +1314,9 @@ func (a *api) InvokeActor(ctx context.Context, in *runtimev1pb.InvokeActorReques
 		in.Metadata = make(map[string]string)
 	}
 	in.Metadata["Dapr-API-Call"] = "true"
+	if baggage := diag.BaggageFromGRPCContext(ctx); baggage != "" {
+		in.Metadata[diagConsts.BaggageHeader] = baggage
+	}
 
 	req := in.ToInternalInvokeRequest()
 
@@ -1182,12 +1329,12 @@ func (a *api) InvokeActor(ctx context.Context, in *runtimev1pb.InvokeActorReques
 	})
 	if err != nil {
 		if _, ok := status.FromError(err); ok {
-			apiServerLogger.Debug(err)
+			diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(err)
 			return nil, err
 		}
 		if !actorerrors.Is(err) {
 			err = messages.ErrActorInvoke.WithFormat(err)
-			apiServerLogger.Debug(err)
+			diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(err)
 			return response, err
 		}
 	}
@@ -1225,29 +1372,46 @@ func (a *api) GetConfiguration(ctx context.Context, in *runtimev1pb.GetConfigura
 
 	store, err := a.getConfigurationStore(in.GetStoreName())
 	if err != nil {
-		apiServerLogger.Debug(err)
+		diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(err)
 		return response, err
 	}
 
+	listReq, err := universal.ConfigurationListRequest(in.GetKeys(), in.GetMetadata())
+	if err != nil {
+		diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(err)
+		return response, err
+	}
 	req := configuration.GetRequest{
 		Keys:     in.GetKeys(),
 		Metadata: in.GetMetadata(),
 	}
 
 	start := time.Now()
-	policyRunner := resiliency.NewRunner[*configuration.GetResponse](ctx,
+	policyRunner := resiliency.NewRunner[*configurationLoader.ListResponse](ctx,
 		a.Universal.Resiliency().ComponentOutboundPolicy(in.GetStoreName(), resiliency.Configuration),
 	)
-	getResponse, err := policyRunner(func(ctx context.Context) (*configuration.GetResponse, error) {
-		return store.Get(ctx, &req)
+	getResponse, err := policyRunner(func(ctx context.Context) (*configurationLoader.ListResponse, error) {
+		if listReq == nil {
+			rResp, rErr := store.Get(ctx, &req)
+			if rResp == nil {
+				return nil, rErr
+			}
+			return &configurationLoader.ListResponse{Items: rResp.Items}, rErr
+		}
+		return configurationLoader.List(ctx, store, listReq)
 	})
 	elapsed := diag.ElapsedSince(start)
 
 	diag.DefaultComponentMonitoring.ConfigurationInvoked(ctx, in.GetStoreName(), diag.Get, err == nil, elapsed)
 
+	if errors.Is(err, configurationLoader.ErrInvalidContinuationToken) {
+		err = messages.ErrBadRequest.WithFormat(err)
+		diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(err)
+		return response, err
+	}
 	if err != nil {
 		richError := apierrors.Basic(codes.Internal, http.StatusInternalServerError, errorcodes.ConfigurationGet, fmt.Sprintf(messages.ErrConfigurationGet, req.Keys, in.GetStoreName(), err.Error()))
-		apiServerLogger.Debug(richError)
+		diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(richError)
 		return response, richError
 	}
 
@@ -1261,6 +1425,11 @@ func (a *api) GetConfiguration(ctx context.Context, in *runtimev1pb.GetConfigura
 			}
 		}
 		response.Items = cachedItems
+
+		// The continuation token of the next page is returned in the response headers
+		if getResponse.ContinuationToken != "" {
+			grpc.SetHeader(ctx, grpcMetadata.Pairs(metadataPrefix+universal.ConfigurationContinuationTokenKey, getResponse.ContinuationToken))
+		}
 	}
 
 	return response, nil
@@ -1309,7 +1478,7 @@ func (h *configurationEventHandler) updateEventHandler(ctx context.Context, e *c
 		Id:    e.ID,
 	})
 	if err != nil {
-		apiServerLogger.Debug(err)
+		diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(err)
 		return err
 	}
 	return nil
@@ -1318,7 +1487,7 @@ func (h *configurationEventHandler) updateEventHandler(ctx context.Context, e *c
 func (a *api) SubscribeConfiguration(request *runtimev1pb.SubscribeConfigurationRequest, stream runtimev1pb.Dapr_SubscribeConfigurationServer) error { //nolint:nosnakecase
 	store, err := a.getConfigurationStore(request.GetStoreName())
 	if err != nil {
-		apiServerLogger.Debug(err)
+		diag.LoggerWithTraceContext(stream.Context(), apiServerLogger).Debug(err)
 		return err
 	}
 
@@ -1347,7 +1516,7 @@ func (a *api) SubscribeConfiguration(request *runtimev1pb.SubscribeConfiguration
 		Id: subscribeID,
 	})
 	if err != nil {
-		apiServerLogger.Debug(err)
+		diag.LoggerWithTraceContext(stream.Context(), apiServerLogger).Debug(err)
 		return err
 	}
 
@@ -1381,10 +1550,19 @@ func (a *api) SubscribeConfiguration(request *runtimev1pb.SubscribeConfiguration
 }
 
 func (a *api) subscribeConfiguration(ctx context.Context, request *runtimev1pb.SubscribeConfigurationRequest, handler *configurationEventHandler, store configuration.Store) (subscribeID string, err error) {
+	filter, md, err := universal.ConfigurationSubscribeFilter(request.GetMetadata())
+	if err != nil {
+		diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(err)
+		return "", err
+	}
 	componentReq := &configuration.SubscribeRequest{
 		Keys:     request.GetKeys(),
-		Metadata: request.GetMetadata(),
+		Metadata: md,
 	}
+	updateHandler := configurationLoader.FilterHandler(filter, configurationLoader.ValidateHandler(
+		handler.updateEventHandler,
+		a.Universal.ConfigurationInvalidHandler(request.GetStoreName(), request.GetMetadata(), a.channels),
+	))
 
 	// TODO(@laurence) deal with failed subscription and retires
 	start := time.Now()
@@ -1392,7 +1570,7 @@ func (a *api) subscribeConfiguration(ctx context.Context, request *runtimev1pb.S
 		a.Universal.Resiliency().ComponentOutboundPolicy(request.GetStoreName(), resiliency.Configuration),
 	)
 	subscribeID, err = policyRunner(func(ctx context.Context) (string, error) {
-		return store.Subscribe(ctx, componentReq, handler.updateEventHandler)
+		return store.Subscribe(ctx, componentReq, updateHandler)
 	})
 	elapsed := diag.ElapsedSince(start)
 
@@ -1400,7 +1578,7 @@ func (a *api) subscribeConfiguration(ctx context.Context, request *runtimev1pb.S
 
 	if err != nil {
 		richError := apierrors.Basic(codes.InvalidArgument, http.StatusInternalServerError, errorcodes.ConfigurationSubscribe, fmt.Sprintf(messages.ErrConfigurationSubscribe, componentReq.Keys, request.GetStoreName(), err))
-		apiServerLogger.Debug(richError)
+		diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(richError)
 		return "", richError
 	}
 
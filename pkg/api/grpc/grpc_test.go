@@ -21,6 +21,7 @@ import (
 	"io"
 	"net"
 	"strconv"
+	"sync"
 	"testing"
 	"time"
 
@@ -40,6 +41,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -72,7 +74,11 @@ import (
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
 	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/reencryption"
 	wfenginefake "github.com/dapr/dapr/pkg/runtime/wfengine/fake"
+	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/state/list"
+	"github.com/dapr/dapr/pkg/security/apitoken"
 	daprt "github.com/dapr/dapr/pkg/testing"
 	testtrace "github.com/dapr/dapr/pkg/testing/trace"
 	"github.com/dapr/kit/logger"
@@ -197,12 +203,16 @@ func (m *mockGRPCAPI) CallActorStream(req *internalv1pb.InternalInvokeRequest, s
 	return nil
 }
 
+func (m *mockGRPCAPI) CallLocalWebSocket(stream internalv1pb.ServiceInvocation_CallLocalWebSocketServer) error { //nolint:nosnakecase
+	return nil
+}
+
 func (m *mockGRPCAPI) CallActorReminder(ctx context.Context, in *internalv1pb.Reminder) (*emptypb.Empty, error) {
 	return new(emptypb.Empty), nil
 }
 
-func (m *mockGRPCAPI) PublishEvent(ctx context.Context, in *runtimev1pb.PublishEventRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, nil
+func (m *mockGRPCAPI) PublishEvent(ctx context.Context, in *runtimev1pb.PublishEventRequest) (*runtimev1pb.PublishEventResponse, error) {
+	return &runtimev1pb.PublishEventResponse{}, nil
 }
 
 func (m *mockGRPCAPI) BulkPublishEventAlpha1(ctx context.Context, in *runtimev1pb.BulkPublishRequest) (*runtimev1pb.BulkPublishResponse, error) {
@@ -332,7 +342,7 @@ func startDaprAPIServer(t *testing.T, testAPIServer *api, token string) *bufconn
 	}
 	streamInterceptors := []grpc.StreamServerInterceptor{}
 	if token != "" {
-		unary, stream := getAPIAuthenticationMiddlewares(token, "dapr-api-token")
+		unary, stream := getAPIAuthenticationMiddlewares(apitoken.Static(token), "dapr-api-token")
 		interceptors = append(interceptors, unary)
 		streamInterceptors = append(streamInterceptors, stream)
 	}
@@ -2352,6 +2362,182 @@ func TestDeleteBulkState(t *testing.T) {
 	}
 }
 
+// etagCheckingStateStore fails the items of bulk sets with a "bad" ETag.
+type etagCheckingStateStore struct {
+	*daprt.FakeStateStore
+
+	reqs []state.SetRequest
+	opts state.BulkStoreOpts
+}
+
+func (s *etagCheckingStateStore) BulkSet(ctx context.Context, reqs []state.SetRequest, opts state.BulkStoreOpts) error {
+	s.reqs = reqs
+	s.opts = opts
+
+	var errs []error
+	for _, req := range reqs {
+		if req.ETag != nil && *req.ETag == "bad" {
+			errs = append(errs, state.NewBulkStoreError(req.Key, state.NewETagError(state.ETagMismatch, nil)))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func TestBulkStateAlpha1(t *testing.T) {
+	store := &etagCheckingStateStore{FakeStateStore: daprt.NewFakeStateStore()}
+	compStore := compstore.New()
+	compStore.AddStateStore("store1", store)
+	lis := startTestServerAPI(t, &api{
+		Universal: universal.New(universal.Options{
+			AppID:      "fakeAPI",
+			Logger:     logger.NewLogger("grpc.api.test"),
+			CompStore:  compStore,
+			Resiliency: resiliency.New(nil),
+		}),
+	})
+
+	clientConn := createTestClient(lis)
+	defer clientConn.Close()
+
+	client := runtimev1pb.NewDaprClient(clientConn)
+
+	t.Run("bulk set with per-item results", func(t *testing.T) {
+		resp, err := client.BulkSetStateAlpha1(t.Context(), &runtimev1pb.BulkSetStateRequestAlpha1{
+			StoreName: "store1",
+			Items: []*commonv1pb.StateItem{
+				{
+					Key:      "a",
+					Value:    []byte("1"),
+					Metadata: map[string]string{"ttlInSeconds": "60"},
+					Options:  &commonv1pb.StateOptions{Consistency: commonv1pb.StateOptions_CONSISTENCY_STRONG},
+				},
+				{Key: "b", Value: []byte("2"), Etag: &commonv1pb.Etag{Value: "bad"}},
+			},
+			Parallelism: ptr.Of(int32(4)),
+			Metadata:    map[string]string{"foo": "bar"},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.GetResults(), 2)
+		assert.Equal(t, "a", resp.GetResults()[0].GetKey())
+		assert.Nil(t, resp.GetResults()[0].Error)
+		assert.Equal(t, "b", resp.GetResults()[1].GetKey())
+		assert.NotEmpty(t, resp.GetResults()[1].GetError())
+
+		require.Len(t, store.reqs, 2)
+		assert.Equal(t, "fakeAPI||a", store.reqs[0].Key)
+		assert.Equal(t, map[string]string{"ttlInSeconds": "60", "foo": "bar"}, store.reqs[0].Metadata)
+		assert.Equal(t, "strong", store.reqs[0].Options.Consistency)
+		assert.Equal(t, 4, store.opts.Parallelism)
+	})
+
+	t.Run("bulk set without key", func(t *testing.T) {
+		_, err := client.BulkSetStateAlpha1(t.Context(), &runtimev1pb.BulkSetStateRequestAlpha1{
+			StoreName: "store1",
+			Items:     []*commonv1pb.StateItem{{Value: []byte("1")}},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("bulk delete", func(t *testing.T) {
+		resp, err := client.BulkDeleteStateAlpha1(t.Context(), &runtimev1pb.BulkDeleteStateRequestAlpha1{
+			StoreName: "store1",
+			Items: []*commonv1pb.StateItem{
+				{Key: "a"},
+				{Key: "b", Etag: &commonv1pb.Etag{Value: "1"}},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.GetResults(), 2)
+		for _, res := range resp.GetResults() {
+			assert.Nil(t, res.Error)
+		}
+		assert.Equal(t, uint64(1), store.CallCount("BulkDelete"))
+	})
+
+	t.Run("store not found", func(t *testing.T) {
+		_, err := client.BulkDeleteStateAlpha1(t.Context(), &runtimev1pb.BulkDeleteStateRequestAlpha1{
+			StoreName: "nostore",
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestListWorkflowsAlpha1(t *testing.T) {
+	wf := wfenginefake.New()
+	lis := startTestServerAPI(t, &api{
+		Universal: universal.New(universal.Options{
+			AppID:          "fakeAPI",
+			Logger:         logger.NewLogger("grpc.api.test"),
+			Resiliency:     resiliency.New(nil),
+			WorkflowEngine: wf,
+			Actors:         fake.New(),
+		}),
+	})
+
+	clientConn := createTestClient(lis)
+	defer clientConn.Close()
+
+	client := runtimev1pb.NewDaprClient(clientConn)
+
+	createdAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("list workflows", func(t *testing.T) {
+		var got *list.ListWorkflowsRequest
+		wf.WithListWorkflows(func(_ context.Context, req *list.ListWorkflowsRequest) (*list.ListWorkflowsResponse, error) {
+			got = req
+			return &list.ListWorkflowsResponse{
+				Workflows: []list.Workflow{{
+					InstanceID: "abc",
+					IndexEntry: wfenginestate.IndexEntry{
+						Name:          "order",
+						RuntimeStatus: "RUNNING",
+						CreatedAt:     createdAt,
+						LastUpdatedAt: createdAt,
+						Tags:          map[string]string{"team": "payments"},
+					},
+				}},
+				ContinuationToken: ptr.Of("next"),
+			}, nil
+		})
+
+		resp, err := client.ListWorkflowsAlpha1(t.Context(), &runtimev1pb.ListWorkflowsRequestAlpha1{
+			RuntimeStatuses:   []string{"RUNNING"},
+			WorkflowName:      "order",
+			CreatedAfter:      timestamppb.New(createdAt.Add(-time.Hour)),
+			Tags:              map[string]string{"team": "payments"},
+			PageSize:          ptr.Of(uint32(10)),
+			ContinuationToken: ptr.Of("abc"),
+		})
+		require.NoError(t, err)
+
+		require.NotNil(t, got)
+		assert.Equal(t, []string{"RUNNING"}, got.Filter.RuntimeStatuses)
+		assert.Equal(t, "order", got.Filter.Name)
+		require.NotNil(t, got.Filter.CreatedAfter)
+		assert.True(t, createdAt.Add(-time.Hour).Equal(*got.Filter.CreatedAfter))
+		assert.Nil(t, got.Filter.CreatedBefore)
+		assert.Equal(t, map[string]string{"team": "payments"}, got.Filter.Tags)
+		assert.Equal(t, uint32(10), *got.PageSize)
+		assert.Equal(t, "abc", *got.ContinuationToken)
+
+		require.Len(t, resp.GetWorkflows(), 1)
+		assert.Equal(t, "abc", resp.GetWorkflows()[0].GetInstanceId())
+		assert.Equal(t, "order", resp.GetWorkflows()[0].GetWorkflowName())
+		assert.Equal(t, "RUNNING", resp.GetWorkflows()[0].GetRuntimeStatus())
+		assert.True(t, createdAt.Equal(resp.GetWorkflows()[0].GetCreatedAt().AsTime()))
+		assert.Equal(t, map[string]string{"team": "payments"}, resp.GetWorkflows()[0].GetTags())
+		assert.Equal(t, "next", resp.GetContinuationToken())
+	})
+
+	t.Run("list error", func(t *testing.T) {
+		wf.WithListWorkflows(func(context.Context, *list.ListWorkflowsRequest) (*list.ListWorkflowsResponse, error) {
+			return nil, errors.New("boom")
+		})
+		_, err := client.ListWorkflowsAlpha1(t.Context(), &runtimev1pb.ListWorkflowsRequestAlpha1{})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
+
 func TestPublishTopic(t *testing.T) {
 	srv := &api{
 		logger: logger.NewLogger("grpc.api.test"),
@@ -2550,6 +2736,155 @@ func TestPublishTopic(t *testing.T) {
 	})
 }
 
+type resultPubSubAdapter struct {
+	daprt.MockPubSubAdapter
+	result *runtimePubsub.PublishResult
+}
+
+func (a *resultPubSubAdapter) PublishWithResult(ctx context.Context, req *pubsub.PublishRequest) (*runtimePubsub.PublishResult, error) {
+	return a.result, a.Publish(ctx, req)
+}
+
+func TestPublishResult(t *testing.T) {
+	adapter := &resultPubSubAdapter{
+		MockPubSubAdapter: daprt.MockPubSubAdapter{
+			PublishFn: func(ctx context.Context, req *pubsub.PublishRequest) error {
+				return nil
+			},
+		},
+	}
+	srv := &api{
+		logger: logger.NewLogger("grpc.api.test"),
+		Universal: universal.New(universal.Options{
+			AppID:     "fakeAPI",
+			CompStore: compstore.New(),
+		}),
+		pubsubAdapter: adapter,
+	}
+
+	mock := daprt.MockPubSub{}
+	mock.On("Features").Return([]pubsub.Feature{})
+	srv.Universal.CompStore().AddPubSub("pubsub", &runtimePubsub.PubsubItem{Component: &mock})
+
+	lis := startTestServerAPI(t, srv)
+	clientConn := createTestClient(lis)
+	defer clientConn.Close()
+	client := runtimev1pb.NewDaprClient(clientConn)
+
+	req := &runtimev1pb.PublishEventRequest{
+		PubsubName: "pubsub",
+		Topic:      "topic",
+	}
+
+	t.Run("broker identifiers are returned", func(t *testing.T) {
+		adapter.result = &runtimePubsub.PublishResult{MessageID: "msg1", Partition: ptr.Of(int32(0)), Offset: "42"}
+		res, err := client.PublishEvent(t.Context(), req)
+		require.NoError(t, err)
+		assert.Equal(t, "msg1", res.GetMessageId())
+		require.NotNil(t, res.Partition)
+		assert.Equal(t, int32(0), res.GetPartition())
+		assert.Equal(t, "42", res.GetOffset())
+	})
+
+	t.Run("no broker identifiers", func(t *testing.T) {
+		adapter.result = nil
+		res, err := client.PublishEvent(t.Context(), req)
+		require.NoError(t, err)
+		assert.Empty(t, res.GetMessageId())
+		assert.Nil(t, res.Partition)
+		assert.Empty(t, res.GetOffset())
+	})
+}
+
+func TestRedriveDeadLetter(t *testing.T) {
+	var lock sync.Mutex
+	var redriven []string
+	srv := &api{
+		logger: logger.NewLogger("grpc.api.test"),
+		Universal: universal.New(universal.Options{
+			AppID:     "fakeAPI",
+			CompStore: compstore.New(),
+		}),
+		pubsubAdapter: &daprt.MockPubSubAdapter{
+			PublishFn: func(ctx context.Context, req *pubsub.PublishRequest) error {
+				if req.PubsubName == "errorpubsub" {
+					return errors.New("error when publish")
+				}
+				lock.Lock()
+				defer lock.Unlock()
+				redriven = append(redriven, req.Topic)
+				return nil
+			},
+		},
+	}
+
+	data, _, err := runtimePubsub.DeadLetter{
+		Topic:           "orders",
+		DeadLetterTopic: "orders-dlq",
+		Reason:          runtimePubsub.DeadLetterReasonError,
+	}.Stamp([]byte(`{"specversion":"1.0","id":"1","type":"order"}`), nil, time.Now())
+	require.NoError(t, err)
+
+	mockPubSub := daprt.MockPubSub{}
+	mockPubSub.On("Subscribe", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		handler := args.Get(1).(pubsub.Handler)
+		go handler(t.Context(), &pubsub.NewMessage{Data: data, Topic: "orders-dlq"})
+	}).Return(nil)
+	srv.Universal.CompStore().AddPubSub("pubsub", &runtimePubsub.PubsubItem{Component: &mockPubSub})
+	srv.Universal.CompStore().AddPubSub("errorpubsub", &runtimePubsub.PubsubItem{Component: &mockPubSub})
+
+	lis := startTestServerAPI(t, srv)
+	clientConn := createTestClient(lis)
+	defer clientConn.Close()
+	client := runtimev1pb.NewDaprClient(clientConn)
+
+	t.Run("redrive successfully", func(t *testing.T) {
+		res, err := client.RedriveDeadLetterAlpha1(t.Context(), &runtimev1pb.RedriveDeadLetterRequestAlpha1{
+			PubsubName:  "pubsub",
+			Topic:       "orders-dlq",
+			Filter:      ptr.Of("event.type == 'order'"),
+			IdleTimeout: ptr.Of("50ms"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), res.GetRedriven())
+		assert.Equal(t, int32(0), res.GetSkipped())
+		lock.Lock()
+		defer lock.Unlock()
+		assert.Equal(t, []string{"orders"}, redriven)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		for _, req := range []*runtimev1pb.RedriveDeadLetterRequestAlpha1{
+			{Filter: ptr.Of("event.type ==")},
+			{IdleTimeout: ptr.Of("soon")},
+			{MaxMessages: ptr.Of(int32(-1))},
+			{RatePerSecond: ptr.Of(-1.0)},
+		} {
+			req.PubsubName = "pubsub"
+			req.Topic = "orders-dlq"
+			_, err := client.RedriveDeadLetterAlpha1(t.Context(), req)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), req.String())
+		}
+	})
+
+	t.Run("redrive unsuccessfully", func(t *testing.T) {
+		_, err := client.RedriveDeadLetterAlpha1(t.Context(), &runtimev1pb.RedriveDeadLetterRequestAlpha1{
+			PubsubName:  "errorpubsub",
+			Topic:       "orders-dlq",
+			IdleTimeout: ptr.Of("50ms"),
+		})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("pubsub not found", func(t *testing.T) {
+		_, err := client.RedriveDeadLetterAlpha1(t.Context(), &runtimev1pb.RedriveDeadLetterRequestAlpha1{
+			PubsubName: "notfound",
+			Topic:      "orders-dlq",
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
 func TestBulkPublish(t *testing.T) {
 	fakeAPI := &api{
 		logger: logger.NewLogger("test"),
@@ -2579,6 +2914,22 @@ func TestBulkPublish(t *testing.T) {
 							entries = append(entries, entry)
 						}
 					}
+				} else if req.Topic == "exhausted-error-topic" {
+					// Simulates the partial failure reported by the runtime once retries are exhausted.
+					entries = append(entries, pubsub.BulkPublishResponseFailedEntry{
+						EntryId: req.Entries[0].EntryId,
+						Error:   errors.New("error on publish"),
+					})
+					return pubsub.BulkPublishResponse{FailedEntries: entries}, runtimePubsub.ErrBulkPublishFailure
+				} else if req.Topic == "metadata-topic" {
+					for _, e := range req.Entries {
+						if e.Metadata["partitionKey"] == "" {
+							entries = append(entries, pubsub.BulkPublishResponseFailedEntry{
+								EntryId: e.EntryId,
+								Error:   errors.New("missing partition key"),
+							})
+						}
+					}
 				}
 				// Mock simulates only partial failures or total success, so error is always nil.
 				return pubsub.BulkPublishResponse{FailedEntries: entries}, nil
@@ -2653,6 +3004,33 @@ func TestBulkPublish(t *testing.T) {
 		assert.NotNil(t, res)
 		assert.Len(t, res.GetFailedEntries(), 2)
 	})
+
+	t.Run("partial failures after exhausted retries", func(t *testing.T) {
+		res, err := client.BulkPublishEventAlpha1(t.Context(), &runtimev1pb.BulkPublishRequest{
+			PubsubName: "pubsub",
+			Topic:      "exhausted-error-topic",
+			Entries:    sampleEntries,
+		})
+		// Per-entry status is available, so expecting no error
+		require.NoError(t, err)
+		require.Len(t, res.GetFailedEntries(), 1)
+		assert.Equal(t, "1", res.GetFailedEntries()[0].GetEntryId())
+		assert.Equal(t, "error on publish", res.GetFailedEntries()[0].GetError())
+	})
+
+	t.Run("entries inherit request metadata", func(t *testing.T) {
+		res, err := client.BulkPublishEventAlpha1(t.Context(), &runtimev1pb.BulkPublishRequest{
+			PubsubName: "pubsub",
+			Topic:      "metadata-topic",
+			Entries: []*runtimev1pb.BulkPublishRequestEntry{
+				{EntryId: "1", Event: []byte("data1")},
+				{EntryId: "2", Event: []byte("data2"), Metadata: map[string]string{"partitionKey": "entry"}},
+			},
+			Metadata: map[string]string{"partitionKey": "request"},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, res.GetFailedEntries())
+	})
 }
 
 func TestInvokeBinding(t *testing.T) {
@@ -2991,6 +3369,289 @@ const (
 	queryTestRequestSyntaxErr = `syntax error`
 )
 
+func TestStateAtomic(t *testing.T) {
+	fakeStore := daprt.NewFakeStateStore()
+	compStore := compstore.New()
+	compStore.AddStateStore("store1", fakeStore)
+	lis := startTestServerAPI(t, &api{
+		Universal: universal.New(universal.Options{
+			AppID:      "fakeAPI",
+			Logger:     logger.NewLogger("grpc.api.test"),
+			CompStore:  compStore,
+			Resiliency: resiliency.New(nil),
+		}),
+	})
+
+	clientConn := createTestClient(lis)
+	defer clientConn.Close()
+
+	client := runtimev1pb.NewDaprClient(clientConn)
+
+	t.Run("increment new key", func(t *testing.T) {
+		resp, err := client.IncrementStateAlpha1(t.Context(), &runtimev1pb.IncrementStateRequestAlpha1{
+			StoreName: "store1",
+			Key:       "counter",
+			Delta:     ptr.Of(int64(-3)),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(-3), resp.GetValue())
+	})
+
+	t.Run("increment non-integer value", func(t *testing.T) {
+		require.NoError(t, fakeStore.Set(t.Context(), &state.SetRequest{Key: "fakeAPI||text", Value: "abc"}))
+		_, err := client.IncrementStateAlpha1(t.Context(), &runtimev1pb.IncrementStateRequestAlpha1{
+			StoreName: "store1",
+			Key:       "text",
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("append new key", func(t *testing.T) {
+		resp, err := client.AppendStateAlpha1(t.Context(), &runtimev1pb.AppendStateRequestAlpha1{
+			StoreName: "store1",
+			Key:       "list",
+			Values:    [][]byte{[]byte(`1`), []byte(`"a"`)},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), resp.GetLength())
+	})
+
+	t.Run("append invalid values", func(t *testing.T) {
+		for _, values := range [][][]byte{nil, {[]byte(`{`)}} {
+			_, err := client.AppendStateAlpha1(t.Context(), &runtimev1pb.AppendStateRequestAlpha1{
+				StoreName: "store1",
+				Key:       "list",
+				Values:    values,
+			})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+	})
+
+	t.Run("store not found", func(t *testing.T) {
+		_, err := client.IncrementStateAlpha1(t.Context(), &runtimev1pb.IncrementStateRequestAlpha1{
+			StoreName: "nostore",
+			Key:       "counter",
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+// etagTransactionStateStore fails transactions with operations with a "bad"
+// ETag.
+type etagTransactionStateStore struct {
+	*daprt.FakeStateStore
+
+	ops []state.TransactionalStateOperation
+}
+
+func (s *etagTransactionStateStore) Multi(ctx context.Context, req *state.TransactionalStateRequest) error {
+	s.ops = req.Operations
+	for _, op := range req.Operations {
+		if set, ok := op.(state.SetRequest); ok && set.ETag != nil && *set.ETag == "bad" {
+			return state.NewETagError(state.ETagMismatch, nil)
+		}
+	}
+	return nil
+}
+
+func TestCheckAndSetStateAlpha1(t *testing.T) {
+	store := &etagTransactionStateStore{FakeStateStore: daprt.NewFakeStateStore()}
+	require.NoError(t, store.Set(t.Context(), &state.SetRequest{Key: "fakeAPI||b", Value: "v"}))
+	res, err := store.Get(t.Context(), &state.GetRequest{Key: "fakeAPI||b"})
+	require.NoError(t, err)
+	etagB := *res.ETag
+
+	compStore := compstore.New()
+	compStore.AddStateStore("store1", store)
+	compStore.AddStateStore("notransactions", &daprt.MockStateStore{})
+	lis := startTestServerAPI(t, &api{
+		Universal: universal.New(universal.Options{
+			AppID:      "fakeAPI",
+			Logger:     logger.NewLogger("grpc.api.test"),
+			CompStore:  compStore,
+			Resiliency: resiliency.New(nil),
+		}),
+	})
+
+	clientConn := createTestClient(lis)
+	defer clientConn.Close()
+
+	client := runtimev1pb.NewDaprClient(clientConn)
+
+	upsert := func(key string) *runtimev1pb.TransactionalStateOperation {
+		return &runtimev1pb.TransactionalStateOperation{
+			OperationType: string(state.OperationUpsert),
+			Request:       &commonv1pb.StateItem{Key: key, Value: []byte("1")},
+		}
+	}
+
+	t.Run("checks match", func(t *testing.T) {
+		_, err := client.CheckAndSetStateAlpha1(t.Context(), &runtimev1pb.CheckAndSetStateRequestAlpha1{
+			StoreName:  "store1",
+			Checks:     []*runtimev1pb.StateETagCheckAlpha1{{Key: "a", Etag: "1"}, {Key: "b", Etag: etagB}},
+			Operations: []*runtimev1pb.TransactionalStateOperation{upsert("a")},
+		})
+		require.NoError(t, err)
+
+		require.Len(t, store.ops, 2)
+		set := store.ops[0].(state.SetRequest)
+		assert.Equal(t, "fakeAPI||a", set.Key)
+		assert.Equal(t, "1", *set.ETag)
+		set = store.ops[1].(state.SetRequest)
+		assert.Equal(t, "fakeAPI||b", set.Key)
+		assert.Equal(t, etagB, *set.ETag)
+	})
+
+	t.Run("written key doesn't match", func(t *testing.T) {
+		_, err := client.CheckAndSetStateAlpha1(t.Context(), &runtimev1pb.CheckAndSetStateRequestAlpha1{
+			StoreName:  "store1",
+			Checks:     []*runtimev1pb.StateETagCheckAlpha1{{Key: "a", Etag: "bad"}},
+			Operations: []*runtimev1pb.TransactionalStateOperation{upsert("a")},
+		})
+		assert.Equal(t, codes.Aborted, status.Code(err))
+	})
+
+	t.Run("no checks", func(t *testing.T) {
+		_, err := client.CheckAndSetStateAlpha1(t.Context(), &runtimev1pb.CheckAndSetStateRequestAlpha1{
+			StoreName:  "store1",
+			Operations: []*runtimev1pb.TransactionalStateOperation{upsert("a")},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("store without transactions", func(t *testing.T) {
+		_, err := client.CheckAndSetStateAlpha1(t.Context(), &runtimev1pb.CheckAndSetStateRequestAlpha1{
+			StoreName: "notransactions",
+			Checks:    []*runtimev1pb.StateETagCheckAlpha1{{Key: "a", Etag: "1"}},
+		})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
+
+func TestStateReencryption(t *testing.T) {
+	compStore := compstore.New()
+	compStore.AddStateStore("store1", daprt.NewFakeStateStore())
+	compStore.AddStateStore("encrypted", daprt.NewFakeStateStore())
+	encryption.AddEncryptedStateStore("encrypted", encryption.ComponentEncryptionKeys{})
+	t.Cleanup(func() { encryption.RemoveEncryptedStateStore("encrypted") })
+	lis := startTestServerAPI(t, &api{
+		Universal: universal.New(universal.Options{
+			AppID:      "fakeAPI",
+			Logger:     logger.NewLogger("grpc.api.test"),
+			CompStore:  compStore,
+			Resiliency: resiliency.New(nil),
+			StateReencryption: reencryption.New(reencryption.Options{
+				GetStateFn: compStore.GetStateStore,
+				Resiliency: resiliency.New(nil),
+			}),
+		}),
+	})
+
+	clientConn := createTestClient(lis)
+	defer clientConn.Close()
+
+	client := runtimev1pb.NewDaprClient(clientConn)
+
+	t.Run("no job started", func(t *testing.T) {
+		_, err := client.GetReencryptStateStatusAlpha1(t.Context(), &runtimev1pb.GetReencryptStateStatusRequestAlpha1{
+			StoreName: "encrypted",
+		})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("start job", func(t *testing.T) {
+		resp, err := client.ReencryptStateAlpha1(t.Context(), &runtimev1pb.ReencryptStateRequestAlpha1{
+			StoreName: "encrypted",
+			Keys:      []string{"a"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "encrypted", resp.GetStoreName())
+		assert.Equal(t, string(reencryption.StateRunning), resp.GetState())
+		assert.NotNil(t, resp.GetStartedAt())
+
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			resp, err = client.GetReencryptStateStatusAlpha1(t.Context(), &runtimev1pb.GetReencryptStateStatusRequestAlpha1{
+				StoreName: "encrypted",
+			})
+			require.NoError(c, err)
+			assert.NotEqual(c, string(reencryption.StateRunning), resp.GetState())
+			assert.NotNil(c, resp.GetCompletedAt())
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("store not encrypted", func(t *testing.T) {
+		_, err := client.ReencryptStateAlpha1(t.Context(), &runtimev1pb.ReencryptStateRequestAlpha1{
+			StoreName: "store1",
+			Keys:      []string{"a"},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("store not found", func(t *testing.T) {
+		_, err := client.ReencryptStateAlpha1(t.Context(), &runtimev1pb.ReencryptStateRequestAlpha1{
+			StoreName: "nostore",
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestSubscribeState(t *testing.T) {
+	fakeStore := daprt.NewFakeStateStore()
+	compStore := compstore.New()
+	compStore.AddStateStore("store1", fakeStore)
+	lis := startTestServerAPI(t, &api{
+		Universal: universal.New(universal.Options{
+			AppID:      "fakeAPI",
+			Logger:     logger.NewLogger("grpc.api.test"),
+			CompStore:  compStore,
+			Resiliency: resiliency.New(nil),
+		}),
+	})
+
+	clientConn := createTestClient(lis)
+	defer clientConn.Close()
+
+	client := runtimev1pb.NewDaprClient(clientConn)
+
+	t.Run("invalid request", func(t *testing.T) {
+		for _, req := range []*runtimev1pb.SubscribeStateRequestAlpha1{
+			{StoreName: "store1"},
+			{StoreName: "store1", Keys: []string{"a"}, PollInterval: ptr.Of("1ms")},
+			{StoreName: "nostore", Keys: []string{"a"}},
+		} {
+			stream, err := client.SubscribeStateAlpha1(t.Context(), req)
+			require.NoError(t, err)
+			_, err = stream.Recv()
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), req.String())
+		}
+	})
+
+	t.Run("changes are streamed", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+
+		stream, err := client.SubscribeStateAlpha1(ctx, &runtimev1pb.SubscribeStateRequestAlpha1{
+			StoreName:    "store1",
+			Keys:         []string{"a", "b"},
+			PollInterval: ptr.Of("100ms"),
+		})
+		require.NoError(t, err)
+
+		// Changes are only reported once the initial values are read.
+		assert.Eventually(t, func() bool {
+			return fakeStore.CallCount("BulkGet") > 0
+		}, 5*time.Second, time.Millisecond)
+		require.NoError(t, fakeStore.Set(t.Context(), &state.SetRequest{Key: "fakeAPI||b", Value: map[string]int{"n": 1}}))
+
+		resp, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, "b", resp.GetKey())
+		assert.Equal(t, "create", resp.GetType())
+		assert.JSONEq(t, `{"n":1}`, string(resp.GetValue()))
+		assert.NotNil(t, resp.Etag)
+	})
+}
+
 func TestQueryState(t *testing.T) {
 	fakeStore := &mockStateStoreQuerier{}
 	// simulate full result
@@ -3069,6 +3730,81 @@ func TestQueryState(t *testing.T) {
 	assert.Equal(t, codes.Internal, status.Code(err))
 }
 
+type fakeQueryStateStore struct {
+	*daprt.FakeStateStore
+}
+
+func (s *fakeQueryStateStore) Query(ctx context.Context, req *state.QueryRequest) (*state.QueryResponse, error) {
+	return &state.QueryResponse{
+		Results: []state.QueryItem{
+			{Key: "fakeAPI||a", Data: []byte(`{"name":"alice","age":30}`), ETag: ptr.Of("1")},
+			{Key: "fakeAPI||b", Data: []byte(`{"name":"bob","age":25}`)},
+		},
+	}, nil
+}
+
+func TestQueryStateAlpha2(t *testing.T) {
+	compStore := compstore.New()
+	compStore.AddStateStore("store1", &fakeQueryStateStore{FakeStateStore: daprt.NewFakeStateStore()})
+	compStore.AddStateStore("noquery", daprt.NewFakeStateStore())
+	lis := startTestServerAPI(t, &api{
+		Universal: universal.New(universal.Options{
+			AppID:      "fakeAPI",
+			Logger:     logger.NewLogger("grpc.api.test"),
+			CompStore:  compStore,
+			Resiliency: resiliency.New(nil),
+		}),
+	})
+
+	clientConn := createTestClient(lis)
+	defer clientConn.Close()
+
+	client := runtimev1pb.NewDaprClient(clientConn)
+
+	t.Run("projection", func(t *testing.T) {
+		resp, err := client.QueryStateAlpha2(t.Context(), &runtimev1pb.QueryStateRequestAlpha2{
+			StoreName: "store1",
+			Query:     `{"select": ["name"], "orderBy": [{"key": "age"}]}`,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.GetResults(), 2)
+		assert.Equal(t, "a", resp.GetResults()[0].GetKey())
+		assert.JSONEq(t, `{"name":"alice"}`, string(resp.GetResults()[0].GetData()))
+		assert.Equal(t, "1", resp.GetResults()[0].GetEtag())
+		assert.Equal(t, "b", resp.GetResults()[1].GetKey())
+		assert.Empty(t, resp.GetAggregates())
+		assert.Nil(t, resp.Cursor)
+	})
+
+	t.Run("aggregates", func(t *testing.T) {
+		resp, err := client.QueryStateAlpha2(t.Context(), &runtimev1pb.QueryStateRequestAlpha2{
+			StoreName: "store1",
+			Query:     `{"aggregate": [{"function": "count"}, {"function": "max", "field": "age"}]}`,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, resp.GetResults())
+		assert.Equal(t, map[string][]byte{"count": []byte("2"), "max(age)": []byte("30")}, resp.GetAggregates())
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		for _, q := range []string{`{`, `{"aggregate": [{"function": "avg"}]}`} {
+			_, err := client.QueryStateAlpha2(t.Context(), &runtimev1pb.QueryStateRequestAlpha2{
+				StoreName: "store1",
+				Query:     q,
+			})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+	})
+
+	t.Run("store without queries", func(t *testing.T) {
+		_, err := client.QueryStateAlpha2(t.Context(), &runtimev1pb.QueryStateRequestAlpha2{
+			StoreName: "noquery",
+			Query:     `{}`,
+		})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
+
 func TestStateStoreQuerierNotImplemented(t *testing.T) {
 	compStore := compstore.New()
 	compStore.AddStateStore("store1", &daprt.MockStateStore{})
@@ -4113,6 +4849,164 @@ func TestUnlock(t *testing.T) {
 	})
 }
 
+func TestRenewLock(t *testing.T) {
+	l := logger.NewLogger("fakeLogger")
+	resiliencyConfig := resiliency.FromConfigurations(l, testResiliency)
+
+	t.Run("InvalidArgument: ExpiryInSeconds is not positive", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+
+		compStore := compstore.New()
+		compStore.AddLock("mock", daprt.NewMockStore(ctl))
+		api := NewAPI(APIOpts{
+			Universal: universal.New(universal.Options{
+				Resiliency: resiliencyConfig,
+				Logger:     l,
+				CompStore:  compStore,
+			}),
+		})
+		req := &runtimev1pb.RenewLockRequest{
+			StoreName:  "mock",
+			ResourceId: "resource",
+			LockOwner:  "owner",
+		}
+		_, err := api.RenewLockAlpha1(t.Context(), req)
+		assert.Equal(t, "api error: code = InvalidArgument desc = ExpiryInSeconds is not positive in lock store mock", err.Error())
+	})
+
+	t.Run("re-acquires the lock when the store can't renew it", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+
+		mockLockStore := daprt.NewMockStore(ctl)
+		gomock.InOrder(
+			mockLockStore.EXPECT().Unlock(t.Context(), gomock.Any()).DoAndReturn(func(ctx context.Context, req *lock.UnlockRequest) (*lock.UnlockResponse, error) {
+				assert.Equal(t, "lock||resource", req.ResourceID)
+				assert.Equal(t, "owner", req.LockOwner)
+				return &lock.UnlockResponse{Status: lock.Success}, nil
+			}),
+			mockLockStore.EXPECT().TryLock(t.Context(), gomock.Any()).DoAndReturn(func(ctx context.Context, req *lock.TryLockRequest) (*lock.TryLockResponse, error) {
+				assert.Equal(t, "lock||resource", req.ResourceID)
+				assert.Equal(t, "owner", req.LockOwner)
+				assert.Equal(t, int32(10), req.ExpiryInSeconds)
+				return &lock.TryLockResponse{Success: true}, nil
+			}),
+		)
+		compStore := compstore.New()
+		compStore.AddLock("mock", mockLockStore)
+		api := NewAPI(APIOpts{
+			Universal: universal.New(universal.Options{
+				Resiliency: resiliencyConfig,
+				Logger:     l,
+				CompStore:  compStore,
+			}),
+		})
+		req := &runtimev1pb.RenewLockRequest{
+			StoreName:       "mock",
+			ResourceId:      "resource",
+			LockOwner:       "owner",
+			ExpiryInSeconds: 10,
+		}
+		resp, err := api.RenewLockAlpha1(t.Context(), req)
+		require.NoError(t, err)
+		assert.Equal(t, runtimev1pb.RenewLockResponse_SUCCESS, resp.GetStatus()) //nolint:nosnakecase
+	})
+
+	t.Run("lock of another owner isn't re-acquired", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+
+		mockLockStore := daprt.NewMockStore(ctl)
+		mockLockStore.EXPECT().Unlock(t.Context(), gomock.Any()).Return(&lock.UnlockResponse{Status: lock.LockBelongsToOthers}, nil)
+		compStore := compstore.New()
+		compStore.AddLock("mock", mockLockStore)
+		api := NewAPI(APIOpts{
+			Universal: universal.New(universal.Options{
+				Resiliency: resiliencyConfig,
+				Logger:     l,
+				CompStore:  compStore,
+			}),
+		})
+		req := &runtimev1pb.RenewLockRequest{
+			StoreName:       "mock",
+			ResourceId:      "resource",
+			LockOwner:       "owner",
+			ExpiryInSeconds: 10,
+		}
+		resp, err := api.RenewLockAlpha1(t.Context(), req)
+		require.NoError(t, err)
+		assert.Equal(t, runtimev1pb.RenewLockResponse_LOCK_BELONGS_TO_OTHERS, resp.GetStatus()) //nolint:nosnakecase
+	})
+}
+
+// rwLockStore is a lock store with native shared locks.
+type rwLockStore struct {
+	*daprt.FakeLockStore
+	readers map[string]bool
+}
+
+func (s *rwLockStore) TryLockShared(_ context.Context, req *lock.TryLockRequest) (*lock.TryLockResponse, error) {
+	s.readers[req.ResourceID+"/"+req.LockOwner] = true
+	return &lock.TryLockResponse{Success: true}, nil
+}
+
+func (s *rwLockStore) UnlockShared(_ context.Context, req *lock.UnlockRequest) (*lock.UnlockResponse, error) {
+	if !s.readers[req.ResourceID+"/"+req.LockOwner] {
+		return &lock.UnlockResponse{Status: lock.LockDoesNotExist}, nil
+	}
+	delete(s.readers, req.ResourceID+"/"+req.LockOwner)
+	return &lock.UnlockResponse{Status: lock.Success}, nil
+}
+
+func TestSharedLock(t *testing.T) {
+	l := logger.NewLogger("fakeLogger")
+	compStore := compstore.New()
+	compStore.AddLock("rw", &rwLockStore{FakeLockStore: &daprt.FakeLockStore{}, readers: map[string]bool{}})
+	compStore.AddLock("exclusive", &daprt.FakeLockStore{})
+	api := NewAPI(APIOpts{
+		Universal: universal.New(universal.Options{
+			Resiliency: resiliency.FromConfigurations(l, testResiliency),
+			Logger:     l,
+			CompStore:  compStore,
+		}),
+	})
+
+	t.Run("lock and unlock", func(t *testing.T) {
+		tryLockRes, err := api.TryLockSharedAlpha1(t.Context(), &runtimev1pb.TryLockSharedRequest{
+			StoreName:       "rw",
+			ResourceId:      "resource",
+			LockOwner:       "owner",
+			ExpiryInSeconds: 10,
+		})
+		require.NoError(t, err)
+		assert.True(t, tryLockRes.GetSuccess())
+
+		for _, expected := range []runtimev1pb.UnlockSharedResponse_Status{
+			runtimev1pb.UnlockSharedResponse_SUCCESS,             //nolint:nosnakecase
+			runtimev1pb.UnlockSharedResponse_LOCK_DOES_NOT_EXIST, //nolint:nosnakecase
+		} {
+			unlockRes, err := api.UnlockSharedAlpha1(t.Context(), &runtimev1pb.UnlockSharedRequest{
+				StoreName:  "rw",
+				ResourceId: "resource",
+				LockOwner:  "owner",
+			})
+			require.NoError(t, err)
+			assert.Equal(t, expected, unlockRes.GetStatus())
+		}
+	})
+
+	t.Run("Unimplemented: lock store without shared locks", func(t *testing.T) {
+		_, err := api.TryLockSharedAlpha1(t.Context(), &runtimev1pb.TryLockSharedRequest{
+			StoreName:       "exclusive",
+			ResourceId:      "resource",
+			LockOwner:       "owner",
+			ExpiryInSeconds: 10,
+		})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
+
 func TestMetadata(t *testing.T) {
 	compStore := compstore.New()
 	require.NoError(t, compStore.AddPendingComponentForCommit(componentsV1alpha1.Component{
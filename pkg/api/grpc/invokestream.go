@@ -0,0 +1,252 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/cenkalti/backoff/v4"
+
+	"github.com/dapr/dapr/pkg/api/grpc/metadata"
+	"github.com/dapr/dapr/pkg/messages"
+	"github.com/dapr/dapr/pkg/messaging"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+)
+
+// InvokeServiceStreamAlpha1 invokes a method on a remote Dapr app, streaming the request and response bodies.
+// The request body is forwarded to the target as it's received, and retries of resiliency policies replay it from a buffer bounded by the max replay buffer size.
+// Retries are only possible until the first chunk of the response is sent to the caller.
+func (a *api) InvokeServiceStreamAlpha1(stream runtimev1pb.Dapr_InvokeServiceStreamAlpha1Server) error { //nolint:nosnakecase
+	if a.directMessaging == nil {
+		return messages.ErrDirectInvokeNotReady
+	}
+
+	ctx := stream.Context()
+
+	// Get the first message from the caller containing the request details
+	chunk := &runtimev1pb.InvokeServiceStreamRequest{}
+	err := stream.RecvMsg(chunk)
+	if err != nil {
+		err = messages.ErrBadRequest.WithFormat(fmt.Errorf("error receiving the first message: %w", err))
+		a.logger.Debug(err)
+		return err
+	}
+	in := chunk.GetRequest()
+	if in.GetId() == "" || in.GetMessage().GetMethod() == "" {
+		err = messages.ErrBadRequest.WithFormat("first message does not contain a request with the app id and method")
+		a.logger.Debug(err)
+		return err
+	}
+	targetID := in.GetId()
+
+	policyDef := a.Universal.Resiliency().EndpointPolicy(targetID, targetID+":"+in.GetMessage().GetMethod())
+
+	// The body of the request is a pipe to which the payload of each chunk is written
+	// The pipe is passed as a plain io.Reader so replaying the request doesn't close it once read, which would make reads fail rather than return io.EOF
+	pr, pw := io.Pipe()
+	defer pr.Close()
+	dataTypeURL := in.GetMessage().GetData().GetTypeUrl()
+	req := invokev1.FromInvokeRequestMessage(in.GetMessage()).
+		WithRawData(struct{ io.Reader }{pr}).
+		WithDataTypeURL(dataTypeURL)
+	if policyDef != nil {
+		req.WithReplay(policyDef.HasRetries()).
+			WithMaxReplaySize(a.maxReplayBufferSize)
+	}
+	defer req.Close()
+
+	if incomingMD, ok := metadata.FromIncomingContext(ctx); ok {
+		req.WithMetadata(incomingMD)
+	}
+
+	// Read the rest of the body in background as we submit the request
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+		pw.CloseWithError(readInvokeServiceStreamBody(stream, chunk, pw))
+	}()
+
+	policyRunner := resiliency.NewRunner[*invokeServiceResp](ctx, policyDef)
+	success := atomic.Bool{}
+	resp, err := policyRunner(func(ctx context.Context) (*invokeServiceResp, error) {
+		imr, rErr := a.directMessaging.Invoke(ctx, targetID, req)
+		if imr != nil {
+			defer imr.Close()
+		}
+		if rErr != nil {
+			return nil, messages.ErrDirectInvoke.WithFormat(targetID, rErr)
+		}
+
+		rResp := &invokeServiceResp{
+			headers: invokev1.InternalMetadataToGrpcMetadata(ctx, imr.Headers(), true),
+		}
+		if imr.IsHTTPResponse() {
+			code := int(imr.Status().GetCode())
+			rResp.headers.Set(daprHTTPStatusHeader, strconv.Itoa(code))
+			if invokev1.ErrorFromHTTPResponseCode(code, "") != nil {
+				// The body of an error response is included in the error, like InvokeService does
+				body, _ := imr.RawDataFull()
+				return rResp, invokev1.ErrorFromHTTPResponseCode(code, string(body))
+			}
+		} else {
+			// Only include trailers if appchannel uses gRPC
+			rResp.trailers = invokev1.InternalMetadataToGrpcMetadata(ctx, imr.Trailers(), false)
+			rErr = invokev1.ErrorFromInternalStatus(imr.Status())
+			if rErr != nil {
+				return rResp, rErr
+			}
+		}
+
+		// Once we start sending the response to the caller, the request can't be retried anymore, so all errors from here on are permanent
+		if !success.CompareAndSwap(false, true) {
+			return rResp, backoff.Permanent(errors.New("already completed"))
+		}
+
+		rErr = a.sendInvokeServiceStreamResponse(stream, imr, rResp)
+		if rErr != nil {
+			return nil, backoff.Permanent(rErr)
+		}
+		return nil, nil
+	})
+
+	if err != nil {
+		// If the response was already being sent, headers and trailers were set already
+		if resp != nil && !success.Load() {
+			if resp.headers != nil {
+				stream.SetHeader(resp.headers)
+			}
+			if resp.trailers != nil {
+				stream.SetTrailer(resp.trailers)
+			}
+		}
+		a.logger.Debugf("Streamed service invocation failed to complete with error: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// readInvokeServiceStreamBody reads the payloads of the chunks of the stream, starting from the one that was already received, and writes them to w.
+func readInvokeServiceStreamBody(stream runtimev1pb.Dapr_InvokeServiceStreamAlpha1Server, chunk *runtimev1pb.InvokeServiceStreamRequest, w io.Writer) error { //nolint:nosnakecase
+	var expectSeq uint64
+	for {
+		if stream.Context().Err() != nil {
+			return stream.Context().Err()
+		}
+
+		// Get the payload from the chunk that was previously read
+		if payload := chunk.GetPayload(); payload != nil {
+			readSeq, err := messaging.ReadChunk(payload, w)
+			if err != nil {
+				return err
+			}
+
+			// Check if the sequence number is greater than the previous
+			if readSeq != expectSeq {
+				return fmt.Errorf("invalid sequence number received: %d (expected: %d)", readSeq, expectSeq)
+			}
+			expectSeq++
+		}
+
+		// Read the next chunk
+		chunk.Reset()
+		err := stream.RecvMsg(chunk)
+		if errors.Is(err, io.EOF) {
+			// Receiving an io.EOF signifies that the client has stopped sending data over the pipe, so we can stop reading
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("error receiving message: %w", err)
+		}
+
+		if chunk.GetRequest() != nil {
+			return errors.New("request found in non-leading message")
+		}
+	}
+}
+
+// sendInvokeServiceStreamResponse sends the response to the caller, chunk by chunk.
+// The first message contains the response details, with the headers and trailers set on the stream.
+func (a *api) sendInvokeServiceStreamResponse(stream runtimev1pb.Dapr_InvokeServiceStreamAlpha1Server, imr *invokev1.InvokeMethodResponse, resp *invokeServiceResp) error { //nolint:nosnakecase
+	if resp.headers != nil {
+		err := stream.SetHeader(resp.headers)
+		if err != nil {
+			return fmt.Errorf("error setting headers: %w", err)
+		}
+	}
+	if resp.trailers != nil {
+		stream.SetTrailer(resp.trailers)
+	}
+
+	buf := invokev1.BufPool.Get().(*[]byte)
+	defer func() {
+		invokev1.BufPool.Put(buf)
+	}()
+
+	msg := &runtimev1pb.InvokeServiceStreamResponse{
+		Response: &commonv1pb.InvokeResponse{
+			ContentType: imr.ContentType(),
+		},
+	}
+	r := imr.RawData()
+	var (
+		seq  uint64
+		done bool
+	)
+	for {
+		if stream.Context().Err() != nil {
+			return stream.Context().Err()
+		}
+
+		if r != nil {
+			n, err := r.Read(*buf)
+			if errors.Is(err, io.EOF) {
+				done = true
+			} else if err != nil {
+				return fmt.Errorf("error reading the response: %w", err)
+			}
+			if n > 0 {
+				msg.Payload = &commonv1pb.StreamPayload{
+					Data: (*buf)[:n],
+					Seq:  seq,
+				}
+				seq++
+			}
+		} else {
+			done = true
+		}
+
+		// The first message is always sent, even if the response is empty
+		if msg.GetResponse() != nil || msg.GetPayload() != nil {
+			err := stream.Send(msg)
+			if err != nil {
+				return fmt.Errorf("error sending message: %w", err)
+			}
+		}
+
+		if done {
+			return nil
+		}
+
+		// Reset the object so we can re-use it
+		msg.Reset()
+	}
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcMetadata "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/dapr/dapr/pkg/api/universal"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/kit/logger"
+)
+
+type fakeStreamDirectMessaging struct {
+	lock   sync.Mutex
+	bodies []string
+	// Status codes returned by each call, OK once exhausted.
+	codes []codes.Code
+}
+
+func (f *fakeStreamDirectMessaging) Invoke(_ context.Context, _ string, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
+	body, err := io.ReadAll(req.RawData())
+	if err != nil {
+		return nil, err
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	code := codes.OK
+	if len(f.bodies) < len(f.codes) {
+		code = f.codes[len(f.bodies)]
+	}
+	f.bodies = append(f.bodies, string(body))
+
+	return invokev1.NewInvokeMethodResponse(int32(code), "", nil).
+		WithHeaders(grpcMetadata.Pairs("x-method", req.Message().GetMethod())).
+		WithContentType("text/plain").
+		WithRawDataString("echo: " + string(body)), nil
+}
+
+func invokeServiceStream(t *testing.T, client runtimev1pb.DaprClient, appID string, chunks ...string) (string, *commonv1pb.InvokeResponse, grpcMetadata.MD, error) {
+	t.Helper()
+
+	var header grpcMetadata.MD
+	stream, err := client.InvokeServiceStreamAlpha1(t.Context(), grpc.Header(&header))
+	require.NoError(t, err)
+
+	for i, chunk := range chunks {
+		req := &runtimev1pb.InvokeServiceStreamRequest{
+			Payload: &commonv1pb.StreamPayload{Data: []byte(chunk), Seq: uint64(i)},
+		}
+		if i == 0 {
+			req.Request = &runtimev1pb.InvokeServiceRequest{
+				Id:      appID,
+				Message: &commonv1pb.InvokeRequest{Method: "mymethod"},
+			}
+		}
+		require.NoError(t, stream.Send(req))
+	}
+	require.NoError(t, stream.CloseSend())
+
+	var (
+		body bytes.Buffer
+		resp *commonv1pb.InvokeResponse
+	)
+	for {
+		msg, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return body.String(), resp, header, nil
+		} else if err != nil {
+			return "", nil, header, err
+		}
+		if msg.GetResponse() != nil {
+			resp = msg.GetResponse()
+		}
+		body.Write(msg.GetPayload().GetData())
+	}
+}
+
+func TestInvokeServiceStreamAlpha1(t *testing.T) {
+	newClient := func(t *testing.T, dm invokev1.DirectMessaging) runtimev1pb.DaprClient {
+		t.Helper()
+		fakeAPI := &api{
+			logger: logger.NewLogger("test"),
+			Universal: universal.New(universal.Options{
+				AppID:      "fakeAPI",
+				Resiliency: resiliency.FromConfigurations(logger.NewLogger("grpc.api.test"), testResiliency),
+			}),
+			directMessaging: dm,
+		}
+		lis := startDaprAPIServer(t, fakeAPI, "")
+		clientConn := createTestClient(lis)
+		t.Cleanup(func() { clientConn.Close() })
+		return runtimev1pb.NewDaprClient(clientConn)
+	}
+
+	t.Run("streams the request and response bodies", func(t *testing.T) {
+		dm := new(fakeStreamDirectMessaging)
+		client := newClient(t, dm)
+
+		body, resp, header, err := invokeServiceStream(t, client, "fakeAppID", "hello ", "streaming ", "world")
+		require.NoError(t, err)
+		assert.Equal(t, "echo: hello streaming world", body)
+		assert.Equal(t, "text/plain", resp.GetContentType())
+		assert.Equal(t, []string{"mymethod"}, header.Get("x-method"))
+		assert.Equal(t, []string{"hello streaming world"}, dm.bodies)
+	})
+
+	t.Run("replays the body on retries", func(t *testing.T) {
+		dm := &fakeStreamDirectMessaging{codes: []codes.Code{codes.Unavailable}}
+		client := newClient(t, dm)
+
+		body, _, _, err := invokeServiceStream(t, client, "failingApp", "hello ", "world")
+		require.NoError(t, err)
+		assert.Equal(t, "echo: hello world", body)
+		assert.Equal(t, []string{"hello world", "hello world"}, dm.bodies)
+	})
+
+	t.Run("returns the error once the retries are exhausted", func(t *testing.T) {
+		dm := &fakeStreamDirectMessaging{codes: []codes.Code{codes.Unavailable, codes.Unavailable}}
+		client := newClient(t, dm)
+
+		_, _, _, err := invokeServiceStream(t, client, "failingApp", "hello")
+		require.Error(t, err)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Len(t, dm.bodies, 2)
+	})
+
+	t.Run("first message without a request", func(t *testing.T) {
+		client := newClient(t, new(fakeStreamDirectMessaging))
+
+		stream, err := client.InvokeServiceStreamAlpha1(t.Context())
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(&runtimev1pb.InvokeServiceStreamRequest{
+			Payload: &commonv1pb.StreamPayload{Data: []byte("hello")},
+		}))
+		_, err = stream.Recv()
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("invalid sequence number", func(t *testing.T) {
+		dm := new(fakeStreamDirectMessaging)
+		client := newClient(t, dm)
+
+		stream, err := client.InvokeServiceStreamAlpha1(t.Context())
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(&runtimev1pb.InvokeServiceStreamRequest{
+			Request: &runtimev1pb.InvokeServiceRequest{
+				Id:      "fakeAppID",
+				Message: &commonv1pb.InvokeRequest{Method: "mymethod"},
+			},
+			Payload: &commonv1pb.StreamPayload{Data: []byte("hello"), Seq: 1},
+		}))
+		require.NoError(t, stream.CloseSend())
+		_, err = stream.Recv()
+		require.Error(t, err)
+		assert.Empty(t, dm.bodies)
+	})
+}
@@ -36,6 +36,7 @@ import (
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/modes"
 	"github.com/dapr/dapr/pkg/security"
+	"github.com/dapr/dapr/pkg/security/apitoken"
 	securityConsts "github.com/dapr/dapr/pkg/security/consts"
 )
 
@@ -58,7 +59,9 @@ type AppChannelConfig struct {
 	MaxRequestBodySize int // In bytes
 	ReadBufferSize     int // In bytes
 	BaseAddress        string
-	AppAPIToken        string
+	UnixDomainSocket   string // If set, used instead of BaseAddress and Port
+	AppAPIToken        *apitoken.Tokens
+	TLSConfig          *tls.Config // If set, used to verify the app when EnableTLS is set
 }
 
 // Manager is a wrapper around gRPC connection pooling.
@@ -149,9 +152,12 @@ func (g *Manager) createLocalConnection(parentCtx context.Context, port int, ena
 	}
 
 	if enableTLS {
-		//nolint:gosec
-		tlsConfig := &tls.Config{InsecureSkipVerify: true}
-		tlsConfig.MinVersion = channel.AppChannelMinTLSVersion
+		tlsConfig := g.channelConfig.TLSConfig
+		if tlsConfig == nil {
+			//nolint:gosec
+			tlsConfig = &tls.Config{InsecureSkipVerify: true}
+			tlsConfig.MinVersion = channel.AppChannelMinTLSVersion
+		}
 		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
 	} else {
 		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
@@ -163,6 +169,10 @@ func (g *Manager) createLocalConnection(parentCtx context.Context, port int, ena
 
 	dialPrefix := GetDialAddressPrefix(g.mode)
 	address := net.JoinHostPort(g.channelConfig.BaseAddress, strconv.Itoa(port))
+	if g.channelConfig.UnixDomainSocket != "" {
+		dialPrefix = "unix:"
+		address = g.channelConfig.UnixDomainSocket
+	}
 
 	ctx, cancel := context.WithTimeout(parentCtx, dialTimeout)
 	defer cancel()
@@ -281,8 +291,8 @@ func (g *Manager) AddAppTokenToContext(ctx context.Context) context.Context {
 	if g == nil || g.channelConfig == nil {
 		return ctx
 	}
-	if g.channelConfig.AppAPIToken != "" {
-		return md.AppendToOutgoingContext(ctx, securityConsts.APITokenHeader, g.channelConfig.AppAPIToken)
+	if token := g.channelConfig.AppAPIToken.Current(); token != "" {
+		return md.AppendToOutgoingContext(ctx, securityConsts.APITokenHeader, token)
 	}
 	return ctx
 }
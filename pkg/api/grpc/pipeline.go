@@ -0,0 +1,130 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc"
+	grpcMetadata "google.golang.org/grpc/metadata"
+
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/dapr/pkg/middleware"
+)
+
+// pipelineCall is the gRPC call that is running through the middleware pipeline.
+type pipelineCall struct {
+	next   func(ctx context.Context) error
+	err    error
+	called bool
+}
+
+type pipelineCallCtxKey struct{}
+
+// Returns the middlewares (unary and stream) that run the gRPC calls through a pipeline of HTTP middleware components.
+// Each call is presented to the middlewares as a POST request to the full method, with the incoming metadata as headers and no body.
+// Middlewares can change the headers, which become the incoming metadata of the call, or reject the call by writing a response without invoking the next handler.
+func setPipelineMiddlewares(pipeline middleware.HTTP) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	// The chain is built once, and the root handler continues the gRPC call that is in the context of the request
+	chain := pipeline(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := r.Context().Value(pipelineCallCtxKey{}).(*pipelineCall)
+		call.called = true
+
+		md := make(grpcMetadata.MD, len(r.Header))
+		for k, v := range r.Header {
+			md[strings.ToLower(k)] = v
+		}
+		call.err = call.next(grpcMetadata.NewIncomingContext(r.Context(), md))
+	}))
+
+	run := func(ctx context.Context, method string, next func(ctx context.Context) error) error {
+		call := &pipelineCall{next: next}
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, pipelineCallCtxKey{}, call), http.MethodPost, method, http.NoBody)
+		if err != nil {
+			return err
+		}
+		req.RequestURI = method
+		req.Proto, req.ProtoMajor, req.ProtoMinor = "HTTP/2", 2, 0
+		if md, ok := grpcMetadata.FromIncomingContext(ctx); ok {
+			for k, v := range md {
+				if strings.HasPrefix(k, ":") {
+					if k == ":authority" && len(v) > 0 {
+						req.Host = v[0]
+					}
+					continue
+				}
+				for _, val := range v {
+					req.Header.Add(k, val)
+				}
+			}
+		}
+
+		rw := &pipelineResponseWriter{header: make(http.Header)}
+		chain.ServeHTTP(rw, req)
+
+		if !call.called {
+			// The call was rejected by a middleware
+			status := rw.status
+			if status == 0 {
+				status = http.StatusOK
+			}
+			if err := invokev1.ErrorFromHTTPResponseCode(status, rw.body.String()); err != nil {
+				return err
+			}
+			return invokev1.ErrorFromHTTPResponseCode(http.StatusForbidden, "call was not forwarded by the middleware pipeline")
+		}
+		return call.err
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			var res any
+			err := run(ctx, info.FullMethod, func(ctx context.Context) (err error) {
+				res, err = handler(ctx, req)
+				return err
+			})
+			return res, err
+		},
+		func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			return run(stream.Context(), info.FullMethod, func(ctx context.Context) error {
+				return handler(srv, &wrappedStream{stream, ctx})
+			})
+		}
+}
+
+// pipelineResponseWriter records the response written by a middleware that rejects a call.
+type pipelineResponseWriter struct {
+	header http.Header
+	body   bytes.Buffer
+	status int
+}
+
+func (w *pipelineResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *pipelineResponseWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.body.Write(b)
+}
+
+func (w *pipelineResponseWriter) WriteHeader(statusCode int) {
+	if w.status == 0 {
+		w.status = statusCode
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcMetadata "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestSetPipelineMiddlewares(t *testing.T) {
+	// The middleware rejects the calls to the "Denied" methods, and rewrites the "x-tenant" header of the others
+	var seenMethod string
+	pipeline := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			seenMethod = r.URL.Path
+			if r.URL.Path == "/myapp.v1.Foo/Denied" {
+				w.WriteHeader(http.StatusTooManyRequests)
+				w.Write([]byte("slow down"))
+				return
+			}
+			r.Header.Set("X-Tenant", "rewritten-"+r.Header.Get("X-Tenant"))
+			next.ServeHTTP(w, r)
+		})
+	}
+	u, s := setPipelineMiddlewares(pipeline)
+
+	ctx := grpcMetadata.NewIncomingContext(t.Context(), grpcMetadata.Pairs("x-tenant", "a", ":authority", "localhost"))
+
+	t.Run("unary call with rewritten metadata", func(t *testing.T) {
+		var md grpcMetadata.MD
+		res, err := u(ctx, "req", &grpc.UnaryServerInfo{FullMethod: "/myapp.v1.Foo/Bar"}, func(ctx context.Context, req any) (any, error) {
+			md, _ = grpcMetadata.FromIncomingContext(ctx)
+			return "res-" + req.(string), nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "res-req", res)
+		assert.Equal(t, "/myapp.v1.Foo/Bar", seenMethod)
+		assert.Equal(t, []string{"rewritten-a"}, md.Get("x-tenant"))
+	})
+
+	t.Run("unary call returns the error of the handler", func(t *testing.T) {
+		_, err := u(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/myapp.v1.Foo/Bar"}, func(ctx context.Context, req any) (any, error) {
+			return nil, status.Error(codes.NotFound, "not found")
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("unary call rejected by the middleware", func(t *testing.T) {
+		var invoked bool
+		_, err := u(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/myapp.v1.Foo/Denied"}, func(ctx context.Context, req any) (any, error) {
+			invoked = true
+			return nil, nil
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.False(t, invoked)
+	})
+
+	t.Run("stream call with rewritten metadata", func(t *testing.T) {
+		var md grpcMetadata.MD
+		err := s(nil, &fakeCtxStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: "/myapp.v1.Foo/Stream"}, func(srv any, stream grpc.ServerStream) error {
+			md, _ = grpcMetadata.FromIncomingContext(stream.Context())
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "/myapp.v1.Foo/Stream", seenMethod)
+		assert.Equal(t, []string{"rewritten-a"}, md.Get("x-tenant"))
+	})
+
+	t.Run("stream call rejected by the middleware", func(t *testing.T) {
+		var invoked bool
+		err := s(nil, &fakeCtxStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: "/myapp.v1.Foo/Denied"}, func(srv any, stream grpc.ServerStream) error {
+			invoked = true
+			return nil
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.False(t, invoked)
+	})
+}
@@ -55,6 +55,11 @@ func (f *Frame) ProtoMessage() {
 	// nop
 }
 
+// Size returns the size of the transported data.
+func (f *Frame) Size() int {
+	return len(f.payload)
+}
+
 // Marshal implements the encoding.Codec interface method.
 func (p *Proxy) Marshal(v any) ([]byte, error) {
 	out, ok := v.(*Frame)
@@ -33,15 +33,20 @@ import (
 	grpcStatus "google.golang.org/grpc/status"
 
 	"github.com/dapr/dapr/pkg/api/grpc/metadata"
+	"github.com/dapr/dapr/pkg/api/policy"
+	"github.com/dapr/dapr/pkg/api/ratelimit"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	diagUtils "github.com/dapr/dapr/pkg/diagnostics/utils"
 	"github.com/dapr/dapr/pkg/healthz"
 	"github.com/dapr/dapr/pkg/messaging"
+	"github.com/dapr/dapr/pkg/middleware"
 	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/runtime/drain"
 	"github.com/dapr/dapr/pkg/runtime/wfengine"
 	"github.com/dapr/dapr/pkg/security"
+	"github.com/dapr/dapr/pkg/security/apitoken"
 	securityConsts "github.com/dapr/dapr/pkg/security/consts"
 	"github.com/dapr/kit/logger"
 )
@@ -65,9 +70,14 @@ type Options struct {
 	TracingSpec    config.TracingSpec
 	MetricSpec     config.MetricSpec
 	APISpec        config.APISpec
+	RateLimiter    *ratelimit.Limiter
+	AccessPolicy   *policy.Engine
 	Proxy          messaging.Proxy
 	WorkflowEngine wfengine.Interface
 	Healthz        healthz.Healthz
+	APITokens      *apitoken.Tokens
+	Pipeline       middleware.HTTP
+	Drainer        *drain.Drainer
 }
 
 type OptionsInternal struct {
@@ -90,13 +100,17 @@ type server struct {
 	logger         logger.Logger
 	infoLogger     logger.Logger
 	grpcServerOpts []grpcGo.ServerOption
-	authToken      string
+	apiTokens      *apitoken.Tokens
 	apiSpec        config.APISpec
+	rateLimiter    *ratelimit.Limiter
+	accessPolicy   *policy.Engine
 	proxy          messaging.Proxy
 	workflowEngine wfengine.Interface
 	sec            security.Handler
 	wg             sync.WaitGroup
 	htarget        healthz.Target
+	pipeline       middleware.HTTP
+	drainer        *drain.Drainer
 }
 
 var (
@@ -142,11 +156,15 @@ func NewAPIServer(opts Options) Server {
 		kind:           apiServer,
 		logger:         apiServerLogger,
 		infoLogger:     apiServerInfoLogger,
-		authToken:      security.GetAPIToken(),
+		apiTokens:      opts.APITokens,
 		apiSpec:        opts.APISpec,
+		rateLimiter:    opts.RateLimiter,
+		accessPolicy:   opts.AccessPolicy,
 		proxy:          opts.Proxy,
 		workflowEngine: opts.WorkflowEngine,
 		htarget:        opts.Healthz.AddTarget("grpc-api-server"),
+		pipeline:       opts.Pipeline,
+		drainer:        opts.Drainer,
 		grpcServerOpts: serverOpts,
 	}
 }
@@ -278,12 +296,21 @@ func (s *server) getMiddlewareOptions() []grpcGo.ServerOption {
 	// We initialize these slices with an initial capacity to give the compiler a "hint" of how much memory we may use.
 	// These capacities are the worst-case scenario below (max number of items added to each slice).
 	// Specifying an initial capacity helps us reducing the risk that we may need to re-allocate the slice, which is wasteful both on the allocator and on the GC.
-	intr := make([]grpcGo.UnaryServerInterceptor, 0, 6)
-	intrStream := make([]grpcGo.StreamServerInterceptor, 0, 5)
+	intr := make([]grpcGo.UnaryServerInterceptor, 0, 9)
+	intrStream := make([]grpcGo.StreamServerInterceptor, 0, 8)
 
 	intr = append(intr, metadata.SetMetadataInContextUnary)
 
-	if len(s.apiSpec.Allowed) > 0 || len(s.apiSpec.Denied) > 0 {
+	if s.drainer != nil {
+		intr = append(intr, setDrainTrackingMiddleware(s.drainer))
+	}
+
+	if s.accessPolicy != nil {
+		s.logger.Info("Enabled API access policy on gRPC server")
+		unary, stream := setAPIAccessPolicyMiddlewares(s.accessPolicy)
+		intr = append(intr, unary)
+		intrStream = append(intrStream, stream)
+	} else if len(s.apiSpec.Allowed) > 0 || len(s.apiSpec.Denied) > 0 {
 		s.logger.Info("Enabled API access list on gRPC server")
 		unary, stream := setAPIEndpointsMiddlewares(s.apiSpec.Allowed, s.apiSpec.Denied)
 		if unary != nil && stream != nil {
@@ -292,13 +319,29 @@ func (s *server) getMiddlewareOptions() []grpcGo.ServerOption {
 		}
 	}
 
-	if s.authToken != "" {
+	if s.apiTokens.Enabled() {
 		s.logger.Info("Enabled token authentication on gRPC server")
-		unary, stream := getAPIAuthenticationMiddlewares(s.authToken, securityConsts.APITokenHeader)
+		unary, stream := getAPIAuthenticationMiddlewares(s.apiTokens, securityConsts.APITokenHeader)
 		intr = append(intr, unary)
 		intrStream = append(intrStream, stream)
 	}
 
+	if s.kind == apiServer && len(s.config.MaxRequestBodySizePerAPI) > 0 {
+		s.logger.Info("Enabled max body size per API on gRPC server")
+		unary, stream := setAPIBodySizeMiddlewares(s.config.MaxRequestBodySize, s.config.MaxRequestBodySizePerAPI)
+		intr = append(intr, unary)
+		intrStream = append(intrStream, stream)
+	}
+
+	if s.rateLimiter != nil {
+		unary, stream := setAPIRateLimitMiddlewares(s.rateLimiter)
+		if unary != nil && stream != nil {
+			s.logger.Info("Enabled API rate limits on gRPC server")
+			intr = append(intr, unary)
+			intrStream = append(intrStream, stream)
+		}
+	}
+
 	if diagUtils.IsTracingEnabled(s.tracingSpec.SamplingRate) {
 		s.logger.Info("Enabled gRPC tracing middleware")
 		intr = append(intr, diag.GRPCTraceUnaryServerInterceptor(s.config.AppID, s.tracingSpec))
@@ -316,6 +359,13 @@ func (s *server) getMiddlewareOptions() []grpcGo.ServerOption {
 		}
 	}
 
+	if s.pipeline != nil {
+		s.logger.Info("Enabled middleware pipeline on gRPC server")
+		unary, stream := setPipelineMiddlewares(s.pipeline)
+		intr = append(intr, unary)
+		intrStream = append(intrStream, stream)
+	}
+
 	if s.config.EnableAPILogging && s.infoLogger != nil {
 		unary, stream := s.getGRPCAPILoggingMiddlewares()
 		intr = append(intr, unary)
@@ -329,6 +379,26 @@ func (s *server) getMiddlewareOptions() []grpcGo.ServerOption {
 	}
 }
 
+// maxRecvMsgSize returns the max size of messages received by the server.
+// When there are limits per API group, this is the largest of them, and each limit is enforced by the body size middlewares.
+func (s *server) maxRecvMsgSize() int {
+	if s.kind != apiServer || len(s.config.MaxRequestBodySizePerAPI) == 0 {
+		return s.config.MaxRequestBodySize
+	}
+
+	size := s.config.MaxRequestBodySize
+	if size <= 0 {
+		return math.MaxInt32
+	}
+	for _, v := range s.config.MaxRequestBodySizePerAPI {
+		if v <= 0 {
+			return math.MaxInt32
+		}
+		size = max(size, v)
+	}
+	return size
+}
+
 func (s *server) getGRPCServer() (*grpcGo.Server, error) {
 	opts := s.getMiddlewareOptions()
 	if len(s.grpcServerOpts) > 0 {
@@ -338,7 +408,7 @@ func (s *server) getGRPCServer() (*grpcGo.Server, error) {
 	// TODO: fix types
 	//nolint:gosec
 	opts = append(opts,
-		grpcGo.MaxRecvMsgSize(s.config.MaxRequestBodySize),
+		grpcGo.MaxRecvMsgSize(s.maxRecvMsgSize()),
 		grpcGo.MaxSendMsgSize(s.config.MaxRequestBodySize),
 		grpcGo.MaxHeaderListSize(uint32(s.config.ReadBufferSize<<10)),
 	)
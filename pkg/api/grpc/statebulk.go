@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"maps"
+	"time"
+
+	"github.com/dapr/components-contrib/state"
+	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/statecache"
+	"github.com/dapr/kit/ptr"
+)
+
+// BulkSetStateAlpha1 saves multiple values, each with its own ETag, options
+// and metadata, and returns the result of each item.
+func (a *api) BulkSetStateAlpha1(ctx context.Context, in *runtimev1pb.BulkSetStateRequestAlpha1) (*runtimev1pb.BulkStateResponseAlpha1, error) {
+	store, err := a.Universal.GetStateStore(in.GetStoreName())
+	if err != nil {
+		// Error has already been logged
+		return nil, err
+	}
+
+	reqs := make([]state.SetRequest, len(in.GetItems()))
+	for i, item := range in.GetItems() {
+		reqs[i], err = a.stateSetRequest(in.GetStoreName(), item, mergeBulkItemMetadata(item.GetMetadata(), in.GetMetadata()))
+		if err != nil {
+			diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(err)
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	results := stateLoader.PerformBulkStoreOperationWithResults(ctx, reqs,
+		a.Universal.Resiliency().ComponentOutboundPolicy(in.GetStoreName(), resiliency.Statestore),
+		bulkStoreOpts(in.Parallelism),
+		store.Set,
+		store.BulkSet,
+	)
+	elapsed := diag.ElapsedSince(start)
+
+	a.Universal.CompStore().GetStateCache(in.GetStoreName()).Invalidate(ctx, statecache.Keys(reqs)...)
+
+	return bulkStateResponse(ctx, in.GetStoreName(), diag.BulkSet, results, elapsed), nil
+}
+
+// BulkDeleteStateAlpha1 deletes multiple keys, each with its own ETag,
+// options and metadata, and returns the result of each item.
+func (a *api) BulkDeleteStateAlpha1(ctx context.Context, in *runtimev1pb.BulkDeleteStateRequestAlpha1) (*runtimev1pb.BulkStateResponseAlpha1, error) {
+	store, err := a.Universal.GetStateStore(in.GetStoreName())
+	if err != nil {
+		// Error has already been logged
+		return nil, err
+	}
+
+	reqs := make([]state.DeleteRequest, len(in.GetItems()))
+	for i, item := range in.GetItems() {
+		reqs[i], err = a.stateDeleteRequest(in.GetStoreName(), item, mergeBulkItemMetadata(item.GetMetadata(), in.GetMetadata()))
+		if err != nil {
+			diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(err)
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	results := stateLoader.PerformBulkStoreOperationWithResults(ctx, reqs,
+		a.Universal.Resiliency().ComponentOutboundPolicy(in.GetStoreName(), resiliency.Statestore),
+		bulkStoreOpts(in.Parallelism),
+		store.Delete,
+		store.BulkDelete,
+	)
+	elapsed := diag.ElapsedSince(start)
+
+	a.Universal.CompStore().GetStateCache(in.GetStoreName()).Invalidate(ctx, statecache.Keys(reqs)...)
+
+	return bulkStateResponse(ctx, in.GetStoreName(), diag.BulkDelete, results, elapsed), nil
+}
+
+func bulkStateResponse(ctx context.Context, storeName, operation string, results []stateLoader.BulkItemResult, elapsed float64) *runtimev1pb.BulkStateResponseAlpha1 {
+	resp := &runtimev1pb.BulkStateResponseAlpha1{
+		Results: make([]*runtimev1pb.BulkStateItemResultAlpha1, len(results)),
+	}
+	success := true
+	for i, res := range results {
+		resp.Results[i] = &runtimev1pb.BulkStateItemResultAlpha1{
+			Key: stateLoader.GetOriginalStateKey(res.Key),
+		}
+		if res.Error != nil {
+			success = false
+			resp.Results[i].Error = ptr.Of(res.Error.Error())
+		}
+	}
+
+	diag.DefaultComponentMonitoring.StateInvoked(ctx, storeName, operation, success, elapsed)
+
+	return resp
+}
+
+// mergeBulkItemMetadata returns the metadata of an item, with the metadata of
+// the request merged in.
+func mergeBulkItemMetadata(item, request map[string]string) map[string]string {
+	md := make(map[string]string, len(item)+len(request))
+	maps.Copy(md, item)
+	maps.Copy(md, request)
+	return md
+}
+
+func bulkStoreOpts(parallelism *int32) state.BulkStoreOpts {
+	if parallelism == nil || *parallelism <= 0 {
+		return state.BulkStoreOpts{Parallelism: stateLoader.DefaultBulkParallelism}
+	}
+	return state.BulkStoreOpts{Parallelism: int(*parallelism)}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	apierrors "github.com/dapr/dapr/pkg/api/errors"
+	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/statecache"
+	kiterrors "github.com/dapr/kit/errors"
+)
+
+// CheckAndSetStateAlpha1 executes the operations of a transaction only if the
+// ETags of all the checked keys match, and fails with a conflict otherwise.
+func (a *api) CheckAndSetStateAlpha1(ctx context.Context, in *runtimev1pb.CheckAndSetStateRequestAlpha1) (*emptypb.Empty, error) {
+	storeName := in.GetStoreName()
+	store, err := a.Universal.GetStateStore(storeName)
+	if err != nil {
+		// Error has already been logged
+		return nil, err
+	}
+
+	req := &stateLoader.CheckAndSetRequest{
+		Checks:   make([]stateLoader.ETagCheck, len(in.GetChecks())),
+		Metadata: in.GetMetadata(),
+	}
+	for i, c := range in.GetChecks() {
+		key, kerr := stateLoader.GetModifiedStateKeyWithMetadata(c.GetKey(), storeName, a.Universal.AppID(), in.GetMetadata())
+		if kerr != nil {
+			err = apierrors.StateStore(storeName).InvalidKeyName(c.GetKey(), kerr.Error())
+			diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(err)
+			return nil, err
+		}
+		req.Checks[i] = stateLoader.ETagCheck{Key: key, ETag: c.GetEtag()}
+	}
+	req.Operations, err = a.stateTransactionOperations(ctx, storeName, in.GetOperations())
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	policyRunner := resiliency.NewRunner[any](ctx,
+		a.Universal.Resiliency().ComponentOutboundPolicy(storeName, resiliency.Statestore),
+	)
+	_, err = policyRunner(func(ctx context.Context) (any, error) {
+		rErr := stateLoader.CheckAndSet(ctx, store, req)
+		if errors.Is(rErr, stateLoader.ErrCheckAndSetInvalid) ||
+			errors.Is(rErr, stateLoader.ErrCheckAndSetNotSupported) ||
+			errors.Is(rErr, stateLoader.ErrCheckAndSetConflict) {
+			return nil, backoff.Permanent(rErr)
+		}
+		return nil, rErr
+	})
+	elapsed := diag.ElapsedSince(start)
+
+	keys := statecache.Keys(req.Operations)
+	for _, c := range req.Checks {
+		keys = append(keys, c.Key)
+	}
+	a.Universal.CompStore().GetStateCache(storeName).Invalidate(ctx, keys...)
+
+	diag.DefaultComponentMonitoring.StateInvoked(ctx, storeName, diag.StateCheckAndSet, err == nil, elapsed)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, stateLoader.ErrCheckAndSetInvalid):
+			err = apierrors.StateStore(storeName).CheckAndSetInvalid(err.Error())
+		case errors.Is(err, stateLoader.ErrCheckAndSetNotSupported):
+			err = apierrors.StateStore(storeName).CheckAndSetNotSupported()
+		case errors.Is(err, stateLoader.ErrCheckAndSetConflict):
+			err = apierrors.StateStore(storeName).CheckAndSetConflict(err.Error())
+		default:
+			if kerr, ok := kiterrors.FromError(err); ok {
+				err = kerr
+			} else {
+				err = apierrors.StateStore(storeName).CheckAndSetFailed(err.Error())
+			}
+		}
+		diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(err)
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
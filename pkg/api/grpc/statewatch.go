@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apierrors "github.com/dapr/dapr/pkg/api/errors"
+	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/encryption"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+)
+
+// SubscribeStateAlpha1 watches the keys of the request until the stream is
+// closed, and sends their changes to the caller.
+func (a *api) SubscribeStateAlpha1(in *runtimev1pb.SubscribeStateRequestAlpha1, stream runtimev1pb.Dapr_SubscribeStateAlpha1Server) error { //nolint:nosnakecase
+	ctx := stream.Context()
+	storeName := in.GetStoreName()
+
+	store, err := a.Universal.GetStateStore(storeName)
+	if err != nil {
+		// Error has already been logged
+		return err
+	}
+
+	if len(in.GetKeys()) == 0 {
+		err = apierrors.StateStore(storeName).WatchInvalid("at least one key is required")
+		diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(err)
+		return err
+	}
+
+	interval := stateLoader.DefaultWatchPollInterval
+	if in.PollInterval != nil {
+		interval, err = time.ParseDuration(in.GetPollInterval())
+		if err == nil && interval < stateLoader.MinWatchPollInterval {
+			err = errors.New("poll interval must be at least " + stateLoader.MinWatchPollInterval.String())
+		}
+		if err != nil {
+			err = apierrors.StateStore(storeName).WatchInvalid(err.Error())
+			diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(err)
+			return err
+		}
+	}
+
+	// Keys are watched by their name in the store, and sent back to the caller
+	// by the name it asked for.
+	storeKeys := make([]string, len(in.GetKeys()))
+	originalKeys := make(map[string]string, len(in.GetKeys()))
+	for i, key := range in.GetKeys() {
+		k, kerr := stateLoader.GetModifiedStateKeyWithMetadata(key, storeName, a.AppID(), in.GetMetadata())
+		if kerr != nil {
+			err = apierrors.StateStore(storeName).InvalidKeyName(key, kerr.Error())
+			diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(err)
+			return err
+		}
+		storeKeys[i] = k
+		originalKeys[k] = key
+	}
+
+	err = stateLoader.Watch(ctx, store, storeKeys, in.GetMetadata(), interval, func(ctx context.Context, event *stateLoader.ChangeEvent) error {
+		out := &runtimev1pb.SubscribeStateResponseAlpha1{
+			Key:  originalKeys[event.Key],
+			Type: string(event.Type),
+			Etag: event.ETag,
+		}
+		if len(event.Value) > 0 {
+			val, derr := encryption.DecodeStateValue(storeName, event.Key, event.Value)
+			if derr != nil {
+				return derr
+			}
+			out.Value = val
+		}
+		return stream.Send(out)
+	})
+	if err != nil && !errors.Is(err, context.Canceled) {
+		err = apierrors.StateStore(storeName).WatchFailed(err.Error())
+		diag.LoggerWithTraceContext(ctx, apiServerLogger).Debug(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dapr/dapr/pkg/channel"
+	"github.com/dapr/dapr/pkg/config"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/messages"
+	"github.com/dapr/dapr/pkg/messaging"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+)
+
+// CallLocalWebSocket is used by another Dapr instance to proxy a WebSocket connection to the local app.
+// The access control policies are checked before the upgrade request is sent to the app.
+func (a *api) CallLocalWebSocket(stream internalv1pb.ServiceInvocation_CallLocalWebSocketServer) error { //nolint:nosnakecase
+	untrack, ok := a.Universal.Drainer().Track()
+	if !ok {
+		return messages.ErrDraining
+	}
+	defer untrack()
+
+	appChannel, ok := a.channels.AppChannelFor(config.AppCallbackInvocation).(channel.WebSocketAppChannel)
+	if !ok {
+		return status.Error(codes.Unimplemented, "websocket connections are only supported by apps using HTTP")
+	}
+
+	// The first chunk contains the upgrade request
+	chunk := &internalv1pb.InternalInvokeRequestStream{}
+	err := stream.RecvMsg(chunk)
+	if err != nil {
+		return err
+	}
+	if chunk.GetRequest().GetMetadata() == nil || chunk.GetRequest().GetMessage() == nil {
+		return status.Errorf(codes.InvalidArgument, messages.ErrInternalInvokeRequest, "request does not contain the required fields in the leading chunk")
+	}
+
+	req, err := invokev1.FromInternalInvokeRequest(chunk.GetRequest())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, messages.ErrInternalInvokeRequest, err.Error())
+	}
+	defer req.Close()
+
+	ctx := stream.Context()
+
+	// Check the ACL
+	err = a.callLocalValidateACL(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	// Diagnostics
+	callerAppID := a.callLocalRecordRequest(req.Proto())
+
+	var statusCode int32
+	defer func() {
+		diag.DefaultMonitoring.ServiceInvocationResponseSent(callerAppID, statusCode)
+	}()
+
+	//nolint:bodyclose
+	res, err := appChannel.DialWebSocket(ctx, req, "")
+	if err != nil {
+		return status.Errorf(codes.Internal, messages.ErrChannelInvoke, err)
+	}
+	defer res.Body.Close()
+
+	// Send the response of the app, without its body
+	//nolint:gosec
+	statusCode = int32(res.StatusCode)
+	resp := invokev1.NewInvokeMethodResponse(statusCode, "", nil).
+		WithHTTPHeaders(res.Header).
+		WithContentType(res.Header.Get("content-type"))
+	defer resp.Close()
+	err = stream.SendMsg(&internalv1pb.InternalInvokeResponseStream{
+		Response: resp.Proto(),
+	})
+	if err != nil {
+		return fmt.Errorf("error sending message: %w", err)
+	}
+
+	// If the app did not accept the upgrade, just send the body of its response
+	if res.StatusCode != http.StatusSwitchingProtocols {
+		return sendWebSocketPayloads(stream, res.Body)
+	}
+
+	conn, ok := res.Body.(io.ReadWriteCloser)
+	if !ok {
+		return status.Error(codes.Internal, "upgraded connection is not writable")
+	}
+
+	// Forward the data of the caller to the app in background
+	// Once the caller is done, the connection is closed, which ends the forwarding of the data of the app below
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+		defer conn.Close()
+		rErr := receiveWebSocketPayloads(stream, conn)
+		if rErr != nil {
+			a.logger.Debugf("Failed to forward websocket data to the app: %v", rErr)
+		}
+	}()
+
+	err = sendWebSocketPayloads(stream, conn)
+	// If the caller closed the connection first, reads fail because the connection to the app is closed
+	if err != nil && ctx.Err() == nil && !errors.Is(err, net.ErrClosed) && !errors.Is(err, io.ErrClosedPipe) {
+		a.logger.Debugf("Failed to forward websocket data from the app: %v", err)
+	}
+	return nil
+}
+
+// receiveWebSocketPayloads reads the payloads of the non-leading messages of the stream and writes them to w.
+func receiveWebSocketPayloads(stream internalv1pb.ServiceInvocation_CallLocalWebSocketServer, w io.Writer) error { //nolint:nosnakecase
+	var expectSeq uint64
+	chunk := &internalv1pb.InternalInvokeRequestStream{}
+	for {
+		chunk.Reset()
+		err := stream.RecvMsg(chunk)
+		if errors.Is(err, io.EOF) {
+			// The caller closed the connection
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("error receiving message: %w", err)
+		}
+
+		if chunk.GetRequest() != nil {
+			return errors.New("request found in non-leading message")
+		}
+
+		if payload := chunk.GetPayload(); payload != nil {
+			readSeq, err := messaging.ReadChunk(payload, w)
+			if err != nil {
+				return err
+			}
+			if readSeq != expectSeq {
+				return fmt.Errorf("invalid sequence number received: %d (expected: %d)", readSeq, expectSeq)
+			}
+			expectSeq++
+		}
+	}
+}
+
+// sendWebSocketPayloads sends the data read from r in the payloads of the stream, until r is exhausted.
+func sendWebSocketPayloads(stream internalv1pb.ServiceInvocation_CallLocalWebSocketServer, r io.Reader) error { //nolint:nosnakecase
+	buf := invokev1.BufPool.Get().(*[]byte)
+	defer func() {
+		invokev1.BufPool.Put(buf)
+	}()
+
+	var seq uint64
+	for {
+		n, err := r.Read(*buf)
+		if n > 0 {
+			sErr := stream.SendMsg(&internalv1pb.InternalInvokeResponseStream{
+				Payload: &commonv1pb.StreamPayload{
+					Data: (*buf)[:n],
+					Seq:  seq,
+				},
+			})
+			if sErr != nil {
+				return fmt.Errorf("error sending message: %w", sErr)
+			}
+			seq++
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dapr/dapr/pkg/api/universal"
+	channelt "github.com/dapr/dapr/pkg/channel/testing"
+	"github.com/dapr/dapr/pkg/config"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	"github.com/dapr/dapr/pkg/runtime/channels"
+	"github.com/dapr/kit/logger"
+)
+
+// fakeWebSocketAppChannel is an app channel whose app echoes the data of accepted WebSocket connections.
+type fakeWebSocketAppChannel struct {
+	*channelt.MockAppChannel
+	dialed chan *invokev1.InvokeMethodRequest
+}
+
+func (f *fakeWebSocketAppChannel) ProxyWebSocket(http.ResponseWriter, *http.Request, *invokev1.InvokeMethodRequest, string) error {
+	return nil
+}
+
+func (f *fakeWebSocketAppChannel) DialWebSocket(_ context.Context, req *invokev1.InvokeMethodRequest, _ string) (*http.Response, error) {
+	f.dialed <- req
+	if req.Message().GetMethod() != "ws" {
+		return &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     http.Header{"Content-Type": []string{"text/plain"}},
+			Body:       io.NopCloser(strings.NewReader("not found")),
+		}, nil
+	}
+
+	appConn, conn := net.Pipe()
+	go func() {
+		defer appConn.Close()
+		io.Copy(appConn, appConn)
+	}()
+	return &http.Response{
+		StatusCode: http.StatusSwitchingProtocols,
+		Header:     http.Header{"Upgrade": []string{"websocket"}, "Connection": []string{"Upgrade"}},
+		Body:       conn,
+	}, nil
+}
+
+func TestCallLocalWebSocket(t *testing.T) {
+	newClient := func(t *testing.T, fakeAPI *api) internalv1pb.ServiceInvocationClient {
+		t.Helper()
+		server, lis := startInternalServer(fakeAPI)
+		t.Cleanup(server.Stop)
+		clientConn := createTestClient(lis)
+		t.Cleanup(func() { clientConn.Close() })
+		return internalv1pb.NewServiceInvocationClient(clientConn)
+	}
+
+	upgradeRequest := func(method string) *internalv1pb.InternalInvokeRequestStream {
+		req := invokev1.NewInvokeMethodRequest(method).
+			WithHTTPExtension(http.MethodGet, "").
+			WithHTTPHeaders(http.Header{"Upgrade": []string{"websocket"}, "Connection": []string{"Upgrade"}})
+		t.Cleanup(func() { req.Close() })
+		return &internalv1pb.InternalInvokeRequestStream{Request: req.Proto()}
+	}
+
+	t.Run("app channel does not support websockets", func(t *testing.T) {
+		client := newClient(t, &api{
+			logger:    logger.NewLogger("test"),
+			Universal: universal.New(universal.Options{AppID: "fakeAPI"}),
+			channels:  (new(channels.Channels)).WithAppChannel(new(channelt.MockAppChannel)),
+		})
+
+		st, err := client.CallLocalWebSocket(t.Context())
+		require.NoError(t, err)
+		require.NoError(t, st.Send(upgradeRequest("ws")))
+		_, err = st.Recv()
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+
+	t.Run("denied by the access control list", func(t *testing.T) {
+		appChannel := &fakeWebSocketAppChannel{dialed: make(chan *invokev1.InvokeMethodRequest, 1)}
+		client := newClient(t, &api{
+			logger:            logger.NewLogger("test"),
+			Universal:         universal.New(universal.Options{AppID: "fakeAPI"}),
+			channels:          (new(channels.Channels)).WithAppChannel(appChannel),
+			accessControlList: &config.AccessControlList{DefaultAction: config.DenyAccess},
+		})
+
+		st, err := client.CallLocalWebSocket(t.Context())
+		require.NoError(t, err)
+		require.NoError(t, st.Send(upgradeRequest("ws")))
+		_, err = st.Recv()
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Empty(t, appChannel.dialed)
+	})
+
+	t.Run("upgrade accepted", func(t *testing.T) {
+		appChannel := &fakeWebSocketAppChannel{dialed: make(chan *invokev1.InvokeMethodRequest, 1)}
+		client := newClient(t, &api{
+			logger:    logger.NewLogger("test"),
+			Universal: universal.New(universal.Options{AppID: "fakeAPI"}),
+			channels:  (new(channels.Channels)).WithAppChannel(appChannel),
+		})
+
+		st, err := client.CallLocalWebSocket(t.Context())
+		require.NoError(t, err)
+		require.NoError(t, st.Send(upgradeRequest("ws")))
+
+		msg, err := st.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, int32(http.StatusSwitchingProtocols), msg.GetResponse().GetStatus().GetCode())
+		assert.Equal(t, []string{"websocket"}, msg.GetResponse().GetHeaders()["Upgrade"].GetValues())
+		assert.Equal(t, []string{"Upgrade"}, (<-appChannel.dialed).Metadata()["Connection"].GetValues())
+
+		for i, data := range []string{"ping", "pong"} {
+			require.NoError(t, st.Send(&internalv1pb.InternalInvokeRequestStream{
+				Payload: &commonv1pb.StreamPayload{Data: []byte(data), Seq: uint64(i)},
+			}))
+			msg, err = st.Recv()
+			require.NoError(t, err)
+			assert.Equal(t, data, string(msg.GetPayload().GetData()))
+			assert.Equal(t, uint64(i), msg.GetPayload().GetSeq())
+		}
+
+		// Closing the stream closes the connection to the app
+		require.NoError(t, st.CloseSend())
+		_, err = st.Recv()
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("upgrade rejected", func(t *testing.T) {
+		appChannel := &fakeWebSocketAppChannel{dialed: make(chan *invokev1.InvokeMethodRequest, 1)}
+		client := newClient(t, &api{
+			logger:    logger.NewLogger("test"),
+			Universal: universal.New(universal.Options{AppID: "fakeAPI"}),
+			channels:  (new(channels.Channels)).WithAppChannel(appChannel),
+		})
+
+		st, err := client.CallLocalWebSocket(t.Context())
+		require.NoError(t, err)
+		require.NoError(t, st.Send(upgradeRequest("other")))
+
+		msg, err := st.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, int32(http.StatusNotFound), msg.GetResponse().GetStatus().GetCode())
+
+		msg, err = st.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, "not found", string(msg.GetPayload().GetData()))
+
+		_, err = st.Recv()
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
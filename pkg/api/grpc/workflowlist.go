@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/state/list"
+	"github.com/dapr/kit/ptr"
+)
+
+// ListWorkflowsAlpha1 lists the workflow instances matching a filter.
+func (a *api) ListWorkflowsAlpha1(ctx context.Context, in *runtimev1pb.ListWorkflowsRequestAlpha1) (*runtimev1pb.ListWorkflowsResponseAlpha1, error) {
+	req := &list.ListWorkflowsRequest{
+		Filter: list.Filter{
+			RuntimeStatuses: in.GetRuntimeStatuses(),
+			Name:            in.GetWorkflowName(),
+			Tags:            in.GetTags(),
+		},
+		PageSize:          in.PageSize,
+		ContinuationToken: in.ContinuationToken,
+	}
+	if in.GetCreatedAfter() != nil {
+		req.Filter.CreatedAfter = ptr.Of(in.GetCreatedAfter().AsTime())
+	}
+	if in.GetCreatedBefore() != nil {
+		req.Filter.CreatedBefore = ptr.Of(in.GetCreatedBefore().AsTime())
+	}
+
+	page, err := a.Universal.ListWorkflows(ctx, req)
+	if err != nil {
+		// Error has already been logged
+		return nil, err
+	}
+
+	res := &runtimev1pb.ListWorkflowsResponseAlpha1{
+		Workflows:         make([]*runtimev1pb.ListWorkflowsResponseAlpha1Workflow, len(page.Workflows)),
+		ContinuationToken: page.ContinuationToken,
+	}
+	for i, wf := range page.Workflows {
+		res.Workflows[i] = &runtimev1pb.ListWorkflowsResponseAlpha1Workflow{
+			InstanceId:    wf.InstanceID,
+			WorkflowName:  wf.Name,
+			RuntimeStatus: wf.RuntimeStatus,
+			CreatedAt:     timestamppb.New(wf.CreatedAt),
+			LastUpdatedAt: timestamppb.New(wf.LastUpdatedAt),
+			Tags:          wf.Tags,
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	actorapi "github.com/dapr/dapr/pkg/actors/api"
+	"github.com/dapr/dapr/pkg/actors/reminders"
+	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	"github.com/dapr/dapr/pkg/messages"
+	"github.com/dapr/kit/ptr"
+)
+
+// For the management of reminders.
+var endpointGroupActorV1Alpha1Misc = &endpoints.EndpointGroup{
+	Name:                 endpoints.EndpointGroupActors,
+	Version:              endpoints.EndpointGroupVersion1alpha1,
+	AppendSpanAttributes: nil, // TODO
+}
+
+// actorReminderInfo is a reminder returned by the reminder management APIs.
+type actorReminderInfo struct {
+	ActorType    string `json:"actorType"`
+	ActorID      string `json:"actorID"`
+	Name         string `json:"name"`
+	DueTime      string `json:"dueTime,omitempty"`
+	Period       string `json:"period,omitempty"`
+	TTL          string `json:"ttl,omitempty"`
+	NextFireTime string `json:"nextFireTime,omitempty"`
+}
+
+type bulkDeleteActorRemindersResponse struct {
+	Deleted int `json:"deleted"`
+}
+
+type actorReminderNextFireTimeResponse struct {
+	NextFireTime string `json:"nextFireTime,omitempty"`
+}
+
+// onQueryActorReminders lists the reminders of an actor type.
+// Supported query parameters:
+// - actorId
+// - nextFireAfter (RFC3339)
+// - nextFireBefore (RFC3339)
+func (a *api) onQueryActorReminders(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, err := queryActorRemindersRequest(r)
+	if err != nil {
+		respondWithError(w, err)
+		log.Debug(err)
+		return
+	}
+
+	rem, err := a.universal.ActorReminders(ctx)
+	if err != nil {
+		respondWithError(w, err)
+		return
+	}
+
+	list, err := rem.Query(ctx, req)
+	if err != nil {
+		respondWithError(w, actorReminderError(err, messages.ErrActorReminderGet))
+		return
+	}
+
+	now := time.Now()
+	res := make([]actorReminderInfo, len(list))
+	for i, reminder := range list {
+		res[i] = actorReminderInfo{
+			ActorType: reminder.ActorType,
+			ActorID:   reminder.ActorID,
+			Name:      reminder.Name,
+			DueTime:   reminder.DueTime,
+			Period:    reminder.Period.String(),
+		}
+		if !reminder.ExpirationTime.IsZero() {
+			res[i].TTL = reminder.ExpirationTime.Format(time.RFC3339)
+		}
+		if next, ok := reminder.NextFireTime(now); ok {
+			res[i].NextFireTime = next.Format(time.RFC3339)
+		}
+	}
+
+	respondWithJSON(w, http.StatusOK, res)
+}
+
+// onBulkDeleteActorReminders deletes the reminders of an actor type matching
+// the same query parameters as onQueryActorReminders.
+func (a *api) onBulkDeleteActorReminders(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, err := queryActorRemindersRequest(r)
+	if err != nil {
+		respondWithError(w, err)
+		log.Debug(err)
+		return
+	}
+
+	rem, err := a.universal.ActorReminders(ctx)
+	if err != nil {
+		respondWithError(w, err)
+		return
+	}
+
+	deleted, err := rem.DeleteMatching(ctx, req)
+	if err != nil {
+		respondWithError(w, actorReminderError(err, messages.ErrActorReminderDelete))
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, bulkDeleteActorRemindersResponse{Deleted: deleted})
+}
+
+// onGetActorReminderNextFireTime returns the time a reminder fires next, which
+// is omitted if the reminder doesn't fire again or if it can't be determined.
+func (a *api) onGetActorReminderNextFireTime(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	rem, err := a.universal.ActorReminders(ctx)
+	if err != nil {
+		respondWithError(w, err)
+		return
+	}
+
+	name := chi.URLParamFromCtx(ctx, nameParam)
+	reminder, err := rem.Get(ctx, &actorapi.GetReminderRequest{
+		Name:      name,
+		ActorType: chi.URLParamFromCtx(ctx, actorTypeParam),
+		ActorID:   chi.URLParamFromCtx(ctx, actorIDParam),
+	})
+	if err != nil {
+		respondWithError(w, actorReminderError(err, messages.ErrActorReminderGet))
+		return
+	}
+	if reminder == nil {
+		respondWithError(w, messages.ErrActorReminderNotFound.WithFormat(name))
+		return
+	}
+
+	var res actorReminderNextFireTimeResponse
+	if next, ok := reminder.NextFireTime(time.Now()); ok {
+		res.NextFireTime = next.Format(time.RFC3339)
+	}
+	respondWithJSON(w, http.StatusOK, res)
+}
+
+func queryActorRemindersRequest(r *http.Request) (*actorapi.QueryRemindersRequest, error) {
+	req := &actorapi.QueryRemindersRequest{
+		ActorType: chi.URLParam(r, actorTypeParam),
+	}
+
+	query := r.URL.Query()
+	if query.Has("actorId") {
+		req.ActorID = ptr.Of(query.Get("actorId"))
+	}
+	for param, dst := range map[string]**time.Time{
+		"nextFireAfter":  &req.NextFireAfter,
+		"nextFireBefore": &req.NextFireBefore,
+	} {
+		if !query.Has(param) {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, query.Get(param))
+		if err != nil {
+			return nil, messages.ErrBadRequest.WithFormat("invalid " + param + ": " + err.Error())
+		}
+		*dst = &t
+	}
+
+	return req, nil
+}
+
+func actorReminderError(err error, apiErr messages.APIError) error {
+	log.Debug(err)
+	if errors.Is(err, reminders.ErrReminderOpActorNotHosted) {
+		return messages.ErrActorReminderOpActorNotHosted
+	}
+	return apiErr.WithFormat(err)
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	actorsapi "github.com/dapr/dapr/pkg/actors/api"
+	actorsfake "github.com/dapr/dapr/pkg/actors/fake"
+	"github.com/dapr/dapr/pkg/actors/reminders"
+	remindersfake "github.com/dapr/dapr/pkg/actors/reminders/fake"
+	"github.com/dapr/dapr/pkg/api/universal"
+	"github.com/dapr/dapr/pkg/healthz"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/kit/logger"
+)
+
+func TestActorReminderManagementEndpoints(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	actors := actorsfake.New()
+	testAPI := &api{
+		healthz: healthz.New(),
+		universal: universal.New(universal.Options{
+			Logger:     logger.NewLogger("test.api.http.actorreminders"),
+			AppID:      "fakeAPI",
+			Resiliency: resiliency.New(nil),
+			Actors:     actors,
+		}),
+	}
+	fakeServer.StartServer(testAPI.constructActorEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	dueTime := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	t.Run("query reminders", func(t *testing.T) {
+		var got *actorsapi.QueryRemindersRequest
+		actors.WithReminders(func(context.Context) (reminders.Interface, error) {
+			return remindersfake.New().WithQuery(func(_ context.Context, req *actorsapi.QueryRemindersRequest) ([]*actorsapi.Reminder, error) {
+				got = req
+				return []*actorsapi.Reminder{
+					{Name: "r1", ActorType: "type1", ActorID: "a", DueTime: dueTime.Format(time.RFC3339)},
+					{Name: "r2", ActorType: "type1", ActorID: "a", DueTime: "10s"},
+				}, nil
+			}), nil
+		})
+
+		resp := fakeServer.DoRequest("GET", "v1.0-alpha1/actors/type1/reminders?actorId=a&nextFireBefore=2030-01-01T00:00:00Z", nil, nil)
+		require.Equal(t, 200, resp.StatusCode, string(resp.RawBody))
+
+		assert.Equal(t, "type1", got.ActorType)
+		assert.Equal(t, "a", *got.ActorID)
+		assert.Nil(t, got.NextFireAfter)
+		assert.Equal(t, "2030-01-01T00:00:00Z", got.NextFireBefore.Format(time.RFC3339))
+
+		var res []actorReminderInfo
+		require.NoError(t, json.Unmarshal(resp.RawBody, &res))
+		require.Len(t, res, 2)
+		assert.Equal(t, dueTime.Format(time.RFC3339), res[0].NextFireTime)
+		assert.Empty(t, res[1].NextFireTime)
+	})
+
+	t.Run("invalid time range", func(t *testing.T) {
+		resp := fakeServer.DoRequest("GET", "v1.0-alpha1/actors/type1/reminders?nextFireAfter=soon", nil, nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_BAD_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("bulk delete reminders", func(t *testing.T) {
+		actors.WithReminders(func(context.Context) (reminders.Interface, error) {
+			return remindersfake.New().WithDeleteMatching(func(_ context.Context, req *actorsapi.QueryRemindersRequest) (int, error) {
+				assert.Nil(t, req.ActorID)
+				return 3, nil
+			}), nil
+		})
+
+		resp := fakeServer.DoRequest("DELETE", "v1.0-alpha1/actors/type1/reminders", nil, nil)
+		require.Equal(t, 200, resp.StatusCode)
+		assert.JSONEq(t, `{"deleted":3}`, string(resp.RawBody))
+	})
+
+	t.Run("actor type not hosted", func(t *testing.T) {
+		actors.WithReminders(func(context.Context) (reminders.Interface, error) {
+			return remindersfake.New().WithDeleteMatching(func(context.Context, *actorsapi.QueryRemindersRequest) (int, error) {
+				return 0, reminders.ErrReminderOpActorNotHosted
+			}), nil
+		})
+
+		resp := fakeServer.DoRequest("DELETE", "v1.0-alpha1/actors/type1/reminders", nil, nil)
+		assert.Equal(t, 403, resp.StatusCode)
+		assert.Equal(t, "ERR_ACTOR_REMINDER_NON_HOSTED", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("next fire time", func(t *testing.T) {
+		actors.WithReminders(func(context.Context) (reminders.Interface, error) {
+			return remindersfake.New().WithGet(func(_ context.Context, req *actorsapi.GetReminderRequest) (*actorsapi.Reminder, error) {
+				if req.Name != "r1" {
+					return nil, nil
+				}
+				return &actorsapi.Reminder{Name: "r1", ActorType: "type1", ActorID: "a", DueTime: dueTime.Format(time.RFC3339)}, nil
+			}), nil
+		})
+
+		resp := fakeServer.DoRequest("GET", "v1.0-alpha1/actors/type1/a/reminders/r1/nextfiretime", nil, nil)
+		require.Equal(t, 200, resp.StatusCode)
+		assert.JSONEq(t, `{"nextFireTime":"`+dueTime.Format(time.RFC3339)+`"}`, string(resp.RawBody))
+
+		resp = fakeServer.DoRequest("GET", "v1.0-alpha1/actors/type1/a/reminders/r2/nextfiretime", nil, nil)
+		assert.Equal(t, 404, resp.StatusCode)
+	})
+}
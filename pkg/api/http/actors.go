@@ -109,6 +109,21 @@ func (a *api) constructActorEndpoints() []endpoints.Endpoint {
 				Name: "InvokeActor",
 			},
 		},
+		{
+			Methods: []string{http.MethodGet, http.MethodPost, http.MethodDelete, http.MethodPut},
+			Route:   "actors/{actorType}/{actorId}/stream/{method}",
+			Version: apiVersionV1alpha1,
+			Group: &endpoints.EndpointGroup{
+				Name:                 endpoints.EndpointGroupActors,
+				Version:              endpoints.EndpointGroupVersion1alpha1,
+				AppendSpanAttributes: appendActorInvocationSpanAttributesFn,
+				MethodName:           methodNameFn,
+			},
+			Handler: a.onDirectActorStreamMessage,
+			Settings: endpoints.EndpointSettings{
+				Name: "InvokeActorStreamAlpha1",
+			},
+		},
 		{
 			Methods: []string{http.MethodGet},
 			Route:   "actors/{actorType}/{actorId}/state/{key}",
@@ -169,6 +184,36 @@ func (a *api) constructActorEndpoints() []endpoints.Endpoint {
 				Name: "GetActorReminder",
 			},
 		},
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "actors/{actorType}/reminders",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupActorV1Alpha1Misc,
+			Handler: a.onQueryActorReminders,
+			Settings: endpoints.EndpointSettings{
+				Name: "QueryActorRemindersAlpha1",
+			},
+		},
+		{
+			Methods: []string{http.MethodDelete},
+			Route:   "actors/{actorType}/reminders",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupActorV1Alpha1Misc,
+			Handler: a.onBulkDeleteActorReminders,
+			Settings: endpoints.EndpointSettings{
+				Name: "BulkDeleteActorRemindersAlpha1",
+			},
+		},
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "actors/{actorType}/{actorId}/reminders/{name}/nextfiretime",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupActorV1Alpha1Misc,
+			Handler: a.onGetActorReminderNextFireTime,
+			Settings: endpoints.EndpointSettings{
+				Name: "GetActorReminderNextFireTimeAlpha1",
+			},
+		},
 	}
 }
 
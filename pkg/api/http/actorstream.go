@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc/codes"
+
+	actorapi "github.com/dapr/dapr/pkg/actors/api"
+	actorerrors "github.com/dapr/dapr/pkg/actors/errors"
+	"github.com/dapr/dapr/pkg/messages"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+)
+
+// onDirectActorStreamMessage invokes an actor method and streams its
+// response, which is written as it's received instead of being buffered.
+// If the actor moves to another host while streaming, the method is invoked
+// again on that host and the stream resumes where it was interrupted, so the
+// method must be idempotent.
+func (a *api) onDirectActorStreamMessage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	router, err := a.universal.ActorRouter(ctx)
+	if err != nil {
+		respondWithError(w, err)
+		return
+	}
+
+	actorType := chi.URLParamFromCtx(ctx, actorTypeParam)
+	actorID := chi.URLParamFromCtx(ctx, actorIDParam)
+	verb := strings.ToUpper(r.Method)
+	method := chi.URLParamFromCtx(ctx, methodParam)
+
+	reqBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		msg := messages.ErrBadRequest.WithFormat("failed to read body: " + err.Error())
+		respondWithError(w, msg)
+		log.Debug(msg)
+		return
+	}
+
+	r.Header.Add("Dapr-API-Call", "true")
+	req := internalsv1pb.NewInternalInvokeRequest(method).
+		WithActor(actorType, actorID).
+		WithHTTPExtension(verb, r.URL.RawQuery).
+		WithData(reqBody).
+		WithContentType(r.Header.Get("content-type")).
+		WithHTTPHeaders(r.Header)
+
+	rc := http.NewResponseController(w)
+	var written bool
+	err = router.CallStream(ctx, req, func(res *internalsv1pb.InternalInvokeResponse) (bool, error) {
+		if !written {
+			written = true
+
+			h := w.Header()
+			headers := res.GetHeaders()
+			delete(headers, actorapi.StreamOffsetHeader)
+			invokev1.InternalMetadataToHTTPHeader(ctx, headers, h.Add)
+			h.Set(headerContentType, res.GetMessage().GetContentType())
+
+			statusCode := int(res.GetStatus().GetCode())
+			if !res.IsHTTPResponse() {
+				//nolint:gosec
+				statusCode = invokev1.HTTPStatusFromCode(codes.Code(statusCode))
+			}
+			w.WriteHeader(statusCode)
+		}
+
+		if _, err := w.Write(res.GetMessage().GetData().GetValue()); err != nil {
+			return false, err
+		}
+		// Not all response writers support flushing, in which case the data is
+		// sent when the handler returns.
+		_ = rc.Flush()
+		return false, nil
+	})
+	if err == nil {
+		return
+	}
+
+	if written {
+		// The response has already started, so the error can't be returned.
+		log.Debugf("Actor stream of %s/%s interrupted: %v", actorType, actorID, err)
+		return
+	}
+
+	if merr, ok := err.(messages.APIError); ok {
+		respondWithError(w, merr)
+		log.Debug(merr)
+		return
+	}
+
+	actorErr, isActorError := actorerrors.As(err)
+	if !isActorError {
+		msg := messages.ErrActorInvoke.WithFormat(err)
+		respondWithError(w, msg)
+		log.Debug(msg)
+		return
+	}
+	h := w.Header()
+	invokev1.InternalMetadataToHTTPHeader(ctx, actorErr.Headers(), h.Add)
+	h.Set(headerContentType, actorErr.ContentType())
+	respondWithData(w, actorErr.StatusCode(), actorErr.Body())
+}
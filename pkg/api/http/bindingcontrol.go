@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	"github.com/dapr/dapr/pkg/messages"
+)
+
+var endpointGroupBindingsV1Alpha1 = &endpoints.EndpointGroup{
+	Name:                 endpoints.EndpointGroupBindings,
+	Version:              endpoints.EndpointGroupVersion1alpha1,
+	AppendSpanAttributes: appendBindingsSpanAttributes,
+}
+
+func (a *api) constructBindingControlEndpoints() []endpoints.Endpoint {
+	return []endpoints.Endpoint{
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "bindings/{name}/pause",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupBindingsV1Alpha1,
+			Handler: a.onPauseInputBinding,
+			Settings: endpoints.EndpointSettings{
+				Name:             "PauseInputBinding",
+				RequiresAPIToken: true,
+			},
+		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "bindings/{name}/resume",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupBindingsV1Alpha1,
+			Handler: a.onResumeInputBinding,
+			Settings: endpoints.EndpointSettings{
+				Name:             "ResumeInputBinding",
+				RequiresAPIToken: true,
+			},
+		},
+	}
+}
+
+// Route: POST "bindings/{name}/pause"
+// Events of the binding are held until it's resumed; the component stays loaded.
+func (a *api) onPauseInputBinding(w http.ResponseWriter, r *http.Request) {
+	a.controlInputBinding(w, r, "pausing", a.pauseInputBindingFn)
+}
+
+// Route: POST "bindings/{name}/resume"
+func (a *api) onResumeInputBinding(w http.ResponseWriter, r *http.Request) {
+	a.controlInputBinding(w, r, "resuming", a.resumeInputBindingFn)
+}
+
+func (a *api) controlInputBinding(w http.ResponseWriter, r *http.Request, action string, fn func(name string) (bool, error)) {
+	name := chi.URLParam(r, "name")
+	if _, ok := a.universal.CompStore().GetInputBinding(name); !ok {
+		msg := messages.ErrInputBindingNotFound.WithFormat(name)
+		respondWithError(w, msg)
+		log.Debug(msg)
+		return
+	}
+
+	if _, err := fn(name); err != nil {
+		msg := messages.ErrInputBindingControl.WithFormat(action, name, err)
+		respondWithError(w, msg)
+		log.Debug(msg)
+		return
+	}
+
+	respondWithEmpty(w)
+}
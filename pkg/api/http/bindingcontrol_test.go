@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dapr/dapr/pkg/api/universal"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+	"github.com/dapr/kit/logger"
+)
+
+func TestBindingControlEndpoints(t *testing.T) {
+	const token = "1234"
+	t.Setenv("DAPR_API_TOKEN", token)
+
+	fakeServer := newFakeHTTPServer()
+	compStore := compstore.New()
+	compStore.AddInputBinding("input1", new(daprt.MockBinding))
+
+	var paused, resumed []string
+	testAPI := &api{
+		universal: universal.New(universal.Options{
+			AppID:     "fakeAPI",
+			Logger:    logger.NewLogger("fakeLogger"),
+			CompStore: compStore,
+		}),
+		pauseInputBindingFn: func(name string) (bool, error) {
+			paused = append(paused, name)
+			return true, nil
+		},
+		resumeInputBindingFn: func(name string) (bool, error) {
+			resumed = append(resumed, name)
+			return false, errors.New("resume failed")
+		},
+	}
+	fakeServer.StartServer(testAPI.constructBindingControlEndpoints(), &fakeHTTPServerOptions{
+		apiAuth: true,
+	})
+	defer fakeServer.Shutdown()
+
+	t.Run("without token - 401", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/bindings/input1/pause", nil, nil)
+		assert.Equal(t, 401, resp.StatusCode)
+		assert.Empty(t, paused)
+	})
+
+	t.Run("pause - 204", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/bindings/input1/pause", nil, nil, "dapr-api-token", token)
+		assert.Equal(t, 204, resp.StatusCode)
+		assert.Equal(t, []string{"input1"}, paused)
+	})
+
+	t.Run("binding not found - 404", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/bindings/nobinding/pause", nil, nil, "dapr-api-token", token)
+		assert.Equal(t, 404, resp.StatusCode)
+		assert.Equal(t, "ERR_INPUT_BINDING_NOT_FOUND", resp.ErrorBody["errorCode"])
+		assert.Equal(t, []string{"input1"}, paused)
+	})
+
+	t.Run("resume failure - 500", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/bindings/input1/resume", nil, nil, "dapr-api-token", token)
+		assert.Equal(t, 500, resp.StatusCode)
+		assert.Equal(t, "ERR_INPUT_BINDING_CONTROL", resp.ErrorBody["errorCode"])
+		assert.Equal(t, []string{"input1"}, resumed)
+	})
+
+	t.Run("api token disabled - 403", func(t *testing.T) {
+		t.Setenv("DAPR_API_TOKEN", "")
+
+		noTokenServer := newFakeHTTPServer()
+		noTokenServer.StartServer(testAPI.constructBindingControlEndpoints(), nil)
+		defer noTokenServer.Shutdown()
+
+		resp := noTokenServer.DoRequest("POST", "v1.0-alpha1/bindings/input1/resume", nil, nil)
+		assert.Equal(t, 403, resp.StatusCode)
+		assert.Equal(t, "ERR_API_TOKEN_REQUIRED", resp.ErrorBody["errorCode"])
+		assert.Equal(t, []string{"input1"}, resumed)
+	})
+}
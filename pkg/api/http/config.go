@@ -15,19 +15,26 @@ package http
 
 // ServerConfig holds config values for an HTTP server.
 type ServerConfig struct {
-	AppID                   string
-	HostAddress             string
-	Port                    int
-	APIListenAddresses      []string
-	PublicPort              *int
-	PublicListenAddress     string
-	ProfilePort             int
-	AllowedOrigins          string
-	EnableProfiling         bool
-	MaxRequestBodySize      int // In bytes
-	UnixDomainSocket        string
-	ReadBufferSize          int
-	EnableAPILogging        bool
-	APILoggingObfuscateURLs bool
-	APILogHealthChecks      bool
+	AppID                    string
+	HostAddress              string
+	Port                     int
+	APIListenAddresses       []string
+	PublicPort               *int
+	PublicListenAddress      string
+	ProfilePort              int
+	AllowedOrigins           string
+	EnableProfiling          bool
+	MaxRequestBodySize       int            // In bytes
+	MaxRequestBodySizePerAPI map[string]int // In bytes, keyed by API group; 0 means no limit
+	UnixDomainSocket         string
+	ReadBufferSize           int
+	EnableAPILogging         bool
+	APILoggingObfuscateURLs  bool
+	APILogHealthChecks       bool
+	// DisableH2C disables serving HTTP/2 over cleartext connections, leaving HTTP/1.1 only.
+	DisableH2C bool
+	// TLSCertFile and TLSKeyFile are the PEM files of the certificate of the API server. When set, the API server is
+	// served over TLS, negotiating HTTP/2 or HTTP/1.1 with ALPN.
+	TLSCertFile string
+	TLSKeyFile  string
 }
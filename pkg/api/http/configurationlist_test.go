@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/api/universal"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+)
+
+func TestConfigurationGetPaginated(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+
+	compStore := compstore.New()
+	compStore.AddConfiguration("store1", &fakeConfigurationStore{})
+	testAPI := &api{
+		universal: universal.New(universal.Options{
+			Logger:     log,
+			Resiliency: resiliency.New(nil),
+			CompStore:  compStore,
+		}),
+	}
+	fakeServer.StartServer(testAPI.constructConfigurationEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	get := func(t *testing.T, query url.Values) fakeHTTPResponse {
+		t.Helper()
+		return fakeServer.DoRequest(http.MethodGet, "v1.0/configuration/store1?"+query.Encode(), nil, nil)
+	}
+
+	t.Run("pages", func(t *testing.T) {
+		resp := get(t, url.Values{"metadata.keyPrefix": {"good-"}, "metadata.pageSize": {"1"}})
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(resp.RawBody))
+		require.Len(t, resp.JSONBody, 1)
+		assert.Contains(t, resp.JSONBody, "good-key1")
+		token := resp.RawHeader.Get(metadataPrefix + universal.ConfigurationContinuationTokenKey)
+		require.NotEmpty(t, token)
+
+		resp = get(t, url.Values{"metadata.keyPrefix": {"good-"}, "metadata.pageSize": {"1"}, "metadata.continuationToken": {token}})
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(resp.RawBody))
+		require.Len(t, resp.JSONBody, 1)
+		assert.Contains(t, resp.JSONBody, "good-key2")
+		assert.Empty(t, resp.RawHeader.Get(metadataPrefix+universal.ConfigurationContinuationTokenKey))
+	})
+
+	t.Run("label selector", func(t *testing.T) {
+		resp := get(t, url.Values{"metadata.labelSelector": {"metadata-key2=metadata-value2"}})
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(resp.RawBody))
+		require.Len(t, resp.JSONBody, 1)
+		assert.Contains(t, resp.JSONBody, "good-key2")
+	})
+
+	t.Run("invalid page size", func(t *testing.T) {
+		resp := get(t, url.Values{"metadata.pageSize": {"-1"}})
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "ERR_BAD_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("invalid continuation token", func(t *testing.T) {
+		resp := get(t, url.Values{"metadata.continuationToken": {"!"}})
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "ERR_BAD_REQUEST", resp.ErrorBody["errorCode"])
+	})
+}
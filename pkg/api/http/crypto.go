@@ -65,6 +65,79 @@ func (a *api) constructCryptoEndpoints() []endpoints.Endpoint {
 				Name: "Decrypt",
 			},
 		},
+		{
+			Methods: []string{http.MethodPut},
+			Route:   "crypto/{name}/encrypt/stream",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupCryptoV1Alpha1,
+			Handler: a.onCryptoEncryptStream,
+			Settings: endpoints.EndpointSettings{
+				Name:        "EncryptStream",
+				IsStreaming: true,
+			},
+		},
+		{
+			Methods: []string{http.MethodPut},
+			Route:   "crypto/{name}/decrypt/stream",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupCryptoV1Alpha1,
+			Handler: a.onCryptoDecryptStream,
+			Settings: endpoints.EndpointSettings{
+				Name:        "DecryptStream",
+				IsStreaming: true,
+			},
+		},
+		{
+			Methods: []string{http.MethodPut},
+			Route:   "crypto/{name}/sign",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupCryptoV1Alpha1,
+			Handler: a.onCryptoSign,
+			Settings: endpoints.EndpointSettings{
+				Name: "Sign",
+			},
+		},
+		{
+			Methods: []string{http.MethodPut},
+			Route:   "crypto/{name}/verify",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupCryptoV1Alpha1,
+			Handler: a.onCryptoVerify,
+			Settings: endpoints.EndpointSettings{
+				Name: "Verify",
+			},
+		},
+		{
+			Methods: []string{http.MethodPut},
+			Route:   "crypto/{name}/datakey",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupCryptoV1Alpha1,
+			Handler: a.onCryptoGenerateDataKey,
+			Settings: endpoints.EndpointSettings{
+				Name: "GenerateDataKey",
+			},
+		},
+		{
+			Methods: []string{http.MethodPut},
+			Route:   "crypto/{name}/rewrap",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupCryptoV1Alpha1,
+			Handler: a.onCryptoRewrapKey,
+			Settings: endpoints.EndpointSettings{
+				Name: "RewrapKey",
+			},
+		},
+		{
+			Methods: []string{http.MethodDelete},
+			Route:   "crypto/{name}/datakey/cache",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupCryptoV1Alpha1,
+			Handler: a.onFlushCryptoDataKeyCache,
+			Settings: endpoints.EndpointSettings{
+				Name:             "FlushDataKeyCache",
+				RequiresAPIToken: true,
+			},
+		},
 	}
 }
 
@@ -87,39 +160,13 @@ func (a *api) onCryptoEncrypt(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Get the required properties from the headers
-	keyName := r.Header.Get(cryptoHeaderKeyName)
-	if keyName == "" {
-		err = messages.ErrBadRequest.WithFormat("missing header '" + cryptoHeaderKeyName + "'")
-		log.Debug(err)
-		respondWithError(w, err)
-		return
-	}
-	algorithm := r.Header.Get(cryptoHeaderKeyWrapAlgorithm)
-	if algorithm == "" {
-		err = messages.ErrBadRequest.WithFormat("missing header '" + cryptoHeaderKeyWrapAlgorithm + "'")
-		log.Debug(err)
+	encOpts, err := a.cryptoEncryptOptions(r, componentName, component)
+	if err != nil {
+		// Error has been logged already
 		respondWithError(w, err)
 		return
 	}
 
-	// Ensure we have the required headerss
-	encOpts := encv1.EncryptOptions{
-		KeyName:   keyName,
-		Algorithm: encv1.KeyAlgorithm(strings.ToUpper(algorithm)),
-		WrapKeyFn: a.universal.CryptoGetWrapKeyFn(ctx, componentName, component),
-
-		// The next values are optional and could be empty
-		OmitKeyName:       kitstrings.IsTruthy(r.Header.Get(cryptoHeaderOmitDecryptionKeyName)),
-		DecryptionKeyName: r.Header.Get(cryptoHeaderDecryptionKeyName),
-	}
-
-	// Set the cipher if present
-	cipher := r.Header.Get(cryptoHeaderDataEncryptionCipher)
-	if cipher != "" {
-		encOpts.Cipher = ptr.Of(encv1.Cipher(strings.ToUpper(cipher)))
-	}
-
 	// Perform the encryption on the body of the request
 	// Errors returned here, synchronously, are initialization errors, for example due to failed wrapping
 	enc, err := encv1.Encrypt(r.Body, encOpts)
@@ -156,17 +203,9 @@ func (a *api) onCryptoDecrypt(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Build the options
-	decOpts := encv1.DecryptOptions{
-		UnwrapKeyFn: a.universal.CryptoGetUnwrapKeyFn(ctx, componentName, component),
-
-		// The next values are optional and could be empty
-		KeyName: r.Header.Get(cryptoHeaderKeyName),
-	}
-
 	// Perform the decryption on the body of the request
 	// Errors returned here, synchronously, are initialization errors, for example due to failed unwrapping
-	dec, err := encv1.Decrypt(r.Body, decOpts)
+	dec, err := encv1.Decrypt(r.Body, a.cryptoDecryptOptions(r, componentName, component))
 	if err != nil {
 		err = messages.ErrCryptoOperation.WithFormat(err)
 		log.Debug(err)
@@ -185,6 +224,52 @@ func (a *api) onCryptoDecrypt(w http.ResponseWriter, r *http.Request) {
 	respondWithData(w, http.StatusOK, resBody)
 }
 
+// cryptoEncryptOptions returns the options of an encryption from the headers of the request.
+func (a *api) cryptoEncryptOptions(r *http.Request, componentName string, component contribCrypto.SubtleCrypto) (encv1.EncryptOptions, error) {
+	// Get the required properties from the headers
+	keyName := r.Header.Get(cryptoHeaderKeyName)
+	if keyName == "" {
+		err := messages.ErrBadRequest.WithFormat("missing header '" + cryptoHeaderKeyName + "'")
+		log.Debug(err)
+		return encv1.EncryptOptions{}, err
+	}
+	algorithm := r.Header.Get(cryptoHeaderKeyWrapAlgorithm)
+	if algorithm == "" {
+		err := messages.ErrBadRequest.WithFormat("missing header '" + cryptoHeaderKeyWrapAlgorithm + "'")
+		log.Debug(err)
+		return encv1.EncryptOptions{}, err
+	}
+
+	// Ensure we have the required headerss
+	encOpts := encv1.EncryptOptions{
+		KeyName:   keyName,
+		Algorithm: encv1.KeyAlgorithm(strings.ToUpper(algorithm)),
+		WrapKeyFn: a.universal.CryptoGetWrapKeyFn(r.Context(), componentName, component),
+
+		// The next values are optional and could be empty
+		OmitKeyName:       kitstrings.IsTruthy(r.Header.Get(cryptoHeaderOmitDecryptionKeyName)),
+		DecryptionKeyName: r.Header.Get(cryptoHeaderDecryptionKeyName),
+	}
+
+	// Set the cipher if present
+	cipher := r.Header.Get(cryptoHeaderDataEncryptionCipher)
+	if cipher != "" {
+		encOpts.Cipher = ptr.Of(encv1.Cipher(strings.ToUpper(cipher)))
+	}
+
+	return encOpts, nil
+}
+
+// cryptoDecryptOptions returns the options of a decryption from the headers of the request.
+func (a *api) cryptoDecryptOptions(r *http.Request, componentName string, component contribCrypto.SubtleCrypto) encv1.DecryptOptions {
+	return encv1.DecryptOptions{
+		UnwrapKeyFn: a.universal.CryptoGetUnwrapKeyFn(r.Context(), componentName, component),
+
+		// The next values are optional and could be empty
+		KeyName: r.Header.Get(cryptoHeaderKeyName),
+	}
+}
+
 func (a *api) cryptoGetComponent(componentName string) (contribCrypto.SubtleCrypto, error) {
 	if a.universal.CompStore().CryptoProvidersLen() == 0 {
 		err := messages.ErrCryptoProvidersNotConfigured
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/dapr/dapr/pkg/api/universal"
+	"github.com/dapr/dapr/pkg/messages"
+)
+
+// Binary fields are encoded as base64.
+type cryptoDataKeyResponse struct {
+	KeyName      string `json:"keyName"`
+	Algorithm    string `json:"algorithm"`
+	PlaintextKey []byte `json:"plaintextKey"`
+	WrappedKey   []byte `json:"wrappedKey"`
+	Tag          []byte `json:"tag,omitempty"`
+}
+
+type cryptoRewrapKeyRequest struct {
+	KeyName      string `json:"keyName"`
+	Algorithm    string `json:"algorithm"`
+	WrappedKey   []byte `json:"wrappedKey"`
+	Tag          []byte `json:"tag,omitempty"`
+	NewKeyName   string `json:"newKeyName,omitempty"`
+	NewAlgorithm string `json:"newAlgorithm,omitempty"`
+}
+
+type cryptoRewrapKeyResponse struct {
+	WrappedKey []byte `json:"wrappedKey"`
+	Tag        []byte `json:"tag,omitempty"`
+}
+
+// Handler for crypto/<component-name>/datakey
+// Responds with a data encryption key, in plaintext and wrapped with the key.
+// Supported headers:
+// - dapr-key-name (required)
+// - dapr-key-wrap-algorithm (required)
+func (a *api) onCryptoGenerateDataKey(w http.ResponseWriter, r *http.Request) {
+	key, err := a.universal.CryptoGenerateDataKey(r.Context(), universal.CryptoDataKeyRequest{
+		ComponentName: chi.URLParamFromCtx(r.Context(), nameParam),
+		KeyName:       r.Header.Get(cryptoHeaderKeyName),
+		Algorithm:     r.Header.Get(cryptoHeaderKeyWrapAlgorithm),
+	})
+	if err != nil {
+		// Error has been logged already
+		respondWithError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, cryptoDataKeyResponse{
+		KeyName:      key.KeyName,
+		Algorithm:    key.Algorithm,
+		PlaintextKey: key.PlaintextKey,
+		WrappedKey:   key.WrappedKey,
+		Tag:          key.Tag,
+	})
+}
+
+// Handler for crypto/<component-name>/rewrap
+// The body of the request is a JSON object with the wrapped key; the response
+// is the key wrapped with the new key.
+func (a *api) onCryptoRewrapKey(w http.ResponseWriter, r *http.Request) {
+	var req cryptoRewrapKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = messages.ErrMalformedRequest.WithFormat(err)
+		log.Debug(err)
+		respondWithError(w, err)
+		return
+	}
+
+	wrappedKey, tag, err := a.universal.CryptoRewrapKey(r.Context(), universal.CryptoRewrapKeyRequest{
+		ComponentName: chi.URLParamFromCtx(r.Context(), nameParam),
+		KeyName:       req.KeyName,
+		Algorithm:     req.Algorithm,
+		WrappedKey:    req.WrappedKey,
+		Tag:           req.Tag,
+		NewKeyName:    req.NewKeyName,
+		NewAlgorithm:  req.NewAlgorithm,
+	})
+	if err != nil {
+		// Error has been logged already
+		respondWithError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, cryptoRewrapKeyResponse{
+		WrappedKey: wrappedKey,
+		Tag:        tag,
+	})
+}
+
+// Route: DELETE "crypto/{name}/datakey/cache?keyName={keyName}"
+// The "keyName" query parameter is repeatable. Without it, all the keys of the
+// crypto provider are flushed.
+func (a *api) onFlushCryptoDataKeyCache(w http.ResponseWriter, r *http.Request) {
+	err := a.universal.FlushCryptoDataKeyCache(r.Context(), chi.URLParamFromCtx(r.Context(), nameParam), r.URL.Query()["keyName"])
+	if err != nil {
+		respondWithError(w, err)
+		return
+	}
+
+	respondWithEmpty(w)
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/api/universal"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/datakeycache"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+func TestCryptoDataKeyEndpoints(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+
+	compStore := compstore.New()
+	const cryptoComponentName = "myvault"
+	compStore.AddCryptoProvider(cryptoComponentName, &daprt.FakeSubtleCrypto{})
+	cache, err := datakeycache.New(datakeycache.Options{Metadata: map[string]string{"dataKeyCacheTTL": "1m"}})
+	require.NoError(t, err)
+	compStore.AddCryptoDataKeyCache(cryptoComponentName, cache)
+	testAPI := &api{
+		universal: universal.New(universal.Options{
+			Logger:     log,
+			CompStore:  compStore,
+			Resiliency: resiliency.New(nil),
+		}),
+	}
+
+	fakeServer.StartServer(testAPI.constructCryptoEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	dataKeyPath := fmt.Sprintf("%s/crypto/%s/datakey", apiVersionV1alpha1, cryptoComponentName)
+	headers := []string{
+		cryptoHeaderKeyName, "aes-passthrough",
+		cryptoHeaderKeyWrapAlgorithm, "A256KW",
+	}
+
+	var dataKey cryptoDataKeyResponse
+	t.Run("Generate data key - 200", func(t *testing.T) {
+		resp := fakeServer.DoRequest(http.MethodPut, dataKeyPath, nil, nil, headers...)
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(resp.RawBody))
+		require.NoError(t, json.Unmarshal(resp.RawBody, &dataKey))
+		assert.Equal(t, "aes-passthrough", dataKey.KeyName)
+		assert.Equal(t, "A256KW", dataKey.Algorithm)
+		assert.Len(t, dataKey.PlaintextKey, 32)
+		assert.Equal(t, dataKey.PlaintextKey, dataKey.WrappedKey)
+
+		// The data key is cached.
+		resp = fakeServer.DoRequest(http.MethodPut, dataKeyPath, nil, nil, headers...)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var again cryptoDataKeyResponse
+		require.NoError(t, json.Unmarshal(resp.RawBody, &again))
+		assert.Equal(t, dataKey, again)
+	})
+
+	t.Run("Generate data key without key name - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest(http.MethodPut, dataKeyPath, nil, nil, cryptoHeaderKeyWrapAlgorithm, "A256KW")
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "ERR_BAD_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	cachePath := fmt.Sprintf("%s/crypto/%s/datakey/cache", apiVersionV1alpha1, cryptoComponentName)
+	t.Run("Flush data key cache without API token - 403", func(t *testing.T) {
+		resp := fakeServer.DoRequest(http.MethodDelete, cachePath, nil, nil)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+		assert.Equal(t, "ERR_API_TOKEN_REQUIRED", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Flush data key cache - 204", func(t *testing.T) {
+		const token = "1234"
+		t.Setenv("DAPR_API_TOKEN", token)
+		tokenServer := newFakeHTTPServer()
+		tokenServer.StartServer(testAPI.constructCryptoEndpoints(), &fakeHTTPServerOptions{
+			apiAuth: true,
+		})
+		defer tokenServer.Shutdown()
+
+		resp := tokenServer.DoRequest(http.MethodDelete, cachePath, nil, map[string]string{"keyName": "aes-passthrough"}, "dapr-api-token", token)
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp = fakeServer.DoRequest(http.MethodPut, dataKeyPath, nil, nil, headers...)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var newKey cryptoDataKeyResponse
+		require.NoError(t, json.Unmarshal(resp.RawBody, &newKey))
+		assert.NotEqual(t, dataKey.PlaintextKey, newKey.PlaintextKey)
+	})
+
+	rewrapPath := fmt.Sprintf("%s/crypto/%s/rewrap", apiVersionV1alpha1, cryptoComponentName)
+	t.Run("Rewrap key - 200", func(t *testing.T) {
+		body, err := json.Marshal(cryptoRewrapKeyRequest{
+			KeyName:    "aes-passthrough",
+			Algorithm:  "A256KW",
+			WrappedKey: dataKey.WrappedKey,
+		})
+		require.NoError(t, err)
+		resp := fakeServer.DoRequest(http.MethodPut, rewrapPath, body, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(resp.RawBody))
+
+		var res cryptoRewrapKeyResponse
+		require.NoError(t, json.Unmarshal(resp.RawBody, &res))
+		assert.Equal(t, dataKey.PlaintextKey, res.WrappedKey)
+		assert.Empty(t, res.Tag)
+	})
+
+	t.Run("Rewrap key with malformed body - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest(http.MethodPut, rewrapPath, []byte("{"), nil)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "ERR_MALFORMED_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Rewrap key with provider error - 500", func(t *testing.T) {
+		resp := fakeServer.DoRequest(http.MethodPut, rewrapPath, []byte(`{"keyName":"error","algorithm":"A256KW","wrappedKey":"AQID"}`), nil)
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+		assert.Equal(t, "ERR_CRYPTO", resp.ErrorBody["errorCode"])
+	})
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"encoding/base64"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/dapr/dapr/pkg/api/universal"
+	"github.com/dapr/dapr/pkg/messages"
+)
+
+const (
+	cryptoHeaderSignatureAlgorithm = "dapr-signature-algorithm"
+	cryptoHeaderSignatureFormat    = "dapr-signature-format"
+	cryptoHeaderSignature          = "dapr-signature"
+)
+
+type cryptoVerifyResponse struct {
+	Valid bool `json:"valid"`
+}
+
+// Handler for crypto/<component-name>/sign
+// The body of the request is the payload; the response is its detached
+// signature, or its MAC for the HS* algorithms.
+// Supported headers:
+// - dapr-key-name (required)
+// - dapr-signature-algorithm (required): a JWA algorithm, e.g. "ES256", "EdDSA" or "HS256"
+// - dapr-signature-format: "raw" (default) or "jws"
+func (a *api) onCryptoSign(w http.ResponseWriter, r *http.Request) {
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		err = messages.ErrBodyRead.WithFormat(err)
+		log.Debug(err)
+		respondWithError(w, err)
+		return
+	}
+
+	format := r.Header.Get(cryptoHeaderSignatureFormat)
+	signature, err := a.universal.CryptoSign(r.Context(), universal.CryptoSignRequest{
+		ComponentName: chi.URLParamFromCtx(r.Context(), nameParam),
+		KeyName:       r.Header.Get(cryptoHeaderKeyName),
+		Algorithm:     r.Header.Get(cryptoHeaderSignatureAlgorithm),
+		Format:        format,
+		Payload:       payload,
+	})
+	if err != nil {
+		// Error has been logged already
+		respondWithError(w, err)
+		return
+	}
+
+	if format == universal.CryptoSignatureFormatJWS {
+		w.Header().Set(headerContentType, "application/jose")
+	} else {
+		w.Header().Set(headerContentType, "application/octet-stream")
+	}
+	respondWithData(w, http.StatusOK, signature)
+}
+
+// Handler for crypto/<component-name>/verify
+// The body of the request is the payload; the response is a JSON object with
+// the "valid" property.
+// Supported headers:
+// - dapr-signature (required): the raw signature encoded as base64, or a JWS with a detached payload
+// - dapr-key-name: required unless the JWS header has the "kid" property
+// - dapr-signature-algorithm: required unless the signature is a JWS
+func (a *api) onCryptoVerify(w http.ResponseWriter, r *http.Request) {
+	signature, err := cryptoDecodeSignature(r.Header.Get(cryptoHeaderSignature))
+	if err != nil {
+		err = messages.ErrBadRequest.WithFormat("invalid header '" + cryptoHeaderSignature + "': " + err.Error())
+		log.Debug(err)
+		respondWithError(w, err)
+		return
+	}
+
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		err = messages.ErrBodyRead.WithFormat(err)
+		log.Debug(err)
+		respondWithError(w, err)
+		return
+	}
+
+	valid, err := a.universal.CryptoVerify(r.Context(), universal.CryptoVerifyRequest{
+		ComponentName: chi.URLParamFromCtx(r.Context(), nameParam),
+		KeyName:       r.Header.Get(cryptoHeaderKeyName),
+		Algorithm:     r.Header.Get(cryptoHeaderSignatureAlgorithm),
+		Payload:       payload,
+		Signature:     signature,
+	})
+	if err != nil {
+		// Error has been logged already
+		respondWithError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, cryptoVerifyResponse{Valid: valid})
+}
+
+// cryptoDecodeSignature decodes the signature of the dapr-signature header.
+// JWS signatures are returned as-is.
+func cryptoDecodeSignature(v string) ([]byte, error) {
+	if strings.Contains(v, "..") {
+		return []byte(v), nil
+	}
+	for _, enc := range []*base64.Encoding{base64.StdEncoding, base64.RawStdEncoding, base64.URLEncoding, base64.RawURLEncoding} {
+		if sig, err := enc.DecodeString(v); err == nil {
+			return sig, nil
+		}
+	}
+	return nil, errors.New("the signature is not encoded as base64")
+}
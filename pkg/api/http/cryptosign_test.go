@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/api/universal"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+func TestCryptoSignEndpoints(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+
+	compStore := compstore.New()
+	const cryptoComponentName = "myvault"
+	compStore.AddCryptoProvider(cryptoComponentName, &daprt.FakeSubtleCrypto{})
+	testAPI := &api{
+		universal: universal.New(universal.Options{
+			Logger:     log,
+			CompStore:  compStore,
+			Resiliency: resiliency.New(nil),
+		}),
+	}
+
+	fakeServer.StartServer(testAPI.constructCryptoEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	signature := []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+
+	doRequest := func(t *testing.T, path string, headers ...string) (*http.Response, []byte) {
+		t.Helper()
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodPut, "http://127.0.0.1/"+path, strings.NewReader("hello world"))
+		require.NoError(t, err)
+		for i := 0; i < len(headers); i += 2 {
+			req.Header.Set(headers[i], headers[i+1])
+		}
+		res, err := fakeServer.client.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		return res, resBody
+	}
+	signPath := fmt.Sprintf("%s/crypto/%s/sign", apiVersionV1alpha1, cryptoComponentName)
+	verifyPath := fmt.Sprintf("%s/crypto/%s/verify", apiVersionV1alpha1, cryptoComponentName)
+
+	t.Run("Sign raw - 200", func(t *testing.T) {
+		res, body := doRequest(t, signPath,
+			cryptoHeaderKeyName, "good",
+			cryptoHeaderSignatureAlgorithm, "RS256",
+		)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "application/octet-stream", res.Header.Get("Content-Type"))
+		assert.Equal(t, signature, body)
+	})
+
+	t.Run("Sign JWS - 200", func(t *testing.T) {
+		res, body := doRequest(t, signPath,
+			cryptoHeaderKeyName, "good",
+			cryptoHeaderSignatureAlgorithm, "PS256",
+			cryptoHeaderSignatureFormat, "jws",
+		)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "application/jose", res.Header.Get("Content-Type"))
+
+		header, sig, ok := bytes.Cut(body, []byte(".."))
+		require.True(t, ok)
+		rawHeader, err := base64.RawURLEncoding.DecodeString(string(header))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"alg":"PS256","kid":"good"}`, string(rawHeader))
+		assert.Equal(t, base64.RawURLEncoding.EncodeToString(signature), string(sig))
+	})
+
+	t.Run("Sign with missing algorithm - 400", func(t *testing.T) {
+		res, body := doRequest(t, signPath, cryptoHeaderKeyName, "good")
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+		assert.Contains(t, string(body), "ERR_BAD_REQUEST")
+	})
+
+	t.Run("Sign HMAC unsupported by the provider - 501", func(t *testing.T) {
+		res, body := doRequest(t, signPath,
+			cryptoHeaderKeyName, "good",
+			cryptoHeaderSignatureAlgorithm, "HS256",
+		)
+		assert.Equal(t, http.StatusNotImplemented, res.StatusCode)
+		assert.Contains(t, string(body), "ERR_CRYPTO_ALGORITHM_NOT_SUPPORTED")
+	})
+
+	t.Run("Sign with provider error - 500", func(t *testing.T) {
+		res, body := doRequest(t, signPath,
+			cryptoHeaderKeyName, "error",
+			cryptoHeaderSignatureAlgorithm, "RS256",
+		)
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+		assert.Contains(t, string(body), "ERR_CRYPTO")
+	})
+
+	for keyName, valid := range map[string]bool{"good": true, "bad": false} {
+		t.Run("Verify "+keyName+" signature - 200", func(t *testing.T) {
+			res, body := doRequest(t, verifyPath,
+				cryptoHeaderKeyName, keyName,
+				cryptoHeaderSignatureAlgorithm, "RS256",
+				cryptoHeaderSignature, base64.StdEncoding.EncodeToString(signature),
+			)
+			assert.Equal(t, http.StatusOK, res.StatusCode)
+
+			var resBody cryptoVerifyResponse
+			require.NoError(t, json.Unmarshal(body, &resBody))
+			assert.Equal(t, valid, resBody.Valid)
+		})
+	}
+
+	t.Run("Verify JWS - 200", func(t *testing.T) {
+		header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","kid":"good"}`))
+		res, body := doRequest(t, verifyPath,
+			cryptoHeaderSignature, header+".."+base64.RawURLEncoding.EncodeToString(signature),
+		)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.JSONEq(t, `{"valid":true}`, string(body))
+	})
+
+	t.Run("Verify with invalid signature header - 400", func(t *testing.T) {
+		res, body := doRequest(t, verifyPath,
+			cryptoHeaderKeyName, "good",
+			cryptoHeaderSignatureAlgorithm, "RS256",
+			cryptoHeaderSignature, "not base64!",
+		)
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+		assert.Contains(t, string(body), "ERR_BAD_REQUEST")
+	})
+
+	t.Run("Verify with missing signature - 400", func(t *testing.T) {
+		res, _ := doRequest(t, verifyPath,
+			cryptoHeaderKeyName, "good",
+			cryptoHeaderSignatureAlgorithm, "RS256",
+		)
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+}
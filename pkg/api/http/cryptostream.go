@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/dapr/dapr/pkg/messages"
+	encv1 "github.com/dapr/kit/schemes/enc/v1"
+)
+
+const (
+	// cryptoTrailerError is the trailer of the streamed responses with the
+	// error which interrupted the stream. Clients must check it, as the status
+	// code has been sent already.
+	cryptoTrailerError = "dapr-crypto-error"
+
+	// cryptoStreamChunkSize is the size of the chunks of the streamed responses,
+	// which is the size of the segments of the Dapr encryption scheme.
+	cryptoStreamChunkSize = encv1.SegmentSize
+)
+
+// Handler for crypto/<component-name>/encrypt/stream
+// It's like crypto/<component-name>/encrypt, but the encrypted data is
+// streamed while the request body is read, so large data can be encrypted
+// without buffering it.
+func (a *api) onCryptoEncryptStream(w http.ResponseWriter, r *http.Request) {
+	componentName := chi.URLParamFromCtx(r.Context(), nameParam)
+	component, err := a.cryptoGetComponent(componentName)
+	if err != nil {
+		// Error has been logged already
+		respondWithError(w, err)
+		return
+	}
+
+	encOpts, err := a.cryptoEncryptOptions(r, componentName, component)
+	if err != nil {
+		// Error has been logged already
+		respondWithError(w, err)
+		return
+	}
+
+	rc := cryptoStreamResponseController(w)
+	enc, err := encv1.Encrypt(r.Body, encOpts)
+	if err != nil {
+		err = messages.ErrCryptoOperation.WithFormat(err)
+		log.Debug(err)
+		respondWithError(w, err)
+		return
+	}
+	respondWithCryptoStream(w, rc, enc)
+}
+
+// Handler for crypto/<component-name>/decrypt/stream
+// It's like crypto/<component-name>/decrypt, but the decrypted data is
+// streamed while the request body is read. Each segment of the data is
+// authenticated before it's written.
+func (a *api) onCryptoDecryptStream(w http.ResponseWriter, r *http.Request) {
+	componentName := chi.URLParamFromCtx(r.Context(), nameParam)
+	component, err := a.cryptoGetComponent(componentName)
+	if err != nil {
+		// Error has been logged already
+		respondWithError(w, err)
+		return
+	}
+
+	rc := cryptoStreamResponseController(w)
+	dec, err := encv1.Decrypt(r.Body, a.cryptoDecryptOptions(r, componentName, component))
+	if err != nil {
+		err = messages.ErrCryptoOperation.WithFormat(err)
+		log.Debug(err)
+		respondWithError(w, err)
+		return
+	}
+	respondWithCryptoStream(w, rc, dec)
+}
+
+// cryptoStreamResponseController returns the controller of the response,
+// with full-duplex enabled, so the request body can still be read after the
+// response has started.
+func cryptoStreamResponseController(w http.ResponseWriter) *http.ResponseController {
+	rc := http.NewResponseController(w)
+	if err := rc.EnableFullDuplex(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		log.Debugf("Failed to enable full-duplex for the crypto stream: %v", err)
+	}
+	return rc
+}
+
+// respondWithCryptoStream streams the output of an encryption or decryption.
+func respondWithCryptoStream(w http.ResponseWriter, rc *http.ResponseController, out io.Reader) {
+	// The first chunk is read before responding, so the errors of the first
+	// segment, e.g. a wrong key, are returned with the status code.
+	buf := make([]byte, cryptoStreamChunkSize)
+	n, err := io.ReadAtLeast(out, buf, 1)
+	if err != nil && !errors.Is(err, io.EOF) {
+		err = messages.ErrCryptoOperation.WithFormat(err)
+		log.Debug(err)
+		respondWithError(w, err)
+		return
+	}
+
+	h := w.Header()
+	h.Set(headerContentType, "application/octet-stream")
+	h.Set("Trailer", cryptoTrailerError)
+	w.WriteHeader(http.StatusOK)
+
+	for {
+		if n > 0 {
+			if _, werr := w.Write(buf[:n]); werr != nil {
+				log.Debugf("Crypto stream interrupted: %v", werr)
+				return
+			}
+			// Not all response writers support flushing, in which case the data is
+			// sent when the handler returns.
+			_ = rc.Flush()
+		}
+		if err != nil {
+			break
+		}
+		n, err = out.Read(buf)
+	}
+
+	if !errors.Is(err, io.EOF) {
+		// The response has already started, so the error is sent in the trailer.
+		log.Debugf("Crypto stream interrupted: %v", err)
+		h.Set(cryptoTrailerError, messages.ErrCryptoOperation.WithFormat(err).Message())
+	}
+}
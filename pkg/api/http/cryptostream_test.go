@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/api/universal"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+func TestCryptoStreamEndpoints(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+
+	compStore := compstore.New()
+	const cryptoComponentName = "myvault"
+	compStore.AddCryptoProvider(cryptoComponentName, &daprt.FakeSubtleCrypto{})
+	testAPI := &api{
+		universal: universal.New(universal.Options{
+			Logger:     log,
+			CompStore:  compStore,
+			Resiliency: resiliency.New(nil),
+		}),
+	}
+
+	fakeServer.StartServer(testAPI.constructCryptoEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	// The message spans multiple segments of the encryption scheme
+	message := bytes.Repeat([]byte("Tanto va la gatta al lardo che ci lascia lo zampino. "), 4000)
+
+	doRequest := func(t *testing.T, path string, body []byte, headers ...string) (*http.Response, []byte) {
+		t.Helper()
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodPut, "http://127.0.0.1/"+path, bytes.NewReader(body))
+		require.NoError(t, err)
+		for i := 0; i < len(headers); i += 2 {
+			req.Header.Set(headers[i], headers[i+1])
+		}
+		res, err := fakeServer.client.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		return res, resBody
+	}
+
+	var encMessage []byte
+	t.Run("Encrypt stream successfully - 200", func(t *testing.T) {
+		apiPath := fmt.Sprintf("%s/crypto/%s/encrypt/stream", apiVersionV1alpha1, cryptoComponentName)
+		res, body := doRequest(t, apiPath, message,
+			cryptoHeaderKeyName, "aes-passthrough",
+			cryptoHeaderKeyWrapAlgorithm, "AES",
+		)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "application/octet-stream", res.Header.Get("Content-Type"))
+		assert.Empty(t, res.Trailer.Get(cryptoTrailerError))
+		assert.True(t, bytes.HasPrefix(body, []byte("dapr.io/enc/v1\n")))
+		encMessage = body
+	})
+
+	t.Run("Decrypt stream successfully - 200", func(t *testing.T) {
+		apiPath := fmt.Sprintf("%s/crypto/%s/decrypt/stream", apiVersionV1alpha1, cryptoComponentName)
+		res, body := doRequest(t, apiPath, encMessage)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Empty(t, res.Trailer.Get(cryptoTrailerError))
+		assert.Equal(t, message, body)
+	})
+
+	t.Run("Decrypt stream of a tampered segment - error in trailer", func(t *testing.T) {
+		tampered := bytes.Clone(encMessage)
+		tampered[len(tampered)-10] ^= 0xff
+
+		apiPath := fmt.Sprintf("%s/crypto/%s/decrypt/stream", apiVersionV1alpha1, cryptoComponentName)
+		res, body := doRequest(t, apiPath, tampered)
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.NotEmpty(t, res.Trailer.Get(cryptoTrailerError))
+		assert.Less(t, len(body), len(message))
+	})
+
+	t.Run("Decrypt stream of invalid data - 500", func(t *testing.T) {
+		apiPath := fmt.Sprintf("%s/crypto/%s/decrypt/stream", apiVersionV1alpha1, cryptoComponentName)
+		res, _ := doRequest(t, apiPath, []byte("not encrypted"))
+
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+	})
+
+	t.Run("Encrypt stream without key name - 400", func(t *testing.T) {
+		apiPath := fmt.Sprintf("%s/crypto/%s/encrypt/stream", apiVersionV1alpha1, cryptoComponentName)
+		res, _ := doRequest(t, apiPath, message, cryptoHeaderKeyWrapAlgorithm, "AES")
+
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+}
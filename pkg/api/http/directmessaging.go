@@ -31,6 +31,7 @@ import (
 
 	"github.com/dapr/dapr/pkg/api/http/consts"
 	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	"github.com/dapr/dapr/pkg/channel"
 	diagConsts "github.com/dapr/dapr/pkg/diagnostics/consts"
 	"github.com/dapr/dapr/pkg/messages"
 	"github.com/dapr/dapr/pkg/messages/errorcodes"
@@ -122,6 +123,11 @@ func (a *api) onDirectMessage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if isWebSocketUpgrade(r) {
+		a.onDirectMessageWebSocket(w, r, targetID, invokeMethodName)
+		return
+	}
+
 	var policyDef *resiliency.PolicyDefinition
 	switch {
 	case strings.HasPrefix(targetID, "http://") || strings.HasPrefix(targetID, "https://"):
@@ -145,7 +151,8 @@ func (a *api) onDirectMessage(w http.ResponseWriter, r *http.Request) {
 		WithHTTPHeaders(r.Header).
 		WithHTTPResponseWriter(w)
 	if policyDef != nil {
-		req.WithReplay(policyDef.HasRetries())
+		req.WithReplay(policyDef.HasRetries()).
+			WithMaxReplaySize(a.maxReplayBufferSize)
 	}
 	defer req.Close()
 
@@ -233,21 +240,20 @@ func (a *api) onDirectMessage(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(int(rResp.Status().GetCode()))
 
 		reader := rResp.RawData()
-		isSSE := sse.IsSSEHttpRequest(r)
 
-		if !isSSE {
+		var err error
+		switch {
+		case sse.IsSSEHttpRequest(r):
+			err = sse.FlushSSEResponse(r.Context(), w, reader)
+		case sse.IsSSEContentType(rResp.ContentType()):
+			// The app responded with an event stream without the caller asking for one
+			err = sse.StreamResponse(r.Context(), w, reader)
+		default:
 			// Use regular io.Copy for non-streaming responses
-			_, rErr = io.Copy(w, reader)
-			if rErr != nil {
-				// Do not return rResp here, we already have a deferred `Close` call on it
-				return nil, backoff.Permanent(rErr)
-			}
-
-			return nil, nil
+			_, err = io.Copy(w, reader)
 		}
-
-		err := sse.FlushSSEResponse(r.Context(), w, reader)
 		if err != nil {
+			// Do not return rResp here, we already have a deferred `Close` call on it
 			return nil, backoff.Permanent(err)
 		}
 
@@ -391,6 +397,130 @@ func pathHasPrefix(path string, prefixParts ...string) int {
 	return -1
 }
 
+// onDirectMessageWebSocket proxies a WebSocket upgrade request.
+// Connections to HTTP endpoints are proxied directly, while connections to apps go through the sidecar of the target app, which applies its access control policies.
+func (a *api) onDirectMessageWebSocket(w http.ResponseWriter, r *http.Request, targetID, invokeMethodName string) {
+	req := invokev1.NewInvokeMethodRequest(invokeMethodName).
+		WithHTTPExtension(r.Method, r.URL.RawQuery).
+		WithHTTPHeaders(r.Header)
+	defer req.Close()
+
+	if a.channels != nil && (strings.HasPrefix(targetID, "http://") || strings.HasPrefix(targetID, "https://") || a.isHTTPEndpoint(targetID)) {
+		var ch channel.HTTPEndpointAppChannel
+		if endpCh, ok := a.channels.EndpointChannels()[targetID]; ok {
+			ch = endpCh
+		} else {
+			ch = a.channels.HTTPEndpointsAppChannel()
+		}
+		wsCh, ok := ch.(channel.WebSocketAppChannel)
+		if !ok {
+			respondWithError(w, messages.ErrDirectInvokeWebSocket.WithFormat(targetID))
+			return
+		}
+
+		err := wsCh.ProxyWebSocket(w, r, req, targetID)
+		if err != nil {
+			log.Debugf("Failed to proxy websocket connection to %s: %v", targetID, err)
+		}
+		return
+	}
+
+	wsDM, ok := a.directMessaging.(invokev1.WebSocketDirectMessaging)
+	if !ok {
+		respondWithError(w, messages.ErrDirectInvokeWebSocket.WithFormat(targetID))
+		return
+	}
+
+	res, err := wsDM.DialWebSocket(r.Context(), targetID, req)
+	if err != nil {
+		// Allowlist policies that are applied on the callee side can return a Permission Denied error
+		apiErr := messages.ErrDirectInvoke.WithFormat(targetID, err)
+		statusCode := apiErr.HTTPCode()
+		if status.Code(err) == codes.PermissionDenied {
+			statusCode = invokev1.HTTPStatusFromCode(codes.PermissionDenied)
+		}
+		respondWithDataAndRecordError(w, statusCode, apiErr.JSONErrorValue(), apiErr)
+		return
+	}
+	defer res.Body.Close()
+
+	err = proxyUpgradedConnection(w, res)
+	if err != nil {
+		log.Debugf("Failed to proxy websocket connection to %s: %v", targetID, err)
+	}
+}
+
+// proxyUpgradedConnection writes the response to the upgrade request to the caller and, if the upgrade was accepted, copies the data between the caller and the upgraded connection until either side closes it.
+func proxyUpgradedConnection(w http.ResponseWriter, res *http.Response) error {
+	if res.StatusCode != http.StatusSwitchingProtocols {
+		for k, v := range res.Header {
+			w.Header()[k] = v
+		}
+		w.WriteHeader(res.StatusCode)
+		_, err := io.Copy(w, res.Body)
+		return err
+	}
+
+	backConn, ok := res.Body.(io.ReadWriteCloser)
+	if !ok {
+		w.WriteHeader(http.StatusBadGateway)
+		return errors.New("upgraded connection is not writable")
+	}
+
+	conn, brw, err := http.NewResponseController(w).Hijack()
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return fmt.Errorf("failed to hijack connection: %w", err)
+	}
+	defer conn.Close()
+
+	_, err = fmt.Fprintf(brw, "HTTP/1.1 %s\r\n", res.Status)
+	if err == nil {
+		err = res.Header.Write(brw)
+	}
+	if err == nil {
+		_, err = brw.WriteString("\r\n")
+	}
+	if err == nil {
+		err = brw.Flush()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write upgrade response: %w", err)
+	}
+
+	// Copy in both directions, and close both connections as soon as either side is done
+	// The reader of the caller's connection is buffered, and may already contain data sent after the upgrade request
+	errCh := make(chan error, 2)
+	go func() {
+		_, cErr := io.Copy(backConn, brw)
+		errCh <- cErr
+	}()
+	go func() {
+		_, cErr := io.Copy(conn, backConn)
+		errCh <- cErr
+	}()
+	err = <-errCh
+	conn.Close()
+	backConn.Close()
+	<-errCh
+	return err
+}
+
+// isWebSocketUpgrade returns true if the request is asking to upgrade the connection to WebSocket.
+func isWebSocketUpgrade(r *http.Request) bool {
+	if !strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		return false
+	}
+	for _, v := range r.Header.Values("Connection") {
+		for _, token := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(token), "upgrade") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (a *api) isHTTPEndpoint(appID string) bool {
 	endpoint, ok := a.universal.CompStore().GetHTTPEndpoint(appID)
 	return ok && endpoint.Name == appID
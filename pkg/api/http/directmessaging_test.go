@@ -15,7 +15,10 @@ limitations under the License.
 package http
 
 import (
+	"context"
 	"errors"
+	"io"
+	"net"
 	"net/http"
 	"strings"
 	"testing"
@@ -23,6 +26,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	epb "google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -83,6 +87,20 @@ func TestV1DirectMessagingEndpoints(t *testing.T) {
 		assert.Equal(t, "fakeDirectMessageResponse", string(resp.RawBody))
 	})
 
+	t.Run("Invoke direct messaging with websocket upgrade without websocket support - 501 Not Implemented", func(t *testing.T) {
+		mockDirectMessaging.Calls = nil // reset call count
+
+		// act
+		resp := fakeServer.DoRequest("GET", "v1.0/invoke/fakeAppID/method/ws", nil, nil,
+			"Upgrade", "websocket",
+			"Connection", "Upgrade",
+		)
+
+		// assert
+		mockDirectMessaging.AssertNumberOfCalls(t, "Invoke", 0)
+		assert.Equal(t, 501, resp.StatusCode)
+	})
+
 	t.Run("Invoke direct messaging without querystring for external invocation - 200 OK", func(t *testing.T) {
 		fakeDirectMessageResponse := getFakeDirectMessageResponse()
 		defer fakeDirectMessageResponse.Close()
@@ -1053,6 +1071,113 @@ func TestPathHasPrefix(t *testing.T) {
 	}
 }
 
+// fakeWebSocketDirectMessaging accepts WebSocket connections to the "ws" method and echoes their data.
+type fakeWebSocketDirectMessaging struct {
+	*daprt.MockDirectMessaging
+	err error
+}
+
+func (f *fakeWebSocketDirectMessaging) DialWebSocket(_ context.Context, targetAppID string, req *invokev1.InvokeMethodRequest) (*http.Response, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if req.Message().GetMethod() != "ws" || req.Message().GetHttpExtension().GetVerb() != commonv1.HTTPExtension_GET { //nolint:nosnakecase
+		return &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     http.Header{"Content-Type": []string{"text/plain"}},
+			Body:       io.NopCloser(strings.NewReader("not found")),
+		}, nil
+	}
+
+	appConn, conn := net.Pipe()
+	go func() {
+		defer appConn.Close()
+		io.Copy(appConn, appConn)
+	}()
+	return &http.Response{
+		Status:     "101 Switching Protocols",
+		StatusCode: http.StatusSwitchingProtocols,
+		Header:     http.Header{"Upgrade": []string{"websocket"}, "Connection": []string{"Upgrade"}, "X-Target": []string{targetAppID}},
+		Body:       conn,
+	}, nil
+}
+
+func TestV1DirectMessagingWebSocket(t *testing.T) {
+	dm := &fakeWebSocketDirectMessaging{MockDirectMessaging: new(daprt.MockDirectMessaging)}
+	fakeServer := newFakeHTTPServer()
+	testAPI := &api{
+		directMessaging: dm,
+		universal: universal.New(universal.Options{
+			CompStore:  compstore.New(),
+			Resiliency: resiliency.New(nil),
+		}),
+	}
+	fakeServer.StartServer(testAPI.constructDirectMessagingEndpoints(), nil)
+
+	upgrade := func(t *testing.T, method string) *http.Response {
+		t.Helper()
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "http://127.0.0.1/v1.0/invoke/fakeAppID/method/"+method, nil)
+		require.NoError(t, err)
+		req.Header.Set("Upgrade", "websocket")
+		req.Header.Set("Connection", "Upgrade")
+		res, err := fakeServer.client.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { res.Body.Close() })
+		return res
+	}
+
+	t.Run("upgrade accepted by the app", func(t *testing.T) {
+		res := upgrade(t, "ws")
+		assert.Equal(t, http.StatusSwitchingProtocols, res.StatusCode)
+		assert.Equal(t, "fakeAppID", res.Header.Get("X-Target"))
+
+		conn, ok := res.Body.(io.ReadWriteCloser)
+		require.True(t, ok)
+		_, err := conn.Write([]byte("ping"))
+		require.NoError(t, err)
+		buf := make([]byte, 4)
+		_, err = io.ReadFull(conn, buf)
+		require.NoError(t, err)
+		assert.Equal(t, "ping", string(buf))
+	})
+
+	t.Run("upgrade rejected by the app", func(t *testing.T) {
+		res := upgrade(t, "other")
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "not found", string(body))
+	})
+
+	t.Run("denied by the access control policies of the target", func(t *testing.T) {
+		dm.err = status.Error(codes.PermissionDenied, "access denied")
+		t.Cleanup(func() { dm.err = nil })
+
+		res := upgrade(t, "ws")
+		assert.Equal(t, http.StatusForbidden, res.StatusCode)
+	})
+}
+
+func TestIsWebSocketUpgrade(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers http.Header
+		want    bool
+	}{
+		{name: "websocket upgrade", headers: http.Header{"Upgrade": []string{"websocket"}, "Connection": []string{"Upgrade"}}, want: true},
+		{name: "connection with multiple tokens", headers: http.Header{"Upgrade": []string{"WebSocket"}, "Connection": []string{"keep-alive, Upgrade"}}, want: true},
+		{name: "missing connection header", headers: http.Header{"Upgrade": []string{"websocket"}}},
+		{name: "other protocol", headers: http.Header{"Upgrade": []string{"h2c"}, "Connection": []string{"Upgrade"}}},
+		{name: "no headers", headers: http.Header{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &http.Request{Header: tt.headers}
+			assert.Equal(t, tt.want, isWebSocketUpgrade(r))
+		})
+	}
+}
+
 func TestFindTargetIDAndMethod(t *testing.T) {
 	tests := []struct {
 		name         string
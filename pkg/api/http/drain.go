@@ -0,0 +1,77 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	"github.com/dapr/dapr/pkg/messages"
+)
+
+var endpointGroupDrainV1 = &endpoints.EndpointGroup{
+	Name:                 endpoints.EndpointGroupDrain,
+	Version:              endpoints.EndpointGroupVersion1,
+	AppendSpanAttributes: nil, // TODO
+}
+
+func (a *api) constructDrainEndpoints() []endpoints.Endpoint {
+	return []endpoints.Endpoint{
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "drain",
+			Version: apiVersionV1,
+			Group:   endpointGroupDrainV1,
+			Handler: a.onPostDrain,
+			Settings: endpoints.EndpointSettings{
+				Name: "Drain",
+			},
+		},
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "drain",
+			Version: apiVersionV1,
+			Group:   endpointGroupDrainV1,
+			Handler: a.onGetDrainStatus,
+			Settings: endpoints.EndpointSettings{
+				Name: "GetDrainStatus",
+			},
+		},
+	}
+}
+
+// onPostDrain starts draining Dapr, which shuts down once the drain has completed.
+// The optional "timeout" query string parameter overrides the configured deadline of the drain.
+func (a *api) onPostDrain(w http.ResponseWriter, r *http.Request) {
+	var timeout time.Duration
+	if v := r.URL.Query().Get("timeout"); v != "" {
+		var err error
+		timeout, err = time.ParseDuration(v)
+		if err != nil || timeout <= 0 {
+			msg := messages.ErrBadRequest.WithFormat(fmt.Sprintf("invalid drain timeout '%s'", v))
+			respondWithError(w, msg)
+			log.Debug(msg)
+			return
+		}
+	}
+
+	status, _ := a.universal.Drain(timeout)
+	respondWithJSON(w, http.StatusAccepted, status)
+}
+
+func (a *api) onGetDrainStatus(w http.ResponseWriter, r *http.Request) {
+	respondWithJSON(w, http.StatusOK, a.universal.DrainStatus())
+}
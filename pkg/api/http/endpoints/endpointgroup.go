@@ -57,7 +57,9 @@ const (
 	EndpointGroupHealth            EndpointGroupName = "healthz"
 	EndpointGroupJobs              EndpointGroupName = "jobs"
 	EndpointGroupShutdown          EndpointGroupName = "shutdown"
+	EndpointGroupDrain             EndpointGroupName = "drain"
 	EndpointGroupConversation      EndpointGroupName = "conversation"
+	EndpointGroupOpenAPI           EndpointGroupName = "openapi"
 )
 
 // EndpointGroupVersion is the version of an endpoint group.
@@ -78,7 +78,7 @@ func (a *api) onGetHealthz(w http.ResponseWriter, r *http.Request) {
 
 func (a *api) onGetOutboundHealthz(w http.ResponseWriter, r *http.Request) {
 	if !a.outboundHealthz.IsReady() {
-		msg := messages.ErrOutboundHealthNotReady
+		msg := messages.ErrOutboundHealthNotReady.WithFormat(a.outboundHealthz.GetUnhealthyTargets())
 		respondWithError(w, msg)
 		log.Debug(msg)
 		return
@@ -20,9 +20,11 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	nethttp "net/http"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-chi/chi/v5"
@@ -39,12 +41,15 @@ import (
 	"github.com/dapr/dapr/pkg/api/http/endpoints"
 	"github.com/dapr/dapr/pkg/api/universal"
 	"github.com/dapr/dapr/pkg/channel/http"
+	configurationLoader "github.com/dapr/dapr/pkg/components/configuration"
 	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	"github.com/dapr/dapr/pkg/compression"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	diagConsts "github.com/dapr/dapr/pkg/diagnostics/consts"
 	diagUtils "github.com/dapr/dapr/pkg/diagnostics/utils"
 	"github.com/dapr/dapr/pkg/encryption"
+	"github.com/dapr/dapr/pkg/expr"
 	"github.com/dapr/dapr/pkg/healthz"
 	"github.com/dapr/dapr/pkg/messages"
 	"github.com/dapr/dapr/pkg/messages/errorcodes"
@@ -53,7 +58,12 @@ import (
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/channels"
+	"github.com/dapr/dapr/pkg/runtime/processor"
 	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/statecache"
+	"github.com/dapr/dapr/pkg/runtime/statemigration"
+	"github.com/dapr/dapr/pkg/runtime/transaction"
+	"github.com/dapr/dapr/pkg/security/apitoken"
 	"github.com/dapr/dapr/utils"
 	kiterrors "github.com/dapr/kit/errors"
 )
@@ -71,13 +81,23 @@ type api struct {
 	directMessaging       invokev1.DirectMessaging
 	channels              *channels.Channels
 	pubsubAdapter         runtimePubsub.Adapter
+	pubsubAdapterStreamer runtimePubsub.AdapterStreamer
+	processor             *processor.Processor
+	streamSubs            map[runtimePubsub.ConnectionID]*sseSubscribeStream
+	streamSubsLock        sync.RWMutex
 	outbox                outbox.Outbox
+	stateTransactions     *transaction.Coordinator
+	stateMigration        *statemigration.Manager
 	sendToOutputBindingFn func(ctx context.Context, name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error)
+	pauseInputBindingFn   func(name string) (bool, error)
+	resumeInputBindingFn  func(name string) (bool, error)
 	metricSpec            *config.MetricSpec
 	tracingSpec           config.TracingSpec
 	maxRequestBodySize    int64 // In bytes
+	maxReplayBufferSize   int   // In bytes
 	healthz               healthz.Healthz
 	outboundHealthz       healthz.Healthz
+	apiTokens             *apitoken.Tokens
 }
 
 const (
@@ -115,13 +135,21 @@ type APIOpts struct {
 	Channels              *channels.Channels
 	DirectMessaging       invokev1.DirectMessaging
 	PubSubAdapter         runtimePubsub.Adapter
+	PubSubAdapterStreamer runtimePubsub.AdapterStreamer
+	Processor             *processor.Processor
 	Outbox                outbox.Outbox
+	StateTransactions     *transaction.Coordinator
+	StateMigration        *statemigration.Manager
 	SendToOutputBindingFn func(ctx context.Context, name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error)
+	PauseInputBindingFn   func(name string) (bool, error)
+	ResumeInputBindingFn  func(name string) (bool, error)
 	TracingSpec           config.TracingSpec
 	MetricSpec            *config.MetricSpec
 	MaxRequestBodySize    int64 // In bytes
+	MaxReplayBufferSize   int   // In bytes
 	Healthz               healthz.Healthz
 	OutboundHealthz       healthz.Healthz
+	APITokens             *apitoken.Tokens
 }
 
 // NewAPI returns a new API.
@@ -131,26 +159,46 @@ func NewAPI(opts APIOpts) API {
 		channels:              opts.Channels,
 		directMessaging:       opts.DirectMessaging,
 		pubsubAdapter:         opts.PubSubAdapter,
+		pubsubAdapterStreamer: opts.PubSubAdapterStreamer,
+		processor:             opts.Processor,
 		outbox:                opts.Outbox,
+		stateTransactions:     opts.StateTransactions,
+		stateMigration:        opts.StateMigration,
 		sendToOutputBindingFn: opts.SendToOutputBindingFn,
+		pauseInputBindingFn:   opts.PauseInputBindingFn,
+		resumeInputBindingFn:  opts.ResumeInputBindingFn,
 		tracingSpec:           opts.TracingSpec,
 		metricSpec:            opts.MetricSpec,
 		maxRequestBodySize:    opts.MaxRequestBodySize,
+		maxReplayBufferSize:   opts.MaxReplayBufferSize,
 		healthz:               opts.Healthz,
 		outboundHealthz:       opts.OutboundHealthz,
+		apiTokens:             opts.APITokens,
 	}
 
 	metadataEndpoints := api.constructMetadataEndpoints()
 	healthEndpoints := api.constructHealthzEndpoints()
 
 	api.endpoints = append(api.endpoints, api.constructStateEndpoints()...)
+	api.endpoints = append(api.endpoints, api.constructStateAtomicEndpoints()...)
+	api.endpoints = append(api.endpoints, api.constructStateWatchEndpoints()...)
+	api.endpoints = append(api.endpoints, api.constructStateTransactionEndpoints()...)
+	api.endpoints = append(api.endpoints, api.constructStateReencryptionEndpoints()...)
+	api.endpoints = append(api.endpoints, api.constructStateMigrationEndpoints()...)
+	api.endpoints = append(api.endpoints, api.constructStateQueryV2Endpoints()...)
+	api.endpoints = append(api.endpoints, api.constructStateBulkEndpoints()...)
+	api.endpoints = append(api.endpoints, api.constructStateCheckAndSetEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructSecretsEndpoints()...)
+	api.endpoints = append(api.endpoints, api.constructSecretCacheEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructPubSubEndpoints()...)
+	api.endpoints = append(api.endpoints, api.constructStreamingSubscriptionEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructActorEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructDirectMessagingEndpoints()...)
 	api.endpoints = append(api.endpoints, metadataEndpoints...)
 	api.endpoints = append(api.endpoints, api.constructShutdownEndpoints()...)
+	api.endpoints = append(api.endpoints, api.constructDrainEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructBindingsEndpoints()...)
+	api.endpoints = append(api.endpoints, api.constructBindingControlEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructConfigurationEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructSubtleCryptoEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructCryptoEndpoints()...)
@@ -159,6 +207,7 @@ func NewAPI(opts APIOpts) API {
 	api.endpoints = append(api.endpoints, api.constructWorkflowEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructJobsEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructConversationEndpoints()...)
+	api.endpoints = append(api.endpoints, api.constructOpenAPIEndpoints()...)
 
 	api.publicEndpoints = append(api.publicEndpoints, metadataEndpoints...)
 	api.publicEndpoints = append(api.publicEndpoints, healthEndpoints...)
@@ -293,6 +342,20 @@ func (a *api) constructPubSubEndpoints() []endpoints.Endpoint {
 				Name: "BulkPublishEvent",
 			},
 		},
+		{
+			Methods: []string{nethttp.MethodPost},
+			Route:   "publish/redrive/{pubsubname}/*",
+			Version: apiVersionV1alpha1,
+			Group: &endpoints.EndpointGroup{
+				Name:                 endpoints.EndpointGroupPubsub,
+				Version:              endpoints.EndpointGroupVersion1alpha1,
+				AppendSpanAttributes: appendPubSubSpanAttributes,
+			},
+			Handler: a.onRedrive,
+			Settings: endpoints.EndpointSettings{
+				Name: "RedriveDeadLetter",
+			},
+		},
 	}
 }
 
@@ -516,7 +579,7 @@ func (a *api) onBulkGetState(w nethttp.ResponseWriter, r *nethttp.Request) {
 	var key string
 	reqs := make([]state.GetRequest, len(req.Keys))
 	for i, k := range req.Keys {
-		key, err = stateLoader.GetModifiedStateKey(k, storeName, a.universal.AppID())
+		key, err = stateLoader.GetModifiedStateKeyWithMetadata(k, storeName, a.universal.AppID(), req.Metadata)
 		if err != nil {
 			status := apierrors.StateStore(storeName).InvalidKeyName(k, err.Error())
 			respondWithError(w, status)
@@ -568,23 +631,21 @@ func (a *api) onBulkGetState(w nethttp.ResponseWriter, r *nethttp.Request) {
 		}
 	}
 
-	if encryption.EncryptedStateStore(storeName) {
-		for i := range bulkResp {
-			if bulkResp[i].Error != "" || len(bulkResp[i].Data) == 0 {
-				bulkResp[i].Data = nil
-				continue
-			}
-
-			val, err := encryption.TryDecryptValue(storeName, bulkResp[i].Data)
-			if err != nil {
-				log.Debugf("Bulk get error: %v", err)
-				bulkResp[i].Data = nil
-				bulkResp[i].Error = err.Error()
-				continue
-			}
+	for i := range bulkResp {
+		if bulkResp[i].Error != "" || len(bulkResp[i].Data) == 0 {
+			bulkResp[i].Data = nil
+			continue
+		}
 
-			bulkResp[i].Data = val
+		val, err := encryption.DecodeStateValue(storeName, responses[i].Key, bulkResp[i].Data)
+		if err != nil {
+			log.Debugf("Bulk get error: %v", err)
+			bulkResp[i].Data = nil
+			bulkResp[i].Error = err.Error()
+			continue
 		}
+
+		bulkResp[i].Data = val
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -622,7 +683,7 @@ func (a *api) onGetState(w nethttp.ResponseWriter, r *nethttp.Request) {
 
 	key := chi.URLParam(r, stateKeyParam)
 	consistency := r.URL.Query().Get(consistencyParam)
-	k, err := stateLoader.GetModifiedStateKey(key, storeName, a.universal.AppID())
+	k, err := stateLoader.GetModifiedStateKeyWithMetadata(key, storeName, a.universal.AppID(), metadata)
 	if err != nil {
 		status := apierrors.StateStore(storeName).InvalidKeyName(key, err.Error())
 		respondWithError(w, status)
@@ -638,12 +699,13 @@ func (a *api) onGetState(w nethttp.ResponseWriter, r *nethttp.Request) {
 		Metadata: metadata,
 	}
 
+	cache := a.universal.CompStore().GetStateCache(storeName)
 	start := time.Now()
 	policyRunner := resiliency.NewRunner[*state.GetResponse](r.Context(),
 		a.universal.Resiliency().ComponentOutboundPolicy(storeName, resiliency.Statestore),
 	)
 	resp, err := policyRunner(func(ctx context.Context) (*state.GetResponse, error) {
-		return store.Get(ctx, req)
+		return cache.ReadThrough(ctx, req, store.Get)
 	})
 	elapsed := diag.ElapsedSince(start)
 
@@ -667,16 +729,12 @@ func (a *api) onGetState(w nethttp.ResponseWriter, r *nethttp.Request) {
 		return
 	}
 
-	if encryption.EncryptedStateStore(storeName) {
-		val, err := encryption.TryDecryptValue(storeName, resp.Data)
-		if err != nil {
-			resp := messages.NewAPIErrorHTTP(fmt.Sprintf(messages.ErrStateGet, key, storeName, err.Error()), errorcodes.StateGet, nethttp.StatusInternalServerError)
-			respondWithError(w, resp)
-			log.Debug(resp)
-			return
-		}
-
-		resp.Data = val
+	resp.Data, err = encryption.DecodeStateValue(storeName, k, resp.Data)
+	if err != nil {
+		resp := messages.NewAPIErrorHTTP(fmt.Sprintf(messages.ErrStateGet, key, storeName, err.Error()), errorcodes.StateGet, nethttp.StatusInternalServerError)
+		respondWithError(w, resp)
+		log.Debug(resp)
+		return
 	}
 
 	if resp.ETag != nil {
@@ -792,6 +850,13 @@ func (a *api) onSubscribeConfiguration(w nethttp.ResponseWriter, r *nethttp.Requ
 		subscribeKeys = append(subscribeKeys, keys...)
 	}
 
+	onInvalid := a.universal.ConfigurationInvalidHandler(storeName, metadata, a.channels)
+	filter, metadata, err := universal.ConfigurationSubscribeFilter(metadata)
+	if err != nil {
+		respondWithError(w, err)
+		log.Debug(err)
+		return
+	}
 	req := &configuration.SubscribeRequest{
 		Keys:     subscribeKeys,
 		Metadata: metadata,
@@ -812,7 +877,7 @@ func (a *api) onSubscribeConfiguration(w nethttp.ResponseWriter, r *nethttp.Requ
 		a.universal.Resiliency().ComponentOutboundPolicy(storeName, resiliency.Configuration),
 	)
 	subscribeID, err := policyRunner(func(ctx context.Context) (string, error) {
-		return store.Subscribe(ctx, req, handler.updateEventHandler)
+		return store.Subscribe(ctx, req, configurationLoader.FilterHandler(filter, configurationLoader.ValidateHandler(handler.updateEventHandler, onInvalid)))
 	})
 	elapsed := diag.ElapsedSince(start)
 
@@ -883,22 +948,41 @@ func (a *api) onGetConfiguration(w nethttp.ResponseWriter, r *nethttp.Request) {
 	for _, key := range r.URL.Query()[configurationKeyParam] {
 		keys = append(keys, key)
 	}
+	listReq, err := universal.ConfigurationListRequest(keys, metadata)
+	if err != nil {
+		respondWithError(w, err)
+		log.Debug(err)
+		return
+	}
 	req := &configuration.GetRequest{
 		Keys:     keys,
 		Metadata: metadata,
 	}
 
 	start := time.Now()
-	policyRunner := resiliency.NewRunner[*configuration.GetResponse](r.Context(),
+	policyRunner := resiliency.NewRunner[*configurationLoader.ListResponse](r.Context(),
 		a.universal.Resiliency().ComponentOutboundPolicy(storeName, resiliency.Configuration),
 	)
-	getResponse, err := policyRunner(func(ctx context.Context) (*configuration.GetResponse, error) {
-		return store.Get(ctx, req)
+	getResponse, err := policyRunner(func(ctx context.Context) (*configurationLoader.ListResponse, error) {
+		if listReq == nil {
+			rResp, rErr := store.Get(ctx, req)
+			if rResp == nil {
+				return nil, rErr
+			}
+			return &configurationLoader.ListResponse{Items: rResp.Items}, rErr
+		}
+		return configurationLoader.List(ctx, store, listReq)
 	})
 	elapsed := diag.ElapsedSince(start)
 
 	diag.DefaultComponentMonitoring.ConfigurationInvoked(context.Background(), storeName, diag.Get, err == nil, elapsed)
 
+	if errors.Is(err, configurationLoader.ErrInvalidContinuationToken) {
+		err = messages.ErrBadRequest.WithFormat(err)
+		respondWithError(w, err)
+		log.Debug(err)
+		return
+	}
 	if err != nil {
 		resp := messages.NewAPIErrorHTTP(fmt.Sprintf(messages.ErrConfigurationGet, keys, storeName, err.Error()), errorcodes.ConfigurationGet, nethttp.StatusInternalServerError)
 		respondWithError(w, resp)
@@ -906,6 +990,13 @@ func (a *api) onGetConfiguration(w nethttp.ResponseWriter, r *nethttp.Request) {
 		return
 	}
 
+	// The continuation token of the next page is returned in the response headers
+	if getResponse != nil && getResponse.ContinuationToken != "" {
+		setResponseMetadataHeaders(w, map[string]string{
+			universal.ConfigurationContinuationTokenKey: getResponse.ContinuationToken,
+		})
+	}
+
 	if getResponse == nil || getResponse.Items == nil || len(getResponse.Items) == 0 {
 		respondWithEmpty(w)
 		return
@@ -933,7 +1024,7 @@ func (a *api) onDeleteState(w nethttp.ResponseWriter, r *nethttp.Request) {
 	consistency := r.URL.Query().Get(consistencyParam)
 
 	metadata := getMetadataFromRequest(r)
-	k, err := stateLoader.GetModifiedStateKey(key, storeName, a.universal.AppID())
+	k, err := stateLoader.GetModifiedStateKeyWithMetadata(key, storeName, a.universal.AppID(), metadata)
 	if err != nil {
 		status := apierrors.StateStore(storeName).InvalidKeyName(key, err.Error())
 		respondWithError(w, status)
@@ -963,6 +1054,8 @@ func (a *api) onDeleteState(w nethttp.ResponseWriter, r *nethttp.Request) {
 	})
 	elapsed := diag.ElapsedSince(start)
 
+	a.universal.CompStore().GetStateCache(storeName).Invalidate(r.Context(), k)
+
 	diag.DefaultComponentMonitoring.StateInvoked(r.Context(), storeName, diag.Delete, err == nil, elapsed)
 
 	if err != nil {
@@ -1020,7 +1113,7 @@ func (a *api) onPostState(w nethttp.ResponseWriter, r *nethttp.Request) {
 			}
 		}
 
-		reqs[i].Key, err = stateLoader.GetModifiedStateKey(r.Key, storeName, a.universal.AppID())
+		reqs[i].Key, err = stateLoader.GetModifiedStateKeyWithMetadata(r.Key, storeName, a.universal.AppID(), reqs[i].Metadata)
 		if err != nil {
 			status := apierrors.StateStore(storeName).InvalidKeyName(r.Key, err.Error())
 			respondWithError(w, status)
@@ -1028,19 +1121,15 @@ func (a *api) onPostState(w nethttp.ResponseWriter, r *nethttp.Request) {
 			return
 		}
 
-		if encryption.EncryptedStateStore(storeName) {
-			data := []byte(fmt.Sprintf("%v", r.Value))
-			val, encErr := encryption.TryEncryptValue(storeName, data)
-			if encErr != nil {
-				statusCode, errMsg := a.stateErrorResponse(encErr)
-				apiResp := messages.NewAPIErrorHTTP(fmt.Sprintf(messages.ErrStateSave, storeName, errMsg), errorcodes.StateSave, statusCode)
-				respondWithError(w, apiResp)
-				log.Debug(apiResp)
-				return
-			}
-
-			reqs[i].Value = val
+		val, encErr := encryption.EncodeStateValue(storeName, reqs[i].Key, r.Value)
+		if encErr != nil {
+			statusCode, errMsg := a.stateErrorResponse(encErr)
+			apiResp := messages.NewAPIErrorHTTP(fmt.Sprintf(messages.ErrStateSave, storeName, errMsg), errorcodes.StateSave, statusCode)
+			respondWithError(w, apiResp)
+			log.Debug(apiResp)
+			return
 		}
+		reqs[i].Value = val
 	}
 
 	start := time.Now()
@@ -1052,6 +1141,8 @@ func (a *api) onPostState(w nethttp.ResponseWriter, r *nethttp.Request) {
 	)
 	elapsed := diag.ElapsedSince(start)
 
+	a.universal.CompStore().GetStateCache(storeName).Invalidate(r.Context(), statecache.Keys(reqs)...)
+
 	diag.DefaultComponentMonitoring.StateInvoked(r.Context(), storeName, diag.Set, err == nil, elapsed)
 
 	if err != nil {
@@ -1137,6 +1228,7 @@ func (a *api) onPublish(w nethttp.ResponseWriter, r *nethttp.Request) {
 			Data:            body,
 			TraceID:         traceID,
 			TraceState:      traceState,
+			Baggage:         diag.BaggageFromHTTPRequest(r),
 			Pubsub:          pubsubName,
 		}, metadata)
 		if err != nil {
@@ -1171,7 +1263,7 @@ func (a *api) onPublish(w nethttp.ResponseWriter, r *nethttp.Request) {
 	}
 
 	start := time.Now()
-	err := a.pubsubAdapter.Publish(r.Context(), &req)
+	result, err := runtimePubsub.PublishWithResult(r.Context(), a.pubsubAdapter, &req)
 	elapsed := diag.ElapsedSince(start)
 
 	diag.DefaultComponentMonitoring.PubsubEgressEvent(context.Background(), pubsubName, topic, err == nil, elapsed)
@@ -1184,12 +1276,16 @@ func (a *api) onPublish(w nethttp.ResponseWriter, r *nethttp.Request) {
 			nerr = apierrors.PubSub(pubsubName).PublishForbidden(topic, a.universal.AppID(), err)
 		case errors.As(err, &runtimePubsub.NotFoundError{}):
 			nerr = apierrors.PubSub(pubsubName).TestNotFound(topic, err)
+		case errors.As(err, &runtimePubsub.SchemaValidationError{}):
+			nerr = apierrors.PubSub(pubsubName).SchemaValidation(topic, err)
 		default:
 			nerr = apierrors.PubSub(pubsubName).PublishMessage(topic, err)
 		}
 
 		respondWithError(w, nerr)
 		log.Debug(nerr)
+	} else if !result.IsEmpty() {
+		respondWithJSON(w, nethttp.StatusOK, result)
 	} else {
 		respondWithEmpty(w)
 	}
@@ -1252,11 +1348,9 @@ func (a *api) onBulkPublish(w nethttp.ResponseWriter, r *nethttp.Request) {
 			Event:       dBytes,
 			ContentType: entry.ContentType,
 		}
-		if entry.Metadata != nil {
-			// Populate entry metadata with request level metadata. Entry level metadata keys
-			// override request level metadata.
-			entries[i].Metadata = utils.PopulateMetadataForBulkPublishEntry(metadata, entry.Metadata)
-		}
+		// Populate entry metadata with request level metadata. Entry level metadata keys
+		// (such as the partition key or ttlInSeconds) override request level metadata.
+		entries[i].Metadata = utils.PopulateMetadataForBulkPublishEntry(metadata, entry.Metadata)
 		if _, ok := entryIDSet[entry.EntryID]; ok || entry.EntryID == "" {
 			nerr := apierrors.PubSub(pubsubName).WithAppError(
 				a.universal.AppID(),
@@ -1295,6 +1389,7 @@ func (a *api) onBulkPublish(w nethttp.ResponseWriter, r *nethttp.Request) {
 				Data:            entries[i].Event,
 				TraceID:         traceID,
 				TraceState:      traceState,
+				Baggage:         diag.BaggageFromHTTPRequest(r),
 				Pubsub:          pubsubName,
 			}, entries[i].Metadata)
 			if err != nil {
@@ -1377,6 +1472,15 @@ func (a *api) onBulkPublish(w nethttp.ResponseWriter, r *nethttp.Request) {
 				respondWithError(w, standardizedErr)
 			}
 			return
+		case errors.As(err, &runtimePubsub.SchemaValidationError{}):
+			nerr := apierrors.PubSub(pubsubName).SchemaValidation(topic, err)
+			standardizedErr, ok := kiterrors.FromError(nerr)
+			if ok {
+				closeChildSpans(standardizedErr.HTTPStatusCode())
+				respondWithError(w, standardizedErr)
+			}
+			log.Debug(nerr)
+			return
 		default:
 			err = apierrors.PubSub(pubsubName).PublishMessage(topic, err)
 			log.Debug(err)
@@ -1398,6 +1502,68 @@ func (a *api) onBulkPublish(w nethttp.ResponseWriter, r *nethttp.Request) {
 	respondWithEmpty(w)
 }
 
+// onRedrive publishes the messages of a dead letter topic back to the topic
+// they were dead lettered from.
+func (a *api) onRedrive(w nethttp.ResponseWriter, r *nethttp.Request) {
+	thepubsub, pubsubName, topic, validationErr := a.validateAndGetPubsubAndTopic(r)
+	if validationErr != nil {
+		log.Debug(validationErr)
+		respondWithError(w, validationErr)
+		return
+	}
+
+	var in RedriveRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+			nerr := apierrors.PubSub(pubsubName).RedriveRequest(topic, err)
+			log.Debug(nerr)
+			respondWithError(w, nerr)
+			return
+		}
+	}
+
+	req := runtimePubsub.RedriveRequest{
+		PubsubName:  pubsubName,
+		Topic:       topic,
+		Metadata:    getMetadataFromRequest(r),
+		Rate:        in.RatePerSecond,
+		MaxMessages: in.MaxMessages,
+	}
+	maps.Copy(req.Metadata, in.Metadata)
+	var err error
+	switch {
+	case in.RatePerSecond < 0:
+		err = errors.New("ratePerSecond must not be negative")
+	case in.MaxMessages < 0:
+		err = errors.New("maxMessages must not be negative")
+	case in.IdleTimeout != "":
+		req.IdleTimeout, err = time.ParseDuration(in.IdleTimeout)
+		if err == nil && req.IdleTimeout <= 0 {
+			err = errors.New("idleTimeout must be positive")
+		}
+	}
+	if err == nil && in.Filter != "" {
+		req.Filter = &expr.Expr{}
+		err = req.Filter.DecodeString(in.Filter)
+	}
+	if err != nil {
+		nerr := apierrors.PubSub(pubsubName).RedriveRequest(topic, err)
+		log.Debug(nerr)
+		respondWithError(w, nerr)
+		return
+	}
+
+	res, err := runtimePubsub.Redrive(r.Context(), thepubsub, a.pubsubAdapter.Publish, req)
+	if err != nil {
+		nerr := apierrors.PubSub(pubsubName).Redrive(topic, err)
+		log.Debug(nerr)
+		respondWithError(w, nerr)
+		return
+	}
+
+	respondWithJSON(w, nethttp.StatusOK, res)
+}
+
 // validateAndGetPubsubAndTopic takes input as request context and returns the pubsub interface, pubsub name, topic name,
 // or error status code and an ErrorResponse object.
 func (a *api) validateAndGetPubsubAndTopic(r *nethttp.Request) (pubsub.PubSub, string, string, error) {
@@ -1519,70 +1685,13 @@ func (a *api) onPostStateTransaction(w nethttp.ResponseWriter, r *nethttp.Reques
 
 	operations := make([]state.TransactionalStateOperation, 0, len(req.Operations))
 	for _, o := range req.Operations {
-		switch o.Operation {
-		case string(state.OperationUpsert):
-			var upsertReq state.SetRequest
-			err := mapstructure.Decode(o.Request, &upsertReq)
-			if err != nil {
-				msg := messages.ErrMalformedRequest.WithFormat(err)
-				respondWithError(w, msg)
-				log.Debug(msg)
-				return
-			}
-			upsertReq.Key, err = stateLoader.GetModifiedStateKey(upsertReq.Key, storeName, a.universal.AppID())
-			if err != nil {
-				status := apierrors.StateStore(storeName).InvalidKeyName(upsertReq.Key, err.Error())
-				respondWithError(w, status)
-				log.Debug(status)
-				return
-			}
-
-			if req.Metadata != nil {
-				if upsertReq.Metadata == nil {
-					upsertReq.Metadata = metadata
-				} else {
-					for k, v := range metadata {
-						upsertReq.Metadata[k] = v
-					}
-				}
-			}
-
-			operations = append(operations, upsertReq)
-		case string(state.OperationDelete):
-			var delReq state.DeleteRequest
-			err := mapstructure.Decode(o.Request, &delReq)
-			if err != nil {
-				msg := messages.ErrMalformedRequest.WithFormat(err)
-				respondWithError(w, msg)
-				log.Debug(msg)
-				return
-			}
-			delReq.Key, err = stateLoader.GetModifiedStateKey(delReq.Key, storeName, a.universal.AppID())
-			if err != nil {
-				status := apierrors.StateStore(storeName).InvalidKeyName(delReq.Key, err.Error())
-				respondWithError(w, status)
-				log.Debug(status)
-
-				return
-			}
-
-			if req.Metadata != nil {
-				if delReq.Metadata == nil {
-					delReq.Metadata = metadata
-				} else {
-					for k, v := range metadata {
-						delReq.Metadata[k] = v
-					}
-				}
-			}
-
-			operations = append(operations, delReq)
-		default:
-			resp := messages.NewAPIErrorHTTP(fmt.Sprintf(messages.ErrNotSupportedStateOperation, o.Operation), errorcodes.StateNotSupportedOperation, nethttp.StatusBadRequest)
-			respondWithError(w, resp)
-			log.Debug(resp)
+		op, err := a.parseStateTransactionOperation(o, storeName, metadata, req.Metadata != nil)
+		if err != nil {
+			respondWithError(w, err)
+			log.Debug(err)
 			return
 		}
+		operations = append(operations, op)
 	}
 
 	if maxMulti, ok := store.(state.TransactionalStoreMultiMaxSize); ok {
@@ -1595,23 +1704,10 @@ func (a *api) onPostStateTransaction(w nethttp.ResponseWriter, r *nethttp.Reques
 		}
 	}
 
-	if encryption.EncryptedStateStore(storeName) {
-		for i, op := range operations {
-			switch req := op.(type) {
-			case state.SetRequest:
-				data := []byte(fmt.Sprintf("%v", req.Value))
-				val, err := encryption.TryEncryptValue(storeName, data)
-				if err != nil {
-					resp := messages.NewAPIErrorHTTP(fmt.Sprintf(messages.ErrStateSave, storeName, err.Error()), errorcodes.StateSave, nethttp.StatusBadRequest)
-					respondWithError(w, resp)
-					log.Debug(resp)
-					return
-				}
-
-				req.Value = val
-				operations[i] = req
-			}
-		}
+	if err := encryptStateTransactionOperations(storeName, operations); err != nil {
+		respondWithError(w, err)
+		log.Debug(err)
+		return
 	}
 
 	outboxEnabled := a.outbox.Enabled(storeName)
@@ -1642,6 +1738,8 @@ func (a *api) onPostStateTransaction(w nethttp.ResponseWriter, r *nethttp.Reques
 	})
 	elapsed := diag.ElapsedSince(start)
 
+	a.universal.CompStore().GetStateCache(storeName).Invalidate(r.Context(), statecache.Keys(operations)...)
+
 	diag.DefaultComponentMonitoring.StateInvoked(context.Background(), storeName, diag.StateTransaction, err == nil, elapsed)
 
 	if err != nil {
@@ -1653,6 +1751,82 @@ func (a *api) onPostStateTransaction(w nethttp.ResponseWriter, r *nethttp.Reques
 	}
 }
 
+// parseStateTransactionOperation parses an operation of a state transaction
+// on the given store. If mergeMetadata is true, the metadata is merged into
+// the metadata of the operation.
+func (a *api) parseStateTransactionOperation(o stateTransactionRequestBodyOperation, storeName string, metadata map[string]string, mergeMetadata bool) (state.TransactionalStateOperation, error) {
+	switch o.Operation {
+	case string(state.OperationUpsert):
+		var upsertReq state.SetRequest
+		err := mapstructure.Decode(o.Request, &upsertReq)
+		if err != nil {
+			return nil, messages.ErrMalformedRequest.WithFormat(err)
+		}
+		if mergeMetadata {
+			if upsertReq.Metadata == nil {
+				upsertReq.Metadata = metadata
+			} else {
+				for k, v := range metadata {
+					upsertReq.Metadata[k] = v
+				}
+			}
+		}
+
+		upsertReq.Key, err = stateLoader.GetModifiedStateKeyWithMetadata(upsertReq.Key, storeName, a.universal.AppID(), upsertReq.Metadata)
+		if err != nil {
+			return nil, apierrors.StateStore(storeName).InvalidKeyName(upsertReq.Key, err.Error())
+		}
+
+		return upsertReq, nil
+	case string(state.OperationDelete):
+		var delReq state.DeleteRequest
+		err := mapstructure.Decode(o.Request, &delReq)
+		if err != nil {
+			return nil, messages.ErrMalformedRequest.WithFormat(err)
+		}
+		if mergeMetadata {
+			if delReq.Metadata == nil {
+				delReq.Metadata = metadata
+			} else {
+				for k, v := range metadata {
+					delReq.Metadata[k] = v
+				}
+			}
+		}
+
+		delReq.Key, err = stateLoader.GetModifiedStateKeyWithMetadata(delReq.Key, storeName, a.universal.AppID(), delReq.Metadata)
+		if err != nil {
+			return nil, apierrors.StateStore(storeName).InvalidKeyName(delReq.Key, err.Error())
+		}
+
+		return delReq, nil
+	default:
+		return nil, messages.NewAPIErrorHTTP(fmt.Sprintf(messages.ErrNotSupportedStateOperation, o.Operation), errorcodes.StateNotSupportedOperation, nethttp.StatusBadRequest)
+	}
+}
+
+// encryptStateTransactionOperations compresses and encrypts the values of the
+// operations if the store is configured to.
+func encryptStateTransactionOperations(storeName string, operations []state.TransactionalStateOperation) error {
+	if !encryption.EncryptedStateStore(storeName) && !compression.CompressedStateStore(storeName) {
+		return nil
+	}
+
+	for i, op := range operations {
+		switch req := op.(type) {
+		case state.SetRequest:
+			val, err := encryption.EncodeStateValue(storeName, req.Key, req.Value)
+			if err != nil {
+				return messages.NewAPIErrorHTTP(fmt.Sprintf(messages.ErrStateSave, storeName, err.Error()), errorcodes.StateSave, nethttp.StatusBadRequest)
+			}
+
+			req.Value = val
+			operations[i] = req
+		}
+	}
+	return nil
+}
+
 func (a *api) onQueryStateHandler() nethttp.HandlerFunc {
 	return UniversalHTTPHandler(
 		a.universal.QueryStateAlpha1,
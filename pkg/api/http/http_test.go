@@ -23,6 +23,7 @@ import (
 	"io"
 	"net"
 	nethttp "net/http"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -62,6 +63,7 @@ import (
 	httpEndpointsV1alpha1 "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
 	"github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
 	"github.com/dapr/dapr/pkg/channel/http"
+	"github.com/dapr/dapr/pkg/compression"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/encryption"
@@ -78,8 +80,11 @@ import (
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/channels"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/drain"
 	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	"github.com/dapr/dapr/pkg/runtime/wfengine/fake"
+	"github.com/dapr/dapr/pkg/security/apitoken"
+	securityConsts "github.com/dapr/dapr/pkg/security/consts"
 	daprt "github.com/dapr/dapr/pkg/testing"
 	testtrace "github.com/dapr/dapr/pkg/testing/trace"
 	"github.com/dapr/dapr/utils"
@@ -327,6 +332,134 @@ func TestPubSubEndpoints(t *testing.T) {
 	fakeServer.Shutdown()
 }
 
+func TestRedriveEndpoint(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	var lock sync.Mutex
+	var redriven []string
+	testAPI := &api{
+		healthz: healthz.New(),
+		universal: universal.New(universal.Options{
+			AppID:     "fakeAPI",
+			CompStore: compstore.New(),
+		}),
+		pubsubAdapter: &daprt.MockPubSubAdapter{
+			PublishFn: func(ctx context.Context, req *pubsub.PublishRequest) error {
+				if req.PubsubName == "errorpubsub" {
+					return fmt.Errorf("Error from pubsub %s", req.PubsubName)
+				}
+				lock.Lock()
+				defer lock.Unlock()
+				redriven = append(redriven, req.Topic)
+				return nil
+			},
+		},
+	}
+
+	data, _, err := runtimePubsub.DeadLetter{
+		Topic:           "orders",
+		DeadLetterTopic: "orders-dlq",
+		Reason:          runtimePubsub.DeadLetterReasonError,
+	}.Stamp([]byte(`{"specversion":"1.0","id":"1","type":"order"}`), nil, time.Now())
+	require.NoError(t, err)
+
+	mockPubSub := daprt.MockPubSub{}
+	mockPubSub.On("Subscribe", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		handler := args.Get(1).(pubsub.Handler)
+		go handler(t.Context(), &pubsub.NewMessage{Data: data, Topic: "orders-dlq"})
+	}).Return(nil)
+	testAPI.universal.CompStore().AddPubSub("pubsubname", &runtimePubsub.PubsubItem{Component: &mockPubSub})
+	testAPI.universal.CompStore().AddPubSub("errorpubsub", &runtimePubsub.PubsubItem{Component: &mockPubSub})
+
+	fakeServer.StartServer(testAPI.constructPubSubEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	t.Run("redrive successfully - 200 OK", func(t *testing.T) {
+		apiPath := apiVersionV1alpha1 + "/publish/redrive/pubsubname/orders-dlq"
+		resp := fakeServer.DoRequest("POST", apiPath, []byte(`{"idleTimeout": "50ms", "filter": "event.type == 'order'"}`), nil)
+		require.Equal(t, 200, resp.StatusCode, string(resp.RawBody))
+
+		var res runtimePubsub.RedriveResult
+		require.NoError(t, json.Unmarshal(resp.RawBody, &res))
+		assert.Equal(t, runtimePubsub.RedriveResult{Redriven: 1}, res)
+		lock.Lock()
+		defer lock.Unlock()
+		assert.Equal(t, []string{"orders"}, redriven)
+	})
+
+	t.Run("invalid request - 400", func(t *testing.T) {
+		apiPath := apiVersionV1alpha1 + "/publish/redrive/pubsubname/orders-dlq"
+		for _, body := range []string{`{"filter": "event.type =="}`, `{"idleTimeout": "soon"}`, `{"maxMessages": -1}`, `{`} {
+			resp := fakeServer.DoRequest("POST", apiPath, []byte(body), nil)
+			assert.Equal(t, 400, resp.StatusCode, body)
+			assert.Equal(t, "ERR_PUBSUB_REDRIVE_REQUEST", resp.ErrorBody["errorCode"], body)
+		}
+	})
+
+	t.Run("redrive unsuccessfully - 500 InternalError", func(t *testing.T) {
+		apiPath := apiVersionV1alpha1 + "/publish/redrive/errorpubsub/orders-dlq"
+		resp := fakeServer.DoRequest("POST", apiPath, []byte(`{"idleTimeout": "50ms"}`), nil)
+		assert.Equal(t, 500, resp.StatusCode)
+		assert.Equal(t, "ERR_PUBSUB_REDRIVE", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("pubsub not found - 404", func(t *testing.T) {
+		apiPath := apiVersionV1alpha1 + "/publish/redrive/notfound/orders-dlq"
+		resp := fakeServer.DoRequest("POST", apiPath, nil, nil)
+		assert.Equal(t, 404, resp.StatusCode)
+		assert.Equal(t, "ERR_PUBSUB_NOT_FOUND", resp.ErrorBody["errorCode"])
+	})
+}
+
+type resultPubSubAdapter struct {
+	daprt.MockPubSubAdapter
+	result *runtimePubsub.PublishResult
+}
+
+func (a *resultPubSubAdapter) PublishWithResult(ctx context.Context, req *pubsub.PublishRequest) (*runtimePubsub.PublishResult, error) {
+	return a.result, a.Publish(ctx, req)
+}
+
+func TestPublishResult(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	adapter := &resultPubSubAdapter{
+		MockPubSubAdapter: daprt.MockPubSubAdapter{
+			PublishFn: func(ctx context.Context, req *pubsub.PublishRequest) error {
+				return nil
+			},
+		},
+	}
+	testAPI := &api{
+		universal: universal.New(universal.Options{
+			AppID:     "fakeAPI",
+			CompStore: compstore.New(),
+		}),
+		pubsubAdapter: adapter,
+	}
+	mock := daprt.MockPubSub{}
+	mock.On("Features").Return([]pubsub.Feature{})
+	testAPI.universal.CompStore().AddPubSub("pubsubname", &runtimePubsub.PubsubItem{Component: &mock})
+
+	fakeServer.StartServer(testAPI.constructPubSubEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	apiPath := apiVersionV1 + "/publish/pubsubname/topic"
+
+	t.Run("broker identifiers are returned - 200", func(t *testing.T) {
+		partition := int32(0)
+		adapter.result = &runtimePubsub.PublishResult{MessageID: "msg1", Partition: &partition, Offset: "42"}
+		resp := fakeServer.DoRequest("POST", apiPath, []byte("{\"key\": \"value\"}"), nil)
+		require.Equal(t, 200, resp.StatusCode, string(resp.RawBody))
+		assert.JSONEq(t, `{"messageId": "msg1", "partition": 0, "offset": "42"}`, string(resp.RawBody))
+	})
+
+	t.Run("no broker identifiers - 204", func(t *testing.T) {
+		adapter.result = nil
+		resp := fakeServer.DoRequest("POST", apiPath, []byte("{\"key\": \"value\"}"), nil)
+		assert.Equal(t, 204, resp.StatusCode)
+		assert.Empty(t, resp.RawBody)
+	})
+}
+
 func TestBulkPubSubEndpoints(t *testing.T) {
 	fakeServer := newFakeHTTPServer()
 	testAPI := &api{
@@ -824,6 +957,72 @@ func TestShutdownEndpoints(t *testing.T) {
 	})
 }
 
+func TestDrainEndpoints(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+
+	shutdownCh := make(chan struct{})
+	drainer := drain.New(drain.Options{})
+	testAPI := &api{
+		universal: universal.New(universal.Options{
+			ShutdownFn: func() {
+				close(shutdownCh)
+			},
+			Drainer: drainer,
+		}),
+	}
+
+	fakeServer.StartServer(testAPI.constructDrainEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	apiPath := apiVersionV1 + "/drain"
+
+	t.Run("Status before draining - 200", func(t *testing.T) {
+		resp := fakeServer.DoRequest("GET", apiPath, nil, nil)
+		assert.Equal(t, 200, resp.StatusCode)
+		var status drain.Status
+		require.NoError(t, json.Unmarshal(resp.RawBody, &status))
+		assert.Equal(t, drain.StateRunning, status.State)
+	})
+
+	t.Run("Invalid timeout - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", apiPath, nil, map[string]string{"timeout": "foo"})
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_BAD_REQUEST", resp.ErrorBody["errorCode"])
+		assert.False(t, drainer.Draining())
+	})
+
+	t.Run("Drain successfully - 202", func(t *testing.T) {
+		done, ok := drainer.Track()
+		require.True(t, ok)
+
+		resp := fakeServer.DoRequest("POST", apiPath, nil, map[string]string{"timeout": "10s"})
+		assert.Equal(t, 202, resp.StatusCode)
+		var status drain.Status
+		require.NoError(t, json.Unmarshal(resp.RawBody, &status))
+		assert.NotEqual(t, drain.StateRunning, status.State)
+		assert.Equal(t, 10*time.Second, status.Deadline.Sub(*status.StartedAt))
+
+		// Dapr shuts down once the in-flight request has completed
+		select {
+		case <-shutdownCh:
+			t.Fatal("Shut down before the drain completed")
+		case <-time.After(100 * time.Millisecond):
+		}
+		done()
+		select {
+		case <-time.After(time.Second):
+			t.Fatal("Did not shut down within 1 second")
+		case <-shutdownCh:
+			// All good
+		}
+
+		resp = fakeServer.DoRequest("GET", apiPath, nil, nil)
+		assert.Equal(t, 200, resp.StatusCode)
+		require.NoError(t, json.Unmarshal(resp.RawBody, &status))
+		assert.Equal(t, drain.StateDrained, status.State)
+	})
+}
+
 func TestGetStatusCodeFromMetadata(t *testing.T) {
 	t.Run("status code present", func(t *testing.T) {
 		res := GetStatusCodeFromMetadata(map[string]string{
@@ -2828,6 +3027,7 @@ func (f *fakeHTTPServer) getRouter(endpoints []endpoints.Endpoint, apiAuth bool)
 	r := srv.getRouter()
 
 	if apiAuth {
+		srv.apiTokens, _ = apitoken.FromEnv(securityConsts.APITokenEnvVar)
 		srv.useAPIAuthentication(r)
 	}
 
@@ -3561,6 +3761,29 @@ func TestStateStoreQuerierEncrypted(t *testing.T) {
 	assert.Contains(t, string(resp.RawBody), "cannot query encrypted store")
 }
 
+func TestStateStoreQuerierCompressed(t *testing.T) {
+	storeName := "compressed-store1"
+	fakeServer := newFakeHTTPServer()
+	compStore := compstore.New()
+	compStore.AddStateStore(storeName, newFakeStateStoreQuerier())
+	testAPI := &api{
+		healthz: healthz.New(),
+		universal: universal.New(universal.Options{
+			Logger:     logger.NewLogger("fakeLogger"),
+			CompStore:  compStore,
+			Resiliency: resiliency.New(nil),
+		}),
+	}
+	compression.AddCompressedStateStore(storeName, compression.Options{Algorithm: compression.GzipAlgorithm})
+	defer compression.RemoveCompressedStateStore(storeName)
+	fakeServer.StartServer(testAPI.constructStateEndpoints(), nil)
+
+	resp := fakeServer.DoRequest("POST", "v1.0-alpha1/state/"+storeName+"/query", nil, nil)
+	// assert
+	assert.Equal(t, 500, resp.StatusCode)
+	assert.Contains(t, string(resp.RawBody), "cannot query compressed store")
+}
+
 const (
 	queryTestRequestOK = `{
 	"filter": {
@@ -3864,6 +4087,21 @@ func TestV1SecretEndpoints(t *testing.T) {
 		assert.Equal(t, expectedOutput, body, "bulk secret response should be same as expected")
 	})
 
+	t.Run("Get Bulk secret - filtered by name prefix", func(t *testing.T) {
+		apiPath := fmt.Sprintf("v1.0/secrets/%s/bulk", storeName)
+		resp := fakeServer.DoRequest("GET", apiPath, nil, map[string]string{"metadata.namePrefix": "bad-", "metadata.pageSize": "10"})
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, map[string]interface{}{}, resp.JSONBody)
+		assert.Empty(t, resp.RawHeader.Get("metadata.continuationToken"))
+	})
+
+	t.Run("Get Bulk secret - invalid page size", func(t *testing.T) {
+		apiPath := fmt.Sprintf("v1.0/secrets/%s/bulk", storeName)
+		resp := fakeServer.DoRequest("GET", apiPath, nil, map[string]string{"metadata.pageSize": "-1"})
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_BAD_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
 	t.Run("Get secret - retries on initial failure with resiliency", func(t *testing.T) {
 		apiPath := fmt.Sprintf("v1.0/secrets/%s/key", "failSecret")
 
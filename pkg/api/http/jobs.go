@@ -14,6 +14,7 @@ limitations under the License.
 package http
 
 import (
+	"context"
 	"net/http"
 	"strings"
 
@@ -34,6 +35,46 @@ var endpointGroupJobsV1Alpha1 = &endpoints.EndpointGroup{
 
 func (a *api) constructJobsEndpoints() []endpoints.Endpoint {
 	return []endpoints.Endpoint{
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "jobs",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupJobsV1Alpha1,
+			Handler: a.onListJobs,
+			Settings: endpoints.EndpointSettings{
+				Name: "ListJobs",
+			},
+		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "jobs/bulk/delete",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupJobsV1Alpha1,
+			Handler: a.onBulkDeleteJobs,
+			Settings: endpoints.EndpointSettings{
+				Name: "BulkDeleteJobs",
+			},
+		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "jobs/bulk/pause",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupJobsV1Alpha1,
+			Handler: a.onBulkPauseJobs,
+			Settings: endpoints.EndpointSettings{
+				Name: "BulkPauseJobs",
+			},
+		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "jobs/bulk/resume",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupJobsV1Alpha1,
+			Handler: a.onBulkResumeJobs,
+			Settings: endpoints.EndpointSettings{
+				Name: "BulkResumeJobs",
+			},
+		},
 		{
 			Methods: []string{http.MethodPost},
 			Route:   "jobs/{name}",
@@ -68,29 +109,37 @@ func (a *api) constructJobsEndpoints() []endpoints.Endpoint {
 }
 
 func (a *api) onCreateScheduleHandler() http.HandlerFunc {
-	return UniversalHTTPHandler(
-		a.universal.ScheduleJobAlpha1HTTP,
-		UniversalHTTPHandlerOpts[*internalsv1pb.JobHTTPRequest, *runtimev1pb.ScheduleJobResponse]{
-			InModifier: func(r *http.Request, in *internalsv1pb.JobHTTPRequest) (*internalsv1pb.JobHTTPRequest, error) {
-				// Users should set the name in the url, and not in the url and body
-				name := strings.TrimSpace(chi.URLParam(r, nameParam))
-				if len(name) == 0 {
-					apierrors.Empty("Job", map[string]string{"appID": a.universal.AppID()}, errorcodes.SchedulerEmpty)
-				}
-				if in.GetName() != "" {
-					return nil, apierrors.SchedulerURLName(map[string]string{"appID": a.universal.AppID()})
-				}
+	return func(w http.ResponseWriter, r *http.Request) {
+		// The job options which the request body has no fields for, such as
+		// the failure policy, are set in the metadata query parameters
+		md := getMetadataFromRequest(r)
+		scheduleJob := func(ctx context.Context, in *internalsv1pb.JobHTTPRequest) (*runtimev1pb.ScheduleJobResponse, error) {
+			return a.universal.ScheduleJobAlpha1HTTPWithMetadata(ctx, in, md)
+		}
+		UniversalHTTPHandler(
+			scheduleJob,
+			UniversalHTTPHandlerOpts[*internalsv1pb.JobHTTPRequest, *runtimev1pb.ScheduleJobResponse]{
+				InModifier: func(r *http.Request, in *internalsv1pb.JobHTTPRequest) (*internalsv1pb.JobHTTPRequest, error) {
+					// Users should set the name in the url, and not in the url and body
+					name := strings.TrimSpace(chi.URLParam(r, nameParam))
+					if len(name) == 0 {
+						apierrors.Empty("Job", map[string]string{"appID": a.universal.AppID()}, errorcodes.SchedulerEmpty)
+					}
+					if in.GetName() != "" {
+						return nil, apierrors.SchedulerURLName(map[string]string{"appID": a.universal.AppID()})
+					}
 
-				in.Name = name
+					in.Name = name
 
-				return in, nil
-			},
-			OutModifier: func(out *runtimev1pb.ScheduleJobResponse) (any, error) {
-				// Nullify the response so status code is 204
-				return nil, nil // empty body
+					return in, nil
+				},
+				OutModifier: func(out *runtimev1pb.ScheduleJobResponse) (any, error) {
+					// Nullify the response so status code is 204
+					return nil, nil // empty body
+				},
 			},
-		},
-	)
+		)(w, r)
+	}
 }
 
 func (a *api) onDeleteJobHandler() http.HandlerFunc {
@@ -0,0 +1,212 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/dapr/dapr/pkg/api/universal"
+	"github.com/dapr/dapr/pkg/messages"
+	"github.com/dapr/dapr/pkg/messages/errorcodes"
+	"github.com/dapr/kit/ptr"
+)
+
+// jobInfo is a job returned by the list jobs API.
+type jobInfo struct {
+	// Job is the job, encoded like in the response of the get job API.
+	Job         json.RawMessage `json:"job"`
+	NextTrigger string          `json:"nextTrigger,omitempty"`
+	Overdue     bool            `json:"overdue,omitempty"`
+	Paused      bool            `json:"paused,omitempty"`
+}
+
+type listJobsResponse struct {
+	Jobs []jobInfo `json:"jobs"`
+}
+
+// bulkJobsRequest is the body of the bulk jobs APIs. Jobs are selected either
+// by names or with the filter fields.
+type bulkJobsRequest struct {
+	Names           []string `json:"names,omitempty"`
+	NamePrefix      string   `json:"namePrefix,omitempty"`
+	ScheduledAfter  string   `json:"scheduledAfter,omitempty"`
+	ScheduledBefore string   `json:"scheduledBefore,omitempty"`
+	OverdueOnly     bool     `json:"overdueOnly,omitempty"`
+}
+
+type bulkJobsResponse struct {
+	Results []bulkJobsResult `json:"results"`
+}
+
+type bulkJobsResult struct {
+	Name  string `json:"name"`
+	Error string `json:"error,omitempty"`
+}
+
+// onListJobs lists the jobs of the app matching a filter.
+// Supported query parameters:
+// - namePrefix
+// - scheduledAfter (RFC3339)
+// - scheduledBefore (RFC3339)
+// - overdue (bool)
+// - paused (bool)
+func (a *api) onListJobs(w http.ResponseWriter, r *http.Request) {
+	filter, err := listJobsFilter(r)
+	if err != nil {
+		respondWithError(w, err)
+		log.Debug(err)
+		return
+	}
+
+	jobs, err := a.universal.ListJobs(r.Context(), filter)
+	if err != nil {
+		respondWithError(w, err)
+		return
+	}
+
+	res := listJobsResponse{
+		Jobs: make([]jobInfo, len(jobs)),
+	}
+	for i, job := range jobs {
+		b, err := protojson.Marshal(job.Job)
+		if err != nil {
+			msg := NewErrorResponse(errorcodes.CommonInternal, "failed to encode response as JSON: "+err.Error())
+			respondWithDataAndRecordError(w, http.StatusInternalServerError, msg.JSONErrorValue(), &errorcodes.CommonInternal)
+			log.Debug(msg)
+			return
+		}
+		res.Jobs[i] = jobInfo{
+			Job:     b,
+			Overdue: job.Overdue,
+			Paused:  job.Paused,
+		}
+		if job.NextTrigger != nil {
+			res.Jobs[i].NextTrigger = job.NextTrigger.Format(time.RFC3339)
+		}
+	}
+
+	respondWithJSON(w, http.StatusOK, res)
+}
+
+// onBulkDeleteJobs deletes the selected jobs.
+func (a *api) onBulkDeleteJobs(w http.ResponseWriter, r *http.Request) {
+	a.onBulkJobs(w, r, a.universal.DeleteJobs)
+}
+
+// onBulkPauseJobs pauses the selected jobs.
+func (a *api) onBulkPauseJobs(w http.ResponseWriter, r *http.Request) {
+	a.onBulkJobs(w, r, a.universal.PauseJobs)
+}
+
+// onBulkResumeJobs resumes the selected paused jobs.
+func (a *api) onBulkResumeJobs(w http.ResponseWriter, r *http.Request) {
+	a.onBulkJobs(w, r, a.universal.ResumeJobs)
+}
+
+func (a *api) onBulkJobs(w http.ResponseWriter, r *http.Request, op func(context.Context, *universal.BulkJobsRequest) ([]universal.BulkJobsResult, error)) {
+	var body bulkJobsRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		err = messages.ErrMalformedRequest.WithFormat(err)
+		log.Debug(err)
+		respondWithError(w, err)
+		return
+	}
+
+	req := &universal.BulkJobsRequest{
+		Names: body.Names,
+		Filter: universal.JobsFilter{
+			NamePrefix:  body.NamePrefix,
+			OverdueOnly: body.OverdueOnly,
+		},
+	}
+	for param, src := range map[string]struct {
+		value string
+		dst   **time.Time
+	}{
+		"scheduledAfter":  {body.ScheduledAfter, &req.Filter.ScheduledAfter},
+		"scheduledBefore": {body.ScheduledBefore, &req.Filter.ScheduledBefore},
+	} {
+		if src.value == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, src.value)
+		if err != nil {
+			err = messages.ErrBadRequest.WithFormat("invalid " + param + ": " + err.Error())
+			log.Debug(err)
+			respondWithError(w, err)
+			return
+		}
+		*src.dst = &t
+	}
+
+	results, err := op(r.Context(), req)
+	if err != nil {
+		respondWithError(w, err)
+		return
+	}
+
+	res := bulkJobsResponse{
+		Results: make([]bulkJobsResult, len(results)),
+	}
+	for i, result := range results {
+		res.Results[i] = bulkJobsResult{Name: result.Name}
+		if result.Err != nil {
+			res.Results[i].Error = result.Err.Error()
+		}
+	}
+
+	respondWithJSON(w, http.StatusOK, res)
+}
+
+func listJobsFilter(r *http.Request) (*universal.JobsFilter, error) {
+	filter := &universal.JobsFilter{}
+
+	query := r.URL.Query()
+	filter.NamePrefix = query.Get("namePrefix")
+	for param, dst := range map[string]**time.Time{
+		"scheduledAfter":  &filter.ScheduledAfter,
+		"scheduledBefore": &filter.ScheduledBefore,
+	} {
+		if !query.Has(param) {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, query.Get(param))
+		if err != nil {
+			return nil, messages.ErrBadRequest.WithFormat("invalid " + param + ": " + err.Error())
+		}
+		*dst = &t
+	}
+	if query.Has("overdue") {
+		overdue, err := strconv.ParseBool(query.Get("overdue"))
+		if err != nil {
+			return nil, messages.ErrBadRequest.WithFormat("invalid overdue: must be a boolean")
+		}
+		filter.OverdueOnly = overdue
+	}
+	if query.Has("paused") {
+		paused, err := strconv.ParseBool(query.Get("paused"))
+		if err != nil {
+			return nil, messages.ErrBadRequest.WithFormat("invalid paused: must be a boolean")
+		}
+		filter.Paused = ptr.Of(paused)
+	}
+
+	return filter, nil
+}
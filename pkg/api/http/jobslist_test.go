@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/api/universal"
+	"github.com/dapr/dapr/pkg/healthz"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/kit/logger"
+)
+
+func TestListJobsFilter(t *testing.T) {
+	t.Run("all filters", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/v1.0-alpha1/jobs?namePrefix=report-&scheduledAfter=2026-01-01T00:00:00Z&scheduledBefore=2026-02-01T00:00:00Z&overdue=true&paused=false", nil)
+		filter, err := listJobsFilter(r)
+		require.NoError(t, err)
+		assert.Equal(t, "report-", filter.NamePrefix)
+		assert.Equal(t, "2026-01-01T00:00:00Z", filter.ScheduledAfter.Format(time.RFC3339))
+		assert.Equal(t, "2026-02-01T00:00:00Z", filter.ScheduledBefore.Format(time.RFC3339))
+		assert.True(t, filter.OverdueOnly)
+		require.NotNil(t, filter.Paused)
+		assert.False(t, *filter.Paused)
+	})
+
+	t.Run("no filters", func(t *testing.T) {
+		filter, err := listJobsFilter(httptest.NewRequest("GET", "/v1.0-alpha1/jobs", nil))
+		require.NoError(t, err)
+		assert.True(t, filter.IsEmpty())
+	})
+
+	t.Run("invalid time", func(t *testing.T) {
+		_, err := listJobsFilter(httptest.NewRequest("GET", "/v1.0-alpha1/jobs?scheduledAfter=tomorrow", nil))
+		require.Error(t, err)
+	})
+}
+
+func TestBulkJobsEndpoints(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	testAPI := &api{
+		healthz: healthz.New(),
+		universal: universal.New(universal.Options{
+			Logger:     logger.NewLogger("test.api.http.jobslist"),
+			AppID:      "fakeAPI",
+			Resiliency: resiliency.New(nil),
+		}),
+	}
+	fakeServer.StartServer(testAPI.constructJobsEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	t.Run("no selection", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/jobs/bulk/delete", []byte(`{}`), nil)
+		assert.Equal(t, 400, resp.StatusCode, string(resp.RawBody))
+	})
+
+	t.Run("names and filter", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/jobs/bulk/pause", []byte(`{"names": ["a"], "namePrefix": "a"}`), nil)
+		assert.Equal(t, 400, resp.StatusCode, string(resp.RawBody))
+	})
+
+	t.Run("invalid time", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/jobs/bulk/resume", []byte(`{"scheduledAfter": "tomorrow"}`), nil)
+		assert.Equal(t, 400, resp.StatusCode, string(resp.RawBody))
+	})
+
+	t.Run("malformed body", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/jobs/bulk/delete", []byte(`{`), nil)
+		assert.Equal(t, 400, resp.StatusCode, string(resp.RawBody))
+	})
+}
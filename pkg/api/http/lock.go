@@ -14,13 +14,16 @@ limitations under the License.
 package http
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/go-chi/chi/v5"
 	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	"github.com/dapr/dapr/pkg/api/universal"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 )
 
@@ -54,14 +57,139 @@ func (a *api) constructDistributedLockEndpoints() []endpoints.Endpoint {
 				Name: "Unlock",
 			},
 		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "lock/{storeName}/renew",
+			Version: apiVersionV1alpha1,
+			Group: &endpoints.EndpointGroup{
+				Name:                 endpoints.EndpointGroupLock,
+				Version:              endpoints.EndpointGroupVersion1alpha1,
+				AppendSpanAttributes: nil, // TODO
+			},
+			Handler: a.onRenewLockAlpha1(),
+			Settings: endpoints.EndpointSettings{
+				Name: "RenewLock",
+			},
+		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "lock/{storeName}/shared",
+			Version: apiVersionV1alpha1,
+			Group: &endpoints.EndpointGroup{
+				Name:                 endpoints.EndpointGroupLock,
+				Version:              endpoints.EndpointGroupVersion1alpha1,
+				AppendSpanAttributes: nil, // TODO
+			},
+			Handler: a.onTryLockSharedAlpha1(),
+			Settings: endpoints.EndpointSettings{
+				Name: "TryLockShared",
+			},
+		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "unlock/{storeName}/shared",
+			Version: apiVersionV1alpha1,
+			Group: &endpoints.EndpointGroup{
+				Name:                 endpoints.EndpointGroupUnlock,
+				Version:              endpoints.EndpointGroupVersion1alpha1,
+				AppendSpanAttributes: nil, // TODO
+			},
+			Handler: a.onUnlockSharedAlpha1(),
+			Settings: endpoints.EndpointSettings{
+				Name: "UnlockShared",
+			},
+		},
 	}
 }
 
 func (a *api) onTryLockAlpha1() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// The fencing token of the lock is returned in the response headers
+		tryLock := func(ctx context.Context, in *runtimev1pb.TryLockRequest) (*runtimev1pb.TryLockResponse, error) {
+			resp, token, err := a.universal.TryLockFenced(ctx, in)
+			if token > 0 {
+				setResponseMetadataHeaders(w, map[string]string{
+					universal.LockFencingTokenKey: strconv.FormatInt(token, 10),
+				})
+			}
+			return resp, err
+		}
+		UniversalHTTPHandler(
+			tryLock,
+			UniversalHTTPHandlerOpts[*runtimev1pb.TryLockRequest, *runtimev1pb.TryLockResponse]{
+				InModifier: func(r *http.Request, in *runtimev1pb.TryLockRequest) (*runtimev1pb.TryLockRequest, error) {
+					in.StoreName = chi.URLParam(r, storeNameParam)
+					return in, nil
+				},
+				// We need to emit unpopulated fields in the response
+				ProtoResponseEmitUnpopulated: true,
+			},
+		)(w, r)
+	}
+}
+
+func (a *api) onUnlockAlpha1() http.HandlerFunc {
 	return UniversalHTTPHandler(
-		a.universal.TryLockAlpha1,
-		UniversalHTTPHandlerOpts[*runtimev1pb.TryLockRequest, *runtimev1pb.TryLockResponse]{
-			InModifier: func(r *http.Request, in *runtimev1pb.TryLockRequest) (*runtimev1pb.TryLockRequest, error) {
+		a.universal.UnlockAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.UnlockRequest, *runtimev1pb.UnlockResponse]{
+			InModifier: func(r *http.Request, in *runtimev1pb.UnlockRequest) (*runtimev1pb.UnlockRequest, error) {
+				in.StoreName = chi.URLParam(r, storeNameParam)
+				return in, nil
+			},
+			OutModifier: func(out *runtimev1pb.UnlockResponse) (any, error) {
+				// Create the response manually because we want to report the status as a number and not a string
+				b, err := protojson.MarshalOptions{
+					EmitUnpopulated: true,
+					UseEnumNumbers:  true,
+				}.Marshal(out)
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal response as JSON: %w", err)
+				}
+				return UniversalHTTPRawResponse{
+					Body:        b,
+					ContentType: jsonContentTypeHeader,
+				}, nil
+			},
+		},
+	)
+}
+
+// Route: POST "lock/{storeName}/renew"
+// The body is a JSON object with "resourceId", "lockOwner", "expiryInSeconds"
+// and, to have the sidecar renew the lock until it's released, "autoRenew"
+// and "maxHoldSeconds". The status of the response is reported as a number,
+// like the one of unlock.
+func (a *api) onRenewLockAlpha1() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.RenewLockAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.RenewLockRequest, *runtimev1pb.RenewLockResponse]{
+			InModifier: func(r *http.Request, in *runtimev1pb.RenewLockRequest) (*runtimev1pb.RenewLockRequest, error) {
+				in.StoreName = chi.URLParam(r, storeNameParam)
+				return in, nil
+			},
+			OutModifier: func(out *runtimev1pb.RenewLockResponse) (any, error) {
+				// Report the status as a number, like the one of unlock
+				b, err := protojson.MarshalOptions{
+					EmitUnpopulated: true,
+					UseEnumNumbers:  true,
+				}.Marshal(out)
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal response as JSON: %w", err)
+				}
+				return UniversalHTTPRawResponse{
+					Body:        b,
+					ContentType: jsonContentTypeHeader,
+				}, nil
+			},
+		},
+	)
+}
+
+func (a *api) onTryLockSharedAlpha1() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.TryLockSharedAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.TryLockSharedRequest, *runtimev1pb.TryLockSharedResponse]{
+			InModifier: func(r *http.Request, in *runtimev1pb.TryLockSharedRequest) (*runtimev1pb.TryLockSharedRequest, error) {
 				in.StoreName = chi.URLParam(r, storeNameParam)
 				return in, nil
 			},
@@ -71,16 +199,16 @@ func (a *api) onTryLockAlpha1() http.HandlerFunc {
 	)
 }
 
-func (a *api) onUnlockAlpha1() http.HandlerFunc {
+func (a *api) onUnlockSharedAlpha1() http.HandlerFunc {
 	return UniversalHTTPHandler(
-		a.universal.UnlockAlpha1,
-		UniversalHTTPHandlerOpts[*runtimev1pb.UnlockRequest, *runtimev1pb.UnlockResponse]{
-			InModifier: func(r *http.Request, in *runtimev1pb.UnlockRequest) (*runtimev1pb.UnlockRequest, error) {
+		a.universal.UnlockSharedAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.UnlockSharedRequest, *runtimev1pb.UnlockSharedResponse]{
+			InModifier: func(r *http.Request, in *runtimev1pb.UnlockSharedRequest) (*runtimev1pb.UnlockSharedRequest, error) {
 				in.StoreName = chi.URLParam(r, storeNameParam)
 				return in, nil
 			},
-			OutModifier: func(out *runtimev1pb.UnlockResponse) (any, error) {
-				// Create the response manually because we want to report the status as a number and not a string
+			OutModifier: func(out *runtimev1pb.UnlockSharedResponse) (any, error) {
+				// Report the status as a number, like the one of unlock
 				b, err := protojson.MarshalOptions{
 					EmitUnpopulated: true,
 					UseEnumNumbers:  true,
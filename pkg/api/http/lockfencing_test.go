@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/api/universal"
+	lockLoader "github.com/dapr/dapr/pkg/components/lock"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+func TestV1Alpha1TryLockFencingToken(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+
+	compStore := compstore.New()
+	compStore.AddLock("fencedstore", &daprt.FakeLockStore{})
+	compStore.AddLock("store2", &daprt.FakeLockStore{})
+	compStore.AddStateStore("statestore", daprt.NewFakeStateStore())
+	require.NoError(t, lockLoader.SaveLockConfiguration("fencedstore", map[string]string{
+		"fencingTokenStateStore": "statestore",
+	}))
+	testAPI := &api{
+		universal: universal.New(universal.Options{
+			Logger:     log,
+			CompStore:  compStore,
+			Resiliency: resiliency.New(nil),
+		}),
+	}
+	fakeServer.StartServer(testAPI.constructDistributedLockEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	body := []byte(`{"resourceId":"r1","lockOwner":"owner1","expiryInSeconds":10}`)
+
+	t.Run("token in the response headers", func(t *testing.T) {
+		resp := fakeServer.DoRequest(http.MethodPost, apiVersionV1alpha1+"/lock/fencedstore", body, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(resp.RawBody))
+		assert.Equal(t, map[string]any{"success": true}, resp.JSONBody)
+		assert.Equal(t, "1", resp.RawHeader.Get(metadataPrefix+universal.LockFencingTokenKey))
+	})
+
+	t.Run("no token without a fencing configuration", func(t *testing.T) {
+		resp := fakeServer.DoRequest(http.MethodPost, apiVersionV1alpha1+"/lock/store2", body, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(resp.RawBody))
+		assert.Empty(t, resp.RawHeader.Get(metadataPrefix+universal.LockFencingTokenKey))
+	})
+}
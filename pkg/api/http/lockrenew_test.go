@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/lock"
+	"github.com/dapr/dapr/pkg/api/universal"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+func TestV1Alpha1RenewLock(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+
+	store := &daprt.FakeLockStore{}
+	compStore := compstore.New()
+	compStore.AddLock("store1", store)
+	testAPI := &api{
+		universal: universal.New(universal.Options{
+			Logger:     log,
+			CompStore:  compStore,
+			Resiliency: resiliency.New(nil),
+		}),
+	}
+	fakeServer.StartServer(testAPI.constructDistributedLockEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	_, err := store.TryLock(t.Context(), &lock.TryLockRequest{ResourceID: "lock||r1", LockOwner: "owner1", ExpiryInSeconds: 10})
+	require.NoError(t, err)
+
+	apiPath := apiVersionV1alpha1 + "/lock/store1/renew"
+	for owner, status := range map[string]float64{
+		"owner1": float64(lock.Success),
+		"owner2": float64(lock.LockBelongsToOthers),
+	} {
+		t.Run("renew lock of "+owner, func(t *testing.T) {
+			b := []byte(`{"resourceId":"r1","lockOwner":"` + owner + `","expiryInSeconds":10}`)
+			resp := fakeServer.DoRequest(http.MethodPost, apiPath, b, nil)
+			require.Equal(t, http.StatusOK, resp.StatusCode, string(resp.RawBody))
+			assert.Equal(t, map[string]any{"status": status}, resp.JSONBody)
+		})
+	}
+
+	t.Run("renew with invalid expiry", func(t *testing.T) {
+		resp := fakeServer.DoRequest(http.MethodPost, apiPath, []byte(`{"resourceId":"r1","lockOwner":"owner1"}`), nil)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "ERR_MALFORMED_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("renew with malformed body", func(t *testing.T) {
+		resp := fakeServer.DoRequest(http.MethodPost, apiPath, []byte(`{`), nil)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "ERR_MALFORMED_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("auto renew without renewer", func(t *testing.T) {
+		resp := fakeServer.DoRequest(http.MethodPost, apiPath, []byte(`{"resourceId":"r1","lockOwner":"owner1","expiryInSeconds":10,"autoRenew":true,"maxHoldSeconds":60}`), nil)
+		assert.Equal(t, http.StatusNotImplemented, resp.StatusCode)
+		assert.Equal(t, "ERR_LOCK_AUTO_RENEW_NOT_AVAILABLE", resp.ErrorBody["errorCode"])
+	})
+}
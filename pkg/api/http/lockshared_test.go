@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/lock"
+	"github.com/dapr/dapr/pkg/api/universal"
+	lockLoader "github.com/dapr/dapr/pkg/components/lock"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+func TestV1Alpha1SharedLock(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+
+	compStore := compstore.New()
+	compStore.AddLock("sharedstore", &daprt.FakeLockStore{})
+	compStore.AddLock("exclusivestore", &daprt.FakeLockStore{})
+	compStore.AddStateStore("statestore", daprt.NewFakeStateStore())
+	require.NoError(t, lockLoader.SaveLockConfiguration("sharedstore", map[string]string{
+		"readWriteLockStateStore": "statestore",
+	}))
+	testAPI := &api{
+		universal: universal.New(universal.Options{
+			Logger:     log,
+			CompStore:  compStore,
+			Resiliency: resiliency.New(nil),
+		}),
+	}
+	fakeServer.StartServer(testAPI.constructDistributedLockEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	lockBody := []byte(`{"resourceId":"r1","lockOwner":"owner1","expiryInSeconds":10}`)
+	unlockBody := []byte(`{"resourceId":"r1","lockOwner":"owner1"}`)
+
+	t.Run("acquire and release shared lock", func(t *testing.T) {
+		resp := fakeServer.DoRequest(http.MethodPost, apiVersionV1alpha1+"/lock/sharedstore/shared", lockBody, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(resp.RawBody))
+		assert.Equal(t, map[string]any{"success": true}, resp.JSONBody)
+
+		// The exclusive lock is refused while the shared lock is held
+		resp = fakeServer.DoRequest(http.MethodPost, apiVersionV1alpha1+"/lock/sharedstore", []byte(`{"resourceId":"r1","lockOwner":"owner2","expiryInSeconds":10}`), nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(resp.RawBody))
+		assert.Equal(t, map[string]any{"success": false}, resp.JSONBody)
+
+		resp = fakeServer.DoRequest(http.MethodPost, apiVersionV1alpha1+"/unlock/sharedstore/shared", unlockBody, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(resp.RawBody))
+		assert.Equal(t, map[string]any{"status": float64(lock.Success)}, resp.JSONBody)
+	})
+
+	t.Run("lock store without shared locks", func(t *testing.T) {
+		resp := fakeServer.DoRequest(http.MethodPost, apiVersionV1alpha1+"/lock/exclusivestore/shared", lockBody, nil)
+		assert.Equal(t, http.StatusNotImplemented, resp.StatusCode)
+		assert.Equal(t, "ERR_LOCK_SHARED_NOT_SUPPORTED", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("malformed body", func(t *testing.T) {
+		resp := fakeServer.DoRequest(http.MethodPost, apiVersionV1alpha1+"/unlock/sharedstore/shared", []byte(`{`), nil)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "ERR_MALFORMED_REQUEST", resp.ErrorBody["errorCode"])
+	})
+}
@@ -21,6 +21,7 @@ import (
 
 	chi "github.com/go-chi/chi/v5"
 
+	"github.com/dapr/dapr/pkg/security/apitoken"
 	securityConsts "github.com/dapr/dapr/pkg/security/consts"
 	"github.com/dapr/kit/streams"
 )
@@ -36,15 +37,15 @@ func MaxBodySizeMiddleware(maxSize int64) func(next http.Handler) http.Handler {
 }
 
 // APITokenAuthMiddleware enforces authentication using the dapr-api-token header.
-func APITokenAuthMiddleware(token string) func(next http.Handler) http.Handler {
+func APITokenAuthMiddleware(tokens *apitoken.Tokens) func(next http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
-		if token == "" {
+		if !tokens.Enabled() {
 			return next
 		}
 
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			v := r.Header.Get(securityConsts.APITokenHeader)
-			if v != token && !isRouteExcludedFromAPITokenAuth(r.Method, r.URL) {
+			if !tokens.Valid(v) && !isRouteExcludedFromAPITokenAuth(r.Method, r.URL) {
 				http.Error(w, "invalid api token", http.StatusUnauthorized)
 				return
 			}
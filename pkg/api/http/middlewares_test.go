@@ -27,6 +27,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/test/bufconn"
 
+	"github.com/dapr/dapr/pkg/security/apitoken"
 	securityConsts "github.com/dapr/dapr/pkg/security/consts"
 )
 
@@ -62,7 +63,7 @@ func TestAPITokenAuthMiddleware(t *testing.T) {
 	}
 
 	t.Run("no token required", func(t *testing.T) {
-		mw := APITokenAuthMiddleware("")
+		mw := APITokenAuthMiddleware(apitoken.Static())
 		h := mw(handler)
 
 		r := httptest.NewRequest(http.MethodGet, "/v1.0/foo", nil)
@@ -73,7 +74,7 @@ func TestAPITokenAuthMiddleware(t *testing.T) {
 	})
 
 	t.Run("required token not provided", func(t *testing.T) {
-		mw := APITokenAuthMiddleware(apiToken)
+		mw := APITokenAuthMiddleware(apitoken.Static(apiToken))
 		h := mw(handler)
 
 		r := httptest.NewRequest(http.MethodGet, "/v1.0/foo", nil)
@@ -84,7 +85,7 @@ func TestAPITokenAuthMiddleware(t *testing.T) {
 	})
 
 	t.Run("with valid token", func(t *testing.T) {
-		mw := APITokenAuthMiddleware(apiToken)
+		mw := APITokenAuthMiddleware(apitoken.Static(apiToken))
 		h := mw(handler)
 
 		r := httptest.NewRequest(http.MethodGet, "/v1.0/foo", nil)
@@ -95,8 +96,29 @@ func TestAPITokenAuthMiddleware(t *testing.T) {
 		assertPass(t, w)
 	})
 
+	t.Run("with any of multiple valid tokens", func(t *testing.T) {
+		mw := APITokenAuthMiddleware(apitoken.Static("new-token", apiToken))
+		h := mw(handler)
+
+		for _, token := range []string{"new-token", apiToken} {
+			r := httptest.NewRequest(http.MethodGet, "/v1.0/foo", nil)
+			r.Header.Set(securityConsts.APITokenHeader, token)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+
+			assertPass(t, w)
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/v1.0/foo", nil)
+		r.Header.Set(securityConsts.APITokenHeader, "old-token")
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		assertFail(t, w)
+	})
+
 	t.Run("healthz endpoints are always allowed", func(t *testing.T) {
-		mw := APITokenAuthMiddleware(apiToken)
+		mw := APITokenAuthMiddleware(apitoken.Static(apiToken))
 		h := mw(handler)
 
 		t.Run("healthz", func(t *testing.T) {
@@ -0,0 +1,264 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"maps"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	"github.com/dapr/dapr/pkg/buildinfo"
+	"github.com/dapr/dapr/pkg/messages"
+	securityConsts "github.com/dapr/dapr/pkg/security/consts"
+)
+
+const openAPIVersion = "3.1.0"
+
+var endpointGroupOpenAPIV1 = &endpoints.EndpointGroup{
+	Name:                 endpoints.EndpointGroupOpenAPI,
+	Version:              endpoints.EndpointGroupVersion1,
+	AppendSpanAttributes: nil,
+}
+
+// openAPIAnyMethods are the methods documented for endpoints that match any method.
+var openAPIAnyMethods = []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+
+// openAPIPathParam matches the parameters in chi routes, such as "{name}" or "{name:regex}".
+var openAPIPathParam = regexp.MustCompile(`\{([^}:]+)(?::[^}]*)?\}`)
+
+type openAPIDocument struct {
+	OpenAPI    string                                 `json:"openapi"`
+	Info       openAPIInfo                            `json:"info"`
+	Paths      map[string]map[string]openAPIOperation `json:"paths"`
+	Components *openAPIComponents                     `json:"components,omitempty"`
+	Security   []map[string][]string                  `json:"security,omitempty"`
+}
+
+type openAPIInfo struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+type openAPIOperation struct {
+	OperationID string                     `json:"operationId,omitempty"`
+	Tags        []string                   `json:"tags,omitempty"`
+	Parameters  []openAPIParameter         `json:"parameters,omitempty"`
+	Responses   map[string]openAPIResponse `json:"responses"`
+}
+
+type openAPIParameter struct {
+	Name     string        `json:"name"`
+	In       string        `json:"in"`
+	Required bool          `json:"required"`
+	Schema   openAPISchema `json:"schema"`
+}
+
+type openAPISchema struct {
+	Type string   `json:"type"`
+	Enum []string `json:"enum,omitempty"`
+}
+
+type openAPIResponse struct {
+	Description string `json:"description"`
+}
+
+type openAPIComponents struct {
+	SecuritySchemes map[string]openAPISecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+type openAPISecurityScheme struct {
+	Type string `json:"type"`
+	In   string `json:"in"`
+	Name string `json:"name"`
+}
+
+func (a *api) constructOpenAPIEndpoints() []endpoints.Endpoint {
+	return []endpoints.Endpoint{
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "openapi.json",
+			Version: apiVersionV1,
+			Group:   endpointGroupOpenAPIV1,
+			Handler: a.onGetOpenAPI,
+			Settings: endpoints.EndpointSettings{
+				Name: "GetOpenAPI",
+			},
+		},
+	}
+}
+
+// onGetOpenAPI returns an OpenAPI document describing the routes registered on the router.
+// Endpoints that are disabled by the API allowlist or denylist are not registered, so they are not included in the document.
+func (a *api) onGetOpenAPI(w http.ResponseWriter, r *http.Request) {
+	chiCtx := chi.RouteContext(r.Context())
+	if chiCtx == nil || chiCtx.Routes == nil {
+		respondWithError(w, messages.ErrAPIUnimplemented)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, a.openAPIDocument(chiCtx.Routes))
+}
+
+func (a *api) openAPIDocument(routes chi.Routes) *openAPIDocument {
+	// Endpoints keyed by method and route, as multiple endpoints can share the same route
+	// Endpoints that match any method use "*" as method
+	byRoute := make(map[string]endpoints.Endpoint, len(a.endpoints))
+	for _, e := range a.endpoints {
+		if len(e.Methods) == 0 {
+			byRoute["* /"+e.Version+"/"+e.Route] = e
+		}
+		for _, m := range e.Methods {
+			byRoute[m+" /"+e.Version+"/"+e.Route] = e
+		}
+	}
+
+	doc := &openAPIDocument{
+		OpenAPI: openAPIVersion,
+		Info: openAPIInfo{
+			Title:       "Dapr",
+			Description: "HTTP API of the Dapr sidecar for the app " + a.universal.AppID(),
+			Version:     buildinfo.Version(),
+		},
+		Paths: map[string]map[string]openAPIOperation{},
+	}
+
+	if a.apiTokens.Enabled() {
+		doc.Components = &openAPIComponents{
+			SecuritySchemes: map[string]openAPISecurityScheme{
+				"daprAPIToken": {Type: "apiKey", In: "header", Name: securityConsts.APITokenHeader},
+			},
+		}
+		doc.Security = []map[string][]string{{"daprAPIToken": {}}}
+	}
+
+	operationIDs := map[string]int{}
+	// The walk function never returns an error
+	_ = chi.Walk(routes, func(method string, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		e, ok := byRoute[method+" "+route]
+		if !ok {
+			// Only document the common methods of endpoints that match any method
+			e, ok = byRoute["* "+route]
+			if !ok || !slices.Contains(openAPIAnyMethods, method) {
+				return nil
+			}
+		}
+
+		path, params := a.openAPIPath(e, route)
+		method = strings.ToLower(method)
+		if _, ok := doc.Paths[path][method]; ok {
+			// Routes that only differ in the parameter patterns map to the same OpenAPI path
+			return nil
+		}
+
+		op := openAPIOperation{
+			Parameters: params,
+			Responses: map[string]openAPIResponse{
+				"default": {Description: "Response of the endpoint"},
+			},
+		}
+		if e.Settings.Name != "" {
+			op.OperationID = e.Settings.Name
+			if len(e.Methods) != 1 {
+				op.OperationID += strings.ToUpper(method[:1]) + method[1:]
+			}
+			operationIDs[op.OperationID]++
+			if n := operationIDs[op.OperationID]; n > 1 {
+				op.OperationID += strconv.Itoa(n)
+			}
+		}
+		if e.Group != nil {
+			op.Tags = []string{string(e.Group.Name)}
+		}
+
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = map[string]openAPIOperation{}
+		}
+		doc.Paths[path][method] = op
+		return nil
+	})
+
+	return doc
+}
+
+// openAPIPath converts a chi route to an OpenAPI path, returning its path parameters.
+// Parameters that refer to components are restricted to the names of the components that are loaded.
+func (a *api) openAPIPath(e endpoints.Endpoint, route string) (string, []openAPIParameter) {
+	// Query strings are not part of the path
+	route, _, _ = strings.Cut(route, "?")
+
+	// Wildcards at the end of the route capture the rest of the path
+	if strings.HasSuffix(route, "/*") {
+		route = strings.TrimSuffix(route, "*") + "{path}"
+	}
+
+	var params []openAPIParameter
+	path := openAPIPathParam.ReplaceAllStringFunc(route, func(match string) string {
+		name := openAPIPathParam.FindStringSubmatch(match)[1]
+		params = append(params, openAPIParameter{
+			Name:     name,
+			In:       "path",
+			Required: true,
+			Schema: openAPISchema{
+				Type: "string",
+				Enum: a.openAPIComponentNames(e, name),
+			},
+		})
+		return "{" + name + "}"
+	})
+
+	return path, params
+}
+
+// openAPIComponentNames returns the sorted names of the loaded components that can be used for a path parameter, if any.
+func (a *api) openAPIComponentNames(e endpoints.Endpoint, param string) []string {
+	if e.Group == nil {
+		return nil
+	}
+
+	compStore := a.universal.CompStore()
+	var names []string
+	switch {
+	case e.Group.Name == endpoints.EndpointGroupState && param == storeNameParam:
+		names = slices.Collect(maps.Keys(compStore.ListStateStores()))
+	case e.Group.Name == endpoints.EndpointGroupConfiguration && param == storeNameParam:
+		names = slices.Collect(maps.Keys(compStore.ListConfigurations()))
+	case (e.Group.Name == endpoints.EndpointGroupLock || e.Group.Name == endpoints.EndpointGroupUnlock) && param == storeNameParam:
+		names = slices.Collect(maps.Keys(compStore.ListLocks()))
+	case e.Group.Name == endpoints.EndpointGroupPubsub && param == pubsubnameparam:
+		names = slices.Collect(maps.Keys(compStore.ListPubSubs()))
+	case e.Group.Name == endpoints.EndpointGroupSecrets && param == secretStoreNameParam:
+		names = slices.Collect(maps.Keys(compStore.ListSecretStores()))
+	case e.Group.Name == endpoints.EndpointGroupBindings && param == nameParam:
+		names = slices.Collect(maps.Keys(compStore.ListOutputBindings()))
+	case e.Group.Name == endpoints.EndpointGroupConversation && param == nameParam:
+		names = slices.Collect(maps.Keys(compStore.ListConversations()))
+	case (e.Group.Name == endpoints.EndpointGroupCrypto || e.Group.Name == endpoints.EndpointGroupSubtleCrypto) && param == nameParam:
+		names = slices.Collect(maps.Keys(compStore.ListCryptoProviders()))
+	default:
+		return nil
+	}
+
+	if len(names) == 0 {
+		return nil
+	}
+	slices.Sort(names)
+	return names
+}
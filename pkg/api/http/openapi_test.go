@@ -0,0 +1,93 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/api/universal"
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+func TestOpenAPIEndpoint(t *testing.T) {
+	compStore := compstore.New()
+	compStore.AddStateStore("store2", daprt.NewFakeStateStore())
+	compStore.AddStateStore("store1", daprt.NewFakeStateStore())
+
+	testAPI := &api{
+		universal: universal.New(universal.Options{
+			AppID:     "fakeAPI",
+			CompStore: compStore,
+		}),
+	}
+	testAPI.endpoints = append(testAPI.endpoints, testAPI.constructStateEndpoints()...)
+	testAPI.endpoints = append(testAPI.endpoints, testAPI.constructSecretsEndpoints()...)
+	testAPI.endpoints = append(testAPI.endpoints, testAPI.constructDirectMessagingEndpoints()...)
+	testAPI.endpoints = append(testAPI.endpoints, testAPI.constructOpenAPIEndpoints()...)
+
+	srv := newServer()
+	srv.apiSpec = config.APISpec{
+		Denied: config.APIAccessRules{
+			{Name: "secrets", Version: "v1", Protocol: "http"},
+		},
+	}
+	router := chi.NewRouter()
+	srv.setupRoutes(router, testAPI.endpoints)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1.0/openapi.json", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var doc openAPIDocument
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &doc))
+	assert.Equal(t, "3.1.0", doc.OpenAPI)
+	assert.Contains(t, doc.Info.Description, "fakeAPI")
+
+	t.Run("path parameters", func(t *testing.T) {
+		op, ok := doc.Paths["/v1.0/state/{storeName}/{key}"]["get"]
+		require.True(t, ok)
+		assert.Equal(t, "GetState", op.OperationID)
+		assert.Equal(t, []string{"state"}, op.Tags)
+		require.Len(t, op.Parameters, 2)
+		assert.Equal(t, "storeName", op.Parameters[0].Name)
+		assert.Equal(t, []string{"store1", "store2"}, op.Parameters[0].Schema.Enum)
+		assert.Equal(t, "key", op.Parameters[1].Name)
+		assert.Empty(t, op.Parameters[1].Schema.Enum)
+	})
+
+	t.Run("multiple methods", func(t *testing.T) {
+		assert.Equal(t, "SaveStatePost", doc.Paths["/v1.0/state/{storeName}"]["post"].OperationID)
+		assert.Equal(t, "SaveStatePut", doc.Paths["/v1.0/state/{storeName}"]["put"].OperationID)
+	})
+
+	t.Run("wildcard routes", func(t *testing.T) {
+		assert.Equal(t, "InvokeServicePost", doc.Paths["/v1.0/invoke/{path}"]["post"].OperationID)
+		assert.NotContains(t, doc.Paths["/v1.0/invoke/{path}"], "options")
+	})
+
+	t.Run("denied endpoints are not included", func(t *testing.T) {
+		for path := range doc.Paths {
+			assert.NotContains(t, path, "/secrets/")
+		}
+	})
+}
@@ -13,6 +13,12 @@ limitations under the License.
 
 package http
 
+import (
+	"encoding/json"
+
+	"github.com/dapr/components-contrib/state"
+)
+
 // OutputBindingRequest is the request object to invoke an output binding.
 type OutputBindingRequest struct {
 	Metadata  map[string]string `json:"metadata"`
@@ -26,3 +32,53 @@ type BulkGetRequest struct {
 	Keys        []string          `json:"keys"`
 	Parallelism int               `json:"parallelism"`
 }
+
+// RedriveRequest is the request object to redrive the messages of a dead letter topic.
+type RedriveRequest struct {
+	Metadata      map[string]string `json:"metadata"`
+	Filter        string            `json:"filter"`
+	RatePerSecond float64           `json:"ratePerSecond"`
+	MaxMessages   int               `json:"maxMessages"`
+	IdleTimeout   string            `json:"idleTimeout"`
+}
+
+// StreamAckRequest is the request object to acknowledge a message delivered on a streaming subscription.
+type StreamAckRequest struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+}
+
+// StateIncrementRequest is the request object to atomically increment an integer state value.
+type StateIncrementRequest struct {
+	// Delta is added to the value, and defaults to 1.
+	Delta    *int64            `json:"delta"`
+	Metadata map[string]string `json:"metadata"`
+}
+
+// StateAppendRequest is the request object to atomically append to a list state value.
+type StateAppendRequest struct {
+	Values   []json.RawMessage `json:"values"`
+	Metadata map[string]string `json:"metadata"`
+}
+
+// BulkSetStateRequest is the request object to save multiple values in a state store.
+type BulkSetStateRequest struct {
+	Items []BulkSetStateItem `json:"items"`
+	// Parallelism is the maximum number of items saved at a time by stores
+	// without native batching.
+	Parallelism int `json:"parallelism"`
+}
+
+// BulkSetStateItem is an item of a bulk set, with its own ETag, TTL and options.
+type BulkSetStateItem struct {
+	state.SetRequest
+	TTLInSeconds *int64 `json:"ttlInSeconds,omitempty"`
+}
+
+// BulkDeleteStateRequest is the request object to delete multiple keys from a state store.
+type BulkDeleteStateRequest struct {
+	Items []state.DeleteRequest `json:"items"`
+	// Parallelism is the maximum number of items deleted at a time by stores
+	// without native batching.
+	Parallelism int `json:"parallelism"`
+}
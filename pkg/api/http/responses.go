@@ -72,6 +72,42 @@ type QueryItem struct {
 	Error string          `json:"error,omitempty"`
 }
 
+// QueryV2Response is the response object for a v2 state query.
+type QueryV2Response struct {
+	Results    []QueryItem    `json:"results,omitempty"`
+	Aggregates map[string]any `json:"aggregates,omitempty"`
+	Cursor     string         `json:"cursor,omitempty"`
+}
+
+// BulkStateResponse is the response object for a bulk set or delete.
+type BulkStateResponse struct {
+	Results []BulkStateItemResult `json:"results"`
+}
+
+// BulkStateItemResult is the result of an item of a bulk set or delete.
+type BulkStateItemResult struct {
+	Key   string `json:"key"`
+	Error string `json:"error,omitempty"`
+}
+
+// StateIncrementResponse is the response object for an atomic state increment.
+type StateIncrementResponse struct {
+	Value int64 `json:"value"`
+}
+
+// StateAppendResponse is the response object for an atomic state append.
+type StateAppendResponse struct {
+	Length int `json:"length"`
+}
+
+// StateChangeEvent is an event sent to the caller watching state changes.
+type StateChangeEvent struct {
+	Key   string          `json:"key"`
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value,omitempty"`
+	ETag  *string         `json:"etag,omitempty"`
+}
+
 // respondWithJSON sends a response with an object that will be encoded as JSON.
 func respondWithJSON(w http.ResponseWriter, code int, obj any) {
 	w.Header().Set(headerContentType, jsonContentTypeHeader)
@@ -0,0 +1,57 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/dapr/dapr/pkg/api/http/endpoints"
+)
+
+var endpointGroupSecretsV1Alpha1 = &endpoints.EndpointGroup{
+	Name:                 endpoints.EndpointGroupSecrets,
+	Version:              endpoints.EndpointGroupVersion1alpha1,
+	AppendSpanAttributes: appendSecretsSpanAttributes,
+}
+
+func (a *api) constructSecretCacheEndpoints() []endpoints.Endpoint {
+	return []endpoints.Endpoint{
+		{
+			Methods: []string{http.MethodDelete},
+			Route:   "secrets/{secretStoreName}/cache",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupSecretsV1Alpha1,
+			Handler: a.onFlushSecretCache,
+			Settings: endpoints.EndpointSettings{
+				Name:             "FlushSecretCache",
+				RequiresAPIToken: true,
+			},
+		},
+	}
+}
+
+// Route: DELETE "secrets/{secretStoreName}/cache?key={key}"
+// The "key" query parameter is repeatable. Without it, all the secrets of the
+// store are flushed.
+func (a *api) onFlushSecretCache(w http.ResponseWriter, r *http.Request) {
+	err := a.universal.FlushSecretCache(r.Context(), chi.URLParam(r, secretStoreNameParam), r.URL.Query()["key"])
+	if err != nil {
+		respondWithError(w, err)
+		return
+	}
+
+	respondWithEmpty(w)
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/secretstores"
+	"github.com/dapr/dapr/pkg/api/universal"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/secretcache"
+	daprt "github.com/dapr/dapr/pkg/testing"
+	"github.com/dapr/kit/logger"
+)
+
+func TestSecretCacheEndpoints(t *testing.T) {
+	const token = "1234"
+	t.Setenv("DAPR_API_TOKEN", token)
+
+	fakeServer := newFakeHTTPServer()
+	compStore := compstore.New()
+	compStore.AddSecretStore("store1", daprt.FakeSecretStore{})
+	cache, err := secretcache.New(secretcache.Options{
+		StoreName: "store1",
+		Metadata:  map[string]string{"cacheTTL": "1h"},
+	})
+	require.NoError(t, err)
+	compStore.AddSecretCache("store1", cache)
+
+	var reads int
+	get := func(t *testing.T) {
+		_, err := cache.ReadThrough(t.Context(), secretstores.GetSecretRequest{Name: "good-key"}, func(ctx context.Context, req secretstores.GetSecretRequest) (secretstores.GetSecretResponse, error) {
+			reads++
+			return secretstores.GetSecretResponse{}, nil
+		})
+		require.NoError(t, err)
+	}
+
+	testAPI := &api{
+		universal: universal.New(universal.Options{
+			AppID:      "fakeAPI",
+			Logger:     logger.NewLogger("fakeLogger"),
+			CompStore:  compStore,
+			Resiliency: resiliency.New(nil),
+		}),
+	}
+	fakeServer.StartServer(testAPI.constructSecretCacheEndpoints(), &fakeHTTPServerOptions{
+		apiAuth: true,
+	})
+	defer fakeServer.Shutdown()
+
+	t.Run("flush - 204", func(t *testing.T) {
+		get(t)
+		get(t)
+		assert.Equal(t, 1, reads)
+
+		resp := fakeServer.DoRequest("DELETE", "v1.0-alpha1/secrets/store1/cache", nil, map[string]string{"key": "good-key"}, "dapr-api-token", token)
+		assert.Equal(t, 204, resp.StatusCode)
+
+		get(t)
+		assert.Equal(t, 2, reads)
+	})
+
+	t.Run("store not found - 401", func(t *testing.T) {
+		resp := fakeServer.DoRequest("DELETE", "v1.0-alpha1/secrets/nostore/cache", nil, nil, "dapr-api-token", token)
+		assert.Equal(t, 401, resp.StatusCode)
+		assert.Equal(t, "ERR_SECRET_STORE_NOT_FOUND", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("without token - 401", func(t *testing.T) {
+		resp := fakeServer.DoRequest("DELETE", "v1.0-alpha1/secrets/store1/cache", nil, nil)
+		assert.Equal(t, 401, resp.StatusCode)
+		assert.Nil(t, resp.ErrorBody)
+	})
+
+	t.Run("api token disabled - 403", func(t *testing.T) {
+		t.Setenv("DAPR_API_TOKEN", "")
+
+		noTokenServer := newFakeHTTPServer()
+		noTokenServer.StartServer(testAPI.constructSecretCacheEndpoints(), nil)
+		defer noTokenServer.Shutdown()
+
+		resp := noTokenServer.DoRequest("DELETE", "v1.0-alpha1/secrets/store1/cache", nil, nil)
+		assert.Equal(t, 403, resp.StatusCode)
+		assert.Equal(t, "ERR_API_TOKEN_REQUIRED", resp.ErrorBody["errorCode"])
+	})
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/go-chi/chi/v5"
 
 	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	"github.com/dapr/dapr/pkg/api/universal"
 	diagConsts "github.com/dapr/dapr/pkg/diagnostics/consts"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 )
@@ -84,30 +85,38 @@ func (a *api) onGetSecretHandler() http.HandlerFunc {
 	)
 }
 
+// onBulkGetSecretHandler returns the secrets of the store. The secrets are
+// filtered and paginated with the "metadata.namePrefix", "metadata.nameRegex",
+// "metadata.pageSize" and "metadata.continuationToken" query parameters; the
+// continuation token of the next page is returned in the
+// "metadata.continuationToken" response header.
 func (a *api) onBulkGetSecretHandler() http.HandlerFunc {
-	return UniversalHTTPHandler(
-		a.universal.GetBulkSecret,
-		UniversalHTTPHandlerOpts[*runtimev1pb.GetBulkSecretRequest, *runtimev1pb.GetBulkSecretResponse]{
-			InModifier: func(r *http.Request, in *runtimev1pb.GetBulkSecretRequest) (*runtimev1pb.GetBulkSecretRequest, error) {
-				in.StoreName = chi.URLParam(r, secretStoreNameParam)
-				in.Metadata = getMetadataFromRequest(r)
-				return in, nil
-			},
-			OutModifier: func(out *runtimev1pb.GetBulkSecretResponse) (any, error) {
-				// If the data is nil, return nil
-				if out == nil || out.GetData() == nil {
-					return nil, nil
-				}
+	return func(w http.ResponseWriter, r *http.Request) {
+		out, token, err := a.universal.GetBulkSecretPage(r.Context(), &runtimev1pb.GetBulkSecretRequest{
+			StoreName: chi.URLParam(r, secretStoreNameParam),
+			Metadata:  getMetadataFromRequest(r),
+		})
+		if err != nil {
+			respondWithError(w, err)
+			return
+		}
 
-				var secrets map[string]map[string]string
-				secrets = make(map[string]map[string]string, len(out.GetData()))
-				// Return just the secrets as map
-				for secretKey, secret := range out.GetData() {
-					secrets[secretKey] = secret.GetSecrets()
-				}
+		if token != "" {
+			setResponseMetadataHeaders(w, map[string]string{universal.BulkSecretContinuationTokenKey: token})
+		}
 
-				return secrets, nil
-			},
-		},
-	)
+		// If the data is nil, return nil
+		if out == nil || out.GetData() == nil {
+			respondWithEmpty(w)
+			return
+		}
+
+		// Return just the secrets as map
+		secrets := make(map[string]map[string]string, len(out.GetData()))
+		for secretKey, secret := range out.GetData() {
+			secrets[secretKey] = secret.GetSecrets()
+		}
+
+		respondWithJSON(w, http.StatusOK, secrets)
+	}
 }
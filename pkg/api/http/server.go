@@ -15,13 +15,13 @@ package http
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
 	"net/url"
-	"os"
 	"regexp"
 	"strconv"
 	"strings"
@@ -39,15 +39,19 @@ import (
 	"golang.org/x/net/http2/h2c"
 
 	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	"github.com/dapr/dapr/pkg/api/policy"
+	"github.com/dapr/dapr/pkg/api/ratelimit"
 	"github.com/dapr/dapr/pkg/config"
 	corsDapr "github.com/dapr/dapr/pkg/cors"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	diagUtils "github.com/dapr/dapr/pkg/diagnostics/utils"
+	"github.com/dapr/dapr/pkg/messages"
 	"github.com/dapr/dapr/pkg/middleware"
 	"github.com/dapr/dapr/pkg/responsewriter"
-	"github.com/dapr/dapr/pkg/security"
+	"github.com/dapr/dapr/pkg/runtime/drain"
+	"github.com/dapr/dapr/pkg/security/apitoken"
 	"github.com/dapr/kit/logger"
-	kitstrings "github.com/dapr/kit/strings"
+	"github.com/dapr/kit/streams"
 )
 
 var (
@@ -68,6 +72,10 @@ type server struct {
 	middleware         middleware.HTTP
 	api                API
 	apiSpec            config.APISpec
+	rateLimiter        *ratelimit.Limiter
+	accessPolicy       *policy.Engine
+	apiTokens          *apitoken.Tokens
+	drainer            *drain.Drainer
 	servers            []*http.Server
 	profilingListeners []net.Listener
 	wg                 sync.WaitGroup
@@ -75,24 +83,32 @@ type server struct {
 
 // NewServerOpts are the options for NewServer.
 type NewServerOpts struct {
-	API         API
-	Config      ServerConfig
-	TracingSpec config.TracingSpec
-	MetricSpec  config.MetricSpec
-	Middleware  middleware.HTTP
-	APISpec     config.APISpec
+	API          API
+	Config       ServerConfig
+	TracingSpec  config.TracingSpec
+	MetricSpec   config.MetricSpec
+	Middleware   middleware.HTTP
+	APISpec      config.APISpec
+	RateLimiter  *ratelimit.Limiter
+	AccessPolicy *policy.Engine
+	APITokens    *apitoken.Tokens
+	Drainer      *drain.Drainer
 }
 
 // NewServer returns a new HTTP server.
 func NewServer(opts NewServerOpts) Server {
 	infoLog.SetOutputLevel(logger.LogLevel("info"))
 	return &server{
-		api:         opts.API,
-		config:      opts.Config,
-		tracingSpec: opts.TracingSpec,
-		metricSpec:  opts.MetricSpec,
-		middleware:  opts.Middleware,
-		apiSpec:     opts.APISpec,
+		api:          opts.API,
+		config:       opts.Config,
+		tracingSpec:  opts.TracingSpec,
+		metricSpec:   opts.MetricSpec,
+		middleware:   opts.Middleware,
+		apiSpec:      opts.APISpec,
+		rateLimiter:  opts.RateLimiter,
+		accessPolicy: opts.AccessPolicy,
+		apiTokens:    opts.APITokens,
+		drainer:      opts.Drainer,
 	}
 }
 
@@ -100,18 +116,22 @@ func NewServer(opts NewServerOpts) Server {
 func (s *server) StartNonBlocking() error {
 	// Create a chi router and add middlewares
 	r := s.getRouter()
-	s.useMaxBodySize(r)
+	apiEndpoints := s.api.APIEndpoints()
+	s.logMaxBodySize()
 	s.useContextSetup(r)
+	s.useDrainTracking(r)
 	s.useTracing(r)
 	s.useMetrics(r)
 	s.useCors(r)
 	// register API authentication middleware after CORS middleware
 	s.useAPIAuthentication(r)
+	// limit the body size before the body reaches the middleware pipeline
+	s.useMaxBodySize(r, apiEndpoints)
 	s.useComponents(r)
 	s.useAPILogging(r)
 
 	// Add all routes
-	s.setupRoutes(r, s.api.APIEndpoints())
+	s.setupRoutes(r, apiEndpoints)
 
 	var listeners []net.Listener
 	var profilingListeners []net.Listener
@@ -139,10 +159,19 @@ func (s *server) StartNonBlocking() error {
 		return errors.New("could not listen on any endpoint")
 	}
 
-	// Create a handler with support for HTTP/2 Cleartext
+	tlsConfig, err := s.tlsConfig()
+	if err != nil {
+		for _, l := range listeners {
+			l.Close()
+		}
+		return err
+	}
+
 	var handler http.Handler = r
-	if !kitstrings.IsTruthy(os.Getenv("DAPR_HTTP_DISABLE_H2C")) {
-		handler = h2c.NewHandler(r, &http2.Server{})
+	if tlsConfig == nil {
+		handler = s.withH2C(r)
+	} else {
+		log.Info("HTTP server serving over TLS, negotiating HTTP/2 or HTTP/1.1 with ALPN")
 	}
 
 	for _, listener := range listeners {
@@ -154,6 +183,14 @@ func (s *server) StartNonBlocking() error {
 			MaxHeaderBytes:    s.config.ReadBufferSize,
 			Addr:              listener.Addr().String(),
 		}
+		if tlsConfig != nil {
+			srv.TLSConfig = tlsConfig.Clone()
+			// Registers the HTTP/2 server for connections negotiating h2 with ALPN
+			if err = http2.ConfigureServer(srv, &http2.Server{}); err != nil {
+				return fmt.Errorf("failed to configure HTTP/2 on the HTTP server: %w", err)
+			}
+			listener = tls.NewListener(listener, srv.TLSConfig)
+		}
 		s.servers = append(s.servers, srv)
 
 		s.wg.Add(1)
@@ -171,12 +208,14 @@ func (s *server) StartNonBlocking() error {
 		s.useContextSetup(publicR)
 		s.useTracing(publicR)
 		s.useMetrics(publicR)
+		publicEndpoints := s.api.PublicEndpoints()
+		s.useMaxBodySize(publicR, publicEndpoints)
 
-		s.setupRoutes(publicR, s.api.PublicEndpoints())
+		s.setupRoutes(publicR, publicEndpoints)
 
 		healthServer := &http.Server{
 			Addr:              fmt.Sprintf("%s:%d", s.config.PublicListenAddress, *s.config.PublicPort),
-			Handler:           publicR,
+			Handler:           s.withH2C(publicR),
 			ReadHeaderTimeout: 10 * time.Second,
 			MaxHeaderBytes:    s.config.ReadBufferSize,
 		}
@@ -264,12 +303,62 @@ func (s *server) Close() error {
 	return errors.Join(errs...)
 }
 
+// tlsConfig returns the TLS configuration of the API server, or nil if it's
+// served over cleartext connections. The protocol of the connections is
+// negotiated with ALPN, preferring HTTP/2 over HTTP/1.1.
+func (s *server) tlsConfig() (*tls.Config, error) {
+	if s.config.TLSCertFile == "" && s.config.TLSKeyFile == "" {
+		return nil, nil
+	}
+	if s.config.TLSCertFile == "" || s.config.TLSKeyFile == "" {
+		return nil, errors.New("both the certificate and the private key files are required to serve the HTTP API over TLS")
+	}
+
+	cert, err := tls.LoadX509KeyPair(s.config.TLSCertFile, s.config.TLSKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the certificate of the HTTP server: %w", err)
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		NextProtos:   []string{http2.NextProtoTLS, "http/1.1"},
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// withH2C returns a handler with support for HTTP/2 Cleartext, both with prior
+// knowledge and via the HTTP/1.1 Upgrade header, unless disabled.
+func (s *server) withH2C(handler http.Handler) http.Handler {
+	if s.config.DisableH2C {
+		return handler
+	}
+	return h2c.NewHandler(handler, &http2.Server{})
+}
+
 func (s *server) getRouter() *chi.Mux {
 	r := chi.NewRouter()
 	r.Use(CleanPathMiddleware, StripSlashesMiddleware)
 	return r
 }
 
+// useDrainTracking tracks the API requests as in-flight requests, so the drain
+// waits for them. Requests are accepted while draining, as the app may need
+// them to complete its work in progress. Upgraded connections and event
+// streams are long-lived, and aren't tracked.
+func (s *server) useDrainTracking(r chi.Router) {
+	if s.drainer == nil {
+		return
+	}
+
+	r.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Upgrade") == "" && !strings.Contains(r.Header.Get("Accept"), "text/event-stream") {
+				defer s.drainer.TrackAlways()()
+			}
+			next.ServeHTTP(w, r)
+		})
+	})
+}
+
 func (s *server) useTracing(r chi.Router) {
 	if !diagUtils.IsTracingEnabled(s.tracingSpec.SamplingRate) {
 		return
@@ -290,14 +379,99 @@ func (s *server) useMetrics(r chi.Router) {
 	r.Use(diag.DefaultHTTPMonitoring.HTTPMiddleware)
 }
 
-func (s *server) useMaxBodySize(r chi.Router) {
-	if s.config.MaxRequestBodySize <= 0 {
-		return
+func (s *server) logMaxBodySize() {
+	if s.config.MaxRequestBodySize > 0 {
+		log.Infof("Enabled max body size HTTP middleware with size %d bytes", s.config.MaxRequestBodySize)
+	}
+	for group, size := range s.config.MaxRequestBodySizePerAPI {
+		log.Infof("Enabled max body size HTTP middleware for the %s API with size %d bytes", group, size)
 	}
+}
 
-	log.Infof("Enabled max body size HTTP middleware with size %d bytes", s.config.MaxRequestBodySize)
+// maxBodySize returns the max size of the request body for the endpoint, in bytes.
+// Streaming endpoints aren't limited by the global max size, as their body
+// isn't buffered, unless a max size is set for their API.
+func (s *server) maxBodySize(e endpoints.Endpoint) int {
+	if e.Group != nil {
+		if size, ok := s.config.MaxRequestBodySizePerAPI[string(e.Group.Name)]; ok {
+			return size
+		}
+	}
+	if e.Settings.IsStreaming {
+		return 0
+	}
+	return s.config.MaxRequestBodySize
+}
 
-	r.Use(MaxBodySizeMiddleware(int64(s.config.MaxRequestBodySize)))
+// bodySizeLimit is the max size of the request body of an endpoint.
+type bodySizeLimit struct {
+	api     string
+	maxSize int // In bytes; 0 means no limit
+}
+
+// useMaxBodySize limits the size of the request body at the router level, so
+// the limits are enforced before the body reaches the middleware pipeline of
+// the app. The endpoint is resolved from the request path to apply the limit
+// of its API, rejecting requests whose declared content length exceeds the
+// limit upfront.
+func (s *server) useMaxBodySize(r chi.Router, eps []endpoints.Endpoint) {
+	defaultLimit := bodySizeLimit{api: "Dapr", maxSize: s.config.MaxRequestBodySize}
+
+	// Routes mirrors the API routes, to find the endpoint of a request before
+	// it's routed.
+	routes := chi.NewRouter()
+	limits := make(map[string]bodySizeLimit)
+	noop := http.HandlerFunc(func(http.ResponseWriter, *http.Request) {})
+	for _, e := range eps {
+		path := "/" + e.Version + "/" + e.Route
+		limit := bodySizeLimit{api: defaultLimit.api, maxSize: s.maxBodySize(e)}
+		if e.Group != nil {
+			limit.api = string(e.Group.Name)
+		}
+		// Requests not matching any route are handled by the fallback endpoint
+		if e.Settings.IsFallback {
+			defaultLimit = limit
+		}
+		if len(e.Methods) == 0 {
+			routes.Handle(path, noop)
+			limits[path] = limit
+			continue
+		}
+		for _, m := range e.Methods {
+			routes.Method(m, path, noop)
+			limits[m+" "+path] = limit
+		}
+	}
+
+	r.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			limit := defaultLimit
+			if pattern := routes.Find(chi.NewRouteContext(), r.Method, routePath(r)); pattern != "" {
+				if l, ok := limits[r.Method+" "+pattern]; ok {
+					limit = l
+				} else if l, ok := limits[pattern]; ok {
+					limit = l
+				}
+			}
+
+			if limit.maxSize > 0 {
+				if r.ContentLength > int64(limit.maxSize) {
+					respondWithError(w, messages.ErrBodyTooLarge.WithFormat(limit.maxSize, limit.api))
+					return
+				}
+				r.Body = streams.LimitReadCloser(r.Body, int64(limit.maxSize))
+			}
+			next.ServeHTTP(w, r)
+		})
+	})
+}
+
+// routePath returns the path the request is routed on.
+func routePath(r *http.Request) string {
+	if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePath != "" {
+		return rctx.RoutePath
+	}
+	return r.URL.EscapedPath()
 }
 
 func (s *server) useContextSetup(mux chi.Router) {
@@ -388,13 +562,12 @@ func (s *server) useCors(r chi.Router) {
 }
 
 func (s *server) useAPIAuthentication(r chi.Router) {
-	token := security.GetAPIToken()
-	if token == "" {
+	if !s.apiTokens.Enabled() {
 		return
 	}
 
 	log.Info("Enabled token authentication on HTTP server")
-	r.Use(APITokenAuthMiddleware(token))
+	r.Use(APITokenAuthMiddleware(s.apiTokens))
 }
 
 func (s *server) unescapeRequestParametersHandler(next http.Handler) http.HandlerFunc {
@@ -433,7 +606,8 @@ func (s *server) setupRoutes(r chi.Router, endpoints []endpoints.Endpoint) {
 	deniedAPIs := s.apiSpec.Denied.GetRulesByProtocol(config.APIAccessRuleProtocolHTTP)
 
 	for _, e := range endpoints {
-		if !e.IsAllowed(allowedAPIs, deniedAPIs) {
+		// When the access policy is enabled, the rules are evaluated on each request instead
+		if s.accessPolicy == nil && !e.IsAllowed(allowedAPIs, deniedAPIs) {
 			continue
 		}
 
@@ -445,6 +619,51 @@ func (s *server) setupRoutes(r chi.Router, endpoints []endpoints.Endpoint) {
 	}
 }
 
+// rateLimitHandler rejects requests once the rate limit of the API group is exceeded.
+func (s *server) rateLimitHandler(group string, next http.Handler) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !s.rateLimiter.Allow(group) {
+			respondWithError(w, messages.ErrAPIRateLimited.WithFormat(group))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// apiTokenRequiredHandler rejects requests to administrative endpoints when
+// the Dapr API token is not enabled, as they would otherwise be
+// unauthenticated.
+func apiTokenRequiredHandler(w http.ResponseWriter, r *http.Request) {
+	respondWithError(w, messages.ErrAPITokenRequired)
+}
+
+// accessPolicyHandler rejects requests that are not allowed by the API access policy.
+func (s *server) accessPolicyHandler(e endpoints.Endpoint, next http.Handler) http.HandlerFunc {
+	route := e.Version + "/" + e.Route
+	var name, version string
+	if e.Group != nil {
+		name = string(e.Group.Name)
+		version = string(e.Group.Version)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		allowed := s.accessPolicy.Allow(policy.Request{
+			Protocol: config.APIAccessRuleProtocolHTTP,
+			Name:     name,
+			Version:  version,
+			Route:    route,
+			Verb:     r.Method,
+			Path:     r.URL.Path,
+			Metadata: r.Header.Get,
+		})
+		if !allowed {
+			respondWithError(w, messages.ErrAPIAccessDenied)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
 // Add information about the route in the context's value.
 func (s *server) addEndpointCtx(e endpoints.Endpoint, next http.Handler) http.HandlerFunc {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -466,6 +685,18 @@ func (s *server) handle(e endpoints.Endpoint, path string, r chi.Router, unescap
 		handler = s.unescapeRequestParametersHandler(handler)
 	}
 
+	if e.Group != nil && s.rateLimiter.Has(string(e.Group.Name)) {
+		handler = s.rateLimitHandler(string(e.Group.Name), handler)
+	}
+
+	if s.accessPolicy != nil && !e.Settings.AlwaysAllowed {
+		handler = s.accessPolicyHandler(e, handler)
+	}
+
+	if e.Settings.RequiresAPIToken && !s.apiTokens.Enabled() {
+		handler = apiTokenRequiredHandler
+	}
+
 	handler = s.addEndpointCtx(e, handler)
 
 	// If no method is defined, match any method
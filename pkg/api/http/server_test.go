@@ -16,12 +16,23 @@ package http
 import (
 	"bytes"
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"io"
+	"math/big"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -29,10 +40,14 @@ import (
 	"github.com/phayes/freeport"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
 
 	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	"github.com/dapr/dapr/pkg/api/policy"
+	"github.com/dapr/dapr/pkg/api/ratelimit"
 	"github.com/dapr/dapr/pkg/config"
 	"github.com/dapr/dapr/pkg/cors"
+	"github.com/dapr/dapr/pkg/security/apitoken"
 	dapr_testing "github.com/dapr/dapr/pkg/testing"
 	"github.com/dapr/kit/logger"
 )
@@ -87,7 +102,7 @@ func TestCorsHandler(t *testing.T) {
 		h := chi.NewRouter()
 		srv.useCors(h)
 		// register API authentication middleware after CORS middleware
-		h.Use(APITokenAuthMiddleware("test"))
+		h.Use(APITokenAuthMiddleware(apitoken.Static("test")))
 		h.Get("/", hf)
 
 		t.Run("OPTIONS request, without api token", func(t *testing.T) {
@@ -145,6 +160,244 @@ func TestCorsHandler(t *testing.T) {
 	})
 }
 
+func TestRateLimitHandler(t *testing.T) {
+	limiter, err := ratelimit.New([]config.APIRateLimit{{Name: "state", RequestsPerSecond: 1}})
+	require.NoError(t, err)
+
+	mh := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	srv := newServer()
+	srv.rateLimiter = limiter
+	router := chi.NewRouter()
+	srv.setupRoutes(router, []endpoints.Endpoint{
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "state/{storeName}/{key}",
+			Version: apiVersionV1,
+			Group:   &endpoints.EndpointGroup{Name: endpoints.EndpointGroupState},
+			Handler: mh,
+		},
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "secrets/{secretStoreName}/{key}",
+			Version: apiVersionV1,
+			Group:   &endpoints.EndpointGroup{Name: endpoints.EndpointGroupSecrets},
+			Handler: mh,
+		},
+	})
+
+	serve := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w
+	}
+
+	assert.Equal(t, http.StatusNoContent, serve("/v1.0/state/store/key").Code)
+
+	w := serve("/v1.0/state/store/key")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Contains(t, w.Body.String(), "ERR_TOO_MANY_REQUESTS")
+	assert.Contains(t, w.Body.String(), "rate limit exceeded for the state API")
+
+	// Other API groups are not limited
+	assert.Equal(t, http.StatusNoContent, serve("/v1.0/secrets/store/key").Code)
+	assert.Equal(t, http.StatusNoContent, serve("/v1.0/secrets/store/key").Code)
+}
+
+func TestAccessPolicyHandler(t *testing.T) {
+	engine, err := policy.New(config.APISpec{
+		Allowed: config.APIAccessRules{
+			{Name: "state", Version: "v1", Protocol: "http", Verbs: []string{http.MethodGet}},
+			{Name: "secrets", Version: "v1", Protocol: "http", Metadata: map[string]string{"x-tenant": "t1"}},
+		},
+		DefaultAction: config.APIAccessDefaultActionDeny,
+	})
+	require.NoError(t, err)
+
+	mh := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	srv := newServer()
+	srv.accessPolicy = engine
+	router := chi.NewRouter()
+	srv.setupRoutes(router, []endpoints.Endpoint{
+		{
+			Methods: []string{http.MethodGet, http.MethodPost},
+			Route:   "state/{storeName}",
+			Version: apiVersionV1,
+			Group:   &endpoints.EndpointGroup{Name: endpoints.EndpointGroupState, Version: endpoints.EndpointGroupVersion1},
+			Handler: mh,
+		},
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "secrets/{secretStoreName}/{key}",
+			Version: apiVersionV1,
+			Group:   &endpoints.EndpointGroup{Name: endpoints.EndpointGroupSecrets, Version: endpoints.EndpointGroupVersion1},
+			Handler: mh,
+		},
+		{
+			Methods:  []string{http.MethodGet},
+			Route:    "healthz",
+			Version:  apiVersionV1,
+			Handler:  mh,
+			Settings: endpoints.EndpointSettings{AlwaysAllowed: true},
+		},
+	})
+
+	serve := func(method, path string, header http.Header) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(method, path, nil)
+		for k, v := range header {
+			r.Header[k] = v
+		}
+		router.ServeHTTP(w, r)
+		return w
+	}
+
+	assert.Equal(t, http.StatusNoContent, serve(http.MethodGet, "/v1.0/state/store", nil).Code)
+
+	w := serve(http.MethodPost, "/v1.0/state/store", nil)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Contains(t, w.Body.String(), "ERR_API_ACCESS_DENIED")
+
+	assert.Equal(t, http.StatusNoContent, serve(http.MethodGet, "/v1.0/secrets/store/key", http.Header{"X-Tenant": {"t1"}}).Code)
+	assert.Equal(t, http.StatusForbidden, serve(http.MethodGet, "/v1.0/secrets/store/key", http.Header{"X-Tenant": {"t2"}}).Code)
+
+	// Endpoints that are always allowed are not affected by the default action
+	assert.Equal(t, http.StatusNoContent, serve(http.MethodGet, "/v1.0/healthz", nil).Code)
+}
+
+func TestMaxBodySizePerAPI(t *testing.T) {
+	mh := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	srv := newServer()
+	srv.config.MaxRequestBodySize = 100
+	srv.config.MaxRequestBodySizePerAPI = map[string]int{
+		"state":   10,
+		"secrets": 0,
+	}
+	eps := []endpoints.Endpoint{
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "state/{storeName}",
+			Version: apiVersionV1,
+			Group:   &endpoints.EndpointGroup{Name: endpoints.EndpointGroupState},
+			Handler: mh,
+		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "publish/{pubsubname}/{topic}",
+			Version: apiVersionV1,
+			Group:   &endpoints.EndpointGroup{Name: endpoints.EndpointGroupPubsub},
+			Handler: mh,
+		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "secrets/{secretStoreName}",
+			Version: apiVersionV1,
+			Group:   &endpoints.EndpointGroup{Name: endpoints.EndpointGroupSecrets},
+			Handler: mh,
+		},
+		{
+			Methods:  []string{http.MethodPost},
+			Route:    "crypto/{name}/encrypt/stream",
+			Version:  apiVersionV1alpha1,
+			Group:    &endpoints.EndpointGroup{Name: endpoints.EndpointGroupCrypto},
+			Handler:  mh,
+			Settings: endpoints.EndpointSettings{IsStreaming: true},
+		},
+		{
+			Methods:  []string{http.MethodPost},
+			Route:    "state/{storeName}/stream",
+			Version:  apiVersionV1,
+			Group:    &endpoints.EndpointGroup{Name: endpoints.EndpointGroupState},
+			Handler:  mh,
+			Settings: endpoints.EndpointSettings{IsStreaming: true},
+		},
+	}
+
+	// The middleware pipeline reads the body before the API handler
+	var pipelineCalls atomic.Int32
+	router := chi.NewRouter()
+	srv.useMaxBodySize(router, eps)
+	router.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			pipelineCalls.Add(1)
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				w.WriteHeader(http.StatusRequestEntityTooLarge)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			next.ServeHTTP(w, r)
+		})
+	})
+	srv.setupRoutes(router, eps)
+
+	serve := func(path string, size int) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, path, strings.NewReader(strings.Repeat("a", size))))
+		return w
+	}
+
+	t.Run("per-API limit", func(t *testing.T) {
+		assert.Equal(t, http.StatusNoContent, serve("/v1.0/state/store", 10).Code)
+
+		w := serve("/v1.0/state/store", 11)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+		assert.Contains(t, w.Body.String(), "ERR_BODY_TOO_LARGE")
+		assert.Contains(t, w.Body.String(), "request body exceeds the limit of 10 bytes for the state API")
+	})
+
+	t.Run("default limit", func(t *testing.T) {
+		assert.Equal(t, http.StatusNoContent, serve("/v1.0/publish/pubsub/topic", 100).Code)
+
+		w := serve("/v1.0/publish/pubsub/topic", 101)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+		assert.Contains(t, w.Body.String(), "for the publish API")
+	})
+
+	t.Run("unlimited API", func(t *testing.T) {
+		assert.Equal(t, http.StatusNoContent, serve("/v1.0/secrets/store", 1000).Code)
+	})
+
+	t.Run("streaming endpoint", func(t *testing.T) {
+		// Not limited by the default limit
+		assert.Equal(t, http.StatusNoContent, serve("/v1.0-alpha1/crypto/store/encrypt/stream", 1000).Code)
+
+		// Limited by the limit of its API
+		assert.Equal(t, http.StatusRequestEntityTooLarge, serve("/v1.0/state/store/stream", 11).Code)
+	})
+
+	t.Run("limit is enforced before the middleware pipeline", func(t *testing.T) {
+		pipelineCalls.Store(0)
+		w := serve("/v1.0/state/store", 11)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+		assert.Zero(t, pipelineCalls.Load())
+
+		// Without a declared content length, the pipeline can't read past the limit
+		w = httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/v1.0/state/store", strings.NewReader(strings.Repeat("a", 11)))
+		req.ContentLength = -1
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+		assert.Equal(t, int32(1), pipelineCalls.Load())
+	})
+
+	t.Run("unknown routes use the default limit", func(t *testing.T) {
+		w := serve("/v1.0/unknown", 101)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+		assert.Contains(t, w.Body.String(), "for the Dapr API")
+	})
+}
+
 func TestUnescapeRequestParametersHandler(t *testing.T) {
 	mh := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		var param string
@@ -547,3 +800,147 @@ func TestClose(t *testing.T) {
 		require.NoError(t, server.Close())
 	})
 }
+
+func TestH2C(t *testing.T) {
+	// Client that speaks HTTP/2 over cleartext connections with prior knowledge
+	h2cClient := &http.Client{
+		Transport: &http2.Transport{
+			AllowHTTP: true,
+			DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, network, addr)
+			},
+		},
+	}
+
+	startServer := func(t *testing.T, disableH2C bool) (int, int) {
+		t.Helper()
+		ports, err := freeport.GetFreePorts(2)
+		require.NoError(t, err)
+		server := NewServer(NewServerOpts{
+			API: &api{},
+			Config: ServerConfig{
+				AppID:               "test",
+				HostAddress:         "127.0.0.1",
+				Port:                ports[0],
+				APIListenAddresses:  []string{"127.0.0.1"},
+				PublicPort:          &ports[1],
+				PublicListenAddress: "127.0.0.1",
+				ReadBufferSize:      4 << 10,
+				DisableH2C:          disableH2C,
+			},
+			Middleware: func(n http.Handler) http.Handler { return n },
+		})
+		require.NoError(t, server.StartNonBlocking())
+		t.Cleanup(func() { require.NoError(t, server.Close()) })
+		dapr_testing.WaitForListeningAddress(t, 5*time.Second, fmt.Sprintf("127.0.0.1:%d", ports[0]), fmt.Sprintf("127.0.0.1:%d", ports[1]))
+		return ports[0], ports[1]
+	}
+
+	t.Run("api and public servers accept h2c", func(t *testing.T) {
+		port, publicPort := startServer(t, false)
+		for _, p := range []int{port, publicPort} {
+			resp, err := h2cClient.Get(fmt.Sprintf("http://127.0.0.1:%d/", p))
+			require.NoError(t, err)
+			resp.Body.Close()
+			assert.Equal(t, 2, resp.ProtoMajor)
+		}
+	})
+
+	t.Run("h2c disabled", func(t *testing.T) {
+		port, publicPort := startServer(t, true)
+		for _, p := range []int{port, publicPort} {
+			_, err := h2cClient.Get(fmt.Sprintf("http://127.0.0.1:%d/", p))
+			require.Error(t, err)
+
+			resp, err := http.Get(fmt.Sprintf("http://127.0.0.1:%d/", p))
+			require.NoError(t, err)
+			resp.Body.Close()
+			assert.Equal(t, 1, resp.ProtoMajor)
+		}
+	})
+}
+
+func TestTLS(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}), 0o600))
+
+	cert, err := x509.ParseCertificate(certDER)
+	require.NoError(t, err)
+	roots := x509.NewCertPool()
+	roots.AddCert(cert)
+
+	port, err := freeport.GetFreePort()
+	require.NoError(t, err)
+	server := NewServer(NewServerOpts{
+		API: &api{},
+		Config: ServerConfig{
+			AppID:              "test",
+			HostAddress:        "127.0.0.1",
+			Port:               port,
+			APIListenAddresses: []string{"127.0.0.1"},
+			ReadBufferSize:     4 << 10,
+			TLSCertFile:        certFile,
+			TLSKeyFile:         keyFile,
+		},
+		Middleware: func(n http.Handler) http.Handler { return n },
+	})
+	require.NoError(t, server.StartNonBlocking())
+	t.Cleanup(func() { require.NoError(t, server.Close()) })
+	dapr_testing.WaitForListeningAddress(t, 5*time.Second, fmt.Sprintf("127.0.0.1:%d", port))
+
+	for name, tc := range map[string]struct {
+		transport  http.RoundTripper
+		protoMajor int
+	}{
+		"http/2 is negotiated with ALPN": {
+			transport:  &http2.Transport{TLSClientConfig: &tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12}},
+			protoMajor: 2,
+		},
+		"http/1.1 clients are served": {
+			transport:  &http.Transport{TLSClientConfig: &tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12}},
+			protoMajor: 1,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp, err := (&http.Client{Transport: tc.transport}).Get(fmt.Sprintf("https://127.0.0.1:%d/", port))
+			require.NoError(t, err)
+			resp.Body.Close()
+			assert.Equal(t, tc.protoMajor, resp.ProtoMajor)
+		})
+	}
+
+	t.Run("cleartext connections are rejected", func(t *testing.T) {
+		resp, err := http.Get(fmt.Sprintf("http://127.0.0.1:%d/", port))
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("certificate without a key", func(t *testing.T) {
+		server := NewServer(NewServerOpts{
+			API: &api{},
+			Config: ServerConfig{
+				Port:               port + 1,
+				APIListenAddresses: []string{"127.0.0.1"},
+				TLSCertFile:        certFile,
+			},
+		})
+		require.Error(t, server.StartNonBlocking())
+	})
+}
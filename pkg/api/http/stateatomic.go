@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"encoding/json"
+	"maps"
+	nethttp "net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	apierrors "github.com/dapr/dapr/pkg/api/errors"
+	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+)
+
+var endpointGroupStateV1Alpha1 = &endpoints.EndpointGroup{
+	Name:                 endpoints.EndpointGroupState,
+	Version:              endpoints.EndpointGroupVersion1alpha1,
+	AppendSpanAttributes: appendStateSpanAttributes,
+}
+
+func (a *api) constructStateAtomicEndpoints() []endpoints.Endpoint {
+	return []endpoints.Endpoint{
+		{
+			Methods: []string{nethttp.MethodPost},
+			Route:   "state/{storeName}/{key}/increment",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupStateV1Alpha1,
+			Handler: a.onIncrementStateHandler(),
+			Settings: endpoints.EndpointSettings{
+				Name: "IncrementStateAlpha1",
+			},
+		},
+		{
+			Methods: []string{nethttp.MethodPost},
+			Route:   "state/{storeName}/{key}/append",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupStateV1Alpha1,
+			Handler: a.onAppendStateHandler(),
+			Settings: endpoints.EndpointSettings{
+				Name: "AppendStateAlpha1",
+			},
+		},
+	}
+}
+
+// onIncrementStateHandler returns the handler which atomically adds a delta to
+// the integer value of a key, and responds with the new value.
+func (a *api) onIncrementStateHandler() nethttp.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.IncrementStateAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.IncrementStateRequestAlpha1, *runtimev1pb.IncrementStateResponseAlpha1]{
+			SkipInputBody: true,
+			InModifier: func(r *nethttp.Request, in *runtimev1pb.IncrementStateRequestAlpha1) (*runtimev1pb.IncrementStateRequestAlpha1, error) {
+				var body StateIncrementRequest
+				if r.ContentLength != 0 {
+					if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+						nerr := apierrors.StateStore(chi.URLParam(r, storeNameParam)).AtomicInvalid("increment", chi.URLParam(r, stateKeyParam), err.Error())
+						log.Debug(nerr)
+						return nil, nerr
+					}
+				}
+				in.StoreName = chi.URLParam(r, storeNameParam)
+				in.Key = chi.URLParam(r, stateKeyParam)
+				in.Delta = body.Delta
+				in.Metadata = getMetadataFromRequest(r)
+				maps.Copy(in.Metadata, body.Metadata)
+				return in, nil
+			},
+			OutModifier: func(out *runtimev1pb.IncrementStateResponseAlpha1) (any, error) {
+				return StateIncrementResponse{Value: out.GetValue()}, nil
+			},
+		},
+	)
+}
+
+// onAppendStateHandler returns the handler which atomically appends values to
+// the JSON array value of a key, and responds with the new length of the array.
+func (a *api) onAppendStateHandler() nethttp.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.AppendStateAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.AppendStateRequestAlpha1, *runtimev1pb.AppendStateResponseAlpha1]{
+			SkipInputBody: true,
+			InModifier: func(r *nethttp.Request, in *runtimev1pb.AppendStateRequestAlpha1) (*runtimev1pb.AppendStateRequestAlpha1, error) {
+				var body StateAppendRequest
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					nerr := apierrors.StateStore(chi.URLParam(r, storeNameParam)).AtomicInvalid("append", chi.URLParam(r, stateKeyParam), err.Error())
+					log.Debug(nerr)
+					return nil, nerr
+				}
+				in.StoreName = chi.URLParam(r, storeNameParam)
+				in.Key = chi.URLParam(r, stateKeyParam)
+				in.Values = make([][]byte, len(body.Values))
+				for i, v := range body.Values {
+					in.Values[i] = v
+				}
+				in.Metadata = getMetadataFromRequest(r)
+				maps.Copy(in.Metadata, body.Metadata)
+				return in, nil
+			},
+			OutModifier: func(out *runtimev1pb.AppendStateResponseAlpha1) (any, error) {
+				return StateAppendResponse{Length: int(out.GetLength())}, nil
+			},
+		},
+	)
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/api/universal"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+	"github.com/dapr/kit/logger"
+)
+
+func TestStateAtomicEndpoints(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	fakeStore := daprt.NewFakeStateStore()
+	compStore := compstore.New()
+	compStore.AddStateStore("store1", fakeStore)
+	testAPI := &api{
+		universal: universal.New(universal.Options{
+			AppID:      "fakeAPI",
+			Logger:     logger.NewLogger("fakeLogger"),
+			CompStore:  compStore,
+			Resiliency: resiliency.New(nil),
+		}),
+	}
+	fakeServer.StartServer(testAPI.constructStateAtomicEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	t.Run("increment new key - 200", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/state/store1/counter/increment", []byte(`{"delta": -3}`), nil)
+		require.Equal(t, 200, resp.StatusCode, string(resp.RawBody))
+		assert.JSONEq(t, `{"value": -3}`, string(resp.RawBody))
+	})
+
+	t.Run("increment defaults to 1 - 200", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/state/store1/other/increment", nil, nil)
+		require.Equal(t, 200, resp.StatusCode, string(resp.RawBody))
+		assert.JSONEq(t, `{"value": 1}`, string(resp.RawBody))
+	})
+
+	t.Run("increment non-integer value - 400", func(t *testing.T) {
+		require.NoError(t, fakeStore.Set(t.Context(), &state.SetRequest{Key: "fakeAPI||text", Value: "abc"}))
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/state/store1/text/increment", nil, nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_STATE_ATOMIC_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("append - 200", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/state/store1/list/append", []byte(`{"values": [1, "a"]}`), nil)
+		require.Equal(t, 200, resp.StatusCode, string(resp.RawBody))
+		assert.JSONEq(t, `{"length": 2}`, string(resp.RawBody))
+	})
+
+	t.Run("append without values - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/state/store1/list/append", []byte(`{}`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_STATE_ATOMIC_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("store not found - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/state/nostore/counter/increment", nil, nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_STATE_STORE_NOT_FOUND", resp.ErrorBody["errorCode"])
+	})
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	nethttp "net/http"
+	"strconv"
+	"time"
+
+	"github.com/dapr/components-contrib/state"
+	apierrors "github.com/dapr/dapr/pkg/api/errors"
+	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/encryption"
+	"github.com/dapr/dapr/pkg/messages"
+	"github.com/dapr/dapr/pkg/messages/errorcodes"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/statecache"
+)
+
+func (a *api) constructStateBulkEndpoints() []endpoints.Endpoint {
+	return []endpoints.Endpoint{
+		{
+			Methods: []string{nethttp.MethodPost},
+			Route:   "state/{storeName}/bulk/set",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupStateV1Alpha1,
+			Handler: a.onBulkSetState,
+			Settings: endpoints.EndpointSettings{
+				Name: "BulkSetStateAlpha1",
+			},
+		},
+		{
+			Methods: []string{nethttp.MethodPost},
+			Route:   "state/{storeName}/bulk/delete",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupStateV1Alpha1,
+			Handler: a.onBulkDeleteState,
+			Settings: endpoints.EndpointSettings{
+				Name: "BulkDeleteStateAlpha1",
+			},
+		},
+	}
+}
+
+// onBulkSetState saves multiple values, each with its own ETag, TTL and
+// options, and responds with the result of each item.
+func (a *api) onBulkSetState(w nethttp.ResponseWriter, r *nethttp.Request) {
+	store, storeName, err := a.getStateStoreWithRequestValidation(w, r)
+	if err != nil {
+		log.Debug(err)
+		return
+	}
+
+	var in BulkSetStateRequest
+	if err = json.NewDecoder(r.Body).Decode(&in); err != nil {
+		msg := messages.ErrMalformedRequest.WithFormat(err)
+		respondWithError(w, msg)
+		log.Debug(msg)
+		return
+	}
+
+	metadata := getMetadataFromRequest(r)
+	reqs := make([]state.SetRequest, len(in.Items))
+	for i, item := range in.Items {
+		if item.Key == "" {
+			resp := messages.NewAPIErrorHTTP(`"key" is a required field`, errorcodes.CommonMalformedRequest, nethttp.StatusBadRequest)
+			respondWithError(w, resp)
+			log.Debug(resp)
+			return
+		}
+
+		reqs[i] = item.SetRequest
+		reqs[i].Metadata = mergeBulkItemMetadata(item.Metadata, metadata)
+		if item.TTLInSeconds != nil {
+			reqs[i].Metadata["ttlInSeconds"] = strconv.FormatInt(*item.TTLInSeconds, 10)
+		}
+
+		reqs[i].Key, err = stateLoader.GetModifiedStateKeyWithMetadata(item.Key, storeName, a.universal.AppID(), reqs[i].Metadata)
+		if err != nil {
+			status := apierrors.StateStore(storeName).InvalidKeyName(item.Key, err.Error())
+			respondWithError(w, status)
+			log.Debug(status)
+			return
+		}
+
+		val, encErr := encryption.EncodeStateValue(storeName, reqs[i].Key, item.Value)
+		if encErr != nil {
+			statusCode, errMsg := a.stateErrorResponse(encErr)
+			apiResp := messages.NewAPIErrorHTTP(fmt.Sprintf(messages.ErrStateSave, storeName, errMsg), errorcodes.StateSave, statusCode)
+			respondWithError(w, apiResp)
+			log.Debug(apiResp)
+			return
+		}
+		reqs[i].Value = val
+	}
+
+	start := time.Now()
+	results := stateLoader.PerformBulkStoreOperationWithResults(r.Context(), reqs,
+		a.universal.Resiliency().ComponentOutboundPolicy(storeName, resiliency.Statestore),
+		bulkStoreOpts(in.Parallelism),
+		store.Set,
+		store.BulkSet,
+	)
+	elapsed := diag.ElapsedSince(start)
+
+	a.universal.CompStore().GetStateCache(storeName).Invalidate(r.Context(), statecache.Keys(reqs)...)
+
+	a.respondWithBulkStateResults(w, r, storeName, diag.BulkSet, results, elapsed)
+}
+
+// onBulkDeleteState deletes multiple keys, each with its own ETag and
+// options, and responds with the result of each item.
+func (a *api) onBulkDeleteState(w nethttp.ResponseWriter, r *nethttp.Request) {
+	store, storeName, err := a.getStateStoreWithRequestValidation(w, r)
+	if err != nil {
+		log.Debug(err)
+		return
+	}
+
+	var in BulkDeleteStateRequest
+	if err = json.NewDecoder(r.Body).Decode(&in); err != nil {
+		msg := messages.ErrMalformedRequest.WithFormat(err)
+		respondWithError(w, msg)
+		log.Debug(msg)
+		return
+	}
+
+	metadata := getMetadataFromRequest(r)
+	reqs := make([]state.DeleteRequest, len(in.Items))
+	for i, item := range in.Items {
+		if item.Key == "" {
+			resp := messages.NewAPIErrorHTTP(`"key" is a required field`, errorcodes.CommonMalformedRequest, nethttp.StatusBadRequest)
+			respondWithError(w, resp)
+			log.Debug(resp)
+			return
+		}
+
+		reqs[i] = item
+		reqs[i].Metadata = mergeBulkItemMetadata(item.Metadata, metadata)
+		reqs[i].Key, err = stateLoader.GetModifiedStateKeyWithMetadata(item.Key, storeName, a.universal.AppID(), reqs[i].Metadata)
+		if err != nil {
+			status := apierrors.StateStore(storeName).InvalidKeyName(item.Key, err.Error())
+			respondWithError(w, status)
+			log.Debug(status)
+			return
+		}
+	}
+
+	start := time.Now()
+	results := stateLoader.PerformBulkStoreOperationWithResults(r.Context(), reqs,
+		a.universal.Resiliency().ComponentOutboundPolicy(storeName, resiliency.Statestore),
+		bulkStoreOpts(in.Parallelism),
+		store.Delete,
+		store.BulkDelete,
+	)
+	elapsed := diag.ElapsedSince(start)
+
+	a.universal.CompStore().GetStateCache(storeName).Invalidate(r.Context(), statecache.Keys(reqs)...)
+
+	a.respondWithBulkStateResults(w, r, storeName, diag.BulkDelete, results, elapsed)
+}
+
+func (a *api) respondWithBulkStateResults(w nethttp.ResponseWriter, r *nethttp.Request, storeName, operation string, results []stateLoader.BulkItemResult, elapsed float64) {
+	resp := BulkStateResponse{
+		Results: make([]BulkStateItemResult, len(results)),
+	}
+	success := true
+	for i, res := range results {
+		resp.Results[i].Key = stateLoader.GetOriginalStateKey(res.Key)
+		if res.Error != nil {
+			success = false
+			_, resp.Results[i].Error = a.stateErrorResponse(res.Error)
+		}
+	}
+
+	diag.DefaultComponentMonitoring.StateInvoked(r.Context(), storeName, operation, success, elapsed)
+
+	respondWithJSON(w, nethttp.StatusOK, resp)
+}
+
+// mergeBulkItemMetadata returns the metadata of an item, with the metadata
+// from the URL query parameters merged in.
+func mergeBulkItemMetadata(item, query map[string]string) map[string]string {
+	md := make(map[string]string, len(item)+len(query)+1)
+	maps.Copy(md, item)
+	maps.Copy(md, query)
+	return md
+}
+
+func bulkStoreOpts(parallelism int) state.BulkStoreOpts {
+	if parallelism <= 0 {
+		parallelism = stateLoader.DefaultBulkParallelism
+	}
+	return state.BulkStoreOpts{Parallelism: parallelism}
+}
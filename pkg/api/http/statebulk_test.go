@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/api/universal"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+	"github.com/dapr/kit/logger"
+)
+
+// etagCheckingStateStore fails the items of bulk sets with a "bad" ETag.
+type etagCheckingStateStore struct {
+	*daprt.FakeStateStore
+
+	reqs []state.SetRequest
+	opts state.BulkStoreOpts
+}
+
+func (s *etagCheckingStateStore) BulkSet(ctx context.Context, reqs []state.SetRequest, opts state.BulkStoreOpts) error {
+	s.reqs = reqs
+	s.opts = opts
+
+	var errs []error
+	for _, req := range reqs {
+		if req.ETag != nil && *req.ETag == "bad" {
+			errs = append(errs, state.NewBulkStoreError(req.Key, state.NewETagError(state.ETagMismatch, nil)))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func TestBulkStateEndpoints(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	store := &etagCheckingStateStore{FakeStateStore: daprt.NewFakeStateStore()}
+	compStore := compstore.New()
+	compStore.AddStateStore("store1", store)
+	testAPI := &api{
+		universal: universal.New(universal.Options{
+			AppID:      "fakeAPI",
+			Logger:     logger.NewLogger("fakeLogger"),
+			CompStore:  compStore,
+			Resiliency: resiliency.New(nil),
+		}),
+	}
+	fakeServer.StartServer(testAPI.constructStateBulkEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	t.Run("bulk set with per-item results - 200", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/state/store1/bulk/set", []byte(`{
+			"items": [
+				{"key": "a", "value": 1, "ttlInSeconds": 60, "options": {"consistency": "strong"}},
+				{"key": "b", "value": 2, "etag": "bad"}
+			],
+			"parallelism": 4
+		}`), map[string]string{"metadata.foo": "bar"})
+		require.Equal(t, 200, resp.StatusCode, string(resp.RawBody))
+		assert.JSONEq(t, `{"results": [{"key": "a"}, {"key": "b", "error": "possible etag mismatch. error from state store"}]}`, string(resp.RawBody))
+
+		require.Len(t, store.reqs, 2)
+		assert.Equal(t, "fakeAPI||a", store.reqs[0].Key)
+		assert.Equal(t, map[string]string{"ttlInSeconds": "60", "foo": "bar"}, store.reqs[0].Metadata)
+		assert.Equal(t, "strong", store.reqs[0].Options.Consistency)
+		assert.Equal(t, 4, store.opts.Parallelism)
+	})
+
+	t.Run("bulk set without key - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/state/store1/bulk/set", []byte(`{"items": [{"value": 1}]}`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_MALFORMED_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("bulk delete - 200", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/state/store1/bulk/delete", []byte(`{"items": [{"key": "a"}, {"key": "b", "etag": "1"}]}`), nil)
+		require.Equal(t, 200, resp.StatusCode, string(resp.RawBody))
+		assert.JSONEq(t, `{"results": [{"key": "a"}, {"key": "b"}]}`, string(resp.RawBody))
+		assert.Equal(t, uint64(1), store.CallCount("BulkDelete"))
+	})
+
+	t.Run("store not found - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/state/nostore/bulk/delete", []byte(`{"items": []}`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_STATE_STORE_NOT_FOUND", resp.ErrorBody["errorCode"])
+	})
+}
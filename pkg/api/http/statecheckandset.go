@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"maps"
+	nethttp "net/http"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+
+	"github.com/dapr/components-contrib/state"
+	apierrors "github.com/dapr/dapr/pkg/api/errors"
+	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/messages"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/statecache"
+	kiterrors "github.com/dapr/kit/errors"
+)
+
+type checkAndSetRequestBody struct {
+	Checks     []checkAndSetRequestBodyCheck          `json:"checks"`
+	Operations []stateTransactionRequestBodyOperation `json:"operations"`
+	Metadata   map[string]string                      `json:"metadata,omitempty"`
+}
+
+type checkAndSetRequestBodyCheck struct {
+	Key  string `json:"key"`
+	ETag string `json:"etag"`
+}
+
+func (a *api) constructStateCheckAndSetEndpoints() []endpoints.Endpoint {
+	return []endpoints.Endpoint{
+		{
+			Methods: []string{nethttp.MethodPost, nethttp.MethodPut},
+			Route:   "state/{storeName}/checkandset",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupStateV1Alpha1,
+			Handler: a.onCheckAndSetState,
+			Settings: endpoints.EndpointSettings{
+				Name: "CheckAndSetStateAlpha1",
+			},
+		},
+	}
+}
+
+// onCheckAndSetState applies the operations of a transaction only if the
+// ETags of all the checked keys match, and responds with a conflict otherwise.
+func (a *api) onCheckAndSetState(w nethttp.ResponseWriter, r *nethttp.Request) {
+	store, storeName, err := a.getStateStoreWithRequestValidation(w, r)
+	if err != nil {
+		log.Debug(err)
+		return
+	}
+
+	var in checkAndSetRequestBody
+	if err = json.NewDecoder(r.Body).Decode(&in); err != nil {
+		msg := messages.ErrMalformedRequest.WithFormat(err)
+		respondWithError(w, msg)
+		log.Debug(msg)
+		return
+	}
+
+	// merge metadata from URL query parameters
+	metadata := getMetadataFromRequest(r)
+	if in.Metadata == nil {
+		in.Metadata = metadata
+	} else {
+		maps.Copy(in.Metadata, metadata)
+	}
+
+	req := &stateLoader.CheckAndSetRequest{
+		Checks:     make([]stateLoader.ETagCheck, len(in.Checks)),
+		Operations: make([]state.TransactionalStateOperation, len(in.Operations)),
+		Metadata:   in.Metadata,
+	}
+	for i, c := range in.Checks {
+		key, kerr := stateLoader.GetModifiedStateKeyWithMetadata(c.Key, storeName, a.universal.AppID(), in.Metadata)
+		if kerr != nil {
+			nerr := apierrors.StateStore(storeName).InvalidKeyName(c.Key, kerr.Error())
+			log.Debug(nerr)
+			respondWithError(w, nerr)
+			return
+		}
+		req.Checks[i] = stateLoader.ETagCheck{Key: key, ETag: c.ETag}
+	}
+	for i, o := range in.Operations {
+		req.Operations[i], err = a.parseStateTransactionOperation(o, storeName, metadata, true)
+		if err != nil {
+			respondWithError(w, err)
+			log.Debug(err)
+			return
+		}
+	}
+
+	if err = encryptStateTransactionOperations(storeName, req.Operations); err != nil {
+		respondWithError(w, err)
+		log.Debug(err)
+		return
+	}
+
+	start := time.Now()
+	policyRunner := resiliency.NewRunner[any](r.Context(),
+		a.universal.Resiliency().ComponentOutboundPolicy(storeName, resiliency.Statestore),
+	)
+	_, err = policyRunner(func(ctx context.Context) (any, error) {
+		rErr := stateLoader.CheckAndSet(ctx, store, req)
+		if errors.Is(rErr, stateLoader.ErrCheckAndSetInvalid) ||
+			errors.Is(rErr, stateLoader.ErrCheckAndSetNotSupported) ||
+			errors.Is(rErr, stateLoader.ErrCheckAndSetConflict) {
+			return nil, backoff.Permanent(rErr)
+		}
+		return nil, rErr
+	})
+	elapsed := diag.ElapsedSince(start)
+
+	keys := statecache.Keys(req.Operations)
+	for _, c := range req.Checks {
+		keys = append(keys, c.Key)
+	}
+	a.universal.CompStore().GetStateCache(storeName).Invalidate(r.Context(), keys...)
+
+	diag.DefaultComponentMonitoring.StateInvoked(context.Background(), storeName, diag.StateCheckAndSet, err == nil, elapsed)
+
+	if err != nil {
+		var nerr error
+		switch {
+		case errors.Is(err, stateLoader.ErrCheckAndSetInvalid):
+			nerr = apierrors.StateStore(storeName).CheckAndSetInvalid(err.Error())
+		case errors.Is(err, stateLoader.ErrCheckAndSetNotSupported):
+			nerr = apierrors.StateStore(storeName).CheckAndSetNotSupported()
+		case errors.Is(err, stateLoader.ErrCheckAndSetConflict):
+			nerr = apierrors.StateStore(storeName).CheckAndSetConflict(err.Error())
+		default:
+			if kerr, ok := kiterrors.FromError(err); ok {
+				nerr = kerr
+			} else {
+				nerr = apierrors.StateStore(storeName).CheckAndSetFailed(err.Error())
+			}
+		}
+		log.Debug(nerr)
+		respondWithError(w, nerr)
+		return
+	}
+
+	respondWithEmpty(w)
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/api/universal"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+	"github.com/dapr/kit/logger"
+)
+
+// etagTransactionStateStore fails transactions with operations with a "bad"
+// ETag.
+type etagTransactionStateStore struct {
+	*daprt.FakeStateStore
+
+	ops []state.TransactionalStateOperation
+}
+
+func (s *etagTransactionStateStore) Multi(ctx context.Context, req *state.TransactionalStateRequest) error {
+	s.ops = req.Operations
+	for _, op := range req.Operations {
+		if set, ok := op.(state.SetRequest); ok && set.ETag != nil && *set.ETag == "bad" {
+			return state.NewETagError(state.ETagMismatch, nil)
+		}
+	}
+	return nil
+}
+
+func TestCheckAndSetStateEndpoint(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	store := &etagTransactionStateStore{FakeStateStore: daprt.NewFakeStateStore()}
+	require.NoError(t, store.Set(t.Context(), &state.SetRequest{Key: "fakeAPI||b", Value: "v"}))
+	res, err := store.Get(t.Context(), &state.GetRequest{Key: "fakeAPI||b"})
+	require.NoError(t, err)
+	etagB := *res.ETag
+
+	compStore := compstore.New()
+	compStore.AddStateStore("store1", store)
+	compStore.AddStateStore("notransactions", &daprt.MockStateStore{})
+	testAPI := &api{
+		universal: universal.New(universal.Options{
+			AppID:      "fakeAPI",
+			Logger:     logger.NewLogger("fakeLogger"),
+			CompStore:  compStore,
+			Resiliency: resiliency.New(nil),
+		}),
+	}
+	fakeServer.StartServer(testAPI.constructStateCheckAndSetEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	t.Run("checks match - 204", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/state/store1/checkandset", []byte(`{
+			"checks": [{"key": "a", "etag": "1"}, {"key": "b", "etag": "`+etagB+`"}],
+			"operations": [{"operation": "upsert", "request": {"key": "a", "value": 1}}]
+		}`), nil)
+		require.Equal(t, 204, resp.StatusCode, string(resp.RawBody))
+
+		require.Len(t, store.ops, 2)
+		set := store.ops[0].(state.SetRequest)
+		assert.Equal(t, "fakeAPI||a", set.Key)
+		assert.Equal(t, "1", *set.ETag)
+		set = store.ops[1].(state.SetRequest)
+		assert.Equal(t, "fakeAPI||b", set.Key)
+		assert.Equal(t, etagB, *set.ETag)
+	})
+
+	t.Run("written key doesn't match - 409", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/state/store1/checkandset", []byte(`{
+			"checks": [{"key": "a", "etag": "bad"}],
+			"operations": [{"operation": "delete", "request": {"key": "c"}}, {"operation": "upsert", "request": {"key": "a", "value": 1}}]
+		}`), nil)
+		assert.Equal(t, 409, resp.StatusCode)
+		assert.Equal(t, "ERR_STATE_CHECK_AND_SET_CONFLICT", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("checked key doesn't match - 409", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/state/store1/checkandset", []byte(`{"checks": [{"key": "b", "etag": "1"}]}`), nil)
+		assert.Equal(t, 409, resp.StatusCode)
+		assert.Equal(t, "ERR_STATE_CHECK_AND_SET_CONFLICT", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("no checks - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/state/store1/checkandset", []byte(`{"operations": [{"operation": "delete", "request": {"key": "a"}}]}`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_STATE_CHECK_AND_SET_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("store without transactions - 500", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/state/notransactions/checkandset", []byte(`{"checks": [{"key": "a", "etag": "1"}]}`), nil)
+		assert.Equal(t, 500, resp.StatusCode)
+		assert.Equal(t, "ERR_STATE_STORE_NOT_SUPPORTED", resp.ErrorBody["errorCode"])
+	})
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	nethttp "net/http"
+
+	apierrors "github.com/dapr/dapr/pkg/api/errors"
+	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	"github.com/dapr/dapr/pkg/messages"
+	"github.com/dapr/dapr/pkg/runtime/statemigration"
+)
+
+type stateMigrationRequestBody struct {
+	// Destination is the name of the state store to copy the keys to.
+	Destination string `json:"destination"`
+	// Keys to copy. If empty, all the keys of the state store are copied,
+	// which requires the state store to support queries.
+	Keys []string `json:"keys,omitempty"`
+	// RatePerSecond is the maximum number of keys copied per second.
+	RatePerSecond float64 `json:"ratePerSecond,omitempty"`
+	// Resume resumes the last migration to the same destination from its
+	// checkpoint. Migrations are kept in memory, so they can't be resumed this
+	// way after the sidecar is restarted.
+	Resume bool `json:"resume,omitempty"`
+	// Checkpoint is the last checkpoint reported by a previous migration to
+	// resume from. Callers which need to resume after the sidecar is restarted
+	// must keep the checkpoint reported by the status of the migration.
+	Checkpoint *statemigration.Checkpoint `json:"checkpoint,omitempty"`
+}
+
+func (a *api) constructStateMigrationEndpoints() []endpoints.Endpoint {
+	return []endpoints.Endpoint{
+		{
+			Methods: []string{nethttp.MethodPost},
+			Route:   "state/{storeName}/migrate",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupStateV1Alpha1,
+			Handler: a.onPostStateMigration,
+			Settings: endpoints.EndpointSettings{
+				Name: "MigrateStateAlpha1",
+			},
+		},
+		{
+			Methods: []string{nethttp.MethodGet},
+			Route:   "state/{storeName}/migrate",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupStateV1Alpha1,
+			Handler: a.onGetStateMigrationStatus,
+			Settings: endpoints.EndpointSettings{
+				Name: "GetMigrateStateStatusAlpha1",
+			},
+		},
+	}
+}
+
+// onPostStateMigration starts copying the keys of a state store to another
+// state store in the background, and responds with the status of the job.
+func (a *api) onPostStateMigration(w nethttp.ResponseWriter, r *nethttp.Request) {
+	_, storeName, err := a.getStateStoreWithRequestValidation(w, r)
+	if err != nil {
+		log.Debug(err)
+		return
+	}
+
+	var req stateMigrationRequestBody
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		msg := messages.ErrMalformedRequest.WithFormat(err)
+		respondWithError(w, msg)
+		log.Debug(msg)
+		return
+	}
+	if req.Destination == "" {
+		nerr := apierrors.StateStore(storeName).MigrationInvalid("destination is required")
+		log.Debug(nerr)
+		respondWithError(w, nerr)
+		return
+	}
+
+	keys := make([]string, len(req.Keys))
+	for i, key := range req.Keys {
+		keys[i], err = stateLoader.GetModifiedStateKey(key, storeName, a.universal.AppID())
+		if err != nil {
+			nerr := apierrors.StateStore(storeName).InvalidKeyName(key, err.Error())
+			log.Debug(nerr)
+			respondWithError(w, nerr)
+			return
+		}
+	}
+
+	status, err := a.stateMigration.Start(statemigration.Request{
+		Source:        storeName,
+		Destination:   req.Destination,
+		Keys:          keys,
+		RatePerSecond: req.RatePerSecond,
+		Checkpoint:    req.Checkpoint,
+		Resume:        req.Resume,
+	})
+	switch {
+	case errors.Is(err, statemigration.ErrRunning):
+		err = apierrors.StateStore(storeName).MigrationRunning()
+	case err != nil:
+		err = apierrors.StateStore(storeName).MigrationInvalid(err.Error())
+	}
+	if err != nil {
+		log.Debug(err)
+		respondWithError(w, err)
+		return
+	}
+
+	respondWithJSON(w, nethttp.StatusAccepted, status)
+}
+
+// onGetStateMigrationStatus responds with the status of the last migration of
+// a state store.
+func (a *api) onGetStateMigrationStatus(w nethttp.ResponseWriter, r *nethttp.Request) {
+	_, storeName, err := a.getStateStoreWithRequestValidation(w, r)
+	if err != nil {
+		log.Debug(err)
+		return
+	}
+
+	status, ok := a.stateMigration.Status(storeName)
+	if !ok {
+		err = apierrors.StateStore(storeName).MigrationNotFound()
+		log.Debug(err)
+		respondWithError(w, err)
+		return
+	}
+
+	respondWithJSON(w, nethttp.StatusOK, status)
+}
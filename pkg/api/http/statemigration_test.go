@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dapr/dapr/pkg/api/universal"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/statemigration"
+	daprt "github.com/dapr/dapr/pkg/testing"
+	"github.com/dapr/kit/logger"
+)
+
+func TestStateMigrationEndpoints(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	compStore := compstore.New()
+	compStore.AddStateStore("store1", daprt.NewFakeStateStore())
+	compStore.AddStateStore("store2", daprt.NewFakeStateStore())
+	testAPI := &api{
+		universal: universal.New(universal.Options{
+			AppID:     "fakeAPI",
+			Logger:    logger.NewLogger("fakeLogger"),
+			CompStore: compStore,
+		}),
+		stateMigration: statemigration.New(statemigration.Options{
+			GetStateFn: compStore.GetStateStore,
+			Resiliency: resiliency.New(nil),
+		}),
+	}
+	fakeServer.StartServer(testAPI.constructStateMigrationEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	t.Run("store not found - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/state/nostore/migrate", []byte(`{"destination": "store2"}`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_STATE_STORE_NOT_FOUND", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("missing destination - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/state/store1/migrate", []byte(`{"keys": ["a"]}`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_STATE_MIGRATION_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("source without queries and no keys - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/state/store1/migrate", []byte(`{"destination": "store2"}`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_STATE_MIGRATION_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("malformed request - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/state/store1/migrate", []byte(`{"keys": 1}`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_MALFORMED_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("no job started - 404", func(t *testing.T) {
+		resp := fakeServer.DoRequest("GET", "v1.0-alpha1/state/store1/migrate", nil, nil)
+		assert.Equal(t, 404, resp.StatusCode)
+		assert.Equal(t, "ERR_STATE_MIGRATION_NOT_FOUND", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("started - 202", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/state/store1/migrate", []byte(`{"destination": "store2", "keys": ["a"]}`), nil)
+		assert.Equal(t, 202, resp.StatusCode)
+		assert.Equal(t, "store2", resp.JSONBody.(map[string]any)["destination"])
+
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			resp := fakeServer.DoRequest("GET", "v1.0-alpha1/state/store1/migrate", nil, nil)
+			assert.Equal(c, 200, resp.StatusCode)
+			assert.Equal(c, "COMPLETED", resp.JSONBody.(map[string]any)["state"])
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"encoding/json"
+	nethttp "net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	apierrors "github.com/dapr/dapr/pkg/api/errors"
+	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/kit/ptr"
+)
+
+var endpointGroupStateV1Alpha2 = &endpoints.EndpointGroup{
+	Name:                 endpoints.EndpointGroupState,
+	Version:              endpoints.EndpointGroupVersion1alpha2,
+	AppendSpanAttributes: appendStateSpanAttributes,
+}
+
+func (a *api) constructStateQueryV2Endpoints() []endpoints.Endpoint {
+	return []endpoints.Endpoint{
+		{
+			Methods: []string{nethttp.MethodPost, nethttp.MethodPut},
+			Route:   "state/{storeName}/query",
+			Version: apiVersionV1alpha2,
+			Group:   endpointGroupStateV1Alpha2,
+			Handler: a.onQueryStateV2Handler(),
+			Settings: endpoints.EndpointSettings{
+				Name: "QueryStateAlpha2",
+			},
+		},
+	}
+}
+
+// onQueryStateV2Handler returns the handler which runs a query with
+// projections, sorting on multiple fields, aggregates and cursor-based
+// pagination.
+func (a *api) onQueryStateV2Handler() nethttp.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.QueryStateAlpha2,
+		UniversalHTTPHandlerOpts[*runtimev1pb.QueryStateRequestAlpha2, *runtimev1pb.QueryStateResponseAlpha2]{
+			SkipInputBody: true,
+			InModifier: func(r *nethttp.Request, in *runtimev1pb.QueryStateRequestAlpha2) (*runtimev1pb.QueryStateRequestAlpha2, error) {
+				var body json.RawMessage
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					nerr := apierrors.StateStore(chi.URLParam(r, storeNameParam)).QueryInvalid("failed to parse JSON query body: " + err.Error())
+					log.Debug(nerr)
+					return nil, nerr
+				}
+				in.StoreName = chi.URLParam(r, storeNameParam)
+				in.Query = string(body)
+				in.Metadata = getMetadataFromRequest(r)
+				return in, nil
+			},
+			OutModifier: func(out *runtimev1pb.QueryStateResponseAlpha2) (any, error) {
+				resp := QueryV2Response{
+					Cursor: out.GetCursor(),
+				}
+				if len(out.GetAggregates()) > 0 {
+					resp.Aggregates = make(map[string]any, len(out.GetAggregates()))
+					for name, val := range out.GetAggregates() {
+						resp.Aggregates[name] = json.RawMessage(val)
+					}
+				}
+				if len(out.GetResults()) > 0 {
+					resp.Results = make([]QueryItem, len(out.GetResults()))
+					for i, item := range out.GetResults() {
+						resp.Results[i] = QueryItem{
+							Key:   item.GetKey(),
+							Error: item.GetError(),
+						}
+						if item.GetEtag() != "" {
+							resp.Results[i].ETag = ptr.Of(item.GetEtag())
+						}
+						if len(item.GetData()) > 0 {
+							resp.Results[i].Data = json.RawMessage(item.GetData())
+						}
+					}
+				}
+				return resp, nil
+			},
+		},
+	)
+}
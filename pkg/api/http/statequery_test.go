@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/api/universal"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+	"github.com/dapr/kit/logger"
+)
+
+type fakeQueryStateStore struct {
+	*daprt.FakeStateStore
+}
+
+func (s *fakeQueryStateStore) Query(ctx context.Context, req *state.QueryRequest) (*state.QueryResponse, error) {
+	return &state.QueryResponse{
+		Results: []state.QueryItem{
+			{Key: "fakeAPI||a", Data: []byte(`{"name":"alice","age":30}`)},
+			{Key: "fakeAPI||b", Data: []byte(`{"name":"bob","age":25}`)},
+		},
+	}, nil
+}
+
+func TestQueryStateV2Endpoint(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	compStore := compstore.New()
+	compStore.AddStateStore("store1", &fakeQueryStateStore{FakeStateStore: daprt.NewFakeStateStore()})
+	compStore.AddStateStore("noquery", daprt.NewFakeStateStore())
+	testAPI := &api{
+		universal: universal.New(universal.Options{
+			AppID:      "fakeAPI",
+			Logger:     logger.NewLogger("fakeLogger"),
+			CompStore:  compStore,
+			Resiliency: resiliency.New(nil),
+		}),
+	}
+	fakeServer.StartServer(testAPI.constructStateQueryV2Endpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	t.Run("projection - 200", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha2/state/store1/query", []byte(`{"select": ["name"], "orderBy": [{"key": "age"}, {"key": "name", "order": "DESC"}]}`), nil)
+		require.Equal(t, 200, resp.StatusCode, string(resp.RawBody))
+		assert.JSONEq(t, `{"results": [{"key": "a", "data": {"name": "alice"}}, {"key": "b", "data": {"name": "bob"}}]}`, string(resp.RawBody))
+	})
+
+	t.Run("aggregates - 200", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha2/state/store1/query", []byte(`{"aggregate": [{"function": "count"}, {"function": "max", "field": "age"}]}`), nil)
+		require.Equal(t, 200, resp.StatusCode, string(resp.RawBody))
+		assert.JSONEq(t, `{"aggregates": {"count": 2, "max(age)": 30}}`, string(resp.RawBody))
+	})
+
+	t.Run("invalid query - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha2/state/store1/query", []byte(`{"aggregate": [{"function": "avg"}]}`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_STATE_QUERY_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("store without queries - 500", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha2/state/noquery/query", []byte(`{}`), nil)
+		assert.Equal(t, 500, resp.StatusCode)
+		assert.Equal(t, "ERR_STATE_STORE_NOT_SUPPORTED", resp.ErrorBody["errorCode"])
+	})
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	nethttp "net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	"github.com/dapr/dapr/pkg/messages"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/runtime/reencryption"
+)
+
+type stateReencryptionRequestBody struct {
+	// Keys to re-encrypt. If empty, all the keys of the state store are
+	// re-encrypted, which requires the state store to support queries.
+	Keys []string `json:"keys,omitempty"`
+}
+
+func (a *api) constructStateReencryptionEndpoints() []endpoints.Endpoint {
+	return []endpoints.Endpoint{
+		{
+			Methods: []string{nethttp.MethodPost},
+			Route:   "state/{storeName}/reencrypt",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupStateV1Alpha1,
+			Handler: a.onPostStateReencryptionHandler(),
+			Settings: endpoints.EndpointSettings{
+				Name: "ReencryptStateAlpha1",
+			},
+		},
+		{
+			Methods: []string{nethttp.MethodGet},
+			Route:   "state/{storeName}/reencrypt",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupStateV1Alpha1,
+			Handler: a.onGetStateReencryptionStatusHandler(),
+			Settings: endpoints.EndpointSettings{
+				Name: "GetReencryptStateStatusAlpha1",
+			},
+		},
+	}
+}
+
+// onPostStateReencryptionHandler returns the handler which starts
+// re-encrypting the values of an encrypted state store with its primary key in
+// the background, and responds with the status of the job.
+func (a *api) onPostStateReencryptionHandler() nethttp.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.ReencryptStateAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.ReencryptStateRequestAlpha1, *runtimev1pb.ReencryptStateStatusAlpha1]{
+			SkipInputBody: true,
+			InModifier: func(r *nethttp.Request, in *runtimev1pb.ReencryptStateRequestAlpha1) (*runtimev1pb.ReencryptStateRequestAlpha1, error) {
+				var body stateReencryptionRequestBody
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+					msg := messages.ErrMalformedRequest.WithFormat(err)
+					log.Debug(msg)
+					return nil, msg
+				}
+				in.StoreName = chi.URLParam(r, storeNameParam)
+				in.Keys = body.Keys
+				return in, nil
+			},
+			OutModifier:       reencryptionStatusFromProto,
+			SuccessStatusCode: nethttp.StatusAccepted,
+		},
+	)
+}
+
+// onGetStateReencryptionStatusHandler returns the handler which responds with
+// the status of the last re-encryption job of a state store.
+func (a *api) onGetStateReencryptionStatusHandler() nethttp.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.GetReencryptStateStatusAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.GetReencryptStateStatusRequestAlpha1, *runtimev1pb.ReencryptStateStatusAlpha1]{
+			InModifier: func(r *nethttp.Request, in *runtimev1pb.GetReencryptStateStatusRequestAlpha1) (*runtimev1pb.GetReencryptStateStatusRequestAlpha1, error) {
+				in.StoreName = chi.URLParam(r, storeNameParam)
+				return in, nil
+			},
+			OutModifier: reencryptionStatusFromProto,
+		},
+	)
+}
+
+// reencryptionStatusFromProto converts the status of a re-encryption job to
+// the JSON object returned by the HTTP API.
+func reencryptionStatusFromProto(out *runtimev1pb.ReencryptStateStatusAlpha1) (any, error) {
+	status := reencryption.Status{
+		StoreName:   out.GetStoreName(),
+		State:       reencryption.State(out.GetState()),
+		StartedAt:   out.GetStartedAt().AsTime(),
+		Scanned:     out.GetScanned(),
+		Reencrypted: out.GetReencrypted(),
+		Failed:      out.GetFailed(),
+		Error:       out.GetError(),
+	}
+	if out.GetCompletedAt() != nil {
+		completedAt := out.GetCompletedAt().AsTime()
+		status.CompletedAt = &completedAt
+	}
+	return status, nil
+}
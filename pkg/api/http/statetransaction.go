@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	nethttp "net/http"
+	"time"
+
+	"github.com/dapr/components-contrib/state"
+	apierrors "github.com/dapr/dapr/pkg/api/errors"
+	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/messages"
+	"github.com/dapr/dapr/pkg/messages/errorcodes"
+	"github.com/dapr/dapr/pkg/runtime/statecache"
+	"github.com/dapr/dapr/pkg/runtime/transaction"
+)
+
+type crossStoreTransactionRequestBody struct {
+	Operations []crossStoreTransactionRequestBodyOperation `json:"operations"`
+	Metadata   map[string]string                           `json:"metadata,omitempty"`
+}
+
+type crossStoreTransactionRequestBodyOperation struct {
+	StoreName string `json:"storeName"`
+	stateTransactionRequestBodyOperation
+}
+
+func (a *api) constructStateTransactionEndpoints() []endpoints.Endpoint {
+	return []endpoints.Endpoint{
+		{
+			Methods: []string{nethttp.MethodPost, nethttp.MethodPut},
+			Route:   "state/transaction",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupStateV1Alpha1,
+			Handler: a.onPostCrossStoreTransaction,
+			Settings: endpoints.EndpointSettings{
+				Name: "ExecuteCrossStoreTransactionAlpha1",
+			},
+		},
+	}
+}
+
+// onPostCrossStoreTransaction executes a transaction whose operations target
+// multiple state stores.
+func (a *api) onPostCrossStoreTransaction(w nethttp.ResponseWriter, r *nethttp.Request) {
+	if a.universal.CompStore().StateStoresLen() == 0 {
+		err := apierrors.StateStore("").NotConfigured(a.universal.AppID())
+		log.Debug(err)
+		respondWithError(w, err)
+		return
+	}
+
+	var req crossStoreTransactionRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		msg := messages.ErrMalformedRequest.WithFormat(err)
+		respondWithError(w, msg)
+		log.Debug(msg)
+		return
+	}
+	if len(req.Operations) == 0 {
+		respondWithEmpty(w)
+		return
+	}
+
+	// merge metadata from URL query parameters
+	metadata := getMetadataFromRequest(r)
+	if req.Metadata == nil {
+		req.Metadata = metadata
+	} else {
+		for k, v := range metadata {
+			req.Metadata[k] = v
+		}
+	}
+
+	// Operations are grouped by store, in the order the stores first appear.
+	var storeReqs []transaction.StoreRequest
+	storeIdx := make(map[string]int)
+	for _, o := range req.Operations {
+		if o.StoreName == "" {
+			msg := messages.ErrMalformedRequest.WithFormat(errors.New("storeName is required for every operation"))
+			respondWithError(w, msg)
+			log.Debug(msg)
+			return
+		}
+
+		idx, ok := storeIdx[o.StoreName]
+		if !ok {
+			if _, ok = a.universal.CompStore().GetStateStore(o.StoreName); !ok {
+				err := apierrors.StateStore(o.StoreName).NotFound(a.universal.AppID())
+				log.Debug(err)
+				respondWithError(w, err)
+				return
+			}
+			if a.outbox.Enabled(o.StoreName) {
+				msg := messages.ErrMalformedRequest.WithFormat(fmt.Errorf("state store %s has the outbox enabled, which is not supported in cross-store transactions", o.StoreName))
+				respondWithError(w, msg)
+				log.Debug(msg)
+				return
+			}
+
+			idx = len(storeReqs)
+			storeIdx[o.StoreName] = idx
+			storeReqs = append(storeReqs, transaction.StoreRequest{
+				StoreName: o.StoreName,
+				Request:   &state.TransactionalStateRequest{Metadata: req.Metadata},
+			})
+		}
+
+		op, err := a.parseStateTransactionOperation(o.stateTransactionRequestBodyOperation, o.StoreName, metadata, req.Metadata != nil)
+		if err != nil {
+			respondWithError(w, err)
+			log.Debug(err)
+			return
+		}
+		storeReqs[idx].Request.Operations = append(storeReqs[idx].Request.Operations, op)
+	}
+
+	for _, storeReq := range storeReqs {
+		store, _ := a.universal.CompStore().GetStateStore(storeReq.StoreName)
+		if maxMulti, ok := store.(state.TransactionalStoreMultiMaxSize); ok {
+			max := maxMulti.MultiMaxSize()
+			if max > 0 && len(storeReq.Request.Operations) > max {
+				err := apierrors.StateStore(storeReq.StoreName).TooManyTransactionalOps(len(storeReq.Request.Operations), max)
+				log.Debug(err)
+				respondWithError(w, err)
+				return
+			}
+		}
+
+		if err := encryptStateTransactionOperations(storeReq.StoreName, storeReq.Request.Operations); err != nil {
+			respondWithError(w, err)
+			log.Debug(err)
+			return
+		}
+	}
+
+	start := time.Now()
+	err := a.stateTransactions.Execute(r.Context(), storeReqs)
+	elapsed := diag.ElapsedSince(start)
+
+	for _, storeReq := range storeReqs {
+		a.universal.CompStore().GetStateCache(storeReq.StoreName).Invalidate(r.Context(), statecache.Keys(storeReq.Request.Operations)...)
+		diag.DefaultComponentMonitoring.StateInvoked(context.Background(), storeReq.StoreName, diag.StateTransaction, err == nil, elapsed)
+	}
+
+	if err != nil {
+		resp := messages.NewAPIErrorHTTP(fmt.Sprintf(messages.ErrStateTransaction, err.Error()), errorcodes.StateTransaction, nethttp.StatusInternalServerError)
+		respondWithError(w, resp)
+		log.Debug(resp)
+	} else {
+		respondWithEmpty(w)
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/api/universal"
+	outboxfake "github.com/dapr/dapr/pkg/outbox/fake"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/transaction"
+	daprt "github.com/dapr/dapr/pkg/testing"
+	"github.com/dapr/kit/logger"
+)
+
+func TestCrossStoreTransactionEndpoint(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	store1, store2 := daprt.NewFakeStateStore(), daprt.NewFakeStateStore()
+	compStore := compstore.New()
+	compStore.AddStateStore("store1", store1)
+	compStore.AddStateStore("store2", store2)
+	testAPI := &api{
+		universal: universal.New(universal.Options{
+			AppID:     "fakeAPI",
+			Logger:    logger.NewLogger("fakeLogger"),
+			CompStore: compStore,
+		}),
+		outbox: outboxfake.New(),
+		stateTransactions: transaction.New(transaction.Options{
+			AppID:       "fakeAPI",
+			GetStateFn:  compStore.GetStateStore,
+			ListStateFn: compStore.ListStateStores,
+			Resiliency:  resiliency.New(nil),
+		}),
+	}
+	fakeServer.StartServer(testAPI.constructStateTransactionEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	const apiPath = "v1.0-alpha1/state/transaction"
+
+	t.Run("missing store name - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", apiPath, []byte(`{"operations": [{"operation": "upsert", "request": {"key": "a", "value": 1}}]}`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_MALFORMED_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("store not found - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", apiPath, []byte(`{"operations": [{"storeName": "nostore", "operation": "upsert", "request": {"key": "a", "value": 1}}]}`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_STATE_STORE_NOT_FOUND", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("invalid operation - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", apiPath, []byte(`{"operations": [{"storeName": "store1", "operation": "bad", "request": {"key": "a"}}]}`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_NOT_SUPPORTED_STATE_OPERATION", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("operations are executed - 204", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", apiPath, []byte(`{"operations": [
+			{"storeName": "store1", "operation": "upsert", "request": {"key": "a", "value": 1}},
+			{"storeName": "store1", "operation": "upsert", "request": {"key": "b", "value": 2}}
+		]}`), nil)
+		require.Equal(t, 204, resp.StatusCode, string(resp.RawBody))
+		assert.Contains(t, store1.GetItems(), "fakeAPI||a")
+		assert.Contains(t, store1.GetItems(), "fakeAPI||b")
+		assert.Equal(t, uint64(1), store1.CallCount("Multi"))
+	})
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	nethttp "net/http"
+	"strings"
+	"time"
+
+	apierrors "github.com/dapr/dapr/pkg/api/errors"
+	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	"github.com/dapr/dapr/pkg/encryption"
+	"github.com/dapr/dapr/pkg/sse"
+)
+
+const (
+	stateWatchKeysParam         = "keys"
+	stateWatchPollIntervalParam = "pollInterval"
+
+	// Names of the events sent to a caller watching state changes.
+	sseEventStateChange = "change"
+	sseEventStateError  = "error"
+)
+
+func (a *api) constructStateWatchEndpoints() []endpoints.Endpoint {
+	return []endpoints.Endpoint{
+		{
+			Methods: []string{nethttp.MethodGet},
+			Route:   "state/{storeName}/subscribe",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupStateV1Alpha1,
+			Handler: a.onSubscribeState,
+			Settings: endpoints.EndpointSettings{
+				Name: "SubscribeStateAlpha1",
+			},
+		},
+	}
+}
+
+// onSubscribeState watches the keys of the request for as long as the request
+// is open, and sends their changes to the caller as server-sent events.
+func (a *api) onSubscribeState(w nethttp.ResponseWriter, r *nethttp.Request) {
+	store, storeName, err := a.getStateStoreWithRequestValidation(w, r)
+	if err != nil {
+		log.Debug(err)
+		return
+	}
+
+	var keys []string
+	for _, v := range r.URL.Query()[stateWatchKeysParam] {
+		for _, key := range strings.Split(v, ",") {
+			if key = strings.TrimSpace(key); key != "" {
+				keys = append(keys, key)
+			}
+		}
+	}
+	if len(keys) == 0 {
+		nerr := apierrors.StateStore(storeName).WatchInvalid("at least one key is required")
+		log.Debug(nerr)
+		respondWithError(w, nerr)
+		return
+	}
+
+	interval := stateLoader.DefaultWatchPollInterval
+	if v := r.URL.Query().Get(stateWatchPollIntervalParam); v != "" {
+		interval, err = time.ParseDuration(v)
+		if err == nil && interval < stateLoader.MinWatchPollInterval {
+			err = errors.New("poll interval must be at least " + stateLoader.MinWatchPollInterval.String())
+		}
+		if err != nil {
+			nerr := apierrors.StateStore(storeName).WatchInvalid(err.Error())
+			log.Debug(nerr)
+			respondWithError(w, nerr)
+			return
+		}
+	}
+
+	metadata := getMetadataFromRequest(r)
+
+	// Keys are watched by their name in the store, and sent back to the caller
+	// by the name it asked for.
+	storeKeys := make([]string, len(keys))
+	originalKeys := make(map[string]string, len(keys))
+	for i, key := range keys {
+		k, kerr := stateLoader.GetModifiedStateKeyWithMetadata(key, storeName, a.universal.AppID(), metadata)
+		if kerr != nil {
+			nerr := apierrors.StateStore(storeName).InvalidKeyName(key, kerr.Error())
+			log.Debug(nerr)
+			respondWithError(w, nerr)
+			return
+		}
+		storeKeys[i] = k
+		originalKeys[k] = key
+	}
+
+	if err = sse.StartEventStream(w); err != nil {
+		nerr := apierrors.StateStore(storeName).WatchFailed(err.Error())
+		log.Debug(nerr)
+		respondWithError(w, nerr)
+		return
+	}
+
+	err = stateLoader.Watch(r.Context(), store, storeKeys, metadata, interval, func(ctx context.Context, event *stateLoader.ChangeEvent) error {
+		out := StateChangeEvent{
+			Key:  originalKeys[event.Key],
+			Type: string(event.Type),
+			ETag: event.ETag,
+		}
+		if len(event.Value) > 0 {
+			val := event.Value
+			val, derr := encryption.DecodeStateValue(storeName, event.Key, val)
+			if derr != nil {
+				return derr
+			}
+			if !json.Valid(val) {
+				// Values which aren't JSON are sent as a string.
+				val, _ = json.Marshal(string(val))
+			}
+			out.Value = val
+		}
+
+		data, merr := json.Marshal(out)
+		if merr != nil {
+			return merr
+		}
+		return sse.WriteEvent(w, sseEventStateChange, data)
+	})
+
+	// The response is already being streamed, so errors are sent as an event.
+	if err != nil && !errors.Is(err, context.Canceled) {
+		log.Debugf("Watching keys of state store '%s' stopped: %s", storeName, err)
+		data, _ := json.Marshal(map[string]string{"error": apierrors.StateStore(storeName).WatchFailed(err.Error()).Error()})
+		_ = sse.WriteEvent(w, sseEventStateError, data)
+	}
+}
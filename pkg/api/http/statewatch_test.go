@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"bufio"
+	"context"
+	nethttp "net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/api/universal"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+	"github.com/dapr/kit/logger"
+)
+
+func TestStateWatchEndpoint(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	fakeStore := daprt.NewFakeStateStore()
+	compStore := compstore.New()
+	compStore.AddStateStore("store1", fakeStore)
+	testAPI := &api{
+		universal: universal.New(universal.Options{
+			AppID:     "fakeAPI",
+			Logger:    logger.NewLogger("fakeLogger"),
+			CompStore: compStore,
+		}),
+	}
+	fakeServer.StartServer(testAPI.constructStateWatchEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	t.Run("no keys - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest("GET", "v1.0-alpha1/state/store1/subscribe", nil, nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_STATE_WATCH_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("poll interval too short - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest("GET", "v1.0-alpha1/state/store1/subscribe", nil, map[string]string{
+			"keys":         "a",
+			"pollInterval": "1ms",
+		})
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_STATE_WATCH_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("store not found - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest("GET", "v1.0-alpha1/state/nostore/subscribe", nil, map[string]string{"keys": "a"})
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_STATE_STORE_NOT_FOUND", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("changes are streamed", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+
+		req, err := nethttp.NewRequestWithContext(ctx, nethttp.MethodGet, "http://127.0.0.1/v1.0-alpha1/state/store1/subscribe?keys=a,b&pollInterval=100ms", nil)
+		require.NoError(t, err)
+		res, err := fakeServer.client.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		require.Equal(t, 200, res.StatusCode)
+		assert.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+
+		// Changes are only reported once the initial values are read.
+		assert.Eventually(t, func() bool {
+			return fakeStore.CallCount("BulkGet") > 0
+		}, time.Second, time.Millisecond)
+		require.NoError(t, fakeStore.Set(t.Context(), &state.SetRequest{Key: "fakeAPI||b", Value: map[string]int{"n": 1}}))
+
+		lines := make(chan string)
+		go func() {
+			scanner := bufio.NewScanner(res.Body)
+			for scanner.Scan() {
+				lines <- scanner.Text()
+			}
+			close(lines)
+		}()
+
+		var got []string
+		for len(got) < 2 {
+			select {
+			case line, ok := <-lines:
+				require.True(t, ok, "stream closed")
+				if line != "" {
+					got = append(got, line)
+				}
+			case <-time.After(5 * time.Second):
+				require.Fail(t, "change not streamed")
+			}
+		}
+		assert.Equal(t, "event: change", got[0])
+		data, ok := strings.CutPrefix(got[1], "data: ")
+		require.True(t, ok)
+		assert.Contains(t, data, `"key":"b","type":"create","value":{"n":1}`)
+	})
+}
@@ -0,0 +1,292 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	nethttp "net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apierrors "github.com/dapr/dapr/pkg/api/errors"
+	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/sse"
+)
+
+const (
+	subscriptionIDParam = "subscriptionID"
+
+	// Names of the events sent on a streaming subscription.
+	sseEventSubscribed = "subscribed"
+	sseEventMessage    = "message"
+)
+
+var endpointGroupStreamingSubscriptionV1Alpha1 = &endpoints.EndpointGroup{
+	Name:                 endpoints.EndpointGroupPubsub,
+	Version:              endpoints.EndpointGroupVersion1alpha1,
+	AppendSpanAttributes: appendPubSubSpanAttributes,
+}
+
+func (a *api) constructStreamingSubscriptionEndpoints() []endpoints.Endpoint {
+	return []endpoints.Endpoint{
+		{
+			Methods: []string{nethttp.MethodGet},
+			Route:   "subscribe/{pubsubname}/*",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupStreamingSubscriptionV1Alpha1,
+			Handler: a.onStreamSubscribe,
+			Settings: endpoints.EndpointSettings{
+				Name: "SubscribeTopicEvents",
+			},
+		},
+		{
+			Methods: []string{nethttp.MethodPost},
+			Route:   "subscriptions/{subscriptionID}/ack",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupStreamingSubscriptionV1Alpha1,
+			Handler: a.onStreamAck,
+			Settings: endpoints.EndpointSettings{
+				Name: "AckTopicEvent",
+			},
+		},
+	}
+}
+
+// onStreamSubscribe subscribes to a topic for as long as the request is open,
+// and sends the messages of the topic to the caller as server-sent events.
+// Every message must be acknowledged through the ack endpoint with the
+// subscription ID sent in the first event of the stream.
+func (a *api) onStreamSubscribe(w nethttp.ResponseWriter, r *nethttp.Request) {
+	_, pubsubName, topic, validationErr := a.validateAndGetPubsubAndTopic(r)
+	if validationErr != nil {
+		log.Debug(validationErr)
+		respondWithError(w, validationErr)
+		return
+	}
+
+	req := &runtimev1pb.SubscribeTopicEventsRequestInitialAlpha1{
+		PubsubName: pubsubName,
+		Topic:      topic,
+		Metadata:   getMetadataFromRequest(r),
+	}
+	if dlq := r.URL.Query().Get("deadLetterTopic"); dlq != "" {
+		req.DeadLetterTopic = &dlq
+	}
+
+	key := a.pubsubAdapterStreamer.StreamerKey(pubsubName, topic)
+	sub := &subapi.Subscription{
+		ObjectMeta: metav1.ObjectMeta{Name: key},
+		Spec: subapi.SubscriptionSpec{
+			Pubsubname:      pubsubName,
+			Topic:           topic,
+			Metadata:        req.GetMetadata(),
+			DeadLetterTopic: req.GetDeadLetterTopic(),
+			Routes:          subapi.Routes{Default: "/"},
+		},
+	}
+
+	stream := &sseSubscribeStream{
+		ctx:        r.Context(),
+		w:          w,
+		pubsubName: pubsubName,
+		topic:      topic,
+		ackCh:      make(chan *runtimev1pb.SubscribeTopicEventsRequestAlpha1),
+	}
+
+	connectionID := a.universal.CompStore().NextSubscriberIndex()
+	if err := a.universal.CompStore().AddStreamSubscription(sub, connectionID); err != nil {
+		nerr := apierrors.PubSub(pubsubName).StreamSubscribe(topic, err)
+		log.Debug(nerr)
+		respondWithError(w, nerr)
+		return
+	}
+	defer a.universal.CompStore().DeleteStreamSubscription(sub)
+
+	if err := a.processor.Subscriber().StartStreamerSubscription(sub, connectionID); err != nil {
+		nerr := apierrors.PubSub(pubsubName).StreamSubscribe(topic, err)
+		log.Debug(nerr)
+		respondWithError(w, nerr)
+		return
+	}
+	defer a.processor.Subscriber().StopStreamerSubscription(sub, connectionID)
+
+	a.addStreamSubscription(connectionID, stream)
+	defer a.removeStreamSubscription(connectionID)
+
+	if err := sse.StartEventStream(w); err != nil {
+		nerr := apierrors.PubSub(pubsubName).StreamSubscribe(topic, err)
+		log.Debug(nerr)
+		respondWithError(w, nerr)
+		return
+	}
+
+	subscribed, _ := json.Marshal(map[string]string{"id": strconv.FormatUint(uint64(connectionID), 10)})
+	if err := stream.writeEvent(sseEventSubscribed, subscribed); err != nil {
+		log.Debugf("Error sending subscription ID to client of pubsub '%s' topic '%s': %s", pubsubName, topic, err)
+		return
+	}
+
+	// The response is already being streamed, so errors can only be logged.
+	if err := a.pubsubAdapterStreamer.Subscribe(stream, req, connectionID); err != nil && !errors.Is(err, context.Canceled) {
+		log.Debugf("Streaming subscription to pubsub '%s' topic '%s' closed: %s", pubsubName, topic, err)
+	}
+}
+
+// onStreamAck acknowledges a message delivered on a streaming subscription.
+func (a *api) onStreamAck(w nethttp.ResponseWriter, r *nethttp.Request) {
+	subscriptionID := chi.URLParam(r, subscriptionIDParam)
+
+	var stream *sseSubscribeStream
+	if id, err := strconv.ParseUint(subscriptionID, 10, 64); err == nil {
+		stream = a.getStreamSubscription(runtimePubsub.ConnectionID(id))
+	}
+	if stream == nil {
+		nerr := apierrors.PubSub("").WithMetadata(nil).StreamNotFound(subscriptionID)
+		log.Debug(nerr)
+		respondWithError(w, nerr)
+		return
+	}
+
+	var in StreamAckRequest
+	err := json.NewDecoder(r.Body).Decode(&in)
+	var status int32
+	if err == nil {
+		status, err = in.status()
+	}
+	if err != nil {
+		nerr := apierrors.PubSub(stream.pubsubName).StreamAck(stream.topic, err)
+		log.Debug(nerr)
+		respondWithError(w, nerr)
+		return
+	}
+
+	ack := &runtimev1pb.SubscribeTopicEventsRequestAlpha1{
+		SubscribeTopicEventsRequestType: &runtimev1pb.SubscribeTopicEventsRequestAlpha1_EventProcessed{
+			EventProcessed: &runtimev1pb.SubscribeTopicEventsRequestProcessedAlpha1{
+				Id: in.ID,
+				Status: &runtimev1pb.TopicEventResponse{
+					Status: runtimev1pb.TopicEventResponse_TopicEventResponseStatus(status),
+				},
+			},
+		},
+	}
+
+	select {
+	case stream.ackCh <- ack:
+		respondWithEmpty(w)
+	case <-stream.ctx.Done():
+		nerr := apierrors.PubSub(stream.pubsubName).WithMetadata(nil).StreamNotFound(subscriptionID)
+		log.Debug(nerr)
+		respondWithError(w, nerr)
+	case <-r.Context().Done():
+	}
+}
+
+// status returns the status of the acknowledgement, which defaults to success.
+func (in StreamAckRequest) status() (int32, error) {
+	if in.ID == "" {
+		return 0, errors.New("id is required")
+	}
+	if in.Status == "" {
+		return int32(runtimev1pb.TopicEventResponse_SUCCESS), nil
+	}
+	status, ok := runtimev1pb.TopicEventResponse_TopicEventResponseStatus_value[strings.ToUpper(in.Status)]
+	if !ok {
+		return 0, fmt.Errorf("invalid status '%s'", in.Status)
+	}
+	return status, nil
+}
+
+func (a *api) addStreamSubscription(id runtimePubsub.ConnectionID, stream *sseSubscribeStream) {
+	a.streamSubsLock.Lock()
+	defer a.streamSubsLock.Unlock()
+	if a.streamSubs == nil {
+		a.streamSubs = make(map[runtimePubsub.ConnectionID]*sseSubscribeStream)
+	}
+	a.streamSubs[id] = stream
+}
+
+func (a *api) removeStreamSubscription(id runtimePubsub.ConnectionID) {
+	a.streamSubsLock.Lock()
+	defer a.streamSubsLock.Unlock()
+	delete(a.streamSubs, id)
+}
+
+func (a *api) getStreamSubscription(id runtimePubsub.ConnectionID) *sseSubscribeStream {
+	a.streamSubsLock.RLock()
+	defer a.streamSubsLock.RUnlock()
+	return a.streamSubs[id]
+}
+
+// sseSubscribeStream adapts a streaming subscription over HTTP to the gRPC
+// stream used by the pubsub streamer. Messages are sent as server-sent events,
+// and acknowledgements are received from the ack endpoint.
+type sseSubscribeStream struct {
+	runtimev1pb.Dapr_SubscribeTopicEventsAlpha1Server
+
+	ctx        context.Context
+	w          nethttp.ResponseWriter
+	writeLock  sync.Mutex
+	pubsubName string
+	topic      string
+	ackCh      chan *runtimev1pb.SubscribeTopicEventsRequestAlpha1
+}
+
+func (s *sseSubscribeStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *sseSubscribeStream) Send(resp *runtimev1pb.SubscribeTopicEventsResponseAlpha1) error {
+	msg := resp.GetEventMessage()
+	if msg == nil {
+		return nil
+	}
+
+	data, err := protojson.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return s.writeEvent(sseEventMessage, data)
+}
+
+func (s *sseSubscribeStream) Recv() (*runtimev1pb.SubscribeTopicEventsRequestAlpha1, error) {
+	select {
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	case ack := <-s.ackCh:
+		return ack, nil
+	}
+}
+
+func (s *sseSubscribeStream) SetHeader(metadata.MD) error  { return nil }
+func (s *sseSubscribeStream) SendHeader(metadata.MD) error { return nil }
+func (s *sseSubscribeStream) SetTrailer(metadata.MD)       {}
+
+func (s *sseSubscribeStream) writeEvent(event string, data []byte) error {
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+	return sse.WriteEvent(s.w, event, data)
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/responsewriter"
+)
+
+func TestStreamAckEndpoint(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	testAPI := &api{}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	stream := &sseSubscribeStream{
+		ctx:        ctx,
+		pubsubName: "pubsubname",
+		topic:      "topic",
+		ackCh:      make(chan *runtimev1pb.SubscribeTopicEventsRequestAlpha1),
+	}
+	testAPI.addStreamSubscription(7, stream)
+
+	fakeServer.StartServer(testAPI.constructStreamingSubscriptionEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	apiPath := apiVersionV1alpha1 + "/subscriptions/7/ack"
+
+	t.Run("ack is passed to the stream - 204", func(t *testing.T) {
+		recvCh := make(chan *runtimev1pb.SubscribeTopicEventsRequestAlpha1)
+		go func() {
+			req, err := stream.Recv()
+			assert.NoError(t, err)
+			recvCh <- req
+		}()
+
+		resp := fakeServer.DoRequest("POST", apiPath, []byte(`{"id": "1", "status": "retry"}`), nil)
+		require.Equal(t, 204, resp.StatusCode, string(resp.RawBody))
+
+		select {
+		case req := <-recvCh:
+			assert.Equal(t, "1", req.GetEventProcessed().GetId())
+			assert.Equal(t, runtimev1pb.TopicEventResponse_RETRY, req.GetEventProcessed().GetStatus().GetStatus())
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "ack not received")
+		}
+	})
+
+	t.Run("invalid ack - 400", func(t *testing.T) {
+		for _, body := range []string{`{"status": "success"}`, `{"id": "1", "status": "unknown"}`, `{`} {
+			resp := fakeServer.DoRequest("POST", apiPath, []byte(body), nil)
+			assert.Equal(t, 400, resp.StatusCode, body)
+			assert.Equal(t, "ERR_PUBSUB_STREAM_ACK", resp.ErrorBody["errorCode"], body)
+		}
+	})
+
+	t.Run("subscription not found - 404", func(t *testing.T) {
+		for _, path := range []string{"/subscriptions/8/ack", "/subscriptions/abc/ack"} {
+			resp := fakeServer.DoRequest("POST", apiVersionV1alpha1+path, []byte(`{"id": "1"}`), nil)
+			assert.Equal(t, 404, resp.StatusCode, path)
+			assert.Equal(t, "ERR_PUBSUB_STREAM_NOT_FOUND", resp.ErrorBody["errorCode"], path)
+		}
+	})
+
+	t.Run("stream closed - 404", func(t *testing.T) {
+		cancel()
+		resp := fakeServer.DoRequest("POST", apiPath, []byte(`{"id": "1"}`), nil)
+		assert.Equal(t, 404, resp.StatusCode)
+		assert.Equal(t, "ERR_PUBSUB_STREAM_NOT_FOUND", resp.ErrorBody["errorCode"])
+	})
+}
+
+func TestSSESubscribeStreamSend(t *testing.T) {
+	rec := httptest.NewRecorder()
+	stream := &sseSubscribeStream{
+		ctx: t.Context(),
+		w:   responsewriter.NewResponseWriter(rec),
+	}
+
+	require.NoError(t, stream.Send(&runtimev1pb.SubscribeTopicEventsResponseAlpha1{
+		SubscribeTopicEventsResponseType: &runtimev1pb.SubscribeTopicEventsResponseAlpha1_InitialResponse{
+			InitialResponse: new(runtimev1pb.SubscribeTopicEventsResponseInitialAlpha1),
+		},
+	}))
+	assert.Empty(t, rec.Body.String())
+
+	require.NoError(t, stream.Send(&runtimev1pb.SubscribeTopicEventsResponseAlpha1{
+		SubscribeTopicEventsResponseType: &runtimev1pb.SubscribeTopicEventsResponseAlpha1_EventMessage{
+			EventMessage: &runtimev1pb.TopicEventRequest{Id: "1", Topic: "topic"},
+		},
+	}))
+	assert.Regexp(t, `^event: message\ndata: \{"id":\s*"1",\s*"topic":\s*"topic"\}\n\n$`, rec.Body.String())
+}
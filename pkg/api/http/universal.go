@@ -23,6 +23,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
+	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/messages"
 )
 
@@ -77,7 +78,7 @@ func UniversalHTTPHandler[T proto.Message, U proto.Message](
 			if err != nil {
 				msg := messages.ErrBodyRead.WithFormat(err)
 				respondWithError(w, msg)
-				log.Debug(msg)
+				diag.LoggerWithTraceContext(r.Context(), log).Debug(msg)
 				return
 			}
 
@@ -86,7 +87,7 @@ func UniversalHTTPHandler[T proto.Message, U proto.Message](
 				if err != nil {
 					msg := messages.ErrMalformedRequest.WithFormat(err)
 					respondWithError(w, msg)
-					log.Debug(msg)
+					diag.LoggerWithTraceContext(r.Context(), log).Debug(msg)
 					return
 				}
 			}
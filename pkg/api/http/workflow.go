@@ -258,6 +258,56 @@ func (a *api) constructWorkflowEndpoints() []endpoints.Endpoint {
 				Name: "PurgeWorkflow",
 			},
 		},
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "workflows/{workflowComponent}",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupWorkflowV1Alpha1,
+			Handler: a.onListWorkflows,
+			Settings: endpoints.EndpointSettings{
+				Name: "ListWorkflows",
+			},
+		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "workflows/{workflowComponent}/{instanceID}/events",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupWorkflowV1Alpha1,
+			Handler: a.onRaiseEventsWorkflow,
+			Settings: endpoints.EndpointSettings{
+				Name: "RaiseEventsWorkflow",
+			},
+		},
+		{
+			Methods: []string{http.MethodDelete},
+			Route:   "workflows/{workflowComponent}/schedules/{scheduleID}",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupWorkflowV1Alpha1,
+			Handler: a.onDeleteWorkflowSchedule,
+			Settings: endpoints.EndpointSettings{
+				Name: "DeleteWorkflowSchedule",
+			},
+		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "workflows/{workflowComponent}/{instanceID}/rewind",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupWorkflowV1Alpha1,
+			Handler: a.onRewindWorkflow,
+			Settings: endpoints.EndpointSettings{
+				Name: "RewindWorkflow",
+			},
+		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "workflows/{workflowComponent}/{instanceID}/checkpoints/{checkpoint}",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupWorkflowV1Alpha1,
+			Handler: a.onPauseWorkflowAtCheckpoint,
+			Settings: endpoints.EndpointSettings{
+				Name: "PauseWorkflowAtCheckpoint",
+			},
+		},
 	}
 }
 
@@ -275,6 +325,7 @@ func (a *api) onStartWorkflowHandler() http.HandlerFunc {
 				in.WorkflowName = chi.URLParam(r, workflowName)
 				in.WorkflowComponent = chi.URLParam(r, workflowComponent)
 				in.InstanceId = r.URL.Query().Get(instanceID)
+				in.Options = workflowScheduleOptions(r)
 
 				// We accept the HTTP request body as the input to the workflow
 				// without making any assumptions about its format.
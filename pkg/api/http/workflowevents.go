@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/dapr/components-contrib/workflows"
+	"github.com/dapr/dapr/pkg/messages"
+)
+
+// maxRaiseEventsBatchSize is the maximum number of events raised to a
+// workflow instance in a single request.
+const maxRaiseEventsBatchSize = 100
+
+// raiseEvent is an event of the raise events API. The data is passed to the
+// workflow as is, without making any assumptions about its format.
+type raiseEvent struct {
+	Name string          `json:"name"`
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// Route: POST "workflows/{workflowComponent}/{instanceID}/events"
+// The body is a JSON array of events, e.g. [{"name": "approval", "data": true}].
+func (a *api) onRaiseEventsWorkflow(w http.ResponseWriter, r *http.Request) {
+	var body []raiseEvent
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		respondWithError(w, messages.ErrMalformedRequest.WithFormat(err))
+		return
+	}
+	if len(body) == 0 || len(body) > maxRaiseEventsBatchSize {
+		respondWithError(w, messages.ErrBadRequest.WithFormat("the number of events must be between 1 and 100"))
+		return
+	}
+
+	events := make([]*workflows.RaiseEventRequest, len(body))
+	for i, e := range body {
+		events[i] = &workflows.RaiseEventRequest{EventName: e.Name}
+		if len(e.Data) > 0 {
+			events[i].EventData = wrapperspb.String(string(e.Data))
+		}
+	}
+
+	if err := a.universal.RaiseEventsWorkflow(r.Context(), chi.URLParam(r, instanceID), events); err != nil {
+		respondWithError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/workflows"
+	actorsfake "github.com/dapr/dapr/pkg/actors/fake"
+	"github.com/dapr/dapr/pkg/api/universal"
+	"github.com/dapr/dapr/pkg/healthz"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/fake"
+	"github.com/dapr/kit/logger"
+)
+
+func TestRaiseEventsWorkflowEndpoint(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	wf := fake.New()
+	testAPI := &api{
+		healthz: healthz.New(),
+		universal: universal.New(universal.Options{
+			Logger:         logger.NewLogger("test.api.http.workflowevents"),
+			AppID:          "fakeAPI",
+			Resiliency:     resiliency.New(nil),
+			WorkflowEngine: wf,
+			Actors:         actorsfake.New(),
+		}),
+	}
+	fakeServer.StartServer(testAPI.constructWorkflowEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	const path = "v1.0-alpha1/workflows/dapr/abc/events"
+
+	t.Run("raise events", func(t *testing.T) {
+		var gotID string
+		var got []*workflows.RaiseEventRequest
+		wf.WithRaiseEvents(func(_ context.Context, instanceID string, events []*workflows.RaiseEventRequest) error {
+			gotID, got = instanceID, events
+			return nil
+		})
+
+		resp := fakeServer.DoRequest("POST", path, []byte(`[{"name":"approval","data":{"ok":true}},{"name":"ping"}]`), nil)
+		require.Equal(t, 202, resp.StatusCode, string(resp.RawBody))
+		assert.Equal(t, "abc", gotID)
+		require.Len(t, got, 2)
+		assert.Equal(t, "approval", got[0].EventName)
+		assert.Equal(t, "abc", got[0].InstanceID)
+		assert.JSONEq(t, `{"ok":true}`, got[0].EventData.GetValue())
+		assert.Equal(t, "ping", got[1].EventName)
+		assert.Nil(t, got[1].EventData)
+	})
+
+	t.Run("malformed body", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", path, []byte(`{"name":"approval"}`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+	})
+
+	t.Run("empty batch", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", path, []byte(`[]`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+	})
+
+	t.Run("batch too large", func(t *testing.T) {
+		body := "[" + strings.Repeat(`{"name":"a"},`, maxRaiseEventsBatchSize) + `{"name":"a"}]`
+		resp := fakeServer.DoRequest("POST", path, []byte(body), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+	})
+
+	t.Run("missing event name", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", path, []byte(`[{"name":""}]`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+	})
+
+	t.Run("engine failure", func(t *testing.T) {
+		wf.WithRaiseEvents(func(context.Context, string, []*workflows.RaiseEventRequest) error {
+			return errors.New("boom")
+		})
+
+		resp := fakeServer.DoRequest("POST", path, []byte(`[{"name":"ping"}]`), nil)
+		assert.Equal(t, 500, resp.StatusCode)
+		assert.Equal(t, "ERR_RAISE_EVENT_WORKFLOW", resp.ErrorBody["errorCode"])
+	})
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dapr/dapr/pkg/messages"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/state/list"
+	"github.com/dapr/kit/ptr"
+)
+
+// tagQueryParamPrefix is the prefix of the query parameters filtering workflow
+// instances by tag, e.g. "tag.team=payments".
+const tagQueryParamPrefix = "tag."
+
+// workflowInfo is a workflow instance returned by the list workflows API.
+type workflowInfo struct {
+	InstanceID    string            `json:"instanceID"`
+	WorkflowName  string            `json:"workflowName"`
+	RuntimeStatus string            `json:"runtimeStatus"`
+	CreatedAt     string            `json:"createdAt"`
+	LastUpdatedAt string            `json:"lastUpdatedAt"`
+	Tags          map[string]string `json:"tags,omitempty"`
+}
+
+type listWorkflowsResponse struct {
+	Workflows         []workflowInfo `json:"workflows"`
+	ContinuationToken string         `json:"continuationToken,omitempty"`
+}
+
+// onListWorkflows lists the workflow instances matching a filter.
+// Supported query parameters:
+// - status (repeatable)
+// - name
+// - createdAfter (RFC3339)
+// - createdBefore (RFC3339)
+// - tag.<name> (repeatable)
+// - pageSize
+// - continuationToken
+func (a *api) onListWorkflows(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, err := listWorkflowsRequest(r)
+	if err != nil {
+		respondWithError(w, err)
+		log.Debug(err)
+		return
+	}
+
+	page, err := a.universal.ListWorkflows(ctx, req)
+	if err != nil {
+		respondWithError(w, err)
+		return
+	}
+
+	res := listWorkflowsResponse{
+		Workflows: make([]workflowInfo, len(page.Workflows)),
+	}
+	for i, wf := range page.Workflows {
+		res.Workflows[i] = workflowInfo{
+			InstanceID:    wf.InstanceID,
+			WorkflowName:  wf.Name,
+			RuntimeStatus: wf.RuntimeStatus,
+			CreatedAt:     wf.CreatedAt.Format(time.RFC3339Nano),
+			LastUpdatedAt: wf.LastUpdatedAt.Format(time.RFC3339Nano),
+			Tags:          wf.Tags,
+		}
+	}
+	if page.ContinuationToken != nil {
+		res.ContinuationToken = *page.ContinuationToken
+	}
+
+	respondWithJSON(w, http.StatusOK, res)
+}
+
+func listWorkflowsRequest(r *http.Request) (*list.ListWorkflowsRequest, error) {
+	req := &list.ListWorkflowsRequest{}
+
+	query := r.URL.Query()
+	req.Filter.RuntimeStatuses = query["status"]
+	req.Filter.Name = query.Get("name")
+	for param, dst := range map[string]**time.Time{
+		"createdAfter":  &req.Filter.CreatedAfter,
+		"createdBefore": &req.Filter.CreatedBefore,
+	} {
+		if !query.Has(param) {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, query.Get(param))
+		if err != nil {
+			return nil, messages.ErrBadRequest.WithFormat("invalid " + param + ": " + err.Error())
+		}
+		*dst = &t
+	}
+	for param, values := range query {
+		tag, ok := strings.CutPrefix(param, tagQueryParamPrefix)
+		if !ok || tag == "" {
+			continue
+		}
+		if req.Filter.Tags == nil {
+			req.Filter.Tags = make(map[string]string)
+		}
+		req.Filter.Tags[tag] = values[0]
+	}
+
+	if query.Has("pageSize") {
+		pageSize, err := strconv.ParseUint(query.Get("pageSize"), 10, 32)
+		if err != nil || pageSize == 0 {
+			return nil, messages.ErrBadRequest.WithFormat("invalid pageSize: must be a positive integer")
+		}
+		req.PageSize = ptr.Of(uint32(pageSize))
+	}
+	if token := query.Get("continuationToken"); token != "" {
+		req.ContinuationToken = ptr.Of(token)
+	}
+
+	return req, nil
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	actorsfake "github.com/dapr/dapr/pkg/actors/fake"
+	"github.com/dapr/dapr/pkg/api/universal"
+	"github.com/dapr/dapr/pkg/healthz"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/fake"
+	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/state/list"
+	"github.com/dapr/kit/logger"
+	"github.com/dapr/kit/ptr"
+)
+
+func TestListWorkflowsEndpoint(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	wf := fake.New()
+	testAPI := &api{
+		healthz: healthz.New(),
+		universal: universal.New(universal.Options{
+			Logger:         logger.NewLogger("test.api.http.workflowlist"),
+			AppID:          "fakeAPI",
+			Resiliency:     resiliency.New(nil),
+			WorkflowEngine: wf,
+			Actors:         actorsfake.New(),
+		}),
+	}
+	fakeServer.StartServer(testAPI.constructWorkflowEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	createdAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("list workflows", func(t *testing.T) {
+		var got *list.ListWorkflowsRequest
+		wf.WithListWorkflows(func(_ context.Context, req *list.ListWorkflowsRequest) (*list.ListWorkflowsResponse, error) {
+			got = req
+			return &list.ListWorkflowsResponse{
+				Workflows: []list.Workflow{{
+					InstanceID: "abc",
+					IndexEntry: wfenginestate.IndexEntry{
+						Name:          "order",
+						RuntimeStatus: "RUNNING",
+						CreatedAt:     createdAt,
+						LastUpdatedAt: createdAt.Add(time.Minute),
+						Tags:          map[string]string{"team": "payments"},
+					},
+				}},
+				ContinuationToken: ptr.Of("next"),
+			}, nil
+		})
+
+		resp := fakeServer.DoRequest("GET", "v1.0-alpha1/workflows/dapr?status=RUNNING&status=SUSPENDED&name=order&createdAfter=2025-12-01T00:00:00Z&tag.team=payments&pageSize=10&continuationToken=abc", nil, nil)
+		require.Equal(t, 200, resp.StatusCode, string(resp.RawBody))
+
+		assert.Equal(t, []string{"RUNNING", "SUSPENDED"}, got.Filter.RuntimeStatuses)
+		assert.Equal(t, "order", got.Filter.Name)
+		assert.Equal(t, "2025-12-01T00:00:00Z", got.Filter.CreatedAfter.Format(time.RFC3339))
+		assert.Nil(t, got.Filter.CreatedBefore)
+		assert.Equal(t, map[string]string{"team": "payments"}, got.Filter.Tags)
+		assert.Equal(t, uint32(10), *got.PageSize)
+		assert.Equal(t, "abc", *got.ContinuationToken)
+
+		var res listWorkflowsResponse
+		require.NoError(t, json.Unmarshal(resp.RawBody, &res))
+		require.Len(t, res.Workflows, 1)
+		assert.Equal(t, "abc", res.Workflows[0].InstanceID)
+		assert.Equal(t, "order", res.Workflows[0].WorkflowName)
+		assert.Equal(t, "2026-01-01T00:00:00Z", res.Workflows[0].CreatedAt)
+		assert.Equal(t, "next", res.ContinuationToken)
+	})
+
+	t.Run("invalid filters", func(t *testing.T) {
+		for _, query := range []string{"createdBefore=yesterday", "pageSize=0", "pageSize=many"} {
+			resp := fakeServer.DoRequest("GET", "v1.0-alpha1/workflows/dapr?"+query, nil, nil)
+			assert.Equal(t, 400, resp.StatusCode, query)
+			assert.Equal(t, "ERR_BAD_REQUEST", resp.ErrorBody["errorCode"], query)
+		}
+	})
+
+	t.Run("listing fails", func(t *testing.T) {
+		wf.WithListWorkflows(func(context.Context, *list.ListWorkflowsRequest) (*list.ListWorkflowsResponse, error) {
+			return nil, errors.New("store does not support listing keys")
+		})
+
+		resp := fakeServer.DoRequest("GET", "v1.0-alpha1/workflows/dapr", nil, nil)
+		assert.Equal(t, 500, resp.StatusCode)
+		assert.Equal(t, "ERR_LIST_WORKFLOWS", resp.ErrorBody["errorCode"])
+	})
+}
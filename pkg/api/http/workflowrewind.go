@@ -0,0 +1,43 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Route: POST "workflows/{workflowComponent}/{instanceID}/rewind?reason={reason}"
+func (a *api) onRewindWorkflow(w http.ResponseWriter, r *http.Request) {
+	err := a.universal.RewindWorkflow(r.Context(), chi.URLParam(r, "instanceID"), r.URL.Query().Get("reason"))
+	if err != nil {
+		respondWithError(w, err)
+		return
+	}
+
+	respondWithEmpty(w)
+}
+
+// Route: POST "workflows/{workflowComponent}/{instanceID}/checkpoints/{checkpoint}"
+// The workflow is resumed from the checkpoint with the resume workflow API.
+func (a *api) onPauseWorkflowAtCheckpoint(w http.ResponseWriter, r *http.Request) {
+	err := a.universal.PauseWorkflowAtCheckpoint(r.Context(), chi.URLParam(r, "instanceID"), chi.URLParam(r, "checkpoint"))
+	if err != nil {
+		respondWithError(w, err)
+		return
+	}
+
+	respondWithEmpty(w)
+}
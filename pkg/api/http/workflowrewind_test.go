@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	actorsfake "github.com/dapr/dapr/pkg/actors/fake"
+	"github.com/dapr/dapr/pkg/api/universal"
+	"github.com/dapr/dapr/pkg/healthz"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/fake"
+	"github.com/dapr/kit/logger"
+)
+
+func TestWorkflowRewindEndpoints(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	wf := fake.New()
+	testAPI := &api{
+		healthz: healthz.New(),
+		universal: universal.New(universal.Options{
+			Logger:         logger.NewLogger("test.api.http.workflowrewind"),
+			AppID:          "fakeAPI",
+			Resiliency:     resiliency.New(nil),
+			WorkflowEngine: wf,
+			Actors:         actorsfake.New(),
+		}),
+	}
+	fakeServer.StartServer(testAPI.constructWorkflowEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	t.Run("rewind", func(t *testing.T) {
+		var gotID, gotReason string
+		wf.WithRewindWorkflow(func(_ context.Context, instanceID string, reason string) error {
+			gotID, gotReason = instanceID, reason
+			return nil
+		})
+
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/workflows/dapr/abc/rewind?reason=fixed+upstream", nil, nil)
+		require.Equal(t, 204, resp.StatusCode, string(resp.RawBody))
+		assert.Equal(t, "abc", gotID)
+		assert.Equal(t, "fixed upstream", gotReason)
+	})
+
+	t.Run("rewind fails", func(t *testing.T) {
+		wf.WithRewindWorkflow(func(context.Context, string, string) error {
+			return errors.New("boom")
+		})
+
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/workflows/dapr/abc/rewind", nil, nil)
+		require.Equal(t, 500, resp.StatusCode)
+		assert.Equal(t, "ERR_REWIND_WORKFLOW", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("pause at checkpoint", func(t *testing.T) {
+		var gotID, gotCheckpoint string
+		wf.WithPauseWorkflowAtCheckpoint(func(_ context.Context, instanceID string, checkpoint string) error {
+			gotID, gotCheckpoint = instanceID, checkpoint
+			return nil
+		})
+
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/workflows/dapr/abc/checkpoints/ApprovePayment", nil, nil)
+		require.Equal(t, 204, resp.StatusCode, string(resp.RawBody))
+		assert.Equal(t, "abc", gotID)
+		assert.Equal(t, "ApprovePayment", gotCheckpoint)
+	})
+
+	t.Run("pause at checkpoint fails", func(t *testing.T) {
+		wf.WithPauseWorkflowAtCheckpoint(func(context.Context, string, string) error {
+			return errors.New("boom")
+		})
+
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/workflows/dapr/abc/checkpoints/ApprovePayment", nil, nil)
+		require.Equal(t, 500, resp.StatusCode)
+		assert.Equal(t, "ERR_PAUSE_WORKFLOW_AT_CHECKPOINT", resp.ErrorBody["errorCode"])
+	})
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/dapr/dapr/pkg/runtime/wfengine/schedule"
+)
+
+// workflowScheduleQueryParams maps the query parameters of the start workflow
+// API to the options scheduling the workflow.
+var workflowScheduleQueryParams = map[string]string{
+	"schedule":         schedule.CronKey,
+	"startDelay":       schedule.StartDelayKey,
+	"scheduleIDPolicy": schedule.IDPolicyKey,
+}
+
+// workflowScheduleOptions returns the options scheduling a workflow from the
+// query parameters of the start workflow API, or nil if there are none.
+func workflowScheduleOptions(r *http.Request) map[string]string {
+	var opts map[string]string
+	query := r.URL.Query()
+	for param, key := range workflowScheduleQueryParams {
+		if !query.Has(param) {
+			continue
+		}
+		if opts == nil {
+			opts = make(map[string]string, len(workflowScheduleQueryParams))
+		}
+		opts[key] = query.Get(param)
+	}
+	return opts
+}
+
+// Route: DELETE "workflows/{workflowComponent}/schedules/{scheduleID}"
+func (a *api) onDeleteWorkflowSchedule(w http.ResponseWriter, r *http.Request) {
+	err := a.universal.DeleteWorkflowSchedule(r.Context(), chi.URLParam(r, "scheduleID"))
+	if err != nil {
+		respondWithError(w, err)
+		return
+	}
+
+	respondWithEmpty(w)
+}
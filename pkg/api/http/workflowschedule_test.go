@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/workflows"
+	actorsfake "github.com/dapr/dapr/pkg/actors/fake"
+	"github.com/dapr/dapr/pkg/api/universal"
+	"github.com/dapr/dapr/pkg/healthz"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/fake"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/schedule"
+	"github.com/dapr/kit/logger"
+)
+
+func TestWorkflowScheduleEndpoints(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	wf := fake.New()
+	testAPI := &api{
+		healthz: healthz.New(),
+		universal: universal.New(universal.Options{
+			Logger:         logger.NewLogger("test.api.http.workflowschedule"),
+			AppID:          "fakeAPI",
+			Resiliency:     resiliency.New(nil),
+			WorkflowEngine: wf,
+			Actors:         actorsfake.New(),
+		}),
+	}
+	fakeServer.StartServer(testAPI.constructWorkflowEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	t.Run("start on a schedule", func(t *testing.T) {
+		var got *workflows.StartRequest
+		wf.WithClient(func() workflows.Workflow {
+			return fake.NewClient().WithStart(func(_ context.Context, req *workflows.StartRequest) (*workflows.StartResponse, error) {
+				got = req
+				return &workflows.StartResponse{InstanceID: *req.InstanceID}, nil
+			})
+		})
+
+		resp := fakeServer.DoRequest("POST", "v1.0/workflows/dapr/report/start?instanceID=nightly&schedule=0+0+0+*+*+*&scheduleIDPolicy=skip_if_running", nil, nil)
+		require.Equal(t, 202, resp.StatusCode, string(resp.RawBody))
+		assert.Equal(t, map[string]string{
+			schedule.CronKey:     "0 0 0 * * *",
+			schedule.IDPolicyKey: "skip_if_running",
+		}, got.Options)
+	})
+
+	t.Run("start without a schedule", func(t *testing.T) {
+		var got *workflows.StartRequest
+		wf.WithClient(func() workflows.Workflow {
+			return fake.NewClient().WithStart(func(_ context.Context, req *workflows.StartRequest) (*workflows.StartResponse, error) {
+				got = req
+				return &workflows.StartResponse{InstanceID: *req.InstanceID}, nil
+			})
+		})
+
+		resp := fakeServer.DoRequest("POST", "v1.0/workflows/dapr/report/start?instanceID=abc", nil, nil)
+		require.Equal(t, 202, resp.StatusCode, string(resp.RawBody))
+		assert.Nil(t, got.Options)
+	})
+
+	t.Run("delete a schedule", func(t *testing.T) {
+		var got string
+		wf.WithDeleteWorkflowSchedule(func(_ context.Context, scheduleID string) error {
+			got = scheduleID
+			return nil
+		})
+
+		resp := fakeServer.DoRequest("DELETE", "v1.0-alpha1/workflows/dapr/schedules/nightly", nil, nil)
+		require.Equal(t, 204, resp.StatusCode, string(resp.RawBody))
+		assert.Equal(t, "nightly", got)
+	})
+
+	t.Run("delete a schedule fails", func(t *testing.T) {
+		wf.WithDeleteWorkflowSchedule(func(context.Context, string) error {
+			return errors.New("boom")
+		})
+
+		resp := fakeServer.DoRequest("DELETE", "v1.0-alpha1/workflows/dapr/schedules/nightly", nil, nil)
+		require.Equal(t, 500, resp.StatusCode)
+		assert.Equal(t, "ERR_DELETE_WORKFLOW_SCHEDULE", resp.ErrorBody["errorCode"])
+	})
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package policy implements the API access policy engine of the Dapr HTTP
+// and gRPC servers, which evaluates the allowed and denied API rules on each
+// request.
+package policy
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/dapr/dapr/pkg/config"
+)
+
+// Engine evaluates the API access rules against requests.
+// A nil Engine allows all requests.
+type Engine struct {
+	allowed     []config.APIAccessRule
+	denied      []config.APIAccessRule
+	defaultDeny bool
+}
+
+// Request describes a request to a Dapr API.
+type Request struct {
+	Protocol config.APIAccessRuleProtocol
+
+	// Name and version of the API group, such as "state" and "v1".
+	Name    string
+	Version string
+	// Route of the HTTP endpoint, in the format "<version>/<route>".
+	// It is used to match rules that use the path of the API as name, such as "v1.0/state".
+	Route string
+
+	// HTTP verb and path of the request.
+	Verb string
+	Path string
+	// Full gRPC method of the request.
+	Method string
+
+	// Metadata returns the value of a header or gRPC metadata key.
+	Metadata func(key string) string
+}
+
+// New returns an Engine for the API spec, or nil if the rules can be applied
+// statically when the routes are registered, which is the case when no rule
+// has conditions and the default action is to allow requests.
+func New(spec config.APISpec) (*Engine, error) {
+	var defaultDeny bool
+	switch spec.DefaultAction {
+	case "", config.APIAccessDefaultActionAllow:
+	case config.APIAccessDefaultActionDeny:
+		defaultDeny = true
+	default:
+		return nil, fmt.Errorf("invalid API default action %q: must be %q or %q", spec.DefaultAction, config.APIAccessDefaultActionAllow, config.APIAccessDefaultActionDeny)
+	}
+
+	if !defaultDeny && !spec.Allowed.HasConditions() && !spec.Denied.HasConditions() {
+		return nil, nil
+	}
+
+	e := &Engine{
+		allowed:     make([]config.APIAccessRule, len(spec.Allowed)),
+		denied:      make([]config.APIAccessRule, len(spec.Denied)),
+		defaultDeny: defaultDeny,
+	}
+	for i, rule := range spec.Allowed {
+		if err := validateRule(rule); err != nil {
+			return nil, err
+		}
+		e.allowed[i] = normalizeRule(rule)
+	}
+	for i, rule := range spec.Denied {
+		if err := validateRule(rule); err != nil {
+			return nil, err
+		}
+		e.denied[i] = normalizeRule(rule)
+	}
+
+	return e, nil
+}
+
+func validateRule(rule config.APIAccessRule) error {
+	switch config.APIAccessRuleProtocol(strings.ToLower(string(rule.Protocol))) {
+	case config.APIAccessRuleProtocolHTTP:
+		if len(rule.GRPCMethods) > 0 {
+			return fmt.Errorf("invalid API access rule for %q: grpcMethods can only be used with the grpc protocol", rule.Name)
+		}
+	case config.APIAccessRuleProtocolGRPC:
+		if len(rule.Verbs) > 0 || rule.PathPrefix != "" {
+			return fmt.Errorf("invalid API access rule for %q: verbs and pathPrefix can only be used with the http protocol", rule.Name)
+		}
+	}
+	return nil
+}
+
+func normalizeRule(rule config.APIAccessRule) config.APIAccessRule {
+	rule.Protocol = config.APIAccessRuleProtocol(strings.ToLower(string(rule.Protocol)))
+	return rule
+}
+
+// Allow returns true if the request is allowed by the policy.
+// Denied rules are evaluated first; then, the request is allowed if it matches
+// an allowed rule, or if there are no allowed rules for the protocol and the
+// default action is to allow requests.
+func (e *Engine) Allow(req Request) bool {
+	if e == nil {
+		return true
+	}
+
+	for _, rule := range e.denied {
+		if matches(rule, req) {
+			return false
+		}
+	}
+
+	var hasAllowed bool
+	for _, rule := range e.allowed {
+		if rule.Protocol != req.Protocol {
+			continue
+		}
+		hasAllowed = true
+		if matches(rule, req) {
+			return true
+		}
+	}
+
+	return !hasAllowed && !e.defaultDeny
+}
+
+func matches(rule config.APIAccessRule, req Request) bool {
+	if rule.Protocol != req.Protocol {
+		return false
+	}
+
+	if !matchesAPI(rule, req) {
+		return false
+	}
+
+	if len(rule.Verbs) > 0 && !slices.ContainsFunc(rule.Verbs, func(v string) bool {
+		return strings.EqualFold(v, req.Verb)
+	}) {
+		return false
+	}
+
+	if rule.PathPrefix != "" && !strings.HasPrefix(req.Path, rule.PathPrefix) {
+		return false
+	}
+
+	if len(rule.GRPCMethods) > 0 && !slices.ContainsFunc(rule.GRPCMethods, func(m string) bool {
+		return m == req.Method || strings.HasSuffix(req.Method, "/"+m)
+	}) {
+		return false
+	}
+
+	for key, want := range rule.Metadata {
+		if req.Metadata == nil {
+			return false
+		}
+		got := req.Metadata(key)
+		if got == "" || (want != "" && got != want) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchesAPI returns true if the rule targets the API group of the request,
+// matching the rule on the path of the HTTP endpoint as a fallback.
+func matchesAPI(rule config.APIAccessRule, req Request) bool {
+	if rule.Name == req.Name && rule.Version == req.Version {
+		return true
+	}
+	return req.Route != "" && strings.HasPrefix(req.Route, rule.Version+"/"+rule.Name)
+}
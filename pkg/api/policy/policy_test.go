@@ -0,0 +1,133 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/config"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("static rules", func(t *testing.T) {
+		e, err := New(config.APISpec{
+			Allowed: config.APIAccessRules{{Name: "state", Version: "v1", Protocol: "http"}},
+		})
+		require.NoError(t, err)
+		assert.Nil(t, e)
+		assert.True(t, e.Allow(Request{}))
+	})
+
+	t.Run("default deny", func(t *testing.T) {
+		e, err := New(config.APISpec{DefaultAction: config.APIAccessDefaultActionDeny})
+		require.NoError(t, err)
+		require.NotNil(t, e)
+		assert.False(t, e.Allow(Request{Protocol: config.APIAccessRuleProtocolHTTP, Name: "state", Version: "v1"}))
+	})
+
+	t.Run("invalid spec", func(t *testing.T) {
+		for name, spec := range map[string]config.APISpec{
+			"default action": {DefaultAction: "foo"},
+			"grpc methods on http": {Allowed: config.APIAccessRules{
+				{Name: "state", Version: "v1", Protocol: "http", GRPCMethods: []string{"GetState"}},
+			}},
+			"verbs on grpc": {Denied: config.APIAccessRules{
+				{Name: "state", Version: "v1", Protocol: "grpc", Verbs: []string{"GET"}},
+			}},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := New(spec)
+				require.Error(t, err)
+			})
+		}
+	})
+}
+
+func TestAllow(t *testing.T) {
+	e, err := New(config.APISpec{
+		Allowed: config.APIAccessRules{
+			{Name: "state", Version: "v1", Protocol: "HTTP", Verbs: []string{"GET"}},
+			{Name: "state", Version: "v1", Protocol: "http", PathPrefix: "/v1.0/state/public", Verbs: []string{"POST"}},
+			{Name: "invoke", Version: "v1.0", Protocol: "http", Verbs: []string{"POST"}},
+			{Name: "state", Version: "v1", Protocol: "grpc", GRPCMethods: []string{"GetState", "/dapr.proto.runtime.v1.Dapr/GetBulkState"}},
+			{Name: "publish", Version: "v1", Protocol: "grpc", Metadata: map[string]string{"x-tenant": "", "x-env": "prod"}},
+		},
+		Denied: config.APIAccessRules{
+			{Name: "state", Version: "v1", Protocol: "http", PathPrefix: "/v1.0/state/public/secret"},
+		},
+		DefaultAction: config.APIAccessDefaultActionDeny,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, e)
+
+	httpReq := func(verb, path string) Request {
+		return Request{
+			Protocol: config.APIAccessRuleProtocolHTTP,
+			Name:     "state",
+			Version:  "v1",
+			Route:    "v1.0/state/{storeName}",
+			Verb:     verb,
+			Path:     path,
+		}
+	}
+	grpcReq := func(name, method string, md map[string]string) Request {
+		return Request{
+			Protocol: config.APIAccessRuleProtocolGRPC,
+			Name:     name,
+			Version:  "v1",
+			Method:   "/dapr.proto.runtime.v1.Dapr/" + method,
+			Metadata: func(key string) string { return md[key] },
+		}
+	}
+
+	tests := map[string]struct {
+		req   Request
+		allow bool
+	}{
+		"http verb":                 {req: httpReq("get", "/v1.0/state/store"), allow: true},
+		"http verb not allowed":     {req: httpReq("POST", "/v1.0/state/store")},
+		"http path prefix":          {req: httpReq("POST", "/v1.0/state/public/key"), allow: true},
+		"http denied path prefix":   {req: httpReq("GET", "/v1.0/state/public/secret/key")},
+		"http unmatched api":        {req: Request{Protocol: config.APIAccessRuleProtocolHTTP, Name: "secrets", Version: "v1", Verb: "GET"}},
+		"http legacy route":         {req: Request{Protocol: config.APIAccessRuleProtocolHTTP, Route: "v1.0/invoke/{id}/method/*", Verb: "POST"}, allow: true},
+		"http legacy route verb":    {req: Request{Protocol: config.APIAccessRuleProtocolHTTP, Route: "v1.0/invoke/{id}/method/*", Verb: "GET"}},
+		"grpc method name":          {req: grpcReq("state", "GetState", nil), allow: true},
+		"grpc full method":          {req: grpcReq("state", "GetBulkState", nil), allow: true},
+		"grpc method not allowed":   {req: grpcReq("state", "SaveState", nil)},
+		"grpc metadata":             {req: grpcReq("publish", "PublishEvent", map[string]string{"x-tenant": "t1", "x-env": "prod"}), allow: true},
+		"grpc metadata wrong value": {req: grpcReq("publish", "PublishEvent", map[string]string{"x-tenant": "t1", "x-env": "dev"})},
+		"grpc metadata missing key": {req: grpcReq("publish", "PublishEvent", map[string]string{"x-env": "prod"})},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.allow, e.Allow(tt.req))
+		})
+	}
+
+	t.Run("default allow without allowed rules for the protocol", func(t *testing.T) {
+		e, err := New(config.APISpec{
+			Denied: config.APIAccessRules{
+				{Name: "state", Version: "v1", Protocol: "grpc", GRPCMethods: []string{"DeleteState"}},
+			},
+		})
+		require.NoError(t, err)
+		assert.False(t, e.Allow(grpcReq("state", "DeleteState", nil)))
+		assert.True(t, e.Allow(grpcReq("state", "GetState", nil)))
+		assert.True(t, e.Allow(httpReq("DELETE", "/v1.0/state/store/key")))
+	})
+}
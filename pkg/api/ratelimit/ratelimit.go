@@ -0,0 +1,89 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ratelimit implements the per API group rate limits of the Dapr
+// HTTP and gRPC servers.
+package ratelimit
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/time/rate"
+
+	"github.com/dapr/dapr/pkg/config"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+)
+
+// Limiter is a set of token-bucket rate limiters, one per API group.
+// A nil Limiter allows all requests.
+type Limiter struct {
+	limiters map[string]*rate.Limiter
+}
+
+// New returns a Limiter for the given rules, or nil if there are none.
+func New(rules []config.APIRateLimit) (*Limiter, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	l := &Limiter{
+		limiters: make(map[string]*rate.Limiter, len(rules)),
+	}
+	for _, rule := range rules {
+		if rule.Name == "" {
+			return nil, errors.New("invalid API rate limit: name is required")
+		}
+		if _, ok := l.limiters[rule.Name]; ok {
+			return nil, fmt.Errorf("invalid API rate limit for %q: defined more than once", rule.Name)
+		}
+		if rule.RequestsPerSecond <= 0 {
+			return nil, fmt.Errorf("invalid API rate limit for %q: requestsPerSecond must be greater than zero", rule.Name)
+		}
+		if rule.Burst < 0 {
+			return nil, fmt.Errorf("invalid API rate limit for %q: burst must not be negative", rule.Name)
+		}
+
+		burst := rule.Burst
+		if burst == 0 {
+			burst = rule.RequestsPerSecond
+		}
+		l.limiters[rule.Name] = rate.NewLimiter(rate.Limit(rule.RequestsPerSecond), burst)
+	}
+
+	return l, nil
+}
+
+// Has returns true if requests to the API group are rate limited.
+func (l *Limiter) Has(group string) bool {
+	if l == nil {
+		return false
+	}
+	_, ok := l.limiters[group]
+	return ok
+}
+
+// Allow returns true if a request to the API group can be processed now,
+// consuming a token from the group's bucket.
+func (l *Limiter) Allow(group string) bool {
+	if l == nil {
+		return true
+	}
+	limiter, ok := l.limiters[group]
+	if !ok || limiter.Allow() {
+		return true
+	}
+
+	diag.DefaultMonitoring.APIRequestRateLimited(group)
+	return false
+}
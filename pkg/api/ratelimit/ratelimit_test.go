@@ -0,0 +1,72 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/config"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("no rules", func(t *testing.T) {
+		l, err := New(nil)
+		require.NoError(t, err)
+		assert.Nil(t, l)
+		assert.True(t, l.Allow("state"))
+		assert.False(t, l.Has("state"))
+	})
+
+	t.Run("invalid rules", func(t *testing.T) {
+		for name, rules := range map[string][]config.APIRateLimit{
+			"missing name":   {{RequestsPerSecond: 1}},
+			"zero rate":      {{Name: "state"}},
+			"negative burst": {{Name: "state", RequestsPerSecond: 1, Burst: -1}},
+			"duplicate":      {{Name: "state", RequestsPerSecond: 1}, {Name: "state", RequestsPerSecond: 2}},
+			"negative rate":  {{Name: "state", RequestsPerSecond: -1}},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := New(rules)
+				require.Error(t, err)
+			})
+		}
+	})
+}
+
+func TestAllow(t *testing.T) {
+	l, err := New([]config.APIRateLimit{
+		{Name: "state", RequestsPerSecond: 1, Burst: 2},
+		{Name: "publish", RequestsPerSecond: 1},
+	})
+	require.NoError(t, err)
+
+	assert.True(t, l.Has("state"))
+	assert.False(t, l.Has("invoke"))
+
+	assert.True(t, l.Allow("state"))
+	assert.True(t, l.Allow("state"))
+	assert.False(t, l.Allow("state"))
+
+	// Burst defaults to the rate
+	assert.True(t, l.Allow("publish"))
+	assert.False(t, l.Allow("publish"))
+
+	// Groups without a limit are not affected
+	for range 10 {
+		assert.True(t, l.Allow("invoke"))
+	}
+}
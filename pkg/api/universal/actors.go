@@ -27,6 +27,7 @@ import (
 
 	"github.com/dapr/dapr/pkg/actors/api"
 	"github.com/dapr/dapr/pkg/actors/reminders"
+	actortimers "github.com/dapr/dapr/pkg/actors/timers"
 	"github.com/dapr/dapr/pkg/messages"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	"github.com/dapr/kit/ptr"
@@ -43,14 +44,14 @@ func (a *Universal) RegisterActorTimer(ctx context.Context, in *runtimev1pb.Regi
 		b, err = json.Marshal(b)
 		if err != nil {
 			err = messages.ErrMalformedRequest.WithFormat(err)
-			a.logger.Debug(err)
+			a.traceLogger(ctx).Debug(err)
 			return nil, err
 		}
 
 		data, err = anypb.New(wrapperspb.Bytes(b))
 		if err != nil {
 			err = messages.ErrMalformedRequest.WithFormat(err)
-			a.logger.Debug(err)
+			a.traceLogger(ctx).Debug(err)
 			return nil, err
 		}
 	}
@@ -68,8 +69,14 @@ func (a *Universal) RegisterActorTimer(ctx context.Context, in *runtimev1pb.Regi
 
 	err = timers.Create(ctx, req)
 	if err != nil {
+		if errors.Is(err, actortimers.ErrTimerOpActorReadReplica) {
+			err = messages.ErrActorReadReplica.WithFormat(req.ActorKey())
+			a.traceLogger(ctx).Debug(err)
+			return nil, err
+		}
+
 		err = messages.ErrActorTimerCreate.WithFormat(err)
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return nil, err
 	}
 	return nil, nil
@@ -102,14 +109,14 @@ func (a *Universal) RegisterActorReminder(ctx context.Context, in *runtimev1pb.R
 		b, err = json.Marshal(b)
 		if err != nil {
 			err = messages.ErrMalformedRequest.WithFormat(err)
-			a.logger.Debug(err)
+			a.traceLogger(ctx).Debug(err)
 			return nil, err
 		}
 
 		data, err = anypb.New(wrapperspb.Bytes(b))
 		if err != nil {
 			err = messages.ErrMalformedRequest.WithFormat(err)
-			a.logger.Debug(err)
+			a.traceLogger(ctx).Debug(err)
 			return nil, err
 		}
 	}
@@ -130,11 +137,14 @@ func (a *Universal) RegisterActorReminder(ctx context.Context, in *runtimev1pb.R
 
 	err = r.Create(ctx, req)
 	if err != nil {
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 
 		if errors.Is(err, reminders.ErrReminderOpActorNotHosted) {
 			return nil, messages.ErrActorReminderOpActorNotHosted
 		}
+		if errors.Is(err, reminders.ErrReminderOpActorReadReplica) {
+			return nil, messages.ErrActorReadReplica.WithFormat(req.ActorKey())
+		}
 
 		status, ok := status.FromError(err)
 		if ok && status.Code() == codes.AlreadyExists {
@@ -162,12 +172,12 @@ func (a *Universal) UnregisterActorReminder(ctx context.Context, in *runtimev1pb
 	err = r.Delete(ctx, req)
 	if err != nil {
 		if errors.Is(err, reminders.ErrReminderOpActorNotHosted) {
-			a.logger.Debug(messages.ErrActorReminderOpActorNotHosted)
+			a.traceLogger(ctx).Debug(messages.ErrActorReminderOpActorNotHosted)
 			return nil, messages.ErrActorReminderOpActorNotHosted
 		}
 
 		err = messages.ErrActorReminderDelete.WithFormat(err)
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return nil, err
 	}
 	return nil, err
@@ -185,7 +195,7 @@ func (a *Universal) GetActorReminder(ctx context.Context, in *runtimev1pb.GetAct
 		ActorType: in.GetActorType(),
 	})
 	if err != nil {
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 
 		if errors.Is(err, reminders.ErrReminderOpActorNotHosted) {
 			return nil, messages.ErrActorReminderOpActorNotHosted
@@ -239,12 +249,12 @@ func (a *Universal) UnregisterActorRemindersByType(ctx context.Context, in *runt
 	err = r.DeleteByActorID(ctx, req)
 	if err != nil {
 		if errors.Is(err, reminders.ErrReminderOpActorNotHosted) {
-			a.logger.Debug(messages.ErrActorReminderOpActorNotHosted)
+			a.traceLogger(ctx).Debug(messages.ErrActorReminderOpActorNotHosted)
 			return nil, messages.ErrActorReminderOpActorNotHosted
 		}
 
 		err = messages.ErrActorReminderDelete.WithFormat(err)
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return nil, err
 	}
 
@@ -264,11 +274,11 @@ func (a *Universal) ListActorReminders(ctx context.Context, req *runtimev1pb.Lis
 	})
 	if err != nil {
 		if errors.Is(err, reminders.ErrReminderOpActorNotHosted) {
-			a.logger.Debug(messages.ErrActorReminderOpActorNotHosted)
+			a.traceLogger(ctx).Debug(messages.ErrActorReminderOpActorNotHosted)
 			return nil, messages.ErrActorReminderOpActorNotHosted
 		}
 
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return nil, err
 	}
 
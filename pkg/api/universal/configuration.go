@@ -0,0 +1,219 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	configurationLoader "github.com/dapr/dapr/pkg/components/configuration"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/messages"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/channels"
+)
+
+// Metadata keys of GetConfiguration and SubscribeConfiguration requests which
+// filter and paginate the items. They aren't passed to the configuration
+// store.
+const (
+	// ConfigurationKeyPrefixKey is a comma-separated list of key prefixes.
+	ConfigurationKeyPrefixKey = "keyPrefix"
+	// ConfigurationLabelSelectorKey is a comma-separated list of "label=value"
+	// pairs, matched against the metadata of the items.
+	ConfigurationLabelSelectorKey     = "labelSelector"
+	ConfigurationPageSizeKey          = "pageSize"
+	ConfigurationContinuationTokenKey = "continuationToken"
+	// ConfigurationDeadLetterRouteKey is the route of the app which receives
+	// the items of a subscription failing validation.
+	ConfigurationDeadLetterRouteKey = "deadLetterRoute"
+)
+
+// ConfigurationListRequest returns the list request of a GetConfiguration
+// request with filters or pagination, or nil if it has none.
+func ConfigurationListRequest(keys []string, md map[string]string) (*configurationLoader.ListRequest, error) {
+	filter, err := configurationFilter(md)
+	if err != nil {
+		return nil, err
+	}
+	req := &configurationLoader.ListRequest{
+		Keys:              keys,
+		Filter:            filter,
+		ContinuationToken: md[ConfigurationContinuationTokenKey],
+	}
+	if v := md[ConfigurationPageSizeKey]; v != "" {
+		pageSize, err := strconv.Atoi(v)
+		if err != nil || pageSize <= 0 {
+			return nil, messages.ErrBadRequest.WithFormat(fmt.Sprintf("invalid %s: must be a positive integer", ConfigurationPageSizeKey))
+		}
+		req.PageSize = pageSize
+	}
+	if req.Filter.IsEmpty() && req.PageSize == 0 && req.ContinuationToken == "" {
+		return nil, nil
+	}
+
+	req.Metadata = configurationStoreMetadata(md)
+	return req, nil
+}
+
+// ConfigurationSubscribeFilter returns the filter of a SubscribeConfiguration
+// request, and the metadata of the request for the configuration store.
+func ConfigurationSubscribeFilter(md map[string]string) (*configurationLoader.Filter, map[string]string, error) {
+	if md[ConfigurationPageSizeKey] != "" || md[ConfigurationContinuationTokenKey] != "" {
+		return nil, nil, messages.ErrBadRequest.WithFormat("subscriptions can't be paginated")
+	}
+	filter, err := configurationFilter(md)
+	if err != nil {
+		return nil, nil, err
+	}
+	if filter.IsEmpty() {
+		if md[ConfigurationDeadLetterRouteKey] != "" {
+			md = configurationStoreMetadata(md)
+		}
+		return nil, md, nil
+	}
+	return &filter, configurationStoreMetadata(md), nil
+}
+
+// configurationDeadLetter is the body of the dead-letter callback of a
+// configuration subscription.
+type configurationDeadLetter struct {
+	ID        string                                  `json:"id"`
+	StoreName string                                  `json:"storeName"`
+	Items     map[string]*configurationDeadLetterItem `json:"items"`
+}
+
+type configurationDeadLetterItem struct {
+	Value    string            `json:"value"`
+	Version  string            `json:"version,omitempty"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+	Error    string            `json:"error"`
+}
+
+// ConfigurationInvalidHandler returns the handler of the items of a
+// configuration subscription which failed validation. The failures are logged
+// and recorded in the metrics. If the subscription has a dead-letter route,
+// the items are also posted to it through the app channel.
+func (a *Universal) ConfigurationInvalidHandler(storeName string, md map[string]string, chans *channels.Channels) configurationLoader.InvalidHandler {
+	route := md[ConfigurationDeadLetterRouteKey]
+	return func(ctx context.Context, id string, invalid []configurationLoader.InvalidItem) {
+		action := diag.ConfigurationValidationDrop
+		if route != "" {
+			action = diag.ConfigurationValidationDeadLetter
+		}
+		for _, item := range invalid {
+			a.traceLogger(ctx).Warnf("Configuration item %s of store %s failed validation and was not delivered to subscription %s: %v", item.Key, storeName, id, item.Err)
+			diag.DefaultComponentMonitoring.ConfigurationValidationFailed(ctx, storeName, action)
+		}
+		if route == "" {
+			return
+		}
+		if err := a.sendConfigurationDeadLetter(ctx, storeName, route, id, invalid, chans); err != nil {
+			a.traceLogger(ctx).Errorf("Error sending invalid configuration items of store %s to dead-letter route %s: %v", storeName, route, err)
+		}
+	}
+}
+
+func (a *Universal) sendConfigurationDeadLetter(ctx context.Context, storeName, route, id string, invalid []configurationLoader.InvalidItem, chans *channels.Channels) error {
+	if chans == nil || chans.AppChannel() == nil {
+		return errors.New(messages.ErrChannelNotFound)
+	}
+	appChannel := chans.AppChannel()
+
+	body := &configurationDeadLetter{
+		ID:        id,
+		StoreName: storeName,
+		Items:     make(map[string]*configurationDeadLetterItem, len(invalid)),
+	}
+	for _, item := range invalid {
+		body.Items[item.Key] = &configurationDeadLetterItem{
+			Value:    item.Item.Value,
+			Version:  item.Item.Version,
+			Metadata: item.Item.Metadata,
+			Error:    item.Err.Error(),
+		}
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	policyDef := a.resiliency.ComponentInboundPolicy(storeName, resiliency.Configuration)
+	req := invokev1.NewInvokeMethodRequest("/"+strings.TrimPrefix(route, "/")).
+		WithHTTPExtension(http.MethodPost, "").
+		WithRawData(bytes.NewReader(data)).
+		WithContentType(invokev1.JSONContentType)
+	if policyDef != nil {
+		req.WithReplay(policyDef.HasRetries())
+	}
+	defer req.Close()
+
+	policyRunner := resiliency.NewRunner[struct{}](ctx, policyDef)
+	_, err = policyRunner(func(ctx context.Context) (struct{}, error) {
+		resp, rErr := appChannel.InvokeMethod(ctx, req, "")
+		if rErr != nil {
+			return struct{}{}, rErr
+		}
+		defer resp.Close()
+		if code := resp.Status().GetCode(); code != http.StatusOK {
+			return struct{}{}, fmt.Errorf("app returned status %d", code)
+		}
+		return struct{}{}, nil
+	})
+	return err
+}
+
+func configurationFilter(md map[string]string) (configurationLoader.Filter, error) {
+	var filter configurationLoader.Filter
+	if v := md[ConfigurationKeyPrefixKey]; v != "" {
+		for _, prefix := range strings.Split(v, ",") {
+			if prefix = strings.TrimSpace(prefix); prefix != "" {
+				filter.Prefixes = append(filter.Prefixes, prefix)
+			}
+		}
+	}
+	if v := md[ConfigurationLabelSelectorKey]; v != "" {
+		filter.Labels = make(map[string]string)
+		for _, pair := range strings.Split(v, ",") {
+			label, value, ok := strings.Cut(pair, "=")
+			label = strings.TrimSpace(label)
+			if !ok || label == "" {
+				return filter, messages.ErrBadRequest.WithFormat(fmt.Sprintf("invalid %s: expected comma-separated label=value pairs", ConfigurationLabelSelectorKey))
+			}
+			filter.Labels[label] = strings.TrimSpace(value)
+		}
+	}
+	return filter, nil
+}
+
+// configurationStoreMetadata returns the metadata of the request without the
+// keys of the filters, pagination and dead-letter route.
+func configurationStoreMetadata(md map[string]string) map[string]string {
+	res := make(map[string]string, len(md))
+	for k, v := range md {
+		switch k {
+		case ConfigurationKeyPrefixKey, ConfigurationLabelSelectorKey, ConfigurationPageSizeKey, ConfigurationContinuationTokenKey, ConfigurationDeadLetterRouteKey:
+		default:
+			res[k] = v
+		}
+	}
+	return res
+}
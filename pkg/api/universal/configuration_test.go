@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/configuration"
+	channelt "github.com/dapr/dapr/pkg/channel/testing"
+	configurationLoader "github.com/dapr/dapr/pkg/components/configuration"
+	"github.com/dapr/dapr/pkg/messages"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/channels"
+)
+
+func TestConfigurationListRequest(t *testing.T) {
+	t.Run("no filters", func(t *testing.T) {
+		req, err := ConfigurationListRequest([]string{"a"}, map[string]string{"foo": "bar"})
+		require.NoError(t, err)
+		assert.Nil(t, req)
+	})
+
+	t.Run("filters and pagination", func(t *testing.T) {
+		req, err := ConfigurationListRequest(nil, map[string]string{
+			ConfigurationKeyPrefixKey:         "app/, infra/",
+			ConfigurationLabelSelectorKey:     "env=prod,tier = web",
+			ConfigurationPageSizeKey:          "10",
+			ConfigurationContinuationTokenKey: "token",
+			"foo":                             "bar",
+		})
+		require.NoError(t, err)
+		require.NotNil(t, req)
+		assert.Equal(t, []string{"app/", "infra/"}, req.Filter.Prefixes)
+		assert.Equal(t, map[string]string{"env": "prod", "tier": "web"}, req.Filter.Labels)
+		assert.Equal(t, 10, req.PageSize)
+		assert.Equal(t, "token", req.ContinuationToken)
+		assert.Equal(t, map[string]string{"foo": "bar"}, req.Metadata)
+	})
+
+	t.Run("invalid page size", func(t *testing.T) {
+		_, err := ConfigurationListRequest(nil, map[string]string{ConfigurationPageSizeKey: "0"})
+		require.ErrorIs(t, err, messages.ErrBadRequest)
+	})
+
+	t.Run("invalid label selector", func(t *testing.T) {
+		_, err := ConfigurationListRequest(nil, map[string]string{ConfigurationLabelSelectorKey: "env"})
+		require.ErrorIs(t, err, messages.ErrBadRequest)
+	})
+}
+
+func TestConfigurationSubscribeFilter(t *testing.T) {
+	filter, md, err := ConfigurationSubscribeFilter(map[string]string{
+		ConfigurationKeyPrefixKey: "app/",
+		"foo":                     "bar",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, filter)
+	assert.Equal(t, []string{"app/"}, filter.Prefixes)
+	assert.Equal(t, map[string]string{"foo": "bar"}, md)
+
+	filter, _, err = ConfigurationSubscribeFilter(map[string]string{"foo": "bar"})
+	require.NoError(t, err)
+	assert.Nil(t, filter)
+
+	filter, md, err = ConfigurationSubscribeFilter(map[string]string{
+		ConfigurationDeadLetterRouteKey: "invalid-config",
+		"foo":                           "bar",
+	})
+	require.NoError(t, err)
+	assert.Nil(t, filter)
+	assert.Equal(t, map[string]string{"foo": "bar"}, md)
+
+	_, _, err = ConfigurationSubscribeFilter(map[string]string{ConfigurationPageSizeKey: "1"})
+	require.ErrorIs(t, err, messages.ErrBadRequest)
+}
+
+func TestConfigurationInvalidHandler(t *testing.T) {
+	fakeAPI := &Universal{
+		logger:     testLogger,
+		resiliency: resiliency.New(nil),
+	}
+	invalid := []configurationLoader.InvalidItem{{
+		Key:  "port",
+		Item: &configuration.Item{Value: "http", Version: "1", Metadata: map[string]string{"contentType": "application/json"}},
+		Err:  configurationLoader.ErrInvalidItem,
+	}}
+
+	t.Run("dead-letter route", func(t *testing.T) {
+		mockAppChannel := new(channelt.MockAppChannel)
+		mockAppChannel.Init()
+		mockAppChannel.On("InvokeMethod", mock.Anything, mock.Anything).Return(invokev1.NewInvokeMethodResponse(200, "OK", nil), nil)
+		chans := new(channels.Channels).WithAppChannel(mockAppChannel)
+
+		handler := fakeAPI.ConfigurationInvalidHandler("store1", map[string]string{ConfigurationDeadLetterRouteKey: "invalid-config"}, chans)
+		handler(t.Context(), "sub1", invalid)
+
+		mockAppChannel.AssertNumberOfCalls(t, "InvokeMethod", 1)
+		body := mockAppChannel.GetInvokedRequest()["/invalid-config"]
+		require.NotNil(t, body)
+		var deadLetter configurationDeadLetter
+		require.NoError(t, json.Unmarshal(body, &deadLetter))
+		assert.Equal(t, "sub1", deadLetter.ID)
+		assert.Equal(t, "store1", deadLetter.StoreName)
+		require.Contains(t, deadLetter.Items, "port")
+		assert.Equal(t, "http", deadLetter.Items["port"].Value)
+		assert.Equal(t, "1", deadLetter.Items["port"].Version)
+		assert.Equal(t, configurationLoader.ErrInvalidItem.Error(), deadLetter.Items["port"].Error)
+	})
+
+	t.Run("no dead-letter route", func(t *testing.T) {
+		mockAppChannel := new(channelt.MockAppChannel)
+		chans := new(channels.Channels).WithAppChannel(mockAppChannel)
+
+		handler := fakeAPI.ConfigurationInvalidHandler("store1", map[string]string{}, chans)
+		handler(t.Context(), "sub1", invalid)
+
+		mockAppChannel.AssertNotCalled(t, "InvokeMethod", mock.Anything, mock.Anything)
+	})
+}
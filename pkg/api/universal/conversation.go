@@ -54,7 +54,7 @@ func (a *Universal) ConverseAlpha1(ctx context.Context, req *runtimev1pb.Convers
 	component, ok := a.compStore.GetConversation(req.GetName())
 	if !ok {
 		err := messages.ErrConversationNotFound.WithFormat(req.GetName())
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return nil, err
 	}
 
@@ -67,7 +67,7 @@ func (a *Universal) ConverseAlpha1(ctx context.Context, req *runtimev1pb.Convers
 
 	if len(req.GetInputs()) == 0 {
 		err := messages.ErrConversationMissingInputs.WithFormat(req.GetName())
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return nil, err
 	}
 
@@ -75,7 +75,7 @@ func (a *Universal) ConverseAlpha1(ctx context.Context, req *runtimev1pb.Convers
 	scrubber, err = piiscrubber.NewDefaultScrubber()
 	if err != nil {
 		err = messages.ErrConversationMissingInputs.WithFormat(req.GetName())
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return &runtimev1pb.ConversationResponse{}, err //nolint:staticcheck
 	}
 
@@ -86,7 +86,7 @@ func (a *Universal) ConverseAlpha1(ctx context.Context, req *runtimev1pb.Convers
 			scrubbed, err = scrubber.ScrubTexts([]string{i.GetContent()})
 			if err != nil {
 				err = messages.ErrConversationInvoke.WithFormat(req.GetName(), err.Error())
-				a.logger.Debug(err)
+				a.traceLogger(ctx).Debug(err)
 				return &runtimev1pb.ConversationResponse{}, err //nolint:staticcheck
 			}
 
@@ -137,13 +137,13 @@ func (a *Universal) ConverseAlpha1(ctx context.Context, req *runtimev1pb.Convers
 
 	if err != nil {
 		err = messages.ErrConversationInvoke.WithFormat(req.GetName(), err.Error())
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return &runtimev1pb.ConversationResponse{}, err //nolint:staticcheck
 	}
 
 	// handle response
 	response := &runtimev1pb.ConversationResponse{} //nolint:staticcheck
-	a.logger.Debug(response)
+	a.traceLogger(ctx).Debug(response)
 	if resp != nil {
 		if resp.ConversationContext != "" {
 			response.ContextID = &resp.ConversationContext
@@ -162,7 +162,7 @@ func (a *Universal) ConverseAlpha1(ctx context.Context, req *runtimev1pb.Convers
 				scrubbed, err = scrubber.ScrubTexts([]string{content})
 				if err != nil {
 					err = messages.ErrConversationInvoke.WithFormat(req.GetName(), err.Error())
-					a.logger.Debug(err)
+					a.traceLogger(ctx).Debug(err)
 					return &runtimev1pb.ConversationResponse{}, err //nolint:staticcheck
 				}
 
@@ -183,13 +183,13 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 	component, ok := a.compStore.GetConversation(req.GetName())
 	if !ok {
 		err := messages.ErrConversationNotFound.WithFormat(req.GetName())
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return nil, err
 	}
 
 	// Log component type for debugging
 	if _, isMistral := component.(*mistral.Mistral); isMistral {
-		a.logger.Debugf("Detected Mistral component: %s", req.GetName())
+		a.traceLogger(ctx).Debugf("Detected Mistral component: %s", req.GetName())
 	}
 
 	// prepare request
@@ -206,7 +206,7 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 
 	if len(req.GetInputs()) == 0 {
 		err = messages.ErrConversationMissingInputs.WithFormat(req.GetName())
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return nil, err
 	}
 
@@ -214,7 +214,7 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 	scrubber, err = piiscrubber.NewDefaultScrubber()
 	if err != nil {
 		err = messages.ErrConversationMissingInputs.WithFormat(req.GetName())
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return &runtimev1pb.ConversationResponseAlpha2{}, err
 	}
 
@@ -228,7 +228,7 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 
 			if message.GetMessageTypes() == nil {
 				err = messages.ErrConversationInvalidParams.WithFormat(req.GetName(), errors.New("message type cannot be nil"))
-				a.logger.Debug(err)
+				a.traceLogger(ctx).Debug(err)
 				return nil, err
 			}
 
@@ -245,7 +245,7 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 						scrubbed, err = scrubber.ScrubTexts([]string{text})
 						if err != nil {
 							err = messages.ErrConversationInvoke.WithFormat(req.GetName(), err.Error())
-							a.logger.Debug(err)
+							a.traceLogger(ctx).Debug(err)
 							return &runtimev1pb.ConversationResponseAlpha2{}, err
 						}
 						text = scrubbed[0]
@@ -272,7 +272,7 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 						scrubbed, err = scrubber.ScrubTexts([]string{text})
 						if err != nil {
 							err = messages.ErrConversationInvoke.WithFormat(req.GetName(), err.Error())
-							a.logger.Debug(err)
+							a.traceLogger(ctx).Debug(err)
 							return &runtimev1pb.ConversationResponseAlpha2{}, err
 						}
 						text = scrubbed[0]
@@ -297,7 +297,7 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 						scrubbed, err = scrubber.ScrubTexts([]string{text})
 						if err != nil {
 							err = messages.ErrConversationInvoke.WithFormat(req.GetName(), err.Error())
-							a.logger.Debug(err)
+							a.traceLogger(ctx).Debug(err)
 							return &runtimev1pb.ConversationResponseAlpha2{}, err
 						}
 						text = scrubbed[0]
@@ -322,7 +322,7 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 						scrubbed, err = scrubber.ScrubTexts([]string{text})
 						if err != nil {
 							err = messages.ErrConversationInvoke.WithFormat(req.GetName(), err.Error())
-							a.logger.Debug(err)
+							a.traceLogger(ctx).Debug(err)
 							return &runtimev1pb.ConversationResponseAlpha2{}, err
 						}
 						text = scrubbed[0]
@@ -340,7 +340,7 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 				for _, tool := range msg.OfAssistant.GetToolCalls() {
 					if tool.ToolTypes == nil {
 						err = messages.ErrConversationInvalidParams.WithFormat(req.GetName(), errors.New("tool types cannot be nil"))
-						a.logger.Debug(err)
+						a.traceLogger(ctx).Debug(err)
 						return nil, err
 					}
 					toolCall := llms.ToolCall{
@@ -376,7 +376,7 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 						scrubbed, err = scrubber.ScrubTexts([]string{text})
 						if err != nil {
 							err = messages.ErrConversationInvoke.WithFormat(req.GetName(), err.Error())
-							a.logger.Debug(err)
+							a.traceLogger(ctx).Debug(err)
 							return &runtimev1pb.ConversationResponseAlpha2{}, err
 						}
 						text = scrubbed[0]
@@ -404,7 +404,7 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 
 			default:
 				err = messages.ErrConversationInvalidParams.WithFormat(req.GetName())
-				a.logger.Debug(err)
+				a.traceLogger(ctx).Debug(err)
 				return nil, err
 			}
 			llmMessages = append(llmMessages, &langchainMsg)
@@ -436,7 +436,7 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 	case "required":
 		if len(tools) == 0 {
 			err = messages.ErrConversationInvalidParams.WithFormat(req.GetName(), "tool choice must be 'auto', 'none', 'required', or a specific tool name matching the tools available to be used")
-			a.logger.Debug(err)
+			a.traceLogger(ctx).Debug(err)
 			return nil, err
 		}
 	default:
@@ -455,7 +455,7 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 			}
 			if !toolNameFound {
 				err = messages.ErrConversationInvalidParams.WithFormat(req.GetName(), "tool choice selected was not found. Must be 'auto', 'none', 'required', or a specific tool name matching the tools available to be used")
-				a.logger.Debug(err)
+				a.traceLogger(ctx).Debug(err)
 				return nil, err
 			}
 		}
@@ -499,13 +499,13 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 
 	if err != nil {
 		err = messages.ErrConversationInvoke.WithFormat(req.GetName(), err.Error())
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return &runtimev1pb.ConversationResponseAlpha2{}, err
 	}
 
 	// handle response
 	response := &runtimev1pb.ConversationResponseAlpha2{}
-	a.logger.Debug(response)
+	a.traceLogger(ctx).Debug(response)
 	if resp != nil {
 		if resp.ConversationContext != "" {
 			response.ContextId = &resp.ConversationContext
@@ -525,7 +525,7 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 						scrubbed, err = scrubber.ScrubTexts([]string{content})
 						if err != nil {
 							err = messages.ErrConversationInvoke.WithFormat(req.GetName(), err.Error())
-							a.logger.Debug(err)
+							a.traceLogger(ctx).Debug(err)
 							return &runtimev1pb.ConversationResponseAlpha2{}, err
 						}
 						content = scrubbed[0]
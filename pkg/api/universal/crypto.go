@@ -23,6 +23,7 @@ import (
 	contribCrypto "github.com/dapr/components-contrib/crypto"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/datakeycache"
 	encv1 "github.com/dapr/kit/schemes/enc/v1"
 )
 
@@ -57,28 +58,44 @@ func (a *Universal) CryptoGetWrapKeyFn(ctx context.Context, componentName string
 	}
 }
 
+// CryptoGetUnwrapKeyFn returns the function unwrapping keys with the crypto
+// provider. Unwrapped keys are cached if the data key cache is enabled for the
+// provider.
 func (a *Universal) CryptoGetUnwrapKeyFn(ctx context.Context, componentName string, component contribCrypto.SubtleCrypto) encv1.UnwrapKeyFn {
 	return func(wrappedKey []byte, algorithm, keyName string, nonce, tag []byte) (plaintextKeyBytes []byte, err error) {
-		policyRunner := resiliency.NewRunner[jwk.Key](ctx,
-			a.resiliency.ComponentOutboundPolicy(componentName, resiliency.Crypto),
-		)
-		start := time.Now()
-		plaintextKey, err := policyRunner(func(ctx context.Context) (jwk.Key, error) {
-			return component.UnwrapKey(ctx, wrappedKey, algorithm, keyName, nonce, tag, nil)
+		req := datakeycache.UnwrapRequest{
+			KeyName:    keyName,
+			Algorithm:  algorithm,
+			WrappedKey: wrappedKey,
+			Nonce:      nonce,
+			Tag:        tag,
+		}
+		return a.compStore.GetCryptoDataKeyCache(componentName).Unwrap(ctx, req, func(ctx context.Context) ([]byte, error) {
+			return a.cryptoUnwrapKey(ctx, componentName, component, req)
 		})
-		elapsed := diag.ElapsedSince(start)
+	}
+}
 
-		diag.DefaultComponentMonitoring.CryptoInvoked(ctx, componentName, diag.CryptoOp, err == nil, elapsed)
+func (a *Universal) cryptoUnwrapKey(ctx context.Context, componentName string, component contribCrypto.SubtleCrypto, req datakeycache.UnwrapRequest) (plaintextKeyBytes []byte, err error) {
+	policyRunner := resiliency.NewRunner[jwk.Key](ctx,
+		a.resiliency.ComponentOutboundPolicy(componentName, resiliency.Crypto),
+	)
+	start := time.Now()
+	plaintextKey, err := policyRunner(func(ctx context.Context) (jwk.Key, error) {
+		return component.UnwrapKey(ctx, req.WrappedKey, req.Algorithm, req.KeyName, req.Nonce, req.Tag, nil)
+	})
+	elapsed := diag.ElapsedSince(start)
 
-		if err != nil {
-			return nil, err
-		}
+	diag.DefaultComponentMonitoring.CryptoInvoked(ctx, componentName, diag.CryptoOp, err == nil, elapsed)
 
-		err = plaintextKey.Raw(&plaintextKeyBytes)
-		if err != nil {
-			return nil, fmt.Errorf("failed to extract key: %w", err)
-		}
+	if err != nil {
+		return nil, err
+	}
 
-		return plaintextKeyBytes, nil
+	err = plaintextKey.Raw(&plaintextKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract key: %w", err)
 	}
+
+	return plaintextKeyBytes, nil
 }
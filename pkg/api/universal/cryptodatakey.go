@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"crypto/rand"
+
+	"github.com/dapr/dapr/pkg/messages"
+	"github.com/dapr/dapr/pkg/runtime/datakeycache"
+)
+
+// cryptoDataKeySize is the size of the generated data keys, for AES-256.
+const cryptoDataKeySize = 32
+
+// CryptoDataKeyRequest is the request for a data encryption key wrapped with a
+// key of the vault.
+type CryptoDataKeyRequest struct {
+	ComponentName string
+	KeyName       string
+	Algorithm     string
+}
+
+// CryptoRewrapKeyRequest is the request to re-wrap a wrapped key, e.g. after
+// the key encryption key has been rotated.
+type CryptoRewrapKeyRequest struct {
+	ComponentName string
+	KeyName       string
+	Algorithm     string
+	WrappedKey    []byte
+	Tag           []byte
+	// NewKeyName and NewAlgorithm are the key and algorithm the key is wrapped
+	// with. They default to KeyName and Algorithm, for providers which wrap
+	// with the latest version of a key.
+	NewKeyName   string
+	NewAlgorithm string
+}
+
+// CryptoGenerateDataKey returns a data encryption key, in plaintext and wrapped
+// with the key of the request. If the data key cache is enabled for the crypto
+// provider, the same data key is returned until it expires, without round
+// trips to the provider.
+func (a *Universal) CryptoGenerateDataKey(ctx context.Context, in CryptoDataKeyRequest) (datakeycache.DataKey, error) {
+	component, err := a.CryptoValidateRequest(in.ComponentName)
+	if err != nil {
+		return datakeycache.DataKey{}, err
+	}
+	if in.KeyName == "" {
+		return datakeycache.DataKey{}, a.cryptoBadRequest(ctx, "missing key name")
+	}
+	if in.Algorithm == "" {
+		return datakeycache.DataKey{}, a.cryptoBadRequest(ctx, "missing key wrap algorithm")
+	}
+
+	cache := a.compStore.GetCryptoDataKeyCache(in.ComponentName)
+	return cache.DataKey(ctx, in.KeyName, in.Algorithm, func(ctx context.Context) (datakeycache.DataKey, error) {
+		key := make([]byte, cryptoDataKeySize)
+		if _, err := rand.Read(key); err != nil {
+			return datakeycache.DataKey{}, err
+		}
+
+		wrappedKey, tag, err := a.CryptoGetWrapKeyFn(ctx, in.ComponentName, component)(key, in.Algorithm, in.KeyName, nil)
+		if err != nil {
+			err = messages.ErrCryptoOperation.WithFormat(err)
+			a.traceLogger(ctx).Debug(err)
+			return datakeycache.DataKey{}, err
+		}
+
+		return datakeycache.DataKey{
+			KeyName:      in.KeyName,
+			Algorithm:    in.Algorithm,
+			PlaintextKey: key,
+			WrappedKey:   wrappedKey,
+			Tag:          tag,
+		}, nil
+	})
+}
+
+// CryptoRewrapKey unwraps a wrapped key and wraps it again with the new key,
+// returning the new wrapped key and its tag. Re-wrapping signals that the key
+// encryption key has been rotated, so the cached data keys of the new key are
+// evicted, and the next ones are wrapped with the rotated key.
+func (a *Universal) CryptoRewrapKey(ctx context.Context, in CryptoRewrapKeyRequest) (wrappedKey []byte, tag []byte, err error) {
+	component, err := a.CryptoValidateRequest(in.ComponentName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if in.KeyName == "" {
+		return nil, nil, a.cryptoBadRequest(ctx, "missing key name")
+	}
+	if in.Algorithm == "" {
+		return nil, nil, a.cryptoBadRequest(ctx, "missing key wrap algorithm")
+	}
+	if len(in.WrappedKey) == 0 {
+		return nil, nil, a.cryptoBadRequest(ctx, "missing wrapped key")
+	}
+	newKeyName, newAlgorithm := in.NewKeyName, in.NewAlgorithm
+	if newKeyName == "" {
+		newKeyName = in.KeyName
+	}
+	if newAlgorithm == "" {
+		newAlgorithm = in.Algorithm
+	}
+
+	// The key is always unwrapped by the provider, as it may have been wrapped
+	// with a key version which isn't available anymore.
+	key, err := a.cryptoUnwrapKey(ctx, in.ComponentName, component, datakeycache.UnwrapRequest{
+		KeyName:    in.KeyName,
+		Algorithm:  in.Algorithm,
+		WrappedKey: in.WrappedKey,
+		Tag:        in.Tag,
+	})
+	if err != nil {
+		err = messages.ErrCryptoOperation.WithFormat(err)
+		a.traceLogger(ctx).Debug(err)
+		return nil, nil, err
+	}
+
+	wrappedKey, tag, err = a.CryptoGetWrapKeyFn(ctx, in.ComponentName, component)(key, newAlgorithm, newKeyName, nil)
+	if err != nil {
+		err = messages.ErrCryptoOperation.WithFormat(err)
+		a.traceLogger(ctx).Debug(err)
+		return nil, nil, err
+	}
+
+	a.compStore.GetCryptoDataKeyCache(in.ComponentName).Flush(newKeyName)
+	return wrappedKey, tag, nil
+}
+
+// FlushCryptoDataKeyCache removes the keys of the key names from the data key
+// cache of the crypto provider, or all its keys if none is given. It's a no-op
+// if caching isn't enabled for the provider.
+func (a *Universal) FlushCryptoDataKeyCache(ctx context.Context, componentName string, keyNames []string) error {
+	if _, err := a.CryptoValidateRequest(componentName); err != nil {
+		return err
+	}
+
+	a.compStore.GetCryptoDataKeyCache(componentName).Flush(keyNames...)
+	a.traceLogger(ctx).Debugf("Flushed data key cache of crypto provider %s", componentName)
+	return nil
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/messages"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/datakeycache"
+	daprt "github.com/dapr/dapr/pkg/testing"
+	encv1 "github.com/dapr/kit/schemes/enc/v1"
+)
+
+// countingSubtleCrypto counts the keys wrapped and unwrapped by the provider.
+type countingSubtleCrypto struct {
+	daprt.FakeSubtleCrypto
+	wraps   atomic.Int32
+	unwraps atomic.Int32
+}
+
+func (c *countingSubtleCrypto) WrapKey(ctx context.Context, plaintextKey jwk.Key, algorithm string, keyName string, nonce []byte, associatedData []byte) ([]byte, []byte, error) {
+	c.wraps.Add(1)
+	return c.FakeSubtleCrypto.WrapKey(ctx, plaintextKey, algorithm, keyName, nonce, associatedData)
+}
+
+func (c *countingSubtleCrypto) UnwrapKey(ctx context.Context, wrappedKey []byte, algorithm string, keyName string, nonce []byte, tag []byte, associatedData []byte) (jwk.Key, error) {
+	c.unwraps.Add(1)
+	return c.FakeSubtleCrypto.UnwrapKey(ctx, wrappedKey, algorithm, keyName, nonce, tag, associatedData)
+}
+
+func TestCryptoDataKeys(t *testing.T) {
+	newAPI := func(t *testing.T, cacheTTL string) (*Universal, *countingSubtleCrypto) {
+		t.Helper()
+		provider := &countingSubtleCrypto{}
+		compStore := compstore.New()
+		compStore.AddCryptoProvider("myvault", provider)
+		if cacheTTL != "" {
+			cache, err := datakeycache.New(datakeycache.Options{Metadata: map[string]string{"dataKeyCacheTTL": cacheTTL}})
+			require.NoError(t, err)
+			compStore.AddCryptoDataKeyCache("myvault", cache)
+		}
+		return &Universal{
+			logger:     testLogger,
+			resiliency: resiliency.New(nil),
+			compStore:  compStore,
+		}, provider
+	}
+	req := CryptoDataKeyRequest{
+		ComponentName: "myvault",
+		KeyName:       "aes-passthrough",
+		Algorithm:     "A256KW",
+	}
+
+	t.Run("generate without cache", func(t *testing.T) {
+		fakeAPI, provider := newAPI(t, "")
+
+		key1, err := fakeAPI.CryptoGenerateDataKey(t.Context(), req)
+		require.NoError(t, err)
+		assert.Len(t, key1.PlaintextKey, cryptoDataKeySize)
+		// The fake provider doesn't encrypt the key.
+		assert.Equal(t, key1.PlaintextKey, key1.WrappedKey)
+
+		key2, err := fakeAPI.CryptoGenerateDataKey(t.Context(), req)
+		require.NoError(t, err)
+		assert.NotEqual(t, key1.PlaintextKey, key2.PlaintextKey)
+		assert.Equal(t, int32(2), provider.wraps.Load())
+	})
+
+	t.Run("generate with cache", func(t *testing.T) {
+		fakeAPI, provider := newAPI(t, "1m")
+
+		key1, err := fakeAPI.CryptoGenerateDataKey(t.Context(), req)
+		require.NoError(t, err)
+		key2, err := fakeAPI.CryptoGenerateDataKey(t.Context(), req)
+		require.NoError(t, err)
+		assert.Equal(t, key1, key2)
+		assert.Equal(t, int32(1), provider.wraps.Load())
+
+		// Unwrapping the data key doesn't need the provider.
+		plaintext, err := fakeAPI.CryptoGetUnwrapKeyFn(t.Context(), "myvault", provider)(key1.WrappedKey, "A256KW", "aes-passthrough", nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, key1.PlaintextKey, plaintext)
+		assert.Zero(t, provider.unwraps.Load())
+
+		require.NoError(t, fakeAPI.FlushCryptoDataKeyCache(t.Context(), "myvault", []string{"aes-passthrough"}))
+		key3, err := fakeAPI.CryptoGenerateDataKey(t.Context(), req)
+		require.NoError(t, err)
+		assert.NotEqual(t, key1.PlaintextKey, key3.PlaintextKey)
+		assert.Equal(t, int32(2), provider.wraps.Load())
+	})
+
+	t.Run("decryptions unwrap from the cache", func(t *testing.T) {
+		fakeAPI, provider := newAPI(t, "1m")
+
+		enc, err := encv1.Encrypt(strings.NewReader("hello world"), encv1.EncryptOptions{
+			KeyName:   "aes-passthrough",
+			Algorithm: encv1.KeyAlgorithmAES256KW,
+			WrapKeyFn: fakeAPI.CryptoGetWrapKeyFn(t.Context(), "myvault", provider),
+		})
+		require.NoError(t, err)
+		var ciphertext bytes.Buffer
+		_, err = ciphertext.ReadFrom(enc)
+		require.NoError(t, err)
+
+		for range 3 {
+			dec, err := encv1.Decrypt(bytes.NewReader(ciphertext.Bytes()), encv1.DecryptOptions{
+				KeyName:     "aes-passthrough",
+				UnwrapKeyFn: fakeAPI.CryptoGetUnwrapKeyFn(t.Context(), "myvault", provider),
+			})
+			require.NoError(t, err)
+			var plaintext bytes.Buffer
+			_, err = plaintext.ReadFrom(dec)
+			require.NoError(t, err)
+			assert.Equal(t, "hello world", plaintext.String())
+		}
+		assert.Equal(t, int32(1), provider.unwraps.Load())
+	})
+
+	t.Run("rewrap", func(t *testing.T) {
+		fakeAPI, provider := newAPI(t, "1m")
+
+		key, err := fakeAPI.CryptoGenerateDataKey(t.Context(), req)
+		require.NoError(t, err)
+
+		wrappedKey, tag, err := fakeAPI.CryptoRewrapKey(t.Context(), CryptoRewrapKeyRequest{
+			ComponentName: "myvault",
+			KeyName:       "aes-passthrough",
+			Algorithm:     "A256KW",
+			WrappedKey:    key.WrappedKey,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, key.PlaintextKey, wrappedKey)
+		assert.Nil(t, tag)
+		// The key is unwrapped by the provider, not from the cache.
+		assert.Equal(t, int32(1), provider.unwraps.Load())
+
+		// The cached data keys of the rotated key are evicted.
+		newKey, err := fakeAPI.CryptoGenerateDataKey(t.Context(), req)
+		require.NoError(t, err)
+		assert.NotEqual(t, key.PlaintextKey, newKey.PlaintextKey)
+
+		wrappedKey, tag, err = fakeAPI.CryptoRewrapKey(t.Context(), CryptoRewrapKeyRequest{
+			ComponentName: "myvault",
+			KeyName:       "aes-passthrough",
+			Algorithm:     "A256KW",
+			WrappedKey:    key.WrappedKey,
+			NewKeyName:    "good-tag",
+		})
+		require.NoError(t, err)
+		// The fake provider returns a fixed key and tag.
+		assert.Len(t, wrappedKey, 16)
+		assert.Equal(t, wrappedKey, tag)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fakeAPI, _ := newAPI(t, "1m")
+
+		_, err := fakeAPI.CryptoGenerateDataKey(t.Context(), CryptoDataKeyRequest{ComponentName: "myvault", KeyName: "error", Algorithm: "A256KW"})
+		require.ErrorIs(t, err, messages.ErrCryptoOperation)
+		_, err = fakeAPI.CryptoGenerateDataKey(t.Context(), CryptoDataKeyRequest{ComponentName: "myvault", KeyName: "aes-passthrough"})
+		require.ErrorIs(t, err, messages.ErrBadRequest)
+		_, err = fakeAPI.CryptoGenerateDataKey(t.Context(), CryptoDataKeyRequest{ComponentName: "notfound", KeyName: "aes-passthrough", Algorithm: "A256KW"})
+		require.ErrorIs(t, err, messages.ErrCryptoProviderNotFound)
+
+		_, _, err = fakeAPI.CryptoRewrapKey(t.Context(), CryptoRewrapKeyRequest{ComponentName: "myvault", KeyName: "error", Algorithm: "A256KW", WrappedKey: []byte("k")})
+		require.ErrorIs(t, err, messages.ErrCryptoOperation)
+		_, _, err = fakeAPI.CryptoRewrapKey(t.Context(), CryptoRewrapKeyRequest{ComponentName: "myvault", KeyName: "aes-passthrough", Algorithm: "A256KW"})
+		require.ErrorIs(t, err, messages.ErrBadRequest)
+
+		err = fakeAPI.FlushCryptoDataKeyCache(t.Context(), "notfound", nil)
+		require.ErrorIs(t, err, messages.ErrCryptoProviderNotFound)
+	})
+}
@@ -0,0 +1,325 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	// Register the hash functions of the signature algorithms.
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+
+	contribCrypto "github.com/dapr/components-contrib/crypto"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/messages"
+	"github.com/dapr/dapr/pkg/resiliency"
+)
+
+// Formats of the detached signatures.
+const (
+	// CryptoSignatureFormatRaw is the signature, or MAC, as returned by the crypto provider.
+	CryptoSignatureFormatRaw = "raw"
+	// CryptoSignatureFormatJWS is the JWS compact serialization with a detached payload (RFC 7515, appendix F).
+	CryptoSignatureFormatJWS = "jws"
+)
+
+// CryptoMACer is implemented by the crypto providers which compute keyed MACs
+// (HS256, HS384 and HS512) with the symmetric keys stored in the vault.
+type CryptoMACer interface {
+	MAC(ctx context.Context, message []byte, algorithm string, keyName string) ([]byte, error)
+}
+
+// CryptoSignRequest is the request to sign a payload with a key stored in the vault.
+type CryptoSignRequest struct {
+	ComponentName string
+	KeyName       string
+	Algorithm     string
+	// Format is CryptoSignatureFormatRaw (default) or CryptoSignatureFormatJWS.
+	Format  string
+	Payload []byte
+}
+
+// CryptoVerifyRequest is the request to verify the detached signature of a payload.
+type CryptoVerifyRequest struct {
+	ComponentName string
+	// KeyName and Algorithm can be omitted for JWS signatures, and are then
+	// read from the header of the signature.
+	KeyName   string
+	Algorithm string
+	Payload   []byte
+	// Signature is the raw signature, or a JWS with a detached payload.
+	Signature []byte
+}
+
+type jwsHeader struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid,omitempty"`
+	B64       *bool  `json:"b64,omitempty"`
+}
+
+// CryptoSign signs the payload, or computes its keyed MAC for the HS*
+// algorithms, returning a detached signature.
+func (a *Universal) CryptoSign(ctx context.Context, in CryptoSignRequest) ([]byte, error) {
+	component, err := a.CryptoValidateRequest(in.ComponentName)
+	if err != nil {
+		return nil, err
+	}
+	if in.KeyName == "" {
+		return nil, a.cryptoBadRequest(ctx, "missing key name")
+	}
+	alg, err := cryptoSignatureAlgorithm(in.Algorithm)
+	if err != nil {
+		return nil, a.cryptoBadRequest(ctx, err.Error())
+	}
+
+	switch in.Format {
+	case "", CryptoSignatureFormatRaw:
+		return a.cryptoSign(ctx, in.ComponentName, component, alg, in.KeyName, in.Payload)
+	case CryptoSignatureFormatJWS:
+		header, err := json.Marshal(jwsHeader{Algorithm: alg.name, KeyID: in.KeyName})
+		if err != nil {
+			return nil, err
+		}
+		protected := base64.RawURLEncoding.EncodeToString(header)
+		signature, err := a.cryptoSign(ctx, in.ComponentName, component, alg, in.KeyName, jwsSigningInput(protected, in.Payload))
+		if err != nil {
+			return nil, err
+		}
+		if alg.ecdsaSize > 0 {
+			// JWS ECDSA signatures are the concatenation of R and S, not ASN.1.
+			signature, err = ecdsaASN1ToJWS(signature, alg.ecdsaSize)
+			if err != nil {
+				return nil, messages.ErrCryptoOperation.WithFormat(err)
+			}
+		}
+		return []byte(protected + ".." + base64.RawURLEncoding.EncodeToString(signature)), nil
+	default:
+		return nil, a.cryptoBadRequest(ctx, fmt.Sprintf("invalid signature format '%s'", in.Format))
+	}
+}
+
+// CryptoVerify verifies the detached signature, or keyed MAC, of the payload.
+func (a *Universal) CryptoVerify(ctx context.Context, in CryptoVerifyRequest) (bool, error) {
+	component, err := a.CryptoValidateRequest(in.ComponentName)
+	if err != nil {
+		return false, err
+	}
+	if len(in.Signature) == 0 {
+		return false, a.cryptoBadRequest(ctx, "missing signature")
+	}
+
+	keyName, algName, message, signature := in.KeyName, in.Algorithm, in.Payload, in.Signature
+	protected, encodedSignature, isJWS := bytes.Cut(in.Signature, []byte(".."))
+	if isJWS {
+		var header jwsHeader
+		rawHeader, err := base64.RawURLEncoding.DecodeString(string(protected))
+		if err == nil {
+			err = json.Unmarshal(rawHeader, &header)
+		}
+		if err != nil {
+			return false, a.cryptoBadRequest(ctx, "invalid JWS header")
+		}
+		if header.B64 != nil && !*header.B64 {
+			return false, a.cryptoBadRequest(ctx, "unencoded JWS payloads are not supported")
+		}
+		if algName != "" && algName != header.Algorithm {
+			return false, a.cryptoBadRequest(ctx, "the algorithm doesn't match the one of the JWS header")
+		}
+		algName = header.Algorithm
+		if keyName == "" {
+			keyName = header.KeyID
+		}
+		message = jwsSigningInput(string(protected), in.Payload)
+		if signature, err = base64.RawURLEncoding.DecodeString(string(encodedSignature)); err != nil {
+			return false, a.cryptoBadRequest(ctx, "invalid JWS signature")
+		}
+	}
+
+	if keyName == "" {
+		return false, a.cryptoBadRequest(ctx, "missing key name")
+	}
+	alg, err := cryptoSignatureAlgorithm(algName)
+	if err != nil {
+		return false, a.cryptoBadRequest(ctx, err.Error())
+	}
+	if isJWS && alg.ecdsaSize > 0 {
+		if signature, err = ecdsaJWSToASN1(signature, alg.ecdsaSize); err != nil {
+			// A malformed signature is an invalid one.
+			return false, nil
+		}
+	}
+
+	if alg.mac {
+		expected, err := a.cryptoSign(ctx, in.ComponentName, component, alg, keyName, message)
+		if err != nil {
+			return false, err
+		}
+		return hmac.Equal(expected, signature), nil
+	}
+
+	policyRunner := resiliency.NewRunner[bool](ctx,
+		a.resiliency.ComponentOutboundPolicy(in.ComponentName, resiliency.Crypto),
+	)
+	start := time.Now()
+	valid, err := policyRunner(func(ctx context.Context) (bool, error) {
+		return component.Verify(ctx, alg.digest(message), signature, alg.name, keyName)
+	})
+	elapsed := diag.ElapsedSince(start)
+
+	diag.DefaultComponentMonitoring.CryptoInvoked(ctx, in.ComponentName, diag.CryptoOp, err == nil, elapsed)
+
+	if err != nil {
+		err = messages.ErrCryptoOperation.WithFormat(err)
+		a.traceLogger(ctx).Debug(err)
+		return false, err
+	}
+	return valid, nil
+}
+
+// cryptoSign signs the message with the crypto provider.
+func (a *Universal) cryptoSign(ctx context.Context, componentName string, component contribCrypto.SubtleCrypto, alg cryptoSigAlg, keyName string, message []byte) ([]byte, error) {
+	var macer CryptoMACer
+	if alg.mac {
+		var ok bool
+		macer, ok = component.(CryptoMACer)
+		if !ok {
+			err := messages.ErrCryptoAlgorithmNotSupported.WithFormat(componentName, alg.name)
+			a.traceLogger(ctx).Debug(err)
+			return nil, err
+		}
+	}
+
+	policyRunner := resiliency.NewRunner[[]byte](ctx,
+		a.resiliency.ComponentOutboundPolicy(componentName, resiliency.Crypto),
+	)
+	start := time.Now()
+	signature, err := policyRunner(func(ctx context.Context) ([]byte, error) {
+		if macer != nil {
+			return macer.MAC(ctx, message, alg.name, keyName)
+		}
+		return component.Sign(ctx, alg.digest(message), alg.name, keyName)
+	})
+	elapsed := diag.ElapsedSince(start)
+
+	diag.DefaultComponentMonitoring.CryptoInvoked(ctx, componentName, diag.CryptoOp, err == nil, elapsed)
+
+	if err != nil {
+		err = messages.ErrCryptoOperation.WithFormat(err)
+		a.traceLogger(ctx).Debug(err)
+		return nil, err
+	}
+	return signature, nil
+}
+
+func (a *Universal) cryptoBadRequest(ctx context.Context, msg string) error {
+	err := messages.ErrBadRequest.WithFormat(msg)
+	a.traceLogger(ctx).Debug(err)
+	return err
+}
+
+// cryptoSigAlg is a signature or MAC algorithm of the JWA names.
+type cryptoSigAlg struct {
+	name string
+	// hash is the hash of the message which is signed, zero for EdDSA which
+	// signs the message itself.
+	hash crypto.Hash
+	// mac is true for the keyed MAC algorithms.
+	mac bool
+	// ecdsaSize is the size of the integers of the ECDSA signatures.
+	ecdsaSize int
+}
+
+func (alg cryptoSigAlg) digest(message []byte) []byte {
+	if alg.mac || alg.hash == 0 {
+		return message
+	}
+	h := alg.hash.New()
+	h.Write(message)
+	return h.Sum(nil)
+}
+
+func cryptoSignatureAlgorithm(name string) (cryptoSigAlg, error) {
+	if strings.EqualFold(name, "EdDSA") {
+		return cryptoSigAlg{name: "EdDSA"}, nil
+	}
+
+	name = strings.ToUpper(name)
+	alg := cryptoSigAlg{name: name}
+	switch name {
+	case "RS256", "PS256", "ES256", "HS256":
+		alg.hash = crypto.SHA256
+	case "RS384", "PS384", "ES384", "HS384":
+		alg.hash = crypto.SHA384
+	case "RS512", "PS512", "ES512", "HS512":
+		alg.hash = crypto.SHA512
+	case "":
+		return alg, errors.New("missing algorithm")
+	default:
+		return alg, fmt.Errorf("unsupported signature algorithm '%s'", name)
+	}
+
+	switch name[:2] {
+	case "HS":
+		alg.mac = true
+	case "ES":
+		alg.ecdsaSize = map[crypto.Hash]int{crypto.SHA256: 32, crypto.SHA384: 48, crypto.SHA512: 66}[alg.hash]
+	}
+	return alg, nil
+}
+
+func jwsSigningInput(protected string, payload []byte) []byte {
+	return []byte(protected + "." + base64.RawURLEncoding.EncodeToString(payload))
+}
+
+type ecdsaSignature struct {
+	R, S *big.Int
+}
+
+// ecdsaASN1ToJWS converts an ASN.1 ECDSA signature to the JWS format.
+func ecdsaASN1ToJWS(signature []byte, size int) ([]byte, error) {
+	var sig ecdsaSignature
+	rest, err := asn1.Unmarshal(signature, &sig)
+	if err != nil || len(rest) > 0 {
+		return nil, errors.New("invalid ECDSA signature")
+	}
+	if sig.R.Sign() < 0 || sig.S.Sign() < 0 || len(sig.R.Bytes()) > size || len(sig.S.Bytes()) > size {
+		return nil, errors.New("invalid ECDSA signature")
+	}
+	out := make([]byte, 2*size)
+	sig.R.FillBytes(out[:size])
+	sig.S.FillBytes(out[size:])
+	return out, nil
+}
+
+// ecdsaJWSToASN1 converts a JWS ECDSA signature to the ASN.1 format.
+func ecdsaJWSToASN1(signature []byte, size int) ([]byte, error) {
+	if len(signature) != 2*size {
+		return nil, errors.New("invalid ECDSA signature length")
+	}
+	return asn1.Marshal(ecdsaSignature{
+		R: new(big.Int).SetBytes(signature[:size]),
+		S: new(big.Int).SetBytes(signature[size:]),
+	})
+}
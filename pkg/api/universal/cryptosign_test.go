@@ -0,0 +1,335 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contribCrypto "github.com/dapr/components-contrib/crypto"
+	"github.com/dapr/dapr/pkg/messages"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+// signingCryptoProvider is a crypto provider with in-memory keys, which also
+// computes HMACs.
+type signingCryptoProvider struct {
+	contribCrypto.LocalCryptoBaseComponent
+	macKey []byte
+}
+
+func newSigningCryptoProvider(t *testing.T, keys map[string]any) *signingCryptoProvider {
+	t.Helper()
+
+	jwks := make(map[string]jwk.Key, len(keys))
+	for name, raw := range keys {
+		key, err := jwk.FromRaw(raw)
+		require.NoError(t, err)
+		jwks[name] = key
+	}
+	return &signingCryptoProvider{
+		LocalCryptoBaseComponent: contribCrypto.LocalCryptoBaseComponent{
+			RetrieveKeyFn: func(_ context.Context, name string) (jwk.Key, error) {
+				key, ok := jwks[name]
+				if !ok {
+					return nil, errors.New("key not found")
+				}
+				return key, nil
+			},
+		},
+		macKey: []byte("secret-mac-key"),
+	}
+}
+
+func (p *signingCryptoProvider) Init(context.Context, contribCrypto.Metadata) error { return nil }
+func (p *signingCryptoProvider) Features() []contribCrypto.Feature                  { return nil }
+func (p *signingCryptoProvider) Close() error                                       { return nil }
+
+func (p *signingCryptoProvider) MAC(_ context.Context, message []byte, algorithm string, keyName string) ([]byte, error) {
+	if keyName != "mac" || algorithm != "HS256" {
+		return nil, errors.New("unsupported key")
+	}
+	h := hmac.New(sha256.New, p.macKey)
+	h.Write(message)
+	return h.Sum(nil), nil
+}
+
+func TestCryptoSign(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	compStore := compstore.New()
+	compStore.AddCryptoProvider("myvault", newSigningCryptoProvider(t, map[string]any{
+		"ec": ecKey,
+		"ed": edKey,
+	}))
+	compStore.AddCryptoProvider("fake", daprt.FakeSubtleCrypto{})
+	fakeAPI := &Universal{
+		logger:     testLogger,
+		resiliency: resiliency.New(nil),
+		compStore:  compStore,
+	}
+	payload := []byte("hello world")
+
+	t.Run("raw ES256 signature", func(t *testing.T) {
+		sig, err := fakeAPI.CryptoSign(t.Context(), CryptoSignRequest{
+			ComponentName: "myvault",
+			KeyName:       "ec",
+			Algorithm:     "es256",
+			Payload:       payload,
+		})
+		require.NoError(t, err)
+		digest := sha256.Sum256(payload)
+		assert.True(t, ecdsa.VerifyASN1(&ecKey.PublicKey, digest[:], sig))
+
+		valid, err := fakeAPI.CryptoVerify(t.Context(), CryptoVerifyRequest{
+			ComponentName: "myvault",
+			KeyName:       "ec",
+			Algorithm:     "ES256",
+			Payload:       payload,
+			Signature:     sig,
+		})
+		require.NoError(t, err)
+		assert.True(t, valid)
+
+		valid, err = fakeAPI.CryptoVerify(t.Context(), CryptoVerifyRequest{
+			ComponentName: "myvault",
+			KeyName:       "ec",
+			Algorithm:     "ES256",
+			Payload:       []byte("tampered"),
+			Signature:     sig,
+		})
+		require.NoError(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("raw EdDSA signature", func(t *testing.T) {
+		sig, err := fakeAPI.CryptoSign(t.Context(), CryptoSignRequest{
+			ComponentName: "myvault",
+			KeyName:       "ed",
+			Algorithm:     "eddsa",
+			Payload:       payload,
+		})
+		require.NoError(t, err)
+		assert.True(t, ed25519.Verify(edKey.Public().(ed25519.PublicKey), payload, sig))
+	})
+
+	t.Run("JWS ES256 signature", func(t *testing.T) {
+		sig, err := fakeAPI.CryptoSign(t.Context(), CryptoSignRequest{
+			ComponentName: "myvault",
+			KeyName:       "ec",
+			Algorithm:     "ES256",
+			Format:        CryptoSignatureFormatJWS,
+			Payload:       payload,
+		})
+		require.NoError(t, err)
+		require.Contains(t, string(sig), "..")
+
+		_, err = jws.Verify(sig, jws.WithKey(jwa.ES256, &ecKey.PublicKey), jws.WithDetachedPayload(payload))
+		require.NoError(t, err)
+
+		msg, err := jws.Parse(sig)
+		require.NoError(t, err)
+		require.Len(t, msg.Signatures(), 1)
+		assert.Equal(t, "ec", msg.Signatures()[0].ProtectedHeaders().KeyID())
+
+		// Key name and algorithm are read from the JWS header.
+		valid, err := fakeAPI.CryptoVerify(t.Context(), CryptoVerifyRequest{
+			ComponentName: "myvault",
+			Payload:       payload,
+			Signature:     sig,
+		})
+		require.NoError(t, err)
+		assert.True(t, valid)
+
+		valid, err = fakeAPI.CryptoVerify(t.Context(), CryptoVerifyRequest{
+			ComponentName: "myvault",
+			Payload:       []byte("tampered"),
+			Signature:     sig,
+		})
+		require.NoError(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("verify a JWS signed by another library", func(t *testing.T) {
+		key, err := jwk.FromRaw(edKey)
+		require.NoError(t, err)
+		sig, err := jws.Sign(nil, jws.WithKey(jwa.EdDSA, key), jws.WithDetachedPayload(payload))
+		require.NoError(t, err)
+
+		valid, err := fakeAPI.CryptoVerify(t.Context(), CryptoVerifyRequest{
+			ComponentName: "myvault",
+			KeyName:       "ed",
+			Payload:       payload,
+			Signature:     sig,
+		})
+		require.NoError(t, err)
+		assert.True(t, valid)
+	})
+
+	t.Run("HMAC", func(t *testing.T) {
+		sig, err := fakeAPI.CryptoSign(t.Context(), CryptoSignRequest{
+			ComponentName: "myvault",
+			KeyName:       "mac",
+			Algorithm:     "HS256",
+			Payload:       payload,
+		})
+		require.NoError(t, err)
+		h := hmac.New(sha256.New, []byte("secret-mac-key"))
+		h.Write(payload)
+		assert.Equal(t, h.Sum(nil), sig)
+
+		valid, err := fakeAPI.CryptoVerify(t.Context(), CryptoVerifyRequest{
+			ComponentName: "myvault",
+			KeyName:       "mac",
+			Algorithm:     "HS256",
+			Payload:       payload,
+			Signature:     sig,
+		})
+		require.NoError(t, err)
+		assert.True(t, valid)
+
+		valid, err = fakeAPI.CryptoVerify(t.Context(), CryptoVerifyRequest{
+			ComponentName: "myvault",
+			KeyName:       "mac",
+			Algorithm:     "HS256",
+			Payload:       []byte("tampered"),
+			Signature:     sig,
+		})
+		require.NoError(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("JWS HMAC", func(t *testing.T) {
+		sig, err := fakeAPI.CryptoSign(t.Context(), CryptoSignRequest{
+			ComponentName: "myvault",
+			KeyName:       "mac",
+			Algorithm:     "HS256",
+			Format:        CryptoSignatureFormatJWS,
+			Payload:       payload,
+		})
+		require.NoError(t, err)
+
+		_, err = jws.Verify(sig, jws.WithKey(jwa.HS256, []byte("secret-mac-key")), jws.WithDetachedPayload(payload))
+		require.NoError(t, err)
+	})
+
+	t.Run("HMAC not supported by the provider", func(t *testing.T) {
+		_, err := fakeAPI.CryptoSign(t.Context(), CryptoSignRequest{
+			ComponentName: "fake",
+			KeyName:       "good",
+			Algorithm:     "HS256",
+			Payload:       payload,
+		})
+		require.ErrorIs(t, err, messages.ErrCryptoAlgorithmNotSupported)
+		assert.Equal(t, http.StatusNotImplemented, err.(messages.APIError).HTTPCode())
+	})
+
+	t.Run("provider errors", func(t *testing.T) {
+		_, err := fakeAPI.CryptoSign(t.Context(), CryptoSignRequest{
+			ComponentName: "fake",
+			KeyName:       "error",
+			Algorithm:     "RS256",
+			Payload:       payload,
+		})
+		require.ErrorIs(t, err, messages.ErrCryptoOperation)
+
+		_, err = fakeAPI.CryptoVerify(t.Context(), CryptoVerifyRequest{
+			ComponentName: "fake",
+			KeyName:       "error",
+			Algorithm:     "RS256",
+			Payload:       payload,
+			Signature:     []byte("sig"),
+		})
+		require.ErrorIs(t, err, messages.ErrCryptoOperation)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		_, err := fakeAPI.CryptoSign(t.Context(), CryptoSignRequest{
+			ComponentName: "notfound",
+			KeyName:       "ec",
+			Algorithm:     "ES256",
+		})
+		require.ErrorIs(t, err, messages.ErrCryptoProviderNotFound)
+
+		for _, req := range []CryptoSignRequest{
+			{ComponentName: "myvault", Algorithm: "ES256"},
+			{ComponentName: "myvault", KeyName: "ec"},
+			{ComponentName: "myvault", KeyName: "ec", Algorithm: "A256KW"},
+			{ComponentName: "myvault", KeyName: "ec", Algorithm: "ES256", Format: "xml"},
+		} {
+			_, err = fakeAPI.CryptoSign(t.Context(), req)
+			require.ErrorIs(t, err, messages.ErrBadRequest)
+		}
+
+		_, err = fakeAPI.CryptoVerify(t.Context(), CryptoVerifyRequest{
+			ComponentName: "myvault",
+			KeyName:       "ec",
+			Algorithm:     "ES256",
+		})
+		require.ErrorIs(t, err, messages.ErrBadRequest)
+
+		header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"ES256","kid":"ec"}`))
+		_, err = fakeAPI.CryptoVerify(t.Context(), CryptoVerifyRequest{
+			ComponentName: "myvault",
+			Algorithm:     "EdDSA",
+			Signature:     []byte(header + ".." + strings.Repeat("A", 86)),
+		})
+		require.ErrorIs(t, err, messages.ErrBadRequest)
+	})
+}
+
+func TestECDSASignatureConversion(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	require.NoError(t, err)
+	digest := sha256.Sum256([]byte("hello"))
+
+	for range 20 {
+		sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+		require.NoError(t, err)
+
+		jwsSig, err := ecdsaASN1ToJWS(sig, 66)
+		require.NoError(t, err)
+		require.Len(t, jwsSig, 132)
+
+		back, err := ecdsaJWSToASN1(jwsSig, 66)
+		require.NoError(t, err)
+		assert.True(t, ecdsa.VerifyASN1(&key.PublicKey, digest[:], back))
+	}
+
+	_, err = ecdsaJWSToASN1(make([]byte, 10), 32)
+	require.Error(t, err)
+	_, err = ecdsaASN1ToJWS([]byte("not asn1"), 32)
+	require.Error(t, err)
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"time"
+
+	"github.com/dapr/dapr/pkg/runtime/drain"
+)
+
+// Drain starts draining Dapr, and shuts it down once the drain has completed.
+// It returns the status of the drain, and false if the drain had already started.
+func (a *Universal) Drain(timeout time.Duration) (drain.Status, bool) {
+	started := a.drainer.Drain(timeout)
+	if started {
+		go func() {
+			<-a.drainer.Done()
+			a.shutdownFn()
+		}()
+	}
+	return a.drainer.Status(), started
+}
+
+// DrainStatus returns the status of the drain.
+func (a *Universal) DrainStatus() drain.Status {
+	return a.drainer.Status()
+}
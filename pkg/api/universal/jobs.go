@@ -20,13 +20,17 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 
 	apierrors "github.com/dapr/dapr/pkg/api/errors"
+	"github.com/dapr/dapr/pkg/messages"
 	"github.com/dapr/dapr/pkg/messages/errorcodes"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
 	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+	"github.com/dapr/dapr/pkg/scheduler/joboptions"
 )
 
 const (
@@ -34,17 +38,35 @@ const (
 )
 
 func (a *Universal) ScheduleJobAlpha1(ctx context.Context, inReq *runtimev1pb.ScheduleJobRequest) (*runtimev1pb.ScheduleJobResponse, error) {
-	return a.scheduleJob(ctx, inReq)
+	return a.scheduleJob(ctx, inReq, nil)
+}
+
+// ScheduleJobWithMetadata schedules a job with the job options configured by
+// the request metadata, such as its failure policy.
+func (a *Universal) ScheduleJobWithMetadata(ctx context.Context, inReq *runtimev1pb.ScheduleJobRequest, md map[string]string) (*runtimev1pb.ScheduleJobResponse, error) {
+	opts, err := joboptions.FromMetadata(md)
+	if err != nil {
+		err = messages.ErrBadRequest.WithFormat(err.Error())
+		a.traceLogger(ctx).Debug(err)
+		return &runtimev1pb.ScheduleJobResponse{}, err
+	}
+	return a.scheduleJob(ctx, inReq, opts)
 }
 
 func (a *Universal) ScheduleJobAlpha1HTTP(ctx context.Context, job *internalsv1pb.JobHTTPRequest) (*runtimev1pb.ScheduleJobResponse, error) {
+	return a.ScheduleJobAlpha1HTTPWithMetadata(ctx, job, nil)
+}
+
+// ScheduleJobAlpha1HTTPWithMetadata schedules a job of the HTTP API with the
+// job options configured by the request metadata.
+func (a *Universal) ScheduleJobAlpha1HTTPWithMetadata(ctx context.Context, job *internalsv1pb.JobHTTPRequest, md map[string]string) (*runtimev1pb.ScheduleJobResponse, error) {
 	data, err := anypb.New(job.GetData())
 	if err != nil {
 		return &runtimev1pb.ScheduleJobResponse{}, fmt.Errorf("error creating storable job data from job: %w", err)
 	}
 
 	//nolint:protogetter
-	return a.scheduleJob(ctx, &runtimev1pb.ScheduleJobRequest{
+	return a.ScheduleJobWithMetadata(ctx, &runtimev1pb.ScheduleJobRequest{
 		Job: &runtimev1pb.Job{
 			Name:          job.GetName(),
 			Schedule:      job.Schedule,
@@ -55,10 +77,10 @@ func (a *Universal) ScheduleJobAlpha1HTTP(ctx context.Context, job *internalsv1p
 			FailurePolicy: job.GetFailurePolicy(),
 		},
 		Overwrite: job.GetOverwrite(),
-	})
+	}, md)
 }
 
-func (a *Universal) scheduleJob(ctx context.Context, jobRequest *runtimev1pb.ScheduleJobRequest) (*runtimev1pb.ScheduleJobResponse, error) {
+func (a *Universal) scheduleJob(ctx context.Context, jobRequest *runtimev1pb.ScheduleJobRequest, opts *joboptions.Options) (*runtimev1pb.ScheduleJobResponse, error) {
 	errMetadata := map[string]string{
 		"appID":     a.AppID(),
 		"namespace": a.Namespace(),
@@ -76,6 +98,21 @@ func (a *Universal) scheduleJob(ctx context.Context, jobRequest *runtimev1pb.Sch
 		return &runtimev1pb.ScheduleJobResponse{}, apierrors.Empty("Schedule", errMetadata, errorcodes.SchedulerScheduleEmpty)
 	}
 
+	failurePolicy := job.GetFailurePolicy()
+	if opts.GetFailurePolicy() != nil {
+		if failurePolicy == nil {
+			failurePolicy = new(commonv1pb.JobFailurePolicy)
+		} else {
+			failurePolicy = proto.Clone(failurePolicy).(*commonv1pb.JobFailurePolicy)
+		}
+		failurePolicy.Delivery = opts.GetFailurePolicy()
+	}
+	if err := joboptions.ValidateFailurePolicy(failurePolicy.GetDelivery()); err != nil {
+		err = messages.ErrBadRequest.WithFormat(err.Error())
+		a.traceLogger(ctx).Debug(err)
+		return &runtimev1pb.ScheduleJobResponse{}, err
+	}
+
 	internalScheduleJobReq := &schedulerv1pb.ScheduleJobRequest{
 		Name: job.GetName(),
 		Metadata: &schedulerv1pb.JobMetadata{
@@ -95,16 +132,17 @@ func (a *Universal) scheduleJob(ctx context.Context, jobRequest *runtimev1pb.Sch
 			Repeats:       job.Repeats,
 			DueTime:       job.DueTime,
 			Ttl:           job.Ttl,
-			FailurePolicy: job.GetFailurePolicy(),
+			FailurePolicy: failurePolicy,
 		},
 	}
+	opts.SetJobFields(internalScheduleJobReq.GetJob())
 
 	schedCtx, cancel := context.WithTimeout(ctx, rpcTimeout)
 	defer cancel()
 
 	_, err := a.scheduler.ScheduleJob(schedCtx, internalScheduleJobReq, grpc.WaitForReady(true))
 	if err != nil {
-		a.logger.Errorf("Error scheduling job %s due to: %s", job.GetName(), err)
+		a.traceLogger(ctx).Errorf("Error scheduling job %s due to: %s", job.GetName(), err)
 		return &runtimev1pb.ScheduleJobResponse{}, apierrors.SchedulerScheduleJob(errMetadata, err)
 	}
 
@@ -118,7 +156,7 @@ func (a *Universal) DeleteJobAlpha1(ctx context.Context, inReq *runtimev1pb.Dele
 	}
 
 	if inReq.GetName() == "" {
-		a.logger.Error("Job name is empty.")
+		a.traceLogger(ctx).Error("Job name is empty.")
 		return &runtimev1pb.DeleteJobResponse{}, apierrors.Empty("Name", errMetadata, errorcodes.SchedulerJobNameEmpty)
 	}
 
@@ -140,7 +178,7 @@ func (a *Universal) DeleteJobAlpha1(ctx context.Context, inReq *runtimev1pb.Dele
 
 	_, err := a.scheduler.DeleteJob(schedCtx, internalDeleteJobReq, grpc.WaitForReady(true))
 	if err != nil {
-		a.logger.Errorf("Error deleting job: %s due to: %s", inReq.GetName(), err)
+		a.traceLogger(ctx).Errorf("Error deleting job: %s due to: %s", inReq.GetName(), err)
 		return &runtimev1pb.DeleteJobResponse{}, apierrors.SchedulerDeleteJob(errMetadata, err)
 	}
 
@@ -154,7 +192,7 @@ func (a *Universal) GetJobAlpha1(ctx context.Context, inReq *runtimev1pb.GetJobR
 	}
 
 	if inReq.GetName() == "" {
-		a.logger.Error("Job name is empty.")
+		a.traceLogger(ctx).Error("Job name is empty.")
 		return new(runtimev1pb.GetJobResponse), apierrors.Empty("Name", errMetadata, errorcodes.SchedulerJobNameEmpty)
 	}
 
@@ -176,7 +214,7 @@ func (a *Universal) GetJobAlpha1(ctx context.Context, inReq *runtimev1pb.GetJobR
 
 	resp, err := a.scheduler.GetJob(schedCtx, internalGetJobReq, grpc.WaitForReady(true))
 	if err != nil {
-		a.logger.Errorf("Error getting job %s due to: %s", inReq.GetName(), err)
+		a.traceLogger(ctx).Errorf("Error getting job %s due to: %s", inReq.GetName(), err)
 		return nil, apierrors.SchedulerGetJob(errMetadata, err)
 	}
 
@@ -210,7 +248,7 @@ func (a *Universal) DeleteJobsByPrefixAlpha1(ctx context.Context, req *runtimev1
 		},
 	})
 	if err != nil {
-		a.logger.Errorf("Error listing jobs due to: %s", err)
+		a.traceLogger(ctx).Errorf("Error listing jobs due to: %s", err)
 		return nil, apierrors.SchedulerDeleteJob(map[string]string{
 			"appID":     a.AppID(),
 			"namespace": a.Namespace(),
@@ -219,48 +257,3 @@ func (a *Universal) DeleteJobsByPrefixAlpha1(ctx context.Context, req *runtimev1
 
 	return nil, nil
 }
-
-func (a *Universal) ListJobsAlpha1(ctx context.Context, req *runtimev1pb.ListJobsRequestAlpha1) (*runtimev1pb.ListJobsResponseAlpha1, error) {
-	errMetadata := map[string]string{
-		"appID":     a.AppID(),
-		"namespace": a.Namespace(),
-	}
-
-	ctx, cancel := context.WithTimeout(ctx, rpcTimeout)
-	defer cancel()
-
-	resp, err := a.scheduler.ListJobs(ctx, &schedulerv1pb.ListJobsRequest{
-		Metadata: &schedulerv1pb.JobMetadata{
-			AppId:     a.appID,
-			Namespace: a.Namespace(),
-			Target: &schedulerv1pb.JobTargetMetadata{
-				Type: &schedulerv1pb.JobTargetMetadata_Job{
-					Job: new(schedulerv1pb.TargetJob),
-				},
-			},
-		},
-	})
-	if err != nil {
-		a.logger.Errorf("Error listing jobs due to: %s", err)
-		return nil, apierrors.SchedulerListJobs(errMetadata, err)
-	}
-
-	jobs := make([]*runtimev1pb.Job, 0, len(resp.GetJobs()))
-	for _, namedJob := range resp.GetJobs() {
-		job := namedJob.GetJob()
-		//nolint:protogetter
-		jobs = append(jobs, &runtimev1pb.Job{
-			Name:          namedJob.GetName(),
-			Schedule:      job.Schedule,
-			Repeats:       job.Repeats,
-			DueTime:       job.DueTime,
-			Ttl:           job.Ttl,
-			Data:          job.Data,
-			FailurePolicy: job.FailurePolicy,
-		})
-	}
-
-	return &runtimev1pb.ListJobsResponseAlpha1{
-		Jobs: jobs,
-	}, nil
-}
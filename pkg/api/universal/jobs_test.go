@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	epb "google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/dapr/dapr/pkg/messages"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+	"github.com/dapr/dapr/pkg/scheduler/joboptions"
+	kiterrors "github.com/dapr/kit/errors"
+	"github.com/dapr/kit/ptr"
+)
+
+type rejectingScheduler struct {
+	*fakeJobsScheduler
+	err error
+}
+
+func (r *rejectingScheduler) ScheduleJob(context.Context, *schedulerv1pb.ScheduleJobRequest, ...grpc.CallOption) (*schedulerv1pb.ScheduleJobResponse, error) {
+	return nil, r.err
+}
+
+func TestScheduleJobQuotaExceeded(t *testing.T) {
+	fakeAPI := &Universal{
+		logger: testLogger,
+		appID:  "myapp",
+		scheduler: &rejectingScheduler{
+			fakeJobsScheduler: newFakeJobsScheduler(nil),
+			err:               status.Error(codes.ResourceExhausted, "quota exceeded: app myapp has reached the maximum of 10 jobs"),
+		},
+	}
+
+	_, err := fakeAPI.ScheduleJobAlpha1(t.Context(), &runtimev1pb.ScheduleJobRequest{
+		Job: &runtimev1pb.Job{Name: "myjob", Schedule: ptr.Of("@daily")},
+	})
+	require.Error(t, err)
+
+	s, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.ResourceExhausted, s.Code())
+	assert.Contains(t, s.Message(), "maximum of 10 jobs")
+	require.NotEmpty(t, s.Details())
+	errInfo, ok := s.Details()[0].(*epb.ErrorInfo)
+	require.True(t, ok)
+	assert.Equal(t, "DAPR_SCHEDULER_QUOTA_EXCEEDED", errInfo.GetReason())
+
+	kitErr, ok := kiterrors.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, http.StatusTooManyRequests, kitErr.HTTPStatusCode())
+}
+
+func TestScheduleJobFailurePolicy(t *testing.T) {
+	sched := newFakeJobsScheduler(map[string]*schedulerv1pb.Job{})
+	fakeAPI := &Universal{
+		logger:    testLogger,
+		appID:     "myapp",
+		scheduler: sched,
+	}
+
+	data, err := anypb.New(wrapperspb.String("hello"))
+	require.NoError(t, err)
+
+	t.Run("the policy of the metadata is stored in the failure policy", func(t *testing.T) {
+		_, err := fakeAPI.ScheduleJobWithMetadata(t.Context(), &runtimev1pb.ScheduleJobRequest{
+			Job: &runtimev1pb.Job{
+				Name:     "myjob",
+				Schedule: ptr.Of("@daily"),
+				Data:     data,
+				FailurePolicy: &commonv1pb.JobFailurePolicy{
+					Policy: &commonv1pb.JobFailurePolicy_Drop{Drop: new(commonv1pb.JobFailurePolicyDrop)},
+				},
+			},
+		}, map[string]string{joboptions.MetadataKeyFailureRetries: "2", joboptions.MetadataKeyFailureAction: joboptions.ActionSkip})
+		require.NoError(t, err)
+
+		job := sched.jobs["myjob"]
+		assert.Same(t, data, job.GetData())
+		assert.NotNil(t, job.GetFailurePolicy().GetDrop())
+		assert.Equal(t, uint32(2), job.GetFailurePolicy().GetDelivery().GetMaxRetries())
+		assert.Equal(t, commonv1pb.JobFailurePolicyDelivery_SKIP, job.GetFailurePolicy().GetDelivery().GetAction())
+	})
+
+	t.Run("the trigger options are stored in the fields of the job", func(t *testing.T) {
+		_, err := fakeAPI.ScheduleJobWithMetadata(t.Context(), &runtimev1pb.ScheduleJobRequest{
+			Job: &runtimev1pb.Job{
+				Name:     "tzjob",
+				Schedule: ptr.Of("@daily"),
+				Data:     data,
+			},
+		}, map[string]string{joboptions.MetadataKeyTimeZone: "Europe/Madrid", joboptions.MetadataKeyJitter: "30s"})
+		require.NoError(t, err)
+
+		job := sched.jobs["tzjob"]
+		assert.Same(t, data, job.GetData())
+		assert.Equal(t, "@daily", job.GetSchedule())
+		assert.Equal(t, "Europe/Madrid", job.GetTimeZone())
+		assert.Equal(t, 30*time.Second, job.GetJitter().AsDuration())
+		assert.Nil(t, job.GetFailurePolicy())
+	})
+
+	t.Run("invalid policies are rejected", func(t *testing.T) {
+		_, err := fakeAPI.ScheduleJobAlpha1(t.Context(), &runtimev1pb.ScheduleJobRequest{
+			Job: &runtimev1pb.Job{
+				Name:     "invalid",
+				Schedule: ptr.Of("@daily"),
+				FailurePolicy: &commonv1pb.JobFailurePolicy{
+					Delivery: &commonv1pb.JobFailurePolicyDelivery{Action: commonv1pb.JobFailurePolicyDelivery_DEAD_LETTER},
+				},
+			},
+		})
+		require.ErrorIs(t, err, messages.ErrBadRequest)
+		assert.NotContains(t, sched.names(), "invalid")
+	})
+}
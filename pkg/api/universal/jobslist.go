@@ -0,0 +1,412 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apierrors "github.com/dapr/dapr/pkg/api/errors"
+	"github.com/dapr/dapr/pkg/messages"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+	"github.com/dapr/dapr/pkg/scheduler/joboptions"
+	"github.com/dapr/kit/cron"
+	"github.com/dapr/kit/ptr"
+)
+
+// jobsBulkConcurrency is the max number of concurrent requests to the
+// scheduler of a bulk operation.
+const jobsBulkConcurrency = 16
+
+// jobScheduleParser parses job schedules the way the scheduler does.
+var jobScheduleParser = cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// JobsFilter selects jobs of the app. An empty filter selects all the jobs.
+type JobsFilter struct {
+	// NamePrefix selects the jobs whose name has the prefix.
+	NamePrefix string
+	// ScheduledAfter and ScheduledBefore select the jobs whose next trigger is
+	// in the window. Jobs whose next trigger is unknown, for example because
+	// their due time is relative to when they were scheduled, don't match.
+	ScheduledAfter  *time.Time
+	ScheduledBefore *time.Time
+	// OverdueOnly selects the one-shot jobs whose due time has passed. The
+	// scheduler keeps them until they are delivered to the app.
+	OverdueOnly bool
+	// Paused selects the paused jobs if true, or the active jobs if false.
+	Paused *bool
+}
+
+// IsEmpty returns true if the filter selects all the jobs.
+func (f *JobsFilter) IsEmpty() bool {
+	return f.NamePrefix == "" && f.ScheduledAfter == nil && f.ScheduledBefore == nil && !f.OverdueOnly && f.Paused == nil
+}
+
+// match returns true if the job matches the filter.
+func (f *JobsFilter) match(job *JobInfo) bool {
+	if !strings.HasPrefix(job.Job.GetName(), f.NamePrefix) {
+		return false
+	}
+	if f.Paused != nil && *f.Paused != job.Paused {
+		return false
+	}
+	if f.OverdueOnly && !job.Overdue {
+		return false
+	}
+	if f.ScheduledAfter != nil && (job.NextTrigger == nil || job.NextTrigger.Before(*f.ScheduledAfter)) {
+		return false
+	}
+	if f.ScheduledBefore != nil && (job.NextTrigger == nil || job.NextTrigger.After(*f.ScheduledBefore)) {
+		return false
+	}
+	return true
+}
+
+// JobInfo is a job of the app returned by ListJobs.
+type JobInfo struct {
+	Job *runtimev1pb.Job
+	// NextTrigger is the next time the job triggers, or nil if it's unknown or
+	// the job is paused.
+	NextTrigger *time.Time
+	// Overdue is true if the job is a one-shot job whose due time has passed.
+	Overdue bool
+	Paused  bool
+}
+
+// BulkJobsRequest selects the jobs of a bulk operation, either by name or with
+// a filter.
+type BulkJobsRequest struct {
+	Names  []string
+	Filter JobsFilter
+}
+
+// BulkJobsResult is the result of a bulk operation for a job.
+type BulkJobsResult struct {
+	Name string
+	Err  error
+}
+
+// ListJobs lists the active and paused jobs of the app matching the filter,
+// sorted by name.
+func (a *Universal) ListJobs(ctx context.Context, filter *JobsFilter) ([]*JobInfo, error) {
+	ctx, cancel := context.WithTimeout(ctx, rpcTimeout)
+	defer cancel()
+
+	resp, err := a.scheduler.ListJobs(ctx, &schedulerv1pb.ListJobsRequest{
+		Metadata: a.jobMetadata(),
+	}, grpc.WaitForReady(true))
+	if err != nil {
+		a.traceLogger(ctx).Errorf("Error listing jobs due to: %s", err)
+		return nil, apierrors.SchedulerListJobs(a.jobErrMetadata(), err)
+	}
+
+	now := time.Now()
+	jobs := make([]*JobInfo, 0, len(resp.GetJobs()))
+	for _, namedJob := range resp.GetJobs() {
+		info := newJobInfo(namedJob.GetName(), namedJob.GetJob(), namedJob.GetPaused(), now)
+		if info == nil {
+			a.traceLogger(ctx).Warnf("Ignoring job %s with an invalid definition", namedJob.GetName())
+			continue
+		}
+		if filter == nil || filter.match(info) {
+			jobs = append(jobs, info)
+		}
+	}
+	slices.SortFunc(jobs, func(a, b *JobInfo) int {
+		return strings.Compare(a.Job.GetName(), b.Job.GetName())
+	})
+
+	return jobs, nil
+}
+
+// DeleteJobs deletes the selected jobs, paused or not.
+func (a *Universal) DeleteJobs(ctx context.Context, req *BulkJobsRequest) ([]BulkJobsResult, error) {
+	return a.bulkJobs(ctx, req, nil, func(ctx context.Context, name string) error {
+		ctx, cancel := context.WithTimeout(ctx, rpcTimeout)
+		defer cancel()
+
+		_, err := a.scheduler.DeleteJob(ctx, &schedulerv1pb.DeleteJobRequest{
+			Name:     name,
+			Metadata: a.jobMetadata(),
+		}, grpc.WaitForReady(true))
+		if err != nil {
+			return apierrors.SchedulerDeleteJob(a.jobErrMetadata(), err)
+		}
+		return nil
+	})
+}
+
+// PauseJobs pauses the selected active jobs. The scheduler doesn't trigger
+// paused jobs until they are resumed.
+func (a *Universal) PauseJobs(ctx context.Context, req *BulkJobsRequest) ([]BulkJobsResult, error) {
+	return a.bulkJobs(ctx, req, ptr.Of(false), func(ctx context.Context, name string) error {
+		ctx, cancel := context.WithTimeout(ctx, rpcTimeout)
+		defer cancel()
+
+		_, err := a.scheduler.PauseJob(ctx, &schedulerv1pb.PauseJobRequest{
+			Name:     name,
+			Metadata: a.jobMetadata(),
+		}, grpc.WaitForReady(true))
+		if err != nil {
+			return apierrors.SchedulerPauseJob(a.jobErrMetadata(), err)
+		}
+		return nil
+	})
+}
+
+// ResumeJobs resumes the selected paused jobs. Their due time and TTL, if
+// relative, are relative to the time they are resumed.
+func (a *Universal) ResumeJobs(ctx context.Context, req *BulkJobsRequest) ([]BulkJobsResult, error) {
+	return a.bulkJobs(ctx, req, ptr.Of(true), func(ctx context.Context, name string) error {
+		ctx, cancel := context.WithTimeout(ctx, rpcTimeout)
+		defer cancel()
+
+		_, err := a.scheduler.ResumeJob(ctx, &schedulerv1pb.ResumeJobRequest{
+			Name:     name,
+			Metadata: a.jobMetadata(),
+		}, grpc.WaitForReady(true))
+		if err != nil {
+			return apierrors.SchedulerResumeJob(a.jobErrMetadata(), err)
+		}
+		return nil
+	})
+}
+
+// ListJobsAlpha1 lists the jobs of the app matching the filter of the request.
+func (a *Universal) ListJobsAlpha1(ctx context.Context, req *runtimev1pb.ListJobsRequestAlpha1) (*runtimev1pb.ListJobsResponseAlpha1, error) {
+	jobs, err := a.ListJobs(ctx, jobsFilterFromProto(req.GetFilter()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &runtimev1pb.ListJobsResponseAlpha1{
+		Jobs:     make([]*runtimev1pb.Job, 0, len(jobs)),
+		JobInfos: make([]*runtimev1pb.JobInfoAlpha1, len(jobs)),
+	}
+	for i, job := range jobs {
+		if !job.Paused {
+			resp.Jobs = append(resp.Jobs, job.Job)
+		}
+		resp.JobInfos[i] = &runtimev1pb.JobInfoAlpha1{
+			Job:     job.Job,
+			Overdue: job.Overdue,
+			Paused:  job.Paused,
+		}
+		if job.NextTrigger != nil {
+			resp.JobInfos[i].NextTrigger = timestamppb.New(*job.NextTrigger)
+		}
+	}
+
+	return resp, nil
+}
+
+// DeleteJobsAlpha1 deletes the selected jobs, paused or not.
+func (a *Universal) DeleteJobsAlpha1(ctx context.Context, req *runtimev1pb.BulkJobsRequestAlpha1) (*runtimev1pb.BulkJobsResponseAlpha1, error) {
+	return bulkJobsToProto(a.DeleteJobs(ctx, bulkJobsRequestFromProto(req)))
+}
+
+// PauseJobsAlpha1 pauses the selected active jobs.
+func (a *Universal) PauseJobsAlpha1(ctx context.Context, req *runtimev1pb.BulkJobsRequestAlpha1) (*runtimev1pb.BulkJobsResponseAlpha1, error) {
+	return bulkJobsToProto(a.PauseJobs(ctx, bulkJobsRequestFromProto(req)))
+}
+
+// ResumeJobsAlpha1 resumes the selected paused jobs.
+func (a *Universal) ResumeJobsAlpha1(ctx context.Context, req *runtimev1pb.BulkJobsRequestAlpha1) (*runtimev1pb.BulkJobsResponseAlpha1, error) {
+	return bulkJobsToProto(a.ResumeJobs(ctx, bulkJobsRequestFromProto(req)))
+}
+
+// bulkJobs runs the operation on the selected jobs concurrently. Jobs selected
+// with the filter are further restricted to the paused or active ones.
+func (a *Universal) bulkJobs(ctx context.Context, req *BulkJobsRequest, paused *bool, op func(ctx context.Context, name string) error) ([]BulkJobsResult, error) {
+	var names []string
+	switch {
+	case len(req.Names) > 0 && !req.Filter.IsEmpty():
+		return nil, messages.ErrBadRequest.WithFormat("jobs must be selected either by names or with a filter")
+	case len(req.Names) > 0:
+		for _, name := range req.Names {
+			if name == "" || strings.Contains(name, "|") {
+				return nil, messages.ErrBadRequest.WithFormat(fmt.Sprintf("invalid job name '%s'", name))
+			}
+		}
+		names = req.Names
+	case req.Filter.IsEmpty():
+		return nil, messages.ErrBadRequest.WithFormat("jobs must be selected either by names or with a filter")
+	default:
+		filter := req.Filter
+		if paused != nil {
+			if filter.Paused != nil && *filter.Paused != *paused {
+				return []BulkJobsResult{}, nil
+			}
+			filter.Paused = paused
+		}
+		jobs, err := a.ListJobs(ctx, &filter)
+		if err != nil {
+			return nil, err
+		}
+		for _, job := range jobs {
+			names = append(names, job.Job.GetName())
+		}
+	}
+
+	results := make([]BulkJobsResult, len(names))
+	var errs errgroup.Group
+	errs.SetLimit(jobsBulkConcurrency)
+	for i, name := range names {
+		errs.Go(func() error {
+			err := op(ctx, name)
+			if err != nil {
+				a.traceLogger(ctx).Debugf("Error in bulk operation on job %s: %s", name, err)
+			}
+			results[i] = BulkJobsResult{Name: name, Err: err}
+			return nil
+		})
+	}
+	_ = errs.Wait()
+
+	return results, nil
+}
+
+func (a *Universal) jobMetadata() *schedulerv1pb.JobMetadata {
+	return &schedulerv1pb.JobMetadata{
+		AppId:     a.appID,
+		Namespace: a.Namespace(),
+		Target: &schedulerv1pb.JobTargetMetadata{
+			Type: &schedulerv1pb.JobTargetMetadata_Job{
+				Job: new(schedulerv1pb.TargetJob),
+			},
+		},
+	}
+}
+
+func (a *Universal) jobErrMetadata() map[string]string {
+	return map[string]string{
+		"appID":     a.AppID(),
+		"namespace": a.Namespace(),
+	}
+}
+
+// newJobInfo returns the info of a job listed by the scheduler, or nil if its
+// definition is invalid.
+func newJobInfo(name string, job *schedulerv1pb.Job, paused bool, now time.Time) *JobInfo {
+	info := &JobInfo{Paused: paused}
+	opts, err := joboptions.FromProto(job)
+	if err != nil {
+		return nil
+	}
+
+	//nolint:protogetter
+	info.Job = &runtimev1pb.Job{
+		Name:          name,
+		Schedule:      job.Schedule,
+		Repeats:       job.Repeats,
+		DueTime:       job.DueTime,
+		Ttl:           job.Ttl,
+		Data:          job.GetData(),
+		FailurePolicy: job.GetFailurePolicy(),
+	}
+	if !info.Paused {
+		info.NextTrigger, info.Overdue = nextJobTrigger(job, opts, now)
+	}
+	return info
+}
+
+// nextJobTrigger returns the next time the job triggers, if known, and whether
+// it's an overdue one-shot job. Relative due times and TTLs are resolved by the
+// scheduler when the job is created, so the next trigger of jobs using them
+// isn't known. The schedule is evaluated in the time zone of the job.
+func nextJobTrigger(job *schedulerv1pb.Job, opts *joboptions.Options, now time.Time) (*time.Time, bool) {
+	var dueTime *time.Time
+	if job.DueTime != nil { //nolint:protogetter
+		t, err := time.Parse(time.RFC3339, job.GetDueTime())
+		if err != nil {
+			return nil, false
+		}
+		dueTime = &t
+	}
+
+	if job.Schedule == nil { //nolint:protogetter
+		if dueTime == nil {
+			return nil, false
+		}
+		return dueTime, dueTime.Before(now)
+	}
+
+	var next time.Time
+	if dueTime != nil && dueTime.After(now) {
+		next = *dueTime
+	} else {
+		schedule, err := jobScheduleParser.Parse(opts.CronSchedule(job.GetSchedule()))
+		if err != nil {
+			return nil, false
+		}
+		next = schedule.Next(now)
+	}
+
+	if job.Ttl != nil { //nolint:protogetter
+		expiration, err := time.Parse(time.RFC3339, job.GetTtl())
+		if err != nil || next.After(expiration) {
+			return nil, false
+		}
+	}
+	return &next, false
+}
+
+func jobsFilterFromProto(filter *runtimev1pb.JobsFilterAlpha1) *JobsFilter {
+	f := &JobsFilter{
+		NamePrefix:  filter.GetNamePrefix(),
+		OverdueOnly: filter.GetOverdueOnly(),
+	}
+	if filter != nil && filter.Paused != nil { //nolint:protogetter
+		f.Paused = ptr.Of(filter.GetPaused())
+	}
+	if filter.GetScheduledAfter() != nil {
+		f.ScheduledAfter = ptr.Of(filter.GetScheduledAfter().AsTime())
+	}
+	if filter.GetScheduledBefore() != nil {
+		f.ScheduledBefore = ptr.Of(filter.GetScheduledBefore().AsTime())
+	}
+	return f
+}
+
+func bulkJobsRequestFromProto(req *runtimev1pb.BulkJobsRequestAlpha1) *BulkJobsRequest {
+	return &BulkJobsRequest{
+		Names:  req.GetNames(),
+		Filter: *jobsFilterFromProto(req.GetFilter()),
+	}
+}
+
+func bulkJobsToProto(results []BulkJobsResult, err error) (*runtimev1pb.BulkJobsResponseAlpha1, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &runtimev1pb.BulkJobsResponseAlpha1{
+		Results: make([]*runtimev1pb.BulkJobResultAlpha1, len(results)),
+	}
+	for i, result := range results {
+		resp.Results[i] = &runtimev1pb.BulkJobResultAlpha1{Name: result.Name}
+		if result.Err != nil {
+			resp.Results[i].Error = ptr.Of(result.Err.Error())
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,311 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dapr/dapr/pkg/messages"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+	"github.com/dapr/dapr/pkg/scheduler/joboptions"
+	"github.com/dapr/kit/ptr"
+)
+
+// fakeJobsScheduler keeps the jobs of an app in memory.
+type fakeJobsScheduler struct {
+	schedulerv1pb.SchedulerClient
+
+	lock   sync.Mutex
+	jobs   map[string]*schedulerv1pb.Job
+	paused map[string]bool
+}
+
+func newFakeJobsScheduler(jobs map[string]*schedulerv1pb.Job) *fakeJobsScheduler {
+	return &fakeJobsScheduler{jobs: jobs, paused: make(map[string]bool)}
+}
+
+func (f *fakeJobsScheduler) Addresses() []string {
+	return nil
+}
+
+func (f *fakeJobsScheduler) ScheduleJob(_ context.Context, req *schedulerv1pb.ScheduleJobRequest, _ ...grpc.CallOption) (*schedulerv1pb.ScheduleJobResponse, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if _, ok := f.jobs[req.GetName()]; ok && !req.GetOverwrite() {
+		return nil, status.Error(codes.AlreadyExists, "job already exists")
+	}
+	f.jobs[req.GetName()] = req.GetJob()
+	return &schedulerv1pb.ScheduleJobResponse{}, nil
+}
+
+func (f *fakeJobsScheduler) GetJob(_ context.Context, req *schedulerv1pb.GetJobRequest, _ ...grpc.CallOption) (*schedulerv1pb.GetJobResponse, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	job, ok := f.jobs[req.GetName()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "job not found")
+	}
+	return &schedulerv1pb.GetJobResponse{Job: job}, nil
+}
+
+func (f *fakeJobsScheduler) DeleteJob(_ context.Context, req *schedulerv1pb.DeleteJobRequest, _ ...grpc.CallOption) (*schedulerv1pb.DeleteJobResponse, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	delete(f.jobs, req.GetName())
+	delete(f.paused, req.GetName())
+	return &schedulerv1pb.DeleteJobResponse{}, nil
+}
+
+func (f *fakeJobsScheduler) PauseJob(_ context.Context, req *schedulerv1pb.PauseJobRequest, _ ...grpc.CallOption) (*schedulerv1pb.PauseJobResponse, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if _, ok := f.jobs[req.GetName()]; !ok {
+		return nil, status.Error(codes.NotFound, "job not found")
+	}
+	if f.paused[req.GetName()] {
+		return nil, status.Error(codes.FailedPrecondition, "job is already paused")
+	}
+	f.paused[req.GetName()] = true
+	return &schedulerv1pb.PauseJobResponse{}, nil
+}
+
+func (f *fakeJobsScheduler) ResumeJob(_ context.Context, req *schedulerv1pb.ResumeJobRequest, _ ...grpc.CallOption) (*schedulerv1pb.ResumeJobResponse, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if !f.paused[req.GetName()] {
+		return nil, status.Error(codes.NotFound, "paused job not found")
+	}
+	delete(f.paused, req.GetName())
+	return &schedulerv1pb.ResumeJobResponse{}, nil
+}
+
+func (f *fakeJobsScheduler) ListJobs(context.Context, *schedulerv1pb.ListJobsRequest, ...grpc.CallOption) (*schedulerv1pb.ListJobsResponse, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	res := &schedulerv1pb.ListJobsResponse{}
+	for name, job := range f.jobs {
+		res.Jobs = append(res.Jobs, &schedulerv1pb.NamedJob{Name: name, Job: job, Paused: f.paused[name]})
+	}
+	return res, nil
+}
+
+func (f *fakeJobsScheduler) names() []string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	names := make([]string, 0, len(f.jobs))
+	for name := range f.jobs {
+		names = append(names, name)
+	}
+	return names
+}
+
+func (f *fakeJobsScheduler) pausedNames() []string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	names := make([]string, 0, len(f.paused))
+	for name := range f.paused {
+		names = append(names, name)
+	}
+	return names
+}
+
+func jobNames(jobs []*JobInfo) []string {
+	names := make([]string, len(jobs))
+	for i, job := range jobs {
+		names[i] = job.Job.GetName()
+	}
+	return names
+}
+
+func TestListJobs(t *testing.T) {
+	now := time.Now().UTC()
+	past := now.Add(-time.Hour).Format(time.RFC3339)
+	soon := now.Add(time.Hour).Format(time.RFC3339)
+	later := now.Add(48 * time.Hour).Format(time.RFC3339)
+
+	sched := newFakeJobsScheduler(map[string]*schedulerv1pb.Job{
+		"report-daily":  {Schedule: ptr.Of("0 0 0 * * *"), DueTime: ptr.Of(later)},
+		"report-once":   {DueTime: ptr.Of(soon)},
+		"cleanup-late":  {DueTime: ptr.Of(past)},
+		"cleanup-later": {DueTime: ptr.Of(later)},
+		"relative":      {DueTime: ptr.Of("10s")},
+	})
+	fakeAPI := &Universal{
+		logger:    testLogger,
+		appID:     "myapp",
+		scheduler: sched,
+	}
+
+	t.Run("all jobs", func(t *testing.T) {
+		jobs, err := fakeAPI.ListJobs(t.Context(), &JobsFilter{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"cleanup-late", "cleanup-later", "relative", "report-daily", "report-once"}, jobNames(jobs))
+		assert.True(t, jobs[0].Overdue)
+		assert.Nil(t, jobs[2].NextTrigger)
+		require.NotNil(t, jobs[3].NextTrigger)
+		assert.True(t, jobs[3].NextTrigger.After(now))
+	})
+
+	t.Run("name prefix", func(t *testing.T) {
+		jobs, err := fakeAPI.ListJobs(t.Context(), &JobsFilter{NamePrefix: "report-"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"report-daily", "report-once"}, jobNames(jobs))
+	})
+
+	t.Run("schedule window", func(t *testing.T) {
+		jobs, err := fakeAPI.ListJobs(t.Context(), &JobsFilter{
+			ScheduledAfter:  ptr.Of(now),
+			ScheduledBefore: ptr.Of(now.Add(2 * time.Hour)),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"report-once"}, jobNames(jobs))
+	})
+
+	t.Run("overdue only", func(t *testing.T) {
+		jobs, err := fakeAPI.ListJobs(t.Context(), &JobsFilter{OverdueOnly: true})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"cleanup-late"}, jobNames(jobs))
+	})
+}
+
+func TestBulkJobs(t *testing.T) {
+	sched := newFakeJobsScheduler(map[string]*schedulerv1pb.Job{
+		"a1": {Schedule: ptr.Of("@every 1h")},
+		"a2": {Schedule: ptr.Of("@every 1h")},
+		"b1": {Schedule: ptr.Of("@every 1h")},
+	})
+	fakeAPI := &Universal{
+		logger:    testLogger,
+		appID:     "myapp",
+		scheduler: sched,
+	}
+
+	t.Run("invalid selection", func(t *testing.T) {
+		_, err := fakeAPI.DeleteJobs(t.Context(), &BulkJobsRequest{})
+		require.ErrorIs(t, err, messages.ErrBadRequest)
+
+		_, err = fakeAPI.DeleteJobs(t.Context(), &BulkJobsRequest{Names: []string{"a1"}, Filter: JobsFilter{NamePrefix: "a"}})
+		require.ErrorIs(t, err, messages.ErrBadRequest)
+	})
+
+	t.Run("pause by filter", func(t *testing.T) {
+		results, err := fakeAPI.PauseJobs(t.Context(), &BulkJobsRequest{Filter: JobsFilter{NamePrefix: "a"}})
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		for _, res := range results {
+			require.NoError(t, res.Err)
+		}
+		assert.ElementsMatch(t, []string{"a1", "a2"}, sched.pausedNames())
+
+		jobs, err := fakeAPI.ListJobs(t.Context(), &JobsFilter{Paused: ptr.Of(true)})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a1", "a2"}, jobNames(jobs))
+		assert.Equal(t, "@every 1h", jobs[0].Job.GetSchedule())
+		assert.Nil(t, jobs[0].NextTrigger)
+	})
+
+	t.Run("pausing a paused job fails", func(t *testing.T) {
+		results, err := fakeAPI.PauseJobs(t.Context(), &BulkJobsRequest{Names: []string{"a1"}})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.Error(t, results[0].Err)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(results[0].Err))
+	})
+
+	t.Run("resume by name", func(t *testing.T) {
+		results, err := fakeAPI.ResumeJobs(t.Context(), &BulkJobsRequest{Names: []string{"a1"}})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.NoError(t, results[0].Err)
+		assert.ElementsMatch(t, []string{"a2"}, sched.pausedNames())
+	})
+
+	t.Run("delete by name deletes paused jobs", func(t *testing.T) {
+		results, err := fakeAPI.DeleteJobs(t.Context(), &BulkJobsRequest{Names: []string{"a1", "a2"}})
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.ElementsMatch(t, []string{"b1"}, sched.names())
+		assert.Empty(t, sched.pausedNames())
+	})
+}
+
+func TestJobsAlpha1(t *testing.T) {
+	sched := newFakeJobsScheduler(map[string]*schedulerv1pb.Job{
+		"a1": {Schedule: ptr.Of("@every 1h")},
+		"a2": {Schedule: ptr.Of("@every 1h")},
+		"b1": {Schedule: ptr.Of("@every 1h")},
+	})
+	fakeAPI := &Universal{
+		logger:    testLogger,
+		appID:     "myapp",
+		scheduler: sched,
+	}
+
+	resp, err := fakeAPI.PauseJobsAlpha1(t.Context(), &runtimev1pb.BulkJobsRequestAlpha1{
+		Filter: &runtimev1pb.JobsFilterAlpha1{NamePrefix: ptr.Of("a")},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetResults(), 2)
+	for _, res := range resp.GetResults() {
+		assert.Nil(t, res.Error) //nolint:protogetter
+	}
+
+	resp, err = fakeAPI.ResumeJobsAlpha1(t.Context(), &runtimev1pb.BulkJobsRequestAlpha1{Names: []string{"b1"}})
+	require.NoError(t, err)
+	require.Len(t, resp.GetResults(), 1)
+	assert.NotEmpty(t, resp.GetResults()[0].GetError())
+
+	list, err := fakeAPI.ListJobsAlpha1(t.Context(), &runtimev1pb.ListJobsRequestAlpha1{})
+	require.NoError(t, err)
+	require.Len(t, list.GetJobs(), 1)
+	assert.Equal(t, "b1", list.GetJobs()[0].GetName())
+	require.Len(t, list.GetJobInfos(), 3)
+	assert.True(t, list.GetJobInfos()[0].GetPaused())
+	assert.Nil(t, list.GetJobInfos()[0].GetNextTrigger())
+	assert.False(t, list.GetJobInfos()[2].GetPaused())
+	assert.NotNil(t, list.GetJobInfos()[2].GetNextTrigger())
+
+	list, err = fakeAPI.ListJobsAlpha1(t.Context(), &runtimev1pb.ListJobsRequestAlpha1{
+		Filter: &runtimev1pb.JobsFilterAlpha1{Paused: ptr.Of(true)},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, list.GetJobs())
+	require.Len(t, list.GetJobInfos(), 2)
+	assert.Equal(t, "a1", list.GetJobInfos()[0].GetJob().GetName())
+
+	resp, err = fakeAPI.DeleteJobsAlpha1(t.Context(), &runtimev1pb.BulkJobsRequestAlpha1{Names: []string{"a1", "b1"}})
+	require.NoError(t, err)
+	require.Len(t, resp.GetResults(), 2)
+	assert.ElementsMatch(t, []string{"a2"}, sched.names())
+}
+
+func TestNextJobTriggerTimeZone(t *testing.T) {
+	now := time.Date(2026, time.January, 15, 0, 0, 0, 0, time.UTC)
+	job := &schedulerv1pb.Job{Schedule: ptr.Of("0 0 9 * * *")}
+
+	next, overdue := nextJobTrigger(job, &joboptions.Options{TimeZone: "America/New_York"}, now)
+	require.NotNil(t, next)
+	assert.False(t, overdue)
+	assert.Equal(t, time.Date(2026, time.January, 15, 14, 0, 0, 0, time.UTC), next.UTC())
+}
@@ -15,24 +15,47 @@ package universal
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/dapr/components-contrib/lock"
+	"github.com/dapr/components-contrib/state"
 	lockLoader "github.com/dapr/dapr/pkg/components/lock"
 	"github.com/dapr/dapr/pkg/messages"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
 )
 
+// LockFencingTokenKey is the response metadata with the fencing token of an
+// acquired lock.
+const LockFencingTokenKey = "fencingToken"
+
+// lockStateMaxAttempts is the max number of attempts to update the state of a
+// lock in the state store when there are concurrent updates.
+const lockStateMaxAttempts = 10
+
 func (a *Universal) TryLockAlpha1(ctx context.Context, req *runtimev1pb.TryLockRequest) (*runtimev1pb.TryLockResponse, error) {
+	resp, _, err := a.TryLockFenced(ctx, req)
+	return resp, err
+}
+
+// TryLockFenced tries acquiring the lock, and returns its fencing token when
+// it's acquired. The fencing token is returned by the lock store, or it's the
+// next value of a sequence kept in the state store configured in the
+// "fencingTokenStateStore" metadata property of the lock store. It's 0 if the
+// lock store doesn't return fencing tokens and no state store is configured.
+func (a *Universal) TryLockFenced(ctx context.Context, req *runtimev1pb.TryLockRequest) (*runtimev1pb.TryLockResponse, int64, error) {
 	// 1. validate and find lock component
 	if req.GetExpiryInSeconds() <= 0 {
 		err := messages.ErrExpiryInSecondsNotPositive.WithFormat(req.GetStoreName())
-		a.logger.Debug(err)
-		return &runtimev1pb.TryLockResponse{}, err
+		a.traceLogger(ctx).Debug(err)
+		return &runtimev1pb.TryLockResponse{}, 0, err
 	}
-	store, err := a.lockValidateRequest(req)
+	store, err := a.lockValidateRequest(ctx, req)
 	if err != nil {
-		return &runtimev1pb.TryLockResponse{}, err
+		return &runtimev1pb.TryLockResponse{}, 0, err
 	}
 
 	// 2. convert request
@@ -45,37 +68,160 @@ func (a *Universal) TryLockAlpha1(ctx context.Context, req *runtimev1pb.TryLockR
 	compReq.ResourceID, err = lockLoader.GetModifiedLockKey(compReq.ResourceID, req.GetStoreName(), a.appID)
 	if err != nil {
 		err = messages.ErrTryLockFailed.WithFormat(err)
-		a.logger.Debug(err)
-		return &runtimev1pb.TryLockResponse{}, err
+		a.traceLogger(ctx).Debug(err)
+		return &runtimev1pb.TryLockResponse{}, 0, err
+	}
+
+	// Shared locks are emulated in a state store, unless the lock store
+	// supports them.
+	rwStateStoreName, fairness := lockLoader.GetReadWriteLockConfiguration(req.GetStoreName())
+	_, nativeRW := store.(lockLoader.RWLocker)
+	emulateRW := !nativeRW && rwStateStoreName != ""
+	if nativeRW {
+		compReq.Metadata = map[string]string{lockLoader.FairnessMetadataKey: fairness}
 	}
 
 	// 3. delegate to the component
-	policyRunner := resiliency.NewRunner[*lock.TryLockResponse](ctx,
+	policyRunner := resiliency.NewRunner[*lockLoader.FencedTryLockResponse](ctx,
 		a.resiliency.ComponentOutboundPolicy(req.GetStoreName(), resiliency.Lock),
 	)
-	resp, err := policyRunner(func(ctx context.Context) (*lock.TryLockResponse, error) {
-		return store.TryLock(ctx, compReq)
+	resp, err := policyRunner(func(ctx context.Context) (*lockLoader.FencedTryLockResponse, error) {
+		if fenced, ok := store.(lockLoader.FencedLocker); ok {
+			return fenced.TryLockFenced(ctx, compReq)
+		}
+		rResp, rErr := store.TryLock(ctx, compReq)
+		if rResp == nil {
+			return nil, rErr
+		}
+		return &lockLoader.FencedTryLockResponse{Success: rResp.Success}, rErr
 	})
 	if err != nil {
 		err = messages.ErrTryLockFailed.WithFormat(err)
-		a.logger.Debug(err)
-		return &runtimev1pb.TryLockResponse{}, err
+		a.traceLogger(ctx).Debug(err)
+		return &runtimev1pb.TryLockResponse{}, 0, err
 	}
 
 	// 4. convert response
 	if resp == nil {
-		return &runtimev1pb.TryLockResponse{}, nil
+		return &runtimev1pb.TryLockResponse{}, 0, nil
+	}
+	if resp.Success && emulateRW {
+		// The exclusive lock can't be held together with shared locks.
+		var ok bool
+		ok, err = a.tryLockWriter(ctx, rwStateStoreName, fairness, compReq)
+		if err != nil || !ok {
+			a.releaseLock(ctx, store, compReq)
+		}
+		if err != nil {
+			err = messages.ErrTryLockFailed.WithFormat(fmt.Sprintf("failed to check shared locks: %v", err))
+			a.traceLogger(ctx).Debug(err)
+			return &runtimev1pb.TryLockResponse{}, 0, err
+		}
+		if !ok {
+			return &runtimev1pb.TryLockResponse{}, 0, nil
+		}
+	}
+	token := resp.FencingToken
+	if resp.Success && token == 0 {
+		if stateStoreName := lockLoader.GetFencingTokenStateStore(req.GetStoreName()); stateStoreName != "" {
+			token, err = a.nextFencingToken(ctx, stateStoreName, compReq.ResourceID)
+			if err != nil {
+				// Without a fencing token, the lock can't be used safely.
+				a.releaseLock(ctx, store, compReq)
+				err = messages.ErrTryLockFailed.WithFormat(fmt.Sprintf("failed to get fencing token: %v", err))
+				a.traceLogger(ctx).Debug(err)
+				return &runtimev1pb.TryLockResponse{}, 0, err
+			}
+		}
 	}
 	return &runtimev1pb.TryLockResponse{
 		Success: resp.Success,
-	}, nil
+	}, token, nil
+}
+
+// releaseLock releases an exclusive lock which can't be granted after all.
+func (a *Universal) releaseLock(ctx context.Context, store lock.Store, req *lock.TryLockRequest) {
+	if _, err := store.Unlock(ctx, &lock.UnlockRequest{ResourceID: req.ResourceID, LockOwner: req.LockOwner}); err != nil {
+		a.traceLogger(ctx).Warnf("Failed to release lock %s: %v", req.ResourceID, err)
+	}
+}
+
+// nextFencingToken increments the sequence of the fencing tokens of the lock in
+// the state store.
+func (a *Universal) nextFencingToken(ctx context.Context, stateStoreName string, lockKey string) (int64, error) {
+	var token int64
+	err := a.updateLockState(ctx, stateStoreName, lockLoader.FencingTokenKey(lockKey), func(data []byte) (any, error) {
+		token = 0
+		if len(data) > 0 {
+			if err := json.Unmarshal(data, &token); err != nil {
+				return nil, fmt.Errorf("invalid fencing token sequence: %w", err)
+			}
+		}
+		token++
+		return token, nil
+	})
+	return token, err
+}
+
+// updateLockState updates a key in the state store keeping the state of the
+// locks, with optimistic concurrency. The update function receives the
+// current value of the key, which is nil if it doesn't exist, and returns the
+// new value, or nil to leave it unchanged. It's called again after a
+// concurrent update.
+func (a *Universal) updateLockState(ctx context.Context, stateStoreName string, key string, update func(data []byte) (any, error)) error {
+	store, ok := a.compStore.GetStateStore(stateStoreName)
+	if !ok {
+		return fmt.Errorf("state store %s not found", stateStoreName)
+	}
+	if !state.FeatureETag.IsPresent(store.Features()) {
+		return fmt.Errorf("state store %s doesn't support ETags", stateStoreName)
+	}
+
+	for range lockStateMaxAttempts {
+		res, err := store.Get(ctx, &state.GetRequest{
+			Key:     key,
+			Options: state.GetStateOption{Consistency: state.Strong},
+		})
+		if err != nil {
+			return err
+		}
+
+		setReq := &state.SetRequest{
+			Key: key,
+			Options: state.SetStateOption{
+				Concurrency: state.FirstWrite,
+				Consistency: state.Strong,
+			},
+		}
+		var data []byte
+		if res != nil && len(res.Data) > 0 {
+			data = res.Data
+			setReq.ETag = res.ETag
+		}
+		setReq.Value, err = update(data)
+		if err != nil || setReq.Value == nil {
+			return err
+		}
+
+		err = store.Set(ctx, setReq)
+		var etagErr *state.ETagError
+		switch {
+		case errors.As(err, &etagErr) && etagErr.Kind() == state.ETagMismatch:
+			// Concurrent update: try again with the new value.
+			continue
+		case err != nil:
+			return err
+		}
+		return nil
+	}
+	return fmt.Errorf("too many concurrent updates of %s", key)
 }
 
 func (a *Universal) UnlockAlpha1(ctx context.Context, req *runtimev1pb.UnlockRequest) (*runtimev1pb.UnlockResponse, error) {
 	var err error
 
 	// 1. validate and find lock component
-	store, err := a.lockValidateRequest(req)
+	store, err := a.lockValidateRequest(ctx, req)
 	if err != nil {
 		return newInternalErrorUnlockResponse(), err
 	}
@@ -89,7 +235,7 @@ func (a *Universal) UnlockAlpha1(ctx context.Context, req *runtimev1pb.UnlockReq
 	compReq.ResourceID, err = lockLoader.GetModifiedLockKey(compReq.ResourceID, req.GetStoreName(), a.appID)
 	if err != nil {
 		err = messages.ErrUnlockFailed.WithFormat(err)
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return newInternalErrorUnlockResponse(), err
 	}
 
@@ -102,10 +248,24 @@ func (a *Universal) UnlockAlpha1(ctx context.Context, req *runtimev1pb.UnlockReq
 	})
 	if err != nil {
 		err = messages.ErrUnlockFailed.WithFormat(err)
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return newInternalErrorUnlockResponse(), err
 	}
 
+	// The lock isn't renewed anymore, whether it has been released or not.
+	a.lockRenewer.Stop(lockRenewalKey(req.GetStoreName(), compReq.ResourceID, compReq.LockOwner))
+
+	if resp != nil && resp.Status == lock.Success {
+		if rwStateStoreName, _ := lockLoader.GetReadWriteLockConfiguration(req.GetStoreName()); rwStateStoreName != "" {
+			if _, nativeRW := store.(lockLoader.RWLocker); !nativeRW {
+				if err = a.unlockWriter(ctx, rwStateStoreName, compReq); err != nil {
+					// The lease of the writer expires in the state store with the lock.
+					a.traceLogger(ctx).Warnf("Failed to remove the owner of lock %s from state store %s: %v", compReq.ResourceID, rwStateStoreName, err)
+				}
+			}
+		}
+	}
+
 	// 4. convert response
 	if resp == nil {
 		return &runtimev1pb.UnlockResponse{}, nil
@@ -116,7 +276,112 @@ func (a *Universal) UnlockAlpha1(ctx context.Context, req *runtimev1pb.UnlockReq
 	}, nil
 }
 
-// Interface for both *runtimev1pb.TryLockRequest and *runtimev1pb.UnlockRequest
+// RenewLockAlpha1 extends the lease of a lock held by its owner. With
+// AutoRenew, the lease is then renewed by the sidecar at half of its expiry,
+// until MaxHoldSeconds have elapsed or the lock is released.
+func (a *Universal) RenewLockAlpha1(ctx context.Context, req *runtimev1pb.RenewLockRequest) (*runtimev1pb.RenewLockResponse, error) {
+	// 1. validate and find lock component
+	if req.ExpiryInSeconds <= 0 {
+		err := messages.ErrExpiryInSecondsNotPositive.WithFormat(req.StoreName)
+		a.traceLogger(ctx).Debug(err)
+		return nil, err
+	}
+	if req.AutoRenew && req.MaxHoldSeconds <= 0 {
+		err := messages.ErrMaxHoldSecondsNotPositive.WithFormat(req.StoreName)
+		a.traceLogger(ctx).Debug(err)
+		return nil, err
+	}
+	if req.AutoRenew && a.lockRenewer == nil {
+		err := messages.ErrLockAutoRenewNotAvailable
+		a.traceLogger(ctx).Debug(err)
+		return nil, err
+	}
+	store, err := a.lockValidateRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	// Lock stores which can't renew their locks have them re-acquired by the
+	// owner.
+	renewLock := func(ctx context.Context, req *lockLoader.RenewLockRequest) (*lockLoader.RenewLockResponse, error) {
+		return lockLoader.RenewByReacquiring(ctx, store, req)
+	}
+	if renewer, ok := store.(lockLoader.Renewer); ok {
+		renewLock = renewer.RenewLock
+	}
+
+	// 2. convert request
+	compReq := &lockLoader.RenewLockRequest{
+		ResourceID:      req.ResourceId,
+		LockOwner:       req.LockOwner,
+		ExpiryInSeconds: req.ExpiryInSeconds,
+	}
+	// modify key
+	compReq.ResourceID, err = lockLoader.GetModifiedLockKey(compReq.ResourceID, req.StoreName, a.appID)
+	if err != nil {
+		err = messages.ErrRenewLockFailed.WithFormat(err)
+		a.traceLogger(ctx).Debug(err)
+		return nil, err
+	}
+
+	// Shared locks are emulated in a state store, unless the lock store
+	// supports them.
+	rwStateStoreName, _ := lockLoader.GetReadWriteLockConfiguration(req.StoreName)
+	_, nativeRW := store.(lockLoader.RWLocker)
+	emulateRW := !nativeRW && rwStateStoreName != ""
+
+	// 3. delegate to the component
+	renew := func(ctx context.Context) (lock.Status, error) {
+		policyRunner := resiliency.NewRunner[*lockLoader.RenewLockResponse](ctx,
+			a.resiliency.ComponentOutboundPolicy(req.StoreName, resiliency.Lock),
+		)
+		resp, rErr := policyRunner(func(ctx context.Context) (*lockLoader.RenewLockResponse, error) {
+			return renewLock(ctx, compReq)
+		})
+		if rErr != nil {
+			return lock.InternalError, rErr
+		}
+		status := lock.Success
+		if resp != nil {
+			status = resp.Status
+		}
+		if status == lock.Success && emulateRW {
+			if rErr = a.renewWriter(ctx, rwStateStoreName, compReq); rErr != nil {
+				return lock.InternalError, rErr
+			}
+		}
+		return status, nil
+	}
+	status, err := renew(ctx)
+	if err != nil {
+		err = messages.ErrRenewLockFailed.WithFormat(err)
+		a.traceLogger(ctx).Debug(err)
+		return nil, err
+	}
+
+	// 4. start the renewals in the background
+	if req.AutoRenew && status == lock.Success {
+		a.lockRenewer.Start(
+			lockRenewalKey(req.StoreName, compReq.ResourceID, compReq.LockOwner),
+			time.Duration(req.ExpiryInSeconds)*time.Second/2,
+			time.Duration(req.MaxHoldSeconds)*time.Second,
+			func(ctx context.Context) (bool, error) {
+				status, err := renew(ctx)
+				return status == lock.Success, err
+			},
+		)
+	}
+
+	return &runtimev1pb.RenewLockResponse{
+		//nolint:nosnakecase
+		Status: runtimev1pb.RenewLockResponse_Status(status),
+	}, nil
+}
+
+func lockRenewalKey(storeName, resourceID, lockOwner string) string {
+	return storeName + "||" + resourceID + "||" + lockOwner
+}
+
+// Interface for *runtimev1pb.TryLockRequest, *runtimev1pb.UnlockRequest, *runtimev1pb.RenewLockRequest and the requests of shared locks
 type tryLockUnlockRequest interface {
 	GetResourceId() string
 	GetLockOwner() string
@@ -124,22 +389,22 @@ type tryLockUnlockRequest interface {
 }
 
 // Internal method that checks if the request is for a lock store component.
-func (a *Universal) lockValidateRequest(req tryLockUnlockRequest) (lock.Store, error) {
+func (a *Universal) lockValidateRequest(ctx context.Context, req tryLockUnlockRequest) (lock.Store, error) {
 	var err error
 
 	if a.compStore.LocksLen() == 0 {
 		err = messages.ErrLockStoresNotConfigured
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return nil, err
 	}
 	if req.GetResourceId() == "" {
 		err = messages.ErrResourceIDEmpty.WithFormat(req.GetStoreName())
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return nil, err
 	}
 	if req.GetLockOwner() == "" {
 		err = messages.ErrLockOwnerEmpty.WithFormat(req.GetStoreName())
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return nil, err
 	}
 
@@ -147,7 +412,7 @@ func (a *Universal) lockValidateRequest(req tryLockUnlockRequest) (lock.Store, e
 	store, ok := a.compStore.GetLock(req.GetStoreName())
 	if !ok {
 		err = messages.ErrLockStoreNotFound.WithFormat(req.GetStoreName())
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return nil, err
 	}
 
@@ -0,0 +1,325 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/components-contrib/lock"
+	"github.com/dapr/components-contrib/state"
+	lockLoader "github.com/dapr/dapr/pkg/components/lock"
+	"github.com/dapr/dapr/pkg/messages"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/lockrenewer"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+// nonRenewableLockStore is a lock store which can't renew leases, so they're
+// renewed by re-acquiring the locks.
+type nonRenewableLockStore struct {
+	lock.Store
+}
+
+// fencedLockStore is a lock store which returns fencing tokens.
+type fencedLockStore struct {
+	*daprt.FakeLockStore
+	token int64
+}
+
+func (s *fencedLockStore) TryLockFenced(ctx context.Context, req *lock.TryLockRequest) (*lockLoader.FencedTryLockResponse, error) {
+	res, err := s.TryLock(ctx, req)
+	if err != nil || !res.Success {
+		return &lockLoader.FencedTryLockResponse{}, err
+	}
+	s.token++
+	return &lockLoader.FencedTryLockResponse{Success: true, FencingToken: s.token}, nil
+}
+
+func TestRenewLockAlpha1(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Now())
+	store := &daprt.FakeLockStore{Clock: clock}
+	renewer := lockrenewer.New(lockrenewer.Options{Clock: clock})
+	ctx, cancel := context.WithCancel(t.Context())
+	errCh := make(chan error)
+	go func() { errCh <- renewer.Run(ctx) }()
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-errCh)
+	})
+
+	compStore := compstore.New()
+	compStore.AddLock("store1", store)
+	compStore.AddLock("store2", nonRenewableLockStore{Store: store})
+	fakeAPI := &Universal{
+		logger:      testLogger,
+		resiliency:  resiliency.New(nil),
+		compStore:   compStore,
+		appID:       "myapp",
+		lockRenewer: renewer,
+	}
+
+	tryLock := func(t *testing.T, resourceID, owner string) {
+		t.Helper()
+		res, err := fakeAPI.TryLockAlpha1(t.Context(), &runtimev1pb.TryLockRequest{
+			StoreName:       "store1",
+			ResourceId:      resourceID,
+			LockOwner:       owner,
+			ExpiryInSeconds: 10,
+		})
+		require.NoError(t, err)
+		require.True(t, res.GetSuccess())
+	}
+
+	t.Run("renew extends the lease", func(t *testing.T) {
+		tryLock(t, "r1", "owner1")
+
+		clock.Step(8 * time.Second)
+		res, err := fakeAPI.RenewLockAlpha1(t.Context(), &runtimev1pb.RenewLockRequest{
+			StoreName:       "store1",
+			ResourceId:      "r1",
+			LockOwner:       "owner1",
+			ExpiryInSeconds: 10,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, runtimev1pb.RenewLockResponse_SUCCESS, res.GetStatus()) //nolint:nosnakecase
+
+		// The lease would have expired without the renewal.
+		clock.Step(8 * time.Second)
+		assert.Equal(t, "owner1", store.Owner("lock||myapp||r1"))
+	})
+
+	t.Run("renew a lock held by others", func(t *testing.T) {
+		tryLock(t, "r2", "owner1")
+
+		res, err := fakeAPI.RenewLockAlpha1(t.Context(), &runtimev1pb.RenewLockRequest{
+			StoreName:       "store1",
+			ResourceId:      "r2",
+			LockOwner:       "owner2",
+			ExpiryInSeconds: 10,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, runtimev1pb.RenewLockResponse_LOCK_BELONGS_TO_OTHERS, res.GetStatus()) //nolint:nosnakecase
+	})
+
+	t.Run("renew an expired lock", func(t *testing.T) {
+		res, err := fakeAPI.RenewLockAlpha1(t.Context(), &runtimev1pb.RenewLockRequest{
+			StoreName:       "store1",
+			ResourceId:      "notheld",
+			LockOwner:       "owner1",
+			ExpiryInSeconds: 10,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, runtimev1pb.RenewLockResponse_LOCK_DOES_NOT_EXIST, res.GetStatus()) //nolint:nosnakecase
+	})
+
+	t.Run("renew by re-acquiring the lock", func(t *testing.T) {
+		tryLock(t, "r4", "owner1")
+
+		clock.Step(8 * time.Second)
+		renewals := store.RenewalCount()
+		res, err := fakeAPI.RenewLockAlpha1(t.Context(), &runtimev1pb.RenewLockRequest{
+			StoreName:       "store2",
+			ResourceId:      "r4",
+			LockOwner:       "owner1",
+			ExpiryInSeconds: 10,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, runtimev1pb.RenewLockResponse_SUCCESS, res.GetStatus()) //nolint:nosnakecase
+		assert.Equal(t, renewals, store.RenewalCount())
+
+		clock.Step(8 * time.Second)
+		assert.Equal(t, "owner1", store.Owner("lock||myapp||r4"))
+
+		res, err = fakeAPI.RenewLockAlpha1(t.Context(), &runtimev1pb.RenewLockRequest{
+			StoreName:       "store2",
+			ResourceId:      "r4",
+			LockOwner:       "owner2",
+			ExpiryInSeconds: 10,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, runtimev1pb.RenewLockResponse_LOCK_BELONGS_TO_OTHERS, res.GetStatus()) //nolint:nosnakecase
+		assert.Equal(t, "owner1", store.Owner("lock||myapp||r4"))
+	})
+
+	t.Run("auto renew until the lock is released", func(t *testing.T) {
+		tryLock(t, "r3", "owner1")
+		renewals := store.RenewalCount()
+
+		res, err := fakeAPI.RenewLockAlpha1(t.Context(), &runtimev1pb.RenewLockRequest{
+			StoreName:       "store1",
+			ResourceId:      "r3",
+			LockOwner:       "owner1",
+			ExpiryInSeconds: 10,
+			AutoRenew:       true,
+			MaxHoldSeconds:  3600,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, runtimev1pb.RenewLockResponse_SUCCESS, res.GetStatus()) //nolint:nosnakecase
+
+		// The lease is renewed every 5 seconds, so it's held after 30 seconds.
+		for i := range 6 {
+			assert.Eventually(t, clock.HasWaiters, time.Second, time.Millisecond)
+			clock.Step(5 * time.Second)
+			assert.Eventually(t, func() bool { return store.RenewalCount() == renewals+i+2 }, time.Second, time.Millisecond)
+		}
+		assert.Equal(t, "owner1", store.Owner("lock||myapp||r3"))
+
+		unlockRes, err := fakeAPI.UnlockAlpha1(t.Context(), &runtimev1pb.UnlockRequest{
+			StoreName:  "store1",
+			ResourceId: "r3",
+			LockOwner:  "owner1",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, runtimev1pb.UnlockResponse_SUCCESS, unlockRes.GetStatus()) //nolint:nosnakecase
+
+		// Renewals have stopped.
+		renewals = store.RenewalCount()
+		clock.Step(5 * time.Second)
+		assert.Never(t, func() bool { return store.RenewalCount() != renewals }, 50*time.Millisecond, time.Millisecond)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		_, err := fakeAPI.RenewLockAlpha1(t.Context(), &runtimev1pb.RenewLockRequest{StoreName: "store1", ResourceId: "r1", LockOwner: "owner1"})
+		require.ErrorIs(t, err, messages.ErrExpiryInSecondsNotPositive)
+
+		_, err = fakeAPI.RenewLockAlpha1(t.Context(), &runtimev1pb.RenewLockRequest{StoreName: "store1", ResourceId: "r1", LockOwner: "owner1", ExpiryInSeconds: 10, AutoRenew: true})
+		require.ErrorIs(t, err, messages.ErrMaxHoldSecondsNotPositive)
+
+		_, err = fakeAPI.RenewLockAlpha1(t.Context(), &runtimev1pb.RenewLockRequest{StoreName: "store1", LockOwner: "owner1", ExpiryInSeconds: 10})
+		require.ErrorIs(t, err, messages.ErrResourceIDEmpty)
+
+		_, err = fakeAPI.RenewLockAlpha1(t.Context(), &runtimev1pb.RenewLockRequest{StoreName: "nostore", ResourceId: "r1", LockOwner: "owner1", ExpiryInSeconds: 10})
+		require.ErrorIs(t, err, messages.ErrLockStoreNotFound)
+
+	})
+
+	t.Run("auto renew without renewer", func(t *testing.T) {
+		noRenewerAPI := &Universal{
+			logger:     testLogger,
+			resiliency: resiliency.New(nil),
+			compStore:  compStore,
+		}
+		_, err := noRenewerAPI.RenewLockAlpha1(t.Context(), &runtimev1pb.RenewLockRequest{
+			StoreName:       "store1",
+			ResourceId:      "r1",
+			LockOwner:       "owner1",
+			ExpiryInSeconds: 10,
+			AutoRenew:       true,
+			MaxHoldSeconds:  60,
+		})
+		require.ErrorIs(t, err, messages.ErrLockAutoRenewNotAvailable)
+		assert.Contains(t, err.Error(), "auto-renewal")
+	})
+}
+
+func TestTryLockFenced(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Now())
+	stateStore := daprt.NewFakeStateStore()
+
+	compStore := compstore.New()
+	compStore.AddLock("fenced", &fencedLockStore{FakeLockStore: &daprt.FakeLockStore{Clock: clock}})
+	compStore.AddLock("sequenced", &daprt.FakeLockStore{Clock: clock})
+	compStore.AddLock("missingstate", &daprt.FakeLockStore{Clock: clock})
+	compStore.AddLock("plain", &daprt.FakeLockStore{Clock: clock})
+	compStore.AddStateStore("statestore", stateStore)
+	require.NoError(t, lockLoader.SaveLockConfiguration("sequenced", map[string]string{
+		"fencingTokenStateStore": "statestore",
+	}))
+	require.NoError(t, lockLoader.SaveLockConfiguration("missingstate", map[string]string{
+		"fencingTokenStateStore": "nope",
+	}))
+	fakeAPI := &Universal{
+		logger:     testLogger,
+		resiliency: resiliency.New(nil),
+		compStore:  compStore,
+		appID:      "myapp",
+	}
+
+	tryLockAs := func(t *testing.T, storeName, owner string) (bool, int64, error) {
+		t.Helper()
+		res, token, err := fakeAPI.TryLockFenced(t.Context(), &runtimev1pb.TryLockRequest{
+			StoreName:       storeName,
+			ResourceId:      "r1",
+			LockOwner:       owner,
+			ExpiryInSeconds: 10,
+		})
+		return res.GetSuccess(), token, err
+	}
+	tryLock := func(t *testing.T, storeName string) (bool, int64, error) {
+		t.Helper()
+		return tryLockAs(t, storeName, "owner1")
+	}
+	unlock := func(t *testing.T, storeName string) {
+		t.Helper()
+		_, err := fakeAPI.UnlockAlpha1(t.Context(), &runtimev1pb.UnlockRequest{
+			StoreName:  storeName,
+			ResourceId: "r1",
+			LockOwner:  "owner1",
+		})
+		require.NoError(t, err)
+	}
+
+	t.Run("token returned by the lock store", func(t *testing.T) {
+		for want := int64(1); want <= 2; want++ {
+			ok, token, err := tryLock(t, "fenced")
+			require.NoError(t, err)
+			assert.True(t, ok)
+			assert.Equal(t, want, token)
+			unlock(t, "fenced")
+		}
+	})
+
+	t.Run("token sequence in the state store", func(t *testing.T) {
+		for want := int64(1); want <= 2; want++ {
+			ok, token, err := tryLock(t, "sequenced")
+			require.NoError(t, err)
+			assert.True(t, ok)
+			assert.Equal(t, want, token)
+
+			// No token when the lock isn't acquired
+			ok, token, err = tryLockAs(t, "sequenced", "owner2")
+			require.NoError(t, err)
+			assert.False(t, ok)
+			assert.Zero(t, token)
+
+			unlock(t, "sequenced")
+		}
+
+		res, err := stateStore.Get(t.Context(), &state.GetRequest{Key: "lock||myapp||r1||fencingtoken"})
+		require.NoError(t, err)
+		assert.Equal(t, "2", string(res.Data))
+	})
+
+	t.Run("lock is released when the token can't be obtained", func(t *testing.T) {
+		store, _ := compStore.GetLock("missingstate")
+		_, _, err := tryLock(t, "missingstate")
+		require.ErrorIs(t, err, messages.ErrTryLockFailed)
+		assert.Empty(t, store.(*daprt.FakeLockStore).Owner("lock||myapp||r1"))
+	})
+
+	t.Run("no token without a fencing configuration", func(t *testing.T) {
+		ok, token, err := tryLock(t, "plain")
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Zero(t, token)
+	})
+}
@@ -0,0 +1,277 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/dapr/components-contrib/lock"
+	lockLoader "github.com/dapr/dapr/pkg/components/lock"
+	"github.com/dapr/dapr/pkg/messages"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+)
+
+// rwLockState is the state of a lock in the state store configured to emulate
+// shared locks for the lock stores which don't support them.
+type rwLockState struct {
+	// Readers are the owners of the shared lock, with the expiration of their
+	// leases.
+	Readers map[string]time.Time `json:"readers,omitempty"`
+	// Writer is the owner of the exclusive lock.
+	Writer          string    `json:"writer,omitempty"`
+	WriterExpiresAt time.Time `json:"writerExpiresAt"`
+	// WriterWaitingUntil is set with the writer-preferred fairness when an
+	// exclusive lock was refused because of the readers, so no new readers are
+	// admitted until then.
+	WriterWaitingUntil time.Time `json:"writerWaitingUntil"`
+}
+
+// prune removes the expired leases.
+func (s *rwLockState) prune(now time.Time) {
+	for owner, expiresAt := range s.Readers {
+		if !now.Before(expiresAt) {
+			delete(s.Readers, owner)
+		}
+	}
+	if s.Writer != "" && !now.Before(s.WriterExpiresAt) {
+		s.Writer, s.WriterExpiresAt = "", time.Time{}
+	}
+	if !now.Before(s.WriterWaitingUntil) {
+		s.WriterWaitingUntil = time.Time{}
+	}
+}
+
+// TryLockSharedAlpha1 acquires a shared lock, which can be held by multiple
+// owners at once but not together with an exclusive lock.
+func (a *Universal) TryLockSharedAlpha1(ctx context.Context, req *runtimev1pb.TryLockSharedRequest) (*runtimev1pb.TryLockSharedResponse, error) {
+	// 1. validate and find lock component
+	if req.ExpiryInSeconds <= 0 {
+		err := messages.ErrExpiryInSecondsNotPositive.WithFormat(req.StoreName)
+		a.traceLogger(ctx).Debug(err)
+		return nil, err
+	}
+	store, err := a.lockValidateRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	stateStoreName, fairness := lockLoader.GetReadWriteLockConfiguration(req.StoreName)
+	rwLocker, native := store.(lockLoader.RWLocker)
+	if !native && stateStoreName == "" {
+		err = messages.ErrLockSharedNotSupported.WithFormat(req.StoreName)
+		a.traceLogger(ctx).Debug(err)
+		return nil, err
+	}
+
+	// 2. convert request
+	compReq := &lock.TryLockRequest{
+		ResourceID:      req.ResourceId,
+		LockOwner:       req.LockOwner,
+		ExpiryInSeconds: req.ExpiryInSeconds,
+		Metadata:        map[string]string{lockLoader.FairnessMetadataKey: fairness},
+	}
+	// modify key
+	compReq.ResourceID, err = lockLoader.GetModifiedLockKey(compReq.ResourceID, req.StoreName, a.appID)
+	if err != nil {
+		err = messages.ErrTryLockFailed.WithFormat(err)
+		a.traceLogger(ctx).Debug(err)
+		return nil, err
+	}
+
+	// 3. delegate to the component, or to the state store
+	var success bool
+	if native {
+		policyRunner := resiliency.NewRunner[*lock.TryLockResponse](ctx,
+			a.resiliency.ComponentOutboundPolicy(req.StoreName, resiliency.Lock),
+		)
+		var resp *lock.TryLockResponse
+		resp, err = policyRunner(func(ctx context.Context) (*lock.TryLockResponse, error) {
+			return rwLocker.TryLockShared(ctx, compReq)
+		})
+		success = resp != nil && resp.Success
+	} else {
+		success, err = a.tryLockReader(ctx, stateStoreName, fairness, compReq)
+	}
+	if err != nil {
+		err = messages.ErrTryLockFailed.WithFormat(err)
+		a.traceLogger(ctx).Debug(err)
+		return nil, err
+	}
+
+	return &runtimev1pb.TryLockSharedResponse{Success: success}, nil
+}
+
+// UnlockSharedAlpha1 releases a shared lock.
+func (a *Universal) UnlockSharedAlpha1(ctx context.Context, req *runtimev1pb.UnlockSharedRequest) (*runtimev1pb.UnlockSharedResponse, error) {
+	// 1. validate and find lock component
+	store, err := a.lockValidateRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	stateStoreName, _ := lockLoader.GetReadWriteLockConfiguration(req.StoreName)
+	rwLocker, native := store.(lockLoader.RWLocker)
+	if !native && stateStoreName == "" {
+		err = messages.ErrLockSharedNotSupported.WithFormat(req.StoreName)
+		a.traceLogger(ctx).Debug(err)
+		return nil, err
+	}
+
+	// 2. convert request
+	compReq := &lock.UnlockRequest{
+		ResourceID: req.ResourceId,
+		LockOwner:  req.LockOwner,
+	}
+	// modify key
+	compReq.ResourceID, err = lockLoader.GetModifiedLockKey(compReq.ResourceID, req.StoreName, a.appID)
+	if err != nil {
+		err = messages.ErrUnlockFailed.WithFormat(err)
+		a.traceLogger(ctx).Debug(err)
+		return nil, err
+	}
+
+	// 3. delegate to the component, or to the state store
+	status := lock.Success
+	if native {
+		policyRunner := resiliency.NewRunner[*lock.UnlockResponse](ctx,
+			a.resiliency.ComponentOutboundPolicy(req.StoreName, resiliency.Lock),
+		)
+		var resp *lock.UnlockResponse
+		resp, err = policyRunner(func(ctx context.Context) (*lock.UnlockResponse, error) {
+			return rwLocker.UnlockShared(ctx, compReq)
+		})
+		if resp != nil {
+			status = resp.Status
+		}
+	} else {
+		status, err = a.unlockReader(ctx, stateStoreName, compReq)
+	}
+	if err != nil {
+		err = messages.ErrUnlockFailed.WithFormat(err)
+		a.traceLogger(ctx).Debug(err)
+		return nil, err
+	}
+
+	return &runtimev1pb.UnlockSharedResponse{
+		//nolint:nosnakecase
+		Status: runtimev1pb.UnlockSharedResponse_Status(status),
+	}, nil
+}
+
+// tryLockReader adds the owner to the readers of the lock in the state store,
+// unless the exclusive lock is held or, with the writer-preferred fairness, a
+// writer is waiting.
+func (a *Universal) tryLockReader(ctx context.Context, stateStoreName string, fairness string, req *lock.TryLockRequest) (bool, error) {
+	var success bool
+	err := a.updateRWLockState(ctx, stateStoreName, req.ResourceID, func(s *rwLockState, now time.Time) bool {
+		success = false
+		_, isReader := s.Readers[req.LockOwner]
+		if s.Writer != "" || (!isReader && fairness == lockLoader.FairnessWriterPreferred && !s.WriterWaitingUntil.IsZero()) {
+			return false
+		}
+		s.Readers[req.LockOwner] = now.Add(time.Duration(req.ExpiryInSeconds) * time.Second)
+		success = true
+		return true
+	})
+	return success, err
+}
+
+// unlockReader removes the owner from the readers of the lock in the state
+// store.
+func (a *Universal) unlockReader(ctx context.Context, stateStoreName string, req *lock.UnlockRequest) (lock.Status, error) {
+	var status lock.Status
+	err := a.updateRWLockState(ctx, stateStoreName, req.ResourceID, func(s *rwLockState, _ time.Time) bool {
+		if _, ok := s.Readers[req.LockOwner]; !ok {
+			status = lock.LockDoesNotExist
+			return false
+		}
+		delete(s.Readers, req.LockOwner)
+		status = lock.Success
+		return true
+	})
+	if err != nil {
+		return lock.InternalError, err
+	}
+	return status, nil
+}
+
+// tryLockWriter records the owner of the exclusive lock, which is held
+// already in the lock store, in the state store. It fails while there are
+// readers and, with the writer-preferred fairness, makes the writer wait for
+// them instead.
+func (a *Universal) tryLockWriter(ctx context.Context, stateStoreName string, fairness string, req *lock.TryLockRequest) (bool, error) {
+	var success bool
+	err := a.updateRWLockState(ctx, stateStoreName, req.ResourceID, func(s *rwLockState, now time.Time) bool {
+		expiresAt := now.Add(time.Duration(req.ExpiryInSeconds) * time.Second)
+		success = len(s.Readers) == 0
+		if !success {
+			if fairness != lockLoader.FairnessWriterPreferred {
+				return false
+			}
+			s.WriterWaitingUntil = expiresAt
+			return true
+		}
+		s.Writer, s.WriterExpiresAt = req.LockOwner, expiresAt
+		s.WriterWaitingUntil = time.Time{}
+		return true
+	})
+	return success, err
+}
+
+// renewWriter extends the lease of the exclusive lock in the state store.
+func (a *Universal) renewWriter(ctx context.Context, stateStoreName string, req *lockLoader.RenewLockRequest) error {
+	return a.updateRWLockState(ctx, stateStoreName, req.ResourceID, func(s *rwLockState, now time.Time) bool {
+		if s.Writer != req.LockOwner {
+			return false
+		}
+		s.WriterExpiresAt = now.Add(time.Duration(req.ExpiryInSeconds) * time.Second)
+		return true
+	})
+}
+
+// unlockWriter removes the owner of the exclusive lock from the state store.
+func (a *Universal) unlockWriter(ctx context.Context, stateStoreName string, req *lock.UnlockRequest) error {
+	return a.updateRWLockState(ctx, stateStoreName, req.ResourceID, func(s *rwLockState, _ time.Time) bool {
+		if s.Writer != req.LockOwner {
+			return false
+		}
+		s.Writer, s.WriterExpiresAt = "", time.Time{}
+		return true
+	})
+}
+
+// updateRWLockState updates the state of the lock in the state store. The
+// update function receives the state without the expired leases, and returns
+// whether it changed it.
+func (a *Universal) updateRWLockState(ctx context.Context, stateStoreName string, lockKey string, update func(s *rwLockState, now time.Time) bool) error {
+	return a.updateLockState(ctx, stateStoreName, lockLoader.ReadWriteLockKey(lockKey), func(data []byte) (any, error) {
+		s := &rwLockState{}
+		if len(data) > 0 {
+			if err := json.Unmarshal(data, s); err != nil {
+				return nil, fmt.Errorf("invalid read-write lock state: %w", err)
+			}
+		}
+		now := time.Now()
+		s.prune(now)
+		if s.Readers == nil {
+			s.Readers = make(map[string]time.Time)
+		}
+		if !update(s, now) {
+			return nil, nil
+		}
+		return s, nil
+	})
+}
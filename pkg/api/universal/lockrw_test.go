@@ -0,0 +1,204 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/lock"
+	"github.com/dapr/components-contrib/state"
+	lockLoader "github.com/dapr/dapr/pkg/components/lock"
+	"github.com/dapr/dapr/pkg/messages"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+// nativeRWLockStore is a lock store with native shared locks.
+type nativeRWLockStore struct {
+	*daprt.FakeLockStore
+	sharedReq *lock.TryLockRequest
+}
+
+func (s *nativeRWLockStore) TryLockShared(_ context.Context, req *lock.TryLockRequest) (*lock.TryLockResponse, error) {
+	s.sharedReq = req
+	return &lock.TryLockResponse{Success: true}, nil
+}
+
+func (s *nativeRWLockStore) UnlockShared(context.Context, *lock.UnlockRequest) (*lock.UnlockResponse, error) {
+	return &lock.UnlockResponse{Status: lock.LockBelongsToOthers}, nil
+}
+
+func TestSharedLocks(t *testing.T) {
+	stateStore := daprt.NewFakeStateStore()
+	native := &nativeRWLockStore{FakeLockStore: &daprt.FakeLockStore{}}
+
+	compStore := compstore.New()
+	compStore.AddLock("rwreaders", &daprt.FakeLockStore{})
+	compStore.AddLock("rwwriters", &daprt.FakeLockStore{})
+	compStore.AddLock("rwnative", native)
+	compStore.AddLock("rwnone", &daprt.FakeLockStore{})
+	compStore.AddStateStore("statestore", stateStore)
+	require.NoError(t, lockLoader.SaveLockConfiguration("rwreaders", map[string]string{
+		"readWriteLockStateStore": "statestore",
+	}))
+	require.NoError(t, lockLoader.SaveLockConfiguration("rwwriters", map[string]string{
+		"readWriteLockStateStore": "statestore",
+		"readWriteLockFairness":   lockLoader.FairnessWriterPreferred,
+	}))
+	require.NoError(t, lockLoader.SaveLockConfiguration("rwnative", map[string]string{
+		"readWriteLockFairness": lockLoader.FairnessWriterPreferred,
+	}))
+	fakeAPI := &Universal{
+		logger:     testLogger,
+		resiliency: resiliency.New(nil),
+		compStore:  compStore,
+		appID:      "myapp",
+	}
+
+	tryLockShared := func(t *testing.T, storeName, owner string) bool {
+		t.Helper()
+		res, err := fakeAPI.TryLockSharedAlpha1(t.Context(), &runtimev1pb.TryLockSharedRequest{
+			StoreName:       storeName,
+			ResourceId:      "r1",
+			LockOwner:       owner,
+			ExpiryInSeconds: 10,
+		})
+		require.NoError(t, err)
+		return res.GetSuccess()
+	}
+	unlockShared := func(t *testing.T, storeName, owner string) lock.Status {
+		t.Helper()
+		res, err := fakeAPI.UnlockSharedAlpha1(t.Context(), &runtimev1pb.UnlockSharedRequest{
+			StoreName:  storeName,
+			ResourceId: "r1",
+			LockOwner:  owner,
+		})
+		require.NoError(t, err)
+		return lock.Status(res.GetStatus())
+	}
+	tryLock := func(t *testing.T, storeName, owner string) bool {
+		t.Helper()
+		res, err := fakeAPI.TryLockAlpha1(t.Context(), &runtimev1pb.TryLockRequest{
+			StoreName:       storeName,
+			ResourceId:      "r1",
+			LockOwner:       owner,
+			ExpiryInSeconds: 10,
+		})
+		require.NoError(t, err)
+		return res.GetSuccess()
+	}
+	unlock := func(t *testing.T, storeName, owner string) {
+		t.Helper()
+		res, err := fakeAPI.UnlockAlpha1(t.Context(), &runtimev1pb.UnlockRequest{
+			StoreName:  storeName,
+			ResourceId: "r1",
+			LockOwner:  owner,
+		})
+		require.NoError(t, err)
+		//nolint:nosnakecase
+		require.Equal(t, runtimev1pb.UnlockResponse_SUCCESS, res.GetStatus())
+	}
+
+	t.Run("readers exclude writers", func(t *testing.T) {
+		assert.True(t, tryLockShared(t, "rwreaders", "reader1"))
+		assert.True(t, tryLockShared(t, "rwreaders", "reader2"))
+		assert.False(t, tryLock(t, "rwreaders", "writer1"))
+
+		// Readers are still admitted with the reader-preferred fairness
+		assert.True(t, tryLockShared(t, "rwreaders", "reader3"))
+
+		assert.Equal(t, lock.Success, unlockShared(t, "rwreaders", "reader1"))
+		assert.Equal(t, lock.Success, unlockShared(t, "rwreaders", "reader2"))
+		assert.Equal(t, lock.Success, unlockShared(t, "rwreaders", "reader3"))
+		assert.Equal(t, lock.LockDoesNotExist, unlockShared(t, "rwreaders", "reader3"))
+
+		assert.True(t, tryLock(t, "rwreaders", "writer1"))
+		unlock(t, "rwreaders", "writer1")
+	})
+
+	t.Run("writers exclude readers", func(t *testing.T) {
+		assert.True(t, tryLock(t, "rwreaders", "writer1"))
+		assert.False(t, tryLockShared(t, "rwreaders", "reader1"))
+		unlock(t, "rwreaders", "writer1")
+		assert.True(t, tryLockShared(t, "rwreaders", "reader1"))
+		assert.Equal(t, lock.Success, unlockShared(t, "rwreaders", "reader1"))
+	})
+
+	t.Run("writers wait with the writer-preferred fairness", func(t *testing.T) {
+		assert.True(t, tryLockShared(t, "rwwriters", "reader1"))
+		assert.False(t, tryLock(t, "rwwriters", "writer1"))
+
+		// New readers wait for the writer, but existing ones can renew
+		assert.False(t, tryLockShared(t, "rwwriters", "reader2"))
+		assert.True(t, tryLockShared(t, "rwwriters", "reader1"))
+
+		assert.Equal(t, lock.Success, unlockShared(t, "rwwriters", "reader1"))
+		assert.True(t, tryLock(t, "rwwriters", "writer1"))
+		unlock(t, "rwwriters", "writer1")
+		assert.True(t, tryLockShared(t, "rwwriters", "reader2"))
+		assert.Equal(t, lock.Success, unlockShared(t, "rwwriters", "reader2"))
+	})
+
+	t.Run("expired readers are ignored", func(t *testing.T) {
+		require.NoError(t, stateStore.Set(t.Context(), &state.SetRequest{
+			Key: "lock||myapp||r1||rwlock",
+			Value: rwLockState{
+				Readers: map[string]time.Time{"reader1": time.Now().Add(-time.Second)},
+			},
+		}))
+		assert.True(t, tryLock(t, "rwreaders", "writer1"))
+		unlock(t, "rwreaders", "writer1")
+	})
+
+	t.Run("native shared locks", func(t *testing.T) {
+		assert.True(t, tryLockShared(t, "rwnative", "reader1"))
+		require.NotNil(t, native.sharedReq)
+		assert.Equal(t, "lock||myapp||r1", native.sharedReq.ResourceID)
+		assert.Equal(t, lockLoader.FairnessWriterPreferred, native.sharedReq.Metadata[lockLoader.FairnessMetadataKey])
+		assert.Equal(t, lock.LockBelongsToOthers, unlockShared(t, "rwnative", "reader1"))
+	})
+
+	t.Run("lock store without shared locks", func(t *testing.T) {
+		_, err := fakeAPI.TryLockSharedAlpha1(t.Context(), &runtimev1pb.TryLockSharedRequest{
+			StoreName:       "rwnone",
+			ResourceId:      "r1",
+			LockOwner:       "reader1",
+			ExpiryInSeconds: 10,
+		})
+		require.ErrorIs(t, err, messages.ErrLockSharedNotSupported)
+
+		_, err = fakeAPI.UnlockSharedAlpha1(t.Context(), &runtimev1pb.UnlockSharedRequest{
+			StoreName:  "rwnone",
+			ResourceId: "r1",
+			LockOwner:  "reader1",
+		})
+		require.ErrorIs(t, err, messages.ErrLockSharedNotSupported)
+	})
+
+	t.Run("invalid expiry", func(t *testing.T) {
+		_, err := fakeAPI.TryLockSharedAlpha1(t.Context(), &runtimev1pb.TryLockSharedRequest{
+			StoreName:  "rwreaders",
+			ResourceId: "r1",
+			LockOwner:  "reader1",
+		})
+		require.ErrorIs(t, err, messages.ErrExpiryInSecondsNotPositive)
+	})
+}
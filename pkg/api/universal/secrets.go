@@ -15,26 +15,35 @@ package universal
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
 	"time"
 
 	"github.com/dapr/components-contrib/secretstores"
+	secretstoresLoader "github.com/dapr/dapr/pkg/components/secretstores"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/messages"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/secretaudit"
 )
 
 func (a *Universal) GetSecret(ctx context.Context, in *runtimev1pb.GetSecretRequest) (*runtimev1pb.GetSecretResponse, error) {
 	var response *runtimev1pb.GetSecretResponse
 
-	component, err := a.secretsValidateRequest(in.GetStoreName())
+	component, err := a.secretsValidateRequest(ctx, in.GetStoreName())
 	if err != nil {
+		a.auditSecret(ctx, secretaudit.OperationGet, in.GetStoreName(), in.GetKey(), false, "", err)
 		return response, err
 	}
 
-	if !a.isSecretAllowed(in.GetStoreName(), in.GetKey()) {
+	allowed, rule := a.secretAccessRule(in.GetStoreName(), in.GetKey())
+	if !allowed {
 		err = messages.ErrSecretPermissionDenied.WithFormat(in.GetKey(), in.GetStoreName())
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
+		a.auditSecret(ctx, secretaudit.OperationGet, in.GetStoreName(), in.GetKey(), false, rule, err)
 		return response, err
 	}
 
@@ -43,72 +52,132 @@ func (a *Universal) GetSecret(ctx context.Context, in *runtimev1pb.GetSecretRequ
 		Metadata: in.GetMetadata(),
 	}
 
-	start := time.Now()
-	policyRunner := resiliency.NewRunner[*secretstores.GetSecretResponse](ctx,
-		a.resiliency.ComponentOutboundPolicy(in.GetStoreName(), resiliency.Secretstore),
-	)
-	getResponse, err := policyRunner(func(ctx context.Context) (*secretstores.GetSecretResponse, error) {
-		rResp, rErr := component.GetSecret(ctx, req)
-		return &rResp, rErr
-	})
-	elapsed := diag.ElapsedSince(start)
+	// Secrets are read from the store only on cache misses, or in the
+	// background when a stale secret is refreshed.
+	getResponse, err := a.compStore.GetSecretCache(in.GetStoreName()).ReadThrough(ctx, req, func(ctx context.Context, req secretstores.GetSecretRequest) (secretstores.GetSecretResponse, error) {
+		start := time.Now()
+		policyRunner := resiliency.NewRunner[*secretstores.GetSecretResponse](ctx,
+			a.resiliency.ComponentOutboundPolicy(in.GetStoreName(), resiliency.Secretstore),
+		)
+		rResp, rErr := policyRunner(func(ctx context.Context) (*secretstores.GetSecretResponse, error) {
+			rResp, rErr := component.GetSecret(ctx, req)
+			return &rResp, rErr
+		})
+		elapsed := diag.ElapsedSince(start)
 
-	diag.DefaultComponentMonitoring.SecretInvoked(ctx, in.GetStoreName(), diag.Get, err == nil, elapsed)
+		diag.DefaultComponentMonitoring.SecretInvoked(ctx, in.GetStoreName(), diag.Get, rErr == nil, elapsed)
 
+		if rResp == nil {
+			return secretstores.GetSecretResponse{}, rErr
+		}
+		return *rResp, rErr
+	})
 	if err != nil {
 		err = messages.ErrSecretGet.WithFormat(req.Name, in.GetStoreName(), err.Error())
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
+		a.auditSecret(ctx, secretaudit.OperationGet, in.GetStoreName(), in.GetKey(), true, rule, err)
 		return response, err
 	}
+	a.auditSecret(ctx, secretaudit.OperationGet, in.GetStoreName(), in.GetKey(), true, rule, nil)
 
-	if getResponse != nil {
-		response = &runtimev1pb.GetSecretResponse{
-			Data: getResponse.Data,
-		}
+	response = &runtimev1pb.GetSecretResponse{
+		Data: getResponse.Data,
 	}
 	return response, nil
 }
 
+// FlushSecretCache removes the secrets from the cache of the secret store, or
+// all its secrets if none is given. It's a no-op if caching isn't enabled for
+// the store.
+func (a *Universal) FlushSecretCache(ctx context.Context, storeName string, names []string) error {
+	if _, err := a.secretsValidateRequest(ctx, storeName); err != nil {
+		return err
+	}
+
+	a.compStore.GetSecretCache(storeName).Flush(names...)
+	a.traceLogger(ctx).Debugf("Flushed secret cache of secret store %s", storeName)
+	return nil
+}
+
+// Metadata keys of GetBulkSecret requests which filter and paginate the
+// secrets. They aren't passed to the secret store.
+const (
+	BulkSecretNamePrefixKey        = "namePrefix"
+	BulkSecretNameRegexKey         = "nameRegex"
+	BulkSecretPageSizeKey          = "pageSize"
+	BulkSecretContinuationTokenKey = "continuationToken"
+)
+
 func (a *Universal) GetBulkSecret(ctx context.Context, in *runtimev1pb.GetBulkSecretRequest) (*runtimev1pb.GetBulkSecretResponse, error) {
+	response, _, err := a.GetBulkSecretPage(ctx, in)
+	return response, err
+}
+
+// GetBulkSecretPage returns the secrets of the store like GetBulkSecret, and
+// the continuation token of the next page when the request is paginated. The
+// token is empty on the last page.
+func (a *Universal) GetBulkSecretPage(ctx context.Context, in *runtimev1pb.GetBulkSecretRequest) (*runtimev1pb.GetBulkSecretResponse, string, error) {
 	var response *runtimev1pb.GetBulkSecretResponse
 
-	component, err := a.secretsValidateRequest(in.GetStoreName())
+	component, err := a.secretsValidateRequest(ctx, in.GetStoreName())
 	if err != nil {
-		return response, err
+		a.auditSecret(ctx, secretaudit.OperationBulkGet, in.GetStoreName(), "", false, "", err)
+		return response, "", err
 	}
 
-	req := secretstores.BulkGetSecretRequest{
-		Metadata: in.GetMetadata(),
+	listReq, err := bulkSecretListRequest(in.GetMetadata())
+	if err != nil {
+		a.traceLogger(ctx).Debug(err)
+		a.auditSecret(ctx, secretaudit.OperationBulkGet, in.GetStoreName(), "", false, "", err)
+		return response, "", err
 	}
 
 	start := time.Now()
-	policyRunner := resiliency.NewRunner[*secretstores.BulkGetSecretResponse](ctx,
+	policyRunner := resiliency.NewRunner[*secretstoresLoader.ListResponse](ctx,
 		a.resiliency.ComponentOutboundPolicy(in.GetStoreName(), resiliency.Secretstore),
 	)
-	getResponse, err := policyRunner(func(ctx context.Context) (*secretstores.BulkGetSecretResponse, error) {
-		rResp, rErr := component.BulkGetSecret(ctx, req)
-		return &rResp, rErr
+	getResponse, err := policyRunner(func(ctx context.Context) (*secretstoresLoader.ListResponse, error) {
+		if listReq == nil {
+			rResp, rErr := component.BulkGetSecret(ctx, secretstores.BulkGetSecretRequest{
+				Metadata: in.GetMetadata(),
+			})
+			return &secretstoresLoader.ListResponse{Data: rResp.Data}, rErr
+		}
+		return secretstoresLoader.List(ctx, component, listReq)
 	})
 	elapsed := diag.ElapsedSince(start)
 
 	diag.DefaultComponentMonitoring.SecretInvoked(ctx, in.GetStoreName(), diag.BulkGet, err == nil, elapsed)
 
+	if errors.Is(err, secretstoresLoader.ErrInvalidContinuationToken) {
+		err = messages.ErrBadRequest.WithFormat(err)
+		a.traceLogger(ctx).Debug(err)
+		a.auditSecret(ctx, secretaudit.OperationBulkGet, in.GetStoreName(), "", false, "", err)
+		return response, "", err
+	}
 	if err != nil {
 		err = messages.ErrBulkSecretGet.WithFormat(in.GetStoreName(), err.Error())
-		a.logger.Debug(err)
-		return response, err
+		a.traceLogger(ctx).Debug(err)
+		a.auditSecret(ctx, secretaudit.OperationBulkGet, in.GetStoreName(), "", true, "", err)
+		return response, "", err
 	}
 
+	if getResponse == nil || len(getResponse.Data) == 0 {
+		// Audit the call even if it returned no secrets.
+		a.auditSecret(ctx, secretaudit.OperationBulkGet, in.GetStoreName(), "", true, "", nil)
+	}
 	if getResponse == nil {
-		return response, nil
+		return response, "", nil
 	}
 	filteredSecrets := map[string]map[string]string{}
 	for key, v := range getResponse.Data {
-		if a.isSecretAllowed(in.GetStoreName(), key) {
+		allowed, rule := a.secretAccessRule(in.GetStoreName(), key)
+		if allowed {
 			filteredSecrets[key] = v
 		} else {
-			a.logger.Debugf(messages.ErrSecretPermissionDenied.WithFormat(key, in.GetStoreName()).String())
+			a.traceLogger(ctx).Debugf(messages.ErrSecretPermissionDenied.WithFormat(key, in.GetStoreName()).String())
 		}
+		a.auditSecret(ctx, secretaudit.OperationBulkGet, in.GetStoreName(), key, allowed, rule, nil)
 	}
 
 	if getResponse.Data != nil {
@@ -119,31 +188,92 @@ func (a *Universal) GetBulkSecret(ctx context.Context, in *runtimev1pb.GetBulkSe
 			response.Data[key] = &runtimev1pb.SecretResponse{Secrets: v}
 		}
 	}
-	return response, nil
+	return response, getResponse.ContinuationToken, nil
+}
+
+// bulkSecretListRequest returns the list request of a GetBulkSecret request
+// with filters or pagination, or nil if it has none.
+func bulkSecretListRequest(md map[string]string) (*secretstoresLoader.ListRequest, error) {
+	req := &secretstoresLoader.ListRequest{
+		Prefix:            md[BulkSecretNamePrefixKey],
+		ContinuationToken: md[BulkSecretContinuationTokenKey],
+	}
+	if v := md[BulkSecretNameRegexKey]; v != "" {
+		regex, err := regexp.Compile(v)
+		if err != nil {
+			return nil, messages.ErrBadRequest.WithFormat(fmt.Sprintf("invalid %s: %v", BulkSecretNameRegexKey, err))
+		}
+		req.Regex = regex
+	}
+	if v := md[BulkSecretPageSizeKey]; v != "" {
+		pageSize, err := strconv.Atoi(v)
+		if err != nil || pageSize <= 0 {
+			return nil, messages.ErrBadRequest.WithFormat(fmt.Sprintf("invalid %s: must be a positive integer", BulkSecretPageSizeKey))
+		}
+		req.PageSize = pageSize
+	}
+	if req.Prefix == "" && req.Regex == nil && req.PageSize == 0 && req.ContinuationToken == "" {
+		return nil, nil
+	}
+
+	req.Metadata = make(map[string]string, len(md))
+	for k, v := range md {
+		switch k {
+		case BulkSecretNamePrefixKey, BulkSecretNameRegexKey, BulkSecretPageSizeKey, BulkSecretContinuationTokenKey:
+		default:
+			req.Metadata[k] = v
+		}
+	}
+	return req, nil
 }
 
 // Internal method that checks if the request is for a valid secret store component.
-func (a *Universal) secretsValidateRequest(componentName string) (secretstores.SecretStore, error) {
+func (a *Universal) secretsValidateRequest(ctx context.Context, componentName string) (secretstores.SecretStore, error) {
 	if a.compStore.SecretStoresLen() == 0 {
 		err := messages.ErrSecretStoreNotConfigured
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return nil, err
 	}
 
 	component, ok := a.compStore.GetSecretStore(componentName)
 	if !ok {
 		err := messages.ErrSecretStoreNotFound.WithFormat(componentName)
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return nil, err
 	}
 
 	return component, nil
 }
 
-func (a *Universal) isSecretAllowed(storeName, key string) bool {
+// secretAccessRule returns whether the secret can be accessed, and the rule of
+// the secrets scope of the store that decided it.
+func (a *Universal) secretAccessRule(storeName, key string) (bool, string) {
 	if config, ok := a.compStore.GetSecretsConfiguration(storeName); ok {
-		return config.IsSecretAllowed(key)
+		return config.AccessRule(key)
 	}
 	// By default, if a configuration is not defined for a secret store, return true.
-	return true
+	return true, ""
+}
+
+// auditSecret emits the audit record of a secrets API call. The secret name is
+// empty for the calls of the bulk API which failed or returned no secrets.
+func (a *Universal) auditSecret(ctx context.Context, operation, storeName, secretName string, allowed bool, rule string, err error) {
+	if a.secretAuditor == nil {
+		return
+	}
+
+	record := secretaudit.Record{
+		Operation:  operation,
+		StoreName:  storeName,
+		SecretName: secretName,
+		Decision:   secretaudit.DecisionAllowed,
+		Rule:       rule,
+	}
+	if !allowed {
+		record.Decision = secretaudit.DecisionDenied
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	a.secretAuditor.Audit(ctx, record)
 }
@@ -14,6 +14,9 @@ limitations under the License.
 package universal
 
 import (
+	"context"
+	"encoding/json"
+	"sync"
 	"testing"
 	"time"
 
@@ -22,12 +25,14 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
 	"github.com/dapr/components-contrib/secretstores"
 	"github.com/dapr/dapr/pkg/config"
 	"github.com/dapr/dapr/pkg/messages"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/secretaudit"
 	daprt "github.com/dapr/dapr/pkg/testing"
 )
 
@@ -318,3 +323,131 @@ func TestSecretAPIWithResiliency(t *testing.T) {
 		assert.Less(t, end.Sub(start), time.Second*30)
 	})
 }
+
+func TestBulkSecretListRequest(t *testing.T) {
+	t.Run("no filters nor pagination", func(t *testing.T) {
+		req, err := bulkSecretListRequest(map[string]string{"key": "value"})
+		require.NoError(t, err)
+		assert.Nil(t, req)
+	})
+
+	t.Run("filters and pagination", func(t *testing.T) {
+		req, err := bulkSecretListRequest(map[string]string{
+			BulkSecretNamePrefixKey:        "db-",
+			BulkSecretNameRegexKey:         "^db-[a-z]+$",
+			BulkSecretPageSizeKey:          "50",
+			BulkSecretContinuationTokenKey: "token",
+			"key":                          "value",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "db-", req.Prefix)
+		assert.True(t, req.Regex.MatchString("db-user"))
+		assert.Equal(t, 50, req.PageSize)
+		assert.Equal(t, "token", req.ContinuationToken)
+		assert.Equal(t, map[string]string{"key": "value"}, req.Metadata)
+	})
+
+	for name, md := range map[string]map[string]string{
+		"invalid regex":     {BulkSecretNameRegexKey: "("},
+		"invalid page size": {BulkSecretPageSizeKey: "ten"},
+		"zero page size":    {BulkSecretPageSizeKey: "0"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := bulkSecretListRequest(md)
+			require.ErrorIs(t, err, messages.ErrBadRequest)
+		})
+	}
+}
+
+type fakeAuditAdapter struct {
+	lock     sync.Mutex
+	requests []*contribpubsub.PublishRequest
+}
+
+func (f *fakeAuditAdapter) Publish(_ context.Context, req *contribpubsub.PublishRequest) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.requests = append(f.requests, req)
+	return nil
+}
+
+func (f *fakeAuditAdapter) BulkPublish(context.Context, *contribpubsub.BulkPublishRequest) (contribpubsub.BulkPublishResponse, error) {
+	return contribpubsub.BulkPublishResponse{}, nil
+}
+
+func (f *fakeAuditAdapter) records(t require.TestingT) []secretaudit.Record {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	records := make([]secretaudit.Record, len(f.requests))
+	for i, req := range f.requests {
+		var ce struct {
+			Data secretaudit.Record `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(req.Data, &ce))
+		records[i] = ce.Data
+	}
+	return records
+}
+
+func TestSecretAudit(t *testing.T) {
+	compStore := compstore.New()
+	compStore.AddSecretStore("store1", daprt.FakeSecretStore{})
+	compStore.AddSecretsConfiguration("store1", config.SecretsScope{
+		DefaultAccess: config.AllowAccess,
+		DeniedSecrets: []string{"not-allowed"},
+	})
+
+	adapter := &fakeAuditAdapter{}
+	auditor, err := secretaudit.New(secretaudit.Options{
+		AppID:         "myapp",
+		Spec:          config.SecretsAuditSpec{Sink: secretaudit.SinkPubsub, PubsubName: "pubsub", Topic: "audit"},
+		PubSubAdapter: adapter,
+	})
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(t.Context())
+	errCh := make(chan error)
+	go func() { errCh <- auditor.Run(ctx) }()
+
+	fakeAPI := &Universal{
+		logger:        testLogger,
+		resiliency:    resiliency.New(nil),
+		compStore:     compStore,
+		secretAuditor: auditor,
+	}
+
+	_, err = fakeAPI.GetSecret(t.Context(), &runtimev1pb.GetSecretRequest{StoreName: "store1", Key: "good-key"})
+	require.NoError(t, err)
+	_, err = fakeAPI.GetSecret(t.Context(), &runtimev1pb.GetSecretRequest{StoreName: "store1", Key: "not-allowed"})
+	require.Error(t, err)
+	_, err = fakeAPI.GetBulkSecret(t.Context(), &runtimev1pb.GetBulkSecretRequest{StoreName: "store1"})
+	require.NoError(t, err)
+
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.Len(c, adapter.records(c), 3)
+	}, 5*time.Second, 10*time.Millisecond)
+	cancel()
+	require.NoError(t, <-errCh)
+
+	records := adapter.records(t)
+	require.Len(t, records, 3)
+	for _, r := range records {
+		assert.Equal(t, "myapp", r.AppID)
+		assert.Equal(t, "store1", r.StoreName)
+	}
+
+	assert.Equal(t, secretaudit.OperationGet, records[0].Operation)
+	assert.Equal(t, "good-key", records[0].SecretName)
+	assert.Equal(t, secretaudit.DecisionAllowed, records[0].Decision)
+	assert.Equal(t, config.SecretsRuleDefaultAccess, records[0].Rule)
+	assert.Empty(t, records[0].Error)
+
+	assert.Equal(t, secretaudit.OperationGet, records[1].Operation)
+	assert.Equal(t, "not-allowed", records[1].SecretName)
+	assert.Equal(t, secretaudit.DecisionDenied, records[1].Decision)
+	assert.Equal(t, config.SecretsRuleDeniedSecrets, records[1].Rule)
+	assert.NotEmpty(t, records[1].Error)
+
+	assert.Equal(t, secretaudit.OperationBulkGet, records[2].Operation)
+	assert.Equal(t, "good-key", records[2].SecretName)
+	assert.Equal(t, secretaudit.DecisionAllowed, records[2].Decision)
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/dapr/components-contrib/state"
 	"github.com/dapr/dapr/pkg/api/errors"
 	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	"github.com/dapr/dapr/pkg/compression"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/encryption"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
@@ -55,20 +56,25 @@ func (a *Universal) QueryStateAlpha1(ctx context.Context, in *runtimev1pb.QueryS
 	querier, ok := store.(state.Querier)
 	if !ok {
 		err = errors.StateStore(in.GetStoreName()).QueryUnsupported()
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return nil, err
 	}
 
 	if encryption.EncryptedStateStore(in.GetStoreName()) {
 		err = errors.StateStore(in.GetStoreName()).QueryFailed("cannot query encrypted store")
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
+		return nil, err
+	}
+	if compression.CompressedStateStore(in.GetStoreName()) {
+		err = errors.StateStore(in.GetStoreName()).QueryFailed("cannot query compressed store")
+		a.traceLogger(ctx).Debug(err)
 		return nil, err
 	}
 
 	var req state.QueryRequest
 	if err = json.Unmarshal([]byte(in.GetQuery()), &req.Query); err != nil {
 		err = errors.StateStore(in.GetStoreName()).QueryFailed("failed to parse JSON query body: " + err.Error())
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return nil, err
 	}
 
@@ -91,7 +97,7 @@ func (a *Universal) QueryStateAlpha1(ctx context.Context, in *runtimev1pb.QueryS
 		}
 
 		err = errors.StateStore(in.GetStoreName()).QueryFailed(err.Error())
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return nil, err
 	}
 
@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+
+	"github.com/dapr/components-contrib/state"
+	apierrors "github.com/dapr/dapr/pkg/api/errors"
+	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	"github.com/dapr/dapr/pkg/compression"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/encryption"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/kit/ptr"
+)
+
+// QueryStateAlpha2 runs a query with projections, sorting on multiple fields,
+// aggregates and cursor-based pagination.
+func (a *Universal) QueryStateAlpha2(ctx context.Context, in *runtimev1pb.QueryStateRequestAlpha2) (*runtimev1pb.QueryStateResponseAlpha2, error) {
+	storeName := in.GetStoreName()
+	store, err := a.GetStateStore(storeName)
+	if err != nil {
+		// Error has already been logged
+		return nil, err
+	}
+
+	if encryption.EncryptedStateStore(storeName) {
+		err = apierrors.StateStore(storeName).QueryFailed("cannot query encrypted store")
+		a.traceLogger(ctx).Debug(err)
+		return nil, err
+	}
+	if compression.CompressedStateStore(storeName) {
+		err = apierrors.StateStore(storeName).QueryFailed("cannot query compressed store")
+		a.traceLogger(ctx).Debug(err)
+		return nil, err
+	}
+
+	req := &stateLoader.QueryV2Request{
+		Metadata: in.GetMetadata(),
+	}
+	if err = json.Unmarshal([]byte(in.GetQuery()), &req.Query); err != nil {
+		err = apierrors.StateStore(storeName).QueryInvalid("failed to parse JSON query body: " + err.Error())
+		a.traceLogger(ctx).Debug(err)
+		return nil, err
+	}
+
+	start := time.Now()
+	policyRunner := resiliency.NewRunner[*stateLoader.QueryV2Response](ctx,
+		a.resiliency.ComponentOutboundPolicy(storeName, resiliency.Statestore),
+	)
+	res, err := policyRunner(func(ctx context.Context) (*stateLoader.QueryV2Response, error) {
+		rRes, rErr := stateLoader.Query(ctx, store, req)
+		if errors.Is(rErr, stateLoader.ErrQueryInvalid) || errors.Is(rErr, stateLoader.ErrQueryNotSupported) {
+			return rRes, backoff.Permanent(rErr)
+		}
+		return rRes, rErr
+	})
+	elapsed := diag.ElapsedSince(start)
+
+	diag.DefaultComponentMonitoring.StateInvoked(ctx, storeName, diag.StateQuery, err == nil, elapsed)
+
+	switch {
+	case errors.Is(err, stateLoader.ErrQueryInvalid):
+		err = apierrors.StateStore(storeName).QueryInvalid(err.Error())
+	case errors.Is(err, stateLoader.ErrQueryNotSupported):
+		if _, ok := store.(state.Querier); !ok {
+			err = apierrors.StateStore(storeName).QueryUnsupported()
+		} else {
+			err = apierrors.StateStore(storeName).QueryFailed(err.Error())
+		}
+	case err != nil:
+		err = apierrors.StateStore(storeName).QueryFailed(err.Error())
+	}
+	if err != nil {
+		a.traceLogger(ctx).Debug(err)
+		return nil, err
+	}
+
+	ret := &runtimev1pb.QueryStateResponseAlpha2{}
+	if res.Cursor != "" {
+		ret.Cursor = ptr.Of(res.Cursor)
+	}
+	if len(res.Aggregates) > 0 {
+		ret.Aggregates = make(map[string][]byte, len(res.Aggregates))
+		for name, val := range res.Aggregates {
+			ret.Aggregates[name], err = json.Marshal(val)
+			if err != nil {
+				err = apierrors.StateStore(storeName).QueryFailed(err.Error())
+				a.traceLogger(ctx).Debug(err)
+				return nil, err
+			}
+		}
+	}
+	if len(res.Results) > 0 {
+		ret.Results = make([]*runtimev1pb.QueryStateItem, len(res.Results))
+		for i, item := range res.Results {
+			ret.Results[i] = &runtimev1pb.QueryStateItem{
+				Key:   stateLoader.GetOriginalStateKey(item.Key),
+				Data:  item.Data,
+				Error: item.Error,
+			}
+			if item.ETag != nil {
+				ret.Results[i].Etag = *item.ETag
+			}
+		}
+	}
+
+	return ret, nil
+}
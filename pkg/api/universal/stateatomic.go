@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+
+	"github.com/dapr/components-contrib/state"
+	apierrors "github.com/dapr/dapr/pkg/api/errors"
+	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	"github.com/dapr/dapr/pkg/compression"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/encryption"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	kiterrors "github.com/dapr/kit/errors"
+)
+
+// IncrementStateAlpha1 atomically adds a delta to the integer value of a key,
+// and returns the new value.
+func (a *Universal) IncrementStateAlpha1(ctx context.Context, in *runtimev1pb.IncrementStateRequestAlpha1) (*runtimev1pb.IncrementStateResponseAlpha1, error) {
+	delta := int64(1)
+	if in.Delta != nil {
+		delta = in.GetDelta()
+	}
+
+	value, err := runStateAtomic(ctx, a, in.GetStoreName(), in.GetKey(), "increment", diag.StateIncrement, in.GetMetadata(), func(ctx context.Context, store state.Store, key string, metadata map[string]string) (int64, error) {
+		return stateLoader.Increment(ctx, store, key, delta, metadata)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &runtimev1pb.IncrementStateResponseAlpha1{Value: value}, nil
+}
+
+// AppendStateAlpha1 atomically appends values to the JSON array value of a
+// key, and returns the new length of the array.
+func (a *Universal) AppendStateAlpha1(ctx context.Context, in *runtimev1pb.AppendStateRequestAlpha1) (*runtimev1pb.AppendStateResponseAlpha1, error) {
+	const operation = "append"
+
+	if len(in.GetValues()) == 0 {
+		err := apierrors.StateStore(in.GetStoreName()).AtomicInvalid(operation, in.GetKey(), "values are required")
+		a.traceLogger(ctx).Debug(err)
+		return nil, err
+	}
+	values := make([]json.RawMessage, len(in.GetValues()))
+	for i, v := range in.GetValues() {
+		if !json.Valid(v) {
+			err := apierrors.StateStore(in.GetStoreName()).AtomicInvalid(operation, in.GetKey(), "values must be encoded as JSON")
+			a.traceLogger(ctx).Debug(err)
+			return nil, err
+		}
+		values[i] = v
+	}
+
+	length, err := runStateAtomic(ctx, a, in.GetStoreName(), in.GetKey(), operation, diag.StateAppend, in.GetMetadata(), func(ctx context.Context, store state.Store, key string, metadata map[string]string) (int, error) {
+		return stateLoader.Append(ctx, store, key, values, metadata)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &runtimev1pb.AppendStateResponseAlpha1{Length: int64(length)}, nil
+}
+
+// runStateAtomic runs an atomic operation on a key with resiliency.
+func runStateAtomic[T any](ctx context.Context, a *Universal, storeName string, key string, operation string, diagOperation string, metadata map[string]string, fn func(context.Context, state.Store, string, map[string]string) (T, error)) (T, error) {
+	var zero T
+
+	store, err := a.GetStateStore(storeName)
+	if err != nil {
+		// Error has already been logged
+		return zero, err
+	}
+
+	k, err := stateLoader.GetModifiedStateKeyWithMetadata(key, storeName, a.appID, metadata)
+	if err != nil {
+		err = apierrors.StateStore(storeName).InvalidKeyName(key, err.Error())
+		a.traceLogger(ctx).Debug(err)
+		return zero, err
+	}
+
+	if encryption.EncryptedStateStore(storeName) || compression.CompressedStateStore(storeName) {
+		err = apierrors.StateStore(storeName).AtomicNotSupported(operation)
+		a.traceLogger(ctx).Debug(err)
+		return zero, err
+	}
+
+	start := time.Now()
+	policyRunner := resiliency.NewRunner[T](ctx,
+		a.resiliency.ComponentOutboundPolicy(storeName, resiliency.Statestore),
+	)
+	res, err := policyRunner(func(ctx context.Context) (T, error) {
+		rRes, rErr := fn(ctx, store, k, metadata)
+		if errors.Is(rErr, stateLoader.ErrAtomicNotSupported) || errors.Is(rErr, stateLoader.ErrAtomicInvalidValue) {
+			return rRes, backoff.Permanent(rErr)
+		}
+		return rRes, rErr
+	})
+	elapsed := diag.ElapsedSince(start)
+
+	a.compStore.GetStateCache(storeName).Invalidate(ctx, k)
+
+	diag.DefaultComponentMonitoring.StateInvoked(context.Background(), storeName, diagOperation, err == nil, elapsed)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, stateLoader.ErrAtomicNotSupported):
+			err = apierrors.StateStore(storeName).AtomicNotSupported(operation)
+		case errors.Is(err, stateLoader.ErrAtomicInvalidValue):
+			err = apierrors.StateStore(storeName).AtomicInvalid(operation, key, err.Error())
+		default:
+			if kerr, ok := kiterrors.FromError(err); ok {
+				err = kerr
+			} else {
+				err = apierrors.StateStore(storeName).AtomicFailed(operation, key, err.Error())
+			}
+		}
+		a.traceLogger(ctx).Debug(err)
+		return zero, err
+	}
+
+	return res, nil
+}
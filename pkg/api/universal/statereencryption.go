@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apierrors "github.com/dapr/dapr/pkg/api/errors"
+	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/runtime/reencryption"
+	"github.com/dapr/kit/ptr"
+)
+
+// ReencryptStateAlpha1 starts re-encrypting the values of an encrypted state
+// store with its primary key in the background, and returns the status of the
+// job.
+func (a *Universal) ReencryptStateAlpha1(ctx context.Context, in *runtimev1pb.ReencryptStateRequestAlpha1) (*runtimev1pb.ReencryptStateStatusAlpha1, error) {
+	storeName := in.GetStoreName()
+	if _, err := a.GetStateStore(storeName); err != nil {
+		// Error has already been logged
+		return nil, err
+	}
+
+	keys := make([]string, len(in.GetKeys()))
+	for i, key := range in.GetKeys() {
+		k, err := stateLoader.GetModifiedStateKey(key, storeName, a.appID)
+		if err != nil {
+			err = apierrors.StateStore(storeName).InvalidKeyName(key, err.Error())
+			a.traceLogger(ctx).Debug(err)
+			return nil, err
+		}
+		keys[i] = k
+	}
+
+	status, err := a.stateReencryption.Start(storeName, keys)
+	switch {
+	case errors.Is(err, reencryption.ErrRunning):
+		err = apierrors.StateStore(storeName).ReencryptionRunning()
+	case err != nil:
+		err = apierrors.StateStore(storeName).ReencryptionInvalid(err.Error())
+	}
+	if err != nil {
+		a.traceLogger(ctx).Debug(err)
+		return nil, err
+	}
+
+	return reencryptionStatusToProto(status), nil
+}
+
+// GetReencryptStateStatusAlpha1 returns the status of the last re-encryption
+// job of a state store.
+func (a *Universal) GetReencryptStateStatusAlpha1(ctx context.Context, in *runtimev1pb.GetReencryptStateStatusRequestAlpha1) (*runtimev1pb.ReencryptStateStatusAlpha1, error) {
+	storeName := in.GetStoreName()
+	if _, err := a.GetStateStore(storeName); err != nil {
+		// Error has already been logged
+		return nil, err
+	}
+
+	status, ok := a.stateReencryption.Status(storeName)
+	if !ok {
+		err := apierrors.StateStore(storeName).ReencryptionNotFound()
+		a.traceLogger(ctx).Debug(err)
+		return nil, err
+	}
+
+	return reencryptionStatusToProto(status), nil
+}
+
+func reencryptionStatusToProto(status reencryption.Status) *runtimev1pb.ReencryptStateStatusAlpha1 {
+	res := &runtimev1pb.ReencryptStateStatusAlpha1{
+		StoreName:   status.StoreName,
+		State:       string(status.State),
+		StartedAt:   timestamppb.New(status.StartedAt),
+		Scanned:     status.Scanned,
+		Reencrypted: status.Reencrypted,
+		Failed:      status.Failed,
+	}
+	if status.CompletedAt != nil {
+		res.CompletedAt = timestamppb.New(*status.CompletedAt)
+	}
+	if status.Error != "" {
+		res.Error = ptr.Of(status.Error)
+	}
+	return res
+}
@@ -25,9 +25,14 @@ import (
 	"github.com/dapr/dapr/pkg/actors/state"
 	"github.com/dapr/dapr/pkg/actors/timers"
 	"github.com/dapr/dapr/pkg/config"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/drain"
+	"github.com/dapr/dapr/pkg/runtime/lockrenewer"
+	"github.com/dapr/dapr/pkg/runtime/reencryption"
 	schedclient "github.com/dapr/dapr/pkg/runtime/scheduler/client"
+	"github.com/dapr/dapr/pkg/runtime/secretaudit"
 	"github.com/dapr/dapr/pkg/runtime/wfengine"
 	"github.com/dapr/kit/logger"
 )
@@ -46,6 +51,10 @@ type Options struct {
 	Scheduler                   schedclient.Interface
 	Actors                      actors.Interface
 	WorkflowEngine              wfengine.Interface
+	Drainer                     *drain.Drainer
+	SecretAuditor               *secretaudit.Auditor
+	StateReencryption           *reencryption.Manager
+	LockRenewer                 *lockrenewer.Renewer
 }
 
 // Universal contains the implementation of gRPC APIs that are also used by the HTTP server.
@@ -62,6 +71,10 @@ type Universal struct {
 	globalConfig                *config.Configuration
 	workflowEngine              wfengine.Interface
 	scheduler                   schedclient.Interface
+	drainer                     *drain.Drainer
+	secretAuditor               *secretaudit.Auditor
+	stateReencryption           *reencryption.Manager
+	lockRenewer                 *lockrenewer.Renewer
 
 	extendedMetadataLock sync.RWMutex
 	actors               actors.Interface
@@ -82,9 +95,19 @@ func New(opts Options) *Universal {
 		scheduler:                   opts.Scheduler,
 		actors:                      opts.Actors,
 		workflowEngine:              opts.WorkflowEngine,
+		drainer:                     opts.Drainer,
+		secretAuditor:               opts.SecretAuditor,
+		stateReencryption:           opts.StateReencryption,
+		lockRenewer:                 opts.LockRenewer,
 	}
 }
 
+// traceLogger returns the logger to use while handling a request, which
+// includes the trace context of the request.
+func (a *Universal) traceLogger(ctx context.Context) logger.Logger {
+	return diag.LoggerWithTraceContext(ctx, a.logger)
+}
+
 func (a *Universal) AppID() string {
 	return a.appID
 }
@@ -105,6 +128,10 @@ func (a *Universal) AppConnectionConfig() config.AppConnectionConfig {
 	return a.appConnectionConfig
 }
 
+func (a *Universal) Drainer() *drain.Drainer {
+	return a.drainer
+}
+
 func (a *Universal) ActorRouter(ctx context.Context) (router.Interface, error) {
 	if err := a.actors.WaitForRegisteredHosts(ctx); err != nil {
 		return nil, err
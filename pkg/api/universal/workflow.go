@@ -27,6 +27,7 @@ import (
 	"github.com/dapr/dapr/pkg/messages"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/state/list"
 	"github.com/dapr/durabletask-go/api"
 	"github.com/dapr/kit/ptr"
 )
@@ -37,7 +38,7 @@ func (a *Universal) GetWorkflow(ctx context.Context, in *runtimev1pb.GetWorkflow
 		return nil, err
 	}
 	if err := a.validateInstanceID(in.GetInstanceId(), false /* isCreate */); err != nil {
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return &runtimev1pb.GetWorkflowResponse{}, err
 	}
 
@@ -51,7 +52,7 @@ func (a *Universal) GetWorkflow(ctx context.Context, in *runtimev1pb.GetWorkflow
 		} else {
 			err = messages.ErrWorkflowGetResponse.WithFormat(in.GetInstanceId(), err)
 		}
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return &runtimev1pb.GetWorkflowResponse{
 			InstanceId: in.GetInstanceId(),
 		}, err
@@ -82,13 +83,13 @@ func (a *Universal) StartWorkflow(ctx context.Context, in *runtimev1pb.StartWork
 		in.InstanceId = randomID.String()
 	}
 	if err := a.validateInstanceID(in.GetInstanceId(), true /* isCreate */); err != nil {
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return &runtimev1pb.StartWorkflowResponse{}, err
 	}
 
 	if in.GetWorkflowName() == "" {
 		err := messages.ErrWorkflowNameMissing
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return &runtimev1pb.StartWorkflowResponse{}, err
 	}
 
@@ -109,7 +110,7 @@ func (a *Universal) StartWorkflow(ctx context.Context, in *runtimev1pb.StartWork
 	})
 	if err != nil {
 		err := messages.ErrStartWorkflow.WithFormat(in.GetWorkflowName(), err)
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return &runtimev1pb.StartWorkflowResponse{}, err
 	}
 
@@ -125,7 +126,7 @@ func (a *Universal) TerminateWorkflow(ctx context.Context, in *runtimev1pb.Termi
 	}
 	emptyResponse := &emptypb.Empty{}
 	if err := a.validateInstanceID(in.GetInstanceId(), false /* isCreate */); err != nil {
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return emptyResponse, err
 	}
 
@@ -139,7 +140,7 @@ func (a *Universal) TerminateWorkflow(ctx context.Context, in *runtimev1pb.Termi
 		} else {
 			err = messages.ErrTerminateWorkflow.WithFormat(in.GetInstanceId(), err)
 		}
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return emptyResponse, err
 	}
 
@@ -153,13 +154,13 @@ func (a *Universal) RaiseEventWorkflow(ctx context.Context, in *runtimev1pb.Rais
 	}
 	emptyResponse := &emptypb.Empty{}
 	if err := a.validateInstanceID(in.GetInstanceId(), false /* isCreate */); err != nil {
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return emptyResponse, err
 	}
 
 	if in.GetEventName() == "" {
 		err := messages.ErrMissingWorkflowEventName
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return emptyResponse, err
 	}
 
@@ -174,7 +175,7 @@ func (a *Universal) RaiseEventWorkflow(ctx context.Context, in *runtimev1pb.Rais
 
 	if err := a.workflowEngine.Client().RaiseEvent(ctx, &req); err != nil {
 		err = messages.ErrRaiseEventWorkflow.WithFormat(in.GetInstanceId(), err)
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return emptyResponse, err
 	}
 	return emptyResponse, nil
@@ -187,7 +188,7 @@ func (a *Universal) PauseWorkflow(ctx context.Context, in *runtimev1pb.PauseWork
 	}
 	emptyResponse := &emptypb.Empty{}
 	if err := a.validateInstanceID(in.GetInstanceId(), false /* isCreate */); err != nil {
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return emptyResponse, err
 	}
 
@@ -196,7 +197,7 @@ func (a *Universal) PauseWorkflow(ctx context.Context, in *runtimev1pb.PauseWork
 	}
 	if err := a.workflowEngine.Client().Pause(ctx, req); err != nil {
 		err = messages.ErrPauseWorkflow.WithFormat(in.GetInstanceId(), err)
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return emptyResponse, err
 	}
 
@@ -210,7 +211,7 @@ func (a *Universal) ResumeWorkflow(ctx context.Context, in *runtimev1pb.ResumeWo
 	}
 	emptyResponse := &emptypb.Empty{}
 	if err := a.validateInstanceID(in.GetInstanceId(), false /* isCreate */); err != nil {
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return emptyResponse, err
 	}
 
@@ -219,7 +220,7 @@ func (a *Universal) ResumeWorkflow(ctx context.Context, in *runtimev1pb.ResumeWo
 	}
 	if err := a.workflowEngine.Client().Resume(ctx, req); err != nil {
 		err = messages.ErrResumeWorkflow.WithFormat(in.GetInstanceId(), err)
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return emptyResponse, err
 	}
 
@@ -233,7 +234,7 @@ func (a *Universal) PurgeWorkflow(ctx context.Context, in *runtimev1pb.PurgeWork
 	}
 	emptyResponse := &emptypb.Empty{}
 	if err := a.validateInstanceID(in.GetInstanceId(), false /* isCreate */); err != nil {
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return emptyResponse, err
 	}
 
@@ -248,13 +249,128 @@ func (a *Universal) PurgeWorkflow(ctx context.Context, in *runtimev1pb.PurgeWork
 		} else {
 			err = messages.ErrPurgeWorkflow.WithFormat(in.GetInstanceId(), err)
 		}
-		a.logger.Debug(err)
+		a.traceLogger(ctx).Debug(err)
 		return emptyResponse, err
 	}
 
 	return emptyResponse, nil
 }
 
+// ListWorkflows is the API handler for listing the workflow instances matching
+// a filter.
+func (a *Universal) ListWorkflows(ctx context.Context, req *list.ListWorkflowsRequest) (*list.ListWorkflowsResponse, error) {
+	if _, err := a.ActorRouter(ctx); err != nil {
+		return nil, err
+	}
+
+	res, err := a.workflowEngine.ListWorkflows(ctx, req)
+	if err != nil {
+		err = messages.ErrListWorkflows.WithFormat(err)
+		a.traceLogger(ctx).Debug(err)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// RaiseEventsWorkflow is the API handler for raising a batch of events to a
+// workflow instance, which are delivered at once.
+func (a *Universal) RaiseEventsWorkflow(ctx context.Context, instanceID string, events []*workflows.RaiseEventRequest) error {
+	if _, err := a.ActorRouter(ctx); err != nil {
+		return err
+	}
+
+	if err := a.validateInstanceID(instanceID, false /* isCreate */); err != nil {
+		a.traceLogger(ctx).Debug(err)
+		return err
+	}
+
+	for _, e := range events {
+		if e.EventName == "" {
+			err := messages.ErrMissingWorkflowEventName
+			a.traceLogger(ctx).Debug(err)
+			return err
+		}
+		e.InstanceID = instanceID
+	}
+
+	if err := a.workflowEngine.RaiseEvents(ctx, instanceID, events); err != nil {
+		err = messages.ErrRaiseEventWorkflow.WithFormat(instanceID, err)
+		a.traceLogger(ctx).Debug(err)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteWorkflowSchedule is the API handler for deleting a workflow schedule.
+func (a *Universal) DeleteWorkflowSchedule(ctx context.Context, scheduleID string) error {
+	if _, err := a.ActorRouter(ctx); err != nil {
+		return err
+	}
+
+	if err := a.validateInstanceID(scheduleID, false /* isCreate */); err != nil {
+		a.traceLogger(ctx).Debug(err)
+		return err
+	}
+
+	if err := a.workflowEngine.DeleteWorkflowSchedule(ctx, scheduleID); err != nil {
+		err = messages.ErrDeleteWorkflowSchedule.WithFormat(scheduleID, err)
+		a.traceLogger(ctx).Debug(err)
+		return err
+	}
+
+	return nil
+}
+
+// RewindWorkflow is the API handler for rewinding a failed workflow to its
+// last successful activity.
+func (a *Universal) RewindWorkflow(ctx context.Context, instanceID string, reason string) error {
+	if _, err := a.ActorRouter(ctx); err != nil {
+		return err
+	}
+
+	if err := a.validateInstanceID(instanceID, false /* isCreate */); err != nil {
+		a.traceLogger(ctx).Debug(err)
+		return err
+	}
+
+	if err := a.workflowEngine.RewindWorkflow(ctx, instanceID, reason); err != nil {
+		err = messages.ErrRewindWorkflow.WithFormat(instanceID, err)
+		a.traceLogger(ctx).Debug(err)
+		return err
+	}
+
+	return nil
+}
+
+// PauseWorkflowAtCheckpoint is the API handler for pausing a workflow at a
+// named checkpoint.
+func (a *Universal) PauseWorkflowAtCheckpoint(ctx context.Context, instanceID string, checkpoint string) error {
+	if _, err := a.ActorRouter(ctx); err != nil {
+		return err
+	}
+
+	if err := a.validateInstanceID(instanceID, false /* isCreate */); err != nil {
+		a.traceLogger(ctx).Debug(err)
+		return err
+	}
+
+	if checkpoint == "" {
+		err := messages.ErrMissingWorkflowCheckpoint
+		a.traceLogger(ctx).Debug(err)
+		return err
+	}
+
+	if err := a.workflowEngine.PauseWorkflowAtCheckpoint(ctx, instanceID, checkpoint); err != nil {
+		err = messages.ErrPauseWorkflowAtCheckpoint.WithFormat(instanceID, err)
+		a.traceLogger(ctx).Debug(err)
+		return err
+	}
+
+	return nil
+}
+
 // GetWorkflowBeta1 is the API handler for getting workflow details
 func (a *Universal) GetWorkflowBeta1(ctx context.Context, in *runtimev1pb.GetWorkflowRequest) (*runtimev1pb.GetWorkflowResponse, error) {
 	return a.GetWorkflow(ctx, in)
@@ -0,0 +1,141 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	bindingroute "github.com/dapr/dapr/pkg/apis/bindingRoute"
+	"github.com/dapr/dapr/pkg/apis/common"
+)
+
+const (
+	Kind    = "BindingRoute"
+	Version = "v1alpha1"
+)
+
+//+genclient
+//+genclient:noStatus
+//+kubebuilder:object:root=true
+
+// BindingRoute describes a Dapr BindingRoute type, which forwards the events
+// of an input binding to a pubsub topic or an output binding without going
+// through the app. Binding routes are only loaded from the resources paths in
+// self-hosted mode, and aren't served by the operator.
+//
+//nolint:recvcheck
+type BindingRoute struct {
+	metav1.TypeMeta `json:",inline"`
+	//+optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	//+optional
+	Spec          BindingRouteSpec `json:"spec,omitempty"`
+	common.Scoped `json:",inline"`
+}
+
+// Kind returns the binding route kind.
+func (BindingRoute) Kind() string {
+	return Kind
+}
+
+func (BindingRoute) APIVersion() string {
+	return bindingroute.GroupName + "/" + Version
+}
+
+// GetName returns the binding route name.
+func (r BindingRoute) GetName() string {
+	return r.Name
+}
+
+// GetNamespace returns the binding route namespace.
+func (r BindingRoute) GetNamespace() string {
+	return r.Namespace
+}
+
+// GetSecretStore returns the name of the secret store. Binding routes don't
+// reference secrets.
+func (r BindingRoute) GetSecretStore() string {
+	return ""
+}
+
+// LogName returns the name of the binding route that can be used in logging.
+func (r BindingRoute) LogName() string {
+	return r.Name + " (" + r.Spec.Source + ")"
+}
+
+// NameValuePairs returns nil, as binding routes have no metadata.
+func (r BindingRoute) NameValuePairs() []common.NameValuePair {
+	return nil
+}
+
+func (r BindingRoute) ClientObject() client.Object {
+	return &r
+}
+
+func (r BindingRoute) GetScopes() []string {
+	return r.Scopes
+}
+
+// EmptyMetaDeepCopy returns a new instance of the binding route type with the
+// TypeMeta's Kind and APIVersion fields set.
+func (r BindingRoute) EmptyMetaDeepCopy() metav1.Object {
+	n := r.DeepCopy()
+	n.TypeMeta = metav1.TypeMeta{
+		Kind:       Kind,
+		APIVersion: bindingroute.GroupName + "/" + Version,
+	}
+	n.ObjectMeta = metav1.ObjectMeta{Name: r.Name}
+	return n
+}
+
+// BindingRouteSpec describes where the events of an input binding are
+// forwarded to.
+type BindingRouteSpec struct {
+	// Source is the name of the input binding whose events are routed.
+	Source string `json:"source" validate:"required"`
+	// Transform is a CEL expression the event is transformed with before it's
+	// forwarded. The expression has access to the event data as `data`, decoded
+	// from JSON when possible, and to the event metadata as `metadata`.
+	//+optional
+	Transform string `json:"transform,omitempty"`
+	// Destination is the pubsub topic or the output binding the events are
+	// forwarded to.
+	Destination BindingRouteDestination `json:"destination"`
+}
+
+// BindingRouteDestination is the pubsub topic or the output binding the events
+// of a binding route are forwarded to. Exactly one of them must be set.
+type BindingRouteDestination struct {
+	//+optional
+	PubsubName string `json:"pubsubName,omitempty"`
+	//+optional
+	Topic string `json:"topic,omitempty"`
+	//+optional
+	Binding string `json:"binding,omitempty"`
+	// Operation is the operation invoked on the output binding. Defaults to
+	// "create".
+	//+optional
+	Operation string `json:"operation,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// BindingRouteList is a list of Dapr BindingRoutes.
+type BindingRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []BindingRoute `json:"items"`
+}
@@ -41,6 +41,8 @@ type ConfigurationSpec struct {
 	// +optional
 	HTTPPipelineSpec *PipelineSpec `json:"httpPipeline,omitempty"`
 	// +optional
+	GRPCPipelineSpec *PipelineSpec `json:"grpcPipeline,omitempty"`
+	// +optional
 	TracingSpec *TracingSpec `json:"tracing,omitempty"`
 	// +kubebuilder:default={enabled:true}
 	MetricSpec *MetricSpec `json:"metric,omitempty"`
@@ -86,6 +88,47 @@ type WorkflowSpec struct {
 	// instances will not be automatically purged.
 	// +optional
 	StateRetentionPolicy *WorkflowStateRetentionPolicy `json:"stateRetentionPolicy,omitempty"`
+
+	// Versions are the latest versions of the workflows, by workflow name. New
+	// instances of a workflow start on its latest version, unless started on
+	// another version, while running instances keep replaying the version
+	// they started on.
+	// +optional
+	Versions map[string]string `json:"versions,omitempty"`
+
+	// EventBufferTTL is how long external events raised to a workflow instance
+	// which hasn't been started yet are retained, to be delivered once the
+	// instance starts. If not set, raising an event to such an instance fails.
+	// +optional
+	EventBufferTTL *time.Duration `json:"eventBufferTTL,omitempty"`
+
+	// MaxConcurrentChildWorkflows is the maximum number of child workflow
+	// executions that can run concurrently in a single Dapr instance. Child
+	// workflow executions beyond this are queued until others complete. If
+	// omitted, no maximum is enforced.
+	// +optional
+	MaxConcurrentChildWorkflows int32 `json:"maxConcurrentChildWorkflows,omitempty"`
+
+	// Limits are the limits on the concurrently running activities and child
+	// workflows of the workflows, by workflow name.
+	// +optional
+	Limits map[string]WorkflowLimits `json:"limits,omitempty"`
+}
+
+// WorkflowLimits are the limits on the concurrently running activities and
+// child workflows of the instances of a workflow, in a single Dapr instance.
+// Executions beyond a limit are queued until others complete. A zero limit
+// means no limit.
+type WorkflowLimits struct {
+	// MaxConcurrentActivities is the maximum number of activities of the
+	// workflow that can run concurrently.
+	// +optional
+	MaxConcurrentActivities int32 `json:"maxConcurrentActivities,omitempty"`
+
+	// MaxConcurrentChildWorkflows is the maximum number of child workflows of
+	// the workflow whose executions can run concurrently.
+	// +optional
+	MaxConcurrentChildWorkflows int32 `json:"maxConcurrentChildWorkflows,omitempty"`
 }
 
 // WorkflowStateRetentionPolicy defines the retention policy of workflow state
@@ -114,6 +157,19 @@ type WorkflowStateRetentionPolicy struct {
 	// Terminated terminal state.
 	// +optional
 	Terminated *time.Duration `json:"terminated,omitempty"`
+
+	// MaxHistoryLength is the maximum number of history events of a workflow
+	// instance. Running workflow instances whose history grows past it are
+	// terminated.
+	// +optional
+	MaxHistoryLength *int64 `json:"maxHistoryLength,omitempty"`
+
+	// ReapInterval is how often the workflow instances are scanned to purge
+	// those past their retention TTL, including those which reached a terminal
+	// state before the policy was set, and to terminate those past the maximum
+	// history length. Defaults to 1h.
+	// +optional
+	ReapInterval *time.Duration `json:"reapInterval,omitempty"`
 }
 
 // APISpec describes the configuration for Dapr APIs.
@@ -124,6 +180,23 @@ type APISpec struct {
 	// List of denied APIs. Can be used in conjunction with allowed.
 	// +optional
 	Denied []APIAccessRule `json:"denied,omitempty"`
+	// Rate limits for groups of APIs, shared by the HTTP and gRPC servers.
+	// +optional
+	RateLimits []APIRateLimit `json:"rateLimits,omitempty"`
+	// Action for requests that are not matched by any allowed rule: "allow" (default) or "deny".
+	// +optional
+	DefaultAction string `json:"defaultAction,omitempty"`
+}
+
+// APIRateLimit describes a token-bucket rate limit for a group of Dapr APIs.
+type APIRateLimit struct {
+	// Name of the API group, such as "state", "publish", "invoke" or "actors".
+	Name string `json:"name"`
+	// Number of requests per second allowed on average.
+	RequestsPerSecond int `json:"requestsPerSecond"`
+	// Maximum number of requests allowed at once. Defaults to RequestsPerSecond.
+	// +optional
+	Burst int `json:"burst,omitempty"`
 }
 
 // WasmSpec describes the security profile for all Dapr Wasm components.
@@ -140,6 +213,18 @@ type APIAccessRule struct {
 	Version string `json:"version"`
 	// +optional
 	Protocol string `json:"protocol,omitempty"`
+	// HTTP verbs the rule applies to.
+	// +optional
+	Verbs []string `json:"verbs,omitempty"`
+	// Prefix of the HTTP request path the rule applies to.
+	// +optional
+	PathPrefix string `json:"pathPrefix,omitempty"`
+	// gRPC methods the rule applies to.
+	// +optional
+	GRPCMethods []string `json:"grpcMethods,omitempty"`
+	// Headers or gRPC metadata that must be present in the request.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
 }
 
 // NameResolutionSpec is the spec for name resolution configuration.
@@ -147,11 +232,29 @@ type NameResolutionSpec struct {
 	Component     string        `json:"component"`
 	Version       string        `json:"version"`
 	Configuration *DynamicValue `json:"configuration"`
+	// SecretStore is the secret store of the secret URIs of the configuration.
+	// +optional
+	SecretStore string `json:"secretStore,omitempty"`
 }
 
 // SecretsSpec is the spec for secrets configuration.
 type SecretsSpec struct {
 	Scopes []SecretsScope `json:"scopes"`
+	// +optional
+	Audit *SecretsAuditSpec `json:"audit,omitempty"`
+}
+
+// SecretsAuditSpec configures the sink of the audit records of the secrets APIs.
+type SecretsAuditSpec struct {
+	// Sink is one of "log" (default), "pubsub" or "otlp".
+	// +optional
+	Sink string `json:"sink,omitempty"`
+	// +optional
+	PubsubName string `json:"pubsubName,omitempty"`
+	// +optional
+	Topic string `json:"topic,omitempty"`
+	// +optional
+	Otel *OtelSpec `json:"otel,omitempty"`
 }
 
 // SecretsScope defines the scope for secrets.
@@ -168,6 +271,22 @@ type SecretsScope struct {
 // PipelineSpec defines the middleware pipeline.
 type PipelineSpec struct {
 	Handlers []HandlerSpec `json:"handlers"`
+	// +optional
+	ResponseHandlers []HandlerSpec `json:"responseHandlers,omitempty"`
+	// +optional
+	Routes []PipelineRouteSpec `json:"routes,omitempty"`
+}
+
+// PipelineRouteSpec defines a pipeline scoped to a URL path prefix or caller app IDs.
+type PipelineRouteSpec struct {
+	// +optional
+	PathPrefix string `json:"pathPrefix,omitempty"`
+	// +optional
+	AppIDs []string `json:"appIDs,omitempty"`
+	// +optional
+	Handlers []HandlerSpec `json:"handlers,omitempty"`
+	// +optional
+	ResponseHandlers []HandlerSpec `json:"responseHandlers,omitempty"`
 }
 
 // HandlerSpec defines a request handlers.
@@ -224,11 +343,56 @@ type SelectorField struct {
 type TracingSpec struct {
 	SamplingRate string `json:"samplingRate"`
 	// +optional
+	Sampler *TracingSamplerSpec `json:"sampler,omitempty"`
+	// +optional
 	Stdout *bool `json:"stdout,omitempty"`
 	// +optional
 	Zipkin *ZipkinSpec `json:"zipkin,omitempty"`
 	// +optional
 	Otel *OtelSpec `json:"otel,omitempty"`
+	// +optional
+	AttributeRules []TracingAttributeRule `json:"attributeRules,omitempty"`
+	// +optional
+	TailSampling *TracingTailSamplingSpec `json:"tailSampling,omitempty"`
+	// +optional
+	Propagation *TracingPropagationSpec `json:"propagation,omitempty"`
+}
+
+// TracingPropagationSpec defines additional trace context propagation formats.
+type TracingPropagationSpec struct {
+	// +optional
+	B3 string `json:"b3,omitempty"`
+}
+
+// TracingTailSamplingSpec defines the tail sampling configuration.
+type TracingTailSamplingSpec struct {
+	// +optional
+	LatencyThreshold int `json:"latencyThreshold,omitempty"`
+	// +optional
+	MaxTraces int `json:"maxTraces,omitempty"`
+}
+
+// TracingAttributeRule defines a span attribute extracted from incoming requests.
+type TracingAttributeRule struct {
+	Source    string `json:"source"`
+	Name      string `json:"name"`
+	Attribute string `json:"attribute"`
+}
+
+// TracingSamplerSpec defines the trace sampler configuration.
+type TracingSamplerSpec struct {
+	// +optional
+	Type string `json:"type,omitempty"`
+	// +optional
+	TracesPerSecond int `json:"tracesPerSecond,omitempty"`
+	// +optional
+	Routes []TracingSamplerRoute `json:"routes,omitempty"`
+}
+
+// TracingSamplerRoute defines a sampling rate override for a route.
+type TracingSamplerRoute struct {
+	Path         string `json:"path"`
+	SamplingRate string `json:"samplingRate"`
 }
 
 // OtelSpec defines Otel exporter configurations.
@@ -236,6 +400,26 @@ type OtelSpec struct {
 	Protocol        string `json:"protocol" yaml:"protocol"`
 	EndpointAddress string `json:"endpointAddress" yaml:"endpointAddress"`
 	IsSecure        *bool  `json:"isSecure" yaml:"isSecure"`
+	// +optional
+	Headers string `json:"headers,omitempty" yaml:"headers,omitempty"`
+	// +optional
+	Timeout int `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	// +optional
+	Compression string `json:"compression,omitempty" yaml:"compression,omitempty"`
+	// +optional
+	TLS *OtelTLSSpec `json:"tls,omitempty" yaml:"tls,omitempty"`
+}
+
+// OtelTLSSpec defines the TLS configuration for the Otel exporter.
+type OtelTLSSpec struct {
+	// +optional
+	CAFile string `json:"caFile,omitempty" yaml:"caFile,omitempty"`
+	// +optional
+	CertFile string `json:"certFile,omitempty" yaml:"certFile,omitempty"`
+	// +optional
+	KeyFile string `json:"keyFile,omitempty" yaml:"keyFile,omitempty"`
+	// +optional
+	ServerName string `json:"serverName,omitempty" yaml:"serverName,omitempty"`
 }
 
 // ZipkinSpec defines Zipkin trace configurations.
@@ -251,6 +435,8 @@ type MetricSpec struct {
 	// +optional
 	HTTP *MetricHTTP `json:"http,omitempty"`
 	// +optional
+	PubSub *MetricPubSub `json:"pubsub,omitempty"`
+	// +optional
 	Rules []MetricsRule `json:"rules,omitempty"`
 	// The LatencyDistributionBuckets variable specifies the latency distribution buckets (in milliseconds) used for
 	// histograms in the application. If this variable is not set or left empty, the application will default to using the standard histogram buckets.
@@ -274,6 +460,23 @@ type MetricHTTP struct {
 	ExcludeVerbs *bool `json:"excludeVerbs,omitempty"`
 }
 
+// MetricPubSub defines configuration for pub/sub metrics.
+type MetricPubSub struct {
+	// If false, the topic label is omitted from pub/sub metrics.
+	// The default is true.
+	// +optional
+	IncludeTopic *bool `json:"includeTopic,omitempty"`
+	// If true (default is false), pub/sub ingress metrics also include partition and consumer group labels
+	// when the component exposes them in the message metadata.
+	// +optional
+	IncreasedCardinality *bool `json:"increasedCardinality,omitempty"`
+	// Maximum number of distinct values recorded for each of the topic, partition and consumer group labels.
+	// Values seen after the limit is reached are recorded as "_other". 0 disables the limit.
+	// The default is 1000.
+	// +optional
+	MaxLabelCardinality *int `json:"maxLabelCardinality,omitempty"`
+}
+
 // MetricsRule defines configuration options for a metric.
 type MetricsRule struct {
 	Name   string        `json:"name"`
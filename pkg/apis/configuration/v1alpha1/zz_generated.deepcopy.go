@@ -28,6 +28,23 @@ import (
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIAccessRule) DeepCopyInto(out *APIAccessRule) {
 	*out = *in
+	if in.Verbs != nil {
+		in, out := &in.Verbs, &out.Verbs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GRPCMethods != nil {
+		in, out := &in.GRPCMethods, &out.GRPCMethods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIAccessRule.
@@ -70,17 +87,41 @@ func (in *APILoggingSpec) DeepCopy() *APILoggingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIRateLimit) DeepCopyInto(out *APIRateLimit) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIRateLimit.
+func (in *APIRateLimit) DeepCopy() *APIRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(APIRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APISpec) DeepCopyInto(out *APISpec) {
 	*out = *in
 	if in.Allowed != nil {
 		in, out := &in.Allowed, &out.Allowed
 		*out = make([]APIAccessRule, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Denied != nil {
 		in, out := &in.Denied, &out.Denied
 		*out = make([]APIAccessRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RateLimits != nil {
+		in, out := &in.RateLimits, &out.RateLimits
+		*out = make([]APIRateLimit, len(*in))
 		copy(*out, *in)
 	}
 }
@@ -250,6 +291,11 @@ func (in *ConfigurationSpec) DeepCopyInto(out *ConfigurationSpec) {
 		*out = new(PipelineSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GRPCPipelineSpec != nil {
+		in, out := &in.GRPCPipelineSpec, &out.GRPCPipelineSpec
+		*out = new(PipelineSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TracingSpec != nil {
 		in, out := &in.TracingSpec, &out.TracingSpec
 		*out = new(TracingSpec)
@@ -494,6 +540,36 @@ func (in *MetricLabel) DeepCopy() *MetricLabel {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricPubSub) DeepCopyInto(out *MetricPubSub) {
+	*out = *in
+	if in.IncludeTopic != nil {
+		in, out := &in.IncludeTopic, &out.IncludeTopic
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IncreasedCardinality != nil {
+		in, out := &in.IncreasedCardinality, &out.IncreasedCardinality
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxLabelCardinality != nil {
+		in, out := &in.MaxLabelCardinality, &out.MaxLabelCardinality
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricPubSub.
+func (in *MetricPubSub) DeepCopy() *MetricPubSub {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricPubSub)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetricSpec) DeepCopyInto(out *MetricSpec) {
 	*out = *in
@@ -512,6 +588,11 @@ func (in *MetricSpec) DeepCopyInto(out *MetricSpec) {
 		*out = new(MetricHTTP)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PubSub != nil {
+		in, out := &in.PubSub, &out.PubSub
+		*out = new(MetricPubSub)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Rules != nil {
 		in, out := &in.Rules, &out.Rules
 		*out = make([]MetricsRule, len(*in))
@@ -590,6 +671,11 @@ func (in *OtelSpec) DeepCopyInto(out *OtelSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(OtelTLSSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OtelSpec.
@@ -602,6 +688,55 @@ func (in *OtelSpec) DeepCopy() *OtelSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OtelTLSSpec) DeepCopyInto(out *OtelTLSSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OtelTLSSpec.
+func (in *OtelTLSSpec) DeepCopy() *OtelTLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OtelTLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineRouteSpec) DeepCopyInto(out *PipelineRouteSpec) {
+	*out = *in
+	if in.AppIDs != nil {
+		in, out := &in.AppIDs, &out.AppIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Handlers != nil {
+		in, out := &in.Handlers, &out.Handlers
+		*out = make([]HandlerSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResponseHandlers != nil {
+		in, out := &in.ResponseHandlers, &out.ResponseHandlers
+		*out = make([]HandlerSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineRouteSpec.
+func (in *PipelineRouteSpec) DeepCopy() *PipelineRouteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRouteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PipelineSpec) DeepCopyInto(out *PipelineSpec) {
 	*out = *in
@@ -612,6 +747,20 @@ func (in *PipelineSpec) DeepCopyInto(out *PipelineSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ResponseHandlers != nil {
+		in, out := &in.ResponseHandlers, &out.ResponseHandlers
+		*out = make([]HandlerSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]PipelineRouteSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineSpec.
@@ -624,6 +773,26 @@ func (in *PipelineSpec) DeepCopy() *PipelineSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretsAuditSpec) DeepCopyInto(out *SecretsAuditSpec) {
+	*out = *in
+	if in.Otel != nil {
+		in, out := &in.Otel, &out.Otel
+		*out = new(OtelSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsAuditSpec.
+func (in *SecretsAuditSpec) DeepCopy() *SecretsAuditSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretsAuditSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretsScope) DeepCopyInto(out *SecretsScope) {
 	*out = *in
@@ -659,6 +828,11 @@ func (in *SecretsSpec) DeepCopyInto(out *SecretsSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Audit != nil {
+		in, out := &in.Audit, &out.Audit
+		*out = new(SecretsAuditSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsSpec.
@@ -706,9 +880,79 @@ func (in *SelectorSpec) DeepCopy() *SelectorSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracingAttributeRule) DeepCopyInto(out *TracingAttributeRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingAttributeRule.
+func (in *TracingAttributeRule) DeepCopy() *TracingAttributeRule {
+	if in == nil {
+		return nil
+	}
+	out := new(TracingAttributeRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracingPropagationSpec) DeepCopyInto(out *TracingPropagationSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingPropagationSpec.
+func (in *TracingPropagationSpec) DeepCopy() *TracingPropagationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TracingPropagationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracingSamplerRoute) DeepCopyInto(out *TracingSamplerRoute) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingSamplerRoute.
+func (in *TracingSamplerRoute) DeepCopy() *TracingSamplerRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(TracingSamplerRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracingSamplerSpec) DeepCopyInto(out *TracingSamplerSpec) {
+	*out = *in
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]TracingSamplerRoute, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingSamplerSpec.
+func (in *TracingSamplerSpec) DeepCopy() *TracingSamplerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TracingSamplerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TracingSpec) DeepCopyInto(out *TracingSpec) {
 	*out = *in
+	if in.Sampler != nil {
+		in, out := &in.Sampler, &out.Sampler
+		*out = new(TracingSamplerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Stdout != nil {
 		in, out := &in.Stdout, &out.Stdout
 		*out = new(bool)
@@ -724,6 +968,21 @@ func (in *TracingSpec) DeepCopyInto(out *TracingSpec) {
 		*out = new(OtelSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AttributeRules != nil {
+		in, out := &in.AttributeRules, &out.AttributeRules
+		*out = make([]TracingAttributeRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.TailSampling != nil {
+		in, out := &in.TailSampling, &out.TailSampling
+		*out = new(TracingTailSamplingSpec)
+		**out = **in
+	}
+	if in.Propagation != nil {
+		in, out := &in.Propagation, &out.Propagation
+		*out = new(TracingPropagationSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingSpec.
@@ -736,6 +995,21 @@ func (in *TracingSpec) DeepCopy() *TracingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracingTailSamplingSpec) DeepCopyInto(out *TracingTailSamplingSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingTailSamplingSpec.
+func (in *TracingTailSamplingSpec) DeepCopy() *TracingTailSamplingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TracingTailSamplingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ValidatorSpec) DeepCopyInto(out *ValidatorSpec) {
 	*out = *in
@@ -771,6 +1045,21 @@ func (in *WasmSpec) DeepCopy() *WasmSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowLimits) DeepCopyInto(out *WorkflowLimits) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowLimits.
+func (in *WorkflowLimits) DeepCopy() *WorkflowLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 	*out = *in
@@ -779,6 +1068,25 @@ func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 		*out = new(WorkflowStateRetentionPolicy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.EventBufferTTL != nil {
+		in, out := &in.EventBufferTTL, &out.EventBufferTTL
+		*out = new(timex.Duration)
+		**out = **in
+	}
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = make(map[string]WorkflowLimits, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowSpec.
@@ -814,6 +1122,16 @@ func (in *WorkflowStateRetentionPolicy) DeepCopyInto(out *WorkflowStateRetention
 		*out = new(timex.Duration)
 		**out = **in
 	}
+	if in.MaxHistoryLength != nil {
+		in, out := &in.MaxHistoryLength, &out.MaxHistoryLength
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ReapInterval != nil {
+		in, out := &in.ReapInterval, &out.ReapInterval
+		*out = new(timex.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowStateRetentionPolicy.
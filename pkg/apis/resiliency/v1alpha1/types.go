@@ -103,6 +103,12 @@ type ActorPolicyNames struct {
 	CircuitBreaker          string `json:"circuitBreaker,omitempty" yaml:"circuitBreaker,omitempty"`
 	CircuitBreakerScope     string `json:"circuitBreakerScope,omitempty" yaml:"circuitBreakerScope,omitempty"`
 	CircuitBreakerCacheSize int    `json:"circuitBreakerCacheSize,omitempty" yaml:"circuitBreakerCacheSize,omitempty"`
+	// Methods are the policies of the methods of the actor type, by method name.
+	Methods map[string]ActorMethodPolicyNames `json:"methods,omitempty" yaml:"methods,omitempty"`
+}
+
+type ActorMethodPolicyNames struct {
+	Timeout string `json:"timeout,omitempty" yaml:"timeout,omitempty"`
 }
 
 // ResiliencyList represents a list of `Resiliency` items.
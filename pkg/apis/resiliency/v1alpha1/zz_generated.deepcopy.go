@@ -24,9 +24,31 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActorMethodPolicyNames) DeepCopyInto(out *ActorMethodPolicyNames) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActorMethodPolicyNames.
+func (in *ActorMethodPolicyNames) DeepCopy() *ActorMethodPolicyNames {
+	if in == nil {
+		return nil
+	}
+	out := new(ActorMethodPolicyNames)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ActorPolicyNames) DeepCopyInto(out *ActorPolicyNames) {
 	*out = *in
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make(map[string]ActorMethodPolicyNames, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActorPolicyNames.
@@ -271,7 +293,7 @@ func (in *Targets) DeepCopyInto(out *Targets) {
 		in, out := &in.Actors, &out.Actors
 		*out = make(map[string]ActorPolicyNames, len(*in))
 		for key, val := range *in {
-			(*out)[key] = val
+			(*out)[key] = *val.DeepCopy()
 		}
 	}
 	if in.Components != nil {
@@ -58,6 +58,33 @@ type SubscriptionSpec struct {
 	DeadLetterTopic string `json:"deadLetterTopic,omitempty"`
 	// The option to enable bulk subscription for this topic.
 	BulkSubscribe BulkSubscribe `json:"bulkSubscribe,omitempty"`
+	// The optional ordering mode of the delivery of messages to the app.
+	// When set to `partitionKey`, messages sharing a partition key are
+	// delivered one at a time, in the order they are received.
+	// +optional
+	// +kubebuilder:validation:Enum=partitionKey
+	Ordering string `json:"ordering,omitempty"`
+	// The optional maximum number of messages of this subscription delivered
+	// to the app concurrently. Zero means no limit.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxConcurrentHandlers int32 `json:"maxConcurrentHandlers,omitempty"`
+	// The optional number of messages accepted from the broker and waiting for
+	// a free handler, on top of maxConcurrentHandlers. Once reached, the
+	// delivery from the broker blocks until a handler is free.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Prefetch int32 `json:"prefetch,omitempty"`
+	// The optional priority of this subscription. When the app max
+	// concurrency is reached, messages of higher priority subscriptions are
+	// delivered first. Defaults to 0.
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+	// The optional CEL expression selecting the messages delivered to the app.
+	// The CloudEvent, including its JSON data, is available as `event`.
+	// Messages for which the expression is not true are dropped.
+	// +optional
+	Filter string `json:"filter,omitempty"`
 }
 
 // BulkSubscribe encapsulates the bulk subscription configuration for a topic.
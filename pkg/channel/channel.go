@@ -16,6 +16,7 @@ package channel
 import (
 	"context"
 	"crypto/tls"
+	"net/http"
 
 	"google.golang.org/protobuf/types/known/anypb"
 
@@ -42,3 +43,12 @@ type AppChannel interface {
 type HTTPEndpointAppChannel interface {
 	InvokeMethod(ctx context.Context, req *invokev1.InvokeMethodRequest, appID string) (*invokev1.InvokeMethodResponse, error)
 }
+
+// WebSocketAppChannel is implemented by app channels that can proxy WebSocket connections.
+type WebSocketAppChannel interface {
+	// ProxyWebSocket upgrades the incoming request and proxies the connection to the target of the invocation request.
+	ProxyWebSocket(w http.ResponseWriter, r *http.Request, req *invokev1.InvokeMethodRequest, appID string) error
+	// DialWebSocket sends the upgrade request to the target of the invocation request.
+	// If the target accepts the upgrade, the response has status 101 and its body is the upgraded connection, as an io.ReadWriteCloser.
+	DialWebSocket(ctx context.Context, req *invokev1.InvokeMethodRequest, appID string) (*http.Response, error)
+}
@@ -34,6 +34,7 @@ import (
 	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
 	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/security/apitoken"
 	securityConsts "github.com/dapr/dapr/pkg/security/consts"
 )
 
@@ -45,13 +46,13 @@ type Channel struct {
 	baseAddress            string
 	ch                     chan struct{}
 	tracingSpec            config.TracingSpec
-	appMetadataToken       string
+	appMetadataToken       *apitoken.Tokens
 	maxRequestBodySize     int
 	appHealth              *apphealth.AppHealth
 }
 
 // CreateLocalChannel creates a gRPC connection with user code.
-func CreateLocalChannel(port, maxConcurrency int, conn *grpc.ClientConn, spec config.TracingSpec, maxRequestBodySize int, readBufferSize int, baseAddress string, appAPIToken string) *Channel {
+func CreateLocalChannel(port, maxConcurrency int, conn *grpc.ClientConn, spec config.TracingSpec, maxRequestBodySize int, readBufferSize int, baseAddress string, appAPIToken *apitoken.Tokens) *Channel {
 	// readBufferSize is unused
 	c := &Channel{
 		appCallbackClient:      runtimev1pb.NewAppCallbackClient(conn),
@@ -164,8 +165,8 @@ func (g *Channel) invokeMethodV1(ctx context.Context, req *invokev1.InvokeMethod
 
 	md := invokev1.InternalMetadataToGrpcMetadata(ctx, pd.GetMetadata(), true)
 
-	if g.appMetadataToken != "" {
-		md.Set(securityConsts.APITokenHeader, g.appMetadataToken)
+	if token := g.appMetadataToken.Current(); token != "" {
+		md.Set(securityConsts.APITokenHeader, token)
 	}
 
 	// Prepare gRPC Metadata
@@ -237,11 +238,10 @@ func (g *Channel) SetAppHealth(ah *apphealth.AppHealth) {
 	g.appHealth = ah
 }
 
-// AddAppTokenToContext adds the app API token to the outgoing gRPC context using the
-// token captured at channel creation time
+// AddAppTokenToContext adds the current app API token to the outgoing gRPC context
 func (g *Channel) AddAppTokenToContext(ctx context.Context) context.Context {
-	if g.appMetadataToken != "" {
-		return grpcMetadata.AppendToOutgoingContext(ctx, securityConsts.APITokenHeader, g.appMetadataToken)
+	if token := g.appMetadataToken.Current(); token != "" {
+		return grpcMetadata.AppendToOutgoingContext(ctx, securityConsts.APITokenHeader, token)
 	}
 	return ctx
 }
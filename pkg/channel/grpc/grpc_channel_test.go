@@ -36,6 +36,7 @@ import (
 	"github.com/dapr/dapr/pkg/config"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/security/apitoken"
 	securityConsts "github.com/dapr/dapr/pkg/security/consts"
 	daprt "github.com/dapr/dapr/pkg/testing"
 )
@@ -97,7 +98,7 @@ func TestInvokeMethod(t *testing.T) {
 		baseAddress:        "localhost:9998",
 		appCallbackClient:  runtimev1pb.NewAppCallbackClient(conn),
 		conn:               conn,
-		appMetadataToken:   "token1",
+		appMetadataToken:   apitoken.Static("token1"),
 		maxRequestBodySize: 4 << 20,
 	}
 	ctx := t.Context()
@@ -143,7 +144,7 @@ func TestHealthProbe(t *testing.T) {
 		baseAddress:        "localhost:9998",
 		appCallbackClient:  runtimev1pb.NewAppCallbackClient(conn),
 		conn:               conn,
-		appMetadataToken:   "token1",
+		appMetadataToken:   apitoken.Static("token1"),
 		maxRequestBodySize: 4 << 20,
 	}
 	ctx := t.Context()
@@ -172,6 +173,6 @@ func TestHealthProbe(t *testing.T) {
 }
 
 func TestCreateLocalChannelWithBaseAddress(t *testing.T) {
-	ch := CreateLocalChannel(8080, 1, nil, config.TracingSpec{}, 1024, 1, "my.app", "")
+	ch := CreateLocalChannel(8080, 1, nil, config.TracingSpec{}, 1024, 1, "my.app", nil)
 	assert.Equal(t, "my.app:8080", ch.baseAddress)
 }
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httputil"
 	"strconv"
 	"strings"
 	"time"
@@ -43,6 +44,7 @@ import (
 	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
 	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/security/apitoken"
 	securityConsts "github.com/dapr/dapr/pkg/security/consts"
 	"github.com/dapr/dapr/pkg/sse"
 	streamutils "github.com/dapr/kit/streams"
@@ -73,7 +75,7 @@ type Channel struct {
 	ch                  chan struct{}
 	compStore           *compstore.ComponentStore
 	tracingSpec         *config.TracingSpec
-	appHeaderToken      string
+	appHeaderToken      *apitoken.Tokens
 	maxResponseBodySize int
 	appHealthCheckPath  string
 	appHealth           *apphealth.AppHealth
@@ -93,7 +95,7 @@ type ChannelConfiguration struct {
 	TLSClientKey       string
 	TLSRootCA          string
 	TLSRenegotiation   string
-	AppAPIToken        string
+	AppAPIToken        *apitoken.Tokens
 }
 
 // CreateHTTPChannel creates an HTTP AppChannel.
@@ -158,6 +160,56 @@ func (h *Channel) GetAppConfig(ctx context.Context, appID string) (*config.Appli
 	return &config, nil
 }
 
+// ProxyWebSocket upgrades the incoming request to a WebSocket connection and proxies it to the target of the invocation request.
+// Because the connection is long-lived, it does not count towards the app's max concurrency.
+func (h *Channel) ProxyWebSocket(w http.ResponseWriter, r *http.Request, req *invokev1.InvokeMethodRequest, appID string) error {
+	channelReq, err := h.constructRequest(r.Context(), req, appID)
+	if err != nil {
+		return err
+	}
+
+	var proxyErr error
+	proxy := &httputil.ReverseProxy{
+		Rewrite: func(pr *httputil.ProxyRequest) {
+			pr.Out.URL = channelReq.URL
+			pr.Out.Host = ""
+			// The upgrade headers are preserved by the proxy, so only the headers added by the channel are set
+			for k, v := range channelReq.Header {
+				pr.Out.Header[k] = v
+			}
+		},
+		Transport: h.client.Transport,
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			proxyErr = err
+			w.WriteHeader(http.StatusBadGateway)
+		},
+	}
+	proxy.ServeHTTP(w, r)
+
+	return proxyErr
+}
+
+// DialWebSocket sends the WebSocket upgrade request of the invocation request to its target.
+// If the target accepts the upgrade, the response has status 101 Switching Protocols and its body is the upgraded connection, which implements io.ReadWriteCloser.
+// Like ProxyWebSocket, the connection does not count towards the app's max concurrency.
+func (h *Channel) DialWebSocket(ctx context.Context, req *invokev1.InvokeMethodRequest, appID string) (*http.Response, error) {
+	// If the request is for the app, do not allow it if the app health status is not successful
+	if h.baseAddress != "" && appID == "" && h.appHealth != nil && !h.appHealth.GetStatus().IsHealthy {
+		return nil, status.Error(codes.Internal, messages.ErrAppUnhealthy)
+	}
+
+	channelReq, err := h.constructRequest(ctx, req, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := h.client.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return transport.RoundTrip(channelReq)
+}
+
 // InvokeMethod invokes user code via HTTP.
 func (h *Channel) InvokeMethod(ctx context.Context, req *invokev1.InvokeMethodRequest, appID string) (*invokev1.InvokeMethodResponse, error) {
 	// Check if HTTP Extension is given. Otherwise, it will return error.
@@ -229,8 +281,8 @@ func (h *Channel) constructJobRequest(ctx context.Context, name string, data *an
 	}
 
 	// Set any additional headers or tokens required
-	if h.appHeaderToken != "" {
-		channelReq.Header.Set(securityConsts.APITokenHeader, h.appHeaderToken)
+	if token := h.appHeaderToken.Current(); token != "" {
+		channelReq.Header.Set(securityConsts.APITokenHeader, token)
 	}
 
 	return channelReq, nil
@@ -377,7 +429,8 @@ func (h *Channel) invokeMethodV1(ctx context.Context, req *invokev1.InvokeMethod
 		W: &bytes.Buffer{},
 	}
 
-	var isSse bool
+	var isSse, streamed bool
+	var streamedStatus int
 
 	execPipeline := h.middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		isSse = sse.IsSSEHttpRequest(r)
@@ -395,14 +448,43 @@ func (h *Channel) invokeMethodV1(ctx context.Context, req *invokev1.InvokeMethod
 		}
 		if clientResp != nil {
 			statusOK := clientResp.StatusCode >= 200 && clientResp.StatusCode < 300
-			if isSse && req.HTTPResponseWriter() != nil && statusOK {
-				callerResponseWriter := req.HTTPResponseWriter()
+			// The response is streamed to the caller through the middleware
+			// pipeline, as long as the caller and all the handlers support flushing
+			callerResponseWriter := req.HTTPResponseWriter()
+			canStream := callerResponseWriter != nil && statusOK && sse.CanFlush(callerResponseWriter) && sse.CanFlush(w)
+			streamedStatus = clientResp.StatusCode
+			switch {
+			case isSse && canStream:
+				streamed = true
+				rw.Stream(callerResponseWriter)
 				reader := bufio.NewReader(clientResp.Body)
-				err = sse.FlushSSEResponse(ctx, callerResponseWriter, reader)
+				err = sse.FlushSSEResponse(ctx, w, reader)
 				if err != nil {
 					return
 				}
-			} else {
+			case sse.IsStreamingHttpResponse(clientResp) && canStream:
+				// Event streams and chunked responses are forwarded to the caller as they are received
+				streamed = true
+				rw.Stream(callerResponseWriter)
+				copyHeader(w.Header(), clientResp.Header)
+				w.WriteHeader(clientResp.StatusCode)
+				err = sse.StreamResponse(ctx, w, clientResp.Body)
+				if err != nil {
+					return
+				}
+			case callerResponseWriter != nil && statusOK:
+				// Other successful responses are forwarded to the caller as they are read too, so their body is never held in memory
+				streamed = true
+				rw.Stream(callerResponseWriter)
+				copyHeader(w.Header(), clientResp.Header)
+				w.WriteHeader(clientResp.StatusCode)
+				body := h.limitResponseBody(clientResp.Body)
+				defer body.Close()
+				_, err = io.Copy(w, body)
+				if err != nil {
+					return
+				}
+			default:
 				copyHeader(w.Header(), clientResp.Header)
 				w.WriteHeader(clientResp.StatusCode)
 				_, _ = io.Copy(w, clientResp.Body)
@@ -421,8 +503,10 @@ func (h *Channel) invokeMethodV1(ctx context.Context, req *invokev1.InvokeMethod
 		return nil, err
 	}
 
-	statusOK := rw.StatusCode() >= 200 && rw.StatusCode() < 300
-	if isSse && statusOK {
+	// The response was already sent to the caller
+	if streamed {
+		// content-length is omitted in http streaming scenarios
+		diag.DefaultHTTPMonitoring.ClientRequestCompleted(ctx, channelReq.Method, req.Message().GetMethod(), strconv.Itoa(streamedStatus), contentLength, elapsedMs)
 		return nil, nil
 	}
 
@@ -519,8 +603,8 @@ func (h *Channel) constructRequest(ctx context.Context, req *invokev1.InvokeMeth
 		channelReq.Header.Set("tracestate", ts)
 	}
 
-	if h.appHeaderToken != "" {
-		channelReq.Header.Set(securityConsts.APITokenHeader, h.appHeaderToken)
+	if token := h.appHeaderToken.Current(); token != "" {
+		channelReq.Header.Set(securityConsts.APITokenHeader, token)
 	}
 
 	return channelReq, nil
@@ -529,26 +613,26 @@ func (h *Channel) constructRequest(ctx context.Context, req *invokev1.InvokeMeth
 func (h *Channel) parseChannelResponse(channelResp *http.Response) (*invokev1.InvokeMethodResponse, error) {
 	contentType := channelResp.Header.Get("content-type")
 
-	// Limit response body if needed
-	var body io.ReadCloser
-	if h.maxResponseBodySize > 0 {
-		body = streamutils.LimitReadCloser(channelResp.Body, int64(h.maxResponseBodySize)<<20)
-	} else {
-		body = channelResp.Body
-	}
-
 	// Convert status code
 	// TODO: fix type
 	//nolint:gosec
 	rsp := invokev1.
 		NewInvokeMethodResponse(int32(channelResp.StatusCode), "", nil).
 		WithHTTPHeaders(channelResp.Header).
-		WithRawData(body).
+		WithRawData(h.limitResponseBody(channelResp.Body)).
 		WithContentType(contentType)
 
 	return rsp, nil
 }
 
+// limitResponseBody limits the response body if needed.
+func (h *Channel) limitResponseBody(body io.ReadCloser) io.ReadCloser {
+	if h.maxResponseBodySize > 0 {
+		return streamutils.LimitReadCloser(body, int64(h.maxResponseBodySize)<<20)
+	}
+	return body
+}
+
 func copyHeader(dst http.Header, src http.Header) {
 	for k, vv := range src {
 		for _, v := range vv {
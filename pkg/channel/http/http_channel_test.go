@@ -14,14 +14,18 @@ limitations under the License.
 package http
 
 import (
+	"bufio"
 	"encoding/json"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -33,6 +37,7 @@ import (
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	httpMiddleware "github.com/dapr/dapr/pkg/middleware/http"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/security/apitoken"
 	"github.com/dapr/dapr/utils"
 )
 
@@ -793,7 +798,7 @@ func TestAppToken(t *testing.T) {
 		c := Channel{
 			baseAddress:    testServer.URL,
 			client:         http.DefaultClient,
-			appHeaderToken: "token1",
+			appHeaderToken: apitoken.Static("token1"),
 			compStore:      compstore.New(),
 			middleware:     httpMiddleware.New().BuildPipelineFromSpec("test", nil),
 		}
@@ -851,6 +856,304 @@ func TestAppToken(t *testing.T) {
 	})
 }
 
+func TestProxyWebSocket(t *testing.T) {
+	// The backend accepts the upgrade and echoes everything it receives
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Upgrade") != "websocket" || r.URL.Path != "/ws" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		conn, brw, err := http.NewResponseController(w).Hijack()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		brw.WriteString("HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n\r\n")
+		brw.Flush()
+		io.Copy(conn, brw)
+	}))
+	defer backend.Close()
+
+	c := Channel{
+		client:     http.DefaultClient,
+		compStore:  compstore.New(),
+		middleware: httpMiddleware.New().BuildPipelineFromSpec("test", nil),
+	}
+
+	front := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := invokev1.NewInvokeMethodRequest("ws").
+			WithHTTPExtension(r.Method, "").
+			WithHTTPHeaders(r.Header)
+		defer req.Close()
+		assert.NoError(t, c.ProxyWebSocket(w, r, req, backend.URL))
+	}))
+	defer front.Close()
+
+	conn, err := net.Dial("tcp", strings.TrimPrefix(front.URL, "http://"))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = conn.Write([]byte("GET /ws HTTP/1.1\r\nHost: localhost\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n\r\n"))
+	require.NoError(t, err)
+
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusSwitchingProtocols, resp.StatusCode)
+
+	_, err = conn.Write([]byte("ping"))
+	require.NoError(t, err)
+	buf := make([]byte, 4)
+	_, err = io.ReadFull(br, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "ping", string(buf))
+}
+
+func TestDialWebSocket(t *testing.T) {
+	// The backend accepts the upgrade and echoes everything it receives
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Upgrade") != "websocket" || r.URL.Path != "/ws" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		conn, brw, err := http.NewResponseController(w).Hijack()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		brw.WriteString("HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n\r\n")
+		brw.Flush()
+		io.Copy(conn, brw)
+	}))
+	defer backend.Close()
+
+	c := Channel{
+		client:      http.DefaultClient,
+		compStore:   compstore.New(),
+		baseAddress: backend.URL,
+	}
+
+	t.Run("upgrade accepted", func(t *testing.T) {
+		req := invokev1.NewInvokeMethodRequest("ws").
+			WithHTTPExtension(http.MethodGet, "").
+			WithHTTPHeaders(http.Header{"Upgrade": []string{"websocket"}, "Connection": []string{"Upgrade"}})
+		defer req.Close()
+
+		res, err := c.DialWebSocket(t.Context(), req, "")
+		require.NoError(t, err)
+		defer res.Body.Close()
+		assert.Equal(t, http.StatusSwitchingProtocols, res.StatusCode)
+
+		conn, ok := res.Body.(io.ReadWriteCloser)
+		require.True(t, ok)
+		_, err = conn.Write([]byte("ping"))
+		require.NoError(t, err)
+		buf := make([]byte, 4)
+		_, err = io.ReadFull(conn, buf)
+		require.NoError(t, err)
+		assert.Equal(t, "ping", string(buf))
+	})
+
+	t.Run("upgrade rejected", func(t *testing.T) {
+		req := invokev1.NewInvokeMethodRequest("other").
+			WithHTTPExtension(http.MethodGet, "").
+			WithHTTPHeaders(http.Header{"Upgrade": []string{"websocket"}, "Connection": []string{"Upgrade"}})
+		defer req.Close()
+
+		res, err := c.DialWebSocket(t.Context(), req, "")
+		require.NoError(t, err)
+		defer res.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+}
+
+// flushRecorder records the body written before each flush
+type flushRecorder struct {
+	*httptest.ResponseRecorder
+	flushed chan string
+}
+
+func (r *flushRecorder) Flush() {
+	r.ResponseRecorder.Flush()
+	r.flushed <- r.Body.String()
+}
+
+func TestInvokeMethodStreamedResponse(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.Write([]byte("first\n"))
+		w.(http.Flusher).Flush()
+		<-release
+		w.Write([]byte("second\n"))
+	}))
+	defer server.Close()
+
+	c := Channel{
+		baseAddress: server.URL,
+		client:      http.DefaultClient,
+		compStore:   compstore.New(),
+		middleware:  httpMiddleware.New().BuildPipelineFromSpec("test", nil),
+	}
+
+	rec := &flushRecorder{
+		ResponseRecorder: httptest.NewRecorder(),
+		flushed:          make(chan string, 2),
+	}
+	fakeReq := invokev1.NewInvokeMethodRequest("method").
+		WithHTTPExtension(http.MethodGet, "").
+		WithHTTPResponseWriter(rec)
+	defer fakeReq.Close()
+
+	type result struct {
+		resp *invokev1.InvokeMethodResponse
+		err  error
+	}
+	resCh := make(chan result, 1)
+	go func() {
+		resp, err := c.InvokeMethod(t.Context(), fakeReq, "")
+		resCh <- result{resp: resp, err: err}
+	}()
+
+	// The first chunk is forwarded before the app completes the response
+	select {
+	case body := <-rec.flushed:
+		assert.Equal(t, "first\n", body)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "first chunk was not flushed")
+	}
+	close(release)
+
+	res := <-resCh
+	require.NoError(t, res.err)
+	assert.Nil(t, res.resp)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/x-ndjson", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "first\nsecond\n", rec.Body.String())
+}
+
+// flushingWriter is a middleware response writer which supports flushing.
+type flushingWriter struct {
+	http.ResponseWriter
+}
+
+func (w flushingWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// bufferingWriter is a middleware response writer which doesn't support flushing.
+type bufferingWriter struct {
+	http.ResponseWriter
+}
+
+func TestInvokeMethodStreamedResponseMiddleware(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Write([]byte("data: first\n\n"))
+		w.(http.Flusher).Flush()
+		w.Write([]byte("data: second\n\n"))
+	}))
+	defer server.Close()
+
+	invoke := func(t *testing.T, wrap func(http.ResponseWriter) http.ResponseWriter) (*invokev1.InvokeMethodResponse, *flushRecorder) {
+		t.Helper()
+		c := Channel{
+			baseAddress: server.URL,
+			client:      http.DefaultClient,
+			compStore:   compstore.New(),
+			middleware: func(next http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("X-Middleware", "true")
+					next.ServeHTTP(wrap(w), r)
+				})
+			},
+		}
+
+		rec := &flushRecorder{
+			ResponseRecorder: httptest.NewRecorder(),
+			flushed:          make(chan string, 2),
+		}
+		fakeReq := invokev1.NewInvokeMethodRequest("method").
+			WithHTTPExtension(http.MethodGet, "").
+			WithHTTPResponseWriter(rec)
+		t.Cleanup(func() { fakeReq.Close() })
+
+		resp, err := c.InvokeMethod(t.Context(), fakeReq, "")
+		require.NoError(t, err)
+		return resp, rec
+	}
+
+	t.Run("streamed through the middleware", func(t *testing.T) {
+		resp, rec := invoke(t, func(w http.ResponseWriter) http.ResponseWriter {
+			return flushingWriter{w}
+		})
+		assert.Nil(t, resp)
+		assert.NotEmpty(t, rec.flushed)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "true", rec.Header().Get("X-Middleware"))
+		assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+		assert.Equal(t, "data: first\n\ndata: second\n\n", rec.Body.String())
+	})
+
+	t.Run("forwarded without flushing if the middleware can't flush", func(t *testing.T) {
+		resp, rec := invoke(t, func(w http.ResponseWriter) http.ResponseWriter {
+			return bufferingWriter{w}
+		})
+		assert.Nil(t, resp)
+		assert.Empty(t, rec.flushed)
+		assert.Equal(t, "true", rec.Header().Get("X-Middleware"))
+		assert.Equal(t, "data: first\n\ndata: second\n\n", rec.Body.String())
+	})
+}
+
+func TestInvokeMethodForwardedResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		w.Header().Set("Content-Length", "5")
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		w.Write([]byte("hello"))
+	}))
+	defer server.Close()
+
+	c := Channel{
+		baseAddress: server.URL,
+		client:      http.DefaultClient,
+		compStore:   compstore.New(),
+		middleware:  httpMiddleware.New().BuildPipelineFromSpec("test", nil),
+	}
+
+	invoke := func(t *testing.T, method string) (*invokev1.InvokeMethodResponse, *httptest.ResponseRecorder) {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		fakeReq := invokev1.NewInvokeMethodRequest(method).
+			WithHTTPExtension(http.MethodGet, "").
+			WithHTTPResponseWriter(rec)
+		t.Cleanup(func() { fakeReq.Close() })
+
+		resp, err := c.InvokeMethod(t.Context(), fakeReq, "")
+		require.NoError(t, err)
+		return resp, rec
+	}
+
+	t.Run("successful responses are forwarded to the caller", func(t *testing.T) {
+		resp, rec := invoke(t, "ok")
+		assert.Nil(t, resp)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "text/plain", rec.Header().Get("Content-Type"))
+		assert.Equal(t, "hello", rec.Body.String())
+	})
+
+	t.Run("failed responses are returned", func(t *testing.T) {
+		resp, rec := invoke(t, "fail")
+		require.NotNil(t, resp)
+		defer resp.Close()
+		assert.Equal(t, int32(http.StatusInternalServerError), resp.Status().GetCode())
+		assert.Empty(t, rec.Body.String())
+	})
+}
+
 func TestHealthProbe(t *testing.T) {
 	ctx := t.Context()
 	h := &testStatusCodeHandler{}
@@ -21,6 +21,16 @@ type RWRecorder struct {
 	statusCode int
 	h          http.Header
 	W          io.ReadWriter
+
+	// When set, the response is forwarded to stream instead of being recorded.
+	stream      http.ResponseWriter
+	wroteHeader bool
+}
+
+// Stream makes the recorder forward the rest of the response to rw as it's
+// written, together with the headers recorded so far.
+func (w *RWRecorder) Stream(rw http.ResponseWriter) {
+	w.stream = rw
 }
 
 func (w *RWRecorder) StatusCode() int {
@@ -43,12 +53,31 @@ func (w *RWRecorder) WriteHeader(code int) {
 	}
 
 	w.statusCode = code
+	if w.stream != nil && !w.wroteHeader {
+		w.wroteHeader = true
+		copyHeader(w.stream.Header(), w.Header())
+		w.stream.WriteHeader(code)
+	}
 }
 
 func (w *RWRecorder) Write(p []byte) (int, error) {
+	if w.stream != nil {
+		if !w.wroteHeader {
+			w.WriteHeader(http.StatusOK)
+		}
+		return w.stream.Write(p)
+	}
 	return w.W.Write(p)
 }
 
+// FlushError flushes the response forwarded to the caller, if any.
+func (w *RWRecorder) FlushError() error {
+	if w.stream == nil {
+		return nil
+	}
+	return http.NewResponseController(w.stream).Flush()
+}
+
 func (w *RWRecorder) Result() *http.Response {
 	res := &http.Response{
 		Proto:      "HTTP/1.1",
@@ -0,0 +1,231 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package file is a configuration store with the items of a mounted file or
+// directory, which are reloaded when the files change.
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/dapr/components-contrib/configuration"
+	contribMetadata "github.com/dapr/components-contrib/metadata"
+	configurationLoader "github.com/dapr/dapr/pkg/components/configuration"
+	"github.com/dapr/dapr/pkg/components/configuration/snapshot"
+	"github.com/dapr/kit/fswatcher"
+	"github.com/dapr/kit/logger"
+	kitmd "github.com/dapr/kit/metadata"
+)
+
+var _ configurationLoader.Lister = (*store)(nil)
+
+type metadata struct {
+	// Path is a YAML or JSON file with the items by key, or a directory with a
+	// file per item, named after its key, like a mounted ConfigMap.
+	Path string `mapstructure:"path"`
+}
+
+type store struct {
+	log      logger.Logger
+	md       metadata
+	snapshot *snapshot.Snapshot
+
+	wg      sync.WaitGroup
+	closeCh chan struct{}
+	close   sync.Once
+}
+
+// NewConfigurationStore returns a configuration store backed by files.
+func NewConfigurationStore(log logger.Logger) configuration.Store {
+	return &store{
+		log:      log,
+		snapshot: snapshot.New(log),
+		closeCh:  make(chan struct{}),
+	}
+}
+
+func (s *store) Init(ctx context.Context, meta configuration.Metadata) error {
+	if err := kitmd.DecodeMetadata(meta.Properties, &s.md); err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+	if s.md.Path == "" {
+		return errors.New("metadata property 'path' is required")
+	}
+	info, err := os.Stat(s.md.Path)
+	if err != nil {
+		return fmt.Errorf("failed to read configuration path: %w", err)
+	}
+	items, err := s.load()
+	if err != nil {
+		return err
+	}
+	s.snapshot.Update(ctx, items)
+
+	// Watch the folder rather than the file, as files in volumes are replaced through symlinks
+	dir := s.md.Path
+	if !info.IsDir() {
+		dir = filepath.Dir(dir)
+	}
+	fs, err := fswatcher.New(fswatcher.Options{
+		Targets: []string{dir},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to watch configuration path: %w", err)
+	}
+
+	watchCtx, cancel := context.WithCancel(context.Background())
+	eventCh := make(chan struct{})
+	s.wg.Add(2)
+	go func() {
+		defer s.wg.Done()
+		if err := fs.Run(watchCtx, eventCh); err != nil && !errors.Is(err, context.Canceled) {
+			s.log.Errorf("Failed to watch configuration path %s: %v", s.md.Path, err)
+		}
+	}()
+	go func() {
+		defer s.wg.Done()
+		defer cancel()
+		for {
+			select {
+			case <-s.closeCh:
+				return
+			case <-eventCh:
+				items, err := s.load()
+				if err != nil {
+					s.log.Errorf("Failed to reload configuration items: %v", err)
+					continue
+				}
+				s.snapshot.Update(watchCtx, items)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// load reads the items of the path.
+func (s *store) load() (map[string]*configuration.Item, error) {
+	info, err := os.Stat(s.md.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read configuration path: %w", err)
+	}
+	if info.IsDir() {
+		return loadDir(s.md.Path)
+	}
+	return loadFile(s.md.Path)
+}
+
+// loadDir reads a file per item. Hidden files are skipped, including the
+// "..data" links of mounted ConfigMaps.
+func loadDir(dir string) (map[string]*configuration.Item, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read configuration directory: %w", err)
+	}
+	items := make(map[string]*configuration.Item, len(entries))
+	for _, e := range entries {
+		if strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		// Follow the symlinks of the mounted ConfigMaps
+		info, err := os.Stat(path)
+		if err != nil || info.IsDir() {
+			continue
+		}
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read configuration file: %w", err)
+		}
+		items[e.Name()] = &configuration.Item{
+			Value:   string(b),
+			Version: snapshot.Version(string(b)),
+		}
+	}
+	return items, nil
+}
+
+// loadFile reads a YAML or JSON file with the items by key. An item is either
+// a value, or an object with value, version and metadata.
+func loadFile(path string) (map[string]*configuration.Item, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read configuration file: %w", err)
+	}
+	var raw map[string]json.RawMessage
+	if err = yaml.Unmarshal(b, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse configuration file: %w", err)
+	}
+
+	items := make(map[string]*configuration.Item, len(raw))
+	for key, v := range raw {
+		item := &configuration.Item{}
+		var str string
+		switch {
+		case json.Unmarshal(v, &str) == nil:
+			item.Value = str
+		case len(v) > 0 && v[0] == '{':
+			if err = json.Unmarshal(v, item); err != nil {
+				return nil, fmt.Errorf("invalid configuration item %s: %w", key, err)
+			}
+		default:
+			// Numbers and booleans
+			item.Value = string(v)
+		}
+		if item.Version == "" {
+			item.Version = snapshot.Version(item.Value)
+		}
+		items[key] = item
+	}
+	return items, nil
+}
+
+func (s *store) Get(_ context.Context, req *configuration.GetRequest) (*configuration.GetResponse, error) {
+	return s.snapshot.Get(req), nil
+}
+
+// ListConfiguration lists a page of the loaded items.
+func (s *store) ListConfiguration(_ context.Context, req *configurationLoader.ListRequest) (*configurationLoader.ListResponse, error) {
+	return s.snapshot.List(req)
+}
+
+func (s *store) Subscribe(_ context.Context, req *configuration.SubscribeRequest, handler configuration.UpdateHandler) (string, error) {
+	return s.snapshot.Subscribe(req, handler)
+}
+
+func (s *store) Unsubscribe(_ context.Context, req *configuration.UnsubscribeRequest) error {
+	return s.snapshot.Unsubscribe(req)
+}
+
+func (s *store) GetComponentMetadata() (metadataInfo contribMetadata.MetadataMap) {
+	contribMetadata.GetMetadataInfoFromStructType(reflect.TypeOf(metadata{}), &metadataInfo, contribMetadata.ConfigurationStoreType)
+	return
+}
+
+func (s *store) Close() error {
+	s.close.Do(func() {
+		close(s.closeCh)
+	})
+	s.wg.Wait()
+	return nil
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/configuration"
+	contribMetadata "github.com/dapr/components-contrib/metadata"
+	"github.com/dapr/kit/logger"
+)
+
+func newStore(t *testing.T, path string) configuration.Store {
+	t.Helper()
+	s := NewConfigurationStore(logger.NewLogger("test"))
+	require.NoError(t, s.Init(t.Context(), configuration.Metadata{Base: contribMetadata.Base{
+		Properties: map[string]string{"path": path},
+	}}))
+	t.Cleanup(func() { require.NoError(t, s.Close()) })
+	return s
+}
+
+func TestFileStore(t *testing.T) {
+	t.Run("directory", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "a"), []byte("1"), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "..data"), []byte("ignored"), 0o600))
+		s := newStore(t, dir)
+
+		res, err := s.Get(t.Context(), &configuration.GetRequest{})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 1)
+		assert.Equal(t, "1", res.Items["a"].Value)
+		assert.NotEmpty(t, res.Items["a"].Version)
+
+		var lock sync.Mutex
+		var events []*configuration.UpdateEvent
+		_, err = s.Subscribe(t.Context(), &configuration.SubscribeRequest{}, func(_ context.Context, e *configuration.UpdateEvent) error {
+			lock.Lock()
+			defer lock.Unlock()
+			events = append(events, e)
+			return nil
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "a"), []byte("2"), 0o600))
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			lock.Lock()
+			defer lock.Unlock()
+			if assert.Len(c, events, 1) {
+				assert.Equal(c, "2", events[0].Items["a"].Value)
+			}
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(`
+a: hello
+b: 42
+c:
+  value: world
+  version: v1
+  metadata:
+    env: prod
+`), 0o600))
+		s := newStore(t, path)
+
+		res, err := s.Get(t.Context(), &configuration.GetRequest{Keys: []string{"a", "b", "c"}})
+		require.NoError(t, err)
+		assert.Equal(t, "hello", res.Items["a"].Value)
+		assert.Equal(t, "42", res.Items["b"].Value)
+		assert.Equal(t, &configuration.Item{
+			Value:    "world",
+			Version:  "v1",
+			Metadata: map[string]string{"env": "prod"},
+		}, res.Items["c"])
+	})
+
+	t.Run("missing path", func(t *testing.T) {
+		s := NewConfigurationStore(logger.NewLogger("test"))
+		require.Error(t, s.Init(t.Context(), configuration.Metadata{}))
+		require.Error(t, s.Init(t.Context(), configuration.Metadata{Base: contribMetadata.Base{
+			Properties: map[string]string{"path": filepath.Join(t.TempDir(), "missing")},
+		}}))
+	})
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package kubernetes is a configuration store with the items of a Kubernetes
+// ConfigMap, which are updated by an informer when the ConfigMap changes.
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/dapr/components-contrib/configuration"
+	contribMetadata "github.com/dapr/components-contrib/metadata"
+	configurationLoader "github.com/dapr/dapr/pkg/components/configuration"
+	"github.com/dapr/dapr/pkg/components/configuration/snapshot"
+	"github.com/dapr/dapr/pkg/security"
+	"github.com/dapr/kit/logger"
+	kitmd "github.com/dapr/kit/metadata"
+)
+
+// syncTimeout is the max time to wait for the informer to load the ConfigMap.
+const syncTimeout = 30 * time.Second
+
+var _ configurationLoader.Lister = (*store)(nil)
+
+type metadata struct {
+	// ConfigMapName is the name of the ConfigMap with the items.
+	ConfigMapName string `mapstructure:"configMapName"`
+	// Namespace is the namespace of the ConfigMap. It defaults to the
+	// namespace of the sidecar.
+	Namespace string `mapstructure:"namespace"`
+	// KubeconfigPath is the kubeconfig used outside of Kubernetes.
+	KubeconfigPath string `mapstructure:"kubeconfigPath"`
+}
+
+type store struct {
+	log      logger.Logger
+	md       metadata
+	client   kubernetes.Interface
+	snapshot *snapshot.Snapshot
+
+	wg      sync.WaitGroup
+	closeCh chan struct{}
+	close   sync.Once
+}
+
+// NewConfigurationStore returns a configuration store backed by a ConfigMap.
+func NewConfigurationStore(log logger.Logger) configuration.Store {
+	return &store{
+		log:      log,
+		snapshot: snapshot.New(log),
+		closeCh:  make(chan struct{}),
+	}
+}
+
+func (s *store) Init(ctx context.Context, meta configuration.Metadata) error {
+	if err := kitmd.DecodeMetadata(meta.Properties, &s.md); err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+	if s.md.ConfigMapName == "" {
+		return errors.New("metadata property 'configMapName' is required")
+	}
+	if s.md.Namespace == "" {
+		s.md.Namespace = security.CurrentNamespace()
+	}
+	if s.client == nil {
+		conf, err := rest.InClusterConfig()
+		if err != nil {
+			conf, err = clientcmd.BuildConfigFromFlags("", s.md.KubeconfigPath)
+			if err != nil {
+				return fmt.Errorf("failed to get Kubernetes configuration: %w", err)
+			}
+		}
+		s.client, err = kubernetes.NewForConfig(conf)
+		if err != nil {
+			return fmt.Errorf("failed to create Kubernetes client: %w", err)
+		}
+	}
+
+	factory := informers.NewSharedInformerFactoryWithOptions(s.client, 0,
+		informers.WithNamespace(s.md.Namespace),
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.FieldSelector = fields.OneTermEqualSelector("metadata.name", s.md.ConfigMapName).String()
+		}),
+	)
+	informer := factory.Core().V1().ConfigMaps().Informer()
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			s.update(obj)
+		},
+		UpdateFunc: func(_, obj any) {
+			s.update(obj)
+		},
+		DeleteFunc: func(any) {
+			s.snapshot.Update(context.Background(), map[string]*configuration.Item{})
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to watch ConfigMap: %w", err)
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		informer.Run(s.closeCh)
+	}()
+
+	syncCtx, cancel := context.WithTimeout(ctx, syncTimeout)
+	defer cancel()
+	if !cache.WaitForCacheSync(syncCtx.Done(), informer.HasSynced) {
+		s.Close()
+		return fmt.Errorf("failed to load ConfigMap %s/%s", s.md.Namespace, s.md.ConfigMapName)
+	}
+	return nil
+}
+
+// update replaces the items with the data of the ConfigMap. The items have the
+// labels of the ConfigMap as metadata.
+func (s *store) update(obj any) {
+	cm, ok := obj.(*corev1.ConfigMap)
+	if !ok {
+		return
+	}
+	items := make(map[string]*configuration.Item, len(cm.Data))
+	for key, value := range cm.Data {
+		items[key] = &configuration.Item{
+			Value:    value,
+			Version:  snapshot.Version(value),
+			Metadata: cm.Labels,
+		}
+	}
+	s.snapshot.Update(context.Background(), items)
+}
+
+func (s *store) Get(_ context.Context, req *configuration.GetRequest) (*configuration.GetResponse, error) {
+	return s.snapshot.Get(req), nil
+}
+
+// ListConfiguration lists a page of the loaded items.
+func (s *store) ListConfiguration(_ context.Context, req *configurationLoader.ListRequest) (*configurationLoader.ListResponse, error) {
+	return s.snapshot.List(req)
+}
+
+func (s *store) Subscribe(_ context.Context, req *configuration.SubscribeRequest, handler configuration.UpdateHandler) (string, error) {
+	return s.snapshot.Subscribe(req, handler)
+}
+
+func (s *store) Unsubscribe(_ context.Context, req *configuration.UnsubscribeRequest) error {
+	return s.snapshot.Unsubscribe(req)
+}
+
+func (s *store) GetComponentMetadata() (metadataInfo contribMetadata.MetadataMap) {
+	contribMetadata.GetMetadataInfoFromStructType(reflect.TypeOf(metadata{}), &metadataInfo, contribMetadata.ConfigurationStoreType)
+	return
+}
+
+func (s *store) Close() error {
+	s.close.Do(func() {
+		close(s.closeCh)
+	})
+	s.wg.Wait()
+	return nil
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/dapr/components-contrib/configuration"
+	contribMetadata "github.com/dapr/components-contrib/metadata"
+	"github.com/dapr/kit/logger"
+)
+
+func TestConfigMapStore(t *testing.T) {
+	client := fake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "config",
+			Namespace: "ns1",
+			Labels:    map[string]string{"env": "prod"},
+		},
+		Data: map[string]string{"a": "1", "b": "2"},
+	})
+	s := NewConfigurationStore(logger.NewLogger("test")).(*store)
+	s.client = client
+	require.NoError(t, s.Init(t.Context(), configuration.Metadata{Base: contribMetadata.Base{
+		Properties: map[string]string{
+			"configMapName": "config",
+			"namespace":     "ns1",
+		},
+	}}))
+	t.Cleanup(func() { require.NoError(t, s.Close()) })
+
+	res, err := s.Get(t.Context(), &configuration.GetRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Items, 2)
+	assert.Equal(t, "1", res.Items["a"].Value)
+	assert.Equal(t, map[string]string{"env": "prod"}, res.Items["a"].Metadata)
+
+	var lock sync.Mutex
+	var events []*configuration.UpdateEvent
+	_, err = s.Subscribe(t.Context(), &configuration.SubscribeRequest{}, func(_ context.Context, e *configuration.UpdateEvent) error {
+		lock.Lock()
+		defer lock.Unlock()
+		events = append(events, e)
+		return nil
+	})
+	require.NoError(t, err)
+
+	_, err = client.CoreV1().ConfigMaps("ns1").Update(t.Context(), &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "ns1"},
+		Data:       map[string]string{"a": "1", "b": "3"},
+	}, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		lock.Lock()
+		defer lock.Unlock()
+		if assert.Len(c, events, 1) {
+			assert.Len(c, events[0].Items, 1)
+			assert.Equal(c, "3", events[0].Items["b"].Value)
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestConfigMapStoreMissingName(t *testing.T) {
+	s := NewConfigurationStore(logger.NewLogger("test")).(*store)
+	s.client = fake.NewClientset()
+	require.Error(t, s.Init(t.Context(), configuration.Metadata{}))
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configuration
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/dapr/components-contrib/configuration"
+)
+
+// ErrInvalidContinuationToken is returned when the continuation token of a
+// list request wasn't returned by a previous request.
+var ErrInvalidContinuationToken = errors.New("invalid continuation token")
+
+// Filter selects configuration items by key prefix and by labels, which are
+// matched against the metadata of the items. An empty filter matches all the
+// items.
+type Filter struct {
+	// Prefixes are the key prefixes of the items. An item matches if its key
+	// has any of them.
+	Prefixes []string
+	// Labels are the metadata of the items. An item matches if it has all of
+	// them.
+	Labels map[string]string
+}
+
+// IsEmpty returns true if the filter matches all the items.
+func (f *Filter) IsEmpty() bool {
+	return f == nil || (len(f.Prefixes) == 0 && len(f.Labels) == 0)
+}
+
+// Match returns true if the item matches the filter.
+func (f *Filter) Match(key string, item *configuration.Item) bool {
+	if f.IsEmpty() {
+		return true
+	}
+	if len(f.Prefixes) > 0 && !slices.ContainsFunc(f.Prefixes, func(prefix string) bool {
+		return strings.HasPrefix(key, prefix)
+	}) {
+		return false
+	}
+	for k, v := range f.Labels {
+		if item == nil || item.Metadata[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// FilterHandler returns an update handler which only receives the items of the
+// events matching the filter, and no events without matching items.
+func FilterHandler(filter *Filter, handler configuration.UpdateHandler) configuration.UpdateHandler {
+	if filter.IsEmpty() {
+		return handler
+	}
+	return func(ctx context.Context, e *configuration.UpdateEvent) error {
+		items := make(map[string]*configuration.Item, len(e.Items))
+		for key, item := range e.Items {
+			if filter.Match(key, item) {
+				items[key] = item
+			}
+		}
+		if len(items) == 0 {
+			return nil
+		}
+		return handler(ctx, &configuration.UpdateEvent{ID: e.ID, Items: items})
+	}
+}
+
+type ListRequest struct {
+	// Keys are the keys of the items. Empty means all the keys of the store.
+	Keys []string
+	// Filter filters the items by key prefix and labels.
+	Filter Filter
+	// PageSize is the maximum number of items in a page. Zero means all the
+	// items are returned in one page.
+	PageSize int
+	// ContinuationToken is returned by the previous page.
+	ContinuationToken string
+	Metadata          map[string]string
+}
+
+type ListResponse struct {
+	Items map[string]*configuration.Item
+	// ContinuationToken is empty when there are no more pages.
+	ContinuationToken string
+}
+
+// Lister is implemented by configuration stores which can list their items
+// page by page, filtered by key prefix and labels. Their continuation tokens
+// are opaque to the runtime.
+type Lister interface {
+	ListConfiguration(ctx context.Context, req *ListRequest) (*ListResponse, error)
+}
+
+// List returns a page of the items of the store matching the filters of the
+// request. Stores implementing Lister filter and paginate natively. The items
+// of the other stores are fetched at once, then paginated with Page.
+func List(ctx context.Context, store configuration.Store, req *ListRequest) (*ListResponse, error) {
+	if lister, ok := store.(Lister); ok {
+		return lister.ListConfiguration(ctx, req)
+	}
+
+	// Validate the continuation token before fetching the items.
+	if _, err := decodeContinuationToken(req.ContinuationToken); err != nil {
+		return nil, err
+	}
+	res, err := store.Get(ctx, &configuration.GetRequest{
+		Keys:     req.Keys,
+		Metadata: req.Metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return &ListResponse{}, nil
+	}
+	return Page(res.Items, req)
+}
+
+// Page returns the page of the items with the keys of the request, or of all
+// the items, which match its filters. The items are sorted by key, with the
+// key of the last item of the page as continuation token.
+func Page(items map[string]*configuration.Item, req *ListRequest) (*ListResponse, error) {
+	after, err := decodeContinuationToken(req.ContinuationToken)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(items))
+	match := func(key string, item *configuration.Item) {
+		if after != "" && key <= after {
+			return
+		}
+		if !req.Filter.Match(key, item) {
+			return
+		}
+		keys = append(keys, key)
+	}
+	if len(req.Keys) > 0 {
+		for _, key := range req.Keys {
+			if item, ok := items[key]; ok && !slices.Contains(keys, key) {
+				match(key, item)
+			}
+		}
+	} else {
+		for key, item := range items {
+			match(key, item)
+		}
+	}
+	slices.Sort(keys)
+
+	list := &ListResponse{}
+	if req.PageSize > 0 && len(keys) > req.PageSize {
+		keys = keys[:req.PageSize]
+		list.ContinuationToken = base64.RawURLEncoding.EncodeToString([]byte(keys[len(keys)-1]))
+	}
+	list.Items = make(map[string]*configuration.Item, len(keys))
+	for _, key := range keys {
+		list.Items[key] = items[key]
+	}
+	return list, nil
+}
+
+// decodeContinuationToken returns the key of the last item of the previous
+// page.
+func decodeContinuationToken(token string) (string, error) {
+	if token == "" {
+		return "", nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidContinuationToken, err)
+	}
+	return string(b), nil
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configuration
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/configuration"
+)
+
+// getStore returns a fixed set of items.
+type getStore struct {
+	configuration.Store
+	items map[string]*configuration.Item
+}
+
+func (s *getStore) Get(context.Context, *configuration.GetRequest) (*configuration.GetResponse, error) {
+	return &configuration.GetResponse{Items: s.items}, nil
+}
+
+// listerStore lists its items natively.
+type listerStore struct {
+	configuration.Store
+	req *ListRequest
+}
+
+func (s *listerStore) ListConfiguration(_ context.Context, req *ListRequest) (*ListResponse, error) {
+	s.req = req
+	return &ListResponse{
+		Items:             map[string]*configuration.Item{"app/a": {Value: "1"}},
+		ContinuationToken: "native",
+	}, nil
+}
+
+func TestList(t *testing.T) {
+	store := &getStore{items: map[string]*configuration.Item{
+		"app/a":   {Value: "1", Metadata: map[string]string{"env": "prod"}},
+		"app/b":   {Value: "2", Metadata: map[string]string{"env": "dev"}},
+		"app/c":   {Value: "3", Metadata: map[string]string{"env": "prod"}},
+		"infra/d": {Value: "4", Metadata: map[string]string{"env": "prod"}},
+	}}
+
+	t.Run("prefix and pages", func(t *testing.T) {
+		res, err := List(t.Context(), store, &ListRequest{Filter: Filter{Prefixes: []string{"app/"}}, PageSize: 2})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"app/a", "app/b"}, keys(res.Items))
+		require.NotEmpty(t, res.ContinuationToken)
+
+		res, err = List(t.Context(), store, &ListRequest{Filter: Filter{Prefixes: []string{"app/"}}, PageSize: 2, ContinuationToken: res.ContinuationToken})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"app/c"}, keys(res.Items))
+		assert.Empty(t, res.ContinuationToken)
+	})
+
+	t.Run("labels", func(t *testing.T) {
+		res, err := List(t.Context(), store, &ListRequest{Filter: Filter{Labels: map[string]string{"env": "prod"}}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"app/a", "app/c", "infra/d"}, keys(res.Items))
+		assert.Empty(t, res.ContinuationToken)
+	})
+
+	t.Run("keys", func(t *testing.T) {
+		res, err := Page(store.items, &ListRequest{Keys: []string{"app/b", "infra/d", "missing"}, PageSize: 1})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"app/b"}, keys(res.Items))
+		require.NotEmpty(t, res.ContinuationToken)
+
+		res, err = Page(store.items, &ListRequest{Keys: []string{"app/b", "infra/d", "missing"}, PageSize: 1, ContinuationToken: res.ContinuationToken})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"infra/d"}, keys(res.Items))
+		assert.Empty(t, res.ContinuationToken)
+	})
+
+	t.Run("invalid continuation token", func(t *testing.T) {
+		_, err := List(t.Context(), store, &ListRequest{ContinuationToken: "!"})
+		require.ErrorIs(t, err, ErrInvalidContinuationToken)
+	})
+
+	t.Run("native listing", func(t *testing.T) {
+		lister := &listerStore{}
+		res, err := List(t.Context(), lister, &ListRequest{Filter: Filter{Prefixes: []string{"app/"}}, PageSize: 1})
+		require.NoError(t, err)
+		assert.Equal(t, "native", res.ContinuationToken)
+		assert.Equal(t, []string{"app/"}, lister.req.Filter.Prefixes)
+	})
+}
+
+func TestFilterHandler(t *testing.T) {
+	var events []*configuration.UpdateEvent
+	handler := FilterHandler(&Filter{Prefixes: []string{"app/"}}, func(_ context.Context, e *configuration.UpdateEvent) error {
+		events = append(events, e)
+		return nil
+	})
+
+	require.NoError(t, handler(t.Context(), &configuration.UpdateEvent{ID: "1", Items: map[string]*configuration.Item{
+		"app/a":   {Value: "1"},
+		"infra/d": {Value: "4"},
+	}}))
+	require.NoError(t, handler(t.Context(), &configuration.UpdateEvent{ID: "1", Items: map[string]*configuration.Item{
+		"infra/d": {Value: "5"},
+	}}))
+
+	require.Len(t, events, 1)
+	assert.Equal(t, "1", events[0].ID)
+	assert.Equal(t, []string{"app/a"}, keys(events[0].Items))
+}
+
+func keys(items map[string]*configuration.Item) []string {
+	res := make([]string, 0, len(items))
+	for k := range items {
+		res = append(res, k)
+	}
+	slices.Sort(res)
+	return res
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package snapshot keeps the items of the built-in configuration stores,
+// which load all their items at once, and notifies the subscribers of the
+// items which changed between loads.
+package snapshot
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/dapr/components-contrib/configuration"
+	configurationLoader "github.com/dapr/dapr/pkg/components/configuration"
+	"github.com/dapr/kit/logger"
+)
+
+type subscription struct {
+	keys    []string
+	handler configuration.UpdateHandler
+}
+
+// Snapshot is the last loaded set of items of a configuration store.
+type Snapshot struct {
+	log           logger.Logger
+	lock          sync.RWMutex
+	items         map[string]*configuration.Item
+	subscriptions map[string]*subscription
+}
+
+func New(log logger.Logger) *Snapshot {
+	return &Snapshot{
+		log:           log,
+		items:         make(map[string]*configuration.Item),
+		subscriptions: make(map[string]*subscription),
+	}
+}
+
+// Get returns the items with the keys of the request, or all of them if the
+// request has no keys. Missing keys are ignored.
+func (s *Snapshot) Get(req *configuration.GetRequest) *configuration.GetResponse {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	res := &configuration.GetResponse{Items: make(map[string]*configuration.Item)}
+	if len(req.Keys) == 0 {
+		maps.Copy(res.Items, s.items)
+		return res
+	}
+	for _, key := range req.Keys {
+		if item, ok := s.items[key]; ok {
+			res.Items[key] = item
+		}
+	}
+	return res
+}
+
+// List returns a page of the items matching the filters of the request,
+// without copying the items which aren't in the page.
+func (s *Snapshot) List(req *configurationLoader.ListRequest) (*configurationLoader.ListResponse, error) {
+	// The items are replaced, not modified, by Update.
+	s.lock.RLock()
+	items := s.items
+	s.lock.RUnlock()
+	return configurationLoader.Page(items, req)
+}
+
+// Update replaces the items, and sends the items which were added, changed or
+// deleted to the subscribers. Deleted items are sent with an empty value and
+// version.
+func (s *Snapshot) Update(ctx context.Context, items map[string]*configuration.Item) {
+	s.lock.Lock()
+	changed := make(map[string]*configuration.Item)
+	for key, item := range items {
+		if old, ok := s.items[key]; !ok || old.Version != item.Version || old.Value != item.Value {
+			changed[key] = item
+		}
+	}
+	for key := range s.items {
+		if _, ok := items[key]; !ok {
+			changed[key] = &configuration.Item{}
+		}
+	}
+	s.items = items
+	subscriptions := make(map[string]*subscription, len(s.subscriptions))
+	maps.Copy(subscriptions, s.subscriptions)
+	s.lock.Unlock()
+
+	if len(changed) == 0 {
+		return
+	}
+	for id, sub := range subscriptions {
+		e := &configuration.UpdateEvent{ID: id, Items: make(map[string]*configuration.Item)}
+		for key, item := range changed {
+			if len(sub.keys) == 0 || slices.Contains(sub.keys, key) {
+				e.Items[key] = item
+			}
+		}
+		if len(e.Items) == 0 {
+			continue
+		}
+		if err := sub.handler(ctx, e); err != nil {
+			s.log.Errorf("Failed to send configuration update to subscription %s: %v", id, err)
+		}
+	}
+}
+
+// Subscribe adds a subscription to the items with the keys of the request, or
+// to all of them if the request has no keys, and returns its ID.
+func (s *Snapshot) Subscribe(req *configuration.SubscribeRequest, handler configuration.UpdateHandler) (string, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate subscription ID: %w", err)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.subscriptions[id.String()] = &subscription{
+		keys:    slices.Clone(req.Keys),
+		handler: handler,
+	}
+	return id.String(), nil
+}
+
+// Unsubscribe removes a subscription.
+func (s *Snapshot) Unsubscribe(req *configuration.UnsubscribeRequest) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.subscriptions[req.ID]; !ok {
+		return fmt.Errorf("subscription %s not found", req.ID)
+	}
+	delete(s.subscriptions, req.ID)
+	return nil
+}
+
+// Version returns the version of an item which has none, which changes with
+// its value.
+func Version(value string) string {
+	h := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(h[:8])
+}
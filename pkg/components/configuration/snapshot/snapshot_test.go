@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/configuration"
+	configurationLoader "github.com/dapr/dapr/pkg/components/configuration"
+	"github.com/dapr/kit/logger"
+)
+
+func TestSnapshot(t *testing.T) {
+	s := New(logger.NewLogger("test"))
+	s.Update(t.Context(), map[string]*configuration.Item{
+		"a": {Value: "1", Version: Version("1")},
+		"b": {Value: "2", Version: Version("2")},
+	})
+
+	assert.Len(t, s.Get(&configuration.GetRequest{}).Items, 2)
+	assert.Equal(t, map[string]*configuration.Item{
+		"a": {Value: "1", Version: Version("1")},
+	}, s.Get(&configuration.GetRequest{Keys: []string{"a", "missing"}}).Items)
+
+	var allEvents, bEvents []*configuration.UpdateEvent
+	allID, err := s.Subscribe(&configuration.SubscribeRequest{}, func(_ context.Context, e *configuration.UpdateEvent) error {
+		allEvents = append(allEvents, e)
+		return nil
+	})
+	require.NoError(t, err)
+	bID, err := s.Subscribe(&configuration.SubscribeRequest{Keys: []string{"b"}}, func(_ context.Context, e *configuration.UpdateEvent) error {
+		bEvents = append(bEvents, e)
+		return nil
+	})
+	require.NoError(t, err)
+
+	t.Run("changed and deleted items are sent", func(t *testing.T) {
+		s.Update(t.Context(), map[string]*configuration.Item{
+			"a": {Value: "1", Version: Version("1")},
+			"c": {Value: "3", Version: Version("3")},
+		})
+		require.Len(t, allEvents, 1)
+		assert.Equal(t, allID, allEvents[0].ID)
+		assert.Equal(t, map[string]*configuration.Item{
+			"b": {},
+			"c": {Value: "3", Version: Version("3")},
+		}, allEvents[0].Items)
+		require.Len(t, bEvents, 1)
+		assert.Equal(t, map[string]*configuration.Item{"b": {}}, bEvents[0].Items)
+	})
+
+	t.Run("no events without changes", func(t *testing.T) {
+		s.Update(t.Context(), map[string]*configuration.Item{
+			"a": {Value: "1", Version: Version("1")},
+			"c": {Value: "3", Version: Version("3")},
+		})
+		assert.Len(t, allEvents, 1)
+	})
+
+	t.Run("unsubscribe", func(t *testing.T) {
+		require.NoError(t, s.Unsubscribe(&configuration.UnsubscribeRequest{ID: bID}))
+		require.Error(t, s.Unsubscribe(&configuration.UnsubscribeRequest{ID: bID}))
+
+		s.Update(t.Context(), map[string]*configuration.Item{
+			"b": {Value: "4", Version: Version("4")},
+		})
+		assert.Len(t, allEvents, 2)
+		assert.Len(t, bEvents, 1)
+	})
+}
+
+func TestList(t *testing.T) {
+	s := New(logger.NewLogger("test"))
+	s.Update(t.Context(), map[string]*configuration.Item{
+		"app/a":   {Value: "1", Metadata: map[string]string{"env": "prod"}},
+		"app/b":   {Value: "2", Metadata: map[string]string{"env": "dev"}},
+		"app/c":   {Value: "3", Metadata: map[string]string{"env": "prod"}},
+		"infra/d": {Value: "4", Metadata: map[string]string{"env": "prod"}},
+	})
+
+	req := &configurationLoader.ListRequest{
+		Filter: configurationLoader.Filter{
+			Prefixes: []string{"app/"},
+			Labels:   map[string]string{"env": "prod"},
+		},
+		PageSize: 1,
+	}
+	res, err := s.List(req)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]*configuration.Item{
+		"app/a": {Value: "1", Metadata: map[string]string{"env": "prod"}},
+	}, res.Items)
+	require.NotEmpty(t, res.ContinuationToken)
+
+	req.ContinuationToken = res.ContinuationToken
+	res, err = s.List(req)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]*configuration.Item{
+		"app/c": {Value: "3", Metadata: map[string]string{"env": "prod"}},
+	}, res.Items)
+	assert.Empty(t, res.ContinuationToken)
+
+	_, err = s.List(&configurationLoader.ListRequest{ContinuationToken: "!"})
+	require.ErrorIs(t, err, configurationLoader.ErrInvalidContinuationToken)
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configuration
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+
+	"github.com/dapr/components-contrib/configuration"
+)
+
+// Item metadata keys declaring the type of the value of a configuration item.
+const (
+	// ItemMetadataKeyContentType is the content type of the value. Values of
+	// JSON content types must be valid JSON documents.
+	ItemMetadataKeyContentType = "contentType"
+	// ItemMetadataKeySchema is an inline JSON schema the value must match. It
+	// implies a JSON content type.
+	ItemMetadataKeySchema = "schema"
+)
+
+// ErrInvalidItem is returned when the value of a configuration item does not
+// match its content type or schema.
+var ErrInvalidItem = errors.New("configuration item does not match its declared type")
+
+// InvalidItem is an item of an update event which failed validation.
+type InvalidItem struct {
+	Key  string
+	Item *configuration.Item
+	Err  error
+}
+
+// InvalidHandler receives the items of an update event which failed
+// validation, sorted by key.
+type InvalidHandler func(ctx context.Context, id string, items []InvalidItem)
+
+// Validator validates configuration items against the content type and schema
+// declared in their metadata. Items which declare neither are always valid.
+// Compiled schemas are cached, so a Validator should live as long as the
+// subscription it validates.
+type Validator struct {
+	lock    sync.Mutex
+	schemas map[string]*jsonschema.Schema
+}
+
+// NewValidator returns a new Validator.
+func NewValidator() *Validator {
+	return &Validator{
+		schemas: make(map[string]*jsonschema.Schema),
+	}
+}
+
+// Validate returns an error wrapping ErrInvalidItem if the item does not match
+// its declared content type or schema.
+func (v *Validator) Validate(item *configuration.Item) error {
+	if item == nil {
+		return nil
+	}
+	contentType := item.Metadata[ItemMetadataKeyContentType]
+	schemaText := item.Metadata[ItemMetadataKeySchema]
+	if contentType == "" && schemaText == "" {
+		return nil
+	}
+
+	if contentType != "" {
+		mediaType, _, err := mime.ParseMediaType(contentType)
+		if err != nil {
+			return fmt.Errorf("%w: invalid content type '%s': %s", ErrInvalidItem, contentType, err)
+		}
+		if !isJSONMediaType(mediaType) {
+			if schemaText != "" {
+				return fmt.Errorf("%w: a schema requires a JSON content type, got '%s'", ErrInvalidItem, contentType)
+			}
+			return nil
+		}
+	}
+
+	var doc any
+	dec := json.NewDecoder(strings.NewReader(item.Value))
+	dec.UseNumber()
+	if err := dec.Decode(&doc); err != nil {
+		return fmt.Errorf("%w: invalid JSON value: %s", ErrInvalidItem, err)
+	}
+	if dec.More() {
+		return fmt.Errorf("%w: invalid JSON value: unexpected data after the document", ErrInvalidItem)
+	}
+	if schemaText == "" {
+		return nil
+	}
+
+	schema, err := v.compile(schemaText)
+	if err != nil {
+		return fmt.Errorf("%w: invalid schema: %s", ErrInvalidItem, err)
+	}
+	if err := schema.Validate(doc); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidItem, err)
+	}
+	return nil
+}
+
+func (v *Validator) compile(schemaText string) (*jsonschema.Schema, error) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if schema, ok := v.schemas[schemaText]; ok {
+		return schema, nil
+	}
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource("schema.json", strings.NewReader(schemaText)); err != nil {
+		return nil, err
+	}
+	schema, err := compiler.Compile("schema.json")
+	if err != nil {
+		return nil, err
+	}
+	v.schemas[schemaText] = schema
+	return schema, nil
+}
+
+func isJSONMediaType(mediaType string) bool {
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// ValidateHandler returns an update handler which only receives the valid
+// items of the events, and no events without valid items. The items failing
+// validation are passed to onInvalid, if not nil, so that a bad configuration
+// push never reaches the subscriber.
+func ValidateHandler(handler configuration.UpdateHandler, onInvalid InvalidHandler) configuration.UpdateHandler {
+	validator := NewValidator()
+	return func(ctx context.Context, e *configuration.UpdateEvent) error {
+		var invalid []InvalidItem
+		items := make(map[string]*configuration.Item, len(e.Items))
+		for key, item := range e.Items {
+			if err := validator.Validate(item); err != nil {
+				invalid = append(invalid, InvalidItem{Key: key, Item: item, Err: err})
+				continue
+			}
+			items[key] = item
+		}
+		if len(invalid) > 0 && onInvalid != nil {
+			slices.SortFunc(invalid, func(a, b InvalidItem) int {
+				return strings.Compare(a.Key, b.Key)
+			})
+			onInvalid(ctx, e.ID, invalid)
+		}
+		if len(items) == 0 {
+			return nil
+		}
+		if len(invalid) == 0 {
+			return handler(ctx, e)
+		}
+		return handler(ctx, &configuration.UpdateEvent{ID: e.ID, Items: items})
+	}
+}
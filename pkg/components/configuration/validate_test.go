@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configuration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/configuration"
+)
+
+const portSchema = `{"type": "object", "properties": {"port": {"type": "integer", "minimum": 1}}, "required": ["port"]}`
+
+func TestValidatorValidate(t *testing.T) {
+	v := NewValidator()
+
+	tests := map[string]struct {
+		item  *configuration.Item
+		valid bool
+	}{
+		"untyped": {
+			item:  &configuration.Item{Value: "{not json"},
+			valid: true,
+		},
+		"plain text": {
+			item:  &configuration.Item{Value: "{not json", Metadata: map[string]string{"contentType": "text/plain"}},
+			valid: true,
+		},
+		"json": {
+			item:  &configuration.Item{Value: `{"a": 1}`, Metadata: map[string]string{"contentType": "application/json; charset=utf-8"}},
+			valid: true,
+		},
+		"invalid json": {
+			item:  &configuration.Item{Value: "{not json", Metadata: map[string]string{"contentType": "application/json"}},
+			valid: false,
+		},
+		"trailing data": {
+			item:  &configuration.Item{Value: `{} {}`, Metadata: map[string]string{"contentType": "application/merge-patch+json"}},
+			valid: false,
+		},
+		"matches schema": {
+			item:  &configuration.Item{Value: `{"port": 8080}`, Metadata: map[string]string{"schema": portSchema}},
+			valid: true,
+		},
+		"does not match schema": {
+			item:  &configuration.Item{Value: `{"port": "http"}`, Metadata: map[string]string{"schema": portSchema}},
+			valid: false,
+		},
+		"schema of non-JSON type": {
+			item:  &configuration.Item{Value: `{"port": 8080}`, Metadata: map[string]string{"contentType": "text/plain", "schema": portSchema}},
+			valid: false,
+		},
+		"invalid schema": {
+			item:  &configuration.Item{Value: `{}`, Metadata: map[string]string{"schema": `{"type": 42}`}},
+			valid: false,
+		},
+		"invalid content type": {
+			item:  &configuration.Item{Value: `{}`, Metadata: map[string]string{"contentType": "/"}},
+			valid: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := v.Validate(tc.item)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrInvalidItem)
+			}
+		})
+	}
+}
+
+func TestValidateHandler(t *testing.T) {
+	var received []*configuration.UpdateEvent
+	var invalid []InvalidItem
+	handler := ValidateHandler(func(_ context.Context, e *configuration.UpdateEvent) error {
+		received = append(received, e)
+		return nil
+	}, func(_ context.Context, id string, items []InvalidItem) {
+		assert.Equal(t, "sub", id)
+		invalid = append(invalid, items...)
+	})
+
+	typed := map[string]string{"schema": portSchema}
+	require.NoError(t, handler(t.Context(), &configuration.UpdateEvent{ID: "sub", Items: map[string]*configuration.Item{
+		"good":  {Value: `{"port": 80}`, Metadata: typed},
+		"bad2":  {Value: `{"port": 0}`, Metadata: typed},
+		"bad1":  {Value: `{}`, Metadata: typed},
+		"plain": {Value: "v"},
+	}}))
+	require.NoError(t, handler(t.Context(), &configuration.UpdateEvent{ID: "sub", Items: map[string]*configuration.Item{
+		"bad3": {Value: `[]`, Metadata: typed},
+	}}))
+
+	require.Len(t, received, 1, "events without valid items are not delivered")
+	assert.Equal(t, "sub", received[0].ID)
+	assert.Len(t, received[0].Items, 2)
+	assert.Contains(t, received[0].Items, "good")
+	assert.Contains(t, received[0].Items, "plain")
+
+	require.Len(t, invalid, 3)
+	assert.Equal(t, "bad1", invalid[0].Key)
+	assert.Equal(t, "bad2", invalid[1].Key)
+	assert.Equal(t, "bad3", invalid[2].Key)
+	for _, item := range invalid {
+		require.ErrorIs(t, item.Err, ErrInvalidItem)
+	}
+}
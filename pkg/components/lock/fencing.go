@@ -0,0 +1,42 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lock
+
+import (
+	"context"
+
+	"github.com/dapr/components-contrib/lock"
+)
+
+// FencedLocker is implemented by the lock stores which return a fencing token
+// when a lock is acquired. Fencing tokens increase monotonically for each
+// lock, so the systems receiving writes from the lock holders can reject the
+// ones of stale holders.
+type FencedLocker interface {
+	TryLockFenced(ctx context.Context, req *lock.TryLockRequest) (*FencedTryLockResponse, error)
+}
+
+// FencedTryLockResponse is the response of a lock acquisition with its fencing
+// token.
+type FencedTryLockResponse struct {
+	Success      bool              `json:"success"`
+	FencingToken int64             `json:"fencingToken"`
+	Metadata     map[string]string `json:"metadata"`
+}
+
+// FencingTokenKey returns the key of the sequence of the fencing tokens of the
+// lock, in the state store configured for the lock store.
+func FencingTokenKey(modifiedLockKey string) string {
+	return modifiedLockKey + separator + "fencingtoken"
+}
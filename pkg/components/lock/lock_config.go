@@ -9,6 +9,20 @@ import (
 const (
 	strategyKey = "keyprefix"
 
+	// fencingTokenStateStoreKey is the metadata property with the name of the
+	// state store keeping the sequences of the fencing tokens, for lock stores
+	// which don't return fencing tokens themselves.
+	fencingTokenStateStoreKey = "fencingtokenstatestore"
+
+	// readWriteLockStateStoreKey is the metadata property with the name of the
+	// state store keeping the readers and writers of the locks, for lock stores
+	// which don't support shared locks themselves.
+	readWriteLockStateStoreKey = "readwritelockstatestore"
+
+	// readWriteLockFairnessKey is the metadata property with the fairness policy
+	// of the shared locks.
+	readWriteLockFairnessKey = "readwritelockfairness"
+
 	strategyAppid     = "appid"
 	strategyStoreName = "name"
 	strategyNone      = "none"
@@ -24,15 +38,26 @@ var (
 )
 
 type StoreConfiguration struct {
-	keyPrefixStrategy string
+	keyPrefixStrategy       string
+	fencingTokenStateStore  string
+	readWriteLockStateStore string
+	readWriteLockFairness   string
 }
 
 func SaveLockConfiguration(storeName string, metadata map[string]string) error {
 	strategy := strategyDefault
+	fairness := FairnessReaderPreferred
+	var fencingTokenStateStore, readWriteLockStateStore string
 	for k, v := range metadata {
-		if strings.ToLower(k) == strategyKey { //nolint:gocritic
+		switch strings.ToLower(k) {
+		case strategyKey:
 			strategy = strings.ToLower(v)
-			break
+		case fencingTokenStateStoreKey:
+			fencingTokenStateStore = v
+		case readWriteLockStateStoreKey:
+			readWriteLockStateStore = v
+		case readWriteLockFairnessKey:
+			fairness = v
 		}
 	}
 
@@ -40,13 +65,36 @@ func SaveLockConfiguration(storeName string, metadata map[string]string) error {
 	if err != nil {
 		return err
 	}
+	if fairness != FairnessReaderPreferred && fairness != FairnessWriterPreferred {
+		return fmt.Errorf("invalid read-write lock fairness '%s': must be '%s' or '%s'", fairness, FairnessReaderPreferred, FairnessWriterPreferred)
+	}
 
 	locksConfigurationMu.Lock()
-	lockConfiguration[storeName] = &StoreConfiguration{keyPrefixStrategy: strategy}
+	lockConfiguration[storeName] = &StoreConfiguration{
+		keyPrefixStrategy:       strategy,
+		fencingTokenStateStore:  fencingTokenStateStore,
+		readWriteLockStateStore: readWriteLockStateStore,
+		readWriteLockFairness:   fairness,
+	}
 	locksConfigurationMu.Unlock()
 	return nil
 }
 
+// GetFencingTokenStateStore returns the name of the state store keeping the
+// sequences of the fencing tokens of the lock store, or an empty string if
+// none is configured.
+func GetFencingTokenStateStore(storeName string) string {
+	return getConfiguration(storeName).fencingTokenStateStore
+}
+
+// GetReadWriteLockConfiguration returns the name of the state store keeping the
+// readers and writers of the locks of the lock store, or an empty string if
+// none is configured, and the fairness policy of its shared locks.
+func GetReadWriteLockConfiguration(storeName string) (stateStore string, fairness string) {
+	c := getConfiguration(storeName)
+	return c.readWriteLockStateStore, c.readWriteLockFairness
+}
+
 func GetModifiedLockKey(key, storeName, appID string) (string, error) {
 	if err := checkKeyIllegal(key); err != nil {
 		return "", err
@@ -90,7 +138,10 @@ func getConfiguration(storeName string) *StoreConfiguration {
 		return c
 	}
 
-	c = &StoreConfiguration{keyPrefixStrategy: strategyDefault}
+	c = &StoreConfiguration{
+		keyPrefixStrategy:     strategyDefault,
+		readWriteLockFairness: FairnessReaderPreferred,
+	}
 	lockConfiguration[storeName] = c
 
 	return c
@@ -104,3 +104,33 @@ func TestPrefix_StoreNotInitial(t *testing.T) {
 	modifiedLockKey, _ := GetModifiedLockKey(key, "store999", "appid99")
 	require.Equal(t, "lock||appid99||lock-key-1234567", modifiedLockKey)
 }
+
+func TestGetFencingTokenStateStore(t *testing.T) {
+	require.NoError(t, SaveLockConfiguration("store7", map[string]string{
+		"keyPrefix":              "none",
+		"fencingTokenStateStore": "statestore1",
+	}))
+	require.Equal(t, "statestore1", GetFencingTokenStateStore("store7"))
+	require.Empty(t, GetFencingTokenStateStore("store1"))
+
+	modifiedLockKey, _ := GetModifiedLockKey(key, "store7", "appid1")
+	require.Equal(t, "lock||lock-key-1234567||fencingtoken", FencingTokenKey(modifiedLockKey))
+}
+
+func TestGetReadWriteLockConfiguration(t *testing.T) {
+	require.NoError(t, SaveLockConfiguration("store8", map[string]string{
+		"readWriteLockStateStore": "statestore1",
+		"readWriteLockFairness":   FairnessWriterPreferred,
+	}))
+	stateStore, fairness := GetReadWriteLockConfiguration("store8")
+	require.Equal(t, "statestore1", stateStore)
+	require.Equal(t, FairnessWriterPreferred, fairness)
+
+	stateStore, fairness = GetReadWriteLockConfiguration("store1")
+	require.Empty(t, stateStore)
+	require.Equal(t, FairnessReaderPreferred, fairness)
+
+	require.Error(t, SaveLockConfiguration("store9", map[string]string{
+		"readWriteLockFairness": "random",
+	}))
+}
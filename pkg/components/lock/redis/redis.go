@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package redis extends the standalone Redis lock store with native shared
+// locks.
+package redis
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	rediscomponent "github.com/dapr/components-contrib/common/component/redis"
+	"github.com/dapr/components-contrib/lock"
+	contribRedis "github.com/dapr/components-contrib/lock/redis"
+	contribMetadata "github.com/dapr/components-contrib/metadata"
+	lockLoader "github.com/dapr/dapr/pkg/components/lock"
+	"github.com/dapr/kit/logger"
+)
+
+// The exclusive lock is the key of the lock, like in the lock store of
+// components-contrib. The owners of the shared lock are the fields of a hash,
+// with the expiration of their leases in milliseconds of the Redis server
+// time. With the writer-preferred fairness, a writer refused because of the
+// readers sets a key which refuses new readers until it expires.
+const (
+	readersKeySuffix       = "||readers"
+	writerWaitingKeySuffix = "||writerwaiting"
+)
+
+const nowScript = `local t = redis.call("TIME"); local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000);`
+
+// KEYS: lock, readers, writer waiting. ARGV: owner, expiry in ms, "1" if
+// writers are preferred.
+const tryLockScript = nowScript + `
+local readers = redis.call("HGETALL", KEYS[2])
+for i = 1, #readers, 2 do
+	if tonumber(readers[i + 1]) > now then
+		if ARGV[3] == "1" then redis.call("SET", KEYS[3], ARGV[1], "PX", ARGV[2]) end
+		return 0
+	end
+end
+if not redis.call("SET", KEYS[1], ARGV[1], "NX", "PX", ARGV[2]) then return 0 end
+redis.call("DEL", KEYS[3])
+return 1`
+
+// KEYS: lock, readers, writer waiting. ARGV: owner, expiry in ms, "1" if
+// writers are preferred.
+const tryLockSharedScript = nowScript + `
+if redis.call("EXISTS", KEYS[1]) == 1 then return 0 end
+local expiresAt = now + tonumber(ARGV[2])
+local keyExpiresAt = expiresAt
+local isReader = false
+local readers = redis.call("HGETALL", KEYS[2])
+for i = 1, #readers, 2 do
+	local readerExpiresAt = tonumber(readers[i + 1])
+	if readerExpiresAt <= now then
+		redis.call("HDEL", KEYS[2], readers[i])
+	else
+		if readers[i] == ARGV[1] then isReader = true end
+		if readerExpiresAt > keyExpiresAt then keyExpiresAt = readerExpiresAt end
+	end
+end
+if not isReader and ARGV[3] == "1" and redis.call("EXISTS", KEYS[3]) == 1 then return 0 end
+redis.call("HSET", KEYS[2], ARGV[1], string.format("%d", expiresAt))
+redis.call("PEXPIREAT", KEYS[2], string.format("%d", keyExpiresAt))
+return 1`
+
+// KEYS: readers. ARGV: owner.
+const unlockSharedScript = nowScript + `
+local expiresAt = redis.call("HGET", KEYS[1], ARGV[1])
+if not expiresAt then return -1 end
+redis.call("HDEL", KEYS[1], ARGV[1])
+if tonumber(expiresAt) <= now then return -1 end
+return 0`
+
+var _ lockLoader.RWLocker = (*store)(nil)
+
+type store struct {
+	lock.Store
+
+	log    logger.Logger
+	client rediscomponent.RedisClient
+}
+
+// NewStandaloneRedisLock returns the standalone Redis lock store of
+// components-contrib, which also supports shared locks.
+func NewStandaloneRedisLock(log logger.Logger) lock.Store {
+	return &store{
+		Store: contribRedis.NewStandaloneRedisLock(log),
+		log:   log,
+	}
+}
+
+func (s *store) InitLockStore(ctx context.Context, metadata lock.Metadata) error {
+	if err := s.Store.InitLockStore(ctx, metadata); err != nil {
+		return err
+	}
+	client, _, err := rediscomponent.ParseClientFromProperties(metadata.Properties, contribMetadata.LockStoreType, ctx, &s.log)
+	if err != nil {
+		return err
+	}
+	s.client = client
+	return nil
+}
+
+// TryLock acquires the exclusive lock, unless the shared lock is held.
+func (s *store) TryLock(ctx context.Context, req *lock.TryLockRequest) (*lock.TryLockResponse, error) {
+	res, err := s.eval(ctx, tryLockScript, req.ResourceID, req.LockOwner, req.ExpiryInSeconds, req.Metadata)
+	if err != nil {
+		return &lock.TryLockResponse{}, err
+	}
+	return &lock.TryLockResponse{Success: res == 1}, nil
+}
+
+// TryLockShared acquires the shared lock, unless the exclusive lock is held
+// or, with the writer-preferred fairness, a writer is waiting for the readers.
+func (s *store) TryLockShared(ctx context.Context, req *lock.TryLockRequest) (*lock.TryLockResponse, error) {
+	res, err := s.eval(ctx, tryLockSharedScript, req.ResourceID, req.LockOwner, req.ExpiryInSeconds, req.Metadata)
+	if err != nil {
+		return &lock.TryLockResponse{}, err
+	}
+	return &lock.TryLockResponse{Success: res == 1}, nil
+}
+
+// UnlockShared releases the shared lock of the owner.
+func (s *store) UnlockShared(ctx context.Context, req *lock.UnlockRequest) (*lock.UnlockResponse, error) {
+	res, parseErr, err := s.client.EvalInt(ctx, unlockSharedScript, []string{req.ResourceID + readersKeySuffix}, req.LockOwner)
+	if err == nil {
+		err = parseErr
+	}
+	if err == nil && res == nil {
+		err = errors.New("eval unlock shared script returned a nil response")
+	}
+	if err != nil {
+		return &lock.UnlockResponse{Status: lock.InternalError}, err
+	}
+	if *res < 0 {
+		return &lock.UnlockResponse{Status: lock.LockDoesNotExist}, nil
+	}
+	return &lock.UnlockResponse{Status: lock.Success}, nil
+}
+
+func (s *store) eval(ctx context.Context, script string, resourceID string, owner string, expiryInSeconds int32, md map[string]string) (int, error) {
+	writerPreferred := "0"
+	if md[lockLoader.FairnessMetadataKey] == lockLoader.FairnessWriterPreferred {
+		writerPreferred = "1"
+	}
+	keys := []string{resourceID, resourceID + readersKeySuffix, resourceID + writerWaitingKeySuffix}
+	expiry := (time.Duration(expiryInSeconds) * time.Second).Milliseconds()
+	res, parseErr, err := s.client.EvalInt(ctx, script, keys, owner, expiry, writerPreferred)
+	if err == nil {
+		err = parseErr
+	}
+	if err == nil && res == nil {
+		err = errors.New("eval script returned a nil response")
+	}
+	if err != nil {
+		return 0, err
+	}
+	return *res, nil
+}
+
+func (s *store) Close() error {
+	errs := []error{s.Store.Close()}
+	if s.client != nil {
+		errs = append(errs, s.client.Close())
+		s.client = nil
+	}
+	return errors.Join(errs...)
+}
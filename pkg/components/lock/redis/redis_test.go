@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"testing"
+	"time"
+
+	miniredis "github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/lock"
+	"github.com/dapr/components-contrib/metadata"
+	lockLoader "github.com/dapr/dapr/pkg/components/lock"
+	"github.com/dapr/kit/logger"
+)
+
+func newTestStore(t *testing.T) (*store, *miniredis.Miniredis) {
+	t.Helper()
+	s := miniredis.RunT(t)
+	comp := NewStandaloneRedisLock(logger.NewLogger("test")).(*store)
+	t.Cleanup(func() { comp.Close() })
+	require.NoError(t, comp.InitLockStore(t.Context(), lock.Metadata{Base: metadata.Base{
+		Properties: map[string]string{"redisHost": s.Addr()},
+	}}))
+	return comp, s
+}
+
+func TestSharedLocks(t *testing.T) {
+	comp, s := newTestStore(t)
+
+	tryLock := func(t *testing.T, owner string, md map[string]string) bool {
+		t.Helper()
+		res, err := comp.TryLock(t.Context(), &lock.TryLockRequest{ResourceID: "r1", LockOwner: owner, ExpiryInSeconds: 10, Metadata: md})
+		require.NoError(t, err)
+		return res.Success
+	}
+	tryLockShared := func(t *testing.T, owner string, md map[string]string) bool {
+		t.Helper()
+		res, err := comp.TryLockShared(t.Context(), &lock.TryLockRequest{ResourceID: "r1", LockOwner: owner, ExpiryInSeconds: 10, Metadata: md})
+		require.NoError(t, err)
+		return res.Success
+	}
+	unlockShared := func(t *testing.T, owner string) lock.Status {
+		t.Helper()
+		res, err := comp.UnlockShared(t.Context(), &lock.UnlockRequest{ResourceID: "r1", LockOwner: owner})
+		require.NoError(t, err)
+		return res.Status
+	}
+
+	t.Run("readers share the lock and exclude writers", func(t *testing.T) {
+		assert.True(t, tryLockShared(t, "reader1", nil))
+		assert.True(t, tryLockShared(t, "reader2", nil))
+		assert.False(t, tryLock(t, "writer", nil))
+
+		assert.Equal(t, lock.Success, unlockShared(t, "reader1"))
+		assert.Equal(t, lock.Success, unlockShared(t, "reader2"))
+		assert.Equal(t, lock.LockDoesNotExist, unlockShared(t, "reader2"))
+
+		assert.True(t, tryLock(t, "writer", nil))
+	})
+
+	t.Run("writers exclude readers", func(t *testing.T) {
+		assert.False(t, tryLockShared(t, "reader1", nil))
+
+		res, err := comp.Unlock(t.Context(), &lock.UnlockRequest{ResourceID: "r1", LockOwner: "writer"})
+		require.NoError(t, err)
+		assert.Equal(t, lock.Success, res.Status)
+
+		assert.True(t, tryLockShared(t, "reader1", nil))
+		assert.Equal(t, lock.Success, unlockShared(t, "reader1"))
+	})
+
+	t.Run("writer-preferred fairness refuses new readers", func(t *testing.T) {
+		md := map[string]string{lockLoader.FairnessMetadataKey: lockLoader.FairnessWriterPreferred}
+		assert.True(t, tryLockShared(t, "reader1", md))
+		assert.False(t, tryLock(t, "writer", md))
+
+		// The writer is waiting: only the readers which hold the lock can renew it.
+		assert.False(t, tryLockShared(t, "reader2", md))
+		assert.True(t, tryLockShared(t, "reader1", md))
+
+		assert.Equal(t, lock.Success, unlockShared(t, "reader1"))
+		assert.True(t, tryLock(t, "writer", md))
+		_, err := comp.Unlock(t.Context(), &lock.UnlockRequest{ResourceID: "r1", LockOwner: "writer"})
+		require.NoError(t, err)
+		assert.True(t, tryLockShared(t, "reader2", md))
+		assert.Equal(t, lock.Success, unlockShared(t, "reader2"))
+	})
+
+	t.Run("leases of readers expire", func(t *testing.T) {
+		assert.True(t, tryLockShared(t, "reader1", nil))
+
+		s.SetTime(time.Now().Add(11 * time.Second))
+		assert.True(t, tryLock(t, "writer", nil))
+		assert.Equal(t, lock.LockDoesNotExist, unlockShared(t, "reader1"))
+	})
+}
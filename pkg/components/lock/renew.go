@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lock
+
+import (
+	"context"
+
+	"github.com/dapr/components-contrib/lock"
+)
+
+// Renewer is implemented by the lock stores which can extend the lease of a
+// lock held by its owner.
+type Renewer interface {
+	RenewLock(ctx context.Context, req *RenewLockRequest) (*RenewLockResponse, error)
+}
+
+// RenewLockRequest is a request to extend the lease of a lock, which expires
+// ExpiryInSeconds after the renewal.
+type RenewLockRequest struct {
+	ResourceID      string            `json:"resourceId"`
+	LockOwner       string            `json:"lockOwner"`
+	ExpiryInSeconds int32             `json:"expiryInSeconds"`
+	Metadata        map[string]string `json:"metadata"`
+}
+
+// RenewLockResponse is the status of a lock renewal: lock.Success, or
+// lock.LockDoesNotExist if the lease has expired already, or
+// lock.LockBelongsToOthers.
+type RenewLockResponse struct {
+	Status   lock.Status       `json:"status"`
+	Metadata map[string]string `json:"metadata"`
+}
+
+// RenewByReacquiring renews a lock of a store which doesn't implement Renewer
+// by releasing it and acquiring it again for the same owner. The renewal isn't
+// atomic: if another owner acquires the lock after it's released, the status
+// is lock.LockBelongsToOthers.
+func RenewByReacquiring(ctx context.Context, store lock.Store, req *RenewLockRequest) (*RenewLockResponse, error) {
+	unlockResp, err := store.Unlock(ctx, &lock.UnlockRequest{
+		ResourceID: req.ResourceID,
+		LockOwner:  req.LockOwner,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if unlockResp != nil && unlockResp.Status != lock.Success {
+		return &RenewLockResponse{Status: unlockResp.Status}, nil
+	}
+
+	tryLockResp, err := store.TryLock(ctx, &lock.TryLockRequest{
+		ResourceID:      req.ResourceID,
+		LockOwner:       req.LockOwner,
+		ExpiryInSeconds: req.ExpiryInSeconds,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if tryLockResp == nil || !tryLockResp.Success {
+		return &RenewLockResponse{Status: lock.LockBelongsToOthers}, nil
+	}
+	return &RenewLockResponse{Status: lock.Success}, nil
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lock
+
+import (
+	"context"
+
+	"github.com/dapr/components-contrib/lock"
+)
+
+// Fairness policies of the shared locks.
+const (
+	// FairnessReaderPreferred grants shared locks as long as no exclusive lock
+	// is held, so writers can wait indefinitely while readers keep coming.
+	FairnessReaderPreferred = "readerPreferred"
+
+	// FairnessWriterPreferred queues the writers: after an exclusive lock was
+	// refused because of the readers, new shared locks are refused until the
+	// writer acquires the lock or its attempt expires.
+	FairnessWriterPreferred = "writerPreferred"
+)
+
+// FairnessMetadataKey is the metadata of the requests to a RWLocker with the
+// fairness policy of the lock store.
+const FairnessMetadataKey = "fairness"
+
+// RWLocker is implemented by the lock stores with native shared locks, which
+// can be held by multiple owners at once but not together with an exclusive
+// lock acquired with TryLock.
+type RWLocker interface {
+	TryLockShared(ctx context.Context, req *lock.TryLockRequest) (*lock.TryLockResponse, error)
+	UnlockShared(ctx context.Context, req *lock.UnlockRequest) (*lock.UnlockResponse, error)
+}
+
+// ReadWriteLockKey returns the key of the readers and writers of the lock, in
+// the state store configured for the lock store.
+func ReadWriteLockKey(modifiedLockKey string) string {
+	return modifiedLockKey + separator + "rwlock"
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/dapr/components-contrib/pubsub"
 	"github.com/dapr/dapr/pkg/components/pluggable"
 	proto "github.com/dapr/dapr/pkg/proto/components/v1"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	"github.com/dapr/kit/logger"
 )
 
@@ -74,13 +75,27 @@ func (p *grpcPubSub) Features() []pubsub.Feature {
 
 // Publish publishes data to a topic.
 func (p *grpcPubSub) Publish(ctx context.Context, req *pubsub.PublishRequest) error {
-	_, err := p.Client.Publish(ctx, &proto.PublishRequest{
+	_, err := p.PublishWithResult(ctx, req)
+	return err
+}
+
+// PublishWithResult publishes data to a topic, and returns the identifiers the
+// broker assigned to the message.
+func (p *grpcPubSub) PublishWithResult(ctx context.Context, req *pubsub.PublishRequest) (*rtpubsub.PublishResult, error) {
+	resp, err := p.Client.Publish(ctx, &proto.PublishRequest{
 		Topic:      req.Topic,
 		PubsubName: req.PubsubName,
 		Data:       req.Data,
 		Metadata:   req.Metadata,
 	})
-	return err
+	if err != nil {
+		return nil, err
+	}
+	return &rtpubsub.PublishResult{
+		MessageID: resp.GetMessageId(),
+		Partition: resp.Partition,
+		Offset:    resp.GetOffset(),
+	}, nil
 }
 
 func (p *grpcPubSub) BulkPublish(ctx context.Context, req *pubsub.BulkPublishRequest) (pubsub.BulkPublishResponse, error) {
@@ -212,6 +227,15 @@ func (p *grpcPubSub) pullMessages(parentCtx context.Context, topic *proto.Topic,
 	return nil
 }
 
+// ListTopics lists the topics of the broker.
+func (p *grpcPubSub) ListTopics(ctx context.Context) ([]string, error) {
+	resp, err := p.Client.ListTopics(ctx, &proto.ListTopicsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetTopics(), nil
+}
+
 // Subscribe subscribes to a given topic and callback the handler when a new message arrives.
 func (p *grpcPubSub) Subscribe(ctx context.Context, req pubsub.SubscribeRequest, handler pubsub.Handler) error {
 	subscription := &proto.Topic{
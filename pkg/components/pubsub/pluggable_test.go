@@ -33,8 +33,10 @@ import (
 	"github.com/dapr/components-contrib/pubsub"
 	"github.com/dapr/dapr/pkg/components/pluggable"
 	proto "github.com/dapr/dapr/pkg/proto/components/v1"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	testingGrpc "github.com/dapr/dapr/pkg/testing/grpc"
 	"github.com/dapr/kit/logger"
+	"github.com/dapr/kit/ptr"
 )
 
 var testLogger = logger.NewLogger("pubsub-pluggable-test")
@@ -49,12 +51,15 @@ type server struct {
 	publishCalled   atomic.Int64
 	onPublishCalled func(*proto.PublishRequest)
 	publishErr      error
+	publishResp     *proto.PublishResponse
 	pullChan        chan *proto.PullMessagesResponse
 	pingCalled      atomic.Int64
 	pingErr         error
 	onAckReceived   func(*proto.PullMessagesRequest)
 	pullCalled      atomic.Int64
 	pullErr         error
+	topics          []string
+	listTopicsErr   error
 }
 
 //nolint:nosnakecase
@@ -101,6 +106,9 @@ func (s *server) Publish(_ context.Context, req *proto.PublishRequest) (*proto.P
 	if s.onPublishCalled != nil {
 		s.onPublishCalled(req)
 	}
+	if s.publishResp != nil {
+		return s.publishResp, s.publishErr
+	}
 	return &proto.PublishResponse{}, s.publishErr
 }
 
@@ -109,6 +117,10 @@ func (s *server) Ping(context.Context, *proto.PingRequest) (*proto.PingResponse,
 	return &proto.PingResponse{}, s.pingErr
 }
 
+func (s *server) ListTopics(context.Context, *proto.ListTopicsRequest) (*proto.ListTopicsResponse, error) {
+	return &proto.ListTopicsResponse{Topics: s.topics}, s.listTopicsErr
+}
+
 func TestPubSubPluggableCalls(t *testing.T) {
 	getPubSub := testingGrpc.TestServerFor(testLogger, func(s *grpc.Server, svc *server) {
 		proto.RegisterPubSubServer(s, svc)
@@ -189,6 +201,23 @@ func TestPubSubPluggableCalls(t *testing.T) {
 		assert.Equal(t, int64(1), svc.publishCalled.Load())
 	})
 
+	t.Run("publish should return the identifiers assigned by the broker", func(t *testing.T) {
+		svc := &server{
+			publishResp: &proto.PublishResponse{MessageId: "msg1", Partition: ptr.Of(int32(2)), Offset: "42"},
+		}
+		ps, cleanup, err := getPubSub(svc)
+		require.NoError(t, err)
+		defer cleanup()
+
+		var rp rtpubsub.ResultPublisher = ps
+		result, err := rp.PublishWithResult(t.Context(), &pubsub.PublishRequest{
+			Topic: "fakeTopic",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, &rtpubsub.PublishResult{MessageID: "msg1", Partition: ptr.Of(int32(2)), Offset: "42"}, result)
+	})
+
 	t.Run("publish should return an error if grpc method returns an error", func(t *testing.T) {
 		const fakeTopic = "fakeTopic"
 
@@ -210,6 +239,26 @@ func TestPubSubPluggableCalls(t *testing.T) {
 		assert.Equal(t, int64(1), svc.publishCalled.Load())
 	})
 
+	t.Run("list topics should return the topics of the component", func(t *testing.T) {
+		ps, cleanup, err := getPubSub(&server{topics: []string{"orders.eu", "orders.us"}})
+		require.NoError(t, err)
+		defer cleanup()
+
+		var lister rtpubsub.TopicLister = ps
+		topics, err := lister.ListTopics(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"orders.eu", "orders.us"}, topics)
+	})
+
+	t.Run("list topics should return an error if grpc method returns an error", func(t *testing.T) {
+		ps, cleanup, err := getPubSub(&server{listTopicsErr: errors.New("fake-list-topics-err")})
+		require.NoError(t, err)
+		defer cleanup()
+
+		_, err = ps.ListTopics(t.Context())
+		require.Error(t, err)
+	})
+
 	t.Run("subscribe should callback handler when new messages arrive", func(t *testing.T) {
 		const fakeTopic, fakeData1, fakeData2 = "fakeTopic", "fakeData1", "fakeData2"
 		var (
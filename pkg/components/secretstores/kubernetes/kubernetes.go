@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package kubernetes extends the Kubernetes secret store with the paginated
+// listing of the secrets of a namespace.
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	kubeclient "github.com/dapr/components-contrib/common/authentication/kubernetes"
+	"github.com/dapr/components-contrib/secretstores"
+	contribKubernetes "github.com/dapr/components-contrib/secretstores/kubernetes"
+	secretstoresLoader "github.com/dapr/dapr/pkg/components/secretstores"
+	"github.com/dapr/kit/logger"
+	kitmd "github.com/dapr/kit/metadata"
+)
+
+var _ secretstoresLoader.Lister = (*store)(nil)
+
+type metadata struct {
+	DefaultNamespace string `mapstructure:"defaultNamespace"`
+	KubeconfigPath   string `mapstructure:"kubeconfigPath"`
+}
+
+type store struct {
+	secretstores.SecretStore
+
+	log    logger.Logger
+	md     metadata
+	client kubernetes.Interface
+}
+
+// NewKubernetesSecretStore returns the Kubernetes secret store of
+// components-contrib, which also lists the secrets page by page.
+func NewKubernetesSecretStore(log logger.Logger) secretstores.SecretStore {
+	return &store{
+		SecretStore: contribKubernetes.NewKubernetesSecretStore(log),
+		log:         log,
+	}
+}
+
+func (s *store) Init(ctx context.Context, meta secretstores.Metadata) error {
+	if err := s.SecretStore.Init(ctx, meta); err != nil {
+		return err
+	}
+	if err := kitmd.DecodeMetadata(meta.Properties, &s.md); err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	kubeconfigPath := s.md.KubeconfigPath
+	if kubeconfigPath == "" {
+		kubeconfigPath = kubeclient.GetKubeconfigPath(s.log, os.Args)
+	}
+	client, err := kubeclient.GetKubeClient(kubeconfigPath)
+	if err != nil {
+		return err
+	}
+	s.client = client
+	return nil
+}
+
+// ListSecrets lists a page of the secrets of the namespace with the
+// continuation token of the Kubernetes API. Kubernetes can't filter by name
+// prefix, so the secrets of the page are filtered after they're listed and
+// pages can have fewer secrets than PageSize.
+func (s *store) ListSecrets(ctx context.Context, req *secretstoresLoader.ListRequest) (*secretstoresLoader.ListResponse, error) {
+	namespace, err := s.namespace(req.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets, err := s.client.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{
+		Limit:    int64(req.PageSize),
+		Continue: req.ContinuationToken,
+	})
+	if err != nil {
+		if req.ContinuationToken != "" && (apierrors.IsBadRequest(err) || apierrors.IsResourceExpired(err)) {
+			return nil, fmt.Errorf("%w: %w", secretstoresLoader.ErrInvalidContinuationToken, err)
+		}
+		return nil, err
+	}
+
+	res := &secretstoresLoader.ListResponse{
+		Data:              make(map[string]map[string]string, len(secrets.Items)),
+		ContinuationToken: secrets.Continue,
+	}
+	for _, secret := range secrets.Items {
+		if !strings.HasPrefix(secret.Name, req.Prefix) {
+			continue
+		}
+		data := make(map[string]string, len(secret.Data))
+		for k, v := range secret.Data {
+			data[k] = string(v)
+		}
+		res.Data[secret.Name] = data
+	}
+	return res, nil
+}
+
+// namespace resolves the namespace of the request like the secret store of
+// components-contrib.
+func (s *store) namespace(md map[string]string) (string, error) {
+	if ns := md["namespace"]; ns != "" {
+		return ns, nil
+	}
+	if ns := os.Getenv("NAMESPACE"); ns != "" {
+		return ns, nil
+	}
+	if s.md.DefaultNamespace != "" {
+		return s.md.DefaultNamespace, nil
+	}
+	return "", errors.New("namespace is missing on metadata and NAMESPACE env variable, and no default namespace is set")
+}
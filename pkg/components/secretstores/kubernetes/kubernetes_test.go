@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	secretstoresLoader "github.com/dapr/dapr/pkg/components/secretstores"
+)
+
+func TestListSecrets(t *testing.T) {
+	client := fake.NewClientset(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "app-a", Namespace: "ns1"},
+			Data:       map[string][]byte{"key": []byte("a")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "app-b", Namespace: "ns1"},
+			Data:       map[string][]byte{"key": []byte("b")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "ns1"},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "app-c", Namespace: "ns2"},
+		},
+	)
+	s := &store{client: client, md: metadata{DefaultNamespace: "ns1"}}
+
+	t.Run("filters by prefix", func(t *testing.T) {
+		res, err := s.ListSecrets(t.Context(), &secretstoresLoader.ListRequest{Prefix: "app-"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]map[string]string{
+			"app-a": {"key": "a"},
+			"app-b": {"key": "b"},
+		}, res.Data)
+		assert.Empty(t, res.ContinuationToken)
+	})
+
+	t.Run("namespace from metadata", func(t *testing.T) {
+		res, err := s.ListSecrets(t.Context(), &secretstoresLoader.ListRequest{
+			Metadata: map[string]string{"namespace": "ns2"},
+		})
+		require.NoError(t, err)
+		assert.Len(t, res.Data, 1)
+		assert.Contains(t, res.Data, "app-c")
+	})
+
+	t.Run("paginates with the Kubernetes continuation token", func(t *testing.T) {
+		var opts metav1.ListOptions
+		client.PrependReactor("list", "secrets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			opts = action.(k8stesting.ListActionImpl).ListOptions
+			if opts.Continue == "invalid" {
+				return true, nil, apierrors.NewBadRequest("invalid continue token")
+			}
+			return true, &corev1.SecretList{
+				ListMeta: metav1.ListMeta{Continue: "next"},
+				Items: []corev1.Secret{
+					{ObjectMeta: metav1.ObjectMeta{Name: "app-a"}},
+				},
+			}, nil
+		})
+
+		res, err := s.ListSecrets(t.Context(), &secretstoresLoader.ListRequest{
+			PageSize:          1,
+			ContinuationToken: "token",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), opts.Limit)
+		assert.Equal(t, "token", opts.Continue)
+		assert.Len(t, res.Data, 1)
+		assert.Equal(t, "next", res.ContinuationToken)
+
+		_, err = s.ListSecrets(t.Context(), &secretstoresLoader.ListRequest{ContinuationToken: "invalid"})
+		require.ErrorIs(t, err, secretstoresLoader.ErrInvalidContinuationToken)
+	})
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstores
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/dapr/components-contrib/secretstores"
+)
+
+// ErrInvalidContinuationToken is returned when the continuation token of a
+// list request wasn't returned by a previous request.
+var ErrInvalidContinuationToken = errors.New("invalid continuation token")
+
+type ListRequest struct {
+	// Prefix filters the secrets by name prefix.
+	Prefix string
+	// Regex filters the secrets by name. It's never pushed down to the
+	// store, so pages can have fewer secrets than PageSize.
+	Regex *regexp.Regexp
+	// PageSize is the maximum number of secrets in a page. Zero means all the
+	// secrets are returned in one page.
+	PageSize int
+	// ContinuationToken is returned by the previous page.
+	ContinuationToken string
+	Metadata          map[string]string
+}
+
+type ListResponse struct {
+	Data map[string]map[string]string
+	// ContinuationToken is empty when there are no more pages.
+	ContinuationToken string
+}
+
+// Lister is implemented by secret stores which can list their secrets page by
+// page, filtered by name prefix. Their continuation tokens are opaque to the
+// runtime.
+type Lister interface {
+	ListSecrets(ctx context.Context, req *ListRequest) (*ListResponse, error)
+}
+
+// List returns a page of the secrets of the store matching the filters of
+// the request. Stores implementing Lister filter by prefix and paginate
+// natively. The secrets of the other stores are fetched in bulk, then sorted
+// by name and paginated, with the name of the last secret of the page as
+// continuation token.
+func List(ctx context.Context, store secretstores.SecretStore, req *ListRequest) (*ListResponse, error) {
+	if lister, ok := store.(Lister); ok {
+		res, err := lister.ListSecrets(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if req.Regex != nil {
+			for name := range res.Data {
+				if !req.Regex.MatchString(name) {
+					delete(res.Data, name)
+				}
+			}
+		}
+		return res, nil
+	}
+
+	var after string
+	if req.ContinuationToken != "" {
+		b, err := base64.RawURLEncoding.DecodeString(req.ContinuationToken)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidContinuationToken, err)
+		}
+		after = string(b)
+	}
+
+	bulk, err := store.BulkGetSecret(ctx, secretstores.BulkGetSecretRequest{Metadata: req.Metadata})
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(bulk.Data))
+	for name := range bulk.Data {
+		if after != "" && name <= after {
+			continue
+		}
+		if !strings.HasPrefix(name, req.Prefix) {
+			continue
+		}
+		if req.Regex != nil && !req.Regex.MatchString(name) {
+			continue
+		}
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	res := &ListResponse{}
+	if req.PageSize > 0 && len(names) > req.PageSize {
+		names = names[:req.PageSize]
+		res.ContinuationToken = base64.RawURLEncoding.EncodeToString([]byte(names[len(names)-1]))
+	}
+	res.Data = make(map[string]map[string]string, len(names))
+	for _, name := range names {
+		res.Data[name] = bulk.Data[name]
+	}
+	return res, nil
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstores
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/secretstores"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+// bulkStore returns a fixed set of secrets in bulk.
+type bulkStore struct {
+	daprt.FakeSecretStore
+	data map[string]map[string]string
+}
+
+func (s *bulkStore) BulkGetSecret(context.Context, secretstores.BulkGetSecretRequest) (secretstores.BulkGetSecretResponse, error) {
+	return secretstores.BulkGetSecretResponse{Data: s.data}, nil
+}
+
+// listerStore lists its secrets natively.
+type listerStore struct {
+	daprt.FakeSecretStore
+	req *ListRequest
+}
+
+func (s *listerStore) ListSecrets(_ context.Context, req *ListRequest) (*ListResponse, error) {
+	s.req = req
+	return &ListResponse{
+		Data: map[string]map[string]string{
+			"db-password": {"db-password": "a"},
+			"db-user":     {"db-user": "b"},
+		},
+		ContinuationToken: "native",
+	}, nil
+}
+
+func TestList(t *testing.T) {
+	store := &bulkStore{data: map[string]map[string]string{
+		"api-key":     {"api-key": "1"},
+		"db-password": {"db-password": "2"},
+		"db-user":     {"db-user": "3"},
+		"db-host":     {"db-host": "4"},
+	}}
+
+	t.Run("prefix and pages", func(t *testing.T) {
+		res, err := List(t.Context(), store, &ListRequest{Prefix: "db-", PageSize: 2})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]map[string]string{
+			"db-host":     {"db-host": "4"},
+			"db-password": {"db-password": "2"},
+		}, res.Data)
+		require.NotEmpty(t, res.ContinuationToken)
+
+		res, err = List(t.Context(), store, &ListRequest{Prefix: "db-", PageSize: 2, ContinuationToken: res.ContinuationToken})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]map[string]string{"db-user": {"db-user": "3"}}, res.Data)
+		assert.Empty(t, res.ContinuationToken)
+	})
+
+	t.Run("regex", func(t *testing.T) {
+		res, err := List(t.Context(), store, &ListRequest{Regex: regexp.MustCompile("-(key|user)$")})
+		require.NoError(t, err)
+		assert.Len(t, res.Data, 2)
+		assert.Contains(t, res.Data, "api-key")
+		assert.Contains(t, res.Data, "db-user")
+	})
+
+	t.Run("invalid continuation token", func(t *testing.T) {
+		_, err := List(t.Context(), store, &ListRequest{ContinuationToken: "!"})
+		require.ErrorIs(t, err, ErrInvalidContinuationToken)
+	})
+
+	t.Run("pushed down to listers", func(t *testing.T) {
+		lister := new(listerStore)
+		req := &ListRequest{Prefix: "db-", Regex: regexp.MustCompile("user"), PageSize: 10}
+		res, err := List(t.Context(), lister, req)
+		require.NoError(t, err)
+		assert.Same(t, req, lister.req)
+		assert.Equal(t, map[string]map[string]string{"db-user": {"db-user": "b"}}, res.Data)
+		assert.Equal(t, "native", res.ContinuationToken)
+	})
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/dapr/components-contrib/state"
+)
+
+// maxAtomicAttempts is the maximum number of read-modify-write attempts of an
+// atomic operation on a store which doesn't implement it natively, before
+// giving up because of concurrent writes to the key.
+const maxAtomicAttempts = 10
+
+var (
+	// ErrAtomicNotSupported is returned when the store neither implements an
+	// atomic operation nor supports ETags.
+	ErrAtomicNotSupported = errors.New("state store does not support atomic operations or ETags")
+	// ErrAtomicInvalidValue is returned when the stored value has a type the
+	// atomic operation can't be applied to.
+	ErrAtomicInvalidValue = errors.New("stored value has an invalid type for the operation")
+	// ErrAtomicConflict is returned when the key is written concurrently by
+	// every attempt of the operation.
+	ErrAtomicConflict = errors.New("too many concurrent writes to the key")
+)
+
+// Incrementer is implemented by state stores which can atomically add to a
+// numeric value.
+type Incrementer interface {
+	// Increment adds delta to the integer stored at key, which is created with
+	// a value of 0 if it doesn't exist, and returns the new value.
+	Increment(ctx context.Context, key string, delta int64, metadata map[string]string) (int64, error)
+}
+
+// Appender is implemented by state stores which can atomically append to a
+// list value.
+type Appender interface {
+	// Append adds values at the end of the JSON array stored at key, which is
+	// created empty if it doesn't exist, and returns the new length of the array.
+	Append(ctx context.Context, key string, values []json.RawMessage, metadata map[string]string) (int, error)
+}
+
+// Increment adds delta to the integer stored at key. Stores implementing
+// Incrementer do it natively, and other stores with an ETag-guarded
+// read-modify-write loop.
+func Increment(ctx context.Context, store state.Store, key string, delta int64, metadata map[string]string) (int64, error) {
+	if inc, ok := store.(Incrementer); ok {
+		return inc.Increment(ctx, key, delta, metadata)
+	}
+
+	var res int64
+	err := readModifyWrite(ctx, store, key, metadata, func(data []byte) ([]byte, error) {
+		var n int64
+		if len(data) > 0 {
+			var err error
+			n, err = strconv.ParseInt(string(bytes.TrimSpace(data)), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: not an integer", ErrAtomicInvalidValue)
+			}
+		}
+		res = n + delta
+		return strconv.AppendInt(nil, res, 10), nil
+	})
+	return res, err
+}
+
+// Append adds values at the end of the JSON array stored at key. Stores
+// implementing Appender do it natively, and other stores with an ETag-guarded
+// read-modify-write loop.
+func Append(ctx context.Context, store state.Store, key string, values []json.RawMessage, metadata map[string]string) (int, error) {
+	if app, ok := store.(Appender); ok {
+		return app.Append(ctx, key, values, metadata)
+	}
+
+	var res int
+	err := readModifyWrite(ctx, store, key, metadata, func(data []byte) ([]byte, error) {
+		var list []json.RawMessage
+		if len(data) > 0 {
+			if err := json.Unmarshal(data, &list); err != nil || list == nil {
+				return nil, fmt.Errorf("%w: not a JSON array", ErrAtomicInvalidValue)
+			}
+		}
+		list = append(list, values...)
+		res = len(list)
+		return json.Marshal(list)
+	})
+	return res, err
+}
+
+// readModifyWrite reads the value of key, and writes the value returned by
+// modify if the key wasn't written in between, retrying otherwise.
+func readModifyWrite(ctx context.Context, store state.Store, key string, metadata map[string]string, modify func([]byte) ([]byte, error)) error {
+	if !state.FeatureETag.IsPresent(store.Features()) {
+		return ErrAtomicNotSupported
+	}
+
+	for range maxAtomicAttempts {
+		res, err := store.Get(ctx, &state.GetRequest{
+			Key:      key,
+			Metadata: metadata,
+			Options: state.GetStateOption{
+				Consistency: state.Strong,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		var data []byte
+		var etag *string
+		if res != nil {
+			data, etag = res.Data, res.ETag
+		}
+
+		value, err := modify(data)
+		if err != nil {
+			return err
+		}
+
+		err = store.Set(ctx, &state.SetRequest{
+			Key:      key,
+			Value:    value,
+			ETag:     etag,
+			Metadata: metadata,
+			Options: state.SetStateOption{
+				Concurrency: state.FirstWrite,
+				Consistency: state.Strong,
+			},
+		})
+		var etagErr *state.ETagError
+		if errors.As(err, &etagErr) && etagErr.Kind() == state.ETagMismatch {
+			continue
+		}
+		return err
+	}
+
+	return ErrAtomicConflict
+}
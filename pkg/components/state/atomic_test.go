@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/state"
+)
+
+// etagStore is an in-memory store enforcing ETags on writes.
+type etagStore struct {
+	state.Store
+
+	lock     sync.Mutex
+	features []state.Feature
+	data     map[string][]byte
+	etags    map[string]int
+	// expireTimes are the expire times of the keys with a TTL.
+	expireTimes map[string]time.Time
+	// onGet is called after every read, to simulate concurrent writes.
+	onGet func(key string)
+}
+
+func newETagStore() *etagStore {
+	return &etagStore{
+		features: []state.Feature{state.FeatureETag},
+		data:     make(map[string][]byte),
+		etags:    make(map[string]int),
+	}
+}
+
+func (s *etagStore) Features() []state.Feature {
+	return s.features
+}
+
+func (s *etagStore) Get(ctx context.Context, req *state.GetRequest) (*state.GetResponse, error) {
+	s.lock.Lock()
+	res := &state.GetResponse{}
+	if data, ok := s.data[req.Key]; ok {
+		etag := strconv.Itoa(s.etags[req.Key])
+		res.Data, res.ETag = data, &etag
+		if expireTime, ok := s.expireTimes[req.Key]; ok {
+			res.Metadata = map[string]string{
+				state.GetRespMetaKeyTTLExpireTime: expireTime.Format(time.RFC3339),
+			}
+		}
+	}
+	s.lock.Unlock()
+	if s.onGet != nil {
+		s.onGet(req.Key)
+	}
+	return res, nil
+}
+
+func (s *etagStore) Set(ctx context.Context, req *state.SetRequest) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, exists := s.data[req.Key]
+	switch {
+	case req.ETag == nil && exists,
+		req.ETag != nil && *req.ETag != strconv.Itoa(s.etags[req.Key]):
+		return state.NewETagError(state.ETagMismatch, errors.New("etag mismatch"))
+	}
+	s.data[req.Key] = req.Value.([]byte)
+	s.etags[req.Key]++
+	return nil
+}
+
+type nativeStore struct {
+	*etagStore
+}
+
+func (s nativeStore) Increment(ctx context.Context, key string, delta int64, metadata map[string]string) (int64, error) {
+	return 42, nil
+}
+
+func TestIncrement(t *testing.T) {
+	t.Run("read-modify-write", func(t *testing.T) {
+		store := newETagStore()
+
+		n, err := Increment(t.Context(), store, "counter", 5, nil)
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), n)
+
+		n, err = Increment(t.Context(), store, "counter", -2, nil)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), n)
+		assert.Equal(t, "3", string(store.data["counter"]))
+	})
+
+	t.Run("retries on concurrent writes", func(t *testing.T) {
+		store := newETagStore()
+		store.data["counter"] = []byte("10")
+		writes := 2
+		store.onGet = func(key string) {
+			if writes > 0 {
+				writes--
+				store.lock.Lock()
+				store.data[key] = []byte("20")
+				store.etags[key]++
+				store.lock.Unlock()
+			}
+		}
+
+		n, err := Increment(t.Context(), store, "counter", 1, nil)
+		require.NoError(t, err)
+		assert.Equal(t, int64(21), n)
+	})
+
+	t.Run("gives up on contention", func(t *testing.T) {
+		store := newETagStore()
+		store.data["counter"] = []byte("10")
+		store.onGet = func(key string) {
+			store.lock.Lock()
+			store.etags[key]++
+			store.lock.Unlock()
+		}
+
+		_, err := Increment(t.Context(), store, "counter", 1, nil)
+		require.ErrorIs(t, err, ErrAtomicConflict)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		store := newETagStore()
+		store.data["counter"] = []byte(`"abc"`)
+
+		_, err := Increment(t.Context(), store, "counter", 1, nil)
+		require.ErrorIs(t, err, ErrAtomicInvalidValue)
+	})
+
+	t.Run("no etag support", func(t *testing.T) {
+		store := newETagStore()
+		store.features = nil
+
+		_, err := Increment(t.Context(), store, "counter", 1, nil)
+		require.ErrorIs(t, err, ErrAtomicNotSupported)
+	})
+
+	t.Run("native", func(t *testing.T) {
+		n, err := Increment(t.Context(), nativeStore{newETagStore()}, "counter", 1, nil)
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), n)
+	})
+}
+
+func TestAppend(t *testing.T) {
+	t.Run("read-modify-write", func(t *testing.T) {
+		store := newETagStore()
+
+		n, err := Append(t.Context(), store, "list", []json.RawMessage{[]byte(`1`), []byte(`"a"`)}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 2, n)
+
+		n, err = Append(t.Context(), store, "list", []json.RawMessage{[]byte(`{"b":true}`)}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 3, n)
+		assert.JSONEq(t, `[1,"a",{"b":true}]`, string(store.data["list"]))
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		store := newETagStore()
+		store.data["list"] = []byte(`{"a":1}`)
+
+		_, err := Append(t.Context(), store, "list", []json.RawMessage{[]byte(`1`)}, nil)
+		require.ErrorIs(t, err, ErrAtomicInvalidValue)
+	})
+}
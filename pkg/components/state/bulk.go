@@ -16,6 +16,8 @@ package state
 import (
 	"context"
 	"errors"
+	"maps"
+	"sync"
 	"sync/atomic"
 
 	"github.com/cenkalti/backoff/v4"
@@ -144,3 +146,90 @@ func PerformBulkStoreOperation[T stateRequestConstraint](
 
 	return err
 }
+
+// DefaultBulkParallelism is the number of requests of a bulk set or delete
+// executed at a time by stores without native batching, when the caller
+// doesn't set it.
+const DefaultBulkParallelism = 10
+
+// BulkItemResult is the result of a request of a bulk set or delete.
+type BulkItemResult struct {
+	Key string
+	// Error is the error of the request, or nil if it succeeded.
+	Error error
+}
+
+// PerformBulkStoreOperationWithResults performs a bulk set or delete like
+// PerformBulkStoreOperation, and returns the result of each request instead
+// of a single error. The result of a request is the one of the last attempt
+// which included it.
+func PerformBulkStoreOperationWithResults[T stateRequestConstraint](
+	ctx context.Context, reqs []T, policyDef *resiliency.PolicyDefinition, opts state.BulkStoreOpts,
+	execSingle func(ctx context.Context, req *T) error,
+	execMulti func(ctx context.Context, reqs []T, opts state.BulkStoreOpts) error,
+) []BulkItemResult {
+	var lock sync.Mutex
+	failed := make(map[string]error, len(reqs))
+	record := func(attempt []T, err error) {
+		lock.Lock()
+		defer lock.Unlock()
+		for _, req := range attempt {
+			delete(failed, req.GetKey())
+		}
+		if err == nil {
+			return
+		}
+		errs := make(map[string]error)
+		collectBulkStoreErrors(err, errs)
+		if len(errs) == 0 {
+			// The error doesn't identify the failed requests.
+			for _, req := range attempt {
+				failed[req.GetKey()] = err
+			}
+			return
+		}
+		maps.Copy(failed, errs)
+	}
+
+	err := PerformBulkStoreOperation(ctx, reqs, policyDef, opts,
+		func(ctx context.Context, req *T) error {
+			err := execSingle(ctx, req)
+			record([]T{*req}, err)
+			return err
+		},
+		func(ctx context.Context, reqs []T, opts state.BulkStoreOpts) error {
+			err := execMulti(ctx, reqs, opts)
+			record(reqs, err)
+			return err
+		},
+	)
+
+	results := make([]BulkItemResult, len(reqs))
+	for i, req := range reqs {
+		results[i] = BulkItemResult{
+			Key:   req.GetKey(),
+			Error: failed[req.GetKey()],
+		}
+		// The operation failed before reaching the store, for example because
+		// a circuit breaker is open.
+		if err != nil && len(failed) == 0 {
+			results[i].Error = err
+		}
+	}
+	return results
+}
+
+// collectBulkStoreErrors adds the errors of the requests found in the tree of
+// err to failed, by key.
+func collectBulkStoreErrors(err error, failed map[string]error) {
+	switch e := err.(type) {
+	case state.BulkStoreError:
+		failed[e.Key()] = e.Unwrap()
+	case interface{ Unwrap() []error }:
+		for _, child := range e.Unwrap() {
+			collectBulkStoreErrors(child, failed)
+		}
+	case interface{ Unwrap() error }:
+		collectBulkStoreErrors(e.Unwrap(), failed)
+	}
+}
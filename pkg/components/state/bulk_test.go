@@ -324,3 +324,102 @@ func TestPerformBulkStoreOperation(t *testing.T) {
 		})
 	})
 }
+
+func TestPerformBulkStoreOperationWithResults(t *testing.T) {
+	simulatedErr := errors.New("simulated")
+	etagMismatchErr := state.NewETagError(state.ETagMismatch, simulatedErr)
+	etagInvalidErr := state.NewETagError(state.ETagInvalid, simulatedErr)
+
+	res := resiliency.FromConfigurations(logger.NewLogger("test"), &resiliencyV1alpha1.Resiliency{
+		Spec: resiliencyV1alpha1.ResiliencySpec{
+			Policies: resiliencyV1alpha1.Policies{
+				Retries: map[string]resiliencyV1alpha1.Retry{
+					"singleRetry": {
+						MaxRetries:  ptr.Of(1),
+						MaxInterval: "100ms",
+						Policy:      "constant",
+						Duration:    "10ms",
+					},
+				},
+				Timeouts: map[string]string{
+					"fast": "100ms",
+				},
+			},
+			Targets: resiliencyV1alpha1.Targets{
+				Components: map[string]resiliencyV1alpha1.ComponentPolicyNames{
+					"mystate": {
+						Outbound: resiliencyV1alpha1.PolicyNames{
+							Retry:   "singleRetry",
+							Timeout: "fast",
+						},
+					},
+				},
+			},
+		},
+	})
+	policyDef := res.ComponentOutboundPolicy("mystate", resiliency.Statestore)
+
+	reqs := []state.DeleteRequest{
+		{Key: "key1"},
+		{Key: "key2"},
+		{Key: "key3"},
+	}
+
+	t.Run("all successful", func(t *testing.T) {
+		results := PerformBulkStoreOperationWithResults(t.Context(), reqs, policyDef, state.BulkStoreOpts{},
+			nil, // The single method should not be invoked, so this will panic if it happens
+			func(ctx context.Context, req []state.DeleteRequest, opts state.BulkStoreOpts) error {
+				return nil
+			},
+		)
+		require.Equal(t, []BulkItemResult{{Key: "key1"}, {Key: "key2"}, {Key: "key3"}}, results)
+	})
+
+	t.Run("errors are reported per item across retries", func(t *testing.T) {
+		count := atomic.Uint32{}
+		results := PerformBulkStoreOperationWithResults(t.Context(), reqs, policyDef, state.BulkStoreOpts{},
+			func(ctx context.Context, req *state.DeleteRequest) error {
+				// The retry of key1 succeeds
+				require.Equal(t, "key1", req.Key)
+				count.Add(1)
+				return nil
+			},
+			func(ctx context.Context, req []state.DeleteRequest, opts state.BulkStoreOpts) error {
+				count.Add(1)
+				return errors.Join(
+					state.NewBulkStoreError("key1", simulatedErr),
+					state.NewBulkStoreError("key2", etagMismatchErr),
+				)
+			},
+		)
+		require.Equal(t, uint32(2), count.Load())
+		require.Len(t, results, 3)
+		require.NoError(t, results[0].Error)
+		require.ErrorIs(t, results[1].Error, etagMismatchErr)
+		require.NoError(t, results[2].Error)
+	})
+
+	t.Run("errors which don't identify the items fail all of them", func(t *testing.T) {
+		results := PerformBulkStoreOperationWithResults(t.Context(), reqs, policyDef, state.BulkStoreOpts{},
+			nil, // The single method should not be invoked, so this will panic if it happens
+			func(ctx context.Context, req []state.DeleteRequest, opts state.BulkStoreOpts) error {
+				return simulatedErr
+			},
+		)
+		require.Len(t, results, 3)
+		for _, res := range results {
+			require.ErrorIs(t, res.Error, simulatedErr)
+		}
+	})
+
+	t.Run("single request", func(t *testing.T) {
+		results := PerformBulkStoreOperationWithResults(t.Context(), reqs[:1], policyDef, state.BulkStoreOpts{},
+			func(ctx context.Context, req *state.DeleteRequest) error {
+				return etagInvalidErr
+			},
+			nil, // The multi method should not be invoked, so this will panic if it happens
+		)
+		require.Len(t, results, 1)
+		require.ErrorIs(t, results[0].Error, etagInvalidErr)
+	})
+}
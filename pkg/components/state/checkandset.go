@@ -0,0 +1,193 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"strconv"
+	"time"
+
+	contribMetadata "github.com/dapr/components-contrib/metadata"
+	"github.com/dapr/components-contrib/state"
+)
+
+var (
+	// ErrCheckAndSetNotSupported is returned when the store neither implements
+	// CheckAndSet nor supports transactions with ETags.
+	ErrCheckAndSetNotSupported = errors.New("state store does not support transactions with ETags")
+	// ErrCheckAndSetInvalid is returned when the request is invalid.
+	ErrCheckAndSetInvalid = errors.New("invalid check-and-set request")
+	// ErrCheckAndSetConflict is returned when the ETag of a checked key doesn't
+	// match, in which case none of the writes is applied.
+	ErrCheckAndSetConflict = errors.New("etag mismatch")
+)
+
+// ETagCheck is a condition of a check-and-set, which holds if the current ETag
+// of the key is ETag.
+type ETagCheck struct {
+	Key  string
+	ETag string
+}
+
+type CheckAndSetRequest struct {
+	Checks     []ETagCheck
+	Operations []state.TransactionalStateOperation
+	Metadata   map[string]string
+}
+
+// CheckAndSetter is implemented by state stores which can natively validate
+// the ETags of a set of keys and apply writes in the same transaction.
+type CheckAndSetter interface {
+	CheckAndSet(ctx context.Context, req *CheckAndSetRequest) error
+}
+
+// CheckAndSet applies the operations of the request only if the ETags of all
+// the checked keys match. Stores implementing CheckAndSetter do it natively.
+// Other stores must support transactions and ETags: the ETags of the checks
+// are set on the operations writing the checked keys, and the checked keys
+// which aren't written are re-written with their current value guarded by
+// their ETag in the same transaction, which changes their ETag.
+func CheckAndSet(ctx context.Context, store state.Store, req *CheckAndSetRequest) error {
+	checks, err := validateCheckAndSet(req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrCheckAndSetInvalid, err)
+	}
+
+	if cas, ok := store.(CheckAndSetter); ok {
+		return cas.CheckAndSet(ctx, req)
+	}
+
+	transactionalStore, ok := store.(state.TransactionalStore)
+	if !ok ||
+		!state.FeatureTransactional.IsPresent(store.Features()) ||
+		!state.FeatureETag.IsPresent(store.Features()) {
+		return ErrCheckAndSetNotSupported
+	}
+
+	operations := make([]state.TransactionalStateOperation, 0, len(req.Checks)+len(req.Operations))
+	written := make(map[string]struct{}, len(req.Operations))
+	for _, op := range req.Operations {
+		key := op.GetKey()
+		written[key] = struct{}{}
+		etag, ok := checks[key]
+		if !ok {
+			operations = append(operations, op)
+			continue
+		}
+
+		switch o := op.(type) {
+		case state.SetRequest:
+			if o.ETag != nil && *o.ETag != etag {
+				return fmt.Errorf("%w: conflicting ETags for key %s", ErrCheckAndSetInvalid, key)
+			}
+			o.ETag = &etag
+			o.Options.Concurrency = state.FirstWrite
+			operations = append(operations, o)
+		case state.DeleteRequest:
+			if o.ETag != nil && *o.ETag != etag {
+				return fmt.Errorf("%w: conflicting ETags for key %s", ErrCheckAndSetInvalid, key)
+			}
+			o.ETag = &etag
+			o.Options.Concurrency = state.FirstWrite
+			operations = append(operations, o)
+		default:
+			return fmt.Errorf("%w: unsupported operation %s", ErrCheckAndSetInvalid, op.Operation())
+		}
+	}
+
+	for _, check := range req.Checks {
+		if _, ok := written[check.Key]; ok {
+			continue
+		}
+		// Mark the key so that duplicate checks are only read once.
+		written[check.Key] = struct{}{}
+
+		res, err := store.Get(ctx, &state.GetRequest{
+			Key:      check.Key,
+			Metadata: req.Metadata,
+			Options: state.GetStateOption{
+				Consistency: state.Strong,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if res == nil || res.ETag == nil || *res.ETag != check.ETag {
+			return fmt.Errorf("%w: key %s", ErrCheckAndSetConflict, check.Key)
+		}
+
+		// The key is re-written with the TTL it has left, rather than the TTL
+		// of the request.
+		metadata := maps.Clone(req.Metadata)
+		if metadata == nil {
+			metadata = make(map[string]string, 1)
+		}
+		delete(metadata, contribMetadata.TTLMetadataKey)
+		delete(metadata, contribMetadata.TTLInSecondsMetadataKey)
+		if ttl, ok := RemainingTTLInSeconds(res.Metadata, time.Now()); ok {
+			if ttl <= 0 {
+				// The key has expired since it was read.
+				return fmt.Errorf("%w: key %s", ErrCheckAndSetConflict, check.Key)
+			}
+			metadata[contribMetadata.TTLInSecondsMetadataKey] = strconv.FormatInt(ttl, 10)
+		}
+
+		operations = append(operations, state.SetRequest{
+			Key:      check.Key,
+			Value:    res.Data,
+			ETag:     &check.ETag,
+			Metadata: metadata,
+			Options: state.SetStateOption{
+				Concurrency: state.FirstWrite,
+				Consistency: state.Strong,
+			},
+		})
+	}
+
+	err = transactionalStore.Multi(ctx, &state.TransactionalStateRequest{
+		Operations: operations,
+		Metadata:   req.Metadata,
+	})
+	var etagErr *state.ETagError
+	if errors.As(err, &etagErr) && etagErr.Kind() == state.ETagMismatch {
+		return fmt.Errorf("%w: %w", ErrCheckAndSetConflict, err)
+	}
+	return err
+}
+
+// validateCheckAndSet validates the request and returns the checked ETags by
+// key.
+func validateCheckAndSet(req *CheckAndSetRequest) (map[string]string, error) {
+	if len(req.Checks) == 0 {
+		return nil, errors.New("at least one check is required")
+	}
+
+	checks := make(map[string]string, len(req.Checks))
+	for _, check := range req.Checks {
+		if check.Key == "" {
+			return nil, errors.New("check key is required")
+		}
+		if check.ETag == "" {
+			return nil, fmt.Errorf("etag of key %s is required", check.Key)
+		}
+		if etag, ok := checks[check.Key]; ok && etag != check.ETag {
+			return nil, fmt.Errorf("conflicting checks for key %s", check.Key)
+		}
+		checks[check.Key] = check.ETag
+	}
+	return checks, nil
+}
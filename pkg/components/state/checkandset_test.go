@@ -0,0 +1,215 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/state"
+)
+
+// transactionalETagStore is an in-memory store enforcing ETags on the
+// operations of transactions.
+type transactionalETagStore struct {
+	*etagStore
+
+	ops []state.TransactionalStateOperation
+}
+
+func newTransactionalETagStore() *transactionalETagStore {
+	s := &transactionalETagStore{etagStore: newETagStore()}
+	s.features = append(s.features, state.FeatureTransactional)
+	return s
+}
+
+func (s *transactionalETagStore) Multi(ctx context.Context, req *state.TransactionalStateRequest) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.ops = req.Operations
+
+	for _, op := range req.Operations {
+		var etag *string
+		switch o := op.(type) {
+		case state.SetRequest:
+			etag = o.ETag
+		case state.DeleteRequest:
+			etag = o.ETag
+		}
+		if etag != nil && *etag != strconv.Itoa(s.etags[op.GetKey()]) {
+			return state.NewETagError(state.ETagMismatch, errors.New("etag mismatch"))
+		}
+	}
+
+	for _, op := range req.Operations {
+		switch o := op.(type) {
+		case state.SetRequest:
+			s.data[o.Key] = o.Value.([]byte)
+			s.etags[o.Key]++
+		case state.DeleteRequest:
+			delete(s.data, o.Key)
+		}
+	}
+	return nil
+}
+
+type nativeCheckAndSetStore struct {
+	*etagStore
+
+	reqs []*CheckAndSetRequest
+}
+
+func (s *nativeCheckAndSetStore) CheckAndSet(ctx context.Context, req *CheckAndSetRequest) error {
+	s.reqs = append(s.reqs, req)
+	return nil
+}
+
+func TestCheckAndSet(t *testing.T) {
+	newStore := func() *transactionalETagStore {
+		store := newTransactionalETagStore()
+		store.data["a"], store.etags["a"] = []byte("1"), 1
+		store.data["b"], store.etags["b"] = []byte("2"), 3
+		return store
+	}
+
+	t.Run("invalid requests", func(t *testing.T) {
+		for name, req := range map[string]*CheckAndSetRequest{
+			"no checks":          {},
+			"empty key":          {Checks: []ETagCheck{{ETag: "1"}}},
+			"empty etag":         {Checks: []ETagCheck{{Key: "a"}}},
+			"conflicting checks": {Checks: []ETagCheck{{Key: "a", ETag: "1"}, {Key: "a", ETag: "2"}}},
+		} {
+			t.Run(name, func(t *testing.T) {
+				require.ErrorIs(t, CheckAndSet(t.Context(), newStore(), req), ErrCheckAndSetInvalid)
+			})
+		}
+
+		etag := "5"
+		err := CheckAndSet(t.Context(), newStore(), &CheckAndSetRequest{
+			Checks:     []ETagCheck{{Key: "a", ETag: "1"}},
+			Operations: []state.TransactionalStateOperation{state.SetRequest{Key: "a", Value: []byte("x"), ETag: &etag}},
+		})
+		require.ErrorIs(t, err, ErrCheckAndSetInvalid)
+	})
+
+	t.Run("all checks match", func(t *testing.T) {
+		store := newStore()
+		err := CheckAndSet(t.Context(), store, &CheckAndSetRequest{
+			Checks: []ETagCheck{{Key: "a", ETag: "1"}, {Key: "b", ETag: "3"}},
+			Operations: []state.TransactionalStateOperation{
+				state.SetRequest{Key: "a", Value: []byte("10")},
+				state.SetRequest{Key: "c", Value: []byte("30")},
+			},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "10", string(store.data["a"]))
+		assert.Equal(t, "2", string(store.data["b"]))
+		assert.Equal(t, "30", string(store.data["c"]))
+
+		// The checked key which isn't written is guarded by its ETag in the
+		// transaction.
+		require.Len(t, store.ops, 3)
+		set := store.ops[0].(state.SetRequest)
+		require.NotNil(t, set.ETag)
+		assert.Equal(t, "1", *set.ETag)
+		assert.Nil(t, store.ops[1].(state.SetRequest).ETag)
+		set = store.ops[2].(state.SetRequest)
+		assert.Equal(t, "b", set.Key)
+		assert.Equal(t, "3", *set.ETag)
+	})
+
+	t.Run("checked key not written keeps its TTL", func(t *testing.T) {
+		store := newStore()
+		store.expireTimes = map[string]time.Time{"b": time.Now().Add(time.Hour)}
+		store.data["c"], store.etags["c"] = []byte("3"), 1
+		err := CheckAndSet(t.Context(), store, &CheckAndSetRequest{
+			Checks: []ETagCheck{{Key: "b", ETag: "3"}, {Key: "c", ETag: "1"}},
+			Operations: []state.TransactionalStateOperation{
+				state.SetRequest{Key: "a", Value: []byte("10")},
+			},
+			Metadata: map[string]string{"ttlInSeconds": "10", "foo": "bar"},
+		})
+		require.NoError(t, err)
+
+		require.Len(t, store.ops, 3)
+		set := store.ops[1].(state.SetRequest)
+		assert.Equal(t, "b", set.Key)
+		ttl, err := strconv.Atoi(set.Metadata["ttlInSeconds"])
+		require.NoError(t, err)
+		assert.InDelta(t, 3600, ttl, 5)
+		assert.Equal(t, "bar", set.Metadata["foo"])
+		set = store.ops[2].(state.SetRequest)
+		assert.Equal(t, "c", set.Key)
+		assert.Equal(t, map[string]string{"foo": "bar"}, set.Metadata)
+	})
+
+	t.Run("checked key not written has expired", func(t *testing.T) {
+		store := newStore()
+		store.expireTimes = map[string]time.Time{"b": time.Now().Add(-time.Minute)}
+		err := CheckAndSet(t.Context(), store, &CheckAndSetRequest{
+			Checks: []ETagCheck{{Key: "b", ETag: "3"}},
+		})
+		require.ErrorIs(t, err, ErrCheckAndSetConflict)
+		assert.Nil(t, store.ops)
+	})
+
+	t.Run("checked key not written doesn't match", func(t *testing.T) {
+		store := newStore()
+		err := CheckAndSet(t.Context(), store, &CheckAndSetRequest{
+			Checks:     []ETagCheck{{Key: "a", ETag: "1"}, {Key: "b", ETag: "1"}},
+			Operations: []state.TransactionalStateOperation{state.DeleteRequest{Key: "a"}},
+		})
+		require.ErrorIs(t, err, ErrCheckAndSetConflict)
+		assert.Nil(t, store.ops)
+		assert.Equal(t, "1", string(store.data["a"]))
+	})
+
+	t.Run("checked key written doesn't match", func(t *testing.T) {
+		store := newStore()
+		err := CheckAndSet(t.Context(), store, &CheckAndSetRequest{
+			Checks:     []ETagCheck{{Key: "a", ETag: "2"}},
+			Operations: []state.TransactionalStateOperation{state.SetRequest{Key: "a", Value: []byte("10")}},
+		})
+		require.ErrorIs(t, err, ErrCheckAndSetConflict)
+		assert.Equal(t, "1", string(store.data["a"]))
+	})
+
+	t.Run("checked key doesn't exist", func(t *testing.T) {
+		err := CheckAndSet(t.Context(), newStore(), &CheckAndSetRequest{
+			Checks: []ETagCheck{{Key: "missing", ETag: "1"}},
+		})
+		require.ErrorIs(t, err, ErrCheckAndSetConflict)
+	})
+
+	t.Run("no transaction support", func(t *testing.T) {
+		err := CheckAndSet(t.Context(), newETagStore(), &CheckAndSetRequest{
+			Checks: []ETagCheck{{Key: "a", ETag: "1"}},
+		})
+		require.ErrorIs(t, err, ErrCheckAndSetNotSupported)
+	})
+
+	t.Run("native", func(t *testing.T) {
+		store := &nativeCheckAndSetStore{etagStore: newETagStore()}
+		req := &CheckAndSetRequest{Checks: []ETagCheck{{Key: "a", ETag: "1"}}}
+		require.NoError(t, CheckAndSet(t.Context(), store, req))
+		assert.Equal(t, []*CheckAndSetRequest{req}, store.reqs)
+	})
+}
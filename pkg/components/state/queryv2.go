@@ -0,0 +1,413 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/components-contrib/state/query"
+)
+
+const (
+	// aggregatePageSize is the number of items read at a time when aggregates
+	// are computed by the sidecar.
+	aggregatePageSize = 1000
+	// maxAggregateItems is the maximum number of items read to compute
+	// aggregates in the sidecar, to bound the cost of a single query.
+	maxAggregateItems = 100_000
+)
+
+var (
+	// ErrQueryNotSupported is returned when the state store can't run the
+	// query, natively or with the help of the sidecar.
+	ErrQueryNotSupported = errors.New("state store does not support the query")
+	// ErrQueryInvalid is returned when the query is invalid.
+	ErrQueryInvalid = errors.New("invalid query")
+)
+
+// AggregateFunction is a function computed over the items matching a query.
+type AggregateFunction string
+
+const (
+	AggregateCount AggregateFunction = "count"
+	AggregateMin   AggregateFunction = "min"
+	AggregateMax   AggregateFunction = "max"
+)
+
+// Aggregate is an aggregate function requested by a query.
+type Aggregate struct {
+	Function AggregateFunction `json:"function"`
+	// Field is the path of the field the function is computed over. It's
+	// required by min and max, and count only counts the items with the field
+	// if set.
+	Field string `json:"field,omitempty"`
+	// Alias is the name of the aggregate in the response. Defaults to the
+	// function, followed by the field in parentheses if set.
+	Alias string `json:"alias,omitempty"`
+}
+
+// Name returns the name of the aggregate in the response.
+func (a Aggregate) Name() string {
+	switch {
+	case a.Alias != "":
+		return a.Alias
+	case a.Field != "":
+		return string(a.Function) + "(" + a.Field + ")"
+	default:
+		return string(a.Function)
+	}
+}
+
+// QueryV2 is a query on the values of a state store. Fields are referenced by
+// their dot-separated path in the values, as in the filters of the alpha1
+// query API.
+type QueryV2 struct {
+	// Filter has the same syntax as the filter of the alpha1 query API.
+	Filter map[string]any `json:"filter,omitempty"`
+	// Select projects the values on the given fields.
+	Select []string `json:"select,omitempty"`
+	// OrderBy sorts the results by one or more fields.
+	OrderBy []query.Sorting `json:"orderBy,omitempty"`
+	// Aggregate computes aggregate functions over all the matching items,
+	// instead of returning them.
+	Aggregate []Aggregate `json:"aggregate,omitempty"`
+	// Limit is the maximum number of results returned at a time.
+	Limit int `json:"limit,omitempty"`
+	// Cursor is the cursor returned with the previous page of results.
+	Cursor string `json:"cursor,omitempty"`
+}
+
+type QueryV2Request struct {
+	Query    QueryV2
+	Metadata map[string]string
+}
+
+type QueryV2Response struct {
+	Results []state.QueryItem
+	// Aggregates holds the value of each aggregate, by name.
+	Aggregates map[string]any
+	// Cursor is set when there are more results to read.
+	Cursor string
+}
+
+// QueryCapabilities are the parts of a v2 query which a state store evaluates
+// natively. Filters, sorting and pagination are always evaluated natively.
+type QueryCapabilities struct {
+	Projection bool
+	Aggregates []AggregateFunction
+}
+
+// QuerierV2 is implemented by state stores which translate v2 queries to their
+// native query language.
+type QuerierV2 interface {
+	QueryCapabilities() QueryCapabilities
+	QueryV2(ctx context.Context, req *QueryV2Request) (*QueryV2Response, error)
+}
+
+// Query runs a v2 query. Stores implementing QuerierV2 run the query natively
+// if their capabilities cover it. Otherwise the filters, sorting and
+// pagination are translated to an alpha1 query, and the projection and
+// aggregates are evaluated by the sidecar.
+func Query(ctx context.Context, store state.Store, req *QueryV2Request) (*QueryV2Response, error) {
+	if err := validateQuery(&req.Query); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrQueryInvalid, err)
+	}
+
+	if q, ok := store.(QuerierV2); ok && supportsQuery(q.QueryCapabilities(), &req.Query) {
+		return q.QueryV2(ctx, req)
+	}
+
+	querier, ok := store.(state.Querier)
+	if !ok {
+		return nil, ErrQueryNotSupported
+	}
+
+	if len(req.Query.Aggregate) > 0 {
+		return aggregate(ctx, querier, req)
+	}
+
+	v1, err := toV1Query(&req.Query, req.Query.Limit, req.Query.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	res, err := querier.Query(ctx, &state.QueryRequest{Query: v1, Metadata: req.Metadata})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return &QueryV2Response{}, nil
+	}
+
+	for i := range res.Results {
+		if res.Results[i].Error == "" {
+			res.Results[i].Data = project(res.Results[i].Data, req.Query.Select)
+		}
+	}
+	return &QueryV2Response{
+		Results: res.Results,
+		Cursor:  res.Token,
+	}, nil
+}
+
+func validateQuery(q *QueryV2) error {
+	if q.Limit < 0 {
+		return errors.New("limit must not be negative")
+	}
+	for _, s := range q.OrderBy {
+		if s.Key == "" {
+			return errors.New("orderBy key is required")
+		}
+		if s.Order != "" && s.Order != query.ASC && s.Order != query.DESC {
+			return fmt.Errorf("orderBy order %q must be %s or %s", s.Order, query.ASC, query.DESC)
+		}
+	}
+	if slices.Contains(q.Select, "") {
+		return errors.New("select fields must not be empty")
+	}
+
+	if len(q.Aggregate) == 0 {
+		return nil
+	}
+	if len(q.Select) > 0 || q.Limit > 0 || q.Cursor != "" {
+		return errors.New("aggregate can't be combined with select, limit or cursor")
+	}
+	names := make(map[string]struct{}, len(q.Aggregate))
+	for _, a := range q.Aggregate {
+		switch a.Function {
+		case AggregateCount:
+		case AggregateMin, AggregateMax:
+			if a.Field == "" {
+				return fmt.Errorf("aggregate function %s requires a field", a.Function)
+			}
+		default:
+			return fmt.Errorf("aggregate function %q is not supported", a.Function)
+		}
+		if _, ok := names[a.Name()]; ok {
+			return fmt.Errorf("aggregate %s is requested more than once", a.Name())
+		}
+		names[a.Name()] = struct{}{}
+	}
+	return nil
+}
+
+func supportsQuery(caps QueryCapabilities, q *QueryV2) bool {
+	if len(q.Select) > 0 && !caps.Projection {
+		return false
+	}
+	for _, a := range q.Aggregate {
+		if !slices.Contains(caps.Aggregates, a.Function) {
+			return false
+		}
+	}
+	return true
+}
+
+// toV1Query translates the filters, sorting and pagination of a query to an
+// alpha1 query.
+func toV1Query(q *QueryV2, limit int, token string) (query.Query, error) {
+	fields, err := json.Marshal(query.QueryFields{
+		Filters: q.Filter,
+		Sort:    q.OrderBy,
+		Page:    query.Pagination{Limit: limit, Token: token},
+	})
+	if err != nil {
+		return query.Query{}, err
+	}
+
+	var v1 query.Query
+	if err = json.Unmarshal(fields, &v1); err != nil {
+		return query.Query{}, fmt.Errorf("%w: %w", ErrQueryInvalid, err)
+	}
+	return v1, nil
+}
+
+// aggregate computes the aggregates of a query by reading all the matching
+// items.
+func aggregate(ctx context.Context, querier state.Querier, req *QueryV2Request) (*QueryV2Response, error) {
+	aggs := req.Query.Aggregate
+	counts := make([]int64, len(aggs))
+	values := make([]any, len(aggs))
+
+	var token string
+	var read int
+	for {
+		v1, err := toV1Query(&req.Query, aggregatePageSize, token)
+		if err != nil {
+			return nil, err
+		}
+		res, err := querier.Query(ctx, &state.QueryRequest{Query: v1, Metadata: req.Metadata})
+		if err != nil {
+			return nil, err
+		}
+		if res == nil {
+			break
+		}
+
+		read += len(res.Results)
+		if read > maxAggregateItems {
+			return nil, fmt.Errorf("%w: aggregates can be computed over at most %d items", ErrQueryNotSupported, maxAggregateItems)
+		}
+
+		for _, item := range res.Results {
+			if item.Error != "" {
+				continue
+			}
+			doc, _ := decodeQueryValue(item.Data)
+			for i, a := range aggs {
+				var v any
+				if a.Field != "" {
+					var ok bool
+					if v, ok = lookupField(doc, a.Field); !ok || v == nil {
+						continue
+					}
+				}
+
+				switch a.Function {
+				case AggregateCount:
+					counts[i]++
+				case AggregateMin, AggregateMax:
+					if values[i] == nil {
+						values[i] = v
+						continue
+					}
+					cmp, err := compareValues(v, values[i])
+					if err != nil {
+						return nil, fmt.Errorf("failed to compute aggregate %s: %w", a.Name(), err)
+					}
+					if (a.Function == AggregateMin && cmp < 0) || (a.Function == AggregateMax && cmp > 0) {
+						values[i] = v
+					}
+				}
+			}
+		}
+
+		if res.Token == "" || len(res.Results) == 0 {
+			break
+		}
+		token = res.Token
+	}
+
+	resp := &QueryV2Response{Aggregates: make(map[string]any, len(aggs))}
+	for i, a := range aggs {
+		if a.Function == AggregateCount {
+			resp.Aggregates[a.Name()] = counts[i]
+		} else {
+			resp.Aggregates[a.Name()] = values[i]
+		}
+	}
+	return resp, nil
+}
+
+// project returns a JSON object with the selected fields of a value only.
+// Values which aren't JSON objects are returned as they are.
+func project(data []byte, fields []string) []byte {
+	if len(fields) == 0 {
+		return data
+	}
+	doc, err := decodeQueryValue(data)
+	if err != nil {
+		return data
+	}
+	if _, ok := doc.(map[string]any); !ok {
+		return data
+	}
+
+	out := make(map[string]any)
+	for _, field := range fields {
+		v, ok := lookupField(doc, field)
+		if !ok {
+			continue
+		}
+
+		parts := strings.Split(field, ".")
+		m := out
+		for _, part := range parts[:len(parts)-1] {
+			next, ok := m[part].(map[string]any)
+			if !ok {
+				next = make(map[string]any)
+				m[part] = next
+			}
+			m = next
+		}
+		m[parts[len(parts)-1]] = v
+	}
+
+	projected, err := json.Marshal(out)
+	if err != nil {
+		return data
+	}
+	return projected
+}
+
+// lookupField returns the value of a field of a JSON value by its
+// dot-separated path.
+func lookupField(doc any, path string) (any, bool) {
+	for _, part := range strings.Split(path, ".") {
+		m, ok := doc.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if doc, ok = m[part]; !ok {
+			return nil, false
+		}
+	}
+	return doc, true
+}
+
+// compareValues compares two numbers or two strings.
+func compareValues(a, b any) (int, error) {
+	switch av := a.(type) {
+	case json.Number:
+		bv, ok := b.(json.Number)
+		if !ok {
+			break
+		}
+		af, err := av.Float64()
+		if err != nil {
+			return 0, err
+		}
+		bf, err := bv.Float64()
+		if err != nil {
+			return 0, err
+		}
+		switch {
+		case af < bf:
+			return -1, nil
+		case af > bf:
+			return 1, nil
+		default:
+			return 0, nil
+		}
+	case string:
+		if bv, ok := b.(string); ok {
+			return strings.Compare(av, bv), nil
+		}
+	}
+	return 0, fmt.Errorf("can't compare %T with %T", a, b)
+}
+
+func decodeQueryValue(data []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var doc any
+	err := dec.Decode(&doc)
+	return doc, err
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/components-contrib/state/query"
+)
+
+// queryStore returns its items page by page, ignoring the filters.
+type queryStore struct {
+	state.Store
+
+	items []state.QueryItem
+	reqs  []*state.QueryRequest
+}
+
+func (s *queryStore) Query(ctx context.Context, req *state.QueryRequest) (*state.QueryResponse, error) {
+	s.reqs = append(s.reqs, req)
+
+	start, _ := strconv.Atoi(req.Query.Page.Token)
+	end := len(s.items)
+	if req.Query.Page.Limit > 0 {
+		end = min(end, start+req.Query.Page.Limit)
+	}
+	res := &state.QueryResponse{Results: append([]state.QueryItem(nil), s.items[start:end]...)}
+	if end < len(s.items) {
+		res.Token = strconv.Itoa(end)
+	}
+	return res, nil
+}
+
+// nativeQueryStore runs the v2 queries its capabilities cover.
+type nativeQueryStore struct {
+	*queryStore
+
+	caps    QueryCapabilities
+	queries []QueryV2
+}
+
+func (s *nativeQueryStore) QueryCapabilities() QueryCapabilities {
+	return s.caps
+}
+
+func (s *nativeQueryStore) QueryV2(ctx context.Context, req *QueryV2Request) (*QueryV2Response, error) {
+	s.queries = append(s.queries, req.Query)
+	return &QueryV2Response{Aggregates: map[string]any{"count": int64(42)}}, nil
+}
+
+func newQueryStore() *queryStore {
+	return &queryStore{
+		items: []state.QueryItem{
+			{Key: "a", Data: []byte(`{"name":"alice","age":30,"address":{"city":"paris","zip":"75001"}}`)},
+			{Key: "b", Data: []byte(`{"name":"bob","age":25}`)},
+			{Key: "c", Data: []byte(`{"name":"carol","age":41.5,"address":{"city":"rome"}}`)},
+		},
+	}
+}
+
+func TestQuery(t *testing.T) {
+	t.Run("invalid queries", func(t *testing.T) {
+		for name, q := range map[string]QueryV2{
+			"negative limit":       {Limit: -1},
+			"empty order key":      {OrderBy: []query.Sorting{{Order: query.ASC}}},
+			"invalid order":        {OrderBy: []query.Sorting{{Key: "a", Order: "UP"}}},
+			"empty select":         {Select: []string{""}},
+			"unknown function":     {Aggregate: []Aggregate{{Function: "avg", Field: "a"}}},
+			"min without field":    {Aggregate: []Aggregate{{Function: AggregateMin}}},
+			"duplicate aggregates": {Aggregate: []Aggregate{{Function: AggregateCount}, {Function: AggregateCount}}},
+			"aggregate and select": {Aggregate: []Aggregate{{Function: AggregateCount}}, Select: []string{"a"}},
+			"invalid filter":       {Filter: map[string]any{"NOPE": 1}},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := Query(t.Context(), newQueryStore(), &QueryV2Request{Query: q})
+				require.ErrorIs(t, err, ErrQueryInvalid)
+			})
+		}
+	})
+
+	t.Run("store without queries", func(t *testing.T) {
+		_, err := Query(t.Context(), &etagStore{}, &QueryV2Request{})
+		require.ErrorIs(t, err, ErrQueryNotSupported)
+	})
+
+	t.Run("projection and pagination in the sidecar", func(t *testing.T) {
+		store := newQueryStore()
+		res, err := Query(t.Context(), store, &QueryV2Request{Query: QueryV2{
+			Filter:  map[string]any{"EQ": map[string]any{"name": "alice"}},
+			Select:  []string{"name", "address.city"},
+			OrderBy: []query.Sorting{{Key: "age", Order: query.DESC}, {Key: "name"}},
+			Limit:   2,
+		}})
+		require.NoError(t, err)
+		require.Len(t, res.Results, 2)
+		assert.JSONEq(t, `{"name":"alice","address":{"city":"paris"}}`, string(res.Results[0].Data))
+		assert.JSONEq(t, `{"name":"bob"}`, string(res.Results[1].Data))
+		assert.Equal(t, "2", res.Cursor)
+
+		require.Len(t, store.reqs, 1)
+		v1 := store.reqs[0].Query
+		assert.NotNil(t, v1.Filter)
+		assert.Equal(t, []query.Sorting{{Key: "age", Order: query.DESC}, {Key: "name"}}, v1.Sort)
+		assert.Equal(t, 2, v1.Page.Limit)
+
+		res, err = Query(t.Context(), store, &QueryV2Request{Query: QueryV2{Limit: 2, Cursor: res.Cursor}})
+		require.NoError(t, err)
+		require.Len(t, res.Results, 1)
+		assert.Equal(t, "c", res.Results[0].Key)
+		assert.Empty(t, res.Cursor)
+	})
+
+	t.Run("aggregates in the sidecar", func(t *testing.T) {
+		store := newQueryStore()
+		res, err := Query(t.Context(), store, &QueryV2Request{Query: QueryV2{
+			Aggregate: []Aggregate{
+				{Function: AggregateCount},
+				{Function: AggregateCount, Field: "address.city"},
+				{Function: AggregateMin, Field: "age"},
+				{Function: AggregateMax, Field: "age", Alias: "oldest"},
+				{Function: AggregateMax, Field: "name"},
+				{Function: AggregateMin, Field: "missing"},
+			},
+		}})
+		require.NoError(t, err)
+		assert.Empty(t, res.Results)
+		assert.Equal(t, map[string]any{
+			"count":               int64(3),
+			"count(address.city)": int64(2),
+			"min(age)":            json.Number("25"),
+			"oldest":              json.Number("41.5"),
+			"max(name)":           "carol",
+			"min(missing)":        nil,
+		}, res.Aggregates)
+	})
+
+	t.Run("aggregates over values which can't be compared", func(t *testing.T) {
+		store := newQueryStore()
+		store.items = append(store.items, state.QueryItem{Key: "d", Data: []byte(`{"age":"old"}`)})
+		_, err := Query(t.Context(), store, &QueryV2Request{Query: QueryV2{
+			Aggregate: []Aggregate{{Function: AggregateMax, Field: "age"}},
+		}})
+		require.Error(t, err)
+	})
+
+	t.Run("capability negotiation", func(t *testing.T) {
+		store := &nativeQueryStore{
+			queryStore: newQueryStore(),
+			caps:       QueryCapabilities{Aggregates: []AggregateFunction{AggregateCount}},
+		}
+
+		// Covered by the capabilities of the store.
+		res, err := Query(t.Context(), store, &QueryV2Request{Query: QueryV2{
+			Aggregate: []Aggregate{{Function: AggregateCount}},
+		}})
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), res.Aggregates["count"])
+		assert.Len(t, store.queries, 1)
+		assert.Empty(t, store.reqs)
+
+		// Not covered, so the sidecar computes the aggregates.
+		res, err = Query(t.Context(), store, &QueryV2Request{Query: QueryV2{
+			Aggregate: []Aggregate{{Function: AggregateCount}, {Function: AggregateMax, Field: "age"}},
+		}})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), res.Aggregates["count"])
+		assert.Len(t, store.queries, 1)
+		assert.Len(t, store.reqs, 1)
+
+		_, err = Query(t.Context(), store, &QueryV2Request{Query: QueryV2{Select: []string{"name"}}})
+		require.NoError(t, err)
+		assert.Len(t, store.queries, 1)
+	})
+}
@@ -16,6 +16,8 @@ package state
 import (
 	"fmt"
 	"os"
+	"regexp"
+	"slices"
 	"strings"
 	"sync"
 
@@ -23,7 +25,8 @@ import (
 )
 
 const (
-	strategyKey = "keyprefix"
+	strategyKey          = "keyprefix"
+	strategyOverridesKey = "keyprefixoverrideallowlist"
 
 	strategyNamespace = "namespace"
 	strategyAppid     = "appid"
@@ -32,8 +35,29 @@ const (
 	strategyDefault   = strategyAppid
 
 	daprSeparator = "||"
+
+	// KeyPrefixMetadata is the request metadata which overrides the key prefix
+	// of a request, with one of the prefixes allowed by the store.
+	KeyPrefixMetadata = "keyPrefix"
+	// TenantMetadata is the request metadata resolving the {tenant}
+	// placeholder of key prefix templates.
+	TenantMetadata = "tenant"
+	// NamespaceMetadata is the request metadata resolving the {namespace}
+	// placeholder of key prefix templates. Defaults to the namespace of the
+	// sidecar.
+	NamespaceMetadata = "namespace"
+)
+
+// Placeholders of key prefix templates.
+const (
+	placeholderAppid     = "appid"
+	placeholderStoreName = "name"
+	placeholderNamespace = "namespace"
+	placeholderTenant    = "tenant"
 )
 
+var templatePlaceholder = regexp.MustCompile(`\{([^{}]*)\}`)
+
 var (
 	statesConfigurationLock sync.RWMutex
 	statesConfiguration     = map[string]*StoreConfiguration{}
@@ -42,14 +66,27 @@ var (
 
 type StoreConfiguration struct {
 	keyPrefixStrategy string
+	// keyPrefixTemplate is true if the strategy is a template with
+	// placeholders, such as "{namespace}-{tenant}".
+	keyPrefixTemplate bool
+	// keyPrefixOverrides are the key prefixes requests can select with the
+	// keyPrefix metadata.
+	keyPrefixOverrides []string
 }
 
 func SaveStateConfiguration(storeName string, metadata map[string]string) error {
 	strategy := strategyDefault
+	var overrides []string
 	for k, v := range metadata {
-		if strings.ToLower(k) == strategyKey { //nolint:gocritic
+		switch strings.ToLower(k) {
+		case strategyKey:
 			strategy = strings.ToLower(v)
-			break
+		case strategyOverridesKey:
+			for _, o := range strings.Split(v, ",") {
+				if o = strings.TrimSpace(o); o != "" {
+					overrides = append(overrides, o)
+				}
+			}
 		}
 	}
 
@@ -57,19 +94,58 @@ func SaveStateConfiguration(storeName string, metadata map[string]string) error
 	if err != nil {
 		return errors.StateStore(storeName).InvalidKeyName(strategy, err.Error())
 	}
+	for _, o := range overrides {
+		if err = checkKeyIllegal(o); err != nil {
+			return errors.StateStore(storeName).InvalidKeyName(o, err.Error())
+		}
+	}
+
+	template := strings.ContainsAny(strategy, "{}")
+	if template {
+		if err = checkKeyPrefixTemplate(strategy); err != nil {
+			return errors.StateStore(storeName).InvalidKeyName(strategy, err.Error())
+		}
+	}
 
 	statesConfigurationLock.Lock()
-	statesConfiguration[storeName] = &StoreConfiguration{keyPrefixStrategy: strategy}
+	statesConfiguration[storeName] = &StoreConfiguration{
+		keyPrefixStrategy:  strategy,
+		keyPrefixTemplate:  template,
+		keyPrefixOverrides: overrides,
+	}
 	statesConfigurationLock.Unlock()
 	return nil
 }
 
 func GetModifiedStateKey(key, storeName, appID string) (string, error) {
+	return GetModifiedStateKeyWithMetadata(key, storeName, appID, nil)
+}
+
+// GetModifiedStateKeyWithMetadata returns the key with the prefix of the
+// store, resolving the placeholders of key prefix templates and the key
+// prefix override from the metadata of the request.
+func GetModifiedStateKeyWithMetadata(key, storeName, appID string, metadata map[string]string) (string, error) {
 	if err := checkKeyIllegal(key); err != nil {
 		return "", errors.StateStore(storeName).InvalidKeyName(key, err.Error())
 	}
 
 	stateConfiguration := getStateConfiguration(storeName)
+
+	if override := metadata[KeyPrefixMetadata]; override != "" {
+		if !slices.Contains(stateConfiguration.keyPrefixOverrides, override) {
+			return "", errors.StateStore(storeName).InvalidKeyName(key, fmt.Sprintf("key prefix '%s' is not allowed for the state store", override))
+		}
+		return override + daprSeparator + key, nil
+	}
+
+	if stateConfiguration.keyPrefixTemplate {
+		prefix, err := resolveKeyPrefixTemplate(stateConfiguration.keyPrefixStrategy, storeName, appID, metadata)
+		if err != nil {
+			return "", errors.StateStore(storeName).InvalidKeyName(key, err.Error())
+		}
+		return prefix + daprSeparator + key, nil
+	}
+
 	switch stateConfiguration.keyPrefixStrategy {
 	case strategyNone:
 		return key, nil
@@ -127,6 +203,51 @@ func getStateConfiguration(storeName string) *StoreConfiguration {
 	return c
 }
 
+func checkKeyPrefixTemplate(template string) error {
+	if strings.Count(template, "{") != strings.Count(template, "}") ||
+		len(templatePlaceholder.FindAllString(template, -1)) != strings.Count(template, "{") {
+		return fmt.Errorf("invalid keyPrefix template '%s'", template)
+	}
+	for _, m := range templatePlaceholder.FindAllStringSubmatch(template, -1) {
+		switch m[1] {
+		case placeholderAppid, placeholderStoreName, placeholderNamespace, placeholderTenant:
+		default:
+			return fmt.Errorf("unknown placeholder '{%s}' in keyPrefix template", m[1])
+		}
+	}
+	return nil
+}
+
+func resolveKeyPrefixTemplate(template, storeName, appID string, metadata map[string]string) (string, error) {
+	var err error
+	prefix := templatePlaceholder.ReplaceAllStringFunc(template, func(placeholder string) string {
+		var v string
+		switch name := placeholder[1 : len(placeholder)-1]; name {
+		case placeholderAppid:
+			v = appID
+		case placeholderStoreName:
+			v = storeName
+		case placeholderNamespace:
+			v = metadata[NamespaceMetadata]
+			if v == "" {
+				v = namespace
+			}
+		case placeholderTenant:
+			v = metadata[TenantMetadata]
+		}
+		if err != nil {
+			return ""
+		}
+		if v == "" {
+			err = fmt.Errorf("can't resolve the placeholder %s of the keyPrefix template", placeholder)
+		} else if cerr := checkKeyIllegal(v); cerr != nil {
+			err = cerr
+		}
+		return v
+	})
+	return prefix, err
+}
+
 func checkKeyIllegal(key string) error {
 	if strings.Contains(key, daprSeparator) {
 		return fmt.Errorf("input key/keyPrefix '%s' can't contain '%s'", key, daprSeparator)
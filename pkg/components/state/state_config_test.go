@@ -165,6 +165,64 @@ func TestPrefix_StoreNotInitial(t *testing.T) {
 	require.Equal(t, key, originalStateKey)
 }
 
+func TestTemplatePrefix(t *testing.T) {
+	namespace = "ns1"
+	defer func() { namespace = "" }()
+
+	require.NoError(t, SaveStateConfiguration("template-store", map[string]string{strategyKey: "{namespace}.{appid}-{tenant}"}))
+
+	t.Run("placeholders resolved from metadata", func(t *testing.T) {
+		modifiedStateKey, err := GetModifiedStateKeyWithMetadata(key, "template-store", "appid1", map[string]string{TenantMetadata: "acme"})
+		require.NoError(t, err)
+		require.Equal(t, "ns1.appid1-acme||state-key-1234567", modifiedStateKey)
+		require.Equal(t, key, GetOriginalStateKey(modifiedStateKey))
+
+		modifiedStateKey, err = GetModifiedStateKeyWithMetadata(key, "template-store", "appid1", map[string]string{TenantMetadata: "acme", NamespaceMetadata: "ns2"})
+		require.NoError(t, err)
+		require.Equal(t, "ns2.appid1-acme||state-key-1234567", modifiedStateKey)
+	})
+
+	t.Run("unresolved placeholder", func(t *testing.T) {
+		_, err := GetModifiedStateKey(key, "template-store", "appid1")
+		require.Error(t, err)
+	})
+
+	t.Run("illegal placeholder value", func(t *testing.T) {
+		_, err := GetModifiedStateKeyWithMetadata(key, "template-store", "appid1", map[string]string{TenantMetadata: "a||b"})
+		require.Error(t, err)
+	})
+
+	t.Run("invalid templates", func(t *testing.T) {
+		for _, template := range []string{"{tenant", "{user}", "{{tenant}}", "tenant}"} {
+			require.Error(t, SaveStateConfiguration("template-store-invalid", map[string]string{strategyKey: template}), template)
+		}
+	})
+}
+
+func TestPrefixOverride(t *testing.T) {
+	require.NoError(t, SaveStateConfiguration("override-store", map[string]string{
+		strategyKey:                  strategyAppid,
+		"keyPrefixOverrideAllowlist": "shared, tenant-a",
+	}))
+
+	modifiedStateKey, err := GetModifiedStateKeyWithMetadata(key, "override-store", "appid1", map[string]string{KeyPrefixMetadata: "shared"})
+	require.NoError(t, err)
+	require.Equal(t, "shared||state-key-1234567", modifiedStateKey)
+
+	modifiedStateKey, err = GetModifiedStateKeyWithMetadata(key, "override-store", "appid1", map[string]string{KeyPrefixMetadata: "tenant-a"})
+	require.NoError(t, err)
+	require.Equal(t, "tenant-a||state-key-1234567", modifiedStateKey)
+
+	_, err = GetModifiedStateKeyWithMetadata(key, "override-store", "appid1", map[string]string{KeyPrefixMetadata: "tenant-b"})
+	require.Error(t, err)
+
+	// Not allowed for stores without an allowlist.
+	_, err = GetModifiedStateKeyWithMetadata(key, "store2", "appid1", map[string]string{KeyPrefixMetadata: "shared"})
+	require.Error(t, err)
+
+	require.Error(t, SaveStateConfiguration("override-store-invalid", map[string]string{"keyPrefixOverrideAllowlist": "a||b"}))
+}
+
 func TestStateConfigRace(t *testing.T) {
 	t.Run("data race between SaveStateConfiguration and GetModifiedStateKey", func(t *testing.T) {
 		var wg sync.WaitGroup
@@ -0,0 +1,37 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"math"
+	"time"
+
+	"github.com/dapr/components-contrib/state"
+)
+
+// RemainingTTLInSeconds returns the number of seconds left before a value read
+// from a state store expires, rounded up, from the expire time reported in the
+// metadata of the get response. The value has expired if it's not positive.
+// It returns false if the value has no TTL, or its expire time is invalid.
+func RemainingTTLInSeconds(metadata map[string]string, now time.Time) (int64, bool) {
+	expireTime, ok := metadata[state.GetRespMetaKeyTTLExpireTime]
+	if !ok {
+		return 0, false
+	}
+	t, err := time.Parse(time.RFC3339, expireTime)
+	if err != nil {
+		return 0, false
+	}
+	return int64(math.Ceil(t.Sub(now).Seconds())), true
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/dapr/components-contrib/state"
+)
+
+const (
+	// DefaultWatchPollInterval is how often keys are read when the store has no
+	// change feed and the caller doesn't set a poll interval.
+	DefaultWatchPollInterval = time.Second
+	// MinWatchPollInterval protects stores from callers polling too often.
+	MinWatchPollInterval = 100 * time.Millisecond
+)
+
+// ChangeType is the kind of change made to a key.
+type ChangeType string
+
+const (
+	ChangeCreate ChangeType = "create"
+	ChangeUpdate ChangeType = "update"
+	ChangeDelete ChangeType = "delete"
+)
+
+// ChangeEvent is a change made to a key of a state store.
+type ChangeEvent struct {
+	Key  string
+	Type ChangeType
+	// Value and ETag are the new value and ETag of the key, and are empty when
+	// the key is deleted.
+	Value []byte
+	ETag  *string
+}
+
+// ChangeHandler is called for every change to a watched key.
+type ChangeHandler func(ctx context.Context, event *ChangeEvent) error
+
+// Watcher is implemented by state stores which have a change feed, such as
+// keyspace notifications or logical decoding, to notify key changes natively.
+type Watcher interface {
+	// Watch calls handler for every change to the given keys until the context
+	// is done or handler returns an error.
+	Watch(ctx context.Context, keys []string, metadata map[string]string, handler ChangeHandler) error
+}
+
+// Watch calls handler for every change to the given keys until the context is
+// done or handler returns an error. Stores implementing Watcher are watched
+// through their change feed, and other stores are polled every interval.
+func Watch(ctx context.Context, store state.Store, keys []string, metadata map[string]string, interval time.Duration, handler ChangeHandler) error {
+	if w, ok := store.(Watcher); ok {
+		return w.Watch(ctx, keys, metadata, handler)
+	}
+
+	return poll(ctx, store, keys, metadata, interval, handler)
+}
+
+type watchedValue struct {
+	data []byte
+	etag *string
+}
+
+// poll reads the keys every interval, and calls handler for every key whose
+// ETag, or value if the store doesn't return ETags, changed since the previous
+// read. The first read only records the current values.
+func poll(ctx context.Context, store state.Store, keys []string, metadata map[string]string, interval time.Duration, handler ChangeHandler) error {
+	reqs := make([]state.GetRequest, len(keys))
+	for i, key := range keys {
+		reqs[i] = state.GetRequest{Key: key, Metadata: metadata}
+	}
+
+	var last map[string]watchedValue
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		res, err := store.BulkGet(ctx, reqs, state.BulkGetOpts{})
+		if err != nil {
+			return err
+		}
+
+		current := make(map[string]watchedValue, len(res))
+		for _, r := range res {
+			// Keys failing to be read are compared again on the next read.
+			if r.Error != "" {
+				if v, ok := last[r.Key]; ok {
+					current[r.Key] = v
+				}
+				continue
+			}
+			if r.Data != nil {
+				current[r.Key] = watchedValue{data: r.Data, etag: r.ETag}
+			}
+		}
+
+		if last != nil {
+			for _, key := range keys {
+				event := diffWatched(key, last, current)
+				if event == nil {
+					continue
+				}
+				if err = handler(ctx, event); err != nil {
+					return err
+				}
+			}
+		}
+		last = current
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// diffWatched returns the change made to key between two reads, or nil if it
+// didn't change.
+func diffWatched(key string, prev, cur map[string]watchedValue) *ChangeEvent {
+	p, existed := prev[key]
+	c, exists := cur[key]
+	switch {
+	case !existed && !exists:
+		return nil
+	case !exists:
+		return &ChangeEvent{Key: key, Type: ChangeDelete}
+	case !existed:
+		return &ChangeEvent{Key: key, Type: ChangeCreate, Value: c.data, ETag: c.etag}
+	case p.etag != nil && c.etag != nil:
+		if *p.etag == *c.etag {
+			return nil
+		}
+	case bytes.Equal(p.data, c.data):
+		return nil
+	}
+	return &ChangeEvent{Key: key, Type: ChangeUpdate, Value: c.data, ETag: c.etag}
+}
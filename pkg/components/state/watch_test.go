@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/state"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+type watcherStore struct {
+	*daprt.FakeStateStore
+}
+
+func (s watcherStore) Watch(ctx context.Context, keys []string, metadata map[string]string, handler ChangeHandler) error {
+	return handler(ctx, &ChangeEvent{Key: keys[0], Type: ChangeDelete})
+}
+
+func TestWatch(t *testing.T) {
+	t.Run("polling", func(t *testing.T) {
+		store := daprt.NewFakeStateStore()
+		require.NoError(t, store.Set(t.Context(), &state.SetRequest{Key: "updated", Value: "a"}))
+		require.NoError(t, store.Set(t.Context(), &state.SetRequest{Key: "deleted", Value: "a"}))
+
+		events := make(chan *ChangeEvent, 10)
+		errCh := make(chan error, 1)
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+		go func() {
+			errCh <- Watch(ctx, store, []string{"created", "updated", "deleted"}, nil, 10*time.Millisecond, func(ctx context.Context, event *ChangeEvent) error {
+				events <- event
+				return nil
+			})
+		}()
+
+		// Changes are only reported once the initial values are read.
+		assert.Eventually(t, func() bool {
+			return store.CallCount("BulkGet") > 0
+		}, time.Second, time.Millisecond)
+		require.NoError(t, store.Set(t.Context(), &state.SetRequest{Key: "created", Value: "b"}))
+		require.NoError(t, store.Set(t.Context(), &state.SetRequest{Key: "updated", Value: "b"}))
+		require.NoError(t, store.Set(t.Context(), &state.SetRequest{Key: "unwatched", Value: "b"}))
+		require.NoError(t, store.Delete(t.Context(), &state.DeleteRequest{Key: "deleted"}))
+
+		got := make(map[string]ChangeType)
+		for range 3 {
+			select {
+			case event := <-events:
+				got[event.Key] = event.Type
+				if event.Type != ChangeDelete {
+					assert.JSONEq(t, `"b"`, string(event.Value))
+					assert.NotNil(t, event.ETag)
+				}
+			case <-time.After(5 * time.Second):
+				require.Fail(t, "change not reported")
+			}
+		}
+		assert.Equal(t, map[string]ChangeType{
+			"created": ChangeCreate,
+			"updated": ChangeUpdate,
+			"deleted": ChangeDelete,
+		}, got)
+
+		cancel()
+		select {
+		case err := <-errCh:
+			require.ErrorIs(t, err, context.Canceled)
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "watch didn't stop")
+		}
+		assert.Empty(t, events)
+	})
+
+	t.Run("handler error stops polling", func(t *testing.T) {
+		store := daprt.NewFakeStateStore()
+		handlerErr := errors.New("client gone")
+
+		go func() {
+			assert.Eventually(t, func() bool {
+				return store.CallCount("BulkGet") > 0
+			}, time.Second, time.Millisecond)
+			assert.NoError(t, store.Set(context.Background(), &state.SetRequest{Key: "key", Value: "a"}))
+		}()
+
+		err := Watch(t.Context(), store, []string{"key"}, nil, 10*time.Millisecond, func(ctx context.Context, event *ChangeEvent) error {
+			return handlerErr
+		})
+		require.ErrorIs(t, err, handlerErr)
+	})
+
+	t.Run("native", func(t *testing.T) {
+		var got *ChangeEvent
+		err := Watch(t.Context(), watcherStore{daprt.NewFakeStateStore()}, []string{"key"}, nil, time.Second, func(ctx context.Context, event *ChangeEvent) error {
+			got = event
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, &ChangeEvent{Key: "key", Type: ChangeDelete}, got)
+	})
+}
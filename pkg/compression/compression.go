@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compression
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+)
+
+// Algorithm is a compression algorithm.
+type Algorithm string
+
+const (
+	GzipAlgorithm Algorithm = "gzip"
+	ZstdAlgorithm Algorithm = "zstd"
+)
+
+const (
+	compressionKey          = "compression"
+	compressionThresholdKey = "compressionThreshold"
+
+	// defaultThreshold is the size in bytes from which values are compressed,
+	// when not set on the component.
+	defaultThreshold = 1024
+)
+
+// Options holds the compression options of a component.
+type Options struct {
+	Algorithm Algorithm
+	// Threshold is the size in bytes from which values are compressed.
+	Threshold int
+}
+
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+// ComponentCompressionOptions returns the compression options set on a
+// component. The algorithm is empty if compression isn't enabled.
+func ComponentCompressionOptions(component v1alpha1.Component) (Options, error) {
+	opts := Options{Threshold: defaultThreshold}
+
+	for _, m := range component.Spec.Metadata {
+		switch m.Name {
+		case compressionKey:
+			algorithm := Algorithm(strings.ToLower(m.Value.String()))
+			switch algorithm {
+			case "", "none":
+				algorithm = ""
+			case GzipAlgorithm, ZstdAlgorithm:
+			default:
+				return Options{}, fmt.Errorf("unsupported compression algorithm %q", m.Value.String())
+			}
+			opts.Algorithm = algorithm
+		case compressionThresholdKey:
+			threshold, err := strconv.Atoi(m.Value.String())
+			if err != nil || threshold < 0 {
+				return Options{}, fmt.Errorf("invalid %s %q: must be a non-negative number of bytes", compressionThresholdKey, m.Value.String())
+			}
+			opts.Threshold = threshold
+		}
+	}
+
+	return opts, nil
+}
+
+func compress(value []byte, algorithm Algorithm) ([]byte, error) {
+	switch algorithm {
+	case GzipAlgorithm:
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		if _, err := zw.Write(value); err != nil {
+			return nil, err
+		}
+		if err := zw.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case ZstdAlgorithm:
+		return zstdEncoder.EncodeAll(value, nil), nil
+	default:
+		return nil, fmt.Errorf("unsupported compression algorithm %q", algorithm)
+	}
+}
+
+func decompress(value []byte, algorithm Algorithm) ([]byte, error) {
+	switch algorithm {
+	case GzipAlgorithm:
+		zr, err := gzip.NewReader(bytes.NewReader(value))
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		return io.ReadAll(zr)
+	case ZstdAlgorithm:
+		return zstdDecoder.DecodeAll(value, nil)
+	default:
+		return nil, fmt.Errorf("unsupported compression algorithm %q", algorithm)
+	}
+}
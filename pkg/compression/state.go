@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compression
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+var (
+	compressedStateStores     = map[string]Options{}
+	compressedStateStoresLock sync.RWMutex
+)
+
+// envelopePrefix is the start of the envelope of compressed values, which
+// records the algorithm the value is compressed with.
+var envelopePrefix = []byte(`{"daprCompression":`)
+
+// envelope is a compressed value, as saved in the state store. Data is
+// encoded as base64, so the envelope can be saved by stores which only accept
+// strings.
+type envelope struct {
+	Compression Algorithm `json:"daprCompression"`
+	Data        []byte    `json:"data"`
+}
+
+// AddCompressedStateStore enables the compression of the values of a state
+// store.
+func AddCompressedStateStore(storeName string, opts Options) bool {
+	compressedStateStoresLock.Lock()
+	defer compressedStateStoresLock.Unlock()
+
+	if _, ok := compressedStateStores[storeName]; ok {
+		return false
+	}
+
+	compressedStateStores[storeName] = opts
+	return true
+}
+
+// RemoveCompressedStateStore disables the compression of the values of a
+// state store.
+func RemoveCompressedStateStore(storeName string) {
+	compressedStateStoresLock.Lock()
+	defer compressedStateStoresLock.Unlock()
+
+	delete(compressedStateStores, storeName)
+}
+
+// CompressedStateStore returns true if the values of a state store are
+// compressed.
+func CompressedStateStore(storeName string) bool {
+	_, ok := getOptions(storeName)
+	return ok
+}
+
+// TryCompressValue compresses a value if the state store has compression
+// enabled and the value is at least as large as the threshold. Values which
+// don't get smaller are returned unmodified.
+func TryCompressValue(storeName string, value []byte) ([]byte, error) {
+	opts, ok := getOptions(storeName)
+	if !ok || len(value) < opts.Threshold || len(value) == 0 {
+		return value, nil
+	}
+
+	data, err := compress(value, opts.Algorithm)
+	if err != nil {
+		return value, fmt.Errorf("could not compress data for state store %s: %w", storeName, err)
+	}
+	val, err := json.Marshal(envelope{
+		Compression: opts.Algorithm,
+		Data:        data,
+	})
+	if err != nil {
+		return value, fmt.Errorf("could not compress data for state store %s: %w", storeName, err)
+	}
+
+	if len(val) >= len(value) {
+		return value, nil
+	}
+	return val, nil
+}
+
+// IsCompressed returns true if a value was compressed by TryCompressValue.
+func IsCompressed(value []byte) bool {
+	return bytes.HasPrefix(value, envelopePrefix)
+}
+
+// TryDecompressValue decompresses a value saved by TryCompressValue. Values
+// which weren't compressed are returned unmodified. The algorithm is read from
+// the value, so values remain readable when the algorithm of the state store
+// is changed.
+func TryDecompressValue(storeName string, value []byte) ([]byte, error) {
+	if !IsCompressed(value) {
+		return value, nil
+	}
+
+	var env envelope
+	if err := json.Unmarshal(value, &env); err != nil || env.Compression == "" {
+		// Not an envelope, but a JSON value which happens to look like one.
+		return value, nil //nolint:nilerr
+	}
+
+	val, err := decompress(env.Data, env.Compression)
+	if err != nil {
+		return value, fmt.Errorf("could not decompress data for state store %s: %w", storeName, err)
+	}
+	return val, nil
+}
+
+func getOptions(storeName string) (Options, bool) {
+	compressedStateStoresLock.RLock()
+	defer compressedStateStoresLock.RUnlock()
+
+	opts, ok := compressedStateStores[storeName]
+	return opts, ok
+}
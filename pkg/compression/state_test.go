@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compression
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	commonapi "github.com/dapr/dapr/pkg/apis/common"
+	"github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+)
+
+func newComponent(md map[string]string) v1alpha1.Component {
+	var comp v1alpha1.Component
+	for k, v := range md {
+		comp.Spec.Metadata = append(comp.Spec.Metadata, commonapi.NameValuePair{
+			Name:  k,
+			Value: commonapi.DynamicValue{JSON: apiextv1.JSON{Raw: []byte(v)}},
+		})
+	}
+	return comp
+}
+
+func TestComponentCompressionOptions(t *testing.T) {
+	t.Run("not enabled", func(t *testing.T) {
+		opts, err := ComponentCompressionOptions(newComponent(nil))
+		require.NoError(t, err)
+		assert.Equal(t, Options{Threshold: defaultThreshold}, opts)
+	})
+
+	t.Run("enabled with threshold", func(t *testing.T) {
+		opts, err := ComponentCompressionOptions(newComponent(map[string]string{
+			"compression":          "ZSTD",
+			"compressionThreshold": "10",
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, Options{Algorithm: ZstdAlgorithm, Threshold: 10}, opts)
+	})
+
+	t.Run("unsupported algorithm", func(t *testing.T) {
+		_, err := ComponentCompressionOptions(newComponent(map[string]string{"compression": "lz4"}))
+		require.Error(t, err)
+	})
+
+	t.Run("invalid threshold", func(t *testing.T) {
+		_, err := ComponentCompressionOptions(newComponent(map[string]string{
+			"compression":          "gzip",
+			"compressionThreshold": "-1",
+		}))
+		require.Error(t, err)
+	})
+}
+
+func TestTryCompressValue(t *testing.T) {
+	large := []byte(`{"data":"` + strings.Repeat("dapr", 1000) + `"}`)
+
+	for _, algorithm := range []Algorithm{GzipAlgorithm, ZstdAlgorithm} {
+		t.Run(string(algorithm), func(t *testing.T) {
+			AddCompressedStateStore("store", Options{Algorithm: algorithm, Threshold: 100})
+			defer RemoveCompressedStateStore("store")
+
+			val, err := TryCompressValue("store", large)
+			require.NoError(t, err)
+			assert.True(t, IsCompressed(val))
+			assert.Less(t, len(val), len(large))
+
+			dec, err := TryDecompressValue("store", val)
+			require.NoError(t, err)
+			assert.Equal(t, large, dec)
+		})
+	}
+
+	t.Run("values below the threshold aren't compressed", func(t *testing.T) {
+		AddCompressedStateStore("store", Options{Algorithm: GzipAlgorithm, Threshold: 100})
+		defer RemoveCompressedStateStore("store")
+
+		val, err := TryCompressValue("store", []byte(`{"a":1}`))
+		require.NoError(t, err)
+		assert.Equal(t, []byte(`{"a":1}`), val)
+	})
+
+	t.Run("values which don't get smaller aren't compressed", func(t *testing.T) {
+		AddCompressedStateStore("store", Options{Algorithm: GzipAlgorithm})
+		defer RemoveCompressedStateStore("store")
+
+		val, err := TryCompressValue("store", []byte("abc"))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("abc"), val)
+	})
+
+	t.Run("store without compression", func(t *testing.T) {
+		val, err := TryCompressValue("other", large)
+		require.NoError(t, err)
+		assert.Equal(t, large, val)
+	})
+}
+
+func TestTryDecompressValue(t *testing.T) {
+	AddCompressedStateStore("store", Options{Algorithm: GzipAlgorithm})
+	defer RemoveCompressedStateStore("store")
+
+	t.Run("algorithm is read from the value", func(t *testing.T) {
+		data, err := compress([]byte("hello"), ZstdAlgorithm)
+		require.NoError(t, err)
+		val := []byte(`{"daprCompression":"zstd","data":"` + base64.StdEncoding.EncodeToString(data) + `"}`)
+
+		dec, err := TryDecompressValue("store", val)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("hello"), dec)
+	})
+
+	t.Run("uncompressed values are returned unmodified", func(t *testing.T) {
+		for _, v := range []string{`{"a":1}`, `{"daprCompression":1}`, "plain"} {
+			dec, err := TryDecompressValue("store", []byte(v))
+			require.NoError(t, err)
+			assert.Equal(t, []byte(v), dec)
+		}
+	})
+
+	t.Run("corrupted value", func(t *testing.T) {
+		_, err := TryDecompressValue("store", []byte(`{"daprCompression":"gzip","data":"AAAA"}`))
+		require.Error(t, err)
+	})
+}
+
+func TestCompressedStateStore(t *testing.T) {
+	assert.False(t, CompressedStateStore("store"))
+	assert.True(t, AddCompressedStateStore("store", Options{Algorithm: GzipAlgorithm}))
+	assert.False(t, AddCompressedStateStore("store", Options{Algorithm: ZstdAlgorithm}))
+	assert.True(t, CompressedStateStore("store"))
+	RemoveCompressedStateStore("store")
+	assert.False(t, CompressedStateStore("store"))
+}
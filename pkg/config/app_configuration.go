@@ -28,6 +28,16 @@ type ApplicationConfig struct {
 
 	// Duplicate of the above config so we can assign it to individual entities.
 	EntityConfigs []EntityConfig `json:"entitiesConfig,omitempty"`
+
+	LifecycleHooks LifecycleHooksConfig `json:"lifecycleHooks,omitempty"`
+}
+
+// LifecycleHooksConfig enables the post-activate and pre-deactivate hooks of
+// the actors hosted by the app.
+type LifecycleHooksConfig struct {
+	Enabled bool `json:"enabled"`
+	// Duration. example: "5s".
+	Timeout string `json:"timeout,omitempty"`
 }
 
 type ReentrancyConfig struct {
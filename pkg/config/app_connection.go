@@ -14,6 +14,12 @@ limitations under the License.
 package config
 
 import (
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/dapr/dapr/pkg/config/protocol"
@@ -26,6 +32,13 @@ const (
 	AppHealthConfigDefaultProbeTimeout = 500 * time.Millisecond
 	// AppHealthConfigDefaultThreshold is the default threshold for determining failures in app health checks.
 	AppHealthConfigDefaultThreshold = int32(3)
+
+	// AppHTTPPoolDefaultMaxIdleConns is the default maximum number of idle connections to the app.
+	AppHTTPPoolDefaultMaxIdleConns = 64
+	// AppHTTPPoolDefaultMaxConnsPerHost is the default maximum number of connections to the app.
+	AppHTTPPoolDefaultMaxConnsPerHost = 1024
+	// AppHTTPPoolDefaultIdleConnTimeout is the default time an idle connection to the app is kept open.
+	AppHTTPPoolDefaultIdleConnTimeout = 90 * time.Second
 )
 
 // AppHealthConfig is the configuration object for the app health probes.
@@ -36,12 +49,178 @@ type AppHealthConfig struct {
 	Threshold     int32
 }
 
+// AppHTTPPoolConfig is the configuration of the connection pool of the HTTP app channel.
+type AppHTTPPoolConfig struct {
+	MaxIdleConns    int
+	MaxConnsPerHost int
+	IdleConnTimeout time.Duration
+	// EnableHTTP2 allows negotiating HTTP/2 with apps using the https protocol.
+	EnableHTTP2 bool
+}
+
 // AppConnectionConfig holds the configuration for the app connection.
 type AppConnectionConfig struct {
 	ChannelAddress      string
 	HealthCheck         *AppHealthConfig
 	HealthCheckHTTPPath string
+	HTTPPool            AppHTTPPoolConfig
 	MaxConcurrency      int
 	Port                int
 	Protocol            protocol.Protocol
+	TLS                 AppTLSConfig
+	// Path of the Unix domain socket the app listens on. If set, it is used instead of ChannelAddress and Port.
+	UnixDomainSocket string
+	// Additional endpoints of the app, which receive the callbacks routed to them instead of the app port.
+	Endpoints []AppEndpoint
+}
+
+// AppTLSSource is the source of the certificates used for TLS on the app channel.
+type AppTLSSource string
+
+const (
+	// AppTLSSourceFile loads the certificates from PEM files.
+	AppTLSSourceFile AppTLSSource = "file"
+	// AppTLSSourceSecretStore loads the certificates from a secret in a secret store.
+	AppTLSSourceSecretStore AppTLSSource = "secretstore"
+	// AppTLSSourceSentry uses the identity certificate issued by Sentry, and
+	// requires the app to present a certificate for the same identity.
+	AppTLSSourceSentry AppTLSSource = "sentry"
+)
+
+// Keys of the secret with the certificates of the app channel, which match the keys of Kubernetes TLS secrets.
+const (
+	AppTLSSecretKeyCA   = "ca.crt"
+	AppTLSSecretKeyCert = "tls.crt"
+	AppTLSSecretKeyKey  = "tls.key"
+)
+
+// AppTLSConfig is the configuration of TLS on the app channel, used when the app protocol is https or grpcs.
+// When no source is set, Dapr connects to the app over TLS without verifying its certificate.
+type AppTLSConfig struct {
+	Source AppTLSSource
+	// PEM files with the CA that signed the certificate of the app, and the client certificate of Dapr.
+	CAFile   string
+	CertFile string
+	KeyFile  string
+	// Secret store and name of the secret with the certificates.
+	SecretStore string
+	SecretName  string
+	// ServerName is the name verified in the certificate of the app; defaults to the channel address.
+	ServerName string
+}
+
+// Validate returns an error if the TLS configuration is invalid for the app protocol.
+func (c AppTLSConfig) Validate(p protocol.Protocol) error {
+	if c.Source == "" {
+		if c.CAFile != "" || c.CertFile != "" || c.KeyFile != "" || c.SecretStore != "" || c.SecretName != "" {
+			return errors.New("app channel TLS options require 'app-channel-tls-source' to be set")
+		}
+		return nil
+	}
+
+	if !p.HasTLS() {
+		return fmt.Errorf("app channel TLS requires app protocol 'https' or 'grpcs', but it is '%s'", p)
+	}
+
+	switch c.Source {
+	case AppTLSSourceFile:
+		if c.CAFile == "" && c.CertFile == "" {
+			return errors.New("app channel TLS source 'file' requires a CA file or a certificate file")
+		}
+		if (c.CertFile == "") != (c.KeyFile == "") {
+			return errors.New("app channel TLS certificate and key files must be set together")
+		}
+	case AppTLSSourceSecretStore:
+		if c.SecretStore == "" || c.SecretName == "" {
+			return errors.New("app channel TLS source 'secretstore' requires a secret store and a secret name")
+		}
+	case AppTLSSourceSentry:
+	default:
+		return fmt.Errorf("invalid app channel TLS source '%s': must be one of '%s', '%s' or '%s'", c.Source, AppTLSSourceFile, AppTLSSourceSecretStore, AppTLSSourceSentry)
+	}
+	return nil
+}
+
+// AppCallback is a kind of callback Dapr sends to the app.
+type AppCallback string
+
+const (
+	// AppCallbackInvocation is the callback for service invocation requests.
+	AppCallbackInvocation AppCallback = "invocation"
+	// AppCallbackPubSub is the callback for pub/sub messages.
+	AppCallbackPubSub AppCallback = "pubsub"
+	// AppCallbackBindings is the callback for input and output bindings events.
+	AppCallbackBindings AppCallback = "bindings"
+	// AppCallbackJobs is the callback for triggered jobs.
+	AppCallbackJobs AppCallback = "jobs"
+)
+
+var appCallbacks = []AppCallback{AppCallbackInvocation, AppCallbackPubSub, AppCallbackBindings, AppCallbackJobs}
+
+// AppEndpoint is an additional port the app listens on, with the callbacks that are sent to it.
+type AppEndpoint struct {
+	Port      int
+	Protocol  protocol.Protocol
+	Callbacks []AppCallback
+}
+
+// ParseAppEndpoint parses an app endpoint in the format "<protocol>:<port>=<callback>[,<callback>...]",
+// for example "grpc:50001=pubsub,bindings".
+func ParseAppEndpoint(val string) (AppEndpoint, error) {
+	target, callbacks, ok := strings.Cut(val, "=")
+	if !ok || callbacks == "" {
+		return AppEndpoint{}, fmt.Errorf("invalid app endpoint %q: must be in the format '<protocol>:<port>=<callback>[,<callback>...]'", val)
+	}
+
+	proto, portStr, ok := strings.Cut(target, ":")
+	if !ok {
+		return AppEndpoint{}, fmt.Errorf("invalid app endpoint %q: missing port", val)
+	}
+
+	var e AppEndpoint
+	switch p := protocol.Protocol(strings.ToLower(proto)); p {
+	case protocol.HTTPProtocol, protocol.HTTPSProtocol, protocol.H2CProtocol, protocol.GRPCProtocol, protocol.GRPCSProtocol:
+		e.Protocol = p
+	default:
+		return AppEndpoint{}, fmt.Errorf("invalid app endpoint %q: invalid protocol %q", val, proto)
+	}
+
+	port, err := strconv.Atoi(portStr)
+	if err != nil || port <= 0 || port > 65535 {
+		return AppEndpoint{}, fmt.Errorf("invalid app endpoint %q: invalid port %q", val, portStr)
+	}
+	e.Port = port
+
+	for _, cb := range strings.Split(callbacks, ",") {
+		cb := AppCallback(strings.ToLower(strings.TrimSpace(cb)))
+		if !slices.Contains(appCallbacks, cb) {
+			return AppEndpoint{}, fmt.Errorf("invalid app endpoint %q: invalid callback %q", val, cb)
+		}
+		e.Callbacks = append(e.Callbacks, cb)
+	}
+
+	return e, nil
+}
+
+// ForEndpoint returns the configuration to connect to the app endpoint.
+// The endpoint shares the channel address and the other settings of the app connection.
+func (c AppConnectionConfig) ForEndpoint(e AppEndpoint) AppConnectionConfig {
+	c.Port = e.Port
+	c.Protocol = e.Protocol
+	c.UnixDomainSocket = ""
+	c.Endpoints = nil
+	return c
+}
+
+// IsConfigured returns true if Dapr can connect to the app.
+func (c AppConnectionConfig) IsConfigured() bool {
+	return c.Port > 0 || c.UnixDomainSocket != ""
+}
+
+// DialNetworkAddress returns the network and address to dial the app.
+func (c AppConnectionConfig) DialNetworkAddress() (network string, address string) {
+	if c.UnixDomainSocket != "" {
+		return "unix", c.UnixDomainSocket
+	}
+	return "tcp", net.JoinHostPort(c.ChannelAddress, strconv.Itoa(c.Port))
 }
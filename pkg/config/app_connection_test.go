@@ -0,0 +1,121 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/config/protocol"
+)
+
+func TestParseAppEndpoint(t *testing.T) {
+	tests := []struct {
+		val     string
+		want    AppEndpoint
+		wantErr bool
+	}{
+		{
+			val: "grpc:50001=pubsub,bindings",
+			want: AppEndpoint{
+				Port:      50001,
+				Protocol:  protocol.GRPCProtocol,
+				Callbacks: []AppCallback{AppCallbackPubSub, AppCallbackBindings},
+			},
+		},
+		{
+			val: "HTTP:8081=Invocation",
+			want: AppEndpoint{
+				Port:      8081,
+				Protocol:  protocol.HTTPProtocol,
+				Callbacks: []AppCallback{AppCallbackInvocation},
+			},
+		},
+		{val: "grpc:50001", wantErr: true},
+		{val: "grpc:50001=", wantErr: true},
+		{val: "50001=pubsub", wantErr: true},
+		{val: "tcp:50001=pubsub", wantErr: true},
+		{val: "grpc:0=pubsub", wantErr: true},
+		{val: "grpc:foo=pubsub", wantErr: true},
+		{val: "grpc:50001=actors", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.val, func(t *testing.T) {
+			got, err := ParseAppEndpoint(tt.val)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAppConnectionConfigForEndpoint(t *testing.T) {
+	c := AppConnectionConfig{
+		ChannelAddress:   "127.0.0.1",
+		Port:             3000,
+		Protocol:         protocol.HTTPProtocol,
+		MaxConcurrency:   10,
+		UnixDomainSocket: "/tmp/app.socket",
+		Endpoints: []AppEndpoint{{
+			Port:      50001,
+			Protocol:  protocol.GRPCProtocol,
+			Callbacks: []AppCallback{AppCallbackPubSub},
+		}},
+	}
+
+	assert.Equal(t, AppConnectionConfig{
+		ChannelAddress: "127.0.0.1",
+		Port:           50001,
+		Protocol:       protocol.GRPCProtocol,
+		MaxConcurrency: 10,
+	}, c.ForEndpoint(c.Endpoints[0]))
+}
+
+func TestAppTLSConfigValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   AppTLSConfig
+		protocol protocol.Protocol
+		wantErr  bool
+	}{
+		{name: "not configured", protocol: protocol.HTTPProtocol},
+		{name: "options without source", config: AppTLSConfig{CAFile: "ca.crt"}, protocol: protocol.HTTPSProtocol, wantErr: true},
+		{name: "protocol without TLS", config: AppTLSConfig{Source: AppTLSSourceSentry}, protocol: protocol.GRPCProtocol, wantErr: true},
+		{name: "invalid source", config: AppTLSConfig{Source: "foo"}, protocol: protocol.HTTPSProtocol, wantErr: true},
+		{name: "sentry", config: AppTLSConfig{Source: AppTLSSourceSentry}, protocol: protocol.GRPCSProtocol},
+		{name: "file with CA", config: AppTLSConfig{Source: AppTLSSourceFile, CAFile: "ca.crt"}, protocol: protocol.HTTPSProtocol},
+		{name: "file with certificate", config: AppTLSConfig{Source: AppTLSSourceFile, CertFile: "tls.crt", KeyFile: "tls.key"}, protocol: protocol.HTTPSProtocol},
+		{name: "file without files", config: AppTLSConfig{Source: AppTLSSourceFile}, protocol: protocol.HTTPSProtocol, wantErr: true},
+		{name: "file with certificate without key", config: AppTLSConfig{Source: AppTLSSourceFile, CertFile: "tls.crt"}, protocol: protocol.HTTPSProtocol, wantErr: true},
+		{name: "secret store", config: AppTLSConfig{Source: AppTLSSourceSecretStore, SecretStore: "store", SecretName: "tls"}, protocol: protocol.HTTPSProtocol},
+		{name: "secret store without secret", config: AppTLSConfig{Source: AppTLSSourceSecretStore, SecretStore: "store"}, protocol: protocol.HTTPSProtocol, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate(tt.protocol)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
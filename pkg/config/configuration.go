@@ -54,6 +54,9 @@ const (
 
 	// Enables feature to support workflows in a clustered deployment.
 	WorkflowsClusteredDeployment Feature = "WorkflowsClusteredDeployment"
+
+	// Enables serving read-only actor invocations from read replicas.
+	ActorReadReplicas Feature = "ActorReadReplicas"
 )
 
 // end feature flags section
@@ -67,6 +70,8 @@ const (
 	DefaultNamespace    = "default"
 	ActionPolicyApp     = "app"
 	ActionPolicyGlobal  = "global"
+
+	defaultPubSubMaxLabelCardinality = 1000
 )
 
 var defaultFeatures = make(map[Feature]bool)
@@ -111,6 +116,7 @@ type AccessControlListOperationAction struct {
 type ConfigurationSpec struct {
 	HTTPPipelineSpec    *PipelineSpec       `json:"httpPipeline,omitempty"    yaml:"httpPipeline,omitempty"`
 	AppHTTPPipelineSpec *PipelineSpec       `json:"appHttpPipeline,omitempty" yaml:"appHttpPipeline,omitempty"`
+	GRPCPipelineSpec    *PipelineSpec       `json:"grpcPipeline,omitempty"    yaml:"grpcPipeline,omitempty"`
 	TracingSpec         *TracingSpec        `json:"tracing,omitempty"         yaml:"tracing,omitempty"`
 	MTLSSpec            *MTLSSpec           `json:"mtls,omitempty"            yaml:"mtls,omitempty"`
 	MetricSpec          *MetricSpec         `json:"metric,omitempty"          yaml:"metric,omitempty"`
@@ -141,6 +147,41 @@ type WorkflowSpec struct {
 	// state once a workflow reaches a terminal state. If not set, workflow
 	// instances will not be automatically purged.
 	StateRetentionPolicy *WorkflowStateRetentionPolicy `json:"stateRetentionPolicy,omitempty" yaml:"stateRetentionPolicy,omitempty"`
+
+	// Versions are the latest versions of the workflows, by workflow name. New
+	// instances of a workflow start on its latest version, unless started on
+	// another version, while running instances keep replaying the version
+	// they started on.
+	Versions map[string]string `json:"versions,omitempty" yaml:"versions,omitempty"`
+
+	// EventBufferTTL is how long external events raised to a workflow instance
+	// which hasn't been started yet are retained, to be delivered once the
+	// instance starts. If not set, raising an event to such an instance fails.
+	EventBufferTTL *time.Duration `json:"eventBufferTTL,omitempty" yaml:"eventBufferTTL,omitempty"`
+
+	// MaxConcurrentChildWorkflows is the maximum number of child workflow
+	// executions that can run concurrently in a single Dapr instance. Child
+	// workflow executions beyond this are queued until others complete. If
+	// omitted, no maximum is enforced.
+	MaxConcurrentChildWorkflows int32 `json:"maxConcurrentChildWorkflows,omitempty" yaml:"maxConcurrentChildWorkflows,omitempty"`
+
+	// Limits are the limits on the concurrently running activities and child
+	// workflows of the workflows, by workflow name.
+	Limits map[string]WorkflowLimits `json:"limits,omitempty" yaml:"limits,omitempty"`
+}
+
+// WorkflowLimits are the limits on the concurrently running activities and
+// child workflows of the instances of a workflow, in a single Dapr instance.
+// Executions beyond a limit are queued until others complete. A zero limit
+// means no limit.
+type WorkflowLimits struct {
+	// MaxConcurrentActivities is the maximum number of activities of the
+	// workflow that can run concurrently.
+	MaxConcurrentActivities int32 `json:"maxConcurrentActivities,omitempty" yaml:"maxConcurrentActivities,omitempty"`
+
+	// MaxConcurrentChildWorkflows is the maximum number of child workflows of
+	// the workflow whose executions can run concurrently.
+	MaxConcurrentChildWorkflows int32 `json:"maxConcurrentChildWorkflows,omitempty" yaml:"maxConcurrentChildWorkflows,omitempty"`
 }
 
 // WorkflowStateRetentionPolicy defines the retention policy of workflow state
@@ -165,6 +206,37 @@ type WorkflowStateRetentionPolicy struct {
 	// Terminated is the TTL for purging workflow instances that reach the
 	// Terminated terminal state.
 	Terminated *time.Duration `json:"terminated,omitempty" yaml:"terminated,omitempty"`
+
+	// MaxHistoryLength is the maximum number of history events of a workflow
+	// instance. Running workflow instances whose history grows past it are
+	// terminated.
+	MaxHistoryLength *int64 `json:"maxHistoryLength,omitempty" yaml:"maxHistoryLength,omitempty"`
+
+	// ReapInterval is how often the workflow instances are scanned to purge
+	// those past their retention TTL, including those which reached a terminal
+	// state before the policy was set, and to terminate those past the maximum
+	// history length. Defaults to 1h.
+	ReapInterval *time.Duration `json:"reapInterval,omitempty" yaml:"reapInterval,omitempty"`
+}
+
+// TTL returns the TTL of workflow instances which reached the given terminal
+// runtime status, e.g. "COMPLETED", and the name of the policy it's from. It
+// returns nil if instances with the status aren't purged.
+func (p *WorkflowStateRetentionPolicy) TTL(status string) (*time.Duration, string) {
+	switch {
+	case p == nil:
+		return nil, ""
+	case p.Completed != nil && status == "COMPLETED":
+		return p.Completed, "completed"
+	case p.Terminated != nil && status == "TERMINATED":
+		return p.Terminated, "terminated"
+	case p.Failed != nil && status == "FAILED":
+		return p.Failed, "failed"
+	case p.AnyTerminal != nil:
+		return p.AnyTerminal, "anyterminal"
+	default:
+		return nil, ""
+	}
 }
 
 func (w *WorkflowSpec) GetMaxConcurrentWorkflowInvocations() *int32 {
@@ -183,6 +255,19 @@ func (w *WorkflowSpec) GetMaxConcurrentActivityInvocations() *int32 {
 
 type SecretsSpec struct {
 	Scopes []SecretsScope `json:"scopes,omitempty"`
+	// Audit emits an audit record for every secret accessed through the secrets APIs.
+	Audit *SecretsAuditSpec `json:"audit,omitempty" yaml:"audit,omitempty"`
+}
+
+// SecretsAuditSpec configures the sink of the audit records of the secrets APIs.
+type SecretsAuditSpec struct {
+	// Sink is one of "log" (default), "pubsub" or "otlp".
+	Sink string `json:"sink,omitempty" yaml:"sink,omitempty"`
+	// Pubsub component and topic the records are published to, for the "pubsub" sink.
+	PubsubName string `json:"pubsubName,omitempty" yaml:"pubsubName,omitempty"`
+	Topic      string `json:"topic,omitempty"      yaml:"topic,omitempty"`
+	// Otel is the OTLP logs endpoint the records are exported to, for the "otlp" sink.
+	Otel *OtelSpec `json:"otel,omitempty" yaml:"otel,omitempty"`
 }
 
 // SecretsScope defines the scope for secrets.
@@ -195,6 +280,22 @@ type SecretsScope struct {
 
 type PipelineSpec struct {
 	Handlers []HandlerSpec `json:"handlers,omitempty" yaml:"handlers,omitempty"`
+	// ResponseHandlers process the response on the way back to the caller, in order.
+	// The response is buffered until all the response handlers have run; streamed responses and upgraded connections skip them.
+	ResponseHandlers []HandlerSpec `json:"responseHandlers,omitempty" yaml:"responseHandlers,omitempty"`
+	// Routes are pipelines scoped to URL path prefixes or caller app IDs.
+	// A request that matches a route runs through the handlers of the first route it matches, instead of the handlers above.
+	Routes []PipelineRouteSpec `json:"routes,omitempty" yaml:"routes,omitempty"`
+}
+
+// PipelineRouteSpec is a middleware pipeline for the requests that match all of its conditions.
+type PipelineRouteSpec struct {
+	// Requests whose URL path is the prefix or is below it.
+	PathPrefix string `json:"pathPrefix,omitempty" yaml:"pathPrefix,omitempty"`
+	// IDs of the calling apps, read from the "dapr-caller-app-id" header.
+	AppIDs           []string      `json:"appIDs,omitempty"           yaml:"appIDs,omitempty"`
+	Handlers         []HandlerSpec `json:"handlers,omitempty"         yaml:"handlers,omitempty"`
+	ResponseHandlers []HandlerSpec `json:"responseHandlers,omitempty" yaml:"responseHandlers,omitempty"`
 }
 
 // APISpec describes the configuration for Dapr APIs.
@@ -203,13 +304,50 @@ type APISpec struct {
 	Allowed APIAccessRules `json:"allowed,omitempty"`
 	// List of denied APIs. Can be used in conjunction with allowed.
 	Denied APIAccessRules `json:"denied,omitempty"`
+	// Rate limits for groups of APIs, shared by the HTTP and gRPC servers.
+	RateLimits []APIRateLimit `json:"rateLimits,omitempty"`
+	// Action for requests that are not matched by any allowed rule: "allow" (default) or "deny".
+	DefaultAction APIAccessDefaultAction `json:"defaultAction,omitempty"`
+}
+
+// APIAccessDefaultAction is the type for the default action of the API access policy.
+type APIAccessDefaultAction string
+
+const (
+	APIAccessDefaultActionAllow APIAccessDefaultAction = "allow"
+	APIAccessDefaultActionDeny  APIAccessDefaultAction = "deny"
+)
+
+// APIRateLimit describes a token-bucket rate limit for a group of Dapr APIs.
+type APIRateLimit struct {
+	// Name of the API group, such as "state", "publish", "invoke" or "actors".
+	Name string `json:"name"`
+	// Number of requests per second allowed on average.
+	RequestsPerSecond int `json:"requestsPerSecond"`
+	// Maximum number of requests allowed at once. Defaults to RequestsPerSecond.
+	Burst int `json:"burst,omitempty"`
 }
 
 // APIAccessRule describes an access rule for allowing a Dapr API to be enabled and accessible by an app.
+// Rules with conditions only match the requests that satisfy all of them, and are evaluated on each request.
 type APIAccessRule struct {
 	Name     string                `json:"name"`
 	Version  string                `json:"version"`
 	Protocol APIAccessRuleProtocol `json:"protocol"`
+
+	// HTTP verbs, such as "GET" or "POST". HTTP only.
+	Verbs []string `json:"verbs,omitempty"`
+	// Prefix of the request path, such as "/v1.0/state/mystore". HTTP only.
+	PathPrefix string `json:"pathPrefix,omitempty"`
+	// gRPC methods, either the method name (e.g. "SaveState") or the full method. gRPC only.
+	GRPCMethods []string `json:"grpcMethods,omitempty"`
+	// Headers or gRPC metadata keys that must be present; a non-empty value must also match.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// HasConditions returns true if the rule only matches requests that satisfy some conditions.
+func (r APIAccessRule) HasConditions() bool {
+	return len(r.Verbs) > 0 || r.PathPrefix != "" || len(r.GRPCMethods) > 0 || len(r.Metadata) > 0
 }
 
 // APIAccessRules is a list of API access rules (allowlist or denylist).
@@ -223,6 +361,16 @@ const (
 	APIAccessRuleProtocolGRPC APIAccessRuleProtocol = "grpc"
 )
 
+// HasConditions returns true if any of the rules has conditions.
+func (r APIAccessRules) HasConditions() bool {
+	for _, v := range r {
+		if v.HasConditions() {
+			return true
+		}
+	}
+	return false
+}
+
 // GetRulesByProtocol returns a list of APIAccessRule objects for a protocol
 // The result is a map where the key is in the format "<version>/<endpoint>"
 func (r APIAccessRules) GetRulesByProtocol(protocol APIAccessRuleProtocol) map[string]struct{} {
@@ -259,10 +407,103 @@ type SelectorField struct {
 }
 
 type TracingSpec struct {
-	SamplingRate string      `json:"samplingRate,omitempty" yaml:"samplingRate,omitempty"`
-	Stdout       bool        `json:"stdout,omitempty" yaml:"stdout,omitempty"`
-	Zipkin       *ZipkinSpec `json:"zipkin,omitempty" yaml:"zipkin,omitempty"`
-	Otel         *OtelSpec   `json:"otel,omitempty" yaml:"otel,omitempty"`
+	SamplingRate string              `json:"samplingRate,omitempty" yaml:"samplingRate,omitempty"`
+	Sampler      *TracingSamplerSpec `json:"sampler,omitempty" yaml:"sampler,omitempty"`
+	Stdout       bool                `json:"stdout,omitempty" yaml:"stdout,omitempty"`
+	Zipkin       *ZipkinSpec         `json:"zipkin,omitempty" yaml:"zipkin,omitempty"`
+	Otel         *OtelSpec           `json:"otel,omitempty" yaml:"otel,omitempty"`
+	// Rules that add span attributes extracted from the incoming requests.
+	AttributeRules []TracingAttributeRule `json:"attributeRules,omitempty" yaml:"attributeRules,omitempty"`
+	// If set, traces are buffered locally and only exported if they errored or were slow.
+	TailSampling *TracingTailSamplingSpec `json:"tailSampling,omitempty" yaml:"tailSampling,omitempty"`
+	// Additional trace context propagation formats, on top of W3C tracecontext.
+	Propagation *TracingPropagationSpec `json:"propagation,omitempty" yaml:"propagation,omitempty"`
+}
+
+const (
+	// TracingB3Single propagates the trace context in the single "b3" header.
+	TracingB3Single = "single"
+	// TracingB3Multi propagates the trace context in the "X-B3-*" headers.
+	TracingB3Multi = "multi"
+)
+
+// TracingPropagationSpec configures the propagation of the trace context.
+type TracingPropagationSpec struct {
+	// Enables Zipkin B3 propagation. Can be "single" or "multi", which sets the
+	// format used for outgoing requests; incoming requests are accepted in both.
+	B3 string `json:"b3,omitempty" yaml:"b3,omitempty"`
+}
+
+// GetB3 returns the B3 propagation format, or an empty string if B3 is disabled.
+func (p *TracingPropagationSpec) GetB3() string {
+	if p == nil {
+		return ""
+	}
+	return strings.ToLower(p.B3)
+}
+
+// TracingTailSamplingSpec configures tail sampling of traces.
+// Spans are buffered per trace until the local root span ends, and the trace is
+// exported only if the root span errored or exceeded the latency threshold.
+// Tail sampling only applies to spans which were sampled by the trace sampler.
+type TracingTailSamplingSpec struct {
+	// Latency threshold in milliseconds above which traces are exported.
+	// If 0, only traces with errors are exported.
+	LatencyThreshold int `json:"latencyThreshold,omitempty" yaml:"latencyThreshold,omitempty"`
+	// Maximum number of traces buffered at the same time.
+	MaxTraces int `json:"maxTraces,omitempty" yaml:"maxTraces,omitempty"` // Defaults to 1000
+}
+
+const (
+	// TracingAttributeSourceHeader extracts the value from an HTTP header or gRPC metadata key.
+	TracingAttributeSourceHeader = "header"
+	// TracingAttributeSourceCloudEvent extracts the value from a CloudEvent attribute.
+	TracingAttributeSourceCloudEvent = "cloudEvent"
+)
+
+// TracingAttributeRule adds a span attribute with a value extracted from the incoming request.
+type TracingAttributeRule struct {
+	// Source of the value: "header" or "cloudEvent".
+	Source string `json:"source" yaml:"source"`
+	// Name of the header, metadata key or CloudEvent attribute to read.
+	Name string `json:"name" yaml:"name"`
+	// Name of the span attribute to set.
+	Attribute string `json:"attribute" yaml:"attribute"`
+}
+
+const (
+	// TracingSamplerProbabilistic samples root spans based on SamplingRate.
+	TracingSamplerProbabilistic = "probabilistic"
+	// TracingSamplerRateLimited samples up to TracesPerSecond root spans per second.
+	TracingSamplerRateLimited = "rateLimited"
+	// TracingSamplerParentBasedAlwaysSample samples all root spans and follows the parent decision otherwise.
+	TracingSamplerParentBasedAlwaysSample = "parentBasedAlwaysSample"
+)
+
+// TracingSamplerSpec defines the trace sampler used by daprd.
+// In all modes, the sampling decision of a parent span is honored.
+type TracingSamplerSpec struct {
+	// Type of the sampler. Defaults to "probabilistic".
+	Type string `json:"type,omitempty" yaml:"type,omitempty"`
+	// Maximum number of traces started per second when Type is "rateLimited".
+	TracesPerSecond int `json:"tracesPerSecond,omitempty" yaml:"tracesPerSecond,omitempty"`
+	// Sampling rate overrides for root spans whose name matches a route prefix.
+	Routes []TracingSamplerRoute `json:"routes,omitempty" yaml:"routes,omitempty"`
+}
+
+// TracingSamplerRoute overrides the sampling rate of root spans for a route.
+type TracingSamplerRoute struct {
+	// Prefix of the span name, which is the request path for HTTP and the full method for gRPC.
+	Path         string `json:"path" yaml:"path"`
+	SamplingRate string `json:"samplingRate" yaml:"samplingRate"`
+}
+
+// GetType returns the sampler type, defaulting to "probabilistic".
+func (s *TracingSamplerSpec) GetType() string {
+	if s == nil || s.Type == "" {
+		return TracingSamplerProbabilistic
+	}
+	return s.Type
 }
 
 // ZipkinSpec defines Zipkin exporter configurations.
@@ -280,6 +521,22 @@ type OtelSpec struct {
 	Headers string `json:"headers,omitempty" yaml:"headers,omitempty"`
 	// Timeout for the request in milliseconds
 	Timeout int `json:"timeout,omitempty" yaml:"timeout,omitempty"` // Defaults to 10000
+	// Compression to use for the exported spans: "gzip" or "none"
+	Compression string `json:"compression,omitempty" yaml:"compression,omitempty"` // Defaults to "none"
+	// TLS configuration used when connecting to a secure endpoint
+	TLS *OtelTLSSpec `json:"tls,omitempty" yaml:"tls,omitempty"`
+}
+
+// OtelTLSSpec defines the TLS configuration for the Otel exporter.
+type OtelTLSSpec struct {
+	// Path to the PEM-encoded CA certificate used to verify the collector
+	CAFile string `json:"caFile,omitempty" yaml:"caFile,omitempty"`
+	// Path to the PEM-encoded client certificate, for mTLS
+	CertFile string `json:"certFile,omitempty" yaml:"certFile,omitempty"`
+	// Path to the PEM-encoded client private key, for mTLS
+	KeyFile string `json:"keyFile,omitempty" yaml:"keyFile,omitempty"`
+	// Server name to verify the collector certificate against
+	ServerName string `json:"serverName,omitempty" yaml:"serverName,omitempty"`
 }
 
 // GetIsSecure returns true if the connection should be secured.
@@ -288,12 +545,22 @@ func (o OtelSpec) GetIsSecure() bool {
 	return o.IsSecure == nil || *o.IsSecure
 }
 
+// GetProtocol returns the transport protocol of the exporter, either "grpc" or "http".
+// The OTLP "http/protobuf" value is accepted as an alias of "http".
+func (o OtelSpec) GetProtocol() string {
+	if strings.EqualFold(o.Protocol, "http/protobuf") {
+		return "http"
+	}
+	return strings.ToLower(o.Protocol)
+}
+
 // MetricSpec configuration for metrics.
 type MetricSpec struct {
 	// Defaults to true
-	Enabled          *bool       `json:"enabled,omitempty" yaml:"enabled,omitempty"`
-	RecordErrorCodes *bool       `json:"recordErrorCodes,omitempty"  yaml:"recordErrorCodes,omitempty"`
-	HTTP             *MetricHTTP `json:"http,omitempty" yaml:"http,omitempty"`
+	Enabled          *bool         `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	RecordErrorCodes *bool         `json:"recordErrorCodes,omitempty"  yaml:"recordErrorCodes,omitempty"`
+	HTTP             *MetricHTTP   `json:"http,omitempty" yaml:"http,omitempty"`
+	PubSub           *MetricPubSub `json:"pubsub,omitempty" yaml:"pubsub,omitempty"`
 	// Latency distribution buckets. If not set, the default buckets are used.
 	LatencyDistributionBuckets *[]int        `json:"latencyDistributionBuckets,omitempty" yaml:"latencyDistributionBuckets,omitempty"`
 	Rules                      []MetricsRule `json:"rules,omitempty" yaml:"rules,omitempty"`
@@ -364,6 +631,33 @@ func (m MetricSpec) GetRecordErrorCodes() bool {
 	return *m.RecordErrorCodes
 }
 
+// GetPubSubIncludeTopic returns true if the topic label is added to pub/sub metrics.
+func (m MetricSpec) GetPubSubIncludeTopic() bool {
+	if m.PubSub == nil || m.PubSub.IncludeTopic == nil {
+		// The default is true
+		return true
+	}
+	return *m.PubSub.IncludeTopic
+}
+
+// GetPubSubIncreasedCardinality returns true if partition and consumer group labels are added to pub/sub metrics.
+func (m MetricSpec) GetPubSubIncreasedCardinality() bool {
+	if m.PubSub == nil || m.PubSub.IncreasedCardinality == nil {
+		// The default is false
+		return false
+	}
+	return *m.PubSub.IncreasedCardinality
+}
+
+// GetPubSubMaxLabelCardinality returns the maximum number of distinct values recorded for each high-cardinality pub/sub label.
+// A value of 0 means no limit.
+func (m MetricSpec) GetPubSubMaxLabelCardinality() int {
+	if m.PubSub == nil || m.PubSub.MaxLabelCardinality == nil {
+		return defaultPubSubMaxLabelCardinality
+	}
+	return max(*m.PubSub.MaxLabelCardinality, 0)
+}
+
 // MetricHTTP defines configuration for metrics for the HTTP server
 type MetricHTTP struct {
 	// If false, metrics for the HTTP server are collected with increased cardinality.
@@ -378,6 +672,23 @@ type MetricHTTP struct {
 	ExcludeVerbs *bool `json:"excludeVerbs,omitempty" yaml:"excludeVerbs,omitempty"`
 }
 
+// MetricPubSub defines configuration for pub/sub metrics.
+type MetricPubSub struct {
+	// If false, the topic label is omitted from pub/sub metrics.
+	// The default is true.
+	// +optional
+	IncludeTopic *bool `json:"includeTopic,omitempty" yaml:"includeTopic,omitempty"`
+	// If true (default is false), pub/sub ingress metrics also include partition and consumer group labels
+	// when the component exposes them in the message metadata.
+	// +optional
+	IncreasedCardinality *bool `json:"increasedCardinality,omitempty" yaml:"increasedCardinality,omitempty"`
+	// Maximum number of distinct values recorded for each of the topic, partition and consumer group labels.
+	// Values seen after the limit is reached are recorded as "_other". 0 disables the limit.
+	// The default is 1000.
+	// +optional
+	MaxLabelCardinality *int `json:"maxLabelCardinality,omitempty" yaml:"maxLabelCardinality,omitempty"`
+}
+
 // MetricsRule defines configuration options for a metric.
 type MetricsRule struct {
 	Name   string        `json:"name,omitempty"   yaml:"name,omitempty"`
@@ -417,6 +728,9 @@ type NameResolutionSpec struct {
 	Component     string `json:"component,omitempty"     yaml:"component,omitempty"`
 	Version       string `json:"version,omitempty"       yaml:"version,omitempty"`
 	Configuration any    `json:"configuration,omitempty" yaml:"configuration,omitempty"`
+	// SecretStore is the secret store of the secret URIs of the configuration.
+	// It defaults to the built-in secret store of the mode.
+	SecretStore string `json:"secretStore,omitempty" yaml:"secretStore,omitempty"`
 }
 
 // MTLSSpec defines mTLS configuration.
@@ -654,31 +968,70 @@ func SetTracingSpecFromEnv(conf *Configuration) error {
 	if timeoutMs > 0 {
 		conf.Spec.TracingSpec.Otel.Timeout = timeoutMs
 	}
+
+	if c := firstEnv(env.OtlpExporterTracesCompression, env.OtlpExporterCompression); c != "" {
+		conf.Spec.TracingSpec.Otel.Compression = c
+	}
+
+	caFile := firstEnv(env.OtlpExporterTracesCertificate, env.OtlpExporterCertificate)
+	certFile := firstEnv(env.OtlpExporterTracesClientCertificate, env.OtlpExporterClientCertificate)
+	keyFile := firstEnv(env.OtlpExporterTracesClientKey, env.OtlpExporterClientKey)
+	if caFile != "" || certFile != "" || keyFile != "" {
+		if conf.Spec.TracingSpec.Otel.TLS == nil {
+			conf.Spec.TracingSpec.Otel.TLS = &OtelTLSSpec{}
+		}
+		if caFile != "" {
+			conf.Spec.TracingSpec.Otel.TLS.CAFile = caFile
+		}
+		if certFile != "" {
+			conf.Spec.TracingSpec.Otel.TLS.CertFile = certFile
+		}
+		if keyFile != "" {
+			conf.Spec.TracingSpec.Otel.TLS.KeyFile = keyFile
+		}
+	}
 	return nil
 }
 
+// firstEnv returns the value of the first environment variable that is set and non-empty.
+func firstEnv(keys ...string) string {
+	for _, k := range keys {
+		if v := os.Getenv(k); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
 // IsSecretAllowed Check if the secret is allowed to be accessed.
 func (c SecretsScope) IsSecretAllowed(key string) bool {
-	// By default, set allow access for the secret store.
-	access := AllowAccess
-	// Check and set deny access.
-	if strings.EqualFold(c.DefaultAccess, DenyAccess) {
-		access = DenyAccess
-	}
+	allowed, _ := c.AccessRule(key)
+	return allowed
+}
+
+// Rules of a secrets scope that decide whether a secret can be accessed.
+const (
+	SecretsRuleAllowedSecrets = "allowedSecrets"
+	SecretsRuleDeniedSecrets  = "deniedSecrets"
+	SecretsRuleDefaultAccess  = "defaultAccess"
+)
 
+// AccessRule returns whether the secret can be accessed, and the rule of the
+// scope that decided it.
+func (c SecretsScope) AccessRule(key string) (bool, string) {
 	// If the allowedSecrets list is not empty then check if the access is specifically allowed for this key.
 	if len(c.AllowedSecrets) != 0 {
-		return containsKey(c.AllowedSecrets, key)
+		return containsKey(c.AllowedSecrets, key), SecretsRuleAllowedSecrets
 	}
 
 	// Check key in deny list if deny list is present for the secret store.
 	// If the specific key is denied, then alone deny access.
-	if deny := containsKey(c.DeniedSecrets, key); deny {
-		return !deny
+	if containsKey(c.DeniedSecrets, key) {
+		return false, SecretsRuleDeniedSecrets
 	}
 
-	// Check if defined default access is allow.
-	return access == AllowAccess
+	// By default, allow access for the secret store, unless the default access is deny.
+	return !strings.EqualFold(c.DefaultAccess, DenyAccess), SecretsRuleDefaultAccess
 }
 
 // Runs Binary Search on a sorted list of strings to find a key.
@@ -823,6 +1176,10 @@ func (c *Configuration) sortMetricsSpec() {
 		c.Spec.MetricSpec.HTTP = c.Spec.MetricsSpec.HTTP
 	}
 
+	if c.Spec.MetricsSpec.PubSub != nil {
+		c.Spec.MetricSpec.PubSub = c.Spec.MetricsSpec.PubSub
+	}
+
 	if c.Spec.MetricsSpec.LatencyDistributionBuckets != nil {
 		c.Spec.MetricSpec.LatencyDistributionBuckets = c.Spec.MetricsSpec.LatencyDistributionBuckets
 	}
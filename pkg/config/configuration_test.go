@@ -19,6 +19,7 @@ import (
 	"reflect"
 	"slices"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -423,6 +424,52 @@ func TestIsSecretAllowed(t *testing.T) {
 	}
 }
 
+func TestSecretsScopeAccessRule(t *testing.T) {
+	testCases := []struct {
+		name            string
+		scope           SecretsScope
+		expectedAllowed bool
+		expectedRule    string
+	}{
+		{
+			name:            "allowed secrets",
+			scope:           SecretsScope{AllowedSecrets: []string{"key"}, DeniedSecrets: []string{"key"}},
+			expectedAllowed: true,
+			expectedRule:    SecretsRuleAllowedSecrets,
+		},
+		{
+			name:            "not in allowed secrets",
+			scope:           SecretsScope{AllowedSecrets: []string{"other"}},
+			expectedAllowed: false,
+			expectedRule:    SecretsRuleAllowedSecrets,
+		},
+		{
+			name:            "denied secrets",
+			scope:           SecretsScope{DeniedSecrets: []string{"key"}},
+			expectedAllowed: false,
+			expectedRule:    SecretsRuleDeniedSecrets,
+		},
+		{
+			name:            "default deny",
+			scope:           SecretsScope{DefaultAccess: DenyAccess, DeniedSecrets: []string{"other"}},
+			expectedAllowed: false,
+			expectedRule:    SecretsRuleDefaultAccess,
+		},
+		{
+			name:            "default allow",
+			expectedAllowed: true,
+			expectedRule:    SecretsRuleDefaultAccess,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			allowed, rule := tc.scope.AccessRule("key")
+			assert.Equal(t, tc.expectedAllowed, allowed)
+			assert.Equal(t, tc.expectedRule, rule)
+		})
+	}
+}
+
 func TestContainsKey(t *testing.T) {
 	s := []string{"a", "b", "c", "z"}
 	assert.False(t, containsKey(s, "h"), "unexpected result")
@@ -514,6 +561,31 @@ func TestTracingPrecedenceFromEnv(t *testing.T) {
 	assert.Equal(t, 2000, conf.Spec.TracingSpec.Otel.Timeout)
 }
 
+func TestTracingCompressionAndTLSFromEnv(t *testing.T) {
+	t.Setenv(env.OtlpExporterTracesCompression, "gzip")
+	t.Setenv(env.OtlpExporterCompression, "none")
+	t.Setenv(env.OtlpExporterCertificate, "/certs/ca.pem")
+	t.Setenv(env.OtlpExporterTracesClientCertificate, "/certs/client.pem")
+	t.Setenv(env.OtlpExporterTracesClientKey, "/certs/client.key")
+
+	conf := LoadDefaultConfiguration()
+	err := SetTracingSpecFromEnv(conf)
+	require.NoError(t, err)
+
+	assert.Equal(t, "gzip", conf.Spec.TracingSpec.Otel.Compression)
+	require.NotNil(t, conf.Spec.TracingSpec.Otel.TLS)
+	assert.Equal(t, "/certs/ca.pem", conf.Spec.TracingSpec.Otel.TLS.CAFile)
+	assert.Equal(t, "/certs/client.pem", conf.Spec.TracingSpec.Otel.TLS.CertFile)
+	assert.Equal(t, "/certs/client.key", conf.Spec.TracingSpec.Otel.TLS.KeyFile)
+}
+
+func TestOtelGetProtocol(t *testing.T) {
+	assert.Equal(t, "grpc", OtelSpec{Protocol: "grpc"}.GetProtocol())
+	assert.Equal(t, "http", OtelSpec{Protocol: "HTTP"}.GetProtocol())
+	assert.Equal(t, "http", OtelSpec{Protocol: "http/protobuf"}.GetProtocol())
+	assert.Empty(t, OtelSpec{}.GetProtocol())
+}
+
 func TestTracingTimeoutFromEnv(t *testing.T) {
 	t.Setenv(env.OtlpExporterTracesTimeout, "invalid")
 	conf := LoadDefaultConfiguration()
@@ -750,3 +822,58 @@ func TestMetricsGetHTTPExcludeVerbs(t *testing.T) {
 		assert.False(t, m.GetHTTPExcludeVerbs())
 	})
 }
+
+func TestMetricsGetPubSub(t *testing.T) {
+	t.Run("no configuration, returns defaults", func(t *testing.T) {
+		m := MetricSpec{}
+		assert.True(t, m.GetPubSubIncludeTopic())
+		assert.False(t, m.GetPubSubIncreasedCardinality())
+		assert.Equal(t, 1000, m.GetPubSubMaxLabelCardinality())
+	})
+
+	t.Run("config is set", func(t *testing.T) {
+		m := MetricSpec{
+			PubSub: &MetricPubSub{
+				IncludeTopic:         ptr.Of(false),
+				IncreasedCardinality: ptr.Of(true),
+				MaxLabelCardinality:  ptr.Of(10),
+			},
+		}
+		assert.False(t, m.GetPubSubIncludeTopic())
+		assert.True(t, m.GetPubSubIncreasedCardinality())
+		assert.Equal(t, 10, m.GetPubSubMaxLabelCardinality())
+	})
+
+	t.Run("negative limit disables the limit", func(t *testing.T) {
+		m := MetricSpec{
+			PubSub: &MetricPubSub{
+				MaxLabelCardinality: ptr.Of(-1),
+			},
+		}
+		assert.Equal(t, 0, m.GetPubSubMaxLabelCardinality())
+	})
+}
+
+func TestWorkflowStateRetentionPolicyTTL(t *testing.T) {
+	t.Run("nil policy", func(t *testing.T) {
+		var p *WorkflowStateRetentionPolicy
+		ttl, _ := p.TTL("COMPLETED")
+		assert.Nil(t, ttl)
+	})
+
+	p := &WorkflowStateRetentionPolicy{
+		Completed:   ptr.Of(time.Hour),
+		AnyTerminal: ptr.Of(time.Minute),
+	}
+
+	ttl, name := p.TTL("COMPLETED")
+	assert.Equal(t, time.Hour, *ttl)
+	assert.Equal(t, "completed", name)
+
+	ttl, name = p.TTL("FAILED")
+	assert.Equal(t, time.Minute, *ttl)
+	assert.Equal(t, "anyterminal", name)
+
+	ttl, _ = (&WorkflowStateRetentionPolicy{Completed: ptr.Of(time.Hour)}).TTL("TERMINATED")
+	assert.Nil(t, ttl)
+}
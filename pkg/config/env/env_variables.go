@@ -17,6 +17,12 @@ const (
 	AppPort string = "APP_PORT"
 	// AppID is the ID of the application.
 	AppID string = "APP_ID"
+	// TopologyZone is the zone the Dapr runtime runs in, reported to the placement service.
+	TopologyZone string = "DAPR_TOPOLOGY_ZONE"
+	// HostLabels are the labels of the Dapr runtime reported to the placement service, as "key1=value1,key2=value2".
+	HostLabels string = "DAPR_HOST_LABELS"
+	// ActorReadReplicas is the number of hosts serving read-only invocations of an actor, when the ActorReadReplicas feature is enabled.
+	ActorReadReplicas string = "DAPR_ACTOR_READ_REPLICAS"
 	// OpenTelemetry target URL for OTLP exporter
 	OtlpExporterEndpoint string = "OTEL_EXPORTER_OTLP_ENDPOINT"
 	// OpenTelemetry target URL for OTLP exporter for traces
@@ -35,4 +41,20 @@ const (
 	OtlpExporterTimeout string = "OTEL_EXPORTER_OTLP_TIMEOUT"
 	// OpenTelemetry timeout for the traces request
 	OtlpExporterTracesTimeout string = "OTEL_EXPORTER_OTLP_TRACES_TIMEOUT"
+	// OpenTelemetry compression for the request (gzip, none)
+	OtlpExporterCompression string = "OTEL_EXPORTER_OTLP_COMPRESSION"
+	// OpenTelemetry compression for the traces request (gzip, none)
+	OtlpExporterTracesCompression string = "OTEL_EXPORTER_OTLP_TRACES_COMPRESSION"
+	// OpenTelemetry CA certificate used to verify the collector
+	OtlpExporterCertificate string = "OTEL_EXPORTER_OTLP_CERTIFICATE"
+	// OpenTelemetry CA certificate used to verify the traces collector
+	OtlpExporterTracesCertificate string = "OTEL_EXPORTER_OTLP_TRACES_CERTIFICATE"
+	// OpenTelemetry client certificate for mTLS
+	OtlpExporterClientCertificate string = "OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE"
+	// OpenTelemetry client certificate for mTLS with the traces collector
+	OtlpExporterTracesClientCertificate string = "OTEL_EXPORTER_OTLP_TRACES_CLIENT_CERTIFICATE"
+	// OpenTelemetry client private key for mTLS
+	OtlpExporterClientKey string = "OTEL_EXPORTER_OTLP_CLIENT_KEY"
+	// OpenTelemetry client private key for mTLS with the traces collector
+	OtlpExporterTracesClientKey string = "OTEL_EXPORTER_OTLP_TRACES_CLIENT_KEY"
 )
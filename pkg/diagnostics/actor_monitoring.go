@@ -0,0 +1,166 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diagnostics
+
+import (
+	"context"
+	"strconv"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	diagUtils "github.com/dapr/dapr/pkg/diagnostics/utils"
+)
+
+var (
+	actorMethodKey = tag.MustNewKey("method")
+	actorHookKey   = tag.MustNewKey("hook")
+)
+
+type actorMetrics struct {
+	// activeActors records the number of active actors per actor type.
+	activeActors *stats.Int64Measure
+	// activationLatency records the time taken to activate an actor.
+	activationLatency *stats.Float64Measure
+	// deactivationLatency records the time taken to deactivate an actor.
+	deactivationLatency *stats.Float64Measure
+	// methodLatency records the latency of actor method invocations per actor type.
+	methodLatency *stats.Float64Measure
+	// hookLatency records the latency of the lifecycle hooks of the actors
+	// invoked on the app.
+	hookLatency *stats.Float64Measure
+	// rebalanceCount records the number of placement table rebalances applied.
+	rebalanceCount *stats.Int64Measure
+	// rebalanceLatency records the time taken to apply a placement table rebalance.
+	rebalanceLatency *stats.Float64Measure
+
+	appID     string
+	enabled   bool
+	namespace string
+	meter     stats.Recorder
+}
+
+func newActorMetrics() *actorMetrics {
+	return &actorMetrics{
+		activeActors: stats.Int64(
+			"runtime/actor/active_actors",
+			"The number of active actors per actor type.",
+			stats.UnitDimensionless),
+		activationLatency: stats.Float64(
+			"runtime/actor/activation/latency",
+			"The time taken to activate an actor.",
+			stats.UnitMilliseconds),
+		deactivationLatency: stats.Float64(
+			"runtime/actor/deactivation/latency",
+			"The time taken to deactivate an actor.",
+			stats.UnitMilliseconds),
+		methodLatency: stats.Float64(
+			"runtime/actor/method/latency",
+			"The latency of actor method invocations.",
+			stats.UnitMilliseconds),
+		hookLatency: stats.Float64(
+			"runtime/actor/lifecycle_hook/latency",
+			"The latency of the lifecycle hooks of actors invoked on the app.",
+			stats.UnitMilliseconds),
+		rebalanceCount: stats.Int64(
+			"runtime/actor/placement/rebalance_count",
+			"The number of placement table rebalances applied by the runtime.",
+			stats.UnitDimensionless),
+		rebalanceLatency: stats.Float64(
+			"runtime/actor/placement/rebalance_latency",
+			"The time taken to apply a placement table rebalance, including draining non-hosted actors.",
+			stats.UnitMilliseconds),
+	}
+}
+
+func (a *actorMetrics) IsEnabled() bool {
+	return a != nil && a.enabled
+}
+
+// Init registers the actor metrics views.
+func (a *actorMetrics) Init(meter view.Meter, appID, namespace string, latencyDistribution *view.Aggregation) error {
+	a.appID = appID
+	a.enabled = true
+	a.namespace = namespace
+	a.meter = meter
+
+	return meter.Register(
+		diagUtils.NewMeasureView(a.activeActors, []tag.Key{appIDKey, namespaceKey, actorTypeKey}, view.LastValue()),
+		diagUtils.NewMeasureView(a.activationLatency, []tag.Key{appIDKey, namespaceKey, actorTypeKey}, latencyDistribution),
+		diagUtils.NewMeasureView(a.deactivationLatency, []tag.Key{appIDKey, namespaceKey, actorTypeKey, successKey}, latencyDistribution),
+		diagUtils.NewMeasureView(a.methodLatency, []tag.Key{appIDKey, namespaceKey, actorTypeKey, actorMethodKey, successKey}, latencyDistribution),
+		diagUtils.NewMeasureView(a.hookLatency, []tag.Key{appIDKey, namespaceKey, actorTypeKey, actorHookKey, successKey}, latencyDistribution),
+		diagUtils.NewMeasureView(a.rebalanceCount, []tag.Key{appIDKey, namespaceKey, successKey}, view.Count()),
+		diagUtils.NewMeasureView(a.rebalanceLatency, []tag.Key{appIDKey, namespaceKey, successKey}, latencyDistribution),
+	)
+}
+
+// ActiveActors records the current number of active actors for an actor type.
+func (a *actorMetrics) ActiveActors(ctx context.Context, actorType string, count int64) {
+	if !a.IsEnabled() {
+		return
+	}
+
+	stats.RecordWithOptions(ctx, stats.WithRecorder(a.meter), stats.WithTags(diagUtils.WithTags(a.activeActors.Name(), appIDKey, a.appID, namespaceKey, a.namespace, actorTypeKey, actorType)...), stats.WithMeasurements(a.activeActors.M(count)))
+}
+
+// ActorActivated records the latency of an actor activation.
+func (a *actorMetrics) ActorActivated(ctx context.Context, actorType string, elapsed float64) {
+	if !a.IsEnabled() {
+		return
+	}
+
+	stats.RecordWithOptions(ctx, stats.WithRecorder(a.meter), stats.WithTags(diagUtils.WithTags(a.activationLatency.Name(), appIDKey, a.appID, namespaceKey, a.namespace, actorTypeKey, actorType)...), stats.WithMeasurements(a.activationLatency.M(elapsed)))
+}
+
+// ActorDeactivated records the latency of an actor deactivation.
+func (a *actorMetrics) ActorDeactivated(ctx context.Context, actorType string, success bool, elapsed float64) {
+	if !a.IsEnabled() {
+		return
+	}
+
+	stats.RecordWithOptions(ctx, stats.WithRecorder(a.meter), stats.WithTags(diagUtils.WithTags(a.deactivationLatency.Name(), appIDKey, a.appID, namespaceKey, a.namespace, actorTypeKey, actorType, successKey, strconv.FormatBool(success))...), stats.WithMeasurements(a.deactivationLatency.M(elapsed)))
+}
+
+// ActorMethodInvoked records the latency of an actor method invocation.
+func (a *actorMetrics) ActorMethodInvoked(ctx context.Context, actorType, method string, success bool, elapsed float64) {
+	if !a.IsEnabled() {
+		return
+	}
+
+	stats.RecordWithOptions(ctx, stats.WithRecorder(a.meter), stats.WithTags(diagUtils.WithTags(a.methodLatency.Name(), appIDKey, a.appID, namespaceKey, a.namespace, actorTypeKey, actorType, actorMethodKey, method, successKey, strconv.FormatBool(success))...), stats.WithMeasurements(a.methodLatency.M(elapsed)))
+}
+
+// ActorLifecycleHookInvoked records the latency of a lifecycle hook of an actor.
+func (a *actorMetrics) ActorLifecycleHookInvoked(ctx context.Context, actorType, hook string, success bool, elapsed float64) {
+	if !a.IsEnabled() {
+		return
+	}
+
+	stats.RecordWithOptions(ctx, stats.WithRecorder(a.meter), stats.WithTags(diagUtils.WithTags(a.hookLatency.Name(), appIDKey, a.appID, namespaceKey, a.namespace, actorTypeKey, actorType, actorHookKey, hook, successKey, strconv.FormatBool(success))...), stats.WithMeasurements(a.hookLatency.M(elapsed)))
+}
+
+// PlacementRebalanced records a placement table rebalance and the time taken to apply it.
+func (a *actorMetrics) PlacementRebalanced(ctx context.Context, success bool, elapsed float64) {
+	if !a.IsEnabled() {
+		return
+	}
+
+	stats.RecordWithOptions(ctx, stats.WithRecorder(a.meter), stats.WithTags(diagUtils.WithTags(a.rebalanceCount.Name(), appIDKey, a.appID, namespaceKey, a.namespace, successKey, strconv.FormatBool(success))...), stats.WithMeasurements(a.rebalanceCount.M(1)))
+
+	if elapsed > 0 {
+		stats.RecordWithOptions(ctx, stats.WithRecorder(a.meter), stats.WithTags(diagUtils.WithTags(a.rebalanceLatency.Name(), appIDKey, a.appID, namespaceKey, a.namespace, successKey, strconv.FormatBool(success))...), stats.WithMeasurements(a.rebalanceLatency.M(elapsed)))
+	}
+}
@@ -0,0 +1,113 @@
+package diagnostics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+
+	"github.com/dapr/dapr/pkg/config"
+)
+
+func initActorMetrics() (*actorMetrics, view.Meter) {
+	a := newActorMetrics()
+	meter := view.NewMeter()
+	meter.Start()
+	_ = a.Init(meter, "test", "default", config.LoadDefaultConfiguration().GetMetricsSpec().GetLatencyDistribution(log))
+
+	return a, meter
+}
+
+func TestActorMetrics(t *testing.T) {
+	t.Run("active actors", func(t *testing.T) {
+		a, meter := initActorMetrics()
+		t.Cleanup(func() { meter.Stop() })
+
+		a.ActiveActors(t.Context(), "mytype", 3)
+		a.ActiveActors(t.Context(), "mytype", 2)
+
+		viewData, _ := meter.RetrieveData("runtime/actor/active_actors")
+		v := meter.Find("runtime/actor/active_actors")
+
+		require.Len(t, viewData, 1)
+		allTagsPresent(t, v, viewData[0].Tags)
+		assert.InEpsilon(t, float64(2), viewData[0].Data.(*view.LastValueData).Value, 0)
+	})
+
+	t.Run("activation latency", func(t *testing.T) {
+		a, meter := initActorMetrics()
+		t.Cleanup(func() { meter.Stop() })
+
+		a.ActorActivated(t.Context(), "mytype", 1)
+
+		viewData, _ := meter.RetrieveData("runtime/actor/activation/latency")
+		v := meter.Find("runtime/actor/activation/latency")
+
+		allTagsPresent(t, v, viewData[0].Tags)
+		assert.InEpsilon(t, float64(1), viewData[0].Data.(*view.DistributionData).Min, 0)
+	})
+
+	t.Run("deactivation latency", func(t *testing.T) {
+		a, meter := initActorMetrics()
+		t.Cleanup(func() { meter.Stop() })
+
+		a.ActorDeactivated(t.Context(), "mytype", true, 1)
+		a.ActorDeactivated(t.Context(), "mytype", false, 2)
+
+		viewData, _ := meter.RetrieveData("runtime/actor/deactivation/latency")
+		v := meter.Find("runtime/actor/deactivation/latency")
+
+		require.Len(t, viewData, 2)
+		allTagsPresent(t, v, viewData[0].Tags)
+	})
+
+	t.Run("method latency", func(t *testing.T) {
+		a, meter := initActorMetrics()
+		t.Cleanup(func() { meter.Stop() })
+
+		a.ActorMethodInvoked(t.Context(), "mytype", "foo", true, 1)
+
+		viewData, _ := meter.RetrieveData("runtime/actor/method/latency")
+		v := meter.Find("runtime/actor/method/latency")
+
+		allTagsPresent(t, v, viewData[0].Tags)
+		assert.InEpsilon(t, float64(1), viewData[0].Data.(*view.DistributionData).Min, 0)
+	})
+
+	t.Run("lifecycle hook latency", func(t *testing.T) {
+		a, meter := initActorMetrics()
+		t.Cleanup(func() { meter.Stop() })
+
+		a.ActorLifecycleHookInvoked(t.Context(), "mytype", "postactivate", true, 1)
+		a.ActorLifecycleHookInvoked(t.Context(), "mytype", "predeactivate", false, 2)
+
+		viewData, _ := meter.RetrieveData("runtime/actor/lifecycle_hook/latency")
+		v := meter.Find("runtime/actor/lifecycle_hook/latency")
+
+		require.Len(t, viewData, 2)
+		allTagsPresent(t, v, viewData[0].Tags)
+	})
+
+	t.Run("placement rebalance", func(t *testing.T) {
+		a, meter := initActorMetrics()
+		t.Cleanup(func() { meter.Stop() })
+
+		a.PlacementRebalanced(t.Context(), true, 1)
+		a.PlacementRebalanced(t.Context(), true, 0)
+
+		viewData, _ := meter.RetrieveData("runtime/actor/placement/rebalance_count")
+		v := meter.Find("runtime/actor/placement/rebalance_count")
+		allTagsPresent(t, v, viewData[0].Tags)
+		assert.Equal(t, int64(2), viewData[0].Data.(*view.CountData).Value)
+
+		viewData, _ = meter.RetrieveData("runtime/actor/placement/rebalance_latency")
+		assert.Equal(t, int64(1), viewData[0].Data.(*view.DistributionData).Count)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		a := newActorMetrics()
+		assert.False(t, a.IsEnabled())
+		a.ActiveActors(t.Context(), "mytype", 1)
+	})
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diagnostics
+
+import (
+	"sync"
+)
+
+// overflowTagValue is the tag value recorded once a cardinality limiter is full.
+const overflowTagValue = "_other"
+
+// cardinalityLimiter bounds the number of distinct values recorded for a tag.
+// Once the limit is reached, any value which has not been seen before is
+// replaced with overflowTagValue.
+type cardinalityLimiter struct {
+	max  int
+	seen map[string]struct{}
+	lock sync.RWMutex
+}
+
+// newCardinalityLimiter returns a new limiter. A max of 0 disables the limit.
+func newCardinalityLimiter(max int) *cardinalityLimiter {
+	return &cardinalityLimiter{
+		max:  max,
+		seen: make(map[string]struct{}),
+	}
+}
+
+// value returns the tag value to record for v.
+func (c *cardinalityLimiter) value(v string) string {
+	if c == nil || c.max <= 0 || v == "" {
+		return v
+	}
+
+	c.lock.RLock()
+	_, ok := c.seen[v]
+	c.lock.RUnlock()
+	if ok {
+		return v
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if _, ok = c.seen[v]; ok {
+		return v
+	}
+	if len(c.seen) >= c.max {
+		return overflowTagValue
+	}
+	c.seen[v] = struct{}{}
+	return v
+}
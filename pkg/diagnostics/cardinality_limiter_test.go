@@ -0,0 +1,30 @@
+package diagnostics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCardinalityLimiter(t *testing.T) {
+	t.Run("values over the limit are replaced", func(t *testing.T) {
+		l := newCardinalityLimiter(2)
+		assert.Equal(t, "a", l.value("a"))
+		assert.Equal(t, "b", l.value("b"))
+		assert.Equal(t, overflowTagValue, l.value("c"))
+		assert.Equal(t, "a", l.value("a"))
+		assert.Empty(t, l.value(""))
+	})
+
+	t.Run("zero disables the limit", func(t *testing.T) {
+		l := newCardinalityLimiter(0)
+		for _, v := range []string{"a", "b", "c", "d"} {
+			assert.Equal(t, v, l.value(v))
+		}
+	})
+
+	t.Run("nil limiter", func(t *testing.T) {
+		var l *cardinalityLimiter
+		assert.Equal(t, "a", l.value("a"))
+	})
+}
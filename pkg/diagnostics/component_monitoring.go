@@ -16,6 +16,32 @@ var (
 	processStatusKey = tag.MustNewKey("process_status")
 	successKey       = tag.MustNewKey("success")
 	topicKey         = tag.MustNewKey("topic")
+	componentTypeKey = tag.MustNewKey("component_type")
+	partitionKey     = tag.MustNewKey("partition")
+	consumerGroupKey = tag.MustNewKey("consumer_group")
+	actionKey        = tag.MustNewKey("action")
+)
+
+// Message metadata keys which components use to expose the partition and consumer group of a pub/sub message.
+var (
+	pubsubPartitionMetadataKeys     = []string{"__partition", "partition"}
+	pubsubConsumerGroupMetadataKeys = []string{"__consumerGroup", "consumerGroup"}
+)
+
+// Component types used to tag the generic component invocation metrics.
+const (
+	ComponentTypeState         = "state"
+	ComponentTypePubsub        = "pubsub"
+	ComponentTypeBindings      = "bindings"
+	ComponentTypeSecretStores  = "secretstores"
+	ComponentTypeConfiguration = "configuration"
+	ComponentTypeCrypto        = "crypto"
+)
+
+// Actions taken on configuration items which failed validation.
+const (
+	ConfigurationValidationDrop       = "drop"
+	ConfigurationValidationDeadLetter = "deadletter"
 )
 
 const (
@@ -27,7 +53,11 @@ const (
 	ConfigurationUnsubscribe = "unsubscribe"
 	StateTransaction         = "transaction"
 	BulkGet                  = "bulk_get"
+	BulkSet                  = "bulk_set"
 	BulkDelete               = "bulk_delete"
+	StateIncrement           = "increment"
+	StateAppend              = "append"
+	StateCheckAndSet         = "check_and_set"
 	CryptoOp                 = "crypto_op"
 )
 
@@ -38,6 +68,9 @@ type componentMetrics struct {
 	bulkPubsubIngressCount      *stats.Int64Measure
 	bulkPubsubEventIngressCount *stats.Int64Measure
 	bulkPubsubIngressLatency    *stats.Float64Measure
+	pubsubIngressExpiredCount   *stats.Int64Measure
+	pubsubIngressQueueDepth     *stats.Int64Measure
+	pubsubIngressSaturation     *stats.Float64Measure
 	pubsubEgressCount           *stats.Int64Measure
 	pubsubEgressLatency         *stats.Float64Measure
 	bulkPubsubEgressCount       *stats.Int64Measure
@@ -52,8 +85,9 @@ type componentMetrics struct {
 	stateCount   *stats.Int64Measure
 	stateLatency *stats.Float64Measure
 
-	configurationCount   *stats.Int64Measure
-	configurationLatency *stats.Float64Measure
+	configurationCount                 *stats.Int64Measure
+	configurationLatency               *stats.Float64Measure
+	configurationValidationFailedCount *stats.Int64Measure
 
 	secretCount   *stats.Int64Measure
 	secretLatency *stats.Float64Measure
@@ -64,10 +98,35 @@ type componentMetrics struct {
 	cryptoCount   *stats.Int64Measure
 	cryptoLatency *stats.Float64Measure
 
+	invocationLatency    *stats.Float64Measure
+	invocationErrorCount *stats.Int64Measure
+	health               *stats.Int64Measure
+
 	appID     string
 	enabled   bool
 	namespace string
 	meter     stats.Recorder
+
+	pubsubIncludeTopic         bool
+	pubsubIncreasedCardinality bool
+	topicLimiter               *cardinalityLimiter
+	partitionLimiter           *cardinalityLimiter
+	consumerGroupLimiter       *cardinalityLimiter
+}
+
+// PubsubMonitoringConfig configures the labels recorded on pub/sub metrics.
+type PubsubMonitoringConfig struct {
+	includeTopic         bool
+	increasedCardinality bool
+	maxLabelCardinality  int
+}
+
+func NewPubsubMonitoringConfig(includeTopic, increasedCardinality bool, maxLabelCardinality int) PubsubMonitoringConfig {
+	return PubsubMonitoringConfig{
+		includeTopic:         includeTopic,
+		increasedCardinality: increasedCardinality,
+		maxLabelCardinality:  maxLabelCardinality,
+	}
 }
 
 // newComponentMetrics returns a componentMetrics instance with default stats.
@@ -93,6 +152,18 @@ func newComponentMetrics() *componentMetrics {
 			"component/pubsub_ingress/bulk/latencies",
 			"The consuming app event processing latency for the bulk pub/sub component.",
 			stats.UnitMilliseconds),
+		pubsubIngressExpiredCount: stats.Int64(
+			"component/pubsub_ingress/expired/count",
+			"The number of incoming messages which were expired when delivered from the pub/sub component.",
+			stats.UnitDimensionless),
+		pubsubIngressQueueDepth: stats.Int64(
+			"component/pubsub_ingress/queue_depth",
+			"The number of incoming messages of a subscription waiting for a free handler.",
+			stats.UnitDimensionless),
+		pubsubIngressSaturation: stats.Float64(
+			"component/pubsub_ingress/handler_saturation",
+			"The ratio of busy handlers to the maximum number of concurrent handlers of a subscription.",
+			stats.UnitDimensionless),
 		pubsubEgressCount: stats.Int64(
 			"component/pubsub_egress/count",
 			"The number of outgoing messages published to the pub/sub component.",
@@ -145,6 +216,10 @@ func newComponentMetrics() *componentMetrics {
 			"component/configuration/latencies",
 			"The latency of the response from the configuration component.",
 			stats.UnitMilliseconds),
+		configurationValidationFailedCount: stats.Int64(
+			"component/configuration/validation_failed/count",
+			"The number of configuration items from the configuration component which failed validation and were not delivered to a subscriber.",
+			stats.UnitDimensionless),
 		secretCount: stats.Int64(
 			"component/secret/count",
 			"The number of operations performed on the secret component.",
@@ -169,26 +244,46 @@ func newComponentMetrics() *componentMetrics {
 			"component/crypto/latencies",
 			"The latency of the response from the crypto component.",
 			stats.UnitMilliseconds),
+		invocationLatency: stats.Float64(
+			"component/invocation/latencies",
+			"The latency of any operation invoked on a component.",
+			stats.UnitMilliseconds),
+		invocationErrorCount: stats.Int64(
+			"component/invocation/error_count",
+			"The number of failed operations invoked on a component.",
+			stats.UnitDimensionless),
+		health: stats.Int64(
+			"component/health",
+			"The health of a component based on the result of its last operation. 1 is healthy, 0 is unhealthy.",
+			stats.UnitDimensionless),
 	}
 }
 
 // Init registers the component metrics views.
-func (c *componentMetrics) Init(meter view.Meter, appID, namespace string, latencyDistribution *view.Aggregation) error {
+func (c *componentMetrics) Init(meter view.Meter, appID, namespace string, pubsubConfig PubsubMonitoringConfig, latencyDistribution *view.Aggregation) error {
 	c.appID = appID
 	c.enabled = true
 	c.namespace = namespace
 	c.meter = meter
+	c.pubsubIncludeTopic = pubsubConfig.includeTopic
+	c.pubsubIncreasedCardinality = pubsubConfig.increasedCardinality
+	c.topicLimiter = newCardinalityLimiter(pubsubConfig.maxLabelCardinality)
+	c.partitionLimiter = newCardinalityLimiter(pubsubConfig.maxLabelCardinality)
+	c.consumerGroupLimiter = newCardinalityLimiter(pubsubConfig.maxLabelCardinality)
 
 	return meter.Register(
-		diagUtils.NewMeasureView(c.pubsubIngressLatency, []tag.Key{appIDKey, componentKey, namespaceKey, processStatusKey, topicKey, statusKey}, latencyDistribution),
-		diagUtils.NewMeasureView(c.pubsubIngressCount, []tag.Key{appIDKey, componentKey, namespaceKey, processStatusKey, topicKey, statusKey}, view.Count()),
-		diagUtils.NewMeasureView(c.bulkPubsubIngressLatency, []tag.Key{appIDKey, componentKey, namespaceKey, processStatusKey, topicKey}, latencyDistribution),
-		diagUtils.NewMeasureView(c.bulkPubsubIngressCount, []tag.Key{appIDKey, componentKey, namespaceKey, processStatusKey, topicKey}, view.Count()),
-		diagUtils.NewMeasureView(c.bulkPubsubEventIngressCount, []tag.Key{appIDKey, componentKey, namespaceKey, processStatusKey, topicKey}, view.Count()),
-		diagUtils.NewMeasureView(c.pubsubEgressLatency, []tag.Key{appIDKey, componentKey, namespaceKey, successKey, topicKey}, latencyDistribution),
-		diagUtils.NewMeasureView(c.pubsubEgressCount, []tag.Key{appIDKey, componentKey, namespaceKey, successKey, topicKey}, view.Count()),
-		diagUtils.NewMeasureView(c.bulkPubsubEgressLatency, []tag.Key{appIDKey, componentKey, namespaceKey, successKey, topicKey}, latencyDistribution),
-		diagUtils.NewMeasureView(c.bulkPubsubEgressCount, []tag.Key{appIDKey, componentKey, namespaceKey, successKey, topicKey}, view.Count()),
+		diagUtils.NewMeasureView(c.pubsubIngressLatency, c.pubsubIngressTagKeys(appIDKey, componentKey, namespaceKey, processStatusKey, statusKey), latencyDistribution),
+		diagUtils.NewMeasureView(c.pubsubIngressCount, c.pubsubIngressTagKeys(appIDKey, componentKey, namespaceKey, processStatusKey, statusKey), view.Count()),
+		diagUtils.NewMeasureView(c.bulkPubsubIngressLatency, c.pubsubIngressTagKeys(appIDKey, componentKey, namespaceKey, processStatusKey), latencyDistribution),
+		diagUtils.NewMeasureView(c.bulkPubsubIngressCount, c.pubsubIngressTagKeys(appIDKey, componentKey, namespaceKey, processStatusKey), view.Count()),
+		diagUtils.NewMeasureView(c.bulkPubsubEventIngressCount, c.pubsubIngressTagKeys(appIDKey, componentKey, namespaceKey, processStatusKey), view.Count()),
+		diagUtils.NewMeasureView(c.pubsubIngressExpiredCount, c.pubsubIngressTagKeys(appIDKey, componentKey, namespaceKey, actionKey), view.Count()),
+		diagUtils.NewMeasureView(c.pubsubIngressQueueDepth, c.pubsubTagKeys(appIDKey, componentKey, namespaceKey), view.LastValue()),
+		diagUtils.NewMeasureView(c.pubsubIngressSaturation, c.pubsubTagKeys(appIDKey, componentKey, namespaceKey), view.LastValue()),
+		diagUtils.NewMeasureView(c.pubsubEgressLatency, c.pubsubTagKeys(appIDKey, componentKey, namespaceKey, successKey), latencyDistribution),
+		diagUtils.NewMeasureView(c.pubsubEgressCount, c.pubsubTagKeys(appIDKey, componentKey, namespaceKey, successKey), view.Count()),
+		diagUtils.NewMeasureView(c.bulkPubsubEgressLatency, c.pubsubTagKeys(appIDKey, componentKey, namespaceKey, successKey), latencyDistribution),
+		diagUtils.NewMeasureView(c.bulkPubsubEgressCount, c.pubsubTagKeys(appIDKey, componentKey, namespaceKey, successKey), view.Count()),
 		diagUtils.NewMeasureView(c.inputBindingLatency, []tag.Key{appIDKey, componentKey, namespaceKey, successKey}, latencyDistribution),
 		diagUtils.NewMeasureView(c.inputBindingCount, []tag.Key{appIDKey, componentKey, namespaceKey, successKey}, view.Count()),
 		diagUtils.NewMeasureView(c.outputBindingLatency, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, latencyDistribution),
@@ -197,12 +292,16 @@ func (c *componentMetrics) Init(meter view.Meter, appID, namespace string, laten
 		diagUtils.NewMeasureView(c.stateCount, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, view.Count()),
 		diagUtils.NewMeasureView(c.configurationLatency, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, latencyDistribution),
 		diagUtils.NewMeasureView(c.configurationCount, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, view.Count()),
+		diagUtils.NewMeasureView(c.configurationValidationFailedCount, []tag.Key{appIDKey, componentKey, namespaceKey, actionKey}, view.Count()),
 		diagUtils.NewMeasureView(c.secretLatency, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, latencyDistribution),
 		diagUtils.NewMeasureView(c.secretCount, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, view.Count()),
 		diagUtils.NewMeasureView(c.conversationLatency, []tag.Key{appIDKey, componentKey, namespaceKey, successKey}, latencyDistribution),
 		diagUtils.NewMeasureView(c.conversationCount, []tag.Key{appIDKey, componentKey, namespaceKey, successKey}, view.Count()),
 		diagUtils.NewMeasureView(c.cryptoLatency, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, latencyDistribution),
 		diagUtils.NewMeasureView(c.cryptoCount, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, view.Count()),
+		diagUtils.NewMeasureView(c.invocationLatency, []tag.Key{appIDKey, componentKey, componentTypeKey, namespaceKey, operationKey, successKey}, latencyDistribution),
+		diagUtils.NewMeasureView(c.invocationErrorCount, []tag.Key{appIDKey, componentKey, componentTypeKey, namespaceKey, operationKey}, view.Count()),
+		diagUtils.NewMeasureView(c.health, []tag.Key{appIDKey, componentKey, componentTypeKey, namespaceKey}, view.LastValue()),
 	)
 }
 
@@ -215,33 +314,60 @@ func (c *componentMetrics) PubsubIngressEvent(ctx context.Context, component, pr
 		stats.RecordWithOptions(
 			ctx,
 			stats.WithRecorder(c.meter),
-			stats.WithTags(diagUtils.WithTags(c.pubsubIngressCount.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, processStatusKey, processStatus, statusKey, status, topicKey, topic)...),
+			stats.WithTags(diagUtils.WithTags(c.pubsubIngressCount.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, processStatusKey, processStatus, statusKey, status, topicKey, c.topicTag(topic))...),
 			stats.WithMeasurements(c.pubsubIngressCount.M(1)))
 
 		if elapsed > 0 {
 			stats.RecordWithOptions(
 				ctx,
 				stats.WithRecorder(c.meter),
-				stats.WithTags(diagUtils.WithTags(c.pubsubIngressLatency.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, processStatusKey, processStatus, statusKey, status, topicKey, topic)...),
+				stats.WithTags(diagUtils.WithTags(c.pubsubIngressLatency.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, processStatusKey, processStatus, statusKey, status, topicKey, c.topicTag(topic))...),
 				stats.WithMeasurements(c.pubsubIngressLatency.M(elapsed)))
 		}
 	}
 }
 
+// PubsubIngressExpiredEvent records the metrics for an expired pub/sub ingress event, with the action taken on it.
+func (c *componentMetrics) PubsubIngressExpiredEvent(ctx context.Context, component, action, topic string) {
+	if c.enabled {
+		stats.RecordWithOptions(
+			ctx,
+			stats.WithRecorder(c.meter),
+			stats.WithTags(diagUtils.WithTags(c.pubsubIngressExpiredCount.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, actionKey, action, topicKey, c.topicTag(topic))...),
+			stats.WithMeasurements(c.pubsubIngressExpiredCount.M(1)))
+	}
+}
+
+// PubsubIngressConcurrency records the number of messages waiting for a handler and the handler saturation of a subscription.
+func (c *componentMetrics) PubsubIngressConcurrency(ctx context.Context, component, topic string, queueDepth int64, saturation float64) {
+	if c.enabled {
+		stats.RecordWithOptions(
+			ctx,
+			stats.WithRecorder(c.meter),
+			stats.WithTags(diagUtils.WithTags(c.pubsubIngressQueueDepth.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, topicKey, c.topicTag(topic))...),
+			stats.WithMeasurements(c.pubsubIngressQueueDepth.M(queueDepth)))
+		stats.RecordWithOptions(
+			ctx,
+			stats.WithRecorder(c.meter),
+			stats.WithTags(diagUtils.WithTags(c.pubsubIngressSaturation.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, topicKey, c.topicTag(topic))...),
+			stats.WithMeasurements(c.pubsubIngressSaturation.M(saturation)))
+	}
+}
+
 // BulkPubsubIngressEvent records the metrics for a bulk pub/sub ingress event.
 func (c *componentMetrics) BulkPubsubIngressEvent(ctx context.Context, component, topic string, elapsed float64) {
 	if c.enabled {
 		stats.RecordWithOptions(
 			ctx,
 			stats.WithRecorder(c.meter),
-			stats.WithTags(diagUtils.WithTags(c.bulkPubsubIngressCount.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, topicKey, topic)...),
+			stats.WithTags(diagUtils.WithTags(c.bulkPubsubIngressCount.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, topicKey, c.topicTag(topic))...),
 			stats.WithMeasurements(c.bulkPubsubIngressCount.M(1)))
 
 		if elapsed > 0 {
 			stats.RecordWithOptions(
 				ctx,
 				stats.WithRecorder(c.meter),
-				stats.WithTags(diagUtils.WithTags(c.bulkPubsubIngressLatency.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, topicKey, topic)...),
+				stats.WithTags(diagUtils.WithTags(c.bulkPubsubIngressLatency.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, topicKey, c.topicTag(topic))...),
 				stats.WithMeasurements(c.bulkPubsubIngressLatency.M(elapsed)))
 		}
 	}
@@ -253,7 +379,7 @@ func (c *componentMetrics) BulkPubsubIngressEventEntries(ctx context.Context, co
 		stats.RecordWithOptions(
 			ctx,
 			stats.WithRecorder(c.meter),
-			stats.WithTags(diagUtils.WithTags(c.bulkPubsubEventIngressCount.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, processStatusKey, processStatus, topicKey, topic)...),
+			stats.WithTags(diagUtils.WithTags(c.bulkPubsubEventIngressCount.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, processStatusKey, processStatus, topicKey, c.topicTag(topic))...),
 			stats.WithMeasurements(c.bulkPubsubEventIngressCount.M(eventCount)))
 	}
 }
@@ -262,24 +388,26 @@ func (c *componentMetrics) BulkPubsubIngressEventEntries(ctx context.Context, co
 // eventCount if greater than zero implies successful publish of few/all events in the bulk publish call
 func (c *componentMetrics) BulkPubsubEgressEvent(ctx context.Context, component, topic string, success bool, eventCount int64, elapsed float64) {
 	if c.enabled {
+		c.ComponentInvoked(ctx, ComponentTypePubsub, component, "bulk_publish", success, elapsed)
+
 		stats.RecordWithOptions(
 			ctx,
 			stats.WithRecorder(c.meter),
-			stats.WithTags(diagUtils.WithTags(c.bulkPubsubEgressCount.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, successKey, strconv.FormatBool(success), topicKey, topic)...),
+			stats.WithTags(diagUtils.WithTags(c.bulkPubsubEgressCount.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, successKey, strconv.FormatBool(success), topicKey, c.topicTag(topic))...),
 			stats.WithMeasurements(c.bulkPubsubEgressCount.M(1)))
 		if eventCount > 0 {
 			// There is at leaset one success in the bulk publish call even if overall success of the call might be a failure
 			stats.RecordWithOptions(
 				ctx,
 				stats.WithRecorder(c.meter),
-				stats.WithTags(diagUtils.WithTags(c.bulkPubsubEventEgressCount.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, successKey, true, topicKey, topic)...),
+				stats.WithTags(diagUtils.WithTags(c.bulkPubsubEventEgressCount.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, successKey, true, topicKey, c.topicTag(topic))...),
 				stats.WithMeasurements(c.bulkPubsubEventEgressCount.M(eventCount)))
 		}
 		if elapsed > 0 {
 			stats.RecordWithOptions(
 				ctx,
 				stats.WithRecorder(c.meter),
-				stats.WithTags(diagUtils.WithTags(c.bulkPubsubEgressLatency.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, successKey, strconv.FormatBool(success), topicKey, topic)...),
+				stats.WithTags(diagUtils.WithTags(c.bulkPubsubEgressLatency.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, successKey, strconv.FormatBool(success), topicKey, c.topicTag(topic))...),
 				stats.WithMeasurements(c.bulkPubsubEgressLatency.M(elapsed)))
 		}
 	}
@@ -288,17 +416,19 @@ func (c *componentMetrics) BulkPubsubEgressEvent(ctx context.Context, component,
 // PubsubEgressEvent records the metris for a pub/sub egress event.
 func (c *componentMetrics) PubsubEgressEvent(ctx context.Context, component, topic string, success bool, elapsed float64) {
 	if c.enabled {
+		c.ComponentInvoked(ctx, ComponentTypePubsub, component, "publish", success, elapsed)
+
 		stats.RecordWithOptions(
 			ctx,
 			stats.WithRecorder(c.meter),
-			stats.WithTags(diagUtils.WithTags(c.pubsubEgressCount.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, successKey, strconv.FormatBool(success), topicKey, topic)...),
+			stats.WithTags(diagUtils.WithTags(c.pubsubEgressCount.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, successKey, strconv.FormatBool(success), topicKey, c.topicTag(topic))...),
 			stats.WithMeasurements(c.pubsubEgressCount.M(1)))
 
 		if elapsed > 0 {
 			stats.RecordWithOptions(
 				ctx,
 				stats.WithRecorder(c.meter),
-				stats.WithTags(diagUtils.WithTags(c.pubsubEgressLatency.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, successKey, strconv.FormatBool(success), topicKey, topic)...),
+				stats.WithTags(diagUtils.WithTags(c.pubsubEgressLatency.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, successKey, strconv.FormatBool(success), topicKey, c.topicTag(topic))...),
 				stats.WithMeasurements(c.pubsubEgressLatency.M(elapsed)))
 		}
 	}
@@ -326,6 +456,8 @@ func (c *componentMetrics) InputBindingEvent(ctx context.Context, component stri
 // OutputBindingEvent records the metrics for an output binding event.
 func (c *componentMetrics) OutputBindingEvent(ctx context.Context, component, operation string, success bool, elapsed float64) {
 	if c.enabled {
+		c.ComponentInvoked(ctx, ComponentTypeBindings, component, operation, success, elapsed)
+
 		stats.RecordWithOptions(
 			ctx,
 			stats.WithRecorder(c.meter),
@@ -345,6 +477,8 @@ func (c *componentMetrics) OutputBindingEvent(ctx context.Context, component, op
 // StateInvoked records the metrics for a state event.
 func (c *componentMetrics) StateInvoked(ctx context.Context, component, operation string, success bool, elapsed float64) {
 	if c.enabled {
+		c.ComponentInvoked(ctx, ComponentTypeState, component, operation, success, elapsed)
+
 		stats.RecordWithOptions(
 			ctx,
 			stats.WithRecorder(c.meter),
@@ -364,6 +498,8 @@ func (c *componentMetrics) StateInvoked(ctx context.Context, component, operatio
 // ConfigurationInvoked records the metrics for a configuration event.
 func (c *componentMetrics) ConfigurationInvoked(ctx context.Context, component, operation string, success bool, elapsed float64) {
 	if c.enabled {
+		c.ComponentInvoked(ctx, ComponentTypeConfiguration, component, operation, success, elapsed)
+
 		stats.RecordWithOptions(
 			ctx,
 			stats.WithRecorder(c.meter),
@@ -380,6 +516,18 @@ func (c *componentMetrics) ConfigurationInvoked(ctx context.Context, component,
 	}
 }
 
+// ConfigurationValidationFailed records the metrics for a configuration item
+// which failed validation, with the action taken on it.
+func (c *componentMetrics) ConfigurationValidationFailed(ctx context.Context, component, action string) {
+	if c.enabled {
+		stats.RecordWithOptions(
+			ctx,
+			stats.WithRecorder(c.meter),
+			stats.WithTags(diagUtils.WithTags(c.configurationValidationFailedCount.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, actionKey, action)...),
+			stats.WithMeasurements(c.configurationValidationFailedCount.M(1)))
+	}
+}
+
 // SecretInvoked records the metrics for a secret event.
 func (c *componentMetrics) ConversationInvoked(ctx context.Context, component string, success bool, elapsed float64) {
 	if c.enabled {
@@ -402,6 +550,8 @@ func (c *componentMetrics) ConversationInvoked(ctx context.Context, component st
 // SecretInvoked records the metrics for a secret event.
 func (c *componentMetrics) SecretInvoked(ctx context.Context, component, operation string, success bool, elapsed float64) {
 	if c.enabled {
+		c.ComponentInvoked(ctx, ComponentTypeSecretStores, component, operation, success, elapsed)
+
 		stats.RecordWithOptions(
 			ctx,
 			stats.WithRecorder(c.meter),
@@ -421,6 +571,8 @@ func (c *componentMetrics) SecretInvoked(ctx context.Context, component, operati
 // CryptoInvoked records the metrics for a crypto event.
 func (c *componentMetrics) CryptoInvoked(ctx context.Context, component, operation string, success bool, elapsed float64) {
 	if c.enabled {
+		c.ComponentInvoked(ctx, ComponentTypeCrypto, component, operation, success, elapsed)
+
 		stats.RecordWithOptions(
 			ctx,
 			stats.WithRecorder(c.meter),
@@ -437,6 +589,104 @@ func (c *componentMetrics) CryptoInvoked(ctx context.Context, component, operati
 	}
 }
 
+// pubsubTagKeys returns the tag keys for a pub/sub metric view, adding the topic key when enabled.
+func (c *componentMetrics) pubsubTagKeys(keys ...tag.Key) []tag.Key {
+	if c.pubsubIncludeTopic {
+		keys = append(keys, topicKey)
+	}
+	return keys
+}
+
+// pubsubIngressTagKeys returns the tag keys for a pub/sub ingress metric view, adding the partition and consumer group keys when increased cardinality is enabled.
+func (c *componentMetrics) pubsubIngressTagKeys(keys ...tag.Key) []tag.Key {
+	keys = c.pubsubTagKeys(keys...)
+	if c.pubsubIncreasedCardinality {
+		keys = append(keys, partitionKey, consumerGroupKey)
+	}
+	return keys
+}
+
+// topicTag returns the value to record for the topic tag.
+func (c *componentMetrics) topicTag(topic string) string {
+	if !c.pubsubIncludeTopic {
+		return ""
+	}
+	return c.topicLimiter.value(topic)
+}
+
+// PubsubIngressContext returns a context carrying the partition and consumer group tags of an incoming pub/sub message.
+// Pub/sub ingress metrics recorded with the returned context include these tags when increased cardinality is enabled.
+// The consumer group is taken from the message metadata if not set.
+func (c *componentMetrics) PubsubIngressContext(ctx context.Context, consumerGroup string, metadata map[string]string) context.Context {
+	if !c.enabled || !c.pubsubIncreasedCardinality {
+		return ctx
+	}
+
+	var partition string
+	for _, k := range pubsubPartitionMetadataKeys {
+		if v, ok := metadata[k]; ok {
+			partition = v
+			break
+		}
+	}
+	if consumerGroup == "" {
+		for _, k := range pubsubConsumerGroupMetadataKeys {
+			if v, ok := metadata[k]; ok {
+				consumerGroup = v
+				break
+			}
+		}
+	}
+
+	var mutators []tag.Mutator
+	if partition != "" {
+		mutators = append(mutators, tag.Upsert(partitionKey, c.partitionLimiter.value(partition)))
+	}
+	if consumerGroup != "" {
+		mutators = append(mutators, tag.Upsert(consumerGroupKey, c.consumerGroupLimiter.value(consumerGroup)))
+	}
+	if len(mutators) == 0 {
+		return ctx
+	}
+
+	tctx, err := tag.New(ctx, mutators...)
+	if err != nil {
+		return ctx
+	}
+	return tctx
+}
+
+// ComponentInvoked records the generic latency, error and health metrics for an operation invoked on a component of any type.
+func (c *componentMetrics) ComponentInvoked(ctx context.Context, componentType, component, operation string, success bool, elapsed float64) {
+	if c.enabled {
+		if elapsed > 0 {
+			stats.RecordWithOptions(
+				ctx,
+				stats.WithRecorder(c.meter),
+				stats.WithTags(diagUtils.WithTags(c.invocationLatency.Name(), appIDKey, c.appID, componentKey, component, componentTypeKey, componentType, namespaceKey, c.namespace, operationKey, operation, successKey, strconv.FormatBool(success))...),
+				stats.WithMeasurements(c.invocationLatency.M(elapsed)))
+		}
+
+		if !success {
+			stats.RecordWithOptions(
+				ctx,
+				stats.WithRecorder(c.meter),
+				stats.WithTags(diagUtils.WithTags(c.invocationErrorCount.Name(), appIDKey, c.appID, componentKey, component, componentTypeKey, componentType, namespaceKey, c.namespace, operationKey, operation)...),
+				stats.WithMeasurements(c.invocationErrorCount.M(1)))
+		}
+
+		var health int64
+		if success {
+			health = 1
+		}
+		stats.RecordWithOptions(
+			ctx,
+			stats.WithRecorder(c.meter),
+			stats.WithTags(diagUtils.WithTags(c.health.Name(), appIDKey, c.appID, componentKey, component, componentTypeKey, componentType, namespaceKey, c.namespace)...),
+			stats.WithMeasurements(c.health.M(health)))
+	}
+}
+
 func ElapsedSince(start time.Time) float64 {
 	return float64(time.Since(start) / time.Millisecond)
 }
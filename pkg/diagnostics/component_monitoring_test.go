@@ -5,7 +5,9 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
 
 	"github.com/dapr/dapr/pkg/config"
 )
@@ -18,7 +20,7 @@ func componentsMetrics() (*componentMetrics, view.Meter) {
 	c := newComponentMetrics()
 	meter := view.NewMeter()
 	meter.Start()
-	_ = c.Init(meter, "test", "default", config.LoadDefaultConfiguration().GetMetricsSpec().GetLatencyDistribution(log))
+	_ = c.Init(meter, "test", "default", NewPubsubMonitoringConfig(true, false, 0), config.LoadDefaultConfiguration().GetMetricsSpec().GetLatencyDistribution(log))
 
 	return c, meter
 }
@@ -80,6 +82,53 @@ func TestPubSub(t *testing.T) {
 		assert.InEpsilon(t, 1, viewData[0].Data.(*view.DistributionData).Min, 0)
 	})
 
+	t.Run("record ingress expired count", func(t *testing.T) {
+		c, meter := componentsMetrics()
+		t.Cleanup(func() {
+			meter.Stop()
+		})
+
+		c.PubsubIngressExpiredEvent(t.Context(), componentName, "deadletter", "A")
+		c.PubsubIngressExpiredEvent(t.Context(), componentName, "deadletter", "A")
+		c.PubsubIngressExpiredEvent(t.Context(), componentName, "drop", "A")
+
+		viewData, _ := meter.RetrieveData("component/pubsub_ingress/expired/count")
+		v := meter.Find("component/pubsub_ingress/expired/count")
+
+		allTagsPresent(t, v, viewData[0].Tags)
+
+		require.Len(t, viewData, 2)
+		counts := make(map[string]int64, len(viewData))
+		for _, row := range viewData {
+			for _, tag := range row.Tags {
+				if tag.Key.Name() == "action" {
+					counts[tag.Value] = row.Data.(*view.CountData).Value
+				}
+			}
+		}
+		assert.Equal(t, map[string]int64{"deadletter": 2, "drop": 1}, counts)
+	})
+
+	t.Run("record ingress concurrency", func(t *testing.T) {
+		c, meter := componentsMetrics()
+		t.Cleanup(func() {
+			meter.Stop()
+		})
+
+		c.PubsubIngressConcurrency(t.Context(), componentName, "A", 3, 0.5)
+		c.PubsubIngressConcurrency(t.Context(), componentName, "A", 2, 1)
+
+		viewData, _ := meter.RetrieveData("component/pubsub_ingress/queue_depth")
+		v := meter.Find("component/pubsub_ingress/queue_depth")
+		allTagsPresent(t, v, viewData[0].Tags)
+		assert.InEpsilon(t, float64(2), viewData[0].Data.(*view.LastValueData).Value, 0)
+
+		viewData, _ = meter.RetrieveData("component/pubsub_ingress/handler_saturation")
+		v = meter.Find("component/pubsub_ingress/handler_saturation")
+		allTagsPresent(t, v, viewData[0].Tags)
+		assert.InEpsilon(t, float64(1), viewData[0].Data.(*view.LastValueData).Value, 0)
+	})
+
 	t.Run("record egress latency", func(t *testing.T) {
 		c, meter := componentsMetrics()
 		t.Cleanup(func() {
@@ -97,6 +146,71 @@ func TestPubSub(t *testing.T) {
 	})
 }
 
+func TestPubSubLabels(t *testing.T) {
+	t.Run("topic excluded", func(t *testing.T) {
+		c := newComponentMetrics()
+		meter := view.NewMeter()
+		meter.Start()
+		t.Cleanup(func() { meter.Stop() })
+		require.NoError(t, c.Init(meter, "test", "default", NewPubsubMonitoringConfig(false, false, 0), view.Distribution(1, 2)))
+
+		c.PubsubEgressEvent(t.Context(), componentName, "A", true, 1)
+
+		viewData, _ := meter.RetrieveData("component/pubsub_egress/count")
+		require.Len(t, viewData, 1)
+		for _, tg := range viewData[0].Tags {
+			assert.NotEqual(t, topicKey, tg.Key)
+		}
+	})
+
+	t.Run("topics over the limit are grouped", func(t *testing.T) {
+		c := newComponentMetrics()
+		meter := view.NewMeter()
+		meter.Start()
+		t.Cleanup(func() { meter.Stop() })
+		require.NoError(t, c.Init(meter, "test", "default", NewPubsubMonitoringConfig(true, false, 1), view.Distribution(1, 2)))
+
+		c.PubsubEgressEvent(t.Context(), componentName, "A", true, 1)
+		c.PubsubEgressEvent(t.Context(), componentName, "B", true, 1)
+		c.PubsubEgressEvent(t.Context(), componentName, "C", true, 1)
+
+		viewData, _ := meter.RetrieveData("component/pubsub_egress/count")
+		require.Len(t, viewData, 2)
+		RequireTagExist(t, viewData, NewTag(topicKey.Name(), "A"))
+		RequireTagExist(t, viewData, NewTag(topicKey.Name(), overflowTagValue))
+	})
+
+	t.Run("partition and consumer group from ingress context", func(t *testing.T) {
+		c := newComponentMetrics()
+		meter := view.NewMeter()
+		meter.Start()
+		t.Cleanup(func() { meter.Stop() })
+		require.NoError(t, c.Init(meter, "test", "default", NewPubsubMonitoringConfig(true, true, 0), view.Distribution(1, 2)))
+
+		ctx := c.PubsubIngressContext(t.Context(), "", map[string]string{
+			"__partition":   "3",
+			"consumerGroup": "group1",
+		})
+		c.PubsubIngressEvent(ctx, componentName, "success", "success", "A", 1)
+
+		viewData, _ := meter.RetrieveData("component/pubsub_ingress/count")
+		require.Len(t, viewData, 1)
+		RequireTagExist(t, viewData, NewTag(partitionKey.Name(), "3"))
+		RequireTagExist(t, viewData, NewTag(consumerGroupKey.Name(), "group1"))
+	})
+
+	t.Run("partition not recorded without increased cardinality", func(t *testing.T) {
+		c, meter := componentsMetrics()
+		t.Cleanup(func() { meter.Stop() })
+
+		ctx := c.PubsubIngressContext(t.Context(), "group1", map[string]string{"__partition": "3"})
+		c.PubsubIngressEvent(ctx, componentName, "success", "success", "A", 1)
+
+		viewData, _ := meter.RetrieveData("component/pubsub_ingress/count")
+		RequireTagNotExist(t, viewData, NewTag(partitionKey.Name(), "3"))
+	})
+}
+
 func TestBindings(t *testing.T) {
 	t.Run("record input binding count", func(t *testing.T) {
 		c, meter := componentsMetrics()
@@ -220,6 +334,20 @@ func TestConfiguration(t *testing.T) {
 
 		assert.InEpsilon(t, 1, viewData[0].Data.(*view.DistributionData).Min, 0)
 	})
+
+	t.Run("record configuration validation failure", func(t *testing.T) {
+		c, meter := componentsMetrics()
+		t.Cleanup(func() {
+			meter.Stop()
+		})
+
+		c.ConfigurationValidationFailed(t.Context(), componentName, "drop")
+
+		viewData, _ := meter.RetrieveData("component/configuration/validation_failed/count")
+		v := meter.Find("component/configuration/validation_failed/count")
+
+		allTagsPresent(t, v, viewData[0].Tags)
+	})
 }
 
 func TestSecrets(t *testing.T) {
@@ -272,6 +400,53 @@ func TestConversation(t *testing.T) {
 	})
 }
 
+func TestComponentInvocation(t *testing.T) {
+	t.Run("record invocation latency", func(t *testing.T) {
+		c, meter := componentsMetrics()
+		t.Cleanup(func() {
+			meter.Stop()
+		})
+		c.StateInvoked(t.Context(), componentName, "get", true, 1)
+		viewData, _ := meter.RetrieveData("component/invocation/latencies")
+		v := meter.Find("component/invocation/latencies")
+		allTagsPresent(t, v, viewData[0].Tags)
+		assert.True(t, TagAndValuePresent(viewData[0].Tags, tag.Tag{Key: componentTypeKey, Value: ComponentTypeState}))
+		assert.InEpsilon(t, 1, viewData[0].Data.(*view.DistributionData).Min, 0)
+	})
+
+	t.Run("record invocation errors", func(t *testing.T) {
+		c, meter := componentsMetrics()
+		t.Cleanup(func() {
+			meter.Stop()
+		})
+		c.SecretInvoked(t.Context(), componentName, "get", true, 1)
+		c.SecretInvoked(t.Context(), componentName, "get", false, 1)
+		c.SecretInvoked(t.Context(), componentName, "get", false, 1)
+		viewData, _ := meter.RetrieveData("component/invocation/error_count")
+		v := meter.Find("component/invocation/error_count")
+		require.Len(t, viewData, 1)
+		allTagsPresent(t, v, viewData[0].Tags)
+		assert.True(t, TagAndValuePresent(viewData[0].Tags, tag.Tag{Key: componentTypeKey, Value: ComponentTypeSecretStores}))
+		assert.Equal(t, int64(2), viewData[0].Data.(*view.CountData).Value)
+	})
+
+	t.Run("record health from last operation", func(t *testing.T) {
+		c, meter := componentsMetrics()
+		t.Cleanup(func() {
+			meter.Stop()
+		})
+		c.OutputBindingEvent(t.Context(), componentName, "create", true, 1)
+		viewData, _ := meter.RetrieveData("component/health")
+		require.Len(t, viewData, 1)
+		assert.InEpsilon(t, float64(1), viewData[0].Data.(*view.LastValueData).Value, 0)
+
+		c.OutputBindingEvent(t.Context(), componentName, "create", false, 1)
+		viewData, _ = meter.RetrieveData("component/health")
+		require.Len(t, viewData, 1)
+		assert.Zero(t, viewData[0].Data.(*view.LastValueData).Value)
+	})
+}
+
 func TestComponentMetricsInit(t *testing.T) {
 	c, meter := componentsMetrics()
 	t.Cleanup(func() {
@@ -42,11 +42,29 @@ const (
 	DaprAPIInvokeMethod               = "dapr.invoke_method"
 	DaprAPIActorTypeID                = "dapr.actor"
 
+	DaprComponentTypeSpanAttributeKey    = "dapr.component.type"
+	DaprBindingOperationSpanAttributeKey = "dapr.binding.operation"
+
+	// Attributes added to the workflow and activity spans of durabletask
+	WorkflowNewEventsSpanAttributeKey = "dapr.workflow.new_events"
+	WorkflowAppIDSpanAttributeKey     = "dapr.workflow.app_id"
+
 	OtelSpanConvHTTPRequestMethodAttributeKey = "http.request.method"
 	OtelSpanConvServerAddressAttributeKey     = "server.address"
 	OtelSpanConvServerPortAttributeKey        = "server.port"
 	OtelSpanConvURLFullAttributeKey           = "url.full"
 
+	// Span events and attributes recorded by resiliency policies
+	ResiliencyRetryEventName              = "dapr.resiliency.retry"
+	ResiliencyCircuitBreakerEventName     = "dapr.resiliency.circuit_breaker"
+	ResiliencyPolicySpanAttributeKey      = "dapr.resiliency.policy"
+	ResiliencyAttemptSpanAttributeKey     = "dapr.resiliency.attempt"
+	ResiliencyBackoffMsSpanAttributeKey   = "dapr.resiliency.backoff_ms"
+	ResiliencyErrorSpanAttributeKey       = "dapr.resiliency.error"
+	ResiliencyCBNameSpanAttributeKey      = "dapr.resiliency.circuit_breaker.name"
+	ResiliencyCBFromStateSpanAttributeKey = "dapr.resiliency.circuit_breaker.from_state"
+	ResiliencyCBToStateSpanAttributeKey   = "dapr.resiliency.circuit_breaker.to_state"
+
 	DaprAPIHTTPSpanAttrValue = "http"
 	DaprAPIGRPCSpanAttrValue = "grpc"
 
@@ -69,6 +87,17 @@ const (
 	TracestateHeader  = "tracestate"
 	BaggageHeader     = "baggage"
 
+	// Zipkin B3 propagation headers
+	B3SingleHeader  = "b3"
+	B3TraceIDHeader = "x-b3-traceid"
+	B3SpanIDHeader  = "x-b3-spanid"
+	B3SampledHeader = "x-b3-sampled"
+	B3FlagsHeader   = "x-b3-flags"
+
+	// Log fields used to correlate runtime logs with traces
+	LogTraceIDField = "trace_id"
+	LogSpanIDField  = "span_id"
+
 	GRPCTraceContextKey  = "grpc-trace-bin"
 	GRPCProxyAppIDKey    = "dapr-app-id"
 	GRPCProxyCalleeIDKey = "dapr-callee-app-id"
@@ -38,6 +38,7 @@ const (
 	daprInternalPrefix        = "/dapr.proto.internals."
 	daprRuntimePrefix         = "/dapr.proto.runtime."
 	daprInvokeServiceMethod   = "/dapr.proto.runtime.v1.Dapr/InvokeService"
+	daprInvokeServiceStream   = "/dapr.proto.runtime.v1.Dapr/InvokeServiceStreamAlpha1"
 	daprCallLocalStreamMethod = "/dapr.proto.internals.v1.ServiceInvocation/CallLocalStream"
 	daprWorkflowPrefix        = "/TaskHubSidecarService"
 )
@@ -75,8 +76,19 @@ func handleBaggage(ctx context.Context) (context.Context, string, error) {
 	return grpcMetadata.NewIncomingContext(ctx, md), validBaggage, nil
 }
 
+// BaggageFromGRPCContext returns the W3C baggage carried by the incoming gRPC metadata, if any.
+// Baggage is validated by the gRPC trace interceptors before it reaches the API handlers.
+func BaggageFromGRPCContext(ctx context.Context) string {
+	md, ok := grpcMetadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	return strings.Join(md.Get(diagConsts.BaggageHeader), ",")
+}
+
 // GRPCTraceUnaryServerInterceptor sets the trace context or starts the trace client span based on request.
 func GRPCTraceUnaryServerInterceptor(appID string, spec config.TracingSpec) grpc.UnaryServerInterceptor {
+	attributeRules := newSpanAttributeRules(spec.AttributeRules)
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
 		var (
 			span             trace.Span
@@ -123,6 +135,10 @@ func GRPCTraceUnaryServerInterceptor(appID string, spec config.TracingSpec) grpc
 				prefixedMetadata[key] = value
 			}
 			AddAttributesToSpan(span, prefixedMetadata)
+			if len(attributeRules) > 0 {
+				md, _ := metadata.FromIncomingContext(ctx)
+				AddAttributesToSpan(span, attributeRules.fromGRPCRequest(md, req))
+			}
 
 			// Correct the span name based on API.
 			if sname, ok := reqSpanAttr[diagConsts.DaprAPISpanNameInternal]; ok {
@@ -146,6 +162,7 @@ func GRPCTraceUnaryServerInterceptor(appID string, spec config.TracingSpec) grpc
 // GRPCTraceStreamServerInterceptor sets the trace context or starts the trace client span based on request.
 // This is used by proxy requests too.
 func GRPCTraceStreamServerInterceptor(appID string, spec config.TracingSpec) grpc.StreamServerInterceptor {
+	attributeRules := newSpanAttributeRules(spec.AttributeRules)
 	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
 		var (
 			span      trace.Span
@@ -229,6 +246,10 @@ func GRPCTraceStreamServerInterceptor(appID string, spec config.TracingSpec) grp
 				prefixedMetadata[key] = value
 			}
 			AddAttributesToSpan(span, prefixedMetadata)
+			if len(attributeRules) > 0 {
+				md, _ := metadata.FromIncomingContext(ctx)
+				AddAttributesToSpan(span, attributeRules.fromGRPCRequest(md, nil))
+			}
 
 			// Correct the span name based on API.
 			if sname, ok := reqSpanAttr[diagConsts.DaprAPISpanNameInternal]; ok {
@@ -237,7 +258,7 @@ func GRPCTraceStreamServerInterceptor(appID string, spec config.TracingSpec) grp
 		}
 
 		// Add grpc-trace-bin header for all non-invocation api's
-		if !isProxied && info.FullMethod != daprInvokeServiceMethod {
+		if !isProxied && info.FullMethod != daprInvokeServiceMethod && info.FullMethod != daprInvokeServiceStream {
 			traceContextBinary := diagUtils.BinaryFromSpanContext(span.SpanContext())
 			grpc.SetHeader(ctx, grpcMetadata.Pairs(diagConsts.GRPCTraceContextKey, string(traceContextBinary)))
 		}
@@ -316,6 +337,13 @@ func SpanContextFromIncomingGRPCMetadata(ctx context.Context) (trace.SpanContext
 				ts := TraceStateFromW3CString(md[diagConsts.TracestateHeader][0])
 				sc.WithTraceState(*ts)
 			}
+		} else if b3PropagationFormat() != "" {
+			sc, ok = spanContextFromB3(func(key string) string {
+				if vals := md[key]; len(vals) > 0 {
+					return vals[0]
+				}
+				return ""
+			})
 		}
 	}
 	return sc, ok
@@ -328,7 +356,11 @@ func SpanContextToGRPCMetadata(ctx context.Context, spanContext trace.SpanContex
 		return ctx
 	}
 
-	return grpcMetadata.AppendToOutgoingContext(ctx, diagConsts.GRPCTraceContextKey, string(traceContextBinary))
+	kv := []string{diagConsts.GRPCTraceContextKey, string(traceContextBinary)}
+	spanContextToB3(spanContext, func(key, value string) {
+		kv = append(kv, key, value)
+	})
+	return grpcMetadata.AppendToOutgoingContext(ctx, kv...)
 }
 
 // spanAttributesMapFromGRPC builds the span trace attributes map for gRPC calls based on given parameters as per open-telemetry specs.
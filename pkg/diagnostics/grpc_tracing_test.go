@@ -89,6 +89,16 @@ func TestUserDefinedMetadata(t *testing.T) {
 	assert.Equal(t, "value2", m["dapr-userdefined-2"])
 }
 
+func TestBaggageFromGRPCContext(t *testing.T) {
+	assert.Empty(t, BaggageFromGRPCContext(t.Context()))
+
+	ctx := grpcMetadata.NewIncomingContext(t.Context(), grpcMetadata.Pairs(
+		diagConsts.BaggageHeader, "key1=value1",
+		diagConsts.BaggageHeader, "key2=value2",
+	))
+	assert.Equal(t, "key1=value1,key2=value2", BaggageFromGRPCContext(ctx))
+}
+
 func TestSpanContextToGRPCMetadata(t *testing.T) {
 	t.Run("empty span context", func(t *testing.T) {
 		ctx := t.Context()
@@ -33,6 +33,7 @@ var (
 	httpStatusCodeKey = tag.MustNewKey("status")
 	httpPathKey       = tag.MustNewKey("path")
 	httpMethodKey     = tag.MustNewKey("method")
+	httpReusedKey     = tag.MustNewKey("reused")
 
 	log = logger.NewLogger("dapr.runtime.diagnostics")
 )
@@ -48,10 +49,14 @@ type httpMetrics struct {
 	clientReceivedBytes    *stats.Int64Measure
 	clientRoundtripLatency *stats.Float64Measure
 	clientCompletedCount   *stats.Int64Measure
+	clientStreamedBytes    *stats.Int64Measure
 
 	healthProbeCompletedCount   *stats.Int64Measure
 	healthProbeRoundtripLatency *stats.Float64Measure
 
+	appChannelOpenConnections     *stats.Int64Measure
+	appChannelConnectionsAcquired *stats.Int64Measure
+
 	appID   string
 	enabled bool
 
@@ -103,6 +108,10 @@ func newHTTPMetrics() *httpMetrics {
 			"http/client/completed_count",
 			"Count of completed requests",
 			stats.UnitDimensionless),
+		clientStreamedBytes: stats.Int64(
+			"http/client/streamed_bytes",
+			"Size of each response chunk streamed incrementally to the caller",
+			stats.UnitBytes),
 		healthProbeCompletedCount: stats.Int64(
 			"http/healthprobes/completed_count",
 			"Count of completed health probes",
@@ -111,6 +120,14 @@ func newHTTPMetrics() *httpMetrics {
 			"http/healthprobes/roundtrip_latency",
 			"Time between first byte of health probes headers sent to last byte of response received, or terminal error",
 			stats.UnitMilliseconds),
+		appChannelOpenConnections: stats.Int64(
+			"http/client/app_channel/open_connections",
+			"Number of connections open to the app in the pool of the HTTP app channel",
+			stats.UnitDimensionless),
+		appChannelConnectionsAcquired: stats.Int64(
+			"http/client/app_channel/connections_acquired",
+			"Count of connections acquired from the pool of the HTTP app channel, and whether they were reused",
+			stats.UnitDimensionless),
 
 		enabled: false,
 	}
@@ -252,6 +269,19 @@ func (h *httpMetrics) ClientRequestCompleted(ctx context.Context, method, path,
 		stats.WithMeasurements(h.clientReceivedBytes.M(contentSize)))
 }
 
+// ClientResponseChunkStreamed records a response chunk that was forwarded to the caller without buffering.
+func (h *httpMetrics) ClientResponseChunkStreamed(ctx context.Context, size int64) {
+	if !h.IsEnabled() {
+		return
+	}
+
+	stats.RecordWithOptions(
+		ctx,
+		stats.WithRecorder(h.meter),
+		stats.WithTags(diagUtils.WithTags(h.clientStreamedBytes.Name(), appIDKey, h.appID)...),
+		stats.WithMeasurements(h.clientStreamedBytes.M(size)))
+}
+
 func (h *httpMetrics) AppHealthProbeStarted(ctx context.Context) {
 	if !h.IsEnabled() {
 		return
@@ -279,6 +309,32 @@ func (h *httpMetrics) AppHealthProbeCompleted(ctx context.Context, status string
 		stats.WithMeasurements(h.healthProbeRoundtripLatency.M(elapsed)))
 }
 
+// AppChannelOpenConnections records the number of connections open to the app.
+func (h *httpMetrics) AppChannelOpenConnections(ctx context.Context, open int64) {
+	if !h.IsEnabled() {
+		return
+	}
+
+	stats.RecordWithOptions(
+		ctx,
+		stats.WithRecorder(h.meter),
+		stats.WithTags(diagUtils.WithTags(h.appChannelOpenConnections.Name(), appIDKey, h.appID)...),
+		stats.WithMeasurements(h.appChannelOpenConnections.M(open)))
+}
+
+// AppChannelConnectionAcquired records a connection to the app acquired for a request.
+func (h *httpMetrics) AppChannelConnectionAcquired(ctx context.Context, reused bool) {
+	if !h.IsEnabled() {
+		return
+	}
+
+	stats.RecordWithOptions(
+		ctx,
+		stats.WithRecorder(h.meter),
+		stats.WithTags(diagUtils.WithTags(h.appChannelConnectionsAcquired.Name(), appIDKey, h.appID, httpReusedKey, strconv.FormatBool(reused))...),
+		stats.WithMeasurements(h.appChannelConnectionsAcquired.M(1)))
+}
+
 type HTTPMonitoringConfig struct {
 	pathMatching []string
 	legacy       bool
@@ -318,8 +374,11 @@ func (h *httpMetrics) Init(meter view.Meter, appID string, config HTTPMonitoring
 		diagUtils.NewMeasureView(h.clientReceivedBytes, tags, defaultSizeDistribution),
 		diagUtils.NewMeasureView(h.clientRoundtripLatency, clientTags, latencyDistribution),
 		diagUtils.NewMeasureView(h.clientCompletedCount, clientTags, view.Count()),
+		diagUtils.NewMeasureView(h.clientStreamedBytes, tags, defaultSizeDistribution),
 		diagUtils.NewMeasureView(h.healthProbeRoundtripLatency, []tag.Key{appIDKey, httpStatusCodeKey}, latencyDistribution),
 		diagUtils.NewMeasureView(h.healthProbeCompletedCount, []tag.Key{appIDKey, httpStatusCodeKey}, view.Count()),
+		diagUtils.NewMeasureView(h.appChannelOpenConnections, tags, view.LastValue()),
+		diagUtils.NewMeasureView(h.appChannelConnectionsAcquired, []tag.Key{appIDKey, httpReusedKey}, view.Count()),
 	}
 
 	if h.legacy {
@@ -310,6 +310,59 @@ func TestHTTPMetricsPathMatchingWithRedirect(t *testing.T) {
 	require.Equal(t, testPath, matchedPath, "Expected matched path to be %q", testPath)
 }
 
+func TestHTTPClientResponseChunkStreamed(t *testing.T) {
+	testHTTP := newHTTPMetrics()
+	meter := view.NewMeter()
+	meter.Start()
+	t.Cleanup(func() {
+		meter.Stop()
+	})
+	require.NoError(t, testHTTP.Init(meter, "fakeID", NewHTTPMonitoringConfig(nil, false, false), config.LoadDefaultConfiguration().GetMetricsSpec().GetLatencyDistribution(log)))
+
+	testHTTP.ClientResponseChunkStreamed(t.Context(), 10)
+	testHTTP.ClientResponseChunkStreamed(t.Context(), 30)
+
+	rows, err := meter.RetrieveData("http/client/streamed_bytes")
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, "app_id", rows[0].Tags[0].Key.Name())
+	assert.Equal(t, "fakeID", rows[0].Tags[0].Value)
+	data := (rows[0].Data).(*view.DistributionData)
+	assert.Equal(t, int64(2), data.Count)
+	assert.InEpsilon(t, 20.0, data.Mean, 0)
+}
+
+func TestAppChannelPoolMetrics(t *testing.T) {
+	testHTTP := newHTTPMetrics()
+	meter := view.NewMeter()
+	meter.Start()
+	t.Cleanup(func() {
+		meter.Stop()
+	})
+	require.NoError(t, testHTTP.Init(meter, "fakeID", NewHTTPMonitoringConfig(nil, false, false), config.LoadDefaultConfiguration().GetMetricsSpec().GetLatencyDistribution(log)))
+
+	testHTTP.AppChannelOpenConnections(t.Context(), 1)
+	testHTTP.AppChannelOpenConnections(t.Context(), 2)
+	testHTTP.AppChannelConnectionAcquired(t.Context(), false)
+	testHTTP.AppChannelConnectionAcquired(t.Context(), true)
+	testHTTP.AppChannelConnectionAcquired(t.Context(), true)
+
+	rows, err := meter.RetrieveData("http/client/app_channel/open_connections")
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.InEpsilon(t, float64(2), rows[0].Data.(*view.LastValueData).Value, 0)
+
+	rows, err = meter.RetrieveData("http/client/app_channel/connections_acquired")
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	counts := map[string]int64{}
+	for _, row := range rows {
+		assert.Equal(t, "reused", row.Tags[1].Key.Name())
+		counts[row.Tags[1].Value] = row.Data.(*view.CountData).Value
+	}
+	assert.Equal(t, map[string]int64{"false": 1, "true": 2}, counts)
+}
+
 func fakeHTTPRequest(body string) *http.Request {
 	req, err := http.NewRequest(http.MethodPost, "http://dapr.io/invoke/method/testmethod", strings.NewReader(body))
 	if err != nil {
@@ -55,8 +55,15 @@ func handleHTTPBaggage(r *http.Request) (*http.Request, string, error) {
 	return r, validBaggage, nil
 }
 
+// BaggageFromHTTPRequest returns the W3C baggage carried by the request headers, if any.
+// Baggage is validated by HTTPTraceMiddleware before it reaches the API handlers.
+func BaggageFromHTTPRequest(r *http.Request) string {
+	return strings.Join(r.Header.Values(diagConsts.BaggageHeader), ",")
+}
+
 // HTTPTraceMiddleware sets the trace context or starts the trace client span based on request.
 func HTTPTraceMiddleware(next http.Handler, appID string, spec config.TracingSpec) http.Handler {
+	attributeRules := newSpanAttributeRules(spec.AttributeRules)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		path := r.URL.Path
 		if isHealthzRequest(path) {
@@ -85,6 +92,7 @@ func HTTPTraceMiddleware(next http.Handler, appID string, spec config.TracingSpe
 			// Add span attributes only if it is sampled, which reduced the perf impact.
 			if span.SpanContext().IsSampled() {
 				AddAttributesToSpan(span, userDefinedHTTPHeaders(r))
+				AddAttributesToSpan(span, attributeRules.fromHTTPRequest(r))
 				spanAttr := spanAttributesMapFromHTTPContext(rw, r)
 				AddAttributesToSpan(span, spanAttr)
 
@@ -154,7 +162,10 @@ func StartProducerSpanChildFromParent(r *http.Request, parentSpan trace.Span) tr
 func SpanContextFromRequest(r *http.Request) (sc trace.SpanContext) {
 	h := r.Header.Get(diagConsts.TraceparentHeader)
 	if h == "" {
-		return trace.SpanContext{}
+		if b3PropagationFormat() != "" {
+			sc, _ = spanContextFromB3(r.Header.Get)
+		}
+		return sc
 	}
 	sc, ok := SpanContextFromW3CString(h)
 	if ok {
@@ -206,6 +217,7 @@ func SpanContextToHTTPHeaders(sc trace.SpanContext, setHeader func(string, strin
 	h := SpanContextToW3CString(sc)
 	setHeader(diagConsts.TraceparentHeader, h)
 	tracestateToHeader(sc, setHeader)
+	spanContextToB3(sc, setHeader)
 }
 
 func tracestateToHeader(sc trace.SpanContext, setHeader func(string, string)) {
@@ -117,6 +117,17 @@ func TestUserDefinedHTTPHeaders(t *testing.T) {
 	assert.Equal(t, "value2", m["dapr-userdefined-2"])
 }
 
+func TestBaggageFromHTTPRequest(t *testing.T) {
+	req := &http.Request{
+		Header: make(http.Header),
+	}
+	assert.Empty(t, BaggageFromHTTPRequest(req))
+
+	req.Header.Add(diagConsts.BaggageHeader, "key1=value1")
+	req.Header.Add(diagConsts.BaggageHeader, "key2=value2")
+	assert.Equal(t, "key1=value1,key2=value2", BaggageFromHTTPRequest(req))
+}
+
 func TestSpanContextToHTTPHeaders(t *testing.T) {
 	tests := []struct {
 		sc trace.SpanContextConfig
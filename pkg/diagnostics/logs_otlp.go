@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diagnostics
+
+import (
+	"bytes"
+	compressgzip "compress/gzip"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/gzip"
+	grpcMetadata "google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dapr/dapr/pkg/config"
+)
+
+// defaultOtlpLogsTimeout is the timeout of the exports when the Otel exporter
+// configuration has none.
+const defaultOtlpLogsTimeout = 10 * time.Second
+
+// OtlpLogsClient exports log records to an OTLP logs endpoint.
+type OtlpLogsClient interface {
+	Export(ctx context.Context, logs []*logspb.ResourceLogs) error
+	Close() error
+}
+
+// NewOtlpLogsClient returns the OTLP logs client for the given Otel exporter configuration.
+func NewOtlpLogsClient(spec config.OtelSpec) (OtlpLogsClient, error) {
+	settings, err := parseOtlpSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+	if settings.timeout <= 0 {
+		settings.timeout = defaultOtlpLogsTimeout
+	}
+
+	tlsConfig := settings.tlsConfig
+	if tlsConfig == nil && spec.GetIsSecure() {
+		tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+
+	if settings.protocol == "http" {
+		scheme := "http"
+		if spec.GetIsSecure() {
+			scheme = "https"
+		}
+		return &otlpHTTPLogsClient{
+			url: scheme + "://" + spec.EndpointAddress + "/v1/logs",
+			client: &http.Client{
+				Timeout:   settings.timeout,
+				Transport: &http.Transport{TLSClientConfig: tlsConfig},
+			},
+			headers: settings.headers,
+			gzip:    settings.compression == otlpCompressionGzip,
+		}, nil
+	}
+
+	creds := insecure.NewCredentials()
+	if spec.GetIsSecure() {
+		creds = credentials.NewTLS(tlsConfig)
+	}
+	dialOptions := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	if settings.compression == otlpCompressionGzip {
+		dialOptions = append(dialOptions, grpc.WithDefaultCallOptions(grpc.UseCompressor(gzip.Name)))
+	}
+	conn, err := grpc.NewClient(spec.EndpointAddress, dialOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gRPC client for Otel endpoint: %w", err)
+	}
+	return &otlpGRPCLogsClient{
+		conn:    conn,
+		client:  collogspb.NewLogsServiceClient(conn),
+		headers: settings.headers,
+		timeout: settings.timeout,
+	}, nil
+}
+
+type otlpGRPCLogsClient struct {
+	conn    *grpc.ClientConn
+	client  collogspb.LogsServiceClient
+	headers map[string]string
+	timeout time.Duration
+}
+
+func (c *otlpGRPCLogsClient) Export(ctx context.Context, logs []*logspb.ResourceLogs) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	if len(c.headers) > 0 {
+		ctx = grpcMetadata.NewOutgoingContext(ctx, grpcMetadata.New(c.headers))
+	}
+
+	_, err := c.client.Export(ctx, &collogspb.ExportLogsServiceRequest{ResourceLogs: logs})
+	return err
+}
+
+func (c *otlpGRPCLogsClient) Close() error {
+	return c.conn.Close()
+}
+
+type otlpHTTPLogsClient struct {
+	url     string
+	client  *http.Client
+	headers map[string]string
+	gzip    bool
+}
+
+func (c *otlpHTTPLogsClient) Export(ctx context.Context, logs []*logspb.ResourceLogs) error {
+	body, err := proto.Marshal(&collogspb.ExportLogsServiceRequest{ResourceLogs: logs})
+	if err != nil {
+		return fmt.Errorf("failed to marshal logs: %w", err)
+	}
+	if c.gzip {
+		var buf bytes.Buffer
+		zw := compressgzip.NewWriter(&buf)
+		if _, err = zw.Write(body); err == nil {
+			err = zw.Close()
+		}
+		if err != nil {
+			return fmt.Errorf("failed to compress logs: %w", err)
+		}
+		body = buf.Bytes()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	if c.gzip {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+	for k, v := range c.headers {
+		req.Header.Set(k, v)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("failed to export logs: status code %d", res.StatusCode)
+	}
+	return nil
+}
+
+func (c *otlpHTTPLogsClient) Close() error {
+	c.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diagnostics
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/kit/ptr"
+)
+
+func TestNewOtlpLogsClient(t *testing.T) {
+	t.Run("invalid protocol", func(t *testing.T) {
+		_, err := NewOtlpLogsClient(config.OtelSpec{Protocol: "tcp", EndpointAddress: "localhost:4317"})
+		require.Error(t, err)
+	})
+
+	t.Run("grpc", func(t *testing.T) {
+		client, err := NewOtlpLogsClient(config.OtelSpec{Protocol: "grpc", EndpointAddress: "localhost:4317", IsSecure: ptr.Of(false)})
+		require.NoError(t, err)
+		assert.IsType(t, &otlpGRPCLogsClient{}, client)
+		require.NoError(t, client.Close())
+	})
+
+	t.Run("http exports the logs", func(t *testing.T) {
+		var got collogspb.ExportLogsServiceRequest
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/v1/logs", r.URL.Path)
+			assert.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+			assert.Equal(t, "value", r.Header.Get("X-Key"))
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.NoError(t, proto.Unmarshal(body, &got))
+		}))
+		t.Cleanup(server.Close)
+
+		client, err := NewOtlpLogsClient(config.OtelSpec{
+			Protocol:        "http",
+			EndpointAddress: strings.TrimPrefix(server.URL, "http://"),
+			IsSecure:        ptr.Of(false),
+			Headers:         "X-Key=value",
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() { client.Close() })
+
+		err = client.Export(t.Context(), []*logspb.ResourceLogs{{
+			ScopeLogs: []*logspb.ScopeLogs{{LogRecords: []*logspb.LogRecord{{SeverityText: "INFO"}}}},
+		}})
+		require.NoError(t, err)
+		require.Len(t, got.GetResourceLogs(), 1)
+		assert.Equal(t, "INFO", got.GetResourceLogs()[0].GetScopeLogs()[0].GetLogRecords()[0].GetSeverityText())
+	})
+
+	t.Run("http export fails on error status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		t.Cleanup(server.Close)
+
+		client, err := NewOtlpLogsClient(config.OtelSpec{
+			Protocol:        "http",
+			EndpointAddress: strings.TrimPrefix(server.URL, "http://"),
+			IsSecure:        ptr.Of(false),
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() { client.Close() })
+
+		require.Error(t, client.Export(t.Context(), nil))
+	})
+}
@@ -42,6 +42,8 @@ var (
 	DefaultResiliencyMonitoring = newResiliencyMetrics()
 	// DefaultWorkflowMonitoring holds workflow specific metrics.
 	DefaultWorkflowMonitoring = newWorkflowMetrics()
+	// DefaultActorMonitoring holds actor runtime specific metrics.
+	DefaultActorMonitoring = newActorMetrics()
 	// DefaultErrorCodeMonitoring holds error code specific metrics.
 	DefaultErrorCodeMonitoring = newErrorCodeMetrics()
 )
@@ -71,7 +73,12 @@ func InitMetrics(meter view.Meter, appID, namespace string, metricSpec config.Me
 		return err
 	}
 
-	if err := DefaultComponentMonitoring.Init(meter, appID, namespace, latencyDistribution); err != nil {
+	pubsubConfig := NewPubsubMonitoringConfig(
+		metricSpec.GetPubSubIncludeTopic(),
+		metricSpec.GetPubSubIncreasedCardinality(),
+		metricSpec.GetPubSubMaxLabelCardinality(),
+	)
+	if err := DefaultComponentMonitoring.Init(meter, appID, namespace, pubsubConfig, latencyDistribution); err != nil {
 		return err
 	}
 
@@ -83,6 +90,10 @@ func InitMetrics(meter view.Meter, appID, namespace string, metricSpec config.Me
 		return err
 	}
 
+	if err := DefaultActorMonitoring.Init(meter, appID, namespace, latencyDistribution); err != nil {
+		return err
+	}
+
 	if metricSpec.GetRecordErrorCodes() {
 		if err := DefaultErrorCodeMonitoring.Init(meter, appID); err != nil {
 			return err
@@ -33,6 +33,7 @@ var (
 	targetKey           = tag.MustNewKey("target")
 	typeKey             = tag.MustNewKey("type")
 	categoryKey         = tag.MustNewKey("category")
+	apiGroupKey         = tag.MustNewKey("api")
 )
 
 const (
@@ -79,6 +80,9 @@ type serviceMetrics struct {
 	serviceInvocationResponseReceivedTotal   *stats.Int64Measure
 	serviceInvocationResponseReceivedLatency *stats.Float64Measure
 
+	// API rate limiting metrics
+	apiRateLimitedTotal *stats.Int64Measure
+
 	appID                 string
 	ctx                   context.Context
 	enabled               bool
@@ -208,6 +212,12 @@ func newServiceMetrics() *serviceMetrics {
 			"The latency of service invocation response.",
 			stats.UnitMilliseconds),
 
+		// API rate limiting
+		apiRateLimitedTotal: stats.Int64(
+			"runtime/api/rate_limited_total",
+			"The number of API requests rejected because the rate limit of the API group was exceeded.",
+			stats.UnitDimensionless),
+
 		// TODO: use the correct context for each request
 		ctx:               context.Background(),
 		pendingActorCalls: make(map[string]int32),
@@ -253,6 +263,8 @@ func (s *serviceMetrics) Init(meter view.Meter, appID string, latencyDistributio
 		diagUtils.NewMeasureView(s.serviceInvocationResponseSentTotal, []tag.Key{appIDKey, destinationAppIDKey, statusKey}, view.Count()),
 		diagUtils.NewMeasureView(s.serviceInvocationResponseReceivedTotal, []tag.Key{appIDKey, sourceAppIDKey, statusKey, typeKey}, view.Count()),
 		diagUtils.NewMeasureView(s.serviceInvocationResponseReceivedLatency, []tag.Key{appIDKey, sourceAppIDKey, statusKey}, latencyDistribution),
+
+		diagUtils.NewMeasureView(s.apiRateLimitedTotal, []tag.Key{appIDKey, apiGroupKey}, view.Count()),
 	)
 }
 
@@ -611,3 +623,14 @@ func (s *serviceMetrics) ServiceInvocationStreamingResponseReceived(sourceAppID
 			stats.WithMeasurements(s.serviceInvocationResponseReceivedTotal.M(1)))
 	}
 }
+
+// APIRequestRateLimited records an API request that was rejected by the rate limiter of the API group.
+func (s *serviceMetrics) APIRequestRateLimited(apiGroup string) {
+	if s.enabled {
+		stats.RecordWithOptions(
+			s.ctx,
+			stats.WithRecorder(s.meter),
+			stats.WithTags(diagUtils.WithTags(s.apiRateLimitedTotal.Name(), appIDKey, s.appID, apiGroupKey, apiGroup)...),
+			stats.WithMeasurements(s.apiRateLimitedTotal.M(1)))
+	}
+}
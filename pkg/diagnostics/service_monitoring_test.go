@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opencensus.io/stats/view"
 
 	"github.com/dapr/dapr/pkg/config"
@@ -88,6 +89,22 @@ func TestServiceInvocation(t *testing.T) {
 	})
 }
 
+func TestAPIRequestRateLimited(t *testing.T) {
+	s, meter := servicesMetrics()
+	t.Cleanup(func() {
+		meter.Stop()
+	})
+
+	s.APIRequestRateLimited("state")
+	s.APIRequestRateLimited("state")
+
+	viewData, err := meter.RetrieveData("runtime/api/rate_limited_total")
+	require.NoError(t, err)
+	require.Len(t, viewData, 1)
+	allTagsPresent(t, meter.Find("runtime/api/rate_limited_total"), viewData[0].Tags)
+	assert.Equal(t, int64(2), viewData[0].Data.(*view.CountData).Value)
+}
+
 func TestSerivceMonitoringInit(t *testing.T) {
 	c, meter := servicesMetrics()
 	t.Cleanup(func() {
@@ -18,6 +18,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strings"
+	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -26,6 +27,7 @@ import (
 	"github.com/dapr/dapr/pkg/config"
 	diagConsts "github.com/dapr/dapr/pkg/diagnostics/consts"
 	diagUtils "github.com/dapr/dapr/pkg/diagnostics/utils"
+	"github.com/dapr/kit/logger"
 )
 
 const (
@@ -145,13 +147,57 @@ func AddAttributesToSpan(span trace.Span, attributes map[string]string) {
 	}
 }
 
+// AddResiliencyRetryEvent records a failed attempt that is about to be retried as an event on the span in the context.
+func AddResiliencyRetryEvent(ctx context.Context, policy string, attempt int32, delay time.Duration, err error) {
+	span := diagUtils.SpanFromContext(ctx)
+	if span == nil || !span.IsRecording() {
+		return
+	}
+
+	attrs := []attribute.KeyValue{
+		attribute.String(diagConsts.ResiliencyPolicySpanAttributeKey, policy),
+		attribute.Int(diagConsts.ResiliencyAttemptSpanAttributeKey, int(attempt)),
+		attribute.Int64(diagConsts.ResiliencyBackoffMsSpanAttributeKey, delay.Milliseconds()),
+	}
+	if err != nil {
+		attrs = append(attrs, attribute.String(diagConsts.ResiliencyErrorSpanAttributeKey, err.Error()))
+	}
+	span.AddEvent(diagConsts.ResiliencyRetryEventName, trace.WithAttributes(attrs...))
+}
+
+// AddResiliencyCircuitBreakerEvent records a circuit breaker state change as an event on the span in the context.
+func AddResiliencyCircuitBreakerEvent(ctx context.Context, policy, name, fromState, toState string) {
+	span := diagUtils.SpanFromContext(ctx)
+	if span == nil || !span.IsRecording() {
+		return
+	}
+
+	span.AddEvent(diagConsts.ResiliencyCircuitBreakerEventName, trace.WithAttributes(
+		attribute.String(diagConsts.ResiliencyPolicySpanAttributeKey, policy),
+		attribute.String(diagConsts.ResiliencyCBNameSpanAttributeKey, name),
+		attribute.String(diagConsts.ResiliencyCBFromStateSpanAttributeKey, fromState),
+		attribute.String(diagConsts.ResiliencyCBToStateSpanAttributeKey, toState),
+	))
+}
+
 // ConstructInputBindingSpanAttributes creates span attributes for InputBindings.
-func ConstructInputBindingSpanAttributes(bindingName, url string) map[string]string {
+func ConstructInputBindingSpanAttributes(bindingName, componentType, url string) map[string]string {
 	return map[string]string{
 		diagConsts.DBNameSpanAttributeKey:             bindingName,
 		diagConsts.GrpcServiceSpanAttributeKey:        diagConsts.DaprGRPCDaprService,
 		diagConsts.DBSystemSpanAttributeKey:           diagConsts.BindingBuildingBlockType,
 		diagConsts.DBConnectionStringSpanAttributeKey: url,
+		diagConsts.DaprComponentTypeSpanAttributeKey:  componentType,
+	}
+}
+
+// ConstructOutputBindingSpanAttributes creates span attributes for OutputBindings.
+func ConstructOutputBindingSpanAttributes(bindingName, componentType, operation string) map[string]string {
+	return map[string]string{
+		diagConsts.DBNameSpanAttributeKey:               bindingName,
+		diagConsts.DBSystemSpanAttributeKey:             diagConsts.BindingBuildingBlockType,
+		diagConsts.DaprBindingOperationSpanAttributeKey: operation,
+		diagConsts.DaprComponentTypeSpanAttributeKey:    componentType,
 	}
 }
 
@@ -177,6 +223,81 @@ func StartInternalCallbackSpan(ctx context.Context, spanName string, parent trac
 	return ctx, span
 }
 
+// StartBulkDeliverySpan starts a span for the delivery of a batch of messages in a single app call.
+// The span is linked to the trace context of each message, so that the producer context of every
+// message survives the batching.
+func StartBulkDeliverySpan(ctx context.Context, spanName string, links []trace.SpanContext, spec *config.TracingSpec) (context.Context, trace.Span) {
+	if spec == nil || !diagUtils.IsTracingEnabled(spec.SamplingRate) {
+		return ctx, nil
+	}
+
+	spanLinks := make([]trace.Link, 0, len(links))
+	for _, sc := range links {
+		if sc.IsValid() {
+			spanLinks = append(spanLinks, trace.Link{SpanContext: sc})
+		}
+	}
+
+	//nolint:spancheck
+	ctx, span := tracer.Start(ctx, spanName,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithNewRoot(),
+		trace.WithLinks(spanLinks...),
+	)
+
+	//nolint:spancheck
+	return ctx, span
+}
+
+// StartInputBindingSpan starts a consumer span for the delivery of an input binding event to the app.
+func StartInputBindingSpan(ctx context.Context, bindingName string, parent trace.SpanContext, spec *config.TracingSpec) (context.Context, trace.Span) {
+	if spec == nil || !diagUtils.IsTracingEnabled(spec.SamplingRate) {
+		return ctx, nil
+	}
+
+	ctx = trace.ContextWithRemoteSpanContext(ctx, parent)
+	//nolint:spancheck
+	ctx, span := tracer.Start(ctx, "bindings/"+bindingName, trace.WithSpanKind(trace.SpanKindConsumer))
+
+	//nolint:spancheck
+	return ctx, span
+}
+
+// StartOutputBindingSpan starts a client span for an output binding invocation.
+// The span is a child of the span in the context, which is the span of the app request.
+func StartOutputBindingSpan(ctx context.Context, bindingName, operation string, spec *config.TracingSpec) (context.Context, trace.Span) {
+	if spec == nil || !diagUtils.IsTracingEnabled(spec.SamplingRate) {
+		return ctx, nil
+	}
+
+	if parent := diagUtils.SpanFromContext(ctx); parent != nil {
+		ctx = trace.ContextWithSpan(ctx, parent)
+	}
+	//nolint:spancheck
+	ctx, span := tracer.Start(ctx, "bindings/"+bindingName+"/"+operation, trace.WithSpanKind(trace.SpanKindClient))
+
+	//nolint:spancheck
+	return ctx, span
+}
+
+// LoggerWithTraceContext returns a logger which adds the trace and span IDs of
+// the span in the context to every log line, so logs can be correlated with traces.
+// If the context has no valid span, the given logger is returned as-is.
+func LoggerWithTraceContext(ctx context.Context, log logger.Logger) logger.Logger {
+	span := diagUtils.SpanFromContext(ctx)
+	if span == nil {
+		return log
+	}
+	sc := span.SpanContext()
+	if !sc.IsValid() {
+		return log
+	}
+	return log.WithFields(map[string]any{
+		diagConsts.LogTraceIDField: sc.TraceID().String(),
+		diagConsts.LogSpanIDField:  sc.SpanID().String(),
+	})
+}
+
 func TraceIDAndStateFromSpan(span trace.Span) (string, string) {
 	var traceID, traceState string
 
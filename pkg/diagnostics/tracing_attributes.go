@@ -0,0 +1,136 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diagnostics
+
+import (
+	"net/http"
+	"strings"
+
+	grpcMetadata "google.golang.org/grpc/metadata"
+
+	"github.com/dapr/dapr/pkg/config"
+)
+
+const (
+	// Prefix of the headers carrying CloudEvent attributes in binary content mode.
+	cloudEventHeaderPrefix = "ce-"
+	// Prefix of the publish metadata overriding CloudEvent attributes.
+	cloudEventMetadataPrefix = "cloudevent."
+	// Prefix of the query string parameters carrying request metadata on HTTP.
+	httpMetadataQueryPrefix = "metadata."
+)
+
+// spanAttributeRule is a validated config.TracingAttributeRule.
+type spanAttributeRule struct {
+	source    string
+	name      string
+	attribute string
+}
+
+// spanAttributeRules extracts span attributes from incoming requests.
+type spanAttributeRules []spanAttributeRule
+
+// newSpanAttributeRules validates the attribute rules of the tracing spec.
+// Invalid rules are logged and ignored.
+func newSpanAttributeRules(rules []config.TracingAttributeRule) spanAttributeRules {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	res := make(spanAttributeRules, 0, len(rules))
+	for i, r := range rules {
+		if r.Name == "" || r.Attribute == "" {
+			log.Warnf("Ignoring tracing attribute rule at index %d: name and attribute are required", i)
+			continue
+		}
+		switch r.Source {
+		case config.TracingAttributeSourceHeader, config.TracingAttributeSourceCloudEvent:
+		default:
+			log.Warnf("Ignoring tracing attribute rule at index %d: invalid source %q", i, r.Source)
+			continue
+		}
+		res = append(res, spanAttributeRule{
+			source:    r.Source,
+			name:      strings.ToLower(r.Name),
+			attribute: r.Attribute,
+		})
+	}
+	return res
+}
+
+// fromHTTPRequest returns the span attributes extracted from an HTTP request.
+// CloudEvent attributes are read from binary content mode headers, or from the
+// publish metadata passed in the query string.
+func (rules spanAttributeRules) fromHTTPRequest(r *http.Request) map[string]string {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	m := make(map[string]string, len(rules))
+	for _, rule := range rules {
+		var val string
+		switch rule.source {
+		case config.TracingAttributeSourceHeader:
+			val = r.Header.Get(rule.name)
+		case config.TracingAttributeSourceCloudEvent:
+			val = r.Header.Get(cloudEventHeaderPrefix + rule.name)
+			if val == "" {
+				val = r.URL.Query().Get(httpMetadataQueryPrefix + cloudEventMetadataPrefix + rule.name)
+			}
+		}
+		if val != "" {
+			m[rule.attribute] = val
+		}
+	}
+	return m
+}
+
+// fromGRPCRequest returns the span attributes extracted from the incoming gRPC
+// metadata and request. CloudEvent attributes are read from binary content mode
+// metadata, or from the publish metadata of the request.
+func (rules spanAttributeRules) fromGRPCRequest(md grpcMetadata.MD, req any) map[string]string {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	var reqMetadata map[string]string
+	if mr, ok := req.(interface{ GetMetadata() map[string]string }); ok {
+		reqMetadata = mr.GetMetadata()
+	}
+
+	m := make(map[string]string, len(rules))
+	for _, rule := range rules {
+		var val string
+		switch rule.source {
+		case config.TracingAttributeSourceHeader:
+			val = firstMetadataValue(md, rule.name)
+		case config.TracingAttributeSourceCloudEvent:
+			val = firstMetadataValue(md, cloudEventHeaderPrefix+rule.name)
+			if val == "" {
+				val = reqMetadata[cloudEventMetadataPrefix+rule.name]
+			}
+		}
+		if val != "" {
+			m[rule.attribute] = val
+		}
+	}
+	return m
+}
+
+func firstMetadataValue(md grpcMetadata.MD, key string) string {
+	if vals := md.Get(key); len(vals) > 0 {
+		return vals[0]
+	}
+	return ""
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diagnostics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	grpcMetadata "google.golang.org/grpc/metadata"
+
+	"github.com/dapr/dapr/pkg/config"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+)
+
+func TestSpanAttributeRules(t *testing.T) {
+	rules := newSpanAttributeRules([]config.TracingAttributeRule{
+		{Source: config.TracingAttributeSourceHeader, Name: "X-Tenant-ID", Attribute: "tenant.id"},
+		{Source: config.TracingAttributeSourceCloudEvent, Name: "partitionkey", Attribute: "event.partition"},
+		{Source: "body", Name: "foo", Attribute: "foo"},
+		{Source: config.TracingAttributeSourceHeader, Name: "", Attribute: "bar"},
+	})
+
+	t.Run("invalid rules are ignored", func(t *testing.T) {
+		assert.Len(t, rules, 2)
+		assert.Nil(t, newSpanAttributeRules(nil))
+	})
+
+	t.Run("http headers", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPost, "/v1.0/publish/pubsub/topic", nil)
+		r.Header.Set("x-tenant-id", "tenant1")
+		r.Header.Set("ce-partitionkey", "p1")
+
+		assert.Equal(t, map[string]string{
+			"tenant.id":       "tenant1",
+			"event.partition": "p1",
+		}, rules.fromHTTPRequest(r))
+	})
+
+	t.Run("http publish metadata", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPost, "/v1.0/publish/pubsub/topic?metadata.cloudevent.partitionkey=p2", nil)
+
+		assert.Equal(t, map[string]string{
+			"event.partition": "p2",
+		}, rules.fromHTTPRequest(r))
+	})
+
+	t.Run("grpc metadata", func(t *testing.T) {
+		md := grpcMetadata.Pairs("x-tenant-id", "tenant1", "ce-partitionkey", "p1")
+
+		assert.Equal(t, map[string]string{
+			"tenant.id":       "tenant1",
+			"event.partition": "p1",
+		}, rules.fromGRPCRequest(md, nil))
+	})
+
+	t.Run("grpc publish metadata", func(t *testing.T) {
+		req := &runtimev1pb.PublishEventRequest{
+			Metadata: map[string]string{"cloudevent.partitionkey": "p2"},
+		}
+
+		assert.Equal(t, map[string]string{
+			"event.partition": "p2",
+		}, rules.fromGRPCRequest(nil, req))
+	})
+
+	t.Run("no matches", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/v1.0/state/store", nil)
+		assert.Empty(t, rules.fromHTTPRequest(r))
+		assert.Empty(t, rules.fromGRPCRequest(grpcMetadata.MD{}, nil))
+	})
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diagnostics
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/dapr/dapr/pkg/config"
+	diagConsts "github.com/dapr/dapr/pkg/diagnostics/consts"
+)
+
+// b3Propagation holds the B3 format used for outgoing requests, or an empty
+// string when B3 propagation is disabled.
+var b3Propagation atomic.Value
+
+// SetB3Propagation enables B3 propagation in the given format, alongside W3C
+// tracecontext. An empty format disables it.
+func SetB3Propagation(format string) error {
+	switch format {
+	case "", config.TracingB3Single, config.TracingB3Multi:
+		b3Propagation.Store(format)
+		return nil
+	default:
+		return fmt.Errorf("invalid B3 propagation format %q", format)
+	}
+}
+
+func b3PropagationFormat() string {
+	f, _ := b3Propagation.Load().(string)
+	return f
+}
+
+// spanContextFromB3 extracts a span context from the B3 single header, falling
+// back to the B3 multi headers.
+func spanContextFromB3(getHeader func(string) string) (trace.SpanContext, bool) {
+	if h := getHeader(diagConsts.B3SingleHeader); h != "" {
+		return spanContextFromB3Single(h)
+	}
+
+	return spanContextFromB3Parts(
+		getHeader(diagConsts.B3TraceIDHeader),
+		getHeader(diagConsts.B3SpanIDHeader),
+		getHeader(diagConsts.B3SampledHeader),
+		getHeader(diagConsts.B3FlagsHeader) == "1",
+	)
+}
+
+// spanContextFromB3Single parses the "b3" header, in the format
+// {TraceId}-{SpanId}-{SamplingState}-{ParentSpanId}, where the last two fields are optional.
+func spanContextFromB3Single(h string) (trace.SpanContext, bool) {
+	parts := strings.Split(h, "-")
+	if len(parts) < 2 || len(parts) > 4 {
+		// This includes a header containing only the sampling state, which carries no trace context.
+		return trace.SpanContext{}, false
+	}
+
+	var sampled string
+	if len(parts) > 2 {
+		sampled = parts[2]
+	}
+	return spanContextFromB3Parts(parts[0], parts[1], sampled, sampled == "d")
+}
+
+func spanContextFromB3Parts(traceIDStr, spanIDStr, sampled string, debug bool) (trace.SpanContext, bool) {
+	if traceIDStr == "" || spanIDStr == "" {
+		return trace.SpanContext{}, false
+	}
+
+	// 64-bit trace IDs are left-padded to 128 bits.
+	if len(traceIDStr) == 16 {
+		traceIDStr = strings.Repeat("0", 16) + traceIDStr
+	}
+	if len(traceIDStr) != 32 || len(spanIDStr) != 16 {
+		return trace.SpanContext{}, false
+	}
+
+	var (
+		traceID trace.TraceID
+		spanID  trace.SpanID
+	)
+	if _, err := hex.Decode(traceID[:], []byte(traceIDStr)); err != nil {
+		return trace.SpanContext{}, false
+	}
+	if _, err := hex.Decode(spanID[:], []byte(spanIDStr)); err != nil {
+		return trace.SpanContext{}, false
+	}
+
+	var flags trace.TraceFlags
+	if debug || sampled == "1" || strings.EqualFold(sampled, "true") {
+		flags = trace.FlagsSampled
+	}
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: flags,
+		Remote:     true,
+	})
+	return sc, sc.IsValid()
+}
+
+// spanContextToB3 adds the span context in the B3 headers, if B3 propagation is enabled.
+func spanContextToB3(sc trace.SpanContext, setHeader func(string, string)) {
+	format := b3PropagationFormat()
+	if format == "" || !sc.IsValid() {
+		return
+	}
+
+	sampled := "0"
+	if sc.IsSampled() {
+		sampled = "1"
+	}
+
+	if format == config.TracingB3Single {
+		setHeader(diagConsts.B3SingleHeader, sc.TraceID().String()+"-"+sc.SpanID().String()+"-"+sampled)
+		return
+	}
+
+	setHeader(diagConsts.B3TraceIDHeader, sc.TraceID().String())
+	setHeader(diagConsts.B3SpanIDHeader, sc.SpanID().String())
+	setHeader(diagConsts.B3SampledHeader, sampled)
+}
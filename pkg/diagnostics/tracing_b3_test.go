@@ -0,0 +1,207 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diagnostics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	grpcMetadata "google.golang.org/grpc/metadata"
+
+	"github.com/dapr/dapr/pkg/api/grpc/metadata"
+	"github.com/dapr/dapr/pkg/config"
+)
+
+const (
+	testB3TraceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	testB3SpanID  = "00f067aa0ba902b7"
+)
+
+func setB3Propagation(t *testing.T, format string) {
+	t.Helper()
+	require.NoError(t, SetB3Propagation(format))
+	t.Cleanup(func() { _ = SetB3Propagation("") })
+}
+
+func TestSetB3Propagation(t *testing.T) {
+	t.Cleanup(func() { _ = SetB3Propagation("") })
+	require.NoError(t, SetB3Propagation(config.TracingB3Single))
+	assert.Equal(t, config.TracingB3Single, b3PropagationFormat())
+	require.NoError(t, SetB3Propagation(""))
+	assert.Empty(t, b3PropagationFormat())
+	require.Error(t, SetB3Propagation("foo"))
+}
+
+func TestSpanContextFromB3(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers map[string]string
+		valid   bool
+		sampled bool
+		traceID string
+	}{
+		{
+			name:    "single header",
+			headers: map[string]string{"b3": testB3TraceID + "-" + testB3SpanID + "-1"},
+			valid:   true,
+			sampled: true,
+			traceID: testB3TraceID,
+		},
+		{
+			name:    "single header with parent",
+			headers: map[string]string{"b3": testB3TraceID + "-" + testB3SpanID + "-0-05e3ac9a4f6e3b90"},
+			valid:   true,
+			traceID: testB3TraceID,
+		},
+		{
+			name:    "single header debug",
+			headers: map[string]string{"b3": testB3TraceID + "-" + testB3SpanID + "-d"},
+			valid:   true,
+			sampled: true,
+			traceID: testB3TraceID,
+		},
+		{
+			name:    "single header sampling only",
+			headers: map[string]string{"b3": "1"},
+		},
+		{
+			name: "multi headers",
+			headers: map[string]string{
+				"x-b3-traceid": testB3TraceID,
+				"x-b3-spanid":  testB3SpanID,
+				"x-b3-sampled": "1",
+			},
+			valid:   true,
+			sampled: true,
+			traceID: testB3TraceID,
+		},
+		{
+			name: "multi headers with 64-bit trace ID",
+			headers: map[string]string{
+				"x-b3-traceid": "a3ce929d0e0e4736",
+				"x-b3-spanid":  testB3SpanID,
+			},
+			valid:   true,
+			traceID: "0000000000000000a3ce929d0e0e4736",
+		},
+		{
+			name: "invalid trace ID",
+			headers: map[string]string{
+				"x-b3-traceid": "zz",
+				"x-b3-spanid":  testB3SpanID,
+			},
+		},
+		{
+			name:    "no headers",
+			headers: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sc, ok := spanContextFromB3(func(key string) string { return tt.headers[key] })
+			assert.Equal(t, tt.valid, ok)
+			if !tt.valid {
+				return
+			}
+			assert.Equal(t, tt.traceID, sc.TraceID().String())
+			assert.Equal(t, testB3SpanID, sc.SpanID().String())
+			assert.Equal(t, tt.sampled, sc.IsSampled())
+			assert.True(t, sc.IsRemote())
+		})
+	}
+}
+
+func TestB3Propagation(t *testing.T) {
+	traceID, _ := trace.TraceIDFromHex(testB3TraceID)
+	spanID, _ := trace.SpanIDFromHex(testB3SpanID)
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		headers := map[string]string{}
+		SpanContextToHTTPHeaders(sc, func(k, v string) { headers[k] = v })
+		assert.NotContains(t, headers, "b3")
+		assert.NotContains(t, headers, "x-b3-traceid")
+
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("b3", testB3TraceID+"-"+testB3SpanID+"-1")
+		assert.False(t, SpanContextFromRequest(r).IsValid())
+	})
+
+	t.Run("inject single", func(t *testing.T) {
+		setB3Propagation(t, config.TracingB3Single)
+
+		headers := map[string]string{}
+		SpanContextToHTTPHeaders(sc, func(k, v string) { headers[k] = v })
+		assert.Equal(t, testB3TraceID+"-"+testB3SpanID+"-1", headers["b3"])
+		assert.NotEmpty(t, headers["traceparent"])
+	})
+
+	t.Run("inject multi", func(t *testing.T) {
+		setB3Propagation(t, config.TracingB3Multi)
+
+		ctx := SpanContextToGRPCMetadata(t.Context(), sc)
+		md, _ := grpcMetadata.FromOutgoingContext(ctx)
+		assert.Equal(t, []string{testB3TraceID}, md.Get("x-b3-traceid"))
+		assert.Equal(t, []string{testB3SpanID}, md.Get("x-b3-spanid"))
+		assert.Equal(t, []string{"1"}, md.Get("x-b3-sampled"))
+		assert.NotEmpty(t, md.Get("grpc-trace-bin"))
+	})
+
+	t.Run("extract from http request", func(t *testing.T) {
+		setB3Propagation(t, config.TracingB3Multi)
+
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("b3", testB3TraceID+"-"+testB3SpanID+"-1")
+		got := SpanContextFromRequest(r)
+		assert.Equal(t, traceID, got.TraceID())
+		assert.Equal(t, spanID, got.SpanID())
+	})
+
+	t.Run("w3c takes precedence", func(t *testing.T) {
+		setB3Propagation(t, config.TracingB3Multi)
+
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("traceparent", "00-"+testB3TraceID+"-b7ad6b7169203331-01")
+		r.Header.Set("b3", testB3TraceID+"-"+testB3SpanID+"-1")
+		assert.Equal(t, "b7ad6b7169203331", SpanContextFromRequest(r).SpanID().String())
+	})
+
+	t.Run("extract from grpc metadata", func(t *testing.T) {
+		setB3Propagation(t, config.TracingB3Single)
+
+		md := grpcMetadata.Pairs(
+			"x-b3-traceid", testB3TraceID,
+			"x-b3-spanid", testB3SpanID,
+			"x-b3-sampled", "1",
+		)
+		ctx := grpcMetadata.NewIncomingContext(t.Context(), md)
+		ctx, err := metadata.SetMetadataInTapHandle(ctx, nil)
+		require.NoError(t, err)
+
+		got, ok := SpanContextFromIncomingGRPCMetadata(ctx)
+		require.True(t, ok)
+		assert.Equal(t, traceID, got.TraceID())
+		assert.Equal(t, spanID, got.SpanID())
+		assert.True(t, got.IsSampled())
+	})
+}
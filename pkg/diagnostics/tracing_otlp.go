@@ -0,0 +1,163 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diagnostics
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/encoding/gzip"
+
+	"github.com/dapr/dapr/pkg/config"
+)
+
+const (
+	otlpCompressionGzip = "gzip"
+	otlpCompressionNone = "none"
+)
+
+// NewOtlpTraceClient returns the OTLP trace client for the given Otel exporter configuration.
+func NewOtlpTraceClient(spec config.OtelSpec) (otlptrace.Client, error) {
+	settings, err := parseOtlpSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	if settings.protocol == "http" {
+		clientOptions := []otlptracehttp.Option{otlptracehttp.WithEndpoint(spec.EndpointAddress)}
+		if !spec.GetIsSecure() {
+			clientOptions = append(clientOptions, otlptracehttp.WithInsecure())
+		}
+		if settings.tlsConfig != nil {
+			clientOptions = append(clientOptions, otlptracehttp.WithTLSClientConfig(settings.tlsConfig))
+		}
+		if len(settings.headers) > 0 {
+			clientOptions = append(clientOptions, otlptracehttp.WithHeaders(settings.headers))
+		}
+		if settings.compression == otlpCompressionGzip {
+			clientOptions = append(clientOptions, otlptracehttp.WithCompression(otlptracehttp.GzipCompression))
+		}
+		if settings.timeout > 0 {
+			clientOptions = append(clientOptions, otlptracehttp.WithTimeout(settings.timeout))
+		}
+		return otlptracehttp.NewClient(clientOptions...), nil
+	}
+
+	clientOptions := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(spec.EndpointAddress)}
+	if !spec.GetIsSecure() {
+		clientOptions = append(clientOptions, otlptracegrpc.WithInsecure())
+	}
+	if settings.tlsConfig != nil {
+		clientOptions = append(clientOptions, otlptracegrpc.WithTLSCredentials(credentials.NewTLS(settings.tlsConfig)))
+	}
+	if len(settings.headers) > 0 {
+		clientOptions = append(clientOptions, otlptracegrpc.WithHeaders(settings.headers))
+	}
+	if settings.compression == otlpCompressionGzip {
+		clientOptions = append(clientOptions, otlptracegrpc.WithCompressor(gzip.Name))
+	}
+	if settings.timeout > 0 {
+		clientOptions = append(clientOptions, otlptracegrpc.WithTimeout(settings.timeout))
+	}
+	return otlptracegrpc.NewClient(clientOptions...), nil
+}
+
+// otlpSettings are the validated settings of an Otel exporter configuration.
+type otlpSettings struct {
+	protocol    string
+	headers     map[string]string
+	compression string
+	tlsConfig   *tls.Config
+	timeout     time.Duration
+}
+
+// parseOtlpSpec validates the Otel exporter configuration.
+func parseOtlpSpec(spec config.OtelSpec) (otlpSettings, error) {
+	settings := otlpSettings{
+		protocol:    spec.GetProtocol(),
+		compression: strings.ToLower(spec.Compression),
+		timeout:     time.Duration(spec.Timeout) * time.Millisecond,
+	}
+	if settings.protocol != "http" && settings.protocol != "grpc" {
+		return settings, fmt.Errorf("invalid protocol %v provided for Otel endpoint", spec.Protocol)
+	}
+
+	if spec.Headers != "" {
+		var err error
+		settings.headers, err = config.StringToHeader(spec.Headers)
+		if err != nil {
+			return settings, fmt.Errorf("invalid headers provided for Otel endpoint: %w", err)
+		}
+	}
+
+	if settings.compression != "" && settings.compression != otlpCompressionGzip && settings.compression != otlpCompressionNone {
+		return settings, fmt.Errorf("invalid compression %v provided for Otel endpoint", spec.Compression)
+	}
+
+	if spec.TLS != nil {
+		if !spec.GetIsSecure() {
+			return settings, errors.New("tls configuration cannot be used with an insecure Otel endpoint")
+		}
+		var err error
+		settings.tlsConfig, err = otlpTLSConfig(*spec.TLS)
+		if err != nil {
+			return settings, fmt.Errorf("invalid tls configuration provided for Otel endpoint: %w", err)
+		}
+	}
+
+	return settings, nil
+}
+
+// otlpTLSConfig builds the TLS client configuration for the Otel exporter.
+func otlpTLSConfig(spec config.OtelTLSSpec) (*tls.Config, error) {
+	//nolint:gosec
+	tlsConfig := &tls.Config{
+		ServerName: spec.ServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if spec.CAFile != "" {
+		caPEM, err := os.ReadFile(spec.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no valid certificates found in CA file %s", spec.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if spec.CertFile != "" || spec.KeyFile != "" {
+		if spec.CertFile == "" || spec.KeyFile == "" {
+			return nil, errors.New("both certFile and keyFile must be provided for mTLS")
+		}
+		cert, err := tls.LoadX509KeyPair(spec.CertFile, spec.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
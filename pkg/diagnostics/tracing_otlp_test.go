@@ -0,0 +1,88 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diagnostics
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/kit/ptr"
+)
+
+func TestNewOtlpTraceClient(t *testing.T) {
+	tests := []struct {
+		name        string
+		spec        config.OtelSpec
+		expectedErr string
+	}{
+		{
+			name: "grpc",
+			spec: config.OtelSpec{EndpointAddress: "foo.bar", Protocol: "grpc", IsSecure: ptr.Of(false), Compression: "gzip", Timeout: 1000},
+		},
+		{
+			name: "http/protobuf",
+			spec: config.OtelSpec{EndpointAddress: "foo.bar", Protocol: "http/protobuf", Headers: "api-key=value", Compression: "GZIP"},
+		},
+		{
+			name: "tls without files",
+			spec: config.OtelSpec{EndpointAddress: "foo.bar", Protocol: "grpc", TLS: &config.OtelTLSSpec{ServerName: "collector"}},
+		},
+		{
+			name:        "invalid protocol",
+			spec:        config.OtelSpec{EndpointAddress: "foo.bar", Protocol: "tcp"},
+			expectedErr: "invalid protocol tcp provided for Otel endpoint",
+		},
+		{
+			name:        "invalid headers",
+			spec:        config.OtelSpec{EndpointAddress: "foo.bar", Protocol: "http", Headers: "invalid"},
+			expectedErr: "invalid headers provided for Otel endpoint",
+		},
+		{
+			name:        "invalid compression",
+			spec:        config.OtelSpec{EndpointAddress: "foo.bar", Protocol: "http", Compression: "zstd"},
+			expectedErr: "invalid compression zstd provided for Otel endpoint",
+		},
+		{
+			name:        "tls with insecure endpoint",
+			spec:        config.OtelSpec{EndpointAddress: "foo.bar", Protocol: "grpc", IsSecure: ptr.Of(false), TLS: &config.OtelTLSSpec{}},
+			expectedErr: "tls configuration cannot be used with an insecure Otel endpoint",
+		},
+		{
+			name:        "client cert without key",
+			spec:        config.OtelSpec{EndpointAddress: "foo.bar", Protocol: "grpc", TLS: &config.OtelTLSSpec{CertFile: "cert.pem"}},
+			expectedErr: "both certFile and keyFile must be provided for mTLS",
+		},
+		{
+			name:        "missing CA file",
+			spec:        config.OtelSpec{EndpointAddress: "foo.bar", Protocol: "http", TLS: &config.OtelTLSSpec{CAFile: filepath.Join(t.TempDir(), "ca.pem")}},
+			expectedErr: "failed to read CA file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewOtlpTraceClient(tt.spec)
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, client)
+		})
+	}
+}
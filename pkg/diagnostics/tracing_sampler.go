@@ -1,8 +1,17 @@
 package diagnostics
 
 import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
 
+	"github.com/dapr/dapr/pkg/config"
 	diagUtils "github.com/dapr/dapr/pkg/diagnostics/utils"
 )
 
@@ -10,3 +19,124 @@ func NewDaprTraceSampler(samplingRateString string) sdktrace.Sampler {
 	samplingRate := diagUtils.GetTraceSamplingRate(samplingRateString)
 	return sdktrace.ParentBased(sdktrace.TraceIDRatioBased(samplingRate))
 }
+
+// NewDaprTraceSamplerFromSpec returns the trace sampler configured in the tracing spec.
+// The decision of a parent span is always honored; the configured sampler and
+// route overrides only apply to root spans.
+func NewDaprTraceSamplerFromSpec(spec config.TracingSpec) (sdktrace.Sampler, error) {
+	var root sdktrace.Sampler
+	switch t := spec.Sampler.GetType(); t {
+	case config.TracingSamplerProbabilistic:
+		root = sdktrace.TraceIDRatioBased(diagUtils.GetTraceSamplingRate(spec.SamplingRate))
+	case config.TracingSamplerRateLimited:
+		if spec.Sampler.TracesPerSecond <= 0 {
+			return nil, fmt.Errorf("tracesPerSecond must be greater than 0 for the %s sampler", t)
+		}
+		root = newRateLimitedSampler(float64(spec.Sampler.TracesPerSecond))
+	case config.TracingSamplerParentBasedAlwaysSample:
+		root = sdktrace.AlwaysSample()
+	default:
+		return nil, fmt.Errorf("invalid trace sampler type %q", t)
+	}
+
+	if spec.Sampler != nil && len(spec.Sampler.Routes) > 0 {
+		rs := &routeSampler{
+			routes:   make([]routeSamplerEntry, len(spec.Sampler.Routes)),
+			fallback: root,
+		}
+		for i, r := range spec.Sampler.Routes {
+			if r.Path == "" {
+				return nil, fmt.Errorf("trace sampler route at index %d must have a path", i)
+			}
+			rs.routes[i] = routeSamplerEntry{
+				prefix:  r.Path,
+				sampler: sdktrace.TraceIDRatioBased(diagUtils.GetTraceSamplingRate(r.SamplingRate)),
+			}
+		}
+		// Longest prefix wins.
+		sort.SliceStable(rs.routes, func(i, j int) bool {
+			return len(rs.routes[i].prefix) > len(rs.routes[j].prefix)
+		})
+		root = rs
+	}
+
+	return sdktrace.ParentBased(root), nil
+}
+
+// rateLimitedSampler samples at most a fixed number of spans per second using a token bucket.
+type rateLimitedSampler struct {
+	perSecond float64
+	maxTokens float64
+	tokens    float64
+	last      time.Time
+	now       func() time.Time
+	lock      sync.Mutex
+}
+
+func newRateLimitedSampler(perSecond float64) *rateLimitedSampler {
+	maxTokens := math.Max(1, math.Ceil(perSecond))
+	return &rateLimitedSampler{
+		perSecond: perSecond,
+		maxTokens: maxTokens,
+		tokens:    maxTokens,
+		last:      time.Now(),
+		now:       time.Now,
+	}
+}
+
+func (s *rateLimitedSampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	decision := sdktrace.Drop
+	if s.allow() {
+		decision = sdktrace.RecordAndSample
+	}
+	return sdktrace.SamplingResult{
+		Decision:   decision,
+		Tracestate: trace.SpanContextFromContext(p.ParentContext).TraceState(),
+	}
+}
+
+func (s *rateLimitedSampler) allow() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := s.now()
+	s.tokens = math.Min(s.maxTokens, s.tokens+now.Sub(s.last).Seconds()*s.perSecond)
+	s.last = now
+	if s.tokens < 1 {
+		return false
+	}
+	s.tokens--
+	return true
+}
+
+func (s *rateLimitedSampler) Description() string {
+	return fmt.Sprintf("RateLimitedSampler{%g}", s.perSecond)
+}
+
+type routeSamplerEntry struct {
+	prefix  string
+	sampler sdktrace.Sampler
+}
+
+// routeSampler delegates to the sampler of the longest route prefix matching the span name.
+type routeSampler struct {
+	routes   []routeSamplerEntry
+	fallback sdktrace.Sampler
+}
+
+func (s *routeSampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	for _, r := range s.routes {
+		if strings.HasPrefix(p.Name, r.prefix) {
+			return r.sampler.ShouldSample(p)
+		}
+	}
+	return s.fallback.ShouldSample(p)
+}
+
+func (s *routeSampler) Description() string {
+	routes := make([]string, len(s.routes))
+	for i, r := range s.routes {
+		routes[i] = r.prefix + ":" + r.sampler.Description()
+	}
+	return fmt.Sprintf("RouteSampler{routes:[%s],fallback:%s}", strings.Join(routes, ","), s.fallback.Description())
+}
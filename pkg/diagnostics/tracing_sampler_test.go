@@ -0,0 +1,124 @@
+package diagnostics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/dapr/dapr/pkg/config"
+)
+
+func sampleRoot(t *testing.T, s sdktrace.Sampler, name string) bool {
+	t.Helper()
+	traceID, _ := defaultIDGenerator().NewIDs(t.Context())
+	res := s.ShouldSample(sdktrace.SamplingParameters{
+		ParentContext: t.Context(),
+		TraceID:       traceID,
+		Name:          name,
+	})
+	return res.Decision == sdktrace.RecordAndSample
+}
+
+func TestNewDaprTraceSamplerFromSpec(t *testing.T) {
+	t.Run("probabilistic by default", func(t *testing.T) {
+		s, err := NewDaprTraceSamplerFromSpec(config.TracingSpec{SamplingRate: "1"})
+		require.NoError(t, err)
+		assert.True(t, sampleRoot(t, s, "/v1.0/invoke"))
+
+		s, err = NewDaprTraceSamplerFromSpec(config.TracingSpec{SamplingRate: "0"})
+		require.NoError(t, err)
+		assert.False(t, sampleRoot(t, s, "/v1.0/invoke"))
+	})
+
+	t.Run("parent based always sample", func(t *testing.T) {
+		s, err := NewDaprTraceSamplerFromSpec(config.TracingSpec{
+			SamplingRate: "0",
+			Sampler:      &config.TracingSamplerSpec{Type: config.TracingSamplerParentBasedAlwaysSample},
+		})
+		require.NoError(t, err)
+		assert.True(t, sampleRoot(t, s, "/v1.0/invoke"))
+
+		// A non-sampled remote parent is honored.
+		traceID, spanID := defaultIDGenerator().NewIDs(t.Context())
+		parent := trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID, Remote: true})
+		res := s.ShouldSample(sdktrace.SamplingParameters{
+			ParentContext: trace.ContextWithRemoteSpanContext(t.Context(), parent),
+			TraceID:       traceID,
+			Name:          "/v1.0/invoke",
+		})
+		assert.Equal(t, sdktrace.Drop, res.Decision)
+	})
+
+	t.Run("rate limited", func(t *testing.T) {
+		_, err := NewDaprTraceSamplerFromSpec(config.TracingSpec{
+			Sampler: &config.TracingSamplerSpec{Type: config.TracingSamplerRateLimited},
+		})
+		require.ErrorContains(t, err, "tracesPerSecond must be greater than 0")
+
+		s, err := NewDaprTraceSamplerFromSpec(config.TracingSpec{
+			Sampler: &config.TracingSamplerSpec{Type: config.TracingSamplerRateLimited, TracesPerSecond: 5},
+		})
+		require.NoError(t, err)
+		sampled := 0
+		for range 100 {
+			if sampleRoot(t, s, "/v1.0/invoke") {
+				sampled++
+			}
+		}
+		assert.GreaterOrEqual(t, sampled, 5)
+		assert.Less(t, sampled, 100)
+	})
+
+	t.Run("route overrides", func(t *testing.T) {
+		s, err := NewDaprTraceSamplerFromSpec(config.TracingSpec{
+			SamplingRate: "1",
+			Sampler: &config.TracingSamplerSpec{
+				Routes: []config.TracingSamplerRoute{
+					{Path: "/v1.0/", SamplingRate: "0"},
+					{Path: "/v1.0/invoke/", SamplingRate: "1"},
+				},
+			},
+		})
+		require.NoError(t, err)
+		assert.True(t, sampleRoot(t, s, "/v1.0/invoke/app/method/foo"))
+		assert.False(t, sampleRoot(t, s, "/v1.0/state/store"))
+		assert.True(t, sampleRoot(t, s, "/dapr.proto.runtime.v1.Dapr/GetState"))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := NewDaprTraceSamplerFromSpec(config.TracingSpec{
+			Sampler: &config.TracingSamplerSpec{Type: "foo"},
+		})
+		require.ErrorContains(t, err, `invalid trace sampler type "foo"`)
+
+		_, err = NewDaprTraceSamplerFromSpec(config.TracingSpec{
+			Sampler: &config.TracingSamplerSpec{Routes: []config.TracingSamplerRoute{{SamplingRate: "1"}}},
+		})
+		require.ErrorContains(t, err, "must have a path")
+	})
+}
+
+func TestRateLimitedSampler(t *testing.T) {
+	now := time.Now()
+	s := newRateLimitedSampler(2)
+	s.last = now
+	s.now = func() time.Time { return now }
+
+	assert.True(t, s.allow())
+	assert.True(t, s.allow())
+	assert.False(t, s.allow())
+
+	now = now.Add(500 * time.Millisecond)
+	assert.True(t, s.allow())
+	assert.False(t, s.allow())
+
+	// Tokens never exceed the burst size.
+	now = now.Add(time.Hour)
+	assert.True(t, s.allow())
+	assert.True(t, s.allow())
+	assert.False(t, s.allow())
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diagnostics
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	otelcodes "go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/dapr/dapr/pkg/config"
+)
+
+const defaultTailSamplingMaxTraces = 1000
+
+// tailSamplingExporter buffers the spans of each trace until its local root span
+// is exported, and forwards the whole trace to the next exporter only if the root
+// span errored or took longer than the latency threshold.
+type tailSamplingExporter struct {
+	next             sdktrace.SpanExporter
+	latencyThreshold time.Duration
+	maxTraces        int
+
+	// traces holds the buffered spans per trace, in insertion order so the
+	// oldest trace is evicted first once maxTraces is reached.
+	traces map[trace.TraceID]*list.Element
+	order  *list.List
+	lock   sync.Mutex
+}
+
+type tailSamplingTrace struct {
+	id    trace.TraceID
+	spans []sdktrace.ReadOnlySpan
+}
+
+// NewTailSamplingExporter wraps the exporter so that only traces with errors or
+// slow root spans are exported.
+func NewTailSamplingExporter(next sdktrace.SpanExporter, spec config.TracingTailSamplingSpec) (sdktrace.SpanExporter, error) {
+	if spec.LatencyThreshold < 0 {
+		return nil, fmt.Errorf("invalid tail sampling latency threshold %d: must not be negative", spec.LatencyThreshold)
+	}
+	if spec.MaxTraces < 0 {
+		return nil, fmt.Errorf("invalid tail sampling max traces %d: must not be negative", spec.MaxTraces)
+	}
+
+	maxTraces := spec.MaxTraces
+	if maxTraces == 0 {
+		maxTraces = defaultTailSamplingMaxTraces
+	}
+
+	return &tailSamplingExporter{
+		next:             next,
+		latencyThreshold: time.Duration(spec.LatencyThreshold) * time.Millisecond,
+		maxTraces:        maxTraces,
+		traces:           make(map[trace.TraceID]*list.Element),
+		order:            list.New(),
+	}, nil
+}
+
+func (e *tailSamplingExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	var export []sdktrace.ReadOnlySpan
+
+	e.lock.Lock()
+	for _, span := range spans {
+		id := span.SpanContext().TraceID()
+
+		// A span with no parent in this process is the local root of the trace.
+		parent := span.Parent()
+		if !parent.IsValid() || parent.IsRemote() {
+			var buffered []sdktrace.ReadOnlySpan
+			if el, ok := e.traces[id]; ok {
+				buffered = el.Value.(*tailSamplingTrace).spans
+				e.order.Remove(el)
+				delete(e.traces, id)
+			}
+			if e.keep(span) {
+				export = append(export, buffered...)
+				export = append(export, span)
+			}
+			continue
+		}
+
+		el, ok := e.traces[id]
+		if !ok {
+			if e.order.Len() >= e.maxTraces {
+				oldest := e.order.Front()
+				delete(e.traces, oldest.Value.(*tailSamplingTrace).id)
+				e.order.Remove(oldest)
+			}
+			el = e.order.PushBack(&tailSamplingTrace{id: id})
+			e.traces[id] = el
+		}
+		t := el.Value.(*tailSamplingTrace)
+		t.spans = append(t.spans, span)
+	}
+	e.lock.Unlock()
+
+	if len(export) == 0 {
+		return nil
+	}
+	return e.next.ExportSpans(ctx, export)
+}
+
+// keep returns true if the trace with the given root span should be exported.
+func (e *tailSamplingExporter) keep(root sdktrace.ReadOnlySpan) bool {
+	if root.Status().Code == otelcodes.Error {
+		return true
+	}
+	return e.latencyThreshold > 0 && root.EndTime().Sub(root.StartTime()) >= e.latencyThreshold
+}
+
+func (e *tailSamplingExporter) Shutdown(ctx context.Context) error {
+	e.lock.Lock()
+	e.traces = make(map[trace.TraceID]*list.Element)
+	e.order.Init()
+	e.lock.Unlock()
+
+	return e.next.Shutdown(ctx)
+}
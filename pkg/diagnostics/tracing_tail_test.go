@@ -0,0 +1,124 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diagnostics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/dapr/dapr/pkg/config"
+)
+
+func TestTailSamplingExporter(t *testing.T) {
+	newTracer := func(t *testing.T, spec config.TracingTailSamplingSpec) (trace.Tracer, *tracetest.InMemoryExporter) {
+		t.Helper()
+		mem := tracetest.NewInMemoryExporter()
+		exp, err := NewTailSamplingExporter(mem, spec)
+		require.NoError(t, err)
+		tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exp))
+		t.Cleanup(func() { _ = tp.Shutdown(t.Context()) })
+		return tp.Tracer("test"), mem
+	}
+
+	t.Run("successful fast trace is dropped", func(t *testing.T) {
+		tr, mem := newTracer(t, config.TracingTailSamplingSpec{LatencyThreshold: 1000})
+
+		ctx, root := tr.Start(t.Context(), "root")
+		_, child := tr.Start(ctx, "child")
+		child.End()
+		root.End()
+
+		assert.Empty(t, mem.GetSpans())
+	})
+
+	t.Run("errored trace is exported with its children", func(t *testing.T) {
+		tr, mem := newTracer(t, config.TracingTailSamplingSpec{})
+
+		ctx, root := tr.Start(t.Context(), "root")
+		_, child := tr.Start(ctx, "child")
+		child.End()
+		root.SetStatus(otelcodes.Error, "boom")
+		root.End()
+
+		spans := mem.GetSpans()
+		require.Len(t, spans, 2)
+		assert.Equal(t, "child", spans[0].Name)
+		assert.Equal(t, "root", spans[1].Name)
+	})
+
+	t.Run("slow trace is exported", func(t *testing.T) {
+		tr, mem := newTracer(t, config.TracingTailSamplingSpec{LatencyThreshold: 100})
+
+		start := time.Now()
+		ctx, root := tr.Start(t.Context(), "root", trace.WithTimestamp(start))
+		_, child := tr.Start(ctx, "child")
+		child.End()
+		root.End(trace.WithTimestamp(start.Add(200 * time.Millisecond)))
+
+		assert.Len(t, mem.GetSpans(), 2)
+	})
+
+	t.Run("span with remote parent is a local root", func(t *testing.T) {
+		tr, mem := newTracer(t, config.TracingTailSamplingSpec{})
+
+		parent := trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{1},
+			SpanID:     trace.SpanID{1},
+			TraceFlags: trace.FlagsSampled,
+			Remote:     true,
+		})
+		_, root := tr.Start(trace.ContextWithRemoteSpanContext(t.Context(), parent), "root")
+		root.SetStatus(otelcodes.Error, "boom")
+		root.End()
+
+		assert.Len(t, mem.GetSpans(), 1)
+	})
+
+	t.Run("oldest trace is evicted once full", func(t *testing.T) {
+		tr, mem := newTracer(t, config.TracingTailSamplingSpec{MaxTraces: 1})
+
+		ctx1, root1 := tr.Start(t.Context(), "root1")
+		_, child1 := tr.Start(ctx1, "child1")
+		child1.End()
+
+		ctx2, root2 := tr.Start(t.Context(), "root2")
+		_, child2 := tr.Start(ctx2, "child2")
+		child2.End()
+
+		root1.SetStatus(otelcodes.Error, "boom")
+		root1.End()
+		root2.SetStatus(otelcodes.Error, "boom")
+		root2.End()
+
+		spans := mem.GetSpans()
+		require.Len(t, spans, 3)
+		assert.Equal(t, "root1", spans[0].Name)
+		assert.Equal(t, "child2", spans[1].Name)
+		assert.Equal(t, "root2", spans[2].Name)
+	})
+
+	t.Run("invalid spec", func(t *testing.T) {
+		_, err := NewTailSamplingExporter(tracetest.NewInMemoryExporter(), config.TracingTailSamplingSpec{LatencyThreshold: -1})
+		require.Error(t, err)
+		_, err = NewTailSamplingExporter(tracetest.NewInMemoryExporter(), config.TracingTailSamplingSpec{MaxTraces: -1})
+		require.Error(t, err)
+	})
+}
@@ -14,8 +14,10 @@ limitations under the License.
 package diagnostics
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"sync"
@@ -26,9 +28,12 @@ import (
 
 	"github.com/dapr/dapr/pkg/config"
 	diagConsts "github.com/dapr/dapr/pkg/diagnostics/consts"
+	"github.com/dapr/kit/logger"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -204,6 +209,91 @@ func TestStartInternalCallbackSpan(t *testing.T) {
 	})
 }
 
+func TestComponentSpans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	defer func() { _ = tp.Shutdown(t.Context()) }()
+
+	// The package tracer only delegates to the first global tracer provider, so swap it.
+	origTracer := tracer
+	tracer = tp.Tracer(tracerName)
+	t.Cleanup(func() { tracer = origTracer })
+
+	traceSpec := &config.TracingSpec{SamplingRate: "1"}
+
+	t.Run("input binding span is a consumer span", func(t *testing.T) {
+		parent := trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{75, 249, 47, 53, 119, 179, 77, 166, 163, 206, 146, 157, 14, 14, 71, 54},
+			SpanID:     trace.SpanID{0, 240, 103, 170, 11, 169, 2, 183},
+			TraceFlags: trace.TraceFlags(1),
+		})
+		_, span := StartInputBindingSpan(t.Context(), "mybinding", parent, traceSpec)
+		require.NotNil(t, span)
+		AddAttributesToSpan(span, ConstructInputBindingSpanAttributes("mybinding", "bindings.kafka", "POST /mybinding"))
+		span.End()
+
+		ended := recorder.Ended()
+		got := ended[len(ended)-1]
+		assert.Equal(t, "bindings/mybinding", got.Name())
+		assert.Equal(t, trace.SpanKindConsumer, got.SpanKind())
+		assert.Equal(t, parent.TraceID(), got.SpanContext().TraceID())
+		assert.Contains(t, got.Attributes(), attribute.String(diagConsts.DaprComponentTypeSpanAttributeKey, "bindings.kafka"))
+	})
+
+	t.Run("output binding span is a child of the request span", func(t *testing.T) {
+		ctx, parent := tp.Tracer("test").Start(t.Context(), "request")
+		_, span := StartOutputBindingSpan(ctx, "mybinding", "create", traceSpec)
+		require.NotNil(t, span)
+		AddAttributesToSpan(span, ConstructOutputBindingSpanAttributes("mybinding", "bindings.http", "create"))
+		span.End()
+		parent.End()
+
+		ended := recorder.Ended()
+		got := ended[len(ended)-2]
+		assert.Equal(t, "bindings/mybinding/create", got.Name())
+		assert.Equal(t, trace.SpanKindClient, got.SpanKind())
+		assert.Equal(t, parent.SpanContext().SpanID(), got.Parent().SpanID())
+		assert.Contains(t, got.Attributes(), attribute.String(diagConsts.DaprBindingOperationSpanAttributeKey, "create"))
+		assert.Contains(t, got.Attributes(), attribute.String(diagConsts.DaprComponentTypeSpanAttributeKey, "bindings.http"))
+	})
+
+	t.Run("bulk delivery span links each message", func(t *testing.T) {
+		links := []trace.SpanContext{
+			trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID:    trace.TraceID{1},
+				SpanID:     trace.SpanID{1},
+				TraceFlags: trace.FlagsSampled,
+			}),
+			trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID:    trace.TraceID{2},
+				SpanID:     trace.SpanID{2},
+				TraceFlags: trace.FlagsSampled,
+			}),
+			{},
+		}
+		_, span := StartBulkDeliverySpan(t.Context(), "pubsub/topic", links, traceSpec)
+		require.NotNil(t, span)
+		span.End()
+
+		ended := recorder.Ended()
+		got := ended[len(ended)-1]
+		assert.Equal(t, "pubsub/topic", got.Name())
+		assert.Equal(t, trace.SpanKindConsumer, got.SpanKind())
+		require.Len(t, got.Links(), 2)
+		assert.Equal(t, links[0], got.Links()[0].SpanContext)
+		assert.Equal(t, links[1], got.Links()[1].SpanContext)
+	})
+
+	t.Run("tracing disabled", func(t *testing.T) {
+		_, span := StartBulkDeliverySpan(t.Context(), "pubsub/topic", nil, &config.TracingSpec{SamplingRate: "0"})
+		assert.Nil(t, span)
+		_, span = StartOutputBindingSpan(t.Context(), "mybinding", "create", &config.TracingSpec{SamplingRate: "0"})
+		assert.Nil(t, span)
+		_, span = StartInputBindingSpan(t.Context(), "mybinding", trace.SpanContext{}, nil)
+		assert.Nil(t, span)
+	})
+}
+
 func runTraces(t *testing.T, testName string, numTraces int, samplingRate string, hasParentSpanContext bool, parentTraceFlag int) int {
 	d := NewDaprTraceSampler(samplingRate)
 	tracerOptions := []sdktrace.TracerProviderOption{
@@ -404,3 +494,42 @@ func TestTraceIDAndStateFromSpan(t *testing.T) {
 		assert.Empty(t, state)
 	})
 }
+
+func TestLoggerWithTraceContext(t *testing.T) {
+	newLogger := func() (logger.Logger, *bytes.Buffer) {
+		var buf bytes.Buffer
+		l := logger.NewLogger("dapr.test.tracecontext")
+		l.EnableJSONOutput(true)
+		l.SetOutput(&buf)
+		return l, &buf
+	}
+
+	t.Run("span in context adds trace and span IDs", func(t *testing.T) {
+		l, buf := newLogger()
+		sc := trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{75, 249, 47, 53, 119, 179, 77, 166, 163, 206, 146, 157, 14, 14, 71, 54},
+			SpanID:     trace.SpanID{0, 240, 103, 170, 11, 169, 2, 183},
+			TraceFlags: trace.FlagsSampled,
+		})
+		ctx := trace.ContextWithSpanContext(t.Context(), sc)
+
+		LoggerWithTraceContext(ctx, l).Info("hello")
+
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", entry[diagConsts.LogTraceIDField])
+		assert.Equal(t, "00f067aa0ba902b7", entry[diagConsts.LogSpanIDField])
+	})
+
+	t.Run("no span in context returns the same logger", func(t *testing.T) {
+		l, buf := newLogger()
+		assert.Equal(t, l, LoggerWithTraceContext(t.Context(), l))
+
+		l.Info("hello")
+
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+		assert.NotContains(t, entry, diagConsts.LogTraceIDField)
+		assert.NotContains(t, entry, diagConsts.LogSpanIDField)
+	})
+}
@@ -60,10 +60,28 @@ type workflowMetrics struct {
 	workflowExecutionLatency *stats.Float64Measure
 	// workflowSchedulingLatency records time taken between workflow execution request and actual workflow execution
 	workflowSchedulingLatency *stats.Float64Measure
-	appID                     string
-	enabled                   bool
-	namespace                 string
-	meter                     stats.Recorder
+	// workflowStartedCount records count of workflows started.
+	workflowStartedCount *stats.Int64Measure
+	// workflowCompletedCount records count of workflows reaching a terminal runtime status.
+	workflowCompletedCount *stats.Int64Measure
+	// workflowPending records the number of workflow instances which have not yet reached a terminal status, as counted
+	// from the workflow state.
+	workflowPending *stats.Int64Measure
+	// historyAppendLatency records time taken to persist new history events to the state store.
+	historyAppendLatency *stats.Float64Measure
+	// reapedCount records the number of workflow instances purged by the reaper.
+	reapedCount *stats.Int64Measure
+	// reclaimedBytes records the bytes of workflow state purged by the reaper.
+	reclaimedBytes *stats.Int64Measure
+	// historyCappedCount records the number of workflow instances terminated for exceeding the maximum history length.
+	historyCappedCount *stats.Int64Measure
+	// eventsDroppedCount records the number of external events dropped before being delivered to a workflow instance.
+	eventsDroppedCount *stats.Int64Measure
+
+	appID     string
+	enabled   bool
+	namespace string
+	meter     stats.Recorder
 }
 
 func newWorkflowMetrics() *workflowMetrics {
@@ -104,6 +122,38 @@ func newWorkflowMetrics() *workflowMetrics {
 			"runtime/workflow/scheduling/latency",
 			"Interval between workflow execution request and workflow execution.",
 			stats.UnitMilliseconds),
+		workflowStartedCount: stats.Int64(
+			"runtime/workflow/started/count",
+			"The number of workflows started.",
+			stats.UnitDimensionless),
+		workflowCompletedCount: stats.Int64(
+			"runtime/workflow/completed/count",
+			"The number of workflows which reached a terminal status (completed, failed, or terminated).",
+			stats.UnitDimensionless),
+		workflowPending: stats.Int64(
+			"runtime/workflow/pending",
+			"The number of workflow instances which have not yet reached a terminal status.",
+			stats.UnitDimensionless),
+		historyAppendLatency: stats.Float64(
+			"runtime/workflow/history/append/latency",
+			"The time taken to append new history events to the workflow state store.",
+			stats.UnitMilliseconds),
+		reapedCount: stats.Int64(
+			"runtime/workflow/reaper/purged/count",
+			"The number of workflow instances purged by the reaper of the workflow state retention policy.",
+			stats.UnitDimensionless),
+		reclaimedBytes: stats.Int64(
+			"runtime/workflow/reaper/reclaimed/bytes",
+			"The size of the workflow state purged by the reaper of the workflow state retention policy.",
+			stats.UnitBytes),
+		historyCappedCount: stats.Int64(
+			"runtime/workflow/reaper/history_capped/count",
+			"The number of workflow instances terminated for exceeding the maximum history length.",
+			stats.UnitDimensionless),
+		eventsDroppedCount: stats.Int64(
+			"runtime/workflow/events/dropped/count",
+			"The number of external events dropped before being delivered to a workflow instance.",
+			stats.UnitDimensionless),
 	}
 }
 
@@ -127,7 +177,15 @@ func (w *workflowMetrics) Init(meter view.Meter, appID, namespace string, latenc
 		diagUtils.NewMeasureView(w.activityExecutionCount, []tag.Key{appIDKey, namespaceKey, activityNameKey, statusKey}, view.Count()),
 		diagUtils.NewMeasureView(w.activityExecutionLatency, []tag.Key{appIDKey, namespaceKey, activityNameKey, statusKey}, latencyDistribution),
 		diagUtils.NewMeasureView(w.workflowExecutionLatency, []tag.Key{appIDKey, namespaceKey, workflowNameKey, statusKey}, latencyDistribution),
-		diagUtils.NewMeasureView(w.workflowSchedulingLatency, []tag.Key{appIDKey, namespaceKey, workflowNameKey}, latencyDistribution))
+		diagUtils.NewMeasureView(w.workflowSchedulingLatency, []tag.Key{appIDKey, namespaceKey, workflowNameKey}, latencyDistribution),
+		diagUtils.NewMeasureView(w.workflowStartedCount, []tag.Key{appIDKey, namespaceKey, workflowNameKey}, view.Count()),
+		diagUtils.NewMeasureView(w.workflowCompletedCount, []tag.Key{appIDKey, namespaceKey, workflowNameKey, statusKey}, view.Count()),
+		diagUtils.NewMeasureView(w.workflowPending, []tag.Key{appIDKey, namespaceKey, workflowNameKey}, view.LastValue()),
+		diagUtils.NewMeasureView(w.historyAppendLatency, []tag.Key{appIDKey, namespaceKey, workflowNameKey}, latencyDistribution),
+		diagUtils.NewMeasureView(w.reapedCount, []tag.Key{appIDKey, namespaceKey, workflowNameKey, statusKey}, view.Count()),
+		diagUtils.NewMeasureView(w.reclaimedBytes, []tag.Key{appIDKey, namespaceKey, workflowNameKey}, view.Sum()),
+		diagUtils.NewMeasureView(w.historyCappedCount, []tag.Key{appIDKey, namespaceKey, workflowNameKey}, view.Count()),
+		diagUtils.NewMeasureView(w.eventsDroppedCount, []tag.Key{appIDKey, namespaceKey, failReasonKey}, view.Sum()))
 }
 
 // WorkflowOperationEvent records total number of Successful/Failed workflow Operations requests. It also records latency for those requests.
@@ -198,3 +256,68 @@ func (w *workflowMetrics) ActivityOperationEvent(ctx context.Context, activityNa
 		stats.RecordWithOptions(ctx, stats.WithRecorder(w.meter), stats.WithTags(diagUtils.WithTags(w.activityOperationLatency.Name(), appIDKey, w.appID, namespaceKey, w.namespace, activityNameKey, activityName, statusKey, status)...), stats.WithMeasurements(w.activityOperationLatency.M(elapsed)))
 	}
 }
+
+// WorkflowStarted records a workflow being started.
+func (w *workflowMetrics) WorkflowStarted(ctx context.Context, workflowName string) {
+	if !w.IsEnabled() {
+		return
+	}
+
+	stats.RecordWithOptions(ctx, stats.WithRecorder(w.meter), stats.WithTags(diagUtils.WithTags(w.workflowStartedCount.Name(), appIDKey, w.appID, namespaceKey, w.namespace, workflowNameKey, workflowName)...), stats.WithMeasurements(w.workflowStartedCount.M(1)))
+}
+
+// WorkflowCompleted records a workflow reaching a terminal status.
+func (w *workflowMetrics) WorkflowCompleted(ctx context.Context, workflowName, status string) {
+	if !w.IsEnabled() {
+		return
+	}
+
+	stats.RecordWithOptions(ctx, stats.WithRecorder(w.meter), stats.WithTags(diagUtils.WithTags(w.workflowCompletedCount.Name(), appIDKey, w.appID, namespaceKey, w.namespace, workflowNameKey, workflowName, statusKey, status)...), stats.WithMeasurements(w.workflowCompletedCount.M(1)))
+}
+
+// HistoryAppendLatency records the time taken to persist new history events for a workflow.
+func (w *workflowMetrics) HistoryAppendLatency(ctx context.Context, workflowName string, elapsed float64) {
+	if !w.IsEnabled() {
+		return
+	}
+
+	stats.RecordWithOptions(ctx, stats.WithRecorder(w.meter), stats.WithTags(diagUtils.WithTags(w.historyAppendLatency.Name(), appIDKey, w.appID, namespaceKey, w.namespace, workflowNameKey, workflowName)...), stats.WithMeasurements(w.historyAppendLatency.M(elapsed)))
+}
+
+// WorkflowReaped records a workflow instance in the given terminal status being purged by the reaper, and the bytes of state reclaimed.
+func (w *workflowMetrics) WorkflowReaped(ctx context.Context, workflowName, status string, reclaimedBytes int64) {
+	if !w.IsEnabled() {
+		return
+	}
+
+	stats.RecordWithOptions(ctx, stats.WithRecorder(w.meter), stats.WithTags(diagUtils.WithTags(w.reapedCount.Name(), appIDKey, w.appID, namespaceKey, w.namespace, workflowNameKey, workflowName, statusKey, status)...), stats.WithMeasurements(w.reapedCount.M(1)))
+	stats.RecordWithOptions(ctx, stats.WithRecorder(w.meter), stats.WithTags(diagUtils.WithTags(w.reclaimedBytes.Name(), appIDKey, w.appID, namespaceKey, w.namespace, workflowNameKey, workflowName)...), stats.WithMeasurements(w.reclaimedBytes.M(reclaimedBytes)))
+}
+
+// WorkflowHistoryCapped records a workflow instance being terminated for exceeding the maximum history length.
+func (w *workflowMetrics) WorkflowHistoryCapped(ctx context.Context, workflowName string) {
+	if !w.IsEnabled() {
+		return
+	}
+
+	stats.RecordWithOptions(ctx, stats.WithRecorder(w.meter), stats.WithTags(diagUtils.WithTags(w.historyCappedCount.Name(), appIDKey, w.appID, namespaceKey, w.namespace, workflowNameKey, workflowName)...), stats.WithMeasurements(w.historyCappedCount.M(1)))
+}
+
+// WorkflowEventsDropped records external events being dropped before being delivered to a workflow instance, for the given reason.
+func (w *workflowMetrics) WorkflowEventsDropped(ctx context.Context, reason string, count int64) {
+	if !w.IsEnabled() || count <= 0 {
+		return
+	}
+
+	stats.RecordWithOptions(ctx, stats.WithRecorder(w.meter), stats.WithTags(diagUtils.WithTags(w.eventsDroppedCount.Name(), appIDKey, w.appID, namespaceKey, w.namespace, failReasonKey, reason)...), stats.WithMeasurements(w.eventsDroppedCount.M(count)))
+}
+
+// WorkflowsPending records the number of workflow instances with the given name which have not yet reached a terminal
+// status. The count is taken from the workflow state, so it includes the instances started before this sidecar.
+func (w *workflowMetrics) WorkflowsPending(ctx context.Context, workflowName string, count int64) {
+	if !w.IsEnabled() {
+		return
+	}
+
+	stats.RecordWithOptions(ctx, stats.WithRecorder(w.meter), stats.WithTags(diagUtils.WithTags(w.workflowPending.Name(), appIDKey, w.appID, namespaceKey, w.namespace, workflowNameKey, workflowName)...), stats.WithMeasurements(w.workflowPending.M(count)))
+}
@@ -362,3 +362,90 @@ func TestExecution(t *testing.T) {
 		})
 	})
 }
+
+func TestWorkflowLifecycle(t *testing.T) {
+	const workflowName = "test"
+
+	t.Run("started and completed workflows", func(t *testing.T) {
+		w, meter := initWorkflowMetrics()
+		t.Cleanup(func() { meter.Stop() })
+
+		w.WorkflowStarted(t.Context(), workflowName)
+		w.WorkflowStarted(t.Context(), workflowName)
+		w.WorkflowCompleted(t.Context(), workflowName, "completed")
+
+		viewData, _ := meter.RetrieveData("runtime/workflow/started/count")
+		v := meter.Find("runtime/workflow/started/count")
+		allTagsPresent(t, v, viewData[0].Tags)
+		assert.Equal(t, int64(2), viewData[0].Data.(*view.CountData).Value)
+
+		viewData, _ = meter.RetrieveData("runtime/workflow/completed/count")
+		v = meter.Find("runtime/workflow/completed/count")
+		allTagsPresent(t, v, viewData[0].Tags)
+		assert.Equal(t, int64(1), viewData[0].Data.(*view.CountData).Value)
+
+		viewData, _ = meter.RetrieveData("runtime/workflow/pending")
+		assert.Empty(t, viewData)
+	})
+
+	t.Run("pending workflows", func(t *testing.T) {
+		w, meter := initWorkflowMetrics()
+		t.Cleanup(func() { meter.Stop() })
+
+		w.WorkflowsPending(t.Context(), workflowName, 3)
+		w.WorkflowsPending(t.Context(), workflowName, 2)
+
+		viewData, _ := meter.RetrieveData("runtime/workflow/pending")
+		v := meter.Find("runtime/workflow/pending")
+		allTagsPresent(t, v, viewData[0].Tags)
+		assert.InEpsilon(t, float64(2), viewData[0].Data.(*view.LastValueData).Value, 0)
+	})
+
+	t.Run("history append latency", func(t *testing.T) {
+		w, meter := initWorkflowMetrics()
+		t.Cleanup(func() { meter.Stop() })
+
+		w.HistoryAppendLatency(t.Context(), workflowName, 5)
+
+		viewData, _ := meter.RetrieveData("runtime/workflow/history/append/latency")
+		v := meter.Find("runtime/workflow/history/append/latency")
+		allTagsPresent(t, v, viewData[0].Tags)
+		assert.InEpsilon(t, float64(5), viewData[0].Data.(*view.DistributionData).Min, 0)
+	})
+	t.Run("reaped workflows", func(t *testing.T) {
+		w, meter := initWorkflowMetrics()
+		t.Cleanup(func() { meter.Stop() })
+
+		w.WorkflowReaped(t.Context(), workflowName, "completed", 100)
+		w.WorkflowReaped(t.Context(), workflowName, "completed", 50)
+		w.WorkflowHistoryCapped(t.Context(), workflowName)
+
+		viewData, _ := meter.RetrieveData("runtime/workflow/reaper/purged/count")
+		v := meter.Find("runtime/workflow/reaper/purged/count")
+		allTagsPresent(t, v, viewData[0].Tags)
+		assert.Equal(t, int64(2), viewData[0].Data.(*view.CountData).Value)
+
+		viewData, _ = meter.RetrieveData("runtime/workflow/reaper/reclaimed/bytes")
+		v = meter.Find("runtime/workflow/reaper/reclaimed/bytes")
+		allTagsPresent(t, v, viewData[0].Tags)
+		assert.InEpsilon(t, float64(150), viewData[0].Data.(*view.SumData).Value, 0)
+
+		viewData, _ = meter.RetrieveData("runtime/workflow/reaper/history_capped/count")
+		v = meter.Find("runtime/workflow/reaper/history_capped/count")
+		allTagsPresent(t, v, viewData[0].Tags)
+		assert.Equal(t, int64(1), viewData[0].Data.(*view.CountData).Value)
+	})
+	t.Run("dropped events", func(t *testing.T) {
+		w, meter := initWorkflowMetrics()
+		t.Cleanup(func() { meter.Stop() })
+
+		w.WorkflowEventsDropped(t.Context(), "expired", 2)
+		w.WorkflowEventsDropped(t.Context(), "expired", 0)
+		w.WorkflowEventsDropped(t.Context(), "expired", 1)
+
+		viewData, _ := meter.RetrieveData("runtime/workflow/events/dropped/count")
+		v := meter.Find("runtime/workflow/events/dropped/count")
+		allTagsPresent(t, v, viewData[0].Tags)
+		assert.InEpsilon(t, float64(3), viewData[0].Data.(*view.SumData).Value, 0)
+	})
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/dapr/components-contrib/secretstores"
 	commonapi "github.com/dapr/dapr/pkg/apis/common"
 	"github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	kitstrings "github.com/dapr/kit/strings"
 )
 
 type Algorithm string
@@ -34,6 +35,8 @@ type Algorithm string
 const (
 	primaryEncryptionKey   = "primaryEncryptionKey"
 	secondaryEncryptionKey = "secondaryEncryptionKey"
+	encryptedFields        = "encryptedFields"
+	authenticateKeyName    = "authenticateKeyName"
 	errPrefix              = "failed to extract encryption key"
 	AESGCMAlgorithm        = "AES-GCM"
 )
@@ -42,6 +45,16 @@ const (
 type ComponentEncryptionKeys struct {
 	Primary   Key
 	Secondary Key
+
+	// Fields selects the fields of JSON values to encrypt. If empty, values are
+	// encrypted as a whole.
+	Fields []FieldSelector
+
+	// AuthenticateKeyName encrypts values with the name of the key as
+	// additional authenticated data. Older versions of Dapr can't decrypt these
+	// values: to roll back, disable it and re-encrypt the state store first.
+	// Encrypted fields are always authenticated.
+	AuthenticateKeyName bool
 }
 
 // Key holds the key to encrypt an arbitrary object.
@@ -63,7 +76,19 @@ func ComponentEncryptionKey(component v1alpha1.Component, secretStore secretstor
 		// search for primary encryption key
 		var valid bool
 
-		if m.Name == primaryEncryptionKey {
+		if m.Name == encryptedFields {
+			fields, err := ParseFieldSelectors(m.Value.String())
+			if err != nil {
+				return ComponentEncryptionKeys{}, fmt.Errorf("invalid %s: %w", encryptedFields, err)
+			}
+			cek.Fields = fields
+
+			continue
+		} else if m.Name == authenticateKeyName {
+			cek.AuthenticateKeyName = kitstrings.IsTruthy(m.Value.String())
+
+			continue
+		} else if m.Name == primaryEncryptionKey {
 			if len(m.Value.Raw) > 0 {
 				// encryption key is already extracted by the Operator
 				cek.Primary = Key{
@@ -160,26 +185,31 @@ func tryGetEncryptionKeyFromMetadataItem(namespace string, item commonapi.NameVa
 }
 
 // Encrypt takes a byte array and encrypts it using a supplied encryption key.
-func encrypt(value []byte, key Key) ([]byte, error) {
+// The additional data is authenticated, but not encrypted.
+func encrypt(value []byte, key Key, additionalData []byte) ([]byte, error) {
 	nsize := make([]byte, key.cipherObj.NonceSize())
 	if _, err := io.ReadFull(rand.Reader, nsize); err != nil {
 		return value, err
 	}
 
-	return key.cipherObj.Seal(nsize, nsize, value, nil), nil
+	return key.cipherObj.Seal(nsize, nsize, value, additionalData), nil
 }
 
 // Decrypt takes a byte array and decrypts it using a supplied encryption key.
-func decrypt(value []byte, key Key) ([]byte, error) {
+// The additional data must be the one the value was encrypted with.
+func decrypt(value []byte, key Key, additionalData []byte) ([]byte, error) {
 	enc, err := b64.StdEncoding.DecodeString(string(value))
 	if err != nil {
 		return value, err
 	}
 
 	nsize := key.cipherObj.NonceSize()
+	if len(enc) < nsize {
+		return value, errors.New("encrypted value is too short")
+	}
 	nonce, ciphertext := enc[:nsize], enc[nsize:]
 
-	return key.cipherObj.Open(nil, nonce, ciphertext, nil)
+	return key.cipherObj.Open(nil, nonce, ciphertext, additionalData)
 }
 
 func createCipher(key Key, algorithm Algorithm) (cipher.AEAD, error) {
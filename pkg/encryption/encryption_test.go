@@ -26,6 +26,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	apiextensionsV1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -136,6 +137,26 @@ func TestComponentEncryptionKey(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("authenticated key name", func(t *testing.T) {
+		component := v1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "statestore",
+			},
+			Spec: v1alpha1.ComponentSpec{
+				Metadata: []commonapi.NameValuePair{
+					{
+						Name:  authenticateKeyName,
+						Value: commonapi.DynamicValue{JSON: apiextensionsV1.JSON{Raw: []byte("true")}},
+					},
+				},
+			},
+		}
+
+		keys, err := ComponentEncryptionKey(component, &mockSecretStore{})
+		require.NoError(t, err)
+		assert.True(t, keys.AuthenticateKeyName)
+	})
+
 	t.Run("no error when component doesn't have encryption keys", func(t *testing.T) {
 		component := v1alpha1.Component{
 			ObjectMeta: metav1.ObjectMeta{
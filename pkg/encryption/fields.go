@@ -0,0 +1,248 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// FieldSelector selects fields of JSON values with a subset of JSONPath: "$"
+// followed by member segments, written as ".name" or "['name']", and
+// wildcards, written as "[*]" or ".*", which select all the elements of an
+// array or all the members of an object.
+type FieldSelector struct {
+	path     string
+	segments []fieldSegment
+}
+
+type fieldSegment struct {
+	name     string
+	wildcard bool
+}
+
+func (f FieldSelector) String() string {
+	return f.path
+}
+
+// ParseFieldSelectors parses a comma-separated list of field selectors.
+func ParseFieldSelectors(val string) ([]FieldSelector, error) {
+	var selectors []FieldSelector
+	for _, path := range strings.Split(val, ",") {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+
+		sel, err := parseFieldSelector(path)
+		if err != nil {
+			return nil, err
+		}
+		selectors = append(selectors, sel)
+	}
+	return selectors, nil
+}
+
+func parseFieldSelector(path string) (FieldSelector, error) {
+	rest, ok := strings.CutPrefix(path, "$")
+	if !ok {
+		return FieldSelector{}, fmt.Errorf("field selector %q must start with $", path)
+	}
+
+	sel := FieldSelector{path: path}
+	for rest != "" {
+		switch {
+		case strings.HasPrefix(rest, "[*]"):
+			sel.segments = append(sel.segments, fieldSegment{wildcard: true})
+			rest = rest[len("[*]"):]
+		case strings.HasPrefix(rest, ".*"):
+			sel.segments = append(sel.segments, fieldSegment{wildcard: true})
+			rest = rest[len(".*"):]
+		case strings.HasPrefix(rest, "['"):
+			end := strings.Index(rest, "']")
+			if end < len("['")+1 {
+				return FieldSelector{}, fmt.Errorf("field selector %q has an invalid member", path)
+			}
+			sel.segments = append(sel.segments, fieldSegment{name: rest[len("['"):end]})
+			rest = rest[end+len("']"):]
+		case rest[0] == '.':
+			end := strings.IndexAny(rest[1:], ".[") + 1
+			if end == 0 {
+				end = len(rest)
+			}
+			if end == 1 {
+				return FieldSelector{}, fmt.Errorf("field selector %q has an empty member", path)
+			}
+			sel.segments = append(sel.segments, fieldSegment{name: rest[1:end]})
+			rest = rest[end:]
+		default:
+			return FieldSelector{}, fmt.Errorf("field selector %q is not supported", path)
+		}
+	}
+
+	if len(sel.segments) == 0 {
+		return FieldSelector{}, fmt.Errorf("field selector %q must select a field", path)
+	}
+	return sel, nil
+}
+
+// encryptFields encrypts the selected fields of a JSON value saved at the state
+// key. Each field is replaced with a string holding its encrypted JSON value.
+func encryptFields(value []byte, stateKey string, keys ComponentEncryptionKeys) ([]byte, error) {
+	doc, err := decodeJSON(value)
+	if err != nil {
+		return value, err
+	}
+
+	for _, sel := range keys.Fields {
+		doc, err = visitFields(doc, sel.segments, func(v any) (any, error) {
+			raw, err := encodeJSON(v)
+			if err != nil {
+				return nil, err
+			}
+			enc, err := encryptValue(raw, stateKey, keys.Primary, true)
+			if err != nil {
+				return nil, err
+			}
+			return string(enc), nil
+		})
+		if err != nil {
+			return value, fmt.Errorf("failed to encrypt field %s: %w", sel, err)
+		}
+	}
+
+	return encodeJSON(doc)
+}
+
+// decryptFields decrypts the encrypted fields of a JSON value saved at the
+// state key. Selectors are visited in reverse order, so fields selected by more
+// than one selector are decrypted in the reverse order they were encrypted.
+func decryptFields(value []byte, stateKey string, keys ComponentEncryptionKeys) ([]byte, error) {
+	doc, err := decodeJSON(value)
+	if err != nil {
+		return value, err
+	}
+
+	for i := len(keys.Fields) - 1; i >= 0; i-- {
+		sel := keys.Fields[i]
+		doc, err = visitFields(doc, sel.segments, func(v any) (any, error) {
+			s, ok := v.(string)
+			if !ok || !isEncryptedField(s) {
+				return v, nil
+			}
+			raw, err := decryptValue([]byte(s), stateKey, keys)
+			if err != nil {
+				return nil, err
+			}
+			return decodeJSON(raw)
+		})
+		if err != nil {
+			return value, fmt.Errorf("failed to decrypt field %s: %w", sel, err)
+		}
+	}
+
+	return encodeJSON(doc)
+}
+
+// fieldsNeedReencryption returns true if any selected field of a JSON value
+// isn't encrypted with the primary key.
+func fieldsNeedReencryption(value []byte, keys ComponentEncryptionKeys) bool {
+	doc, err := decodeJSON(value)
+	if err != nil {
+		return false
+	}
+
+	var needs bool
+	for _, sel := range keys.Fields {
+		_, _ = visitFields(doc, sel.segments, func(v any) (any, error) {
+			if s, ok := v.(string); !ok || !encryptedWith([]byte(s), keys.Primary, true) {
+				needs = true
+			}
+			return v, nil
+		})
+	}
+	return needs
+}
+
+// visitFields replaces the fields of doc selected by the segments with the
+// result of fn.
+func visitFields(doc any, segments []fieldSegment, fn func(any) (any, error)) (any, error) {
+	if len(segments) == 0 {
+		return fn(doc)
+	}
+
+	seg, rest := segments[0], segments[1:]
+	var err error
+	switch v := doc.(type) {
+	case map[string]any:
+		for name, field := range v {
+			if !seg.wildcard && name != seg.name {
+				continue
+			}
+			if v[name], err = visitFields(field, rest, fn); err != nil {
+				return nil, err
+			}
+		}
+	case []any:
+		if !seg.wildcard {
+			return doc, nil
+		}
+		for i, elem := range v {
+			if v[i], err = visitFields(elem, rest, fn); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return doc, nil
+}
+
+func isEncryptedField(s string) bool {
+	return strings.HasPrefix(s, authenticatedPrefix) && strings.Contains(s, separator)
+}
+
+// isJSONDocument returns true if the value is a JSON object or array.
+func isJSONDocument(value []byte) bool {
+	trimmed := bytes.TrimSpace(value)
+	if len(trimmed) == 0 || (trimmed[0] != '{' && trimmed[0] != '[') {
+		return false
+	}
+	return json.Valid(trimmed)
+}
+
+// decodeJSON decodes a JSON value, keeping numbers as they were written.
+func decodeJSON(value []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(value))
+	dec.UseNumber()
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	if dec.More() {
+		return nil, errors.New("unexpected data after JSON value")
+	}
+	return doc, nil
+}
+
+func encodeJSON(doc any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKey(t *testing.T, name string) Key {
+	t.Helper()
+
+	b := make([]byte, 32)
+	rand.Read(b)
+	key := Key{Name: name, Key: hex.EncodeToString(b)}
+
+	var err error
+	key.cipherObj, err = createCipher(key, AESGCMAlgorithm)
+	require.NoError(t, err)
+	return key
+}
+
+func TestParseFieldSelectors(t *testing.T) {
+	t.Run("valid selectors", func(t *testing.T) {
+		sels, err := ParseFieldSelectors("$.ssn, $.card['number'],$.items[*].secret,$.tags.*")
+		require.NoError(t, err)
+		require.Len(t, sels, 4)
+		assert.Equal(t, []fieldSegment{{name: "ssn"}}, sels[0].segments)
+		assert.Equal(t, []fieldSegment{{name: "card"}, {name: "number"}}, sels[1].segments)
+		assert.Equal(t, []fieldSegment{{name: "items"}, {wildcard: true}, {name: "secret"}}, sels[2].segments)
+		assert.Equal(t, []fieldSegment{{name: "tags"}, {wildcard: true}}, sels[3].segments)
+		assert.Equal(t, "$.card['number']", sels[1].String())
+	})
+
+	for _, sel := range []string{"ssn", "$", "$..a", "$['']", "$[0]", "$.a["} {
+		t.Run("invalid selector "+sel, func(t *testing.T) {
+			_, err := ParseFieldSelectors(sel)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestFieldEncryption(t *testing.T) {
+	fields, err := ParseFieldSelectors("$.ssn,$.cards[*].number,$.address,$.address.zip")
+	require.NoError(t, err)
+
+	encryptedStateStores = map[string]ComponentEncryptionKeys{}
+	AddEncryptedStateStore("test", ComponentEncryptionKeys{
+		Primary: newTestKey(t, "primary"),
+		Fields:  fields,
+	})
+
+	t.Run("selected fields are encrypted", func(t *testing.T) {
+		v := []byte(`{"name":"a<b","ssn":"123","cards":[{"number":4111,"exp":"01/30"}],"address":{"zip":"12345","city":"x"}}`)
+		enc, err := TryEncryptValue("test", "app||key", v)
+		require.NoError(t, err)
+
+		var doc map[string]any
+		require.NoError(t, json.Unmarshal(enc, &doc))
+		assert.Equal(t, "a<b", doc["name"])
+		assert.True(t, isEncryptedField(doc["ssn"].(string)))
+		assert.True(t, isEncryptedField(doc["address"].(string)))
+		card := doc["cards"].([]any)[0].(map[string]any)
+		assert.True(t, isEncryptedField(card["number"].(string)))
+		assert.Equal(t, "01/30", card["exp"])
+		assert.NotContains(t, string(enc), "12345")
+
+		dec, err := TryDecryptValue("test", "app||key", enc)
+		require.NoError(t, err)
+		assert.JSONEq(t, string(v), string(dec))
+		assert.Contains(t, string(dec), `"number":4111`)
+	})
+
+	t.Run("values which aren't JSON documents are encrypted as a whole", func(t *testing.T) {
+		v := []byte(`"hello"`)
+		enc, err := TryEncryptValue("test", "app||key", v)
+		require.NoError(t, err)
+		assert.False(t, json.Valid(enc))
+
+		dec, err := TryDecryptValue("test", "app||key", enc)
+		require.NoError(t, err)
+		assert.Equal(t, v, dec)
+	})
+
+	t.Run("fields are bound to their state key", func(t *testing.T) {
+		enc, err := TryEncryptValue("test", "app||key", []byte(`{"ssn":"123"}`))
+		require.NoError(t, err)
+
+		_, err = TryDecryptValue("test", "app||other", enc)
+		require.Error(t, err)
+	})
+
+	t.Run("tampered fields fail to decrypt", func(t *testing.T) {
+		enc, err := TryEncryptValue("test", "app||key", []byte(`{"ssn":"123"}`))
+		require.NoError(t, err)
+
+		var doc map[string]string
+		require.NoError(t, json.Unmarshal(enc, &doc))
+		b := []byte(doc["ssn"])
+		b[10] ^= 1
+		doc["ssn"] = string(b)
+		enc, err = json.Marshal(doc)
+		require.NoError(t, err)
+
+		_, err = TryDecryptValue("test", "app||key", enc)
+		require.Error(t, err)
+	})
+}
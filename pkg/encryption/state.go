@@ -16,17 +16,33 @@ package encryption
 import (
 	"bytes"
 	b64 "encoding/base64"
+	"errors"
 	"fmt"
+	"strings"
+	"sync"
 )
 
-var encryptedStateStores = map[string]ComponentEncryptionKeys{}
+var (
+	encryptedStateStores     = map[string]ComponentEncryptionKeys{}
+	encryptedStateStoresLock sync.RWMutex
+)
 
 const (
 	separator = "||"
+
+	// authenticatedPrefix marks the values encrypted with the name of the key
+	// and the state key they're saved at as additional authenticated data, so a
+	// value can't be decrypted with a key other than the one named on the
+	// record, nor once copied to another state key. Values without the prefix
+	// are decrypted without additional data.
+	authenticatedPrefix = "v2:"
 )
 
 // AddEncryptedStateStore adds an encrypted state store and an associated encryption key to a list.
 func AddEncryptedStateStore(storeName string, keys ComponentEncryptionKeys) bool {
+	encryptedStateStoresLock.Lock()
+	defer encryptedStateStoresLock.Unlock()
+
 	if _, ok := encryptedStateStores[storeName]; ok {
 		return false
 	}
@@ -35,40 +51,125 @@ func AddEncryptedStateStore(storeName string, keys ComponentEncryptionKeys) bool
 	return true
 }
 
+// RemoveEncryptedStateStore removes the encryption keys of a state store, so
+// the keys can be rotated when the state store is loaded again.
+func RemoveEncryptedStateStore(storeName string) {
+	encryptedStateStoresLock.Lock()
+	defer encryptedStateStoresLock.Unlock()
+
+	delete(encryptedStateStores, storeName)
+}
+
 // EncryptedStateStore returns a bool that indicates if a state stores supports encryption.
 func EncryptedStateStore(storeName string) bool {
-	_, ok := encryptedStateStores[storeName]
+	_, ok := getKeys(storeName)
 	return ok
 }
 
-// TryEncryptValue will try to encrypt a byte array if the state store has associated encryption keys.
+// TryEncryptValue will try to encrypt a byte array saved at the state key if the state store has associated encryption keys.
 // The function will append the name of the key to the value for later extraction.
+// If fields are selected for encryption and the value is a JSON object or array, only the selected fields are encrypted.
 // If no encryption keys exist, the function will return the bytes unmodified.
-func TryEncryptValue(storeName string, value []byte) ([]byte, error) {
-	keys := encryptedStateStores[storeName]
-	enc, err := encrypt(value, keys.Primary)
-	if err != nil {
-		return value, err
+func TryEncryptValue(storeName string, stateKey string, value []byte) ([]byte, error) {
+	keys, _ := getKeys(storeName)
+	if len(keys.Fields) > 0 && isJSONDocument(value) {
+		return encryptFields(value, stateKey, keys)
 	}
 
-	sEnc := b64.StdEncoding.EncodeToString(enc) + separator + keys.Primary.Name
-	return []byte(sEnc), nil
+	return encryptValue(value, stateKey, keys.Primary, keys.AuthenticateKeyName)
 }
 
-// TryDecryptValue will try to decrypt a byte array if the state store has associated encryption keys.
+// TryDecryptValue will try to decrypt a byte array saved at the state key if the state store has associated encryption keys.
 // If no encryption keys exist, the function will return the bytes unmodified.
-func TryDecryptValue(storeName string, value []byte) ([]byte, error) {
+func TryDecryptValue(storeName string, stateKey string, value []byte) ([]byte, error) {
 	if len(value) == 0 {
 		return []byte(""), nil
 	}
 
-	keys := encryptedStateStores[storeName]
+	keys, _ := getKeys(storeName)
+	// Values encrypted as a whole are never valid JSON.
+	if len(keys.Fields) > 0 && isJSONDocument(value) {
+		val, err := decryptFields(value, stateKey, keys)
+		if err != nil {
+			return value, fmt.Errorf("could not decrypt data for state store %s: %w", storeName, err)
+		}
+		return val, nil
+	}
+
+	val, err := decryptValue(value, stateKey, keys)
+	if err != nil {
+		return value, fmt.Errorf("could not decrypt data for state store %s: %w", storeName, err)
+	}
+	return val, nil
+}
+
+// NeedsReencryption returns true if any part of an encrypted value isn't
+// encrypted with the primary key of the state store, or if the name of the key
+// isn't authenticated as configured for the state store.
+func NeedsReencryption(storeName string, value []byte) bool {
+	if len(value) == 0 {
+		return false
+	}
+
+	keys, _ := getKeys(storeName)
+	if len(keys.Fields) > 0 && isJSONDocument(value) {
+		return fieldsNeedReencryption(value, keys)
+	}
+
+	return !encryptedWith(value, keys.Primary, keys.AuthenticateKeyName)
+}
+
+// ReencryptValue decrypts a value saved at the state key and encrypts it again
+// with the primary key of the state store.
+func ReencryptValue(storeName string, stateKey string, value []byte) ([]byte, error) {
+	val, err := TryDecryptValue(storeName, stateKey, value)
+	if err != nil {
+		return value, err
+	}
+	return TryEncryptValue(storeName, stateKey, val)
+}
+
+func getKeys(storeName string) (ComponentEncryptionKeys, bool) {
+	encryptedStateStoresLock.RLock()
+	defer encryptedStateStoresLock.RUnlock()
+
+	keys, ok := encryptedStateStores[storeName]
+	return keys, ok
+}
+
+// encryptValue encrypts a value with the key, and appends the name of the key.
+// If authenticated, the name of the key and the state key the value is saved at
+// are the additional authenticated data.
+func encryptValue(value []byte, stateKey string, key Key, authenticated bool) ([]byte, error) {
+	if !authenticated {
+		enc, err := encrypt(value, key, nil)
+		if err != nil {
+			return value, err
+		}
+		return []byte(b64.StdEncoding.EncodeToString(enc) + separator + key.Name), nil
+	}
+
+	enc, err := encrypt(value, key, additionalData(key.Name, stateKey))
+	if err != nil {
+		return value, err
+	}
+
+	sEnc := authenticatedPrefix + b64.StdEncoding.EncodeToString(enc) + separator + key.Name
+	return []byte(sEnc), nil
+}
+
+// decryptValue decrypts a value saved at the state key with the key named on
+// it.
+func decryptValue(value []byte, stateKey string, keys ComponentEncryptionKeys) ([]byte, error) {
 	// extract the decryption key that should be appended to the value
 	ind := bytes.LastIndex(value, []byte(separator))
+	if ind < 0 {
+		return value, errors.New("encryption key name not found on record")
+	}
 	keyName := string(value[ind+len(separator):])
 
 	if len(keyName) == 0 {
-		return value, fmt.Errorf("could not decrypt data for state store %s: encryption key name not found on record", storeName)
+		return value, errors.New("encryption key name not found on record")
 	}
 
 	var key Key
@@ -78,6 +179,30 @@ func TryDecryptValue(storeName string, value []byte) ([]byte, error) {
 	} else if keys.Secondary.Name == keyName {
 		key = keys.Secondary
 	}
+	if key.cipherObj == nil {
+		return value, fmt.Errorf("encryption key %s not found", keyName)
+	}
+
+	enc := value[:ind]
+	if rest, ok := bytes.CutPrefix(enc, []byte(authenticatedPrefix)); ok {
+		return decrypt(rest, key, additionalData(keyName, stateKey))
+	}
+	return decrypt(enc, key, nil)
+}
+
+// additionalData returns the additional authenticated data of a value saved at
+// the state key and encrypted with the named key.
+func additionalData(keyName string, stateKey string) []byte {
+	return []byte(keyName + separator + stateKey)
+}
 
-	return decrypt(value[:ind], key)
+// encryptedWith returns true if the value is encrypted with the key, with the
+// name of the key authenticated or not.
+func encryptedWith(value []byte, key Key, authenticated bool) bool {
+	s := string(value)
+	if strings.HasPrefix(s, authenticatedPrefix) != authenticated {
+		return false
+	}
+	ind := strings.LastIndex(s, separator)
+	return ind >= 0 && s[ind+len(separator):] == key.Name
 }
@@ -17,6 +17,7 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"encoding/hex"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -88,12 +89,12 @@ func TestTryEncryptValue(t *testing.T) {
 		})
 
 		v := []byte("hello")
-		r, err := TryEncryptValue("test", v)
+		r, err := TryEncryptValue("test", "app||key", v)
 
 		require.NoError(t, err)
 		assert.NotEqual(t, v, r)
 
-		dr, err := TryDecryptValue("test", r)
+		dr, err := TryDecryptValue("test", "app||key", r)
 		require.NoError(t, err)
 		assert.Equal(t, v, dr)
 	})
@@ -120,7 +121,7 @@ func TestTryEncryptValue(t *testing.T) {
 		})
 
 		v := []byte("hello")
-		r, err := TryEncryptValue("test", v)
+		r, err := TryEncryptValue("test", "app||key", v)
 
 		require.NoError(t, err)
 		assert.NotEqual(t, v, r)
@@ -130,7 +131,7 @@ func TestTryEncryptValue(t *testing.T) {
 			Secondary: pr,
 		})
 
-		dr, err := TryDecryptValue("test", r)
+		dr, err := TryDecryptValue("test", "app||key", r)
 		require.NoError(t, err)
 		assert.Equal(t, v, dr)
 	})
@@ -158,12 +159,12 @@ func TestTryEncryptValue(t *testing.T) {
 
 		v := []byte("hello")
 		s := base64.StdEncoding.EncodeToString(v)
-		r, err := TryEncryptValue("test", []byte(s))
+		r, err := TryEncryptValue("test", "app||key", []byte(s))
 
 		require.NoError(t, err)
 		assert.NotEqual(t, v, r)
 
-		dr, err := TryDecryptValue("test", r)
+		dr, err := TryDecryptValue("test", "app||key", r)
 		require.NoError(t, err)
 		assert.Equal(t, []byte(s), dr)
 	})
@@ -190,12 +191,12 @@ func TestTryEncryptValue(t *testing.T) {
 		})
 
 		v := []byte("hello world")
-		r, err := TryEncryptValue("test", v)
+		r, err := TryEncryptValue("test", "app||key", v)
 
 		require.NoError(t, err)
 		assert.NotEqual(t, v, r)
 
-		dr, err := TryDecryptValue("test", r)
+		dr, err := TryDecryptValue("test", "app||key", r)
 		require.NoError(t, err)
 		assert.Equal(t, v, dr)
 	})
@@ -223,7 +224,7 @@ func TestTryDecryptValue(t *testing.T) {
 			Primary: pr,
 		})
 
-		dr, err := TryDecryptValue("test", nil)
+		dr, err := TryDecryptValue("test", "app||key", nil)
 		require.NoError(t, err)
 		assert.Empty(t, dr)
 	})
@@ -245,3 +246,121 @@ func TestEncryptedStateStore(t *testing.T) {
 		assert.False(t, ok)
 	})
 }
+
+func TestAuthenticatedKeyName(t *testing.T) {
+	primary := newTestKey(t, "primary")
+
+	t.Run("values are encrypted without authenticated data by default", func(t *testing.T) {
+		encryptedStateStores = map[string]ComponentEncryptionKeys{}
+		AddEncryptedStateStore("test", ComponentEncryptionKeys{Primary: primary})
+
+		r, err := TryEncryptValue("test", "app||key", []byte("hello"))
+		require.NoError(t, err)
+		assert.False(t, strings.HasPrefix(string(r), authenticatedPrefix))
+		assert.False(t, NeedsReencryption("test", r))
+
+		// Older versions decrypt the value without additional data.
+		ind := strings.LastIndex(string(r), separator)
+		dr, err := decrypt(r[:ind], primary, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("hello"), dr)
+	})
+
+	t.Run("values are encrypted with the key name authenticated", func(t *testing.T) {
+		encryptedStateStores = map[string]ComponentEncryptionKeys{}
+		AddEncryptedStateStore("test", ComponentEncryptionKeys{Primary: primary, AuthenticateKeyName: true})
+
+		r, err := TryEncryptValue("test", "app||key", []byte("hello"))
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(string(r), authenticatedPrefix))
+
+		// The same key under another name can't decrypt the value.
+		renamed := primary
+		renamed.Name = "renamed"
+		encryptedStateStores = map[string]ComponentEncryptionKeys{}
+		AddEncryptedStateStore("test", ComponentEncryptionKeys{Primary: renamed, AuthenticateKeyName: true})
+		_, err = TryDecryptValue("test", "app||key", []byte(strings.TrimSuffix(string(r), "primary")+"renamed"))
+		require.Error(t, err)
+	})
+
+	t.Run("authenticated values are bound to their state key", func(t *testing.T) {
+		encryptedStateStores = map[string]ComponentEncryptionKeys{}
+		AddEncryptedStateStore("test", ComponentEncryptionKeys{Primary: primary, AuthenticateKeyName: true})
+
+		r, err := TryEncryptValue("test", "app||key", []byte("hello"))
+		require.NoError(t, err)
+
+		dr, err := TryDecryptValue("test", "app||key", r)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("hello"), dr)
+
+		// The value can't be decrypted once copied to another key.
+		_, err = TryDecryptValue("test", "app||other", r)
+		require.Error(t, err)
+	})
+
+	t.Run("values written without authenticated data are decrypted", func(t *testing.T) {
+		encryptedStateStores = map[string]ComponentEncryptionKeys{}
+		AddEncryptedStateStore("test", ComponentEncryptionKeys{Primary: primary, AuthenticateKeyName: true})
+
+		enc, err := encrypt([]byte("hello"), primary, nil)
+		require.NoError(t, err)
+		legacy := []byte(base64.StdEncoding.EncodeToString(enc) + separator + primary.Name)
+
+		dr, err := TryDecryptValue("test", "app||key", legacy)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("hello"), dr)
+		assert.True(t, NeedsReencryption("test", legacy))
+	})
+
+	t.Run("authenticated values are re-encrypted when it's disabled", func(t *testing.T) {
+		encryptedStateStores = map[string]ComponentEncryptionKeys{}
+		AddEncryptedStateStore("test", ComponentEncryptionKeys{Primary: primary, AuthenticateKeyName: true})
+		r, err := TryEncryptValue("test", "app||key", []byte("hello"))
+		require.NoError(t, err)
+
+		RemoveEncryptedStateStore("test")
+		AddEncryptedStateStore("test", ComponentEncryptionKeys{Primary: primary})
+		require.True(t, NeedsReencryption("test", r))
+
+		r, err = ReencryptValue("test", "app||key", r)
+		require.NoError(t, err)
+		assert.False(t, strings.HasPrefix(string(r), authenticatedPrefix))
+		assert.False(t, NeedsReencryption("test", r))
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		encryptedStateStores = map[string]ComponentEncryptionKeys{}
+		AddEncryptedStateStore("test", ComponentEncryptionKeys{Primary: primary})
+
+		_, err := TryDecryptValue("test", "app||key", []byte(authenticatedPrefix+"aGVsbG8="+separator+"other"))
+		require.Error(t, err)
+	})
+}
+
+func TestReencryptValue(t *testing.T) {
+	oldKey, newKey := newTestKey(t, "old"), newTestKey(t, "new")
+
+	encryptedStateStores = map[string]ComponentEncryptionKeys{}
+	AddEncryptedStateStore("test", ComponentEncryptionKeys{Primary: oldKey})
+	r, err := TryEncryptValue("test", "app||key", []byte("hello"))
+	require.NoError(t, err)
+	assert.False(t, NeedsReencryption("test", r))
+
+	// Rotate the keys.
+	RemoveEncryptedStateStore("test")
+	AddEncryptedStateStore("test", ComponentEncryptionKeys{Primary: newKey, Secondary: oldKey})
+	assert.True(t, NeedsReencryption("test", r))
+
+	r, err = ReencryptValue("test", "app||key", r)
+	require.NoError(t, err)
+	assert.False(t, NeedsReencryption("test", r))
+	assert.True(t, strings.HasSuffix(string(r), separator+"new"))
+
+	// The old key can be retired.
+	RemoveEncryptedStateStore("test")
+	AddEncryptedStateStore("test", ComponentEncryptionKeys{Primary: newKey})
+	dr, err := TryDecryptValue("test", "app||key", r)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("hello"), dr)
+}
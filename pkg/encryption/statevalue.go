@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/dapr/dapr/pkg/compression"
+)
+
+// EncodeStateValue compresses and encrypts a value to save at the state key of
+// a state store, as configured for the state store.
+// Values which aren't a byte slice are serialized as JSON to be compressed. If
+// the value is a byte slice, so is the result; other values which are not
+// compressed nor encrypted are returned unmodified, so the state store
+// serializes them as usual.
+func EncodeStateValue(storeName string, stateKey string, value any) (any, error) {
+	compressed := compression.CompressedStateStore(storeName)
+	encrypted := EncryptedStateStore(storeName)
+	if !compressed && !encrypted {
+		return value, nil
+	}
+
+	data, ok := value.([]byte)
+	if !ok {
+		if compressed {
+			var err error
+			if data, err = json.Marshal(value); err != nil {
+				return value, err
+			}
+		} else {
+			data = []byte(fmt.Sprintf("%v", value))
+		}
+	}
+
+	if compressed {
+		val, err := compression.TryCompressValue(storeName, data)
+		if err != nil {
+			return value, err
+		}
+		if !encrypted && !compression.IsCompressed(val) {
+			// Small values are saved as they are.
+			return value, nil
+		}
+		data = val
+	}
+
+	if !encrypted {
+		return data, nil
+	}
+	return TryEncryptValue(storeName, stateKey, data)
+}
+
+// DecodeStateValue decrypts and decompresses a value read from the state key of
+// a state store.
+// Compressed values are detected by their envelope, so they're decompressed
+// even if compression has been disabled for the state store since they were
+// saved.
+func DecodeStateValue(storeName string, stateKey string, value []byte) ([]byte, error) {
+	var err error
+	if EncryptedStateStore(storeName) {
+		if value, err = TryDecryptValue(storeName, stateKey, value); err != nil {
+			return nil, err
+		}
+	}
+	value, err = compression.TryDecompressValue(storeName, value)
+	if err != nil {
+		return nil, err
+	}
+	return value, nil
+}
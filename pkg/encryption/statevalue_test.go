@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/compression"
+)
+
+func addTestEncryptedStateStore(t *testing.T, storeName string) {
+	t.Helper()
+
+	bytes := make([]byte, 32)
+	rand.Read(bytes)
+	pr := Key{
+		Name: "primary",
+		Key:  hex.EncodeToString(bytes),
+	}
+	cipherObj, err := createCipher(pr, AESGCMAlgorithm)
+	require.NoError(t, err)
+	pr.cipherObj = cipherObj
+
+	encryptedStateStores = map[string]ComponentEncryptionKeys{}
+	AddEncryptedStateStore(storeName, ComponentEncryptionKeys{Primary: pr})
+	t.Cleanup(func() {
+		encryptedStateStores = map[string]ComponentEncryptionKeys{}
+	})
+}
+
+func TestEncodeStateValue(t *testing.T) {
+	large := []byte(`{"data":"` + strings.Repeat("dapr", 1000) + `"}`)
+
+	t.Run("store without compression and encryption", func(t *testing.T) {
+		encryptedStateStores = map[string]ComponentEncryptionKeys{}
+		value := map[string]any{"a": 1}
+		val, err := EncodeStateValue("store", "app||key", value)
+		require.NoError(t, err)
+		assert.Equal(t, value, val)
+	})
+
+	t.Run("compressed and encrypted", func(t *testing.T) {
+		addTestEncryptedStateStore(t, "store")
+		compression.AddCompressedStateStore("store", compression.Options{Algorithm: compression.GzipAlgorithm})
+		defer compression.RemoveCompressedStateStore("store")
+
+		val, err := EncodeStateValue("store", "app||key", large)
+		require.NoError(t, err)
+		require.IsType(t, []byte{}, val)
+		assert.NotEqual(t, large, val)
+
+		dec, err := DecodeStateValue("store", "app||key", val.([]byte))
+		require.NoError(t, err)
+		assert.Equal(t, large, dec)
+	})
+
+	t.Run("values which aren't bytes are compressed as JSON", func(t *testing.T) {
+		compression.AddCompressedStateStore("store", compression.Options{Algorithm: compression.GzipAlgorithm})
+		defer compression.RemoveCompressedStateStore("store")
+
+		val, err := EncodeStateValue("store", "app||key", map[string]any{"data": strings.Repeat("dapr", 1000)})
+		require.NoError(t, err)
+		require.IsType(t, []byte{}, val)
+		assert.True(t, compression.IsCompressed(val.([]byte)))
+
+		dec, err := DecodeStateValue("store", "app||key", val.([]byte))
+		require.NoError(t, err)
+		assert.JSONEq(t, string(large), string(dec))
+	})
+
+	t.Run("small values are saved as they are", func(t *testing.T) {
+		compression.AddCompressedStateStore("store", compression.Options{Algorithm: compression.GzipAlgorithm, Threshold: 100})
+		defer compression.RemoveCompressedStateStore("store")
+
+		value := map[string]any{"a": 1}
+		val, err := EncodeStateValue("store", "app||key", value)
+		require.NoError(t, err)
+		assert.Equal(t, value, val)
+	})
+
+	t.Run("values which aren't bytes are encrypted as text", func(t *testing.T) {
+		addTestEncryptedStateStore(t, "store")
+
+		val, err := EncodeStateValue("store", "app||key", "hello")
+		require.NoError(t, err)
+		require.IsType(t, []byte{}, val)
+
+		dec, err := DecodeStateValue("store", "app||key", val.([]byte))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("hello"), dec)
+	})
+}
+
+func TestDecodeStateValue(t *testing.T) {
+	large := []byte(`{"data":"` + strings.Repeat("dapr", 1000) + `"}`)
+
+	t.Run("compressed value in a store without compression", func(t *testing.T) {
+		compression.AddCompressedStateStore("store", compression.Options{Algorithm: compression.ZstdAlgorithm})
+		val, err := EncodeStateValue("store", "app||key", large)
+		compression.RemoveCompressedStateStore("store")
+		require.NoError(t, err)
+		require.True(t, compression.IsCompressed(val.([]byte)))
+
+		dec, err := DecodeStateValue("store", "app||key", val.([]byte))
+		require.NoError(t, err)
+		assert.Equal(t, large, dec)
+	})
+
+	t.Run("plain value", func(t *testing.T) {
+		dec, err := DecodeStateValue("store", "app||key", []byte(`{"a":1}`))
+		require.NoError(t, err)
+		assert.Equal(t, []byte(`{"a":1}`), dec)
+	})
+}
@@ -2,11 +2,16 @@ package expr
 
 import (
 	"encoding/json"
+	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/checker/decls"
+	"github.com/google/cel-go/common/types/ref"
 	exprProto "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 const missingVariableMessage = "undeclared reference to '"
@@ -62,6 +67,30 @@ func (e *Expr) DecodeString(value string) (err error) {
 }
 
 func (e *Expr) Eval(variables map[string]interface{}) (interface{}, error) {
+	out, err := e.eval(variables)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Value(), nil
+}
+
+// EvalJSON evaluates the expression and returns its result encoded as JSON.
+func (e *Expr) EvalJSON(variables map[string]interface{}) ([]byte, error) {
+	out, err := e.eval(variables)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := out.ConvertToNative(reflect.TypeOf(&structpb.Value{}))
+	if err != nil {
+		return nil, fmt.Errorf("cannot encode result of type %s as JSON: %w", out.Type().TypeName(), err)
+	}
+
+	return protojson.Marshal(v.(*structpb.Value))
+}
+
+func (e *Expr) eval(variables map[string]interface{}) (ref.Val, error) {
 	if e.program == nil {
 		err := e.DecodeString(e.expr)
 		if err != nil {
@@ -74,7 +103,7 @@ func (e *Expr) Eval(variables map[string]interface{}) (interface{}, error) {
 		return nil, err
 	}
 
-	return out.Value(), nil
+	return out, nil
 }
 
 func (e *Expr) Expr() string {
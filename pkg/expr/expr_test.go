@@ -27,6 +27,20 @@ func TestEval(t *testing.T) {
 	assert.True(t, result.(bool))
 }
 
+func TestEvalJSON(t *testing.T) {
+	var e expr.Expr
+	err := e.DecodeString(`{"id": input.id, "total": input.items.map(i, i.price).size()}`)
+	require.NoError(t, err)
+	result, err := e.EvalJSON(map[string]interface{}{
+		"input": map[string]interface{}{
+			"id":    "order-1",
+			"items": []interface{}{map[string]interface{}{"price": 1}, map[string]interface{}{"price": 2}},
+		},
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"order-1","total":2}`, string(result))
+}
+
 func TestJSONMarshal(t *testing.T) {
 	var e expr.Expr
 	exprBytes := []byte(`"(has(input.test) && input.test == 1234) || (has(result.test) && result.test == 5678)"`)
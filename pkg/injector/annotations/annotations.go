@@ -28,6 +28,7 @@ const (
 	KeyLogLevel                         = "dapr.io/log-level"
 	KeyAPITokenSecret                   = "dapr.io/api-token-secret" /* #nosec */
 	KeyAppTokenSecret                   = "dapr.io/app-token-secret" /* #nosec */
+	KeyMountTokenSecrets                = "dapr.io/mount-token-secrets"
 	KeyLogAsJSON                        = "dapr.io/log-as-json"
 	KeyAppMaxConcurrency                = "dapr.io/app-max-concurrency"
 	KeyEnableMetrics                    = "dapr.io/enable-metrics"
@@ -61,6 +62,7 @@ const (
 	KeyBlockShutdownDuration            = "dapr.io/block-shutdown-duration"
 	KeyEnableAPILogging                 = "dapr.io/enable-api-logging"
 	KeyUnixDomainSocketPath             = "dapr.io/unix-domain-socket-path"
+	KeyAppUnixDomainSocket              = "dapr.io/app-unix-domain-socket"
 	KeyVolumeMountsReadOnly             = "dapr.io/volume-mounts"
 	KeyVolumeMountsReadWrite            = "dapr.io/volume-mounts-rw"
 	KeyDisableBuiltinK8sSecretStore     = "dapr.io/disable-builtin-k8s-secret-store" //nolint:gosec
@@ -76,5 +78,16 @@ const (
 	KeyPluggableComponentContainer      = "dapr.io/component-container"
 	KeyPluggableComponentsInjection     = "dapr.io/inject-pluggable-components"
 	KeyAppChannel                       = "dapr.io/app-channel-address"
+	KeyAppChannelMaxIdleConns           = "dapr.io/app-channel-max-idle-conns"
+	KeyAppChannelMaxConnsPerHost        = "dapr.io/app-channel-max-conns-per-host"
+	KeyAppChannelIdleConnTimeout        = "dapr.io/app-channel-idle-conn-timeout"
+	KeyAppChannelEnableHTTP2            = "dapr.io/app-channel-enable-http2"
+	KeyAppChannelTLSSource              = "dapr.io/app-channel-tls-source"
+	KeyAppChannelTLSCAFile              = "dapr.io/app-channel-tls-ca-file"
+	KeyAppChannelTLSCertFile            = "dapr.io/app-channel-tls-cert-file"
+	KeyAppChannelTLSKeyFile             = "dapr.io/app-channel-tls-key-file"
+	KeyAppChannelTLSSecretStore         = "dapr.io/app-channel-tls-secret-store" /* #nosec */
+	KeyAppChannelTLSSecretName          = "dapr.io/app-channel-tls-secret-name"  /* #nosec */
+	KeyAppChannelTLSServerName          = "dapr.io/app-channel-tls-server-name"
 	KeySentryRequestJwtAudiences        = "dapr.io/sentry-request-jwt-audiences"
 )
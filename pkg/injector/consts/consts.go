@@ -41,6 +41,10 @@ const (
 	TokenVolumeKubernetesMountPath = "/var/run/secrets/dapr.io/sentrytoken" /* #nosec */ // Mount path for the Kubernetes service account volume with the sentry token.
 	TokenVolumeName                = "dapr-identity-token"                  /* #nosec */ // Name of the volume with the service account token for daprd.
 	ComponentsUDSVolumeName        = "dapr-components-unix-domain-socket"   // Name of the Unix domain socket volume for components.
+	APITokenVolumeName             = "dapr-api-token"                       /* #nosec */ // Name of the volume with the secret of the Dapr API token.
+	APITokenVolumeMountPath        = "/var/run/secrets/dapr.io/api-token"   /* #nosec */ // Mount path for the secret of the Dapr API token.
+	AppTokenVolumeName             = "dapr-app-token"                       /* #nosec */ // Name of the volume with the secret of the app API token.
+	AppTokenVolumeMountPath        = "/var/run/secrets/dapr.io/app-token"   /* #nosec */ // Mount path for the secret of the app API token.
 	ComponentsUDSMountPathEnvVar   = "DAPR_COMPONENT_SOCKETS_FOLDER"
 	ComponentsUDSDefaultFolder     = "/tmp/dapr-components-sockets"
 
@@ -70,8 +70,20 @@ type SidecarConfig struct {
 	LogLevel                            string  `annotation:"dapr.io/log-level" default:"info"`
 	APITokenSecret                      string  `annotation:"dapr.io/api-token-secret"`
 	AppTokenSecret                      string  `annotation:"dapr.io/app-token-secret"`
+	MountTokenSecrets                   bool    `annotation:"dapr.io/mount-token-secrets"`
 	LogAsJSON                           bool    `annotation:"dapr.io/log-as-json"`
 	AppMaxConcurrency                   *int    `annotation:"dapr.io/app-max-concurrency"`
+	AppChannelMaxIdleConns              *int    `annotation:"dapr.io/app-channel-max-idle-conns"`
+	AppChannelMaxConnsPerHost           *int    `annotation:"dapr.io/app-channel-max-conns-per-host"`
+	AppChannelIdleConnTimeout           *string `annotation:"dapr.io/app-channel-idle-conn-timeout"`
+	AppChannelEnableHTTP2               bool    `annotation:"dapr.io/app-channel-enable-http2"`
+	AppChannelTLSSource                 string  `annotation:"dapr.io/app-channel-tls-source"`
+	AppChannelTLSCAFile                 string  `annotation:"dapr.io/app-channel-tls-ca-file"`
+	AppChannelTLSCertFile               string  `annotation:"dapr.io/app-channel-tls-cert-file"`
+	AppChannelTLSKeyFile                string  `annotation:"dapr.io/app-channel-tls-key-file"`
+	AppChannelTLSSecretStore            string  `annotation:"dapr.io/app-channel-tls-secret-store"`
+	AppChannelTLSSecretName             string  `annotation:"dapr.io/app-channel-tls-secret-name"`
+	AppChannelTLSServerName             string  `annotation:"dapr.io/app-channel-tls-server-name"`
 	EnableMetrics                       bool    `annotation:"dapr.io/enable-metrics" default:"true"`
 	SidecarMetricsPort                  int32   `annotation:"dapr.io/metrics-port" default:"9090"`
 	EnableDebug                         bool    `annotation:"dapr.io/enable-debug" default:"false"`
@@ -103,6 +115,7 @@ type SidecarConfig struct {
 	BlockShutdownDuration               *string `annotation:"dapr.io/block-shutdown-duration"`
 	EnableAPILogging                    *bool   `annotation:"dapr.io/enable-api-logging"`
 	UnixDomainSocketPath                string  `annotation:"dapr.io/unix-domain-socket-path"`
+	AppUnixDomainSocket                 string  `annotation:"dapr.io/app-unix-domain-socket"` // Name of the socket file, in the unix-domain-socket-path folder
 	VolumeMounts                        string  `annotation:"dapr.io/volume-mounts"`
 	VolumeMountsRW                      string  `annotation:"dapr.io/volume-mounts-rw"`
 	DisableBuiltinK8sSecretStore        bool    `annotation:"dapr.io/disable-builtin-k8s-secret-store"`
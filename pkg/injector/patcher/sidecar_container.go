@@ -108,6 +108,41 @@ func (c *SidecarConfig) getSidecarContainer(opts getSidecarContainerOpts) (*core
 		args = append(args, "--app-channel-address", c.AppChannelAddress)
 	}
 
+	if c.AppChannelMaxIdleConns != nil {
+		args = append(args, "--app-channel-max-idle-conns", strconv.Itoa(*c.AppChannelMaxIdleConns))
+	}
+	if c.AppChannelMaxConnsPerHost != nil {
+		args = append(args, "--app-channel-max-conns-per-host", strconv.Itoa(*c.AppChannelMaxConnsPerHost))
+	}
+	if c.AppChannelIdleConnTimeout != nil {
+		args = append(args, "--app-channel-idle-conn-timeout", *c.AppChannelIdleConnTimeout)
+	}
+	if c.AppChannelEnableHTTP2 {
+		args = append(args, "--app-channel-enable-http2")
+	}
+
+	if c.AppChannelTLSSource != "" {
+		args = append(args, "--app-channel-tls-source", c.AppChannelTLSSource)
+	}
+	if c.AppChannelTLSCAFile != "" {
+		args = append(args, "--app-channel-tls-ca-file", c.AppChannelTLSCAFile)
+	}
+	if c.AppChannelTLSCertFile != "" {
+		args = append(args, "--app-channel-tls-cert-file", c.AppChannelTLSCertFile)
+	}
+	if c.AppChannelTLSKeyFile != "" {
+		args = append(args, "--app-channel-tls-key-file", c.AppChannelTLSKeyFile)
+	}
+	if c.AppChannelTLSSecretStore != "" {
+		args = append(args, "--app-channel-tls-secret-store", c.AppChannelTLSSecretStore)
+	}
+	if c.AppChannelTLSSecretName != "" {
+		args = append(args, "--app-channel-tls-secret-name", c.AppChannelTLSSecretName)
+	}
+	if c.AppChannelTLSServerName != "" {
+		args = append(args, "--app-channel-tls-server-name", c.AppChannelTLSServerName)
+	}
+
 	// Actor/placement/reminders services
 	// Note that PlacementAddress takes priority over ActorsAddress
 	if strings.TrimSpace(c.PlacementAddress) != "" {
@@ -186,6 +221,11 @@ func (c *SidecarConfig) getSidecarContainer(opts getSidecarContainerOpts) (*core
 		// Note this is a constant path
 		// The passed annotation determines where the socket folder is mounted in the app container, but in the daprd container the path is a constant
 		args = append(args, "--unix-domain-socket", injectorConsts.UnixDomainSocketDaprdPath)
+
+		// The app creates its socket in the same shared folder
+		if c.AppUnixDomainSocket != "" {
+			args = append(args, "--app-unix-domain-socket", path.Join(injectorConsts.UnixDomainSocketDaprdPath, path.Base(c.AppUnixDomainSocket)))
+		}
 	}
 
 	if c.BlockShutdownDuration != nil {
@@ -371,7 +411,12 @@ func (c *SidecarConfig) getSidecarContainer(opts getSidecarContainerOpts) (*core
 		})
 	}
 
-	if c.APITokenSecret != "" {
+	if c.APITokenSecret != "" && c.MountTokenSecrets {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  securityConsts.APITokenEnvVar + "_FILE",
+			Value: injectorConsts.APITokenVolumeMountPath + "/token",
+		})
+	} else if c.APITokenSecret != "" {
 		container.Env = append(container.Env, corev1.EnvVar{
 			Name: securityConsts.APITokenEnvVar,
 			ValueFrom: &corev1.EnvVarSource{
@@ -385,7 +430,12 @@ func (c *SidecarConfig) getSidecarContainer(opts getSidecarContainerOpts) (*core
 		})
 	}
 
-	if c.AppTokenSecret != "" {
+	if c.AppTokenSecret != "" && c.MountTokenSecrets {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  securityConsts.AppAPITokenEnvVar + "_FILE",
+			Value: injectorConsts.AppTokenVolumeMountPath + "/token",
+		})
+	} else if c.AppTokenSecret != "" {
 		container.Env = append(container.Env, corev1.EnvVar{
 			Name: securityConsts.AppAPITokenEnvVar,
 			ValueFrom: &corev1.EnvVarSource{
@@ -624,6 +624,35 @@ func TestGetSidecarContainer(t *testing.T) {
 		assert.Equal(t, corev1.PullAlways, container.ImagePullPolicy)
 	})
 
+	t.Run("get sidecar container with mounted token secrets", func(t *testing.T) {
+		c := NewSidecarConfig(&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					annotations.KeyAppID:             "app_id",
+					annotations.KeyAPITokenSecret:    "secret",
+					annotations.KeyAppTokenSecret:    "appsecret",
+					annotations.KeyMountTokenSecrets: "true",
+				},
+			},
+		})
+		c.Namespace = "dapr-system"
+
+		c.SetFromPodAnnotations()
+
+		container, err := c.getSidecarContainer(getSidecarContainerOpts{})
+		require.NoError(t, err)
+
+		assertEqualJSON(t, container.Env, `[{"name":"NAMESPACE","value":"dapr-system"},{"name":"DAPR_TRUST_ANCHORS"},{"name":"POD_NAME","valueFrom":{"fieldRef":{"fieldPath":"metadata.name"}}},{"name":"DAPR_CONTROLPLANE_NAMESPACE"},{"name":"DAPR_CONTROLPLANE_TRUST_DOMAIN"},{"name":"DAPR_API_TOKEN_FILE","value":"/var/run/secrets/dapr.io/api-token/token"},{"name":"APP_API_TOKEN_FILE","value":"/var/run/secrets/dapr.io/app-token/token"}]`)
+
+		volumes, mounts := c.getTokenSecretVolumes()
+		require.Len(t, volumes, 2)
+		require.Len(t, mounts, 2)
+		assert.Equal(t, "secret", volumes[0].Secret.SecretName)
+		assert.Equal(t, "appsecret", volumes[1].Secret.SecretName)
+		assert.Equal(t, "/var/run/secrets/dapr.io/api-token", mounts[0].MountPath)
+		assert.Equal(t, "/var/run/secrets/dapr.io/app-token", mounts[1].MountPath)
+	})
+
 	t.Run("get sidecar container with custom grpc ports", func(t *testing.T) {
 		c := NewSidecarConfig(&corev1.Pod{
 			ObjectMeta: metav1.ObjectMeta{
@@ -928,6 +957,72 @@ func TestGetSidecarContainer(t *testing.T) {
 				assert.Len(t, container.VolumeMounts, 1)
 				assert.Equal(t, injectorConsts.UnixDomainSocketVolume, container.VolumeMounts[0].Name)
 				assert.Equal(t, "/tmp", container.VolumeMounts[0].MountPath)
+				assert.NotContains(t, container.Args, "--app-unix-domain-socket")
+			},
+		},
+		{
+			name: "set app UDS",
+			annotations: map[string]string{
+				annotations.KeyUnixDomainSocketPath: "/tmp",
+				annotations.KeyAppUnixDomainSocket:  "app.socket",
+			},
+			getSidecarContainerOpts: getSidecarContainerOpts{
+				VolumeMounts: []corev1.VolumeMount{
+					{Name: injectorConsts.UnixDomainSocketVolume, MountPath: "/tmp"},
+				},
+			},
+			assertFn: func(t *testing.T, container *corev1.Container) {
+				args := strings.Join(container.Args, " ")
+				assert.Contains(t, args, "--app-unix-domain-socket "+injectorConsts.UnixDomainSocketDaprdPath+"/app.socket")
+			},
+		},
+		{
+			name: "app UDS requires the UDS path",
+			annotations: map[string]string{
+				annotations.KeyAppUnixDomainSocket: "app.socket",
+			},
+			assertFn: func(t *testing.T, container *corev1.Container) {
+				assert.NotContains(t, container.Args, "--app-unix-domain-socket")
+			},
+		},
+		{
+			name: "set app channel pool options",
+			annotations: map[string]string{
+				annotations.KeyAppChannelMaxIdleConns:    "128",
+				annotations.KeyAppChannelMaxConnsPerHost: "256",
+				annotations.KeyAppChannelIdleConnTimeout: "30s",
+				annotations.KeyAppChannelEnableHTTP2:     "true",
+			},
+			assertFn: func(t *testing.T, container *corev1.Container) {
+				args := strings.Join(container.Args, " ")
+				assert.Contains(t, args, "--app-channel-max-idle-conns 128")
+				assert.Contains(t, args, "--app-channel-max-conns-per-host 256")
+				assert.Contains(t, args, "--app-channel-idle-conn-timeout 30s")
+				assert.Contains(t, container.Args, "--app-channel-enable-http2")
+			},
+		},
+		{
+			name: "set app channel TLS options",
+			annotations: map[string]string{
+				annotations.KeyAppChannelTLSSource:      "secretstore",
+				annotations.KeyAppChannelTLSSecretStore: "kubernetes",
+				annotations.KeyAppChannelTLSSecretName:  "app-tls",
+				annotations.KeyAppChannelTLSServerName:  "myapp.local",
+			},
+			assertFn: func(t *testing.T, container *corev1.Container) {
+				args := strings.Join(container.Args, " ")
+				assert.Contains(t, args, "--app-channel-tls-source secretstore")
+				assert.Contains(t, args, "--app-channel-tls-secret-store kubernetes")
+				assert.Contains(t, args, "--app-channel-tls-secret-name app-tls")
+				assert.Contains(t, args, "--app-channel-tls-server-name myapp.local")
+				assert.NotContains(t, args, "--app-channel-tls-ca-file")
+			},
+		},
+		{
+			name: "app channel pool options are not set by default",
+			assertFn: func(t *testing.T, container *corev1.Container) {
+				args := strings.Join(container.Args, " ")
+				assert.NotContains(t, args, "--app-channel-")
 			},
 		},
 	}))
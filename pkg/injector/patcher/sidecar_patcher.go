@@ -91,6 +91,13 @@ func (c *SidecarConfig) GetPatch() (patchOps jsonpatch.Patch, err error) {
 		})
 	}
 
+	// Secrets with the API tokens, mounted as files so rotated tokens are reloaded by daprd
+	if c.MountTokenSecrets {
+		tokenVolumes, tokenMounts := c.getTokenSecretVolumes()
+		volumes = append(volumes, tokenVolumes...)
+		volumeMounts = append(volumeMounts, tokenMounts...)
+	}
+
 	// Get the sidecar container
 	sidecarContainer, err := c.getSidecarContainer(getSidecarContainerOpts{
 		ComponentsSocketsVolumeMount: componentsSocketVolumeMount,
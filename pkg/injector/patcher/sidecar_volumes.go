@@ -87,6 +87,38 @@ func (c *SidecarConfig) getTokenVolume() corev1.Volume {
 	}
 }
 
+// getTokenSecretVolumes returns the volumes and the daprd mounts for the secrets with the API tokens.
+func (c *SidecarConfig) getTokenSecretVolumes() ([]corev1.Volume, []corev1.VolumeMount) {
+	var (
+		volumes []corev1.Volume
+		mounts  []corev1.VolumeMount
+	)
+	add := func(name, secret, mountPath string) {
+		volumes = append(volumes, corev1.Volume{
+			Name: name,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: secret,
+					Items:      []corev1.KeyToPath{{Key: "token", Path: "token"}},
+				},
+			},
+		})
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      name,
+			MountPath: mountPath,
+			ReadOnly:  true,
+		})
+	}
+
+	if c.APITokenSecret != "" {
+		add(injectorConsts.APITokenVolumeName, c.APITokenSecret, injectorConsts.APITokenVolumeMountPath)
+	}
+	if c.AppTokenSecret != "" {
+		add(injectorConsts.AppTokenVolumeName, c.AppTokenSecret, injectorConsts.AppTokenVolumeMountPath)
+	}
+	return volumes, mounts
+}
+
 func addVolumeMountToContainers(containers map[int]corev1.Container, addMounts corev1.VolumeMount) jsonpatch.Patch {
 	volumeMount := []corev1.VolumeMount{addMounts}
 	volumeMountPatchOps := make(jsonpatch.Patch, 0, len(containers))
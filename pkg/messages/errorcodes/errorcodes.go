@@ -64,6 +64,7 @@ var (
 	ErrActorRuntimeClosed         = ErrorCode{"ERR_ACTOR_RUNTIME_CLOSED", "", CategoryActor}          // Actor runtime is closed
 	ErrActorNamespaceRequired     = ErrorCode{"ERR_ACTOR_NAMESPACE_REQUIRED", "", CategoryActor}      // Actors must have a namespace configured when running in Kubernetes mode
 	ErrActorNoAddress             = ErrorCode{"ERR_ACTOR_NO_ADDRESS", "", CategoryActor}              // No address found for actor
+	ErrActorReadReplica           = ErrorCode{"ERR_ACTOR_READ_REPLICA", "", CategoryActor}            // Write operation by a read replica of an actor
 
 	// ### Workflows API
 	WorkflowGet                       = ErrorCode{"ERR_GET_WORKFLOW", "", CategoryWorkflow}                 // Error getting workflow
@@ -72,7 +73,12 @@ var (
 	WorkflowResume                    = ErrorCode{"ERR_RESUME_WORKFLOW", "", CategoryWorkflow}              // Error resuming workflow
 	WorkflowTerminate                 = ErrorCode{"ERR_TERMINATE_WORKFLOW", "", CategoryWorkflow}           // Error terminating workflow
 	WorkflowPurge                     = ErrorCode{"ERR_PURGE_WORKFLOW", "", CategoryWorkflow}               // Error purging workflow
+	WorkflowList                      = ErrorCode{"ERR_LIST_WORKFLOWS", "", CategoryWorkflow}               // Error listing workflows
+	WorkflowScheduleDelete            = ErrorCode{"ERR_DELETE_WORKFLOW_SCHEDULE", "", CategoryWorkflow}     // Error deleting workflow schedule
 	WorkflowRaiseEvent                = ErrorCode{"ERR_RAISE_EVENT_WORKFLOW", "", CategoryWorkflow}         // Error raising event in workflow
+	WorkflowRewind                    = ErrorCode{"ERR_REWIND_WORKFLOW", "", CategoryWorkflow}              // Error rewinding workflow
+	WorkflowCheckpoint                = ErrorCode{"ERR_PAUSE_WORKFLOW_AT_CHECKPOINT", "", CategoryWorkflow} // Error pausing workflow at checkpoint
+	WorkflowCheckpointMissing         = ErrorCode{"ERR_WORKFLOW_CHECKPOINT_MISSING", "", CategoryWorkflow}  // Missing workflow checkpoint name
 	WorkflowComponentMissing          = ErrorCode{"ERR_WORKFLOW_COMPONENT_MISSING", "", CategoryWorkflow}   // Missing workflow component
 	WorkflowComponentNotFound         = ErrorCode{"ERR_WORKFLOW_COMPONENT_NOT_FOUND", "", CategoryWorkflow} // Workflow component not found
 	WorkflowEventNameMissing          = ErrorCode{"ERR_WORKFLOW_EVENT_NAME_MISSING", "", CategoryWorkflow}  // Missing workflow event name
@@ -97,6 +103,22 @@ var (
 	StateStoreQueryNotSupported        = ErrorCode{"ERR_STATE_STORE_NOT_SUPPORTED", "DAPR_STATE_QUERYING_NOT_SUPPORTED", CategoryState}        // State store does not support querying
 	StateStoreTooManyTransactions      = ErrorCode{"ERR_STATE_STORE_TOO_MANY_TRANSACTIONS", "DAPR_STATE_TOO_MANY_TRANSACTIONS", CategoryState} // Too many operations per transaction
 	StateMalformedRequest              = ErrorCode{"ERR_MALFORMED_REQUEST", "DAPR_STATE_ILLEGAL_KEY", CategoryState}                           // Invalid key
+	StateAtomicNotSupported            = ErrorCode{"ERR_STATE_STORE_NOT_SUPPORTED", "DAPR_STATE_ATOMIC_NOT_SUPPORTED", CategoryState}          // State store does not support atomic operations
+	StateAtomicRequest                 = ErrorCode{"ERR_STATE_ATOMIC_REQUEST", "DAPR_STATE_ATOMIC_INVALID", CategoryState}                     // Invalid atomic operation request or stored value
+	StateAtomic                        = ErrorCode{"ERR_STATE_ATOMIC", "DAPR_STATE_ATOMIC_FAILED", CategoryState}                              // Error executing an atomic operation
+	StateWatchRequest                  = ErrorCode{"ERR_STATE_WATCH_REQUEST", "DAPR_STATE_WATCH_INVALID", CategoryState}                       // Invalid state watch request
+	StateWatch                         = ErrorCode{"ERR_STATE_WATCH", "DAPR_STATE_WATCH_FAILED", CategoryState}                                // Error watching state changes
+	StateQueryRequest                  = ErrorCode{"ERR_STATE_QUERY_REQUEST", "DAPR_STATE_QUERY_INVALID", CategoryState}                       // Invalid state query
+	StateReencryptionRequest           = ErrorCode{"ERR_STATE_REENCRYPTION_REQUEST", "DAPR_STATE_REENCRYPTION_INVALID", CategoryState}         // Invalid request to re-encrypt a state store
+	StateReencryptionRunning           = ErrorCode{"ERR_STATE_REENCRYPTION_RUNNING", "DAPR_STATE_REENCRYPTION_RUNNING", CategoryState}         // A re-encryption of the state store is already running
+	StateReencryptionNotFound          = ErrorCode{"ERR_STATE_REENCRYPTION_NOT_FOUND", "DAPR_STATE_REENCRYPTION_NOT_FOUND", CategoryState}     // No re-encryption of the state store has been started
+	StateMigrationRequest              = ErrorCode{"ERR_STATE_MIGRATION_REQUEST", "DAPR_STATE_MIGRATION_INVALID", CategoryState}               // Invalid request to migrate a state store
+	StateMigrationRunning              = ErrorCode{"ERR_STATE_MIGRATION_RUNNING", "DAPR_STATE_MIGRATION_RUNNING", CategoryState}               // A migration of the state store is already running
+	StateMigrationNotFound             = ErrorCode{"ERR_STATE_MIGRATION_NOT_FOUND", "DAPR_STATE_MIGRATION_NOT_FOUND", CategoryState}           // No migration of the state store has been started
+	StateCheckAndSetNotSupported       = ErrorCode{"ERR_STATE_STORE_NOT_SUPPORTED", "DAPR_STATE_CHECK_AND_SET_NOT_SUPPORTED", CategoryState}   // State store does not support check-and-set
+	StateCheckAndSetRequest            = ErrorCode{"ERR_STATE_CHECK_AND_SET_REQUEST", "DAPR_STATE_CHECK_AND_SET_INVALID", CategoryState}       // Invalid check-and-set request
+	StateCheckAndSetConflict           = ErrorCode{"ERR_STATE_CHECK_AND_SET_CONFLICT", "DAPR_STATE_CHECK_AND_SET_CONFLICT", CategoryState}     // The ETag of a checked key doesn't match
+	StateCheckAndSet                   = ErrorCode{"ERR_STATE_CHECK_AND_SET", "DAPR_STATE_CHECK_AND_SET_FAILED", CategoryState}                // Error executing a check-and-set
 
 	// ### Configuration API
 	ConfigurationGet                = ErrorCode{"ERR_CONFIGURATION_GET", "", CategoryConfiguration}                  // Error getting configuration
@@ -110,6 +132,7 @@ var (
 	CryptoKey                    = ErrorCode{"ERR_CRYPTO_KEY", "", CategoryCrypto}                      // Error retrieving crypto key
 	CryptoProviderNotFound       = ErrorCode{"ERR_CRYPTO_PROVIDER_NOT_FOUND", "", CategoryCrypto}       // Crypto provider not found
 	CryptoProvidersNotConfigured = ErrorCode{"ERR_CRYPTO_PROVIDERS_NOT_CONFIGURED", "", CategoryCrypto} // Crypto providers not configured
+	CryptoAlgorithmNotSupported  = ErrorCode{"ERR_CRYPTO_ALGORITHM_NOT_SUPPORTED", "", CategoryCrypto}  // Algorithm not supported by the crypto provider
 
 	// ### Secrets API
 	SecretGet                = ErrorCode{"ERR_SECRET_GET", "", CategorySecret}                   // Error getting secret
@@ -131,6 +154,12 @@ var (
 	PubSubEventsMarshalEvents   = ErrorCode{"ERR_PUBSUB_EVENTS_SER", "DAPR_PUBSUB_MARSHAL_EVENTS", CategoryPubsub}                 // Error marshalling events to bytes
 	PubSubEventsUnmarshalEvents = ErrorCode{"ERR_PUBSUB_EVENTS_SER", "DAPR_PUBSUB_UNMARSHAL_EVENTS", CategoryPubsub}               // Error unmarshalling events
 	PubsubPublishOutbox         = ErrorCode{"ERR_PUBLISH_OUTBOX", "", CategoryPubsub}                                              // Error publishing message to outbox
+	PubsubRedrive               = ErrorCode{"ERR_PUBSUB_REDRIVE", "DAPR_PUBSUB_REDRIVE", CategoryPubsub}                           // Error redriving messages from a dead letter topic
+	PubsubRedriveRequest        = ErrorCode{"ERR_PUBSUB_REDRIVE_REQUEST", "DAPR_PUBSUB_REDRIVE_REQUEST", CategoryPubsub}           // Invalid redrive request
+	PubsubSchemaValidation      = ErrorCode{"ERR_PUBSUB_SCHEMA_VALIDATION", "DAPR_PUBSUB_SCHEMA_VALIDATION", CategoryPubsub}       // Message does not match the topic schema
+	PubsubStreamSubscribe       = ErrorCode{"ERR_PUBSUB_STREAM_SUBSCRIBE", "DAPR_PUBSUB_STREAM_SUBSCRIBE", CategoryPubsub}         // Error starting a streaming subscription
+	PubsubStreamNotFound        = ErrorCode{"ERR_PUBSUB_STREAM_NOT_FOUND", "DAPR_PUBSUB_STREAM_NOT_FOUND", CategoryPubsub}         // Streaming subscription not found
+	PubsubStreamAck             = ErrorCode{"ERR_PUBSUB_STREAM_ACK", "DAPR_PUBSUB_STREAM_ACK", CategoryPubsub}                     // Invalid acknowledgement of a streamed message
 
 	// ### Conversation API
 	ConversationInvalidParms  = ErrorCode{"ERR_CONVERSATION_INVALID_PARMS", "", CategoryConversation}  // Invalid parameters for conversation component
@@ -142,28 +171,38 @@ var (
 	ServiceInvocationDirectInvoke = ErrorCode{"ERR_DIRECT_INVOKE", "", CategoryServiceInvocation} // Error invoking service
 
 	// ### Bindings API
-	BindingInvokeOutputBinding = ErrorCode{"ERR_INVOKE_OUTPUT_BINDING", "", CategoryBinding} // Error invoking output binding
+	BindingInvokeOutputBinding = ErrorCode{"ERR_INVOKE_OUTPUT_BINDING", "", CategoryBinding}   // Error invoking output binding
+	BindingInputNotFound       = ErrorCode{"ERR_INPUT_BINDING_NOT_FOUND", "", CategoryBinding} // Input binding not found
+	BindingInputControl        = ErrorCode{"ERR_INPUT_BINDING_CONTROL", "", CategoryBinding}   // Error pausing or resuming input binding
 
 	// ### Distributed Lock API
-	LockStoreNotConfigured = ErrorCode{"ERR_LOCK_STORE_NOT_CONFIGURED", "", CategoryLock} // Lock store not configured
-	LockStoreNotFound      = ErrorCode{"ERR_LOCK_STORE_NOT_FOUND", "", CategoryLock}      // Lock store not found
-	LockTry                = ErrorCode{"ERR_TRY_LOCK", "", CategoryLock}                  // Error acquiring lock
-	LockUnlock             = ErrorCode{"ERR_UNLOCK", "", CategoryLock}                    // Error releasing lock
+	LockStoreNotConfigured    = ErrorCode{"ERR_LOCK_STORE_NOT_CONFIGURED", "", CategoryLock}     // Lock store not configured
+	LockStoreNotFound         = ErrorCode{"ERR_LOCK_STORE_NOT_FOUND", "", CategoryLock}          // Lock store not found
+	LockTry                   = ErrorCode{"ERR_TRY_LOCK", "", CategoryLock}                      // Error acquiring lock
+	LockUnlock                = ErrorCode{"ERR_UNLOCK", "", CategoryLock}                        // Error releasing lock
+	LockRenew                 = ErrorCode{"ERR_RENEW_LOCK", "", CategoryLock}                    // Error renewing lock
+	LockAutoRenewNotAvailable = ErrorCode{"ERR_LOCK_AUTO_RENEW_NOT_AVAILABLE", "", CategoryLock} // Lock auto-renewal is not available
+	LockSharedNotSupported    = ErrorCode{"ERR_LOCK_SHARED_NOT_SUPPORTED", "", CategoryLock}     // Lock store doesn't support shared locks
 
 	// ### Healthz
 	HealthNotReady         = ErrorCode{"ERR_HEALTH_NOT_READY", "", CategoryHealth}          // Dapr not ready
 	HealthAppidNotMatch    = ErrorCode{"ERR_HEALTH_APPID_NOT_MATCH", "", CategoryHealth}    // Dapr  App ID does not match
 	HealthOutboundNotReady = ErrorCode{"ERR_OUTBOUND_HEALTH_NOT_READY", "", CategoryHealth} // Dapr outbound not ready
+	HealthDraining         = ErrorCode{"ERR_DRAINING", "", CategoryHealth}                  // Dapr is draining
 
 	// ### Common
+	CommonAPIAccessDenied      = ErrorCode{"ERR_API_ACCESS_DENIED", "", CategoryCommon}      // API access denied by policy
 	CommonAPIUnimplemented     = ErrorCode{"ERR_API_UNIMPLEMENTED", "", CategoryCommon}      // API not implemented
+	CommonAPITokenRequired     = ErrorCode{"ERR_API_TOKEN_REQUIRED", "", CategoryCommon}     // Administrative API requires the Dapr API token
 	CommonAppChannelNil        = ErrorCode{"ERR_APP_CHANNEL_NIL", "", CategoryCommon}        // App channel is nil
 	CommonBadRequest           = ErrorCode{"ERR_BAD_REQUEST", "", CategoryCommon}            // Bad request
 	CommonBodyRead             = ErrorCode{"ERR_BODY_READ", "", CategoryCommon}              // Error reading request body
+	CommonBodyTooLarge         = ErrorCode{"ERR_BODY_TOO_LARGE", "", CategoryCommon}         // Request body exceeds the size limit
 	CommonInternal             = ErrorCode{"ERR_INTERNAL", "", CategoryCommon}               // Internal error
 	CommonMalformedRequest     = ErrorCode{"ERR_MALFORMED_REQUEST", "", CategoryCommon}      // Malformed request
 	CommonMalformedRequestData = ErrorCode{"ERR_MALFORMED_REQUEST_DATA", "", CategoryCommon} // Malformed request data
 	CommonMalformedResponse    = ErrorCode{"ERR_MALFORMED_RESPONSE", "", CategoryCommon}     // Malformed response
+	CommonTooManyRequests      = ErrorCode{"ERR_TOO_MANY_REQUESTS", "", CategoryCommon}      // API rate limit exceeded
 
 	// ### Scheduler/Jobs API
 	SchedulerScheduleJob   = ErrorCode{"DAPR_SCHEDULER_SCHEDULE_JOB", "DAPR_SCHEDULER_SCHEDULE_JOB", CategoryJob}     // Error scheduling job
@@ -172,8 +211,11 @@ var (
 	SchedulerGetJob        = ErrorCode{"DAPR_SCHEDULER_GET_JOB", "DAPR_SCHEDULER_GET_JOB", CategoryJob}               // Error getting job
 	SchedulerListJobs      = ErrorCode{"DAPR_SCHEDULER_LIST_JOBS", "DAPR_SCHEDULER_LIST_JOBS", CategoryJob}           // Error listing jobs
 	SchedulerDeleteJob     = ErrorCode{"DAPR_SCHEDULER_DELETE_JOB", "DAPR_SCHEDULER_DELETE_JOB", CategoryJob}         // Error deleting job
+	SchedulerPauseJob      = ErrorCode{"DAPR_SCHEDULER_PAUSE_JOB", "DAPR_SCHEDULER_PAUSE_JOB", CategoryJob}           // Error pausing job
+	SchedulerResumeJob     = ErrorCode{"DAPR_SCHEDULER_RESUME_JOB", "DAPR_SCHEDULER_RESUME_JOB", CategoryJob}         // Error resuming job
 	SchedulerEmpty         = ErrorCode{"DAPR_SCHEDULER_EMPTY", "DAPR_SCHEDULER_EMPTY", CategoryJob}                   // Required argument is empty
 	SchedulerScheduleEmpty = ErrorCode{"DAPR_SCHEDULER_SCHEDULE_EMPTY", "DAPR_SCHEDULER_SCHEDULE_EMPTY", CategoryJob} // No schedule provided for job
+	SchedulerQuotaExceeded = ErrorCode{"DAPR_SCHEDULER_QUOTA_EXCEEDED", "DAPR_SCHEDULER_QUOTA_EXCEEDED", CategoryJob} // Job quota of the app or namespace exceeded
 
 	// ### Generic
 	CommonGeneric = ErrorCode{"ERROR", "ERROR", CategoryCommon} // Generic error
@@ -62,20 +62,30 @@ var (
 	// Generic.
 	ErrBadRequest       = APIError{"invalid request: %v", errorcodes.CommonBadRequest, http.StatusBadRequest, grpcCodes.InvalidArgument}
 	ErrAPIUnimplemented = APIError{"this API is currently not implemented", errorcodes.CommonAPIUnimplemented, http.StatusNotImplemented, grpcCodes.Unimplemented}
+	ErrAPIAccessDenied  = APIError{"access to the requested API is denied by the API access policy", errorcodes.CommonAPIAccessDenied, http.StatusForbidden, grpcCodes.PermissionDenied}
+	ErrAPITokenRequired = APIError{"this API is only available when the Dapr API token is enabled", errorcodes.CommonAPITokenRequired, http.StatusForbidden, grpcCodes.PermissionDenied}
+	ErrAPIRateLimited   = APIError{"rate limit exceeded for the %s API", errorcodes.CommonTooManyRequests, http.StatusTooManyRequests, grpcCodes.ResourceExhausted}
+	ErrBodyTooLarge     = APIError{"request body exceeds the limit of %d bytes for the %s API", errorcodes.CommonBodyTooLarge, http.StatusRequestEntityTooLarge, grpcCodes.ResourceExhausted}
 
 	// HTTP.
 	ErrBodyRead         = APIError{"failed to read request body: %v", errorcodes.CommonBodyRead, http.StatusBadRequest, grpcCodes.InvalidArgument}
 	ErrMalformedRequest = APIError{"failed deserializing HTTP body: %v", errorcodes.CommonMalformedRequest, http.StatusBadRequest, grpcCodes.InvalidArgument}
 
 	// DirectMessaging.
-	ErrDirectInvoke         = APIError{"failed to invoke, id: %s, err: %v", errorcodes.ServiceInvocationDirectInvoke, http.StatusInternalServerError, grpcCodes.Internal}
-	ErrDirectInvokeNoAppID  = APIError{"failed getting app id either from the URL path or the header dapr-app-id", errorcodes.ServiceInvocationDirectInvoke, http.StatusNotFound, grpcCodes.NotFound}
-	ErrDirectInvokeNotReady = APIError{"invoke API is not ready", errorcodes.ServiceInvocationDirectInvoke, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrDirectInvoke          = APIError{"failed to invoke, id: %s, err: %v", errorcodes.ServiceInvocationDirectInvoke, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrDirectInvokeNoAppID   = APIError{"failed getting app id either from the URL path or the header dapr-app-id", errorcodes.ServiceInvocationDirectInvoke, http.StatusNotFound, grpcCodes.NotFound}
+	ErrDirectInvokeNotReady  = APIError{"invoke API is not ready", errorcodes.ServiceInvocationDirectInvoke, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrDirectInvokeWebSocket = APIError{"websocket connections are not supported by target: %s", errorcodes.ServiceInvocationDirectInvoke, http.StatusNotImplemented, grpcCodes.Unimplemented}
+
+	// Bindings.
+	ErrInputBindingNotFound = APIError{"input binding %s not found", errorcodes.BindingInputNotFound, http.StatusNotFound, grpcCodes.NotFound}
+	ErrInputBindingControl  = APIError{"error %s input binding %s: %v", errorcodes.BindingInputControl, http.StatusInternalServerError, grpcCodes.Internal}
 
 	// Healthz.
 	ErrHealthNotReady         = APIError{"dapr is not ready: %v", errorcodes.HealthNotReady, http.StatusInternalServerError, grpcCodes.Internal}
-	ErrOutboundHealthNotReady = APIError{"dapr outbound is not ready", errorcodes.HealthOutboundNotReady, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrOutboundHealthNotReady = APIError{"dapr outbound is not ready: %v", errorcodes.HealthOutboundNotReady, http.StatusInternalServerError, grpcCodes.Internal}
 	ErrHealthAppIDNotMatch    = APIError{"dapr app-id does not match", errorcodes.HealthAppidNotMatch, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrDraining               = APIError{"dapr is draining and not accepting new requests", errorcodes.HealthDraining, http.StatusServiceUnavailable, grpcCodes.Unavailable}
 
 	// Secrets.
 	ErrSecretStoreNotConfigured = APIError{"secret store is not configured", errorcodes.SecretStoreNotConfigured, http.StatusInternalServerError, grpcCodes.FailedPrecondition}
@@ -89,6 +99,7 @@ var (
 	ErrCryptoProviderNotFound       = APIError{"crypto provider %s not found", errorcodes.CryptoProviderNotFound, http.StatusBadRequest, grpcCodes.InvalidArgument}
 	ErrCryptoGetKey                 = APIError{"failed to retrieve key %s: %v", errorcodes.CryptoKey, http.StatusInternalServerError, grpcCodes.Internal}
 	ErrCryptoOperation              = APIError{"failed to perform operation: %v", errorcodes.Crypto, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrCryptoAlgorithmNotSupported  = APIError{"crypto provider %s doesn't support the algorithm %s", errorcodes.CryptoAlgorithmNotSupported, http.StatusNotImplemented, grpcCodes.Unimplemented}
 
 	// Actor.
 	ErrActorReminderOpActorNotHosted = APIError{"operations on actor reminders are only possible on hosted actor types", errorcodes.ActorReminderNonHosted, http.StatusForbidden, grpcCodes.PermissionDenied}
@@ -109,6 +120,7 @@ var (
 	ErrActorRuntimeClosed            = APIError{"actor runtime is closed", errorcodes.ErrActorRuntimeClosed, http.StatusServiceUnavailable, grpcCodes.Unavailable}
 	ErrActorNamespaceRequired        = APIError{"actors must have a namespace configured when running in Kubernetes mode", errorcodes.ErrActorNamespaceRequired, http.StatusPreconditionFailed, grpcCodes.FailedPrecondition}
 	ErrActorNoAddress                = APIError{"did not find address for actor", errorcodes.ErrActorNoAddress, http.StatusNotFound, grpcCodes.FailedPrecondition}
+	ErrActorReadReplica              = APIError{"actor %s is activated as a read replica, which can't save state or create reminders and timers", errorcodes.ErrActorReadReplica, http.StatusForbidden, grpcCodes.FailedPrecondition}
 
 	// Lock.
 	ErrLockStoresNotConfigured    = APIError{"lock store is not configured", errorcodes.LockStoreNotConfigured, http.StatusInternalServerError, grpcCodes.FailedPrecondition}
@@ -118,6 +130,10 @@ var (
 	ErrLockStoreNotFound          = APIError{"lock store %s not found", errorcodes.LockStoreNotFound, http.StatusBadRequest, grpcCodes.InvalidArgument}
 	ErrTryLockFailed              = APIError{"failed to try acquiring lock: %s", errorcodes.LockTry, http.StatusInternalServerError, grpcCodes.Internal}
 	ErrUnlockFailed               = APIError{"failed to release lock: %s", errorcodes.LockUnlock, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrRenewLockFailed            = APIError{"failed to renew lock: %s", errorcodes.LockRenew, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrMaxHoldSecondsNotPositive  = APIError{"MaxHoldSeconds is not positive in lock store %s", errorcodes.CommonMalformedRequest, http.StatusBadRequest, grpcCodes.InvalidArgument}
+	ErrLockAutoRenewNotAvailable  = APIError{"lock auto-renewal is not available", errorcodes.LockAutoRenewNotAvailable, http.StatusNotImplemented, grpcCodes.Unimplemented}
+	ErrLockSharedNotSupported     = APIError{"lock store %s doesn't support shared locks", errorcodes.LockSharedNotSupported, http.StatusNotImplemented, grpcCodes.Unimplemented}
 
 	// Workflow.
 	ErrStartWorkflow                 = APIError{"error starting workflow '%s': %s", errorcodes.WorkflowStart, http.StatusInternalServerError, grpcCodes.Internal}
@@ -135,6 +151,11 @@ var (
 	ErrPauseWorkflow                 = APIError{"error pausing workflow %s: %s", errorcodes.WorkflowPause, http.StatusInternalServerError, grpcCodes.Internal}
 	ErrResumeWorkflow                = APIError{"error resuming workflow %s: %s", errorcodes.WorkflowResume, http.StatusInternalServerError, grpcCodes.Internal}
 	ErrPurgeWorkflow                 = APIError{"error purging workflow %s: %s", errorcodes.WorkflowPurge, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrListWorkflows                 = APIError{"error listing workflows: %s", errorcodes.WorkflowList, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrDeleteWorkflowSchedule        = APIError{"error deleting workflow schedule %s: %s", errorcodes.WorkflowScheduleDelete, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrRewindWorkflow                = APIError{"error rewinding workflow %s: %s", errorcodes.WorkflowRewind, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrMissingWorkflowCheckpoint     = APIError{"missing workflow checkpoint name", errorcodes.WorkflowCheckpointMissing, http.StatusBadRequest, grpcCodes.InvalidArgument}
+	ErrPauseWorkflowAtCheckpoint     = APIError{"error pausing workflow %s at checkpoint: %s", errorcodes.WorkflowCheckpoint, http.StatusInternalServerError, grpcCodes.Internal}
 
 	// Conversation
 	ErrConversationNotFound      = APIError{"failed finding conversation component %s", errorcodes.ConversationNotFound, http.StatusBadRequest, grpcCodes.InvalidArgument}
@@ -180,6 +180,12 @@ func (imr *InvokeMethodRequest) WithReplay(enabled bool) *InvokeMethodRequest {
 	return imr
 }
 
+// WithMaxReplaySize sets the maximum number of bytes buffered to replay the data stream; 0 means no limit.
+func (imr *InvokeMethodRequest) WithMaxReplaySize(size int) *InvokeMethodRequest {
+	imr.replayableRequest.SetMaxReplaySize(size)
+	return imr
+}
+
 // WithHTTPResponseWriter enables downstream channel implementations to stream data back to the caller.
 func (imr *InvokeMethodRequest) WithHTTPResponseWriter(rw http.ResponseWriter) *InvokeMethodRequest {
 	imr.httpResponseWriter = rw
@@ -121,6 +121,12 @@ func (imr *InvokeMethodResponse) WithReplay(enabled bool) *InvokeMethodResponse
 	return imr
 }
 
+// WithMaxReplaySize sets the maximum number of bytes buffered to replay the data stream; 0 means no limit.
+func (imr *InvokeMethodResponse) WithMaxReplaySize(size int) *InvokeMethodResponse {
+	imr.replayableRequest.SetMaxReplaySize(size)
+	return imr
+}
+
 // CanReplay returns true if the data stream can be replayed.
 func (imr *InvokeMethodResponse) CanReplay() bool {
 	// We can replay if:
@@ -15,9 +15,17 @@ package v1
 
 import (
 	"context"
+	"net/http"
 )
 
 // DirectMessaging is the API interface for invoking a remote app.
 type DirectMessaging interface {
 	Invoke(ctx context.Context, targetAppID string, req *InvokeMethodRequest) (*InvokeMethodResponse, error)
 }
+
+// WebSocketDirectMessaging is implemented by DirectMessaging implementations that can open WebSocket connections to apps.
+type WebSocketDirectMessaging interface {
+	// DialWebSocket sends the WebSocket upgrade request to the target app, either local or remote.
+	// If the app accepts the upgrade, the response has status 101 and its body is the upgraded connection, as an io.ReadWriteCloser.
+	DialWebSocket(ctx context.Context, targetAppID string, req *InvokeMethodRequest) (*http.Response, error)
+}
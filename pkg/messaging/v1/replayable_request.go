@@ -15,6 +15,7 @@ package v1
 
 import (
 	"bytes"
+	"errors"
 	"io"
 	"sync"
 
@@ -32,6 +33,9 @@ var (
 	bsPool  = byteslicepool.NewByteSlicePool(minByteSliceCapacity)
 )
 
+// ErrReplayBufferExceeded is returned when a data stream cannot be replayed because it was larger than the maximum replay buffer size.
+var ErrReplayBufferExceeded = errors.New("cannot replay the data stream: the maximum replay buffer size was exceeded")
+
 func newBuffer() any {
 	return new(bytes.Buffer)
 }
@@ -43,6 +47,10 @@ type replayableRequest struct {
 	lock             sync.Mutex
 	currentTeeReader *streamutils.TeeReadCloser
 	currentData      []byte
+	// Maximum number of bytes kept in the replay buffer; 0 means no limit.
+	maxReplaySize int
+	// Set when the data read exceeded maxReplaySize, so the stream can't be replayed anymore.
+	replayExceeded bool
 }
 
 // WithRawData sets message data.
@@ -72,24 +80,44 @@ func (rr *replayableRequest) SetReplay(enabled bool) {
 	}
 }
 
+// SetMaxReplaySize sets the maximum number of bytes buffered to replay the data stream.
+// Once more data than that is read, the stream can't be replayed anymore, which bounds the memory used by requests with retries.
+// A value of 0 means no limit.
+func (rr *replayableRequest) SetMaxReplaySize(size int) {
+	rr.lock.Lock()
+	defer rr.lock.Unlock()
+
+	rr.maxReplaySize = size
+}
+
 // CanReplay returns true if the data stream can be replayed.
 func (rr *replayableRequest) CanReplay() bool {
-	return rr.replay != nil
+	rr.lock.Lock()
+	defer rr.lock.Unlock()
+
+	return rr.replay != nil && !rr.replayExceeded
 }
 
 // RawData returns the stream body.
 func (rr *replayableRequest) RawData() (r io.Reader) {
-	rr.lock.Lock()
-	defer rr.lock.Unlock()
-
 	// If there's a previous TeeReadCloser, stop it so readers won't add more data into its replay buffer
-	if rr.currentTeeReader != nil {
-		_ = rr.currentTeeReader.Stop()
+	// This is done without holding the lock, as the TeeReadCloser holds its own lock while it writes into the replay buffer, which acquires ours
+	rr.lock.Lock()
+	prevTeeReader := rr.currentTeeReader
+	rr.lock.Unlock()
+	if prevTeeReader != nil {
+		_ = prevTeeReader.Stop()
 	}
 
+	rr.lock.Lock()
+	defer rr.lock.Unlock()
+
 	if rr.data == nil {
 		// If there's no data, and there's never been, just return a reader with no data
 		r = bytes.NewReader(nil)
+	} else if rr.replayExceeded {
+		// The data was already consumed and it didn't fit in the replay buffer
+		r = &errorReader{err: ErrReplayBufferExceeded}
 	} else if rr.replay != nil {
 		// If there's replaying enabled, we need to create a new TeeReadCloser
 		// We need to copy the data read insofar from the reply buffer because the buffer becomes invalid after new data is written into the it, then reset the buffer
@@ -111,7 +139,7 @@ func (rr *replayableRequest) RawData() (r io.Reader) {
 			bytes.NewReader(rr.currentData[0:l]),
 			rr.data,
 		)
-		rr.currentTeeReader = streamutils.NewTeeReadCloser(mr, rr.replay)
+		rr.currentTeeReader = streamutils.NewTeeReadCloser(mr, &replayWriter{rr: rr})
 		r = rr.currentTeeReader
 	} else {
 		// No replay enabled
@@ -121,6 +149,37 @@ func (rr *replayableRequest) RawData() (r io.Reader) {
 	return r
 }
 
+// replayWriter writes the data read into the replay buffer, until the maximum replay size is exceeded.
+type replayWriter struct {
+	rr *replayableRequest
+}
+
+func (w *replayWriter) Write(p []byte) (int, error) {
+	rr := w.rr
+	rr.lock.Lock()
+	defer rr.lock.Unlock()
+
+	if rr.replayExceeded || rr.replay == nil {
+		return len(p), nil
+	}
+	if rr.maxReplaySize > 0 && rr.replay.Len()+len(p) > rr.maxReplaySize {
+		// Stop buffering and release the memory; the current read is not affected
+		rr.replayExceeded = true
+		rr.replay.Reset()
+		return len(p), nil
+	}
+	return rr.replay.Write(p)
+}
+
+// errorReader is an io.Reader that always returns an error.
+type errorReader struct {
+	err error
+}
+
+func (r *errorReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
+
 func (rr *replayableRequest) closeReplay() {
 	// Return the buffer and byte slice to the pools if we got one
 	if rr.replay != nil {
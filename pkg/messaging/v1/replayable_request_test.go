@@ -19,6 +19,7 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"runtime"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -218,6 +219,62 @@ func TestReplayableRequest(t *testing.T) {
 	})
 }
 
+func TestReplayableRequestMaxReplaySize(t *testing.T) {
+	message := make([]byte, 100<<10)
+	_, err := io.ReadFull(rand.Reader, message)
+	require.NoError(t, err)
+
+	newReplayable := func(maxSize int) *replayableRequest {
+		rr := &replayableRequest{}
+		rr.WithRawData(newReaderCloser(bytes.NewReader(message)))
+		rr.SetReplay(true)
+		rr.SetMaxReplaySize(maxSize)
+		return rr
+	}
+
+	t.Run("data within the limit can be replayed", func(t *testing.T) {
+		rr := newReplayable(200 << 10)
+		defer rr.Close()
+
+		for range 2 {
+			read, err := io.ReadAll(rr.RawData())
+			require.NoError(t, err)
+			assert.Equal(t, message, read)
+			assert.True(t, rr.CanReplay())
+		}
+	})
+
+	t.Run("data over the limit is read once", func(t *testing.T) {
+		rr := newReplayable(10 << 10)
+		defer rr.Close()
+
+		read, err := io.ReadAll(rr.RawData())
+		require.NoError(t, err)
+		assert.Equal(t, message, read)
+		assert.False(t, rr.CanReplay())
+		assert.Equal(t, 0, rr.replay.Len())
+
+		_, err = io.ReadAll(rr.RawData())
+		require.ErrorIs(t, err, ErrReplayBufferExceeded)
+	})
+
+	t.Run("replay state can be checked while the data is read", func(t *testing.T) {
+		rr := newReplayable(10 << 10)
+		defer rr.Close()
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_, _ = io.ReadAll(rr.RawData())
+		}()
+		for rr.CanReplay() {
+			runtime.Gosched()
+		}
+		<-done
+		assert.False(t, rr.CanReplay())
+	})
+}
+
 // readerCloser is a io.Reader that can be closed. Once the stream is closed, reading from it returns an error.
 type readerCloser struct {
 	r      io.Reader
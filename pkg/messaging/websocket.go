@@ -0,0 +1,198 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/dapr/dapr/pkg/channel"
+	"github.com/dapr/dapr/pkg/config"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+)
+
+// DialWebSocket sends the WebSocket upgrade request to the target app.
+// Connections to remote apps are carried over a CallLocalWebSocket stream to the target's sidecar, which checks the access control policies before dialing its app.
+// The returned response has status 101 if the app accepted the upgrade, in which case its body is the upgraded connection, as an io.ReadWriteCloser.
+func (d *directMessaging) DialWebSocket(ctx context.Context, targetAppID string, req *invokev1.InvokeMethodRequest) (*http.Response, error) {
+	app, err := d.getRemoteApp(targetAppID)
+	if err != nil {
+		return nil, err
+	}
+
+	if app.id == d.appID && app.namespace == d.namespace {
+		appChannel, ok := d.channels.AppChannelFor(config.AppCallbackInvocation).(channel.WebSocketAppChannel)
+		if !ok {
+			return nil, errors.New("cannot proxy websocket connection: app channel not initialized or not using HTTP")
+		}
+		return appChannel.DialWebSocket(ctx, req, "")
+	}
+
+	conn, teardown, err := d.connectionCreatorFn(ctx, app.address, app.id, app.namespace)
+	if err != nil {
+		if teardown != nil {
+			teardown(true)
+		}
+		return nil, err
+	}
+
+	ctx = d.setContextSpan(ctx)
+
+	d.addForwardedHeadersToMetadata(req)
+	d.addDestinationAppIDHeaderToMetadata(app.id, req)
+	d.addCallerAndCalleeAppIDHeaderToMetadata(d.namespace, d.appID, app.id, req)
+
+	start := time.Now()
+	diag.DefaultMonitoring.ServiceInvocationRequestSent(app.id)
+
+	// The stream lives as long as the connection, so it gets its own context which is canceled when the connection is closed
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := internalv1pb.NewServiceInvocationClient(conn).CallLocalWebSocket(ctx,
+		grpc.MaxCallRecvMsgSize(d.maxRequestBodySize),
+		grpc.MaxCallSendMsgSize(d.maxRequestBodySize),
+	)
+	if err != nil {
+		cancel()
+		teardown(false)
+		return nil, err
+	}
+
+	wsConn := &webSocketStreamConn{
+		stream: stream,
+		close: func() {
+			cancel()
+			teardown(false)
+		},
+	}
+
+	res, err := wsConn.handshake(req)
+	if err != nil {
+		wsConn.Close()
+		return nil, err
+	}
+	//nolint:gosec
+	diag.DefaultMonitoring.ServiceInvocationResponseReceived(app.id, int32(res.StatusCode), start)
+	return res, nil
+}
+
+// webSocketStreamConn is a connection to a remote app carried over a CallLocalWebSocket stream.
+// Reads and writes can happen concurrently, but there must not be more than one reader nor more than one writer at a time.
+type webSocketStreamConn struct {
+	stream    internalv1pb.ServiceInvocation_CallLocalWebSocketClient //nolint:nosnakecase
+	close     func()
+	closeOnce sync.Once
+
+	// Data received in the last payload that was not read yet
+	pending  []byte
+	readSeq  uint64
+	writeSeq uint64
+}
+
+// handshake sends the upgrade request and returns the response of the app.
+func (c *webSocketStreamConn) handshake(req *invokev1.InvokeMethodRequest) (*http.Response, error) {
+	err := c.stream.Send(&internalv1pb.InternalInvokeRequestStream{
+		Request: req.Proto(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	chunk, err := c.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	if chunk.GetResponse() == nil {
+		return nil, errors.New("response not found in leading message")
+	}
+	imr, err := invokev1.InternalInvokeResponse(chunk.GetResponse())
+	if err != nil {
+		return nil, err
+	}
+	defer imr.Close()
+
+	code := int(imr.Status().GetCode())
+	res := &http.Response{
+		Status:     fmt.Sprintf("%d %s", code, http.StatusText(code)),
+		StatusCode: code,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     make(http.Header),
+		Body:       c,
+	}
+	invokev1.InternalMetadataToHTTPHeader(c.stream.Context(), imr.Headers(), res.Header.Add)
+	if ct := imr.ContentType(); ct != "" {
+		res.Header.Set("content-type", ct)
+	}
+	return res, nil
+}
+
+// Read reads the data received from the remote app.
+func (c *webSocketStreamConn) Read(p []byte) (int, error) {
+	for len(c.pending) == 0 {
+		chunk, err := c.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		if chunk.GetResponse() != nil {
+			return 0, errors.New("response found in non-leading message")
+		}
+		if payload := chunk.GetPayload(); payload != nil {
+			if payload.GetSeq() != c.readSeq {
+				return 0, fmt.Errorf("invalid sequence number received: %d (expected: %d)", payload.GetSeq(), c.readSeq)
+			}
+			c.readSeq++
+			c.pending = payload.GetData()
+		}
+	}
+
+	n := copy(p, c.pending)
+	c.pending = c.pending[n:]
+	return n, nil
+}
+
+// Write sends data to the remote app.
+func (c *webSocketStreamConn) Write(p []byte) (int, error) {
+	err := c.stream.Send(&internalv1pb.InternalInvokeRequestStream{
+		Payload: &commonv1pb.StreamPayload{
+			Data: p,
+			Seq:  c.writeSeq,
+		},
+	})
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			// The stream was closed by the remote sidecar; the actual error is returned by Recv
+			err = io.ErrClosedPipe
+		}
+		return 0, err
+	}
+	c.writeSeq++
+	return len(p), nil
+}
+
+// Close closes the stream.
+func (c *webSocketStreamConn) Close() error {
+	c.closeOnce.Do(c.close)
+	return nil
+}
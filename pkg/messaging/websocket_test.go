@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messaging
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+// mockWebSocketServer accepts WebSocket connections to the "ws" method and echoes their data.
+type mockWebSocketServer struct {
+	internalv1pb.UnimplementedServiceInvocationServer
+	received chan *internalv1pb.InternalInvokeRequest
+}
+
+func (m *mockWebSocketServer) CallLocalWebSocket(stream internalv1pb.ServiceInvocation_CallLocalWebSocketServer) error { //nolint:nosnakecase
+	chunk, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	m.received <- chunk.GetRequest()
+
+	switch chunk.GetRequest().GetMessage().GetMethod() {
+	case "denied":
+		return status.Error(codes.PermissionDenied, "access denied")
+	case "ws":
+	default:
+		resp := invokev1.NewInvokeMethodResponse(http.StatusNotFound, "", nil).
+			WithContentType("text/plain")
+		defer resp.Close()
+		stream.Send(&internalv1pb.InternalInvokeResponseStream{Response: resp.Proto()})
+		return stream.Send(&internalv1pb.InternalInvokeResponseStream{
+			Payload: &commonv1pb.StreamPayload{Data: []byte("not found")},
+		})
+	}
+
+	resp := invokev1.NewInvokeMethodResponse(http.StatusSwitchingProtocols, "", nil).
+		WithHTTPHeaders(http.Header{"Upgrade": []string{"websocket"}, "Connection": []string{"Upgrade"}})
+	defer resp.Close()
+	err = stream.Send(&internalv1pb.InternalInvokeResponseStream{Response: resp.Proto()})
+	if err != nil {
+		return err
+	}
+	for {
+		chunk, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		err = stream.Send(&internalv1pb.InternalInvokeResponseStream{Payload: chunk.GetPayload()})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func TestDialWebSocket(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "ws.sock")
+	lis, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	srv := &mockWebSocketServer{received: make(chan *internalv1pb.InternalInvokeRequest, 1)}
+	server := grpc.NewServer()
+	internalv1pb.RegisterServiceInvocationServer(server, srv)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	clientConn := createTestClient(socket)
+	t.Cleanup(func() { clientConn.Close() })
+
+	resolver := new(daprt.MockResolver)
+	resolver.On("ResolveID", mock.Anything).Return("addr1", nil)
+
+	messaging := NewDirectMessaging(NewDirectMessagingOpts{
+		AppID:              "app1",
+		Namespace:          "default",
+		Resolver:           resolver,
+		CompStore:          compstore.New(),
+		MaxRequestBodySize: 4 << 20,
+		ClientConnFn: func(ctx context.Context, address string, id string, namespace string, customOpts ...grpc.DialOption) (*grpc.ClientConn, func(destroy bool), error) {
+			return clientConn, func(_ bool) {}, nil
+		},
+	}).(*directMessaging)
+	t.Cleanup(func() { messaging.Close() })
+
+	dial := func(t *testing.T, method string) (*http.Response, error) {
+		t.Helper()
+		req := invokev1.NewInvokeMethodRequest(method).
+			WithHTTPExtension(http.MethodGet, "").
+			WithHTTPHeaders(http.Header{"Upgrade": []string{"websocket"}, "Connection": []string{"Upgrade"}})
+		t.Cleanup(func() { req.Close() })
+		//nolint:bodyclose
+		res, err := messaging.DialWebSocket(t.Context(), "app2", req)
+		if res != nil {
+			t.Cleanup(func() { res.Body.Close() })
+		}
+		return res, err
+	}
+
+	t.Run("upgrade accepted", func(t *testing.T) {
+		res, err := dial(t, "ws")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusSwitchingProtocols, res.StatusCode)
+		assert.Equal(t, "websocket", res.Header.Get("Upgrade"))
+
+		received := <-srv.received
+		assert.Equal(t, []string{"app1"}, received.GetMetadata()[invokev1.CallerIDHeader].GetValues())
+		assert.Equal(t, []string{"app2"}, received.GetMetadata()[invokev1.CalleeIDHeader].GetValues())
+		assert.Equal(t, []string{"Upgrade"}, received.GetMetadata()["Connection"].GetValues())
+
+		conn, ok := res.Body.(io.ReadWriteCloser)
+		require.True(t, ok)
+		for _, data := range []string{"ping", "pong"} {
+			_, err = conn.Write([]byte(data))
+			require.NoError(t, err)
+			buf := make([]byte, 4)
+			_, err = io.ReadFull(conn, buf)
+			require.NoError(t, err)
+			assert.Equal(t, data, string(buf))
+		}
+	})
+
+	t.Run("upgrade rejected", func(t *testing.T) {
+		res, err := dial(t, "other")
+		require.NoError(t, err)
+		<-srv.received
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+		assert.Equal(t, "text/plain", res.Header.Get("content-type"))
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "not found", string(body))
+	})
+
+	t.Run("denied by the target", func(t *testing.T) {
+		_, err := dial(t, "denied")
+		<-srv.received
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
@@ -81,3 +81,16 @@ func (h *HTTP) BuildPipelineFromSpec(name string, spec *config.PipelineSpec) mid
 	h.pipelines = append(h.pipelines, p)
 	return p.http()
 }
+
+// UpdatePipelineSpec replaces the spec of the pipelines built with the given
+// name, so they use the new handlers and routes for the next requests.
+func (h *HTTP) UpdatePipelineSpec(name string, spec *config.PipelineSpec) {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	for _, p := range h.pipelines {
+		if p.name == name {
+			p.setSpec(spec)
+		}
+	}
+}
@@ -150,4 +150,39 @@ func TestHTTP(t *testing.T) {
 		assert.Equal(t, int32(4), middle1.invoked.Load())
 		assert.Equal(t, int32(4), middle2.invoked.Load())
 	})
+
+	t.Run("updating the spec of a pipeline by name", func(t *testing.T) {
+		middle1 := newTestMiddle("test")
+		middle2 := newTestMiddle("test2")
+		h := New()
+		h.Add(Spec{
+			Component:      middle1.comp,
+			Implementation: middle1.item.Middleware,
+		})
+		h.Add(Spec{
+			Component:      middle2.comp,
+			Implementation: middle2.item.Middleware,
+		})
+
+		var invoked int
+		root := nethttp.HandlerFunc(func(nethttp.ResponseWriter, *nethttp.Request) { invoked++ })
+		handler := h.BuildPipelineFromSpec("test", &config.PipelineSpec{Handlers: []config.HandlerSpec{
+			{Name: "test", Type: "middleware.http.fakemw", Version: "v1"},
+		}})(root)
+		other := h.BuildPipelineFromSpec("other", &config.PipelineSpec{Handlers: []config.HandlerSpec{
+			{Name: "test", Type: "middleware.http.fakemw", Version: "v1"},
+		}})(root)
+
+		h.UpdatePipelineSpec("test", &config.PipelineSpec{Handlers: []config.HandlerSpec{
+			{Name: "test2", Type: "middleware.http.fakemw", Version: "v1"},
+		}})
+		handler.ServeHTTP(nil, nil)
+		assert.Equal(t, int32(0), middle1.invoked.Load())
+		assert.Equal(t, int32(1), middle2.invoked.Load())
+
+		other.ServeHTTP(nil, nil)
+		assert.Equal(t, 2, invoked)
+		assert.Equal(t, int32(1), middle1.invoked.Load())
+		assert.Equal(t, int32(1), middle2.invoked.Load())
+	})
 }
@@ -14,10 +14,17 @@ limitations under the License.
 package http
 
 import (
+	"bufio"
+	"bytes"
+	"context"
+	"net"
 	"net/http"
+	"slices"
+	"strings"
 	"sync"
 
 	"github.com/dapr/dapr/pkg/config"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	"github.com/dapr/dapr/pkg/middleware"
 	"github.com/dapr/dapr/pkg/middleware/store"
 )
@@ -74,25 +81,217 @@ func (p *pipeline) buildChain() {
 	defer p.lock.Unlock()
 
 	// If no spec or no handlers defined, use root.
-	if p.spec == nil || len(p.spec.Handlers) == 0 {
+	if p.spec == nil || (len(p.spec.Handlers) == 0 && len(p.spec.ResponseHandlers) == 0 && len(p.spec.Routes) == 0) {
 		p.chain = p.root
 		return
 	}
 
 	log.Infof("Building pipeline %s", p.name)
 
-	next := p.root
-	for i := len(p.spec.Handlers) - 1; i >= 0; i-- {
+	next := p.buildPhases(p.spec.Handlers, p.spec.ResponseHandlers)
+	if len(p.spec.Routes) > 0 {
+		next = p.withRoutes(next)
+	}
+
+	p.chain = next
+}
+
+// setSpec replaces the spec of the pipeline and rebuilds the chain.
+func (p *pipeline) setSpec(spec *config.PipelineSpec) {
+	p.lock.Lock()
+	p.spec = spec
+	built := p.root != nil
+	p.lock.Unlock()
+
+	// The chain is built once the pipeline has a root handler
+	if built {
+		p.buildChain()
+	}
+}
+
+// buildPhases builds the request and response phases of a chain from root.
+func (p *pipeline) buildPhases(handlers, responseHandlers []config.HandlerSpec) http.Handler {
+	next := p.buildHandlers(handlers, p.root)
+	if len(responseHandlers) > 0 {
+		next = p.withResponsePhase(next, responseHandlers)
+	}
+	return next
+}
+
+// pipelineRoute is the chain of a route of the pipeline.
+type pipelineRoute struct {
+	spec  config.PipelineRouteSpec
+	chain http.Handler
+}
+
+// withRoutes returns a handler that runs the requests through the chain of
+// the first route that they match, or through next if they match no route.
+func (p *pipeline) withRoutes(next http.Handler) http.Handler {
+	routes := make([]pipelineRoute, len(p.spec.Routes))
+	for i, r := range p.spec.Routes {
+		routes[i] = pipelineRoute{
+			spec:  r,
+			chain: p.buildPhases(r.Handlers, r.ResponseHandlers),
+		}
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, route := range routes {
+			if route.matches(r) {
+				route.chain.ServeHTTP(w, r)
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (pr pipelineRoute) matches(r *http.Request) bool {
+	if prefix := pr.spec.PathPrefix; prefix != "" {
+		path := r.URL.Path
+		if !strings.HasPrefix(path, prefix) {
+			return false
+		}
+		// The prefix must end at a path segment, so "/v1.0/state" does not match "/v1.0/statefoo"
+		if len(path) > len(prefix) && !strings.HasSuffix(prefix, "/") && path[len(prefix)] != '/' {
+			return false
+		}
+	}
+	if len(pr.spec.AppIDs) > 0 && !slices.Contains(pr.spec.AppIDs, r.Header.Get(invokev1.CallerIDHeader)) {
+		return false
+	}
+	return true
+}
+
+// buildHandlers chains the middlewares of the handlers in front of next.
+func (p *pipeline) buildHandlers(handlers []config.HandlerSpec, next http.Handler) http.Handler {
+	for i := len(handlers) - 1; i >= 0; i-- {
 		handler, ok := p.store.Get(store.Metadata{
-			Name:    p.spec.Handlers[i].Name,
-			Type:    p.spec.Handlers[i].Type,
-			Version: p.spec.Handlers[i].Version,
+			Name:    handlers[i].Name,
+			Type:    handlers[i].Type,
+			Version: handlers[i].Version,
 		})
 		if !ok {
 			continue
 		}
 		next = handler(next)
 	}
+	return next
+}
 
-	p.chain = next
+type recordedResponseCtxKey struct{}
+
+// withResponsePhase returns a handler that records the response of next, and
+// then runs it through the response handlers on the way back to the caller.
+// The response handlers see the original request, and their next handler
+// writes the recorded response, so they can only rewrite the response.
+// Upgraded connections and streamed responses, which are flushed or are event
+// streams, can't be buffered: they are passed through to the caller and skip
+// the response handlers.
+func (p *pipeline) withResponsePhase(next http.Handler, responseHandlers []config.HandlerSpec) http.Handler {
+	replay := p.buildHandlers(responseHandlers, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.Context().Value(recordedResponseCtxKey{}).(*responseRecorder).replay(w)
+	}))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Upgrade") != "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		rec := &responseRecorder{w: w, header: make(http.Header)}
+		next.ServeHTTP(rec, r)
+		if rec.streaming {
+			return
+		}
+		replay.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), recordedResponseCtxKey{}, rec)))
+	})
+}
+
+// responseRecorder records the response written by the request phase of the
+// pipeline, until the response is streamed.
+type responseRecorder struct {
+	w      http.ResponseWriter
+	header http.Header
+	body   bytes.Buffer
+	status int
+
+	// streaming is set once the response is passed through to the caller.
+	streaming bool
+}
+
+func (rr *responseRecorder) Header() http.Header {
+	if rr.streaming {
+		return rr.w.Header()
+	}
+	return rr.header
+}
+
+func (rr *responseRecorder) Write(b []byte) (int, error) {
+	if rr.status == 0 {
+		rr.WriteHeader(http.StatusOK)
+	}
+	if rr.streaming {
+		return rr.w.Write(b)
+	}
+	return rr.body.Write(b)
+}
+
+func (rr *responseRecorder) WriteHeader(statusCode int) {
+	if rr.status != 0 {
+		return
+	}
+	rr.status = statusCode
+	if strings.HasPrefix(rr.header.Get("Content-Type"), "text/event-stream") {
+		rr.stream()
+	}
+}
+
+// Flush streams the response to the caller.
+func (rr *responseRecorder) Flush() {
+	if !rr.streaming {
+		if rr.status == 0 {
+			rr.status = http.StatusOK
+		}
+		rr.stream()
+	}
+	if f, ok := rr.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack passes the connection through to the handler, such as for WebSockets.
+func (rr *responseRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hj, ok := rr.w.(http.Hijacker)
+	if !ok {
+		return nil, nil, http.ErrNotSupported
+	}
+	rr.streaming = true
+	return hj.Hijack()
+}
+
+// Unwrap returns the response writer of the caller, for http.ResponseController.
+func (rr *responseRecorder) Unwrap() http.ResponseWriter {
+	return rr.w
+}
+
+// stream writes the response recorded so far to the caller, and passes the
+// rest of the response through.
+func (rr *responseRecorder) stream() {
+	rr.streaming = true
+	rr.replay(rr.w)
+	rr.body.Reset()
+}
+
+func (rr *responseRecorder) replay(w http.ResponseWriter) {
+	for k, v := range rr.header {
+		w.Header()[k] = v
+	}
+	if rr.status != 0 {
+		w.WriteHeader(rr.status)
+	}
+	if rr.body.Len() > 0 {
+		// Errors writing to the caller are not actionable here
+		_, _ = w.Write(rr.body.Bytes())
+	}
 }
@@ -14,11 +14,16 @@ limitations under the License.
 package http
 
 import (
+	"bufio"
+	"bytes"
+	"net"
 	nethttp "net/http"
+	"net/http/httptest"
 	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
@@ -351,6 +356,223 @@ func TestPipeline_buildChain(t *testing.T) {
 	})
 }
 
+func TestPipeline_responsePhase(t *testing.T) {
+	// The redact middleware replaces the body of the response and adds a security header
+	redact := store.Item[middleware.HTTP]{
+		Metadata: store.Metadata{Name: "redact", Type: "middleware.http.fakemw", Version: "v1"},
+		Middleware: func(next nethttp.Handler) nethttp.Handler {
+			return nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				rec := httptest.NewRecorder()
+				next.ServeHTTP(rec, r)
+				for k, v := range rec.Header() {
+					w.Header()[k] = v
+				}
+				w.Header().Set("X-Content-Type-Options", "nosniff")
+				w.WriteHeader(rec.Code)
+				w.Write(bytes.ReplaceAll(rec.Body.Bytes(), []byte("secret"), []byte("******")))
+			})
+		},
+	}
+
+	store := store.New[middleware.HTTP]("test")
+	middle1 := newTestMiddle("test")
+	store.Add(middle1.item)
+	store.Add(redact)
+	p := newPipeline("test", store, &config.PipelineSpec{
+		Handlers: []config.HandlerSpec{
+			{Name: "test", Type: "middleware.http.fakemw", Version: "v1"},
+		},
+		ResponseHandlers: []config.HandlerSpec{
+			{Name: "redact", Type: "middleware.http.fakemw", Version: "v1"},
+			{Name: "notloaded", Type: "middleware.http.fakemw", Version: "v1"},
+		},
+	})
+
+	var invoked int
+	root := nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		invoked++
+		w.Header().Set("X-App", "app")
+		w.WriteHeader(nethttp.StatusCreated)
+		w.Write([]byte("the secret is here"))
+	})
+	handler := p.http()(root)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(nethttp.MethodGet, "/", nil))
+	assert.Equal(t, 1, invoked)
+	assert.Equal(t, int32(1), middle1.invoked.Load())
+	assert.Equal(t, nethttp.StatusCreated, rec.Code)
+	assert.Equal(t, "app", rec.Header().Get("X-App"))
+	assert.Equal(t, "nosniff", rec.Header().Get("X-Content-Type-Options"))
+	assert.Equal(t, "the ****** is here", rec.Body.String())
+
+	t.Run("event streams are passed through", func(t *testing.T) {
+		handler := p.http()(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.Write([]byte("data: secret\n\n"))
+		}))
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(nethttp.MethodGet, "/", nil))
+		assert.Equal(t, nethttp.StatusOK, rec.Code)
+		assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+		assert.Empty(t, rec.Header().Get("X-Content-Type-Options"))
+		assert.Equal(t, "data: secret\n\n", rec.Body.String())
+	})
+
+	t.Run("flushed responses are passed through", func(t *testing.T) {
+		handler := p.http()(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			w.Header().Set("X-App", "app")
+			w.Write([]byte("the secret "))
+			require.NoError(t, nethttp.NewResponseController(w).Flush())
+			w.Write([]byte("is here"))
+		}))
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(nethttp.MethodGet, "/", nil))
+		assert.True(t, rec.Flushed)
+		assert.Equal(t, "app", rec.Header().Get("X-App"))
+		assert.Empty(t, rec.Header().Get("X-Content-Type-Options"))
+		assert.Equal(t, "the secret is here", rec.Body.String())
+	})
+
+	t.Run("connections can be hijacked", func(t *testing.T) {
+		var hijacked bool
+		handler := p.http()(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			_, _, err := nethttp.NewResponseController(w).Hijack()
+			require.NoError(t, err)
+			hijacked = true
+		}))
+
+		rec := &hijackRecorder{ResponseRecorder: httptest.NewRecorder()}
+		handler.ServeHTTP(rec, httptest.NewRequest(nethttp.MethodGet, "/", nil))
+		assert.True(t, hijacked)
+		assert.True(t, rec.hijacked)
+		assert.Empty(t, rec.Header().Get("X-Content-Type-Options"))
+	})
+
+	t.Run("upgrade requests are passed through", func(t *testing.T) {
+		handler := p.http()(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			_, ok := w.(*hijackRecorder)
+			assert.True(t, ok)
+			w.WriteHeader(nethttp.StatusSwitchingProtocols)
+		}))
+
+		req := httptest.NewRequest(nethttp.MethodGet, "/", nil)
+		req.Header.Set("Connection", "Upgrade")
+		req.Header.Set("Upgrade", "websocket")
+		rec := &hijackRecorder{ResponseRecorder: httptest.NewRecorder()}
+		handler.ServeHTTP(rec, req)
+		assert.Equal(t, nethttp.StatusSwitchingProtocols, rec.Code)
+		assert.Empty(t, rec.Header().Get("X-Content-Type-Options"))
+	})
+}
+
+type hijackRecorder struct {
+	*httptest.ResponseRecorder
+	hijacked bool
+}
+
+func (h *hijackRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h.hijacked = true
+	return nil, nil, nil
+}
+
+func TestPipeline_routes(t *testing.T) {
+	store := store.New[middleware.HTTP]("test")
+	middleDefault := newTestMiddle("default")
+	middleState := newTestMiddle("state")
+	middleCaller := newTestMiddle("caller")
+	store.Add(middleDefault.item)
+	store.Add(middleState.item)
+	store.Add(middleCaller.item)
+	p := newPipeline("test", store, &config.PipelineSpec{
+		Handlers: []config.HandlerSpec{
+			{Name: "default", Type: "middleware.http.fakemw", Version: "v1"},
+		},
+		Routes: []config.PipelineRouteSpec{
+			{
+				PathPrefix: "/v1.0/state",
+				AppIDs:     []string{"app1"},
+				Handlers: []config.HandlerSpec{
+					{Name: "state", Type: "middleware.http.fakemw", Version: "v1"},
+					{Name: "caller", Type: "middleware.http.fakemw", Version: "v1"},
+				},
+			},
+			{
+				PathPrefix: "/v1.0/state",
+				Handlers: []config.HandlerSpec{
+					{Name: "state", Type: "middleware.http.fakemw", Version: "v1"},
+				},
+			},
+			{
+				AppIDs: []string{"app2"},
+				Handlers: []config.HandlerSpec{
+					{Name: "caller", Type: "middleware.http.fakemw", Version: "v1"},
+				},
+			},
+		},
+	})
+
+	var invoked int
+	root := nethttp.HandlerFunc(func(nethttp.ResponseWriter, *nethttp.Request) { invoked++ })
+	handler := p.http()(root)
+
+	serve := func(path, callerID string) {
+		r := httptest.NewRequest(nethttp.MethodGet, path, nil)
+		if callerID != "" {
+			r.Header.Set("dapr-caller-app-id", callerID)
+		}
+		handler.ServeHTTP(httptest.NewRecorder(), r)
+	}
+	assertInvoked := func(t *testing.T, def, state, caller int32) {
+		t.Helper()
+		assert.Equal(t, def, middleDefault.invoked.Load())
+		assert.Equal(t, state, middleState.invoked.Load())
+		assert.Equal(t, caller, middleCaller.invoked.Load())
+	}
+
+	// First route matches the path and the caller
+	serve("/v1.0/state/mystore", "app1")
+	assertInvoked(t, 0, 1, 1)
+
+	// Second route matches the path only
+	serve("/v1.0/state", "app2")
+	assertInvoked(t, 0, 2, 1)
+
+	// The prefix must end at a path segment
+	serve("/v1.0/statefoo", "")
+	assertInvoked(t, 1, 2, 1)
+
+	// Third route matches the caller only
+	serve("/v1.0/invoke", "app2")
+	assertInvoked(t, 1, 2, 2)
+
+	// No route matches
+	serve("/v1.0/invoke", "app1")
+	assertInvoked(t, 2, 2, 2)
+	assert.Equal(t, 5, invoked)
+
+	t.Run("update spec", func(t *testing.T) {
+		p.setSpec(&config.PipelineSpec{
+			Routes: []config.PipelineRouteSpec{
+				{
+					PathPrefix: "/v1.0/invoke/",
+					Handlers: []config.HandlerSpec{
+						{Name: "caller", Type: "middleware.http.fakemw", Version: "v1"},
+					},
+				},
+			},
+		})
+
+		serve("/v1.0/invoke/app/method/foo", "")
+		assertInvoked(t, 2, 2, 3)
+		serve("/v1.0/state/mystore", "app1")
+		assertInvoked(t, 2, 2, 3)
+		assert.Equal(t, 7, invoked)
+	})
+}
+
 type testmiddle struct {
 	item    store.Item[middleware.HTTP]
 	comp    compapi.Component
@@ -0,0 +1,99 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wasm
+
+import (
+	"context"
+
+	"github.com/tetratelabs/wazero"
+	wazeroapi "github.com/tetratelabs/wazero/api"
+
+	wasmhost "github.com/dapr/dapr/pkg/runtime/wasm"
+	"github.com/dapr/kit/logger"
+)
+
+// hostModuleName is the name of the module that guests import the host functions from.
+const hostModuleName = "dapr"
+
+// Results of the host functions that are not a length.
+// Lengths fit in the lower 32 bits, so these can never be confused with a length.
+const (
+	resultNotFound uint64 = 1 << 32
+	resultError    uint64 = 2 << 32
+)
+
+// getFn is a host service that reads a value from a store.
+type getFn func(ctx context.Context, grants wasmhost.Grants, storeName, key string) ([]byte, bool, error)
+
+type hostFunctions struct {
+	host   *wasmhost.Host
+	grants wasmhost.Grants
+	logger logger.Logger
+}
+
+// instantiate instantiates the "dapr" host module in the runtime, with the functions:
+//
+//	secret_get(store_ptr, store_len, key_ptr, key_len, buf, buf_limit u32) u64
+//	state_get(store_ptr, store_len, key_ptr, key_len, buf, buf_limit u32) u64
+//
+// Both return the length of the value, which is written to buf only if it is not longer than buf_limit.
+// Guests with a buffer that is too small call the function again with a larger one.
+// The result is 1<<32 if the value does not exist, and 2<<32 if it can't be read, including when the store is not granted.
+func (hf *hostFunctions) instantiate(ctx context.Context, rt wazero.Runtime) error {
+	_, err := rt.NewHostModuleBuilder(hostModuleName).
+		NewFunctionBuilder().
+		WithFunc(func(ctx context.Context, mod wazeroapi.Module, storePtr, storeLen, keyPtr, keyLen, buf, bufLimit uint32) uint64 {
+			return hf.get(ctx, mod.Memory(), "secret_get", hf.host.GetSecret, storePtr, storeLen, keyPtr, keyLen, buf, bufLimit)
+		}).
+		WithParameterNames("store_ptr", "store_len", "key_ptr", "key_len", "buf", "buf_limit").
+		Export("secret_get").
+		NewFunctionBuilder().
+		WithFunc(func(ctx context.Context, mod wazeroapi.Module, storePtr, storeLen, keyPtr, keyLen, buf, bufLimit uint32) uint64 {
+			return hf.get(ctx, mod.Memory(), "state_get", hf.host.GetState, storePtr, storeLen, keyPtr, keyLen, buf, bufLimit)
+		}).
+		WithParameterNames("store_ptr", "store_len", "key_ptr", "key_len", "buf", "buf_limit").
+		Export("state_get").
+		Instantiate(ctx)
+	return err
+}
+
+func (hf *hostFunctions) get(ctx context.Context, mem wazeroapi.Memory, name string, fn getFn, storePtr, storeLen, keyPtr, keyLen, buf, bufLimit uint32) uint64 {
+	storeName, ok := mem.Read(storePtr, storeLen)
+	if !ok {
+		hf.logger.Errorf("wasm: %s: store name is out of memory range", name)
+		return resultError
+	}
+	key, ok := mem.Read(keyPtr, keyLen)
+	if !ok {
+		hf.logger.Errorf("wasm: %s: key is out of memory range", name)
+		return resultError
+	}
+
+	val, found, err := fn(ctx, hf.grants, string(storeName), string(key))
+	if err != nil {
+		hf.logger.Errorf("wasm: %s: %v", name, err)
+		return resultError
+	}
+	if !found {
+		return resultNotFound
+	}
+
+	// The value is only written if it fits in the buffer
+	valLen := uint32(len(val)) //nolint:gosec
+	if valLen <= bufLimit && !mem.Write(buf, val) {
+		hf.logger.Errorf("wasm: %s: buffer is out of memory range", name)
+		return resultError
+	}
+	return uint64(valLen)
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package wasm implements the Wasm HTTP middleware, which runs http-wasm
+// guests with host functions to access Dapr building blocks.
+package wasm
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/http-wasm/http-wasm-host-go/api"
+	"github.com/http-wasm/http-wasm-host-go/handler"
+	wasmnethttp "github.com/http-wasm/http-wasm-host-go/handler/nethttp"
+	"github.com/tetratelabs/wazero"
+
+	"github.com/dapr/components-contrib/common/wasm"
+	contribmiddleware "github.com/dapr/components-contrib/middleware"
+	"github.com/dapr/dapr/pkg/middleware"
+	wasmhost "github.com/dapr/dapr/pkg/runtime/wasm"
+	"github.com/dapr/kit/logger"
+	kitmd "github.com/dapr/kit/metadata"
+)
+
+// Metadata is the configuration of the Wasm middleware, in addition to the common Wasm metadata.
+type Metadata struct {
+	// GuestConfig is an optional configuration passed to Wasm guests.
+	GuestConfig string `mapstructure:"guestConfig"`
+
+	// SecretStores is the comma-separated list of secret stores that the guest can read with the "secret_get" host function.
+	SecretStores string `mapstructure:"secretStores"`
+
+	// StateStores is the comma-separated list of state stores that the guest can read with the "state_get" host function.
+	StateStores string `mapstructure:"stateStores"`
+}
+
+// ErrComponentModel is returned when the guest is a component instead of a core module.
+var ErrComponentModel = errors.New("wasm: the guest is a component-model binary (WASI preview 2), which is not supported by the Wasm runtime; build it as a core module targeting WASI preview 1 (wasip1)")
+
+type Middleware struct {
+	logger logger.Logger
+	host   *wasmhost.Host
+}
+
+// New returns a new Wasm HTTP middleware, which gives the guests access to the building blocks of the host.
+func New(logger logger.Logger, host *wasmhost.Host) *Middleware {
+	return &Middleware{
+		logger: logger,
+		host:   host,
+	}
+}
+
+// GetHandler returns the HTTP handler of the middleware.
+func (m *Middleware) GetHandler(ctx context.Context, metadata contribmiddleware.Metadata) (middleware.HTTP, error) {
+	rh, err := m.getHandler(ctx, metadata)
+	if err != nil {
+		return nil, err
+	}
+	return rh.requestHandler, nil
+}
+
+func (m *Middleware) getHandler(ctx context.Context, metadata contribmiddleware.Metadata) (*requestHandler, error) {
+	meta, err := wasm.GetInitMetadata(ctx, metadata.Base)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: failed to parse metadata: %w", err)
+	}
+
+	var middlewareMeta Metadata
+	err = kitmd.DecodeMetadata(metadata.Base, &middlewareMeta)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: failed to parse wasm middleware metadata: %w", err)
+	}
+
+	if isComponent(meta.Guest) {
+		return nil, ErrComponentModel
+	}
+
+	hf := &hostFunctions{
+		host: m.host,
+		grants: wasmhost.Grants{
+			SecretStores: splitList(middlewareMeta.SecretStores),
+			StateStores:  splitList(middlewareMeta.StateStores),
+		},
+		logger: m.logger,
+	}
+
+	var stdout, stderr bytes.Buffer
+	mw, err := wasmnethttp.NewMiddleware(ctx, meta.Guest,
+		handler.Logger(m),
+		handler.Runtime(func(ctx context.Context) (wazero.Runtime, error) {
+			rt := wazero.NewRuntime(ctx)
+			if err := hf.instantiate(ctx, rt); err != nil {
+				_ = rt.Close(ctx)
+				return nil, fmt.Errorf("wasm: error instantiating the %s host module: %w", hostModuleName, err)
+			}
+			return rt, nil
+		}),
+		handler.ModuleConfig(wasm.NewModuleConfig(meta).
+			WithName(meta.GuestName).
+			WithStdout(&stdout).  // reset per request
+			WithStderr(&stderr)), // reset per request
+		handler.GuestConfig([]byte(middlewareMeta.GuestConfig)))
+	if err != nil {
+		return nil, err
+	}
+
+	return &requestHandler{mw: mw, logger: m.logger, stdout: &stdout, stderr: &stderr}, nil
+}
+
+// IsEnabled implements the same method as documented on api.Logger.
+func (m *Middleware) IsEnabled(level api.LogLevel) bool {
+	var l logger.LogLevel
+	switch level {
+	case api.LogLevelError:
+		l = logger.ErrorLevel
+	case api.LogLevelWarn:
+		l = logger.WarnLevel
+	case api.LogLevelInfo:
+		l = logger.InfoLevel
+	case api.LogLevelDebug:
+		l = logger.DebugLevel
+	default: // same as api.LogLevelNone
+		return false
+	}
+	return m.logger.IsOutputLevelEnabled(l)
+}
+
+// Log implements the same method as documented on api.Logger.
+func (m *Middleware) Log(_ context.Context, level api.LogLevel, message string) {
+	switch level {
+	case api.LogLevelError:
+		m.logger.Error(message)
+	case api.LogLevelWarn:
+		m.logger.Warn(message)
+	case api.LogLevelInfo:
+		m.logger.Info(message)
+	case api.LogLevelDebug:
+		m.logger.Debug(message)
+	default: // same as api.LogLevelNone
+		return
+	}
+}
+
+type requestHandler struct {
+	mw             wasmnethttp.Middleware
+	logger         logger.Logger
+	stdout, stderr *bytes.Buffer
+}
+
+func (rh *requestHandler) requestHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h := rh.mw.NewHandler(r.Context(), next)
+		defer func() {
+			rh.stdout.Reset()
+			rh.stderr.Reset()
+		}()
+
+		h.ServeHTTP(w, r)
+
+		if stdout := rh.stdout.String(); len(stdout) > 0 {
+			rh.logger.Debugf("wasm stdout: %s", stdout)
+		}
+		if stderr := rh.stderr.String(); len(stderr) > 0 {
+			rh.logger.Debugf("wasm stderr: %s", stderr)
+		}
+	})
+}
+
+// Close implements io.Closer.
+func (rh *requestHandler) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return rh.mw.Close(ctx)
+}
+
+// isComponent returns true if the binary is a component instead of a core module.
+// Both start with the same magic number, and components have a layer of 1 after the version.
+func isComponent(b []byte) bool {
+	return len(b) >= 8 && bytes.Equal(b[:4], []byte("\x00asm")) && b[6] == 0x01 && b[7] == 0x00
+}
+
+func splitList(s string) []string {
+	if s == "" {
+		return nil
+	}
+	parts := strings.Split(s, ",")
+	res := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			res = append(res, p)
+		}
+	}
+	return res
+}
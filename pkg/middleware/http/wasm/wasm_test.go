@@ -0,0 +1,138 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wasm
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	wazeroapi "github.com/tetratelabs/wazero/api"
+
+	contribmiddleware "github.com/dapr/components-contrib/middleware"
+	wasmhost "github.com/dapr/dapr/pkg/runtime/wasm"
+	"github.com/dapr/kit/logger"
+)
+
+var (
+	coreModuleHeader = []byte("\x00asm\x01\x00\x00\x00")
+	componentHeader  = []byte("\x00asm\x0d\x00\x01\x00")
+)
+
+func TestGetHandler_componentModel(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "component.wasm")
+	require.NoError(t, os.WriteFile(path, componentHeader, 0o600))
+
+	metadata := contribmiddleware.Metadata{}
+	metadata.Properties = map[string]string{"url": "file://" + path}
+	_, err := New(logger.NewLogger(t.Name()), wasmhost.DefaultHost).GetHandler(t.Context(), metadata)
+	require.ErrorIs(t, err, ErrComponentModel)
+}
+
+func TestIsComponent(t *testing.T) {
+	assert.False(t, isComponent(coreModuleHeader))
+	assert.True(t, isComponent(componentHeader))
+	assert.False(t, isComponent([]byte("\x00asm")))
+	assert.False(t, isComponent(nil))
+}
+
+func TestSplitList(t *testing.T) {
+	assert.Nil(t, splitList(""))
+	assert.Equal(t, []string{"a"}, splitList("a"))
+	assert.Equal(t, []string{"a", "b"}, splitList(" a, ,b "))
+}
+
+func TestHostFunctions_get(t *testing.T) {
+	hf := &hostFunctions{
+		grants: wasmhost.Grants{StateStores: []string{"store"}},
+		logger: logger.NewLogger(t.Name()),
+	}
+	fn := func(ctx context.Context, grants wasmhost.Grants, storeName, key string) ([]byte, bool, error) {
+		assert.Equal(t, hf.grants, grants)
+		switch {
+		case storeName != "store":
+			return nil, false, errors.New("not granted")
+		case key == "key":
+			return []byte("value"), true, nil
+		default:
+			return nil, false, nil
+		}
+	}
+
+	// The store name is at 0, the key at 8 and the buffer at 16
+	newMem := func(storeName, key string) *fakeMemory {
+		mem := &fakeMemory{buf: make([]byte, 32)}
+		copy(mem.buf[0:], storeName)
+		copy(mem.buf[8:], key)
+		return mem
+	}
+
+	t.Run("value fits in the buffer", func(t *testing.T) {
+		mem := newMem("store", "key")
+		res := hf.get(t.Context(), mem, "state_get", fn, 0, 5, 8, 3, 16, 16)
+		assert.Equal(t, uint64(5), res)
+		assert.Equal(t, "value", string(mem.buf[16:21]))
+	})
+
+	t.Run("value does not fit in the buffer", func(t *testing.T) {
+		mem := newMem("store", "key")
+		res := hf.get(t.Context(), mem, "state_get", fn, 0, 5, 8, 3, 16, 2)
+		assert.Equal(t, uint64(5), res)
+		assert.Equal(t, make([]byte, 16), mem.buf[16:])
+	})
+
+	t.Run("value does not exist", func(t *testing.T) {
+		mem := newMem("store", "other")
+		res := hf.get(t.Context(), mem, "state_get", fn, 0, 5, 8, 5, 16, 16)
+		assert.Equal(t, resultNotFound, res)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		mem := newMem("other", "key")
+		res := hf.get(t.Context(), mem, "state_get", fn, 0, 5, 8, 3, 16, 16)
+		assert.Equal(t, resultError, res)
+	})
+
+	t.Run("out of memory range", func(t *testing.T) {
+		mem := newMem("store", "key")
+		assert.Equal(t, resultError, hf.get(t.Context(), mem, "state_get", fn, 30, 5, 8, 3, 16, 16))
+		assert.Equal(t, resultError, hf.get(t.Context(), mem, "state_get", fn, 0, 5, 30, 3, 16, 16))
+		assert.Equal(t, resultError, hf.get(t.Context(), mem, "state_get", fn, 0, 5, 8, 3, 30, 16))
+	})
+}
+
+// fakeMemory is a guest memory backed by a slice.
+type fakeMemory struct {
+	wazeroapi.Memory
+	buf []byte
+}
+
+func (m *fakeMemory) Read(offset, byteCount uint32) ([]byte, bool) {
+	if uint64(offset)+uint64(byteCount) > uint64(len(m.buf)) {
+		return nil, false
+	}
+	return m.buf[offset : offset+byteCount], true
+}
+
+func (m *fakeMemory) Write(offset uint32, v []byte) bool {
+	if uint64(offset)+uint64(len(v)) > uint64(len(m.buf)) {
+		return false
+	}
+	copy(m.buf[offset:], v)
+	return true
+}
@@ -25,6 +25,7 @@ type Fake struct {
 	enabledFn                   func(stateStore string) bool
 	publishInternalFn           func(ctx context.Context, stateStore string, states []state.TransactionalStateOperation, source, traceID, traceState string) ([]state.TransactionalStateOperation, error)
 	subscribeToInternalTopicsFn func(ctx context.Context, appID string) error
+	flushFn                     func(ctx context.Context) error
 }
 
 func New() *Fake {
@@ -35,6 +36,7 @@ func New() *Fake {
 			return nil, nil
 		},
 		subscribeToInternalTopicsFn: func(ctx context.Context, appID string) error { return nil },
+		flushFn:                     func(ctx context.Context) error { return nil },
 	}
 }
 
@@ -58,6 +60,11 @@ func (f *Fake) WithSubscribeToInternalTopics(fn func(ctx context.Context, appID
 	return f
 }
 
+func (f *Fake) WithFlush(fn func(ctx context.Context) error) *Fake {
+	f.flushFn = fn
+	return f
+}
+
 func (f *Fake) AddOrUpdateOutbox(stateStore v1alpha1.Component) {
 	f.addOrUpdateOutboxFn(stateStore)
 }
@@ -73,3 +80,7 @@ func (f *Fake) PublishInternal(ctx context.Context, stateStore string, states []
 func (f *Fake) SubscribeToInternalTopics(ctx context.Context, appID string) error {
 	return f.subscribeToInternalTopicsFn(ctx, appID)
 }
+
+func (f *Fake) Flush(ctx context.Context) error {
+	return f.flushFn(ctx)
+}
@@ -26,4 +26,7 @@ type Outbox interface {
 	Enabled(stateStore string) bool
 	PublishInternal(ctx context.Context, stateStore string, states []state.TransactionalStateOperation, source, traceID, traceState string) ([]state.TransactionalStateOperation, error)
 	SubscribeToInternalTopics(ctx context.Context, appID string) error
+	// Flush stops publishing new outbox messages, and waits until the messages
+	// that are being published have completed.
+	Flush(ctx context.Context) error
 }
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package delta contains the incremental dissemination of the placement
+// tables. Instead of the full tables, the placement service sends the Dapr
+// runtimes supporting it only the tables of the actor types which changed
+// since the tables the runtime last received.
+package delta
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	v1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
+	kitstrings "github.com/dapr/kit/strings"
+)
+
+const (
+	// MetadataKey is the gRPC metadata key the Dapr runtimes set on the
+	// placement stream when they support delta updates.
+	MetadataKey = "dapr-placement-delta"
+
+	// UpdateOperation is the operation of the placement orders holding a delta
+	// of the tables.
+	UpdateOperation = "update-delta"
+)
+
+// WithSupport returns a context reporting that the Dapr runtime supports delta
+// updates on the placement stream it opens.
+func WithSupport(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, MetadataKey, "true")
+}
+
+// Supported returns true if the Dapr runtime which opened the placement stream
+// supports delta updates.
+func Supported(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	v := md.Get(MetadataKey)
+	return len(v) > 0 && kitstrings.IsTruthy(v[0])
+}
+
+// Diff returns the delta updating the tables from to the tables to. The delta
+// holds the tables of the actor types which were added or changed, and an
+// empty table for the actor types which were removed, and the version of the
+// tables from as its base version, so the Dapr runtimes holding other tables
+// can detect it and request a full update. It returns false if the tables
+// can't be updated incrementally, and a full update is required.
+func Diff(from, to *v1pb.PlacementTables) (*v1pb.PlacementTables, bool) {
+	if from == nil || to == nil || from.GetReplicationFactor() != to.GetReplicationFactor() {
+		return nil, false
+	}
+
+	delta := &v1pb.PlacementTables{
+		Entries:           make(map[string]*v1pb.PlacementTable),
+		Version:           to.GetVersion(),
+		BaseVersion:       from.GetVersion(),
+		ApiLevel:          to.GetApiLevel(),
+		ReplicationFactor: to.GetReplicationFactor(),
+	}
+	for actorType, table := range to.GetEntries() {
+		if !proto.Equal(table, from.GetEntries()[actorType]) {
+			delta.Entries[actorType] = table
+		}
+	}
+	for actorType := range from.GetEntries() {
+		if _, ok := to.GetEntries()[actorType]; !ok {
+			delta.Entries[actorType] = &v1pb.PlacementTable{}
+		}
+	}
+	return delta, true
+}
+
+// IsRemoved returns true if the table of an actor type in a delta marks the
+// actor type as removed.
+func IsRemoved(table *v1pb.PlacementTable) bool {
+	return len(table.GetLoadMap()) == 0
+}
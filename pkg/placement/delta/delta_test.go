@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package delta
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	v1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
+)
+
+func TestDiff(t *testing.T) {
+	table := func(hosts ...string) *v1pb.PlacementTable {
+		pt := &v1pb.PlacementTable{LoadMap: make(map[string]*v1pb.Host)}
+		for _, h := range hosts {
+			pt.LoadMap[h] = &v1pb.Host{Name: h}
+		}
+		return pt
+	}
+
+	from := &v1pb.PlacementTables{
+		Version:           "1",
+		ReplicationFactor: 100,
+		Entries: map[string]*v1pb.PlacementTable{
+			"unchanged": table("a:1"),
+			"changed":   table("a:1"),
+			"removed":   table("b:1"),
+		},
+	}
+	to := &v1pb.PlacementTables{
+		Version:           "2",
+		ApiLevel:          20,
+		ReplicationFactor: 100,
+		Entries: map[string]*v1pb.PlacementTable{
+			"unchanged": table("a:1"),
+			"changed":   table("a:1", "c:1"),
+			"added":     table("c:1"),
+		},
+	}
+
+	t.Run("only the changed actor types are in the delta", func(t *testing.T) {
+		d, ok := Diff(from, to)
+		require.True(t, ok)
+		assert.Equal(t, "2", d.GetVersion())
+		assert.Equal(t, "1", d.GetBaseVersion())
+		assert.Equal(t, uint32(20), d.GetApiLevel())
+		assert.Len(t, d.GetEntries(), 3)
+		assert.Len(t, d.GetEntries()["changed"].GetLoadMap(), 2)
+		assert.Len(t, d.GetEntries()["added"].GetLoadMap(), 1)
+		assert.True(t, IsRemoved(d.GetEntries()["removed"]))
+		assert.NotContains(t, d.GetEntries(), "unchanged")
+	})
+
+	t.Run("a full update is required without base tables", func(t *testing.T) {
+		_, ok := Diff(nil, to)
+		assert.False(t, ok)
+	})
+
+	t.Run("a full update is required when the replication factor changes", func(t *testing.T) {
+		_, ok := Diff(&v1pb.PlacementTables{ReplicationFactor: 50}, to)
+		assert.False(t, ok)
+	})
+}
+
+func TestSupported(t *testing.T) {
+	md, _ := metadata.FromOutgoingContext(WithSupport(t.Context()))
+	assert.True(t, Supported(metadata.NewIncomingContext(t.Context(), md)))
+	assert.False(t, Supported(t.Context()))
+}
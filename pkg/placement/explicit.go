@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placement
+
+import (
+	"sync"
+
+	v1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
+)
+
+// ExplicitPlacement declares the actor types which aren't sharded across
+// their hosts by the hash of the actor IDs.
+type ExplicitPlacement struct {
+	// Singletons are the actor types hosted by a single host of the namespace
+	// at a time, which holds the lease of the actor type.
+	Singletons []string
+	// HostLabels are the labels the hosts of an actor type must have, by actor
+	// type. Actor types without hosts having the labels can't be placed.
+	HostLabels map[string]map[string]string
+}
+
+// singletonLeases holds the hosts holding the leases of the singleton actor
+// types in a namespace, by actor type.
+type singletonLeases struct {
+	lock    sync.Mutex
+	holders map[string]string
+}
+
+// placeExplicit restricts the hosts of the actor types placed explicitly in
+// the tables. The hosts of an actor type pinned to labels are restricted to
+// the ones having these labels. A singleton actor type is restricted to the
+// host holding its lease, which keeps it until it no longer hosts the actor
+// type, so that joining hosts don't move the actors.
+func (p *Service) placeExplicit(ns string, tables *v1pb.PlacementTables, streams []daprdStream) {
+	if len(p.explicit.Singletons) == 0 && len(p.explicit.HostLabels) == 0 {
+		return
+	}
+
+	hostLabels := make(map[string]map[string]string, len(streams))
+	for _, stream := range streams {
+		hostLabels[stream.hostName] = stream.labels
+	}
+
+	for actorType, labels := range p.explicit.HostLabels {
+		table, ok := tables.GetEntries()[actorType]
+		if !ok {
+			continue
+		}
+		for name := range table.GetLoadMap() {
+			if !hasLabels(hostLabels[name], labels) {
+				delete(table.GetLoadMap(), name)
+			}
+		}
+		if len(table.GetLoadMap()) == 0 {
+			log.Warnf("No host of actor type %s in namespace %s has the labels %v", actorType, ns, labels)
+		}
+	}
+
+	if len(p.explicit.Singletons) == 0 {
+		return
+	}
+
+	leases, _ := p.singletonLeases.GetOrSet(ns, &singletonLeases{holders: make(map[string]string)})
+	leases.lock.Lock()
+	defer leases.lock.Unlock()
+
+	for _, actorType := range p.explicit.Singletons {
+		table, ok := tables.GetEntries()[actorType]
+		if !ok || len(table.GetLoadMap()) == 0 {
+			delete(leases.holders, actorType)
+			continue
+		}
+
+		holder := leases.holders[actorType]
+		if _, ok := table.GetLoadMap()[holder]; !ok {
+			// The lease is granted to the first host by name, so that every
+			// placement service would grant it to the same host.
+			holder = ""
+			for name := range table.GetLoadMap() {
+				if holder == "" || name < holder {
+					holder = name
+				}
+			}
+			log.Infof("Granting the lease of singleton actor type %s in namespace %s to host %s", actorType, ns, holder)
+			leases.holders[actorType] = holder
+		}
+
+		table.LoadMap = map[string]*v1pb.Host{holder: table.GetLoadMap()[holder]}
+	}
+}
+
+func hasLabels(have, want map[string]string) bool {
+	for k, v := range want {
+		if got, ok := have[k]; !ok || got != v {
+			return false
+		}
+	}
+	return true
+}
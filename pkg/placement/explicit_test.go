@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placement
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/alphadose/haxmap"
+	"github.com/stretchr/testify/assert"
+
+	v1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
+)
+
+func TestPlaceExplicit(t *testing.T) {
+	newTables := func(hosts ...string) *v1pb.PlacementTables {
+		tables := &v1pb.PlacementTables{Entries: make(map[string]*v1pb.PlacementTable)}
+		for _, actorType := range []string{"coordinator", "pinned", "sharded"} {
+			table := &v1pb.PlacementTable{LoadMap: make(map[string]*v1pb.Host)}
+			for _, host := range hosts {
+				table.LoadMap[host] = &v1pb.Host{Name: host}
+			}
+			tables.Entries[actorType] = table
+		}
+		return tables
+	}
+	hostsOf := func(tables *v1pb.PlacementTables, actorType string) []string {
+		return slices.Collect(maps.Keys(tables.GetEntries()[actorType].GetLoadMap()))
+	}
+	streams := []daprdStream{
+		{hostName: "a:1", labels: map[string]string{"tier": "data"}},
+		{hostName: "b:1", labels: map[string]string{"tier": "control"}},
+		{hostName: "c:1", labels: map[string]string{"tier": "control", "disk": "ssd"}},
+		{hostName: "d:1"},
+	}
+
+	p := &Service{
+		explicit: ExplicitPlacement{
+			Singletons: []string{"coordinator"},
+			HostLabels: map[string]map[string]string{
+				"coordinator": {"tier": "control"},
+				"pinned":      {"tier": "control", "disk": "ssd"},
+			},
+		},
+		singletonLeases: *haxmap.New[string, *singletonLeases](),
+	}
+
+	t.Run("actor types are placed explicitly", func(t *testing.T) {
+		tables := newTables("b:1", "c:1", "a:1", "d:1")
+		p.placeExplicit("ns", tables, streams)
+		assert.ElementsMatch(t, []string{"b:1"}, hostsOf(tables, "coordinator"))
+		assert.ElementsMatch(t, []string{"c:1"}, hostsOf(tables, "pinned"))
+		assert.ElementsMatch(t, []string{"a:1", "b:1", "c:1", "d:1"}, hostsOf(tables, "sharded"))
+	})
+
+	t.Run("lease holder keeps the singleton", func(t *testing.T) {
+		tables := newTables("a:1", "b:1", "c:1", "d:1", "0:1")
+		p.placeExplicit("ns", tables, append(streams, daprdStream{hostName: "0:1", labels: map[string]string{"tier": "control"}}))
+		assert.ElementsMatch(t, []string{"b:1"}, hostsOf(tables, "coordinator"))
+	})
+
+	t.Run("lease moves when the holder leaves", func(t *testing.T) {
+		tables := newTables("a:1", "c:1", "d:1")
+		p.placeExplicit("ns", tables, streams)
+		assert.ElementsMatch(t, []string{"c:1"}, hostsOf(tables, "coordinator"))
+	})
+
+	t.Run("no host has the labels", func(t *testing.T) {
+		tables := newTables("a:1", "d:1")
+		p.placeExplicit("other", tables, streams)
+		assert.Empty(t, hostsOf(tables, "coordinator"))
+		assert.Empty(t, hostsOf(tables, "pinned"))
+	})
+}
@@ -49,6 +49,9 @@ type Host struct {
 	Port  int64
 	Load  int64
 	AppID string
+	// Weight is the percentage of the virtual nodes of the replication factor
+	// the host gets in the ring. Zero means 100.
+	Weight int64
 }
 
 // Consistent represents a data structure for consistent hashing.
@@ -91,8 +94,9 @@ func NewFromExisting(loadMap map[string]*Host, replicationFactor int64, virtualN
 		replicationFactor: replicationFactor,
 	}
 
-	for hostName := range loadMap {
+	for hostName, host := range loadMap {
 		hashes := virtualNodesCache.GetHashes(replicationFactor, hostName)
+		hashes = hashes[:virtualNodes(replicationFactor, host.Weight)]
 		for _, h := range hashes {
 			newHash.hosts[h] = hostName
 		}
@@ -107,6 +111,15 @@ func NewFromExisting(loadMap map[string]*Host, replicationFactor int64, virtualN
 	return newHash
 }
 
+// virtualNodes returns the number of virtual nodes of a host with a weight.
+// Weighted hosts keep at least one virtual node.
+func virtualNodes(replicationFactor int64, weight int64) int64 {
+	if weight <= 0 || weight >= 100 {
+		return replicationFactor
+	}
+	return max(1, replicationFactor*weight/100)
+}
+
 // VirtualNodesCache data example:
 //
 //	100 -> (the replication factor)
@@ -231,6 +244,38 @@ func (c *Consistent) GetHost(key string) (*Host, error) {
 	return c.loadMap[h], nil
 }
 
+// GetReplicas returns up to n distinct hosts which can serve `key`, walking
+// the ring from the position of the key. The first host is the owner of the
+// key, as returned by GetHost.
+//
+// It returns ErrNoHosts if the ring has no hosts in it.
+func (c *Consistent) GetReplicas(key string, n int) ([]*Host, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	if len(c.hosts) == 0 {
+		return nil, ErrNoHosts
+	}
+
+	n = min(n, len(c.loadMap))
+	replicas := make([]*Host, 0, n)
+	seen := make(map[string]struct{}, n)
+
+	idx := c.search(hash(key))
+	for i := 0; i < len(c.sortedSet) && len(replicas) < n; i++ {
+		name := c.hosts[c.sortedSet[(idx+i)%len(c.sortedSet)]]
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		if host, ok := c.loadMap[name]; ok {
+			replicas = append(replicas, host)
+		}
+	}
+
+	return replicas, nil
+}
+
 // GetLeast uses Consistent Hashing With Bounded loads
 //
 // https://research.googleblog.com/2017/04/consistent-hashing-with-bounded-loads.html
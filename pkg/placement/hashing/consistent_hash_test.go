@@ -65,6 +65,57 @@ func TestReplicationFactor(t *testing.T) {
 	})
 }
 
+func TestGetReplicas(t *testing.T) {
+	loadMap := make(map[string]*Host, len(nodes))
+	for _, node := range nodes {
+		loadMap[node] = NewHost(node, node, 0, 1)
+	}
+	h := NewFromExisting(loadMap, 100, NewVirtualNodesCache())
+
+	for i := range 100 {
+		key := strconv.Itoa(i)
+		owner, err := h.GetHost(key)
+		require.NoError(t, err)
+
+		replicas, err := h.GetReplicas(key, 3)
+		require.NoError(t, err)
+		require.Len(t, replicas, 3)
+		assert.Equal(t, owner, replicas[0])
+		assert.NotEqual(t, replicas[0].Name, replicas[1].Name)
+		assert.NotEqual(t, replicas[0].Name, replicas[2].Name)
+		assert.NotEqual(t, replicas[1].Name, replicas[2].Name)
+	}
+
+	replicas, err := h.GetReplicas("key", 10)
+	require.NoError(t, err)
+	assert.Len(t, replicas, len(nodes))
+
+	_, err = NewConsistentHash(100).GetReplicas("key", 2)
+	require.ErrorIs(t, err, ErrNoHosts)
+}
+
+func TestWeightedHosts(t *testing.T) {
+	loadMap := make(map[string]*Host, len(nodes))
+	for _, node := range nodes {
+		loadMap[node] = NewHost(node, node, 0, 1)
+	}
+	loadMap["node1"].Weight = 10
+	h := NewFromExisting(loadMap, 100, NewVirtualNodesCache())
+
+	owned := map[string]int{}
+	for _, hostName := range h.hosts {
+		owned[hostName]++
+	}
+	assert.Equal(t, 10, owned["node1"])
+	assert.Equal(t, 100, owned["node2"])
+	assert.Len(t, h.sortedSet, 410)
+
+	assert.Equal(t, int64(100), virtualNodes(100, 0))
+	assert.Equal(t, int64(100), virtualNodes(100, 100))
+	assert.Equal(t, int64(1), virtualNodes(100, 1))
+	assert.Equal(t, int64(1), virtualNodes(10, 5))
+}
+
 func TestGetAndSetVirtualNodeCacheHashes(t *testing.T) {
 	cache := NewVirtualNodesCache()
 
@@ -22,6 +22,7 @@ import (
 
 	"google.golang.org/grpc/peer"
 
+	"github.com/dapr/dapr/pkg/placement/delta"
 	"github.com/dapr/dapr/pkg/placement/monitoring"
 	"github.com/dapr/dapr/pkg/placement/raft"
 	v1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
@@ -39,6 +40,8 @@ func (p *Service) membershipChangeWorker(ctx context.Context) {
 		val, _ := p.memberUpdateCount.GetOrSet(ns, &atomic.Uint32{})
 		val.Store(0)
 	})
+	p.rebalances.Clear()
+	p.singletonLeases.Clear()
 
 	p.wg.Add(1)
 	go func() {
@@ -176,6 +179,17 @@ func (p *Service) membershipUpsertRemove(ctx context.Context, op hostMemberChang
 	return false
 }
 
+// placementTables returns the tables to disseminate to the Dapr runtimes
+// connected in the namespace.
+func (p *Service) placementTables(ns string, streams []daprdStream) *v1pb.PlacementTables {
+	tables := p.raftNode.FSM().PlacementState(ns)
+	p.placeExplicit(ns, tables, streams)
+	if p.zoneAware {
+		preferZone(tables, streams)
+	}
+	return tables
+}
+
 func (p *Service) isLastMemberInNamespace(op hostMemberChange) bool {
 	return p.raftNode.FSM().State().MemberCountInNamespace(op.host.Namespace) == 0
 }
@@ -187,6 +201,8 @@ func (p *Service) handleLastDisconnectedMemberInNamespace(op hostMemberChange) {
 	// - prevent next dissemination, because there are no more hosts in the namespace
 	p.disseminateLocks.Delete(op.host.Namespace)
 	p.memberUpdateCount.Del(op.host.Namespace)
+	p.rebalances.Del(op.host.Namespace)
+	p.singletonLeases.Del(op.host.Namespace)
 }
 
 func (p *Service) performTableDissemination(ctx context.Context, ns string) error {
@@ -216,15 +232,13 @@ func (p *Service) performTableDissemination(ctx context.Context, ns string) erro
 
 	// Get a snapshot copy of the current streams, so we don't have to
 	// lock them while we're doing the dissemination (long operation)
-	streams := make([]daprdStream, 0, p.streamConnPool.getStreamCount(ns))
-	p.streamConnPool.forEachInNamespace(ns, func(_ uint32, stream *daprdStream) {
-		streams = append(streams, *stream)
-	})
+	streams := p.streamConnPool.snapshot(ns)
 
 	req := &tablesUpdateRequest{
-		hosts: streams,
+		hosts:  streams,
+		tables: p.placementTables(ns, streams),
 	}
-	req.tables = p.raftNode.FSM().PlacementState(ns)
+	pending := p.throttleRebalance(ns, req.tables)
 
 	numActorTypesInNamespace := p.raftNode.FSM().State().MemberCountInNamespace(ns)
 	log.Infof(
@@ -238,6 +252,14 @@ func (p *Service) performTableDissemination(ctx context.Context, ns string) erro
 	log.Infof(
 		"Completed dissemination for namespace %s. memberUpdateCount: %d, streams: %d, actor types: %d, table generation: %s",
 		ns, cnt, nStreamConnPool, numActorTypesInNamespace, req.GetVersion())
+	p.setLastTables(ns, req.tables)
+
+	// Keep the member updates pending until the hosts held back have joined.
+	if pending {
+		p.scheduleRebalance(ctx, ns)
+		return nil
+	}
+
 	if val, ok := p.memberUpdateCount.Get(ns); ok {
 		val.Store(0)
 	}
@@ -255,6 +277,7 @@ func (p *Service) performTablesUpdate(parentCtx context.Context, req *tablesUpda
 	// Enforce maximum API level
 	if req.tables != nil {
 		req.SetAPILevel(p.minAPILevel, p.maxAPILevel)
+		req.computeDeltas()
 	}
 
 	ctx, cancel := context.WithTimeout(parentCtx, 15*time.Second)
@@ -283,7 +306,17 @@ func (p *Service) disseminateOperationOnHosts(ctx context.Context, req *tablesUp
 
 	for i := range req.hosts {
 		go func(i int) {
-			errCh <- p.disseminateOperation(ctx, req.hosts[i], operation, req.tables)
+			if operation != updateOperation {
+				errCh <- p.disseminateOperation(ctx, req.hosts[i], operation, nil)
+				return
+			}
+
+			op, tables := req.update(req.hosts[i])
+			err := p.disseminateOperation(ctx, req.hosts[i], op, tables)
+			if err == nil {
+				req.hosts[i].disseminated.set(req.tables)
+			}
+			errCh <- err
 		}(i)
 	}
 
@@ -301,7 +334,7 @@ func (p *Service) disseminateOperation(ctx context.Context, target daprdStream,
 	o := &v1pb.PlacementOrder{
 		Operation: operation,
 	}
-	if operation == updateOperation {
+	if operation == updateOperation || operation == delta.UpdateOperation {
 		o.Tables = tables
 	}
 
@@ -32,6 +32,7 @@ import (
 	"k8s.io/utils/clock"
 
 	"github.com/dapr/dapr/pkg/healthz"
+	"github.com/dapr/dapr/pkg/placement/delta"
 	"github.com/dapr/dapr/pkg/placement/monitoring"
 	"github.com/dapr/dapr/pkg/placement/raft"
 	placementv1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
@@ -70,6 +71,41 @@ type hostMemberChange struct {
 type tablesUpdateRequest struct {
 	hosts  []daprdStream
 	tables *placementv1pb.PlacementTables
+	// deltas are the deltas updating the tables last sent to the hosts to the
+	// tables, by the tables they are based on.
+	deltas map[*placementv1pb.PlacementTables]*placementv1pb.PlacementTables
+}
+
+// computeDeltas computes the deltas to send to the hosts supporting them. The
+// tables are sent in full to the other hosts, and to the hosts which didn't
+// receive any tables yet.
+func (r *tablesUpdateRequest) computeDeltas() {
+	r.deltas = make(map[*placementv1pb.PlacementTables]*placementv1pb.PlacementTables)
+	for _, host := range r.hosts {
+		if !host.supportsDelta {
+			continue
+		}
+		base := host.disseminated.get()
+		if base == nil || base == r.tables {
+			continue
+		}
+		if _, ok := r.deltas[base]; ok {
+			continue
+		}
+		if d, ok := delta.Diff(base, r.tables); ok {
+			r.deltas[base] = d
+		}
+	}
+}
+
+// update returns the operation and the tables updating the host.
+func (r *tablesUpdateRequest) update(host daprdStream) (string, *placementv1pb.PlacementTables) {
+	if host.supportsDelta {
+		if d, ok := r.deltas[host.disseminated.get()]; ok {
+			return delta.UpdateOperation, d
+		}
+	}
+	return updateOperation, r.tables
 }
 
 // GetVersion is used only for logs in membership.go
@@ -130,10 +166,30 @@ type Service struct {
 	// Default is 2 seconds
 	disseminateTimeout time.Duration
 
+	// zoneAware restricts the hosts of each actor type to the zone most of the
+	// connected Dapr runtimes are in, when any of the hosts is in that zone.
+	zoneAware bool
+
+	// explicit declares the actor types placed explicitly, rather than by the
+	// hash of the actor IDs.
+	explicit ExplicitPlacement
+	// singletonLeases holds the leases of the singleton actor types, by
+	// namespace.
+	singletonLeases haxmap.Map[string, *singletonLeases]
+
 	// memberUpdateCount represents how many dapr runtimes needs to change in a namespace.
 	// Only actor runtime's heartbeat can increase this.
 	memberUpdateCount haxmap.Map[string, *atomic.Uint32]
 
+	// rebalances holds the state of the rebalances of the actors, by namespace.
+	rebalances haxmap.Map[string, *rebalanceState]
+	// maxPartitionMoves is the maximum number of actor partitions moving
+	// between hosts in a dissemination round. Unlimited when not positive.
+	maxPartitionMoves int
+	// drainBudget is the time the hosts losing actor partitions have to drain
+	// them before more partitions move.
+	drainBudget time.Duration
+
 	// Maximum API level to return.
 	// If nil, there's no limit.
 	maxAPILevel *uint32
@@ -171,6 +227,10 @@ type ServiceOpts struct {
 	KeepAliveTime      time.Duration
 	KeepAliveTimeout   time.Duration
 	DisseminateTimeout time.Duration
+	ZoneAware          bool
+	MaxPartitionMoves  int
+	DrainBudget        time.Duration
+	ExplicitPlacement  ExplicitPlacement
 	Raft               raft.Options
 }
 
@@ -204,9 +264,15 @@ func New(opts ServiceOpts) (*Service, error) {
 		sec:                opts.SecProvider,
 		disseminateLocks:   cmap.NewMutex[string](),
 		memberUpdateCount:  *haxmap.New[string, *atomic.Uint32](),
+		rebalances:         *haxmap.New[string, *rebalanceState](),
+		maxPartitionMoves:  opts.MaxPartitionMoves,
+		drainBudget:        opts.DrainBudget,
 		keepAliveTime:      opts.KeepAliveTime,
 		keepAliveTimeout:   opts.KeepAliveTimeout,
 		disseminateTimeout: opts.DisseminateTimeout,
+		zoneAware:          opts.ZoneAware,
+		explicit:           opts.ExplicitPlacement,
+		singletonLeases:    *haxmap.New[string, *singletonLeases](),
 		port:               opts.Port,
 		listenAddress:      opts.ListenAddress,
 		htarget:            opts.Healthz.AddTarget("placement-service"),
@@ -381,6 +447,15 @@ func (p *Service) ReportDaprStatus(stream placementv1pb.Placement_ReportDaprStat
 
 			host := in.host
 
+			// The Dapr runtime couldn't apply a delta update, so it needs the full
+			// tables.
+			if host.GetFullSync() {
+				log.Debugf("Full tables requested by %s in namespace %s", hostName, namespace)
+				if err = p.sendLastTables(namespace, daprStream); err != nil {
+					log.Errorf("Failed to send the full tables to %s: %v", hostName, err)
+				}
+			}
+
 			if !requiresUpdateInPlacementTables(host, &isActorHost) {
 				continue
 			}
@@ -470,19 +545,28 @@ func (p *Service) handleNewConnection(req *placementv1pb.Host, daprStream *daprd
 	// This is safe to do without locking all the streams, because we're not changing the state
 	// If the member does host actors, we need to update the state first, and then we'll disseminate in the next disseminate interval
 	if len(req.GetEntities()) == 0 {
-		updateReq := &tablesUpdateRequest{
-			hosts: []daprdStream{*daprStream},
-		}
-		updateReq.tables = p.raftNode.FSM().PlacementState(req.GetNamespace())
-		err = p.performTablesUpdate(context.Background(), updateReq)
-		if err != nil {
-			return err
-		}
+		return p.sendLastTables(req.GetNamespace(), daprStream)
 	}
 
 	return nil
 }
 
+// sendLastTables sends the tables last disseminated in the namespace in full
+// to a Dapr runtime.
+func (p *Service) sendLastTables(namespace string, daprStream *daprdStream) error {
+	daprStream.disseminated.set(nil)
+
+	// Send the tables last disseminated, which may hold back hosts joining.
+	tables, ok := p.lastTables(namespace)
+	if !ok {
+		tables = p.placementTables(namespace, p.streamConnPool.snapshot(namespace))
+	}
+	return p.performTablesUpdate(context.Background(), &tablesUpdateRequest{
+		hosts:  []daprdStream{*daprStream},
+		tables: tables,
+	})
+}
+
 func (p *Service) checkAPILevel(req *placementv1pb.Host) error {
 	clusterAPILevel := max(p.minAPILevel, p.raftNode.FSM().State().APILevel())
 
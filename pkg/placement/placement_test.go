@@ -33,6 +33,7 @@ import (
 	clocktesting "k8s.io/utils/clock/testing"
 
 	"github.com/dapr/dapr/pkg/healthz"
+	"github.com/dapr/dapr/pkg/placement/delta"
 	"github.com/dapr/dapr/pkg/placement/raft"
 	"github.com/dapr/dapr/pkg/placement/tests"
 	v1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
@@ -245,3 +246,48 @@ func TestRequiresUpdateInPlacementTables(t *testing.T) {
 		})
 	}
 }
+
+func TestTablesUpdateRequestDeltas(t *testing.T) {
+	base := &v1pb.PlacementTables{
+		Version:           "1",
+		ReplicationFactor: 100,
+		Entries: map[string]*v1pb.PlacementTable{
+			"type1": {LoadMap: map[string]*v1pb.Host{"a:1": {Name: "a:1"}}},
+		},
+	}
+	tables := &v1pb.PlacementTables{
+		Version:           "2",
+		ReplicationFactor: 100,
+		Entries: map[string]*v1pb.PlacementTable{
+			"type1": {LoadMap: map[string]*v1pb.Host{"a:1": {Name: "a:1"}}},
+			"type2": {LoadMap: map[string]*v1pb.Host{"b:1": {Name: "b:1"}}},
+		},
+	}
+
+	newStream := func(supportsDelta bool, last *v1pb.PlacementTables) daprdStream {
+		s := daprdStream{supportsDelta: supportsDelta, disseminated: new(disseminatedTables)}
+		s.disseminated.set(last)
+		return s
+	}
+	upToDate := newStream(true, base)
+	legacy := newStream(false, base)
+	connected := newStream(true, nil)
+
+	req := &tablesUpdateRequest{hosts: []daprdStream{upToDate, legacy, connected}, tables: tables}
+	req.computeDeltas()
+
+	op, sent := req.update(upToDate)
+	assert.Equal(t, delta.UpdateOperation, op)
+	assert.Equal(t, "2", sent.GetVersion())
+	assert.Equal(t, "1", sent.GetBaseVersion())
+	assert.Len(t, sent.GetEntries(), 1)
+	assert.Contains(t, sent.GetEntries(), "type2")
+
+	op, sent = req.update(legacy)
+	assert.Equal(t, updateOperation, op)
+	assert.Same(t, tables, sent)
+
+	op, sent = req.update(connected)
+	assert.Equal(t, updateOperation, op)
+	assert.Same(t, tables, sent)
+}
@@ -18,7 +18,9 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/dapr/dapr/pkg/placement/delta"
 	"github.com/dapr/dapr/pkg/placement/monitoring"
+	"github.com/dapr/dapr/pkg/placement/topology"
 	placementv1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
 )
 
@@ -32,13 +34,36 @@ type daprdStream struct {
 	hostName      string
 	hostID        string
 	hostNamespace string
-	stream        placementv1pb.Placement_ReportDaprStatusServer
-	cancelFn      context.CancelFunc
-	recvCh        chan recvResult
+	// zone is the zone reported by the Dapr runtime when it registered.
+	zone string
+	// labels are the labels reported by the Dapr runtime when it registered.
+	labels map[string]string
+	// supportsDelta is true if the Dapr runtime supports delta updates of the
+	// tables.
+	supportsDelta bool
+	// disseminated holds the tables the Dapr runtime last received.
+	disseminated *disseminatedTables
+	stream       placementv1pb.Placement_ReportDaprStatusServer
+	cancelFn     context.CancelFunc
+	recvCh       chan recvResult
 }
 
 func newDaprdStream(host *placementv1pb.Host, stream placementv1pb.Placement_ReportDaprStatusServer, cancel context.CancelFunc) *daprdStream {
+	var (
+		zone          string
+		labels        map[string]string
+		supportsDelta bool
+	)
+	if stream != nil {
+		zone = topology.ZoneFromContext(stream.Context())
+		labels = topology.LabelsFromContext(stream.Context())
+		supportsDelta = delta.Supported(stream.Context())
+	}
 	return &daprdStream{
+		zone:          zone,
+		labels:        labels,
+		supportsDelta: supportsDelta,
+		disseminated:  new(disseminatedTables),
 		hostID:        host.GetId(),
 		hostName:      host.GetName(),
 		hostNamespace: host.GetNamespace(),
@@ -48,6 +73,31 @@ func newDaprdStream(host *placementv1pb.Host, stream placementv1pb.Placement_Rep
 	}
 }
 
+// disseminatedTables holds the tables last sent to a Dapr runtime, which the
+// delta updates sent to it are based on.
+type disseminatedTables struct {
+	lock   sync.Mutex
+	tables *placementv1pb.PlacementTables
+}
+
+func (d *disseminatedTables) get() *placementv1pb.PlacementTables {
+	if d == nil {
+		return nil
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.tables
+}
+
+func (d *disseminatedTables) set(tables *placementv1pb.PlacementTables) {
+	if d == nil {
+		return
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.tables = tables
+}
+
 // streamConnPool has the stream connections established between the placement gRPC server
 // and the Dapr runtime grouped by namespace, with an assigned id for faster lookup/deletion
 // The id is a simple auto-incrementing number, for efficiency.
@@ -131,6 +181,16 @@ func (s *streamConnPool) forEachInNamespace(namespace string, fn func(key uint32
 	}
 }
 
+// snapshot returns a copy of the streams in the namespace, so they don't have
+// to be locked during long operations.
+func (s *streamConnPool) snapshot(namespace string) []daprdStream {
+	streams := make([]daprdStream, 0, s.getStreamCount(namespace))
+	s.forEachInNamespace(namespace, func(_ uint32, stream *daprdStream) {
+		streams = append(streams, *stream)
+	})
+	return streams
+}
+
 func (s *streamConnPool) getStream(stream placementv1pb.Placement_ReportDaprStatusServer) (*daprdStream, bool) {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
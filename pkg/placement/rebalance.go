@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placement
+
+import (
+	"context"
+	"maps"
+	"math"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dapr/dapr/pkg/placement/raft"
+	v1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
+)
+
+// rebalanceState is the state of the rebalances of the actors in a namespace.
+type rebalanceState struct {
+	// tables are the tables last disseminated in the namespace.
+	tables atomic.Pointer[v1pb.PlacementTables]
+	// movedAt is the last time actor partitions moved between hosts.
+	movedAt time.Time
+	// scheduled is true when a dissemination of the hosts held back is
+	// scheduled.
+	scheduled atomic.Bool
+}
+
+// throttleRebalance holds back the hosts joining the actor types in the
+// tables once the maximum number of actor partitions moving in a round is
+// reached, or while the hosts which last lost actor partitions are within
+// their drain budget. It returns true if hosts were held back, which join in
+// a later round. A partition is the share of an actor type a host owns.
+// Partitions moving away from hosts which left can't be held back.
+func (p *Service) throttleRebalance(ns string, tables *v1pb.PlacementTables) bool {
+	if p.maxPartitionMoves <= 0 && p.drainBudget <= 0 {
+		return false
+	}
+
+	state, _ := p.rebalances.GetOrSet(ns, &rebalanceState{})
+	last := state.tables.Load()
+	if last == nil {
+		// Nothing to move on the first round.
+		return false
+	}
+
+	budget := p.maxPartitionMoves
+	if budget <= 0 {
+		budget = math.MaxInt
+	}
+	if p.drainBudget > 0 && p.clock.Since(state.movedAt) < p.drainBudget {
+		budget = 0
+	}
+
+	var (
+		moves   int
+		moved   bool
+		pending bool
+	)
+	for _, actorType := range slices.Sorted(maps.Keys(tables.GetEntries())) {
+		table := tables.GetEntries()[actorType]
+		lastHosts := last.GetEntries()[actorType].GetLoadMap()
+
+		var remaining int
+		for name := range table.GetLoadMap() {
+			if _, ok := lastHosts[name]; ok {
+				remaining++
+			}
+		}
+		if remaining < len(lastHosts) {
+			moved = true
+		}
+		if remaining == 0 {
+			// The actor type has no hosts to move partitions from.
+			continue
+		}
+
+		for _, name := range slices.Sorted(maps.Keys(table.GetLoadMap())) {
+			if _, ok := lastHosts[name]; ok {
+				continue
+			}
+			if moves < budget {
+				moves++
+				continue
+			}
+			delete(table.LoadMap, name)
+			pending = true
+		}
+	}
+
+	if moved || moves > 0 {
+		state.movedAt = p.clock.Now()
+	}
+
+	return pending
+}
+
+// scheduleRebalance schedules a dissemination of the hosts held back in the
+// namespace, once the drain budget of the last round is spent.
+func (p *Service) scheduleRebalance(ctx context.Context, ns string) {
+	state, ok := p.rebalances.Get(ns)
+	if !ok || !state.scheduled.CompareAndSwap(false, true) {
+		return
+	}
+
+	delay := max(p.drainBudget-p.clock.Since(state.movedAt), p.disseminateTimeout)
+	log.Debugf("Scheduling the dissemination of the hosts held back in namespace %s in %s", ns, delay)
+
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		defer state.scheduled.Store(false)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-p.clock.After(delay):
+		}
+
+		select {
+		case <-ctx.Done():
+		case p.membershipCh <- hostMemberChange{
+			cmdType: raft.TableDisseminate,
+			host:    raft.DaprHostMember{Namespace: ns},
+		}:
+		}
+	}()
+}
+
+// setLastTables records the tables last disseminated in the namespace.
+func (p *Service) setLastTables(ns string, tables *v1pb.PlacementTables) {
+	state, _ := p.rebalances.GetOrSet(ns, &rebalanceState{})
+	state.tables.Store(tables)
+}
+
+// lastTables returns a copy of the tables last disseminated in the namespace.
+func (p *Service) lastTables(ns string) (*v1pb.PlacementTables, bool) {
+	state, ok := p.rebalances.Get(ns)
+	if !ok {
+		return nil, false
+	}
+	tables := state.tables.Load()
+	if tables == nil {
+		return nil, false
+	}
+	return proto.Clone(tables).(*v1pb.PlacementTables), true
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placement
+
+import (
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/alphadose/haxmap"
+	"github.com/stretchr/testify/assert"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	v1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
+)
+
+func TestThrottleRebalance(t *testing.T) {
+	newTables := func(hosts ...string) *v1pb.PlacementTables {
+		table := &v1pb.PlacementTable{LoadMap: make(map[string]*v1pb.Host)}
+		for _, h := range hosts {
+			table.LoadMap[h] = &v1pb.Host{Name: h}
+		}
+		return &v1pb.PlacementTables{Entries: map[string]*v1pb.PlacementTable{"type1": table}}
+	}
+	hosts := func(tables *v1pb.PlacementTables) []string {
+		return slices.Sorted(maps.Keys(tables.GetEntries()["type1"].GetLoadMap()))
+	}
+	newService := func(maxMoves int, drainBudget time.Duration) (*Service, *clocktesting.FakeClock) {
+		clock := clocktesting.NewFakeClock(time.Now())
+		return &Service{
+			clock:             clock,
+			rebalances:        *haxmap.New[string, *rebalanceState](),
+			maxPartitionMoves: maxMoves,
+			drainBudget:       drainBudget,
+		}, clock
+	}
+
+	t.Run("all hosts join on the first round", func(t *testing.T) {
+		p, _ := newService(1, 0)
+		tables := newTables("a", "b", "c")
+		assert.False(t, p.throttleRebalance("ns", tables))
+		assert.Equal(t, []string{"a", "b", "c"}, hosts(tables))
+	})
+
+	t.Run("hosts beyond the maximum moves are held back", func(t *testing.T) {
+		p, _ := newService(1, 0)
+		p.setLastTables("ns", newTables("a"))
+
+		tables := newTables("a", "b", "c")
+		assert.True(t, p.throttleRebalance("ns", tables))
+		assert.Equal(t, []string{"a", "b"}, hosts(tables))
+		p.setLastTables("ns", tables)
+
+		tables = newTables("a", "b", "c")
+		assert.False(t, p.throttleRebalance("ns", tables))
+		assert.Equal(t, []string{"a", "b", "c"}, hosts(tables))
+	})
+
+	t.Run("hosts are held back within the drain budget", func(t *testing.T) {
+		p, clock := newService(0, time.Minute)
+		p.setLastTables("ns", newTables("a", "b"))
+
+		// The partitions of the host leaving move.
+		tables := newTables("a")
+		assert.False(t, p.throttleRebalance("ns", tables))
+		p.setLastTables("ns", tables)
+
+		tables = newTables("a", "c")
+		assert.True(t, p.throttleRebalance("ns", tables))
+		assert.Equal(t, []string{"a"}, hosts(tables))
+
+		clock.Step(time.Minute)
+		tables = newTables("a", "c")
+		assert.False(t, p.throttleRebalance("ns", tables))
+		assert.Equal(t, []string{"a", "c"}, hosts(tables))
+	})
+
+	t.Run("new actor types aren't throttled", func(t *testing.T) {
+		p, _ := newService(1, 0)
+		p.setLastTables("ns", &v1pb.PlacementTables{})
+		tables := newTables("a", "b")
+		assert.False(t, p.throttleRebalance("ns", tables))
+		assert.Equal(t, []string{"a", "b"}, hosts(tables))
+	})
+}
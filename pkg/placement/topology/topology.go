@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package topology contains the topology labels the Dapr runtimes report to
+// the placement service when they register.
+package topology
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// ZoneMetadataKey is the gRPC metadata key of the placement stream holding
+	// the zone of the Dapr runtime.
+	ZoneMetadataKey = "dapr-placement-zone"
+
+	// LabelsMetadataKey is the gRPC metadata key of the placement stream
+	// holding the labels of the Dapr runtime, as "key=value" values.
+	LabelsMetadataKey = "dapr-placement-labels"
+)
+
+// WithZone returns a context reporting the zone of the Dapr runtime on the
+// placement stream it opens.
+func WithZone(ctx context.Context, zone string) context.Context {
+	if zone == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, ZoneMetadataKey, zone)
+}
+
+// ZoneFromContext returns the zone reported by a Dapr runtime on a placement
+// stream, or an empty string if it didn't report any.
+func ZoneFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if v := md.Get(ZoneMetadataKey); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+// WithLabels returns a context reporting the labels of the Dapr runtime on the
+// placement stream it opens.
+func WithLabels(ctx context.Context, labels map[string]string) context.Context {
+	for k, v := range labels {
+		ctx = metadata.AppendToOutgoingContext(ctx, LabelsMetadataKey, k+"="+v)
+	}
+	return ctx
+}
+
+// LabelsFromContext returns the labels reported by a Dapr runtime on a
+// placement stream, or nil if it didn't report any.
+func LabelsFromContext(ctx context.Context) map[string]string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+	return parseLabels(md.Get(LabelsMetadataKey))
+}
+
+// ParseLabels parses labels in the "key1=value1,key2=value2" format. Entries
+// without a key are ignored.
+func ParseLabels(val string) map[string]string {
+	if val == "" {
+		return nil
+	}
+	return parseLabels(strings.Split(val, ","))
+}
+
+func parseLabels(vals []string) map[string]string {
+	var labels map[string]string
+	for _, val := range vals {
+		k, v, _ := strings.Cut(val, "=")
+		k = strings.TrimSpace(k)
+		if k == "" {
+			continue
+		}
+		if labels == nil {
+			labels = make(map[string]string, len(vals))
+		}
+		labels[k] = strings.TrimSpace(v)
+	}
+	return labels
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestZone(t *testing.T) {
+	ctx := WithZone(t.Context(), "zone-1")
+	md, _ := metadata.FromOutgoingContext(ctx)
+	assert.Equal(t, "zone-1", ZoneFromContext(metadata.NewIncomingContext(t.Context(), md)))
+
+	assert.Equal(t, t.Context(), WithZone(t.Context(), ""))
+	assert.Empty(t, ZoneFromContext(t.Context()))
+}
+
+func TestLabels(t *testing.T) {
+	ctx := WithLabels(t.Context(), map[string]string{"tier": "control", "disk": "ssd"})
+	md, _ := metadata.FromOutgoingContext(ctx)
+	assert.Equal(t, map[string]string{"tier": "control", "disk": "ssd"}, LabelsFromContext(metadata.NewIncomingContext(t.Context(), md)))
+	assert.Nil(t, LabelsFromContext(t.Context()))
+
+	assert.Equal(t, map[string]string{"tier": "control", "disk": ""}, ParseLabels("tier = control,disk,=ignored"))
+	assert.Nil(t, ParseLabels(""))
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placement
+
+import (
+	v1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
+)
+
+// minZoneWeight is the weight of the hosts in the zones with the fewest
+// callers, so they keep a share of the actors.
+const minZoneWeight = 10
+
+// preferZone weights the hosts of each actor type in the tables by the number
+// of connected Dapr runtimes, which are the callers of the actors, in their
+// zone. The hosts in the zone with most callers get the largest share of the
+// actors, while the hosts in the other zones keep a share proportional to
+// their callers, so the actors aren't all moved when a zone is lost. Hosts
+// without a zone aren't weighted. Every runtime receives the same tables, so
+// they all agree on the host of each actor.
+func preferZone(tables *v1pb.PlacementTables, streams []daprdStream) {
+	weights := zoneWeights(streams)
+	if len(weights) <= 1 {
+		return
+	}
+
+	hostZones := make(map[string]string, len(streams))
+	for _, stream := range streams {
+		hostZones[stream.hostName] = stream.zone
+	}
+
+	for _, table := range tables.GetEntries() {
+		for name, host := range table.GetLoadMap() {
+			if weight, ok := weights[hostZones[name]]; ok {
+				host.Weight = weight
+			}
+		}
+	}
+}
+
+// zoneWeights returns the weight of the hosts in each zone reported by the
+// connected Dapr runtimes, as the percentage of their callers relative to the
+// zone with most callers.
+func zoneWeights(streams []daprdStream) map[string]uint32 {
+	counts := make(map[string]int)
+	var most int
+	for _, stream := range streams {
+		if stream.zone != "" {
+			counts[stream.zone]++
+			most = max(most, counts[stream.zone])
+		}
+	}
+
+	weights := make(map[string]uint32, len(counts))
+	for zone, n := range counts {
+		weights[zone] = uint32(max(minZoneWeight, 100*n/most)) //nolint:gosec
+	}
+	return weights
+}
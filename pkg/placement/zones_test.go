@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
+)
+
+func TestPreferZone(t *testing.T) {
+	newTables := func() *v1pb.PlacementTables {
+		return &v1pb.PlacementTables{Entries: map[string]*v1pb.PlacementTable{
+			"type1": {LoadMap: map[string]*v1pb.Host{
+				"a:1": {Name: "a:1"},
+				"b:1": {Name: "b:1"},
+				"c:1": {Name: "c:1"},
+			}},
+			"type2": {LoadMap: map[string]*v1pb.Host{
+				"c:1": {Name: "c:1"},
+			}},
+		}}
+	}
+	streams := []daprdStream{
+		{hostName: "a:1", zone: "zone-1"},
+		{hostName: "b:1", zone: "zone-1"},
+		{hostName: "c:1", zone: "zone-2"},
+		{hostName: "d:1", zone: "zone-1"},
+	}
+
+	weights := func(tables *v1pb.PlacementTables, actorType string) map[string]uint32 {
+		w := make(map[string]uint32)
+		for name, host := range tables.GetEntries()[actorType].GetLoadMap() {
+			w[name] = host.GetWeight()
+		}
+		return w
+	}
+
+	t.Run("hosts are weighted by the callers in their zone", func(t *testing.T) {
+		tables := newTables()
+		preferZone(tables, streams)
+		assert.Equal(t, map[string]uint32{"a:1": 100, "b:1": 100, "c:1": 33}, weights(tables, "type1"))
+		assert.Equal(t, map[string]uint32{"c:1": 33}, weights(tables, "type2"))
+	})
+
+	t.Run("hosts without a zone are not weighted", func(t *testing.T) {
+		tables := newTables()
+		preferZone(tables, []daprdStream{
+			{hostName: "a:1", zone: "zone-1"},
+			{hostName: "b:1"},
+			{hostName: "c:1", zone: "zone-2"},
+			{hostName: "d:1", zone: "zone-1"},
+		})
+		assert.Equal(t, map[string]uint32{"a:1": 100, "b:1": 0, "c:1": 50}, weights(tables, "type1"))
+	})
+
+	t.Run("single zone", func(t *testing.T) {
+		tables := newTables()
+		preferZone(tables, []daprdStream{{hostName: "a:1", zone: "zone-1"}, {hostName: "b:1", zone: "zone-1"}})
+		assert.Equal(t, map[string]uint32{"a:1": 0, "b:1": 0, "c:1": 0}, weights(tables, "type1"))
+	})
+
+	t.Run("no zones reported", func(t *testing.T) {
+		tables := newTables()
+		preferZone(tables, []daprdStream{{hostName: "a:1"}, {hostName: "b:1"}})
+		assert.Equal(t, map[string]uint32{"a:1": 0, "b:1": 0, "c:1": 0}, weights(tables, "type1"))
+	})
+
+	t.Run("zones with few callers get the minimum weight", func(t *testing.T) {
+		streams := []daprdStream{{hostName: "c:1", zone: "zone-2"}}
+		for range 20 {
+			streams = append(streams, daprdStream{zone: "zone-1"})
+		}
+		assert.Equal(t, map[string]uint32{"zone-1": 100, "zone-2": minZoneWeight}, zoneWeights(streams))
+	})
+}
@@ -207,6 +207,109 @@ func (StateOptions_StateConsistency) EnumDescriptor() ([]byte, []int) {
 	return file_dapr_proto_common_v1_common_proto_rawDescGZIP(), []int{6, 1}
 }
 
+// Backoff is the backoff between the retries.
+type JobFailurePolicyDelivery_Backoff int32
+
+const (
+	// CONSTANT retries at the interval.
+	JobFailurePolicyDelivery_CONSTANT JobFailurePolicyDelivery_Backoff = 0
+	// EXPONENTIAL doubles the delay after each retry, up to the max interval.
+	JobFailurePolicyDelivery_EXPONENTIAL JobFailurePolicyDelivery_Backoff = 1
+)
+
+// Enum value maps for JobFailurePolicyDelivery_Backoff.
+var (
+	JobFailurePolicyDelivery_Backoff_name = map[int32]string{
+		0: "CONSTANT",
+		1: "EXPONENTIAL",
+	}
+	JobFailurePolicyDelivery_Backoff_value = map[string]int32{
+		"CONSTANT":    0,
+		"EXPONENTIAL": 1,
+	}
+)
+
+func (x JobFailurePolicyDelivery_Backoff) Enum() *JobFailurePolicyDelivery_Backoff {
+	p := new(JobFailurePolicyDelivery_Backoff)
+	*p = x
+	return p
+}
+
+func (x JobFailurePolicyDelivery_Backoff) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobFailurePolicyDelivery_Backoff) Descriptor() protoreflect.EnumDescriptor {
+	return file_dapr_proto_common_v1_common_proto_enumTypes[3].Descriptor()
+}
+
+func (JobFailurePolicyDelivery_Backoff) Type() protoreflect.EnumType {
+	return &file_dapr_proto_common_v1_common_proto_enumTypes[3]
+}
+
+func (x JobFailurePolicyDelivery_Backoff) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobFailurePolicyDelivery_Backoff.Descriptor instead.
+func (JobFailurePolicyDelivery_Backoff) EnumDescriptor() ([]byte, []int) {
+	return file_dapr_proto_common_v1_common_proto_rawDescGZIP(), []int{11, 0}
+}
+
+// Action is the action taken once the retries are exhausted.
+type JobFailurePolicyDelivery_Action int32
+
+const (
+	// FAIL reports the failure to the scheduler, which applies the drop or
+	// constant policy of the job.
+	JobFailurePolicyDelivery_FAIL JobFailurePolicyDelivery_Action = 0
+	// SKIP drops the trigger.
+	JobFailurePolicyDelivery_SKIP JobFailurePolicyDelivery_Action = 1
+	// DEAD_LETTER publishes the trigger to the dead letter topic.
+	JobFailurePolicyDelivery_DEAD_LETTER JobFailurePolicyDelivery_Action = 2
+)
+
+// Enum value maps for JobFailurePolicyDelivery_Action.
+var (
+	JobFailurePolicyDelivery_Action_name = map[int32]string{
+		0: "FAIL",
+		1: "SKIP",
+		2: "DEAD_LETTER",
+	}
+	JobFailurePolicyDelivery_Action_value = map[string]int32{
+		"FAIL":        0,
+		"SKIP":        1,
+		"DEAD_LETTER": 2,
+	}
+)
+
+func (x JobFailurePolicyDelivery_Action) Enum() *JobFailurePolicyDelivery_Action {
+	p := new(JobFailurePolicyDelivery_Action)
+	*p = x
+	return p
+}
+
+func (x JobFailurePolicyDelivery_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobFailurePolicyDelivery_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_dapr_proto_common_v1_common_proto_enumTypes[4].Descriptor()
+}
+
+func (JobFailurePolicyDelivery_Action) Type() protoreflect.EnumType {
+	return &file_dapr_proto_common_v1_common_proto_enumTypes[4]
+}
+
+func (x JobFailurePolicyDelivery_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobFailurePolicyDelivery_Action.Descriptor instead.
+func (JobFailurePolicyDelivery_Action) EnumDescriptor() ([]byte, []int) {
+	return file_dapr_proto_common_v1_common_proto_rawDescGZIP(), []int{11, 1}
+}
+
 // HTTPExtension includes HTTP verb and querystring
 // when Dapr runtime delivers HTTP content.
 //
@@ -746,10 +849,13 @@ type JobFailurePolicy struct {
 	// policy is the policy to apply when a job fails to trigger.
 	//
 	// Types that are assignable to Policy:
-	//
 	//	*JobFailurePolicy_Drop
 	//	*JobFailurePolicy_Constant
 	Policy isJobFailurePolicy_Policy `protobuf_oneof:"policy"`
+	// delivery is the optional policy applied by daprd when the app fails to
+	// process a trigger of the job, or is unreachable, before the failure is
+	// reported to the scheduler.
+	Delivery *JobFailurePolicyDelivery `protobuf:"bytes,3,opt,name=delivery,proto3,oneof" json:"delivery,omitempty"`
 }
 
 func (x *JobFailurePolicy) Reset() {
@@ -805,6 +911,13 @@ func (x *JobFailurePolicy) GetConstant() *JobFailurePolicyConstant {
 	return nil
 }
 
+func (x *JobFailurePolicy) GetDelivery() *JobFailurePolicyDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
 type isJobFailurePolicy_Policy interface {
 	isJobFailurePolicy_Policy()
 }
@@ -919,6 +1032,111 @@ func (x *JobFailurePolicyConstant) GetMaxRetries() uint32 {
 	return 0
 }
 
+// JobFailurePolicyDelivery retries the delivery of a job trigger to the app,
+// and decides what happens to the trigger once the retries are exhausted.
+type JobFailurePolicyDelivery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// max_retries is the number of times the delivery is retried.
+	MaxRetries uint32 `protobuf:"varint,1,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// interval is the delay before the first retry. Defaults to 1s.
+	Interval *durationpb.Duration `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// backoff is the backoff between the retries.
+	Backoff JobFailurePolicyDelivery_Backoff `protobuf:"varint,3,opt,name=backoff,proto3,enum=dapr.proto.common.v1.JobFailurePolicyDelivery_Backoff" json:"backoff,omitempty"`
+	// max_interval is the maximum delay between retries with the exponential
+	// backoff. Defaults to 1m.
+	MaxInterval *durationpb.Duration `protobuf:"bytes,4,opt,name=max_interval,json=maxInterval,proto3" json:"max_interval,omitempty"`
+	// action is the action taken once the retries are exhausted.
+	Action JobFailurePolicyDelivery_Action `protobuf:"varint,5,opt,name=action,proto3,enum=dapr.proto.common.v1.JobFailurePolicyDelivery_Action" json:"action,omitempty"`
+	// dead_letter_pubsub and dead_letter_topic are the pub/sub component and
+	// topic the trigger is published to with the DEAD_LETTER action.
+	DeadLetterPubsub string `protobuf:"bytes,6,opt,name=dead_letter_pubsub,json=deadLetterPubsub,proto3" json:"dead_letter_pubsub,omitempty"`
+	DeadLetterTopic  string `protobuf:"bytes,7,opt,name=dead_letter_topic,json=deadLetterTopic,proto3" json:"dead_letter_topic,omitempty"`
+}
+
+func (x *JobFailurePolicyDelivery) Reset() {
+	*x = JobFailurePolicyDelivery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_common_v1_common_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobFailurePolicyDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobFailurePolicyDelivery) ProtoMessage() {}
+
+func (x *JobFailurePolicyDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_common_v1_common_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobFailurePolicyDelivery.ProtoReflect.Descriptor instead.
+func (*JobFailurePolicyDelivery) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_common_v1_common_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *JobFailurePolicyDelivery) GetMaxRetries() uint32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *JobFailurePolicyDelivery) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *JobFailurePolicyDelivery) GetBackoff() JobFailurePolicyDelivery_Backoff {
+	if x != nil {
+		return x.Backoff
+	}
+	return JobFailurePolicyDelivery_CONSTANT
+}
+
+func (x *JobFailurePolicyDelivery) GetMaxInterval() *durationpb.Duration {
+	if x != nil {
+		return x.MaxInterval
+	}
+	return nil
+}
+
+func (x *JobFailurePolicyDelivery) GetAction() JobFailurePolicyDelivery_Action {
+	if x != nil {
+		return x.Action
+	}
+	return JobFailurePolicyDelivery_FAIL
+}
+
+func (x *JobFailurePolicyDelivery) GetDeadLetterPubsub() string {
+	if x != nil {
+		return x.DeadLetterPubsub
+	}
+	return ""
+}
+
+func (x *JobFailurePolicyDelivery) GetDeadLetterTopic() string {
+	if x != nil {
+		return x.DeadLetterTopic
+	}
+	return ""
+}
+
 var File_dapr_proto_common_v1_common_proto protoreflect.FileDescriptor
 
 var file_dapr_proto_common_v1_common_proto_rawDesc = []byte{
@@ -1024,7 +1242,7 @@ var file_dapr_proto_common_v1_common_proto_rawDesc = []byte{
 	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0xac, 0x01, 0x0a, 0x10, 0x4a, 0x6f, 0x62, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f,
+	0x8a, 0x02, 0x0a, 0x10, 0x4a, 0x6f, 0x62, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f,
 	0x6c, 0x69, 0x63, 0x79, 0x12, 0x40, 0x0a, 0x04, 0x64, 0x72, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
 	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x46, 0x61, 0x69,
@@ -1034,25 +1252,63 @@ var file_dapr_proto_common_v1_common_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
 	0x4a, 0x6f, 0x62, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
 	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x16,
-	0x0a, 0x14, 0x4a, 0x6f, 0x62, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x44, 0x72, 0x6f, 0x70, 0x22, 0x87, 0x01, 0x0a, 0x18, 0x4a, 0x6f, 0x62, 0x46, 0x61,
-	0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x24, 0x0a, 0x0b, 0x6d, 0x61,
-	0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48,
-	0x00, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x88, 0x01, 0x01,
-	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73,
-	0x42, 0x69, 0x0a, 0x0a, 0x69, 0x6f, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x0c,
-	0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x5a, 0x2f, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x64, 0x61,
-	0x70, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0xaa, 0x02, 0x1b,
-	0x44, 0x61, 0x70, 0x72, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x75, 0x74, 0x6f,
-	0x67, 0x65, 0x6e, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x74, 0x61, 0x6e, 0x74, 0x12, 0x4f, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f,
+	0x62, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x48, 0x01, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x22, 0x16, 0x0a, 0x14,
+	0x4a, 0x6f, 0x62, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x44, 0x72, 0x6f, 0x70, 0x22, 0x87, 0x01, 0x0a, 0x18, 0x4a, 0x6f, 0x62, 0x46, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x74, 0x12, 0x35, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x24, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f,
+	0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52,
+	0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x84,
+	0x04, 0x0a, 0x18, 0x4a, 0x6f, 0x62, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6d,
+	0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x12, 0x50, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x46,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x52, 0x07, 0x62, 0x61,
+	0x63, 0x6b, 0x6f, 0x66, 0x66, 0x12, 0x3c, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x12, 0x4d, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x5f, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x50, 0x75, 0x62, 0x73, 0x75, 0x62,
+	0x12, 0x2a, 0x0a, 0x11, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x5f,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x61,
+	0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x22, 0x28, 0x0a, 0x07,
+	0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4e, 0x53, 0x54,
+	0x41, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x58, 0x50, 0x4f, 0x4e, 0x45, 0x4e,
+	0x54, 0x49, 0x41, 0x4c, 0x10, 0x01, 0x22, 0x2d, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x08, 0x0a, 0x04, 0x46, 0x41, 0x49, 0x4c, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x4b,
+	0x49, 0x50, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x45, 0x41, 0x44, 0x5f, 0x4c, 0x45, 0x54,
+	0x54, 0x45, 0x52, 0x10, 0x02, 0x42, 0x69, 0x0a, 0x0a, 0x69, 0x6f, 0x2e, 0x64, 0x61, 0x70, 0x72,
+	0x2e, 0x76, 0x31, 0x42, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61,
+	0x70, 0x72, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0xaa, 0x02, 0x1b, 0x44, 0x61, 0x70, 0x72, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x2e, 0x41, 0x75, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1067,47 +1323,55 @@ func file_dapr_proto_common_v1_common_proto_rawDescGZIP() []byte {
 	return file_dapr_proto_common_v1_common_proto_rawDescData
 }
 
-var file_dapr_proto_common_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_dapr_proto_common_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_dapr_proto_common_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_dapr_proto_common_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_dapr_proto_common_v1_common_proto_goTypes = []interface{}{
-	(HTTPExtension_Verb)(0),            // 0: dapr.proto.common.v1.HTTPExtension.Verb
-	(StateOptions_StateConcurrency)(0), // 1: dapr.proto.common.v1.StateOptions.StateConcurrency
-	(StateOptions_StateConsistency)(0), // 2: dapr.proto.common.v1.StateOptions.StateConsistency
-	(*HTTPExtension)(nil),              // 3: dapr.proto.common.v1.HTTPExtension
-	(*InvokeRequest)(nil),              // 4: dapr.proto.common.v1.InvokeRequest
-	(*InvokeResponse)(nil),             // 5: dapr.proto.common.v1.InvokeResponse
-	(*StreamPayload)(nil),              // 6: dapr.proto.common.v1.StreamPayload
-	(*StateItem)(nil),                  // 7: dapr.proto.common.v1.StateItem
-	(*Etag)(nil),                       // 8: dapr.proto.common.v1.Etag
-	(*StateOptions)(nil),               // 9: dapr.proto.common.v1.StateOptions
-	(*ConfigurationItem)(nil),          // 10: dapr.proto.common.v1.ConfigurationItem
-	(*JobFailurePolicy)(nil),           // 11: dapr.proto.common.v1.JobFailurePolicy
-	(*JobFailurePolicyDrop)(nil),       // 12: dapr.proto.common.v1.JobFailurePolicyDrop
-	(*JobFailurePolicyConstant)(nil),   // 13: dapr.proto.common.v1.JobFailurePolicyConstant
-	nil,                                // 14: dapr.proto.common.v1.StateItem.MetadataEntry
-	nil,                                // 15: dapr.proto.common.v1.ConfigurationItem.MetadataEntry
-	(*anypb.Any)(nil),                  // 16: google.protobuf.Any
-	(*durationpb.Duration)(nil),        // 17: google.protobuf.Duration
+	(HTTPExtension_Verb)(0),               // 0: dapr.proto.common.v1.HTTPExtension.Verb
+	(StateOptions_StateConcurrency)(0),    // 1: dapr.proto.common.v1.StateOptions.StateConcurrency
+	(StateOptions_StateConsistency)(0),    // 2: dapr.proto.common.v1.StateOptions.StateConsistency
+	(JobFailurePolicyDelivery_Backoff)(0), // 3: dapr.proto.common.v1.JobFailurePolicyDelivery.Backoff
+	(JobFailurePolicyDelivery_Action)(0),  // 4: dapr.proto.common.v1.JobFailurePolicyDelivery.Action
+	(*HTTPExtension)(nil),                 // 5: dapr.proto.common.v1.HTTPExtension
+	(*InvokeRequest)(nil),                 // 6: dapr.proto.common.v1.InvokeRequest
+	(*InvokeResponse)(nil),                // 7: dapr.proto.common.v1.InvokeResponse
+	(*StreamPayload)(nil),                 // 8: dapr.proto.common.v1.StreamPayload
+	(*StateItem)(nil),                     // 9: dapr.proto.common.v1.StateItem
+	(*Etag)(nil),                          // 10: dapr.proto.common.v1.Etag
+	(*StateOptions)(nil),                  // 11: dapr.proto.common.v1.StateOptions
+	(*ConfigurationItem)(nil),             // 12: dapr.proto.common.v1.ConfigurationItem
+	(*JobFailurePolicy)(nil),              // 13: dapr.proto.common.v1.JobFailurePolicy
+	(*JobFailurePolicyDrop)(nil),          // 14: dapr.proto.common.v1.JobFailurePolicyDrop
+	(*JobFailurePolicyConstant)(nil),      // 15: dapr.proto.common.v1.JobFailurePolicyConstant
+	(*JobFailurePolicyDelivery)(nil),      // 16: dapr.proto.common.v1.JobFailurePolicyDelivery
+	nil,                                   // 17: dapr.proto.common.v1.StateItem.MetadataEntry
+	nil,                                   // 18: dapr.proto.common.v1.ConfigurationItem.MetadataEntry
+	(*anypb.Any)(nil),                     // 19: google.protobuf.Any
+	(*durationpb.Duration)(nil),           // 20: google.protobuf.Duration
 }
 var file_dapr_proto_common_v1_common_proto_depIdxs = []int32{
 	0,  // 0: dapr.proto.common.v1.HTTPExtension.verb:type_name -> dapr.proto.common.v1.HTTPExtension.Verb
-	16, // 1: dapr.proto.common.v1.InvokeRequest.data:type_name -> google.protobuf.Any
-	3,  // 2: dapr.proto.common.v1.InvokeRequest.http_extension:type_name -> dapr.proto.common.v1.HTTPExtension
-	16, // 3: dapr.proto.common.v1.InvokeResponse.data:type_name -> google.protobuf.Any
-	8,  // 4: dapr.proto.common.v1.StateItem.etag:type_name -> dapr.proto.common.v1.Etag
-	14, // 5: dapr.proto.common.v1.StateItem.metadata:type_name -> dapr.proto.common.v1.StateItem.MetadataEntry
-	9,  // 6: dapr.proto.common.v1.StateItem.options:type_name -> dapr.proto.common.v1.StateOptions
+	19, // 1: dapr.proto.common.v1.InvokeRequest.data:type_name -> google.protobuf.Any
+	5,  // 2: dapr.proto.common.v1.InvokeRequest.http_extension:type_name -> dapr.proto.common.v1.HTTPExtension
+	19, // 3: dapr.proto.common.v1.InvokeResponse.data:type_name -> google.protobuf.Any
+	10, // 4: dapr.proto.common.v1.StateItem.etag:type_name -> dapr.proto.common.v1.Etag
+	17, // 5: dapr.proto.common.v1.StateItem.metadata:type_name -> dapr.proto.common.v1.StateItem.MetadataEntry
+	11, // 6: dapr.proto.common.v1.StateItem.options:type_name -> dapr.proto.common.v1.StateOptions
 	1,  // 7: dapr.proto.common.v1.StateOptions.concurrency:type_name -> dapr.proto.common.v1.StateOptions.StateConcurrency
 	2,  // 8: dapr.proto.common.v1.StateOptions.consistency:type_name -> dapr.proto.common.v1.StateOptions.StateConsistency
-	15, // 9: dapr.proto.common.v1.ConfigurationItem.metadata:type_name -> dapr.proto.common.v1.ConfigurationItem.MetadataEntry
-	12, // 10: dapr.proto.common.v1.JobFailurePolicy.drop:type_name -> dapr.proto.common.v1.JobFailurePolicyDrop
-	13, // 11: dapr.proto.common.v1.JobFailurePolicy.constant:type_name -> dapr.proto.common.v1.JobFailurePolicyConstant
-	17, // 12: dapr.proto.common.v1.JobFailurePolicyConstant.interval:type_name -> google.protobuf.Duration
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	18, // 9: dapr.proto.common.v1.ConfigurationItem.metadata:type_name -> dapr.proto.common.v1.ConfigurationItem.MetadataEntry
+	14, // 10: dapr.proto.common.v1.JobFailurePolicy.drop:type_name -> dapr.proto.common.v1.JobFailurePolicyDrop
+	15, // 11: dapr.proto.common.v1.JobFailurePolicy.constant:type_name -> dapr.proto.common.v1.JobFailurePolicyConstant
+	16, // 12: dapr.proto.common.v1.JobFailurePolicy.delivery:type_name -> dapr.proto.common.v1.JobFailurePolicyDelivery
+	20, // 13: dapr.proto.common.v1.JobFailurePolicyConstant.interval:type_name -> google.protobuf.Duration
+	20, // 14: dapr.proto.common.v1.JobFailurePolicyDelivery.interval:type_name -> google.protobuf.Duration
+	3,  // 15: dapr.proto.common.v1.JobFailurePolicyDelivery.backoff:type_name -> dapr.proto.common.v1.JobFailurePolicyDelivery.Backoff
+	20, // 16: dapr.proto.common.v1.JobFailurePolicyDelivery.max_interval:type_name -> google.protobuf.Duration
+	4,  // 17: dapr.proto.common.v1.JobFailurePolicyDelivery.action:type_name -> dapr.proto.common.v1.JobFailurePolicyDelivery.Action
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_dapr_proto_common_v1_common_proto_init() }
@@ -1248,6 +1512,18 @@ func file_dapr_proto_common_v1_common_proto_init() {
 				return nil
 			}
 		}
+		file_dapr_proto_common_v1_common_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobFailurePolicyDelivery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_dapr_proto_common_v1_common_proto_msgTypes[8].OneofWrappers = []interface{}{
 		(*JobFailurePolicy_Drop)(nil),
@@ -1259,8 +1535,8 @@ func file_dapr_proto_common_v1_common_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_dapr_proto_common_v1_common_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   13,
+			NumEnums:      5,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -24,6 +24,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	anypb "google.golang.org/protobuf/types/known/anypb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	structpb "google.golang.org/protobuf/types/known/structpb"
 	reflect "reflect"
 	sync "sync"
@@ -157,6 +158,9 @@ type JobEvent struct {
 	Metadata *v11.JobMetadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// data is the data payload of the job.
 	Data *anypb.Any `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// delivery_policy is the failure policy applied by daprd when the app fails
+	// to process the job.
+	DeliveryPolicy *v1.JobFailurePolicyDelivery `protobuf:"bytes,5,opt,name=delivery_policy,json=deliveryPolicy,proto3" json:"delivery_policy,omitempty"`
 }
 
 func (x *JobEvent) Reset() {
@@ -219,6 +223,102 @@ func (x *JobEvent) GetData() *anypb.Any {
 	return nil
 }
 
+func (x *JobEvent) GetDeliveryPolicy() *v1.JobFailurePolicyDelivery {
+	if x != nil {
+		return x.DeliveryPolicy
+	}
+	return nil
+}
+
+// StoredJobMetadata is the metadata stored by Scheduler with a job, holding
+// the options of the job which the cron library has no fields for.
+type StoredJobMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// metadata is the metadata associated with the job.
+	Metadata *v11.JobMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// delivery_policy is the failure policy applied by daprd when the app fails
+	// to process the job.
+	DeliveryPolicy *v1.JobFailurePolicyDelivery `protobuf:"bytes,2,opt,name=delivery_policy,json=deliveryPolicy,proto3" json:"delivery_policy,omitempty"`
+	// time_zone is the IANA time zone the schedule of the job, and of its
+	// blackout windows, is evaluated in.
+	TimeZone *string `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3,oneof" json:"time_zone,omitempty"`
+	// jitter is the maximum random delay added to each trigger of the job.
+	Jitter *durationpb.Duration `protobuf:"bytes,4,opt,name=jitter,proto3" json:"jitter,omitempty"`
+	// blackout_windows are the windows during which triggers of the job are
+	// skipped.
+	BlackoutWindows []*v11.JobBlackoutWindow `protobuf:"bytes,5,rep,name=blackout_windows,json=blackoutWindows,proto3" json:"blackout_windows,omitempty"`
+}
+
+func (x *StoredJobMetadata) Reset() {
+	*x = StoredJobMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_internals_v1_jobs_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoredJobMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoredJobMetadata) ProtoMessage() {}
+
+func (x *StoredJobMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_internals_v1_jobs_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoredJobMetadata.ProtoReflect.Descriptor instead.
+func (*StoredJobMetadata) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_internals_v1_jobs_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StoredJobMetadata) GetMetadata() *v11.JobMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *StoredJobMetadata) GetDeliveryPolicy() *v1.JobFailurePolicyDelivery {
+	if x != nil {
+		return x.DeliveryPolicy
+	}
+	return nil
+}
+
+func (x *StoredJobMetadata) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
+func (x *StoredJobMetadata) GetJitter() *durationpb.Duration {
+	if x != nil {
+		return x.Jitter
+	}
+	return nil
+}
+
+func (x *StoredJobMetadata) GetBlackoutWindows() []*v11.JobBlackoutWindow {
+	if x != nil {
+		return x.BlackoutWindows
+	}
+	return nil
+}
+
 var File_dapr_proto_internals_v1_jobs_proto protoreflect.FileDescriptor
 
 var file_dapr_proto_internals_v1_jobs_proto_rawDesc = []byte{
@@ -229,6 +329,8 @@ var file_dapr_proto_internals_v1_jobs_proto_rawDesc = []byte{
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73,
 	0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f,
 	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
@@ -259,7 +361,7 @@ var file_dapr_proto_internals_v1_jobs_proto_rawDesc = []byte{
 	0x5f, 0x64, 0x75, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x74, 0x74,
 	0x6c, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x42,
 	0x11, 0x0a, 0x0f, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x22, 0x9c, 0x01, 0x0a, 0x08, 0x4a, 0x6f, 0x62, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x63, 0x79, 0x22, 0xf5, 0x01, 0x0a, 0x08, 0x4a, 0x6f, 0x62, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
 	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
 	0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
@@ -269,11 +371,39 @@ var file_dapr_proto_internals_v1_jobs_proto_rawDesc = []byte{
 	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
 	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x64, 0x61, 0x70, 0x72, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73, 0x2f, 0x76, 0x31,
-	0x3b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x61, 0x12, 0x57, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64, 0x61, 0x70,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x0e, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0xe8, 0x02, 0x0a, 0x11, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x40, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x57, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64, 0x61,
+	0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x0e, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x20, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a,
+	0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x12, 0x55, 0x0a, 0x10, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x64, 0x61, 0x70,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6f, 0x75, 0x74,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x0f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6f, 0x75, 0x74,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x70, 0x6b,
+	0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x73, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -288,25 +418,34 @@ func file_dapr_proto_internals_v1_jobs_proto_rawDescGZIP() []byte {
 	return file_dapr_proto_internals_v1_jobs_proto_rawDescData
 }
 
-var file_dapr_proto_internals_v1_jobs_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_dapr_proto_internals_v1_jobs_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_dapr_proto_internals_v1_jobs_proto_goTypes = []interface{}{
-	(*JobHTTPRequest)(nil),      // 0: dapr.proto.internals.v1.JobHTTPRequest
-	(*JobEvent)(nil),            // 1: dapr.proto.internals.v1.JobEvent
-	(*structpb.Value)(nil),      // 2: google.protobuf.Value
-	(*v1.JobFailurePolicy)(nil), // 3: dapr.proto.common.v1.JobFailurePolicy
-	(*v11.JobMetadata)(nil),     // 4: dapr.proto.scheduler.v1.JobMetadata
-	(*anypb.Any)(nil),           // 5: google.protobuf.Any
+	(*JobHTTPRequest)(nil),              // 0: dapr.proto.internals.v1.JobHTTPRequest
+	(*JobEvent)(nil),                    // 1: dapr.proto.internals.v1.JobEvent
+	(*StoredJobMetadata)(nil),           // 2: dapr.proto.internals.v1.StoredJobMetadata
+	(*structpb.Value)(nil),              // 3: google.protobuf.Value
+	(*v1.JobFailurePolicy)(nil),         // 4: dapr.proto.common.v1.JobFailurePolicy
+	(*v11.JobMetadata)(nil),             // 5: dapr.proto.scheduler.v1.JobMetadata
+	(*anypb.Any)(nil),                   // 6: google.protobuf.Any
+	(*v1.JobFailurePolicyDelivery)(nil), // 7: dapr.proto.common.v1.JobFailurePolicyDelivery
+	(*durationpb.Duration)(nil),         // 8: google.protobuf.Duration
+	(*v11.JobBlackoutWindow)(nil),       // 9: dapr.proto.scheduler.v1.JobBlackoutWindow
 }
 var file_dapr_proto_internals_v1_jobs_proto_depIdxs = []int32{
-	2, // 0: dapr.proto.internals.v1.JobHTTPRequest.data:type_name -> google.protobuf.Value
-	3, // 1: dapr.proto.internals.v1.JobHTTPRequest.failure_policy:type_name -> dapr.proto.common.v1.JobFailurePolicy
-	4, // 2: dapr.proto.internals.v1.JobEvent.metadata:type_name -> dapr.proto.scheduler.v1.JobMetadata
-	5, // 3: dapr.proto.internals.v1.JobEvent.data:type_name -> google.protobuf.Any
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	3, // 0: dapr.proto.internals.v1.JobHTTPRequest.data:type_name -> google.protobuf.Value
+	4, // 1: dapr.proto.internals.v1.JobHTTPRequest.failure_policy:type_name -> dapr.proto.common.v1.JobFailurePolicy
+	5, // 2: dapr.proto.internals.v1.JobEvent.metadata:type_name -> dapr.proto.scheduler.v1.JobMetadata
+	6, // 3: dapr.proto.internals.v1.JobEvent.data:type_name -> google.protobuf.Any
+	7, // 4: dapr.proto.internals.v1.JobEvent.delivery_policy:type_name -> dapr.proto.common.v1.JobFailurePolicyDelivery
+	5, // 5: dapr.proto.internals.v1.StoredJobMetadata.metadata:type_name -> dapr.proto.scheduler.v1.JobMetadata
+	7, // 6: dapr.proto.internals.v1.StoredJobMetadata.delivery_policy:type_name -> dapr.proto.common.v1.JobFailurePolicyDelivery
+	8, // 7: dapr.proto.internals.v1.StoredJobMetadata.jitter:type_name -> google.protobuf.Duration
+	9, // 8: dapr.proto.internals.v1.StoredJobMetadata.blackout_windows:type_name -> dapr.proto.scheduler.v1.JobBlackoutWindow
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_dapr_proto_internals_v1_jobs_proto_init() }
@@ -339,15 +478,28 @@ func file_dapr_proto_internals_v1_jobs_proto_init() {
 				return nil
 			}
 		}
+		file_dapr_proto_internals_v1_jobs_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoredJobMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_dapr_proto_internals_v1_jobs_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_dapr_proto_internals_v1_jobs_proto_msgTypes[2].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_dapr_proto_internals_v1_jobs_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -32,11 +32,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ServiceInvocation_CallActor_FullMethodName         = "/dapr.proto.internals.v1.ServiceInvocation/CallActor"
-	ServiceInvocation_CallLocal_FullMethodName         = "/dapr.proto.internals.v1.ServiceInvocation/CallLocal"
-	ServiceInvocation_CallActorReminder_FullMethodName = "/dapr.proto.internals.v1.ServiceInvocation/CallActorReminder"
-	ServiceInvocation_CallLocalStream_FullMethodName   = "/dapr.proto.internals.v1.ServiceInvocation/CallLocalStream"
-	ServiceInvocation_CallActorStream_FullMethodName   = "/dapr.proto.internals.v1.ServiceInvocation/CallActorStream"
+	ServiceInvocation_CallActor_FullMethodName          = "/dapr.proto.internals.v1.ServiceInvocation/CallActor"
+	ServiceInvocation_CallLocal_FullMethodName          = "/dapr.proto.internals.v1.ServiceInvocation/CallLocal"
+	ServiceInvocation_CallActorReminder_FullMethodName  = "/dapr.proto.internals.v1.ServiceInvocation/CallActorReminder"
+	ServiceInvocation_CallLocalStream_FullMethodName    = "/dapr.proto.internals.v1.ServiceInvocation/CallLocalStream"
+	ServiceInvocation_CallActorStream_FullMethodName    = "/dapr.proto.internals.v1.ServiceInvocation/CallActorStream"
+	ServiceInvocation_CallLocalWebSocket_FullMethodName = "/dapr.proto.internals.v1.ServiceInvocation/CallLocalWebSocket"
 )
 
 // ServiceInvocationClient is the client API for ServiceInvocation service.
@@ -62,6 +63,11 @@ type ServiceInvocationClient interface {
 	// CallActorStream is used to invoke actor method with request and streaming
 	// response.
 	CallActorStream(ctx context.Context, in *InternalInvokeRequest, opts ...grpc.CallOption) (ServiceInvocation_CallActorStreamClient, error)
+	// CallLocalWebSocket proxies a WebSocket connection to the app.
+	// The first message sent by the caller MUST contain the upgrade `request`, and the first message sent by the callee MUST contain the `response` of the app to it.
+	// If the app accepted the upgrade (HTTP status 101), the `payload` of the following messages in each direction carries the data of the connection, with the same sequence numbering as CallLocalStream.
+	// Otherwise, the callee sends the body of the response of the app and ends the stream.
+	CallLocalWebSocket(ctx context.Context, opts ...grpc.CallOption) (ServiceInvocation_CallLocalWebSocketClient, error)
 }
 
 type serviceInvocationClient struct {
@@ -162,6 +168,37 @@ func (x *serviceInvocationCallActorStreamClient) Recv() (*InternalInvokeResponse
 	return m, nil
 }
 
+func (c *serviceInvocationClient) CallLocalWebSocket(ctx context.Context, opts ...grpc.CallOption) (ServiceInvocation_CallLocalWebSocketClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ServiceInvocation_ServiceDesc.Streams[2], ServiceInvocation_CallLocalWebSocket_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serviceInvocationCallLocalWebSocketClient{stream}
+	return x, nil
+}
+
+type ServiceInvocation_CallLocalWebSocketClient interface {
+	Send(*InternalInvokeRequestStream) error
+	Recv() (*InternalInvokeResponseStream, error)
+	grpc.ClientStream
+}
+
+type serviceInvocationCallLocalWebSocketClient struct {
+	grpc.ClientStream
+}
+
+func (x *serviceInvocationCallLocalWebSocketClient) Send(m *InternalInvokeRequestStream) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *serviceInvocationCallLocalWebSocketClient) Recv() (*InternalInvokeResponseStream, error) {
+	m := new(InternalInvokeResponseStream)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ServiceInvocationServer is the server API for ServiceInvocation service.
 // All implementations should embed UnimplementedServiceInvocationServer
 // for forward compatibility
@@ -185,6 +222,11 @@ type ServiceInvocationServer interface {
 	// CallActorStream is used to invoke actor method with request and streaming
 	// response.
 	CallActorStream(*InternalInvokeRequest, ServiceInvocation_CallActorStreamServer) error
+	// CallLocalWebSocket proxies a WebSocket connection to the app.
+	// The first message sent by the caller MUST contain the upgrade `request`, and the first message sent by the callee MUST contain the `response` of the app to it.
+	// If the app accepted the upgrade (HTTP status 101), the `payload` of the following messages in each direction carries the data of the connection, with the same sequence numbering as CallLocalStream.
+	// Otherwise, the callee sends the body of the response of the app and ends the stream.
+	CallLocalWebSocket(ServiceInvocation_CallLocalWebSocketServer) error
 }
 
 // UnimplementedServiceInvocationServer should be embedded to have forward compatible implementations.
@@ -206,6 +248,9 @@ func (UnimplementedServiceInvocationServer) CallLocalStream(ServiceInvocation_Ca
 func (UnimplementedServiceInvocationServer) CallActorStream(*InternalInvokeRequest, ServiceInvocation_CallActorStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method CallActorStream not implemented")
 }
+func (UnimplementedServiceInvocationServer) CallLocalWebSocket(ServiceInvocation_CallLocalWebSocketServer) error {
+	return status.Errorf(codes.Unimplemented, "method CallLocalWebSocket not implemented")
+}
 
 // UnsafeServiceInvocationServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ServiceInvocationServer will
@@ -319,6 +364,32 @@ func (x *serviceInvocationCallActorStreamServer) Send(m *InternalInvokeResponse)
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ServiceInvocation_CallLocalWebSocket_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ServiceInvocationServer).CallLocalWebSocket(&serviceInvocationCallLocalWebSocketServer{stream})
+}
+
+type ServiceInvocation_CallLocalWebSocketServer interface {
+	Send(*InternalInvokeResponseStream) error
+	Recv() (*InternalInvokeRequestStream, error)
+	grpc.ServerStream
+}
+
+type serviceInvocationCallLocalWebSocketServer struct {
+	grpc.ServerStream
+}
+
+func (x *serviceInvocationCallLocalWebSocketServer) Send(m *InternalInvokeResponseStream) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *serviceInvocationCallLocalWebSocketServer) Recv() (*InternalInvokeRequestStream, error) {
+	m := new(InternalInvokeRequestStream)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ServiceInvocation_ServiceDesc is the grpc.ServiceDesc for ServiceInvocation service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -351,6 +422,12 @@ var ServiceInvocation_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ServiceInvocation_CallActorStream_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "CallLocalWebSocket",
+			Handler:       _ServiceInvocation_CallLocalWebSocket_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "dapr/proto/internals/v1/service_invocation.proto",
 }
@@ -34,6 +34,7 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	Dapr_InvokeService_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/InvokeService"
+	Dapr_InvokeServiceStreamAlpha1_FullMethodName      = "/dapr.proto.runtime.v1.Dapr/InvokeServiceStreamAlpha1"
 	Dapr_GetState_FullMethodName                       = "/dapr.proto.runtime.v1.Dapr/GetState"
 	Dapr_GetBulkState_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/GetBulkState"
 	Dapr_SaveState_FullMethodName                      = "/dapr.proto.runtime.v1.Dapr/SaveState"
@@ -41,9 +42,19 @@ const (
 	Dapr_DeleteState_FullMethodName                    = "/dapr.proto.runtime.v1.Dapr/DeleteState"
 	Dapr_DeleteBulkState_FullMethodName                = "/dapr.proto.runtime.v1.Dapr/DeleteBulkState"
 	Dapr_ExecuteStateTransaction_FullMethodName        = "/dapr.proto.runtime.v1.Dapr/ExecuteStateTransaction"
+	Dapr_CheckAndSetStateAlpha1_FullMethodName         = "/dapr.proto.runtime.v1.Dapr/CheckAndSetStateAlpha1"
+	Dapr_BulkSetStateAlpha1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/BulkSetStateAlpha1"
+	Dapr_BulkDeleteStateAlpha1_FullMethodName          = "/dapr.proto.runtime.v1.Dapr/BulkDeleteStateAlpha1"
+	Dapr_IncrementStateAlpha1_FullMethodName           = "/dapr.proto.runtime.v1.Dapr/IncrementStateAlpha1"
+	Dapr_AppendStateAlpha1_FullMethodName              = "/dapr.proto.runtime.v1.Dapr/AppendStateAlpha1"
+	Dapr_SubscribeStateAlpha1_FullMethodName           = "/dapr.proto.runtime.v1.Dapr/SubscribeStateAlpha1"
+	Dapr_QueryStateAlpha2_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/QueryStateAlpha2"
+	Dapr_ReencryptStateAlpha1_FullMethodName           = "/dapr.proto.runtime.v1.Dapr/ReencryptStateAlpha1"
+	Dapr_GetReencryptStateStatusAlpha1_FullMethodName  = "/dapr.proto.runtime.v1.Dapr/GetReencryptStateStatusAlpha1"
 	Dapr_PublishEvent_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/PublishEvent"
 	Dapr_BulkPublishEventAlpha1_FullMethodName         = "/dapr.proto.runtime.v1.Dapr/BulkPublishEventAlpha1"
 	Dapr_SubscribeTopicEventsAlpha1_FullMethodName     = "/dapr.proto.runtime.v1.Dapr/SubscribeTopicEventsAlpha1"
+	Dapr_RedriveDeadLetterAlpha1_FullMethodName        = "/dapr.proto.runtime.v1.Dapr/RedriveDeadLetterAlpha1"
 	Dapr_InvokeBinding_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/InvokeBinding"
 	Dapr_GetSecret_FullMethodName                      = "/dapr.proto.runtime.v1.Dapr/GetSecret"
 	Dapr_GetBulkSecret_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/GetBulkSecret"
@@ -65,6 +76,9 @@ const (
 	Dapr_UnsubscribeConfiguration_FullMethodName       = "/dapr.proto.runtime.v1.Dapr/UnsubscribeConfiguration"
 	Dapr_TryLockAlpha1_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/TryLockAlpha1"
 	Dapr_UnlockAlpha1_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/UnlockAlpha1"
+	Dapr_RenewLockAlpha1_FullMethodName                = "/dapr.proto.runtime.v1.Dapr/RenewLockAlpha1"
+	Dapr_TryLockSharedAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/TryLockSharedAlpha1"
+	Dapr_UnlockSharedAlpha1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/UnlockSharedAlpha1"
 	Dapr_EncryptAlpha1_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/EncryptAlpha1"
 	Dapr_DecryptAlpha1_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/DecryptAlpha1"
 	Dapr_GetMetadata_FullMethodName                    = "/dapr.proto.runtime.v1.Dapr/GetMetadata"
@@ -76,6 +90,8 @@ const (
 	Dapr_SubtleUnwrapKeyAlpha1_FullMethodName          = "/dapr.proto.runtime.v1.Dapr/SubtleUnwrapKeyAlpha1"
 	Dapr_SubtleSignAlpha1_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/SubtleSignAlpha1"
 	Dapr_SubtleVerifyAlpha1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/SubtleVerifyAlpha1"
+	Dapr_GenerateDataKeyAlpha1_FullMethodName          = "/dapr.proto.runtime.v1.Dapr/GenerateDataKeyAlpha1"
+	Dapr_RewrapKeyAlpha1_FullMethodName                = "/dapr.proto.runtime.v1.Dapr/RewrapKeyAlpha1"
 	Dapr_StartWorkflowAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/StartWorkflowAlpha1"
 	Dapr_GetWorkflowAlpha1_FullMethodName              = "/dapr.proto.runtime.v1.Dapr/GetWorkflowAlpha1"
 	Dapr_PurgeWorkflowAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/PurgeWorkflowAlpha1"
@@ -90,12 +106,16 @@ const (
 	Dapr_PauseWorkflowBeta1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/PauseWorkflowBeta1"
 	Dapr_ResumeWorkflowBeta1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/ResumeWorkflowBeta1"
 	Dapr_RaiseEventWorkflowBeta1_FullMethodName        = "/dapr.proto.runtime.v1.Dapr/RaiseEventWorkflowBeta1"
+	Dapr_ListWorkflowsAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/ListWorkflowsAlpha1"
 	Dapr_Shutdown_FullMethodName                       = "/dapr.proto.runtime.v1.Dapr/Shutdown"
 	Dapr_ScheduleJobAlpha1_FullMethodName              = "/dapr.proto.runtime.v1.Dapr/ScheduleJobAlpha1"
 	Dapr_GetJobAlpha1_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/GetJobAlpha1"
 	Dapr_DeleteJobAlpha1_FullMethodName                = "/dapr.proto.runtime.v1.Dapr/DeleteJobAlpha1"
 	Dapr_DeleteJobsByPrefixAlpha1_FullMethodName       = "/dapr.proto.runtime.v1.Dapr/DeleteJobsByPrefixAlpha1"
 	Dapr_ListJobsAlpha1_FullMethodName                 = "/dapr.proto.runtime.v1.Dapr/ListJobsAlpha1"
+	Dapr_DeleteJobsAlpha1_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/DeleteJobsAlpha1"
+	Dapr_PauseJobsAlpha1_FullMethodName                = "/dapr.proto.runtime.v1.Dapr/PauseJobsAlpha1"
+	Dapr_ResumeJobsAlpha1_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/ResumeJobsAlpha1"
 	Dapr_ConverseAlpha1_FullMethodName                 = "/dapr.proto.runtime.v1.Dapr/ConverseAlpha1"
 	Dapr_ConverseAlpha2_FullMethodName                 = "/dapr.proto.runtime.v1.Dapr/ConverseAlpha2"
 )
@@ -107,6 +127,8 @@ type DaprClient interface {
 	// Invokes a method on a remote Dapr app.
 	// Deprecated: Use proxy mode service invocation instead.
 	InvokeService(ctx context.Context, in *InvokeServiceRequest, opts ...grpc.CallOption) (*v1.InvokeResponse, error)
+	// Invokes a method on a remote Dapr app, streaming the request and response bodies.
+	InvokeServiceStreamAlpha1(ctx context.Context, opts ...grpc.CallOption) (Dapr_InvokeServiceStreamAlpha1Client, error)
 	// Gets the state for a specific key.
 	GetState(ctx context.Context, in *GetStateRequest, opts ...grpc.CallOption) (*GetStateResponse, error)
 	// Gets a bulk of state items for a list of keys
@@ -121,13 +143,37 @@ type DaprClient interface {
 	DeleteBulkState(ctx context.Context, in *DeleteBulkStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Executes transactions for a specified store
 	ExecuteStateTransaction(ctx context.Context, in *ExecuteStateTransactionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Executes the operations of a transaction only if the ETags of all the
+	// checked keys match.
+	CheckAndSetStateAlpha1(ctx context.Context, in *CheckAndSetStateRequestAlpha1, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Saves multiple values, and returns the result of each item.
+	BulkSetStateAlpha1(ctx context.Context, in *BulkSetStateRequestAlpha1, opts ...grpc.CallOption) (*BulkStateResponseAlpha1, error)
+	// Deletes multiple keys, and returns the result of each item.
+	BulkDeleteStateAlpha1(ctx context.Context, in *BulkDeleteStateRequestAlpha1, opts ...grpc.CallOption) (*BulkStateResponseAlpha1, error)
+	// Atomically adds a delta to the integer value of a key.
+	IncrementStateAlpha1(ctx context.Context, in *IncrementStateRequestAlpha1, opts ...grpc.CallOption) (*IncrementStateResponseAlpha1, error)
+	// Atomically appends values to the JSON array value of a key.
+	AppendStateAlpha1(ctx context.Context, in *AppendStateRequestAlpha1, opts ...grpc.CallOption) (*AppendStateResponseAlpha1, error)
+	// Watches the changes made to keys of a state store.
+	SubscribeStateAlpha1(ctx context.Context, in *SubscribeStateRequestAlpha1, opts ...grpc.CallOption) (Dapr_SubscribeStateAlpha1Client, error)
+	// Queries for data with projections, sorting on multiple fields, aggregates
+	// and cursor-based pagination.
+	QueryStateAlpha2(ctx context.Context, in *QueryStateRequestAlpha2, opts ...grpc.CallOption) (*QueryStateResponseAlpha2, error)
+	// Starts re-encrypting the values of an encrypted state store with its
+	// primary key in the background.
+	ReencryptStateAlpha1(ctx context.Context, in *ReencryptStateRequestAlpha1, opts ...grpc.CallOption) (*ReencryptStateStatusAlpha1, error)
+	// Gets the status of the last re-encryption job of a state store.
+	GetReencryptStateStatusAlpha1(ctx context.Context, in *GetReencryptStateStatusRequestAlpha1, opts ...grpc.CallOption) (*ReencryptStateStatusAlpha1, error)
 	// Publishes events to the specific topic.
-	PublishEvent(ctx context.Context, in *PublishEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PublishEvent(ctx context.Context, in *PublishEventRequest, opts ...grpc.CallOption) (*PublishEventResponse, error)
 	// Bulk Publishes multiple events to the specified topic.
 	BulkPublishEventAlpha1(ctx context.Context, in *BulkPublishRequest, opts ...grpc.CallOption) (*BulkPublishResponse, error)
 	// SubscribeTopicEventsAlpha1 subscribes to a PubSub topic and receives topic
 	// events from it.
 	SubscribeTopicEventsAlpha1(ctx context.Context, opts ...grpc.CallOption) (Dapr_SubscribeTopicEventsAlpha1Client, error)
+	// RedriveDeadLetterAlpha1 publishes the messages of a dead letter topic
+	// back to the topics they were dead lettered from.
+	RedriveDeadLetterAlpha1(ctx context.Context, in *RedriveDeadLetterRequestAlpha1, opts ...grpc.CallOption) (*RedriveDeadLetterResponseAlpha1, error)
 	// Invokes binding data to specific output bindings
 	InvokeBinding(ctx context.Context, in *InvokeBindingRequest, opts ...grpc.CallOption) (*InvokeBindingResponse, error)
 	// Gets secrets from secret stores.
@@ -168,6 +214,13 @@ type DaprClient interface {
 	TryLockAlpha1(ctx context.Context, in *TryLockRequest, opts ...grpc.CallOption) (*TryLockResponse, error)
 	// UnlockAlpha1 unlocks a lock.
 	UnlockAlpha1(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error)
+	// RenewLockAlpha1 extends the expiry of a lock held by its owner.
+	RenewLockAlpha1(ctx context.Context, in *RenewLockRequest, opts ...grpc.CallOption) (*RenewLockResponse, error)
+	// TryLockSharedAlpha1 tries to get a shared lock with an expiry, which can be
+	// held by multiple owners at once but not together with an exclusive lock.
+	TryLockSharedAlpha1(ctx context.Context, in *TryLockSharedRequest, opts ...grpc.CallOption) (*TryLockSharedResponse, error)
+	// UnlockSharedAlpha1 releases a shared lock.
+	UnlockSharedAlpha1(ctx context.Context, in *UnlockSharedRequest, opts ...grpc.CallOption) (*UnlockSharedResponse, error)
 	// EncryptAlpha1 encrypts a message using the Dapr encryption scheme and a key stored in the vault.
 	EncryptAlpha1(ctx context.Context, opts ...grpc.CallOption) (Dapr_EncryptAlpha1Client, error)
 	// DecryptAlpha1 decrypts a message using the Dapr encryption scheme and a key stored in the vault.
@@ -190,6 +243,10 @@ type DaprClient interface {
 	SubtleSignAlpha1(ctx context.Context, in *SubtleSignRequest, opts ...grpc.CallOption) (*SubtleSignResponse, error)
 	// SubtleVerifyAlpha1 verifies the signature of a message using a key stored in the vault.
 	SubtleVerifyAlpha1(ctx context.Context, in *SubtleVerifyRequest, opts ...grpc.CallOption) (*SubtleVerifyResponse, error)
+	// GenerateDataKeyAlpha1 returns a data encryption key, in plaintext and wrapped with a key stored in the vault.
+	GenerateDataKeyAlpha1(ctx context.Context, in *GenerateDataKeyRequestAlpha1, opts ...grpc.CallOption) (*GenerateDataKeyResponseAlpha1, error)
+	// RewrapKeyAlpha1 unwraps a wrapped key and wraps it again with a key stored in the vault.
+	RewrapKeyAlpha1(ctx context.Context, in *RewrapKeyRequestAlpha1, opts ...grpc.CallOption) (*RewrapKeyResponseAlpha1, error)
 	// Deprecated: Do not use.
 	// Starts a new instance of a workflow
 	StartWorkflowAlpha1(ctx context.Context, in *StartWorkflowRequest, opts ...grpc.CallOption) (*StartWorkflowResponse, error)
@@ -225,6 +282,8 @@ type DaprClient interface {
 	ResumeWorkflowBeta1(ctx context.Context, in *ResumeWorkflowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Raise an event to a running workflow instance
 	RaiseEventWorkflowBeta1(ctx context.Context, in *RaiseEventWorkflowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Lists the workflow instances matching a filter
+	ListWorkflowsAlpha1(ctx context.Context, in *ListWorkflowsRequestAlpha1, opts ...grpc.CallOption) (*ListWorkflowsResponseAlpha1, error)
 	// Shutdown the sidecar
 	Shutdown(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Create and schedule a job
@@ -235,6 +294,12 @@ type DaprClient interface {
 	DeleteJobAlpha1(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*DeleteJobResponse, error)
 	DeleteJobsByPrefixAlpha1(ctx context.Context, in *DeleteJobsByPrefixRequestAlpha1, opts ...grpc.CallOption) (*DeleteJobsByPrefixResponseAlpha1, error)
 	ListJobsAlpha1(ctx context.Context, in *ListJobsRequestAlpha1, opts ...grpc.CallOption) (*ListJobsResponseAlpha1, error)
+	// Delete the selected jobs, paused or not
+	DeleteJobsAlpha1(ctx context.Context, in *BulkJobsRequestAlpha1, opts ...grpc.CallOption) (*BulkJobsResponseAlpha1, error)
+	// Pause the selected jobs, which aren't triggered until they are resumed
+	PauseJobsAlpha1(ctx context.Context, in *BulkJobsRequestAlpha1, opts ...grpc.CallOption) (*BulkJobsResponseAlpha1, error)
+	// Resume the selected paused jobs
+	ResumeJobsAlpha1(ctx context.Context, in *BulkJobsRequestAlpha1, opts ...grpc.CallOption) (*BulkJobsResponseAlpha1, error)
 	// Converse with a LLM service
 	ConverseAlpha1(ctx context.Context, in *ConversationRequest, opts ...grpc.CallOption) (*ConversationResponse, error)
 	// Converse with a LLM service via alpha2 api
@@ -258,6 +323,37 @@ func (c *daprClient) InvokeService(ctx context.Context, in *InvokeServiceRequest
 	return out, nil
 }
 
+func (c *daprClient) InvokeServiceStreamAlpha1(ctx context.Context, opts ...grpc.CallOption) (Dapr_InvokeServiceStreamAlpha1Client, error) {
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[0], Dapr_InvokeServiceStreamAlpha1_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daprInvokeServiceStreamAlpha1Client{stream}
+	return x, nil
+}
+
+type Dapr_InvokeServiceStreamAlpha1Client interface {
+	Send(*InvokeServiceStreamRequest) error
+	Recv() (*InvokeServiceStreamResponse, error)
+	grpc.ClientStream
+}
+
+type daprInvokeServiceStreamAlpha1Client struct {
+	grpc.ClientStream
+}
+
+func (x *daprInvokeServiceStreamAlpha1Client) Send(m *InvokeServiceStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *daprInvokeServiceStreamAlpha1Client) Recv() (*InvokeServiceStreamResponse, error) {
+	m := new(InvokeServiceStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *daprClient) GetState(ctx context.Context, in *GetStateRequest, opts ...grpc.CallOption) (*GetStateResponse, error) {
 	out := new(GetStateResponse)
 	err := c.cc.Invoke(ctx, Dapr_GetState_FullMethodName, in, out, opts...)
@@ -321,8 +417,112 @@ func (c *daprClient) ExecuteStateTransaction(ctx context.Context, in *ExecuteSta
 	return out, nil
 }
 
-func (c *daprClient) PublishEvent(ctx context.Context, in *PublishEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *daprClient) CheckAndSetStateAlpha1(ctx context.Context, in *CheckAndSetStateRequestAlpha1, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Dapr_CheckAndSetStateAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) BulkSetStateAlpha1(ctx context.Context, in *BulkSetStateRequestAlpha1, opts ...grpc.CallOption) (*BulkStateResponseAlpha1, error) {
+	out := new(BulkStateResponseAlpha1)
+	err := c.cc.Invoke(ctx, Dapr_BulkSetStateAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) BulkDeleteStateAlpha1(ctx context.Context, in *BulkDeleteStateRequestAlpha1, opts ...grpc.CallOption) (*BulkStateResponseAlpha1, error) {
+	out := new(BulkStateResponseAlpha1)
+	err := c.cc.Invoke(ctx, Dapr_BulkDeleteStateAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) IncrementStateAlpha1(ctx context.Context, in *IncrementStateRequestAlpha1, opts ...grpc.CallOption) (*IncrementStateResponseAlpha1, error) {
+	out := new(IncrementStateResponseAlpha1)
+	err := c.cc.Invoke(ctx, Dapr_IncrementStateAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) AppendStateAlpha1(ctx context.Context, in *AppendStateRequestAlpha1, opts ...grpc.CallOption) (*AppendStateResponseAlpha1, error) {
+	out := new(AppendStateResponseAlpha1)
+	err := c.cc.Invoke(ctx, Dapr_AppendStateAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) SubscribeStateAlpha1(ctx context.Context, in *SubscribeStateRequestAlpha1, opts ...grpc.CallOption) (Dapr_SubscribeStateAlpha1Client, error) {
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[1], Dapr_SubscribeStateAlpha1_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daprSubscribeStateAlpha1Client{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Dapr_SubscribeStateAlpha1Client interface {
+	Recv() (*SubscribeStateResponseAlpha1, error)
+	grpc.ClientStream
+}
+
+type daprSubscribeStateAlpha1Client struct {
+	grpc.ClientStream
+}
+
+func (x *daprSubscribeStateAlpha1Client) Recv() (*SubscribeStateResponseAlpha1, error) {
+	m := new(SubscribeStateResponseAlpha1)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daprClient) QueryStateAlpha2(ctx context.Context, in *QueryStateRequestAlpha2, opts ...grpc.CallOption) (*QueryStateResponseAlpha2, error) {
+	out := new(QueryStateResponseAlpha2)
+	err := c.cc.Invoke(ctx, Dapr_QueryStateAlpha2_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) ReencryptStateAlpha1(ctx context.Context, in *ReencryptStateRequestAlpha1, opts ...grpc.CallOption) (*ReencryptStateStatusAlpha1, error) {
+	out := new(ReencryptStateStatusAlpha1)
+	err := c.cc.Invoke(ctx, Dapr_ReencryptStateAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) GetReencryptStateStatusAlpha1(ctx context.Context, in *GetReencryptStateStatusRequestAlpha1, opts ...grpc.CallOption) (*ReencryptStateStatusAlpha1, error) {
+	out := new(ReencryptStateStatusAlpha1)
+	err := c.cc.Invoke(ctx, Dapr_GetReencryptStateStatusAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) PublishEvent(ctx context.Context, in *PublishEventRequest, opts ...grpc.CallOption) (*PublishEventResponse, error) {
+	out := new(PublishEventResponse)
 	err := c.cc.Invoke(ctx, Dapr_PublishEvent_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -340,7 +540,7 @@ func (c *daprClient) BulkPublishEventAlpha1(ctx context.Context, in *BulkPublish
 }
 
 func (c *daprClient) SubscribeTopicEventsAlpha1(ctx context.Context, opts ...grpc.CallOption) (Dapr_SubscribeTopicEventsAlpha1Client, error) {
-	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[0], Dapr_SubscribeTopicEventsAlpha1_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[2], Dapr_SubscribeTopicEventsAlpha1_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -370,6 +570,15 @@ func (x *daprSubscribeTopicEventsAlpha1Client) Recv() (*SubscribeTopicEventsResp
 	return m, nil
 }
 
+func (c *daprClient) RedriveDeadLetterAlpha1(ctx context.Context, in *RedriveDeadLetterRequestAlpha1, opts ...grpc.CallOption) (*RedriveDeadLetterResponseAlpha1, error) {
+	out := new(RedriveDeadLetterResponseAlpha1)
+	err := c.cc.Invoke(ctx, Dapr_RedriveDeadLetterAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daprClient) InvokeBinding(ctx context.Context, in *InvokeBindingRequest, opts ...grpc.CallOption) (*InvokeBindingResponse, error) {
 	out := new(InvokeBindingResponse)
 	err := c.cc.Invoke(ctx, Dapr_InvokeBinding_FullMethodName, in, out, opts...)
@@ -506,7 +715,7 @@ func (c *daprClient) GetConfiguration(ctx context.Context, in *GetConfigurationR
 }
 
 func (c *daprClient) SubscribeConfigurationAlpha1(ctx context.Context, in *SubscribeConfigurationRequest, opts ...grpc.CallOption) (Dapr_SubscribeConfigurationAlpha1Client, error) {
-	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[1], Dapr_SubscribeConfigurationAlpha1_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[3], Dapr_SubscribeConfigurationAlpha1_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -538,7 +747,7 @@ func (x *daprSubscribeConfigurationAlpha1Client) Recv() (*SubscribeConfiguration
 }
 
 func (c *daprClient) SubscribeConfiguration(ctx context.Context, in *SubscribeConfigurationRequest, opts ...grpc.CallOption) (Dapr_SubscribeConfigurationClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[2], Dapr_SubscribeConfiguration_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[4], Dapr_SubscribeConfiguration_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -605,8 +814,35 @@ func (c *daprClient) UnlockAlpha1(ctx context.Context, in *UnlockRequest, opts .
 	return out, nil
 }
 
+func (c *daprClient) RenewLockAlpha1(ctx context.Context, in *RenewLockRequest, opts ...grpc.CallOption) (*RenewLockResponse, error) {
+	out := new(RenewLockResponse)
+	err := c.cc.Invoke(ctx, Dapr_RenewLockAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) TryLockSharedAlpha1(ctx context.Context, in *TryLockSharedRequest, opts ...grpc.CallOption) (*TryLockSharedResponse, error) {
+	out := new(TryLockSharedResponse)
+	err := c.cc.Invoke(ctx, Dapr_TryLockSharedAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) UnlockSharedAlpha1(ctx context.Context, in *UnlockSharedRequest, opts ...grpc.CallOption) (*UnlockSharedResponse, error) {
+	out := new(UnlockSharedResponse)
+	err := c.cc.Invoke(ctx, Dapr_UnlockSharedAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daprClient) EncryptAlpha1(ctx context.Context, opts ...grpc.CallOption) (Dapr_EncryptAlpha1Client, error) {
-	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[3], Dapr_EncryptAlpha1_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[5], Dapr_EncryptAlpha1_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -637,7 +873,7 @@ func (x *daprEncryptAlpha1Client) Recv() (*EncryptResponse, error) {
 }
 
 func (c *daprClient) DecryptAlpha1(ctx context.Context, opts ...grpc.CallOption) (Dapr_DecryptAlpha1Client, error) {
-	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[4], Dapr_DecryptAlpha1_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[6], Dapr_DecryptAlpha1_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -748,6 +984,24 @@ func (c *daprClient) SubtleVerifyAlpha1(ctx context.Context, in *SubtleVerifyReq
 	return out, nil
 }
 
+func (c *daprClient) GenerateDataKeyAlpha1(ctx context.Context, in *GenerateDataKeyRequestAlpha1, opts ...grpc.CallOption) (*GenerateDataKeyResponseAlpha1, error) {
+	out := new(GenerateDataKeyResponseAlpha1)
+	err := c.cc.Invoke(ctx, Dapr_GenerateDataKeyAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) RewrapKeyAlpha1(ctx context.Context, in *RewrapKeyRequestAlpha1, opts ...grpc.CallOption) (*RewrapKeyResponseAlpha1, error) {
+	out := new(RewrapKeyResponseAlpha1)
+	err := c.cc.Invoke(ctx, Dapr_RewrapKeyAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *daprClient) StartWorkflowAlpha1(ctx context.Context, in *StartWorkflowRequest, opts ...grpc.CallOption) (*StartWorkflowResponse, error) {
 	out := new(StartWorkflowResponse)
@@ -881,6 +1135,15 @@ func (c *daprClient) RaiseEventWorkflowBeta1(ctx context.Context, in *RaiseEvent
 	return out, nil
 }
 
+func (c *daprClient) ListWorkflowsAlpha1(ctx context.Context, in *ListWorkflowsRequestAlpha1, opts ...grpc.CallOption) (*ListWorkflowsResponseAlpha1, error) {
+	out := new(ListWorkflowsResponseAlpha1)
+	err := c.cc.Invoke(ctx, Dapr_ListWorkflowsAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daprClient) Shutdown(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Dapr_Shutdown_FullMethodName, in, out, opts...)
@@ -935,6 +1198,33 @@ func (c *daprClient) ListJobsAlpha1(ctx context.Context, in *ListJobsRequestAlph
 	return out, nil
 }
 
+func (c *daprClient) DeleteJobsAlpha1(ctx context.Context, in *BulkJobsRequestAlpha1, opts ...grpc.CallOption) (*BulkJobsResponseAlpha1, error) {
+	out := new(BulkJobsResponseAlpha1)
+	err := c.cc.Invoke(ctx, Dapr_DeleteJobsAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) PauseJobsAlpha1(ctx context.Context, in *BulkJobsRequestAlpha1, opts ...grpc.CallOption) (*BulkJobsResponseAlpha1, error) {
+	out := new(BulkJobsResponseAlpha1)
+	err := c.cc.Invoke(ctx, Dapr_PauseJobsAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) ResumeJobsAlpha1(ctx context.Context, in *BulkJobsRequestAlpha1, opts ...grpc.CallOption) (*BulkJobsResponseAlpha1, error) {
+	out := new(BulkJobsResponseAlpha1)
+	err := c.cc.Invoke(ctx, Dapr_ResumeJobsAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daprClient) ConverseAlpha1(ctx context.Context, in *ConversationRequest, opts ...grpc.CallOption) (*ConversationResponse, error) {
 	out := new(ConversationResponse)
 	err := c.cc.Invoke(ctx, Dapr_ConverseAlpha1_FullMethodName, in, out, opts...)
@@ -960,6 +1250,8 @@ type DaprServer interface {
 	// Invokes a method on a remote Dapr app.
 	// Deprecated: Use proxy mode service invocation instead.
 	InvokeService(context.Context, *InvokeServiceRequest) (*v1.InvokeResponse, error)
+	// Invokes a method on a remote Dapr app, streaming the request and response bodies.
+	InvokeServiceStreamAlpha1(Dapr_InvokeServiceStreamAlpha1Server) error
 	// Gets the state for a specific key.
 	GetState(context.Context, *GetStateRequest) (*GetStateResponse, error)
 	// Gets a bulk of state items for a list of keys
@@ -974,13 +1266,37 @@ type DaprServer interface {
 	DeleteBulkState(context.Context, *DeleteBulkStateRequest) (*emptypb.Empty, error)
 	// Executes transactions for a specified store
 	ExecuteStateTransaction(context.Context, *ExecuteStateTransactionRequest) (*emptypb.Empty, error)
+	// Executes the operations of a transaction only if the ETags of all the
+	// checked keys match.
+	CheckAndSetStateAlpha1(context.Context, *CheckAndSetStateRequestAlpha1) (*emptypb.Empty, error)
+	// Saves multiple values, and returns the result of each item.
+	BulkSetStateAlpha1(context.Context, *BulkSetStateRequestAlpha1) (*BulkStateResponseAlpha1, error)
+	// Deletes multiple keys, and returns the result of each item.
+	BulkDeleteStateAlpha1(context.Context, *BulkDeleteStateRequestAlpha1) (*BulkStateResponseAlpha1, error)
+	// Atomically adds a delta to the integer value of a key.
+	IncrementStateAlpha1(context.Context, *IncrementStateRequestAlpha1) (*IncrementStateResponseAlpha1, error)
+	// Atomically appends values to the JSON array value of a key.
+	AppendStateAlpha1(context.Context, *AppendStateRequestAlpha1) (*AppendStateResponseAlpha1, error)
+	// Watches the changes made to keys of a state store.
+	SubscribeStateAlpha1(*SubscribeStateRequestAlpha1, Dapr_SubscribeStateAlpha1Server) error
+	// Queries for data with projections, sorting on multiple fields, aggregates
+	// and cursor-based pagination.
+	QueryStateAlpha2(context.Context, *QueryStateRequestAlpha2) (*QueryStateResponseAlpha2, error)
+	// Starts re-encrypting the values of an encrypted state store with its
+	// primary key in the background.
+	ReencryptStateAlpha1(context.Context, *ReencryptStateRequestAlpha1) (*ReencryptStateStatusAlpha1, error)
+	// Gets the status of the last re-encryption job of a state store.
+	GetReencryptStateStatusAlpha1(context.Context, *GetReencryptStateStatusRequestAlpha1) (*ReencryptStateStatusAlpha1, error)
 	// Publishes events to the specific topic.
-	PublishEvent(context.Context, *PublishEventRequest) (*emptypb.Empty, error)
+	PublishEvent(context.Context, *PublishEventRequest) (*PublishEventResponse, error)
 	// Bulk Publishes multiple events to the specified topic.
 	BulkPublishEventAlpha1(context.Context, *BulkPublishRequest) (*BulkPublishResponse, error)
 	// SubscribeTopicEventsAlpha1 subscribes to a PubSub topic and receives topic
 	// events from it.
 	SubscribeTopicEventsAlpha1(Dapr_SubscribeTopicEventsAlpha1Server) error
+	// RedriveDeadLetterAlpha1 publishes the messages of a dead letter topic
+	// back to the topics they were dead lettered from.
+	RedriveDeadLetterAlpha1(context.Context, *RedriveDeadLetterRequestAlpha1) (*RedriveDeadLetterResponseAlpha1, error)
 	// Invokes binding data to specific output bindings
 	InvokeBinding(context.Context, *InvokeBindingRequest) (*InvokeBindingResponse, error)
 	// Gets secrets from secret stores.
@@ -1021,6 +1337,13 @@ type DaprServer interface {
 	TryLockAlpha1(context.Context, *TryLockRequest) (*TryLockResponse, error)
 	// UnlockAlpha1 unlocks a lock.
 	UnlockAlpha1(context.Context, *UnlockRequest) (*UnlockResponse, error)
+	// RenewLockAlpha1 extends the expiry of a lock held by its owner.
+	RenewLockAlpha1(context.Context, *RenewLockRequest) (*RenewLockResponse, error)
+	// TryLockSharedAlpha1 tries to get a shared lock with an expiry, which can be
+	// held by multiple owners at once but not together with an exclusive lock.
+	TryLockSharedAlpha1(context.Context, *TryLockSharedRequest) (*TryLockSharedResponse, error)
+	// UnlockSharedAlpha1 releases a shared lock.
+	UnlockSharedAlpha1(context.Context, *UnlockSharedRequest) (*UnlockSharedResponse, error)
 	// EncryptAlpha1 encrypts a message using the Dapr encryption scheme and a key stored in the vault.
 	EncryptAlpha1(Dapr_EncryptAlpha1Server) error
 	// DecryptAlpha1 decrypts a message using the Dapr encryption scheme and a key stored in the vault.
@@ -1043,6 +1366,10 @@ type DaprServer interface {
 	SubtleSignAlpha1(context.Context, *SubtleSignRequest) (*SubtleSignResponse, error)
 	// SubtleVerifyAlpha1 verifies the signature of a message using a key stored in the vault.
 	SubtleVerifyAlpha1(context.Context, *SubtleVerifyRequest) (*SubtleVerifyResponse, error)
+	// GenerateDataKeyAlpha1 returns a data encryption key, in plaintext and wrapped with a key stored in the vault.
+	GenerateDataKeyAlpha1(context.Context, *GenerateDataKeyRequestAlpha1) (*GenerateDataKeyResponseAlpha1, error)
+	// RewrapKeyAlpha1 unwraps a wrapped key and wraps it again with a key stored in the vault.
+	RewrapKeyAlpha1(context.Context, *RewrapKeyRequestAlpha1) (*RewrapKeyResponseAlpha1, error)
 	// Deprecated: Do not use.
 	// Starts a new instance of a workflow
 	StartWorkflowAlpha1(context.Context, *StartWorkflowRequest) (*StartWorkflowResponse, error)
@@ -1078,6 +1405,8 @@ type DaprServer interface {
 	ResumeWorkflowBeta1(context.Context, *ResumeWorkflowRequest) (*emptypb.Empty, error)
 	// Raise an event to a running workflow instance
 	RaiseEventWorkflowBeta1(context.Context, *RaiseEventWorkflowRequest) (*emptypb.Empty, error)
+	// Lists the workflow instances matching a filter
+	ListWorkflowsAlpha1(context.Context, *ListWorkflowsRequestAlpha1) (*ListWorkflowsResponseAlpha1, error)
 	// Shutdown the sidecar
 	Shutdown(context.Context, *ShutdownRequest) (*emptypb.Empty, error)
 	// Create and schedule a job
@@ -1088,6 +1417,12 @@ type DaprServer interface {
 	DeleteJobAlpha1(context.Context, *DeleteJobRequest) (*DeleteJobResponse, error)
 	DeleteJobsByPrefixAlpha1(context.Context, *DeleteJobsByPrefixRequestAlpha1) (*DeleteJobsByPrefixResponseAlpha1, error)
 	ListJobsAlpha1(context.Context, *ListJobsRequestAlpha1) (*ListJobsResponseAlpha1, error)
+	// Delete the selected jobs, paused or not
+	DeleteJobsAlpha1(context.Context, *BulkJobsRequestAlpha1) (*BulkJobsResponseAlpha1, error)
+	// Pause the selected jobs, which aren't triggered until they are resumed
+	PauseJobsAlpha1(context.Context, *BulkJobsRequestAlpha1) (*BulkJobsResponseAlpha1, error)
+	// Resume the selected paused jobs
+	ResumeJobsAlpha1(context.Context, *BulkJobsRequestAlpha1) (*BulkJobsResponseAlpha1, error)
 	// Converse with a LLM service
 	ConverseAlpha1(context.Context, *ConversationRequest) (*ConversationResponse, error)
 	// Converse with a LLM service via alpha2 api
@@ -1101,6 +1436,9 @@ type UnimplementedDaprServer struct {
 func (UnimplementedDaprServer) InvokeService(context.Context, *InvokeServiceRequest) (*v1.InvokeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvokeService not implemented")
 }
+func (UnimplementedDaprServer) InvokeServiceStreamAlpha1(Dapr_InvokeServiceStreamAlpha1Server) error {
+	return status.Errorf(codes.Unimplemented, "method InvokeServiceStreamAlpha1 not implemented")
+}
 func (UnimplementedDaprServer) GetState(context.Context, *GetStateRequest) (*GetStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetState not implemented")
 }
@@ -1122,7 +1460,34 @@ func (UnimplementedDaprServer) DeleteBulkState(context.Context, *DeleteBulkState
 func (UnimplementedDaprServer) ExecuteStateTransaction(context.Context, *ExecuteStateTransactionRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteStateTransaction not implemented")
 }
-func (UnimplementedDaprServer) PublishEvent(context.Context, *PublishEventRequest) (*emptypb.Empty, error) {
+func (UnimplementedDaprServer) CheckAndSetStateAlpha1(context.Context, *CheckAndSetStateRequestAlpha1) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAndSetStateAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) BulkSetStateAlpha1(context.Context, *BulkSetStateRequestAlpha1) (*BulkStateResponseAlpha1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkSetStateAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) BulkDeleteStateAlpha1(context.Context, *BulkDeleteStateRequestAlpha1) (*BulkStateResponseAlpha1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkDeleteStateAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) IncrementStateAlpha1(context.Context, *IncrementStateRequestAlpha1) (*IncrementStateResponseAlpha1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncrementStateAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) AppendStateAlpha1(context.Context, *AppendStateRequestAlpha1) (*AppendStateResponseAlpha1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppendStateAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) SubscribeStateAlpha1(*SubscribeStateRequestAlpha1, Dapr_SubscribeStateAlpha1Server) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeStateAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) QueryStateAlpha2(context.Context, *QueryStateRequestAlpha2) (*QueryStateResponseAlpha2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryStateAlpha2 not implemented")
+}
+func (UnimplementedDaprServer) ReencryptStateAlpha1(context.Context, *ReencryptStateRequestAlpha1) (*ReencryptStateStatusAlpha1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReencryptStateAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) GetReencryptStateStatusAlpha1(context.Context, *GetReencryptStateStatusRequestAlpha1) (*ReencryptStateStatusAlpha1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReencryptStateStatusAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) PublishEvent(context.Context, *PublishEventRequest) (*PublishEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PublishEvent not implemented")
 }
 func (UnimplementedDaprServer) BulkPublishEventAlpha1(context.Context, *BulkPublishRequest) (*BulkPublishResponse, error) {
@@ -1131,6 +1496,9 @@ func (UnimplementedDaprServer) BulkPublishEventAlpha1(context.Context, *BulkPubl
 func (UnimplementedDaprServer) SubscribeTopicEventsAlpha1(Dapr_SubscribeTopicEventsAlpha1Server) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeTopicEventsAlpha1 not implemented")
 }
+func (UnimplementedDaprServer) RedriveDeadLetterAlpha1(context.Context, *RedriveDeadLetterRequestAlpha1) (*RedriveDeadLetterResponseAlpha1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedriveDeadLetterAlpha1 not implemented")
+}
 func (UnimplementedDaprServer) InvokeBinding(context.Context, *InvokeBindingRequest) (*InvokeBindingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvokeBinding not implemented")
 }
@@ -1194,6 +1562,15 @@ func (UnimplementedDaprServer) TryLockAlpha1(context.Context, *TryLockRequest) (
 func (UnimplementedDaprServer) UnlockAlpha1(context.Context, *UnlockRequest) (*UnlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnlockAlpha1 not implemented")
 }
+func (UnimplementedDaprServer) RenewLockAlpha1(context.Context, *RenewLockRequest) (*RenewLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewLockAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) TryLockSharedAlpha1(context.Context, *TryLockSharedRequest) (*TryLockSharedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TryLockSharedAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) UnlockSharedAlpha1(context.Context, *UnlockSharedRequest) (*UnlockSharedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockSharedAlpha1 not implemented")
+}
 func (UnimplementedDaprServer) EncryptAlpha1(Dapr_EncryptAlpha1Server) error {
 	return status.Errorf(codes.Unimplemented, "method EncryptAlpha1 not implemented")
 }
@@ -1227,6 +1604,12 @@ func (UnimplementedDaprServer) SubtleSignAlpha1(context.Context, *SubtleSignRequ
 func (UnimplementedDaprServer) SubtleVerifyAlpha1(context.Context, *SubtleVerifyRequest) (*SubtleVerifyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubtleVerifyAlpha1 not implemented")
 }
+func (UnimplementedDaprServer) GenerateDataKeyAlpha1(context.Context, *GenerateDataKeyRequestAlpha1) (*GenerateDataKeyResponseAlpha1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateDataKeyAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) RewrapKeyAlpha1(context.Context, *RewrapKeyRequestAlpha1) (*RewrapKeyResponseAlpha1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewrapKeyAlpha1 not implemented")
+}
 func (UnimplementedDaprServer) StartWorkflowAlpha1(context.Context, *StartWorkflowRequest) (*StartWorkflowResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartWorkflowAlpha1 not implemented")
 }
@@ -1269,6 +1652,9 @@ func (UnimplementedDaprServer) ResumeWorkflowBeta1(context.Context, *ResumeWorkf
 func (UnimplementedDaprServer) RaiseEventWorkflowBeta1(context.Context, *RaiseEventWorkflowRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RaiseEventWorkflowBeta1 not implemented")
 }
+func (UnimplementedDaprServer) ListWorkflowsAlpha1(context.Context, *ListWorkflowsRequestAlpha1) (*ListWorkflowsResponseAlpha1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkflowsAlpha1 not implemented")
+}
 func (UnimplementedDaprServer) Shutdown(context.Context, *ShutdownRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Shutdown not implemented")
 }
@@ -1287,6 +1673,15 @@ func (UnimplementedDaprServer) DeleteJobsByPrefixAlpha1(context.Context, *Delete
 func (UnimplementedDaprServer) ListJobsAlpha1(context.Context, *ListJobsRequestAlpha1) (*ListJobsResponseAlpha1, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListJobsAlpha1 not implemented")
 }
+func (UnimplementedDaprServer) DeleteJobsAlpha1(context.Context, *BulkJobsRequestAlpha1) (*BulkJobsResponseAlpha1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteJobsAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) PauseJobsAlpha1(context.Context, *BulkJobsRequestAlpha1) (*BulkJobsResponseAlpha1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseJobsAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) ResumeJobsAlpha1(context.Context, *BulkJobsRequestAlpha1) (*BulkJobsResponseAlpha1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeJobsAlpha1 not implemented")
+}
 func (UnimplementedDaprServer) ConverseAlpha1(context.Context, *ConversationRequest) (*ConversationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConverseAlpha1 not implemented")
 }
@@ -1323,6 +1718,32 @@ func _Dapr_InvokeService_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_InvokeServiceStreamAlpha1_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DaprServer).InvokeServiceStreamAlpha1(&daprInvokeServiceStreamAlpha1Server{stream})
+}
+
+type Dapr_InvokeServiceStreamAlpha1Server interface {
+	Send(*InvokeServiceStreamResponse) error
+	Recv() (*InvokeServiceStreamRequest, error)
+	grpc.ServerStream
+}
+
+type daprInvokeServiceStreamAlpha1Server struct {
+	grpc.ServerStream
+}
+
+func (x *daprInvokeServiceStreamAlpha1Server) Send(m *InvokeServiceStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *daprInvokeServiceStreamAlpha1Server) Recv() (*InvokeServiceStreamRequest, error) {
+	m := new(InvokeServiceStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Dapr_GetState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetStateRequest)
 	if err := dec(in); err != nil {
@@ -1449,6 +1870,171 @@ func _Dapr_ExecuteStateTransaction_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_CheckAndSetStateAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAndSetStateRequestAlpha1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).CheckAndSetStateAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_CheckAndSetStateAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).CheckAndSetStateAlpha1(ctx, req.(*CheckAndSetStateRequestAlpha1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_BulkSetStateAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkSetStateRequestAlpha1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).BulkSetStateAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_BulkSetStateAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).BulkSetStateAlpha1(ctx, req.(*BulkSetStateRequestAlpha1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_BulkDeleteStateAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkDeleteStateRequestAlpha1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).BulkDeleteStateAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_BulkDeleteStateAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).BulkDeleteStateAlpha1(ctx, req.(*BulkDeleteStateRequestAlpha1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_IncrementStateAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrementStateRequestAlpha1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).IncrementStateAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_IncrementStateAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).IncrementStateAlpha1(ctx, req.(*IncrementStateRequestAlpha1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_AppendStateAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppendStateRequestAlpha1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).AppendStateAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_AppendStateAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).AppendStateAlpha1(ctx, req.(*AppendStateRequestAlpha1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_SubscribeStateAlpha1_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeStateRequestAlpha1)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaprServer).SubscribeStateAlpha1(m, &daprSubscribeStateAlpha1Server{stream})
+}
+
+type Dapr_SubscribeStateAlpha1Server interface {
+	Send(*SubscribeStateResponseAlpha1) error
+	grpc.ServerStream
+}
+
+type daprSubscribeStateAlpha1Server struct {
+	grpc.ServerStream
+}
+
+func (x *daprSubscribeStateAlpha1Server) Send(m *SubscribeStateResponseAlpha1) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Dapr_QueryStateAlpha2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStateRequestAlpha2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).QueryStateAlpha2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_QueryStateAlpha2_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).QueryStateAlpha2(ctx, req.(*QueryStateRequestAlpha2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_ReencryptStateAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReencryptStateRequestAlpha1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).ReencryptStateAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_ReencryptStateAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).ReencryptStateAlpha1(ctx, req.(*ReencryptStateRequestAlpha1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_GetReencryptStateStatusAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReencryptStateStatusRequestAlpha1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).GetReencryptStateStatusAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_GetReencryptStateStatusAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).GetReencryptStateStatusAlpha1(ctx, req.(*GetReencryptStateStatusRequestAlpha1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dapr_PublishEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PublishEventRequest)
 	if err := dec(in); err != nil {
@@ -1511,6 +2097,24 @@ func (x *daprSubscribeTopicEventsAlpha1Server) Recv() (*SubscribeTopicEventsRequ
 	return m, nil
 }
 
+func _Dapr_RedriveDeadLetterAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedriveDeadLetterRequestAlpha1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).RedriveDeadLetterAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_RedriveDeadLetterAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).RedriveDeadLetterAlpha1(ctx, req.(*RedriveDeadLetterRequestAlpha1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dapr_InvokeBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InvokeBindingRequest)
 	if err := dec(in); err != nil {
@@ -1895,6 +2499,60 @@ func _Dapr_UnlockAlpha1_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_RenewLockAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).RenewLockAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_RenewLockAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).RenewLockAlpha1(ctx, req.(*RenewLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_TryLockSharedAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TryLockSharedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).TryLockSharedAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_TryLockSharedAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).TryLockSharedAlpha1(ctx, req.(*TryLockSharedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_UnlockSharedAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockSharedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).UnlockSharedAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_UnlockSharedAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).UnlockSharedAlpha1(ctx, req.(*UnlockSharedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dapr_EncryptAlpha1_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(DaprServer).EncryptAlpha1(&daprEncryptAlpha1Server{stream})
 }
@@ -2109,6 +2767,42 @@ func _Dapr_SubtleVerifyAlpha1_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_GenerateDataKeyAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateDataKeyRequestAlpha1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).GenerateDataKeyAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_GenerateDataKeyAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).GenerateDataKeyAlpha1(ctx, req.(*GenerateDataKeyRequestAlpha1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_RewrapKeyAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewrapKeyRequestAlpha1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).RewrapKeyAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_RewrapKeyAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).RewrapKeyAlpha1(ctx, req.(*RewrapKeyRequestAlpha1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dapr_StartWorkflowAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StartWorkflowRequest)
 	if err := dec(in); err != nil {
@@ -2361,6 +3055,24 @@ func _Dapr_RaiseEventWorkflowBeta1_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_ListWorkflowsAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkflowsRequestAlpha1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).ListWorkflowsAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_ListWorkflowsAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).ListWorkflowsAlpha1(ctx, req.(*ListWorkflowsRequestAlpha1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dapr_Shutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ShutdownRequest)
 	if err := dec(in); err != nil {
@@ -2469,6 +3181,60 @@ func _Dapr_ListJobsAlpha1_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_DeleteJobsAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkJobsRequestAlpha1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).DeleteJobsAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_DeleteJobsAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).DeleteJobsAlpha1(ctx, req.(*BulkJobsRequestAlpha1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_PauseJobsAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkJobsRequestAlpha1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).PauseJobsAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_PauseJobsAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).PauseJobsAlpha1(ctx, req.(*BulkJobsRequestAlpha1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_ResumeJobsAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkJobsRequestAlpha1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).ResumeJobsAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_ResumeJobsAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).ResumeJobsAlpha1(ctx, req.(*BulkJobsRequestAlpha1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dapr_ConverseAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ConversationRequest)
 	if err := dec(in); err != nil {
@@ -2544,6 +3310,38 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExecuteStateTransaction",
 			Handler:    _Dapr_ExecuteStateTransaction_Handler,
 		},
+		{
+			MethodName: "CheckAndSetStateAlpha1",
+			Handler:    _Dapr_CheckAndSetStateAlpha1_Handler,
+		},
+		{
+			MethodName: "BulkSetStateAlpha1",
+			Handler:    _Dapr_BulkSetStateAlpha1_Handler,
+		},
+		{
+			MethodName: "BulkDeleteStateAlpha1",
+			Handler:    _Dapr_BulkDeleteStateAlpha1_Handler,
+		},
+		{
+			MethodName: "IncrementStateAlpha1",
+			Handler:    _Dapr_IncrementStateAlpha1_Handler,
+		},
+		{
+			MethodName: "AppendStateAlpha1",
+			Handler:    _Dapr_AppendStateAlpha1_Handler,
+		},
+		{
+			MethodName: "QueryStateAlpha2",
+			Handler:    _Dapr_QueryStateAlpha2_Handler,
+		},
+		{
+			MethodName: "ReencryptStateAlpha1",
+			Handler:    _Dapr_ReencryptStateAlpha1_Handler,
+		},
+		{
+			MethodName: "GetReencryptStateStatusAlpha1",
+			Handler:    _Dapr_GetReencryptStateStatusAlpha1_Handler,
+		},
 		{
 			MethodName: "PublishEvent",
 			Handler:    _Dapr_PublishEvent_Handler,
@@ -2552,6 +3350,10 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BulkPublishEventAlpha1",
 			Handler:    _Dapr_BulkPublishEventAlpha1_Handler,
 		},
+		{
+			MethodName: "RedriveDeadLetterAlpha1",
+			Handler:    _Dapr_RedriveDeadLetterAlpha1_Handler,
+		},
 		{
 			MethodName: "InvokeBinding",
 			Handler:    _Dapr_InvokeBinding_Handler,
@@ -2628,6 +3430,18 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnlockAlpha1",
 			Handler:    _Dapr_UnlockAlpha1_Handler,
 		},
+		{
+			MethodName: "RenewLockAlpha1",
+			Handler:    _Dapr_RenewLockAlpha1_Handler,
+		},
+		{
+			MethodName: "TryLockSharedAlpha1",
+			Handler:    _Dapr_TryLockSharedAlpha1_Handler,
+		},
+		{
+			MethodName: "UnlockSharedAlpha1",
+			Handler:    _Dapr_UnlockSharedAlpha1_Handler,
+		},
 		{
 			MethodName: "GetMetadata",
 			Handler:    _Dapr_GetMetadata_Handler,
@@ -2664,6 +3478,14 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubtleVerifyAlpha1",
 			Handler:    _Dapr_SubtleVerifyAlpha1_Handler,
 		},
+		{
+			MethodName: "GenerateDataKeyAlpha1",
+			Handler:    _Dapr_GenerateDataKeyAlpha1_Handler,
+		},
+		{
+			MethodName: "RewrapKeyAlpha1",
+			Handler:    _Dapr_RewrapKeyAlpha1_Handler,
+		},
 		{
 			MethodName: "StartWorkflowAlpha1",
 			Handler:    _Dapr_StartWorkflowAlpha1_Handler,
@@ -2720,6 +3542,10 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RaiseEventWorkflowBeta1",
 			Handler:    _Dapr_RaiseEventWorkflowBeta1_Handler,
 		},
+		{
+			MethodName: "ListWorkflowsAlpha1",
+			Handler:    _Dapr_ListWorkflowsAlpha1_Handler,
+		},
 		{
 			MethodName: "Shutdown",
 			Handler:    _Dapr_Shutdown_Handler,
@@ -2744,6 +3570,18 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListJobsAlpha1",
 			Handler:    _Dapr_ListJobsAlpha1_Handler,
 		},
+		{
+			MethodName: "DeleteJobsAlpha1",
+			Handler:    _Dapr_DeleteJobsAlpha1_Handler,
+		},
+		{
+			MethodName: "PauseJobsAlpha1",
+			Handler:    _Dapr_PauseJobsAlpha1_Handler,
+		},
+		{
+			MethodName: "ResumeJobsAlpha1",
+			Handler:    _Dapr_ResumeJobsAlpha1_Handler,
+		},
 		{
 			MethodName: "ConverseAlpha1",
 			Handler:    _Dapr_ConverseAlpha1_Handler,
@@ -2754,6 +3592,17 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "InvokeServiceStreamAlpha1",
+			Handler:       _Dapr_InvokeServiceStreamAlpha1_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SubscribeStateAlpha1",
+			Handler:       _Dapr_SubscribeStateAlpha1_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "SubscribeTopicEventsAlpha1",
 			Handler:       _Dapr_SubscribeTopicEventsAlpha1_Handler,
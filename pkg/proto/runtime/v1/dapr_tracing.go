@@ -25,6 +25,13 @@ func (x *InvokeServiceRequest) AppendSpanAttributes(rpcMethod string, m map[stri
 	m[diagConsts.DaprAPISpanNameInternal] = "CallLocal/" + x.GetId() + "/" + x.GetMessage().GetMethod()
 }
 
+func (x *InvokeServiceStreamRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// Only the first message of the stream holds the request details
+	if x.GetRequest() != nil {
+		x.GetRequest().AppendSpanAttributes(rpcMethod, m)
+	}
+}
+
 func (x *PublishEventRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	m[diagConsts.GrpcServiceSpanAttributeKey] = diagConsts.DaprGRPCDaprService
 	m[diagConsts.MessagingSystemSpanAttributeKey] = diagConsts.PubsubBuildingBlockType
@@ -37,6 +44,12 @@ func (x *BulkPublishRequest) AppendSpanAttributes(rpcMethod string, m map[string
 	m[diagConsts.MessagingDestinationSpanAttributeKey] = x.GetTopic()
 }
 
+func (x *RedriveDeadLetterRequestAlpha1) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	m[diagConsts.GrpcServiceSpanAttributeKey] = diagConsts.DaprGRPCDaprService
+	m[diagConsts.MessagingSystemSpanAttributeKey] = diagConsts.PubsubBuildingBlockType
+	m[diagConsts.MessagingDestinationSpanAttributeKey] = x.GetTopic()
+}
+
 func (x *InvokeBindingRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	m[diagConsts.DBNameSpanAttributeKey] = x.GetName()
 	m[diagConsts.GrpcServiceSpanAttributeKey] = diagConsts.DaprGRPCDaprService
@@ -61,6 +74,78 @@ func (x *SaveStateRequest) AppendSpanAttributes(rpcMethod string, m map[string]s
 	m[diagConsts.DBConnectionStringSpanAttributeKey] = diagConsts.StateBuildingBlockType
 }
 
+func (x *CheckAndSetStateRequestAlpha1) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	m[diagConsts.DBNameSpanAttributeKey] = x.GetStoreName()
+	m[diagConsts.GrpcServiceSpanAttributeKey] = diagConsts.DaprGRPCDaprService
+	m[diagConsts.DBSystemSpanAttributeKey] = diagConsts.StateBuildingBlockType
+	m[diagConsts.DBStatementSpanAttributeKey] = rpcMethod
+	m[diagConsts.DBConnectionStringSpanAttributeKey] = diagConsts.StateBuildingBlockType
+}
+
+func (x *BulkSetStateRequestAlpha1) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	m[diagConsts.DBNameSpanAttributeKey] = x.GetStoreName()
+	m[diagConsts.GrpcServiceSpanAttributeKey] = diagConsts.DaprGRPCDaprService
+	m[diagConsts.DBSystemSpanAttributeKey] = diagConsts.StateBuildingBlockType
+	m[diagConsts.DBStatementSpanAttributeKey] = rpcMethod
+	m[diagConsts.DBConnectionStringSpanAttributeKey] = diagConsts.StateBuildingBlockType
+}
+
+func (x *BulkDeleteStateRequestAlpha1) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	m[diagConsts.DBNameSpanAttributeKey] = x.GetStoreName()
+	m[diagConsts.GrpcServiceSpanAttributeKey] = diagConsts.DaprGRPCDaprService
+	m[diagConsts.DBSystemSpanAttributeKey] = diagConsts.StateBuildingBlockType
+	m[diagConsts.DBStatementSpanAttributeKey] = rpcMethod
+	m[diagConsts.DBConnectionStringSpanAttributeKey] = diagConsts.StateBuildingBlockType
+}
+
+func (x *IncrementStateRequestAlpha1) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	m[diagConsts.DBNameSpanAttributeKey] = x.GetStoreName()
+	m[diagConsts.GrpcServiceSpanAttributeKey] = diagConsts.DaprGRPCDaprService
+	m[diagConsts.DBSystemSpanAttributeKey] = diagConsts.StateBuildingBlockType
+	m[diagConsts.DBStatementSpanAttributeKey] = rpcMethod
+	m[diagConsts.DBConnectionStringSpanAttributeKey] = diagConsts.StateBuildingBlockType
+}
+
+func (x *AppendStateRequestAlpha1) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	m[diagConsts.DBNameSpanAttributeKey] = x.GetStoreName()
+	m[diagConsts.GrpcServiceSpanAttributeKey] = diagConsts.DaprGRPCDaprService
+	m[diagConsts.DBSystemSpanAttributeKey] = diagConsts.StateBuildingBlockType
+	m[diagConsts.DBStatementSpanAttributeKey] = rpcMethod
+	m[diagConsts.DBConnectionStringSpanAttributeKey] = diagConsts.StateBuildingBlockType
+}
+
+func (x *SubscribeStateRequestAlpha1) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	m[diagConsts.DBNameSpanAttributeKey] = x.GetStoreName()
+	m[diagConsts.GrpcServiceSpanAttributeKey] = diagConsts.DaprGRPCDaprService
+	m[diagConsts.DBSystemSpanAttributeKey] = diagConsts.StateBuildingBlockType
+	m[diagConsts.DBStatementSpanAttributeKey] = rpcMethod
+	m[diagConsts.DBConnectionStringSpanAttributeKey] = diagConsts.StateBuildingBlockType
+}
+
+func (x *QueryStateRequestAlpha2) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	m[diagConsts.DBNameSpanAttributeKey] = x.GetStoreName()
+	m[diagConsts.GrpcServiceSpanAttributeKey] = diagConsts.DaprGRPCDaprService
+	m[diagConsts.DBSystemSpanAttributeKey] = diagConsts.StateBuildingBlockType
+	m[diagConsts.DBStatementSpanAttributeKey] = rpcMethod
+	m[diagConsts.DBConnectionStringSpanAttributeKey] = diagConsts.StateBuildingBlockType
+}
+
+func (x *ReencryptStateRequestAlpha1) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	m[diagConsts.DBNameSpanAttributeKey] = x.GetStoreName()
+	m[diagConsts.GrpcServiceSpanAttributeKey] = diagConsts.DaprGRPCDaprService
+	m[diagConsts.DBSystemSpanAttributeKey] = diagConsts.StateBuildingBlockType
+	m[diagConsts.DBStatementSpanAttributeKey] = rpcMethod
+	m[diagConsts.DBConnectionStringSpanAttributeKey] = diagConsts.StateBuildingBlockType
+}
+
+func (x *GetReencryptStateStatusRequestAlpha1) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	m[diagConsts.DBNameSpanAttributeKey] = x.GetStoreName()
+	m[diagConsts.GrpcServiceSpanAttributeKey] = diagConsts.DaprGRPCDaprService
+	m[diagConsts.DBSystemSpanAttributeKey] = diagConsts.StateBuildingBlockType
+	m[diagConsts.DBStatementSpanAttributeKey] = rpcMethod
+	m[diagConsts.DBConnectionStringSpanAttributeKey] = diagConsts.StateBuildingBlockType
+}
+
 func (x *DeleteStateRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	m[diagConsts.DBNameSpanAttributeKey] = x.GetStoreName()
 	m[diagConsts.GrpcServiceSpanAttributeKey] = diagConsts.DaprGRPCDaprService
@@ -117,6 +202,10 @@ func (*GetBulkSecretRequest) AppendSpanAttributes(rpcMethod string, m map[string
 	// TODO
 }
 
+func (*GenerateDataKeyRequestAlpha1) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// TODO
+}
+
 func (*GetBulkStateRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
@@ -137,6 +226,10 @@ func (*GetWorkflowRequest) AppendSpanAttributes(rpcMethod string, m map[string]s
 	// TODO
 }
 
+func (*ListWorkflowsRequestAlpha1) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// TODO
+}
+
 func (*ListActorRemindersRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
@@ -173,6 +266,14 @@ func (*GetActorReminderRequest) AppendSpanAttributes(rpcMethod string, m map[str
 	// TODO
 }
 
+func (*RenewLockRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// TODO
+}
+
+func (*RewrapKeyRequestAlpha1) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// TODO
+}
+
 func (*ResumeWorkflowRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
@@ -245,10 +346,18 @@ func (*TryLockRequest) AppendSpanAttributes(rpcMethod string, m map[string]strin
 	// TODO
 }
 
+func (*TryLockSharedRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// TODO
+}
+
 func (*UnlockRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
 
+func (*UnlockSharedRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// TODO
+}
+
 func (*UnregisterActorReminderRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
@@ -84,6 +84,110 @@ func (UnlockResponse_Status) EnumDescriptor() ([]byte, []int) {
 	return file_dapr_proto_runtime_v1_lock_proto_rawDescGZIP(), []int{3, 0}
 }
 
+type RenewLockResponse_Status int32
+
+const (
+	RenewLockResponse_SUCCESS                RenewLockResponse_Status = 0
+	RenewLockResponse_LOCK_DOES_NOT_EXIST    RenewLockResponse_Status = 1
+	RenewLockResponse_LOCK_BELONGS_TO_OTHERS RenewLockResponse_Status = 2
+	RenewLockResponse_INTERNAL_ERROR         RenewLockResponse_Status = 3
+)
+
+// Enum value maps for RenewLockResponse_Status.
+var (
+	RenewLockResponse_Status_name = map[int32]string{
+		0: "SUCCESS",
+		1: "LOCK_DOES_NOT_EXIST",
+		2: "LOCK_BELONGS_TO_OTHERS",
+		3: "INTERNAL_ERROR",
+	}
+	RenewLockResponse_Status_value = map[string]int32{
+		"SUCCESS":                0,
+		"LOCK_DOES_NOT_EXIST":    1,
+		"LOCK_BELONGS_TO_OTHERS": 2,
+		"INTERNAL_ERROR":         3,
+	}
+)
+
+func (x RenewLockResponse_Status) Enum() *RenewLockResponse_Status {
+	p := new(RenewLockResponse_Status)
+	*p = x
+	return p
+}
+
+func (x RenewLockResponse_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RenewLockResponse_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_dapr_proto_runtime_v1_lock_proto_enumTypes[1].Descriptor()
+}
+
+func (RenewLockResponse_Status) Type() protoreflect.EnumType {
+	return &file_dapr_proto_runtime_v1_lock_proto_enumTypes[1]
+}
+
+func (x RenewLockResponse_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RenewLockResponse_Status.Descriptor instead.
+func (RenewLockResponse_Status) EnumDescriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_lock_proto_rawDescGZIP(), []int{5, 0}
+}
+
+type UnlockSharedResponse_Status int32
+
+const (
+	UnlockSharedResponse_SUCCESS                UnlockSharedResponse_Status = 0
+	UnlockSharedResponse_LOCK_DOES_NOT_EXIST    UnlockSharedResponse_Status = 1
+	UnlockSharedResponse_LOCK_BELONGS_TO_OTHERS UnlockSharedResponse_Status = 2
+	UnlockSharedResponse_INTERNAL_ERROR         UnlockSharedResponse_Status = 3
+)
+
+// Enum value maps for UnlockSharedResponse_Status.
+var (
+	UnlockSharedResponse_Status_name = map[int32]string{
+		0: "SUCCESS",
+		1: "LOCK_DOES_NOT_EXIST",
+		2: "LOCK_BELONGS_TO_OTHERS",
+		3: "INTERNAL_ERROR",
+	}
+	UnlockSharedResponse_Status_value = map[string]int32{
+		"SUCCESS":                0,
+		"LOCK_DOES_NOT_EXIST":    1,
+		"LOCK_BELONGS_TO_OTHERS": 2,
+		"INTERNAL_ERROR":         3,
+	}
+)
+
+func (x UnlockSharedResponse_Status) Enum() *UnlockSharedResponse_Status {
+	p := new(UnlockSharedResponse_Status)
+	*p = x
+	return p
+}
+
+func (x UnlockSharedResponse_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnlockSharedResponse_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_dapr_proto_runtime_v1_lock_proto_enumTypes[2].Descriptor()
+}
+
+func (UnlockSharedResponse_Status) Type() protoreflect.EnumType {
+	return &file_dapr_proto_runtime_v1_lock_proto_enumTypes[2]
+}
+
+func (x UnlockSharedResponse_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnlockSharedResponse_Status.Descriptor instead.
+func (UnlockSharedResponse_Status) EnumDescriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_lock_proto_rawDescGZIP(), []int{9, 0}
+}
+
 type TryLockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -103,12 +207,12 @@ type TryLockRequest struct {
 	// which aims to prevent multi-thread in the same process trying the same lock concurrently.
 	//
 	// The reason why we don't make it automatically generated is:
-	//  1. If it is automatically generated,there must be a 'my_lock_owner_id' field in the response.
-	//     This name is so weird that we think it is inappropriate to put it into the api spec
-	//  2. If we change the field 'my_lock_owner_id' in the response to 'lock_owner',which means the current lock owner of this lock,
-	//     we find that in some lock services users can't get the current lock owner.Actually users don't need it at all.
-	//  3. When reentrant lock is needed,the existing lock_owner is required to identify client and check "whether this client can reenter this lock".
-	//     So this field in the request shouldn't be removed.
+	// 1. If it is automatically generated,there must be a 'my_lock_owner_id' field in the response.
+	//   This name is so weird that we think it is inappropriate to put it into the api spec
+	// 2. If we change the field 'my_lock_owner_id' in the response to 'lock_owner',which means the current lock owner of this lock,
+	//   we find that in some lock services users can't get the current lock owner.Actually users don't need it at all.
+	// 3. When reentrant lock is needed,the existing lock_owner is required to identify client and check "whether this client can reenter this lock".
+	//   So this field in the request shouldn't be removed.
 	LockOwner string `protobuf:"bytes,3,opt,name=lock_owner,json=lockOwner,proto3" json:"lock_owner,omitempty"`
 	// Required. The time before expiry.The time unit is second.
 	ExpiryInSeconds int32 `protobuf:"varint,4,opt,name=expiry_in_seconds,json=expiryInSeconds,proto3" json:"expiry_in_seconds,omitempty"`
@@ -332,6 +436,381 @@ func (x *UnlockResponse) GetStatus() UnlockResponse_Status {
 	return UnlockResponse_SUCCESS
 }
 
+type RenewLockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required. The lock store name, e.g. `redis`.
+	StoreName string `protobuf:"bytes,1,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	// Required. resource_id is the lock key.
+	ResourceId string `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// Required. The owner of the lock, which was used to acquire it.
+	LockOwner string `protobuf:"bytes,3,opt,name=lock_owner,json=lockOwner,proto3" json:"lock_owner,omitempty"`
+	// Required. The time before expiry after the renewal, in seconds.
+	ExpiryInSeconds int32 `protobuf:"varint,4,opt,name=expiry_in_seconds,json=expiryInSeconds,proto3" json:"expiry_in_seconds,omitempty"`
+	// If true, the sidecar keeps renewing the lock at half of its expiry until
+	// it's released or max_hold_seconds have elapsed.
+	AutoRenew bool `protobuf:"varint,5,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	// The maximum time the lock is renewed by the sidecar for, in seconds.
+	// Required when auto_renew is true.
+	MaxHoldSeconds int32 `protobuf:"varint,6,opt,name=max_hold_seconds,json=maxHoldSeconds,proto3" json:"max_hold_seconds,omitempty"`
+}
+
+func (x *RenewLockRequest) Reset() {
+	*x = RenewLockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_lock_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenewLockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewLockRequest) ProtoMessage() {}
+
+func (x *RenewLockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_lock_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewLockRequest.ProtoReflect.Descriptor instead.
+func (*RenewLockRequest) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_lock_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RenewLockRequest) GetStoreName() string {
+	if x != nil {
+		return x.StoreName
+	}
+	return ""
+}
+
+func (x *RenewLockRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *RenewLockRequest) GetLockOwner() string {
+	if x != nil {
+		return x.LockOwner
+	}
+	return ""
+}
+
+func (x *RenewLockRequest) GetExpiryInSeconds() int32 {
+	if x != nil {
+		return x.ExpiryInSeconds
+	}
+	return 0
+}
+
+func (x *RenewLockRequest) GetAutoRenew() bool {
+	if x != nil {
+		return x.AutoRenew
+	}
+	return false
+}
+
+func (x *RenewLockRequest) GetMaxHoldSeconds() int32 {
+	if x != nil {
+		return x.MaxHoldSeconds
+	}
+	return 0
+}
+
+type RenewLockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status RenewLockResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=dapr.proto.runtime.v1.RenewLockResponse_Status" json:"status,omitempty"`
+}
+
+func (x *RenewLockResponse) Reset() {
+	*x = RenewLockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_lock_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenewLockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewLockResponse) ProtoMessage() {}
+
+func (x *RenewLockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_lock_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewLockResponse.ProtoReflect.Descriptor instead.
+func (*RenewLockResponse) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_lock_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RenewLockResponse) GetStatus() RenewLockResponse_Status {
+	if x != nil {
+		return x.Status
+	}
+	return RenewLockResponse_SUCCESS
+}
+
+type TryLockSharedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required. The lock store name, e.g. `redis`.
+	StoreName string `protobuf:"bytes,1,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	// Required. resource_id is the lock key.
+	ResourceId string `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// Required. lock_owner indicate the identifier of lock owner.
+	LockOwner string `protobuf:"bytes,3,opt,name=lock_owner,json=lockOwner,proto3" json:"lock_owner,omitempty"`
+	// Required. The time before expiry, in seconds.
+	ExpiryInSeconds int32 `protobuf:"varint,4,opt,name=expiry_in_seconds,json=expiryInSeconds,proto3" json:"expiry_in_seconds,omitempty"`
+}
+
+func (x *TryLockSharedRequest) Reset() {
+	*x = TryLockSharedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_lock_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TryLockSharedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TryLockSharedRequest) ProtoMessage() {}
+
+func (x *TryLockSharedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_lock_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TryLockSharedRequest.ProtoReflect.Descriptor instead.
+func (*TryLockSharedRequest) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_lock_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TryLockSharedRequest) GetStoreName() string {
+	if x != nil {
+		return x.StoreName
+	}
+	return ""
+}
+
+func (x *TryLockSharedRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *TryLockSharedRequest) GetLockOwner() string {
+	if x != nil {
+		return x.LockOwner
+	}
+	return ""
+}
+
+func (x *TryLockSharedRequest) GetExpiryInSeconds() int32 {
+	if x != nil {
+		return x.ExpiryInSeconds
+	}
+	return 0
+}
+
+type TryLockSharedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *TryLockSharedResponse) Reset() {
+	*x = TryLockSharedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_lock_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TryLockSharedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TryLockSharedResponse) ProtoMessage() {}
+
+func (x *TryLockSharedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_lock_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TryLockSharedResponse.ProtoReflect.Descriptor instead.
+func (*TryLockSharedResponse) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_lock_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TryLockSharedResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type UnlockSharedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StoreName string `protobuf:"bytes,1,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	// resource_id is the lock key.
+	ResourceId string `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	LockOwner  string `protobuf:"bytes,3,opt,name=lock_owner,json=lockOwner,proto3" json:"lock_owner,omitempty"`
+}
+
+func (x *UnlockSharedRequest) Reset() {
+	*x = UnlockSharedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_lock_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlockSharedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockSharedRequest) ProtoMessage() {}
+
+func (x *UnlockSharedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_lock_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockSharedRequest.ProtoReflect.Descriptor instead.
+func (*UnlockSharedRequest) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_lock_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UnlockSharedRequest) GetStoreName() string {
+	if x != nil {
+		return x.StoreName
+	}
+	return ""
+}
+
+func (x *UnlockSharedRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *UnlockSharedRequest) GetLockOwner() string {
+	if x != nil {
+		return x.LockOwner
+	}
+	return ""
+}
+
+type UnlockSharedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status UnlockSharedResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=dapr.proto.runtime.v1.UnlockSharedResponse_Status" json:"status,omitempty"`
+}
+
+func (x *UnlockSharedResponse) Reset() {
+	*x = UnlockSharedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_lock_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlockSharedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockSharedResponse) ProtoMessage() {}
+
+func (x *UnlockSharedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_lock_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockSharedResponse.ProtoReflect.Descriptor instead.
+func (*UnlockSharedResponse) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_lock_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UnlockSharedResponse) GetStatus() UnlockSharedResponse_Status {
+	if x != nil {
+		return x.Status
+	}
+	return UnlockSharedResponse_SUCCESS
+}
+
 var File_dapr_proto_runtime_v1_lock_proto protoreflect.FileDescriptor
 
 var file_dapr_proto_runtime_v1_lock_proto_rawDesc = []byte{
@@ -369,14 +848,74 @@ var file_dapr_proto_runtime_v1_lock_proto_rawDesc = []byte{
 	0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x10, 0x01, 0x12, 0x1a, 0x0a,
 	0x16, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x42, 0x45, 0x4c, 0x4f, 0x4e, 0x47, 0x53, 0x5f, 0x54, 0x4f,
 	0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x53, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x54,
-	0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x42, 0x69, 0x0a,
-	0x0a, 0x69, 0x6f, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x44, 0x61, 0x70,
-	0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x70, 0x6b,
-	0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f,
-	0x76, 0x31, 0x3b, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0xaa, 0x02, 0x1b, 0x44, 0x61, 0x70,
-	0x72, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x67, 0x65, 0x6e,
-	0x2e, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x22, 0xe6, 0x01,
+	0x0a, 0x10, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x69, 0x6e, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x12, 0x28, 0x0a, 0x10,
+	0x6d, 0x61, 0x78, 0x5f, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x48, 0x6f, 0x6c, 0x64, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xbc, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x6e, 0x65, 0x77,
+	0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x64,
+	0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x5e, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13,
+	0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x58,
+	0x49, 0x53, 0x54, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x42, 0x45,
+	0x4c, 0x4f, 0x4e, 0x47, 0x53, 0x5f, 0x54, 0x4f, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x53, 0x10,
+	0x02, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x10, 0x03, 0x22, 0xa1, 0x01, 0x0a, 0x14, 0x54, 0x72, 0x79, 0x4c, 0x6f, 0x63,
+	0x6b, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2a, 0x0a,
+	0x11, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x49, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x31, 0x0a, 0x15, 0x54, 0x72, 0x79,
+	0x4c, 0x6f, 0x63, 0x6b, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x74, 0x0a, 0x13,
+	0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x22, 0xc2, 0x01, 0x0a, 0x14, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x64, 0x61,
+	0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x5e, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x00, 0x12, 0x17,
+	0x0a, 0x13, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x45, 0x58, 0x49, 0x53, 0x54, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
+	0x42, 0x45, 0x4c, 0x4f, 0x4e, 0x47, 0x53, 0x5f, 0x54, 0x4f, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52,
+	0x53, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x42, 0x69, 0x0a, 0x0a, 0x69, 0x6f, 0x2e, 0x64, 0x61,
+	0x70, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x44, 0x61, 0x70, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61,
+	0x70, 0x72, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0xaa, 0x02, 0x1b, 0x44, 0x61, 0x70, 0x72, 0x2e, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x2e,
+	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -391,22 +930,32 @@ func file_dapr_proto_runtime_v1_lock_proto_rawDescGZIP() []byte {
 	return file_dapr_proto_runtime_v1_lock_proto_rawDescData
 }
 
-var file_dapr_proto_runtime_v1_lock_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_dapr_proto_runtime_v1_lock_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_dapr_proto_runtime_v1_lock_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_dapr_proto_runtime_v1_lock_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_dapr_proto_runtime_v1_lock_proto_goTypes = []interface{}{
-	(UnlockResponse_Status)(0), // 0: dapr.proto.runtime.v1.UnlockResponse.Status
-	(*TryLockRequest)(nil),     // 1: dapr.proto.runtime.v1.TryLockRequest
-	(*TryLockResponse)(nil),    // 2: dapr.proto.runtime.v1.TryLockResponse
-	(*UnlockRequest)(nil),      // 3: dapr.proto.runtime.v1.UnlockRequest
-	(*UnlockResponse)(nil),     // 4: dapr.proto.runtime.v1.UnlockResponse
+	(UnlockResponse_Status)(0),       // 0: dapr.proto.runtime.v1.UnlockResponse.Status
+	(RenewLockResponse_Status)(0),    // 1: dapr.proto.runtime.v1.RenewLockResponse.Status
+	(UnlockSharedResponse_Status)(0), // 2: dapr.proto.runtime.v1.UnlockSharedResponse.Status
+	(*TryLockRequest)(nil),           // 3: dapr.proto.runtime.v1.TryLockRequest
+	(*TryLockResponse)(nil),          // 4: dapr.proto.runtime.v1.TryLockResponse
+	(*UnlockRequest)(nil),            // 5: dapr.proto.runtime.v1.UnlockRequest
+	(*UnlockResponse)(nil),           // 6: dapr.proto.runtime.v1.UnlockResponse
+	(*RenewLockRequest)(nil),         // 7: dapr.proto.runtime.v1.RenewLockRequest
+	(*RenewLockResponse)(nil),        // 8: dapr.proto.runtime.v1.RenewLockResponse
+	(*TryLockSharedRequest)(nil),     // 9: dapr.proto.runtime.v1.TryLockSharedRequest
+	(*TryLockSharedResponse)(nil),    // 10: dapr.proto.runtime.v1.TryLockSharedResponse
+	(*UnlockSharedRequest)(nil),      // 11: dapr.proto.runtime.v1.UnlockSharedRequest
+	(*UnlockSharedResponse)(nil),     // 12: dapr.proto.runtime.v1.UnlockSharedResponse
 }
 var file_dapr_proto_runtime_v1_lock_proto_depIdxs = []int32{
 	0, // 0: dapr.proto.runtime.v1.UnlockResponse.status:type_name -> dapr.proto.runtime.v1.UnlockResponse.Status
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1, // 1: dapr.proto.runtime.v1.RenewLockResponse.status:type_name -> dapr.proto.runtime.v1.RenewLockResponse.Status
+	2, // 2: dapr.proto.runtime.v1.UnlockSharedResponse.status:type_name -> dapr.proto.runtime.v1.UnlockSharedResponse.Status
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_dapr_proto_runtime_v1_lock_proto_init() }
@@ -463,14 +1012,86 @@ func file_dapr_proto_runtime_v1_lock_proto_init() {
 				return nil
 			}
 		}
+		file_dapr_proto_runtime_v1_lock_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewLockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_lock_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewLockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_lock_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TryLockSharedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_lock_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TryLockSharedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_lock_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockSharedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_lock_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockSharedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_dapr_proto_runtime_v1_lock_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   4,
+			NumEnums:      3,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -120,6 +120,75 @@ func (x *PublishEventRequest) GetMetadata() map[string]string {
 	return nil
 }
 
+// PublishEventResponse is the message returned from a PublishEvent call. The
+// fields hold the identifiers the broker assigned to the published message,
+// and are empty when the pubsub component doesn't return them.
+type PublishEventResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the message in the broker.
+	MessageId string `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// The partition the message was written to.
+	Partition *int32 `protobuf:"varint,2,opt,name=partition,proto3,oneof" json:"partition,omitempty"`
+	// The offset or sequence number of the message in the partition or stream.
+	Offset string `protobuf:"bytes,3,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *PublishEventResponse) Reset() {
+	*x = PublishEventResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishEventResponse) ProtoMessage() {}
+
+func (x *PublishEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishEventResponse.ProtoReflect.Descriptor instead.
+func (*PublishEventResponse) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PublishEventResponse) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *PublishEventResponse) GetPartition() int32 {
+	if x != nil && x.Partition != nil {
+		return *x.Partition
+	}
+	return 0
+}
+
+func (x *PublishEventResponse) GetOffset() string {
+	if x != nil {
+		return x.Offset
+	}
+	return ""
+}
+
 // BulkPublishRequest is the message to bulk publish events to pubsub topic
 type BulkPublishRequest struct {
 	state         protoimpl.MessageState
@@ -139,7 +208,7 @@ type BulkPublishRequest struct {
 func (x *BulkPublishRequest) Reset() {
 	*x = BulkPublishRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[1]
+		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -152,7 +221,7 @@ func (x *BulkPublishRequest) String() string {
 func (*BulkPublishRequest) ProtoMessage() {}
 
 func (x *BulkPublishRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[1]
+	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -165,7 +234,7 @@ func (x *BulkPublishRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkPublishRequest.ProtoReflect.Descriptor instead.
 func (*BulkPublishRequest) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{1}
+	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *BulkPublishRequest) GetPubsubName() string {
@@ -215,7 +284,7 @@ type BulkPublishRequestEntry struct {
 func (x *BulkPublishRequestEntry) Reset() {
 	*x = BulkPublishRequestEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[2]
+		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -228,7 +297,7 @@ func (x *BulkPublishRequestEntry) String() string {
 func (*BulkPublishRequestEntry) ProtoMessage() {}
 
 func (x *BulkPublishRequestEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[2]
+	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -241,7 +310,7 @@ func (x *BulkPublishRequestEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkPublishRequestEntry.ProtoReflect.Descriptor instead.
 func (*BulkPublishRequestEntry) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{2}
+	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *BulkPublishRequestEntry) GetEntryId() string {
@@ -285,7 +354,7 @@ type BulkPublishResponse struct {
 func (x *BulkPublishResponse) Reset() {
 	*x = BulkPublishResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[3]
+		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -298,7 +367,7 @@ func (x *BulkPublishResponse) String() string {
 func (*BulkPublishResponse) ProtoMessage() {}
 
 func (x *BulkPublishResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[3]
+	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -311,7 +380,7 @@ func (x *BulkPublishResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkPublishResponse.ProtoReflect.Descriptor instead.
 func (*BulkPublishResponse) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{3}
+	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *BulkPublishResponse) GetFailedEntries() []*BulkPublishResponseFailedEntry {
@@ -336,7 +405,7 @@ type BulkPublishResponseFailedEntry struct {
 func (x *BulkPublishResponseFailedEntry) Reset() {
 	*x = BulkPublishResponseFailedEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[4]
+		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -349,7 +418,7 @@ func (x *BulkPublishResponseFailedEntry) String() string {
 func (*BulkPublishResponseFailedEntry) ProtoMessage() {}
 
 func (x *BulkPublishResponseFailedEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[4]
+	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -362,7 +431,7 @@ func (x *BulkPublishResponseFailedEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkPublishResponseFailedEntry.ProtoReflect.Descriptor instead.
 func (*BulkPublishResponseFailedEntry) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{4}
+	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *BulkPublishResponseFailedEntry) GetEntryId() string {
@@ -389,7 +458,6 @@ type SubscribeTopicEventsRequestAlpha1 struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to SubscribeTopicEventsRequestType:
-	//
 	//	*SubscribeTopicEventsRequestAlpha1_InitialRequest
 	//	*SubscribeTopicEventsRequestAlpha1_EventProcessed
 	SubscribeTopicEventsRequestType isSubscribeTopicEventsRequestAlpha1_SubscribeTopicEventsRequestType `protobuf_oneof:"subscribe_topic_events_request_type"`
@@ -398,7 +466,7 @@ type SubscribeTopicEventsRequestAlpha1 struct {
 func (x *SubscribeTopicEventsRequestAlpha1) Reset() {
 	*x = SubscribeTopicEventsRequestAlpha1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[5]
+		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -411,7 +479,7 @@ func (x *SubscribeTopicEventsRequestAlpha1) String() string {
 func (*SubscribeTopicEventsRequestAlpha1) ProtoMessage() {}
 
 func (x *SubscribeTopicEventsRequestAlpha1) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[5]
+	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -424,7 +492,7 @@ func (x *SubscribeTopicEventsRequestAlpha1) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use SubscribeTopicEventsRequestAlpha1.ProtoReflect.Descriptor instead.
 func (*SubscribeTopicEventsRequestAlpha1) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{5}
+	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{6}
 }
 
 func (m *SubscribeTopicEventsRequestAlpha1) GetSubscribeTopicEventsRequestType() isSubscribeTopicEventsRequestAlpha1_SubscribeTopicEventsRequestType {
@@ -490,7 +558,7 @@ type SubscribeTopicEventsRequestInitialAlpha1 struct {
 func (x *SubscribeTopicEventsRequestInitialAlpha1) Reset() {
 	*x = SubscribeTopicEventsRequestInitialAlpha1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[6]
+		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -503,7 +571,7 @@ func (x *SubscribeTopicEventsRequestInitialAlpha1) String() string {
 func (*SubscribeTopicEventsRequestInitialAlpha1) ProtoMessage() {}
 
 func (x *SubscribeTopicEventsRequestInitialAlpha1) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[6]
+	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -516,7 +584,7 @@ func (x *SubscribeTopicEventsRequestInitialAlpha1) ProtoReflect() protoreflect.M
 
 // Deprecated: Use SubscribeTopicEventsRequestInitialAlpha1.ProtoReflect.Descriptor instead.
 func (*SubscribeTopicEventsRequestInitialAlpha1) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{6}
+	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SubscribeTopicEventsRequestInitialAlpha1) GetPubsubName() string {
@@ -563,7 +631,7 @@ type SubscribeTopicEventsRequestProcessedAlpha1 struct {
 func (x *SubscribeTopicEventsRequestProcessedAlpha1) Reset() {
 	*x = SubscribeTopicEventsRequestProcessedAlpha1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[7]
+		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -576,7 +644,7 @@ func (x *SubscribeTopicEventsRequestProcessedAlpha1) String() string {
 func (*SubscribeTopicEventsRequestProcessedAlpha1) ProtoMessage() {}
 
 func (x *SubscribeTopicEventsRequestProcessedAlpha1) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[7]
+	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -589,7 +657,7 @@ func (x *SubscribeTopicEventsRequestProcessedAlpha1) ProtoReflect() protoreflect
 
 // Deprecated: Use SubscribeTopicEventsRequestProcessedAlpha1.ProtoReflect.Descriptor instead.
 func (*SubscribeTopicEventsRequestProcessedAlpha1) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{7}
+	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SubscribeTopicEventsRequestProcessedAlpha1) GetId() string {
@@ -614,7 +682,6 @@ type SubscribeTopicEventsResponseAlpha1 struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to SubscribeTopicEventsResponseType:
-	//
 	//	*SubscribeTopicEventsResponseAlpha1_InitialResponse
 	//	*SubscribeTopicEventsResponseAlpha1_EventMessage
 	SubscribeTopicEventsResponseType isSubscribeTopicEventsResponseAlpha1_SubscribeTopicEventsResponseType `protobuf_oneof:"subscribe_topic_events_response_type"`
@@ -623,7 +690,7 @@ type SubscribeTopicEventsResponseAlpha1 struct {
 func (x *SubscribeTopicEventsResponseAlpha1) Reset() {
 	*x = SubscribeTopicEventsResponseAlpha1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[8]
+		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -636,7 +703,7 @@ func (x *SubscribeTopicEventsResponseAlpha1) String() string {
 func (*SubscribeTopicEventsResponseAlpha1) ProtoMessage() {}
 
 func (x *SubscribeTopicEventsResponseAlpha1) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[8]
+	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -649,7 +716,7 @@ func (x *SubscribeTopicEventsResponseAlpha1) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use SubscribeTopicEventsResponseAlpha1.ProtoReflect.Descriptor instead.
 func (*SubscribeTopicEventsResponseAlpha1) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{8}
+	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{9}
 }
 
 func (m *SubscribeTopicEventsResponseAlpha1) GetSubscribeTopicEventsResponseType() isSubscribeTopicEventsResponseAlpha1_SubscribeTopicEventsResponseType {
@@ -702,7 +769,7 @@ type SubscribeTopicEventsResponseInitialAlpha1 struct {
 func (x *SubscribeTopicEventsResponseInitialAlpha1) Reset() {
 	*x = SubscribeTopicEventsResponseInitialAlpha1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[9]
+		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -715,7 +782,7 @@ func (x *SubscribeTopicEventsResponseInitialAlpha1) String() string {
 func (*SubscribeTopicEventsResponseInitialAlpha1) ProtoMessage() {}
 
 func (x *SubscribeTopicEventsResponseInitialAlpha1) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[9]
+	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -728,7 +795,176 @@ func (x *SubscribeTopicEventsResponseInitialAlpha1) ProtoReflect() protoreflect.
 
 // Deprecated: Use SubscribeTopicEventsResponseInitialAlpha1.ProtoReflect.Descriptor instead.
 func (*SubscribeTopicEventsResponseInitialAlpha1) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{9}
+	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{10}
+}
+
+// RedriveDeadLetterRequestAlpha1 is the message to publish the messages of a
+// dead letter topic back to the topics they were dead lettered from.
+type RedriveDeadLetterRequestAlpha1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the pubsub component
+	PubsubName string `protobuf:"bytes,1,opt,name=pubsub_name,json=pubsubName,proto3" json:"pubsub_name,omitempty"`
+	// The dead letter topic
+	Topic string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	// The metadata passing to the pubsub component
+	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// CEL expression selecting the messages to redrive (optional). The
+	// CloudEvent is available as `event` and the message metadata as
+	// `metadata`. Messages which are not selected are kept in the dead letter
+	// topic.
+	Filter *string `protobuf:"bytes,4,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	// The maximum number of messages redriven per second (optional). Defaults
+	// to no limit.
+	RatePerSecond *float64 `protobuf:"fixed64,5,opt,name=rate_per_second,json=ratePerSecond,proto3,oneof" json:"rate_per_second,omitempty"`
+	// The maximum number of messages redriven (optional). Defaults to no limit.
+	MaxMessages *int32 `protobuf:"varint,6,opt,name=max_messages,json=maxMessages,proto3,oneof" json:"max_messages,omitempty"`
+	// The time after which the redrive stops when no message is received from
+	// the dead letter topic, as a Go duration string (optional). Defaults to
+	// 5s.
+	IdleTimeout *string `protobuf:"bytes,7,opt,name=idle_timeout,json=idleTimeout,proto3,oneof" json:"idle_timeout,omitempty"`
+}
+
+func (x *RedriveDeadLetterRequestAlpha1) Reset() {
+	*x = RedriveDeadLetterRequestAlpha1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedriveDeadLetterRequestAlpha1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedriveDeadLetterRequestAlpha1) ProtoMessage() {}
+
+func (x *RedriveDeadLetterRequestAlpha1) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedriveDeadLetterRequestAlpha1.ProtoReflect.Descriptor instead.
+func (*RedriveDeadLetterRequestAlpha1) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RedriveDeadLetterRequestAlpha1) GetPubsubName() string {
+	if x != nil {
+		return x.PubsubName
+	}
+	return ""
+}
+
+func (x *RedriveDeadLetterRequestAlpha1) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *RedriveDeadLetterRequestAlpha1) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *RedriveDeadLetterRequestAlpha1) GetFilter() string {
+	if x != nil && x.Filter != nil {
+		return *x.Filter
+	}
+	return ""
+}
+
+func (x *RedriveDeadLetterRequestAlpha1) GetRatePerSecond() float64 {
+	if x != nil && x.RatePerSecond != nil {
+		return *x.RatePerSecond
+	}
+	return 0
+}
+
+func (x *RedriveDeadLetterRequestAlpha1) GetMaxMessages() int32 {
+	if x != nil && x.MaxMessages != nil {
+		return *x.MaxMessages
+	}
+	return 0
+}
+
+func (x *RedriveDeadLetterRequestAlpha1) GetIdleTimeout() string {
+	if x != nil && x.IdleTimeout != nil {
+		return *x.IdleTimeout
+	}
+	return ""
+}
+
+// RedriveDeadLetterResponseAlpha1 is the outcome of a redrive.
+type RedriveDeadLetterResponseAlpha1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of messages published back to their original topic.
+	Redriven int32 `protobuf:"varint,1,opt,name=redriven,proto3" json:"redriven,omitempty"`
+	// The number of messages kept in the dead letter topic, because they were
+	// not selected by the filter or their original topic is unknown.
+	Skipped int32 `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
+}
+
+func (x *RedriveDeadLetterResponseAlpha1) Reset() {
+	*x = RedriveDeadLetterResponseAlpha1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedriveDeadLetterResponseAlpha1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedriveDeadLetterResponseAlpha1) ProtoMessage() {}
+
+func (x *RedriveDeadLetterResponseAlpha1) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedriveDeadLetterResponseAlpha1.ProtoReflect.Descriptor instead.
+func (*RedriveDeadLetterResponseAlpha1) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RedriveDeadLetterResponseAlpha1) GetRedriven() int32 {
+	if x != nil {
+		return x.Redriven
+	}
+	return 0
+}
+
+func (x *RedriveDeadLetterResponseAlpha1) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
 }
 
 var File_dapr_proto_runtime_v1_pubsub_proto protoreflect.FileDescriptor
@@ -758,7 +994,15 @@ var file_dapr_proto_runtime_v1_pubsub_proto_rawDesc = []byte{
 	0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
 	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
 	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa7, 0x02, 0x0a, 0x12, 0x42, 0x75, 0x6c, 0x6b, 0x50,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x7e, 0x0a, 0x14, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a,
+	0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x00, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa7, 0x02, 0x0a, 0x12, 0x42, 0x75, 0x6c, 0x6b, 0x50,
 	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
 	0x0b, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14,
@@ -873,14 +1117,49 @@ var file_dapr_proto_runtime_v1_pubsub_proto_rawDesc = []byte{
 	0x6e, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x2b, 0x0a, 0x29, 0x53, 0x75, 0x62, 0x73,
 	0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x69, 0x0a, 0x0a, 0x69, 0x6f, 0x2e, 0x64, 0x61, 0x70, 0x72,
-	0x2e, 0x76, 0x31, 0x42, 0x0a, 0x44, 0x61, 0x70, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x5a,
-	0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x72,
-	0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0xaa, 0x02, 0x1b, 0x44, 0x61, 0x70, 0x72, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x2e, 0x41, 0x75, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x22, 0xd0, 0x03, 0x0a, 0x1e, 0x52, 0x65, 0x64, 0x72, 0x69, 0x76,
+	0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x73,
+	0x75, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x75, 0x62, 0x73, 0x75, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12,
+	0x5f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x43, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x64, 0x72, 0x69, 0x76,
+	0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x1b, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a,
+	0x0f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x0d, 0x72, 0x61, 0x74, 0x65, 0x50, 0x65,
+	0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x6d, 0x61,
+	0x78, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x02, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0b, 0x69, 0x64, 0x6c, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x88, 0x01, 0x01, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x69, 0x64, 0x6c, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x57, 0x0a, 0x1f, 0x52, 0x65, 0x64, 0x72,
+	0x69, 0x76, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x64, 0x72, 0x69, 0x76, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72,
+	0x65, 0x64, 0x72, 0x69, 0x76, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65,
+	0x64, 0x42, 0x69, 0x0a, 0x0a, 0x69, 0x6f, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x76, 0x31, 0x42,
+	0x0a, 0x44, 0x61, 0x70, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x5a, 0x31, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x64, 0x61, 0x70,
+	0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0xaa, 0x02,
+	0x1b, 0x44, 0x61, 0x70, 0x72, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x75, 0x74,
+	0x6f, 0x67, 0x65, 0x6e, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -895,42 +1174,47 @@ func file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP() []byte {
 	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescData
 }
 
-var file_dapr_proto_runtime_v1_pubsub_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_dapr_proto_runtime_v1_pubsub_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_dapr_proto_runtime_v1_pubsub_proto_goTypes = []interface{}{
 	(*PublishEventRequest)(nil),                        // 0: dapr.proto.runtime.v1.PublishEventRequest
-	(*BulkPublishRequest)(nil),                         // 1: dapr.proto.runtime.v1.BulkPublishRequest
-	(*BulkPublishRequestEntry)(nil),                    // 2: dapr.proto.runtime.v1.BulkPublishRequestEntry
-	(*BulkPublishResponse)(nil),                        // 3: dapr.proto.runtime.v1.BulkPublishResponse
-	(*BulkPublishResponseFailedEntry)(nil),             // 4: dapr.proto.runtime.v1.BulkPublishResponseFailedEntry
-	(*SubscribeTopicEventsRequestAlpha1)(nil),          // 5: dapr.proto.runtime.v1.SubscribeTopicEventsRequestAlpha1
-	(*SubscribeTopicEventsRequestInitialAlpha1)(nil),   // 6: dapr.proto.runtime.v1.SubscribeTopicEventsRequestInitialAlpha1
-	(*SubscribeTopicEventsRequestProcessedAlpha1)(nil), // 7: dapr.proto.runtime.v1.SubscribeTopicEventsRequestProcessedAlpha1
-	(*SubscribeTopicEventsResponseAlpha1)(nil),         // 8: dapr.proto.runtime.v1.SubscribeTopicEventsResponseAlpha1
-	(*SubscribeTopicEventsResponseInitialAlpha1)(nil),  // 9: dapr.proto.runtime.v1.SubscribeTopicEventsResponseInitialAlpha1
-	nil,                        // 10: dapr.proto.runtime.v1.PublishEventRequest.MetadataEntry
-	nil,                        // 11: dapr.proto.runtime.v1.BulkPublishRequest.MetadataEntry
-	nil,                        // 12: dapr.proto.runtime.v1.BulkPublishRequestEntry.MetadataEntry
-	nil,                        // 13: dapr.proto.runtime.v1.SubscribeTopicEventsRequestInitialAlpha1.MetadataEntry
-	(*TopicEventResponse)(nil), // 14: dapr.proto.runtime.v1.TopicEventResponse
-	(*TopicEventRequest)(nil),  // 15: dapr.proto.runtime.v1.TopicEventRequest
+	(*PublishEventResponse)(nil),                       // 1: dapr.proto.runtime.v1.PublishEventResponse
+	(*BulkPublishRequest)(nil),                         // 2: dapr.proto.runtime.v1.BulkPublishRequest
+	(*BulkPublishRequestEntry)(nil),                    // 3: dapr.proto.runtime.v1.BulkPublishRequestEntry
+	(*BulkPublishResponse)(nil),                        // 4: dapr.proto.runtime.v1.BulkPublishResponse
+	(*BulkPublishResponseFailedEntry)(nil),             // 5: dapr.proto.runtime.v1.BulkPublishResponseFailedEntry
+	(*SubscribeTopicEventsRequestAlpha1)(nil),          // 6: dapr.proto.runtime.v1.SubscribeTopicEventsRequestAlpha1
+	(*SubscribeTopicEventsRequestInitialAlpha1)(nil),   // 7: dapr.proto.runtime.v1.SubscribeTopicEventsRequestInitialAlpha1
+	(*SubscribeTopicEventsRequestProcessedAlpha1)(nil), // 8: dapr.proto.runtime.v1.SubscribeTopicEventsRequestProcessedAlpha1
+	(*SubscribeTopicEventsResponseAlpha1)(nil),         // 9: dapr.proto.runtime.v1.SubscribeTopicEventsResponseAlpha1
+	(*SubscribeTopicEventsResponseInitialAlpha1)(nil),  // 10: dapr.proto.runtime.v1.SubscribeTopicEventsResponseInitialAlpha1
+	(*RedriveDeadLetterRequestAlpha1)(nil),             // 11: dapr.proto.runtime.v1.RedriveDeadLetterRequestAlpha1
+	(*RedriveDeadLetterResponseAlpha1)(nil),            // 12: dapr.proto.runtime.v1.RedriveDeadLetterResponseAlpha1
+	nil,                                                // 13: dapr.proto.runtime.v1.PublishEventRequest.MetadataEntry
+	nil,                                                // 14: dapr.proto.runtime.v1.BulkPublishRequest.MetadataEntry
+	nil,                                                // 15: dapr.proto.runtime.v1.BulkPublishRequestEntry.MetadataEntry
+	nil,                                                // 16: dapr.proto.runtime.v1.SubscribeTopicEventsRequestInitialAlpha1.MetadataEntry
+	nil,                                                // 17: dapr.proto.runtime.v1.RedriveDeadLetterRequestAlpha1.MetadataEntry
+	(*TopicEventResponse)(nil),                         // 18: dapr.proto.runtime.v1.TopicEventResponse
+	(*TopicEventRequest)(nil),                          // 19: dapr.proto.runtime.v1.TopicEventRequest
 }
 var file_dapr_proto_runtime_v1_pubsub_proto_depIdxs = []int32{
-	10, // 0: dapr.proto.runtime.v1.PublishEventRequest.metadata:type_name -> dapr.proto.runtime.v1.PublishEventRequest.MetadataEntry
-	2,  // 1: dapr.proto.runtime.v1.BulkPublishRequest.entries:type_name -> dapr.proto.runtime.v1.BulkPublishRequestEntry
-	11, // 2: dapr.proto.runtime.v1.BulkPublishRequest.metadata:type_name -> dapr.proto.runtime.v1.BulkPublishRequest.MetadataEntry
-	12, // 3: dapr.proto.runtime.v1.BulkPublishRequestEntry.metadata:type_name -> dapr.proto.runtime.v1.BulkPublishRequestEntry.MetadataEntry
-	4,  // 4: dapr.proto.runtime.v1.BulkPublishResponse.failedEntries:type_name -> dapr.proto.runtime.v1.BulkPublishResponseFailedEntry
-	6,  // 5: dapr.proto.runtime.v1.SubscribeTopicEventsRequestAlpha1.initial_request:type_name -> dapr.proto.runtime.v1.SubscribeTopicEventsRequestInitialAlpha1
-	7,  // 6: dapr.proto.runtime.v1.SubscribeTopicEventsRequestAlpha1.event_processed:type_name -> dapr.proto.runtime.v1.SubscribeTopicEventsRequestProcessedAlpha1
-	13, // 7: dapr.proto.runtime.v1.SubscribeTopicEventsRequestInitialAlpha1.metadata:type_name -> dapr.proto.runtime.v1.SubscribeTopicEventsRequestInitialAlpha1.MetadataEntry
-	14, // 8: dapr.proto.runtime.v1.SubscribeTopicEventsRequestProcessedAlpha1.status:type_name -> dapr.proto.runtime.v1.TopicEventResponse
-	9,  // 9: dapr.proto.runtime.v1.SubscribeTopicEventsResponseAlpha1.initial_response:type_name -> dapr.proto.runtime.v1.SubscribeTopicEventsResponseInitialAlpha1
-	15, // 10: dapr.proto.runtime.v1.SubscribeTopicEventsResponseAlpha1.event_message:type_name -> dapr.proto.runtime.v1.TopicEventRequest
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	13, // 0: dapr.proto.runtime.v1.PublishEventRequest.metadata:type_name -> dapr.proto.runtime.v1.PublishEventRequest.MetadataEntry
+	3,  // 1: dapr.proto.runtime.v1.BulkPublishRequest.entries:type_name -> dapr.proto.runtime.v1.BulkPublishRequestEntry
+	14, // 2: dapr.proto.runtime.v1.BulkPublishRequest.metadata:type_name -> dapr.proto.runtime.v1.BulkPublishRequest.MetadataEntry
+	15, // 3: dapr.proto.runtime.v1.BulkPublishRequestEntry.metadata:type_name -> dapr.proto.runtime.v1.BulkPublishRequestEntry.MetadataEntry
+	5,  // 4: dapr.proto.runtime.v1.BulkPublishResponse.failedEntries:type_name -> dapr.proto.runtime.v1.BulkPublishResponseFailedEntry
+	7,  // 5: dapr.proto.runtime.v1.SubscribeTopicEventsRequestAlpha1.initial_request:type_name -> dapr.proto.runtime.v1.SubscribeTopicEventsRequestInitialAlpha1
+	8,  // 6: dapr.proto.runtime.v1.SubscribeTopicEventsRequestAlpha1.event_processed:type_name -> dapr.proto.runtime.v1.SubscribeTopicEventsRequestProcessedAlpha1
+	16, // 7: dapr.proto.runtime.v1.SubscribeTopicEventsRequestInitialAlpha1.metadata:type_name -> dapr.proto.runtime.v1.SubscribeTopicEventsRequestInitialAlpha1.MetadataEntry
+	18, // 8: dapr.proto.runtime.v1.SubscribeTopicEventsRequestProcessedAlpha1.status:type_name -> dapr.proto.runtime.v1.TopicEventResponse
+	10, // 9: dapr.proto.runtime.v1.SubscribeTopicEventsResponseAlpha1.initial_response:type_name -> dapr.proto.runtime.v1.SubscribeTopicEventsResponseInitialAlpha1
+	19, // 10: dapr.proto.runtime.v1.SubscribeTopicEventsResponseAlpha1.event_message:type_name -> dapr.proto.runtime.v1.TopicEventRequest
+	17, // 11: dapr.proto.runtime.v1.RedriveDeadLetterRequestAlpha1.metadata:type_name -> dapr.proto.runtime.v1.RedriveDeadLetterRequestAlpha1.MetadataEntry
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_dapr_proto_runtime_v1_pubsub_proto_init() }
@@ -953,7 +1237,7 @@ func file_dapr_proto_runtime_v1_pubsub_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BulkPublishRequest); i {
+			switch v := v.(*PublishEventResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -965,7 +1249,7 @@ func file_dapr_proto_runtime_v1_pubsub_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BulkPublishRequestEntry); i {
+			switch v := v.(*BulkPublishRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -977,7 +1261,7 @@ func file_dapr_proto_runtime_v1_pubsub_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BulkPublishResponse); i {
+			switch v := v.(*BulkPublishRequestEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -989,7 +1273,7 @@ func file_dapr_proto_runtime_v1_pubsub_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BulkPublishResponseFailedEntry); i {
+			switch v := v.(*BulkPublishResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1001,7 +1285,7 @@ func file_dapr_proto_runtime_v1_pubsub_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeTopicEventsRequestAlpha1); i {
+			switch v := v.(*BulkPublishResponseFailedEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1013,7 +1297,7 @@ func file_dapr_proto_runtime_v1_pubsub_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeTopicEventsRequestInitialAlpha1); i {
+			switch v := v.(*SubscribeTopicEventsRequestAlpha1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1025,7 +1309,7 @@ func file_dapr_proto_runtime_v1_pubsub_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeTopicEventsRequestProcessedAlpha1); i {
+			switch v := v.(*SubscribeTopicEventsRequestInitialAlpha1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1037,7 +1321,7 @@ func file_dapr_proto_runtime_v1_pubsub_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeTopicEventsResponseAlpha1); i {
+			switch v := v.(*SubscribeTopicEventsRequestProcessedAlpha1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1049,6 +1333,18 @@ func file_dapr_proto_runtime_v1_pubsub_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeTopicEventsResponseAlpha1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubscribeTopicEventsResponseInitialAlpha1); i {
 			case 0:
 				return &v.state
@@ -1060,23 +1356,49 @@ func file_dapr_proto_runtime_v1_pubsub_proto_init() {
 				return nil
 			}
 		}
+		file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedriveDeadLetterRequestAlpha1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedriveDeadLetterResponseAlpha1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[5].OneofWrappers = []interface{}{
+	file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[6].OneofWrappers = []interface{}{
 		(*SubscribeTopicEventsRequestAlpha1_InitialRequest)(nil),
 		(*SubscribeTopicEventsRequestAlpha1_EventProcessed)(nil),
 	}
-	file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[6].OneofWrappers = []interface{}{}
-	file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[9].OneofWrappers = []interface{}{
 		(*SubscribeTopicEventsResponseAlpha1_InitialResponse)(nil),
 		(*SubscribeTopicEventsResponseAlpha1_EventMessage)(nil),
 	}
+	file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[11].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_dapr_proto_runtime_v1_pubsub_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -27,6 +27,8 @@ const (
 	Scheduler_WatchHosts_FullMethodName         = "/dapr.proto.scheduler.v1.Scheduler/WatchHosts"
 	Scheduler_DeleteByMetadata_FullMethodName   = "/dapr.proto.scheduler.v1.Scheduler/DeleteByMetadata"
 	Scheduler_DeleteByNamePrefix_FullMethodName = "/dapr.proto.scheduler.v1.Scheduler/DeleteByNamePrefix"
+	Scheduler_PauseJob_FullMethodName           = "/dapr.proto.scheduler.v1.Scheduler/PauseJob"
+	Scheduler_ResumeJob_FullMethodName          = "/dapr.proto.scheduler.v1.Scheduler/ResumeJob"
 )
 
 // SchedulerClient is the client API for Scheduler service.
@@ -54,6 +56,11 @@ type SchedulerClient interface {
 	// DeleteByNamePrefix is used by the daprd sidecar to delete jobs by name
 	// prefix. An empty prefix deletes all jobs from the target.
 	DeleteByNamePrefix(ctx context.Context, in *DeleteByNamePrefixRequest, opts ...grpc.CallOption) (*DeleteByNamePrefixResponse, error)
+	// PauseJob is used by the daprd sidecar to pause a job, which isn't
+	// triggered until it's resumed.
+	PauseJob(ctx context.Context, in *PauseJobRequest, opts ...grpc.CallOption) (*PauseJobResponse, error)
+	// ResumeJob is used by the daprd sidecar to resume a paused job.
+	ResumeJob(ctx context.Context, in *ResumeJobRequest, opts ...grpc.CallOption) (*ResumeJobResponse, error)
 }
 
 type schedulerClient struct {
@@ -181,6 +188,24 @@ func (c *schedulerClient) DeleteByNamePrefix(ctx context.Context, in *DeleteByNa
 	return out, nil
 }
 
+func (c *schedulerClient) PauseJob(ctx context.Context, in *PauseJobRequest, opts ...grpc.CallOption) (*PauseJobResponse, error) {
+	out := new(PauseJobResponse)
+	err := c.cc.Invoke(ctx, Scheduler_PauseJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) ResumeJob(ctx context.Context, in *ResumeJobRequest, opts ...grpc.CallOption) (*ResumeJobResponse, error) {
+	out := new(ResumeJobResponse)
+	err := c.cc.Invoke(ctx, Scheduler_ResumeJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SchedulerServer is the server API for Scheduler service.
 // All implementations should embed UnimplementedSchedulerServer
 // for forward compatibility
@@ -206,6 +231,11 @@ type SchedulerServer interface {
 	// DeleteByNamePrefix is used by the daprd sidecar to delete jobs by name
 	// prefix. An empty prefix deletes all jobs from the target.
 	DeleteByNamePrefix(context.Context, *DeleteByNamePrefixRequest) (*DeleteByNamePrefixResponse, error)
+	// PauseJob is used by the daprd sidecar to pause a job, which isn't
+	// triggered until it's resumed.
+	PauseJob(context.Context, *PauseJobRequest) (*PauseJobResponse, error)
+	// ResumeJob is used by the daprd sidecar to resume a paused job.
+	ResumeJob(context.Context, *ResumeJobRequest) (*ResumeJobResponse, error)
 }
 
 // UnimplementedSchedulerServer should be embedded to have forward compatible implementations.
@@ -236,6 +266,12 @@ func (UnimplementedSchedulerServer) DeleteByMetadata(context.Context, *DeleteByM
 func (UnimplementedSchedulerServer) DeleteByNamePrefix(context.Context, *DeleteByNamePrefixRequest) (*DeleteByNamePrefixResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteByNamePrefix not implemented")
 }
+func (UnimplementedSchedulerServer) PauseJob(context.Context, *PauseJobRequest) (*PauseJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseJob not implemented")
+}
+func (UnimplementedSchedulerServer) ResumeJob(context.Context, *ResumeJobRequest) (*ResumeJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeJob not implemented")
+}
 
 // UnsafeSchedulerServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to SchedulerServer will
@@ -403,6 +439,42 @@ func _Scheduler_DeleteByNamePrefix_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Scheduler_PauseJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).PauseJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_PauseJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).PauseJob(ctx, req.(*PauseJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_ResumeJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).ResumeJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_ResumeJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).ResumeJob(ctx, req.(*ResumeJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Scheduler_ServiceDesc is the grpc.ServiceDesc for Scheduler service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -434,6 +506,14 @@ var Scheduler_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteByNamePrefix",
 			Handler:    _Scheduler_DeleteByNamePrefix_Handler,
 		},
+		{
+			MethodName: "PauseJob",
+			Handler:    _Scheduler_PauseJob_Handler,
+		},
+		{
+			MethodName: "ResumeJob",
+			Handler:    _Scheduler_ResumeJob_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -36,6 +36,11 @@ func (NoOp) ActorPostLockPolicy(actorType string, id string) *PolicyDefinition {
 	return nil
 }
 
+// ActorMethodPostLockPolicy returns a NoOp policy definition for a method of an actor instance.
+func (NoOp) ActorMethodPostLockPolicy(actorType string, id string, method string) *PolicyDefinition {
+	return nil
+}
+
 // ComponentInboundPolicy returns a NoOp inbound policy definition for a component.
 func (NoOp) ComponentInboundPolicy(name string, componentName ComponentType) *PolicyDefinition {
 	return nil
@@ -24,6 +24,7 @@ import (
 
 	"github.com/cenkalti/backoff/v4"
 
+	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/resiliency/breaker"
 	"github.com/dapr/kit/logger"
 	"github.com/dapr/kit/retry"
@@ -182,8 +183,11 @@ func NewRunnerWithOptions[T any](ctx context.Context, def *PolicyDefinition, opt
 				resAny, err := def.cb.Execute(func() (any, error) {
 					return operCopy(ctx)
 				})
-				if def.addCBStateChangedMetric != nil && prevState != def.cb.State() {
-					def.addCBStateChangedMetric()
+				if newState := def.cb.State(); prevState != newState {
+					if def.addCBStateChangedMetric != nil {
+						def.addCBStateChangedMetric()
+					}
+					diag.AddResiliencyCircuitBreakerEvent(ctx, def.name, def.cb.Name, string(prevState), string(newState))
 				}
 				if def.r != nil && breaker.IsErrorPermanent(err) {
 					// Break out of retry
@@ -233,6 +237,7 @@ func NewRunnerWithOptions[T any](ctx context.Context, def *PolicyDefinition, opt
 				if def.addRetryActivatedMetric != nil {
 					def.addRetryActivatedMetric()
 				}
+				diag.AddResiliencyRetryEvent(ctx, def.name, attempts.Load(), d, opErr)
 				def.log.Warnf("Error processing operation %s. Retrying in %v…", def.name, d)
 				def.log.Debugf("Error for operation %s was: %v", def.name, opErr)
 			},
@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"strconv"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -25,8 +26,12 @@ import (
 	"github.com/cenkalti/backoff/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 
 	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+	diagConsts "github.com/dapr/dapr/pkg/diagnostics/consts"
+	"github.com/dapr/dapr/pkg/expr"
 	"github.com/dapr/dapr/pkg/resiliency/breaker"
 	"github.com/dapr/kit/logger"
 	"github.com/dapr/kit/retry"
@@ -432,3 +437,57 @@ func TestPolicyDisposer(t *testing.T) {
 	slices.Sort(disposed)
 	assert.Equal(t, []int32{1, 2, 3}, disposed)
 }
+
+func TestPolicySpanEvents(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	var trip expr.Expr
+	require.NoError(t, trip.DecodeString("consecutiveFailures > 1"))
+	cb := breaker.CircuitBreaker{
+		Name:    "testcb",
+		Trip:    &trip,
+		Timeout: time.Minute,
+	}
+	cb.Initialize(testLog)
+
+	ctx, span := tp.Tracer("test").Start(t.Context(), "op")
+	policy := NewRunner[struct{}](ctx, &PolicyDefinition{
+		log:  testLog,
+		name: "testpolicy",
+		r:    NewRetry(retry.Config{Policy: retry.PolicyConstant, Duration: time.Millisecond, MaxRetries: 3}, NewRetryConditionMatch()),
+		cb:   &cb,
+	})
+	_, err := policy(func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, errors.New("boom")
+	})
+	require.Error(t, err)
+	span.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+
+	var retries, cbEvents int
+	for _, e := range spans[0].Events() {
+		attrs := make(map[string]string, len(e.Attributes))
+		for _, a := range e.Attributes {
+			attrs[string(a.Key)] = a.Value.Emit()
+		}
+		assert.Equal(t, "testpolicy", attrs[diagConsts.ResiliencyPolicySpanAttributeKey])
+		switch e.Name {
+		case diagConsts.ResiliencyRetryEventName:
+			retries++
+			assert.Equal(t, strconv.Itoa(retries), attrs[diagConsts.ResiliencyAttemptSpanAttributeKey])
+			assert.Equal(t, "1", attrs[diagConsts.ResiliencyBackoffMsSpanAttributeKey])
+			assert.NotEmpty(t, attrs[diagConsts.ResiliencyErrorSpanAttributeKey])
+		case diagConsts.ResiliencyCircuitBreakerEventName:
+			cbEvents++
+			assert.Equal(t, "testcb", attrs[diagConsts.ResiliencyCBNameSpanAttributeKey])
+			assert.Equal(t, string(breaker.StateClosed), attrs[diagConsts.ResiliencyCBFromStateSpanAttributeKey])
+			assert.Equal(t, string(breaker.StateOpen), attrs[diagConsts.ResiliencyCBToStateSpanAttributeKey])
+		}
+	}
+	// The circuit breaker opens on the second failure, which is a permanent error and stops retrying.
+	assert.Equal(t, 1, retries)
+	assert.Equal(t, 1, cbEvents)
+}
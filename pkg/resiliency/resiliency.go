@@ -93,6 +93,8 @@ type (
 		ActorPreLockPolicy(actorType string, id string) *PolicyDefinition
 		// ActorPolicy returns the policy for an actor instance to be used after the lock is acquired.
 		ActorPostLockPolicy(actorType string, id string) *PolicyDefinition
+		// ActorMethodPostLockPolicy returns the policy for a method of an actor instance to be used after the lock is acquired.
+		ActorMethodPostLockPolicy(actorType string, id string, method string) *PolicyDefinition
 		// ComponentOutboundPolicy returns the outbound policy for a component.
 		ComponentOutboundPolicy(name string, componentType ComponentType) *PolicyDefinition
 		// ComponentInboundPolicy returns the inbound policy for a component.
@@ -164,6 +166,8 @@ type (
 	// Policy used after an actor is locked. It only uses timeout as retry/circuit breaker is handled before locking.
 	ActorPostLockPolicyNames struct {
 		Timeout string
+		// MethodTimeouts override the timeout for the methods of the actor type, by method name.
+		MethodTimeouts map[string]string
 	}
 
 	DefaultPolicyTemplate string
@@ -490,7 +494,8 @@ func (r *Resiliency) decodeTargets(c *resiliencyV1alpha.Resiliency) (err error)
 					CircuitBreakerScope: scope,
 				},
 				PostLockPolicies: ActorPostLockPolicyNames{
-					Timeout: t.Timeout,
+					Timeout:        t.Timeout,
+					MethodTimeouts: actorMethodTimeouts(t.Methods),
 				},
 			}
 		} else {
@@ -500,7 +505,8 @@ func (r *Resiliency) decodeTargets(c *resiliencyV1alpha.Resiliency) (err error)
 					CircuitBreaker: "",
 				},
 				PostLockPolicies: ActorPostLockPolicyNames{
-					Timeout: t.Timeout,
+					Timeout:        t.Timeout,
+					MethodTimeouts: actorMethodTimeouts(t.Methods),
 				},
 			}
 		}
@@ -774,6 +780,12 @@ func (r *Resiliency) ActorPreLockPolicy(actorType string, id string) *PolicyDefi
 
 // ActorPostLockPolicy returns the policy for an actor instance to be used after an actor lock is acquired.
 func (r *Resiliency) ActorPostLockPolicy(actorType string, id string) *PolicyDefinition {
+	return r.ActorMethodPostLockPolicy(actorType, id, "")
+}
+
+// ActorMethodPostLockPolicy returns the policy for a method of an actor instance to be used after an actor lock is acquired.
+// The timeout of the method, if any, overrides the timeout of the actor type.
+func (r *Resiliency) ActorMethodPostLockPolicy(actorType string, id string, method string) *PolicyDefinition {
 	policyDef := &PolicyDefinition{
 		log:  r.log,
 		name: "actor[" + actorType + ", " + id + "]",
@@ -784,6 +796,9 @@ func (r *Resiliency) ActorPostLockPolicy(actorType string, id string) *PolicyDef
 		if policyNames.Timeout != "" {
 			policyDef.t = r.timeouts[policyNames.Timeout]
 		}
+		if timeout, ok := policyNames.MethodTimeouts[method]; ok && method != "" {
+			policyDef.t = r.timeouts[timeout]
+		}
 	} else {
 		if defaultPolicies, ok := r.getDefaultPolicy(ActorPolicy{}); ok {
 			r.log.Debugf("Found Default Policy for Actor type %s: %+v", actorType, defaultPolicies)
@@ -1011,6 +1026,20 @@ func parseDuration(val string) (time.Duration, error) {
 	return time.ParseDuration(val)
 }
 
+// actorMethodTimeouts returns the timeout policy names of the methods of an actor type.
+func actorMethodTimeouts(methods map[string]resiliencyV1alpha.ActorMethodPolicyNames) map[string]string {
+	if len(methods) == 0 {
+		return nil
+	}
+	timeouts := make(map[string]string, len(methods))
+	for method, policyNames := range methods {
+		if policyNames.Timeout != "" {
+			timeouts[method] = policyNames.Timeout
+		}
+	}
+	return timeouts
+}
+
 // ParseActorCircuitBreakerScope parses a string to a `ActorCircuitBreakerScope`.
 func ParseActorCircuitBreakerScope(val string) (ActorCircuitBreakerScope, error) {
 	switch val {
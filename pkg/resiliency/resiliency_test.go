@@ -726,3 +726,31 @@ func concurrentPolicyExec(t *testing.T, policyDefFn func(idx int) *PolicyDefinit
 	}
 	wg.Wait()
 }
+
+func TestActorMethodPostLockPolicy(t *testing.T) {
+	r := FromConfigurations(log, &resiliencyV1alpha.Resiliency{
+		Spec: resiliencyV1alpha.ResiliencySpec{
+			Policies: resiliencyV1alpha.Policies{
+				Timeouts: map[string]string{
+					"actorTimeout":  "10s",
+					"methodTimeout": "2s",
+				},
+			},
+			Targets: resiliencyV1alpha.Targets{
+				Actors: map[string]resiliencyV1alpha.ActorPolicyNames{
+					"myActorType": {
+						Timeout: "actorTimeout",
+						Methods: map[string]resiliencyV1alpha.ActorMethodPolicyNames{
+							"slow": {Timeout: "methodTimeout"},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	assert.Equal(t, 2*time.Second, r.ActorMethodPostLockPolicy("myActorType", "id", "slow").t)
+	assert.Equal(t, 10*time.Second, r.ActorMethodPostLockPolicy("myActorType", "id", "other").t)
+	assert.Equal(t, 10*time.Second, r.ActorPostLockPolicy("myActorType", "id").t)
+	assert.Zero(t, r.ActorMethodPostLockPolicy("otherActorType", "id", "slow").t)
+}
@@ -146,7 +146,17 @@ func (rw *responseWriter) callBefore() {
 }
 
 func (rw *responseWriter) Flush() {
-	if f, ok := rw.ResponseWriter.(http.Flusher); ok {
-		f.Flush()
+	_ = rw.FlushError()
+}
+
+// FlushError flushes the buffered data to the client, unwrapping the underlying
+// http.ResponseWriter as needed. It returns http.ErrNotSupported if none of the
+// wrapped writers can be flushed.
+// This is used by http.ResponseController.
+func (rw *responseWriter) FlushError() error {
+	if !rw.Written() {
+		// Flushing sends the headers, so the status is StatusOK if WriteHeader has not been called yet
+		rw.WriteHeader(http.StatusOK)
 	}
+	return http.NewResponseController(rw.ResponseWriter).Flush()
 }
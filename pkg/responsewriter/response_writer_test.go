@@ -213,3 +213,44 @@ func TestResponseWriterWithReadFrom(t *testing.T) {
 	require.Equal(t, writeString, mrw.Body.String())
 	require.Equal(t, writeString, mrw.writtenStr)
 }
+
+// unwrappingResponseWriter hides the Flush method of the wrapped writer, but exposes it via Unwrap
+type unwrappingResponseWriter struct {
+	http.ResponseWriter
+}
+
+func (rw *unwrappingResponseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
+func TestResponseWriterFlush(t *testing.T) {
+	t.Run("flushes nested writers", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		rw := NewResponseWriter(&unwrappingResponseWriter{ResponseWriter: rec})
+
+		require.NoError(t, http.NewResponseController(rw).Flush())
+		require.True(t, rec.Flushed)
+		require.True(t, rw.Written())
+		require.Equal(t, http.StatusOK, rw.Status())
+	})
+
+	t.Run("flush not supported", func(t *testing.T) {
+		rw := NewResponseWriter(&unwrappingResponseWriter{ResponseWriter: &mockResponseWriterWithoutFlush{header: http.Header{}}})
+
+		require.ErrorIs(t, http.NewResponseController(rw).Flush(), http.ErrNotSupported)
+	})
+}
+
+type mockResponseWriterWithoutFlush struct {
+	header http.Header
+}
+
+func (rw *mockResponseWriterWithoutFlush) Header() http.Header {
+	return rw.header
+}
+
+func (rw *mockResponseWriterWithoutFlush) Write(b []byte) (int, error) {
+	return len(b), nil
+}
+
+func (rw *mockResponseWriterWithoutFlush) WriteHeader(int) {}
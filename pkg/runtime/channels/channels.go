@@ -14,8 +14,10 @@ limitations under the License.
 package channels
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
@@ -37,6 +39,7 @@ import (
 	"github.com/dapr/dapr/pkg/runtime/compstore"
 	"github.com/dapr/dapr/pkg/runtime/meta"
 	"github.com/dapr/dapr/pkg/runtime/registry"
+	"github.com/dapr/dapr/pkg/security/apitoken"
 	"github.com/dapr/kit/logger"
 )
 
@@ -68,7 +71,13 @@ type Options struct {
 	ReadBufferSize int
 
 	GRPC        *manager.Manager
-	AppAPIToken string
+	AppAPIToken *apitoken.Tokens
+
+	// AppTLS is the TLS configuration used to connect to the app when the app protocol is https or grpcs.
+	AppTLS *AppTLS
+
+	// NewGRPCManager returns the gRPC manager of an additional app endpoint that uses gRPC.
+	NewGRPCManager func(config.AppConnectionConfig) *manager.Manager
 }
 
 type Channels struct {
@@ -82,14 +91,39 @@ type Channels struct {
 	httpClient          *http.Client
 	grpc                *manager.Manager
 
-	appAPIToken     string
+	appAPIToken     *apitoken.Tokens
 	appChannel      channel.AppChannel
 	endpChannels    map[string]channel.HTTPEndpointAppChannel
 	httpEndpChannel channel.AppChannel
+	appEndpoints    map[config.AppCallback]*appEndpoint
 	lock            sync.RWMutex
 }
 
+// appEndpoint is an additional app endpoint, which receives the callbacks routed to it.
+type appEndpoint struct {
+	connConfig config.AppConnectionConfig
+	httpClient *http.Client
+	grpc       *manager.Manager
+	channel    channel.AppChannel
+}
+
 func New(opts Options) *Channels {
+	// Callbacks routed to the same endpoint share its client
+	appEndpoints := make(map[config.AppCallback]*appEndpoint)
+	for _, e := range opts.AppConnectionConfig.Endpoints {
+		ep := &appEndpoint{
+			connConfig: opts.AppConnectionConfig.ForEndpoint(e),
+		}
+		if e.Protocol.IsHTTP() {
+			ep.httpClient = appHTTPClient(ep.connConfig, opts.GlobalConfig, opts.ReadBufferSize, opts.AppTLS)
+		} else if opts.NewGRPCManager != nil {
+			ep.grpc = opts.NewGRPCManager(ep.connConfig)
+		}
+		for _, cb := range e.Callbacks {
+			appEndpoints[cb] = ep
+		}
+	}
+
 	return &Channels{
 		registry:            opts.Registry.HTTPMiddlewares(),
 		compStore:           opts.ComponentStore,
@@ -100,8 +134,9 @@ func New(opts Options) *Channels {
 		appMiddlware:        opts.AppMiddleware,
 		grpc:                opts.GRPC,
 		appAPIToken:         opts.AppAPIToken,
-		httpClient:          appHTTPClient(opts.AppConnectionConfig, opts.GlobalConfig, opts.ReadBufferSize),
+		httpClient:          appHTTPClient(opts.AppConnectionConfig, opts.GlobalConfig, opts.ReadBufferSize, opts.AppTLS),
 		endpChannels:        make(map[string]channel.HTTPEndpointAppChannel),
+		appEndpoints:        appEndpoints,
 	}
 }
 
@@ -124,7 +159,19 @@ func (c *Channels) Refresh() error {
 	c.httpEndpChannel = httpEndpChannel
 	c.endpChannels = endpChannels
 
-	if c.appConnectionConfig.Port == 0 {
+	// Callbacks routed to the same app endpoint share its channel
+	refreshed := make(map[*appEndpoint]struct{}, len(c.appEndpoints))
+	for _, ep := range c.appEndpoints {
+		if _, ok := refreshed[ep]; ok {
+			continue
+		}
+		refreshed[ep] = struct{}{}
+		if err = c.initAppEndpointChannel(ep); err != nil {
+			return fmt.Errorf("failed to create app channel for port %d: %w", ep.connConfig.Port, err)
+		}
+	}
+
+	if !c.appConnectionConfig.IsConfigured() {
 		log.Warn("App channel is not initialized. Did you configure an app-port?")
 		return nil
 	}
@@ -157,6 +204,28 @@ func (c *Channels) AppChannel() channel.AppChannel {
 	return c.appChannel
 }
 
+// AppChannelFor returns the app channel for the callback, which is the channel
+// of the app endpoint the callback is routed to, or the default app channel.
+func (c *Channels) AppChannelFor(cb config.AppCallback) channel.AppChannel {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if ep, ok := c.appEndpoints[cb]; ok && ep.channel != nil {
+		return ep.channel
+	}
+	return c.appChannel
+}
+
+// RoutedAppEndpoint returns the protocol of the app endpoint the callback is
+// routed to, and its gRPC manager if it uses gRPC.
+// It returns false if the callback is sent to the app port.
+func (c *Channels) RoutedAppEndpoint(cb config.AppCallback) (protocol.Protocol, *manager.Manager, bool) {
+	ep, ok := c.appEndpoints[cb]
+	if !ok {
+		return "", nil, false
+	}
+	return ep.connConfig.Protocol, ep.grpc, true
+}
+
 func (c *Channels) HTTPEndpointsAppChannel() channel.HTTPEndpointAppChannel {
 	c.lock.RLock()
 	defer c.lock.RUnlock()
@@ -175,13 +244,21 @@ func (c *Channels) AppHTTPClient() *http.Client {
 
 // AppHTTPEndpoint Returns the HTTP endpoint for the app.
 func (c *Channels) AppHTTPEndpoint() string {
+	return appHTTPEndpoint(c.appConnectionConfig)
+}
+
+func appHTTPEndpoint(connConfig config.AppConnectionConfig) string {
 	// Application protocol is "http" or "https"
-	port := strconv.Itoa(c.appConnectionConfig.Port)
-	switch c.appConnectionConfig.Protocol {
+	// When connecting over a Unix domain socket, the HTTP client dials the socket and the host is only used in the requests
+	host := connConfig.ChannelAddress + ":" + strconv.Itoa(connConfig.Port)
+	if connConfig.UnixDomainSocket != "" {
+		host = "localhost"
+	}
+	switch connConfig.Protocol {
 	case protocol.HTTPProtocol, protocol.H2CProtocol:
-		return "http://" + c.appConnectionConfig.ChannelAddress + ":" + port
+		return "http://" + host
 	case protocol.HTTPSProtocol:
-		return "https://" + c.appConnectionConfig.ChannelAddress + ":" + port
+		return "https://" + host
 	default:
 		return ""
 	}
@@ -203,6 +280,30 @@ func (c *Channels) appHTTPChannelConfig() channelhttp.ChannelConfiguration {
 	return conf
 }
 
+func (c *Channels) initAppEndpointChannel(ep *appEndpoint) error {
+	if !ep.connConfig.Protocol.IsHTTP() {
+		if ep.grpc == nil {
+			return errors.New("gRPC manager not initialized")
+		}
+		ch, err := ep.grpc.GetAppChannel()
+		if err != nil {
+			return err
+		}
+		ep.channel = ch
+		return nil
+	}
+
+	conf := c.appHTTPChannelConfig()
+	conf.Endpoint = appHTTPEndpoint(ep.connConfig)
+	conf.Client = ep.httpClient
+	ch, err := channelhttp.CreateHTTPChannel(conf)
+	if err != nil {
+		return err
+	}
+	ep.channel = ch
+	return nil
+}
+
 func (c *Channels) initEndpointChannels() (map[string]channel.HTTPEndpointAppChannel, error) {
 	// Create dedicated app channels for known app endpoints
 	endpoints := c.compStore.ListHTTPEndpoints()
@@ -295,39 +396,56 @@ func (c *Channels) getHTTPEndpointAppChannel(endpoint httpendpapi.HTTPEndpoint)
 }
 
 // appHTTPClient Initializes the appHTTPClient property.
-func appHTTPClient(connConfig config.AppConnectionConfig, globalConfig *config.Configuration, readBufferSize int) *http.Client {
+func appHTTPClient(connConfig config.AppConnectionConfig, globalConfig *config.Configuration, readBufferSize int, appTLS *AppTLS) *http.Client {
 	var transport http.RoundTripper
 
+	pool := &poolMetrics{}
+	dialer := &net.Dialer{}
+	dial := pool.dial(func(ctx context.Context, network, addr string) (net.Conn, error) {
+		if connConfig.UnixDomainSocket != "" {
+			return dialer.DialContext(ctx, "unix", connConfig.UnixDomainSocket)
+		}
+		return dialer.DialContext(ctx, network, addr)
+	})
+
 	if connConfig.Protocol == protocol.H2CProtocol {
 		// Enable HTTP/2 Cleartext transport
 		transport = &http2.Transport{
 			AllowHTTP: true, // To enable using "http" as protocol
-			DialTLS: func(network, addr string, _ *tls.Config) (net.Conn, error) {
+			DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
 				// Return the TCP socket without TLS
-				return net.Dial(network, addr)
+				return dial(ctx, network, addr)
 			},
+			IdleConnTimeout: connConfig.HTTPPool.IdleConnTimeout,
 			// TODO: This may not be exactly the same as "MaxResponseHeaderBytes" so check before enabling this
 			// MaxHeaderListSize: uint32(a.runtimeConfig.readBufferSize),
 		}
 	} else {
 		var tlsConfig *tls.Config
 		if connConfig.Protocol == protocol.HTTPSProtocol {
-			tlsConfig = &tls.Config{
-				InsecureSkipVerify: true, //nolint:gosec
-				MinVersion:         channel.AppChannelMinTLSVersion,
+			tlsConfig = appTLS.ClientConfig()
+			if tlsConfig == nil {
+				tlsConfig = &tls.Config{
+					InsecureSkipVerify: true, //nolint:gosec
+					MinVersion:         channel.AppChannelMinTLSVersion,
+				}
 			}
 		}
 
 		ts := http.DefaultTransport.(*http.Transport).Clone()
-		ts.ForceAttemptHTTP2 = false
+		ts.ForceAttemptHTTP2 = connConfig.HTTPPool.EnableHTTP2
 		ts.TLSClientConfig = tlsConfig
 		ts.ReadBufferSize = readBufferSize
 		ts.MaxResponseHeaderBytes = int64(readBufferSize)
-		ts.MaxConnsPerHost = 1024
-		ts.MaxIdleConns = 64 // A local channel connects to a single host
-		ts.MaxIdleConnsPerHost = 64
+		ts.MaxConnsPerHost = connConfig.HTTPPool.MaxConnsPerHost
+		// A local channel connects to a single host
+		ts.MaxIdleConns = connConfig.HTTPPool.MaxIdleConns
+		ts.MaxIdleConnsPerHost = connConfig.HTTPPool.MaxIdleConns
+		ts.IdleConnTimeout = connConfig.HTTPPool.IdleConnTimeout
+		ts.DialContext = dial
 		transport = ts
 	}
+	transport = pool.roundTripper(transport)
 
 	// Initialize this property in the object, and then pass it to the HTTP channel and the actors runtime (for health checks)
 	// We want to re-use the same client so TCP sockets can be re-used efficiently across everything that communicates with the app
@@ -20,8 +20,15 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
+	"io"
 	"math/big"
+	"net"
 	"net/http"
+	"net/http/httptest"
+	"net/http/httptrace"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -34,6 +41,8 @@ import (
 	httpendpapi "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
 	httpMiddlewareLoader "github.com/dapr/dapr/pkg/components/middleware/http"
 	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/config/protocol"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	"github.com/dapr/dapr/pkg/modes"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
 	"github.com/dapr/dapr/pkg/runtime/meta"
@@ -214,3 +223,135 @@ func TestGetHTTPEndpointAppChannel(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestAppUnixDomainSocket(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "app.socket")
+	l, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	srv := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("hello from " + r.URL.Path))
+		}),
+		ReadHeaderTimeout: time.Second,
+	}
+	go srv.Serve(l)
+	t.Cleanup(func() { srv.Close() })
+
+	connConfig := config.AppConnectionConfig{
+		ChannelAddress:   "127.0.0.1",
+		Port:             0,
+		Protocol:         protocol.HTTPProtocol,
+		UnixDomainSocket: socket,
+	}
+	assert.True(t, connConfig.IsConfigured())
+
+	ch := &Channels{
+		appConnectionConfig: connConfig,
+		httpClient:          appHTTPClient(connConfig, &config.Configuration{}, 4<<10, nil),
+	}
+	assert.Equal(t, "http://localhost", ch.AppHTTPEndpoint())
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, ch.AppHTTPEndpoint()+"/foo", nil)
+	require.NoError(t, err)
+	res, err := ch.AppHTTPClient().Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "hello from /foo", string(body))
+}
+
+func TestAppEndpoints(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("hello from " + r.URL.Path))
+	}))
+	t.Cleanup(srv.Close)
+	port, err := strconv.Atoi(srv.URL[strings.LastIndex(srv.URL, ":")+1:])
+	require.NoError(t, err)
+
+	ch := New(Options{
+		Registry:       registry.New(registry.NewOptions()),
+		ComponentStore: compstore.New(),
+		Meta:           meta.New(meta.Options{Mode: modes.StandaloneMode}),
+		GlobalConfig:   &config.Configuration{},
+		AppMiddleware:  func(next http.Handler) http.Handler { return next },
+		AppConnectionConfig: config.AppConnectionConfig{
+			ChannelAddress: "127.0.0.1",
+			Protocol:       protocol.GRPCProtocol,
+			Endpoints: []config.AppEndpoint{{
+				Port:      port,
+				Protocol:  protocol.HTTPProtocol,
+				Callbacks: []config.AppCallback{config.AppCallbackPubSub, config.AppCallbackBindings},
+			}},
+		},
+	})
+	require.NoError(t, ch.Refresh())
+
+	p, _, ok := ch.RoutedAppEndpoint(config.AppCallbackPubSub)
+	assert.True(t, ok)
+	assert.Equal(t, protocol.HTTPProtocol, p)
+	_, _, ok = ch.RoutedAppEndpoint(config.AppCallbackInvocation)
+	assert.False(t, ok)
+
+	// The app port is not configured, so only the routed callbacks have a channel
+	assert.Nil(t, ch.AppChannelFor(config.AppCallbackInvocation))
+	require.NotNil(t, ch.AppChannelFor(config.AppCallbackBindings))
+	assert.Same(t, ch.AppChannelFor(config.AppCallbackPubSub), ch.AppChannelFor(config.AppCallbackBindings))
+
+	req := invokev1.NewInvokeMethodRequest("foo").
+		WithHTTPExtension(http.MethodGet, "")
+	defer req.Close()
+	res, err := ch.AppChannelFor(config.AppCallbackPubSub).InvokeMethod(t.Context(), req, "")
+	require.NoError(t, err)
+	defer res.Close()
+	body, err := res.RawDataFull()
+	require.NoError(t, err)
+	assert.Equal(t, "hello from /foo", string(body))
+}
+
+func TestAppHTTPClientPool(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	t.Cleanup(srv.Close)
+
+	t.Run("connections are counted until closed", func(t *testing.T) {
+		pool := &poolMetrics{}
+		dial := pool.dial((&net.Dialer{}).DialContext)
+
+		conn, err := dial(t.Context(), "tcp", srv.Listener.Addr().String())
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), pool.open.Load())
+
+		require.NoError(t, conn.Close())
+		conn.Close()
+		assert.Equal(t, int64(0), pool.open.Load())
+	})
+
+	t.Run("idle connections are reused", func(t *testing.T) {
+		client := appHTTPClient(config.AppConnectionConfig{
+			Protocol: protocol.HTTPProtocol,
+			HTTPPool: config.AppHTTPPoolConfig{
+				MaxIdleConns:    1,
+				MaxConnsPerHost: 1,
+				IdleConnTimeout: time.Minute,
+			},
+		}, &config.Configuration{}, 4<<10, nil)
+
+		var reused []bool
+		for range 2 {
+			trace := &httptrace.ClientTrace{
+				GotConn: func(info httptrace.GotConnInfo) {
+					reused = append(reused, info.Reused)
+				},
+			}
+			req, err := http.NewRequestWithContext(httptrace.WithClientTrace(t.Context(), trace), http.MethodGet, srv.URL, nil)
+			require.NoError(t, err)
+			res, err := client.Do(req)
+			require.NoError(t, err)
+			io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+		assert.Equal(t, []bool{false, true}, reused)
+	})
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package channels
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"sync/atomic"
+
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+)
+
+// poolMetrics tracks the utilization of the connection pool of the HTTP app channel.
+type poolMetrics struct {
+	open atomic.Int64
+}
+
+type dialFn func(ctx context.Context, network, addr string) (net.Conn, error)
+
+// dial wraps the dial function to count the connections open to the app.
+func (p *poolMetrics) dial(dial dialFn) dialFn {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		conn, err := dial(ctx, network, addr)
+		if err != nil {
+			return nil, err
+		}
+		diag.DefaultHTTPMonitoring.AppChannelOpenConnections(ctx, p.open.Add(1))
+		return &poolConn{Conn: conn, pool: p}, nil
+	}
+}
+
+// roundTripper wraps the round tripper to record whether the connection used for each request was reused from the pool.
+func (p *poolMetrics) roundTripper(next http.RoundTripper) http.RoundTripper {
+	return roundTripperFn(func(r *http.Request) (*http.Response, error) {
+		ctx := r.Context()
+		trace := &httptrace.ClientTrace{
+			GotConn: func(info httptrace.GotConnInfo) {
+				diag.DefaultHTTPMonitoring.AppChannelConnectionAcquired(ctx, info.Reused)
+			},
+		}
+		return next.RoundTrip(r.WithContext(httptrace.WithClientTrace(ctx, trace)))
+	})
+}
+
+type roundTripperFn func(*http.Request) (*http.Response, error)
+
+func (fn roundTripperFn) RoundTrip(r *http.Request) (*http.Response, error) {
+	return fn(r)
+}
+
+// poolConn is a connection to the app that updates the pool metrics when closed.
+type poolConn struct {
+	net.Conn
+	pool      *poolMetrics
+	closeOnce sync.Once
+}
+
+func (c *poolConn) Close() error {
+	c.closeOnce.Do(func() {
+		diag.DefaultHTTPMonitoring.AppChannelOpenConnections(context.Background(), c.pool.open.Add(-1))
+	})
+	return c.Conn.Close()
+}
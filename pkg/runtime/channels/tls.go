@@ -0,0 +1,211 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package channels
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/dapr/components-contrib/secretstores"
+	"github.com/dapr/dapr/pkg/channel"
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/security"
+)
+
+type AppTLSOptions struct {
+	// AppConnectionConfig is the application connection configuration.
+	AppConnectionConfig config.AppConnectionConfig
+
+	// Security is the security handler, which provides the identity certificate when the source is Sentry.
+	Security security.Handler
+
+	// ComponentStore is the component store, with the secret store when the source is a secret store.
+	ComponentStore *compstore.ComponentStore
+}
+
+// AppTLS provides the TLS configuration used to connect to the app, with the
+// certificates loaded from the configured source.
+// A nil AppTLS connects to the app without verifying its certificate.
+type AppTLS struct {
+	config     config.AppTLSConfig
+	serverName string
+	sec        security.Handler
+	compStore  *compstore.ComponentStore
+
+	lock  sync.RWMutex
+	cert  *tls.Certificate
+	roots *x509.CertPool
+}
+
+// NewAppTLS returns the TLS configuration of the app channel, or nil if no source of certificates is configured.
+func NewAppTLS(opts AppTLSOptions) (*AppTLS, error) {
+	tlsConfig := opts.AppConnectionConfig.TLS
+	if tlsConfig.Source == "" {
+		return nil, nil
+	}
+
+	if tlsConfig.Source == config.AppTLSSourceSentry && (opts.Security == nil || !opts.Security.MTLSEnabled()) {
+		return nil, errors.New("app channel TLS source 'sentry' requires mTLS to be enabled")
+	}
+
+	serverName := tlsConfig.ServerName
+	if serverName == "" {
+		serverName = opts.AppConnectionConfig.ChannelAddress
+		if opts.AppConnectionConfig.UnixDomainSocket != "" {
+			serverName = "localhost"
+		}
+	}
+
+	return &AppTLS{
+		config:     tlsConfig,
+		serverName: serverName,
+		sec:        opts.Security,
+		compStore:  opts.ComponentStore,
+	}, nil
+}
+
+// ClientConfig returns the TLS configuration for the clients connecting to the app.
+// The certificates are read on each handshake, so they can be reloaded without recreating the clients.
+func (a *AppTLS) ClientConfig() *tls.Config {
+	if a == nil {
+		return nil
+	}
+
+	if a.config.Source == config.AppTLSSourceSentry {
+		// The app must present a certificate for the identity of this app
+		return a.sec.MTLSClientConfig(a.sec.ID())
+	}
+
+	return &tls.Config{
+		MinVersion: channel.AppChannelMinTLSVersion,
+		ServerName: a.serverName,
+		// The certificate of the app is verified in VerifyConnection with the CA that is currently loaded
+		InsecureSkipVerify:   true, //nolint:gosec
+		VerifyConnection:     a.verifyConnection,
+		GetClientCertificate: a.getClientCertificate,
+	}
+}
+
+// Load loads the certificates from the configured source.
+// Certificates stored in a secret store can only be loaded after the secret store has been initialized.
+func (a *AppTLS) Load(ctx context.Context) error {
+	if a == nil {
+		return nil
+	}
+
+	var caPEM, certPEM, keyPEM []byte
+	switch a.config.Source {
+	case config.AppTLSSourceFile:
+		var err error
+		if a.config.CAFile != "" {
+			if caPEM, err = os.ReadFile(a.config.CAFile); err != nil {
+				return fmt.Errorf("failed to read app channel CA file: %w", err)
+			}
+		}
+		if a.config.CertFile != "" {
+			if certPEM, err = os.ReadFile(a.config.CertFile); err != nil {
+				return fmt.Errorf("failed to read app channel certificate file: %w", err)
+			}
+			if keyPEM, err = os.ReadFile(a.config.KeyFile); err != nil {
+				return fmt.Errorf("failed to read app channel key file: %w", err)
+			}
+		}
+	case config.AppTLSSourceSecretStore:
+		store, ok := a.compStore.GetSecretStore(a.config.SecretStore)
+		if !ok {
+			return fmt.Errorf("secret store '%s' with the app channel certificates not found", a.config.SecretStore)
+		}
+		res, err := store.GetSecret(ctx, secretstores.GetSecretRequest{Name: a.config.SecretName})
+		if err != nil {
+			return fmt.Errorf("failed to get secret '%s' with the app channel certificates: %w", a.config.SecretName, err)
+		}
+		caPEM = []byte(res.Data[config.AppTLSSecretKeyCA])
+		certPEM = []byte(res.Data[config.AppTLSSecretKeyCert])
+		keyPEM = []byte(res.Data[config.AppTLSSecretKeyKey])
+	default:
+		// The identity certificate is managed by the security handler
+		return nil
+	}
+
+	var roots *x509.CertPool
+	if len(caPEM) > 0 {
+		roots = x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(caPEM) {
+			return errors.New("failed to parse the app channel CA")
+		}
+	}
+
+	var cert *tls.Certificate
+	if len(certPEM) > 0 || len(keyPEM) > 0 {
+		c, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return fmt.Errorf("failed to parse the app channel certificate: %w", err)
+		}
+		cert = &c
+	}
+
+	if roots == nil && cert == nil {
+		return errors.New("no app channel certificates found")
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.roots = roots
+	a.cert = cert
+	return nil
+}
+
+func (a *AppTLS) getClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+	if a.cert == nil {
+		// Do not send a client certificate
+		return &tls.Certificate{}, nil
+	}
+	return a.cert, nil
+}
+
+func (a *AppTLS) verifyConnection(cs tls.ConnectionState) error {
+	a.lock.RLock()
+	roots := a.roots
+	a.lock.RUnlock()
+
+	if roots == nil {
+		// Only the client certificate is configured
+		return nil
+	}
+
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("app did not present a certificate")
+	}
+
+	opts := x509.VerifyOptions{
+		Roots:         roots,
+		DNSName:       a.serverName,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, c := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(c)
+	}
+	if _, err := cs.PeerCertificates[0].Verify(opts); err != nil {
+		return fmt.Errorf("failed to verify the certificate of the app: %w", err)
+	}
+	return nil
+}
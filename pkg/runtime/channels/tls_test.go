@@ -0,0 +1,261 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package channels
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/secretstores"
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/config/protocol"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+type testPKI struct {
+	caPEM   []byte
+	pool    *x509.CertPool
+	ca      *x509.Certificate
+	caKey   *ecdsa.PrivateKey
+	nextSer int64
+}
+
+func newTestPKI(t *testing.T) *testPKI {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	ca, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	pool := x509.NewCertPool()
+	pool.AddCert(ca)
+	return &testPKI{
+		caPEM:   pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pool:    pool,
+		ca:      ca,
+		caKey:   key,
+		nextSer: 2,
+	}
+}
+
+// issue returns the PEM certificate and key of a leaf certificate for the given IP address.
+func (p *testPKI) issue(t *testing.T, ip string, usage x509.ExtKeyUsage) ([]byte, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(p.nextSer),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.ParseIP(ip)},
+	}
+	p.nextSer++
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, p.ca, &key.PublicKey, p.caKey)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+}
+
+type fakeTLSSecretStore struct {
+	daprt.FakeSecretStore
+	data map[string]string
+}
+
+func (s *fakeTLSSecretStore) GetSecret(context.Context, secretstores.GetSecretRequest) (secretstores.GetSecretResponse, error) {
+	return secretstores.GetSecretResponse{Data: s.data}, nil
+}
+
+func TestAppTLS(t *testing.T) {
+	pki := newTestPKI(t)
+	serverCert, serverKey := pki.issue(t, "127.0.0.1", x509.ExtKeyUsageServerAuth)
+	clientCert, clientKey := pki.issue(t, "127.0.0.1", x509.ExtKeyUsageClientAuth)
+
+	// The app requires a client certificate signed by the CA
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	cert, err := tls.X509KeyPair(serverCert, serverKey)
+	require.NoError(t, err)
+	server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    pki.pool,
+		MinVersion:   tls.VersionTLS12,
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	_, portStr, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+	port, err := strconv.Atoi(portStr)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	writeFile := func(name string, data []byte) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, data, 0o600))
+		return path
+	}
+	caFile := writeFile("ca.crt", pki.caPEM)
+	certFile := writeFile("tls.crt", clientCert)
+	keyFile := writeFile("tls.key", clientKey)
+
+	connConfig := config.AppConnectionConfig{
+		ChannelAddress: "127.0.0.1",
+		Port:           port,
+		Protocol:       protocol.HTTPSProtocol,
+		HTTPPool: config.AppHTTPPoolConfig{
+			MaxIdleConns:    1,
+			MaxConnsPerHost: 1,
+		},
+	}
+
+	request := func(t *testing.T, tlsConfig config.AppTLSConfig, compStore *compstore.ComponentStore) error {
+		t.Helper()
+		connConfig := connConfig
+		connConfig.TLS = tlsConfig
+		appTLS, err := NewAppTLS(AppTLSOptions{
+			AppConnectionConfig: connConfig,
+			ComponentStore:      compStore,
+		})
+		require.NoError(t, err)
+		require.NoError(t, appTLS.Load(t.Context()))
+
+		client := appHTTPClient(connConfig, &config.Configuration{}, 4<<10, appTLS)
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, appHTTPEndpoint(connConfig), nil)
+		require.NoError(t, err)
+		res, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer res.Body.Close()
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		return nil
+	}
+
+	t.Run("not configured", func(t *testing.T) {
+		appTLS, err := NewAppTLS(AppTLSOptions{AppConnectionConfig: connConfig})
+		require.NoError(t, err)
+		assert.Nil(t, appTLS)
+		assert.Nil(t, appTLS.ClientConfig())
+		require.NoError(t, appTLS.Load(t.Context()))
+	})
+
+	t.Run("certificates from files", func(t *testing.T) {
+		require.NoError(t, request(t, config.AppTLSConfig{
+			Source:   config.AppTLSSourceFile,
+			CAFile:   caFile,
+			CertFile: certFile,
+			KeyFile:  keyFile,
+		}, nil))
+	})
+
+	t.Run("certificates from a secret store", func(t *testing.T) {
+		compStore := compstore.New()
+		compStore.AddSecretStore("store", &fakeTLSSecretStore{data: map[string]string{
+			config.AppTLSSecretKeyCA:   string(pki.caPEM),
+			config.AppTLSSecretKeyCert: string(clientCert),
+			config.AppTLSSecretKeyKey:  string(clientKey),
+		}})
+		require.NoError(t, request(t, config.AppTLSConfig{
+			Source:      config.AppTLSSourceSecretStore,
+			SecretStore: "store",
+			SecretName:  "tls",
+		}, compStore))
+	})
+
+	t.Run("app rejects missing client certificate", func(t *testing.T) {
+		require.Error(t, request(t, config.AppTLSConfig{
+			Source: config.AppTLSSourceFile,
+			CAFile: caFile,
+		}, nil))
+	})
+
+	t.Run("app certificate signed by another CA", func(t *testing.T) {
+		otherCA := writeFile("other-ca.crt", newTestPKI(t).caPEM)
+		err := request(t, config.AppTLSConfig{
+			Source:   config.AppTLSSourceFile,
+			CAFile:   otherCA,
+			CertFile: certFile,
+			KeyFile:  keyFile,
+		}, nil)
+		require.ErrorContains(t, err, "failed to verify the certificate of the app")
+	})
+
+	t.Run("app certificate for another server name", func(t *testing.T) {
+		err := request(t, config.AppTLSConfig{
+			Source:     config.AppTLSSourceFile,
+			CAFile:     caFile,
+			CertFile:   certFile,
+			KeyFile:    keyFile,
+			ServerName: "other.local",
+		}, nil)
+		require.ErrorContains(t, err, "failed to verify the certificate of the app")
+	})
+
+	t.Run("secret store not found", func(t *testing.T) {
+		appTLS, err := NewAppTLS(AppTLSOptions{
+			AppConnectionConfig: config.AppConnectionConfig{TLS: config.AppTLSConfig{
+				Source:      config.AppTLSSourceSecretStore,
+				SecretStore: "store",
+				SecretName:  "tls",
+			}},
+			ComponentStore: compstore.New(),
+		})
+		require.NoError(t, err)
+		require.Error(t, appTLS.Load(t.Context()))
+	})
+
+	t.Run("sentry requires mTLS", func(t *testing.T) {
+		_, err := NewAppTLS(AppTLSOptions{
+			AppConnectionConfig: config.AppConnectionConfig{TLS: config.AppTLSConfig{
+				Source: config.AppTLSSourceSentry,
+			}},
+		})
+		require.Error(t, err)
+	})
+}
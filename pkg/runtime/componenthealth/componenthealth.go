@@ -0,0 +1,206 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package componenthealth periodically pings the loaded components which
+// support it, and reports their health to the outbound health endpoint and
+// the component health metric. This way readiness reflects a component which
+// broke after its initialization, for example because of revoked broker
+// credentials.
+package componenthealth
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/components-contrib/health"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/healthz"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.runtime.componenthealth")
+
+const (
+	// DefaultInterval is the interval between the pings of the components.
+	DefaultInterval = 30 * time.Second
+	// DefaultTimeout is the timeout of a ping.
+	DefaultTimeout = 5 * time.Second
+
+	operationPing = "ping"
+)
+
+type Options struct {
+	CompStore *compstore.ComponentStore
+	// Healthz is the outbound health, which gets a target for each pinged
+	// component.
+	Healthz healthz.Healthz
+
+	// Interval defaults to DefaultInterval and Timeout to DefaultTimeout.
+	Interval time.Duration
+	Timeout  time.Duration
+
+	Clock clock.WithTicker
+}
+
+// Monitor pings the components.
+type Monitor struct {
+	compStore *compstore.ComponentStore
+	healthz   healthz.Healthz
+	interval  time.Duration
+	timeout   time.Duration
+	clock     clock.WithTicker
+
+	lock    sync.Mutex
+	targets map[string]*target
+}
+
+type target struct {
+	healthz.Target
+	healthy bool
+}
+
+// component is a component which supports pings.
+type component struct {
+	componentType string
+	name          string
+	pinger        health.Pinger
+}
+
+func New(opts Options) *Monitor {
+	m := &Monitor{
+		compStore: opts.CompStore,
+		healthz:   opts.Healthz,
+		interval:  opts.Interval,
+		timeout:   opts.Timeout,
+		clock:     opts.Clock,
+		targets:   make(map[string]*target),
+	}
+	if m.interval <= 0 {
+		m.interval = DefaultInterval
+	}
+	if m.timeout <= 0 {
+		m.timeout = DefaultTimeout
+	}
+	if m.clock == nil {
+		m.clock = clock.RealClock{}
+	}
+	return m
+}
+
+// Run pings the components until the context is done.
+func (m *Monitor) Run(ctx context.Context) error {
+	ticker := m.clock.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C():
+			m.check(ctx)
+		}
+	}
+}
+
+// check pings the loaded components and updates their targets. The targets of
+// the components which were unloaded are marked ready, so they don't hold the
+// outbound health down.
+func (m *Monitor) check(ctx context.Context) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	loaded := make(map[string]struct{})
+	for _, comp := range m.components() {
+		key := comp.componentType + "/" + comp.name
+		loaded[key] = struct{}{}
+
+		t, ok := m.targets[key]
+		if !ok {
+			t = &target{Target: m.healthz.AddTarget(key), healthy: true}
+			m.targets[key] = t
+		}
+
+		err := m.ping(ctx, comp)
+		switch {
+		case err == nil:
+			if !t.healthy {
+				log.Infof("Component %s is healthy again", key)
+			}
+			t.Ready()
+		case ctx.Err() != nil:
+			return
+		default:
+			if t.healthy {
+				log.Warnf("Component %s is unhealthy: %v", key, err)
+			}
+			t.NotReady()
+		}
+		t.healthy = err == nil
+	}
+
+	for key, t := range m.targets {
+		if _, ok := loaded[key]; !ok {
+			t.Ready()
+			delete(m.targets, key)
+		}
+	}
+}
+
+func (m *Monitor) ping(ctx context.Context, comp component) error {
+	ctx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+
+	start := m.clock.Now()
+	err := comp.pinger.Ping(ctx)
+	elapsed := float64(m.clock.Since(start) / time.Millisecond)
+	diag.DefaultComponentMonitoring.ComponentInvoked(ctx, comp.componentType, comp.name, operationPing, err == nil, elapsed)
+	return err
+}
+
+// components returns the loaded components which support pings. A binding
+// which is both an input and an output binding is pinged once.
+func (m *Monitor) components() []component {
+	var comps []component
+	add := func(componentType, name string, c any) {
+		if pinger, ok := c.(health.Pinger); ok {
+			comps = append(comps, component{componentType: componentType, name: name, pinger: pinger})
+		}
+	}
+
+	inputs := m.compStore.ListInputBindings()
+	for name, b := range inputs {
+		add(diag.ComponentTypeBindings, name, b)
+	}
+	for name, b := range m.compStore.ListOutputBindings() {
+		if _, ok := inputs[name]; !ok {
+			add(diag.ComponentTypeBindings, name, b)
+		}
+	}
+	for name, ps := range m.compStore.ListPubSubs() {
+		add(diag.ComponentTypePubsub, name, ps.Component)
+	}
+	for name, s := range m.compStore.ListStateStores() {
+		add(diag.ComponentTypeState, name, s)
+	}
+	for name, s := range m.compStore.ListSecretStores() {
+		add(diag.ComponentTypeSecretStores, name, s)
+	}
+	for name, s := range m.compStore.ListConfigurations() {
+		add(diag.ComponentTypeConfiguration, name, s)
+	}
+	return comps
+}
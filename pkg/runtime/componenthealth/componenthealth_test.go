@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package componenthealth
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/dapr/pkg/healthz"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+// pingBinding is a binding which supports pings.
+type pingBinding struct {
+	daprt.MockBinding
+	err   atomic.Pointer[error]
+	pings atomic.Int32
+}
+
+func (b *pingBinding) Ping(context.Context) error {
+	b.pings.Add(1)
+	if err := b.err.Load(); err != nil {
+		return *err
+	}
+	return nil
+}
+
+func (b *pingBinding) fail(err error) {
+	if err == nil {
+		b.err.Store(nil)
+		return
+	}
+	b.err.Store(&err)
+}
+
+func TestMonitor(t *testing.T) {
+	newMonitor := func() (*Monitor, *compstore.ComponentStore, healthz.Healthz) {
+		compStore := compstore.New()
+		outbound := healthz.New()
+		outbound.AddTarget("app").Ready()
+		m := New(Options{
+			CompStore: compStore,
+			Healthz:   outbound,
+			Clock:     clocktesting.NewFakeClock(time.Now()),
+		})
+		return m, compStore, outbound
+	}
+
+	t.Run("unhealthy binding", func(t *testing.T) {
+		m, compStore, outbound := newMonitor()
+		b := new(pingBinding)
+		compStore.AddInputBinding("kafka", b)
+
+		m.check(t.Context())
+		assert.True(t, outbound.IsReady())
+
+		b.fail(errors.New("invalid credentials"))
+		m.check(t.Context())
+		assert.False(t, outbound.IsReady())
+		assert.Equal(t, []string{"bindings/kafka"}, outbound.GetUnhealthyTargets())
+
+		b.fail(nil)
+		m.check(t.Context())
+		assert.True(t, outbound.IsReady())
+	})
+
+	t.Run("input and output binding pinged once", func(t *testing.T) {
+		m, compStore, _ := newMonitor()
+		b := new(pingBinding)
+		compStore.AddInputBinding("kafka", b)
+		compStore.AddOutputBinding("kafka", b)
+
+		m.check(t.Context())
+		assert.Equal(t, int32(1), b.pings.Load())
+	})
+
+	t.Run("components without pings are ignored", func(t *testing.T) {
+		m, compStore, outbound := newMonitor()
+		compStore.AddInputBinding("cron", new(daprt.MockBinding))
+
+		m.check(t.Context())
+		assert.True(t, outbound.IsReady())
+		assert.Empty(t, m.targets)
+	})
+
+	t.Run("unloaded component doesn't hold the health down", func(t *testing.T) {
+		m, compStore, outbound := newMonitor()
+		b := new(pingBinding)
+		b.fail(errors.New("invalid credentials"))
+		compStore.AddOutputBinding("kafka", b)
+
+		m.check(t.Context())
+		assert.False(t, outbound.IsReady())
+
+		compStore.DeleteOutputBinding("kafka")
+		m.check(t.Context())
+		assert.True(t, outbound.IsReady())
+		assert.Empty(t, m.targets)
+	})
+
+	t.Run("run pings on every interval", func(t *testing.T) {
+		compStore := compstore.New()
+		b := new(pingBinding)
+		compStore.AddInputBinding("kafka", b)
+		clock := clocktesting.NewFakeClock(time.Now())
+		m := New(Options{
+			CompStore: compStore,
+			Healthz:   healthz.New(),
+			Clock:     clock,
+		})
+
+		ctx, cancel := context.WithCancel(t.Context())
+		done := make(chan error)
+		go func() { done <- m.Run(ctx) }()
+
+		assert.Eventually(t, clock.HasWaiters, time.Second, 10*time.Millisecond)
+		clock.Step(DefaultInterval)
+		assert.Eventually(t, func() bool { return b.pings.Load() == 1 }, time.Second, 10*time.Millisecond)
+
+		cancel()
+		select {
+		case err := <-done:
+			assert.NoError(t, err)
+		case <-time.After(time.Second):
+			t.Fatal("monitor didn't stop")
+		}
+	})
+}
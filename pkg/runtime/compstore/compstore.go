@@ -30,7 +30,10 @@ import (
 	compsv1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	httpEndpointV1alpha1 "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
 	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/runtime/datakeycache"
 	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/secretcache"
+	"github.com/dapr/dapr/pkg/runtime/statecache"
 )
 
 // ComponentStore is a store of all components which have been configured for the
@@ -40,10 +43,12 @@ type ComponentStore struct {
 	lock sync.RWMutex
 
 	states                  map[string]state.Store
+	stateCaches             map[string]*statecache.Cache
 	configurations          map[string]configuration.Store
 	configurationSubscribes map[string]chan struct{}
 	secretsConfigurations   map[string]config.SecretsScope
 	secrets                 map[string]secretstores.SecretStore
+	secretCaches            map[string]*secretcache.Cache
 	inputBindings           map[string]bindings.InputBinding
 	inputBindingRoutes      map[string]string
 	outputBindings          map[string]bindings.OutputBinding
@@ -52,6 +57,7 @@ type ComponentStore struct {
 	workflowComponents      map[string]workflows.Workflow
 	workflowBackends        map[string]backend.Backend
 	cryptoProviders         map[string]crypto.SubtleCrypto
+	cryptoDataKeyCaches     map[string]*datakeycache.Cache
 	components              []compsv1alpha1.Component
 	subscriptions           *subscriptions
 	httpEndpoints           []httpEndpointV1alpha1.HTTPEndpoint
@@ -72,10 +78,12 @@ type ComponentStore struct {
 func New() *ComponentStore {
 	return &ComponentStore{
 		states:                  make(map[string]state.Store),
+		stateCaches:             make(map[string]*statecache.Cache),
 		configurations:          make(map[string]configuration.Store),
 		configurationSubscribes: make(map[string]chan struct{}),
 		secretsConfigurations:   make(map[string]config.SecretsScope),
 		secrets:                 make(map[string]secretstores.SecretStore),
+		secretCaches:            make(map[string]*secretcache.Cache),
 		inputBindings:           make(map[string]bindings.InputBinding),
 		inputBindingRoutes:      make(map[string]string),
 		outputBindings:          make(map[string]bindings.OutputBinding),
@@ -84,6 +92,7 @@ func New() *ComponentStore {
 		workflowComponents:      make(map[string]workflows.Workflow),
 		workflowBackends:        make(map[string]backend.Backend),
 		cryptoProviders:         make(map[string]crypto.SubtleCrypto),
+		cryptoDataKeyCaches:     make(map[string]*datakeycache.Cache),
 		subscriptions: &subscriptions{
 			declaratives: make(map[string]*DeclarativeSubscription),
 			streams:      make(map[string][]*DeclarativeSubscription),
@@ -15,6 +15,7 @@ package compstore
 
 import (
 	"github.com/dapr/components-contrib/crypto"
+	"github.com/dapr/dapr/pkg/runtime/datakeycache"
 )
 
 func (c *ComponentStore) AddCryptoProvider(name string, provider crypto.SubtleCrypto) {
@@ -47,3 +48,23 @@ func (c *ComponentStore) CryptoProvidersLen() int {
 	defer c.lock.RUnlock()
 	return len(c.cryptoProviders)
 }
+
+func (c *ComponentStore) AddCryptoDataKeyCache(name string, cache *datakeycache.Cache) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cryptoDataKeyCaches[name] = cache
+}
+
+// GetCryptoDataKeyCache returns the data key cache of the crypto provider, or
+// nil if caching isn't enabled for it.
+func (c *ComponentStore) GetCryptoDataKeyCache(name string) *datakeycache.Cache {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.cryptoDataKeyCaches[name]
+}
+
+func (c *ComponentStore) DeleteCryptoDataKeyCache(name string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.cryptoDataKeyCaches, name)
+}
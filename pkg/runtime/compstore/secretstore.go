@@ -13,7 +13,10 @@ limitations under the License.
 
 package compstore
 
-import "github.com/dapr/components-contrib/secretstores"
+import (
+	"github.com/dapr/components-contrib/secretstores"
+	"github.com/dapr/dapr/pkg/runtime/secretcache"
+)
 
 func (c *ComponentStore) AddSecretStore(name string, store secretstores.SecretStore) {
 	c.lock.Lock()
@@ -45,3 +48,23 @@ func (c *ComponentStore) SecretStoresLen() int {
 	defer c.lock.RUnlock()
 	return len(c.secrets)
 }
+
+func (c *ComponentStore) AddSecretCache(name string, cache *secretcache.Cache) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.secretCaches[name] = cache
+}
+
+// GetSecretCache returns the cache of the secret store, or nil if caching
+// isn't enabled for it.
+func (c *ComponentStore) GetSecretCache(name string) *secretcache.Cache {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.secretCaches[name]
+}
+
+func (c *ComponentStore) DeleteSecretCache(name string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.secretCaches, name)
+}
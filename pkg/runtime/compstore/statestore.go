@@ -15,8 +15,10 @@ package compstore
 
 import (
 	"fmt"
+	"maps"
 
 	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/runtime/statecache"
 )
 
 func (c *ComponentStore) AddStateStore(name string, store state.Store) {
@@ -74,3 +76,29 @@ func (c *ComponentStore) GetStateStoreActor() (state.Store, string, bool) {
 	}
 	return c.actorStateStore.store, c.actorStateStore.name, true
 }
+
+func (c *ComponentStore) AddStateCache(name string, cache *statecache.Cache) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.stateCaches[name] = cache
+}
+
+// GetStateCache returns the cache of the state store, or nil if caching isn't
+// enabled for it.
+func (c *ComponentStore) GetStateCache(name string) *statecache.Cache {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.stateCaches[name]
+}
+
+func (c *ComponentStore) ListStateCaches() map[string]*statecache.Cache {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return maps.Clone(c.stateCaches)
+}
+
+func (c *ComponentStore) DeleteStateCache(name string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.stateCaches, name)
+}
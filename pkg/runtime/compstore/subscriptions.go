@@ -80,17 +80,26 @@ func (c *ComponentStore) AddStreamSubscription(comp *subapi.Subscription, connec
 		c.lock.Unlock()
 	}()
 
+	filter, err := rtpubsub.CreateFilter(comp.Spec.Filter)
+	if err != nil {
+		return err
+	}
+
 	sub := &DeclarativeSubscription{
 		Comp: comp,
 		NamedSubscription: &NamedSubscription{
 			Name:         ptr.Of(comp.Name),
 			ConnectionID: connectionID,
 			Subscription: rtpubsub.Subscription{
-				PubsubName:      comp.Spec.Pubsubname,
-				Topic:           comp.Spec.Topic,
-				DeadLetterTopic: comp.Spec.DeadLetterTopic,
-				Metadata:        comp.Spec.Metadata,
-				Rules:           []*rtpubsub.Rule{{Path: "/"}},
+				PubsubName:            comp.Spec.Pubsubname,
+				Topic:                 comp.Spec.Topic,
+				DeadLetterTopic:       comp.Spec.DeadLetterTopic,
+				Metadata:              comp.Spec.Metadata,
+				Rules:                 []*rtpubsub.Rule{{Path: "/"}},
+				Ordering:              rtpubsub.Ordering(comp.Spec.Ordering),
+				MaxConcurrentHandlers: comp.Spec.MaxConcurrentHandlers,
+				Prefetch:              comp.Spec.Prefetch,
+				Filter:                filter,
 			},
 		},
 	}
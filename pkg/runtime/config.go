@@ -65,6 +65,9 @@ const (
 	// DefaultReadBufferSize is the default option for the maximum header size in bytes for Dapr HTTP servers.
 	// Equal to 4KB
 	DefaultReadBufferSize = 4 << 10
+	// DefaultMaxReplayBufferSize is the default option for the maximum size in bytes of the request bodies buffered to retry service invocation requests.
+	// Equal to 4MB
+	DefaultMaxReplayBufferSize = 4 << 20
 	// DefaultGracefulShutdownDuration is the default option for the duration of the graceful shutdown.
 	DefaultGracefulShutdownDuration = time.Second * 5
 	// DefaultAppHealthCheckPath is the default path for HTTP health checks.
@@ -83,7 +86,10 @@ type Config struct {
 	AppMaxConcurrency             int
 	EnableMTLS                    bool
 	AppSSL                        bool
-	MaxRequestSize                int // In bytes
+	MaxRequestSize                int            // In bytes
+	MaxRequestSizePerAPI          map[string]int // In bytes, keyed by API group
+	DaprHTTPTLSCertFile           string
+	DaprHTTPTLSKeyFile            string
 	ResourcesPath                 []string
 	ComponentsPath                string
 	AppProtocol                   string
@@ -98,6 +104,7 @@ type Config struct {
 	ApplicationPort               string
 	DaprGracefulShutdownSeconds   int
 	DaprBlockShutdownDuration     *time.Duration
+	DaprDrainTimeout              time.Duration
 	ActorsService                 string
 	RemindersService              string
 	SchedulerAddress              []string
@@ -111,9 +118,23 @@ type Config struct {
 	Config                        []string
 	UnixDomainSocket              string
 	ReadBufferSize                int // In bytes
+	MaxReplayBufferSize           int // In bytes
 	DisableBuiltinK8sSecretStore  bool
 	AppHealthCheckPath            string
 	AppChannelAddress             string
+	AppUnixDomainSocket           string
+	AppEndpoints                  []string
+	AppChannelMaxIdleConns        int
+	AppChannelMaxConnsPerHost     int
+	AppChannelIdleConnTimeout     time.Duration
+	AppChannelEnableHTTP2         bool
+	AppChannelTLSSource           string
+	AppChannelTLSCAFile           string
+	AppChannelTLSCertFile         string
+	AppChannelTLSKeyFile          string
+	AppChannelTLSSecretStore      string
+	AppChannelTLSSecretName       string
+	AppChannelTLSServerName       string
 	Metrics                       metrics.Options
 	Registry                      *registry.Options
 	Security                      security.Handler
@@ -144,10 +165,15 @@ type internalConfig struct {
 	mTLSEnabled                  bool
 	sentryServiceAddress         string
 	unixDomainSocket             string
-	maxRequestBodySize           int // In bytes
+	maxRequestBodySize           int            // In bytes
+	maxRequestBodySizePerAPI     map[string]int // In bytes, keyed by API group
+	httpTLSCertFile              string
+	httpTLSKeyFile               string
 	readBufferSize               int // In bytes
+	maxReplayBufferSize          int // In bytes
 	gracefulShutdownDuration     time.Duration
 	blockShutdownDuration        *time.Duration
+	drainTimeout                 time.Duration
 	enableAPILogging             *bool
 	disableBuiltinK8sSecretStore bool
 	config                       []string
@@ -319,16 +345,37 @@ func (c *Config) toInternal() (*internalConfig, error) {
 		disableBuiltinK8sSecretStore: c.DisableBuiltinK8sSecretStore,
 		unixDomainSocket:             c.UnixDomainSocket,
 		maxRequestBodySize:           c.MaxRequestSize,
+		maxRequestBodySizePerAPI:     c.MaxRequestSizePerAPI,
+		httpTLSCertFile:              c.DaprHTTPTLSCertFile,
+		httpTLSKeyFile:               c.DaprHTTPTLSKeyFile,
 		readBufferSize:               c.ReadBufferSize,
+		maxReplayBufferSize:          c.MaxReplayBufferSize,
 		enableAPILogging:             c.EnableAPILogging,
 		appConnectionConfig: config.AppConnectionConfig{
 			ChannelAddress:      c.AppChannelAddress,
 			HealthCheckHTTPPath: c.AppHealthCheckPath,
 			MaxConcurrency:      c.AppMaxConcurrency,
+			UnixDomainSocket:    c.AppUnixDomainSocket,
+			HTTPPool: config.AppHTTPPoolConfig{
+				MaxIdleConns:    c.AppChannelMaxIdleConns,
+				MaxConnsPerHost: c.AppChannelMaxConnsPerHost,
+				IdleConnTimeout: c.AppChannelIdleConnTimeout,
+				EnableHTTP2:     c.AppChannelEnableHTTP2,
+			},
+			TLS: config.AppTLSConfig{
+				Source:      config.AppTLSSource(strings.ToLower(c.AppChannelTLSSource)),
+				CAFile:      c.AppChannelTLSCAFile,
+				CertFile:    c.AppChannelTLSCertFile,
+				KeyFile:     c.AppChannelTLSKeyFile,
+				SecretStore: c.AppChannelTLSSecretStore,
+				SecretName:  c.AppChannelTLSSecretName,
+				ServerName:  c.AppChannelTLSServerName,
+			},
 		},
 		registry:                  registry.New(c.Registry),
 		metricsExporter:           metrics.New(c.Metrics),
 		blockShutdownDuration:     c.DaprBlockShutdownDuration,
+		drainTimeout:              c.DaprDrainTimeout,
 		actorsService:             c.ActorsService,
 		remindersService:          c.RemindersService,
 		schedulerAddress:          c.SchedulerAddress,
@@ -406,6 +453,24 @@ func (c *Config) toInternal() (*internalConfig, error) {
 		return nil, fmt.Errorf("the 'dapr-grpc-port' argument value %d conflicts with 'app-port'", intc.apiGRPCPort)
 	}
 
+	routed := make(map[config.AppCallback]struct{})
+	for _, val := range c.AppEndpoints {
+		e, err := config.ParseAppEndpoint(val)
+		if err != nil {
+			return nil, err
+		}
+		if e.Port == intc.appConnectionConfig.Port || e.Port == intc.httpPort || e.Port == intc.apiGRPCPort {
+			return nil, fmt.Errorf("the 'app-endpoint' port %d conflicts with another port", e.Port)
+		}
+		for _, cb := range e.Callbacks {
+			if _, ok := routed[cb]; ok {
+				return nil, fmt.Errorf("the '%s' callback is routed to more than one 'app-endpoint'", cb)
+			}
+			routed[cb] = struct{}{}
+		}
+		intc.appConnectionConfig.Endpoints = append(intc.appConnectionConfig.Endpoints, e)
+	}
+
 	if intc.maxRequestBodySize == -1 {
 		intc.maxRequestBodySize = DefaultMaxRequestBodySize
 	}
@@ -420,6 +485,16 @@ func (c *Config) toInternal() (*internalConfig, error) {
 		intc.gracefulShutdownDuration = time.Duration(c.DaprGracefulShutdownSeconds) * time.Second
 	}
 
+	if intc.appConnectionConfig.HTTPPool.MaxIdleConns <= 0 {
+		intc.appConnectionConfig.HTTPPool.MaxIdleConns = config.AppHTTPPoolDefaultMaxIdleConns
+	}
+	if intc.appConnectionConfig.HTTPPool.MaxConnsPerHost <= 0 {
+		intc.appConnectionConfig.HTTPPool.MaxConnsPerHost = config.AppHTTPPoolDefaultMaxConnsPerHost
+	}
+	if intc.appConnectionConfig.HTTPPool.IdleConnTimeout <= 0 {
+		intc.appConnectionConfig.HTTPPool.IdleConnTimeout = config.AppHTTPPoolDefaultIdleConnTimeout
+	}
+
 	if intc.appConnectionConfig.MaxConcurrency == -1 {
 		intc.appConnectionConfig.MaxConcurrency = 0
 	}
@@ -455,6 +530,10 @@ func (c *Config) toInternal() (*internalConfig, error) {
 		return nil, fmt.Errorf("invalid value for 'app-protocol': %v", c.AppProtocol)
 	}
 
+	if err := intc.appConnectionConfig.TLS.Validate(intc.appConnectionConfig.Protocol); err != nil {
+		return nil, err
+	}
+
 	intc.apiListenAddresses = strings.Split(c.DaprAPIListenAddresses, ",")
 	if len(intc.apiListenAddresses) == 0 {
 		intc.apiListenAddresses = []string{DefaultAPIListenAddress}
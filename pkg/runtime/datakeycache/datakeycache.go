@@ -0,0 +1,299 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datakeycache
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"golang.org/x/sync/singleflight"
+	"k8s.io/utils/clock"
+)
+
+const (
+	propertyTTL        = "datakeycachettl"
+	propertyMaxEntries = "datakeycachemaxentries"
+	propertyMaxUses    = "datakeycachemaxuses"
+
+	defaultMaxEntries = 1000
+)
+
+type Options struct {
+	ProviderName string
+	Metadata     map[string]string
+	Clock        clock.Clock
+}
+
+// DataKey is a data encryption key, in plaintext and wrapped with a key
+// encryption key of the crypto provider.
+type DataKey struct {
+	KeyName      string
+	Algorithm    string
+	PlaintextKey []byte
+	WrappedKey   []byte
+	Tag          []byte
+}
+
+// UnwrapRequest identifies a wrapped key.
+type UnwrapRequest struct {
+	KeyName    string
+	Algorithm  string
+	WrappedKey []byte
+	Nonce      []byte
+	Tag        []byte
+}
+
+// CreateFn creates a data key, wrapping it with the crypto provider.
+type CreateFn func(ctx context.Context) (DataKey, error)
+
+// UnwrapFn unwraps a key with the crypto provider.
+type UnwrapFn func(ctx context.Context) ([]byte, error)
+
+// Cache is an in-memory cache of the data keys of a crypto provider, which
+// saves round trips to the provider on hot paths.
+// Data keys are reused for the TTL of the cache, or until they have been
+// handed out for the max uses, and the keys unwrapped by the provider are
+// cached for the TTL too. Keys are cached by key name, so evicting the keys of
+// a key name after its key encryption key has been rotated makes the new data
+// keys be wrapped with the rotated key.
+// All methods can be called on a nil Cache, which caches nothing.
+type Cache struct {
+	providerName string
+	ttl          time.Duration
+	maxUses      int
+	clock        clock.Clock
+	dataKeys     *lru.Cache[string, *dataKeyEntry]
+	unwrapped    *lru.Cache[string, *unwrappedEntry]
+	group        singleflight.Group
+
+	// lock guards the uses of the data keys.
+	lock sync.Mutex
+}
+
+type dataKeyEntry struct {
+	key       DataKey
+	expiresAt time.Time
+	uses      int
+}
+
+type unwrappedEntry struct {
+	keyName   string
+	key       []byte
+	expiresAt time.Time
+}
+
+// New returns the cache configured in the metadata of a crypto provider, or
+// nil if caching isn't enabled for the provider.
+func New(opts Options) (*Cache, error) {
+	var ttl time.Duration
+	var maxUses int
+	maxEntries := defaultMaxEntries
+	for k, v := range opts.Metadata {
+		var err error
+		switch strings.ToLower(k) {
+		case propertyTTL:
+			ttl, err = time.ParseDuration(v)
+			if err == nil && ttl <= 0 {
+				err = errors.New("must be positive")
+			}
+		case propertyMaxEntries:
+			maxEntries, err = parsePositiveInt(v)
+		case propertyMaxUses:
+			maxUses, err = parsePositiveInt(v)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid data key cache metadata %s: %w", k, err)
+		}
+	}
+
+	if ttl == 0 {
+		return nil, nil
+	}
+
+	dataKeys, err := lru.New[string, *dataKeyEntry](maxEntries)
+	if err != nil {
+		return nil, err
+	}
+	unwrapped, err := lru.New[string, *unwrappedEntry](maxEntries)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Cache{
+		providerName: opts.ProviderName,
+		ttl:          ttl,
+		maxUses:      maxUses,
+		clock:        opts.Clock,
+		dataKeys:     dataKeys,
+		unwrapped:    unwrapped,
+	}
+	if c.clock == nil {
+		c.clock = clock.RealClock{}
+	}
+	return c, nil
+}
+
+// DataKey returns the cached data key of the key name and algorithm, or
+// creates it with create and caches it. Concurrent requests for the same key
+// wait for a single creation.
+func (c *Cache) DataKey(ctx context.Context, keyName, algorithm string, create CreateFn) (DataKey, error) {
+	if c == nil {
+		return create(ctx)
+	}
+
+	cacheKey := algorithm + "\x00" + keyName
+	for {
+		if key, ok := c.useDataKey(cacheKey); ok {
+			return key, nil
+		}
+
+		_, err, _ := c.group.Do(cacheKey, func() (any, error) {
+			// Another request may have created the key in the meantime.
+			if c.hasDataKey(cacheKey) {
+				return nil, nil
+			}
+
+			key, err := create(ctx)
+			if err != nil {
+				return nil, err
+			}
+			now := c.clock.Now()
+			c.dataKeys.Add(cacheKey, &dataKeyEntry{key: key, expiresAt: now.Add(c.ttl)})
+			// Decryptions of the data encrypted with the key don't need the provider.
+			c.unwrapped.Add(unwrapCacheKey(UnwrapRequest{
+				KeyName:    key.KeyName,
+				Algorithm:  key.Algorithm,
+				WrappedKey: key.WrappedKey,
+				Tag:        key.Tag,
+			}), &unwrappedEntry{keyName: key.KeyName, key: bytes.Clone(key.PlaintextKey), expiresAt: now.Add(c.ttl)})
+			return nil, nil
+		})
+		if err != nil {
+			return DataKey{}, err
+		}
+	}
+}
+
+// Unwrap returns the cached plaintext of the wrapped key, or unwraps it with
+// unwrap and caches it.
+func (c *Cache) Unwrap(ctx context.Context, req UnwrapRequest, unwrap UnwrapFn) ([]byte, error) {
+	if c == nil {
+		return unwrap(ctx)
+	}
+
+	cacheKey := unwrapCacheKey(req)
+	if e, ok := c.unwrapped.Get(cacheKey); ok && c.clock.Now().Before(e.expiresAt) {
+		return bytes.Clone(e.key), nil
+	}
+
+	key, err := unwrap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.unwrapped.Add(cacheKey, &unwrappedEntry{keyName: req.KeyName, key: bytes.Clone(key), expiresAt: c.clock.Now().Add(c.ttl)})
+	return key, nil
+}
+
+// Flush removes the keys of the key names from the cache, or all the keys if
+// no key name is given.
+func (c *Cache) Flush(keyNames ...string) {
+	if c == nil {
+		return
+	}
+
+	if len(keyNames) == 0 {
+		c.dataKeys.Purge()
+		c.unwrapped.Purge()
+		return
+	}
+
+	names := make(map[string]struct{}, len(keyNames))
+	for _, name := range keyNames {
+		names[name] = struct{}{}
+	}
+	for _, k := range c.dataKeys.Keys() {
+		if e, ok := c.dataKeys.Peek(k); ok {
+			if _, flush := names[e.key.KeyName]; flush {
+				c.dataKeys.Remove(k)
+			}
+		}
+	}
+	for _, k := range c.unwrapped.Keys() {
+		if e, ok := c.unwrapped.Peek(k); ok {
+			if _, flush := names[e.keyName]; flush {
+				c.unwrapped.Remove(k)
+			}
+		}
+	}
+}
+
+// useDataKey returns a copy of the cached data key, counting its use.
+func (c *Cache) useDataKey(cacheKey string) (DataKey, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	e, ok := c.dataKeys.Get(cacheKey)
+	if !ok || !c.valid(e) {
+		return DataKey{}, false
+	}
+	e.uses++
+	if c.maxUses > 0 && e.uses >= c.maxUses {
+		c.dataKeys.Remove(cacheKey)
+	}
+
+	return DataKey{
+		KeyName:      e.key.KeyName,
+		Algorithm:    e.key.Algorithm,
+		PlaintextKey: bytes.Clone(e.key.PlaintextKey),
+		WrappedKey:   bytes.Clone(e.key.WrappedKey),
+		Tag:          bytes.Clone(e.key.Tag),
+	}, true
+}
+
+func (c *Cache) hasDataKey(cacheKey string) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	e, ok := c.dataKeys.Peek(cacheKey)
+	return ok && c.valid(e)
+}
+
+// valid must be called with the lock held.
+func (c *Cache) valid(e *dataKeyEntry) bool {
+	return c.clock.Now().Before(e.expiresAt) && (c.maxUses == 0 || e.uses < c.maxUses)
+}
+
+func unwrapCacheKey(req UnwrapRequest) string {
+	h := sha256.New()
+	for _, v := range [][]byte{[]byte(req.KeyName), []byte(req.Algorithm), req.WrappedKey, req.Nonce, req.Tag} {
+		h.Write([]byte(strconv.Itoa(len(v)) + ":"))
+		h.Write(v)
+	}
+	return string(h.Sum(nil))
+}
+
+func parsePositiveInt(v string) (int, error) {
+	n, err := strconv.Atoi(v)
+	if err == nil && n <= 0 {
+		err = errors.New("must be positive")
+	}
+	return n, err
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datakeycache
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+// countingProvider creates data keys and unwraps keys, counting the calls.
+type countingProvider struct {
+	creates atomic.Int32
+	unwraps atomic.Int32
+	err     error
+}
+
+func (p *countingProvider) create(keyName string) CreateFn {
+	return func(context.Context) (DataKey, error) {
+		n := p.creates.Add(1)
+		if p.err != nil {
+			return DataKey{}, p.err
+		}
+		return DataKey{
+			KeyName:      keyName,
+			Algorithm:    "A256KW",
+			PlaintextKey: []byte("key-" + strconv.Itoa(int(n))),
+			WrappedKey:   []byte("wrapped-" + strconv.Itoa(int(n))),
+		}, nil
+	}
+}
+
+func (p *countingProvider) unwrap(context.Context) ([]byte, error) {
+	p.unwraps.Add(1)
+	if p.err != nil {
+		return nil, p.err
+	}
+	return []byte("plaintext"), nil
+}
+
+func TestNew(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		c, err := New(Options{Metadata: map[string]string{"dataKeyCacheMaxUses": "10"}})
+		require.NoError(t, err)
+		assert.Nil(t, c)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		c, err := New(Options{Metadata: map[string]string{
+			"dataKeyCacheTTL":        "5m",
+			"dataKeyCacheMaxEntries": "10",
+			"dataKeyCacheMaxUses":    "100",
+		}})
+		require.NoError(t, err)
+		require.NotNil(t, c)
+		assert.Equal(t, 5*time.Minute, c.ttl)
+		assert.Equal(t, 100, c.maxUses)
+	})
+
+	for name, md := range map[string]map[string]string{
+		"invalid ttl":         {"dataKeyCacheTTL": "soon"},
+		"negative ttl":        {"dataKeyCacheTTL": "-1s"},
+		"invalid max entries": {"dataKeyCacheTTL": "1m", "dataKeyCacheMaxEntries": "0"},
+		"invalid max uses":    {"dataKeyCacheTTL": "1m", "dataKeyCacheMaxUses": "many"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := New(Options{Metadata: md})
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestDataKey(t *testing.T) {
+	newCache := func(t *testing.T, md map[string]string) (*Cache, *clocktesting.FakeClock) {
+		t.Helper()
+		clock := clocktesting.NewFakeClock(time.Now())
+		md["dataKeyCacheTTL"] = "1m"
+		c, err := New(Options{Metadata: md, Clock: clock})
+		require.NoError(t, err)
+		return c, clock
+	}
+
+	t.Run("reused until expired", func(t *testing.T) {
+		c, clock := newCache(t, map[string]string{})
+		p := &countingProvider{}
+
+		key1, err := c.DataKey(t.Context(), "mykey", "A256KW", p.create("mykey"))
+		require.NoError(t, err)
+		key2, err := c.DataKey(t.Context(), "mykey", "A256KW", p.create("mykey"))
+		require.NoError(t, err)
+		assert.Equal(t, key1, key2)
+		assert.Equal(t, int32(1), p.creates.Load())
+
+		// Other key names and algorithms have their own data keys.
+		_, err = c.DataKey(t.Context(), "otherkey", "A256KW", p.create("otherkey"))
+		require.NoError(t, err)
+		_, err = c.DataKey(t.Context(), "mykey", "RSA-OAEP", p.create("mykey"))
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), p.creates.Load())
+
+		clock.Step(time.Minute)
+		key3, err := c.DataKey(t.Context(), "mykey", "A256KW", p.create("mykey"))
+		require.NoError(t, err)
+		assert.NotEqual(t, key1.PlaintextKey, key3.PlaintextKey)
+		assert.Equal(t, int32(4), p.creates.Load())
+	})
+
+	t.Run("max uses", func(t *testing.T) {
+		c, _ := newCache(t, map[string]string{"dataKeyCacheMaxUses": "2"})
+		p := &countingProvider{}
+
+		for range 5 {
+			_, err := c.DataKey(t.Context(), "mykey", "A256KW", p.create("mykey"))
+			require.NoError(t, err)
+		}
+		assert.Equal(t, int32(3), p.creates.Load())
+	})
+
+	t.Run("returned keys are copies", func(t *testing.T) {
+		c, _ := newCache(t, map[string]string{})
+		p := &countingProvider{}
+
+		key, err := c.DataKey(t.Context(), "mykey", "A256KW", p.create("mykey"))
+		require.NoError(t, err)
+		key.PlaintextKey[0] = 'X'
+		key, err = c.DataKey(t.Context(), "mykey", "A256KW", p.create("mykey"))
+		require.NoError(t, err)
+		assert.Equal(t, "key-1", string(key.PlaintextKey))
+	})
+
+	t.Run("errors aren't cached", func(t *testing.T) {
+		c, _ := newCache(t, map[string]string{})
+		p := &countingProvider{err: errors.New("kms down")}
+
+		_, err := c.DataKey(t.Context(), "mykey", "A256KW", p.create("mykey"))
+		require.Error(t, err)
+		_, err = c.DataKey(t.Context(), "mykey", "A256KW", p.create("mykey"))
+		require.Error(t, err)
+		assert.Equal(t, int32(2), p.creates.Load())
+	})
+
+	t.Run("concurrent requests create a single key", func(t *testing.T) {
+		c, _ := newCache(t, map[string]string{})
+		p := &countingProvider{}
+
+		var wg sync.WaitGroup
+		for range 20 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := c.DataKey(t.Context(), "mykey", "A256KW", p.create("mykey"))
+				assert.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, int32(1), p.creates.Load())
+	})
+
+	t.Run("created keys are unwrapped from the cache", func(t *testing.T) {
+		c, _ := newCache(t, map[string]string{})
+		p := &countingProvider{}
+
+		key, err := c.DataKey(t.Context(), "mykey", "A256KW", p.create("mykey"))
+		require.NoError(t, err)
+		plaintext, err := c.Unwrap(t.Context(), UnwrapRequest{KeyName: "mykey", Algorithm: "A256KW", WrappedKey: key.WrappedKey}, p.unwrap)
+		require.NoError(t, err)
+		assert.Equal(t, key.PlaintextKey, plaintext)
+		assert.Zero(t, p.unwraps.Load())
+	})
+
+	t.Run("nil cache", func(t *testing.T) {
+		var c *Cache
+		p := &countingProvider{}
+		for range 2 {
+			_, err := c.DataKey(t.Context(), "mykey", "A256KW", p.create("mykey"))
+			require.NoError(t, err)
+		}
+		assert.Equal(t, int32(2), p.creates.Load())
+	})
+}
+
+func TestUnwrap(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Now())
+	c, err := New(Options{Metadata: map[string]string{"dataKeyCacheTTL": "1m"}, Clock: clock})
+	require.NoError(t, err)
+	p := &countingProvider{}
+	req := UnwrapRequest{KeyName: "mykey", Algorithm: "A256KW", WrappedKey: []byte("wrapped")}
+
+	for range 3 {
+		plaintext, err := c.Unwrap(t.Context(), req, p.unwrap)
+		require.NoError(t, err)
+		assert.Equal(t, "plaintext", string(plaintext))
+	}
+	assert.Equal(t, int32(1), p.unwraps.Load())
+
+	// Keys are cached by all the fields of the request.
+	_, err = c.Unwrap(t.Context(), UnwrapRequest{KeyName: "mykey", Algorithm: "A256KW", WrappedKey: []byte("wrapped"), Tag: []byte("tag")}, p.unwrap)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), p.unwraps.Load())
+
+	clock.Step(time.Minute)
+	_, err = c.Unwrap(t.Context(), req, p.unwrap)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), p.unwraps.Load())
+
+	p.err = errors.New("kms down")
+	c.Flush()
+	_, err = c.Unwrap(t.Context(), req, p.unwrap)
+	require.Error(t, err)
+	_, err = c.Unwrap(t.Context(), req, p.unwrap)
+	require.Error(t, err)
+	assert.Equal(t, int32(5), p.unwraps.Load())
+}
+
+func TestFlush(t *testing.T) {
+	c, err := New(Options{Metadata: map[string]string{"dataKeyCacheTTL": "1m"}})
+	require.NoError(t, err)
+	p := &countingProvider{}
+
+	for _, name := range []string{"key1", "key2"} {
+		_, err = c.DataKey(t.Context(), name, "A256KW", p.create(name))
+		require.NoError(t, err)
+		_, err = c.Unwrap(t.Context(), UnwrapRequest{KeyName: name, WrappedKey: []byte("wrapped")}, p.unwrap)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 2, c.dataKeys.Len())
+	require.Equal(t, 4, c.unwrapped.Len())
+
+	c.Flush("key1")
+	assert.Equal(t, 1, c.dataKeys.Len())
+	assert.Equal(t, 2, c.unwrapped.Len())
+	_, ok := c.dataKeys.Peek("A256KW\x00key2")
+	assert.True(t, ok)
+
+	c.Flush()
+	assert.Zero(t, c.dataKeys.Len())
+	assert.Zero(t, c.unwrapped.Len())
+
+	// Flushing a nil cache is a no-op.
+	var nilCache *Cache
+	nilCache.Flush("key1")
+}
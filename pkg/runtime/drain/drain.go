@@ -0,0 +1,292 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package drain implements the drain mode of the sidecar, which stops
+// accepting new work and waits for the work in progress to complete before
+// the sidecar shuts down.
+package drain
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.runtime.drain")
+
+// State is the state of the drain.
+type State string
+
+const (
+	StateRunning  State = "RUNNING"
+	StateDraining State = "DRAINING"
+	StateDrained  State = "DRAINED"
+)
+
+// StepState is the state of a drain step.
+type StepState string
+
+const (
+	StepStatePending   StepState = "PENDING"
+	StepStateRunning   StepState = "RUNNING"
+	StepStateCompleted StepState = "COMPLETED"
+	StepStateFailed    StepState = "FAILED"
+	// StepStateTimedOut means the deadline of the drain expired before the step completed.
+	StepStateTimedOut StepState = "TIMED_OUT"
+)
+
+// StepRequests is the name of the step that waits for the in-flight requests.
+const StepRequests = "requests"
+
+// DefaultTimeout is the deadline of the drain when no timeout is configured.
+const DefaultTimeout = 30 * time.Second
+
+// Step is a step of the drain.
+// Steps run in order, and each step must wait until its work has completed.
+type Step struct {
+	Name string
+	Fn   func(ctx context.Context) error
+}
+
+type Options struct {
+	// Steps run before and after waiting for the in-flight requests.
+	// Steps that stop accepting new work go before, and steps that clean up go after.
+	Before []Step
+	After  []Step
+
+	// Timeout is the deadline of the drain when none is given to Drain.
+	// Defaults to DefaultTimeout.
+	Timeout time.Duration
+
+	Clock clock.Clock
+}
+
+// Status reports the progress of the drain.
+type Status struct {
+	State     State        `json:"state"`
+	StartedAt *time.Time   `json:"startedAt,omitempty"`
+	Deadline  *time.Time   `json:"deadline,omitempty"`
+	InFlight  int64        `json:"inFlight"`
+	Steps     []StepStatus `json:"steps,omitempty"`
+}
+
+// StepStatus is the status of a drain step.
+type StepStatus struct {
+	Name  string    `json:"name"`
+	State StepState `json:"state"`
+	Error string    `json:"error,omitempty"`
+}
+
+// Drainer tracks the in-flight requests and drains the sidecar.
+// A nil Drainer accepts all requests and never drains.
+type Drainer struct {
+	steps   []Step
+	timeout time.Duration
+	clock   clock.Clock
+
+	lock      sync.RWMutex
+	state     State
+	startedAt time.Time
+	deadline  time.Time
+	status    []StepStatus
+	doneCh    chan struct{}
+
+	inflight Tracker
+}
+
+func New(opts Options) *Drainer {
+	cl := opts.Clock
+	if cl == nil {
+		cl = clock.RealClock{}
+	}
+
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	d := &Drainer{
+		timeout: timeout,
+		clock:   cl,
+		state:   StateRunning,
+		doneCh:  make(chan struct{}),
+	}
+
+	// The in-flight requests are waited for after the steps that stop accepting new work
+	d.steps = make([]Step, 0, len(opts.Before)+len(opts.After)+1)
+	d.steps = append(d.steps, opts.Before...)
+	d.steps = append(d.steps, Step{Name: StepRequests, Fn: d.waitInFlight})
+	d.steps = append(d.steps, opts.After...)
+
+	return d
+}
+
+// Track registers a new request, and returns the function to call when the request completes.
+// It returns false if the sidecar is draining, in which case the request must be rejected.
+func (d *Drainer) Track() (func(), bool) {
+	if d == nil {
+		return func() {}, true
+	}
+
+	// Requests are added with the lock held, so none is added once the drain has started
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	if d.state != StateRunning {
+		return nil, false
+	}
+
+	return d.inflight.Add()
+}
+
+// TrackAlways registers a new request which is accepted even while the sidecar
+// is draining, such as the calls of the app to the Dapr APIs, which the app may
+// need to complete its work in progress. It returns the function to call when
+// the request completes.
+func (d *Drainer) TrackAlways() func() {
+	if d == nil {
+		return func() {}
+	}
+	untrack, _ := d.inflight.Add()
+	return untrack
+}
+
+// Draining returns true if the drain has started.
+func (d *Drainer) Draining() bool {
+	if d == nil {
+		return false
+	}
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	return d.state != StateRunning
+}
+
+// Done returns a channel that is closed when the drain has completed.
+// The channel of a nil Drainer is never closed.
+func (d *Drainer) Done() <-chan struct{} {
+	if d == nil {
+		return nil
+	}
+	return d.doneCh
+}
+
+// Drain stops accepting new requests and runs the drain steps in the
+// background, until they complete or the timeout expires.
+// If timeout is not positive, the timeout of the options is used.
+// It returns false if the drain had already started.
+func (d *Drainer) Drain(timeout time.Duration) bool {
+	if d == nil {
+		return false
+	}
+	if timeout <= 0 {
+		timeout = d.timeout
+	}
+
+	d.lock.Lock()
+	if d.state != StateRunning {
+		d.lock.Unlock()
+		return false
+	}
+	d.state = StateDraining
+	d.startedAt = d.clock.Now()
+	d.deadline = d.startedAt.Add(timeout)
+	d.status = make([]StepStatus, len(d.steps))
+	for i, step := range d.steps {
+		d.status[i] = StepStatus{Name: step.Name, State: StepStatePending}
+	}
+	d.lock.Unlock()
+
+	log.Infof("Draining Dapr with a timeout of %s", timeout)
+
+	go d.run(timeout)
+	return true
+}
+
+func (d *Drainer) run(timeout time.Duration) {
+	defer close(d.doneCh)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-d.clock.After(timeout):
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	for i, step := range d.steps {
+		d.setStep(i, StepStateRunning, nil)
+		err := step.Fn(ctx)
+		switch {
+		case ctx.Err() != nil:
+			log.Warnf("Drain timed out while running step %s", step.Name)
+			d.setStep(i, StepStateTimedOut, nil)
+		case err != nil:
+			log.Errorf("Drain step %s failed: %v", step.Name, err)
+			d.setStep(i, StepStateFailed, err)
+		default:
+			d.setStep(i, StepStateCompleted, nil)
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	d.lock.Lock()
+	d.state = StateDrained
+	d.lock.Unlock()
+	log.Infof("Dapr drained in %s", d.clock.Since(d.startedAt))
+}
+
+func (d *Drainer) setStep(i int, state StepState, err error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.status[i].State = state
+	if err != nil {
+		d.status[i].Error = err.Error()
+	}
+}
+
+func (d *Drainer) waitInFlight(ctx context.Context) error {
+	if d.inflight.Wait(ctx) != nil {
+		return errors.New("timed out waiting for in-flight requests")
+	}
+	return nil
+}
+
+// Status returns the progress of the drain.
+func (d *Drainer) Status() Status {
+	if d == nil {
+		return Status{State: StateRunning}
+	}
+
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	s := Status{
+		State:    d.state,
+		InFlight: d.inflight.Len(),
+	}
+	if d.state != StateRunning {
+		startedAt, deadline := d.startedAt, d.deadline
+		s.StartedAt = &startedAt
+		s.Deadline = &deadline
+		s.Steps = make([]StepStatus, len(d.status))
+		copy(s.Steps, d.status)
+	}
+	return s
+}
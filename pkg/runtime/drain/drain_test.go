@@ -0,0 +1,178 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drain
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func stepStates(s Status) map[string]StepState {
+	states := make(map[string]StepState, len(s.Steps))
+	for _, step := range s.Steps {
+		states[step.Name] = step.State
+	}
+	return states
+}
+
+func TestDrainer(t *testing.T) {
+	t.Run("nil drainer accepts all requests", func(t *testing.T) {
+		var d *Drainer
+		done, ok := d.Track()
+		require.True(t, ok)
+		done()
+		d.TrackAlways()()
+		assert.False(t, d.Draining())
+		assert.False(t, d.Drain(time.Second))
+		assert.Equal(t, Status{State: StateRunning}, d.Status())
+	})
+
+	t.Run("waits for in-flight requests and runs the steps in order", func(t *testing.T) {
+		var order []string
+		step := func(name string) Step {
+			return Step{Name: name, Fn: func(context.Context) error {
+				order = append(order, name)
+				return nil
+			}}
+		}
+		d := New(Options{
+			Before: []Step{step("before")},
+			After:  []Step{step("after")},
+		})
+
+		done, ok := d.Track()
+		require.True(t, ok)
+		assert.Equal(t, Status{State: StateRunning, InFlight: 1}, d.Status())
+
+		require.True(t, d.Drain(time.Minute))
+		assert.False(t, d.Drain(time.Minute))
+		assert.True(t, d.Draining())
+
+		_, ok = d.Track()
+		assert.False(t, ok)
+
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			assert.Equal(c, StepStateRunning, stepStates(d.Status())[StepRequests])
+		}, time.Second*5, time.Millisecond*10)
+		assert.Equal(t, int64(1), d.Status().InFlight)
+
+		done()
+		// Calling the function again does nothing
+		done()
+
+		select {
+		case <-d.Done():
+		case <-time.After(time.Second * 5):
+			require.Fail(t, "drain did not complete")
+		}
+
+		status := d.Status()
+		assert.Equal(t, StateDrained, status.State)
+		assert.Equal(t, int64(0), status.InFlight)
+		assert.Equal(t, []StepStatus{
+			{Name: "before", State: StepStateCompleted},
+			{Name: StepRequests, State: StepStateCompleted},
+			{Name: "after", State: StepStateCompleted},
+		}, status.Steps)
+		assert.Equal(t, []string{"before", "after"}, order)
+		require.NotNil(t, status.StartedAt)
+		require.NotNil(t, status.Deadline)
+		assert.Equal(t, time.Minute, status.Deadline.Sub(*status.StartedAt))
+	})
+
+	t.Run("requests tracked always are accepted while draining", func(t *testing.T) {
+		d := New(Options{})
+
+		done, ok := d.Track()
+		require.True(t, ok)
+		require.True(t, d.Drain(time.Minute))
+
+		apiDone := d.TrackAlways()
+		assert.Equal(t, int64(2), d.Status().InFlight)
+
+		done()
+		select {
+		case <-d.Done():
+			require.Fail(t, "drain completed with requests in flight")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		apiDone()
+		select {
+		case <-d.Done():
+		case <-time.After(time.Second * 5):
+			require.Fail(t, "drain did not complete")
+		}
+	})
+
+	t.Run("failed step does not stop the drain", func(t *testing.T) {
+		d := New(Options{
+			Before: []Step{{Name: "fail", Fn: func(context.Context) error {
+				return errors.New("an error")
+			}}},
+		})
+		require.True(t, d.Drain(time.Minute))
+		<-d.Done()
+
+		status := d.Status()
+		assert.Equal(t, StateDrained, status.State)
+		assert.Equal(t, []StepStatus{
+			{Name: "fail", State: StepStateFailed, Error: "an error"},
+			{Name: StepRequests, State: StepStateCompleted},
+		}, status.Steps)
+	})
+
+	t.Run("timeout stops the drain", func(t *testing.T) {
+		clock := clocktesting.NewFakeClock(time.Now())
+		d := New(Options{
+			Timeout: time.Minute,
+			After: []Step{{Name: "after", Fn: func(context.Context) error {
+				return nil
+			}}},
+			Clock: clock,
+		})
+
+		_, ok := d.Track()
+		require.True(t, ok)
+
+		// The timeout of the options is used
+		require.True(t, d.Drain(0))
+		assert.Equal(t, time.Minute, d.Status().Deadline.Sub(*d.Status().StartedAt))
+
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			assert.True(c, clock.HasWaiters())
+		}, time.Second*5, time.Millisecond*10)
+		clock.Step(time.Minute)
+
+		select {
+		case <-d.Done():
+		case <-time.After(time.Second * 5):
+			require.Fail(t, "drain did not complete")
+		}
+
+		status := d.Status()
+		assert.Equal(t, StateDrained, status.State)
+		assert.Equal(t, int64(1), status.InFlight)
+		assert.Equal(t, []StepStatus{
+			{Name: StepRequests, State: StepStateTimedOut},
+			{Name: "after", State: StepStatePending},
+		}, status.Steps)
+	})
+}
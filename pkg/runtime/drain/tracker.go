@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drain
+
+import (
+	"context"
+	"sync"
+)
+
+// Tracker counts the work in progress, and waits for it to complete.
+// Unlike a sync.WaitGroup, work can be added while waiting.
+// The zero value is ready to use.
+type Tracker struct {
+	lock   sync.Mutex
+	n      int64
+	closed bool
+	idleCh chan struct{}
+}
+
+// Add registers new work, and returns the function to call when it completes.
+// It returns false if the tracker is closed, in which case the work must be
+// rejected.
+func (t *Tracker) Add() (func(), bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.closed {
+		return nil, false
+	}
+	t.n++
+
+	var once sync.Once
+	return func() {
+		once.Do(t.done)
+	}, true
+}
+
+func (t *Tracker) done() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.n--
+	if t.n == 0 && t.idleCh != nil {
+		close(t.idleCh)
+		t.idleCh = nil
+	}
+}
+
+// Close stops accepting new work.
+func (t *Tracker) Close() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.closed = true
+}
+
+// Len returns the amount of work in progress.
+func (t *Tracker) Len() int64 {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.n
+}
+
+// Wait blocks until no work is in progress, or the context is canceled.
+func (t *Tracker) Wait(ctx context.Context) error {
+	t.lock.Lock()
+	if t.n == 0 {
+		t.lock.Unlock()
+		return nil
+	}
+	if t.idleCh == nil {
+		t.idleCh = make(chan struct{})
+	}
+	idleCh := t.idleCh
+	t.lock.Unlock()
+
+	select {
+	case <-idleCh:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
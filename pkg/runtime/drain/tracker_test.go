@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracker(t *testing.T) {
+	t.Run("wait without work", func(t *testing.T) {
+		var tr Tracker
+		require.NoError(t, tr.Wait(t.Context()))
+	})
+
+	t.Run("wait for work, which can be added while waiting", func(t *testing.T) {
+		var tr Tracker
+		done1, ok := tr.Add()
+		require.True(t, ok)
+
+		waitCh := make(chan error, 1)
+		go func() {
+			waitCh <- tr.Wait(t.Context())
+		}()
+
+		done2, ok := tr.Add()
+		require.True(t, ok)
+		assert.Equal(t, int64(2), tr.Len())
+
+		done1()
+		// Calling the function again has no effect.
+		done1()
+		select {
+		case <-waitCh:
+			require.Fail(t, "wait returned with work in progress")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		done2()
+		select {
+		case err := <-waitCh:
+			require.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "wait didn't return")
+		}
+		assert.Equal(t, int64(0), tr.Len())
+	})
+
+	t.Run("closed tracker rejects work", func(t *testing.T) {
+		var tr Tracker
+		done, ok := tr.Add()
+		require.True(t, ok)
+		tr.Close()
+
+		_, ok = tr.Add()
+		assert.False(t, ok)
+		done()
+		require.NoError(t, tr.Wait(t.Context()))
+	})
+
+	t.Run("wait is canceled", func(t *testing.T) {
+		var tr Tracker
+		_, ok := tr.Add()
+		require.True(t, ok)
+
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+		require.ErrorIs(t, tr.Wait(ctx), context.Canceled)
+	})
+}
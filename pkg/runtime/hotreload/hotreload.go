@@ -16,6 +16,7 @@ package hotreload
 import (
 	"context"
 
+	bindingrouteapi "github.com/dapr/dapr/pkg/apis/bindingRoute/v1alpha1"
 	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
 	"github.com/dapr/dapr/pkg/config"
@@ -60,6 +61,7 @@ type Reloader struct {
 	loader                  loader.Interface
 	componentsReconciler    *reconciler.Reconciler[compapi.Component]
 	subscriptionsReconciler *reconciler.Reconciler[subapi.Subscription]
+	bindingRoutesReconciler *reconciler.Reconciler[bindingrouteapi.BindingRoute]
 }
 
 func NewDisk(opts OptionsReloaderDisk) (*Reloader, error) {
@@ -72,6 +74,7 @@ func NewDisk(opts OptionsReloaderDisk) (*Reloader, error) {
 		AppID:          opts.AppID,
 		Dirs:           opts.Dirs,
 		ComponentStore: opts.ComponentStore,
+		Routes:         opts.Processor.Binding(),
 	})
 	if err != nil {
 		return nil, err
@@ -94,6 +97,13 @@ func NewDisk(opts OptionsReloaderDisk) (*Reloader, error) {
 			Authorizer: opts.Authorizer,
 			Healthz:    opts.Healthz,
 		}),
+		bindingRoutesReconciler: reconciler.NewBindingRoutes(reconciler.Options[bindingrouteapi.BindingRoute]{
+			Loader:     loader,
+			CompStore:  opts.ComponentStore,
+			Processor:  opts.Processor,
+			Authorizer: opts.Authorizer,
+			Healthz:    opts.Healthz,
+		}),
 	}, nil
 }
 
@@ -127,6 +137,13 @@ func NewOperator(opts OptionsReloaderOperator) *Reloader {
 			Authorizer: opts.Authorizer,
 			Healthz:    opts.Healthz,
 		}),
+		bindingRoutesReconciler: reconciler.NewBindingRoutes(reconciler.Options[bindingrouteapi.BindingRoute]{
+			Loader:     loader,
+			CompStore:  opts.ComponentStore,
+			Processor:  opts.Processor,
+			Authorizer: opts.Authorizer,
+			Healthz:    opts.Healthz,
+		}),
 	}
 }
 
@@ -137,11 +154,12 @@ func (r *Reloader) Run(ctx context.Context) error {
 		return nil
 	}
 
-	log.Info("Hot reloading enabled. Daprd will reload 'Component' and 'Subscription' resources on change.")
+	log.Info("Hot reloading enabled. Daprd will reload 'Component', 'Subscription' and 'BindingRoute' resources on change.")
 
 	return concurrency.NewRunnerManager(
 		r.loader.Run,
 		r.componentsReconciler.Run,
 		r.subscriptionsReconciler.Run,
+		r.bindingRoutesReconciler.Run,
 	).Run(ctx)
 }
@@ -19,6 +19,7 @@ import (
 	"strings"
 	"time"
 
+	bindingrouteapi "github.com/dapr/dapr/pkg/apis/bindingRoute/v1alpha1"
 	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
 	loaderdisk "github.com/dapr/dapr/pkg/internal/loader/disk"
@@ -38,11 +39,13 @@ type Options struct {
 	AppID          string
 	Dirs           []string
 	ComponentStore *compstore.ComponentStore
+	Routes         store.RouteLister
 }
 
 type disk struct {
 	components    *resource[compapi.Component]
 	subscriptions *resource[subapi.Subscription]
+	bindingRoutes *resource[bindingrouteapi.BindingRoute]
 	fs            *fswatcher.FSWatcher
 	batcher       *batcher.Batcher[int, struct{}]
 }
@@ -84,6 +87,16 @@ func New(opts Options) (loader.Interface, error) {
 				batcher: batcher,
 			},
 		),
+		bindingRoutes: newResource[bindingrouteapi.BindingRoute](
+			resourceOptions[bindingrouteapi.BindingRoute]{
+				loader: loaderdisk.NewBindingRoutes(loaderdisk.Options{
+					AppID: opts.AppID,
+					Paths: opts.Dirs,
+				}),
+				store:   store.NewBindingRoutes(opts.Routes),
+				batcher: batcher,
+			},
+		),
 		batcher: batcher,
 	}, nil
 }
@@ -94,6 +107,7 @@ func (d *disk) Run(ctx context.Context) error {
 	return concurrency.NewRunnerManager(
 		d.components.run,
 		d.subscriptions.run,
+		d.bindingRoutes.run,
 		func(ctx context.Context) error {
 			return d.fs.Run(ctx, eventCh)
 		},
@@ -123,3 +137,7 @@ func (d *disk) Components() loader.Loader[compapi.Component] {
 func (d *disk) Subscriptions() loader.Loader[subapi.Subscription] {
 	return d.subscriptions
 }
+
+func (d *disk) BindingRoutes() loader.Loader[bindingrouteapi.BindingRoute] {
+	return d.bindingRoutes
+}
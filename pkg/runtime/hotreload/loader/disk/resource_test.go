@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	bindingrouteapi "github.com/dapr/dapr/pkg/apis/bindingRoute/v1alpha1"
 	commonapi "github.com/dapr/dapr/pkg/apis/common"
 	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	loaderdisk "github.com/dapr/dapr/pkg/internal/loader/disk"
@@ -73,6 +74,7 @@ func Test_Disk(t *testing.T) {
 	d, err := New(Options{
 		Dirs:           []string{dir},
 		ComponentStore: store,
+		Routes:         new(fakeRoutes),
 	})
 	require.NoError(t, err)
 
@@ -132,6 +134,66 @@ func Test_Disk(t *testing.T) {
 	}, events)
 }
 
+type fakeRoutes struct {
+	routes []bindingrouteapi.BindingRoute
+}
+
+func (f *fakeRoutes) Routes() []bindingrouteapi.BindingRoute {
+	return f.routes
+}
+
+func Test_DiskBindingRoutes(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	d, err := New(Options{
+		Dirs:           []string{dir},
+		ComponentStore: compstore.New(),
+		Routes:         &fakeRoutes{routes: []bindingrouteapi.BindingRoute{{ObjectMeta: metav1.ObjectMeta{Name: "old"}}}},
+	})
+	require.NoError(t, err)
+
+	errCh := make(chan error)
+	ctx, cancel := context.WithCancel(t.Context())
+	go func() {
+		errCh <- d.Run(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-errCh)
+	})
+
+	conn, err := d.BindingRoutes().Stream(t.Context())
+	require.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(dir, "f.yaml"), []byte(`apiVersion: dapr.io/v1alpha1
+kind: BindingRoute
+metadata:
+  name: route1
+spec:
+  source: in
+  destination:
+    binding: out
+`), 0o600)
+	require.NoError(t, err)
+
+	events := make(map[string]operatorpb.ResourceEventType)
+	for range 2 {
+		select {
+		case event := <-conn.EventCh:
+			events[event.Resource.Name] = event.Type
+		case <-time.After(time.Second * 3):
+			assert.Fail(t, "expected to receive event")
+		}
+	}
+
+	assert.Equal(t, map[string]operatorpb.ResourceEventType{
+		"old":    operatorpb.ResourceEventType_DELETED,
+		"route1": operatorpb.ResourceEventType_CREATED,
+	}, events)
+}
+
 func Test_Stream(t *testing.T) {
 	t.Parallel()
 
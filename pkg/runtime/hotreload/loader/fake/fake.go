@@ -16,6 +16,7 @@ package fake
 import (
 	"context"
 
+	bindingrouteapi "github.com/dapr/dapr/pkg/apis/bindingRoute/v1alpha1"
 	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
 	"github.com/dapr/dapr/pkg/runtime/hotreload/differ"
@@ -26,6 +27,7 @@ type FakeT struct {
 	runFn         func(context.Context) error
 	components    *Fake[compapi.Component]
 	subscriptions *Fake[subapi.Subscription]
+	bindingRoutes *Fake[bindingrouteapi.BindingRoute]
 	startFn       func(context.Context) error
 }
 
@@ -37,6 +39,7 @@ func New() *FakeT {
 		},
 		components:    NewFake[compapi.Component](),
 		subscriptions: NewFake[subapi.Subscription](),
+		bindingRoutes: NewFake[bindingrouteapi.BindingRoute](),
 		startFn: func(ctx context.Context) error {
 			<-ctx.Done()
 			return nil
@@ -56,6 +59,10 @@ func (f *FakeT) Subscriptions() loader.Loader[subapi.Subscription] {
 	return f.subscriptions
 }
 
+func (f *FakeT) BindingRoutes() loader.Loader[bindingrouteapi.BindingRoute] {
+	return f.bindingRoutes
+}
+
 func (f *FakeT) WithComponents(fake *Fake[compapi.Component]) *FakeT {
 	f.components = fake
 	return f
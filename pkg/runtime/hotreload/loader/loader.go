@@ -16,6 +16,7 @@ package loader
 import (
 	"context"
 
+	bindingrouteapi "github.com/dapr/dapr/pkg/apis/bindingRoute/v1alpha1"
 	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
 	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
@@ -28,6 +29,7 @@ type Interface interface {
 	Run(context.Context) error
 	Components() Loader[compapi.Component]
 	Subscriptions() Loader[subapi.Subscription]
+	BindingRoutes() Loader[bindingrouteapi.BindingRoute]
 }
 
 type StreamConn[T differ.Resource] struct {
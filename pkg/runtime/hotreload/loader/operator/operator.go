@@ -18,10 +18,12 @@ import (
 	"errors"
 	"sync/atomic"
 
+	bindingrouteapi "github.com/dapr/dapr/pkg/apis/bindingRoute/v1alpha1"
 	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
 	operatorpb "github.com/dapr/dapr/pkg/proto/operator/v1"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/hotreload/differ"
 	"github.com/dapr/dapr/pkg/runtime/hotreload/loader"
 	loadercompstore "github.com/dapr/dapr/pkg/runtime/hotreload/loader/store"
 	"github.com/dapr/kit/logger"
@@ -66,3 +68,23 @@ func (o *operator) Components() loader.Loader[componentsapi.Component] {
 func (o *operator) Subscriptions() loader.Loader[subapi.Subscription] {
 	return o.subscriptions
 }
+
+// BindingRoutes returns a loader which never loads binding routes, as the
+// operator doesn't serve them. Binding routes are only loaded from disk in
+// self-hosted mode.
+func (o *operator) BindingRoutes() loader.Loader[bindingrouteapi.BindingRoute] {
+	return noBindingRoutes{}
+}
+
+type noBindingRoutes struct{}
+
+func (noBindingRoutes) List(context.Context) (*differ.LocalRemoteResources[bindingrouteapi.BindingRoute], error) {
+	return nil, nil
+}
+
+func (noBindingRoutes) Stream(context.Context) (*loader.StreamConn[bindingrouteapi.BindingRoute], error) {
+	return &loader.StreamConn[bindingrouteapi.BindingRoute]{
+		EventCh:     make(chan *loader.Event[bindingrouteapi.BindingRoute]),
+		ReconcileCh: make(chan struct{}),
+	}, nil
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	bindingrouteapi "github.com/dapr/dapr/pkg/apis/bindingRoute/v1alpha1"
+)
+
+// RouteLister lists the binding routes which were added.
+type RouteLister interface {
+	Routes() []bindingrouteapi.BindingRoute
+}
+
+type bindingRoutes struct {
+	routes RouteLister
+}
+
+func NewBindingRoutes(routes RouteLister) Store[bindingrouteapi.BindingRoute] {
+	return &bindingRoutes{
+		routes: routes,
+	}
+}
+
+func (b *bindingRoutes) List() []bindingrouteapi.BindingRoute {
+	return b.routes.Routes()
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+
+	bindingrouteapi "github.com/dapr/dapr/pkg/apis/bindingRoute/v1alpha1"
+	"github.com/dapr/dapr/pkg/runtime/hotreload/loader"
+	"github.com/dapr/dapr/pkg/runtime/processor"
+)
+
+type bindingRoutes struct {
+	proc *processor.Processor
+	loader.Loader[bindingrouteapi.BindingRoute]
+}
+
+// The go linter does not yet understand that these functions are being used by
+// the generic reconciler.
+//
+//nolint:unused
+func (b *bindingRoutes) update(_ context.Context, route bindingrouteapi.BindingRoute) {
+	log.Infof("Adding BindingRoute: %s", route.LogName())
+	if err := b.proc.Binding().AddRoute(route); err != nil {
+		log.Errorf("Failed to add BindingRoute %s: %s", route.LogName(), err)
+	}
+}
+
+//nolint:unused
+func (b *bindingRoutes) delete(_ context.Context, route bindingrouteapi.BindingRoute) {
+	log.Infof("Removing BindingRoute: %s", route.LogName())
+	if err := b.proc.Binding().RemoveRoute(route.Name); err != nil {
+		log.Errorf("Failed to remove BindingRoute %s: %s", route.LogName(), err)
+	}
+}
@@ -105,6 +105,10 @@ func (c *components) verify(vcomp compapi.Component) bool {
 					log.Errorf("Aborting to hot-reload a state store component that is used as an actor state store: %s", comp.LogName())
 					return false
 				}
+				if strings.EqualFold(meta.Name, state.PropertyKeyActorReminderStore) {
+					log.Errorf("Aborting to hot-reload a state store component that is used as an actor reminder store: %s", comp.LogName())
+					return false
+				}
 			}
 		}
 	}
@@ -21,6 +21,7 @@ import (
 
 	"k8s.io/utils/clock"
 
+	bindingrouteapi "github.com/dapr/dapr/pkg/apis/bindingRoute/v1alpha1"
 	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
 	"github.com/dapr/dapr/pkg/healthz"
@@ -84,6 +85,18 @@ func NewSubscriptions(opts Options[subapi.Subscription]) *Reconciler[subapi.Subs
 	}
 }
 
+func NewBindingRoutes(opts Options[bindingrouteapi.BindingRoute]) *Reconciler[bindingrouteapi.BindingRoute] {
+	return &Reconciler[bindingrouteapi.BindingRoute]{
+		clock:   clock.RealClock{},
+		kind:    bindingrouteapi.Kind,
+		htarget: opts.Healthz.AddTarget("binding-route-reconciler"),
+		manager: &bindingRoutes{
+			Loader: opts.Loader.BindingRoutes(),
+			proc:   opts.Processor,
+		},
+	}
+}
+
 func (r *Reconciler[T]) Run(ctx context.Context) error {
 	conn, err := r.manager.Stream(ctx)
 	if err != nil {
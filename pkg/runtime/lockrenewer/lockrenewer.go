@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lockrenewer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.runtime.lockrenewer")
+
+// RenewFn renews the lease of a lock, returning false if the lock has been
+// lost.
+type RenewFn func(ctx context.Context) (bool, error)
+
+type Options struct {
+	Clock clock.WithTicker
+}
+
+// Renewer renews the leases of the locks held by the app in the background,
+// so long critical sections don't lose their locks mid-operation. Leases are
+// renewed at half of their expiry, until the max hold time of the lock, then
+// they're left to expire.
+type Renewer struct {
+	clock  clock.WithTicker
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	lock   sync.Mutex
+	leases map[string]*lease
+}
+
+type lease struct {
+	cancel context.CancelFunc
+}
+
+func New(opts Options) *Renewer {
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &Renewer{
+		clock:  opts.Clock,
+		ctx:    ctx,
+		cancel: cancel,
+		leases: make(map[string]*lease),
+	}
+	if r.clock == nil {
+		r.clock = clock.RealClock{}
+	}
+	return r
+}
+
+// Run stops the renewals when the context is canceled.
+func (r *Renewer) Run(ctx context.Context) error {
+	<-ctx.Done()
+	r.cancel()
+	r.wg.Wait()
+	return nil
+}
+
+// Start renews the lease of the lock with the key every interval, until
+// maxHold has elapsed, the lock has been lost or Stop is called. It replaces
+// the renewals of the key in progress.
+func (r *Renewer) Start(key string, interval, maxHold time.Duration, renew RenewFn) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if l, ok := r.leases[key]; ok {
+		l.cancel()
+	}
+	if r.ctx.Err() != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(r.ctx)
+	l := &lease{cancel: cancel}
+	r.leases[key] = l
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		defer r.remove(key, l)
+		r.renewLoop(ctx, key, interval, r.clock.Now().Add(maxHold), renew)
+	}()
+}
+
+// Stop stops the renewals of the lock with the key, e.g. when it's released.
+// It's a no-op on a nil Renewer.
+func (r *Renewer) Stop(key string) {
+	if r == nil {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if l, ok := r.leases[key]; ok {
+		l.cancel()
+		delete(r.leases, key)
+	}
+}
+
+func (r *Renewer) renewLoop(ctx context.Context, key string, interval time.Duration, deadline time.Time, renew RenewFn) {
+	ticker := r.clock.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C():
+			if !now.Before(deadline) {
+				log.Debugf("Stopped renewing lock %s: max hold time reached", key)
+				return
+			}
+
+			held, err := renew(ctx)
+			switch {
+			case ctx.Err() != nil:
+				return
+			case err != nil:
+				// The lease may still be valid: try again at the next interval.
+				log.Warnf("Failed to renew lock %s: %v", key, err)
+			case !held:
+				log.Warnf("Stopped renewing lock %s: the lock has been lost", key)
+				return
+			}
+		}
+	}
+}
+
+// remove forgets the lease, unless it has been replaced.
+func (r *Renewer) remove(key string, l *lease) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.leases[key] == l {
+		l.cancel()
+		delete(r.leases, key)
+	}
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lockrenewer
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+// countingRenew counts the renewals, and returns the result of held.
+type countingRenew struct {
+	calls atomic.Int32
+	held  atomic.Bool
+	err   atomic.Pointer[error]
+}
+
+func newCountingRenew() *countingRenew {
+	c := &countingRenew{}
+	c.held.Store(true)
+	return c
+}
+
+func (c *countingRenew) renew(context.Context) (bool, error) {
+	c.calls.Add(1)
+	if err := c.err.Load(); err != nil {
+		return false, *err
+	}
+	return c.held.Load(), nil
+}
+
+func TestRenewer(t *testing.T) {
+	newRenewer := func(t *testing.T) (*Renewer, *clocktesting.FakeClock) {
+		t.Helper()
+		clock := clocktesting.NewFakeClock(time.Now())
+		r := New(Options{Clock: clock})
+		ctx, cancel := context.WithCancel(t.Context())
+		errCh := make(chan error)
+		go func() { errCh <- r.Run(ctx) }()
+		t.Cleanup(func() {
+			cancel()
+			require.NoError(t, <-errCh)
+		})
+		return r, clock
+	}
+
+	// step advances the clock once the renewal loops are waiting on it.
+	step := func(t *testing.T, clock *clocktesting.FakeClock, d time.Duration) {
+		t.Helper()
+		assert.Eventually(t, clock.HasWaiters, time.Second, time.Millisecond)
+		clock.Step(d)
+	}
+
+	t.Run("renews until the max hold time", func(t *testing.T) {
+		r, clock := newRenewer(t)
+		c := newCountingRenew()
+
+		r.Start("key", 5*time.Second, 12*time.Second, c.renew)
+		step(t, clock, 5*time.Second)
+		assert.Eventually(t, func() bool { return c.calls.Load() == 1 }, time.Second, time.Millisecond)
+		step(t, clock, 5*time.Second)
+		assert.Eventually(t, func() bool { return c.calls.Load() == 2 }, time.Second, time.Millisecond)
+
+		// The max hold time is reached at the next interval.
+		step(t, clock, 5*time.Second)
+		assert.Eventually(t, func() bool {
+			r.lock.Lock()
+			defer r.lock.Unlock()
+			return len(r.leases) == 0
+		}, time.Second, time.Millisecond)
+		assert.Equal(t, int32(2), c.calls.Load())
+	})
+
+	t.Run("stops when the lock is lost", func(t *testing.T) {
+		r, clock := newRenewer(t)
+		c := newCountingRenew()
+		c.held.Store(false)
+
+		r.Start("key", time.Second, time.Hour, c.renew)
+		step(t, clock, time.Second)
+		assert.Eventually(t, func() bool {
+			r.lock.Lock()
+			defer r.lock.Unlock()
+			return len(r.leases) == 0
+		}, time.Second, time.Millisecond)
+		assert.Equal(t, int32(1), c.calls.Load())
+	})
+
+	t.Run("keeps renewing after errors", func(t *testing.T) {
+		r, clock := newRenewer(t)
+		c := newCountingRenew()
+		err := errors.New("store unavailable")
+		c.err.Store(&err)
+
+		r.Start("key", time.Second, time.Hour, c.renew)
+		for i := range 3 {
+			step(t, clock, time.Second)
+			assert.Eventually(t, func() bool { return c.calls.Load() == int32(i+1) }, time.Second, time.Millisecond)
+		}
+		r.Stop("key")
+	})
+
+	t.Run("stop and replace", func(t *testing.T) {
+		r, clock := newRenewer(t)
+		c1, c2 := newCountingRenew(), newCountingRenew()
+
+		r.Start("key", time.Second, time.Hour, c1.renew)
+		r.Start("key", time.Second, time.Hour, c2.renew)
+		step(t, clock, time.Second)
+		assert.Eventually(t, func() bool { return c2.calls.Load() == 1 }, time.Second, time.Millisecond)
+		assert.Zero(t, c1.calls.Load())
+
+		r.Stop("key")
+		r.lock.Lock()
+		assert.Empty(t, r.leases)
+		r.lock.Unlock()
+	})
+
+	t.Run("nil renewer", func(t *testing.T) {
+		var r *Renewer
+		r.Stop("key")
+	})
+}
+
+func TestRenewerRun(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Now())
+	r := New(Options{Clock: clock})
+	ctx, cancel := context.WithCancel(t.Context())
+	errCh := make(chan error)
+	go func() { errCh <- r.Run(ctx) }()
+
+	c := newCountingRenew()
+	r.Start("key", time.Second, time.Hour, c.renew)
+	cancel()
+	require.NoError(t, <-errCh)
+
+	// Renewals aren't started after the renewer has stopped.
+	r.Start("key2", time.Second, time.Hour, c.renew)
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	assert.NotContains(t, r.leases, "key2")
+}
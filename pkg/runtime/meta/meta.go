@@ -96,7 +96,12 @@ func (m *Meta) convertItemsToProps(items []common.NameValuePair) (map[string]str
 }
 
 func (m *Meta) AuthSecretStoreOrDefault(resource Resource) string {
-	secretStore := resource.GetSecretStore()
+	return m.SecretStoreOrDefault(resource.GetSecretStore())
+}
+
+// SecretStoreOrDefault returns the secret store, or the built-in secret store
+// of the mode if it's empty.
+func (m *Meta) SecretStoreOrDefault(secretStore string) string {
 	if secretStore == "" {
 		switch m.mode {
 		case modes.KubernetesMode:
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+
+	nr "github.com/dapr/components-contrib/nameresolution"
+	"github.com/dapr/dapr/pkg/runtime/processor/secret"
+)
+
+var errResolverClosed = errors.New("name resolver is closed")
+
+// deferredResolver is a name resolver whose configuration references secrets,
+// so it's initialized once the secret stores are loaded. Names are resolved
+// after it's initialized.
+type deferredResolver struct {
+	nr.Resolver
+
+	ready chan struct{}
+	once  sync.Once
+	err   error
+}
+
+// deferredResolverMulti is a deferredResolver of a resolver which implements
+// nr.ResolverMulti.
+type deferredResolverMulti struct {
+	*deferredResolver
+	multi nr.ResolverMulti
+}
+
+// newDeferredResolver wraps the resolver, keeping the nr.ResolverMulti
+// interface of the resolver.
+func newDeferredResolver(resolver nr.Resolver) (nr.Resolver, *deferredResolver) {
+	r := &deferredResolver{
+		Resolver: resolver,
+		ready:    make(chan struct{}),
+	}
+	if multi, ok := resolver.(nr.ResolverMulti); ok {
+		return deferredResolverMulti{deferredResolver: r, multi: multi}, r
+	}
+	return r, r
+}
+
+// Init initializes the resolver, or fails the resolutions with the error of
+// the configuration.
+func (r *deferredResolver) Init(ctx context.Context, metadata nr.Metadata) error {
+	err := r.Resolver.Init(ctx, metadata)
+	r.fail(err)
+	return err
+}
+
+// fail completes the initialization, with an error if it isn't nil.
+func (r *deferredResolver) fail(err error) {
+	r.once.Do(func() {
+		r.err = err
+		close(r.ready)
+	})
+}
+
+func (r *deferredResolver) wait(ctx context.Context) error {
+	select {
+	case <-r.ready:
+		return r.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (r *deferredResolver) ResolveID(ctx context.Context, req nr.ResolveRequest) (string, error) {
+	if err := r.wait(ctx); err != nil {
+		return "", err
+	}
+	return r.Resolver.ResolveID(ctx, req)
+}
+
+// Close closes the resolver if it was initialized.
+func (r *deferredResolver) Close() error {
+	r.fail(errResolverClosed)
+	if r.err != nil {
+		return nil
+	}
+	return r.Resolver.Close()
+}
+
+func (r deferredResolverMulti) ResolveIDMulti(ctx context.Context, req nr.ResolveRequest) (nr.AddressList, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.multi.ResolveIDMulti(ctx, req)
+}
+
+// hasSecretURIs returns true if the name resolution configuration references
+// secrets.
+func hasSecretURIs(configuration any) bool {
+	if configuration == nil {
+		return false
+	}
+	b, err := json.Marshal(configuration)
+	return err == nil && strings.Contains(string(b), secret.URIScheme)
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/modes"
+	"github.com/dapr/kit/fswatcher"
+)
+
+// watchPipelines reloads the middleware pipelines when the configuration
+// files change, until the context is canceled.
+// Only the configuration files of standalone mode are watched.
+func (a *DaprRuntime) watchPipelines(ctx context.Context) error {
+	if a.runtimeConfig.mode != modes.StandaloneMode || len(a.runtimeConfig.config) == 0 {
+		<-ctx.Done()
+		return nil
+	}
+
+	// Watch the folders rather than the files, as files in volumes are replaced through symlinks
+	dirs := make([]string, 0, len(a.runtimeConfig.config))
+	for _, path := range a.runtimeConfig.config {
+		if dir := filepath.Dir(path); !slices.Contains(dirs, dir) {
+			dirs = append(dirs, dir)
+		}
+	}
+	fs, err := fswatcher.New(fswatcher.Options{
+		Targets: dirs,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to watch configuration files: %w", err)
+	}
+
+	eventCh := make(chan struct{})
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- fs.Run(ctx, eventCh)
+	}()
+
+	for {
+		select {
+		case err := <-errCh:
+			if errors.Is(err, context.Canceled) {
+				return nil
+			}
+			return err
+		case <-eventCh:
+			globalConfig, err := config.LoadStandaloneConfiguration(a.runtimeConfig.config...)
+			if err != nil {
+				log.Errorf("Failed to reload middleware pipelines from configuration: %v", err)
+				continue
+			}
+			a.updatePipelines(globalConfig)
+		}
+	}
+}
+
+// updatePipelines updates the middleware pipelines with the specs of the configuration.
+func (a *DaprRuntime) updatePipelines(globalConfig *config.Configuration) {
+	a.httpMiddleware.UpdatePipelineSpec("server", globalConfig.Spec.HTTPPipelineSpec)
+	a.httpMiddleware.UpdatePipelineSpec("app", globalConfig.Spec.AppHTTPPipelineSpec)
+	a.httpMiddleware.UpdatePipelineSpec("grpc", globalConfig.Spec.GRPCPipelineSpec)
+	log.Info("Reloaded middleware pipelines from configuration")
+}
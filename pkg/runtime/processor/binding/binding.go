@@ -30,9 +30,11 @@ import (
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/channels"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/drain"
 	rterrors "github.com/dapr/dapr/pkg/runtime/errors"
 	"github.com/dapr/dapr/pkg/runtime/meta"
 	"github.com/dapr/dapr/pkg/runtime/processor/binding/input"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	"github.com/dapr/kit/logger"
 )
 
@@ -49,6 +51,7 @@ const (
 var log = logger.NewLogger("dapr.runtime.processor.binding")
 
 type Options struct {
+	AppID  string
 	IsHTTP bool
 
 	Registry       *compbindings.Registry
@@ -58,9 +61,12 @@ type Options struct {
 	GRPC           *manager.Manager
 	TracingSpec    *config.TracingSpec
 	Channels       *channels.Channels
+	PubsubAdapter  rtpubsub.Adapter
+	Drainer        *drain.Drainer
 }
 
 type binding struct {
+	appID  string
 	isHTTP bool
 
 	registry    *compbindings.Registry
@@ -71,26 +77,36 @@ type binding struct {
 	tracingSpec *config.TracingSpec
 	grpc        *manager.Manager
 
+	pubsubAdapter rtpubsub.Adapter
+	drainer       *drain.Drainer
+
 	lock            sync.Mutex
 	readingBindings bool
 	stopForever     bool
 
 	subscribeBindingList []string
 	activeInputs         map[string]*input.Input
+	routes               map[string]*route
+	gates                map[string]*input.Gate
 	wg                   sync.WaitGroup
 }
 
 func New(opts Options) *binding {
 	return &binding{
-		registry:     opts.Registry,
-		compStore:    opts.ComponentStore,
-		meta:         opts.Meta,
-		isHTTP:       opts.IsHTTP,
-		resiliency:   opts.Resiliency,
-		tracingSpec:  opts.TracingSpec,
-		grpc:         opts.GRPC,
-		channels:     opts.Channels,
-		activeInputs: make(map[string]*input.Input),
+		appID:         opts.AppID,
+		registry:      opts.Registry,
+		compStore:     opts.ComponentStore,
+		meta:          opts.Meta,
+		isHTTP:        opts.IsHTTP,
+		resiliency:    opts.Resiliency,
+		tracingSpec:   opts.TracingSpec,
+		grpc:          opts.GRPC,
+		channels:      opts.Channels,
+		activeInputs:  make(map[string]*input.Input),
+		routes:        make(map[string]*route),
+		gates:         make(map[string]*input.Gate),
+		pubsubAdapter: opts.PubsubAdapter,
+		drainer:       opts.Drainer,
 	}
 }
 
@@ -135,6 +151,7 @@ func (b *binding) Close(comp compapi.Component) error {
 			input.Stop()
 		}
 		delete(b.activeInputs, comp.Name)
+		delete(b.gates, comp.Name)
 		if err := inbinding.Close(); err != nil {
 			errs = append(errs, err)
 		}
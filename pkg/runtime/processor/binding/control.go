@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/dapr/dapr/pkg/runtime/processor/binding/input"
+)
+
+// Metadata of input bindings limiting the consumption of their events.
+const (
+	// MaxInFlightKey is the maximum number of events of the binding handled
+	// concurrently.
+	MaxInFlightKey = "maxInFlight"
+	// RateLimitKey is the maximum number of events of the binding handled per
+	// second.
+	RateLimitKey = "rateLimit"
+)
+
+// readLimits are the limits of the consumption of an input binding.
+type readLimits struct {
+	maxInFlight int
+	rateLimit   float64
+}
+
+func readLimitsFromMetadata(md map[string]string) (readLimits, error) {
+	var limits readLimits
+	if v := md[MaxInFlightKey]; v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return limits, fmt.Errorf("invalid %s %q: must be a non-negative integer", MaxInFlightKey, v)
+		}
+		limits.maxInFlight = n
+	}
+	if v := md[RateLimitKey]; v != "" {
+		r, err := strconv.ParseFloat(v, 64)
+		if err != nil || r < 0 {
+			return limits, fmt.Errorf("invalid %s %q: must be a non-negative number", RateLimitKey, v)
+		}
+		limits.rateLimit = r
+	}
+	return limits, nil
+}
+
+// PauseInputBinding pauses the consumption of an input binding, until it's
+// resumed. The binding stays paused if it's restarted. It returns false if
+// the binding was already paused.
+func (b *binding) PauseInputBinding(name string) (bool, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if _, ok := b.compStore.GetInputBinding(name); !ok {
+		return false, fmt.Errorf("input binding %s not found", name)
+	}
+	paused := b.gateFor(name).Pause()
+	if paused {
+		log.Infof("Paused consumption of input binding %s", name)
+	}
+	return paused, nil
+}
+
+// ResumeInputBinding resumes the consumption of a paused input binding. It
+// returns false if the binding wasn't paused.
+func (b *binding) ResumeInputBinding(name string) (bool, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if _, ok := b.compStore.GetInputBinding(name); !ok {
+		return false, fmt.Errorf("input binding %s not found", name)
+	}
+	resumed := b.gateFor(name).Resume()
+	if resumed {
+		log.Infof("Resumed consumption of input binding %s", name)
+	}
+	return resumed, nil
+}
+
+// gateFor returns the gate pausing the consumption of an input binding. It
+// must be called with the lock held.
+func (b *binding) gateFor(name string) *input.Gate {
+	gate, ok := b.gates[name]
+	if !ok {
+		gate = input.NewGate()
+		b.gates[name] = gate
+	}
+	return gate
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/meta"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+func TestReadLimitsFromMetadata(t *testing.T) {
+	t.Run("no limits", func(t *testing.T) {
+		limits, err := readLimitsFromMetadata(map[string]string{})
+		require.NoError(t, err)
+		assert.Equal(t, readLimits{}, limits)
+	})
+
+	t.Run("limits", func(t *testing.T) {
+		limits, err := readLimitsFromMetadata(map[string]string{
+			MaxInFlightKey: "4",
+			RateLimitKey:   "2.5",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, readLimits{maxInFlight: 4, rateLimit: 2.5}, limits)
+	})
+
+	for name, md := range map[string]map[string]string{
+		"invalid max in flight":  {MaxInFlightKey: "many"},
+		"negative max in flight": {MaxInFlightKey: "-1"},
+		"invalid rate limit":     {RateLimitKey: "fast"},
+		"negative rate limit":    {RateLimitKey: "-0.5"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := readLimitsFromMetadata(md)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestPauseResumeInputBinding(t *testing.T) {
+	compStore := compstore.New()
+	compStore.AddInputBinding("input1", new(daprt.MockBinding))
+	b := New(Options{
+		Resiliency:     resiliency.New(log),
+		ComponentStore: compStore,
+		Meta:           meta.New(meta.Options{}),
+	})
+
+	_, err := b.PauseInputBinding("nobinding")
+	require.Error(t, err)
+
+	paused, err := b.PauseInputBinding("input1")
+	require.NoError(t, err)
+	assert.True(t, paused)
+	assert.True(t, b.gates["input1"].Paused())
+
+	paused, err = b.PauseInputBinding("input1")
+	require.NoError(t, err)
+	assert.False(t, paused)
+
+	resumed, err := b.ResumeInputBinding("input1")
+	require.NoError(t, err)
+	assert.True(t, resumed)
+	assert.False(t, b.gates["input1"].Paused())
+
+	resumed, err = b.ResumeInputBinding("input1")
+	require.NoError(t, err)
+	assert.False(t, resumed)
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+
+	"github.com/dapr/components-contrib/bindings"
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/messages"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+)
+
+// Metadata of input bindings configuring the delivery of their events to the
+// app.
+const (
+	// DeliveryMaxRetriesKey is the number of times the delivery of an event is
+	// retried after the app returned an error.
+	DeliveryMaxRetriesKey = "deliveryMaxRetries"
+	// DeliveryRetryIntervalKey is the interval before the first retry, doubled
+	// on each subsequent retry.
+	DeliveryRetryIntervalKey = "deliveryRetryInterval"
+	// DeadLetterBindingKey is the output binding events are sent to when
+	// their delivery failed.
+	DeadLetterBindingKey = "deadLetterBinding"
+	// DeadLetterPubsubKey and DeadLetterTopicKey are the pubsub and topic
+	// events are published to when their delivery failed.
+	DeadLetterPubsubKey = "deadLetterPubsub"
+	DeadLetterTopicKey  = "deadLetterTopic"
+)
+
+const (
+	defaultDeliveryRetryInterval    = time.Second
+	maxDeliveryRetryInterval        = time.Minute
+	deliveryRetryIntervalMultiplier = 2
+)
+
+type deliveryHandler func(context.Context, string, []byte, map[string]string) ([]byte, error)
+
+// deliveryPolicy is the policy of an input binding for the events its app
+// fails to process.
+type deliveryPolicy struct {
+	maxRetries        int
+	retryInterval     time.Duration
+	deadLetterBinding string
+	deadLetterPubsub  string
+	deadLetterTopic   string
+}
+
+// deliveryPolicyFromMetadata returns the delivery policy configured in the
+// metadata of an input binding.
+func deliveryPolicyFromMetadata(md map[string]string) (deliveryPolicy, error) {
+	policy := deliveryPolicy{
+		retryInterval:     defaultDeliveryRetryInterval,
+		deadLetterBinding: md[DeadLetterBindingKey],
+		deadLetterPubsub:  md[DeadLetterPubsubKey],
+		deadLetterTopic:   md[DeadLetterTopicKey],
+	}
+
+	if v := md[DeliveryMaxRetriesKey]; v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return policy, fmt.Errorf("invalid %s %q: must be a non-negative integer", DeliveryMaxRetriesKey, v)
+		}
+		policy.maxRetries = n
+	}
+	if v := md[DeliveryRetryIntervalKey]; v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return policy, fmt.Errorf("invalid %s %q: must be a positive duration", DeliveryRetryIntervalKey, v)
+		}
+		policy.retryInterval = d
+	}
+
+	if (policy.deadLetterPubsub == "") != (policy.deadLetterTopic == "") {
+		return policy, fmt.Errorf("%s and %s must be set together", DeadLetterPubsubKey, DeadLetterTopicKey)
+	}
+	if policy.deadLetterBinding != "" && policy.deadLetterPubsub != "" {
+		return policy, fmt.Errorf("only one of %s and %s can be set", DeadLetterBindingKey, DeadLetterPubsubKey)
+	}
+
+	return policy, nil
+}
+
+func (p deliveryPolicy) hasDeadLetter() bool {
+	return p.deadLetterBinding != "" || p.deadLetterPubsub != ""
+}
+
+// withDrainTracking wraps the handler delivering the events of an input
+// binding to the app, so the drain waits for the events being delivered.
+// Events received while draining are rejected.
+func (b *binding) withDrainTracking(handler deliveryHandler) deliveryHandler {
+	return func(ctx context.Context, name string, data []byte, md map[string]string) ([]byte, error) {
+		untrack, ok := b.drainer.Track()
+		if !ok {
+			return nil, messages.ErrDraining
+		}
+		defer untrack()
+		return handler(ctx, name, data, md)
+	}
+}
+
+// withDeliveryPolicy wraps the handler delivering the events of an input
+// binding to the app, so a failed delivery is retried with an exponential
+// backoff and, once the retries are exhausted, the event is sent to the dead
+// letter target. An event sent to the dead letter target is acknowledged to
+// the binding.
+func (b *binding) withDeliveryPolicy(policy deliveryPolicy, handler deliveryHandler) deliveryHandler {
+	if policy.maxRetries == 0 && !policy.hasDeadLetter() {
+		return handler
+	}
+
+	return func(ctx context.Context, name string, data []byte, md map[string]string) ([]byte, error) {
+		bo := &backoff.ExponentialBackOff{
+			InitialInterval:     policy.retryInterval,
+			MaxInterval:         max(policy.retryInterval, maxDeliveryRetryInterval),
+			Multiplier:          deliveryRetryIntervalMultiplier,
+			RandomizationFactor: backoff.DefaultRandomizationFactor,
+			Clock:               backoff.SystemClock,
+		}
+		bo.Reset()
+
+		var (
+			resp     []byte
+			attempts int
+		)
+		err := backoff.Retry(func() error {
+			attempts++
+			var err error
+			resp, err = handler(ctx, name, data, md)
+			if err != nil && attempts <= policy.maxRetries {
+				log.Debugf("Retrying delivery of event from input binding %s after error: %s", name, err)
+			}
+			return err
+		}, backoff.WithContext(backoff.WithMaxRetries(bo, uint64(policy.maxRetries)), ctx)) //nolint:gosec
+		if err == nil || !policy.hasDeadLetter() || ctx.Err() != nil {
+			return resp, err
+		}
+
+		if dlErr := b.sendToDeadLetter(ctx, policy, name, data, md, attempts, err); dlErr != nil {
+			log.Errorf("Failed to send event from input binding %s to dead letter: %s", name, dlErr)
+			return nil, errors.Join(err, dlErr)
+		}
+		log.Warnf("Event from input binding %s sent to dead letter after %d delivery attempts: %s", name, attempts, err)
+		return nil, nil
+	}
+}
+
+// sendToDeadLetter sends an event of an input binding whose delivery failed to
+// the dead letter target of the binding. The event is stamped with the dead
+// letter information, the binding name being the origin topic.
+func (b *binding) sendToDeadLetter(ctx context.Context, policy deliveryPolicy, name string, data []byte, md map[string]string, attempts int, deliveryErr error) error {
+	dl := rtpubsub.DeadLetter{
+		Topic:           name,
+		DeadLetterTopic: policy.deadLetterTopic,
+		Reason:          rtpubsub.DeadLetterReasonError,
+		Attempts:        attempts,
+		Err:             deliveryErr,
+	}
+	if policy.deadLetterBinding != "" {
+		dl.DeadLetterTopic = policy.deadLetterBinding
+	}
+	data, md, err := dl.Stamp(data, md, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if policy.deadLetterBinding != "" {
+		_, err = b.SendToOutputBinding(ctx, policy.deadLetterBinding, &bindings.InvokeRequest{
+			Data:      data,
+			Metadata:  md,
+			Operation: bindings.CreateOperation,
+		})
+		return err
+	}
+
+	ps, ok := b.compStore.GetPubSub(policy.deadLetterPubsub)
+	if !ok {
+		return fmt.Errorf("dead letter pubsub %s not found", policy.deadLetterPubsub)
+	}
+	return ps.Component.Publish(ctx, &contribpubsub.PublishRequest{
+		Data:       data,
+		PubsubName: policy.deadLetterPubsub,
+		Topic:      policy.deadLetterTopic,
+		Metadata:   md,
+	})
+}
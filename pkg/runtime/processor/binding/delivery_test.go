@@ -0,0 +1,190 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/bindings"
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/messages"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/drain"
+	"github.com/dapr/dapr/pkg/runtime/meta"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+func TestDeliveryPolicyFromMetadata(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		policy, err := deliveryPolicyFromMetadata(map[string]string{})
+		require.NoError(t, err)
+		assert.Equal(t, 0, policy.maxRetries)
+		assert.Equal(t, defaultDeliveryRetryInterval, policy.retryInterval)
+		assert.False(t, policy.hasDeadLetter())
+	})
+
+	t.Run("retries and dead letter topic", func(t *testing.T) {
+		policy, err := deliveryPolicyFromMetadata(map[string]string{
+			DeliveryMaxRetriesKey:    "3",
+			DeliveryRetryIntervalKey: "250ms",
+			DeadLetterPubsubKey:      "pubsub",
+			DeadLetterTopicKey:       "failed",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3, policy.maxRetries)
+		assert.Equal(t, "250ms", policy.retryInterval.String())
+		assert.True(t, policy.hasDeadLetter())
+	})
+
+	for name, md := range map[string]map[string]string{
+		"invalid retries":          {DeliveryMaxRetriesKey: "-1"},
+		"invalid interval":         {DeliveryRetryIntervalKey: "soon"},
+		"pubsub without topic":     {DeadLetterPubsubKey: "pubsub"},
+		"binding and pubsub both":  {DeadLetterBindingKey: "out", DeadLetterPubsubKey: "pubsub", DeadLetterTopicKey: "failed"},
+		"topic without the pubsub": {DeadLetterTopicKey: "failed"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := deliveryPolicyFromMetadata(md)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestWithDeliveryPolicy(t *testing.T) {
+	newBinding := func() *binding {
+		return New(Options{
+			Resiliency:     resiliency.New(log),
+			ComponentStore: compstore.New(),
+			Meta:           meta.New(meta.Options{}),
+		})
+	}
+
+	failing := func(failures int, calls *int) deliveryHandler {
+		return func(context.Context, string, []byte, map[string]string) ([]byte, error) {
+			*calls++
+			if *calls <= failures {
+				return nil, errors.New("app error")
+			}
+			return []byte("ok"), nil
+		}
+	}
+
+	t.Run("no policy", func(t *testing.T) {
+		var calls int
+		handler := newBinding().withDeliveryPolicy(deliveryPolicy{}, failing(1, &calls))
+		_, err := handler(t.Context(), "input", nil, nil)
+		require.Error(t, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("retries until delivered", func(t *testing.T) {
+		var calls int
+		handler := newBinding().withDeliveryPolicy(deliveryPolicy{maxRetries: 3, retryInterval: 1}, failing(2, &calls))
+		resp, err := handler(t.Context(), "input", nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("ok"), resp)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("retries exhausted without dead letter", func(t *testing.T) {
+		var calls int
+		handler := newBinding().withDeliveryPolicy(deliveryPolicy{maxRetries: 2, retryInterval: 1}, failing(5, &calls))
+		_, err := handler(t.Context(), "input", nil, nil)
+		require.Error(t, err)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("dead letter binding", func(t *testing.T) {
+		b := newBinding()
+		out := new(daprt.MockBinding)
+		out.On("Invoke", mock.Anything).Return(nil)
+		b.compStore.AddOutputBinding("dlq", out)
+
+		var calls int
+		handler := b.withDeliveryPolicy(deliveryPolicy{maxRetries: 1, retryInterval: 1, deadLetterBinding: "dlq"}, failing(5, &calls))
+		_, err := handler(t.Context(), "input", []byte("event"), map[string]string{"k": "v"})
+		require.NoError(t, err)
+		assert.Equal(t, 2, calls)
+
+		req := out.Calls[0].Arguments.Get(0).(*bindings.InvokeRequest)
+		assert.Equal(t, []byte("event"), req.Data)
+		assert.Equal(t, bindings.CreateOperation, req.Operation)
+		assert.Equal(t, "v", req.Metadata["k"])
+		assert.Equal(t, "input", req.Metadata[rtpubsub.DeadLetterOriginalTopicField])
+		assert.Equal(t, "2", req.Metadata[rtpubsub.DeadLetterAttemptsField])
+		assert.Equal(t, "app error", req.Metadata[rtpubsub.DeadLetterErrorField])
+	})
+
+	t.Run("dead letter topic", func(t *testing.T) {
+		b := newBinding()
+		ps := new(daprt.MockPubSub)
+		ps.On("Publish", mock.Anything).Return(nil)
+		b.compStore.AddPubSub("pubsub", &rtpubsub.PubsubItem{Component: ps})
+
+		var calls int
+		handler := b.withDeliveryPolicy(deliveryPolicy{deadLetterPubsub: "pubsub", deadLetterTopic: "failed"}, failing(5, &calls))
+		_, err := handler(t.Context(), "input", []byte("event"), nil)
+		require.NoError(t, err)
+		assert.Equal(t, 1, calls)
+
+		req := ps.Calls[0].Arguments.Get(0).(*contribpubsub.PublishRequest)
+		assert.Equal(t, "pubsub", req.PubsubName)
+		assert.Equal(t, "failed", req.Topic)
+		assert.Equal(t, []byte("event"), req.Data)
+		assert.Equal(t, string(rtpubsub.DeadLetterReasonError), req.Metadata[rtpubsub.DeadLetterReasonField])
+	})
+
+	t.Run("dead letter fails", func(t *testing.T) {
+		var calls int
+		handler := newBinding().withDeliveryPolicy(deliveryPolicy{deadLetterPubsub: "missing", deadLetterTopic: "failed"}, failing(5, &calls))
+		_, err := handler(t.Context(), "input", []byte("event"), nil)
+		require.Error(t, err)
+	})
+}
+
+func TestWithDrainTracking(t *testing.T) {
+	d := drain.New(drain.Options{})
+	b := New(Options{
+		Resiliency:     resiliency.New(log),
+		ComponentStore: compstore.New(),
+		Meta:           meta.New(meta.Options{}),
+		Drainer:        d,
+	})
+
+	var calls int
+	handler := b.withDrainTracking(func(context.Context, string, []byte, map[string]string) ([]byte, error) {
+		calls++
+		assert.Equal(t, int64(1), d.Status().InFlight)
+		return []byte("ok"), nil
+	})
+
+	resp, err := handler(t.Context(), "input", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("ok"), resp)
+	assert.Equal(t, int64(0), d.Status().InFlight)
+
+	require.True(t, d.Drain(time.Minute))
+	_, err = handler(t.Context(), "input", nil, nil)
+	require.ErrorIs(t, err, messages.ErrDraining)
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package input
+
+import (
+	"context"
+	"sync"
+)
+
+// Gate pauses and resumes the consumption of an input binding. Events
+// received while the gate is paused wait for it to be resumed, so the
+// binding stops consuming from its source without being unloaded.
+type Gate struct {
+	lock    sync.Mutex
+	resumed chan struct{}
+}
+
+// NewGate returns a new gate, which is resumed.
+func NewGate() *Gate {
+	resumed := make(chan struct{})
+	close(resumed)
+	return &Gate{resumed: resumed}
+}
+
+// Pause pauses the gate. It returns false if the gate was already paused.
+func (g *Gate) Pause() bool {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	select {
+	case <-g.resumed:
+		g.resumed = make(chan struct{})
+		return true
+	default:
+		return false
+	}
+}
+
+// Resume resumes the gate. It returns false if the gate wasn't paused.
+func (g *Gate) Resume() bool {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	select {
+	case <-g.resumed:
+		return false
+	default:
+		close(g.resumed)
+		return true
+	}
+}
+
+// Paused returns true if the gate is paused.
+func (g *Gate) Paused() bool {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	select {
+	case <-g.resumed:
+		return false
+	default:
+		return true
+	}
+}
+
+// Wait blocks until the gate is resumed or the context is done.
+func (g *Gate) Wait(ctx context.Context) error {
+	g.lock.Lock()
+	resumed := g.resumed
+	g.lock.Unlock()
+
+	select {
+	case <-resumed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package input
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGate(t *testing.T) {
+	g := NewGate()
+	assert.False(t, g.Paused())
+	require.NoError(t, g.Wait(t.Context()))
+
+	assert.True(t, g.Pause())
+	assert.False(t, g.Pause())
+	assert.True(t, g.Paused())
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, g.Wait(ctx), context.DeadlineExceeded)
+
+	waited := make(chan error, 1)
+	go func() { waited <- g.Wait(t.Context()) }()
+
+	assert.True(t, g.Resume())
+	assert.False(t, g.Resume())
+	select {
+	case err := <-waited:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("gate wasn't resumed")
+	}
+}
+
+func TestAdmit(t *testing.T) {
+	t.Run("max in flight", func(t *testing.T) {
+		stopCtx, stop := context.WithCancel(t.Context())
+		defer stop()
+		i := &Input{name: "input", slots: make(chan struct{}, 1), stopCtx: stopCtx}
+
+		release, err := i.admit(t.Context())
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+		defer cancel()
+		_, err = i.admit(ctx)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		release()
+		release, err = i.admit(t.Context())
+		require.NoError(t, err)
+		release()
+	})
+
+	t.Run("stop releases paused events", func(t *testing.T) {
+		stopCtx, stop := context.WithCancel(t.Context())
+		gate := NewGate()
+		gate.Pause()
+		i := &Input{name: "input", gate: gate, stopCtx: stopCtx}
+
+		admitted := make(chan error, 1)
+		go func() {
+			_, err := i.admit(t.Context())
+			admitted <- err
+		}()
+
+		stop()
+		select {
+		case err := <-admitted:
+			require.Error(t, err)
+		case <-time.After(time.Second):
+			t.Fatal("event wasn't released")
+		}
+	})
+}
@@ -16,10 +16,13 @@ package input
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"golang.org/x/time/rate"
+
 	"github.com/dapr/components-contrib/bindings"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/kit/logger"
@@ -31,6 +34,15 @@ type Options struct {
 	Name    string
 	Binding bindings.InputBinding
 	Handler func(context.Context, string, []byte, map[string]string) ([]byte, error)
+
+	// MaxInFlight is the maximum number of events handled concurrently. Zero
+	// means no limit.
+	MaxInFlight int
+	// RateLimit is the maximum number of events handled per second. Zero means
+	// no limit.
+	RateLimit float64
+	// Gate pauses the consumption of the binding, if set.
+	Gate *Gate
 }
 
 type Input struct {
@@ -38,7 +50,13 @@ type Input struct {
 	binding bindings.InputBinding
 	handler func(context.Context, string, []byte, map[string]string) ([]byte, error)
 
+	slots   chan struct{}
+	limiter *rate.Limiter
+	gate    *Gate
+
 	cancel   func()
+	stopCtx  context.Context
+	stop     func()
 	closed   atomic.Bool
 	wg       sync.WaitGroup
 	inflight atomic.Int64
@@ -46,12 +64,22 @@ type Input struct {
 
 func Run(opts Options) (*Input, error) {
 	ctx, cancel := context.WithCancel(context.Background())
+	stopCtx, stop := context.WithCancel(context.Background())
 
 	i := &Input{
 		name:    opts.Name,
 		binding: opts.Binding,
 		handler: opts.Handler,
+		gate:    opts.Gate,
 		cancel:  cancel,
+		stopCtx: stopCtx,
+		stop:    stop,
+	}
+	if opts.MaxInFlight > 0 {
+		i.slots = make(chan struct{}, opts.MaxInFlight)
+	}
+	if opts.RateLimit > 0 {
+		i.limiter = rate.NewLimiter(rate.Limit(opts.RateLimit), 1)
 	}
 
 	return i, i.read(ctx)
@@ -59,6 +87,8 @@ func Run(opts Options) (*Input, error) {
 
 func (i *Input) Stop() {
 	i.closed.Store(true)
+	// Release the events waiting for the binding to be resumed or for a slot.
+	i.stop()
 	inflight := i.inflight.Load() > 0
 
 	i.wg.Wait()
@@ -89,6 +119,12 @@ func (i *Input) read(ctx context.Context) error {
 			return nil, nil
 		}
 
+		release, err := i.admit(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+
 		start := time.Now()
 		b, err := i.handler(ctx, i.name, resp.Data, resp.Metadata)
 		elapsed := diag.ElapsedSince(start)
@@ -103,3 +139,35 @@ func (i *Input) read(ctx context.Context) error {
 		return b, nil
 	})
 }
+
+// admit waits for the binding to be resumed, for the rate limit and for a slot
+// to handle an event. The returned function releases the slot.
+func (i *Input) admit(ctx context.Context) (func(), error) {
+	if i.gate == nil && i.limiter == nil && i.slots == nil {
+		return func() {}, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	defer context.AfterFunc(i.stopCtx, cancel)()
+
+	if i.gate != nil {
+		if err := i.gate.Wait(ctx); err != nil {
+			return nil, fmt.Errorf("input binding %s is paused: %w", i.name, err)
+		}
+	}
+	if i.limiter != nil {
+		if err := i.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if i.slots == nil {
+		return func() {}, nil
+	}
+	select {
+	case i.slots <- struct{}{}:
+		return func() { <-i.slots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/dapr/components-contrib/bindings"
+	"github.com/dapr/components-contrib/contenttype"
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	bindingrouteapi "github.com/dapr/dapr/pkg/apis/bindingRoute/v1alpha1"
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/expr"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/kit/ptr"
+)
+
+// route forwards the events of an input binding to a pubsub topic or an output
+// binding, as declared by a binding route.
+type route struct {
+	name        string
+	destination bindingrouteapi.BindingRouteDestination
+	transform   *expr.Expr
+
+	// resource is the binding route the route was created from.
+	resource bindingrouteapi.BindingRoute
+}
+
+func newRoute(r bindingrouteapi.BindingRoute) (*route, error) {
+	if r.Spec.Source == "" {
+		return nil, fmt.Errorf("binding route %s has no source binding", r.Name)
+	}
+
+	dst := r.Spec.Destination
+	toPubsub := dst.PubsubName != "" || dst.Topic != ""
+	switch {
+	case toPubsub && dst.Binding != "":
+		return nil, fmt.Errorf("binding route %s must have either a pubsub or a binding destination, not both", r.Name)
+	case toPubsub && (dst.PubsubName == "" || dst.Topic == ""):
+		return nil, fmt.Errorf("binding route %s must have both the pubsub name and the topic of its destination", r.Name)
+	case !toPubsub && dst.Binding == "":
+		return nil, fmt.Errorf("binding route %s has no destination", r.Name)
+	case dst.Binding == r.Spec.Source:
+		return nil, fmt.Errorf("binding route %s routes binding %s to itself", r.Name, dst.Binding)
+	}
+	if dst.Binding != "" && dst.Operation == "" {
+		dst.Operation = string(bindings.CreateOperation)
+	}
+
+	rt := &route{
+		name:        r.Name,
+		destination: dst,
+		resource:    r,
+	}
+	if r.Spec.Transform != "" {
+		rt.transform = new(expr.Expr)
+		if err := rt.transform.DecodeString(r.Spec.Transform); err != nil {
+			return nil, fmt.Errorf("invalid transform of binding route %s: %w", r.Name, err)
+		}
+	}
+	return rt, nil
+}
+
+// apply returns the data of an event transformed by the route.
+func (r *route) apply(data []byte, md map[string]string) ([]byte, error) {
+	if r.transform == nil {
+		return data, nil
+	}
+
+	var decoded any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		decoded = string(data)
+	}
+	metadata := make(map[string]any, len(md))
+	for k, v := range md {
+		metadata[k] = v
+	}
+
+	return r.transform.EvalJSON(map[string]any{
+		"data":     decoded,
+		"metadata": metadata,
+	})
+}
+
+// AddRoute adds a binding route, so the events of its source input binding
+// are forwarded by the sidecar instead of being sent to the app. An input
+// binding has at most one route. A route with the same name is replaced.
+func (b *binding) AddRoute(r bindingrouteapi.BindingRoute) error {
+	rt, err := newRoute(r)
+	if err != nil {
+		return err
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	source := r.Spec.Source
+	if existing, ok := b.routes[source]; ok && existing.name != rt.name {
+		return fmt.Errorf("input binding %s is already routed by binding route %s", source, existing.name)
+	}
+
+	// The route may have been moved from another input binding.
+	if old, ok := b.routeSource(r.Name); ok && old != source {
+		delete(b.routes, old)
+		if err = b.restartInputBinding(old); err != nil {
+			return err
+		}
+	}
+
+	b.routes[source] = rt
+
+	// Restart the input binding if it's already read, so its events go through
+	// the route.
+	return b.restartInputBinding(source)
+}
+
+// RemoveRoute removes a binding route, so the events of its source input
+// binding are sent to the app again.
+func (b *binding) RemoveRoute(name string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	source, ok := b.routeSource(name)
+	if !ok {
+		return nil
+	}
+	delete(b.routes, source)
+
+	return b.restartInputBinding(source)
+}
+
+// Routes returns the binding routes which were added.
+func (b *binding) Routes() []bindingrouteapi.BindingRoute {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	routes := make([]bindingrouteapi.BindingRoute, 0, len(b.routes))
+	for _, rt := range b.routes {
+		routes = append(routes, rt.resource)
+	}
+	return routes
+}
+
+// routeSource returns the source input binding of the route with the name.
+// The lock must be held.
+func (b *binding) routeSource(name string) (string, bool) {
+	for source, rt := range b.routes {
+		if rt.name == name {
+			return source, true
+		}
+	}
+	return "", false
+}
+
+// restartInputBinding restarts an input binding if the input bindings are
+// read, so its events are delivered following its current route. Without an
+// app, an input binding without a route is stopped. The lock must be held.
+func (b *binding) restartInputBinding(source string) error {
+	inbinding, ok := b.compStore.GetInputBinding(source)
+	if !ok || !b.readingBindings {
+		return nil
+	}
+
+	if input := b.activeInputs[source]; input != nil {
+		input.Stop()
+		delete(b.activeInputs, source)
+	}
+
+	if b.routes[source] == nil && b.channels.AppChannelFor(config.AppCallbackBindings) == nil {
+		return nil
+	}
+
+	comp, _ := b.compStore.GetComponent(source)
+	return b.startInputBinding(comp, inbinding)
+}
+
+// forwardBindingEvent returns the handler forwarding the events of an input
+// binding to the destination of its route.
+func (b *binding) forwardBindingEvent(rt *route) deliveryHandler {
+	return func(ctx context.Context, name string, data []byte, md map[string]string) ([]byte, error) {
+		data, err := rt.apply(data, md)
+		if err != nil {
+			return nil, fmt.Errorf("failed to transform event with binding route %s: %w", rt.name, err)
+		}
+
+		if rt.destination.Binding != "" {
+			_, err = b.SendToOutputBinding(ctx, rt.destination.Binding, &bindings.InvokeRequest{
+				Data:      data,
+				Metadata:  md,
+				Operation: bindings.OperationKind(rt.destination.Operation),
+			})
+			return nil, err
+		}
+
+		if b.pubsubAdapter == nil {
+			return nil, errors.New("pubsub adapter not initialized")
+		}
+		var dataContentType string
+		if json.Valid(data) {
+			dataContentType = invokev1.JSONContentType
+		}
+		envelope, err := rtpubsub.NewCloudEvent(&rtpubsub.CloudEvent{
+			Source:          b.appID,
+			Topic:           rt.destination.Topic,
+			Pubsub:          rt.destination.PubsubName,
+			DataContentType: dataContentType,
+			Data:            data,
+		}, md)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create cloudevent for binding route %s: %w", rt.name, err)
+		}
+		ce, err := json.Marshal(envelope)
+		if err != nil {
+			return nil, err
+		}
+		return nil, b.pubsubAdapter.Publish(ctx, &contribpubsub.PublishRequest{
+			Data:        ce,
+			PubsubName:  rt.destination.PubsubName,
+			Topic:       rt.destination.Topic,
+			Metadata:    md,
+			ContentType: ptr.Of(contenttype.CloudEventContentType),
+		})
+	}
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dapr/components-contrib/bindings"
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	bindingrouteapi "github.com/dapr/dapr/pkg/apis/bindingRoute/v1alpha1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/meta"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+func newBindingRoute(spec bindingrouteapi.BindingRouteSpec) bindingrouteapi.BindingRoute {
+	return bindingrouteapi.BindingRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "route"},
+		Spec:       spec,
+	}
+}
+
+func TestNewRoute(t *testing.T) {
+	t.Run("binding destination defaults to create", func(t *testing.T) {
+		rt, err := newRoute(newBindingRoute(bindingrouteapi.BindingRouteSpec{
+			Source:      "in",
+			Destination: bindingrouteapi.BindingRouteDestination{Binding: "out"},
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, string(bindings.CreateOperation), rt.destination.Operation)
+		assert.Nil(t, rt.transform)
+	})
+
+	for name, spec := range map[string]bindingrouteapi.BindingRouteSpec{
+		"no source":         {Destination: bindingrouteapi.BindingRouteDestination{Binding: "out"}},
+		"no destination":    {Source: "in"},
+		"both destinations": {Source: "in", Destination: bindingrouteapi.BindingRouteDestination{Binding: "out", PubsubName: "ps", Topic: "t"}},
+		"pubsub no topic":   {Source: "in", Destination: bindingrouteapi.BindingRouteDestination{PubsubName: "ps"}},
+		"routed to itself":  {Source: "in", Destination: bindingrouteapi.BindingRouteDestination{Binding: "in"}},
+		"invalid transform": {Source: "in", Transform: "data.", Destination: bindingrouteapi.BindingRouteDestination{Binding: "out"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := newRoute(newBindingRoute(spec))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestRouteApply(t *testing.T) {
+	rt, err := newRoute(newBindingRoute(bindingrouteapi.BindingRouteSpec{
+		Source:      "in",
+		Transform:   `{"id": data.orderId, "source": metadata.source}`,
+		Destination: bindingrouteapi.BindingRouteDestination{Binding: "out"},
+	}))
+	require.NoError(t, err)
+
+	data, err := rt.apply([]byte(`{"orderId":"42","items":3}`), map[string]string{"source": "queue"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"42","source":"queue"}`, string(data))
+}
+
+func TestAddRoute(t *testing.T) {
+	b := New(Options{
+		Resiliency:     resiliency.New(log),
+		ComponentStore: compstore.New(),
+		Meta:           meta.New(meta.Options{}),
+	})
+
+	require.NoError(t, b.AddRoute(newBindingRoute(bindingrouteapi.BindingRouteSpec{
+		Source:      "in",
+		Destination: bindingrouteapi.BindingRouteDestination{Binding: "out"},
+	})))
+
+	other := newBindingRoute(bindingrouteapi.BindingRouteSpec{
+		Source:      "in",
+		Destination: bindingrouteapi.BindingRouteDestination{Binding: "other"},
+	})
+	other.Name = "other"
+	require.Error(t, b.AddRoute(other))
+}
+
+func TestRemoveRoute(t *testing.T) {
+	b := New(Options{
+		Resiliency:     resiliency.New(log),
+		ComponentStore: compstore.New(),
+		Meta:           meta.New(meta.Options{}),
+	})
+
+	sources := func() []string {
+		var s []string
+		for _, r := range b.Routes() {
+			s = append(s, r.Spec.Source)
+		}
+		return s
+	}
+
+	require.NoError(t, b.AddRoute(newBindingRoute(bindingrouteapi.BindingRouteSpec{
+		Source:      "in",
+		Destination: bindingrouteapi.BindingRouteDestination{Binding: "out"},
+	})))
+	assert.Equal(t, []string{"in"}, sources())
+
+	// Updating a route moves it to its new source.
+	require.NoError(t, b.AddRoute(newBindingRoute(bindingrouteapi.BindingRouteSpec{
+		Source:      "in2",
+		Destination: bindingrouteapi.BindingRouteDestination{Binding: "out"},
+	})))
+	assert.Equal(t, []string{"in2"}, sources())
+
+	require.NoError(t, b.RemoveRoute("route"))
+	assert.Empty(t, b.Routes())
+	require.NoError(t, b.RemoveRoute("route"))
+}
+
+func TestForwardBindingEvent(t *testing.T) {
+	t.Run("to output binding", func(t *testing.T) {
+		b := New(Options{
+			Resiliency:     resiliency.New(log),
+			ComponentStore: compstore.New(),
+			Meta:           meta.New(meta.Options{}),
+		})
+		out := new(daprt.MockBinding)
+		out.On("Invoke", mock.Anything).Return(nil)
+		b.compStore.AddOutputBinding("out", out)
+
+		rt, err := newRoute(newBindingRoute(bindingrouteapi.BindingRouteSpec{
+			Source:      "in",
+			Destination: bindingrouteapi.BindingRouteDestination{Binding: "out"},
+		}))
+		require.NoError(t, err)
+
+		_, err = b.forwardBindingEvent(rt)(t.Context(), "in", []byte("event"), map[string]string{"k": "v"})
+		require.NoError(t, err)
+
+		req := out.Calls[0].Arguments.Get(0).(*bindings.InvokeRequest)
+		assert.Equal(t, []byte("event"), req.Data)
+		assert.Equal(t, "v", req.Metadata["k"])
+		assert.Equal(t, bindings.CreateOperation, req.Operation)
+	})
+
+	t.Run("to pubsub topic", func(t *testing.T) {
+		var got *contribpubsub.PublishRequest
+		b := New(Options{
+			AppID:          "app",
+			Resiliency:     resiliency.New(log),
+			ComponentStore: compstore.New(),
+			Meta:           meta.New(meta.Options{}),
+			PubsubAdapter: &daprt.MockPubSubAdapter{
+				PublishFn: func(_ context.Context, req *contribpubsub.PublishRequest) error {
+					got = req
+					return nil
+				},
+			},
+		})
+
+		rt, err := newRoute(newBindingRoute(bindingrouteapi.BindingRouteSpec{
+			Source:      "in",
+			Transform:   `{"id": data.orderId}`,
+			Destination: bindingrouteapi.BindingRouteDestination{PubsubName: "ps", Topic: "orders"},
+		}))
+		require.NoError(t, err)
+
+		_, err = b.forwardBindingEvent(rt)(t.Context(), "in", []byte(`{"orderId":"42"}`), nil)
+		require.NoError(t, err)
+
+		require.NotNil(t, got)
+		assert.Equal(t, "ps", got.PubsubName)
+		assert.Equal(t, "orders", got.Topic)
+		var ce map[string]any
+		require.NoError(t, json.Unmarshal(got.Data, &ce))
+		assert.Equal(t, "app", ce[contribpubsub.SourceField])
+		assert.Equal(t, "orders", ce[contribpubsub.TopicField])
+		assert.Equal(t, map[string]any{"id": "42"}, ce[contribpubsub.DataField])
+	})
+}
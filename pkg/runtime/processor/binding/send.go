@@ -33,6 +33,7 @@ import (
 	componentsV1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	"github.com/dapr/dapr/pkg/components"
 	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	diagConsts "github.com/dapr/dapr/pkg/diagnostics/consts"
 	diagUtils "github.com/dapr/dapr/pkg/diagnostics/utils"
@@ -52,9 +53,8 @@ func (b *binding) StartReadingFromBindings(ctx context.Context) error {
 
 	b.readingBindings = true
 
-	if b.channels.AppChannel() == nil {
-		return errors.New("app channel not initialized")
-	}
+	// Without an app, only the input bindings with a binding route are read.
+	hasApp := b.channels.AppChannelFor(config.AppCallbackBindings) != nil
 
 	// Clean any previous state
 	var wg sync.WaitGroup
@@ -77,6 +77,9 @@ func (b *binding) StartReadingFromBindings(ctx context.Context) error {
 	}
 
 	for name, bind := range b.compStore.ListInputBindings() {
+		if !hasApp && b.routes[name] == nil {
+			continue
+		}
 		if err := b.startInputBinding(bindings[name], bind); err != nil {
 			return err
 		}
@@ -95,7 +98,8 @@ func (b *binding) startInputBinding(comp componentsV1alpha1.Component, binding b
 
 	m := meta.Properties
 
-	if isBindingOfExplicitDirection(ComponentTypeInput, m) {
+	rt := b.routes[comp.Name]
+	if rt != nil || isBindingOfExplicitDirection(ComponentTypeInput, m) {
 		isSubscribed = true
 	} else {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
@@ -111,10 +115,31 @@ func (b *binding) startInputBinding(comp componentsV1alpha1.Component, binding b
 		return nil
 	}
 
+	policy, err := deliveryPolicyFromMetadata(m)
+	if err != nil {
+		log.Errorf("error reading delivery policy of input binding %s: %s", comp.Name, err)
+		return err
+	}
+
+	limits, err := readLimitsFromMetadata(m)
+	if err != nil {
+		log.Errorf("error reading limits of input binding %s: %s", comp.Name, err)
+		return err
+	}
+
+	handler := b.sendBindingEventToApp
+	if rt != nil {
+		log.Infof("events of input binding %s are routed by binding route %s", comp.Name, rt.name)
+		handler = b.forwardBindingEvent(rt)
+	}
+
 	input, err := input.Run(input.Options{
-		Name:    comp.Name,
-		Binding: binding,
-		Handler: b.sendBindingEventToApp,
+		Name:        comp.Name,
+		Binding:     binding,
+		Handler:     b.withDrainTracking(b.withDeliveryPolicy(policy, handler)),
+		MaxInFlight: limits.maxInFlight,
+		RateLimit:   limits.rateLimit,
+		Gate:        b.gateFor(comp.Name),
 	})
 	if err != nil {
 		log.Errorf("error reading from input binding %s: %s", comp.Name, err)
@@ -188,12 +213,20 @@ func (b *binding) SendToOutputBinding(ctx context.Context, name string, req *bin
 		ops := binding.Operations()
 		for _, o := range ops {
 			if o == req.Operation {
+				ctx, span := diag.StartOutputBindingSpan(ctx, name, string(req.Operation), b.tracingSpec)
 				policyRunner := resiliency.NewRunner[*bindings.InvokeResponse](ctx,
 					b.resiliency.ComponentOutboundPolicy(name, resiliency.Binding),
 				)
-				return policyRunner(func(ctx context.Context) (*bindings.InvokeResponse, error) {
+				resp, err := policyRunner(func(ctx context.Context) (*bindings.InvokeResponse, error) {
 					return binding.Invoke(ctx, req)
 				})
+				if span != nil {
+					m := diag.ConstructOutputBindingSpanAttributes(name, b.componentType(name), string(req.Operation))
+					diag.AddAttributesToSpan(span, m)
+					diag.UpdateSpanStatusFromGRPCError(span, err)
+					span.End()
+				}
+				return resp, err
 			}
 		}
 		supported := make([]string, 0, len(ops))
@@ -246,7 +279,6 @@ func (b *binding) onAppResponse(ctx context.Context, response *bindings.AppRespo
 
 func (b *binding) sendBindingEventToApp(ctx context.Context, bindingName string, data []byte, metadata map[string]string) ([]byte, error) {
 	var response bindings.AppResponse
-	spanName := "bindings/" + bindingName
 	spanContext := trace.SpanContext{}
 
 	// Check the grpc-trace-bin with fallback to traceparent.
@@ -267,7 +299,7 @@ func (b *binding) sendBindingEventToApp(ctx context.Context, bindingName string,
 		}
 	}
 	// span is nil if tracing is disabled (sampling rate is 0)
-	ctx, span := diag.StartInternalCallbackSpan(ctx, spanName, spanContext, b.tracingSpec)
+	ctx, span := diag.StartInputBindingSpan(ctx, bindingName, spanContext, b.tracingSpec)
 
 	var appResponseBody []byte
 	path, _ := b.compStore.GetInputBindingRoute(bindingName)
@@ -317,6 +349,7 @@ func (b *binding) sendBindingEventToApp(ctx context.Context, bindingName string,
 		if span != nil {
 			m := diag.ConstructInputBindingSpanAttributes(
 				bindingName,
+				b.componentType(bindingName),
 				"/dapr.proto.runtime.v1.AppCallback/OnBindingEvent")
 			diag.AddAttributesToSpan(span, m)
 			diag.UpdateSpanStatusFromGRPCError(span, err)
@@ -378,7 +411,7 @@ func (b *binding) sendBindingEventToApp(ctx context.Context, bindingName string,
 			},
 		)
 		resp, err := policyRunner(func(ctx context.Context) (*invokev1.InvokeMethodResponse, error) {
-			rResp, rErr := b.channels.AppChannel().InvokeMethod(ctx, req, "")
+			rResp, rErr := b.channels.AppChannelFor(config.AppCallbackBindings).InvokeMethod(ctx, req, "")
 			if rErr != nil {
 				return rResp, rErr
 			}
@@ -399,6 +432,7 @@ func (b *binding) sendBindingEventToApp(ctx context.Context, bindingName string,
 		if span != nil {
 			m := diag.ConstructInputBindingSpanAttributes(
 				bindingName,
+				b.componentType(bindingName),
 				http.MethodPost+" /"+bindingName,
 			)
 			diag.AddAttributesToSpan(span, m)
@@ -465,7 +499,7 @@ func (b *binding) isAppSubscribedToBinding(ctx context.Context, binding string)
 			WithContentType(invokev1.JSONContentType)
 		defer req.Close()
 
-		resp, err := b.channels.AppChannel().InvokeMethod(ctx, req, "")
+		resp, err := b.channels.AppChannelFor(config.AppCallbackBindings).InvokeMethod(ctx, req, "")
 		if err != nil {
 			return false, fmt.Errorf("could not invoke OPTIONS method on input binding subscription endpoint %q: %v", path, err)
 		}
@@ -491,3 +525,12 @@ func isBindingOfExplicitDirection(direction string, metadata map[string]string)
 
 	return false
 }
+
+// componentType returns the component type of the binding with the given name, used in span attributes.
+func (b *binding) componentType(name string) string {
+	comp, ok := b.compStore.GetComponent(name)
+	if !ok {
+		return ""
+	}
+	return comp.Spec.Type
+}
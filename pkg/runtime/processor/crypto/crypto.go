@@ -22,10 +22,14 @@ import (
 	compcrypto "github.com/dapr/dapr/pkg/components/crypto"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/datakeycache"
 	rterrors "github.com/dapr/dapr/pkg/runtime/errors"
 	"github.com/dapr/dapr/pkg/runtime/meta"
+	"github.com/dapr/kit/logger"
 )
 
+var log = logger.NewLogger("dapr.runtime.processor.crypto")
+
 type Options struct {
 	Registry       *compcrypto.Registry
 	ComponentStore *compstore.ComponentStore
@@ -70,7 +74,22 @@ func (c *crypto) Init(ctx context.Context, comp compapi.Component) error {
 		return rterrors.NewInit(rterrors.InitComponentFailure, fName, err)
 	}
 
+	cache, err := datakeycache.New(datakeycache.Options{
+		ProviderName: comp.ObjectMeta.Name,
+		Metadata:     meta.Properties,
+	})
+	if err != nil {
+		diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "init", comp.ObjectMeta.Name)
+		return rterrors.NewInit(rterrors.InitComponentFailure, fName, err)
+	}
+
 	c.compStore.AddCryptoProvider(comp.ObjectMeta.Name, component)
+	if cache != nil {
+		log.Infof("Data key cache enabled for crypto provider %s", comp.ObjectMeta.Name)
+		c.compStore.AddCryptoDataKeyCache(comp.ObjectMeta.Name, cache)
+	} else {
+		c.compStore.DeleteCryptoDataKeyCache(comp.ObjectMeta.Name)
+	}
 	diag.DefaultMonitoring.ComponentInitialized(comp.Spec.Type)
 	return nil
 }
@@ -79,6 +98,7 @@ func (c *crypto) Close(comp compapi.Component) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 	defer c.compStore.DeleteCryptoProvider(comp.ObjectMeta.Name)
+	defer c.compStore.DeleteCryptoDataKeyCache(comp.ObjectMeta.Name)
 
 	crypto, ok := c.compStore.GetCryptoProvider(comp.ObjectMeta.Name)
 	if !ok {
@@ -20,6 +20,7 @@ import (
 	"github.com/dapr/durabletask-go/backend"
 
 	"github.com/dapr/components-contrib/bindings"
+	bindingrouteapi "github.com/dapr/dapr/pkg/apis/bindingRoute/v1alpha1"
 	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
 	"github.com/dapr/dapr/pkg/runtime/meta"
@@ -34,11 +35,13 @@ type manager interface {
 
 type StateManager interface {
 	ActorStateStoreName() (string, bool)
+	ActorReminderStoreName() (string, bool)
 	manager
 }
 
 type SecretManager interface {
 	ProcessResource(context.Context, meta.Resource) (bool, string)
+	ResolveURIs(ctx context.Context, v any, secretStore string) (any, error)
 	manager
 }
 
@@ -59,6 +62,11 @@ type BindingManager interface {
 
 	StartReadingFromBindings(context.Context) error
 	StopReadingFromBindings(forever bool)
+	AddRoute(bindingrouteapi.BindingRoute) error
+	RemoveRoute(name string) error
+	Routes() []bindingrouteapi.BindingRoute
+	PauseInputBinding(name string) (bool, error)
+	ResumeInputBinding(name string) (bool, error)
 	manager
 }
 
@@ -33,6 +33,7 @@ import (
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/channels"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/drain"
 	"github.com/dapr/dapr/pkg/runtime/meta"
 	"github.com/dapr/dapr/pkg/runtime/processor/binding"
 	"github.com/dapr/dapr/pkg/runtime/processor/configuration"
@@ -107,8 +108,15 @@ type Options struct {
 	Adapter         rtpubsub.Adapter
 	AdapterStreamer rtpubsub.AdapterStreamer
 
+	// AppMaxConcurrency is the maximum number of concurrent requests to the
+	// app. Zero means no limit.
+	AppMaxConcurrency int
+
 	// Reporter is the reporter for the operator.
 	Reporter registry.Reporter
+
+	// Drainer tracks the deliveries to the app while the runtime drains.
+	Drainer *drain.Drainer
 }
 
 // Processor manages the lifecycle of all components categories.
@@ -138,20 +146,25 @@ type Processor struct {
 }
 
 func New(opts Options) *Processor {
+	subscriberIsHTTP, subscriberGRPC := appEndpoint(opts, config.AppCallbackPubSub)
 	subscriber := subscriber.New(subscriber.Options{
 		AppID:           opts.ID,
 		Namespace:       opts.Namespace,
 		Resiliency:      opts.Resiliency,
 		TracingSpec:     opts.GlobalConfig.Spec.TracingSpec,
-		IsHTTP:          opts.IsHTTP,
+		IsHTTP:          subscriberIsHTTP,
 		Channels:        opts.Channels,
-		GRPC:            opts.GRPC,
+		GRPC:            subscriberGRPC,
 		CompStore:       opts.ComponentStore,
 		Adapter:         opts.Adapter,
 		AdapterStreamer: opts.AdapterStreamer,
+		MaxConcurrency:  opts.AppMaxConcurrency,
+		Drainer:         opts.Drainer,
 	})
 
 	state := state.New(state.Options{
+		ID:             opts.ID,
+		Namespace:      opts.Namespace,
 		ActorsEnabled:  opts.ActorsEnabled,
 		Registry:       opts.Registry.StateStores(),
 		ComponentStore: opts.ComponentStore,
@@ -166,15 +179,19 @@ func New(opts Options) *Processor {
 		OperatorClient: opts.OperatorClient,
 	})
 
+	bindingIsHTTP, bindingGRPC := appEndpoint(opts, config.AppCallbackBindings)
 	binding := binding.New(binding.Options{
+		AppID:          opts.ID,
 		Registry:       opts.Registry.Bindings(),
 		ComponentStore: opts.ComponentStore,
 		Meta:           opts.Meta,
-		IsHTTP:         opts.IsHTTP,
+		IsHTTP:         bindingIsHTTP,
 		Resiliency:     opts.Resiliency,
-		GRPC:           opts.GRPC,
+		GRPC:           bindingGRPC,
 		TracingSpec:    opts.GlobalConfig.Spec.TracingSpec,
 		Channels:       opts.Channels,
+		PubsubAdapter:  opts.Adapter,
+		Drainer:        opts.Drainer,
 	})
 
 	// ensure a default no-op reporter
@@ -237,6 +254,19 @@ func New(opts Options) *Processor {
 	}
 }
 
+// appEndpoint returns the protocol and gRPC manager used to send the callback
+// to the app, which can be routed to another app endpoint than the app port.
+func appEndpoint(opts Options, cb config.AppCallback) (bool, *grpcmanager.Manager) {
+	if opts.Channels == nil {
+		return opts.IsHTTP, opts.GRPC
+	}
+	p, grpc, ok := opts.Channels.RoutedAppEndpoint(cb)
+	if !ok {
+		return opts.IsHTTP, opts.GRPC
+	}
+	return p.IsHTTP(), grpc
+}
+
 func (p *Processor) Process(ctx context.Context) error {
 	if !p.running.CompareAndSwap(false, true) {
 		return errors.New("processor is already running")
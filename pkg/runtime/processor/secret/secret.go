@@ -17,6 +17,7 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"os"
 	"strings"
 	"sync"
@@ -29,6 +30,7 @@ import (
 	"github.com/dapr/dapr/pkg/runtime/compstore"
 	rterrors "github.com/dapr/dapr/pkg/runtime/errors"
 	"github.com/dapr/dapr/pkg/runtime/meta"
+	"github.com/dapr/dapr/pkg/runtime/secretcache"
 	"github.com/dapr/dapr/pkg/security/consts"
 	"github.com/dapr/kit/logger"
 )
@@ -82,7 +84,22 @@ func (s *secret) Init(ctx context.Context, comp compapi.Component) error {
 		return rterrors.NewInit(rterrors.InitComponentFailure, fName, err)
 	}
 
+	cache, err := secretcache.New(secretcache.Options{
+		StoreName: comp.ObjectMeta.Name,
+		Metadata:  meta.Properties,
+	})
+	if err != nil {
+		diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "init", comp.ObjectMeta.Name)
+		return rterrors.NewInit(rterrors.InitComponentFailure, fName, err)
+	}
+
 	s.compStore.AddSecretStore(comp.ObjectMeta.Name, secretStore)
+	if cache != nil {
+		log.Infof("Secret cache enabled for secret store %s", comp.ObjectMeta.Name)
+		s.compStore.AddSecretCache(comp.ObjectMeta.Name, cache)
+	} else {
+		s.compStore.DeleteSecretCache(comp.ObjectMeta.Name)
+	}
 	diag.DefaultMonitoring.ComponentInitialized(comp.Spec.Type)
 
 	return nil
@@ -98,6 +115,8 @@ func (s *secret) Close(comp compapi.Component) error {
 	}
 
 	defer s.compStore.DeleteSecretStore(comp.Name)
+	defer s.compStore.DeleteSecretCache(comp.Name)
+	s.compStore.GetSecretCache(comp.Name).Close()
 
 	if err := sec.Close(); err != nil {
 		return err
@@ -112,6 +131,7 @@ func (s *secret) ProcessResource(ctx context.Context, resource meta.Resource) (u
 	cache := map[string]secretstores.GetSecretResponse{}
 
 	secretStoreName = s.meta.AuthSecretStoreOrDefault(resource)
+	uris := s.newURIResolver(secretStoreName, resource.GetNamespace())
 
 	metadata := resource.NameValuePairs()
 	for i, m := range metadata {
@@ -128,6 +148,21 @@ func (s *secret) ProcessResource(ctx context.Context, resource meta.Resource) (u
 		}
 
 		if m.SecretKeyRef.Name == "" {
+			// Values can reference secrets of the secret store with secret URIs
+			val, changed, err := uris.resolveRaw(ctx, m.Value.Raw)
+			var notLoaded *storeNotLoadedError
+			if errors.As(err, &notLoaded) {
+				log.Warnf("%s %s references a secret store that isn't loaded: %s", resource.Kind(), resource.GetName(), notLoaded.store)
+				return updated, notLoaded.store
+			}
+			if err != nil {
+				log.Errorf("Error resolving secret URI of %s %s: %v", resource.Kind(), resource.GetName(), err)
+				continue
+			}
+			if changed {
+				metadata[i].SetValue(val)
+				updated = true
+			}
 			continue
 		}
 
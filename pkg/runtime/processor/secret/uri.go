@@ -0,0 +1,230 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secret
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/dapr/components-contrib/secretstores"
+)
+
+// URIScheme is the scheme of the values which reference a secret, in the form
+// "secret://<store>/<name>[/<key>]". The key defaults to the name of the
+// secret. Segments containing a "/" must be percent-encoded.
+// Like secretKeyRefs, secret URIs can only reference the secret store of the
+// resource, so they don't give access to the secrets of other stores.
+const URIScheme = "secret://"
+
+// URI is the reference of a value in a secret store.
+type URI struct {
+	Store string
+	Name  string
+	Key   string
+}
+
+// ParseURI parses a secret URI. It returns nil if the value isn't a secret
+// URI, and an error if it is a malformed one.
+func ParseURI(v string) (*URI, error) {
+	if !strings.HasPrefix(v, URIScheme) {
+		return nil, nil
+	}
+
+	segments := strings.Split(strings.TrimPrefix(v, URIScheme), "/")
+	if len(segments) < 2 || len(segments) > 3 {
+		return nil, fmt.Errorf("invalid secret URI %q: expected %s<store>/<name>[/<key>]", v, URIScheme)
+	}
+	for i, s := range segments {
+		u, err := url.PathUnescape(s)
+		if err != nil || u == "" {
+			return nil, fmt.Errorf("invalid secret URI %q: empty or malformed segment", v)
+		}
+		segments[i] = u
+	}
+
+	uri := &URI{Store: segments[0], Name: segments[1], Key: segments[1]}
+	if len(segments) == 3 {
+		uri.Key = segments[2]
+	}
+	return uri, nil
+}
+
+// storeNotLoadedError is returned when a secret URI references a secret store
+// which isn't loaded yet.
+type storeNotLoadedError struct {
+	store string
+}
+
+func (e *storeNotLoadedError) Error() string {
+	return fmt.Sprintf("secret store %s isn't loaded", e.store)
+}
+
+// uriResolver resolves secret URIs of the secret store of a resource,
+// reading each secret once.
+type uriResolver struct {
+	secret    *secret
+	store     string
+	namespace string
+	secrets   map[[2]string]map[string]string
+}
+
+func (s *secret) newURIResolver(store, namespace string) *uriResolver {
+	return &uriResolver{
+		secret:    s,
+		store:     store,
+		namespace: namespace,
+		secrets:   make(map[[2]string]map[string]string),
+	}
+}
+
+// resolve returns the value referenced by the secret URI.
+func (r *uriResolver) resolve(ctx context.Context, uri *URI) (string, error) {
+	if uri.Store != r.store {
+		if r.store == "" {
+			return "", fmt.Errorf("secret URIs can't be used without a secret store: secret store %s isn't the secret store of the resource", uri.Store)
+		}
+		return "", fmt.Errorf("secret URIs can only reference the secret store %s of the resource, not %s", r.store, uri.Store)
+	}
+
+	data, ok := r.secrets[[2]string{uri.Store, uri.Name}]
+	if !ok {
+		store, ok := r.secret.compStore.GetSecretStore(uri.Store)
+		if !ok {
+			return "", &storeNotLoadedError{store: uri.Store}
+		}
+
+		req := secretstores.GetSecretRequest{Name: uri.Name}
+		if r.namespace != "" {
+			req.Metadata = map[string]string{"namespace": r.namespace}
+		}
+		res, err := store.GetSecret(ctx, req)
+		if err != nil {
+			return "", fmt.Errorf("failed to get secret %s from secret store %s: %w", uri.Name, uri.Store, err)
+		}
+		data = res.Data
+		r.secrets[[2]string{uri.Store, uri.Name}] = data
+	}
+
+	val, ok := data[uri.Key]
+	if !ok {
+		return "", fmt.Errorf("key %s not found in secret %s of secret store %s", uri.Key, uri.Name, uri.Store)
+	}
+	return val, nil
+}
+
+// resolveValue resolves the secret URIs of a value, including the ones nested
+// in objects and arrays. It returns whether the value changed.
+func (r *uriResolver) resolveValue(ctx context.Context, v any) (any, bool, error) {
+	switch val := v.(type) {
+	case string:
+		uri, err := ParseURI(val)
+		if err != nil || uri == nil {
+			return v, false, err
+		}
+		resolved, err := r.resolve(ctx, uri)
+		if err != nil {
+			return v, false, err
+		}
+		return resolved, true, nil
+	case map[string]any:
+		// The value is copied on write, so the resource isn't changed
+		var out map[string]any
+		for k, item := range val {
+			resolved, changed, err := r.resolveValue(ctx, item)
+			if err != nil {
+				return v, false, err
+			}
+			if changed {
+				if out == nil {
+					out = maps.Clone(val)
+				}
+				out[k] = resolved
+			}
+		}
+		if out == nil {
+			return v, false, nil
+		}
+		return out, true, nil
+	case []any:
+		var out []any
+		for i, item := range val {
+			resolved, changed, err := r.resolveValue(ctx, item)
+			if err != nil {
+				return v, false, err
+			}
+			if changed {
+				if out == nil {
+					out = slices.Clone(val)
+				}
+				out[i] = resolved
+			}
+		}
+		if out == nil {
+			return v, false, nil
+		}
+		return out, true, nil
+	default:
+		return v, false, nil
+	}
+}
+
+// resolveRaw resolves the secret URIs of a raw metadata value. A value which
+// is a secret URI is replaced with the secret, like a secretKeyRef, while the
+// URIs nested in JSON objects and arrays are replaced in the JSON document.
+func (r *uriResolver) resolveRaw(ctx context.Context, raw []byte) ([]byte, bool, error) {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) == 0 || !bytes.Contains(trimmed, []byte(URIScheme)) {
+		return raw, false, nil
+	}
+
+	var v any
+	switch trimmed[0] {
+	case '"', '{', '[':
+		if err := json.Unmarshal(trimmed, &v); err != nil {
+			return raw, false, nil
+		}
+	default:
+		v = string(trimmed)
+	}
+
+	resolved, changed, err := r.resolveValue(ctx, v)
+	if err != nil || !changed {
+		return raw, false, err
+	}
+	if s, ok := resolved.(string); ok {
+		return []byte(s), true, nil
+	}
+	b, err := json.Marshal(resolved)
+	if err != nil {
+		return raw, false, err
+	}
+	return b, true, nil
+}
+
+// ResolveURIs resolves the secret URIs of a value of a Configuration resource,
+// including the ones nested in objects and arrays. They can only reference the
+// given secret store, or the built-in one if it's empty, which must be loaded.
+func (s *secret) ResolveURIs(ctx context.Context, v any, secretStore string) (any, error) {
+	resolved, _, err := s.newURIResolver(s.meta.SecretStoreOrDefault(secretStore), "").resolveValue(ctx, v)
+	if err != nil {
+		return v, fmt.Errorf("failed to resolve secret URI: %w", err)
+	}
+	return resolved, nil
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secret
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonapi "github.com/dapr/dapr/pkg/apis/common"
+	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	"github.com/dapr/dapr/pkg/modes"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/meta"
+	"github.com/dapr/dapr/pkg/runtime/mock"
+	"github.com/dapr/dapr/pkg/runtime/registry"
+)
+
+func TestParseURI(t *testing.T) {
+	tests := []struct {
+		value   string
+		uri     *URI
+		wantErr bool
+	}{
+		{value: "plain"},
+		{value: "secret://store/name", uri: &URI{Store: "store", Name: "name", Key: "name"}},
+		{value: "secret://store/name/key", uri: &URI{Store: "store", Name: "name", Key: "key"}},
+		{value: "secret://store/ns%2Fname/key", uri: &URI{Store: "store", Name: "ns/name", Key: "key"}},
+		{value: "secret://store", wantErr: true},
+		{value: "secret://store//key", wantErr: true},
+		{value: "secret://store/name/key/extra", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			uri, err := ParseURI(tt.value)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.uri, uri)
+		})
+	}
+}
+
+func TestProcessResourceSecretURIs(t *testing.T) {
+	newSecret := func() *secret {
+		compStore := compstore.New()
+		compStore.AddSecretStore("mock", &mock.SecretStore{})
+		return New(Options{
+			Registry:       registry.New(registry.NewOptions()).SecretStores(),
+			ComponentStore: compStore,
+			Meta: meta.New(meta.Options{
+				ID:   "test",
+				Mode: modes.StandaloneMode,
+			}),
+		})
+	}
+	newBinding := func(values ...string) *componentsapi.Component {
+		comp := &componentsapi.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: "mockBinding"},
+			Spec:       componentsapi.ComponentSpec{Type: "bindings.mock", Version: "v1"},
+			Auth:       componentsapi.Auth{SecretStore: "mock"},
+		}
+		for _, v := range values {
+			nvp := commonapi.NameValuePair{Name: "a"}
+			nvp.SetValue([]byte(v))
+			comp.Spec.Metadata = append(comp.Spec.Metadata, nvp)
+		}
+		return comp
+	}
+
+	t.Run("values referencing secrets", func(t *testing.T) {
+		comp := newBinding(`"secret://mock/name1/key1"`, `"secret://mock/name1"`, `"plain"`)
+
+		updated, unready := newSecret().ProcessResource(t.Context(), comp)
+		assert.True(t, updated)
+		assert.Empty(t, unready)
+		assert.Equal(t, "value1", comp.Spec.Metadata[0].Value.String())
+		assert.Equal(t, "value1", comp.Spec.Metadata[1].Value.String())
+		assert.Equal(t, "plain", comp.Spec.Metadata[2].Value.String())
+	})
+
+	t.Run("references nested in JSON values", func(t *testing.T) {
+		comp := newBinding(`{"user":"admin","auth":{"password":"secret://mock/name1/_value"},"hosts":["secret://mock/name1/key1"]}`)
+
+		updated, unready := newSecret().ProcessResource(t.Context(), comp)
+		assert.True(t, updated)
+		assert.Empty(t, unready)
+		assert.JSONEq(t, `{"user":"admin","auth":{"password":"_value_data"},"hosts":["value1"]}`, string(comp.Spec.Metadata[0].Value.Raw))
+	})
+
+	t.Run("secret store not loaded", func(t *testing.T) {
+		comp := newBinding(`"secret://other/name1"`)
+		comp.Auth.SecretStore = "other"
+
+		updated, unready := newSecret().ProcessResource(t.Context(), comp)
+		assert.False(t, updated)
+		assert.Equal(t, "other", unready)
+	})
+
+	t.Run("other secret store", func(t *testing.T) {
+		sec := newSecret()
+		sec.compStore.AddSecretStore("other", &mock.SecretStore{})
+		comp := newBinding(`"secret://other/name1"`)
+
+		updated, unready := sec.ProcessResource(t.Context(), comp)
+		assert.False(t, updated)
+		assert.Empty(t, unready)
+		assert.Equal(t, "secret://other/name1", comp.Spec.Metadata[0].Value.String())
+	})
+
+	t.Run("no secret store", func(t *testing.T) {
+		comp := newBinding(`"secret://mock/name1"`)
+		comp.Auth.SecretStore = ""
+
+		updated, unready := newSecret().ProcessResource(t.Context(), comp)
+		assert.False(t, updated)
+		assert.Empty(t, unready)
+		assert.Equal(t, "secret://mock/name1", comp.Spec.Metadata[0].Value.String())
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		comp := newBinding(`"secret://mock/name1/missing"`)
+
+		updated, unready := newSecret().ProcessResource(t.Context(), comp)
+		assert.False(t, updated)
+		assert.Empty(t, unready)
+		assert.Equal(t, "secret://mock/name1/missing", comp.Spec.Metadata[0].Value.String())
+	})
+}
+
+func TestResolveURIs(t *testing.T) {
+	compStore := compstore.New()
+	compStore.AddSecretStore("mock", &mock.SecretStore{})
+	sec := New(Options{
+		ComponentStore: compStore,
+		Meta:           meta.New(meta.Options{ID: "test", Mode: modes.KubernetesMode}),
+	})
+
+	t.Run("nested references", func(t *testing.T) {
+		cfg := map[string]any{
+			"token": "secret://mock/name1/key1",
+			"nested": map[string]any{
+				"list": []any{"a", "secret://mock/name1/_value"},
+			},
+			"port": float64(8500),
+		}
+
+		resolved, err := sec.ResolveURIs(t.Context(), cfg, "mock")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"token": "value1",
+			"nested": map[string]any{
+				"list": []any{"a", "_value_data"},
+			},
+			"port": float64(8500),
+		}, resolved)
+		// The original value isn't changed
+		assert.Equal(t, "secret://mock/name1/key1", cfg["token"])
+	})
+
+	t.Run("secret store not loaded", func(t *testing.T) {
+		_, err := sec.ResolveURIs(t.Context(), map[string]any{"token": "secret://other/name"}, "other")
+		require.Error(t, err)
+	})
+
+	t.Run("other secret store", func(t *testing.T) {
+		_, err := sec.ResolveURIs(t.Context(), map[string]any{"token": "secret://mock/name1"}, "other")
+		require.ErrorContains(t, err, "can only reference the secret store other")
+	})
+
+	t.Run("built-in secret store by default", func(t *testing.T) {
+		_, err := sec.ResolveURIs(t.Context(), map[string]any{"token": "secret://mock/name1"}, "")
+		require.ErrorContains(t, err, "can only reference the secret store kubernetes")
+	})
+}
@@ -22,23 +22,28 @@ import (
 	contribstate "github.com/dapr/components-contrib/state"
 	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	compstate "github.com/dapr/dapr/pkg/components/state"
+	"github.com/dapr/dapr/pkg/compression"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/encryption"
 	"github.com/dapr/dapr/pkg/outbox"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
 	rterrors "github.com/dapr/dapr/pkg/runtime/errors"
 	"github.com/dapr/dapr/pkg/runtime/meta"
+	"github.com/dapr/dapr/pkg/runtime/statecache"
 	"github.com/dapr/kit/logger"
 	kitstrings "github.com/dapr/kit/strings"
 )
 
 const (
-	PropertyKeyActorStateStore = "actorstatestore"
+	PropertyKeyActorStateStore    = "actorstatestore"
+	PropertyKeyActorReminderStore = "actorreminderstore"
 )
 
 var log = logger.NewLogger("dapr.runtime.processor.state")
 
 type Options struct {
+	ID             string
+	Namespace      string
 	Registry       *compstate.Registry
 	ComponentStore *compstore.ComponentStore
 	Meta           *meta.Meta
@@ -47,18 +52,23 @@ type Options struct {
 }
 
 type state struct {
+	id        string
+	namespace string
 	registry  *compstate.Registry
 	compStore *compstore.ComponentStore
 	meta      *meta.Meta
 	lock      sync.RWMutex
 
-	actorStateStoreName *string
-	actorsEnabled       bool
-	outbox              outbox.Outbox
+	actorStateStoreName    *string
+	actorReminderStoreName *string
+	actorsEnabled          bool
+	outbox                 outbox.Outbox
 }
 
 func New(opts Options) *state {
 	return &state{
+		id:            opts.ID,
+		namespace:     opts.Namespace,
 		registry:      opts.Registry,
 		compStore:     opts.ComponentStore,
 		meta:          opts.Meta,
@@ -99,6 +109,18 @@ func (s *state) Init(ctx context.Context, comp compapi.Component) error {
 		}
 	}
 
+	compOpts, err := compression.ComponentCompressionOptions(comp)
+	if err != nil {
+		diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "creation", comp.ObjectMeta.Name)
+		return rterrors.NewInit(rterrors.CreateComponentFailure, fName, err)
+	}
+
+	if compOpts.Algorithm != "" {
+		if compression.AddCompressedStateStore(comp.ObjectMeta.Name, compOpts) {
+			log.Infof("Automatic %s compression enabled for state store %s, for values of at least %d bytes", compOpts.Algorithm, comp.ObjectMeta.Name, compOpts.Threshold)
+		}
+	}
+
 	meta, err := s.meta.ToBaseMetadata(comp)
 	if err != nil {
 		diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "init", comp.ObjectMeta.Name)
@@ -116,11 +138,13 @@ func (s *state) Init(ctx context.Context, comp compapi.Component) error {
 	if s.actorsEnabled {
 		// set specified actor store if "actorStateStore" is true in the spec.
 		actorStoreSpecified := false
+		actorReminderStoreSpecified := false
 		for k, v := range props {
-			//nolint:gocritic
-			if strings.ToLower(k) == PropertyKeyActorStateStore {
+			switch strings.ToLower(k) {
+			case PropertyKeyActorStateStore:
 				actorStoreSpecified = kitstrings.IsTruthy(v)
-				break
+			case PropertyKeyActorReminderStore:
+				actorReminderStoreSpecified = kitstrings.IsTruthy(v)
 			}
 		}
 
@@ -133,6 +157,17 @@ func (s *state) Init(ctx context.Context, comp compapi.Component) error {
 			}
 			s.compStore.AddStateStoreActor(comp.ObjectMeta.Name, store)
 		}
+
+		// set specified actor reminder store if "actorReminderStore" is true in
+		// the spec.
+		if actorReminderStoreSpecified {
+			if s.actorReminderStoreName == nil {
+				log.Info("Using '" + comp.ObjectMeta.Name + "' as actor reminder store")
+				s.actorReminderStoreName = &comp.ObjectMeta.Name
+			} else if *s.actorReminderStoreName != comp.ObjectMeta.Name {
+				return fmt.Errorf("detected duplicate actor reminder store: %s and %s", *s.actorReminderStoreName, comp.ObjectMeta.Name)
+			}
+		}
 	}
 
 	s.compStore.AddStateStore(comp.ObjectMeta.Name, store)
@@ -143,6 +178,24 @@ func (s *state) Init(ctx context.Context, comp compapi.Component) error {
 		return rterrors.NewInit(rterrors.InitComponentFailure, fName, wrapError)
 	}
 
+	cache, err := statecache.New(statecache.Options{
+		StoreName:   comp.ObjectMeta.Name,
+		AppID:       s.id,
+		Namespace:   s.namespace,
+		Metadata:    props,
+		GetPubSubFn: s.compStore.GetPubSubComponent,
+	})
+	if err != nil {
+		diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "init", comp.ObjectMeta.Name)
+		return rterrors.NewInit(rterrors.InitComponentFailure, fName, err)
+	}
+	if cache != nil {
+		log.Infof("State cache enabled for state store %s", comp.ObjectMeta.Name)
+		s.compStore.AddStateCache(comp.ObjectMeta.Name, cache)
+	} else {
+		s.compStore.DeleteStateCache(comp.ObjectMeta.Name)
+	}
+
 	s.outbox.AddOrUpdateOutbox(comp)
 
 	diag.DefaultMonitoring.ComponentInitialized(comp.Spec.Type)
@@ -160,6 +213,9 @@ func (s *state) Close(comp compapi.Component) error {
 	}
 
 	defer s.compStore.DeleteStateStore(comp.Name)
+	defer s.compStore.DeleteStateCache(comp.Name)
+	defer encryption.RemoveEncryptedStateStore(comp.Name)
+	defer compression.RemoveCompressedStateStore(comp.Name)
 
 	if err := ss.Close(); err != nil {
 		return err
@@ -177,3 +233,13 @@ func (s *state) ActorStateStoreName() (string, bool) {
 	}
 	return *s.actorStateStoreName, true
 }
+
+func (s *state) ActorReminderStoreName() (string, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if s.actorReminderStoreName == nil {
+		return "", false
+	}
+	return *s.actorReminderStoreName, true
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/channels"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/drain"
 	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	"github.com/dapr/dapr/pkg/runtime/subscription"
 	"github.com/dapr/dapr/pkg/runtime/subscription/postman"
@@ -52,6 +53,10 @@ type Options struct {
 	CompStore       *compstore.ComponentStore
 	Adapter         rtpubsub.Adapter
 	AdapterStreamer rtpubsub.AdapterStreamer
+	// MaxConcurrency is the app max concurrency, which bounds the deliveries
+	// to the app shared by all subscriptions.
+	MaxConcurrency int
+	Drainer        *drain.Drainer
 }
 
 type Subscriber struct {
@@ -65,6 +70,8 @@ type Subscriber struct {
 	compStore       *compstore.ComponentStore
 	adapter         rtpubsub.Adapter
 	adapterStreamer rtpubsub.AdapterStreamer
+	scheduler       *subscription.Scheduler
+	drainer         *drain.Drainer
 
 	appSubs      map[string][]*namedSubscription
 	streamSubs   map[string]map[rtpubsub.ConnectionID]*namedSubscription
@@ -96,6 +103,8 @@ func New(opts Options) *Subscriber {
 		compStore:       opts.CompStore,
 		adapter:         opts.Adapter,
 		adapterStreamer: opts.AdapterStreamer,
+		scheduler:       subscription.NewScheduler(opts.MaxConcurrency),
+		drainer:         opts.Drainer,
 		appSubs:         make(map[string][]*namedSubscription),
 		streamSubs:      make(map[string]map[rtpubsub.ConnectionID]*namedSubscription),
 		retryCtx:        make(map[string]context.Context),
@@ -517,7 +526,7 @@ func (s *Subscriber) initProgrammaticSubscriptions(ctx context.Context) error {
 		return nil
 	}
 
-	appChannel := s.channels.AppChannel()
+	appChannel := s.channels.AppChannelFor(config.AppCallbackPubSub)
 	if appChannel == nil {
 		log.Warn("app channel not initialized, make sure -app-port is specified if pubsub subscription is required")
 		return nil
@@ -563,7 +572,11 @@ func (s *Subscriber) startSubscription(pubsub *rtpubsub.PubsubItem, comp *compst
 	// TODO: @joshvanl
 	var postman postman.Interface
 	var streamer rtpubsub.AdapterStreamer
+	// Streamed messages are not delivered through the app channel, so they
+	// don't take app delivery slots.
+	scheduler := s.scheduler
 	if isStreamer {
+		scheduler = nil
 		streamer = s.adapterStreamer
 		postman = streaming.New(streaming.Options{
 			Tracing: s.tracingSpec,
@@ -598,6 +611,9 @@ func (s *Subscriber) startSubscription(pubsub *rtpubsub.PubsubItem, comp *compst
 		AdapterStreamer: streamer,
 		ConnectionID:    comp.ConnectionID,
 		Postman:         postman,
+		GetStateStoreFn: s.compStore.GetStateStore,
+		Scheduler:       scheduler,
+		Drainer:         s.drainer,
 	})
 }
 
@@ -46,7 +46,17 @@ func (p *Processor) AddPendingSubscription(ctx context.Context, subscriptions ..
 				MaxMessagesCount:   comp.Spec.BulkSubscribe.MaxMessagesCount,
 				MaxAwaitDurationMs: comp.Spec.BulkSubscribe.MaxAwaitDurationMs,
 			},
+			Ordering:              rtpubsub.Ordering(comp.Spec.Ordering),
+			MaxConcurrentHandlers: comp.Spec.MaxConcurrentHandlers,
+			Prefetch:              comp.Spec.Prefetch,
+			Priority:              comp.Spec.Priority,
 		}
+		filter, err := rtpubsub.CreateFilter(comp.Spec.Filter)
+		if err != nil {
+			p.errorSubscriptions(ctx, err)
+			return false
+		}
+		sub.Filter = filter
 		for _, rule := range comp.Spec.Routes.Rules {
 			erule, err := rtpubsub.CreateRoutingRule(rule.Match, rule.Path)
 			if err != nil {
@@ -18,6 +18,7 @@ import (
 
 	contribPubsub "github.com/dapr/components-contrib/pubsub"
 	rtv1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/schema"
 )
 
 // PubsubItem is a pubsub component with its scoped subscriptions and
@@ -29,6 +30,7 @@ type PubsubItem struct {
 	AllowedTopics       []string
 	ProtectedTopics     []string
 	NamespaceScoped     bool
+	Schemas             *schema.Validator
 }
 
 // TopicKey uniquely identifies a pubsub+topic combination
@@ -53,7 +53,13 @@ func ApplyBulkPublishResiliency(ctx context.Context, req *contribPubsub.BulkPubl
 			Entries:    newEntries,
 			Metadata:   req.Metadata,
 		}
-		return bulkPublisher.BulkPublish(ctx, newReq)
+		res, err := bulkPublisher.BulkPublish(ctx, newReq)
+		// Some components report failed entries without returning an error.
+		// Surface them as a failure so that only the failed entries are retried.
+		if err == nil && len(res.FailedEntries) > 0 {
+			err = ErrBulkPublishFailure
+		}
+		return res, err
 	})
 	// If final error is timeout, CB open or CB too many requests, return the current request entries as failed
 	if err != nil &&
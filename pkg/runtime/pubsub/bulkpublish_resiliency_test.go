@@ -45,6 +45,7 @@ type mockBulkPublisher struct {
 	applyTimeout      bool
 	timeoutSleep      time.Duration
 	failCount         int
+	failWithoutError  bool
 }
 
 // Pass in failCount to fail the first n times
@@ -104,6 +105,9 @@ func (m *mockBulkPublisher) BulkPublish(ctx context.Context, req *contribPubsub.
 					})
 			}
 		}
+		if m.failWithoutError {
+			return res, nil
+		}
 		return res, assert.AnError
 	}
 	return res, nil
@@ -271,6 +275,65 @@ func TestApplyBulkPublishResiliency(t *testing.T) {
 		}, bulkPublisher.entryIDRetryTimes)
 	})
 
+	t.Run("partial failures without error with retries", func(t *testing.T) {
+		// Setup
+		// fail events with even Entry ID once, reporting them without an error
+		bulkPublisher := NewMockBulkPublisher(t, 1, true, false)
+		bulkPublisher.failWithoutError = true
+
+		// set short retry with 3 retries max
+		shortRetry.MaxRetries = ptr.Of(3)
+
+		// timeout will not be triggered here
+		policyProvider := createResPolicyProvider(resiliencyV1alpha.CircuitBreaker{}, longTimeout, shortRetry)
+		policyDef := policyProvider.ComponentOutboundPolicy(pubsubName, resiliency.Pubsub)
+
+		// Act
+		res, err := ApplyBulkPublishResiliency(ctx, req, policyDef, bulkPublisher)
+
+		// Assert
+		// expecting no final error, the failed events are retried and pass in the second try
+		require.NoError(t, err)
+		assert.Empty(t, res.FailedEntries)
+		assertRetryCount(t, map[string]int{
+			"0": 2,
+			"2": 2,
+			"4": 2,
+			"1": 1,
+			"3": 1,
+			"5": 1,
+		}, bulkPublisher.entryIDRetryTimes)
+	})
+
+	t.Run("partial failures without error exhaust retries", func(t *testing.T) {
+		// Setup
+		// fail events with even Entry ID on every try, reporting them without an error
+		bulkPublisher := NewMockBulkPublisher(t, 3, true, false)
+		bulkPublisher.failWithoutError = true
+
+		// set short retry with 2 retries max
+		shortRetry.MaxRetries = ptr.Of(2)
+
+		// timeout will not be triggered here
+		policyProvider := createResPolicyProvider(resiliencyV1alpha.CircuitBreaker{}, longTimeout, shortRetry)
+		policyDef := policyProvider.ComponentOutboundPolicy(pubsubName, resiliency.Pubsub)
+
+		// Act
+		res, err := ApplyBulkPublishResiliency(ctx, req, policyDef, bulkPublisher)
+
+		// Assert
+		require.ErrorIs(t, err, ErrBulkPublishFailure)
+		assert.Len(t, res.FailedEntries, 3)
+		assertRetryCount(t, map[string]int{
+			"0": 3,
+			"2": 3,
+			"4": 3,
+			"1": 1,
+			"3": 1,
+			"5": 1,
+		}, bulkPublisher.entryIDRetryTimes)
+	})
+
 	t.Run("no failures", func(t *testing.T) {
 		// Setup
 		// no failures
@@ -34,12 +34,23 @@ type CloudEvent struct {
 	Type            string `mapstructure:"cloudevent.type"`
 	TraceParent     string `mapstructure:"cloudevent.traceparent"`
 	Subject         string `mapstructure:"cloudevent.subject"`
+	Baggage         string `mapstructure:"cloudevent.baggage"`
 }
 
+// BaggageField is the cloudevent extension attribute used to carry W3C baggage.
+const BaggageField = "baggage"
+
 // NewCloudEvent encapsulates the creation of a Dapr cloudevent from an existing cloudevent or a raw payload.
 func NewCloudEvent(req *CloudEvent, metadata map[string]string) (map[string]interface{}, error) {
 	if contribContenttype.IsCloudEventContentType(req.DataContentType) {
-		return contribPubsub.FromCloudEvent(req.Data, req.Topic, req.Pubsub, req.TraceID, req.TraceState)
+		ce, err := contribPubsub.FromCloudEvent(req.Data, req.Topic, req.Pubsub, req.TraceID, req.TraceState)
+		if err != nil {
+			return nil, err
+		}
+		if req.Baggage != "" {
+			ce[BaggageField] = req.Baggage
+		}
+		return ce, nil
 	}
 
 	// certain metadata beginning with "cloudevent." are considered overrides to the cloudevent envelope
@@ -53,6 +64,16 @@ func NewCloudEvent(req *CloudEvent, metadata map[string]string) (map[string]inte
 	if req.TraceParent != "" {
 		req.TraceID = req.TraceParent
 	}
-	return contribPubsub.NewCloudEventsEnvelope(req.ID, req.Source, req.Type,
-		req.Subject, req.Topic, req.Pubsub, req.DataContentType, req.Data, req.TraceID, req.TraceState), nil
+	ce := contribPubsub.NewCloudEventsEnvelope(req.ID, req.Source, req.Type,
+		req.Subject, req.Topic, req.Pubsub, req.DataContentType, req.Data, req.TraceID, req.TraceState)
+	if req.Baggage != "" {
+		ce[BaggageField] = req.Baggage
+	}
+	return ce, nil
+}
+
+// BaggageFromCloudEvent returns the W3C baggage carried by the cloudevent, if any.
+func BaggageFromCloudEvent(cloudEvent map[string]interface{}) string {
+	baggage, _ := cloudEvent[BaggageField].(string)
+	return baggage
 }
@@ -132,6 +132,56 @@ func TestNewCloudEvent(t *testing.T) {
 		assert.Equal(t, "pubsub", ce["pubsubname"].(string))
 		assert.Equal(t, "subject1", ce["subject"].(string))
 	})
+
+	t.Run("baggage", func(t *testing.T) {
+		ce, err := NewCloudEvent(&CloudEvent{
+			Topic:   "topic1",
+			Pubsub:  "pubsub",
+			Data:    []byte("hello"),
+			Baggage: "key1=value1,key2=value2",
+		}, map[string]string{})
+		require.NoError(t, err)
+		assert.Equal(t, "key1=value1,key2=value2", BaggageFromCloudEvent(ce))
+
+		m := map[string]interface{}{
+			"specversion":     "1.0",
+			"id":              "event",
+			"datacontenttype": "text/plain",
+			"data":            "world",
+		}
+		b, _ := json.Marshal(m)
+		ce, err = NewCloudEvent(&CloudEvent{
+			Data:            b,
+			DataContentType: "application/cloudevents+json",
+			Topic:           "topic1",
+			Pubsub:          "pubsub",
+			Baggage:         "key1=value1",
+		}, map[string]string{})
+		require.NoError(t, err)
+		assert.Equal(t, "key1=value1", ce["baggage"].(string))
+	})
+
+	t.Run("baggage metadata override", func(t *testing.T) {
+		ce, err := NewCloudEvent(&CloudEvent{
+			Topic:   "topic1",
+			Pubsub:  "pubsub",
+			Baggage: "key1=value1",
+		}, map[string]string{
+			"cloudevent.baggage": "key2=value2",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "key2=value2", ce["baggage"].(string))
+	})
+
+	t.Run("no baggage", func(t *testing.T) {
+		ce, err := NewCloudEvent(&CloudEvent{
+			Topic:  "topic1",
+			Pubsub: "pubsub",
+		}, map[string]string{})
+		require.NoError(t, err)
+		assert.NotContains(t, ce, "baggage")
+		assert.Empty(t, BaggageFromCloudEvent(ce))
+	})
 }
 
 func validUUID(u string) bool {
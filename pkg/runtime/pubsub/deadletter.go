@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+)
+
+// CloudEvent extension attributes, or message metadata keys for messages
+// which are not CloudEvents, stamped on messages sent to a dead letter topic.
+const (
+	DeadLetterOriginalTopicField = "dlqorigtopic"
+	DeadLetterTopicsField        = "dlqtopics"
+	DeadLetterAttemptsField      = "dlqattempts"
+	DeadLetterErrorField         = "dlqerror"
+	DeadLetterReasonField        = "dlqreason"
+	DeadLetterFirstTimeField     = "dlqfirsttime"
+	DeadLetterTimeField          = "dlqtime"
+)
+
+var deadLetterFields = []string{
+	DeadLetterOriginalTopicField,
+	DeadLetterTopicsField,
+	DeadLetterAttemptsField,
+	DeadLetterErrorField,
+	DeadLetterReasonField,
+	DeadLetterFirstTimeField,
+	DeadLetterTimeField,
+}
+
+// DeadLetterReason is the reason for which a message is sent to a dead letter
+// topic.
+type DeadLetterReason string
+
+const (
+	// DeadLetterReasonError means the delivery to the app failed.
+	DeadLetterReasonError DeadLetterReason = "error"
+	// DeadLetterReasonDropped means the app dropped the message.
+	DeadLetterReasonDropped DeadLetterReason = "dropped"
+	// DeadLetterReasonExpired means the message expired before delivery.
+	DeadLetterReasonExpired DeadLetterReason = "expired"
+	// DeadLetterReasonUnmatched means no route matched the message.
+	DeadLetterReasonUnmatched DeadLetterReason = "unmatched"
+	// DeadLetterReasonInvalid means the message could not be deserialized.
+	DeadLetterReasonInvalid DeadLetterReason = "invalid"
+)
+
+// ErrDeadLetterLoop is returned when sending a message to a dead letter topic
+// would send it back to a topic it has already been dead lettered from.
+var ErrDeadLetterLoop = errors.New("dead letter topic loop detected")
+
+// DeadLetter describes why and how a message is sent to a dead letter topic.
+type DeadLetter struct {
+	// Topic is the topic the message was received from.
+	Topic string
+	// DeadLetterTopic is the topic the message is sent to.
+	DeadLetterTopic string
+	// Reason is the reason for dead lettering the message.
+	Reason DeadLetterReason
+	// Attempts is the number of delivery attempts made, if known.
+	Attempts int
+	// Err is the last delivery error, if any.
+	Err error
+}
+
+// Stamp returns the data and metadata of a message to be sent to the dead
+// letter topic. CloudEvents are stamped with the dead letter extension
+// attributes, other messages carry them as metadata. When the message has
+// already been dead lettered, the original topic and the first time are kept,
+// and the chain of topics is extended. ErrDeadLetterLoop is returned if the
+// dead letter topic is part of that chain.
+func (d DeadLetter) Stamp(data []byte, md map[string]string, now time.Time) ([]byte, map[string]string, error) {
+	cloudEvent := decodeCloudEvent(data)
+
+	get := func(key string) string {
+		if cloudEvent != nil {
+			v, _ := cloudEvent[key].(string)
+			return v
+		}
+		return md[key]
+	}
+
+	originalTopic := get(DeadLetterOriginalTopicField)
+	if originalTopic == "" {
+		originalTopic = d.Topic
+	}
+	var topics []string
+	if chain := get(DeadLetterTopicsField); chain != "" {
+		topics = strings.Split(chain, ",")
+	}
+	topics = append(topics, d.Topic)
+	if slices.Contains(topics, d.DeadLetterTopic) {
+		return nil, nil, fmt.Errorf("%w: message from topic %s already went through %s", ErrDeadLetterLoop, d.Topic, d.DeadLetterTopic)
+	}
+	firstTime := get(DeadLetterFirstTimeField)
+	nowStr := now.UTC().Format(time.RFC3339)
+	if firstTime == "" {
+		firstTime = nowStr
+	}
+
+	fields := map[string]string{
+		DeadLetterOriginalTopicField: originalTopic,
+		DeadLetterTopicsField:        strings.Join(topics, ","),
+		DeadLetterReasonField:        string(d.Reason),
+		DeadLetterFirstTimeField:     firstTime,
+		DeadLetterTimeField:          nowStr,
+	}
+	if d.Attempts > 0 {
+		fields[DeadLetterAttemptsField] = strconv.Itoa(d.Attempts)
+	}
+	if d.Err != nil {
+		fields[DeadLetterErrorField] = d.Err.Error()
+	}
+
+	if cloudEvent == nil {
+		stamped := make(map[string]string, len(md)+len(fields))
+		maps.Copy(stamped, md)
+		// Remove the attempts and error of a previous dead lettering.
+		delete(stamped, DeadLetterAttemptsField)
+		delete(stamped, DeadLetterErrorField)
+		maps.Copy(stamped, fields)
+		return data, stamped, nil
+	}
+
+	delete(cloudEvent, DeadLetterAttemptsField)
+	delete(cloudEvent, DeadLetterErrorField)
+	for k, v := range fields {
+		cloudEvent[k] = v
+	}
+	stamped, err := json.Marshal(cloudEvent)
+	if err != nil {
+		return nil, nil, err
+	}
+	return stamped, md, nil
+}
+
+// ClearDeadLetter returns the original topic of a dead lettered message, and
+// its data and metadata without the dead letter information. The returned
+// topic is empty if the message was not stamped when dead lettered.
+func ClearDeadLetter(data []byte, md map[string]string) (string, []byte, map[string]string, error) {
+	cloudEvent := decodeCloudEvent(data)
+	if cloudEvent == nil {
+		topic := md[DeadLetterOriginalTopicField]
+		cleared := maps.Clone(md)
+		for _, k := range deadLetterFields {
+			delete(cleared, k)
+		}
+		return topic, data, cleared, nil
+	}
+
+	topic, _ := cloudEvent[DeadLetterOriginalTopicField].(string)
+	for _, k := range deadLetterFields {
+		delete(cloudEvent, k)
+	}
+	cleared, err := json.Marshal(cloudEvent)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	return topic, cleared, md, nil
+}
+
+// decodeCloudEvent returns the message data decoded as a CloudEvent, or nil if
+// the data is not a CloudEvent.
+func decodeCloudEvent(data []byte) map[string]any {
+	var cloudEvent map[string]any
+	// Use json.Number so that the numbers in the payload are kept as is.
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&cloudEvent); err != nil || cloudEvent[contribpubsub.SpecVersionField] == nil {
+		return nil
+	}
+	return cloudEvent
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeadLetterStamp(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	t.Run("cloud event is stamped with extension attributes", func(t *testing.T) {
+		dl := DeadLetter{
+			Topic:           "orders",
+			DeadLetterTopic: "orders-dlq",
+			Reason:          DeadLetterReasonError,
+			Attempts:        3,
+			Err:             errors.New("boom"),
+		}
+		data, md, err := dl.Stamp([]byte(`{"specversion":"1.0","id":"1","data":{"n":12345678901234567890}}`), map[string]string{"a": "b"}, now)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"a": "b"}, md)
+
+		var ce map[string]any
+		require.NoError(t, json.Unmarshal(data, &ce))
+		assert.Equal(t, "orders", ce[DeadLetterOriginalTopicField])
+		assert.Equal(t, "orders", ce[DeadLetterTopicsField])
+		assert.Equal(t, "3", ce[DeadLetterAttemptsField])
+		assert.Equal(t, "boom", ce[DeadLetterErrorField])
+		assert.Equal(t, "error", ce[DeadLetterReasonField])
+		assert.Equal(t, "2026-01-02T03:04:05Z", ce[DeadLetterFirstTimeField])
+		assert.Equal(t, "2026-01-02T03:04:05Z", ce[DeadLetterTimeField])
+		assert.Contains(t, string(data), "12345678901234567890")
+	})
+
+	t.Run("raw message is stamped with metadata", func(t *testing.T) {
+		dl := DeadLetter{
+			Topic:           "orders",
+			DeadLetterTopic: "orders-dlq",
+			Reason:          DeadLetterReasonDropped,
+		}
+		md := map[string]string{"a": "b"}
+		data, stamped, err := dl.Stamp([]byte("raw"), md, now)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("raw"), data)
+		assert.Equal(t, "b", stamped["a"])
+		assert.Equal(t, "orders", stamped[DeadLetterOriginalTopicField])
+		assert.Equal(t, "dropped", stamped[DeadLetterReasonField])
+		assert.NotContains(t, stamped, DeadLetterAttemptsField)
+		assert.NotContains(t, stamped, DeadLetterErrorField)
+		assert.Equal(t, map[string]string{"a": "b"}, md)
+	})
+
+	t.Run("chained dead letter keeps the original topic and time", func(t *testing.T) {
+		first := DeadLetter{
+			Topic:           "orders",
+			DeadLetterTopic: "orders-dlq",
+			Reason:          DeadLetterReasonError,
+			Attempts:        2,
+			Err:             errors.New("boom"),
+		}
+		_, md, err := first.Stamp(nil, nil, now)
+		require.NoError(t, err)
+
+		second := DeadLetter{
+			Topic:           "orders-dlq",
+			DeadLetterTopic: "orders-dlq-dlq",
+			Reason:          DeadLetterReasonUnmatched,
+		}
+		_, md, err = second.Stamp(nil, md, now.Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, "orders", md[DeadLetterOriginalTopicField])
+		assert.Equal(t, "orders,orders-dlq", md[DeadLetterTopicsField])
+		assert.Equal(t, "unmatched", md[DeadLetterReasonField])
+		assert.Equal(t, "2026-01-02T03:04:05Z", md[DeadLetterFirstTimeField])
+		assert.Equal(t, "2026-01-02T04:04:05Z", md[DeadLetterTimeField])
+		assert.NotContains(t, md, DeadLetterAttemptsField)
+		assert.NotContains(t, md, DeadLetterErrorField)
+	})
+
+	t.Run("loop is detected", func(t *testing.T) {
+		dl := DeadLetter{
+			Topic:           "orders-dlq",
+			DeadLetterTopic: "orders",
+			Reason:          DeadLetterReasonError,
+		}
+		_, _, err := dl.Stamp([]byte(`{"specversion":"1.0","id":"1","dlqtopics":"orders"}`), nil, now)
+		require.ErrorIs(t, err, ErrDeadLetterLoop)
+
+		dl.DeadLetterTopic = "orders-dlq"
+		_, _, err = dl.Stamp(nil, nil, now)
+		require.ErrorIs(t, err, ErrDeadLetterLoop)
+	})
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/dapr/components-contrib/metadata"
+	"github.com/dapr/components-contrib/state"
+)
+
+const (
+	// MetadataKeyInboxStateStore is the subscription metadata key enabling the
+	// inbox of a subscription, recording the IDs of the processed messages in
+	// the given state store so that redelivered messages are not delivered to
+	// the app again.
+	MetadataKeyInboxStateStore = "inboxStateStore"
+	// MetadataKeyInboxTTL is the subscription metadata key setting for how
+	// long, in seconds, the IDs of the processed messages are kept.
+	MetadataKeyInboxTTL = "inboxTTLInSeconds"
+
+	// DefaultInboxTTL is the time for which the IDs of the processed messages
+	// are kept by default.
+	DefaultInboxTTL = 24 * time.Hour
+
+	inboxKeyPrefix = "inbox"
+)
+
+// Inbox records the IDs of the messages processed by a subscription, to skip
+// the messages redelivered by the broker.
+type Inbox struct {
+	store     state.Store
+	keyPrefix string
+	ttl       string
+}
+
+// InboxFromMetadata returns the inbox configured in the metadata of the
+// subscription of an app to a topic, or nil if the subscription has no inbox.
+func InboxFromMetadata(md map[string]string, appID, pubsubName, topic string, getStateStoreFn func(string) (state.Store, bool)) (*Inbox, error) {
+	storeName := md[MetadataKeyInboxStateStore]
+	if storeName == "" {
+		return nil, nil
+	}
+
+	ttl := DefaultInboxTTL
+	if val, ok := md[MetadataKeyInboxTTL]; ok && val != "" {
+		secs, err := strconv.ParseInt(val, 10, 64)
+		if err != nil || secs <= 0 {
+			return nil, fmt.Errorf("invalid value for metadata %s: %q", MetadataKeyInboxTTL, val)
+		}
+		ttl = time.Duration(secs) * time.Second
+	}
+
+	if getStateStoreFn == nil {
+		return nil, fmt.Errorf("inbox state store %s not found", storeName)
+	}
+	store, ok := getStateStoreFn(storeName)
+	if !ok {
+		return nil, fmt.Errorf("inbox state store %s not found", storeName)
+	}
+	if !state.FeatureTTL.IsPresent(store.Features()) {
+		return nil, fmt.Errorf("inbox state store %s does not support TTLs", storeName)
+	}
+
+	return &Inbox{
+		store:     store,
+		keyPrefix: appID + "||" + inboxKeyPrefix + "||" + pubsubName + "||" + topic + "||",
+		ttl:       strconv.FormatInt(int64(ttl/time.Second), 10),
+	}, nil
+}
+
+// Processed returns true if the message with the given ID has already been
+// processed.
+func (i *Inbox) Processed(ctx context.Context, id string) (bool, error) {
+	resp, err := i.store.Get(ctx, &state.GetRequest{
+		Key: i.keyPrefix + id,
+	})
+	if err != nil {
+		return false, fmt.Errorf("error reading inbox entry of message %s: %w", id, err)
+	}
+	return resp != nil && len(resp.Data) > 0, nil
+}
+
+// Record records that the message with the given ID has been processed.
+func (i *Inbox) Record(ctx context.Context, id string) error {
+	err := i.store.Set(ctx, &state.SetRequest{
+		Key:   i.keyPrefix + id,
+		Value: []byte(strconv.FormatInt(time.Now().Unix(), 10)),
+		Metadata: map[string]string{
+			metadata.TTLInSecondsMetadataKey: i.ttl,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error recording inbox entry of message %s: %w", id, err)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/state"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+// inboxStore is a state store supporting TTLs, recording the metadata of the
+// set requests.
+type inboxStore struct {
+	*daprt.FakeStateStore
+	setMetadata map[string]string
+}
+
+func (i *inboxStore) Features() []state.Feature {
+	return []state.Feature{state.FeatureTTL}
+}
+
+func (i *inboxStore) Set(ctx context.Context, req *state.SetRequest) error {
+	i.setMetadata = req.Metadata
+	return i.FakeStateStore.Set(ctx, req)
+}
+
+func TestInbox(t *testing.T) {
+	store := &inboxStore{FakeStateStore: daprt.NewFakeStateStore()}
+	getStore := func(name string) (state.Store, bool) {
+		switch name {
+		case "inbox":
+			return store, true
+		case "nottl":
+			return daprt.NewFakeStateStore(), true
+		default:
+			return nil, false
+		}
+	}
+
+	t.Run("not configured", func(t *testing.T) {
+		inbox, err := InboxFromMetadata(map[string]string{}, "app", "pubsub", "orders", getStore)
+		require.NoError(t, err)
+		assert.Nil(t, inbox)
+	})
+
+	t.Run("invalid configurations", func(t *testing.T) {
+		for name, md := range map[string]map[string]string{
+			"unknown store":     {MetadataKeyInboxStateStore: "unknown"},
+			"store without TTL": {MetadataKeyInboxStateStore: "nottl"},
+			"invalid ttl":       {MetadataKeyInboxStateStore: "inbox", MetadataKeyInboxTTL: "0"},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := InboxFromMetadata(md, "app", "pubsub", "orders", getStore)
+				require.Error(t, err)
+			})
+		}
+	})
+
+	t.Run("processed messages are recorded", func(t *testing.T) {
+		inbox, err := InboxFromMetadata(map[string]string{
+			MetadataKeyInboxStateStore: "inbox",
+			MetadataKeyInboxTTL:        "60",
+		}, "app", "pubsub", "orders", getStore)
+		require.NoError(t, err)
+		require.NotNil(t, inbox)
+
+		processed, err := inbox.Processed(t.Context(), "1")
+		require.NoError(t, err)
+		assert.False(t, processed)
+
+		require.NoError(t, inbox.Record(t.Context(), "1"))
+		assert.Equal(t, map[string]string{"ttlInSeconds": "60"}, store.setMetadata)
+
+		processed, err = inbox.Processed(t.Context(), "1")
+		require.NoError(t, err)
+		assert.True(t, processed)
+
+		processed, err = inbox.Processed(t.Context(), "2")
+		require.NoError(t, err)
+		assert.False(t, processed)
+	})
+
+	t.Run("entries are scoped to the subscription", func(t *testing.T) {
+		md := map[string]string{MetadataKeyInboxStateStore: "inbox"}
+		orders, err := InboxFromMetadata(md, "app", "pubsub", "orders", getStore)
+		require.NoError(t, err)
+		payments, err := InboxFromMetadata(md, "app", "pubsub", "payments", getStore)
+		require.NoError(t, err)
+
+		require.NoError(t, orders.Record(t.Context(), "3"))
+		assert.Equal(t, map[string]string{"ttlInSeconds": "86400"}, store.setMetadata)
+		processed, err := payments.Processed(t.Context(), "3")
+		require.NoError(t, err)
+		assert.False(t, processed)
+	})
+}
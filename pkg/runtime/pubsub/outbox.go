@@ -16,6 +16,7 @@ package pubsub
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
@@ -28,7 +29,9 @@ import (
 	contribPubsub "github.com/dapr/components-contrib/pubsub"
 	"github.com/dapr/components-contrib/state"
 	"github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	"github.com/dapr/dapr/pkg/expr"
 	"github.com/dapr/dapr/pkg/outbox"
+	"github.com/dapr/dapr/pkg/runtime/drain"
 	"github.com/dapr/kit/logger"
 	kitstrings "github.com/dapr/kit/strings"
 )
@@ -38,6 +41,8 @@ const (
 	outboxPublishTopicKey            = "outboxPublishTopic"
 	outboxPubsubKey                  = "outboxPubsub"
 	outboxDiscardWhenMissingStateKey = "outboxDiscardWhenMissingState"
+	outboxProjectionKey              = "outboxProjection"
+	outboxPairsKey                   = "outboxPairs"
 	outboxStatePrefix                = "outbox"
 	defaultStateScanDelay            = time.Second * 1
 )
@@ -49,6 +54,18 @@ type outboxConfig struct {
 	publishTopic                  string
 	outboxPubsub                  string
 	outboxDiscardWhenMissingState bool
+	// projection computes the published payload from the state operation.
+	projection *expr.Expr
+}
+
+// outboxPair is an additional pubsub topic fed by the outbox of a state
+// store, as set in the outboxPairs metadata.
+type outboxPair struct {
+	PublishPubsub           string `json:"publishPubsub"`
+	PublishTopic            string `json:"publishTopic"`
+	OutboxPubsub            string `json:"outboxPubsub"`
+	DiscardWhenMissingState bool   `json:"discardWhenMissingState"`
+	Projection              string `json:"projection"`
 }
 
 type outboxImpl struct {
@@ -56,9 +73,10 @@ type outboxImpl struct {
 	getPubsubFn           func(string) (contribPubsub.PubSub, bool)
 	getStateFn            func(string) (state.Store, bool)
 	publisher             Adapter
-	outboxStores          map[string]outboxConfig
+	outboxStores          map[string][]outboxConfig
 	lock                  sync.RWMutex
 	namespace             string
+	inflight              drain.Tracker
 }
 
 type OptionsOutbox struct {
@@ -76,14 +94,14 @@ func NewOutbox(opts OptionsOutbox) outbox.Outbox {
 		getPubsubFn:           opts.GetPubsubFn,
 		getStateFn:            opts.GetStateFn,
 		publisher:             opts.Publisher,
-		outboxStores:          make(map[string]outboxConfig),
+		outboxStores:          make(map[string][]outboxConfig),
 		namespace:             opts.Namespace,
 	}
 }
 
 // AddOrUpdateOutbox examines a statestore for outbox properties and saves it for later usage in outbox operations.
 func (o *outboxImpl) AddOrUpdateOutbox(stateStore v1alpha1.Component) {
-	var publishPubSub, publishTopicKey, outboxPubsub string
+	var publishPubSub, publishTopicKey, outboxPubsub, projection, pairs string
 	var outboxDiscardWhenMissingState bool
 
 	for _, v := range stateStore.Spec.Metadata {
@@ -96,24 +114,71 @@ func (o *outboxImpl) AddOrUpdateOutbox(stateStore v1alpha1.Component) {
 			outboxPubsub = v.Value.String()
 		case outboxDiscardWhenMissingStateKey:
 			outboxDiscardWhenMissingState = kitstrings.IsTruthy(v.Value.String())
+		case outboxProjectionKey:
+			projection = v.Value.String()
+		case outboxPairsKey:
+			pairs = v.Value.String()
 		}
 	}
 
+	var configs []outboxConfig
+	add := func(pair outboxPair) {
+		if pair.PublishPubsub == "" || pair.PublishTopic == "" {
+			outboxLogger.Errorf("invalid outbox configuration on state store %s: the publish pubsub and topic are required", stateStore.Name)
+			return
+		}
+		if pair.OutboxPubsub == "" {
+			pair.OutboxPubsub = pair.PublishPubsub
+		}
+		c := outboxConfig{
+			publishPubSub:                 pair.PublishPubsub,
+			publishTopic:                  pair.PublishTopic,
+			outboxPubsub:                  pair.OutboxPubsub,
+			outboxDiscardWhenMissingState: pair.DiscardWhenMissingState,
+		}
+		if pair.Projection != "" {
+			c.projection = &expr.Expr{}
+			if err := c.projection.DecodeString(pair.Projection); err != nil {
+				outboxLogger.Errorf("invalid outbox projection for topic %s on state store %s: %s", pair.PublishTopic, stateStore.Name, err)
+				return
+			}
+		}
+		for _, existing := range configs {
+			// The internal topic of a pair is derived from its publish topic.
+			if existing.outboxPubsub == c.outboxPubsub && existing.publishTopic == c.publishTopic {
+				outboxLogger.Errorf("invalid outbox configuration on state store %s: topic %s is configured more than once for outbox pubsub %s", stateStore.Name, c.publishTopic, c.outboxPubsub)
+				return
+			}
+		}
+		configs = append(configs, c)
+	}
+
 	if publishPubSub != "" && publishTopicKey != "" {
-		o.lock.Lock()
-		defer o.lock.Unlock()
+		add(outboxPair{
+			PublishPubsub:           publishPubSub,
+			PublishTopic:            publishTopicKey,
+			OutboxPubsub:            outboxPubsub,
+			DiscardWhenMissingState: outboxDiscardWhenMissingState,
+			Projection:              projection,
+		})
+	}
 
-		if outboxPubsub == "" {
-			outboxPubsub = publishPubSub
+	if pairs != "" {
+		var extra []outboxPair
+		if err := json.Unmarshal([]byte(pairs), &extra); err != nil {
+			outboxLogger.Errorf("invalid %s metadata on state store %s: %s", outboxPairsKey, stateStore.Name, err)
 		}
-
-		o.outboxStores[stateStore.Name] = outboxConfig{
-			publishPubSub:                 publishPubSub,
-			publishTopic:                  publishTopicKey,
-			outboxPubsub:                  outboxPubsub,
-			outboxDiscardWhenMissingState: outboxDiscardWhenMissingState,
+		for _, pair := range extra {
+			add(pair)
 		}
 	}
+
+	if len(configs) > 0 {
+		o.lock.Lock()
+		defer o.lock.Unlock()
+
+		o.outboxStores[stateStore.Name] = configs
+	}
 }
 
 // Enabled returns a bool to indicate if a state store has outbox configured
@@ -140,7 +205,7 @@ func transaction() (state.TransactionalStateOperation, error) {
 // PublishInternal publishes the state to an internal topic for outbox processing and returns the updated list of transactions
 func (o *outboxImpl) PublishInternal(ctx context.Context, stateStore string, operations []state.TransactionalStateOperation, source, traceID, traceState string) ([]state.TransactionalStateOperation, error) {
 	o.lock.RLock()
-	c, ok := o.outboxStores[stateStore]
+	configs, ok := o.outboxStores[stateStore]
 	o.lock.RUnlock()
 
 	if !ok {
@@ -164,46 +229,19 @@ func (o *outboxImpl) PublishInternal(ctx context.Context, stateStore string, ope
 
 	for _, op := range operations {
 		sr, ok := op.(state.SetRequest)
-		if ok {
+		if !ok {
+			continue
+		}
+
+		for _, c := range configs {
 			tr, err := transaction()
 			if err != nil {
 				return nil, err
 			}
 
-			var payload any
-			var contentType string
-
-			if proj, ok := projections[sr.Key]; ok {
-				payload = proj.Value
-
-				if proj.ContentType != nil {
-					contentType = *proj.ContentType
-				} else if ct, ok := proj.Metadata[metadata.ContentType]; ok {
-					contentType = ct
-				}
-			} else {
-				payload = sr.Value
-
-				if sr.ContentType != nil {
-					contentType = *sr.ContentType
-				} else if ct, ok := sr.Metadata[metadata.ContentType]; ok {
-					contentType = ct
-				}
-			}
-
-			var ceData []byte
-			bt, ok := payload.([]byte)
-			if ok {
-				ceData = bt
-			} else if contentType != "" && strings.EqualFold(contentType, "application/json") {
-				b, sErr := json.Marshal(payload)
-				if sErr != nil {
-					return nil, sErr
-				}
-
-				ceData = b
-			} else {
-				ceData = []byte(fmt.Sprintf("%v", payload))
+			ceData, contentType, err := outboxPayload(sr, projections, c)
+			if err != nil {
+				return nil, err
 			}
 
 			var dataContentType string
@@ -243,6 +281,78 @@ func (o *outboxImpl) PublishInternal(ctx context.Context, stateStore string, ope
 	return operations, nil
 }
 
+// outboxPayload returns the payload published for a state operation and its
+// content type. The payload is the projection operation of the transaction
+// for the same key if any, otherwise the result of the projection expression
+// of the outbox pair if set, otherwise the state value.
+func outboxPayload(sr state.SetRequest, projections map[string]state.SetRequest, c outboxConfig) ([]byte, string, error) {
+	var payload any
+	var contentType string
+
+	if proj, ok := projections[sr.Key]; ok {
+		payload = proj.Value
+
+		if proj.ContentType != nil {
+			contentType = *proj.ContentType
+		} else if ct, ok := proj.Metadata[metadata.ContentType]; ok {
+			contentType = ct
+		}
+	} else if c.projection != nil {
+		b, err := c.projection.EvalJSON(map[string]any{
+			"key":      sr.Key,
+			"value":    outboxValue(sr.Value),
+			"metadata": sr.Metadata,
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf("error evaluating outbox projection for topic %s: %w", c.publishTopic, err)
+		}
+		return b, "application/json", nil
+	} else {
+		payload = sr.Value
+
+		if sr.ContentType != nil {
+			contentType = *sr.ContentType
+		} else if ct, ok := sr.Metadata[metadata.ContentType]; ok {
+			contentType = ct
+		}
+	}
+
+	if bt, ok := payload.([]byte); ok {
+		return bt, contentType, nil
+	}
+	if contentType != "" && strings.EqualFold(contentType, "application/json") {
+		b, err := json.Marshal(payload)
+		if err != nil {
+			return nil, "", err
+		}
+		return b, contentType, nil
+	}
+	return []byte(fmt.Sprintf("%v", payload)), contentType, nil
+}
+
+// outboxValue returns a state value as seen by projection expressions: JSON
+// values are decoded, other values are passed as strings.
+func outboxValue(value any) any {
+	var b []byte
+	switch v := value.(type) {
+	case []byte:
+		b = v
+	case string:
+		return v
+	default:
+		var err error
+		if b, err = json.Marshal(v); err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+	}
+
+	var decoded any
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		return string(b)
+	}
+	return decoded
+}
+
 func outboxTopic(appID, topic, namespace string) string {
 	return namespace + appID + topic + "outbox"
 }
@@ -251,99 +361,122 @@ func (o *outboxImpl) SubscribeToInternalTopics(ctx context.Context, appID string
 	o.lock.RLock()
 	defer o.lock.RUnlock()
 
-	for stateStore, c := range o.outboxStores {
-		outboxPubsub, ok := o.getPubsubFn(c.outboxPubsub)
-		if !ok {
-			outboxLogger.Warnf("could not subscribe to internal outbox topic: outbox pubsub %s not loaded", c.outboxPubsub)
-			continue
+	for stateStore, configs := range o.outboxStores {
+		for _, c := range configs {
+			o.subscribeToInternalTopic(ctx, appID, stateStore, c)
 		}
+	}
 
-		outboxPubsub.Subscribe(ctx, contribPubsub.SubscribeRequest{
-			Topic: outboxTopic(appID, c.publishTopic, o.namespace),
-		}, func(ctx context.Context, msg *contribPubsub.NewMessage) error {
-			var cloudEvent map[string]interface{}
+	return nil
+}
 
-			err := json.Unmarshal(msg.Data, &cloudEvent)
-			if err != nil {
-				return err
-			}
+// subscribeToInternalTopic subscribes to the internal topic of an outbox pair,
+// publishing its messages to the pair topic once the state is committed.
+func (o *outboxImpl) subscribeToInternalTopic(ctx context.Context, appID, stateStore string, c outboxConfig) {
+	outboxPubsub, ok := o.getPubsubFn(c.outboxPubsub)
+	if !ok {
+		outboxLogger.Warnf("could not subscribe to internal outbox topic: outbox pubsub %s not loaded", c.outboxPubsub)
+		return
+	}
 
-			stateKey := o.cloudEventExtractorFn(cloudEvent, contribPubsub.IDField)
+	outboxPubsub.Subscribe(ctx, contribPubsub.SubscribeRequest{
+		Topic: outboxTopic(appID, c.publishTopic, o.namespace),
+	}, func(ctx context.Context, msg *contribPubsub.NewMessage) error {
+		untrack, ok := o.inflight.Add()
+		if !ok {
+			// The message is redelivered once Dapr restarts, or to another replica.
+			return errors.New("outbox is flushed")
+		}
+		defer untrack()
 
-			store, ok := o.getStateFn(stateStore)
-			if !ok {
-				return fmt.Errorf("cannot get outbox state: state store %s not found", stateStore)
-			}
+		var cloudEvent map[string]interface{}
 
-			time.Sleep(defaultStateScanDelay)
+		err := json.Unmarshal(msg.Data, &cloudEvent)
+		if err != nil {
+			return err
+		}
 
-			bo := &backoff.ExponentialBackOff{
-				InitialInterval:     time.Millisecond * 500,
-				MaxInterval:         time.Second * 3,
-				MaxElapsedTime:      time.Second * 10,
-				Multiplier:          3,
-				Clock:               backoff.SystemClock,
-				RandomizationFactor: 0.1,
-			}
+		stateKey := o.cloudEventExtractorFn(cloudEvent, contribPubsub.IDField)
 
-			err = backoff.Retry(func() error {
-				resp, sErr := store.Get(ctx, &state.GetRequest{
-					Key: stateKey,
-				})
-				if sErr != nil {
-					return sErr
-				}
+		store, ok := o.getStateFn(stateStore)
+		if !ok {
+			return fmt.Errorf("cannot get outbox state: state store %s not found", stateStore)
+		}
 
-				if resp != nil && len(resp.Data) > 0 {
-					return nil
-				}
+		time.Sleep(defaultStateScanDelay)
 
-				return fmt.Errorf("cannot publish outbox message to topic %s with pubsub %s: outbox state not found", c.publishTopic, c.publishPubSub)
-			}, bo)
-			if err != nil {
-				if c.outboxDiscardWhenMissingState {
-					outboxLogger.Errorf("failed to publish outbox topic to pubsub %s: %s, discarding message", c.publishPubSub, err)
-					//lint:ignore nilerr dropping message
-					return nil
-				}
+		bo := &backoff.ExponentialBackOff{
+			InitialInterval:     time.Millisecond * 500,
+			MaxInterval:         time.Second * 3,
+			MaxElapsedTime:      time.Second * 10,
+			Multiplier:          3,
+			Clock:               backoff.SystemClock,
+			RandomizationFactor: 0.1,
+		}
 
-				outboxLogger.Errorf("failed to publish outbox topic to pubsub %s: %s, rejecting for later processing", c.publishPubSub, err)
-				return err
+		err = backoff.Retry(func() error {
+			resp, sErr := store.Get(ctx, &state.GetRequest{
+				Key: stateKey,
+			})
+			if sErr != nil {
+				return sErr
 			}
 
-			cloudEvent[contribPubsub.TopicField] = c.publishTopic
-			cloudEvent[contribPubsub.PubsubField] = c.publishPubSub
+			if resp != nil && len(resp.Data) > 0 {
+				return nil
+			}
 
-			b, err := json.Marshal(cloudEvent)
-			if err != nil {
-				return err
+			return fmt.Errorf("cannot publish outbox message to topic %s with pubsub %s: outbox state not found", c.publishTopic, c.publishPubSub)
+		}, bo)
+		if err != nil {
+			if c.outboxDiscardWhenMissingState {
+				outboxLogger.Errorf("failed to publish outbox topic to pubsub %s: %s, discarding message", c.publishPubSub, err)
+				//lint:ignore nilerr dropping message
+				return nil
 			}
 
-			contentType := cloudEvent[contribPubsub.DataContentTypeField].(string)
+			outboxLogger.Errorf("failed to publish outbox topic to pubsub %s: %s, rejecting for later processing", c.publishPubSub, err)
+			return err
+		}
 
-			err = o.publisher.Publish(ctx, &contribPubsub.PublishRequest{
-				PubsubName:  c.publishPubSub,
-				Data:        b,
-				Topic:       c.publishTopic,
-				ContentType: &contentType,
+		cloudEvent[contribPubsub.TopicField] = c.publishTopic
+		cloudEvent[contribPubsub.PubsubField] = c.publishPubSub
+
+		b, err := json.Marshal(cloudEvent)
+		if err != nil {
+			return err
+		}
+
+		contentType := cloudEvent[contribPubsub.DataContentTypeField].(string)
+
+		err = o.publisher.Publish(ctx, &contribPubsub.PublishRequest{
+			PubsubName:  c.publishPubSub,
+			Data:        b,
+			Topic:       c.publishTopic,
+			ContentType: &contentType,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = backoff.Retry(func() error {
+			err = store.Delete(ctx, &state.DeleteRequest{
+				Key: stateKey,
 			})
 			if err != nil {
 				return err
 			}
 
-			err = backoff.Retry(func() error {
-				err = store.Delete(ctx, &state.DeleteRequest{
-					Key: stateKey,
-				})
-				if err != nil {
-					return err
-				}
-
-				return nil
-			}, bo)
-			return err
-		})
-	}
+			return nil
+		}, bo)
+		return err
+	})
+}
 
-	return nil
+// Flush stops publishing new outbox messages to the user topics, and waits
+// until the messages that are being published have completed, or the context
+// is canceled.
+func (o *outboxImpl) Flush(ctx context.Context) error {
+	o.inflight.Close()
+	return o.inflight.Wait(ctx)
 }
@@ -47,6 +47,22 @@ func newTestOutbox(publishFn func(context.Context, *contribPubsub.PublishRequest
 	})
 }
 
+// outboxComponent returns a state store component with the given metadata.
+func outboxComponent(name string, md map[string]string) v1alpha1.Component {
+	comp := v1alpha1.Component{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}
+	for k, v := range md {
+		comp.Spec.Metadata = append(comp.Spec.Metadata, common.NameValuePair{
+			Name:  k,
+			Value: common.DynamicValue{JSON: v1.JSON{Raw: []byte(v)}},
+		})
+	}
+	return comp
+}
+
 func TestNewOutbox(t *testing.T) {
 	o := newTestOutbox(nil)
 	assert.NotNil(t, o)
@@ -171,7 +187,8 @@ func TestAddOrUpdateOutbox(t *testing.T) {
 			},
 		})
 
-		c := o.outboxStores["test"]
+		require.Len(t, o.outboxStores["test"], 1)
+		c := o.outboxStores["test"][0]
 		assert.Equal(t, "2", c.outboxPubsub)
 		assert.Equal(t, "a", c.publishPubSub)
 		assert.Equal(t, "1", c.publishTopic)
@@ -205,13 +222,113 @@ func TestAddOrUpdateOutbox(t *testing.T) {
 			},
 		})
 
-		c := o.outboxStores["test"]
+		require.Len(t, o.outboxStores["test"], 1)
+		c := o.outboxStores["test"][0]
 		assert.Equal(t, "a", c.outboxPubsub)
 		assert.Equal(t, "a", c.publishPubSub)
 		assert.Equal(t, "1", c.publishTopic)
 	})
 }
 
+func TestOutboxPairs(t *testing.T) {
+	t.Run("pairs are added to the state store outbox", func(t *testing.T) {
+		o := newTestOutbox(nil).(*outboxImpl)
+		o.AddOrUpdateOutbox(outboxComponent("test", map[string]string{
+			outboxPublishPubsubKey: "a",
+			outboxPublishTopicKey:  "1",
+			outboxProjectionKey:    `{"id": key}`,
+			outboxPairsKey: `[
+				{"publishPubsub": "b", "publishTopic": "2", "outboxPubsub": "c", "discardWhenMissingState": true},
+				{"publishPubsub": "b", "publishTopic": "3", "projection": "value.total"}
+			]`,
+		}))
+
+		configs := o.outboxStores["test"]
+		require.Len(t, configs, 3)
+		assert.Equal(t, "a", configs[0].publishPubSub)
+		assert.Equal(t, `{"id": key}`, configs[0].projection.String())
+		assert.Equal(t, "c", configs[1].outboxPubsub)
+		assert.True(t, configs[1].outboxDiscardWhenMissingState)
+		assert.Nil(t, configs[1].projection)
+		assert.Equal(t, "b", configs[2].outboxPubsub)
+		assert.Equal(t, "value.total", configs[2].projection.String())
+	})
+
+	t.Run("pairs alone enable the outbox", func(t *testing.T) {
+		o := newTestOutbox(nil).(*outboxImpl)
+		o.AddOrUpdateOutbox(outboxComponent("test", map[string]string{
+			outboxPairsKey: `[{"publishPubsub": "b", "publishTopic": "2"}]`,
+		}))
+		assert.True(t, o.Enabled("test"))
+	})
+
+	t.Run("invalid pairs are skipped", func(t *testing.T) {
+		o := newTestOutbox(nil).(*outboxImpl)
+		o.AddOrUpdateOutbox(outboxComponent("test", map[string]string{
+			outboxPublishPubsubKey: "a",
+			outboxPublishTopicKey:  "1",
+			outboxPairsKey: `[
+				{"publishPubsub": "a", "publishTopic": "1"},
+				{"publishPubsub": "b"},
+				{"publishPubsub": "b", "publishTopic": "2", "projection": "value."}
+			]`,
+		}))
+		require.Len(t, o.outboxStores["test"], 1)
+
+		o = newTestOutbox(nil).(*outboxImpl)
+		o.AddOrUpdateOutbox(outboxComponent("test", map[string]string{
+			outboxPairsKey: `not json`,
+		}))
+		assert.False(t, o.Enabled("test"))
+	})
+
+	t.Run("every pair publishes its projection", func(t *testing.T) {
+		published := make(map[string]map[string]any)
+		o := newTestOutbox(func(ctx context.Context, pr *contribPubsub.PublishRequest) error {
+			var cloudEvent map[string]any
+			require.NoError(t, json.Unmarshal(pr.Data, &cloudEvent))
+			published[pr.PubsubName+"/"+pr.Topic] = cloudEvent
+			return nil
+		}).(*outboxImpl)
+		o.AddOrUpdateOutbox(outboxComponent("test", map[string]string{
+			outboxPublishPubsubKey: "a",
+			outboxPublishTopicKey:  "1",
+			outboxPairsKey:         `[{"publishPubsub": "b", "publishTopic": "2", "projection": "{'order': key, 'total': value.total}"}]`,
+		}))
+
+		trs, err := o.PublishInternal(t.Context(), "test", []state.TransactionalStateOperation{
+			state.SetRequest{
+				Key:   "key",
+				Value: []byte(`{"total":42,"items":[1,2]}`),
+			},
+		}, "testapp", "", "")
+		require.NoError(t, err)
+		assert.Len(t, trs, 3)
+
+		require.Len(t, published, 2)
+		assert.Equal(t, `{"total":42,"items":[1,2]}`, published["a/testapp1outbox"]["data"])
+		assert.Equal(t, map[string]any{"order": "key", "total": float64(42)}, published["b/testapp2outbox"]["data"])
+		assert.Equal(t, "application/json", published["b/testapp2outbox"]["datacontenttype"])
+	})
+
+	t.Run("failed projection fails the transaction", func(t *testing.T) {
+		o := newTestOutbox(nil).(*outboxImpl)
+		o.AddOrUpdateOutbox(outboxComponent("test", map[string]string{
+			outboxPublishPubsubKey: "a",
+			outboxPublishTopicKey:  "1",
+			outboxProjectionKey:    "value.missing",
+		}))
+
+		_, err := o.PublishInternal(t.Context(), "test", []state.TransactionalStateOperation{
+			state.SetRequest{
+				Key:   "key",
+				Value: []byte(`{"total":42}`),
+			},
+		}, "testapp", "", "")
+		require.Error(t, err)
+	})
+}
+
 func TestPublishInternal(t *testing.T) {
 	t.Run("valid operation, correct default parameters", func(t *testing.T) {
 		o := newTestOutbox(func(ctx context.Context, pr *contribPubsub.PublishRequest) error {
@@ -1178,6 +1295,30 @@ func (o *outboxStateMock) Get(ctx context.Context, req *state.GetRequest) (*stat
 	return nil, nil
 }
 
+func TestOutboxFlush(t *testing.T) {
+	psMock := &outboxPubsubMock{
+		expectedOutboxTopic: "test1outbox",
+		t:                   t,
+	}
+	o := newTestOutbox(nil).(*outboxImpl)
+	o.getPubsubFn = func(string) (contribPubsub.PubSub, bool) {
+		return psMock, true
+	}
+	o.AddOrUpdateOutbox(outboxComponent("test", map[string]string{
+		outboxPublishPubsubKey: "a",
+		outboxPublishTopicKey:  "1",
+		outboxPubsubKey:        "a",
+	}))
+	require.NoError(t, o.SubscribeToInternalTopics(t.Context(), "test"))
+	require.NotNil(t, psMock.handler)
+
+	require.NoError(t, o.Flush(t.Context()))
+
+	// Messages received once flushed are rejected, to be redelivered.
+	err := psMock.handler(t.Context(), &contribPubsub.NewMessage{Data: []byte(`{}`), Topic: "test1outbox"})
+	require.ErrorContains(t, err, "outbox is flushed")
+}
+
 func TestOutboxTopic(t *testing.T) {
 	t.Run("not namespaced", func(t *testing.T) {
 		o := newTestOutbox(nil).(*outboxImpl)
@@ -53,26 +53,40 @@ func New(opts Options) rtpubsub.Adapter {
 // And then forward them to the Pub/Sub component.
 // This method is used by the HTTP and gRPC APIs.
 func (p *publisher) Publish(ctx context.Context, req *contribpubsub.PublishRequest) error {
+	_, err := p.PublishWithResult(ctx, req)
+	return err
+}
+
+// PublishWithResult publishes like Publish, and returns the identifiers the
+// broker assigned to the message if the component implements
+// rtpubsub.ResultPublisher. The result is nil otherwise.
+func (p *publisher) PublishWithResult(ctx context.Context, req *contribpubsub.PublishRequest) (*rtpubsub.PublishResult, error) {
 	pubsub, ok := p.getpubsubFn(req.PubsubName)
 	if !ok {
-		return rtpubsub.NotFoundError{PubsubName: req.PubsubName}
+		return nil, rtpubsub.NotFoundError{PubsubName: req.PubsubName}
 	}
 
 	if allowed := rtpubsub.IsOperationAllowed(req.Topic, pubsub, pubsub.ScopedPublishings); !allowed {
-		return rtpubsub.NotAllowedError{Topic: req.Topic, ID: p.appID}
+		return nil, rtpubsub.NotAllowedError{Topic: req.Topic, ID: p.appID}
+	}
+
+	if err := rtpubsub.ValidatePublishSchema(ctx, pubsub, req.Topic, req.Data); err != nil {
+		return nil, err
 	}
 
 	if pubsub.NamespaceScoped {
 		req.Topic = p.namespace + req.Topic
 	}
 
-	policyRunner := resiliency.NewRunner[any](ctx,
+	policyRunner := resiliency.NewRunner[*rtpubsub.PublishResult](ctx,
 		p.resiliency.ComponentOutboundPolicy(req.PubsubName, resiliency.Pubsub),
 	)
-	_, err := policyRunner(func(ctx context.Context) (any, error) {
+	return policyRunner(func(ctx context.Context) (*rtpubsub.PublishResult, error) {
+		if rp, ok := pubsub.Component.(rtpubsub.ResultPublisher); ok {
+			return rp.PublishWithResult(ctx, req)
+		}
 		return nil, pubsub.Component.Publish(ctx, req)
 	})
-	return err
 }
 
 func (p *publisher) BulkPublish(ctx context.Context, req *contribpubsub.BulkPublishRequest) (contribpubsub.BulkPublishResponse, error) {
@@ -85,6 +99,12 @@ func (p *publisher) BulkPublish(ctx context.Context, req *contribpubsub.BulkPubl
 		return contribpubsub.BulkPublishResponse{}, rtpubsub.NotAllowedError{Topic: req.Topic, ID: p.appID}
 	}
 
+	for _, entry := range req.Entries {
+		if err := rtpubsub.ValidatePublishSchema(ctx, pubsub, req.Topic, entry.Event); err != nil {
+			return contribpubsub.BulkPublishResponse{}, err
+		}
+	}
+
 	policyDef := p.resiliency.ComponentOutboundPolicy(req.PubsubName, resiliency.Pubsub)
 
 	if contribpubsub.FeatureBulkPublish.IsPresent(pubsub.Component.Features()) {
@@ -15,6 +15,9 @@ package publisher
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -26,6 +29,7 @@ import (
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
 	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/schema"
 	daprt "github.com/dapr/dapr/pkg/testing"
 	"github.com/dapr/kit/logger"
 )
@@ -375,6 +379,57 @@ func TestPublish(t *testing.T) {
 	})
 }
 
+func TestPublishSchemaValidation(t *testing.T) {
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"schemaType": "JSON",
+			"schema":     `{"type":"object","properties":{"amount":{"type":"integer"}},"required":["amount"]}`,
+		})
+	}))
+	t.Cleanup(registry.Close)
+
+	compStore := compstore.New()
+	compStore.AddPubSub(TestPubsubName, &rtpubsub.PubsubItem{
+		Component: &mockPublishPubSub{},
+		Schemas:   schema.New(schema.Options{RegistryURL: registry.URL, Publish: true}),
+	})
+	ps := New(Options{
+		Resiliency:  resiliency.New(logger.NewLogger("test")),
+		GetPubSubFn: compStore.GetPubSub,
+	})
+
+	t.Run("valid cloud event is published", func(t *testing.T) {
+		err := ps.Publish(t.Context(), &contribpubsub.PublishRequest{
+			PubsubName: TestPubsubName,
+			Topic:      "topic0",
+			Data:       []byte(`{"specversion":"1.0","id":"1","datacontenttype":"application/json","data":{"amount":1}}`),
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid cloud event is rejected", func(t *testing.T) {
+		err := ps.Publish(t.Context(), &contribpubsub.PublishRequest{
+			PubsubName: TestPubsubName,
+			Topic:      "topic0",
+			Data:       []byte(`{"specversion":"1.0","id":"1","datacontenttype":"application/json","data":{"amount":"1"}}`),
+		})
+		require.ErrorAs(t, err, &rtpubsub.SchemaValidationError{})
+		require.ErrorIs(t, err, schema.ErrInvalid)
+	})
+
+	t.Run("bulk publish with an invalid entry is rejected", func(t *testing.T) {
+		_, err := ps.BulkPublish(t.Context(), &contribpubsub.BulkPublishRequest{
+			PubsubName: TestPubsubName,
+			Topic:      "topic0",
+			Entries: []contribpubsub.BulkMessageEntry{
+				{EntryId: "1", Event: []byte(`{"amount":1}`), ContentType: "application/json"},
+				{EntryId: "2", Event: []byte(`{"amount":"2"}`), ContentType: "application/json"},
+			},
+		})
+		require.ErrorAs(t, err, &rtpubsub.SchemaValidationError{})
+	})
+}
+
 func TestNamespacedPublisher(t *testing.T) {
 	compStore := compstore.New()
 	compStore.AddPubSub(TestPubsubName, &rtpubsub.PubsubItem{
@@ -399,6 +454,52 @@ func TestNamespacedPublisher(t *testing.T) {
 	assert.Equal(t, "ns1topic0", pubSub.Component.(*mockPublishPubSub).PublishedRequest.Load().Topic)
 }
 
+func TestPublishWithResult(t *testing.T) {
+	partition := int32(3)
+	compStore := compstore.New()
+	compStore.AddPubSub(TestPubsubName, &rtpubsub.PubsubItem{
+		Component: &mockResultPublishPubSub{result: &rtpubsub.PublishResult{MessageID: "msg1", Partition: &partition, Offset: "42"}},
+	})
+	compStore.AddPubSub(TestSecondPubsubName, &rtpubsub.PubsubItem{
+		Component: &mockPublishPubSub{},
+	})
+
+	ps := New(Options{
+		Resiliency:  resiliency.New(logger.NewLogger("test")),
+		GetPubSubFn: compStore.GetPubSub,
+	})
+	rp, ok := ps.(rtpubsub.ResultPublisher)
+	require.True(t, ok)
+
+	t.Run("component returns a result", func(t *testing.T) {
+		result, err := rp.PublishWithResult(t.Context(), &contribpubsub.PublishRequest{
+			PubsubName: TestPubsubName,
+			Topic:      "topic0",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, &rtpubsub.PublishResult{MessageID: "msg1", Partition: &partition, Offset: "42"}, result)
+	})
+
+	t.Run("component without results", func(t *testing.T) {
+		result, err := rp.PublishWithResult(t.Context(), &contribpubsub.PublishRequest{
+			PubsubName: TestSecondPubsubName,
+			Topic:      "topic0",
+		})
+		require.NoError(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+type mockResultPublishPubSub struct {
+	mockPublishPubSub
+	result *rtpubsub.PublishResult
+}
+
+func (m *mockResultPublishPubSub) PublishWithResult(ctx context.Context, req *contribpubsub.PublishRequest) (*rtpubsub.PublishResult, error) {
+	m.PublishedRequest.Store(req)
+	return m.result, nil
+}
+
 type mockPublishPubSub struct {
 	PublishedRequest atomic.Pointer[contribpubsub.PublishRequest]
 }
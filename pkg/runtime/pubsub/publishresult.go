@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"context"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+)
+
+// PublishResult contains the identifiers the broker assigned to a published
+// message. Fields which the broker doesn't assign are left empty.
+type PublishResult struct {
+	// MessageID is the ID of the message in the broker.
+	MessageID string `json:"messageId,omitempty"`
+	// Partition is the partition the message was written to.
+	Partition *int32 `json:"partition,omitempty"`
+	// Offset is the offset or sequence number of the message in the partition
+	// or stream. It is a string as not every broker uses numeric offsets.
+	Offset string `json:"offset,omitempty"`
+}
+
+// ResultPublisher is implemented by pubsub components which can return the
+// identifiers the broker assigned to a published message. It is also
+// implemented by the publishing Adapter, which returns a nil result for
+// components that don't implement it.
+type ResultPublisher interface {
+	PublishWithResult(ctx context.Context, req *contribpubsub.PublishRequest) (*PublishResult, error)
+}
+
+// IsEmpty returns true if the broker didn't assign any identifier.
+func (r *PublishResult) IsEmpty() bool {
+	return r == nil || (r.MessageID == "" && r.Partition == nil && r.Offset == "")
+}
+
+// PublishWithResult publishes the request with the adapter, and returns the
+// identifiers the broker assigned to the message if the adapter implements
+// ResultPublisher.
+func PublishWithResult(ctx context.Context, adapter Adapter, req *contribpubsub.PublishRequest) (*PublishResult, error) {
+	if rp, ok := adapter.(ResultPublisher); ok {
+		return rp.PublishWithResult(ctx, req)
+	}
+	return nil, adapter.Publish(ctx, req)
+}
@@ -0,0 +1,32 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublishResultIsEmpty(t *testing.T) {
+	partition := int32(0)
+
+	var nilResult *PublishResult
+	assert.True(t, nilResult.IsEmpty())
+	assert.True(t, (&PublishResult{}).IsEmpty())
+
+	assert.False(t, (&PublishResult{MessageID: "msg1"}).IsEmpty())
+	assert.False(t, (&PublishResult{Partition: &partition}).IsEmpty())
+	assert.False(t, (&PublishResult{Offset: "1700000000000-0"}).IsEmpty())
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/expr"
+	"github.com/dapr/kit/logger"
+)
+
+var redriveLogger = logger.NewLogger("dapr.runtime.pubsub.redrive")
+
+// DefaultRedriveIdleTimeout is the time after which a redrive stops when no
+// message is received from the dead letter topic.
+const DefaultRedriveIdleTimeout = 5 * time.Second
+
+// errRedriveDone is returned to the component for the messages received once
+// the redrive has completed, so that they are kept in the dead letter topic.
+var errRedriveDone = errors.New("redrive completed")
+
+// RedriveRequest is a request to publish the messages of a dead letter topic
+// back to the topic they were dead lettered from.
+type RedriveRequest struct {
+	PubsubName string
+	// Topic is the dead letter topic.
+	Topic    string
+	Metadata map[string]string
+	// Filter selects the messages to redrive. The CloudEvent is available as
+	// `event` and the message metadata as `metadata`. Messages which are not
+	// selected are published back to the dead letter topic.
+	Filter *expr.Expr
+	// Rate is the maximum number of messages redriven per second. Zero means
+	// no limit.
+	Rate float64
+	// MaxMessages is the maximum number of messages redriven. Zero means no
+	// limit.
+	MaxMessages int
+	// IdleTimeout is the time after which the redrive stops when no message is
+	// received. Defaults to DefaultRedriveIdleTimeout.
+	IdleTimeout time.Duration
+}
+
+// RedriveResult is the outcome of a redrive.
+type RedriveResult struct {
+	// Redriven is the number of messages published back to their original
+	// topic.
+	Redriven int `json:"redriven"`
+	// Skipped is the number of messages kept in the dead letter topic, because
+	// they were not selected by the filter or their original topic is unknown.
+	Skipped int `json:"skipped"`
+}
+
+// Redrive subscribes to the dead letter topic of the request and publishes its
+// messages back to the topic they were dead lettered from, until the topic is
+// drained, the maximum number of messages is reached or the context is done.
+// The redrive stops at the first message which cannot be published, which is
+// kept in the dead letter topic.
+func Redrive(ctx context.Context, component contribpubsub.PubSub, publish func(context.Context, *contribpubsub.PublishRequest) error, req RedriveRequest) (RedriveResult, error) {
+	idleTimeout := req.IdleTimeout
+	if idleTimeout <= 0 {
+		idleTimeout = DefaultRedriveIdleTimeout
+	}
+	limit := rate.Inf
+	if req.Rate > 0 {
+		limit = rate.Limit(req.Rate)
+	}
+	limiter := rate.NewLimiter(limit, 1)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		lock     sync.Mutex
+		res      RedriveResult
+		redrvErr error
+		// skipped holds the messages published back to the dead letter topic.
+		// Receiving one of them again means the whole topic has been read.
+		skipped  = make(map[[sha256.Size]byte]struct{})
+		activity = make(chan struct{}, 1)
+		inflight atomic.Int32
+		done     = make(chan struct{})
+		stop     = sync.OnceFunc(func() { close(done) })
+	)
+	notify := func() {
+		select {
+		case activity <- struct{}{}:
+		default:
+		}
+	}
+
+	handler := func(ctx context.Context, msg *contribpubsub.NewMessage) error {
+		inflight.Add(1)
+		defer func() {
+			inflight.Add(-1)
+			notify()
+		}()
+
+		lock.Lock()
+		defer lock.Unlock()
+
+		select {
+		case <-done:
+			return errRedriveDone
+		default:
+		}
+
+		sum := sha256.Sum256(msg.Data)
+		if _, ok := skipped[sum]; ok {
+			stop()
+			return errRedriveDone
+		}
+
+		topic, data, md, err := ClearDeadLetter(msg.Data, msg.Metadata)
+		if err != nil {
+			return err
+		}
+		if topic == "" || !redriveSelected(req.Filter, data, md) {
+			err = publish(ctx, &contribpubsub.PublishRequest{
+				Data:        msg.Data,
+				PubsubName:  req.PubsubName,
+				Topic:       req.Topic,
+				Metadata:    msg.Metadata,
+				ContentType: msg.ContentType,
+			})
+			if err != nil {
+				redrvErr = fmt.Errorf("error keeping message in dead letter topic %s: %w", req.Topic, err)
+				stop()
+				return err
+			}
+			skipped[sum] = struct{}{}
+			res.Skipped++
+			return nil
+		}
+
+		if err = limiter.Wait(ctx); err != nil {
+			return err
+		}
+		err = publish(ctx, &contribpubsub.PublishRequest{
+			Data:        data,
+			PubsubName:  req.PubsubName,
+			Topic:       topic,
+			Metadata:    md,
+			ContentType: msg.ContentType,
+		})
+		if err != nil {
+			redrvErr = fmt.Errorf("error redriving message to topic %s: %w", topic, err)
+			stop()
+			return err
+		}
+		res.Redriven++
+		if req.MaxMessages > 0 && res.Redriven >= req.MaxMessages {
+			stop()
+		}
+		return nil
+	}
+
+	err := component.Subscribe(ctx, contribpubsub.SubscribeRequest{
+		Topic:    req.Topic,
+		Metadata: req.Metadata,
+	}, handler)
+	if err != nil {
+		return res, err
+	}
+
+	idle := time.NewTimer(idleTimeout)
+	defer idle.Stop()
+loop:
+	for {
+		select {
+		case <-activity:
+			idle.Reset(idleTimeout)
+		case <-idle.C:
+			// A message being redriven, for example waiting for the rate
+			// limiter, is not idle.
+			if inflight.Load() > 0 {
+				idle.Reset(idleTimeout)
+				continue
+			}
+			break loop
+		case <-done:
+			break loop
+		case <-ctx.Done():
+			break loop
+		}
+	}
+	stop()
+	cancel()
+
+	lock.Lock()
+	defer lock.Unlock()
+	return res, redrvErr
+}
+
+// redriveSelected returns true if the message is selected by the filter. A
+// filter which cannot be evaluated for the message does not select it.
+func redriveSelected(filter *expr.Expr, data []byte, md map[string]string) bool {
+	if filter == nil || filter.String() == "" {
+		return true
+	}
+	event := map[string]any{}
+	if decodeCloudEvent(data) != nil {
+		_ = json.Unmarshal(data, &event)
+	}
+	res, err := filter.Eval(map[string]any{
+		"event":    event,
+		"metadata": md,
+	})
+	if err != nil {
+		redriveLogger.Debugf("error evaluating redrive filter %s: %s", filter, err)
+		return false
+	}
+	selected, _ := res.(bool)
+	return selected
+}
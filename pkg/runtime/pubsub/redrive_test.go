@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/expr"
+)
+
+// redrivePubSub is a dead letter topic which delivers its messages one at a
+// time, and keeps the messages which are not acknowledged.
+type redrivePubSub struct {
+	contribpubsub.PubSub
+
+	lock       sync.Mutex
+	dlq        [][]byte
+	published  map[string][][]byte
+	publishErr error
+}
+
+func (r *redrivePubSub) Subscribe(ctx context.Context, req contribpubsub.SubscribeRequest, handler contribpubsub.Handler) error {
+	go func() {
+		for {
+			r.lock.Lock()
+			if len(r.dlq) == 0 {
+				r.lock.Unlock()
+				return
+			}
+			data := r.dlq[0]
+			r.dlq = r.dlq[1:]
+			r.lock.Unlock()
+
+			err := handler(ctx, &contribpubsub.NewMessage{Data: data, Topic: req.Topic, Metadata: map[string]string{}})
+			if err != nil {
+				r.lock.Lock()
+				r.dlq = append([][]byte{data}, r.dlq...)
+				r.lock.Unlock()
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (r *redrivePubSub) publish(_ context.Context, req *contribpubsub.PublishRequest) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if req.Topic == "orders-dlq" {
+		r.dlq = append(r.dlq, req.Data)
+		return nil
+	}
+	if r.publishErr != nil {
+		return r.publishErr
+	}
+	r.published[req.Topic] = append(r.published[req.Topic], req.Data)
+	return nil
+}
+
+func (r *redrivePubSub) dlqLen() int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return len(r.dlq)
+}
+
+func deadLettered(t *testing.T, id, originalTopic string) []byte {
+	t.Helper()
+	data := []byte(`{"specversion":"1.0","id":"` + id + `","type":"order","data":{"n":1}}`)
+	if originalTopic == "" {
+		return data
+	}
+	data, _, err := DeadLetter{
+		Topic:           originalTopic,
+		DeadLetterTopic: "orders-dlq",
+		Reason:          DeadLetterReasonError,
+		Attempts:        3,
+	}.Stamp(data, nil, time.Now())
+	require.NoError(t, err)
+	return data
+}
+
+func TestRedrive(t *testing.T) {
+	req := RedriveRequest{
+		PubsubName:  "pubsub",
+		Topic:       "orders-dlq",
+		IdleTimeout: 50 * time.Millisecond,
+	}
+
+	t.Run("messages are published back to their original topic", func(t *testing.T) {
+		comp := &redrivePubSub{
+			dlq:       [][]byte{deadLettered(t, "1", "orders"), deadLettered(t, "2", "payments")},
+			published: make(map[string][][]byte),
+		}
+		res, err := Redrive(t.Context(), comp, comp.publish, req)
+		require.NoError(t, err)
+		assert.Equal(t, RedriveResult{Redriven: 2}, res)
+		assert.Zero(t, comp.dlqLen())
+		require.Len(t, comp.published["orders"], 1)
+		require.Len(t, comp.published["payments"], 1)
+
+		event := decodeCloudEvent(comp.published["orders"][0])
+		assert.Equal(t, "1", event[contribpubsub.IDField])
+		for _, k := range deadLetterFields {
+			assert.NotContains(t, event, k)
+		}
+	})
+
+	t.Run("filtered and unknown messages are kept", func(t *testing.T) {
+		filter := &expr.Expr{}
+		require.NoError(t, filter.DecodeString(`event.id != "2"`))
+		comp := &redrivePubSub{
+			dlq: [][]byte{
+				deadLettered(t, "1", "orders"),
+				deadLettered(t, "2", "orders"),
+				deadLettered(t, "3", ""),
+				deadLettered(t, "4", "orders"),
+			},
+			published: make(map[string][][]byte),
+		}
+		req := req
+		req.Filter = filter
+		res, err := Redrive(t.Context(), comp, comp.publish, req)
+		require.NoError(t, err)
+		assert.Equal(t, RedriveResult{Redriven: 2, Skipped: 2}, res)
+		assert.Len(t, comp.published["orders"], 2)
+		assert.Eventually(t, func() bool {
+			return comp.dlqLen() == 2
+		}, time.Second, time.Millisecond)
+	})
+
+	t.Run("max messages", func(t *testing.T) {
+		comp := &redrivePubSub{
+			dlq:       [][]byte{deadLettered(t, "1", "orders"), deadLettered(t, "2", "orders")},
+			published: make(map[string][][]byte),
+		}
+		req := req
+		req.MaxMessages = 1
+		res, err := Redrive(t.Context(), comp, comp.publish, req)
+		require.NoError(t, err)
+		assert.Equal(t, RedriveResult{Redriven: 1}, res)
+		assert.Eventually(t, func() bool {
+			return comp.dlqLen() == 1
+		}, time.Second, time.Millisecond)
+	})
+
+	t.Run("rate limit", func(t *testing.T) {
+		comp := &redrivePubSub{
+			dlq: [][]byte{
+				deadLettered(t, "1", "orders"),
+				deadLettered(t, "2", "orders"),
+				deadLettered(t, "3", "orders"),
+			},
+			published: make(map[string][][]byte),
+		}
+		req := req
+		req.Rate = 20
+		start := time.Now()
+		res, err := Redrive(t.Context(), comp, comp.publish, req)
+		require.NoError(t, err)
+		assert.Equal(t, RedriveResult{Redriven: 3}, res)
+		assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+	})
+
+	t.Run("publish error stops the redrive", func(t *testing.T) {
+		comp := &redrivePubSub{
+			dlq:        [][]byte{deadLettered(t, "1", "orders"), deadLettered(t, "2", "orders")},
+			published:  make(map[string][][]byte),
+			publishErr: errors.New("boom"),
+		}
+		res, err := Redrive(t.Context(), comp, comp.publish, req)
+		require.Error(t, err)
+		assert.Equal(t, RedriveResult{}, res)
+		assert.Eventually(t, func() bool {
+			return comp.dlqLen() == 2
+		}, time.Second, time.Millisecond)
+	})
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/dapr/components-contrib/contenttype"
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+)
+
+// SchemaValidationError is returned by the runtime when a published message
+// does not match the schema of its topic.
+type SchemaValidationError struct {
+	Topic string
+	Err   error
+}
+
+func (e SchemaValidationError) Error() string {
+	return fmt.Sprintf("message published to topic %s failed schema validation: %s", e.Topic, e.Err)
+}
+
+func (e SchemaValidationError) Unwrap() error {
+	return e.Err
+}
+
+// ValidatePublishSchema validates a message published to the topic of the
+// pub/sub against the schema of the topic, if the pub/sub validates published
+// messages.
+func ValidatePublishSchema(ctx context.Context, ps *PubsubItem, topic string, data []byte) error {
+	if ps.Schemas == nil {
+		return nil
+	}
+	payload := data
+	if cloudEvent := decodeCloudEvent(data); cloudEvent != nil {
+		payload = CloudEventPayload(cloudEvent)
+	}
+	if err := ps.Schemas.ValidatePublish(ctx, topic, payload); err != nil {
+		return SchemaValidationError{Topic: topic, Err: err}
+	}
+	return nil
+}
+
+// CloudEventPayload returns the payload of a CloudEvent: its JSON encoded data
+// for JSON content types, and its raw data otherwise.
+func CloudEventPayload(cloudEvent map[string]any) []byte {
+	if v, ok := cloudEvent[contribpubsub.DataBase64Field].(string); ok {
+		if b, err := base64.StdEncoding.DecodeString(v); err == nil {
+			return b
+		}
+	}
+
+	data := cloudEvent[contribpubsub.DataField]
+	ct, _ := cloudEvent[contribpubsub.DataContentTypeField].(string)
+	if ct == "" || contenttype.IsJSONContentType(ct) {
+		b, _ := json.Marshal(data)
+		return b
+	}
+	switch v := data.(type) {
+	case []byte:
+		return v
+	case string:
+		return []byte(v)
+	default:
+		b, _ := json.Marshal(v)
+		return b
+	}
+}
@@ -0,0 +1,380 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package schema validates pub/sub messages against the schemas of their
+// topic, as stored in a Confluent-compatible schema registry.
+package schema
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+
+	"github.com/dapr/kit/logger"
+)
+
+// Component metadata keys configuring the schema validation of a pub/sub.
+const (
+	MetadataKeyRegistryURL       = "schemaRegistryURL"
+	MetadataKeyRegistryAPIKey    = "schemaRegistryAPIKey"
+	MetadataKeyRegistryAPISecret = "schemaRegistryAPISecret"
+	MetadataKeyValidation        = "schemaValidation"
+	MetadataKeySubjects          = "schemaSubjects"
+	MetadataKeyCacheTTL          = "schemaCacheTTL"
+)
+
+// Values of the schemaValidation metadata.
+const (
+	ValidationPublish   = "publish"
+	ValidationSubscribe = "subscribe"
+)
+
+// DefaultCacheTTL is the time for which schemas fetched from the registry
+// are cached.
+const DefaultCacheTTL = 5 * time.Minute
+
+// Schema types of the registry.
+const (
+	typeAvro     = "AVRO"
+	typeJSON     = "JSON"
+	typeProtobuf = "PROTOBUF"
+)
+
+// wireFormatMagicByte starts the payloads encoded in the Confluent wire
+// format, followed by the 4 bytes schema ID.
+const wireFormatMagicByte = 0
+
+// ErrInvalid is returned when a message does not match the schema of its
+// topic.
+var ErrInvalid = errors.New("message does not match the topic schema")
+
+var log = logger.NewLogger("dapr.runtime.pubsub.schema")
+
+// Options are the options of a Validator.
+type Options struct {
+	// RegistryURL is the URL of the schema registry.
+	RegistryURL string
+	// APIKey and APISecret are the optional basic auth credentials of the
+	// registry.
+	APIKey    string
+	APISecret string
+	// Publish and Subscribe enable the validation of published and delivered
+	// messages.
+	Publish   bool
+	Subscribe bool
+	// Subjects maps topics to registry subjects. Topics which are not mapped
+	// use the "<topic>-value" subject.
+	Subjects map[string]string
+	// CacheTTL is the time for which schemas are cached. Defaults to
+	// DefaultCacheTTL.
+	CacheTTL time.Duration
+	// HTTPClient is the client used to reach the registry.
+	HTTPClient *http.Client
+}
+
+// Validator validates messages against the schemas of their topic.
+type Validator struct {
+	registryURL string
+	apiKey      string
+	apiSecret   string
+	publish     bool
+	subscribe   bool
+	subjects    map[string]string
+	cacheTTL    time.Duration
+	client      *http.Client
+
+	lock     sync.Mutex
+	subjectC map[string]cached
+	idC      map[int]cached
+}
+
+type cached struct {
+	schema  *compiled
+	expires time.Time
+}
+
+// compiled is a schema of the registry. A nil compiled schema validates any
+// message, for example when the topic has no schema.
+type compiled struct {
+	json *jsonschema.Schema
+	avro *goavro.Codec
+}
+
+// New returns a new Validator.
+func New(opts Options) *Validator {
+	cacheTTL := opts.CacheTTL
+	if cacheTTL <= 0 {
+		cacheTTL = DefaultCacheTTL
+	}
+	client := opts.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &Validator{
+		registryURL: strings.TrimSuffix(opts.RegistryURL, "/"),
+		apiKey:      opts.APIKey,
+		apiSecret:   opts.APISecret,
+		publish:     opts.Publish,
+		subscribe:   opts.Subscribe,
+		subjects:    opts.Subjects,
+		cacheTTL:    cacheTTL,
+		client:      client,
+		subjectC:    make(map[string]cached),
+		idC:         make(map[int]cached),
+	}
+}
+
+// FromMetadata returns the Validator configured by the metadata of a pub/sub
+// component, or nil if schema validation is not configured.
+func FromMetadata(md map[string]string) (*Validator, error) {
+	registryURL := md[MetadataKeyRegistryURL]
+	if registryURL == "" {
+		return nil, nil
+	}
+	if _, err := url.Parse(registryURL); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", MetadataKeyRegistryURL, err)
+	}
+
+	opts := Options{
+		RegistryURL: registryURL,
+		APIKey:      md[MetadataKeyRegistryAPIKey],
+		APISecret:   md[MetadataKeyRegistryAPISecret],
+		Publish:     true,
+		Subscribe:   true,
+	}
+
+	if v := md[MetadataKeyValidation]; v != "" {
+		opts.Publish, opts.Subscribe = false, false
+		for _, s := range strings.Split(v, ",") {
+			switch strings.TrimSpace(s) {
+			case ValidationPublish:
+				opts.Publish = true
+			case ValidationSubscribe:
+				opts.Subscribe = true
+			default:
+				return nil, fmt.Errorf("invalid %s '%s': must be a list of '%s' and '%s'", MetadataKeyValidation, v, ValidationPublish, ValidationSubscribe)
+			}
+		}
+	}
+
+	if v := md[MetadataKeySubjects]; v != "" {
+		opts.Subjects = make(map[string]string)
+		for _, pair := range strings.Split(v, ";") {
+			topic, subject, ok := strings.Cut(pair, "=")
+			topic, subject = strings.TrimSpace(topic), strings.TrimSpace(subject)
+			if !ok || topic == "" || subject == "" {
+				return nil, fmt.Errorf("invalid %s '%s': must be a list of topic=subject pairs separated by ';'", MetadataKeySubjects, v)
+			}
+			opts.Subjects[topic] = subject
+		}
+	}
+
+	if v := md[MetadataKeyCacheTTL]; v != "" {
+		ttl, err := time.ParseDuration(v)
+		if err != nil || ttl <= 0 {
+			return nil, fmt.Errorf("invalid %s '%s': must be a positive duration", MetadataKeyCacheTTL, v)
+		}
+		opts.CacheTTL = ttl
+	}
+
+	return New(opts), nil
+}
+
+// ValidatePublish validates the payload of a message published to the topic.
+func (v *Validator) ValidatePublish(ctx context.Context, topic string, payload []byte) error {
+	if v == nil || !v.publish {
+		return nil
+	}
+	return v.validate(ctx, topic, payload)
+}
+
+// ValidateSubscribe validates the payload of a message delivered from the
+// topic.
+func (v *Validator) ValidateSubscribe(ctx context.Context, topic string, payload []byte) error {
+	if v == nil || !v.subscribe {
+		return nil
+	}
+	return v.validate(ctx, topic, payload)
+}
+
+func (v *Validator) validate(ctx context.Context, topic string, payload []byte) error {
+	// Payloads in the Confluent wire format reference the schema they were
+	// encoded with.
+	if len(payload) > 5 && payload[0] == wireFormatMagicByte {
+		id := int(binary.BigEndian.Uint32(payload[1:5]))
+		schema, err := v.schemaByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		return schema.validateBinary(payload[5:])
+	}
+
+	subject := v.subjects[topic]
+	if subject == "" {
+		subject = topic + "-value"
+	}
+	schema, err := v.schemaBySubject(ctx, subject)
+	if err != nil {
+		return err
+	}
+	return schema.validateText(payload)
+}
+
+func (v *Validator) schemaBySubject(ctx context.Context, subject string) (*compiled, error) {
+	v.lock.Lock()
+	c, ok := v.subjectC[subject]
+	v.lock.Unlock()
+	if ok && time.Now().Before(c.expires) {
+		return c.schema, nil
+	}
+
+	schema, err := v.fetch(ctx, "/subjects/"+url.PathEscape(subject)+"/versions/latest")
+	if err != nil {
+		return nil, fmt.Errorf("error getting the schema of subject %s: %w", subject, err)
+	}
+
+	v.lock.Lock()
+	v.subjectC[subject] = cached{schema: schema, expires: time.Now().Add(v.cacheTTL)}
+	v.lock.Unlock()
+	return schema, nil
+}
+
+func (v *Validator) schemaByID(ctx context.Context, id int) (*compiled, error) {
+	v.lock.Lock()
+	c, ok := v.idC[id]
+	v.lock.Unlock()
+	if ok && time.Now().Before(c.expires) {
+		return c.schema, nil
+	}
+
+	schema, err := v.fetch(ctx, "/schemas/ids/"+strconv.Itoa(id))
+	if err != nil {
+		return nil, fmt.Errorf("error getting the schema with id %d: %w", id, err)
+	}
+
+	v.lock.Lock()
+	v.idC[id] = cached{schema: schema, expires: time.Now().Add(v.cacheTTL)}
+	v.lock.Unlock()
+	return schema, nil
+}
+
+// fetch gets a schema from the registry. A schema which is not found in the
+// registry is returned as a nil schema, which validates any message.
+func (v *Validator) fetch(ctx context.Context, path string) (*compiled, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.registryURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.schemaregistry.v1+json")
+	if v.apiKey != "" {
+		req.SetBasicAuth(v.apiKey, v.apiSecret)
+	}
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("schema registry returned status code %d", resp.StatusCode)
+	}
+
+	var res struct {
+		Schema     string `json:"schema"`
+		SchemaType string `json:"schemaType"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, fmt.Errorf("error decoding schema registry response: %w", err)
+	}
+
+	switch res.SchemaType {
+	case "", typeAvro:
+		codec, err := goavro.NewCodec(res.Schema)
+		if err != nil {
+			return nil, fmt.Errorf("invalid avro schema: %w", err)
+		}
+		return &compiled{avro: codec}, nil
+	case typeJSON:
+		compiler := jsonschema.NewCompiler()
+		if err = compiler.AddResource("schema.json", strings.NewReader(res.Schema)); err != nil {
+			return nil, fmt.Errorf("invalid json schema: %w", err)
+		}
+		schema, err := compiler.Compile("schema.json")
+		if err != nil {
+			return nil, fmt.Errorf("invalid json schema: %w", err)
+		}
+		return &compiled{json: schema}, nil
+	case typeProtobuf:
+		log.Warnf("Protobuf schemas are not supported for validation; skipping the validation of %s", path)
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown schema type %s", res.SchemaType)
+	}
+}
+
+// validateText validates a JSON payload. Payloads validated against an Avro
+// schema use the Avro JSON encoding.
+func (c *compiled) validateText(payload []byte) error {
+	switch {
+	case c == nil:
+		return nil
+	case c.json != nil:
+		var v any
+		dec := json.NewDecoder(bytes.NewReader(payload))
+		dec.UseNumber()
+		if err := dec.Decode(&v); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalid, err)
+		}
+		if err := c.json.Validate(v); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalid, err)
+		}
+	default:
+		if _, _, err := c.avro.NativeFromTextual(payload); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalid, err)
+		}
+	}
+	return nil
+}
+
+// validateBinary validates a payload encoded in the Confluent wire format,
+// without its header.
+func (c *compiled) validateBinary(payload []byte) error {
+	switch {
+	case c == nil:
+		return nil
+	case c.json != nil:
+		return c.validateText(payload)
+	default:
+		if _, _, err := c.avro.NativeFromBinary(payload); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalid, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	jsonSchema = `{"type":"object","properties":{"id":{"type":"integer"}},"required":["id"]}`
+	avroSchema = `{"type":"record","name":"order","fields":[{"name":"id","type":"long"}]}`
+)
+
+// registry serves the schemas of a schema registry, by path.
+func registry(t *testing.T, schemas map[string]map[string]string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		s, ok := schemas[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(s)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func TestValidate(t *testing.T) {
+	srv, calls := registry(t, map[string]map[string]string{
+		"/subjects/orders-value/versions/latest": {"schema": jsonSchema, "schemaType": "JSON"},
+		"/subjects/payments/versions/latest":     {"schema": avroSchema},
+		"/schemas/ids/7":                         {"schema": avroSchema, "schemaType": "AVRO"},
+		"/subjects/proto-value/versions/latest":  {"schema": "syntax = \"proto3\";", "schemaType": "PROTOBUF"},
+	})
+	v := New(Options{
+		RegistryURL: srv.URL,
+		Publish:     true,
+		Subscribe:   true,
+		Subjects:    map[string]string{"payments": "payments"},
+	})
+
+	t.Run("json schema", func(t *testing.T) {
+		require.NoError(t, v.ValidatePublish(t.Context(), "orders", []byte(`{"id":1}`)))
+		require.ErrorIs(t, v.ValidatePublish(t.Context(), "orders", []byte(`{"id":"one"}`)), ErrInvalid)
+		require.ErrorIs(t, v.ValidateSubscribe(t.Context(), "orders", []byte(`not json`)), ErrInvalid)
+	})
+
+	t.Run("avro schema", func(t *testing.T) {
+		require.NoError(t, v.ValidatePublish(t.Context(), "payments", []byte(`{"id":1}`)))
+		require.ErrorIs(t, v.ValidatePublish(t.Context(), "payments", []byte(`{"name":"one"}`)), ErrInvalid)
+	})
+
+	t.Run("wire format", func(t *testing.T) {
+		codec, err := goavro.NewCodec(avroSchema)
+		require.NoError(t, err)
+		encoded, err := codec.BinaryFromNative([]byte{0, 0, 0, 0, 7}, map[string]any{"id": int64(1)})
+		require.NoError(t, err)
+		require.NoError(t, v.ValidateSubscribe(t.Context(), "any", encoded))
+		require.ErrorIs(t, v.ValidateSubscribe(t.Context(), "any", []byte{0, 0, 0, 0, 7, 0xff}), ErrInvalid)
+	})
+
+	t.Run("topics without a schema are not validated", func(t *testing.T) {
+		require.NoError(t, v.ValidatePublish(t.Context(), "unknown", []byte(`anything`)))
+		require.NoError(t, v.ValidatePublish(t.Context(), "proto", []byte(`anything`)))
+	})
+
+	t.Run("schemas are cached", func(t *testing.T) {
+		before := calls.Load()
+		require.NoError(t, v.ValidatePublish(t.Context(), "orders", []byte(`{"id":2}`)))
+		require.NoError(t, v.ValidatePublish(t.Context(), "unknown", []byte(`anything`)))
+		assert.Equal(t, before, calls.Load())
+	})
+
+	t.Run("disabled directions are not validated", func(t *testing.T) {
+		v := New(Options{RegistryURL: srv.URL, Subscribe: true})
+		require.NoError(t, v.ValidatePublish(t.Context(), "orders", []byte(`{"id":"one"}`)))
+		require.ErrorIs(t, v.ValidateSubscribe(t.Context(), "orders", []byte(`{"id":"one"}`)), ErrInvalid)
+	})
+
+	t.Run("registry errors", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer srv.Close()
+		err := New(Options{RegistryURL: srv.URL, Publish: true}).ValidatePublish(t.Context(), "orders", []byte(`{}`))
+		require.Error(t, err)
+		require.NotErrorIs(t, err, ErrInvalid)
+	})
+
+	t.Run("nil validator", func(t *testing.T) {
+		var v *Validator
+		require.NoError(t, v.ValidatePublish(t.Context(), "orders", []byte(`{"id":"one"}`)))
+		require.NoError(t, v.ValidateSubscribe(t.Context(), "orders", []byte(`{"id":"one"}`)))
+	})
+}
+
+func TestFromMetadata(t *testing.T) {
+	t.Run("not configured", func(t *testing.T) {
+		v, err := FromMetadata(map[string]string{MetadataKeyValidation: ValidationPublish})
+		require.NoError(t, err)
+		assert.Nil(t, v)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		v, err := FromMetadata(map[string]string{MetadataKeyRegistryURL: "http://localhost:8081/"})
+		require.NoError(t, err)
+		require.NotNil(t, v)
+		assert.Equal(t, "http://localhost:8081", v.registryURL)
+		assert.True(t, v.publish)
+		assert.True(t, v.subscribe)
+		assert.Equal(t, DefaultCacheTTL, v.cacheTTL)
+	})
+
+	t.Run("options", func(t *testing.T) {
+		v, err := FromMetadata(map[string]string{
+			MetadataKeyRegistryURL:       "http://localhost:8081",
+			MetadataKeyRegistryAPIKey:    "key",
+			MetadataKeyRegistryAPISecret: "secret",
+			MetadataKeyValidation:        "subscribe",
+			MetadataKeySubjects:          "orders=orders-v2; payments = payments",
+			MetadataKeyCacheTTL:          "1m",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "key", v.apiKey)
+		assert.Equal(t, "secret", v.apiSecret)
+		assert.False(t, v.publish)
+		assert.True(t, v.subscribe)
+		assert.Equal(t, map[string]string{"orders": "orders-v2", "payments": "payments"}, v.subjects)
+		assert.Equal(t, time.Minute, v.cacheTTL)
+	})
+
+	for name, md := range map[string]map[string]string{
+		"invalid validation": {MetadataKeyValidation: "both"},
+		"invalid subjects":   {MetadataKeySubjects: "orders"},
+		"invalid cache ttl":  {MetadataKeyCacheTTL: "-1s"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			md[MetadataKeyRegistryURL] = "http://localhost:8081"
+			_, err := FromMetadata(md)
+			require.Error(t, err)
+		})
+	}
+}
@@ -1,17 +1,38 @@
 package pubsub
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/dapr/dapr/pkg/expr"
+)
 
 type Subscription struct {
-	PubsubName      string            `json:"pubsubname"`
-	Topic           string            `json:"topic"`
-	DeadLetterTopic string            `json:"deadLetterTopic"`
-	Metadata        map[string]string `json:"metadata"`
-	Rules           []*Rule           `json:"rules,omitempty"`
-	Scopes          []string          `json:"scopes"`
-	BulkSubscribe   *BulkSubscribe    `json:"bulkSubscribe"`
+	PubsubName            string            `json:"pubsubname"`
+	Topic                 string            `json:"topic"`
+	DeadLetterTopic       string            `json:"deadLetterTopic"`
+	Metadata              map[string]string `json:"metadata"`
+	Rules                 []*Rule           `json:"rules,omitempty"`
+	Scopes                []string          `json:"scopes"`
+	BulkSubscribe         *BulkSubscribe    `json:"bulkSubscribe"`
+	Ordering              Ordering          `json:"ordering,omitempty"`
+	MaxConcurrentHandlers int32             `json:"maxConcurrentHandlers,omitempty"`
+	Prefetch              int32             `json:"prefetch,omitempty"`
+	Priority              int32             `json:"priority,omitempty"`
+	Filter                *expr.Expr        `json:"filter,omitempty"`
 }
 
+// Ordering is the ordering mode of the delivery of messages to the app.
+type Ordering string
+
+const (
+	// OrderingNone delivers messages to the app as they are received, with no
+	// ordering guarantees.
+	OrderingNone Ordering = ""
+	// OrderingPartitionKey serializes the delivery of messages sharing the same
+	// partition key.
+	OrderingPartitionKey Ordering = "partitionKey"
+)
+
 type BulkSubscribe struct {
 	Enabled            bool  `json:"enabled"`
 	MaxMessagesCount   int32 `json:"maxMessagesCount,omitempty"`
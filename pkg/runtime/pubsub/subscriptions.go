@@ -62,13 +62,18 @@ var (
 
 type (
 	SubscriptionJSON struct {
-		PubsubName      string            `json:"pubsubname"`
-		Topic           string            `json:"topic"`
-		DeadLetterTopic string            `json:"deadLetterTopic"`
-		Metadata        map[string]string `json:"metadata,omitempty"`
-		Route           string            `json:"route"`  // Single route from v1alpha1
-		Routes          RoutesJSON        `json:"routes"` // Multiple routes from v2alpha1
-		BulkSubscribe   BulkSubscribeJSON `json:"bulkSubscribe,omitempty"`
+		PubsubName            string            `json:"pubsubname"`
+		Topic                 string            `json:"topic"`
+		DeadLetterTopic       string            `json:"deadLetterTopic"`
+		Metadata              map[string]string `json:"metadata,omitempty"`
+		Route                 string            `json:"route"`  // Single route from v1alpha1
+		Routes                RoutesJSON        `json:"routes"` // Multiple routes from v2alpha1
+		BulkSubscribe         BulkSubscribeJSON `json:"bulkSubscribe,omitempty"`
+		Ordering              string            `json:"ordering,omitempty"`
+		MaxConcurrentHandlers int32             `json:"maxConcurrentHandlers,omitempty"`
+		Prefetch              int32             `json:"prefetch,omitempty"`
+		Priority              int32             `json:"priority,omitempty"`
+		Filter                string            `json:"filter,omitempty"`
 	}
 
 	RoutesJSON struct {
@@ -171,18 +176,27 @@ func GetSubscriptionsHTTP(ctx context.Context, channel channel.AppChannel, log l
 				}
 				n++
 			}
+			filter, err := CreateFilter(si.Filter)
+			if err != nil {
+				return nil, err
+			}
 			bulkSubscribe := &BulkSubscribe{
 				Enabled:            si.BulkSubscribe.Enabled,
 				MaxMessagesCount:   si.BulkSubscribe.MaxMessagesCount,
 				MaxAwaitDurationMs: si.BulkSubscribe.MaxAwaitDurationMs,
 			}
 			subscriptions[i] = Subscription{
-				PubsubName:      si.PubsubName,
-				Topic:           si.Topic,
-				Metadata:        si.Metadata,
-				DeadLetterTopic: si.DeadLetterTopic,
-				Rules:           rules[:n],
-				BulkSubscribe:   bulkSubscribe,
+				PubsubName:            si.PubsubName,
+				Topic:                 si.Topic,
+				Metadata:              si.Metadata,
+				DeadLetterTopic:       si.DeadLetterTopic,
+				Rules:                 rules[:n],
+				BulkSubscribe:         bulkSubscribe,
+				Ordering:              Ordering(si.Ordering),
+				MaxConcurrentHandlers: si.MaxConcurrentHandlers,
+				Prefetch:              si.Prefetch,
+				Priority:              si.Priority,
+				Filter:                filter,
 			}
 		}
 
@@ -303,6 +317,20 @@ func parseRoutingRulesGRPC(routes *runtimev1pb.TopicRoutes) ([]*Rule, error) {
 	return r, nil
 }
 
+// CreateFilter returns the compiled filter expression of a subscription, or
+// nil if the subscription has no filter.
+func CreateFilter(filter string) (*expr.Expr, error) {
+	filter = strings.TrimSpace(filter)
+	if filter == "" {
+		return nil, nil
+	}
+	e := &expr.Expr{}
+	if err := e.DecodeString(filter); err != nil {
+		return nil, fmt.Errorf("invalid subscription filter %q: %w", filter, err)
+	}
+	return e, nil
+}
+
 func CreateRoutingRule(match, path string) (*Rule, error) {
 	var e *expr.Expr
 	matchTrimmed := strings.TrimSpace(match)
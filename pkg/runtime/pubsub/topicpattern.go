@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"context"
+	"regexp"
+	"strings"
+)
+
+// TopicLister is implemented by pub/sub components which can list the topics
+// of their broker. The runtime uses it to subscribe to the topics matching a
+// topic pattern when the component cannot subscribe to patterns natively.
+type TopicLister interface {
+	ListTopics(ctx context.Context) ([]string, error)
+}
+
+// IsTopicPattern returns true if the topic of a subscription is a pattern.
+func IsTopicPattern(topic string) bool {
+	return strings.Contains(topic, "*")
+}
+
+// TopicPattern matches topics against a topic pattern. In a pattern, `*`
+// matches any sequence of characters within a segment of a topic, segments
+// being separated by `.` or `/`, and `**` matches any sequence of characters
+// including separators. For example `orders.*` matches `orders.created` but
+// not `orders.eu.created`, which is matched by `orders.**`.
+type TopicPattern struct {
+	re *regexp.Regexp
+}
+
+// NewTopicPattern returns the TopicPattern of a pattern.
+func NewTopicPattern(pattern string) TopicPattern {
+	var b strings.Builder
+	b.WriteString("^")
+	for i, part := range strings.Split(pattern, "**") {
+		if i > 0 {
+			b.WriteString(".*")
+		}
+		for j, seg := range strings.Split(part, "*") {
+			if j > 0 {
+				b.WriteString("[^./]*")
+			}
+			b.WriteString(regexp.QuoteMeta(seg))
+		}
+	}
+	b.WriteString("$")
+	return TopicPattern{re: regexp.MustCompile(b.String())}
+}
+
+// Match returns true if the topic matches the pattern.
+func (p TopicPattern) Match(topic string) bool {
+	return p.re.MatchString(topic)
+}
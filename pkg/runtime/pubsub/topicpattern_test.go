@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopicPattern(t *testing.T) {
+	assert.True(t, IsTopicPattern("orders.*"))
+	assert.False(t, IsTopicPattern("orders"))
+
+	tests := []struct {
+		pattern string
+		topic   string
+		match   bool
+	}{
+		{"orders.*", "orders.created", true},
+		{"orders.*", "orders.", true},
+		{"orders.*", "orders", false},
+		{"orders.*", "orders.eu.created", false},
+		{"orders.*", "ordersXcreated", false},
+		{"orders.**", "orders.eu.created", true},
+		{"orders/*", "orders/created", true},
+		{"orders/*", "orders/eu/created", false},
+		{"*.created", "orders.created", true},
+		{"order*", "orders", true},
+		{"order*", "orders.created", false},
+		{"orders.[eu]*", "orders.[eu]created", true},
+		{"orders.[eu]*", "orders.ecreated", false},
+		{"**", "anything/at.all", true},
+	}
+	for _, test := range tests {
+		t.Run(test.pattern+" "+test.topic, func(t *testing.T) {
+			assert.Equal(t, test.match, NewTopicPattern(test.pattern).Match(test.topic))
+		})
+	}
+}
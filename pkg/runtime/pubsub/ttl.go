@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+)
+
+const (
+	// MetadataKeyMessageTTL is the subscription metadata key setting the
+	// maximum age, in seconds, of a message at delivery time. The age is
+	// computed from the CloudEvent `time` attribute.
+	MetadataKeyMessageTTL = "messageTTLInSeconds"
+	// MetadataKeyExpiredMessageAction is the subscription metadata key setting
+	// what the runtime does with expired messages.
+	MetadataKeyExpiredMessageAction = "expiredMessageAction"
+)
+
+// ExpiredMessageAction is the action taken by the runtime for a message which
+// has expired by the time it is delivered.
+type ExpiredMessageAction string
+
+const (
+	// ExpiredMessageActionDeadLetter drops expired messages, sending them to the
+	// dead letter topic of the subscription if one is configured. This is the
+	// default.
+	ExpiredMessageActionDeadLetter ExpiredMessageAction = "deadletter"
+	// ExpiredMessageActionDrop drops expired messages without sending them to
+	// the dead letter topic.
+	ExpiredMessageActionDrop ExpiredMessageAction = "drop"
+	// ExpiredMessageActionDeliver disables TTL enforcement in the runtime, and
+	// expired messages are delivered to the app.
+	ExpiredMessageActionDeliver ExpiredMessageAction = "deliver"
+)
+
+// TTLPolicy is the message expiration policy of a subscription.
+type TTLPolicy struct {
+	// TTL is the maximum age of a message at delivery time. Zero means only the
+	// expiration set on the message itself is enforced.
+	TTL    time.Duration
+	Action ExpiredMessageAction
+}
+
+// TTLPolicyFromMetadata returns the TTL policy configured in the metadata of a
+// subscription.
+func TTLPolicyFromMetadata(md map[string]string) (TTLPolicy, error) {
+	policy := TTLPolicy{
+		Action: ExpiredMessageActionDeadLetter,
+	}
+
+	if val, ok := md[MetadataKeyMessageTTL]; ok && val != "" {
+		secs, err := strconv.ParseInt(val, 10, 64)
+		if err != nil || secs < 0 {
+			return TTLPolicy{}, fmt.Errorf("invalid value for metadata %s: %q", MetadataKeyMessageTTL, val)
+		}
+		policy.TTL = time.Duration(secs) * time.Second
+	}
+
+	if val, ok := md[MetadataKeyExpiredMessageAction]; ok && val != "" {
+		switch action := ExpiredMessageAction(strings.ToLower(val)); action {
+		case ExpiredMessageActionDeadLetter, ExpiredMessageActionDrop, ExpiredMessageActionDeliver:
+			policy.Action = action
+		default:
+			return TTLPolicy{}, fmt.Errorf("invalid value for metadata %s: %q", MetadataKeyExpiredMessageAction, val)
+		}
+	}
+
+	return policy, nil
+}
+
+// HasExpired returns true if the given CloudEvent has expired as of now,
+// either because its own expiration has passed or because it is older than
+// the TTL of the policy.
+func (p TTLPolicy) HasExpired(cloudEvent map[string]any, now time.Time) bool {
+	if p.Action == ExpiredMessageActionDeliver {
+		return false
+	}
+
+	if contribpubsub.HasExpired(cloudEvent) {
+		return true
+	}
+
+	if p.TTL <= 0 {
+		return false
+	}
+
+	t, ok := cloudEvent[contribpubsub.TimeField].(string)
+	if !ok || t == "" {
+		return false
+	}
+	published, err := time.Parse(time.RFC3339, t)
+	if err != nil {
+		return false
+	}
+
+	return now.After(published.Add(p.TTL))
+}
+
+// DeadLetter returns true if expired messages should be sent to the dead
+// letter topic.
+func (p TTLPolicy) DeadLetter() bool {
+	return p.Action == ExpiredMessageActionDeadLetter
+}
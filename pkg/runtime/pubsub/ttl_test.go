@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+)
+
+func TestTTLPolicyFromMetadata(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		policy, err := TTLPolicyFromMetadata(nil)
+		require.NoError(t, err)
+		assert.Equal(t, TTLPolicy{Action: ExpiredMessageActionDeadLetter}, policy)
+	})
+
+	t.Run("ttl and action", func(t *testing.T) {
+		policy, err := TTLPolicyFromMetadata(map[string]string{
+			MetadataKeyMessageTTL:           "30",
+			MetadataKeyExpiredMessageAction: "Drop",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, TTLPolicy{TTL: 30 * time.Second, Action: ExpiredMessageActionDrop}, policy)
+	})
+
+	t.Run("invalid ttl", func(t *testing.T) {
+		_, err := TTLPolicyFromMetadata(map[string]string{MetadataKeyMessageTTL: "-1"})
+		require.Error(t, err)
+	})
+
+	t.Run("invalid action", func(t *testing.T) {
+		_, err := TTLPolicyFromMetadata(map[string]string{MetadataKeyExpiredMessageAction: "ignore"})
+		require.Error(t, err)
+	})
+}
+
+func TestTTLPolicyHasExpired(t *testing.T) {
+	now := time.Now()
+	published := now.Add(-time.Minute).UTC().Format(time.RFC3339)
+	expired := now.Add(-time.Second).UTC().Format(time.RFC3339)
+
+	tests := map[string]struct {
+		policy     TTLPolicy
+		cloudEvent map[string]any
+		exp        bool
+	}{
+		"no ttl nor expiration": {
+			policy:     TTLPolicy{Action: ExpiredMessageActionDeadLetter},
+			cloudEvent: map[string]any{contribpubsub.TimeField: published},
+		},
+		"message expiration": {
+			policy:     TTLPolicy{Action: ExpiredMessageActionDeadLetter},
+			cloudEvent: map[string]any{contribpubsub.ExpirationField: expired},
+			exp:        true,
+		},
+		"subscription ttl reached": {
+			policy:     TTLPolicy{TTL: 10 * time.Second, Action: ExpiredMessageActionDrop},
+			cloudEvent: map[string]any{contribpubsub.TimeField: published},
+			exp:        true,
+		},
+		"subscription ttl not reached": {
+			policy:     TTLPolicy{TTL: time.Hour, Action: ExpiredMessageActionDrop},
+			cloudEvent: map[string]any{contribpubsub.TimeField: published},
+		},
+		"subscription ttl without time": {
+			policy:     TTLPolicy{TTL: time.Second, Action: ExpiredMessageActionDrop},
+			cloudEvent: map[string]any{},
+		},
+		"enforcement disabled": {
+			policy: TTLPolicy{TTL: time.Second, Action: ExpiredMessageActionDeliver},
+			cloudEvent: map[string]any{
+				contribpubsub.TimeField:       published,
+				contribpubsub.ExpirationField: expired,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.exp, test.policy.HasExpired(test.cloudEvent, now))
+		})
+	}
+}
@@ -0,0 +1,321 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package reencryption implements the background jobs which re-encrypt the
+// values of encrypted state stores with their primary key, so the secondary
+// key can be retired after the keys are rotated.
+package reencryption
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/components-contrib/state/query"
+	"github.com/dapr/dapr/pkg/encryption"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.runtime.reencryption")
+
+var (
+	// ErrStoreNotFound is returned when the state store isn't loaded.
+	ErrStoreNotFound = errors.New("state store not found")
+	// ErrNotEncrypted is returned when the state store isn't encrypted.
+	ErrNotEncrypted = errors.New("state store is not encrypted")
+	// ErrKeysRequired is returned when no keys are given and the keys of the
+	// state store can't be listed with a query.
+	ErrKeysRequired = errors.New("keys are required for state stores which don't support queries")
+	// ErrRunning is returned when a job is already running for the state store.
+	ErrRunning = errors.New("a re-encryption job is already running for the state store")
+	// ErrClosed is returned when the manager has been closed.
+	ErrClosed = errors.New("re-encryption manager is closed")
+)
+
+// State is the state of a re-encryption job.
+type State string
+
+const (
+	StateRunning   State = "RUNNING"
+	StateCompleted State = "COMPLETED"
+	StateFailed    State = "FAILED"
+)
+
+// queryPageSize is the number of values read at a time when the keys of the
+// state store are listed with a query.
+const queryPageSize = 100
+
+type Options struct {
+	GetStateFn func(string) (state.Store, bool)
+	Resiliency resiliency.Provider
+	Clock      clock.Clock
+}
+
+// Status reports the progress of a re-encryption job.
+type Status struct {
+	StoreName   string     `json:"storeName"`
+	State       State      `json:"state"`
+	StartedAt   time.Time  `json:"startedAt"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	// Scanned is the number of keys read, Reencrypted the number of keys
+	// written with the primary key, and Failed the number of keys which could
+	// not be re-encrypted.
+	Scanned     int64  `json:"scanned"`
+	Reencrypted int64  `json:"reencrypted"`
+	Failed      int64  `json:"failed"`
+	Error       string `json:"error,omitempty"`
+}
+
+// Manager runs the re-encryption jobs, one at a time per state store, and
+// keeps the status of the last job of each state store.
+type Manager struct {
+	getStateFn func(string) (state.Store, bool)
+	resiliency resiliency.Provider
+	clock      clock.Clock
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	lock sync.RWMutex
+	jobs map[string]*Status
+}
+
+func New(opts Options) *Manager {
+	if opts.Clock == nil {
+		opts.Clock = clock.RealClock{}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Manager{
+		getStateFn: opts.GetStateFn,
+		resiliency: opts.Resiliency,
+		clock:      opts.Clock,
+		ctx:        ctx,
+		cancel:     cancel,
+		jobs:       make(map[string]*Status),
+	}
+}
+
+// Run waits until the context is canceled, then stops the running jobs.
+func (m *Manager) Run(ctx context.Context) error {
+	<-ctx.Done()
+	m.cancel()
+	m.wg.Wait()
+	return nil
+}
+
+// Start starts re-encrypting the values of the keys of a state store in the
+// background. The keys are the keys as saved in the state store. If no keys
+// are given, all the keys are listed with a query.
+func (m *Manager) Start(storeName string, keys []string) (Status, error) {
+	store, ok := m.getStateFn(storeName)
+	if !ok {
+		return Status{}, fmt.Errorf("%w: %s", ErrStoreNotFound, storeName)
+	}
+	if !encryption.EncryptedStateStore(storeName) {
+		return Status{}, ErrNotEncrypted
+	}
+	if _, ok = store.(state.Querier); !ok && len(keys) == 0 {
+		return Status{}, ErrKeysRequired
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.ctx.Err() != nil {
+		return Status{}, ErrClosed
+	}
+	if job, ok := m.jobs[storeName]; ok && job.State == StateRunning {
+		return *job, ErrRunning
+	}
+
+	job := &Status{
+		StoreName: storeName,
+		State:     StateRunning,
+		StartedAt: m.clock.Now(),
+	}
+	m.jobs[storeName] = job
+
+	log.Infof("Re-encrypting state store %s", storeName)
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		m.run(storeName, store, keys, job)
+	}()
+
+	return *job, nil
+}
+
+// Status returns the status of the last job of a state store, and false if no
+// job has been started.
+func (m *Manager) Status(storeName string) (Status, bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	job, ok := m.jobs[storeName]
+	if !ok {
+		return Status{}, false
+	}
+	return *job, true
+}
+
+func (m *Manager) run(storeName string, store state.Store, keys []string, job *Status) {
+	var err error
+	if len(keys) > 0 {
+		for _, key := range keys {
+			if m.ctx.Err() != nil {
+				break
+			}
+			m.reencrypt(storeName, store, job, key, nil, nil)
+		}
+	} else {
+		err = m.reencryptAll(storeName, store, job)
+	}
+	if err == nil {
+		err = m.ctx.Err()
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	completedAt := m.clock.Now()
+	job.CompletedAt = &completedAt
+	switch {
+	case err != nil:
+		job.State, job.Error = StateFailed, err.Error()
+	case job.Failed > 0:
+		job.State, job.Error = StateFailed, fmt.Sprintf("failed to re-encrypt %d keys", job.Failed)
+	default:
+		job.State = StateCompleted
+	}
+	log.Infof("Re-encryption of state store %s finished: %d keys scanned, %d re-encrypted, %d failed", storeName, job.Scanned, job.Reencrypted, job.Failed)
+}
+
+// reencryptAll re-encrypts all the values of the state store, listed page by
+// page with a query.
+func (m *Manager) reencryptAll(storeName string, store state.Store, job *Status) error {
+	querier := store.(state.Querier)
+	var token string
+	for m.ctx.Err() == nil {
+		req := &state.QueryRequest{
+			Query: query.Query{
+				QueryFields: query.QueryFields{
+					Page: query.Pagination{Limit: queryPageSize, Token: token},
+				},
+			},
+		}
+
+		var res *state.QueryResponse
+		err := m.runPolicy(storeName, func(ctx context.Context) (err error) {
+			res, err = querier.Query(ctx, req)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list keys: %w", err)
+		}
+		if res == nil {
+			return nil
+		}
+
+		for _, item := range res.Results {
+			if item.Error != "" {
+				log.Warnf("Failed to re-encrypt key %s in state store %s: %s", item.Key, storeName, item.Error)
+				m.count(job, 1, 0, 1)
+				continue
+			}
+			m.reencrypt(storeName, store, job, item.Key, item.Data, item.ETag)
+		}
+
+		if res.Token == "" || len(res.Results) == 0 {
+			return nil
+		}
+		token = res.Token
+	}
+	return nil
+}
+
+// reencrypt re-encrypts the value of a key, reading it first if data is nil.
+// Values are written guarded by their ETag, so values written concurrently,
+// which are encrypted with the primary key already, aren't overwritten.
+func (m *Manager) reencrypt(storeName string, store state.Store, job *Status, key string, data []byte, etag *string) {
+	if data == nil {
+		err := m.runPolicy(storeName, func(ctx context.Context) error {
+			res, err := store.Get(ctx, &state.GetRequest{
+				Key:     key,
+				Options: state.GetStateOption{Consistency: state.Strong},
+			})
+			if err == nil && res != nil {
+				data, etag = res.Data, res.ETag
+			}
+			return err
+		})
+		if err != nil {
+			log.Warnf("Failed to read key %s in state store %s: %s", key, storeName, err)
+			m.count(job, 1, 0, 1)
+			return
+		}
+	}
+
+	if !encryption.NeedsReencryption(storeName, data) {
+		m.count(job, 1, 0, 0)
+		return
+	}
+
+	val, err := encryption.ReencryptValue(storeName, key, data)
+	if err != nil {
+		log.Warnf("Failed to re-encrypt key %s in state store %s: %s", key, storeName, err)
+		m.count(job, 1, 0, 1)
+		return
+	}
+
+	req := &state.SetRequest{Key: key, Value: val}
+	if etag != nil && state.FeatureETag.IsPresent(store.Features()) {
+		req.ETag = etag
+		req.Options.Concurrency = state.FirstWrite
+	}
+	err = m.runPolicy(storeName, func(ctx context.Context) error {
+		return store.Set(ctx, req)
+	})
+	var etagErr *state.ETagError
+	switch {
+	case errors.As(err, &etagErr) && etagErr.Kind() == state.ETagMismatch:
+		m.count(job, 1, 0, 0)
+	case err != nil:
+		log.Warnf("Failed to write key %s in state store %s: %s", key, storeName, err)
+		m.count(job, 1, 0, 1)
+	default:
+		m.count(job, 1, 1, 0)
+	}
+}
+
+func (m *Manager) count(job *Status, scanned, reencrypted, failed int64) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	job.Scanned += scanned
+	job.Reencrypted += reencrypted
+	job.Failed += failed
+}
+
+// runPolicy calls fn with the resiliency policy of the state store.
+func (m *Manager) runPolicy(storeName string, fn func(context.Context) error) error {
+	policyRunner := resiliency.NewRunner[any](m.ctx,
+		m.resiliency.ComponentOutboundPolicy(storeName, resiliency.Statestore),
+	)
+	_, err := policyRunner(func(ctx context.Context) (any, error) {
+		return nil, fn(ctx)
+	})
+	return err
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reencryption
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/apis/common"
+	"github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	"github.com/dapr/dapr/pkg/encryption"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/mock"
+)
+
+// memStore is an in-memory store.
+type memStore struct {
+	state.Store
+
+	lock sync.Mutex
+	data map[string][]byte
+}
+
+func (s *memStore) Features() []state.Feature {
+	return nil
+}
+
+func (s *memStore) Get(ctx context.Context, req *state.GetRequest) (*state.GetResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return &state.GetResponse{Data: s.data[req.Key]}, nil
+}
+
+func (s *memStore) Set(ctx context.Context, req *state.SetRequest) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.data[req.Key] = req.Value.([]byte)
+	return nil
+}
+
+func (s *memStore) get(key string) []byte {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.data[key]
+}
+
+// queryableStore is an in-memory store which lists its keys with queries.
+type queryableStore struct {
+	*memStore
+}
+
+// Query returns one key per page.
+func (s *queryableStore) Query(ctx context.Context, req *state.QueryRequest) (*state.QueryResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	keys := make([]string, 0, len(s.data))
+	for k := range s.data {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	idx, _ := strconv.Atoi(req.Query.Page.Token)
+	if idx >= len(keys) {
+		return &state.QueryResponse{}, nil
+	}
+	return &state.QueryResponse{
+		Results: []state.QueryItem{{Key: keys[idx], Data: s.data[keys[idx]]}},
+		Token:   strconv.Itoa(idx + 1),
+	}, nil
+}
+
+// setKeys loads the encryption keys of the store named test.
+func setKeys(t *testing.T, primary, secondary string) {
+	t.Helper()
+
+	encryption.RemoveEncryptedStateStore("test")
+	comp := v1alpha1.Component{}
+	for _, item := range []struct{ name, key string }{
+		{"primaryEncryptionKey", primary},
+		{"secondaryEncryptionKey", secondary},
+	} {
+		if item.key == "" {
+			continue
+		}
+		comp.Spec.Metadata = append(comp.Spec.Metadata, common.NameValuePair{
+			Name:         item.name,
+			Value:        common.DynamicValue{JSON: apiextv1.JSON{Raw: []byte(item.key)}},
+			SecretKeyRef: common.SecretKeyRef{Name: item.key[:8]},
+		})
+	}
+	keys, err := encryption.ComponentEncryptionKey(comp, &mock.SecretStore{})
+	require.NoError(t, err)
+	encryption.AddEncryptedStateStore("test", keys)
+}
+
+func randomKey() string {
+	b := make([]byte, 32)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func waitForJob(t *testing.T, m *Manager) Status {
+	t.Helper()
+
+	var status Status
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		var ok bool
+		status, ok = m.Status("test")
+		assert.True(c, ok)
+		assert.NotEqual(c, StateRunning, status.State)
+	}, 5*time.Second, 10*time.Millisecond)
+	return status
+}
+
+func TestManager(t *testing.T) {
+	oldKey, newKey := randomKey(), randomKey()
+	t.Cleanup(func() { encryption.RemoveEncryptedStateStore("test") })
+
+	// encryptAll writes the values with the old key.
+	encryptAll := func(t *testing.T, store *memStore, values map[string]string) {
+		setKeys(t, oldKey, "")
+		for k, v := range values {
+			enc, err := encryption.TryEncryptValue("test", k, []byte(v))
+			require.NoError(t, err)
+			store.data[k] = enc
+		}
+		setKeys(t, newKey, oldKey)
+	}
+
+	newManager := func(t *testing.T, store state.Store) *Manager {
+		m := New(Options{
+			GetStateFn: func(name string) (state.Store, bool) {
+				return store, name == "test"
+			},
+			Resiliency: resiliency.New(nil),
+		})
+		ctx, cancel := context.WithCancel(t.Context())
+		errCh := make(chan error)
+		go func() { errCh <- m.Run(ctx) }()
+		t.Cleanup(func() {
+			cancel()
+			require.NoError(t, <-errCh)
+		})
+		return m
+	}
+
+	assertReencrypted := func(t *testing.T, store *memStore, values map[string]string) {
+		// The values can be read with the new key only.
+		setKeys(t, newKey, "")
+		for k, v := range values {
+			dec, err := encryption.TryDecryptValue("test", k, store.get(k))
+			require.NoError(t, err)
+			assert.Equal(t, v, string(dec))
+		}
+	}
+
+	t.Run("keys", func(t *testing.T) {
+		store := &memStore{data: map[string][]byte{}}
+		values := map[string]string{"a": "1", "b": "2"}
+		encryptAll(t, store, values)
+		m := newManager(t, store)
+
+		status, err := m.Start("test", []string{"a", "b", "missing"})
+		require.NoError(t, err)
+		assert.Equal(t, StateRunning, status.State)
+
+		status = waitForJob(t, m)
+		assert.Equal(t, StateCompleted, status.State)
+		assert.Equal(t, int64(3), status.Scanned)
+		assert.Equal(t, int64(2), status.Reencrypted)
+		assertReencrypted(t, store, values)
+	})
+
+	t.Run("all keys with a query", func(t *testing.T) {
+		store := &queryableStore{memStore: &memStore{data: map[string][]byte{}}}
+		values := map[string]string{"a": "1", "b": "2", "c": "3"}
+		encryptAll(t, store.memStore, values)
+		m := newManager(t, store)
+
+		_, err := m.Start("test", nil)
+		require.NoError(t, err)
+
+		status := waitForJob(t, m)
+		assert.Equal(t, StateCompleted, status.State)
+		assert.Equal(t, int64(3), status.Reencrypted)
+		assertReencrypted(t, store.memStore, values)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		store := &memStore{data: map[string][]byte{}}
+		setKeys(t, newKey, "")
+		m := newManager(t, store)
+
+		_, err := m.Start("test", nil)
+		require.ErrorIs(t, err, ErrKeysRequired)
+		_, err = m.Start("other", []string{"a"})
+		require.ErrorIs(t, err, ErrStoreNotFound)
+		_, ok := m.Status("test")
+		assert.False(t, ok)
+
+		encryption.RemoveEncryptedStateStore("test")
+		_, err = m.Start("test", []string{"a"})
+		require.ErrorIs(t, err, ErrNotEncrypted)
+	})
+}
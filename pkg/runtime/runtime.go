@@ -28,8 +28,6 @@ import (
 	"time"
 
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
-	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
-	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
 	"go.opentelemetry.io/otel/exporters/zipkin"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
@@ -41,6 +39,7 @@ import (
 	"github.com/dapr/components-contrib/state"
 	"github.com/dapr/kit/concurrency"
 	"github.com/dapr/kit/logger"
+	kitstrings "github.com/dapr/kit/strings"
 
 	"github.com/dapr/dapr/pkg/actors"
 	"github.com/dapr/dapr/pkg/actors/hostconfig"
@@ -48,7 +47,10 @@ import (
 	"github.com/dapr/dapr/pkg/api/grpc/manager"
 	"github.com/dapr/dapr/pkg/api/grpc/proxy/codec"
 	"github.com/dapr/dapr/pkg/api/http"
+	"github.com/dapr/dapr/pkg/api/policy"
+	"github.com/dapr/dapr/pkg/api/ratelimit"
 	"github.com/dapr/dapr/pkg/api/universal"
+	bindingrouteapi "github.com/dapr/dapr/pkg/apis/bindingRoute/v1alpha1"
 	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	endpointapi "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
 	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
@@ -57,34 +59,48 @@ import (
 	"github.com/dapr/dapr/pkg/components/pluggable"
 	secretstoresLoader "github.com/dapr/dapr/pkg/components/secretstores"
 	"github.com/dapr/dapr/pkg/config"
+	env "github.com/dapr/dapr/pkg/config/env"
 	"github.com/dapr/dapr/pkg/config/protocol"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	diagUtils "github.com/dapr/dapr/pkg/diagnostics/utils"
 	"github.com/dapr/dapr/pkg/internal/loader"
 	"github.com/dapr/dapr/pkg/internal/loader/disk"
 	"github.com/dapr/dapr/pkg/internal/loader/kubernetes"
+	"github.com/dapr/dapr/pkg/messages"
 	"github.com/dapr/dapr/pkg/messaging"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/dapr/pkg/middleware"
 	middlewarehttp "github.com/dapr/dapr/pkg/middleware/http"
 	"github.com/dapr/dapr/pkg/modes"
 	"github.com/dapr/dapr/pkg/operator/client"
 	"github.com/dapr/dapr/pkg/outbox"
+	"github.com/dapr/dapr/pkg/placement/topology"
 	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/authorizer"
 	"github.com/dapr/dapr/pkg/runtime/channels"
+	"github.com/dapr/dapr/pkg/runtime/componenthealth"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/drain"
 	rterrors "github.com/dapr/dapr/pkg/runtime/errors"
 	"github.com/dapr/dapr/pkg/runtime/hotreload"
+	"github.com/dapr/dapr/pkg/runtime/lockrenewer"
 	"github.com/dapr/dapr/pkg/runtime/meta"
 	"github.com/dapr/dapr/pkg/runtime/processor"
 	"github.com/dapr/dapr/pkg/runtime/pubsub"
 	"github.com/dapr/dapr/pkg/runtime/pubsub/publisher"
 	"github.com/dapr/dapr/pkg/runtime/pubsub/streamer"
+	"github.com/dapr/dapr/pkg/runtime/reencryption"
 	"github.com/dapr/dapr/pkg/runtime/registry"
 	"github.com/dapr/dapr/pkg/runtime/scheduler"
+	"github.com/dapr/dapr/pkg/runtime/secretaudit"
+	"github.com/dapr/dapr/pkg/runtime/statemigration"
+	"github.com/dapr/dapr/pkg/runtime/transaction"
+	wasmhost "github.com/dapr/dapr/pkg/runtime/wasm"
 	"github.com/dapr/dapr/pkg/runtime/wfengine"
 	"github.com/dapr/dapr/pkg/security"
+	"github.com/dapr/dapr/pkg/security/apitoken"
+	securityConsts "github.com/dapr/dapr/pkg/security/consts"
 	"github.com/dapr/dapr/utils"
 )
 
@@ -101,6 +117,12 @@ type DaprRuntime struct {
 	directMessaging   invokev1.DirectMessaging
 	actors            actors.Interface
 	wfengine          wfengine.Interface
+	apiRateLimiter    *ratelimit.Limiter
+	apiAccessPolicy   *policy.Engine
+	apiTokens         *apitoken.Tokens
+	appAPITokens      *apitoken.Tokens
+	appTLS            *channels.AppTLS
+	drainer           *drain.Drainer
 
 	nameResolver          nr.Resolver
 	hostAddress           string
@@ -120,6 +142,12 @@ type DaprRuntime struct {
 	pubsubAdapter         pubsub.Adapter
 	pubsubAdapterStreamer pubsub.AdapterStreamer
 	outbox                outbox.Outbox
+	stateTransactions     *transaction.Coordinator
+	stateReencryption     *reencryption.Manager
+	stateMigration        *statemigration.Manager
+	componentHealth       *componenthealth.Monitor
+	secretAuditor         *secretaudit.Auditor
+	lockRenewer           *lockrenewer.Renewer
 	meta                  *meta.Meta
 	processor             *processor.Processor
 	authz                 *authorizer.Authorizer
@@ -131,6 +159,10 @@ type DaprRuntime struct {
 	grpcAPIServer      grpc.Server
 	grpcInternalServer grpc.Server
 
+	// Initializes the name resolver once the secret stores are loaded, if its
+	// configuration references secrets.
+	initDeferredNameResolution func(context.Context) error
+
 	// Used for testing.
 	initComplete chan struct{}
 
@@ -170,6 +202,7 @@ func newDaprRuntime(ctx context.Context,
 	codec.Register()
 
 	compStore := compstore.New()
+	wasmhost.DefaultHost.Init(compStore, runtimeConfig.id)
 
 	namespace := security.CurrentNamespace()
 	podName := getPodName()
@@ -187,8 +220,23 @@ func newDaprRuntime(ctx context.Context,
 		return nil, err
 	}
 
-	appAPIToken := security.GetAppToken()
-	grpc := createGRPCManager(sec, runtimeConfig, globalConfig, appAPIToken)
+	apiTokens, err := apitoken.FromEnv(securityConsts.APITokenEnvVar)
+	if err != nil {
+		return nil, err
+	}
+	appAPIToken, err := apitoken.FromEnv(securityConsts.AppAPITokenEnvVar)
+	if err != nil {
+		return nil, err
+	}
+	appTLS, err := channels.NewAppTLS(channels.AppTLSOptions{
+		AppConnectionConfig: runtimeConfig.appConnectionConfig,
+		Security:            sec,
+		ComponentStore:      compStore,
+	})
+	if err != nil {
+		return nil, err
+	}
+	grpc := createGRPCManager(sec, runtimeConfig, runtimeConfig.appConnectionConfig, globalConfig, appAPIToken, appTLS)
 
 	authz := authorizer.New(authorizer.Options{
 		ID:           runtimeConfig.id,
@@ -209,6 +257,10 @@ func newDaprRuntime(ctx context.Context,
 		GRPC:                grpc,
 		AppMiddleware:       httpMiddlewareApp,
 		AppAPIToken:         appAPIToken,
+		AppTLS:              appTLS,
+		NewGRPCManager: func(connConfig config.AppConnectionConfig) *manager.Manager {
+			return createGRPCManager(sec, runtimeConfig, connConfig, globalConfig, appAPIToken, appTLS)
+		},
 	})
 
 	pubsubAdapter := publisher.New(publisher.Options{
@@ -227,6 +279,43 @@ func newDaprRuntime(ctx context.Context,
 		CloudEventExtractorFn: pubsub.ExtractCloudEventProperty,
 		Namespace:             namespace,
 	})
+	stateTransactions := transaction.New(transaction.Options{
+		AppID:       runtimeConfig.id,
+		GetStateFn:  compStore.GetStateStore,
+		ListStateFn: compStore.ListStateStores,
+		Resiliency:  resiliencyProvider,
+	})
+	stateReencryption := reencryption.New(reencryption.Options{
+		GetStateFn: compStore.GetStateStore,
+		Resiliency: resiliencyProvider,
+	})
+	stateMigration := statemigration.New(statemigration.Options{
+		GetStateFn:      compStore.GetStateStore,
+		GetStateCacheFn: compStore.GetStateCache,
+		Resiliency:      resiliencyProvider,
+	})
+
+	componentHealth := componenthealth.New(componenthealth.Options{
+		CompStore: compStore,
+		Healthz:   runtimeConfig.outboundHealthz,
+	})
+
+	var secretAuditor *secretaudit.Auditor
+	if globalConfig.Spec.Secrets != nil && globalConfig.Spec.Secrets.Audit != nil {
+		secretAuditor, err = secretaudit.New(secretaudit.Options{
+			AppID:         runtimeConfig.id,
+			Spec:          *globalConfig.Spec.Secrets.Audit,
+			PubSubAdapter: pubsubAdapter,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	readReplicas, err := actorReadReplicas(globalConfig)
+	if err != nil {
+		return nil, err
+	}
 
 	actors := actors.New(actors.Options{
 		AppID:     runtimeConfig.id,
@@ -242,31 +331,45 @@ func newDaprRuntime(ctx context.Context,
 		StateTTLEnabled:    globalConfig.IsFeatureEnabled(config.ActorStateTTL),
 		MaxRequestBodySize: runtimeConfig.maxRequestBodySize,
 		Mode:               runtimeConfig.mode,
+		ReadReplicas:       readReplicas,
+		Zone:               os.Getenv(env.TopologyZone),
+		HostLabels:         topology.ParseLabels(os.Getenv(env.HostLabels)),
 	})
 
+	// The drainer is created before the processor, which tracks the deliveries
+	// to the app with it, and stops the processor inputs once it exists.
+	var stopInputs func()
+	drainer := newDrainer(runtimeConfig.drainTimeout, func() { stopInputs() }, actors, outbox)
+
 	processor := processor.New(processor.Options{
-		ID:              runtimeConfig.id,
-		Namespace:       namespace,
-		IsHTTP:          runtimeConfig.appConnectionConfig.Protocol.IsHTTP(),
-		ActorsEnabled:   len(runtimeConfig.actorsService) > 0,
-		Actors:          actors,
-		Registry:        runtimeConfig.registry,
-		ComponentStore:  compStore,
-		Meta:            meta,
-		GlobalConfig:    globalConfig,
-		Resiliency:      resiliencyProvider,
-		Mode:            runtimeConfig.mode,
-		PodName:         podName,
-		OperatorClient:  operatorClient,
-		GRPC:            grpc,
-		Channels:        channels,
-		MiddlewareHTTP:  httpMiddleware,
-		Security:        sec,
-		Outbox:          outbox,
-		Adapter:         pubsubAdapter,
-		AdapterStreamer: pubsubAdapterStreamer,
-		Reporter:        runtimeConfig.registry.Reporter(),
+		ID:                runtimeConfig.id,
+		Namespace:         namespace,
+		IsHTTP:            runtimeConfig.appConnectionConfig.Protocol.IsHTTP(),
+		ActorsEnabled:     len(runtimeConfig.actorsService) > 0,
+		Actors:            actors,
+		Registry:          runtimeConfig.registry,
+		ComponentStore:    compStore,
+		Meta:              meta,
+		GlobalConfig:      globalConfig,
+		Resiliency:        resiliencyProvider,
+		Mode:              runtimeConfig.mode,
+		PodName:           podName,
+		OperatorClient:    operatorClient,
+		GRPC:              grpc,
+		Channels:          channels,
+		MiddlewareHTTP:    httpMiddleware,
+		Security:          sec,
+		Outbox:            outbox,
+		Adapter:           pubsubAdapter,
+		AdapterStreamer:   pubsubAdapterStreamer,
+		AppMaxConcurrency: runtimeConfig.appConnectionConfig.MaxConcurrency,
+		Reporter:          runtimeConfig.registry.Reporter(),
+		Drainer:           drainer,
 	})
+	stopInputs = func() {
+		processor.Subscriber().StopAllSubscriptionsForever()
+		processor.Binding().StopReadingFromBindings(true)
+	}
 
 	var reloader *hotreload.Reloader
 	switch runtimeConfig.mode {
@@ -318,6 +421,7 @@ func newDaprRuntime(ctx context.Context,
 		Addresses:        runtimeConfig.schedulerAddress,
 		Security:         sec,
 		WFEngine:         wfe,
+		PubSubAdapter:    pubsubAdapter,
 		Healthz:          runtimeConfig.healthz,
 		SchedulerStreams: runtimeConfig.schedulerStreams,
 	})
@@ -330,6 +434,9 @@ func newDaprRuntime(ctx context.Context,
 		globalConfig:          globalConfig,
 		accessControlList:     accessControlList,
 		grpc:                  grpc,
+		apiTokens:             apiTokens,
+		appAPITokens:          appAPIToken,
+		appTLS:                appTLS,
 		tracerProvider:        nil,
 		resiliency:            resiliencyProvider,
 		appHealthReady:        nil,
@@ -337,6 +444,12 @@ func newDaprRuntime(ctx context.Context,
 		pubsubAdapter:         pubsubAdapter,
 		pubsubAdapterStreamer: pubsubAdapterStreamer,
 		outbox:                outbox,
+		stateTransactions:     stateTransactions,
+		stateReencryption:     stateReencryption,
+		stateMigration:        stateMigration,
+		componentHealth:       componentHealth,
+		secretAuditor:         secretAuditor,
+		lockRenewer:           lockrenewer.New(lockrenewer.Options{}),
 		meta:                  meta,
 		operatorClient:        operatorClient,
 		channels:              channels,
@@ -353,6 +466,7 @@ func newDaprRuntime(ctx context.Context,
 		httpMiddleware:        httpMiddleware,
 		actors:                actors,
 		wfengine:              wfe,
+		drainer:               drainer,
 	}
 	close(rt.isAppHealthy)
 
@@ -369,6 +483,14 @@ func newDaprRuntime(ctx context.Context,
 		rt.actors.Run,
 		rt.wfengine.Run,
 		rt.jobsManager.Run,
+		rt.apiTokens.Run,
+		rt.appAPITokens.Run,
+		rt.stateReencryption.Run,
+		rt.stateMigration.Run,
+		rt.componentHealth.Run,
+		rt.secretAuditor.Run,
+		rt.lockRenewer.Run,
+		rt.watchPipelines,
 		func(ctx context.Context) error {
 			start := time.Now()
 			log.Infof("%s mode configured", rt.runtimeConfig.mode)
@@ -438,9 +560,9 @@ func newDaprRuntime(ctx context.Context,
 // Run performs initialization of the runtime with the runtime and global configurations.
 func (a *DaprRuntime) Run(parentCtx context.Context) error {
 	ctx := parentCtx
-	if a.runtimeConfig.blockShutdownDuration != nil {
+	if a.runtimeConfig.blockShutdownDuration != nil || a.runtimeConfig.drainTimeout > 0 {
 		// Override context with Background. Runner context will be cancelled when
-		// blocking graceful shutdown returns.
+		// blocking graceful shutdown or draining returns.
 		ctx = context.Background()
 		a.runnerCloser.Add(func(ctx context.Context) error {
 			select {
@@ -450,16 +572,28 @@ func (a *DaprRuntime) Run(parentCtx context.Context) error {
 				return nil
 			}
 
-			log.Infof("Blocking graceful shutdown for %s or until app reports unhealthy...", *a.runtimeConfig.blockShutdownDuration)
+			if a.runtimeConfig.blockShutdownDuration != nil {
+				log.Infof("Blocking graceful shutdown for %s or until app reports unhealthy...", *a.runtimeConfig.blockShutdownDuration)
 
-			a.processor.Subscriber().StopAllSubscriptionsForever()
-			a.processor.Binding().StopReadingFromBindings(true)
+				a.processor.Subscriber().StopAllSubscriptionsForever()
+				a.processor.Binding().StopReadingFromBindings(true)
 
-			select {
-			case <-a.clock.After(*a.runtimeConfig.blockShutdownDuration):
-				log.Info("Block shutdown period expired, entering shutdown...")
-			case <-a.isAppHealthy:
-				log.Info("App reported unhealthy, entering shutdown...")
+				select {
+				case <-a.clock.After(*a.runtimeConfig.blockShutdownDuration):
+					log.Info("Block shutdown period expired, entering shutdown...")
+				case <-a.isAppHealthy:
+					log.Info("App reported unhealthy, entering shutdown...")
+				}
+			}
+
+			if a.runtimeConfig.drainTimeout > 0 {
+				// The drain may have already been started with the API
+				a.drainer.Drain(a.runtimeConfig.drainTimeout)
+				select {
+				case <-a.drainer.Done():
+					log.Info("Drain completed, entering shutdown...")
+				case <-ctx.Done():
+				}
 			}
 			return nil
 		})
@@ -468,6 +602,35 @@ func (a *DaprRuntime) Run(parentCtx context.Context) error {
 	return a.runnerCloser.Run(ctx)
 }
 
+// newDrainer returns the drainer of the runtime, which stops the subscriptions
+// and bindings, then waits for the in-flight requests, the active actors and
+// the outbox messages.
+func newDrainer(timeout time.Duration, stopInputs func(), actors actors.Interface, outbox outbox.Outbox) *drain.Drainer {
+	return drain.New(drain.Options{
+		Timeout: timeout,
+		Before: []drain.Step{
+			{Name: "subscriptions", Fn: func(context.Context) error {
+				stopInputs()
+				return nil
+			}},
+		},
+		After: []drain.Step{
+			{Name: "actors", Fn: func(ctx context.Context) error {
+				table, err := actors.Table(ctx)
+				if errors.Is(err, messages.ErrActorNoPlacement) {
+					// Actors are disabled
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				return table.HaltAll(ctx)
+			}},
+			{Name: "outbox", Fn: outbox.Flush},
+		},
+	})
+}
+
 func getPodName() string {
 	return os.Getenv("POD_NAME")
 }
@@ -491,9 +654,28 @@ func getOperatorClient(ctx context.Context, sec security.Handler, cfg *internalC
 func (a *DaprRuntime) setupTracing(ctx context.Context, hostAddress string, tpStore tracerProviderStore) error {
 	tracingSpec := a.globalConfig.GetTracingSpec()
 
+	if err := diag.SetB3Propagation(tracingSpec.Propagation.GetB3()); err != nil {
+		return err
+	}
+
+	// With tail sampling, each exporter only receives the traces which errored or were slow.
+	registerExporter := func(exporter sdktrace.SpanExporter) error {
+		if tracingSpec.TailSampling != nil {
+			var err error
+			exporter, err = diag.NewTailSamplingExporter(exporter, *tracingSpec.TailSampling)
+			if err != nil {
+				return err
+			}
+		}
+		tpStore.RegisterExporter(exporter)
+		return nil
+	}
+
 	// Register stdout trace exporter if user wants to debug requests or log as Info level.
 	if tracingSpec.Stdout {
-		tpStore.RegisterExporter(diagUtils.NewStdOutExporter())
+		if err := registerExporter(diagUtils.NewStdOutExporter()); err != nil {
+			return err
+		}
 	}
 
 	// Register zipkin trace exporter if ZipkinSpec is specified
@@ -502,56 +684,24 @@ func (a *DaprRuntime) setupTracing(ctx context.Context, hostAddress string, tpSt
 		if err != nil {
 			return err
 		}
-		tpStore.RegisterExporter(zipkinExporter)
+		if err = registerExporter(zipkinExporter); err != nil {
+			return err
+		}
 	}
 
 	// Register otel trace exporter if OtelSpec is specified
 	if tracingSpec.Otel != nil && tracingSpec.Otel.EndpointAddress != "" && tracingSpec.Otel.Protocol != "" {
-		endpoint := tracingSpec.Otel.EndpointAddress
-		protocol := tracingSpec.Otel.Protocol
-		if protocol != "http" && protocol != "grpc" {
-			return fmt.Errorf("invalid protocol %v provided for Otel endpoint", protocol)
-		}
-
-		var client otlptrace.Client
-		if protocol == "http" {
-			clientOptions := []otlptracehttp.Option{otlptracehttp.WithEndpoint(endpoint)}
-			if !tracingSpec.Otel.GetIsSecure() {
-				clientOptions = append(clientOptions, otlptracehttp.WithInsecure())
-			}
-			if tracingSpec.Otel.Headers != "" {
-				headers, err := config.StringToHeader(tracingSpec.Otel.Headers)
-				if err != nil {
-					return fmt.Errorf("invalid headers provided for Otel endpoint: %w", err)
-				}
-				clientOptions = append(clientOptions, otlptracehttp.WithHeaders(headers))
-			}
-			if tracingSpec.Otel.Timeout > 0 {
-				clientOptions = append(clientOptions, otlptracehttp.WithTimeout(time.Duration(tracingSpec.Otel.Timeout)*time.Millisecond))
-			}
-			client = otlptracehttp.NewClient(clientOptions...)
-		} else {
-			clientOptions := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(endpoint)}
-			if !tracingSpec.Otel.GetIsSecure() {
-				clientOptions = append(clientOptions, otlptracegrpc.WithInsecure())
-			}
-			if tracingSpec.Otel.Headers != "" {
-				headers, err := config.StringToHeader(tracingSpec.Otel.Headers)
-				if err != nil {
-					return fmt.Errorf("invalid headers provided for Otel endpoint: %w", err)
-				}
-				clientOptions = append(clientOptions, otlptracegrpc.WithHeaders(headers))
-			}
-			if tracingSpec.Otel.Timeout > 0 {
-				clientOptions = append(clientOptions, otlptracegrpc.WithTimeout(time.Duration(tracingSpec.Otel.Timeout)*time.Millisecond))
-			}
-			client = otlptracegrpc.NewClient(clientOptions...)
+		client, err := diag.NewOtlpTraceClient(*tracingSpec.Otel)
+		if err != nil {
+			return err
 		}
 		otelExporter, err := otlptrace.New(ctx, client)
 		if err != nil {
 			return err
 		}
-		tpStore.RegisterExporter(otelExporter)
+		if err = registerExporter(otelExporter); err != nil {
+			return err
+		}
 	}
 
 	if !tpStore.HasExporter() && tracingSpec.SamplingRate != "" {
@@ -562,7 +712,10 @@ func (a *DaprRuntime) setupTracing(ctx context.Context, hostAddress string, tpSt
 	tpStore.RegisterResource(r)
 
 	// Register a trace sampler based on Sampling settings
-	daprTraceSampler := diag.NewDaprTraceSampler(tracingSpec.SamplingRate)
+	daprTraceSampler, err := diag.NewDaprTraceSamplerFromSpec(tracingSpec)
+	if err != nil {
+		return err
+	}
 	log.Infof("Dapr trace sampler initialized: %s", daprTraceSampler.Description())
 
 	tpStore.RegisterSampler(daprTraceSampler)
@@ -625,6 +778,25 @@ func (a *DaprRuntime) initRuntime(ctx context.Context) error {
 
 	a.flushOutstandingComponents(ctx)
 
+	// Name resolution configurations referencing secrets are initialized once
+	// the secret stores are loaded.
+	if a.initDeferredNameResolution != nil {
+		if err = a.initDeferredNameResolution(ctx); err != nil {
+			log.Errorf(err.Error())
+		}
+	}
+
+	// Compensate the cross-store transactions left unfinished by a previous run
+	if err = a.stateTransactions.Recover(ctx); err != nil {
+		log.Warnf("failed to recover state transactions: %s", err)
+	}
+
+	for name, cache := range a.compStore.ListStateCaches() {
+		if err = cache.SubscribeInvalidations(ctx); err != nil {
+			log.Warnf("failed to subscribe to state cache invalidations of state store %s: %s", name, err)
+		}
+	}
+
 	err = a.loadHTTPEndpoints(ctx)
 	if err != nil {
 		log.Warnf("failed to load HTTP endpoints: %s", err)
@@ -636,6 +808,16 @@ func (a *DaprRuntime) initRuntime(ctx context.Context) error {
 		return fmt.Errorf("failed to load declarative subscriptions: %s", err)
 	}
 
+	err = a.loadBindingRoutes(ctx)
+	if err != nil {
+		log.Warnf("failed to load binding routes: %s", err)
+	}
+
+	// The certificates of the app channel can be stored in a secret store, so they are loaded after the components
+	if err = a.appTLS.Load(ctx); err != nil {
+		return fmt.Errorf("failed to load the TLS certificates of the app channel: %w", err)
+	}
+
 	if err = a.channels.Refresh(); err != nil {
 		log.Warnf("failed to open %s channel to app: %s", string(a.runtimeConfig.appConnectionConfig.Protocol), err)
 	}
@@ -645,6 +827,18 @@ func (a *DaprRuntime) initRuntime(ctx context.Context) error {
 
 	a.namespace = security.CurrentNamespace()
 
+	// The API rate limits are shared by the HTTP and gRPC servers
+	a.apiRateLimiter, err = ratelimit.New(a.globalConfig.GetAPISpec().RateLimits)
+	if err != nil {
+		return fmt.Errorf("failed to create API rate limiter: %w", err)
+	}
+
+	// The API access policy is shared by the HTTP and gRPC servers too
+	a.apiAccessPolicy, err = policy.New(a.globalConfig.GetAPISpec())
+	if err != nil {
+		return fmt.Errorf("failed to create API access policy: %w", err)
+	}
+
 	// Create and start the external gRPC server
 	a.daprUniversal = universal.New(universal.Options{
 		AppID:                       a.runtimeConfig.id,
@@ -659,6 +853,10 @@ func (a *DaprRuntime) initRuntime(ctx context.Context) error {
 		Scheduler:                   a.jobsManager.Client(),
 		Actors:                      a.actors,
 		WorkflowEngine:              a.wfengine,
+		Drainer:                     a.drainer,
+		SecretAuditor:               a.secretAuditor,
+		StateReencryption:           a.stateReencryption,
+		LockRenewer:                 a.lockRenewer,
 	})
 
 	// Create and start internal and external gRPC servers
@@ -674,6 +872,7 @@ func (a *DaprRuntime) initRuntime(ctx context.Context) error {
 		TracingSpec:           a.globalConfig.GetTracingSpec(),
 		AccessControlList:     a.accessControlList,
 		Processor:             a.processor,
+		MaxReplayBufferSize:   a.runtimeConfig.maxReplayBufferSize,
 	})
 
 	if err = a.runnerCloser.AddCloser(a.daprGRPCAPI); err != nil {
@@ -803,14 +1002,16 @@ func (a *DaprRuntime) appHealthChanged(ctx context.Context, status *apphealth.St
 		}
 
 		if a.channels.AppChannel() != nil {
-			// Start subscribing to topics and reading from input bindings
+			// Start subscribing to topics
 			if err := a.processor.Subscriber().StartAppSubscriptions(); err != nil {
 				log.Warnf("failed to subscribe to topics: %s ", err)
 			}
-			err := a.processor.Binding().StartReadingFromBindings(ctx)
-			if err != nil {
-				log.Warnf("failed to read from bindings: %s ", err)
-			}
+		}
+
+		// Start reading from input bindings. Without an app, only the input
+		// bindings with a binding route are read.
+		if err := a.processor.Binding().StartReadingFromBindings(ctx); err != nil {
+			log.Warnf("failed to read from bindings: %s ", err)
 		}
 
 		// Start subscribing to outbox topics
@@ -825,6 +1026,7 @@ func (a *DaprRuntime) appHealthChanged(ctx context.Context, status *apphealth.St
 			HostedActorTypes:        a.appConfig.Entities,
 			DefaultIdleTimeout:      a.appConfig.ActorIdleTimeout,
 			Reentrancy:              a.appConfig.Reentrancy,
+			LifecycleHooks:          a.appConfig.LifecycleHooks,
 			AppChannel:              a.channels.AppChannel(),
 		}); err != nil {
 			log.Warnf("Failed to register hosted actors: %s", err)
@@ -896,40 +1098,56 @@ func (a *DaprRuntime) startHTTPServer() error {
 		Channels:              a.channels,
 		DirectMessaging:       a.directMessaging,
 		PubSubAdapter:         a.pubsubAdapter,
+		PubSubAdapterStreamer: a.pubsubAdapterStreamer,
+		Processor:             a.processor,
 		Outbox:                a.outbox,
+		StateTransactions:     a.stateTransactions,
+		StateMigration:        a.stateMigration,
 		SendToOutputBindingFn: a.processor.Binding().SendToOutputBinding,
+		PauseInputBindingFn:   a.processor.Binding().PauseInputBinding,
+		ResumeInputBindingFn:  a.processor.Binding().ResumeInputBinding,
 		TracingSpec:           a.globalConfig.GetTracingSpec(),
 		MetricSpec:            &getMetricSpec,
 		MaxRequestBodySize:    int64(a.runtimeConfig.maxRequestBodySize),
+		MaxReplayBufferSize:   a.runtimeConfig.maxReplayBufferSize,
 		Healthz:               a.runtimeConfig.healthz,
 		OutboundHealthz:       a.runtimeConfig.outboundHealthz,
+		APITokens:             a.apiTokens,
 	})
 
 	serverConf := http.ServerConfig{
-		AppID:                   a.runtimeConfig.id,
-		HostAddress:             a.hostAddress,
-		Port:                    a.runtimeConfig.httpPort,
-		APIListenAddresses:      a.runtimeConfig.apiListenAddresses,
-		PublicPort:              a.runtimeConfig.publicPort,
-		PublicListenAddress:     a.runtimeConfig.publicListenAddress,
-		ProfilePort:             a.runtimeConfig.profilePort,
-		AllowedOrigins:          a.runtimeConfig.allowedOrigins,
-		EnableProfiling:         a.runtimeConfig.enableProfiling,
-		MaxRequestBodySize:      a.runtimeConfig.maxRequestBodySize,
-		UnixDomainSocket:        a.runtimeConfig.unixDomainSocket,
-		ReadBufferSize:          a.runtimeConfig.readBufferSize,
-		EnableAPILogging:        *a.runtimeConfig.enableAPILogging,
-		APILoggingObfuscateURLs: a.globalConfig.GetAPILoggingSpec().ObfuscateURLs,
-		APILogHealthChecks:      !a.globalConfig.GetAPILoggingSpec().OmitHealthChecks,
+		AppID:                    a.runtimeConfig.id,
+		HostAddress:              a.hostAddress,
+		Port:                     a.runtimeConfig.httpPort,
+		APIListenAddresses:       a.runtimeConfig.apiListenAddresses,
+		PublicPort:               a.runtimeConfig.publicPort,
+		PublicListenAddress:      a.runtimeConfig.publicListenAddress,
+		ProfilePort:              a.runtimeConfig.profilePort,
+		AllowedOrigins:           a.runtimeConfig.allowedOrigins,
+		EnableProfiling:          a.runtimeConfig.enableProfiling,
+		MaxRequestBodySize:       a.runtimeConfig.maxRequestBodySize,
+		MaxRequestBodySizePerAPI: a.runtimeConfig.maxRequestBodySizePerAPI,
+		UnixDomainSocket:         a.runtimeConfig.unixDomainSocket,
+		ReadBufferSize:           a.runtimeConfig.readBufferSize,
+		EnableAPILogging:         *a.runtimeConfig.enableAPILogging,
+		APILoggingObfuscateURLs:  a.globalConfig.GetAPILoggingSpec().ObfuscateURLs,
+		APILogHealthChecks:       !a.globalConfig.GetAPILoggingSpec().OmitHealthChecks,
+		DisableH2C:               kitstrings.IsTruthy(os.Getenv("DAPR_HTTP_DISABLE_H2C")),
+		TLSCertFile:              a.runtimeConfig.httpTLSCertFile,
+		TLSKeyFile:               a.runtimeConfig.httpTLSKeyFile,
 	}
 
 	server := http.NewServer(http.NewServerOpts{
-		API:         a.daprHTTPAPI,
-		Config:      serverConf,
-		TracingSpec: a.globalConfig.GetTracingSpec(),
-		MetricSpec:  a.globalConfig.GetMetricsSpec(),
-		Middleware:  a.httpMiddleware.BuildPipelineFromSpec("server", a.globalConfig.Spec.HTTPPipelineSpec),
-		APISpec:     a.globalConfig.GetAPISpec(),
+		API:          a.daprHTTPAPI,
+		Config:       serverConf,
+		TracingSpec:  a.globalConfig.GetTracingSpec(),
+		MetricSpec:   a.globalConfig.GetMetricsSpec(),
+		Middleware:   a.httpMiddleware.BuildPipelineFromSpec("server", a.globalConfig.Spec.HTTPPipelineSpec),
+		APISpec:      a.globalConfig.GetAPISpec(),
+		RateLimiter:  a.apiRateLimiter,
+		AccessPolicy: a.apiAccessPolicy,
+		APITokens:    a.apiTokens,
+		Drainer:      a.drainer,
 	})
 	if err := server.StartNonBlocking(); err != nil {
 		return err
@@ -963,15 +1181,27 @@ func (a *DaprRuntime) startGRPCInternalServer(api grpc.API) error {
 
 func (a *DaprRuntime) startGRPCAPIServer(api grpc.API, port int) error {
 	serverConf := a.getNewServerConfig(a.runtimeConfig.apiListenAddresses, port)
+
+	// The middleware pipeline is only added to the gRPC server when it has handlers or routes
+	var pipeline middleware.HTTP
+	if spec := a.globalConfig.Spec.GRPCPipelineSpec; spec != nil && (len(spec.Handlers) > 0 || len(spec.Routes) > 0) {
+		pipeline = a.httpMiddleware.BuildPipelineFromSpec("grpc", spec)
+	}
+
 	a.grpcAPIServer = grpc.NewAPIServer(grpc.Options{
 		API:            api,
 		Config:         serverConf,
 		TracingSpec:    a.globalConfig.GetTracingSpec(),
 		MetricSpec:     a.globalConfig.GetMetricsSpec(),
 		APISpec:        a.globalConfig.GetAPISpec(),
+		RateLimiter:    a.apiRateLimiter,
+		AccessPolicy:   a.apiAccessPolicy,
 		Proxy:          a.proxy,
 		WorkflowEngine: a.wfengine,
 		Healthz:        a.runtimeConfig.healthz,
+		APITokens:      a.apiTokens,
+		Pipeline:       pipeline,
+		Drainer:        a.drainer,
 	})
 
 	if err := a.grpcAPIServer.StartNonBlocking(); err != nil {
@@ -989,16 +1219,17 @@ func (a *DaprRuntime) getNewServerConfig(apiListenAddresses []string, port int)
 		trustDomain = a.accessControlList.TrustDomain
 	}
 	return grpc.ServerConfig{
-		AppID:              a.runtimeConfig.id,
-		HostAddress:        a.hostAddress,
-		Port:               port,
-		APIListenAddresses: apiListenAddresses,
-		NameSpace:          a.namespace,
-		TrustDomain:        trustDomain,
-		MaxRequestBodySize: a.runtimeConfig.maxRequestBodySize,
-		UnixDomainSocket:   a.runtimeConfig.unixDomainSocket,
-		ReadBufferSize:     a.runtimeConfig.readBufferSize,
-		EnableAPILogging:   *a.runtimeConfig.enableAPILogging,
+		AppID:                    a.runtimeConfig.id,
+		HostAddress:              a.hostAddress,
+		Port:                     port,
+		APIListenAddresses:       apiListenAddresses,
+		NameSpace:                a.namespace,
+		TrustDomain:              trustDomain,
+		MaxRequestBodySize:       a.runtimeConfig.maxRequestBodySize,
+		MaxRequestBodySizePerAPI: a.runtimeConfig.maxRequestBodySizePerAPI,
+		UnixDomainSocket:         a.runtimeConfig.unixDomainSocket,
+		ReadBufferSize:           a.runtimeConfig.readBufferSize,
+		EnableAPILogging:         *a.runtimeConfig.enableAPILogging,
 	}
 }
 
@@ -1038,8 +1269,10 @@ func (a *DaprRuntime) initNameResolution(ctx context.Context) (err error) {
 	}
 
 	resolverMetadata.Name = resolverName
+	var secretStore string
 	if a.globalConfig.Spec.NameResolutionSpec != nil {
 		resolverMetadata.Configuration = a.globalConfig.Spec.NameResolutionSpec.Configuration
+		secretStore = a.globalConfig.Spec.NameResolutionSpec.SecretStore
 	}
 	// Override host address if the internal gRPC listen address is localhost.
 	hostAddress := a.hostAddress
@@ -1058,6 +1291,28 @@ func (a *DaprRuntime) initNameResolution(ctx context.Context) (err error) {
 		Namespace:        a.namespace,
 	}
 
+	if hasSecretURIs(resolverMetadata.Configuration) {
+		var deferred *deferredResolver
+		a.nameResolver, deferred = newDeferredResolver(a.nameResolver)
+		a.initDeferredNameResolution = func(ctx context.Context) error {
+			var err error
+			resolverMetadata.Configuration, err = a.processor.Secret().ResolveURIs(ctx, resolverMetadata.Configuration, secretStore)
+			if err != nil {
+				deferred.fail(err)
+				diag.DefaultMonitoring.ComponentInitFailed("nameResolution", "init", resolverName)
+				return rterrors.NewInit(rterrors.InitComponentFailure, fName, err)
+			}
+			if err = a.nameResolver.Init(ctx, resolverMetadata); err != nil {
+				diag.DefaultMonitoring.ComponentInitFailed("nameResolution", "init", resolverName)
+				return rterrors.NewInit(rterrors.InitComponentFailure, fName, err)
+			}
+			log.Infof("Initialized name resolution to %s", resolverName)
+			return nil
+		}
+		log.Infof("Name resolution to %s references secrets and is initialized once the secret stores are loaded", resolverName)
+		return a.runnerCloser.AddCloser(a.nameResolver)
+	}
+
 	err = a.nameResolver.Init(ctx, resolverMetadata)
 	if err != nil {
 		diag.DefaultMonitoring.ComponentInitFailed("nameResolution", "init", resolverName)
@@ -1083,6 +1338,10 @@ func (a *DaprRuntime) initActors(ctx context.Context) error {
 		log.Info("actors: state store is not configured - this is okay for clients but services with hosted actors will fail to initialize!")
 	}
 
+	// Reminders are kept by the Scheduler service, unless a state store is
+	// marked as the actor reminder store.
+	actorReminderStoreName, _ := a.processor.State().ActorReminderStoreName()
+
 	// Override host address if the internal gRPC listen address is localhost.
 	hostAddress := a.hostAddress
 	if utils.Contains(
@@ -1098,6 +1357,7 @@ func (a *DaprRuntime) initActors(ctx context.Context) error {
 		GRPC:              a.grpc,
 		SchedulerClient:   a.jobsManager.Client(),
 		SchedulerReloader: a.jobsManager,
+		ReminderStoreName: actorReminderStoreName,
 	}); err != nil {
 		return err
 	}
@@ -1244,6 +1504,36 @@ func (a *DaprRuntime) loadHTTPEndpoints(ctx context.Context) error {
 	return nil
 }
 
+func (a *DaprRuntime) loadBindingRoutes(ctx context.Context) error {
+	var loader loader.Loader[bindingrouteapi.BindingRoute]
+
+	switch a.runtimeConfig.mode {
+	case modes.StandaloneMode:
+		loader = disk.NewBindingRoutes(disk.Options{
+			AppID: a.runtimeConfig.id,
+			Paths: a.runtimeConfig.standalone.ResourcesPath,
+		})
+	default:
+		// The operator doesn't serve binding routes.
+		return nil
+	}
+
+	log.Info("Loading binding routes…")
+	routes, err := loader.Load(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range routes {
+		log.Infof("Found binding route: %s", r.LogName())
+		if err := a.processor.Binding().AddRoute(r); err != nil {
+			log.Errorf("Failed to add binding route %s: %s", r.Name, err)
+		}
+	}
+
+	return nil
+}
+
 // ShutdownWithWait will gracefully stop runtime and wait outstanding operations.
 func (a *DaprRuntime) ShutdownWithWait() {
 	a.runnerCloser.Close()
@@ -1270,13 +1560,12 @@ func (a *DaprRuntime) cleanSockets() error {
 }
 
 func (a *DaprRuntime) blockUntilAppIsReady(ctx context.Context) error {
-	if a.runtimeConfig.appConnectionConfig.Port <= 0 {
+	if !a.runtimeConfig.appConnectionConfig.IsConfigured() {
 		return nil
 	}
 
-	log.Infof("application protocol: %s. waiting on port %v.  This will block until the app is listening on that port.", string(a.runtimeConfig.appConnectionConfig.Protocol), a.runtimeConfig.appConnectionConfig.Port)
-
-	dialAddr := a.runtimeConfig.appConnectionConfig.ChannelAddress + ":" + strconv.Itoa(a.runtimeConfig.appConnectionConfig.Port)
+	dialNetwork, dialAddr := a.runtimeConfig.appConnectionConfig.DialNetworkAddress()
+	log.Infof("application protocol: %s. waiting on %s address %s.  This will block until the app is listening on that address.", string(a.runtimeConfig.appConnectionConfig.Protocol), dialNetwork, dialAddr)
 
 	counter := 0
 	for {
@@ -1288,11 +1577,11 @@ func (a *DaprRuntime) blockUntilAppIsReady(ctx context.Context) error {
 			Timeout: 500 * time.Millisecond,
 		}
 		if a.runtimeConfig.appConnectionConfig.Protocol.HasTLS() {
-			conn, err = tls.DialWithDialer(dialer, "tcp", dialAddr, &tls.Config{
+			conn, err = tls.DialWithDialer(dialer, dialNetwork, dialAddr, &tls.Config{
 				InsecureSkipVerify: true, //nolint:gosec
 			})
 		} else {
-			conn, err = dialer.DialContext(ctx, "tcp", dialAddr)
+			conn, err = dialer.DialContext(ctx, dialNetwork, dialAddr)
 		}
 		if err == nil && conn != nil {
 			conn.Close()
@@ -1307,12 +1596,12 @@ func (a *DaprRuntime) blockUntilAppIsReady(ctx context.Context) error {
 		// prevents overwhelming the OS with open connections
 		case <-a.clock.After(time.Millisecond * 100):
 			if counter%100 == 0 {
-				log.Infof("waiting for application to listen on port %v", a.runtimeConfig.appConnectionConfig.Port)
+				log.Infof("waiting for application to listen on %s", dialAddr)
 			}
 		}
 	}
 
-	log.Infof("application discovered on port %v", a.runtimeConfig.appConnectionConfig.Port)
+	log.Infof("application discovered on %s", dialAddr)
 
 	return nil
 }
@@ -1395,6 +1684,25 @@ func (a *DaprRuntime) getComponentsCapabilitesMap() map[string][]string {
 }
 
 // converts components Features from FeatureType to string
+// actorReadReplicas returns the number of hosts serving read-only invocations
+// of an actor, which is zero unless the ActorReadReplicas feature is enabled.
+func actorReadReplicas(globalConfig *config.Configuration) (int, error) {
+	if !globalConfig.IsFeatureEnabled(config.ActorReadReplicas) {
+		return 0, nil
+	}
+
+	val, ok := os.LookupEnv(env.ActorReadReplicas)
+	if !ok || len(val) == 0 {
+		return actors.DefaultReadReplicas, nil
+	}
+
+	replicas, err := strconv.Atoi(val)
+	if err != nil || replicas < 1 {
+		return 0, fmt.Errorf("invalid value %q of %s: must be a positive integer", val, env.ActorReadReplicas)
+	}
+	return replicas, nil
+}
+
 func featureTypeToString(features interface{}) []string {
 	featureStr := make([]string, 0)
 	switch reflect.TypeOf(features).Kind() {
@@ -1407,21 +1715,23 @@ func featureTypeToString(features interface{}) []string {
 	return featureStr
 }
 
-func createGRPCManager(sec security.Handler, runtimeConfig *internalConfig, globalConfig *config.Configuration, appAPIToken string) *manager.Manager {
+func createGRPCManager(sec security.Handler, runtimeConfig *internalConfig, connConfig config.AppConnectionConfig, globalConfig *config.Configuration, appAPIToken *apitoken.Tokens, appTLS *channels.AppTLS) *manager.Manager {
 	grpcAppChannelConfig := &manager.AppChannelConfig{}
 	if globalConfig != nil {
 		grpcAppChannelConfig.TracingSpec = globalConfig.GetTracingSpec()
 	}
 	if runtimeConfig != nil {
-		grpcAppChannelConfig.Port = runtimeConfig.appConnectionConfig.Port
-		grpcAppChannelConfig.MaxConcurrency = runtimeConfig.appConnectionConfig.MaxConcurrency
-		grpcAppChannelConfig.EnableTLS = (runtimeConfig.appConnectionConfig.Protocol == protocol.GRPCSProtocol)
+		grpcAppChannelConfig.Port = connConfig.Port
+		grpcAppChannelConfig.MaxConcurrency = connConfig.MaxConcurrency
+		grpcAppChannelConfig.EnableTLS = (connConfig.Protocol == protocol.GRPCSProtocol)
 		grpcAppChannelConfig.MaxRequestBodySize = runtimeConfig.maxRequestBodySize
 		grpcAppChannelConfig.ReadBufferSize = runtimeConfig.readBufferSize
-		grpcAppChannelConfig.BaseAddress = runtimeConfig.appConnectionConfig.ChannelAddress
+		grpcAppChannelConfig.BaseAddress = connConfig.ChannelAddress
+		grpcAppChannelConfig.UnixDomainSocket = connConfig.UnixDomainSocket
 	}
 
 	grpcAppChannelConfig.AppAPIToken = appAPIToken
+	grpcAppChannelConfig.TLSConfig = appTLS.ClientConfig()
 	m := manager.NewManager(sec, runtimeConfig.mode, grpcAppChannelConfig)
 	m.StartCollector()
 	return m
@@ -74,14 +74,17 @@ import (
 	wfenginefake "github.com/dapr/dapr/pkg/runtime/wfengine/fake"
 	"github.com/dapr/dapr/pkg/security"
 
+	"github.com/dapr/dapr/pkg/actors"
 	actorsfake "github.com/dapr/dapr/pkg/actors/fake"
 	pb "github.com/dapr/dapr/pkg/api/grpc/proxy/testservice"
 	stateLoader "github.com/dapr/dapr/pkg/components/state"
 	"github.com/dapr/dapr/pkg/config"
+	env "github.com/dapr/dapr/pkg/config/env"
 	modeconfig "github.com/dapr/dapr/pkg/config/modes"
 	"github.com/dapr/dapr/pkg/cors"
 	diagUtils "github.com/dapr/dapr/pkg/diagnostics/utils"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	middlewarehttp "github.com/dapr/dapr/pkg/middleware/http"
 	"github.com/dapr/dapr/pkg/modes"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/authorizer"
@@ -577,6 +580,63 @@ func TestInitNameResolution(t *testing.T) {
 		// assert
 		require.NoError(t, err, "expected no error")
 	})
+
+	t.Run("configuration referencing secrets is resolved once the secret stores are loaded", func(t *testing.T) {
+		rt, err := NewTestDaprRuntime(t, modes.StandaloneMode)
+		require.NoError(t, err)
+
+		rt.globalConfig.Spec.NameResolutionSpec = &config.NameResolutionSpec{
+			Component:     "someResolver",
+			Configuration: map[string]any{"token": "secret://mock/name1/key1"},
+			SecretStore:   "mock",
+		}
+		mockResolver := new(daprt.MockResolver)
+		rt.runtimeConfig.registry.NameResolutions().RegisterComponent(
+			func(_ logger.Logger) nameresolution.Resolver {
+				return mockResolver
+			},
+			"someResolver",
+		)
+
+		err = rt.initNameResolution(t.Context())
+		require.NoError(t, err)
+		require.NotNil(t, rt.initDeferredNameResolution)
+		mockResolver.AssertNotCalled(t, "Init", mock.Anything)
+
+		// Names aren't resolved until the resolver is initialized
+		ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+		defer cancel()
+		_, err = rt.nameResolver.ResolveID(ctx, nameresolution.ResolveRequest{ID: "app"})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		rt.compStore.AddSecretStore("mock", &rtmock.SecretStore{})
+		mockResolver.On("Init", mock.MatchedBy(func(md nameresolution.Metadata) bool {
+			return assert.ObjectsAreEqual(map[string]any{"token": "value1"}, md.Configuration)
+		})).Return(nil)
+		mockResolver.On("ResolveID", nameresolution.ResolveRequest{ID: "app"}).Return("10.0.0.1:50002", nil)
+
+		require.NoError(t, rt.initDeferredNameResolution(t.Context()))
+		addr, err := rt.nameResolver.ResolveID(t.Context(), nameresolution.ResolveRequest{ID: "app"})
+		require.NoError(t, err)
+		assert.Equal(t, "10.0.0.1:50002", addr)
+	})
+
+	t.Run("configuration referencing secrets fails the resolutions if it can't be resolved", func(t *testing.T) {
+		rt, err := NewTestDaprRuntime(t, modes.StandaloneMode)
+		require.NoError(t, err)
+
+		rt.globalConfig.Spec.NameResolutionSpec = &config.NameResolutionSpec{
+			Component:     "someResolver",
+			Configuration: map[string]any{"token": "secret://mock/name1/key1"},
+			SecretStore:   "mock",
+		}
+		initMockResolverForRuntime(rt, "someResolver", nil)
+
+		require.NoError(t, rt.initNameResolution(t.Context()))
+		require.Error(t, rt.initDeferredNameResolution(t.Context()))
+		_, err = rt.nameResolver.ResolveID(t.Context(), nameresolution.ResolveRequest{ID: "app"})
+		require.ErrorContains(t, err, "secret store mock isn't loaded")
+	})
 }
 
 func TestSetupTracing(t *testing.T) {
@@ -643,6 +703,55 @@ func TestSetupTracing(t *testing.T) {
 			},
 		},
 		expectedErr: "invalid headers provided for Otel endpoint",
+	}, {
+		name: "otel trace grpc exporter with compression",
+		tracingConfig: config.TracingSpec{
+			Otel: &config.OtelSpec{
+				EndpointAddress: "foo.bar",
+				IsSecure:        ptr.Of(false),
+				Protocol:        "grpc",
+				Compression:     "gzip",
+				Timeout:         1000,
+			},
+		},
+		expectedExporters: []sdktrace.SpanExporter{&otlptrace.Exporter{}},
+	}, {
+		name: "invalid otel trace exporter compression",
+		tracingConfig: config.TracingSpec{
+			Otel: &config.OtelSpec{
+				EndpointAddress: "foo.bar",
+				Protocol:        "http",
+				Compression:     "zstd",
+			},
+		},
+		expectedErr: "invalid compression zstd provided for Otel endpoint",
+	}, {
+		name: "invalid trace sampler",
+		tracingConfig: config.TracingSpec{
+			SamplingRate: "1",
+			Sampler: &config.TracingSamplerSpec{
+				Type: config.TracingSamplerRateLimited,
+			},
+		},
+		expectedExporters: []sdktrace.SpanExporter{&diagUtils.NullExporter{}},
+		expectedErr:       "tracesPerSecond must be greater than 0",
+	}, {
+		name: "invalid b3 propagation",
+		tracingConfig: config.TracingSpec{
+			Propagation: &config.TracingPropagationSpec{
+				B3: "both",
+			},
+		},
+		expectedErr: "invalid B3 propagation format",
+	}, {
+		name: "invalid tail sampling",
+		tracingConfig: config.TracingSpec{
+			Stdout: true,
+			TailSampling: &config.TracingTailSamplingSpec{
+				LatencyThreshold: -1,
+			},
+		},
+		expectedErr: "invalid tail sampling latency threshold",
 	}, {
 		name: "stdout trace exporter",
 		tracingConfig: config.TracingSpec{
@@ -2293,3 +2402,103 @@ func TestOtelResourceDetection(t *testing.T) {
 		})
 	}
 }
+
+func TestWatchPipelines(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.yaml")
+	writeConfig := func(handler string) {
+		require.NoError(t, os.WriteFile(configPath, []byte(`apiVersion: dapr.io/v1alpha1
+kind: Configuration
+metadata:
+  name: config
+spec:
+  httpPipeline:
+    handlers:
+    - name: `+handler+`
+      type: middleware.http.fakemw
+`), 0o600))
+	}
+	writeConfig("first")
+
+	var firstInvoked, secondInvoked atomic.Int32
+	httpMiddleware := middlewarehttp.New()
+	for name, counter := range map[string]*atomic.Int32{"first": &firstInvoked, "second": &secondInvoked} {
+		httpMiddleware.Add(middlewarehttp.Spec{
+			Component: componentsV1alpha1.Component{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec:       componentsV1alpha1.ComponentSpec{Type: "middleware.http.fakemw", Version: "v1"},
+			},
+			Implementation: func(next http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					counter.Add(1)
+					next.ServeHTTP(w, r)
+				})
+			},
+		})
+	}
+
+	globalConfig, err := config.LoadStandaloneConfiguration(configPath)
+	require.NoError(t, err)
+	handler := httpMiddleware.BuildPipelineFromSpec("server", globalConfig.Spec.HTTPPipelineSpec)(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+
+	rt := &DaprRuntime{
+		runtimeConfig:  &internalConfig{mode: modes.StandaloneMode, config: []string{configPath}},
+		httpMiddleware: httpMiddleware,
+	}
+	ctx, cancel := context.WithCancel(t.Context())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- rt.watchPipelines(ctx)
+	}()
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, int32(1), firstInvoked.Load())
+
+	// The file is written again until the watcher, which batches events for 500ms, has picked up the change
+	require.EventuallyWithT(t, func(c *assert.CollectT) {
+		writeConfig("second")
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+		assert.Positive(c, secondInvoked.Load())
+	}, 10*time.Second, time.Second)
+
+	cancel()
+	select {
+	case err := <-errCh:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the watcher to stop")
+	}
+}
+
+func TestActorReadReplicas(t *testing.T) {
+	enabled := &config.Configuration{Spec: config.ConfigurationSpec{
+		Features: []config.FeatureSpec{{Name: config.ActorReadReplicas, Enabled: true}},
+	}}
+	enabled.LoadFeatures()
+
+	t.Run("feature disabled", func(t *testing.T) {
+		t.Setenv(env.ActorReadReplicas, "5")
+		replicas, err := actorReadReplicas(&config.Configuration{})
+		require.NoError(t, err)
+		assert.Equal(t, 0, replicas)
+	})
+
+	t.Run("default number of replicas", func(t *testing.T) {
+		replicas, err := actorReadReplicas(enabled)
+		require.NoError(t, err)
+		assert.Equal(t, actors.DefaultReadReplicas, replicas)
+	})
+
+	t.Run("number of replicas from the environment", func(t *testing.T) {
+		t.Setenv(env.ActorReadReplicas, "5")
+		replicas, err := actorReadReplicas(enabled)
+		require.NoError(t, err)
+		assert.Equal(t, 5, replicas)
+	})
+
+	t.Run("invalid number of replicas", func(t *testing.T) {
+		t.Setenv(env.ActorReadReplicas, "0")
+		_, err := actorReadReplicas(enabled)
+		require.Error(t, err)
+	})
+}
@@ -125,6 +125,26 @@ func (w *wrapper) DeleteByNamePrefix(ctx context.Context, req *v1pb.DeleteByName
 	return resp, err
 }
 
+func (w *wrapper) PauseJob(ctx context.Context, req *v1pb.PauseJobRequest, opts ...grpc.CallOption) (*v1pb.PauseJobResponse, error) {
+	var resp *v1pb.PauseJobResponse
+	err := w.call(ctx, func(client v1pb.SchedulerClient) error {
+		var err error
+		resp, err = client.PauseJob(ctx, req, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (w *wrapper) ResumeJob(ctx context.Context, req *v1pb.ResumeJobRequest, opts ...grpc.CallOption) (*v1pb.ResumeJobResponse, error) {
+	var resp *v1pb.ResumeJobResponse
+	err := w.call(ctx, func(client v1pb.SchedulerClient) error {
+		var err error
+		resp, err = client.ResumeJob(ctx, req, opts...)
+		return err
+	})
+	return resp, err
+}
+
 type apiFn func(client v1pb.SchedulerClient) error
 
 func (w *wrapper) call(ctx context.Context, fn apiFn) error {
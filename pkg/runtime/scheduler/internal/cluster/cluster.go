@@ -22,6 +22,7 @@ import (
 	"github.com/dapr/dapr/pkg/actors"
 	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
 	"github.com/dapr/dapr/pkg/runtime/channels"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	"github.com/dapr/dapr/pkg/runtime/wfengine"
 	"github.com/dapr/kit/concurrency"
 	"github.com/dapr/kit/logger"
@@ -39,6 +40,7 @@ type Options struct {
 	Actors   actors.Interface
 	Channels *channels.Channels
 	WFEngine wfengine.Interface
+	PubSub   rtpubsub.Adapter
 }
 
 // Cluster manages connections to multiple schedulers.
@@ -52,6 +54,7 @@ type Cluster struct {
 	actors   actors.Interface
 	channels *channels.Channels
 	wfengine wfengine.Interface
+	pubsub   rtpubsub.Adapter
 }
 
 func New(opts Options) *Cluster {
@@ -64,6 +67,7 @@ func New(opts Options) *Cluster {
 		actors:     opts.Actors,
 		channels:   opts.Channels,
 		wfengine:   opts.WFEngine,
+		pubsub:     opts.PubSub,
 	}
 }
 
@@ -126,6 +130,7 @@ func (c *Cluster) watchJobs(ctx context.Context) error {
 			channels: c.channels,
 			actors:   router,
 			wfengine: c.wfengine,
+			pubsub:   c.pubsub,
 		}
 		runners[i] = connectors[i].run
 	}
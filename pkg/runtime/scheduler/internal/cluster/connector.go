@@ -20,6 +20,7 @@ import (
 	"github.com/dapr/dapr/pkg/actors/router"
 	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
 	"github.com/dapr/dapr/pkg/runtime/channels"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	"github.com/dapr/dapr/pkg/runtime/wfengine"
 )
 
@@ -29,6 +30,7 @@ type connector struct {
 	channels *channels.Channels
 	actors   router.Interface
 	wfengine wfengine.Interface
+	pubsub   rtpubsub.Adapter
 }
 
 // run starts the scheduler connector.
@@ -65,6 +67,8 @@ func (c *connector) run(ctx context.Context) error {
 		channels: c.channels,
 		actors:   c.actors,
 		wfengine: c.wfengine,
+		pubsub:   c.pubsub,
+		appID:    c.req.GetInitial().GetAppId(),
 	}).run(ctx)
 
 	if err == nil {
@@ -15,23 +15,35 @@ package cluster
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/anypb"
 
+	"github.com/dapr/components-contrib/contenttype"
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
 	"github.com/dapr/dapr/pkg/actors/api"
 	actorerrors "github.com/dapr/dapr/pkg/actors/errors"
 	"github.com/dapr/dapr/pkg/actors/router"
+	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
 	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
 	"github.com/dapr/dapr/pkg/runtime/channels"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	"github.com/dapr/dapr/pkg/runtime/wfengine"
+	"github.com/dapr/dapr/pkg/scheduler/joboptions"
 	"github.com/dapr/kit/concurrency"
+	"github.com/dapr/kit/ptr"
 )
 
 type streamer struct {
@@ -41,6 +53,8 @@ type streamer struct {
 	actors   router.Interface
 	channels *channels.Channels
 	wfengine wfengine.Interface
+	pubsub   rtpubsub.Adapter
+	appID    string
 
 	wg       sync.WaitGroup
 	inflight atomic.Int64
@@ -163,14 +177,54 @@ func (s *streamer) handleJob(ctx context.Context, job *schedulerv1pb.WatchJobsRe
 	}
 }
 
-// invokeApp calls the local app with the given job data.
+// invokeApp calls the local app with the given job data, applying the job's
+// failure policy if the app fails to process the job or is unreachable.
 func (s *streamer) invokeApp(ctx context.Context, job *schedulerv1pb.WatchJobsResponse) error {
-	appChannel := s.channels.AppChannel()
+	err := s.triggerApp(ctx, job.GetName(), job.GetData())
+	policy := job.GetDeliveryPolicy()
+	if err == nil || policy == nil {
+		return err
+	}
+
+	for retry := 1; err != nil && retry <= int(policy.GetMaxRetries()); retry++ {
+		timer := time.NewTimer(joboptions.RetryDelay(policy, retry))
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+
+		log.Debugf("Retrying job %s (attempt %d/%d)", job.GetName(), retry, policy.GetMaxRetries())
+		err = s.triggerApp(ctx, job.GetName(), job.GetData())
+	}
+	if err == nil {
+		return nil
+	}
+
+	switch policy.GetAction() {
+	case commonv1pb.JobFailurePolicyDelivery_SKIP:
+		log.Warnf("Skipping failed job %s: %s", job.GetName(), err)
+		return nil
+	case commonv1pb.JobFailurePolicyDelivery_DEAD_LETTER:
+		if perr := s.publishDeadLetter(ctx, job.GetName(), job.GetData(), policy, err); perr != nil {
+			return errors.Join(err, perr)
+		}
+		log.Warnf("Sent failed job %s to dead letter topic %s/%s", job.GetName(), policy.GetDeadLetterPubsub(), policy.GetDeadLetterTopic())
+		return nil
+	default:
+		return err
+	}
+}
+
+// triggerApp sends a single job trigger to the app.
+func (s *streamer) triggerApp(ctx context.Context, name string, data *anypb.Any) error {
+	appChannel := s.channels.AppChannelFor(config.AppCallbackJobs)
 	if appChannel == nil {
 		return errors.New("received job, but app channel not initialized")
 	}
 
-	response, err := appChannel.TriggerJob(ctx, job.GetName(), job.GetData())
+	response, err := appChannel.TriggerJob(ctx, name, data)
 	if err != nil {
 		return fmt.Errorf("error returned from app channel while sending triggered job to app: %w", err)
 	}
@@ -185,19 +239,80 @@ func (s *streamer) invokeApp(ctx context.Context, job *schedulerv1pb.WatchJobsRe
 	//nolint:gosec
 	switch codes.Code(statusCode) {
 	case codes.OK:
-		log.Debugf("Sent job %s to app", job.GetName())
+		log.Debugf("Sent job %s to app", name)
 		return nil
 	case codes.NotFound:
-		log.Errorf("non-retriable error returned from app while processing triggered job %s. status code returned: %v", job.GetName(), statusCode)
+		log.Errorf("non-retriable error returned from app while processing triggered job %s. status code returned: %v", name, statusCode)
 		// return nil to signal SUCCESS
 		return nil
 	default:
-		err := fmt.Errorf("unexpected status code returned from app while processing triggered job %s. status code returned: %v", job.GetName(), statusCode)
+		err := fmt.Errorf("unexpected status code returned from app while processing triggered job %s. status code returned: %v", name, statusCode)
 		log.Error(err.Error())
 		return err
 	}
 }
 
+// publishDeadLetter publishes a failed job, along with the error which caused
+// it to fail, to the dead letter topic of the job's failure policy.
+func (s *streamer) publishDeadLetter(ctx context.Context, name string, data *anypb.Any, policy *commonv1pb.JobFailurePolicyDelivery, jobErr error) error {
+	if s.pubsub == nil {
+		return errors.New("cannot send job to dead letter topic: pubsub is not initialized")
+	}
+
+	var payload json.RawMessage
+	if data != nil {
+		var err error
+		payload, err = protojson.Marshal(data)
+		if err != nil {
+			// The type of the data is not known to the runtime, so fall back to
+			// the raw bytes.
+			payload, err = json.Marshal(map[string]string{
+				"@type": data.GetTypeUrl(),
+				"value": base64.StdEncoding.EncodeToString(data.GetValue()),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	body, err := json.Marshal(struct {
+		Name  string          `json:"name"`
+		Error string          `json:"error"`
+		Data  json.RawMessage `json:"data,omitempty"`
+	}{
+		Name:  name,
+		Error: jobErr.Error(),
+		Data:  payload,
+	})
+	if err != nil {
+		return err
+	}
+
+	envelope, err := rtpubsub.NewCloudEvent(&rtpubsub.CloudEvent{
+		Source:          s.appID,
+		Topic:           policy.GetDeadLetterTopic(),
+		Pubsub:          policy.GetDeadLetterPubsub(),
+		DataContentType: contenttype.JSONContentType,
+		Data:            body,
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	ce, err := json.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+
+	return s.pubsub.Publish(ctx, &contribpubsub.PublishRequest{
+		Data:        ce,
+		PubsubName:  policy.GetDeadLetterPubsub(),
+		Topic:       policy.GetDeadLetterTopic(),
+		ContentType: ptr.Of(contenttype.CloudEventContentType),
+	})
+}
+
 // invokeActorReminder calls the actor ID with the given reminder data.
 func (s *streamer) invokeActorReminder(ctx context.Context, job *schedulerv1pb.WatchJobsResponse) error {
 	if s.actors == nil {
@@ -0,0 +1,205 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/channel"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+	"github.com/dapr/dapr/pkg/runtime/channels"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+)
+
+type fakeJobAppChannel struct {
+	channel.AppChannel
+
+	lock     sync.Mutex
+	codes    []codes.Code
+	triggers []*anypb.Any
+}
+
+func (f *fakeJobAppChannel) TriggerJob(_ context.Context, _ string, data *anypb.Any) (*invokev1.InvokeMethodResponse, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	code := codes.Internal
+	if len(f.triggers) < len(f.codes) {
+		code = f.codes[len(f.triggers)]
+	}
+	f.triggers = append(f.triggers, data)
+	return invokev1.NewInvokeMethodResponse(int32(code), "", nil), nil
+}
+
+type fakePubSubAdapter struct {
+	rtpubsub.Adapter
+
+	lock     sync.Mutex
+	requests []*contribpubsub.PublishRequest
+	err      error
+}
+
+func (f *fakePubSubAdapter) Publish(_ context.Context, req *contribpubsub.PublishRequest) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.requests = append(f.requests, req)
+	return f.err
+}
+
+func TestStreamerInvokeApp(t *testing.T) {
+	data, err := anypb.New(wrapperspb.String("hello"))
+	require.NoError(t, err)
+
+	newJob := func(policy *commonv1pb.JobFailurePolicyDelivery) *schedulerv1pb.WatchJobsResponse {
+		return &schedulerv1pb.WatchJobsResponse{Name: "myjob", Data: data, DeliveryPolicy: policy}
+	}
+
+	newStreamer := func(app *fakeJobAppChannel, adapter *fakePubSubAdapter) *streamer {
+		s := &streamer{
+			channels: new(channels.Channels).WithAppChannel(app),
+			appID:    "myapp",
+		}
+		if adapter != nil {
+			s.pubsub = adapter
+		}
+		return s
+	}
+
+	t.Run("without a failure policy the job fails", func(t *testing.T) {
+		app := new(fakeJobAppChannel)
+		err := newStreamer(app, nil).invokeApp(t.Context(), newJob(nil))
+		require.Error(t, err)
+		assert.Len(t, app.triggers, 1)
+	})
+
+	t.Run("the app receives the data", func(t *testing.T) {
+		app := &fakeJobAppChannel{codes: []codes.Code{codes.OK}}
+		err := newStreamer(app, nil).invokeApp(t.Context(), newJob(&commonv1pb.JobFailurePolicyDelivery{
+			MaxRetries: 1, Interval: durationpb.New(time.Millisecond), Action: commonv1pb.JobFailurePolicyDelivery_FAIL,
+		}))
+		require.NoError(t, err)
+		require.Len(t, app.triggers, 1)
+		assert.Equal(t, data.GetTypeUrl(), app.triggers[0].GetTypeUrl())
+	})
+
+	t.Run("retries until the app succeeds", func(t *testing.T) {
+		app := &fakeJobAppChannel{codes: []codes.Code{codes.Internal, codes.Internal, codes.OK}}
+		err := newStreamer(app, nil).invokeApp(t.Context(), newJob(&commonv1pb.JobFailurePolicyDelivery{
+			MaxRetries: 3, Interval: durationpb.New(time.Millisecond), Action: commonv1pb.JobFailurePolicyDelivery_FAIL,
+		}))
+		require.NoError(t, err)
+		assert.Len(t, app.triggers, 3)
+	})
+
+	t.Run("fails once the retries are exhausted", func(t *testing.T) {
+		app := new(fakeJobAppChannel)
+		err := newStreamer(app, nil).invokeApp(t.Context(), newJob(&commonv1pb.JobFailurePolicyDelivery{
+			MaxRetries: 2, Interval: durationpb.New(time.Millisecond), Action: commonv1pb.JobFailurePolicyDelivery_FAIL,
+		}))
+		require.Error(t, err)
+		assert.Len(t, app.triggers, 3)
+	})
+
+	t.Run("skips once the retries are exhausted", func(t *testing.T) {
+		app := new(fakeJobAppChannel)
+		err := newStreamer(app, nil).invokeApp(t.Context(), newJob(&commonv1pb.JobFailurePolicyDelivery{
+			MaxRetries: 1, Interval: durationpb.New(time.Millisecond), Action: commonv1pb.JobFailurePolicyDelivery_SKIP,
+		}))
+		require.NoError(t, err)
+		assert.Len(t, app.triggers, 2)
+	})
+
+	t.Run("publishes to the dead letter topic", func(t *testing.T) {
+		app := new(fakeJobAppChannel)
+		adapter := new(fakePubSubAdapter)
+		err := newStreamer(app, adapter).invokeApp(t.Context(), newJob(&commonv1pb.JobFailurePolicyDelivery{
+			Action: commonv1pb.JobFailurePolicyDelivery_DEAD_LETTER, DeadLetterPubsub: "mypubsub", DeadLetterTopic: "failed-jobs",
+		}))
+		require.NoError(t, err)
+		assert.Len(t, app.triggers, 1)
+
+		require.Len(t, adapter.requests, 1)
+		req := adapter.requests[0]
+		assert.Equal(t, "mypubsub", req.PubsubName)
+		assert.Equal(t, "failed-jobs", req.Topic)
+
+		var ce struct {
+			Source string `json:"source"`
+			Data   struct {
+				Name  string          `json:"name"`
+				Error string          `json:"error"`
+				Data  json.RawMessage `json:"data"`
+			} `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(req.Data, &ce))
+		assert.Equal(t, "myapp", ce.Source)
+		assert.Equal(t, "myjob", ce.Data.Name)
+		assert.NotEmpty(t, ce.Data.Error)
+		assert.JSONEq(t, `{"@type":"type.googleapis.com/google.protobuf.StringValue","value":"hello"}`, string(ce.Data.Data))
+	})
+
+	t.Run("fails if the dead letter publish fails", func(t *testing.T) {
+		app := new(fakeJobAppChannel)
+		adapter := &fakePubSubAdapter{err: errors.New("publish failed")}
+		err := newStreamer(app, adapter).invokeApp(t.Context(), newJob(&commonv1pb.JobFailurePolicyDelivery{
+			Action: commonv1pb.JobFailurePolicyDelivery_DEAD_LETTER, DeadLetterPubsub: "mypubsub", DeadLetterTopic: "failed-jobs",
+		}))
+		require.Error(t, err)
+		assert.Len(t, adapter.requests, 1)
+	})
+
+	t.Run("stops retrying when the context is canceled", func(t *testing.T) {
+		app := new(fakeJobAppChannel)
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+		err := newStreamer(app, nil).invokeApp(ctx, newJob(&commonv1pb.JobFailurePolicyDelivery{
+			MaxRetries: 5, Interval: durationpb.New(time.Hour), Action: commonv1pb.JobFailurePolicyDelivery_SKIP,
+		}))
+		require.ErrorIs(t, err, context.Canceled)
+		assert.Len(t, app.triggers, 1)
+	})
+
+	t.Run("applies the policy when the app is unreachable", func(t *testing.T) {
+		s := &streamer{channels: new(channels.Channels), appID: "myapp"}
+		err := s.invokeApp(t.Context(), newJob(nil))
+		require.Error(t, err)
+
+		err = s.invokeApp(t.Context(), newJob(&commonv1pb.JobFailurePolicyDelivery{
+			MaxRetries: 1, Interval: durationpb.New(time.Millisecond), Action: commonv1pb.JobFailurePolicyDelivery_SKIP,
+		}))
+		require.NoError(t, err)
+
+		adapter := new(fakePubSubAdapter)
+		s.pubsub = adapter
+		err = s.invokeApp(t.Context(), newJob(&commonv1pb.JobFailurePolicyDelivery{
+			Action: commonv1pb.JobFailurePolicyDelivery_DEAD_LETTER, DeadLetterPubsub: "mypubsub", DeadLetterTopic: "failed-jobs",
+		}))
+		require.NoError(t, err)
+		assert.Len(t, adapter.requests, 1)
+	})
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/dapr/dapr/pkg/actors"
 	"github.com/dapr/dapr/pkg/healthz"
 	"github.com/dapr/dapr/pkg/runtime/channels"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	"github.com/dapr/dapr/pkg/runtime/scheduler/client"
 	"github.com/dapr/dapr/pkg/runtime/scheduler/internal/clients"
 	"github.com/dapr/dapr/pkg/runtime/scheduler/internal/clients/wrapper"
@@ -39,6 +40,7 @@ type Options struct {
 	AppID            string
 	Actors           actors.Interface
 	Channels         *channels.Channels
+	PubSubAdapter    rtpubsub.Adapter
 	WFEngine         wfengine.Interface
 	Addresses        []string
 	Security         security.Handler
@@ -56,11 +58,12 @@ type Scheduler struct {
 
 func New(opts Options) (*Scheduler, error) {
 	connector := connector.New(connector.Options{
-		Namespace: opts.Namespace,
-		AppID:     opts.AppID,
-		Actors:    opts.Actors,
-		Channels:  opts.Channels,
-		WFEngine:  opts.WFEngine,
+		Namespace:     opts.Namespace,
+		AppID:         opts.AppID,
+		Actors:        opts.Actors,
+		Channels:      opts.Channels,
+		WFEngine:      opts.WFEngine,
+		PubSubAdapter: opts.PubSubAdapter,
 	})
 
 	if opts.SchedulerStreams < 1 {
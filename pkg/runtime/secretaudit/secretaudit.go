@@ -0,0 +1,216 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package secretaudit emits an audit record for every secret accessed through
+// the secrets APIs, to the log, a pubsub topic or an OTLP logs endpoint.
+package secretaudit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/dapr/pkg/config"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.runtime.secretaudit")
+
+// Sinks of the audit records.
+const (
+	SinkLog    = "log"
+	SinkPubsub = "pubsub"
+	SinkOTLP   = "otlp"
+)
+
+// Operations of the secrets APIs.
+const (
+	OperationGet     = "get"
+	OperationBulkGet = "bulkGet"
+)
+
+// Decisions of the access to a secret.
+const (
+	DecisionAllowed = "allowed"
+	DecisionDenied  = "denied"
+)
+
+const (
+	// queueSize is the number of records buffered for the pubsub and OTLP
+	// sinks. Records are dropped when the sink can't keep up.
+	queueSize = 1024
+	// batchSize is the maximum number of records written to a sink at once.
+	batchSize = 100
+	// drainTimeout is the time given to the sink to write the buffered records
+	// on shutdown.
+	drainTimeout = 5 * time.Second
+)
+
+// Record is the audit record of the access to a secret.
+type Record struct {
+	Time       time.Time `json:"time"`
+	AppID      string    `json:"appID"`
+	Operation  string    `json:"operation"`
+	StoreName  string    `json:"storeName"`
+	SecretName string    `json:"secretName,omitempty"`
+	Decision   string    `json:"decision"`
+	// Rule is the rule of the secrets scope that decided the access, empty if
+	// the store has no secrets scope.
+	Rule string `json:"rule,omitempty"`
+	// Error is the error returned to the caller, if any.
+	Error string `json:"error,omitempty"`
+}
+
+type sink interface {
+	write(ctx context.Context, records []Record) error
+	close() error
+}
+
+type Options struct {
+	AppID         string
+	Spec          config.SecretsAuditSpec
+	PubSubAdapter rtpubsub.Adapter
+	Clock         clock.Clock
+}
+
+// Auditor emits the audit records of the secrets APIs to the configured sink.
+// A nil Auditor audits nothing.
+type Auditor struct {
+	appID string
+	clock clock.Clock
+	sink  sink
+	// queue buffers the records of the sinks which write them over the network.
+	queue chan Record
+}
+
+// New returns the auditor of the given configuration.
+func New(opts Options) (*Auditor, error) {
+	a := &Auditor{
+		appID: opts.AppID,
+		clock: opts.Clock,
+	}
+	if a.clock == nil {
+		a.clock = clock.RealClock{}
+	}
+
+	switch opts.Spec.Sink {
+	case "", SinkLog:
+		a.sink = logSink{}
+		return a, nil
+	case SinkPubsub:
+		if opts.Spec.PubsubName == "" || opts.Spec.Topic == "" {
+			return nil, errors.New("the pubsub sink of the secrets audit requires pubsubName and topic")
+		}
+		a.sink = &pubsubSink{
+			adapter:    opts.PubSubAdapter,
+			appID:      opts.AppID,
+			pubsubName: opts.Spec.PubsubName,
+			topic:      opts.Spec.Topic,
+		}
+	case SinkOTLP:
+		if opts.Spec.Otel == nil {
+			return nil, errors.New("the otlp sink of the secrets audit requires otel")
+		}
+		s, err := newOTLPSink(opts.AppID, *opts.Spec.Otel)
+		if err != nil {
+			return nil, fmt.Errorf("invalid otlp sink of the secrets audit: %w", err)
+		}
+		a.sink = s
+	default:
+		return nil, fmt.Errorf("invalid sink of the secrets audit: %s", opts.Spec.Sink)
+	}
+
+	a.queue = make(chan Record, queueSize)
+	return a, nil
+}
+
+// Audit emits the record. The time and the app ID of the record are set by
+// the auditor.
+func (a *Auditor) Audit(ctx context.Context, record Record) {
+	if a == nil {
+		return
+	}
+
+	record.Time = a.clock.Now().UTC()
+	record.AppID = a.appID
+
+	if a.queue == nil {
+		if err := a.sink.write(ctx, []Record{record}); err != nil {
+			log.Errorf("Failed to write secret audit record: %v", err)
+		}
+		return
+	}
+
+	select {
+	case a.queue <- record:
+	default:
+		log.Warnf("Dropped secret audit record of secret %q of secret store %s: the queue is full", record.SecretName, record.StoreName)
+	}
+}
+
+// Run writes the buffered records to the sink until the context is canceled,
+// then writes the records left in the buffer.
+func (a *Auditor) Run(ctx context.Context) error {
+	if a == nil || a.queue == nil {
+		<-ctx.Done()
+		return nil
+	}
+
+	defer func() {
+		if err := a.sink.close(); err != nil {
+			log.Errorf("Failed to close secret audit sink: %v", err)
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			a.drain(context.WithoutCancel(ctx))
+			return nil
+		case record := <-a.queue:
+			a.write(ctx, a.batch([]Record{record}))
+		}
+	}
+}
+
+// drain writes the records left in the buffer.
+func (a *Auditor) drain(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, drainTimeout)
+	defer cancel()
+	for batch := a.batch(nil); len(batch) > 0; batch = a.batch(nil) {
+		a.write(ctx, batch)
+	}
+}
+
+// batch appends the buffered records to the batch, up to the batch size.
+func (a *Auditor) batch(batch []Record) []Record {
+	for len(batch) < batchSize {
+		select {
+		case record := <-a.queue:
+			batch = append(batch, record)
+		default:
+			return batch
+		}
+	}
+	return batch
+}
+
+func (a *Auditor) write(ctx context.Context, batch []Record) {
+	if err := a.sink.write(ctx, batch); err != nil {
+		log.Errorf("Failed to write %d secret audit records: %v", len(batch), err)
+	}
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretaudit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/config"
+)
+
+type fakeAdapter struct {
+	lock     sync.Mutex
+	requests []*contribpubsub.PublishRequest
+	err      error
+}
+
+func (f *fakeAdapter) Publish(_ context.Context, req *contribpubsub.PublishRequest) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.requests = append(f.requests, req)
+	return f.err
+}
+
+func (f *fakeAdapter) BulkPublish(context.Context, *contribpubsub.BulkPublishRequest) (contribpubsub.BulkPublishResponse, error) {
+	return contribpubsub.BulkPublishResponse{}, nil
+}
+
+// records returns the audit records of the published cloud events.
+func (f *fakeAdapter) records(t *testing.T) []Record {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	records := make([]Record, len(f.requests))
+	for i, req := range f.requests {
+		var ce struct {
+			Data Record `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(req.Data, &ce))
+		records[i] = ce.Data
+	}
+	return records
+}
+
+func TestNew(t *testing.T) {
+	t.Run("log sink by default", func(t *testing.T) {
+		a, err := New(Options{})
+		require.NoError(t, err)
+		assert.IsType(t, logSink{}, a.sink)
+		assert.Nil(t, a.queue)
+	})
+
+	t.Run("pubsub sink", func(t *testing.T) {
+		a, err := New(Options{Spec: config.SecretsAuditSpec{Sink: SinkPubsub, PubsubName: "pubsub", Topic: "audit"}})
+		require.NoError(t, err)
+		assert.IsType(t, &pubsubSink{}, a.sink)
+		assert.NotNil(t, a.queue)
+	})
+
+	t.Run("pubsub sink without topic", func(t *testing.T) {
+		_, err := New(Options{Spec: config.SecretsAuditSpec{Sink: SinkPubsub, PubsubName: "pubsub"}})
+		require.Error(t, err)
+	})
+
+	t.Run("otlp sink without otel", func(t *testing.T) {
+		_, err := New(Options{Spec: config.SecretsAuditSpec{Sink: SinkOTLP}})
+		require.Error(t, err)
+	})
+
+	t.Run("otlp sink with invalid protocol", func(t *testing.T) {
+		_, err := New(Options{Spec: config.SecretsAuditSpec{Sink: SinkOTLP, Otel: &config.OtelSpec{Protocol: "tcp", EndpointAddress: "localhost:4317"}}})
+		require.Error(t, err)
+	})
+
+	t.Run("unknown sink", func(t *testing.T) {
+		_, err := New(Options{Spec: config.SecretsAuditSpec{Sink: "file"}})
+		require.Error(t, err)
+	})
+}
+
+func TestAuditor(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	newAuditor := func(t *testing.T, adapter *fakeAdapter) *Auditor {
+		t.Helper()
+		a, err := New(Options{
+			AppID:         "myapp",
+			Spec:          config.SecretsAuditSpec{Sink: SinkPubsub, PubsubName: "pubsub", Topic: "audit"},
+			PubSubAdapter: adapter,
+			Clock:         clocktesting.NewFakeClock(now),
+		})
+		require.NoError(t, err)
+		return a
+	}
+
+	t.Run("nil auditor audits nothing", func(t *testing.T) {
+		var a *Auditor
+		a.Audit(t.Context(), Record{StoreName: "store"})
+
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+		require.NoError(t, a.Run(ctx))
+	})
+
+	t.Run("publishes the records", func(t *testing.T) {
+		adapter := &fakeAdapter{}
+		a := newAuditor(t, adapter)
+
+		ctx, cancel := context.WithCancel(t.Context())
+		errCh := make(chan error)
+		go func() { errCh <- a.Run(ctx) }()
+
+		a.Audit(t.Context(), Record{
+			Operation:  OperationGet,
+			StoreName:  "store",
+			SecretName: "secret",
+			Decision:   DecisionDenied,
+			Rule:       config.SecretsRuleDeniedSecrets,
+		})
+
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			assert.Len(c, adapter.records(t), 1)
+		}, 5*time.Second, 10*time.Millisecond)
+		cancel()
+		require.NoError(t, <-errCh)
+
+		adapter.lock.Lock()
+		assert.Equal(t, "pubsub", adapter.requests[0].PubsubName)
+		assert.Equal(t, "audit", adapter.requests[0].Topic)
+		adapter.lock.Unlock()
+		assert.Equal(t, []Record{{
+			Time:       now,
+			AppID:      "myapp",
+			Operation:  OperationGet,
+			StoreName:  "store",
+			SecretName: "secret",
+			Decision:   DecisionDenied,
+			Rule:       config.SecretsRuleDeniedSecrets,
+		}}, adapter.records(t))
+	})
+
+	t.Run("writes the buffered records on shutdown", func(t *testing.T) {
+		adapter := &fakeAdapter{err: errors.New("publish failed")}
+		a := newAuditor(t, adapter)
+		for range batchSize + 1 {
+			a.Audit(t.Context(), Record{StoreName: "store", Decision: DecisionAllowed})
+		}
+
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+		require.NoError(t, a.Run(ctx))
+		assert.Len(t, adapter.records(t), batchSize+1)
+	})
+
+	t.Run("drops the records when the queue is full", func(t *testing.T) {
+		adapter := &fakeAdapter{}
+		a := newAuditor(t, adapter)
+		for range queueSize + 10 {
+			a.Audit(t.Context(), Record{StoreName: "store", Decision: DecisionAllowed})
+		}
+		assert.Len(t, a.queue, queueSize)
+	})
+}
+
+func TestOTLPLogRecord(t *testing.T) {
+	r := otlpLogRecord(Record{
+		Time:       time.Unix(10, 0),
+		AppID:      "myapp",
+		Operation:  OperationBulkGet,
+		StoreName:  "store",
+		SecretName: "secret",
+		Decision:   DecisionDenied,
+		Rule:       config.SecretsRuleAllowedSecrets,
+	})
+
+	assert.Equal(t, uint64(10*time.Second), r.GetTimeUnixNano())
+	assert.Equal(t, logspb.SeverityNumber_SEVERITY_NUMBER_WARN, r.GetSeverityNumber())
+	attributes := make(map[string]string, len(r.GetAttributes()))
+	for _, kv := range r.GetAttributes() {
+		attributes[kv.GetKey()] = kv.GetValue().GetStringValue()
+	}
+	assert.Equal(t, map[string]string{
+		"dapr.app_id":           "myapp",
+		"dapr.secret.operation": OperationBulkGet,
+		"dapr.secret.store":     "store",
+		"dapr.secret.name":      "secret",
+		"dapr.secret.decision":  DecisionDenied,
+		"dapr.secret.rule":      config.SecretsRuleAllowedSecrets,
+	}, attributes)
+}
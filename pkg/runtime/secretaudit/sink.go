@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretaudit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+
+	"github.com/dapr/components-contrib/contenttype"
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/config"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/kit/ptr"
+)
+
+// logSink writes the records to the log of the sidecar.
+type logSink struct{}
+
+func (logSink) write(_ context.Context, records []Record) error {
+	for _, r := range records {
+		fields := map[string]any{
+			"appID":     r.AppID,
+			"operation": r.Operation,
+			"storeName": r.StoreName,
+			"decision":  r.Decision,
+		}
+		if r.SecretName != "" {
+			fields["secretName"] = r.SecretName
+		}
+		if r.Rule != "" {
+			fields["rule"] = r.Rule
+		}
+		if r.Error != "" {
+			fields["error"] = r.Error
+		}
+		log.WithFields(fields).Info("Secret access audit")
+	}
+	return nil
+}
+
+func (logSink) close() error {
+	return nil
+}
+
+// pubsubSink publishes the records as cloud events to a pubsub topic.
+type pubsubSink struct {
+	adapter    rtpubsub.Adapter
+	appID      string
+	pubsubName string
+	topic      string
+}
+
+func (s *pubsubSink) write(ctx context.Context, records []Record) error {
+	if s.adapter == nil {
+		return errors.New("pubsub adapter is not available")
+	}
+
+	var errs []error
+	for _, r := range records {
+		data, err := json.Marshal(r)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		envelope, err := rtpubsub.NewCloudEvent(&rtpubsub.CloudEvent{
+			Source:          s.appID,
+			Topic:           s.topic,
+			Pubsub:          s.pubsubName,
+			DataContentType: contenttype.JSONContentType,
+			Data:            data,
+		}, nil)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		ce, err := json.Marshal(envelope)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		errs = append(errs, s.adapter.Publish(ctx, &contribpubsub.PublishRequest{
+			Data:        ce,
+			PubsubName:  s.pubsubName,
+			Topic:       s.topic,
+			ContentType: ptr.Of(contenttype.CloudEventContentType),
+		}))
+	}
+	return errors.Join(errs...)
+}
+
+func (s *pubsubSink) close() error {
+	return nil
+}
+
+// otlpSink exports the records as OTLP log records.
+type otlpSink struct {
+	client   diag.OtlpLogsClient
+	resource *resourcepb.Resource
+}
+
+func newOTLPSink(appID string, spec config.OtelSpec) (*otlpSink, error) {
+	client, err := diag.NewOtlpLogsClient(spec)
+	if err != nil {
+		return nil, err
+	}
+	return &otlpSink{
+		client: client,
+		resource: &resourcepb.Resource{
+			Attributes: []*commonpb.KeyValue{stringAttribute("service.name", appID)},
+		},
+	}, nil
+}
+
+func (s *otlpSink) write(ctx context.Context, records []Record) error {
+	logRecords := make([]*logspb.LogRecord, len(records))
+	for i, r := range records {
+		logRecords[i] = otlpLogRecord(r)
+	}
+
+	err := s.client.Export(ctx, []*logspb.ResourceLogs{{
+		Resource: s.resource,
+		ScopeLogs: []*logspb.ScopeLogs{{
+			Scope:      &commonpb.InstrumentationScope{Name: "dapr.secretaudit"},
+			LogRecords: logRecords,
+		}},
+	}})
+	if err != nil {
+		return fmt.Errorf("failed to export logs: %w", err)
+	}
+	return nil
+}
+
+func (s *otlpSink) close() error {
+	return s.client.Close()
+}
+
+func otlpLogRecord(r Record) *logspb.LogRecord {
+	severity, severityText := logspb.SeverityNumber_SEVERITY_NUMBER_INFO, "INFO"
+	if r.Decision == DecisionDenied {
+		severity, severityText = logspb.SeverityNumber_SEVERITY_NUMBER_WARN, "WARN"
+	}
+
+	attributes := []*commonpb.KeyValue{
+		stringAttribute("dapr.app_id", r.AppID),
+		stringAttribute("dapr.secret.operation", r.Operation),
+		stringAttribute("dapr.secret.store", r.StoreName),
+		stringAttribute("dapr.secret.decision", r.Decision),
+	}
+	if r.SecretName != "" {
+		attributes = append(attributes, stringAttribute("dapr.secret.name", r.SecretName))
+	}
+	if r.Rule != "" {
+		attributes = append(attributes, stringAttribute("dapr.secret.rule", r.Rule))
+	}
+	if r.Error != "" {
+		attributes = append(attributes, stringAttribute("error.message", r.Error))
+	}
+
+	//nolint:gosec
+	timestamp := uint64(r.Time.UnixNano())
+	return &logspb.LogRecord{
+		TimeUnixNano:         timestamp,
+		ObservedTimeUnixNano: timestamp,
+		SeverityNumber:       severity,
+		SeverityText:         severityText,
+		Body:                 &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: "Secret access audit"}},
+		Attributes:           attributes,
+	}
+}
+
+func stringAttribute(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key:   key,
+		Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}},
+	}
+}
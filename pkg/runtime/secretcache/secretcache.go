@@ -0,0 +1,264 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretcache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/components-contrib/secretstores"
+	"github.com/dapr/kit/logger"
+)
+
+const (
+	propertyTTL         = "cachettl"
+	propertyMaxEntries  = "cachemaxentries"
+	propertyStaleTTL    = "cachestalettl"
+	propertyNegativeTTL = "cachenegativettl"
+
+	defaultMaxEntries = 1000
+
+	// refreshTimeout is the timeout of the background refresh of a secret.
+	refreshTimeout = 30 * time.Second
+)
+
+var log = logger.NewLogger("dapr.runtime.secretcache")
+
+type Options struct {
+	StoreName string
+	Metadata  map[string]string
+	Clock     clock.Clock
+}
+
+// GetFn reads a secret from the secret store.
+type GetFn func(context.Context, secretstores.GetSecretRequest) (secretstores.GetSecretResponse, error)
+
+// Cache is an in-memory cache of the secrets read from a secret store through
+// the secrets API. Secrets are fresh for the TTL of the cache; after that, for
+// the stale TTL, the cached value is still returned while the secret is
+// refreshed in the background. Reads of secrets which don't exist are cached
+// for the negative TTL; other errors, e.g. of an unavailable store, are never
+// cached.
+// All methods can be called on a nil Cache, which caches nothing.
+type Cache struct {
+	storeName   string
+	ttl         time.Duration
+	staleTTL    time.Duration
+	negativeTTL time.Duration
+	clock       clock.Clock
+	lru         *lru.Cache[string, *entry]
+
+	lock       sync.Mutex
+	refreshing map[string]struct{}
+	wg         sync.WaitGroup
+}
+
+type entry struct {
+	data map[string]string
+	err  error
+	// freshUntil is when the entry expires, and staleUntil when it can't be
+	// returned anymore while it's refreshed.
+	freshUntil time.Time
+	staleUntil time.Time
+}
+
+// New returns the cache configured in the metadata of a secret store, or nil
+// if caching isn't enabled for the store.
+func New(opts Options) (*Cache, error) {
+	var ttl, staleTTL, negativeTTL time.Duration
+	maxEntries := defaultMaxEntries
+	for k, v := range opts.Metadata {
+		var err error
+		switch strings.ToLower(k) {
+		case propertyTTL:
+			ttl, err = parsePositiveDuration(v)
+		case propertyStaleTTL:
+			staleTTL, err = parsePositiveDuration(v)
+		case propertyNegativeTTL:
+			negativeTTL, err = parsePositiveDuration(v)
+		case propertyMaxEntries:
+			maxEntries, err = strconv.Atoi(v)
+			if err == nil && maxEntries <= 0 {
+				err = errors.New("must be positive")
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid secret cache metadata %s: %w", k, err)
+		}
+	}
+
+	if ttl == 0 {
+		return nil, nil
+	}
+
+	cache, err := lru.New[string, *entry](maxEntries)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Cache{
+		storeName:   opts.StoreName,
+		ttl:         ttl,
+		staleTTL:    staleTTL,
+		negativeTTL: negativeTTL,
+		clock:       opts.Clock,
+		lru:         cache,
+		refreshing:  make(map[string]struct{}),
+	}
+	if c.clock == nil {
+		c.clock = clock.RealClock{}
+	}
+	return c, nil
+}
+
+// ReadThrough returns the cached secret of the request, or reads it with get
+// and caches it. Requests with metadata are never served from the cache.
+func (c *Cache) ReadThrough(ctx context.Context, req secretstores.GetSecretRequest, get GetFn) (secretstores.GetSecretResponse, error) {
+	if c == nil || len(req.Metadata) > 0 {
+		return get(ctx, req)
+	}
+
+	now := c.clock.Now()
+	if e, ok := c.lru.Get(req.Name); ok {
+		switch {
+		case now.Before(e.freshUntil):
+			return e.response()
+		case now.Before(e.staleUntil):
+			c.refresh(ctx, req, get)
+			return e.response()
+		}
+	}
+
+	res, err := get(ctx, req)
+	c.add(req.Name, res, err)
+	return res, err
+}
+
+// Flush removes the secrets from the cache, or all the secrets if none is
+// given.
+func (c *Cache) Flush(names ...string) {
+	if c == nil {
+		return
+	}
+
+	if len(names) == 0 {
+		c.lru.Purge()
+		return
+	}
+	for _, name := range names {
+		c.lru.Remove(name)
+	}
+}
+
+// Close waits for the background refreshes to complete.
+func (c *Cache) Close() {
+	if c == nil {
+		return
+	}
+	c.wg.Wait()
+}
+
+// refresh reads the secret in the background, unless it's already being
+// refreshed. The stale entry is kept if the read fails.
+func (c *Cache) refresh(ctx context.Context, req secretstores.GetSecretRequest, get GetFn) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if _, ok := c.refreshing[req.Name]; ok {
+		return
+	}
+	c.refreshing[req.Name] = struct{}{}
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		defer func() {
+			c.lock.Lock()
+			delete(c.refreshing, req.Name)
+			c.lock.Unlock()
+		}()
+
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), refreshTimeout)
+		defer cancel()
+		res, err := get(ctx, req)
+		if err != nil {
+			log.Debugf("Failed to refresh secret %s of secret store %s, keeping the cached value: %v", req.Name, c.storeName, err)
+			return
+		}
+		c.add(req.Name, res, nil)
+	}()
+}
+
+func (c *Cache) add(name string, res secretstores.GetSecretResponse, err error) {
+	now := c.clock.Now()
+	if err != nil {
+		if c.negativeTTL > 0 && isNotFound(err) {
+			c.lru.Add(name, &entry{
+				err:        err,
+				freshUntil: now.Add(c.negativeTTL),
+				staleUntil: now.Add(c.negativeTTL),
+			})
+		}
+		return
+	}
+
+	c.lru.Add(name, &entry{
+		data:       maps.Clone(res.Data),
+		freshUntil: now.Add(c.ttl),
+		staleUntil: now.Add(c.ttl + c.staleTTL),
+	})
+}
+
+// response returns a copy of the cached secret, so callers modifying it don't
+// change the cached value.
+func (e *entry) response() (secretstores.GetSecretResponse, error) {
+	if e.err != nil {
+		return secretstores.GetSecretResponse{}, e.err
+	}
+	return secretstores.GetSecretResponse{Data: maps.Clone(e.data)}, nil
+}
+
+// isNotFound returns true if the error of a secret store means the secret
+// doesn't exist. Secret stores have no common error for it, so the errors of
+// files, gRPC and Kubernetes are checked, and the message of the others.
+func isNotFound(err error) bool {
+	switch {
+	case errors.Is(err, fs.ErrNotExist),
+		status.Code(err) == codes.NotFound,
+		apierrors.IsNotFound(err):
+		return true
+	default:
+		return strings.Contains(strings.ToLower(err.Error()), "not found")
+	}
+}
+
+func parsePositiveDuration(v string) (time.Duration, error) {
+	d, err := time.ParseDuration(v)
+	if err == nil && d <= 0 {
+		err = errors.New("must be positive")
+	}
+	return d, err
+}
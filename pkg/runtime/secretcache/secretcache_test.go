@@ -0,0 +1,234 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretcache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/components-contrib/secretstores"
+)
+
+// countingStore returns the value of the secret, or its error, and counts the
+// reads.
+type countingStore struct {
+	value atomic.Value
+	err   atomic.Pointer[error]
+	reads atomic.Int32
+}
+
+func (s *countingStore) get(_ context.Context, req secretstores.GetSecretRequest) (secretstores.GetSecretResponse, error) {
+	s.reads.Add(1)
+	if err := s.err.Load(); err != nil {
+		return secretstores.GetSecretResponse{}, *err
+	}
+	return secretstores.GetSecretResponse{Data: map[string]string{req.Name: s.value.Load().(string)}}, nil
+}
+
+func TestNew(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		c, err := New(Options{Metadata: map[string]string{"cacheStaleTTL": "1m"}})
+		require.NoError(t, err)
+		assert.Nil(t, c)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		c, err := New(Options{Metadata: map[string]string{
+			"cacheTTL":         "5m",
+			"cacheStaleTTL":    "1m",
+			"cacheNegativeTTL": "10s",
+			"cacheMaxEntries":  "10",
+		}})
+		require.NoError(t, err)
+		require.NotNil(t, c)
+		assert.Equal(t, 5*time.Minute, c.ttl)
+		assert.Equal(t, time.Minute, c.staleTTL)
+		assert.Equal(t, 10*time.Second, c.negativeTTL)
+	})
+
+	for name, md := range map[string]map[string]string{
+		"invalid ttl":         {"cacheTTL": "soon"},
+		"negative ttl":        {"cacheTTL": "-1s"},
+		"invalid max entries": {"cacheTTL": "1m", "cacheMaxEntries": "0"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := New(Options{Metadata: md})
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestReadThrough(t *testing.T) {
+	newCache := func(t *testing.T, md map[string]string) (*Cache, *clocktesting.FakeClock, *countingStore) {
+		t.Helper()
+		clock := clocktesting.NewFakeClock(time.Now())
+		c, err := New(Options{StoreName: "store", Metadata: md, Clock: clock})
+		require.NoError(t, err)
+		store := new(countingStore)
+		store.value.Store("v1")
+		return c, clock, store
+	}
+	req := secretstores.GetSecretRequest{Name: "db"}
+
+	t.Run("fresh secrets are cached", func(t *testing.T) {
+		c, clock, store := newCache(t, map[string]string{"cacheTTL": "1m"})
+
+		for range 2 {
+			res, err := c.ReadThrough(t.Context(), req, store.get)
+			require.NoError(t, err)
+			assert.Equal(t, "v1", res.Data["db"])
+		}
+		assert.Equal(t, int32(1), store.reads.Load())
+
+		store.value.Store("v2")
+		clock.Step(time.Minute)
+		res, err := c.ReadThrough(t.Context(), req, store.get)
+		require.NoError(t, err)
+		assert.Equal(t, "v2", res.Data["db"])
+		assert.Equal(t, int32(2), store.reads.Load())
+	})
+
+	t.Run("requests with metadata aren't cached", func(t *testing.T) {
+		c, _, store := newCache(t, map[string]string{"cacheTTL": "1m"})
+		withMetadata := secretstores.GetSecretRequest{Name: "db", Metadata: map[string]string{"version": "2"}}
+
+		for range 2 {
+			_, err := c.ReadThrough(t.Context(), withMetadata, store.get)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, int32(2), store.reads.Load())
+	})
+
+	t.Run("stale secrets are refreshed in the background", func(t *testing.T) {
+		c, clock, store := newCache(t, map[string]string{"cacheTTL": "1m", "cacheStaleTTL": "1m"})
+		_, err := c.ReadThrough(t.Context(), req, store.get)
+		require.NoError(t, err)
+
+		store.value.Store("v2")
+		clock.Step(90 * time.Second)
+		res, err := c.ReadThrough(t.Context(), req, store.get)
+		require.NoError(t, err)
+		assert.Equal(t, "v1", res.Data["db"])
+
+		c.Close()
+		assert.Equal(t, int32(2), store.reads.Load())
+		res, err = c.ReadThrough(t.Context(), req, store.get)
+		require.NoError(t, err)
+		assert.Equal(t, "v2", res.Data["db"])
+		assert.Equal(t, int32(2), store.reads.Load())
+	})
+
+	t.Run("stale secret is kept when the refresh fails", func(t *testing.T) {
+		c, clock, store := newCache(t, map[string]string{"cacheTTL": "1m", "cacheStaleTTL": "1m"})
+		_, err := c.ReadThrough(t.Context(), req, store.get)
+		require.NoError(t, err)
+
+		storeErr := errors.New("provider unavailable")
+		store.err.Store(&storeErr)
+		clock.Step(90 * time.Second)
+		_, err = c.ReadThrough(t.Context(), req, store.get)
+		require.NoError(t, err)
+		c.Close()
+
+		res, err := c.ReadThrough(t.Context(), req, store.get)
+		require.NoError(t, err)
+		assert.Equal(t, "v1", res.Data["db"])
+	})
+
+	t.Run("negative caching", func(t *testing.T) {
+		c, clock, store := newCache(t, map[string]string{"cacheTTL": "1m", "cacheNegativeTTL": "10s"})
+		storeErr := errors.New("secret not found")
+		store.err.Store(&storeErr)
+
+		for range 2 {
+			_, err := c.ReadThrough(t.Context(), req, store.get)
+			require.ErrorIs(t, err, storeErr)
+		}
+		assert.Equal(t, int32(1), store.reads.Load())
+
+		store.err.Store(nil)
+		clock.Step(10 * time.Second)
+		_, err := c.ReadThrough(t.Context(), req, store.get)
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), store.reads.Load())
+	})
+
+	t.Run("other errors are not cached", func(t *testing.T) {
+		c, _, store := newCache(t, map[string]string{"cacheTTL": "1m", "cacheNegativeTTL": "10s"})
+		storeErr := status.Error(codes.Unavailable, "provider unavailable")
+		store.err.Store(&storeErr)
+
+		for range 2 {
+			_, err := c.ReadThrough(t.Context(), req, store.get)
+			require.ErrorIs(t, err, storeErr)
+		}
+		assert.Equal(t, int32(2), store.reads.Load())
+	})
+
+	t.Run("flush", func(t *testing.T) {
+		c, _, store := newCache(t, map[string]string{"cacheTTL": "1m"})
+		other := secretstores.GetSecretRequest{Name: "api"}
+		_, err := c.ReadThrough(t.Context(), req, store.get)
+		require.NoError(t, err)
+		_, err = c.ReadThrough(t.Context(), other, store.get)
+		require.NoError(t, err)
+
+		c.Flush("db")
+		_, err = c.ReadThrough(t.Context(), req, store.get)
+		require.NoError(t, err)
+		_, err = c.ReadThrough(t.Context(), other, store.get)
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), store.reads.Load())
+
+		c.Flush()
+		_, err = c.ReadThrough(t.Context(), other, store.get)
+		require.NoError(t, err)
+		assert.Equal(t, int32(4), store.reads.Load())
+	})
+
+	t.Run("nil cache", func(t *testing.T) {
+		var c *Cache
+		store := new(countingStore)
+		store.value.Store("v1")
+		for range 2 {
+			_, err := c.ReadThrough(t.Context(), req, store.get)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, int32(2), store.reads.Load())
+		c.Flush()
+		c.Close()
+	})
+}
+
+func TestIsNotFound(t *testing.T) {
+	assert.True(t, isNotFound(errors.New("secret db not found")))
+	assert.True(t, isNotFound(fmt.Errorf("failed to read: %w", fs.ErrNotExist)))
+	assert.True(t, isNotFound(status.Error(codes.NotFound, "no such secret")))
+	assert.True(t, isNotFound(apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "db")))
+	assert.False(t, isNotFound(errors.New("connection refused")))
+	assert.False(t, isNotFound(status.Error(codes.PermissionDenied, "access denied")))
+}
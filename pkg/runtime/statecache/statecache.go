@@ -0,0 +1,249 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statecache
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/kit/logger"
+)
+
+const (
+	propertyMaxEntries         = "cachemaxentries"
+	propertyTTL                = "cachettl"
+	propertyInvalidationPubsub = "cacheinvalidationpubsub"
+
+	defaultTTL = 30 * time.Second
+)
+
+var log = logger.NewLogger("dapr.runtime.statecache")
+
+type Options struct {
+	StoreName   string
+	AppID       string
+	Namespace   string
+	Metadata    map[string]string
+	GetPubSubFn func(string) (contribpubsub.PubSub, bool)
+}
+
+// Cache is an in-memory cache of the values read from a state store through
+// the state API, bounded in size and in how long values are kept. Values are
+// invalidated when keys are written through the API of this instance and,
+// if an invalidation pubsub is configured, of the other replicas of the app.
+// All methods can be called on a nil Cache, which caches nothing.
+type Cache struct {
+	storeName string
+	lru       *expirable.LRU[string, *state.GetResponse]
+
+	pubsubName  string
+	topic       string
+	instanceID  string
+	getPubSubFn func(string) (contribpubsub.PubSub, bool)
+}
+
+// invalidation is the message sent to the other replicas of the app when keys
+// are written.
+type invalidation struct {
+	Keys   []string `json:"keys"`
+	Source string   `json:"source"`
+}
+
+// New returns the cache configured in the metadata of a state store, or nil if
+// caching isn't enabled for the store.
+func New(opts Options) (*Cache, error) {
+	var maxEntries int
+	ttl := defaultTTL
+	var pubsubName string
+	for k, v := range opts.Metadata {
+		var err error
+		switch strings.ToLower(k) {
+		case propertyMaxEntries:
+			maxEntries, err = strconv.Atoi(v)
+			if err == nil && maxEntries < 0 {
+				err = errors.New("must not be negative")
+			}
+		case propertyTTL:
+			ttl, err = time.ParseDuration(v)
+			if err == nil && ttl <= 0 {
+				err = errors.New("must be positive")
+			}
+		case propertyInvalidationPubsub:
+			pubsubName = v
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid state cache metadata %s: %w", k, err)
+		}
+	}
+
+	if maxEntries == 0 {
+		return nil, nil
+	}
+
+	return &Cache{
+		storeName:   opts.StoreName,
+		lru:         expirable.NewLRU[string, *state.GetResponse](maxEntries, nil, ttl),
+		pubsubName:  pubsubName,
+		topic:       opts.Namespace + opts.AppID + opts.StoreName + "statecache",
+		instanceID:  uuid.NewString(),
+		getPubSubFn: opts.GetPubSubFn,
+	}, nil
+}
+
+// Get returns the cached response of the request, if any. Requests with
+// strong consistency or metadata are never served from the cache.
+func (c *Cache) Get(req *state.GetRequest) (*state.GetResponse, bool) {
+	if c == nil || !cacheable(req) {
+		return nil, false
+	}
+
+	res, ok := c.lru.Get(req.Key)
+	if !ok {
+		return nil, false
+	}
+	return cloneResponse(res), true
+}
+
+// Add caches the response of the request.
+func (c *Cache) Add(req *state.GetRequest, res *state.GetResponse) {
+	if c == nil || !cacheable(req) {
+		return
+	}
+
+	if res == nil {
+		res = &state.GetResponse{}
+	}
+	c.lru.Add(req.Key, cloneResponse(res))
+}
+
+// ReadThrough returns the cached response of the request, or reads it with get
+// and caches it.
+func (c *Cache) ReadThrough(ctx context.Context, req *state.GetRequest, get func(context.Context, *state.GetRequest) (*state.GetResponse, error)) (*state.GetResponse, error) {
+	if res, ok := c.Get(req); ok {
+		return res, nil
+	}
+
+	res, err := get(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	c.Add(req, res)
+	return res, nil
+}
+
+// Invalidate removes the keys from the cache of this instance and, if an
+// invalidation pubsub is configured, of the other replicas of the app.
+func (c *Cache) Invalidate(ctx context.Context, keys ...string) {
+	if c == nil || len(keys) == 0 {
+		return
+	}
+
+	for _, key := range keys {
+		c.lru.Remove(key)
+	}
+
+	if c.pubsubName == "" {
+		return
+	}
+	ps, ok := c.getPubSubFn(c.pubsubName)
+	if !ok {
+		log.Warnf("Could not invalidate state cache of store %s in other replicas: pubsub %s not loaded", c.storeName, c.pubsubName)
+		return
+	}
+	data, _ := json.Marshal(invalidation{Keys: keys, Source: c.instanceID})
+	err := ps.Publish(ctx, &contribpubsub.PublishRequest{
+		Data:       data,
+		PubsubName: c.pubsubName,
+		Topic:      c.topic,
+	})
+	if err != nil {
+		log.Warnf("Could not invalidate state cache of store %s in other replicas: %s", c.storeName, err)
+	}
+}
+
+// SubscribeInvalidations subscribes to the keys invalidated by the other
+// replicas of the app, if an invalidation pubsub is configured.
+func (c *Cache) SubscribeInvalidations(ctx context.Context) error {
+	if c == nil || c.pubsubName == "" {
+		return nil
+	}
+
+	ps, ok := c.getPubSubFn(c.pubsubName)
+	if !ok {
+		return fmt.Errorf("state cache invalidation pubsub %s not loaded", c.pubsubName)
+	}
+
+	// Every instance uses its own consumer ID, so all replicas receive every
+	// invalidation.
+	return ps.Subscribe(ctx, contribpubsub.SubscribeRequest{
+		Topic:    c.topic,
+		Metadata: map[string]string{"consumerID": c.instanceID},
+	}, func(ctx context.Context, msg *contribpubsub.NewMessage) error {
+		var inv invalidation
+		if err := json.Unmarshal(msg.Data, &inv); err != nil {
+			log.Warnf("Dropping invalid state cache invalidation for store %s: %s", c.storeName, err)
+			return nil
+		}
+		if inv.Source == c.instanceID {
+			return nil
+		}
+		for _, key := range inv.Keys {
+			c.lru.Remove(key)
+		}
+		return nil
+	})
+}
+
+// Keys returns the keys of state requests or transaction operations.
+func Keys[T interface{ GetKey() string }](reqs []T) []string {
+	keys := make([]string, len(reqs))
+	for i, req := range reqs {
+		keys[i] = req.GetKey()
+	}
+	return keys
+}
+
+func cacheable(req *state.GetRequest) bool {
+	return req.Options.Consistency != state.Strong && len(req.Metadata) == 0
+}
+
+// cloneResponse copies a response, so callers modifying it don't change the
+// cached value.
+func cloneResponse(res *state.GetResponse) *state.GetResponse {
+	clone := &state.GetResponse{
+		Data:     bytes.Clone(res.Data),
+		Metadata: maps.Clone(res.Metadata),
+	}
+	if res.ETag != nil {
+		etag := *res.ETag
+		clone.ETag = &etag
+	}
+	if res.ContentType != nil {
+		contentType := *res.ContentType
+		clone.ContentType = &contentType
+	}
+	return clone
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statecache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	inmemory "github.com/dapr/components-contrib/pubsub/in-memory"
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/kit/logger"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		c, err := New(Options{Metadata: map[string]string{"cacheTTL": "1s"}})
+		require.NoError(t, err)
+		assert.Nil(t, c)
+
+		// A nil cache caches nothing.
+		c.Add(&state.GetRequest{Key: "a"}, &state.GetResponse{Data: []byte("1")})
+		_, ok := c.Get(&state.GetRequest{Key: "a"})
+		assert.False(t, ok)
+		c.Invalidate(t.Context(), "a")
+	})
+
+	t.Run("invalid metadata", func(t *testing.T) {
+		_, err := New(Options{Metadata: map[string]string{"cacheMaxEntries": "abc"}})
+		require.Error(t, err)
+		_, err = New(Options{Metadata: map[string]string{"cacheMaxEntries": "10", "cacheTTL": "-1s"}})
+		require.Error(t, err)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		c, err := New(Options{Metadata: map[string]string{"CACHEMAXENTRIES": "10"}})
+		require.NoError(t, err)
+		assert.NotNil(t, c)
+	})
+}
+
+func TestReadThrough(t *testing.T) {
+	c, err := New(Options{Metadata: map[string]string{"cacheMaxEntries": "2"}})
+	require.NoError(t, err)
+
+	var reads int
+	get := func(ctx context.Context, req *state.GetRequest) (*state.GetResponse, error) {
+		reads++
+		etag := "1"
+		return &state.GetResponse{Data: []byte("value-" + req.Key), ETag: &etag}, nil
+	}
+
+	res, err := c.ReadThrough(t.Context(), &state.GetRequest{Key: "a"}, get)
+	require.NoError(t, err)
+	assert.Equal(t, "value-a", string(res.Data))
+
+	t.Run("hits are served from the cache", func(t *testing.T) {
+		res.Data[0] = 'X'
+		res, err = c.ReadThrough(t.Context(), &state.GetRequest{Key: "a"}, get)
+		require.NoError(t, err)
+		assert.Equal(t, "value-a", string(res.Data))
+		assert.Equal(t, "1", *res.ETag)
+		assert.Equal(t, 1, reads)
+	})
+
+	t.Run("strong consistency and metadata bypass the cache", func(t *testing.T) {
+		_, err = c.ReadThrough(t.Context(), &state.GetRequest{Key: "a", Options: state.GetStateOption{Consistency: state.Strong}}, get)
+		require.NoError(t, err)
+		_, err = c.ReadThrough(t.Context(), &state.GetRequest{Key: "a", Metadata: map[string]string{"partitionKey": "p"}}, get)
+		require.NoError(t, err)
+		assert.Equal(t, 3, reads)
+	})
+
+	t.Run("writes invalidate keys", func(t *testing.T) {
+		c.Invalidate(t.Context(), "a")
+		_, err = c.ReadThrough(t.Context(), &state.GetRequest{Key: "a"}, get)
+		require.NoError(t, err)
+		assert.Equal(t, 4, reads)
+	})
+
+	t.Run("least recently used keys are evicted", func(t *testing.T) {
+		for _, key := range []string{"b", "c"} {
+			_, err = c.ReadThrough(t.Context(), &state.GetRequest{Key: key}, get)
+			require.NoError(t, err)
+		}
+		_, ok := c.Get(&state.GetRequest{Key: "a"})
+		assert.False(t, ok)
+	})
+}
+
+func TestInvalidationPubsub(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	ps := inmemory.New(logger.NewLogger("test"))
+	require.NoError(t, ps.Init(ctx, contribpubsub.Metadata{}))
+
+	newCache := func() *Cache {
+		c, err := New(Options{
+			StoreName: "store",
+			AppID:     "app",
+			Metadata: map[string]string{
+				"cacheMaxEntries":         "10",
+				"cacheInvalidationPubsub": "ps",
+			},
+			GetPubSubFn: func(name string) (contribpubsub.PubSub, bool) {
+				return ps, name == "ps"
+			},
+		})
+		require.NoError(t, err)
+		require.NoError(t, c.SubscribeInvalidations(ctx))
+		return c
+	}
+	c1, c2 := newCache(), newCache()
+
+	req := &state.GetRequest{Key: "a"}
+	c2.Add(req, &state.GetResponse{Data: []byte("1")})
+	c1.Invalidate(ctx, "a")
+
+	assert.Eventually(t, func() bool {
+		_, ok := c2.Get(req)
+		return !ok
+	}, 5*time.Second, 10*time.Millisecond)
+}
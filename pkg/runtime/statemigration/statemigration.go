@@ -0,0 +1,417 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package statemigration implements the background jobs which copy the keys
+// of a state store to another one, so apps can move between state store
+// backends. Jobs are kept in memory and stop with the sidecar; an interrupted
+// job is resumed by starting a new one from the checkpoint it last reported.
+package statemigration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/components-contrib/state/query"
+	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	"github.com/dapr/dapr/pkg/encryption"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/statecache"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.runtime.statemigration")
+
+var (
+	// ErrStoreNotFound is returned when a state store isn't loaded.
+	ErrStoreNotFound = errors.New("state store not found")
+	// ErrSameStore is returned when the source and destination are the same.
+	ErrSameStore = errors.New("source and destination state stores must be different")
+	// ErrKeysRequired is returned when no keys are given and the keys of the
+	// source state store can't be listed with a query.
+	ErrKeysRequired = errors.New("keys are required for source state stores which don't support queries")
+	// ErrNothingToResume is returned when a job is resumed, but no previous job
+	// copied to the same destination.
+	ErrNothingToResume = errors.New("no previous migration to the destination to resume")
+	// ErrRunning is returned when a job is already running for the source state
+	// store.
+	ErrRunning = errors.New("a migration is already running for the state store")
+	// ErrClosed is returned when the manager has been closed.
+	ErrClosed = errors.New("state migration manager is closed")
+)
+
+// State is the state of a migration job.
+type State string
+
+const (
+	StateRunning   State = "RUNNING"
+	StateCompleted State = "COMPLETED"
+	StateFailed    State = "FAILED"
+)
+
+// queryPageSize is the number of keys listed at a time when the keys of the
+// source state store are listed with a query.
+const queryPageSize = 100
+
+type Options struct {
+	GetStateFn      func(string) (state.Store, bool)
+	GetStateCacheFn func(string) *statecache.Cache
+	Resiliency      resiliency.Provider
+	Clock           clock.Clock
+}
+
+// Request is a request to copy the keys of a state store to another one.
+type Request struct {
+	Source      string
+	Destination string
+	// Keys to copy, as saved in the state store. If empty, all the keys of the
+	// source state store are copied, which requires it to support queries.
+	Keys []string
+	// RatePerSecond is the maximum number of keys copied per second. No limit
+	// if zero.
+	RatePerSecond float64
+	// Checkpoint is where to resume copying from.
+	Checkpoint *Checkpoint
+	// Resume resumes copying from the checkpoint of the last job of the source
+	// state store run by this manager, if Checkpoint isn't set.
+	Resume bool
+}
+
+// Checkpoint records the keys which have been copied by a job, so an
+// interrupted job can be resumed.
+type Checkpoint struct {
+	// Offset is the number of keys of the request which have been copied.
+	Offset int `json:"offset,omitempty"`
+	// Token is the token of the next page of keys listed with a query.
+	Token string `json:"token,omitempty"`
+}
+
+// Status reports the progress of a migration job.
+type Status struct {
+	Source      string     `json:"source"`
+	Destination string     `json:"destination"`
+	State       State      `json:"state"`
+	StartedAt   time.Time  `json:"startedAt"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	// Scanned is the number of keys read, Copied the number of keys written to
+	// the destination, and Failed the number of keys which could not be
+	// copied.
+	Scanned    int64      `json:"scanned"`
+	Copied     int64      `json:"copied"`
+	Failed     int64      `json:"failed"`
+	Checkpoint Checkpoint `json:"checkpoint"`
+	Error      string     `json:"error,omitempty"`
+}
+
+// Manager runs the migration jobs, one at a time per source state store, and
+// keeps the status of the last job of each source state store.
+type Manager struct {
+	getStateFn      func(string) (state.Store, bool)
+	getStateCacheFn func(string) *statecache.Cache
+	resiliency      resiliency.Provider
+	clock           clock.Clock
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	lock sync.RWMutex
+	jobs map[string]*Status
+}
+
+func New(opts Options) *Manager {
+	if opts.Clock == nil {
+		opts.Clock = clock.RealClock{}
+	}
+	if opts.GetStateCacheFn == nil {
+		opts.GetStateCacheFn = func(string) *statecache.Cache { return nil }
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Manager{
+		getStateFn:      opts.GetStateFn,
+		getStateCacheFn: opts.GetStateCacheFn,
+		resiliency:      opts.Resiliency,
+		clock:           opts.Clock,
+		ctx:             ctx,
+		cancel:          cancel,
+		jobs:            make(map[string]*Status),
+	}
+}
+
+// Run waits until the context is canceled, then stops the running jobs.
+func (m *Manager) Run(ctx context.Context) error {
+	<-ctx.Done()
+	m.cancel()
+	m.wg.Wait()
+	return nil
+}
+
+// Start starts copying the keys of a state store to another one in the
+// background. Values are written with their TTL when the source state store
+// reports it. ETags are generated by each state store, so they can't be
+// copied.
+func (m *Manager) Start(req Request) (Status, error) {
+	if req.Source == req.Destination {
+		return Status{}, ErrSameStore
+	}
+	source, ok := m.getStateFn(req.Source)
+	if !ok {
+		return Status{}, fmt.Errorf("%w: %s", ErrStoreNotFound, req.Source)
+	}
+	destination, ok := m.getStateFn(req.Destination)
+	if !ok {
+		return Status{}, fmt.Errorf("%w: %s", ErrStoreNotFound, req.Destination)
+	}
+	if _, ok = source.(state.Querier); !ok && len(req.Keys) == 0 {
+		return Status{}, ErrKeysRequired
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.ctx.Err() != nil {
+		return Status{}, ErrClosed
+	}
+	last, ok := m.jobs[req.Source]
+	if ok && last.State == StateRunning {
+		return *last, ErrRunning
+	}
+
+	var checkpoint Checkpoint
+	switch {
+	case req.Checkpoint != nil:
+		checkpoint = *req.Checkpoint
+	case req.Resume:
+		if !ok || last.Destination != req.Destination {
+			return Status{}, ErrNothingToResume
+		}
+		checkpoint = last.Checkpoint
+	}
+	if checkpoint.Offset < 0 || checkpoint.Offset > len(req.Keys) {
+		return Status{}, fmt.Errorf("checkpoint offset %d is out of the range of the keys", checkpoint.Offset)
+	}
+
+	job := &Status{
+		Source:      req.Source,
+		Destination: req.Destination,
+		State:       StateRunning,
+		StartedAt:   m.clock.Now(),
+		Checkpoint:  checkpoint,
+	}
+	m.jobs[req.Source] = job
+
+	limit := rate.Inf
+	if req.RatePerSecond > 0 {
+		limit = rate.Limit(req.RatePerSecond)
+	}
+	limiter := rate.NewLimiter(limit, 1)
+
+	log.Infof("Migrating state store %s to %s", req.Source, req.Destination)
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		m.run(req, source, destination, limiter, job)
+	}()
+
+	return *job, nil
+}
+
+// Status returns the status of the last job of a source state store, and
+// false if no job has been started.
+func (m *Manager) Status(source string) (Status, bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	job, ok := m.jobs[source]
+	if !ok {
+		return Status{}, false
+	}
+	return *job, true
+}
+
+func (m *Manager) run(req Request, source, destination state.Store, limiter *rate.Limiter, job *Status) {
+	var err error
+	if len(req.Keys) > 0 {
+		for i := job.Checkpoint.Offset; i < len(req.Keys); i++ {
+			if err = limiter.Wait(m.ctx); err != nil {
+				break
+			}
+			m.copy(req, source, destination, job, req.Keys[i])
+			m.checkpoint(job, Checkpoint{Offset: i + 1})
+		}
+	} else {
+		err = m.copyAll(req, source, destination, limiter, job)
+	}
+	if err == nil {
+		err = m.ctx.Err()
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	completedAt := m.clock.Now()
+	job.CompletedAt = &completedAt
+	switch {
+	case err != nil:
+		job.State, job.Error = StateFailed, err.Error()
+	case job.Failed > 0:
+		job.State, job.Error = StateFailed, fmt.Sprintf("failed to copy %d keys", job.Failed)
+	default:
+		job.State = StateCompleted
+	}
+	log.Infof("Migration of state store %s to %s finished: %d keys scanned, %d copied, %d failed", req.Source, req.Destination, job.Scanned, job.Copied, job.Failed)
+}
+
+// copyAll copies all the keys of the source state store, listed page by page
+// with a query. The checkpoint is moved to the next page once all the keys of
+// a page are copied.
+func (m *Manager) copyAll(req Request, source, destination state.Store, limiter *rate.Limiter, job *Status) error {
+	querier := source.(state.Querier)
+	token := job.Checkpoint.Token
+	for m.ctx.Err() == nil {
+		qreq := &state.QueryRequest{
+			Query: query.Query{
+				QueryFields: query.QueryFields{
+					Page: query.Pagination{Limit: queryPageSize, Token: token},
+				},
+			},
+		}
+
+		var res *state.QueryResponse
+		err := m.runPolicy(req.Source, func(ctx context.Context) (err error) {
+			res, err = querier.Query(ctx, qreq)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list keys: %w", err)
+		}
+		if res == nil {
+			return nil
+		}
+
+		for _, item := range res.Results {
+			if err = limiter.Wait(m.ctx); err != nil {
+				return err
+			}
+			m.copy(req, source, destination, job, item.Key)
+		}
+
+		if res.Token == "" || len(res.Results) == 0 {
+			return nil
+		}
+		token = res.Token
+		m.checkpoint(job, Checkpoint{Token: token})
+	}
+	return nil
+}
+
+// copy reads the value of a key from the source state store and writes it to
+// the destination state store, with the remaining time to live of the value.
+func (m *Manager) copy(req Request, source, destination state.Store, job *Status, key string) {
+	var res *state.GetResponse
+	err := m.runPolicy(req.Source, func(ctx context.Context) (err error) {
+		res, err = source.Get(ctx, &state.GetRequest{
+			Key:     key,
+			Options: state.GetStateOption{Consistency: state.Strong},
+		})
+		return err
+	})
+	if err != nil {
+		log.Warnf("Failed to read key %s in state store %s: %s", key, req.Source, err)
+		m.count(job, 1, 0, 1)
+		return
+	}
+	if res == nil || res.Data == nil {
+		// The key has been deleted since it was listed.
+		m.count(job, 1, 0, 0)
+		return
+	}
+
+	val, err := convertValue(req.Source, req.Destination, key, res.Data)
+	if err != nil {
+		log.Warnf("Failed to convert the value of key %s of state store %s: %s", key, req.Source, err)
+		m.count(job, 1, 0, 1)
+		return
+	}
+
+	setReq := &state.SetRequest{Key: key, Value: val}
+	// Values with an invalid expire time are copied without TTL, rather than
+	// lost.
+	if ttl, ok := stateLoader.RemainingTTLInSeconds(res.Metadata, m.clock.Now()); ok {
+		if ttl <= 0 {
+			// The value has expired since it was read.
+			m.count(job, 1, 0, 0)
+			return
+		}
+		setReq.Metadata = map[string]string{
+			"ttlInSeconds": strconv.FormatInt(ttl, 10),
+		}
+	}
+
+	err = m.runPolicy(req.Destination, func(ctx context.Context) error {
+		return destination.Set(ctx, setReq)
+	})
+	if err != nil {
+		log.Warnf("Failed to write key %s in state store %s: %s", key, req.Destination, err)
+		m.count(job, 1, 0, 1)
+		return
+	}
+
+	m.getStateCacheFn(req.Destination).Invalidate(m.ctx, key)
+	m.count(job, 1, 1, 0)
+}
+
+// convertValue converts a value saved at the key of the source state store to
+// a value to save at the same key of the destination state store, if they
+// don't have the same compression or encryption.
+func convertValue(source, destination string, key string, data []byte) ([]byte, error) {
+	data, err := encryption.DecodeStateValue(source, key, data)
+	if err != nil {
+		return nil, err
+	}
+	val, err := encryption.EncodeStateValue(destination, key, data)
+	if err != nil {
+		return nil, err
+	}
+	data, _ = val.([]byte)
+	return data, nil
+}
+
+func (m *Manager) count(job *Status, scanned, copied, failed int64) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	job.Scanned += scanned
+	job.Copied += copied
+	job.Failed += failed
+}
+
+func (m *Manager) checkpoint(job *Status, checkpoint Checkpoint) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	job.Checkpoint = checkpoint
+}
+
+// runPolicy calls fn with the resiliency policy of the state store.
+func (m *Manager) runPolicy(storeName string, fn func(context.Context) error) error {
+	policyRunner := resiliency.NewRunner[any](m.ctx,
+		m.resiliency.ComponentOutboundPolicy(storeName, resiliency.Statestore),
+	)
+	_, err := policyRunner(func(ctx context.Context) (any, error) {
+		return nil, fn(ctx)
+	})
+	return err
+}
@@ -0,0 +1,251 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statemigration
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/compression"
+	"github.com/dapr/dapr/pkg/resiliency"
+)
+
+// memStore is an in-memory store.
+type memStore struct {
+	state.Store
+
+	lock     sync.Mutex
+	data     map[string][]byte
+	metadata map[string]map[string]string
+}
+
+func newMemStore(data map[string]string) *memStore {
+	s := &memStore{
+		data:     make(map[string][]byte, len(data)),
+		metadata: make(map[string]map[string]string),
+	}
+	for k, v := range data {
+		s.data[k] = []byte(v)
+	}
+	return s
+}
+
+func (s *memStore) Get(ctx context.Context, req *state.GetRequest) (*state.GetResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return &state.GetResponse{Data: s.data[req.Key], Metadata: s.metadata[req.Key]}, nil
+}
+
+func (s *memStore) Set(ctx context.Context, req *state.SetRequest) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.data[req.Key] = req.Value.([]byte)
+	s.metadata[req.Key] = req.Metadata
+	return nil
+}
+
+func (s *memStore) get(key string) ([]byte, map[string]string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.data[key], s.metadata[key]
+}
+
+func (s *memStore) len() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return len(s.data)
+}
+
+// queryableStore is an in-memory store which lists its keys with queries.
+type queryableStore struct {
+	*memStore
+}
+
+// Query returns one key per page.
+func (s *queryableStore) Query(ctx context.Context, req *state.QueryRequest) (*state.QueryResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	keys := make([]string, 0, len(s.data))
+	for k := range s.data {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	idx, _ := strconv.Atoi(req.Query.Page.Token)
+	if idx >= len(keys) {
+		return &state.QueryResponse{}, nil
+	}
+	return &state.QueryResponse{
+		Results: []state.QueryItem{{Key: keys[idx], Data: s.data[keys[idx]]}},
+		Token:   strconv.Itoa(idx + 1),
+	}, nil
+}
+
+func waitForJob(t *testing.T, m *Manager) Status {
+	t.Helper()
+
+	var status Status
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		var ok bool
+		status, ok = m.Status("source")
+		assert.True(c, ok)
+		assert.NotEqual(c, StateRunning, status.State)
+	}, 5*time.Second, 10*time.Millisecond)
+	return status
+}
+
+func TestManager(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	newManager := func(t *testing.T, source, destination state.Store) *Manager {
+		m := New(Options{
+			GetStateFn: func(name string) (state.Store, bool) {
+				switch name {
+				case "source":
+					return source, true
+				case "destination":
+					return destination, true
+				default:
+					return nil, false
+				}
+			},
+			Resiliency: resiliency.New(nil),
+			Clock:      clocktesting.NewFakeClock(now),
+		})
+		ctx, cancel := context.WithCancel(t.Context())
+		errCh := make(chan error)
+		go func() { errCh <- m.Run(ctx) }()
+		t.Cleanup(func() {
+			cancel()
+			require.NoError(t, <-errCh)
+		})
+		return m
+	}
+
+	t.Run("keys with TTLs", func(t *testing.T) {
+		source := newMemStore(map[string]string{"a": "1", "b": "2", "expired": "3"})
+		source.metadata["a"] = map[string]string{state.GetRespMetaKeyTTLExpireTime: now.Add(90*time.Second + 500*time.Millisecond).Format(time.RFC3339Nano)}
+		source.metadata["expired"] = map[string]string{state.GetRespMetaKeyTTLExpireTime: now.Add(-time.Second).Format(time.RFC3339)}
+		destination := newMemStore(nil)
+		m := newManager(t, source, destination)
+
+		status, err := m.Start(Request{
+			Source:        "source",
+			Destination:   "destination",
+			Keys:          []string{"a", "b", "expired", "missing"},
+			RatePerSecond: 1000,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, StateRunning, status.State)
+
+		status = waitForJob(t, m)
+		assert.Equal(t, StateCompleted, status.State)
+		assert.Equal(t, int64(4), status.Scanned)
+		assert.Equal(t, int64(2), status.Copied)
+		assert.Equal(t, Checkpoint{Offset: 4}, status.Checkpoint)
+
+		val, md := destination.get("a")
+		assert.Equal(t, "1", string(val))
+		assert.Equal(t, map[string]string{"ttlInSeconds": "91"}, md)
+		val, md = destination.get("b")
+		assert.Equal(t, "2", string(val))
+		assert.Empty(t, md)
+		assert.Equal(t, 2, destination.len())
+	})
+
+	t.Run("all keys with a query", func(t *testing.T) {
+		source := &queryableStore{memStore: newMemStore(map[string]string{"a": "1", "b": "2", "c": "3"})}
+		destination := newMemStore(nil)
+		m := newManager(t, source, destination)
+
+		_, err := m.Start(Request{Source: "source", Destination: "destination"})
+		require.NoError(t, err)
+
+		status := waitForJob(t, m)
+		assert.Equal(t, StateCompleted, status.State)
+		assert.Equal(t, int64(3), status.Copied)
+		assert.Equal(t, 3, destination.len())
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		source := &queryableStore{memStore: newMemStore(map[string]string{"a": "1", "b": "2", "c": "3"})}
+		destination := newMemStore(nil)
+		m := newManager(t, source, destination)
+
+		_, err := m.Start(Request{Source: "source", Destination: "destination", Resume: true})
+		require.ErrorIs(t, err, ErrNothingToResume)
+
+		// Resume from the second page.
+		_, err = m.Start(Request{Source: "source", Destination: "destination", Checkpoint: &Checkpoint{Token: "1"}})
+		require.NoError(t, err)
+		status := waitForJob(t, m)
+		assert.Equal(t, int64(2), status.Copied)
+		assert.Equal(t, Checkpoint{Token: "3"}, status.Checkpoint)
+		val, _ := destination.get("a")
+		assert.Nil(t, val)
+
+		// Nothing left to copy.
+		_, err = m.Start(Request{Source: "source", Destination: "destination", Resume: true})
+		require.NoError(t, err)
+		status = waitForJob(t, m)
+		assert.Equal(t, int64(0), status.Copied)
+	})
+
+	t.Run("values are decompressed", func(t *testing.T) {
+		compression.AddCompressedStateStore("source", compression.Options{Algorithm: compression.GzipAlgorithm})
+		defer compression.RemoveCompressedStateStore("source")
+
+		value := `{"data":"` + strings.Repeat("dapr", 100) + `"}`
+		compressed, err := compression.TryCompressValue("source", []byte(value))
+		require.NoError(t, err)
+		require.True(t, compression.IsCompressed(compressed))
+
+		source := newMemStore(map[string]string{"a": string(compressed)})
+		destination := newMemStore(nil)
+		m := newManager(t, source, destination)
+
+		_, err = m.Start(Request{Source: "source", Destination: "destination", Keys: []string{"a"}})
+		require.NoError(t, err)
+		status := waitForJob(t, m)
+		assert.Equal(t, StateCompleted, status.State)
+		val, _ := destination.get("a")
+		assert.Equal(t, value, string(val))
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		m := newManager(t, newMemStore(nil), newMemStore(nil))
+
+		_, err := m.Start(Request{Source: "source", Destination: "destination"})
+		require.ErrorIs(t, err, ErrKeysRequired)
+		_, err = m.Start(Request{Source: "source", Destination: "source", Keys: []string{"a"}})
+		require.ErrorIs(t, err, ErrSameStore)
+		_, err = m.Start(Request{Source: "source", Destination: "other", Keys: []string{"a"}})
+		require.ErrorIs(t, err, ErrStoreNotFound)
+		_, err = m.Start(Request{Source: "source", Destination: "destination", Keys: []string{"a"}, Checkpoint: &Checkpoint{Offset: 2}})
+		require.Error(t, err)
+		_, ok := m.Status("source")
+		assert.False(t, ok)
+	})
+}
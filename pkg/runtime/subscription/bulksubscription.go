@@ -18,14 +18,18 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/dapr/components-contrib/contenttype"
 	"github.com/dapr/components-contrib/metadata"
 	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/messages"
 	"github.com/dapr/dapr/pkg/resiliency"
 	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/schema"
 	"github.com/dapr/dapr/pkg/runtime/subscription/todo"
 )
 
@@ -70,6 +74,34 @@ func (s *Subscription) bulkSubscribeTopic(ctx context.Context, policyDef *resili
 	}
 
 	bulkHandler := func(ctx context.Context, msg *contribpubsub.BulkMessage) ([]contribpubsub.BulkSubscribeResponseEntry, error) {
+		untrack, ok := s.drainer.Track()
+		if !ok {
+			return nil, messages.ErrDraining
+		}
+		defer untrack()
+
+		if s.limiter != nil {
+			// A bulk of messages is delivered to the app by a single handler.
+			leave, err := s.limiter.enqueue(ctx)
+			if err != nil {
+				return nil, err
+			}
+			defer leave()
+			release, err := s.limiter.acquire(ctx)
+			if err != nil {
+				return nil, err
+			}
+			defer release()
+		}
+
+		if s.scheduler != nil {
+			release, err := s.scheduler.acquire(ctx, s.route.Priority)
+			if err != nil {
+				return nil, err
+			}
+			defer release()
+		}
+
 		if msg.Metadata == nil {
 			msg.Metadata = make(map[string]string, 1)
 		}
@@ -79,12 +111,21 @@ func (s *Subscription) bulkSubscribeTopic(ctx context.Context, policyDef *resili
 		bulkResponses := make([]contribpubsub.BulkSubscribeResponseEntry, len(msg.Entries))
 		routePathBulkMessageMap := make(map[string]todo.BulkSubscribedMessage)
 		entryIdIndexMap := make(map[string]int, len(msg.Entries)) //nolint:stylecheck
+		// The messages are delivered from a topic matching the topic of the
+		// subscription, which may be a pattern
+		msgTopic := topic
+		if msg.Topic != "" {
+			msgTopic = msg.Topic
+			if namespacedConsumer {
+				msgTopic = strings.Replace(msgTopic, s.namespace, "", 1)
+			}
+		}
 		bulkSubCallData := todo.BulkSubscribeCallData{
 			BulkResponses:   &bulkResponses,
 			BulkSubDiag:     &bulkSubDiag,
 			EntryIdIndexMap: &entryIdIndexMap,
 			PsName:          psName,
-			Topic:           topic,
+			Topic:           msgTopic,
 		}
 		rawPayload, err := metadata.IsRawPayload(route.Metadata)
 		if err != nil {
@@ -111,6 +152,10 @@ func (s *Subscription) bulkSubscribeTopic(ctx context.Context, policyDef *resili
 					hasAnyError = true
 					continue
 				}
+				if rPath == "" {
+					// The entry has been dropped.
+					continue
+				}
 				dataB64 := base64.StdEncoding.EncodeToString(message.Event)
 				if message.ContentType == "" {
 					message.ContentType = "application/octet-stream"
@@ -126,17 +171,15 @@ func (s *Subscription) bulkSubscribeTopic(ctx context.Context, policyDef *resili
 					hasAnyError = true
 					continue
 				}
-				if contribpubsub.HasExpired(cloudEvent) {
-					log.Warnf("dropping expired pub/sub event %v as of %v", cloudEvent[contribpubsub.IDField], cloudEvent[contribpubsub.ExpirationField])
+				if s.ttlPolicy.HasExpired(cloudEvent, time.Now()) {
+					log.Warnf("dropping expired pub/sub event %v in pubsub %s and topic %s", cloudEvent[contribpubsub.IDField], psName, topic)
 					bulkSubDiag.StatusWiseDiag[string(contribpubsub.Drop)]++
-					if route.DeadLetterTopic != "" {
-						_ = s.sendToDeadLetter(ctx, psName, &contribpubsub.NewMessage{
-							Data:        message.Event,
-							Topic:       topic,
-							Metadata:    message.Metadata,
-							ContentType: &msg.Entries[i].ContentType,
-						}, route.DeadLetterTopic)
-					}
+					s.handleExpired(ctx, psName, topic, &contribpubsub.NewMessage{
+						Data:        message.Event,
+						Topic:       topic,
+						Metadata:    message.Metadata,
+						ContentType: &msg.Entries[i].ContentType,
+					})
 					bulkResponses[i].EntryId = message.EntryId
 					bulkResponses[i].Error = nil
 					continue
@@ -146,6 +189,10 @@ func (s *Subscription) bulkSubscribeTopic(ctx context.Context, policyDef *resili
 					hasAnyError = true
 					continue
 				}
+				if rPath == "" {
+					// The entry has been dropped.
+					continue
+				}
 				if message.ContentType == "" {
 					message.ContentType = contenttype.CloudEventContentType
 				}
@@ -209,6 +256,37 @@ func (s *Subscription) getRouteIfProcessable(ctx context.Context, bulkSubCallDat
 	i int, matchElem interface{},
 ) (string, error) {
 	bscData := *bulkSubCallData
+	if s.pubsub.Schemas != nil {
+		payload := message.Event
+		if cloudEvent, ok := matchElem.(map[string]interface{}); ok {
+			payload = rtpubsub.CloudEventPayload(cloudEvent)
+		}
+		if err := s.pubsub.Schemas.ValidateSubscribe(ctx, bscData.Topic, payload); err != nil {
+			if !errors.Is(err, schema.ErrInvalid) {
+				log.Errorf("Error validating event in bulk subscribe %s and topic %s for entry id %s against the topic schema: %s", bscData.PsName, bscData.Topic, message.EntryId, err)
+				todo.SetBulkResponseEntry(bscData.BulkResponses, i, message.EntryId, err)
+				return "", err
+			}
+			log.Warnf("Dropping event in bulk subscribe %s and topic %s for entry id %s: %s", bscData.PsName, bscData.Topic, message.EntryId, err)
+			bscData.BulkSubDiag.StatusWiseDiag[string(contribpubsub.Drop)]++
+			if route.DeadLetterTopic != "" {
+				_ = s.sendToDeadLetter(ctx, bscData.PsName, &contribpubsub.NewMessage{
+					Data:        message.Event,
+					Topic:       bscData.Topic,
+					Metadata:    message.Metadata,
+					ContentType: &message.ContentType,
+				}, route.DeadLetterTopic, rtpubsub.DeadLetter{Reason: rtpubsub.DeadLetterReasonInvalid, Err: err})
+			}
+			todo.SetBulkResponseEntry(bscData.BulkResponses, i, message.EntryId, nil)
+			return "", nil
+		}
+	}
+	if !matchFilter(route.Filter, matchElem) {
+		log.Debugf("Event in bulk subscribe %s and topic %s for entry id %s does not match the subscription filter; dropping", bscData.PsName, bscData.Topic, message.EntryId)
+		bscData.BulkSubDiag.StatusWiseDiag[string(contribpubsub.Drop)]++
+		todo.SetBulkResponseEntry(bscData.BulkResponses, i, message.EntryId, nil)
+		return "", nil
+	}
 	rPath, shouldProcess, routeErr := findMatchingRoute(route.Rules, matchElem)
 	if routeErr != nil {
 		log.Errorf("Error finding matching route for event in bulk subscribe %s and topic %s for entry id %s: %s", bscData.PsName, bscData.Topic, message.EntryId, routeErr)
@@ -225,7 +303,7 @@ func (s *Subscription) getRouteIfProcessable(ctx context.Context, bulkSubCallDat
 				Topic:       bscData.Topic,
 				Metadata:    message.Metadata,
 				ContentType: &message.ContentType,
-			}, route.DeadLetterTopic)
+			}, route.DeadLetterTopic, rtpubsub.DeadLetter{Reason: rtpubsub.DeadLetterReasonUnmatched})
 		}
 		todo.SetBulkResponseEntry(bscData.BulkResponses, i, message.EntryId, nil)
 		return "", nil
@@ -265,23 +343,43 @@ func (s *Subscription) sendBulkToDeadLetter(ctx context.Context,
 	bscData := *bulkSubCallData
 	data := make([]contribpubsub.BulkMessageEntry, len(msg.Entries))
 
-	if sendAllEntries {
-		data = msg.Entries
-	} else {
-		n := 0
-		for _, message := range msg.Entries {
-			entryId := (*bscData.EntryIdIndexMap)[message.EntryId] //nolint:stylecheck
-			if (*bscData.BulkResponses)[entryId].Error != nil {
-				data[n] = message
-				n++
+	now := time.Now()
+	n := 0
+	for _, message := range msg.Entries {
+		dl := rtpubsub.DeadLetter{
+			Topic:           s.topic,
+			DeadLetterTopic: deadLetterTopic,
+			Reason:          rtpubsub.DeadLetterReasonInvalid,
+		}
+		if !sendAllEntries {
+			entryId, ok := (*bscData.EntryIdIndexMap)[message.EntryId] //nolint:stylecheck
+			if !ok || (*bscData.BulkResponses)[entryId].Error == nil {
+				continue
 			}
+			dl.Reason = rtpubsub.DeadLetterReasonError
+			dl.Err = (*bscData.BulkResponses)[entryId].Error
+		}
+
+		var err error
+		message.Event, message.Metadata, err = dl.Stamp(message.Event, message.Metadata, now)
+		if errors.Is(err, rtpubsub.ErrDeadLetterLoop) {
+			log.Errorf("dropping message %s from topic %s: %s", message.EntryId, s.topic, err)
+			continue
 		}
-		data = data[:n]
+		if err != nil {
+			return err
+		}
+		data[n] = message
+		n++
 	}
+	data = data[:n]
 	bscData.BulkSubDiag.StatusWiseDiag[string(contribpubsub.Drop)] += int64(len(data))
 	if bscData.BulkSubDiag.RetryReported {
 		bscData.BulkSubDiag.StatusWiseDiag[string(contribpubsub.Retry)] -= int64(len(data))
 	}
+	if len(data) == 0 {
+		return nil
+	}
 	req := &contribpubsub.BulkPublishRequest{
 		Entries:    data,
 		PubsubName: bscData.PsName,
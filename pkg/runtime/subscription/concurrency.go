@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"context"
+	"sync/atomic"
+
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+)
+
+// limiter enforces the maximum number of concurrent handlers of a
+// subscription, and the number of messages which can wait for a free handler.
+// Messages beyond that block the delivery from the broker.
+type limiter struct {
+	pubsubName string
+	topic      string
+
+	queue    chan struct{}
+	handlers chan struct{}
+	waiting  atomic.Int64
+}
+
+// newLimiter returns a limiter for the given subscription limits, or nil if
+// the concurrency of the subscription is not limited.
+func newLimiter(pubsubName, topic string, maxConcurrentHandlers, prefetch int32) *limiter {
+	if maxConcurrentHandlers <= 0 {
+		return nil
+	}
+	return &limiter{
+		pubsubName: pubsubName,
+		topic:      topic,
+		queue:      make(chan struct{}, maxConcurrentHandlers+max(prefetch, 0)),
+		handlers:   make(chan struct{}, maxConcurrentHandlers),
+	}
+}
+
+// enqueue waits for a place in the queue of the subscription, and returns the
+// function to leave it.
+func (l *limiter) enqueue(ctx context.Context) (func(), error) {
+	select {
+	case l.queue <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	l.waiting.Add(1)
+	l.report(ctx)
+	return func() {
+		<-l.queue
+	}, nil
+}
+
+// acquire waits for a free handler, and returns the function to release it.
+// enqueue must have been called before.
+func (l *limiter) acquire(ctx context.Context) (func(), error) {
+	select {
+	case l.handlers <- struct{}{}:
+	case <-ctx.Done():
+		l.waiting.Add(-1)
+		l.report(ctx)
+		return nil, ctx.Err()
+	}
+	l.waiting.Add(-1)
+	l.report(ctx)
+	return func() {
+		<-l.handlers
+		l.report(ctx)
+	}, nil
+}
+
+func (l *limiter) report(ctx context.Context) {
+	diag.DefaultComponentMonitoring.PubsubIngressConcurrency(ctx, l.pubsubName, l.topic,
+		l.waiting.Load(), float64(len(l.handlers))/float64(cap(l.handlers)))
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/resiliency"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+)
+
+func TestLimiter(t *testing.T) {
+	t.Run("no limit", func(t *testing.T) {
+		assert.Nil(t, newLimiter("pubsub", "topic", 0, 10))
+	})
+
+	t.Run("handlers and prefetch are enforced", func(t *testing.T) {
+		l := newLimiter("pubsub", "topic", 2, 1)
+		require.NotNil(t, l)
+
+		var releases []func()
+		for range 2 {
+			leave, err := l.enqueue(t.Context())
+			require.NoError(t, err)
+			release, err := l.acquire(t.Context())
+			require.NoError(t, err)
+			releases = append(releases, func() {
+				release()
+				leave()
+			})
+		}
+
+		// The third message is prefetched but no handler is free.
+		leave, err := l.enqueue(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), l.waiting.Load())
+		ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+		defer cancel()
+		_, err = l.acquire(ctx)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, int64(0), l.waiting.Load())
+
+		// The fourth message is beyond the prefetch and blocks.
+		ctx, cancel = context.WithTimeout(t.Context(), 10*time.Millisecond)
+		defer cancel()
+		_, err = l.enqueue(ctx)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		leave()
+		releases[0]()
+		leave, err = l.enqueue(t.Context())
+		require.NoError(t, err)
+		release, err := l.acquire(t.Context())
+		require.NoError(t, err)
+		release()
+		leave()
+		releases[1]()
+		assert.Empty(t, l.queue)
+		assert.Empty(t, l.handlers)
+	})
+}
+
+func TestConcurrencyOptions(t *testing.T) {
+	comp := &mockSubscribePubSub{}
+	require.NoError(t, comp.Init(t.Context(), contribpubsub.Metadata{}))
+
+	_, err := New(Options{
+		Resiliency: resiliency.New(log),
+		PubSub:     &rtpubsub.PubsubItem{Component: comp},
+		PubSubName: "testpubsub",
+		Topic:      "topic0",
+		Route: rtpubsub.Subscription{
+			MaxConcurrentHandlers: -1,
+		},
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"context"
+	"sync"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+)
+
+// partitionKeyExtension is the CloudEvents partitioning extension attribute.
+const partitionKeyExtension = "partitionkey"
+
+// partitionKeyMetadataKeys are the message metadata keys which components use
+// to expose the partition key of a message.
+var partitionKeyMetadataKeys = []string{"partitionKey", "__key"}
+
+// orderer serializes the delivery of messages sharing a key. Messages with
+// the same key are delivered one at a time, in the order in which they
+// acquired their turn. Each key has its own queue so messages with different
+// keys are delivered concurrently.
+type orderer struct {
+	lock   sync.Mutex
+	queues map[string][]chan struct{}
+}
+
+func newOrderer() *orderer {
+	return &orderer{
+		queues: make(map[string][]chan struct{}),
+	}
+}
+
+// acquire waits for the turn of the caller on the queue of the given key, and
+// returns the function to release it. An empty key is never serialized.
+func (o *orderer) acquire(ctx context.Context, key string) (func(), error) {
+	if key == "" {
+		return func() {}, nil
+	}
+
+	o.lock.Lock()
+	queue, ok := o.queues[key]
+	turn := make(chan struct{})
+	o.queues[key] = append(queue, turn)
+	if !ok {
+		// First in the queue.
+		close(turn)
+	}
+	o.lock.Unlock()
+
+	select {
+	case <-turn:
+		return func() { o.release(key) }, nil
+	case <-ctx.Done():
+		// Wait for the turn in the background so that the queue keeps moving.
+		go func() {
+			<-turn
+			o.release(key)
+		}()
+		return nil, ctx.Err()
+	}
+}
+
+// release gives the turn of the given key to the next waiter, if any.
+func (o *orderer) release(key string) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	queue := o.queues[key][1:]
+	if len(queue) == 0 {
+		delete(o.queues, key)
+		return
+	}
+	o.queues[key] = queue
+	close(queue[0])
+}
+
+// partitionKey returns the partition key of a message, looking first at the
+// message metadata and then at the CloudEvent partitioning extension.
+func partitionKey(msg *contribpubsub.NewMessage, cloudEvent map[string]any) string {
+	for _, k := range partitionKeyMetadataKeys {
+		if v := msg.Metadata[k]; v != "" {
+			return v
+		}
+	}
+	if v, ok := cloudEvent[partitionKeyExtension].(string); ok {
+		return v
+	}
+	return ""
+}
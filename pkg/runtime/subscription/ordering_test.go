@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/resiliency"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+)
+
+func (o *orderer) queueLen(key string) int {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	return len(o.queues[key])
+}
+
+func TestOrderer(t *testing.T) {
+	t.Run("same key is delivered in order", func(t *testing.T) {
+		o := newOrderer()
+		release, err := o.acquire(t.Context(), "a")
+		require.NoError(t, err)
+
+		var lock sync.Mutex
+		var order []int
+		var wg sync.WaitGroup
+		for i := range 5 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				rel, err := o.acquire(t.Context(), "a")
+				assert.NoError(t, err)
+				lock.Lock()
+				order = append(order, i)
+				lock.Unlock()
+				rel()
+			}()
+			assert.Eventually(t, func() bool {
+				return o.queueLen("a") == i+2
+			}, time.Second, time.Millisecond)
+		}
+
+		release()
+		wg.Wait()
+		assert.Equal(t, []int{0, 1, 2, 3, 4}, order)
+		assert.Empty(t, o.queues)
+	})
+
+	t.Run("different keys are not serialized", func(t *testing.T) {
+		o := newOrderer()
+		releaseA, err := o.acquire(t.Context(), "a")
+		require.NoError(t, err)
+		releaseB, err := o.acquire(t.Context(), "b")
+		require.NoError(t, err)
+		releaseEmpty, err := o.acquire(t.Context(), "")
+		require.NoError(t, err)
+		releaseA()
+		releaseB()
+		releaseEmpty()
+		assert.Empty(t, o.queues)
+	})
+
+	t.Run("cancelled waiter does not block the queue", func(t *testing.T) {
+		o := newOrderer()
+		release, err := o.acquire(t.Context(), "a")
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+		_, err = o.acquire(ctx, "a")
+		require.ErrorIs(t, err, context.Canceled)
+
+		release()
+		release, err = o.acquire(t.Context(), "a")
+		require.NoError(t, err)
+		release()
+		assert.Eventually(t, func() bool {
+			return o.queueLen("a") == 0
+		}, time.Second, time.Millisecond)
+	})
+}
+
+func TestPartitionKey(t *testing.T) {
+	msg := &contribpubsub.NewMessage{Metadata: map[string]string{"__key": "k1"}}
+	assert.Equal(t, "k1", partitionKey(msg, map[string]any{partitionKeyExtension: "k2"}))
+
+	msg = &contribpubsub.NewMessage{Metadata: map[string]string{}}
+	assert.Equal(t, "k2", partitionKey(msg, map[string]any{partitionKeyExtension: "k2"}))
+	assert.Empty(t, partitionKey(msg, map[string]any{}))
+}
+
+func TestOrderingOptions(t *testing.T) {
+	comp := &mockSubscribePubSub{}
+	require.NoError(t, comp.Init(t.Context(), contribpubsub.Metadata{}))
+
+	t.Run("ordering with bulk subscribe is rejected", func(t *testing.T) {
+		_, err := New(Options{
+			Resiliency: resiliency.New(log),
+			PubSub:     &rtpubsub.PubsubItem{Component: comp},
+			PubSubName: "testpubsub",
+			Topic:      "topic0",
+			Route: rtpubsub.Subscription{
+				Ordering:      rtpubsub.OrderingPartitionKey,
+				BulkSubscribe: &rtpubsub.BulkSubscribe{Enabled: true},
+			},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("unknown ordering is rejected", func(t *testing.T) {
+		_, err := New(Options{
+			Resiliency: resiliency.New(log),
+			PubSub:     &rtpubsub.PubsubItem{Component: comp},
+			PubSubName: "testpubsub",
+			Topic:      "topic0",
+			Route: rtpubsub.Subscription{
+				Ordering: "global",
+			},
+		})
+		require.Error(t, err)
+	})
+}
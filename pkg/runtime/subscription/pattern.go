@@ -0,0 +1,207 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+)
+
+const (
+	// topicRefreshIntervalMetadataKey is the subscription metadata key of the
+	// interval at which the topics of the pubsub are listed again, to subscribe
+	// to the new topics matching the topic pattern of the subscription.
+	topicRefreshIntervalMetadataKey = "topicRefreshInterval"
+
+	defaultTopicRefreshInterval = 30 * time.Second
+	topicListTimeout            = 10 * time.Second
+)
+
+// patternSubscription subscribes to the topics matching a topic pattern, for
+// pubsubs which cannot subscribe to patterns but can list their topics. A
+// subscription is started for every matching topic, and stopped when the
+// topic no longer exists.
+type patternSubscription struct {
+	opts     Options
+	pattern  rtpubsub.TopicPattern
+	lister   rtpubsub.TopicLister
+	interval time.Duration
+
+	lock    sync.Mutex
+	subs    map[string]*Subscription
+	closeCh chan struct{}
+	closed  bool
+	wg      sync.WaitGroup
+}
+
+func newPatternSubscription(opts Options) (*Subscription, error) {
+	lister, ok := opts.PubSub.Component.(rtpubsub.TopicLister)
+	if !ok {
+		return nil, fmt.Errorf("invalid subscription to topic '%s' on pubsub '%s': the pubsub does not support topic patterns", opts.Topic, opts.PubSubName)
+	}
+	if opts.AdapterStreamer != nil {
+		return nil, fmt.Errorf("invalid subscription to topic '%s' on pubsub '%s': topic patterns are not supported for streaming subscriptions", opts.Topic, opts.PubSubName)
+	}
+
+	interval := defaultTopicRefreshInterval
+	if v, ok := opts.Route.Metadata[topicRefreshIntervalMetadataKey]; ok {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid subscription to topic '%s' on pubsub '%s': invalid %s '%s'", opts.Topic, opts.PubSubName, topicRefreshIntervalMetadataKey, v)
+		}
+		interval = d
+	}
+
+	p := &patternSubscription{
+		opts:     opts,
+		pattern:  rtpubsub.NewTopicPattern(opts.Topic),
+		lister:   lister,
+		interval: interval,
+		subs:     make(map[string]*Subscription),
+		closeCh:  make(chan struct{}),
+	}
+
+	if err := p.refresh(); err != nil {
+		return nil, fmt.Errorf("failed to list the topics matching '%s' on pubsub '%s': %w", opts.Topic, opts.PubSubName, err)
+	}
+
+	p.wg.Add(1)
+	go p.run()
+
+	return &Subscription{
+		appID:      opts.AppID,
+		namespace:  opts.Namespace,
+		pubsubName: opts.PubSubName,
+		topic:      opts.Topic,
+		pubsub:     opts.PubSub,
+		route:      opts.Route,
+		pattern:    p,
+	}, nil
+}
+
+func (p *patternSubscription) run() {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.closeCh:
+			return
+		case <-ticker.C:
+			if err := p.refresh(); err != nil {
+				log.Errorf("Failed to list the topics matching '%s' on pubsub '%s': %s", p.opts.Topic, p.opts.PubSubName, err)
+			}
+		}
+	}
+}
+
+// refresh lists the topics of the pubsub, and starts and stops the
+// subscriptions to the matching topics accordingly.
+func (p *patternSubscription) refresh() error {
+	ctx, cancel := context.WithTimeout(context.Background(), topicListTimeout)
+	defer cancel()
+	topics, err := p.lister.ListTopics(ctx)
+	if err != nil {
+		return err
+	}
+
+	matched := make(map[string]struct{})
+	for _, topic := range topics {
+		if p.opts.PubSub.NamespaceScoped {
+			if !strings.HasPrefix(topic, p.opts.Namespace) {
+				continue
+			}
+			topic = strings.TrimPrefix(topic, p.opts.Namespace)
+		}
+		if !p.pattern.Match(topic) || !rtpubsub.IsOperationAllowed(topic, p.opts.PubSub, p.opts.PubSub.ScopedSubscriptions) {
+			continue
+		}
+		matched[topic] = struct{}{}
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.closed {
+		return nil
+	}
+
+	for topic, sub := range p.subs {
+		if _, ok := matched[topic]; !ok {
+			log.Infof("Topic '%s' on pubsub '%s' no longer exists; stopping its subscription for pattern '%s'", topic, p.opts.PubSubName, p.opts.Topic)
+			sub.Stop()
+			delete(p.subs, topic)
+		}
+	}
+
+	for topic := range matched {
+		if _, ok := p.subs[topic]; ok {
+			continue
+		}
+		opts := p.opts
+		opts.Topic = topic
+		sub, err := New(opts)
+		if err != nil {
+			// The subscription is attempted again on the next refresh.
+			log.Errorf("Failed to subscribe to topic '%s' matching '%s' on pubsub '%s': %s", topic, p.opts.Topic, p.opts.PubSubName, err)
+			continue
+		}
+		log.Infof("Subscribed to topic '%s' matching '%s' on pubsub '%s'", topic, p.opts.Topic, p.opts.PubSubName)
+		p.subs[topic] = sub
+	}
+
+	return nil
+}
+
+// topics returns the topics subscribed to.
+func (p *patternSubscription) topics() []string {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	topics := make([]string, 0, len(p.subs))
+	for topic := range p.subs {
+		topics = append(topics, topic)
+	}
+	return topics
+}
+
+func (p *patternSubscription) stop(err ...error) {
+	p.lock.Lock()
+	if p.closed {
+		p.lock.Unlock()
+		return
+	}
+	p.closed = true
+	close(p.closeCh)
+	subs := p.subs
+	p.subs = make(map[string]*Subscription)
+	p.lock.Unlock()
+
+	p.wg.Wait()
+
+	var wg sync.WaitGroup
+	wg.Add(len(subs))
+	for _, sub := range subs {
+		go func(sub *Subscription) {
+			sub.Stop(err...)
+			wg.Done()
+		}(sub)
+	}
+	wg.Wait()
+}
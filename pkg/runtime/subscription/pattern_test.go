@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/resiliency"
+	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+)
+
+// listingPubSub is a pubsub which can list its topics.
+type listingPubSub struct {
+	contribpubsub.PubSub
+
+	lock       sync.Mutex
+	topics     []string
+	subscribed map[string]context.Context
+	features   []contribpubsub.Feature
+}
+
+func (l *listingPubSub) Features() []contribpubsub.Feature {
+	return l.features
+}
+
+func (l *listingPubSub) ListTopics(context.Context) ([]string, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return slices.Clone(l.topics), nil
+}
+
+func (l *listingPubSub) Subscribe(ctx context.Context, req contribpubsub.SubscribeRequest, _ contribpubsub.Handler) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.subscribed[req.Topic] = ctx
+	return nil
+}
+
+func (l *listingPubSub) setTopics(topics ...string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.topics = topics
+}
+
+// active returns the topics with an active subscription.
+func (l *listingPubSub) active() []string {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	var topics []string
+	for topic, ctx := range l.subscribed {
+		if ctx.Err() == nil {
+			topics = append(topics, topic)
+		}
+	}
+	slices.Sort(topics)
+	return topics
+}
+
+func TestPatternSubscription(t *testing.T) {
+	newOpts := func(comp contribpubsub.PubSub, ps *runtimePubsub.PubsubItem) Options {
+		if ps == nil {
+			ps = &runtimePubsub.PubsubItem{}
+		}
+		ps.Component = comp
+		return Options{
+			Resiliency: resiliency.New(log),
+			PubSub:     ps,
+			PubSubName: "testpubsub",
+			Topic:      "orders.*",
+			Route: runtimePubsub.Subscription{
+				Rules:    []*runtimePubsub.Rule{{Path: "orders"}},
+				Metadata: map[string]string{topicRefreshIntervalMetadataKey: "10ms"},
+			},
+		}
+	}
+
+	t.Run("matching topics are subscribed as they appear and disappear", func(t *testing.T) {
+		comp := &listingPubSub{
+			topics:     []string{"orders.created", "orders.eu.created", "payments.created"},
+			subscribed: make(map[string]context.Context),
+		}
+		sub, err := New(newOpts(comp, nil))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"orders.created"}, comp.active())
+
+		comp.setTopics("orders.created", "orders.deleted")
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			assert.Equal(c, []string{"orders.created", "orders.deleted"}, comp.active())
+		}, time.Second, 5*time.Millisecond)
+
+		comp.setTopics("orders.deleted")
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			assert.Equal(c, []string{"orders.deleted"}, comp.active())
+		}, time.Second, 5*time.Millisecond)
+
+		sub.Stop()
+		assert.Empty(t, comp.active())
+		assert.Empty(t, sub.pattern.topics())
+	})
+
+	t.Run("topics which are not allowed are not subscribed", func(t *testing.T) {
+		comp := &listingPubSub{
+			topics:     []string{"orders.created", "orders.deleted"},
+			subscribed: make(map[string]context.Context),
+		}
+		sub, err := New(newOpts(comp, &runtimePubsub.PubsubItem{AllowedTopics: []string{"orders.created"}}))
+		require.NoError(t, err)
+		t.Cleanup(func() { sub.Stop() })
+		assert.Equal(t, []string{"orders.created"}, comp.active())
+	})
+
+	t.Run("namespaced topics", func(t *testing.T) {
+		comp := &listingPubSub{
+			topics:     []string{"ns1orders.created", "ns2orders.deleted"},
+			subscribed: make(map[string]context.Context),
+		}
+		opts := newOpts(comp, &runtimePubsub.PubsubItem{NamespaceScoped: true})
+		opts.Namespace = "ns1"
+		sub, err := New(opts)
+		require.NoError(t, err)
+		t.Cleanup(func() { sub.Stop() })
+		assert.Equal(t, []string{"ns1orders.created"}, comp.active())
+	})
+
+	t.Run("patterns are passed through to pubsubs supporting wildcards", func(t *testing.T) {
+		comp := &listingPubSub{
+			subscribed: make(map[string]context.Context),
+			features:   []contribpubsub.Feature{contribpubsub.FeatureSubscribeWildcards},
+		}
+		sub, err := New(newOpts(comp, nil))
+		require.NoError(t, err)
+		t.Cleanup(func() { sub.Stop() })
+		assert.Nil(t, sub.pattern)
+		assert.Equal(t, []string{"orders.*"}, comp.active())
+	})
+
+	t.Run("pubsubs which cannot list topics reject patterns", func(t *testing.T) {
+		comp := &mockSubscribePubSub{}
+		require.NoError(t, comp.Init(t.Context(), contribpubsub.Metadata{}))
+		_, err := New(newOpts(comp, nil))
+		require.ErrorContains(t, err, "does not support topic patterns")
+	})
+}
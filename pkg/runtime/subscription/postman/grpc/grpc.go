@@ -23,12 +23,14 @@ import (
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/codes"
+	grpcMetadata "google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	contribpubsub "github.com/dapr/components-contrib/pubsub"
 	"github.com/dapr/dapr/pkg/api/grpc/manager"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
+	diagConsts "github.com/dapr/dapr/pkg/diagnostics/consts"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	rtv1 "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
@@ -70,6 +72,9 @@ func (g *grpc) Deliver(ctx context.Context, msg *pubsub.SubscribedMessage) error
 	}
 
 	ctx = invokev1.WithCustomGRPCMetadata(ctx, msg.Metadata)
+	if baggage := pubsub.BaggageFromCloudEvent(cloudEvent); baggage != "" {
+		ctx = grpcMetadata.AppendToOutgoingContext(ctx, diagConsts.BaggageHeader, baggage)
+	}
 	ctx = g.channel.AddAppTokenToContext(ctx)
 
 	conn, err := g.channel.GetAppClient()
@@ -93,14 +98,14 @@ func (g *grpc) Deliver(ctx context.Context, msg *pubsub.SubscribedMessage) error
 		errStatus, hasErrStatus := status.FromError(err)
 		if hasErrStatus && (errStatus.Code() == codes.Unimplemented) {
 			// DROP
-			log.Warnf("non-retriable error returned from app while processing pub/sub event %v: %s", cloudEvent[contribpubsub.IDField], err)
+			diag.LoggerWithTraceContext(ctx, log).Warnf("non-retriable error returned from app while processing pub/sub event %v: %s", cloudEvent[contribpubsub.IDField], err)
 			diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, msg.PubSub, strings.ToLower(string(contribpubsub.Drop)), "", msg.Topic, elapsed)
 
 			return nil
 		}
 
 		err = fmt.Errorf("error returned from app while processing pub/sub event %v: %w", cloudEvent[contribpubsub.IDField], rterrors.NewRetriable(err))
-		log.Debug(err)
+		diag.LoggerWithTraceContext(ctx, log).Debug(err)
 		diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, msg.PubSub, strings.ToLower(string(contribpubsub.Retry)), "", msg.Topic, elapsed)
 
 		// return error status code for resiliency to decide on retry
@@ -125,7 +130,7 @@ func (g *grpc) Deliver(ctx context.Context, msg *pubsub.SubscribedMessage) error
 		// TODO: add retry error info
 		return fmt.Errorf("RETRY status returned from app while processing pub/sub event %v: %w", cloudEvent[contribpubsub.IDField], rterrors.NewRetriable(nil))
 	case rtv1.TopicEventResponse_DROP: //nolint:nosnakecase
-		log.Warnf("DROP status returned from app while processing pub/sub event %v", cloudEvent[contribpubsub.IDField])
+		diag.LoggerWithTraceContext(ctx, log).Warnf("DROP status returned from app while processing pub/sub event %v", cloudEvent[contribpubsub.IDField])
 		diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, msg.PubSub, strings.ToLower(string(contribpubsub.Drop)), strings.ToLower(string(contribpubsub.Success)), msg.Topic, elapsed)
 
 		return pubsub.ErrMessageDropped
@@ -170,33 +175,15 @@ func (g *grpc) DeliverBulk(ctx context.Context, req *postman.DeliverBulkRequest)
 		Path:       psm.Path,
 	}
 
-	spans := make([]trace.Span, len(psm.PubSubMessages))
-	n := 0
-	for _, pubSubMsg := range psm.PubSubMessages {
-		cloudEvent := pubSubMsg.CloudEvent
-		iTraceID := cloudEvent[contribpubsub.TraceParentField]
-		if iTraceID == nil {
-			iTraceID = cloudEvent[contribpubsub.TraceIDField]
-		}
-		if iTraceID != nil {
-			if traceID, ok := iTraceID.(string); ok {
-				sc, _ := diag.SpanContextFromW3CString(traceID)
-
-				// no ops if trace is off
-				var span trace.Span
-				ctx, span = diag.StartInternalCallbackSpan(ctx, "pubsub/"+psm.Topic, sc, g.tracingSpec)
-				if span != nil {
-					ctx = diag.SpanContextToGRPCMetadata(ctx, span.SpanContext())
-					spans[n] = span
-					n++
-				}
-			} else {
-				log.Warnf("ignored non-string traceid value: %v", iTraceID)
-			}
-		}
+	var span trace.Span
+	if links := todo.BulkSpanLinks(psm.PubSubMessages); len(links) > 0 {
+		// no ops if trace is off
+		ctx, span = diag.StartBulkDeliverySpan(ctx, "pubsub/"+psm.Topic, links, g.tracingSpec)
+	}
+	if span != nil {
+		ctx = diag.SpanContextToGRPCMetadata(ctx, span.SpanContext())
+		defer span.End()
 	}
-	spans = spans[:n]
-	defer todo.EndSpans(spans)
 	ctx = invokev1.WithCustomGRPCMetadata(ctx, psm.Metadata)
 	ctx = g.channel.AddAppTokenToContext(ctx)
 
@@ -210,7 +197,7 @@ func (g *grpc) DeliverBulk(ctx context.Context, req *postman.DeliverBulkRequest)
 	res, err := clientV1.OnBulkTopicEventAlpha1(ctx, envelope)
 	elapsed := diag.ElapsedSince(start)
 
-	for _, span := range spans {
+	if span != nil {
 		m := diag.ConstructSubscriptionSpanAttributes(envelope.GetTopic())
 		diag.AddAttributesToSpan(span, m)
 		diag.UpdateSpanStatusFromGRPCError(span, err)
@@ -220,7 +207,7 @@ func (g *grpc) DeliverBulk(ctx context.Context, req *postman.DeliverBulkRequest)
 		errStatus, hasErrStatus := status.FromError(err)
 		if hasErrStatus && (errStatus.Code() == codes.Unimplemented) {
 			// DROP
-			log.Warnf("non-retriable error returned from app while processing bulk pub/sub event: %s", err)
+			diag.LoggerWithTraceContext(ctx, log).Warnf("non-retriable error returned from app while processing bulk pub/sub event: %s", err)
 			bscData.BulkSubDiag.StatusWiseDiag[string(contribpubsub.Drop)] += int64(len(psm.PubSubMessages))
 			bscData.BulkSubDiag.Elapsed = elapsed
 			todo.PopulateBulkSubscribeResponsesWithError(psm, bulkResponses, nil)
@@ -228,7 +215,7 @@ func (g *grpc) DeliverBulk(ctx context.Context, req *postman.DeliverBulkRequest)
 		}
 
 		err = fmt.Errorf("error returned from app while processing bulk pub/sub event: %w", err)
-		log.Debug(err)
+		diag.LoggerWithTraceContext(ctx, log).Debug(err)
 		bscData.BulkSubDiag.StatusWiseDiag[string(contribpubsub.Retry)] += int64(len(psm.PubSubMessages))
 		bscData.BulkSubDiag.Elapsed = elapsed
 		todo.PopulateBulkSubscribeResponsesWithError(psm, bulkResponses, err)
@@ -262,7 +249,7 @@ func (g *grpc) DeliverBulk(ctx context.Context, req *postman.DeliverBulkRequest)
 					fmt.Errorf("RETRY status returned from app while processing pub/sub event for entry id: %v", entryID))
 				hasAnyError = true
 			case rtv1.TopicEventResponse_DROP: //nolint:nosnakecase
-				log.Warnf("DROP status returned from app while processing pub/sub event for entry id: %v", entryID)
+				diag.LoggerWithTraceContext(ctx, log).Warnf("DROP status returned from app while processing pub/sub event for entry id: %v", entryID)
 				bscData.BulkSubDiag.StatusWiseDiag[string(contribpubsub.Drop)] += 1
 				entryRespReceived[entryID] = true
 				todo.AddBulkResponseEntry(bulkResponses, entryID, nil)
@@ -284,7 +271,7 @@ func (g *grpc) DeliverBulk(ctx context.Context, req *postman.DeliverBulkRequest)
 				hasAnyError = true
 			}
 		} else {
-			log.Warnf("Invalid entry id received from app while processing pub/sub event %v", entryID)
+			diag.LoggerWithTraceContext(ctx, log).Warnf("Invalid entry id received from app while processing pub/sub event %v", entryID)
 			continue
 		}
 	}
@@ -306,17 +293,34 @@ func (g *grpc) DeliverBulk(ctx context.Context, req *postman.DeliverBulkRequest)
 	}
 }
 
+// sendToDeadLetter sends a message dropped by the app to the dead letter
+// topic, stamped with the dead letter information.
 func (g *grpc) sendToDeadLetter(ctx context.Context, name string, msg *contribpubsub.NewMessage, deadLetterTopic string) error {
+	dl := pubsub.DeadLetter{
+		Topic:           msg.Topic,
+		DeadLetterTopic: deadLetterTopic,
+		Reason:          pubsub.DeadLetterReasonDropped,
+	}
+	data, md, err := dl.Stamp(msg.Data, msg.Metadata, time.Now())
+	if errors.Is(err, pubsub.ErrDeadLetterLoop) {
+		diag.LoggerWithTraceContext(ctx, log).Errorf("dropping message from topic %s: %s", msg.Topic, err)
+		return nil
+	}
+	if err != nil {
+		diag.LoggerWithTraceContext(ctx, log).Errorf("error stamping message for dead letter, origin topic: %s dead letter topic %s err: %s", msg.Topic, deadLetterTopic, err)
+		return err
+	}
+
 	req := &contribpubsub.PublishRequest{
-		Data:        msg.Data,
+		Data:        data,
 		PubsubName:  name,
 		Topic:       deadLetterTopic,
-		Metadata:    msg.Metadata,
+		Metadata:    md,
 		ContentType: msg.ContentType,
 	}
 
 	if err := g.adapter.Publish(ctx, req); err != nil {
-		log.Errorf("error sending message to dead letter, origin topic: %s dead letter topic %s err: %w", msg.Topic, deadLetterTopic, err)
+		diag.LoggerWithTraceContext(ctx, log).Errorf("error sending message to dead letter, origin topic: %s dead letter topic %s err: %w", msg.Topic, deadLetterTopic, err)
 		return err
 	}
 
@@ -29,6 +29,7 @@ import (
 	contribpubsub "github.com/dapr/components-contrib/pubsub"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
+	diagConsts "github.com/dapr/dapr/pkg/diagnostics/consts"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/channels"
@@ -73,6 +74,10 @@ func (h *http) Deliver(ctx context.Context, msg *pubsub.SubscribedMessage) error
 		WithCustomHTTPMetadata(msg.Metadata)
 	defer req.Close()
 
+	if baggage := pubsub.BaggageFromCloudEvent(cloudEvent); baggage != "" {
+		req.WithCustomHTTPMetadata(map[string]string{diagConsts.BaggageHeader: baggage})
+	}
+
 	iTraceID := cloudEvent[contribpubsub.TraceParentField]
 	if iTraceID == nil {
 		iTraceID = cloudEvent[contribpubsub.TraceIDField]
@@ -84,7 +89,7 @@ func (h *http) Deliver(ctx context.Context, msg *pubsub.SubscribedMessage) error
 	}
 
 	start := time.Now()
-	resp, err := h.channels.AppChannel().InvokeMethod(ctx, req, "")
+	resp, err := h.channels.AppChannelFor(config.AppCallbackPubSub).InvokeMethod(ctx, req, "")
 	elapsed := diag.ElapsedSince(start)
 
 	if err != nil {
@@ -108,9 +113,9 @@ func (h *http) Deliver(ctx context.Context, msg *pubsub.SubscribedMessage) error
 		err := json.NewDecoder(resp.RawData()).Decode(&appResponse)
 		if err != nil {
 			if errors.Is(err, io.EOF) {
-				log.Debugf("skipping status check due to empty response body from pub/sub event %v", cloudEvent[contribpubsub.IDField])
+				diag.LoggerWithTraceContext(ctx, log).Debugf("skipping status check due to empty response body from pub/sub event %v", cloudEvent[contribpubsub.IDField])
 			} else {
-				log.Debugf("skipping status check due to error parsing result from pub/sub event %v: %s", cloudEvent[contribpubsub.IDField], err)
+				diag.LoggerWithTraceContext(ctx, log).Debugf("skipping status check due to error parsing result from pub/sub event %v: %s", cloudEvent[contribpubsub.IDField], err)
 			}
 			diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, msg.PubSub, strings.ToLower(string(contribpubsub.Success)), "", msg.Topic, elapsed)
 			return nil
@@ -129,7 +134,7 @@ func (h *http) Deliver(ctx context.Context, msg *pubsub.SubscribedMessage) error
 			return fmt.Errorf("RETRY status returned from app while processing pub/sub event %v: %w", cloudEvent[contribpubsub.IDField], rterrors.NewRetriable(nil))
 		case contribpubsub.Drop:
 			diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, msg.PubSub, strings.ToLower(string(contribpubsub.Drop)), strings.ToLower(string(contribpubsub.Success)), msg.Topic, elapsed)
-			log.Warnf("DROP status returned from app while processing pub/sub event %v", cloudEvent[contribpubsub.IDField])
+			diag.LoggerWithTraceContext(ctx, log).Warnf("DROP status returned from app while processing pub/sub event %v", cloudEvent[contribpubsub.IDField])
 			return pubsub.ErrMessageDropped
 		}
 		// Consider unknown status field as error and retry
@@ -142,14 +147,14 @@ func (h *http) Deliver(ctx context.Context, msg *pubsub.SubscribedMessage) error
 		// These are errors that are not retriable, for now it is just 404 but more status codes can be added.
 		// When adding/removing an error here, check if that is also applicable to GRPC since there is a mapping between HTTP and GRPC errors:
 		// https://cloud.google.com/apis/design/errors#handling_errors
-		log.Errorf("non-retriable error returned from app while processing pub/sub event %v: %s. status code returned: %v", cloudEvent[contribpubsub.IDField], body, statusCode)
+		diag.LoggerWithTraceContext(ctx, log).Errorf("non-retriable error returned from app while processing pub/sub event %v: %s. status code returned: %v", cloudEvent[contribpubsub.IDField], body, statusCode)
 		diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, msg.PubSub, strings.ToLower(string(contribpubsub.Drop)), "", msg.Topic, elapsed)
 		return nil
 	}
 
 	// Every error from now on is a retriable error.
 	errMsg := fmt.Sprintf("retriable error returned from app while processing pub/sub event %v, topic: %v, body: %s. status code returned: %v", cloudEvent[contribpubsub.IDField], cloudEvent[contribpubsub.TopicField], body, statusCode)
-	log.Warnf(errMsg)
+	diag.LoggerWithTraceContext(ctx, log).Warnf(errMsg)
 	diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, msg.PubSub, strings.ToLower(string(contribpubsub.Retry)), "", msg.Topic, elapsed)
 	// return error status code for resiliency to decide on retry
 	// TODO: Update types to uint32
@@ -175,7 +180,7 @@ func (h *http) DeliverBulk(ctx context.Context, req *postman.DeliverBulkRequest)
 	da, marshalErr := json.Marshal(&bsrr.Envelope)
 
 	if marshalErr != nil {
-		log.Errorf("Error serializing bulk cloud event in pubsub %s and topic %s: %s", psm.Pubsub, psm.Topic, marshalErr)
+		diag.LoggerWithTraceContext(ctx, log).Errorf("Error serializing bulk cloud event in pubsub %s and topic %s: %s", psm.Pubsub, psm.Topic, marshalErr)
 		if req.DeadLetterTopic != "" {
 			entries := make([]contribpubsub.BulkMessageEntry, len(psm.PubSubMessages))
 			for i, pubsubMsg := range psm.PubSubMessages {
@@ -202,8 +207,6 @@ func (h *http) DeliverBulk(ctx context.Context, req *postman.DeliverBulkRequest)
 		return marshalErr
 	}
 
-	spans := make([]trace.Span, len(rawMsgEntries))
-
 	iReq := invokev1.NewInvokeMethodRequest(psm.Path).
 		WithHTTPExtension(nethttp.MethodPost, "").
 		WithRawDataBytes(da).
@@ -211,28 +214,15 @@ func (h *http) DeliverBulk(ctx context.Context, req *postman.DeliverBulkRequest)
 		WithCustomHTTPMetadata(psm.Metadata)
 	defer iReq.Close()
 
-	n := 0
-	for _, pubsubMsg := range psm.PubSubMessages {
-		cloudEvent := pubsubMsg.CloudEvent
-		iTraceID := cloudEvent[contribpubsub.TraceParentField]
-		if iTraceID == nil {
-			iTraceID = cloudEvent[contribpubsub.TraceIDField]
-		}
-		if iTraceID != nil {
-			traceID := iTraceID.(string)
-			sc, _ := diag.SpanContextFromW3CString(traceID)
-			var span trace.Span
-			ctx, span = diag.StartInternalCallbackSpan(ctx, "pubsub/"+psm.Topic, sc, h.tracingSpec)
-			if span != nil {
-				spans[n] = span
-				n++
-			}
-		}
+	var span trace.Span
+	if links := todo.BulkSpanLinks(psm.PubSubMessages); len(links) > 0 {
+		ctx, span = diag.StartBulkDeliverySpan(ctx, "pubsub/"+psm.Topic, links, h.tracingSpec)
+	}
+	if span != nil {
+		defer span.End()
 	}
-	spans = spans[:n]
-	defer todo.EndSpans(spans)
 	start := time.Now()
-	resp, err := h.channels.AppChannel().InvokeMethod(ctx, iReq, "")
+	resp, err := h.channels.AppChannelFor(config.AppCallbackPubSub).InvokeMethod(ctx, iReq, "")
 	elapsed := diag.ElapsedSince(start)
 	if err != nil {
 		bscData.BulkSubDiag.StatusWiseDiag[string(contribpubsub.Retry)] += int64(len(rawMsgEntries))
@@ -244,7 +234,7 @@ func (h *http) DeliverBulk(ctx context.Context, req *postman.DeliverBulkRequest)
 
 	statusCode := int(resp.Status().GetCode())
 
-	for _, span := range spans {
+	if span != nil {
 		m := diag.ConstructSubscriptionSpanAttributes(psm.Topic)
 		diag.AddAttributesToSpan(span, m)
 		diag.UpdateSpanStatusFromHTTPStatus(span, statusCode)
@@ -281,7 +271,7 @@ func (h *http) DeliverBulk(ctx context.Context, req *postman.DeliverBulkRequest)
 				case contribpubsub.Drop:
 					bscData.BulkSubDiag.StatusWiseDiag[string(contribpubsub.Drop)]++
 					entryRespReceived[response.EntryId] = true
-					log.Warnf("DROP status returned from app while processing pub/sub event %v", response.EntryId)
+					diag.LoggerWithTraceContext(ctx, log).Warnf("DROP status returned from app while processing pub/sub event %v", response.EntryId)
 					todo.AddBulkResponseEntry(&bsrr.Entries, response.EntryId, nil)
 					if req.DeadLetterTopic != "" {
 						msg := psm.PubSubMessages[(*bscData.EntryIdIndexMap)[response.EntryId]]
@@ -301,7 +291,7 @@ func (h *http) DeliverBulk(ctx context.Context, req *postman.DeliverBulkRequest)
 					hasAnyError = true
 				}
 			} else {
-				log.Warnf("Invalid entry id received from app while processing pub/sub event %v", response.EntryId)
+				diag.LoggerWithTraceContext(ctx, log).Warnf("Invalid entry id received from app while processing pub/sub event %v", response.EntryId)
 				continue
 			}
 		}
@@ -327,7 +317,7 @@ func (h *http) DeliverBulk(ctx context.Context, req *postman.DeliverBulkRequest)
 		// These are errors that are not retriable, for now it is just 404 but more status codes can be added.
 		// When adding/removing an error here, check if that is also applicable to GRPC since there is a mapping between HTTP and GRPC errors:
 		// https://cloud.google.com/apis/design/errors#handling_errors
-		log.Errorf("Non-retriable error returned from app while processing bulk pub/sub event. status code returned: %v", statusCode)
+		diag.LoggerWithTraceContext(ctx, log).Errorf("Non-retriable error returned from app while processing bulk pub/sub event. status code returned: %v", statusCode)
 		bscData.BulkSubDiag.StatusWiseDiag[string(contribpubsub.Drop)] += int64(len(rawMsgEntries))
 		bscData.BulkSubDiag.Elapsed = elapsed
 		todo.PopulateBulkSubscribeResponsesWithError(psm, &bsrr.Entries, nil)
@@ -337,7 +327,7 @@ func (h *http) DeliverBulk(ctx context.Context, req *postman.DeliverBulkRequest)
 	// Every error from now on is a retriable error.
 	retriableErrorStr := fmt.Sprintf("Retriable error returned from app while processing bulk pub/sub event, topic: %v. status code returned: %v", psm.Topic, statusCode)
 	retriableError := errors.New(retriableErrorStr)
-	log.Warn(retriableErrorStr)
+	diag.LoggerWithTraceContext(ctx, log).Warn(retriableErrorStr)
 	bscData.BulkSubDiag.StatusWiseDiag[string(contribpubsub.Retry)] += int64(len(rawMsgEntries))
 	bscData.BulkSubDiag.Elapsed = elapsed
 	todo.PopulateBulkSubscribeResponsesWithError(psm, &bsrr.Entries, retriableError)
@@ -369,8 +359,35 @@ func (h *http) sendBulkToDeadLetter(ctx context.Context,
 	if bscData.BulkSubDiag.RetryReported {
 		bscData.BulkSubDiag.StatusWiseDiag[string(contribpubsub.Retry)] -= int64(len(data))
 	}
+	stamped := make([]contribpubsub.BulkMessageEntry, 0, len(data))
+	for _, entry := range data {
+		dl := pubsub.DeadLetter{
+			Topic:           msg.Topic,
+			DeadLetterTopic: deadLetterTopic,
+			Reason:          pubsub.DeadLetterReasonInvalid,
+		}
+		if !sendAllEntries {
+			dl.Reason = pubsub.DeadLetterReasonError
+			dl.Err = (*bscData.BulkResponses)[(*bscData.EntryIdIndexMap)[entry.EntryId]].Error
+		}
+		event, md, err := dl.Stamp(entry.Event, entry.Metadata, time.Now())
+		if errors.Is(err, pubsub.ErrDeadLetterLoop) {
+			diag.LoggerWithTraceContext(ctx, log).Errorf("dropping message from topic %s: %s", msg.Topic, err)
+			continue
+		}
+		if err != nil {
+			diag.LoggerWithTraceContext(ctx, log).Errorf("error stamping message for dead letter, origin topic: %s dead letter topic %s err: %s", msg.Topic, deadLetterTopic, err)
+			return err
+		}
+		entry.Event = event
+		entry.Metadata = md
+		stamped = append(stamped, entry)
+	}
+	if len(stamped) == 0 {
+		return nil
+	}
 	req := &contribpubsub.BulkPublishRequest{
-		Entries:    data,
+		Entries:    stamped,
 		PubsubName: bscData.PsName,
 		Topic:      deadLetterTopic,
 		Metadata:   msg.Metadata,
@@ -378,23 +395,40 @@ func (h *http) sendBulkToDeadLetter(ctx context.Context,
 
 	_, err := h.adapter.BulkPublish(ctx, req)
 	if err != nil {
-		log.Errorf("error sending message to dead letter, origin topic: %s dead letter topic %s err: %w", msg.Topic, deadLetterTopic, err)
+		diag.LoggerWithTraceContext(ctx, log).Errorf("error sending message to dead letter, origin topic: %s dead letter topic %s err: %w", msg.Topic, deadLetterTopic, err)
 	}
 
 	return err
 }
 
+// sendToDeadLetter sends a message dropped by the app to the dead letter
+// topic, stamped with the dead letter information.
 func (h *http) sendToDeadLetter(ctx context.Context, name string, msg *contribpubsub.NewMessage, deadLetterTopic string) error {
+	dl := pubsub.DeadLetter{
+		Topic:           msg.Topic,
+		DeadLetterTopic: deadLetterTopic,
+		Reason:          pubsub.DeadLetterReasonDropped,
+	}
+	data, md, err := dl.Stamp(msg.Data, msg.Metadata, time.Now())
+	if errors.Is(err, pubsub.ErrDeadLetterLoop) {
+		diag.LoggerWithTraceContext(ctx, log).Errorf("dropping message from topic %s: %s", msg.Topic, err)
+		return nil
+	}
+	if err != nil {
+		diag.LoggerWithTraceContext(ctx, log).Errorf("error stamping message for dead letter, origin topic: %s dead letter topic %s err: %s", msg.Topic, deadLetterTopic, err)
+		return err
+	}
+
 	req := &contribpubsub.PublishRequest{
-		Data:        msg.Data,
+		Data:        data,
 		PubsubName:  name,
 		Topic:       deadLetterTopic,
-		Metadata:    msg.Metadata,
+		Metadata:    md,
 		ContentType: msg.ContentType,
 	}
 
 	if err := h.adapter.Publish(ctx, req); err != nil {
-		log.Errorf("error sending message to dead letter, origin topic: %s dead letter topic %s err: %w", msg.Topic, deadLetterTopic, err)
+		diag.LoggerWithTraceContext(ctx, log).Errorf("error sending message to dead letter, origin topic: %s dead letter topic %s err: %w", msg.Topic, deadLetterTopic, err)
 		return err
 	}
 
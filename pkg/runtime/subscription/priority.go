@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+)
+
+// Scheduler shares the delivery slots of the app, bounded by the app max
+// concurrency, between all subscriptions. When every slot is taken, a freed
+// slot is handed to the waiting delivery of the highest priority
+// subscription, in arrival order for deliveries of the same priority.
+type Scheduler struct {
+	lock    sync.Mutex
+	free    int
+	seq     uint64
+	waiters waiters
+}
+
+// NewScheduler returns a Scheduler for the given app max concurrency, or nil
+// if the concurrency of the app is not limited.
+func NewScheduler(maxConcurrency int) *Scheduler {
+	if maxConcurrency <= 0 {
+		return nil
+	}
+	return &Scheduler{
+		free: maxConcurrency,
+	}
+}
+
+// acquire waits for a delivery slot for a message of a subscription with the
+// given priority, and returns the function to release it.
+func (s *Scheduler) acquire(ctx context.Context, priority int32) (func(), error) {
+	s.lock.Lock()
+	if s.free > 0 && len(s.waiters) == 0 {
+		s.free--
+		s.lock.Unlock()
+		return s.releaseFn(), nil
+	}
+
+	w := &waiter{
+		priority: priority,
+		seq:      s.seq,
+		ready:    make(chan struct{}),
+	}
+	s.seq++
+	heap.Push(&s.waiters, w)
+	s.lock.Unlock()
+
+	select {
+	case <-w.ready:
+		return s.releaseFn(), nil
+	case <-ctx.Done():
+		s.lock.Lock()
+		if w.index >= 0 {
+			heap.Remove(&s.waiters, w.index)
+			s.lock.Unlock()
+		} else {
+			// The slot was handed over while the context was cancelled.
+			s.lock.Unlock()
+			s.release()
+		}
+		return nil, ctx.Err()
+	}
+}
+
+func (s *Scheduler) releaseFn() func() {
+	var once sync.Once
+	return func() {
+		once.Do(s.release)
+	}
+}
+
+// release hands the slot over to the next waiting delivery, if any.
+func (s *Scheduler) release() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if len(s.waiters) == 0 {
+		s.free++
+		return
+	}
+	w := heap.Pop(&s.waiters).(*waiter)
+	close(w.ready)
+}
+
+type waiter struct {
+	priority int32
+	seq      uint64
+	index    int
+	ready    chan struct{}
+}
+
+// waiters is a heap of the deliveries waiting for a slot, ordered by
+// descending priority and then by arrival.
+type waiters []*waiter
+
+func (w waiters) Len() int { return len(w) }
+
+func (w waiters) Less(i, j int) bool {
+	if w[i].priority != w[j].priority {
+		return w[i].priority > w[j].priority
+	}
+	return w[i].seq < w[j].seq
+}
+
+func (w waiters) Swap(i, j int) {
+	w[i], w[j] = w[j], w[i]
+	w[i].index = i
+	w[j].index = j
+}
+
+func (w *waiters) Push(x any) {
+	item := x.(*waiter)
+	item.index = len(*w)
+	*w = append(*w, item)
+}
+
+func (w *waiters) Pop() any {
+	old := *w
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	item.index = -1
+	*w = old[:n-1]
+	return item
+}
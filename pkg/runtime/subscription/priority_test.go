@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduler(t *testing.T) {
+	t.Run("no limit", func(t *testing.T) {
+		assert.Nil(t, NewScheduler(0))
+	})
+
+	t.Run("slots are handed to the highest priority first", func(t *testing.T) {
+		s := NewScheduler(1)
+		require.NotNil(t, s)
+
+		release, err := s.acquire(t.Context(), 0)
+		require.NoError(t, err)
+
+		order := make(chan int32, 4)
+		waitFor := func(priority int32) {
+			go func() {
+				r, err := s.acquire(t.Context(), priority)
+				assert.NoError(t, err)
+				order <- priority
+				r()
+			}()
+			// Wait for the delivery to be queued, so arrival order is known.
+			assert.Eventually(t, func() bool {
+				s.lock.Lock()
+				defer s.lock.Unlock()
+				for _, w := range s.waiters {
+					if w.priority == priority {
+						return true
+					}
+				}
+				return false
+			}, time.Second, time.Millisecond)
+		}
+		waitFor(-1)
+		waitFor(5)
+		waitFor(1)
+		waitFor(3)
+
+		release()
+		var got []int32
+		for range 4 {
+			select {
+			case p := <-order:
+				got = append(got, p)
+			case <-time.After(5 * time.Second):
+				require.Fail(t, "delivery not scheduled")
+			}
+		}
+		assert.Equal(t, []int32{5, 3, 1, -1}, got)
+		assert.Eventually(t, func() bool {
+			s.lock.Lock()
+			defer s.lock.Unlock()
+			return s.free == 1
+		}, time.Second, time.Millisecond)
+	})
+
+	t.Run("cancelled waiters give up their place", func(t *testing.T) {
+		s := NewScheduler(1)
+
+		release, err := s.acquire(t.Context(), 0)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+		defer cancel()
+		_, err = s.acquire(ctx, 10)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Empty(t, s.waiters)
+
+		release()
+		// Releasing twice doesn't free more slots than the limit.
+		release()
+		assert.Equal(t, 1, s.free)
+	})
+}
@@ -26,12 +26,17 @@ import (
 	contribContenttype "github.com/dapr/components-contrib/contenttype"
 	"github.com/dapr/components-contrib/metadata"
 	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/components-contrib/state"
 	"github.com/dapr/dapr/pkg/api/grpc/manager"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/expr"
+	"github.com/dapr/dapr/pkg/messages"
 	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/drain"
 	rterrors "github.com/dapr/dapr/pkg/runtime/errors"
 	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/schema"
 	"github.com/dapr/dapr/pkg/runtime/subscription/postman"
 	"github.com/dapr/kit/logger"
 )
@@ -50,6 +55,9 @@ type Options struct {
 	AdapterStreamer rtpubsub.AdapterStreamer
 	ConnectionID    rtpubsub.ConnectionID
 	Postman         postman.Interface
+	GetStateStoreFn func(string) (state.Store, bool)
+	Scheduler       *Scheduler
+	Drainer         *drain.Drainer
 }
 
 type Subscription struct {
@@ -63,6 +71,13 @@ type Subscription struct {
 	tracingSpec  *config.TracingSpec
 	grpc         *manager.Manager
 	connectionID rtpubsub.ConnectionID
+	ttlPolicy    rtpubsub.TTLPolicy
+	orderer      *orderer
+	limiter      *limiter
+	scheduler    *Scheduler
+	pattern      *patternSubscription
+	inbox        *rtpubsub.Inbox
+	drainer      *drain.Drainer
 
 	adapterStreamer rtpubsub.AdapterStreamer
 	adapter         rtpubsub.Adapter
@@ -81,14 +96,52 @@ const (
 	BinaryCloudEventHeaderPrefix = "ce_"
 	DefaultCloudEventContentType = "application/json"
 	ContentTypeMetadataKey       = "content-type"
+
+	consumerGroupMetadataKey = "consumerGroup"
 )
 
 func New(opts Options) (*Subscription, error) {
-	allowed := rtpubsub.IsOperationAllowed(opts.Topic, opts.PubSub, opts.PubSub.ScopedSubscriptions)
-	if !allowed {
+	// Topic patterns are expanded by the runtime when the pubsub cannot
+	// subscribe to them, in which case every matching topic must be allowed.
+	expand := rtpubsub.IsTopicPattern(opts.Topic) &&
+		!contribpubsub.FeatureSubscribeWildcards.IsPresent(opts.PubSub.Component.Features())
+	if !expand && !rtpubsub.IsOperationAllowed(opts.Topic, opts.PubSub, opts.PubSub.ScopedSubscriptions) {
 		return nil, fmt.Errorf("subscription to topic '%s' on pubsub '%s' is not allowed", opts.Topic, opts.PubSubName)
 	}
 
+	ttlPolicy, err := rtpubsub.TTLPolicyFromMetadata(opts.Route.Metadata)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subscription to topic '%s' on pubsub '%s': %w", opts.Topic, opts.PubSubName, err)
+	}
+
+	var ord *orderer
+	switch opts.Route.Ordering {
+	case rtpubsub.OrderingNone:
+	case rtpubsub.OrderingPartitionKey:
+		if opts.Route.BulkSubscribe != nil && opts.Route.BulkSubscribe.Enabled {
+			return nil, fmt.Errorf("invalid subscription to topic '%s' on pubsub '%s': ordering is not supported with bulk subscribe", opts.Topic, opts.PubSubName)
+		}
+		ord = newOrderer()
+	default:
+		return nil, fmt.Errorf("invalid subscription to topic '%s' on pubsub '%s': unknown ordering '%s'", opts.Topic, opts.PubSubName, opts.Route.Ordering)
+	}
+
+	if opts.Route.MaxConcurrentHandlers < 0 || opts.Route.Prefetch < 0 {
+		return nil, fmt.Errorf("invalid subscription to topic '%s' on pubsub '%s': maxConcurrentHandlers and prefetch must not be negative", opts.Topic, opts.PubSubName)
+	}
+
+	if expand {
+		return newPatternSubscription(opts)
+	}
+
+	inbox, err := rtpubsub.InboxFromMetadata(opts.Route.Metadata, opts.AppID, opts.PubSubName, opts.Topic, opts.GetStateStoreFn)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subscription to topic '%s' on pubsub '%s': %w", opts.Topic, opts.PubSubName, err)
+	}
+	if inbox != nil && opts.Route.BulkSubscribe != nil && opts.Route.BulkSubscribe.Enabled {
+		return nil, fmt.Errorf("invalid subscription to topic '%s' on pubsub '%s': inbox is not supported with bulk subscribe", opts.Topic, opts.PubSubName)
+	}
+
 	ctx, cancel := context.WithCancelCause(context.Background())
 
 	s := &Subscription{
@@ -106,6 +159,12 @@ func New(opts Options) (*Subscription, error) {
 		connectionID:    opts.ConnectionID,
 		adapterStreamer: opts.AdapterStreamer,
 		postman:         opts.Postman,
+		ttlPolicy:       ttlPolicy,
+		orderer:         ord,
+		limiter:         newLimiter(opts.PubSubName, opts.Topic, opts.Route.MaxConcurrentHandlers, opts.Route.Prefetch),
+		inbox:           inbox,
+		scheduler:       opts.Scheduler,
+		drainer:         opts.Drainer,
 	}
 
 	name := s.pubsubName
@@ -116,7 +175,7 @@ func New(opts Options) (*Subscription, error) {
 	namespaced := s.pubsub.NamespaceScoped
 
 	if route.BulkSubscribe != nil && route.BulkSubscribe.Enabled {
-		err := s.bulkSubscribeTopic(ctx, policyDef)
+		err = s.bulkSubscribeTopic(ctx, policyDef)
 		if err != nil {
 			cancel(nil)
 			return nil, fmt.Errorf("failed to bulk subscribe to topic %s: %w", s.topic, err)
@@ -129,7 +188,7 @@ func New(opts Options) (*Subscription, error) {
 		subscribeTopic = s.namespace + s.topic
 	}
 
-	err := s.pubsub.Component.Subscribe(ctx, contribpubsub.SubscribeRequest{
+	err = s.pubsub.Component.Subscribe(ctx, contribpubsub.SubscribeRequest{
 		Topic:    subscribeTopic,
 		Metadata: routeMetadata,
 	}, func(ctx context.Context, msg *contribpubsub.NewMessage) error {
@@ -144,6 +203,20 @@ func New(opts Options) (*Subscription, error) {
 			return errors.New("subscription is closed")
 		}
 
+		untrack, ok := s.drainer.Track()
+		if !ok {
+			return messages.ErrDraining
+		}
+		defer untrack()
+
+		if s.limiter != nil {
+			leave, lErr := s.limiter.enqueue(ctx)
+			if lErr != nil {
+				return lErr
+			}
+			defer leave()
+		}
+
 		if msg.Metadata == nil {
 			msg.Metadata = make(map[string]string, 1)
 		}
@@ -160,6 +233,8 @@ func New(opts Options) (*Subscription, error) {
 
 		msg.Metadata[rtpubsub.MetadataKeyPubSub] = name
 
+		ctx = diag.DefaultComponentMonitoring.PubsubIngressContext(ctx, routeMetadata[consumerGroupMetadataKey], msg.Metadata)
+
 		msgTopic := msg.Topic
 		if s.pubsub.NamespaceScoped {
 			msgTopic = strings.Replace(msgTopic, s.namespace, "", 1)
@@ -169,7 +244,7 @@ func New(opts Options) (*Subscription, error) {
 		if err != nil {
 			log.Errorf("error deserializing pubsub metadata: %s", err)
 			if route.DeadLetterTopic != "" {
-				if dlqErr := s.sendToDeadLetter(ctx, name, msg, route.DeadLetterTopic); dlqErr == nil {
+				if dlqErr := s.sendToDeadLetter(ctx, name, msg, route.DeadLetterTopic, rtpubsub.DeadLetter{Reason: rtpubsub.DeadLetterReasonInvalid, Err: err}); dlqErr == nil {
 					// dlq has been configured and message is successfully sent to dlq.
 					diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Drop)), "", msgTopic, 0)
 					return nil
@@ -195,11 +270,14 @@ func New(opts Options) (*Subscription, error) {
 			if tracestate, ok := msg.Metadata[contribpubsub.TraceStateField]; ok {
 				cloudEvent[contribpubsub.TraceStateField] = tracestate
 			}
+			if baggage, ok := msg.Metadata[rtpubsub.BaggageField]; ok {
+				cloudEvent[rtpubsub.BaggageField] = baggage
+			}
 			data, err = json.Marshal(cloudEvent)
 			if err != nil {
 				log.Errorf("error serializing cloud event in pubsub %s and topic %s: %s", name, msgTopic, err)
 				if route.DeadLetterTopic != "" {
-					if dlqErr := s.sendToDeadLetter(ctx, name, msg, route.DeadLetterTopic); dlqErr == nil {
+					if dlqErr := s.sendToDeadLetter(ctx, name, msg, route.DeadLetterTopic, rtpubsub.DeadLetter{Reason: rtpubsub.DeadLetterReasonInvalid, Err: err}); dlqErr == nil {
 						// dlq has been configured and message is successfully sent to dlq.
 						diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Drop)), "", msgTopic, 0)
 						return nil
@@ -225,7 +303,7 @@ func New(opts Options) (*Subscription, error) {
 			if err != nil {
 				log.Errorf("error deserializing cloud event in pubsub %s and topic %s: %s", name, msgTopic, err)
 				if route.DeadLetterTopic != "" {
-					if dlqErr := s.sendToDeadLetter(ctx, name, msg, route.DeadLetterTopic); dlqErr == nil {
+					if dlqErr := s.sendToDeadLetter(ctx, name, msg, route.DeadLetterTopic, rtpubsub.DeadLetter{Reason: rtpubsub.DeadLetterReasonInvalid, Err: err}); dlqErr == nil {
 						// dlq has been configured and message is successfully sent to dlq.
 						diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Drop)), "", msgTopic, 0)
 						return nil
@@ -253,15 +331,46 @@ func New(opts Options) (*Subscription, error) {
 					cloudEvent[contribpubsub.TraceStateField] = tracestate
 				}
 			}
+			if _, ok := cloudEvent[rtpubsub.BaggageField]; !ok {
+				if baggage, ok := msg.Metadata[rtpubsub.BaggageField]; ok {
+					cloudEvent[rtpubsub.BaggageField] = baggage
+				}
+			}
 		}
 
-		if contribpubsub.HasExpired(cloudEvent) {
-			log.Warnf("dropping expired pub/sub event %v as of %v", cloudEvent[contribpubsub.IDField], cloudEvent[contribpubsub.ExpirationField])
+		if s.pubsub.Schemas != nil {
+			payload := msg.Data
+			if !rawPayload && !contribContenttype.IsBinaryContentType(contentType) {
+				payload = rtpubsub.CloudEventPayload(cloudEvent)
+			}
+			if err = s.pubsub.Schemas.ValidateSubscribe(ctx, msgTopic, payload); err != nil {
+				if !errors.Is(err, schema.ErrInvalid) {
+					log.Errorf("error validating event %v in pubsub %s and topic %s against the topic schema: %s", cloudEvent[contribpubsub.IDField], name, msgTopic, err)
+					diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Retry)), "", msgTopic, 0)
+					return err
+				}
+				log.Warnf("dropping pub/sub event %v in pubsub %s and topic %s: %s", cloudEvent[contribpubsub.IDField], name, msgTopic, err)
+				if route.DeadLetterTopic != "" {
+					if dlqErr := s.sendToDeadLetter(ctx, name, msg, route.DeadLetterTopic, rtpubsub.DeadLetter{Reason: rtpubsub.DeadLetterReasonInvalid, Err: err}); dlqErr != nil {
+						diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Retry)), "", msgTopic, 0)
+						return dlqErr
+					}
+				}
+				diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Drop)), "", msgTopic, 0)
+				return nil
+			}
+		}
+
+		if s.ttlPolicy.HasExpired(cloudEvent, time.Now()) {
+			log.Warnf("dropping expired pub/sub event %v in pubsub %s and topic %s", cloudEvent[contribpubsub.IDField], name, msgTopic)
 			diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Drop)), "", msgTopic, 0)
+			s.handleExpired(ctx, name, msgTopic, msg)
+			return nil
+		}
 
-			if route.DeadLetterTopic != "" {
-				_ = s.sendToDeadLetter(ctx, name, msg, route.DeadLetterTopic)
-			}
+		if !matchFilter(route.Filter, cloudEvent) {
+			log.Debugf("event %v in pubsub %s and topic %s does not match the subscription filter; dropping", cloudEvent[contribpubsub.IDField], name, msgTopic)
+			diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Drop)), strings.ToLower(string(contribpubsub.Success)), msgTopic, 0)
 			return nil
 		}
 
@@ -269,7 +378,7 @@ func New(opts Options) (*Subscription, error) {
 		if err != nil {
 			log.Errorf("error finding matching route for event %v in pubsub %s and topic %s: %s", cloudEvent[contribpubsub.IDField], name, msgTopic, err)
 			if route.DeadLetterTopic != "" {
-				if dlqErr := s.sendToDeadLetter(ctx, name, msg, route.DeadLetterTopic); dlqErr == nil {
+				if dlqErr := s.sendToDeadLetter(ctx, name, msg, route.DeadLetterTopic, rtpubsub.DeadLetter{Reason: rtpubsub.DeadLetterReasonInvalid, Err: err}); dlqErr == nil {
 					// dlq has been configured and message is successfully sent to dlq.
 					diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Drop)), "", msgTopic, 0)
 					return nil
@@ -284,11 +393,61 @@ func New(opts Options) (*Subscription, error) {
 			log.Debugf("no matching route for event %v in pubsub %s and topic %s; skipping", cloudEvent[contribpubsub.IDField], name, msgTopic)
 			diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Drop)), strings.ToLower(string(contribpubsub.Success)), msgTopic, 0)
 			if route.DeadLetterTopic != "" {
-				_ = s.sendToDeadLetter(ctx, name, msg, route.DeadLetterTopic)
+				_ = s.sendToDeadLetter(ctx, name, msg, route.DeadLetterTopic, rtpubsub.DeadLetter{Reason: rtpubsub.DeadLetterReasonUnmatched})
 			}
 			return nil
 		}
 
+		// Skip the messages redelivered by the broker once processed.
+		var eventID string
+		if s.inbox != nil {
+			eventID, _ = cloudEvent[contribpubsub.IDField].(string)
+		}
+		if eventID != "" {
+			processed, iErr := s.inbox.Processed(ctx, eventID)
+			if iErr != nil {
+				log.Errorf("error checking the inbox for event %s in pubsub %s and topic %s: %s", eventID, name, msgTopic, iErr)
+				diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Retry)), "", msgTopic, 0)
+				return iErr
+			}
+			if processed {
+				log.Debugf("event %s in pubsub %s and topic %s has already been processed; dropping", eventID, name, msgTopic)
+				diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Drop)), strings.ToLower(string(contribpubsub.Success)), msgTopic, 0)
+				return nil
+			}
+		}
+
+		if s.orderer != nil {
+			// Wait for the delivery of the previous messages with the same partition key.
+			release, oErr := s.orderer.acquire(ctx, partitionKey(msg, cloudEvent))
+			if oErr != nil {
+				diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Retry)), "", msgTopic, 0)
+				return oErr
+			}
+			defer release()
+		}
+
+		if s.limiter != nil {
+			// Wait for a free handler of the subscription.
+			release, lErr := s.limiter.acquire(ctx)
+			if lErr != nil {
+				diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Retry)), "", msgTopic, 0)
+				return lErr
+			}
+			defer release()
+		}
+
+		if s.scheduler != nil {
+			// Wait for a free delivery slot of the app, which is handed to the
+			// highest priority subscription first.
+			release, sErr := s.scheduler.acquire(ctx, route.Priority)
+			if sErr != nil {
+				diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Retry)), "", msgTopic, 0)
+				return sErr
+			}
+			defer release()
+		}
+
 		sm := &rtpubsub.SubscribedMessage{
 			CloudEvent:   cloudEvent,
 			Data:         data,
@@ -298,8 +457,10 @@ func New(opts Options) (*Subscription, error) {
 			PubSub:       name,
 			SubscriberID: s.connectionID,
 		}
-		policyRunner := resiliency.NewRunner[any](context.Background(), policyDef)
+		policyRunner := resiliency.NewRunner[any](diag.DefaultComponentMonitoring.PubsubIngressContext(context.Background(), routeMetadata[consumerGroupMetadataKey], msg.Metadata), policyDef)
+		var attempts atomic.Int32
 		_, err = policyRunner(func(ctx context.Context) (any, error) {
+			attempts.Add(1)
 			pErr := s.postman.Deliver(ctx, sm)
 
 			var rErr *rterrors.RetriableError
@@ -308,7 +469,7 @@ func New(opts Options) (*Subscription, error) {
 			} else if errors.Is(pErr, rtpubsub.ErrMessageDropped) {
 				// send dropped message to dead letter queue if configured
 				if route.DeadLetterTopic != "" {
-					derr := s.sendToDeadLetter(ctx, name, msg, route.DeadLetterTopic)
+					derr := s.sendToDeadLetter(ctx, name, msg, route.DeadLetterTopic, rtpubsub.DeadLetter{Reason: rtpubsub.DeadLetterReasonDropped, Attempts: int(attempts.Load())})
 					if derr != nil {
 						log.Warnf("failed to send dropped message to dead letter queue for topic %s: %v", msgTopic, derr)
 						return nil, pErr
@@ -325,7 +486,7 @@ func New(opts Options) (*Subscription, error) {
 			// Sending msg to dead letter queue.
 			// If no DLQ is configured, return error for backwards compatibility (component-level retry).
 			if route.DeadLetterTopic != "" {
-				if dlqErr := s.sendToDeadLetter(ctx, name, msg, route.DeadLetterTopic); dlqErr == nil {
+				if dlqErr := s.sendToDeadLetter(ctx, name, msg, route.DeadLetterTopic, rtpubsub.DeadLetter{Reason: rtpubsub.DeadLetterReasonError, Attempts: int(attempts.Load()), Err: err}); dlqErr == nil {
 					// dlq has been configured and message is successfully sent to dlq.
 					diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Drop)), "", msgTopic, 0)
 					return nil
@@ -334,6 +495,12 @@ func New(opts Options) (*Subscription, error) {
 			diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Retry)), "", msgTopic, 0)
 			return err
 		}
+		if err == nil && eventID != "" {
+			if iErr := s.inbox.Record(ctx, eventID); iErr != nil {
+				// The event may be delivered again if redelivered by the broker.
+				log.Warnf("failed to record event %s in pubsub %s and topic %s in the inbox: %s", eventID, name, msgTopic, iErr)
+			}
+		}
 		return err
 	})
 	if err != nil {
@@ -345,6 +512,11 @@ func New(opts Options) (*Subscription, error) {
 }
 
 func (s *Subscription) Stop(err ...error) {
+	if s.pattern != nil {
+		s.pattern.stop(err...)
+		return
+	}
+
 	s.closed.Store(true)
 	inflight := s.inflight.Load() > 0
 
@@ -366,12 +538,27 @@ func (s *Subscription) Stop(err ...error) {
 	s.cancel(nil)
 }
 
-func (s *Subscription) sendToDeadLetter(ctx context.Context, name string, msg *contribpubsub.NewMessage, deadLetterTopic string) error {
+// sendToDeadLetter sends the message to the dead letter topic, stamped with
+// the dead letter information. A message which would loop through the dead
+// letter topics is dropped.
+func (s *Subscription) sendToDeadLetter(ctx context.Context, name string, msg *contribpubsub.NewMessage, deadLetterTopic string, dl rtpubsub.DeadLetter) error {
+	dl.Topic = s.topic
+	dl.DeadLetterTopic = deadLetterTopic
+	data, md, err := dl.Stamp(msg.Data, msg.Metadata, time.Now())
+	if errors.Is(err, rtpubsub.ErrDeadLetterLoop) {
+		log.Errorf("dropping message from topic %s: %s", s.topic, err)
+		return nil
+	}
+	if err != nil {
+		log.Errorf("error stamping message for dead letter, origin topic: %s dead letter topic %s err: %s", s.topic, deadLetterTopic, err)
+		return err
+	}
+
 	req := &contribpubsub.PublishRequest{
-		Data:        msg.Data,
+		Data:        data,
 		PubsubName:  name,
 		Topic:       deadLetterTopic,
-		Metadata:    msg.Metadata,
+		Metadata:    md,
 		ContentType: msg.ContentType,
 	}
 
@@ -383,6 +570,18 @@ func (s *Subscription) sendToDeadLetter(ctx context.Context, name string, msg *c
 	return nil
 }
 
+// handleExpired sends an expired message to the dead letter topic if the TTL
+// policy and the subscription allow it, and records the expired message metric.
+func (s *Subscription) handleExpired(ctx context.Context, name, msgTopic string, msg *contribpubsub.NewMessage) {
+	action := rtpubsub.ExpiredMessageActionDrop
+	if s.ttlPolicy.DeadLetter() && s.route.DeadLetterTopic != "" {
+		if err := s.sendToDeadLetter(ctx, name, msg, s.route.DeadLetterTopic, rtpubsub.DeadLetter{Reason: rtpubsub.DeadLetterReasonExpired}); err == nil {
+			action = rtpubsub.ExpiredMessageActionDeadLetter
+		}
+	}
+	diag.DefaultComponentMonitoring.PubsubIngressExpiredEvent(ctx, name, string(action), msgTopic)
+}
+
 // findMatchingRoute selects the path based on routing rules. If there are
 // no matching rules, the route-level path is used.
 func findMatchingRoute(rules []*rtpubsub.Rule, cloudEvent interface{}) (path string, shouldProcess bool, err error) {
@@ -403,6 +602,24 @@ func findMatchingRoute(rules []*rtpubsub.Rule, cloudEvent interface{}) (path str
 	return "", false, nil
 }
 
+// matchFilter returns true if the cloud event is selected by the filter of the
+// subscription. An event for which the filter cannot be evaluated, for example
+// because it lacks a field used by the filter, is not selected.
+func matchFilter(filter *expr.Expr, cloudEvent interface{}) bool {
+	if filter == nil {
+		return true
+	}
+	res, err := filter.Eval(map[string]interface{}{
+		"event": cloudEvent,
+	})
+	if err != nil {
+		log.Debugf("error evaluating subscription filter %s: %s", filter, err)
+		return false
+	}
+	selected, _ := res.(bool)
+	return selected
+}
+
 func matchRoutingRule(rules []*rtpubsub.Rule, data map[string]interface{}) (*rtpubsub.Rule, error) {
 	for _, rule := range rules {
 		if rule.Match == nil || len(rule.Match.String()) == 0 {
@@ -14,21 +14,29 @@ limitations under the License.
 package subscription
 
 import (
+	"context"
 	"encoding/json"
+	nethttp "net/http"
+	"net/http/httptest"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/components-contrib/state"
 	channelt "github.com/dapr/dapr/pkg/channel/testing"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/channels"
 	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	publisherfake "github.com/dapr/dapr/pkg/runtime/pubsub/publisher/fake"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/schema"
 	"github.com/dapr/dapr/pkg/runtime/subscription/postman/http"
+	daprt "github.com/dapr/dapr/pkg/testing"
 )
 
 func TestTracingOnNewPublishedMessage(t *testing.T) {
@@ -101,3 +109,413 @@ func TestTracingOnNewPublishedMessage(t *testing.T) {
 		}
 	})
 }
+
+func TestMessageTTL(t *testing.T) {
+	oldEvent := []byte(`{"id":"1","specversion":"1.0","type":"t","source":"s","time":"` +
+		time.Now().Add(-time.Minute).UTC().Format(time.RFC3339) + `","data":{"orderId":"1"}}`)
+
+	tests := map[string]struct {
+		metadata       map[string]string
+		expInvoked     int
+		expDeadLetters int
+	}{
+		"no subscription ttl delivers the event": {
+			expInvoked: 1,
+		},
+		"ttl not reached delivers the event": {
+			metadata:   map[string]string{runtimePubsub.MetadataKeyMessageTTL: "3600"},
+			expInvoked: 1,
+		},
+		"expired event is sent to the dead letter topic": {
+			metadata:       map[string]string{runtimePubsub.MetadataKeyMessageTTL: "10"},
+			expDeadLetters: 1,
+		},
+		"expired event is dropped": {
+			metadata: map[string]string{
+				runtimePubsub.MetadataKeyMessageTTL:           "10",
+				runtimePubsub.MetadataKeyExpiredMessageAction: "drop",
+			},
+		},
+		"expired event is delivered when enforcement is disabled": {
+			metadata: map[string]string{
+				runtimePubsub.MetadataKeyMessageTTL:           "10",
+				runtimePubsub.MetadataKeyExpiredMessageAction: "deliver",
+			},
+			expInvoked: 1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			comp := &mockSubscribePubSub{}
+			require.NoError(t, comp.Init(t.Context(), contribpubsub.Metadata{}))
+
+			respB, _ := json.Marshal(contribpubsub.AppResponse{Status: contribpubsub.Success})
+			fakeResp := invokev1.NewInvokeMethodResponse(200, "OK", nil).
+				WithRawDataBytes(respB).
+				WithContentType("application/json")
+			defer fakeResp.Close()
+
+			mockAppChannel := new(channelt.MockAppChannel)
+			mockAppChannel.Init()
+			mockAppChannel.On("InvokeMethod", mock.MatchedBy(matchContextInterface), mock.Anything).Return(fakeResp, nil)
+
+			var deadLetters int
+			adapter := publisherfake.New().WithPublishFn(func(_ context.Context, req *contribpubsub.PublishRequest) error {
+				assert.Equal(t, "topic1", req.Topic)
+				deadLetters++
+				return nil
+			})
+
+			ps, err := New(Options{
+				Resiliency: resiliency.New(log),
+				Postman: http.New(http.Options{
+					Channels: new(channels.Channels).WithAppChannel(mockAppChannel),
+				}),
+				PubSub:     &runtimePubsub.PubsubItem{Component: comp},
+				AppID:      TestRuntimeConfigID,
+				PubSubName: "testpubsub",
+				Topic:      "topic0",
+				Adapter:    adapter,
+				Route: runtimePubsub.Subscription{
+					Metadata:        test.metadata,
+					Rules:           []*runtimePubsub.Rule{{Path: "orders"}},
+					DeadLetterTopic: "topic1",
+				},
+			})
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				ps.Stop()
+			})
+
+			require.NoError(t, comp.Publish(t.Context(), &contribpubsub.PublishRequest{
+				PubsubName: "testpubsub",
+				Topic:      "topic0",
+				Data:       oldEvent,
+			}))
+			mockAppChannel.AssertNumberOfCalls(t, "InvokeMethod", test.expInvoked)
+			assert.Equal(t, test.expDeadLetters, deadLetters)
+		})
+	}
+
+	t.Run("invalid ttl metadata fails the subscription", func(t *testing.T) {
+		comp := &mockSubscribePubSub{}
+		require.NoError(t, comp.Init(t.Context(), contribpubsub.Metadata{}))
+
+		_, err := New(Options{
+			Resiliency: resiliency.New(log),
+			PubSub:     &runtimePubsub.PubsubItem{Component: comp},
+			PubSubName: "testpubsub",
+			Topic:      "topic0",
+			Route: runtimePubsub.Subscription{
+				Metadata: map[string]string{runtimePubsub.MetadataKeyMessageTTL: "ten"},
+			},
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestSubscriptionFilter(t *testing.T) {
+	event := []byte(`{"id":"1","specversion":"1.0","type":"order.created","source":"s","data":{"amount":42}}`)
+
+	tests := map[string]struct {
+		filter     string
+		expInvoked int
+	}{
+		"no filter delivers the event": {
+			expInvoked: 1,
+		},
+		"matching attribute delivers the event": {
+			filter:     `event.type == "order.created"`,
+			expInvoked: 1,
+		},
+		"matching payload delivers the event": {
+			filter:     `event.data.amount > 10`,
+			expInvoked: 1,
+		},
+		"non matching event is dropped": {
+			filter: `event.type == "order.deleted"`,
+		},
+		"event missing the filtered field is dropped": {
+			filter: `event.data.customer == "c1"`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			comp := &mockSubscribePubSub{}
+			require.NoError(t, comp.Init(t.Context(), contribpubsub.Metadata{}))
+
+			respB, _ := json.Marshal(contribpubsub.AppResponse{Status: contribpubsub.Success})
+			fakeResp := invokev1.NewInvokeMethodResponse(200, "OK", nil).
+				WithRawDataBytes(respB).
+				WithContentType("application/json")
+			defer fakeResp.Close()
+
+			mockAppChannel := new(channelt.MockAppChannel)
+			mockAppChannel.Init()
+			mockAppChannel.On("InvokeMethod", mock.MatchedBy(matchContextInterface), mock.Anything).Return(fakeResp, nil)
+
+			filter, err := runtimePubsub.CreateFilter(test.filter)
+			require.NoError(t, err)
+
+			var deadLetters int
+			ps, err := New(Options{
+				Resiliency: resiliency.New(log),
+				Postman: http.New(http.Options{
+					Channels: new(channels.Channels).WithAppChannel(mockAppChannel),
+				}),
+				PubSub:     &runtimePubsub.PubsubItem{Component: comp},
+				AppID:      TestRuntimeConfigID,
+				PubSubName: "testpubsub",
+				Topic:      "topic0",
+				Adapter: publisherfake.New().WithPublishFn(func(context.Context, *contribpubsub.PublishRequest) error {
+					deadLetters++
+					return nil
+				}),
+				Route: runtimePubsub.Subscription{
+					Rules:           []*runtimePubsub.Rule{{Path: "orders"}},
+					DeadLetterTopic: "topic1",
+					Filter:          filter,
+				},
+			})
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				ps.Stop()
+			})
+
+			require.NoError(t, comp.Publish(t.Context(), &contribpubsub.PublishRequest{
+				PubsubName: "testpubsub",
+				Topic:      "topic0",
+				Data:       event,
+			}))
+			mockAppChannel.AssertNumberOfCalls(t, "InvokeMethod", test.expInvoked)
+			assert.Zero(t, deadLetters)
+		})
+	}
+
+	t.Run("invalid filter is rejected", func(t *testing.T) {
+		_, err := runtimePubsub.CreateFilter(`event.type ==`)
+		require.Error(t, err)
+	})
+}
+
+// ttlStateStore is a state store supporting TTLs.
+type ttlStateStore struct {
+	*daprt.FakeStateStore
+}
+
+func (ttlStateStore) Features() []state.Feature {
+	return []state.Feature{state.FeatureTTL}
+}
+
+func TestSubscriptionInbox(t *testing.T) {
+	comp := &mockSubscribePubSub{}
+	require.NoError(t, comp.Init(t.Context(), contribpubsub.Metadata{}))
+
+	respB, _ := json.Marshal(contribpubsub.AppResponse{Status: contribpubsub.Success})
+	fakeResp := invokev1.NewInvokeMethodResponse(200, "OK", nil).
+		WithRawDataBytes(respB).
+		WithContentType("application/json")
+	defer fakeResp.Close()
+
+	mockAppChannel := new(channelt.MockAppChannel)
+	mockAppChannel.Init()
+	mockAppChannel.On("InvokeMethod", mock.MatchedBy(matchContextInterface), mock.Anything).Return(fakeResp, nil)
+
+	store := ttlStateStore{FakeStateStore: daprt.NewFakeStateStore()}
+	ps, err := New(Options{
+		Resiliency: resiliency.New(log),
+		Postman: http.New(http.Options{
+			Channels: new(channels.Channels).WithAppChannel(mockAppChannel),
+		}),
+		PubSub:     &runtimePubsub.PubsubItem{Component: comp},
+		AppID:      TestRuntimeConfigID,
+		PubSubName: "testpubsub",
+		Topic:      "topic0",
+		Route: runtimePubsub.Subscription{
+			Rules:    []*runtimePubsub.Rule{{Path: "orders"}},
+			Metadata: map[string]string{runtimePubsub.MetadataKeyInboxStateStore: "inbox"},
+		},
+		GetStateStoreFn: func(name string) (state.Store, bool) {
+			return store, name == "inbox"
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		ps.Stop()
+	})
+
+	publish := func(id string) {
+		require.NoError(t, comp.Publish(t.Context(), &contribpubsub.PublishRequest{
+			PubsubName: "testpubsub",
+			Topic:      "topic0",
+			Data:       []byte(`{"id":"` + id + `","specversion":"1.0","type":"order","source":"s","data":{}}`),
+		}))
+	}
+
+	publish("1")
+	publish("1")
+	mockAppChannel.AssertNumberOfCalls(t, "InvokeMethod", 1)
+
+	publish("2")
+	mockAppChannel.AssertNumberOfCalls(t, "InvokeMethod", 2)
+
+	t.Run("inbox is not supported with bulk subscribe", func(t *testing.T) {
+		_, err := New(Options{
+			Resiliency: resiliency.New(log),
+			PubSub:     &runtimePubsub.PubsubItem{Component: comp},
+			PubSubName: "testpubsub",
+			Topic:      "topic1",
+			Route: runtimePubsub.Subscription{
+				Metadata:      map[string]string{runtimePubsub.MetadataKeyInboxStateStore: "inbox"},
+				BulkSubscribe: &runtimePubsub.BulkSubscribe{Enabled: true},
+			},
+			GetStateStoreFn: func(name string) (state.Store, bool) {
+				return store, true
+			},
+		})
+		require.ErrorContains(t, err, "inbox is not supported with bulk subscribe")
+	})
+}
+
+func TestSubscriptionSchemaValidation(t *testing.T) {
+	registry := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"schemaType": "JSON",
+			"schema":     `{"type":"object","properties":{"amount":{"type":"integer"}},"required":["amount"]}`,
+		})
+	}))
+	t.Cleanup(registry.Close)
+
+	tests := map[string]struct {
+		event         string
+		expInvoked    int
+		expDeadLetter int
+	}{
+		"valid event is delivered": {
+			event:      `{"id":"1","specversion":"1.0","type":"order","source":"s","data":{"amount":42}}`,
+			expInvoked: 1,
+		},
+		"invalid event is dead lettered": {
+			event:         `{"id":"1","specversion":"1.0","type":"order","source":"s","data":{"amount":"42"}}`,
+			expDeadLetter: 1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			comp := &mockSubscribePubSub{}
+			require.NoError(t, comp.Init(t.Context(), contribpubsub.Metadata{}))
+
+			respB, _ := json.Marshal(contribpubsub.AppResponse{Status: contribpubsub.Success})
+			fakeResp := invokev1.NewInvokeMethodResponse(200, "OK", nil).
+				WithRawDataBytes(respB).
+				WithContentType("application/json")
+			defer fakeResp.Close()
+
+			mockAppChannel := new(channelt.MockAppChannel)
+			mockAppChannel.Init()
+			mockAppChannel.On("InvokeMethod", mock.MatchedBy(matchContextInterface), mock.Anything).Return(fakeResp, nil)
+
+			var deadLetters []*contribpubsub.PublishRequest
+			ps, err := New(Options{
+				Resiliency: resiliency.New(log),
+				Postman: http.New(http.Options{
+					Channels: new(channels.Channels).WithAppChannel(mockAppChannel),
+				}),
+				PubSub: &runtimePubsub.PubsubItem{
+					Component: comp,
+					Schemas:   schema.New(schema.Options{RegistryURL: registry.URL, Subscribe: true}),
+				},
+				AppID:      TestRuntimeConfigID,
+				PubSubName: "testpubsub",
+				Topic:      "topic0",
+				Adapter: publisherfake.New().WithPublishFn(func(_ context.Context, req *contribpubsub.PublishRequest) error {
+					deadLetters = append(deadLetters, req)
+					return nil
+				}),
+				Route: runtimePubsub.Subscription{
+					Rules:           []*runtimePubsub.Rule{{Path: "orders"}},
+					DeadLetterTopic: "topic1",
+				},
+			})
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				ps.Stop()
+			})
+
+			require.NoError(t, comp.Publish(t.Context(), &contribpubsub.PublishRequest{
+				PubsubName: "testpubsub",
+				Topic:      "topic0",
+				Data:       []byte(test.event),
+			}))
+			mockAppChannel.AssertNumberOfCalls(t, "InvokeMethod", test.expInvoked)
+			require.Len(t, deadLetters, test.expDeadLetter)
+			for _, req := range deadLetters {
+				assert.Equal(t, "topic1", req.Topic)
+				assert.Contains(t, string(req.Data), `"dlqreason":"invalid"`)
+			}
+		})
+	}
+}
+
+func TestSubscriptionSchemaValidationTopicPattern(t *testing.T) {
+	// Only the topic the message is delivered from has a schema
+	var subjects []string
+	registry := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		subjects = append(subjects, r.URL.Path)
+		if r.URL.Path != "/subjects/orders.eu-value/versions/latest" {
+			w.WriteHeader(nethttp.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"schemaType": "JSON",
+			"schema":     `{"type":"object","properties":{"amount":{"type":"integer"}},"required":["amount"]}`,
+		})
+	}))
+	t.Cleanup(registry.Close)
+
+	comp := &mockSubscribePubSub{features: []contribpubsub.Feature{contribpubsub.FeatureSubscribeWildcards}}
+	require.NoError(t, comp.Init(t.Context(), contribpubsub.Metadata{}))
+
+	respB, _ := json.Marshal(contribpubsub.AppResponse{Status: contribpubsub.Success})
+	fakeResp := invokev1.NewInvokeMethodResponse(200, "OK", nil).
+		WithRawDataBytes(respB).
+		WithContentType("application/json")
+	defer fakeResp.Close()
+
+	mockAppChannel := new(channelt.MockAppChannel)
+	mockAppChannel.Init()
+	mockAppChannel.On("InvokeMethod", mock.MatchedBy(matchContextInterface), mock.Anything).Return(fakeResp, nil)
+
+	ps, err := New(Options{
+		Resiliency: resiliency.New(log),
+		Postman: http.New(http.Options{
+			Channels: new(channels.Channels).WithAppChannel(mockAppChannel),
+		}),
+		PubSub: &runtimePubsub.PubsubItem{
+			Component: comp,
+			Schemas:   schema.New(schema.Options{RegistryURL: registry.URL, Subscribe: true}),
+		},
+		AppID:      TestRuntimeConfigID,
+		PubSubName: "testpubsub",
+		Topic:      "orders.*",
+		Route: runtimePubsub.Subscription{
+			Rules: []*runtimePubsub.Rule{{Path: "orders"}},
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		ps.Stop()
+	})
+
+	handler, ok := comp.handlers["orders.*"]
+	require.True(t, ok)
+	require.NoError(t, handler(t.Context(), &contribpubsub.NewMessage{
+		Topic: "orders.eu",
+		Data:  []byte(`{"id":"1","specversion":"1.0","type":"order","source":"s","data":{"amount":42}}`),
+	}))
+	mockAppChannel.AssertNumberOfCalls(t, "InvokeMethod", 1)
+	assert.Equal(t, []string{"/subjects/orders.eu-value/versions/latest"}, subjects)
+}
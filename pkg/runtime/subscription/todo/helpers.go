@@ -138,10 +138,26 @@ func ReportBulkSubDiagnostics(ctx context.Context, topic string, bulkSubDiag *Bu
 	}
 }
 
-func EndSpans(spans []trace.Span) {
-	for _, span := range spans {
-		if span != nil {
-			span.End()
+// BulkSpanLinks returns the trace contexts of the messages of a bulk delivery,
+// to be used as links of the delivery span.
+func BulkSpanLinks(msgs []Message) []trace.SpanContext {
+	links := make([]trace.SpanContext, 0, len(msgs))
+	for _, msg := range msgs {
+		iTraceID := msg.CloudEvent[contribpubsub.TraceParentField]
+		if iTraceID == nil {
+			iTraceID = msg.CloudEvent[contribpubsub.TraceIDField]
+		}
+		if iTraceID == nil {
+			continue
+		}
+		traceID, ok := iTraceID.(string)
+		if !ok {
+			log.Warnf("ignored non-string traceid value: %v", iTraceID)
+			continue
+		}
+		if sc, ok := diag.SpanContextFromW3CString(traceID); ok {
+			links = append(links, sc)
 		}
 	}
+	return links
 }
@@ -0,0 +1,302 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transaction
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dapr/components-contrib/state"
+)
+
+const (
+	sagaKeyPrefix = "dapr-saga"
+
+	// staleSagaAge is how long a saga must have been started before it's
+	// compensated by the recovery, so sagas still running in other replicas of
+	// the app are left alone.
+	staleSagaAge = time.Minute
+)
+
+// sagaRecord is the compensation record of a saga, saved while the saga runs in
+// the first state store of the transaction which supports listing keys, so it
+// can be found by the recovery.
+type sagaRecord struct {
+	ID        string      `json:"id"`
+	StartedAt time.Time   `json:"startedAt"`
+	Stores    []sagaStore `json:"stores"`
+}
+
+// sagaStore holds the values the keys of a state store had before the saga,
+// and the metadata of the request used to restore them.
+type sagaStore struct {
+	StoreName string            `json:"storeName"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+	Previous  []sagaValue       `json:"previous"`
+}
+
+type sagaValue struct {
+	Key    string `json:"key"`
+	Value  []byte `json:"value,omitempty"`
+	Exists bool   `json:"exists"`
+}
+
+func (c *Coordinator) saga(ctx context.Context, txID string, reqs []StoreRequest, stores []state.Store) error {
+	rec := &sagaRecord{
+		ID:        txID,
+		StartedAt: c.clock.Now(),
+		Stores:    make([]sagaStore, len(reqs)),
+	}
+	for i, req := range reqs {
+		previous, err := c.readPrevious(ctx, req.StoreName, stores[i], req.Request)
+		if err != nil {
+			return fmt.Errorf("failed to read state store %s: %w", req.StoreName, err)
+		}
+		rec.Stores[i] = sagaStore{StoreName: req.StoreName, Metadata: req.Request.Metadata, Previous: previous}
+	}
+
+	// Without a persisted record an interrupted saga could never be
+	// compensated, so the transaction isn't started.
+	logName, logStore := recordStore(reqs, stores)
+	if logStore == nil {
+		return fmt.Errorf("%w: transaction %s", ErrNoRecordStore, txID)
+	}
+	if err := c.saveRecord(ctx, logName, logStore, rec); err != nil {
+		return fmt.Errorf("failed to save compensation record in state store %s: %w", logName, err)
+	}
+
+	for i, req := range reqs {
+		err := c.run(ctx, req.StoreName, func(ctx context.Context) error {
+			return apply(ctx, stores[i], req.Request)
+		})
+		if err != nil {
+			err = fmt.Errorf("failed to execute transaction in state store %s: %w", req.StoreName, err)
+			// The failing store may have applied some of the operations too.
+			if cerr := c.compensate(ctx, rec, i+1); cerr != nil {
+				return errors.Join(err, fmt.Errorf("failed to compensate transaction %s, it will be compensated on the next start: %w", txID, cerr))
+			}
+			if derr := c.deleteRecord(ctx, logName, logStore, txID); derr != nil {
+				log.Warnf("Failed to delete compensation record of transaction %s: %s", txID, derr)
+			}
+			return err
+		}
+	}
+
+	// If the record can't be deleted the transaction is reported as failed, as
+	// it'll be compensated on the next start.
+	if err := c.deleteRecord(ctx, logName, logStore, txID); err != nil {
+		return fmt.Errorf("failed to complete transaction %s: %w", txID, err)
+	}
+	return nil
+}
+
+// Recover compensates the sagas left unfinished by a previous run, whose
+// records are found in any of the loaded state stores which support listing
+// keys.
+func (c *Coordinator) Recover(ctx context.Context) error {
+	var errs []error
+	for name, store := range c.listStateFn() {
+		ks, ok := store.(state.KeysLiker)
+		if !ok {
+			continue
+		}
+
+		ids, err := c.listRecords(ctx, ks)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to list compensation records in state store %s: %w", name, err))
+			continue
+		}
+
+		for _, id := range ids {
+			if err = c.recoverSaga(ctx, name, store, id); err != nil {
+				errs = append(errs, fmt.Errorf("failed to compensate transaction %s: %w", id, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// listRecords returns the IDs of the sagas whose records are saved in the
+// store.
+func (c *Coordinator) listRecords(ctx context.Context, store state.KeysLiker) ([]string, error) {
+	prefix := c.recordKey("")
+	var (
+		ids   []string
+		token *string
+	)
+	for {
+		res, err := store.KeysLike(ctx, &state.KeysLikeRequest{
+			Pattern:           prefix + "%",
+			ContinuationToken: token,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range res.Keys {
+			// The pattern may match more keys, as "_" matches any character.
+			if id, ok := strings.CutPrefix(key, prefix); ok && id != "" {
+				ids = append(ids, id)
+			}
+		}
+		if res.ContinuationToken == nil || *res.ContinuationToken == "" {
+			return ids, nil
+		}
+		token = res.ContinuationToken
+	}
+}
+
+func (c *Coordinator) recoverSaga(ctx context.Context, logName string, logStore state.Store, txID string) error {
+	res, err := logStore.Get(ctx, &state.GetRequest{Key: c.recordKey(txID)})
+	if err != nil {
+		return err
+	}
+	if res == nil || len(res.Data) == 0 {
+		return nil
+	}
+
+	var rec sagaRecord
+	if err = json.Unmarshal(res.Data, &rec); err != nil {
+		return fmt.Errorf("invalid compensation record: %w", err)
+	}
+	if c.clock.Since(rec.StartedAt) < staleSagaAge {
+		return nil
+	}
+
+	log.Infof("Compensating unfinished transaction %s", txID)
+	if err = c.compensate(ctx, &rec, len(rec.Stores)); err != nil {
+		return err
+	}
+	return c.deleteRecord(ctx, logName, logStore, txID)
+}
+
+// readPrevious reads the values of the keys written by the request.
+func (c *Coordinator) readPrevious(ctx context.Context, storeName string, store state.Store, req *state.TransactionalStateRequest) ([]sagaValue, error) {
+	var previous []sagaValue
+	seen := make(map[string]struct{}, len(req.Operations))
+	for _, op := range req.Operations {
+		key := op.GetKey()
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		var res *state.GetResponse
+		err := c.run(ctx, storeName, func(ctx context.Context) (err error) {
+			res, err = store.Get(ctx, &state.GetRequest{
+				Key:      key,
+				Metadata: req.Metadata,
+				Options: state.GetStateOption{
+					Consistency: state.Strong,
+				},
+			})
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		v := sagaValue{Key: key}
+		if res != nil && res.Data != nil {
+			v.Value, v.Exists = res.Data, true
+		}
+		previous = append(previous, v)
+	}
+	return previous, nil
+}
+
+// compensate restores the previous values of the keys of the first n stores
+// of the saga, in reverse order.
+func (c *Coordinator) compensate(ctx context.Context, rec *sagaRecord, n int) error {
+	var errs []error
+	for i := n - 1; i >= 0; i-- {
+		s := rec.Stores[i]
+		store, ok := c.getStateFn(s.StoreName)
+		if !ok {
+			errs = append(errs, fmt.Errorf("%w: %s", ErrStoreNotFound, s.StoreName))
+			continue
+		}
+
+		for _, prev := range s.Previous {
+			err := c.run(ctx, s.StoreName, func(ctx context.Context) error {
+				if prev.Exists {
+					return store.Set(ctx, &state.SetRequest{Key: prev.Key, Value: prev.Value, Metadata: s.Metadata})
+				}
+				return store.Delete(ctx, &state.DeleteRequest{Key: prev.Key, Metadata: s.Metadata})
+			})
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to restore key %s in state store %s: %w", prev.Key, s.StoreName, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (c *Coordinator) saveRecord(ctx context.Context, logName string, logStore state.Store, rec *sagaRecord) error {
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, logName, func(ctx context.Context) error {
+		return logStore.Set(ctx, &state.SetRequest{Key: c.recordKey(rec.ID), Value: data})
+	})
+}
+
+func (c *Coordinator) deleteRecord(ctx context.Context, logName string, logStore state.Store, txID string) error {
+	return c.run(ctx, logName, func(ctx context.Context) error {
+		return logStore.Delete(ctx, &state.DeleteRequest{Key: c.recordKey(txID)})
+	})
+}
+
+// recordKey returns the key of the record of a saga. Each saga has its own
+// record, so sagas running concurrently don't contend on the same key.
+func (c *Coordinator) recordKey(txID string) string {
+	return c.appID + "||" + sagaKeyPrefix + "||" + txID
+}
+
+// recordStore returns the first store of the transaction which supports
+// listing keys, where the compensation record is saved.
+func recordStore(reqs []StoreRequest, stores []state.Store) (string, state.Store) {
+	for i, store := range stores {
+		if _, ok := store.(state.KeysLiker); ok {
+			return reqs[i].StoreName, store
+		}
+	}
+	return "", nil
+}
+
+// apply applies the operations of the request, in a single transaction if the
+// store supports it.
+func apply(ctx context.Context, store state.Store, req *state.TransactionalStateRequest) error {
+	if isTransactional(store) {
+		return store.(state.TransactionalStore).Multi(ctx, req)
+	}
+
+	for _, op := range req.Operations {
+		var err error
+		switch o := op.(type) {
+		case state.SetRequest:
+			err = store.Set(ctx, &o)
+		case state.DeleteRequest:
+			err = store.Delete(ctx, &o)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.runtime.transaction")
+
+// ErrStoreNotFound is returned when a transaction targets a state store which
+// isn't loaded.
+var ErrStoreNotFound = errors.New("state store not found")
+
+// ErrNoRecordStore is returned when a transaction executed as a saga has no
+// state store which supports listing keys, where its compensation record can be
+// saved.
+var ErrNoRecordStore = errors.New("none of the state stores supports listing keys to save the compensation record")
+
+// TwoPhaseCommitter is implemented by state stores which can take part in a
+// two-phase commit.
+type TwoPhaseCommitter interface {
+	// Prepare validates and locks the operations of the transaction, without
+	// making them visible.
+	Prepare(ctx context.Context, txID string, req *state.TransactionalStateRequest) error
+	// Commit makes the operations of a prepared transaction visible.
+	Commit(ctx context.Context, txID string) error
+	// Abort discards the operations of a prepared transaction.
+	Abort(ctx context.Context, txID string) error
+}
+
+// StoreRequest is the part of a transaction targeting a single state store.
+type StoreRequest struct {
+	StoreName string
+	Request   *state.TransactionalStateRequest
+}
+
+type Options struct {
+	AppID       string
+	GetStateFn  func(string) (state.Store, bool)
+	ListStateFn func() map[string]state.Store
+	Resiliency  resiliency.Provider
+	Clock       clock.Clock
+}
+
+// Coordinator executes transactions spanning multiple state stores. When all
+// the stores support it the transaction is executed with a two-phase commit,
+// and otherwise as a saga: the operations are applied store by store, and the
+// previous values of the keys are restored if any store fails.
+type Coordinator struct {
+	appID       string
+	getStateFn  func(string) (state.Store, bool)
+	listStateFn func() map[string]state.Store
+	resiliency  resiliency.Provider
+	clock       clock.Clock
+}
+
+func New(opts Options) *Coordinator {
+	if opts.Clock == nil {
+		opts.Clock = clock.RealClock{}
+	}
+	return &Coordinator{
+		appID:       opts.AppID,
+		getStateFn:  opts.GetStateFn,
+		listStateFn: opts.ListStateFn,
+		resiliency:  opts.Resiliency,
+		clock:       opts.Clock,
+	}
+}
+
+// Execute executes the requests as a single transaction. Each state store must
+// appear in one request only.
+func (c *Coordinator) Execute(ctx context.Context, reqs []StoreRequest) error {
+	if len(reqs) == 0 {
+		return nil
+	}
+
+	stores := make([]state.Store, len(reqs))
+	twoPhase := true
+	for i, req := range reqs {
+		store, ok := c.getStateFn(req.StoreName)
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrStoreNotFound, req.StoreName)
+		}
+		stores[i] = store
+		if _, ok = store.(TwoPhaseCommitter); !ok {
+			twoPhase = false
+		}
+	}
+
+	// A transaction on a single transactional store needs no coordination.
+	if len(reqs) == 1 && isTransactional(stores[0]) {
+		return c.run(ctx, reqs[0].StoreName, func(ctx context.Context) error {
+			return stores[0].(state.TransactionalStore).Multi(ctx, reqs[0].Request)
+		})
+	}
+
+	txID := uuid.NewString()
+	if twoPhase {
+		return c.twoPhaseCommit(ctx, txID, reqs, stores)
+	}
+	return c.saga(ctx, txID, reqs, stores)
+}
+
+func (c *Coordinator) twoPhaseCommit(ctx context.Context, txID string, reqs []StoreRequest, stores []state.Store) error {
+	for i, req := range reqs {
+		tpc := stores[i].(TwoPhaseCommitter)
+		err := c.run(ctx, req.StoreName, func(ctx context.Context) error {
+			return tpc.Prepare(ctx, txID, req.Request)
+		})
+		if err != nil {
+			for j := range i {
+				aborted := stores[j].(TwoPhaseCommitter)
+				if aerr := c.run(ctx, reqs[j].StoreName, func(ctx context.Context) error {
+					return aborted.Abort(ctx, txID)
+				}); aerr != nil {
+					log.Errorf("Failed to abort transaction %s in state store %s: %s", txID, reqs[j].StoreName, aerr)
+				}
+			}
+			return fmt.Errorf("failed to prepare transaction in state store %s: %w", req.StoreName, err)
+		}
+	}
+
+	// Once every store is prepared the transaction is decided, so every store
+	// is committed even if some fail.
+	var errs []error
+	for i, req := range reqs {
+		tpc := stores[i].(TwoPhaseCommitter)
+		if err := c.run(ctx, req.StoreName, func(ctx context.Context) error {
+			return tpc.Commit(ctx, txID)
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("failed to commit transaction in state store %s: %w", req.StoreName, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// run calls fn with the resiliency policy of the state store.
+func (c *Coordinator) run(ctx context.Context, storeName string, fn func(context.Context) error) error {
+	policyRunner := resiliency.NewRunner[any](ctx,
+		c.resiliency.ComponentOutboundPolicy(storeName, resiliency.Statestore),
+	)
+	_, err := policyRunner(func(ctx context.Context) (any, error) {
+		return nil, fn(ctx)
+	})
+	return err
+}
+
+func isTransactional(store state.Store) bool {
+	_, ok := store.(state.TransactionalStore)
+	return ok && state.FeatureTransactional.IsPresent(store.Features())
+}
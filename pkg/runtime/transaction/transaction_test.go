@@ -0,0 +1,289 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transaction
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/resiliency"
+)
+
+// memStore is an in-memory store which fails writes to the keys in failKeys,
+// and to the keys starting with failPrefix if set.
+type memStore struct {
+	state.Store
+
+	lock       sync.Mutex
+	data       map[string][]byte
+	metadata   map[string]map[string]string
+	failKeys   map[string]bool
+	failPrefix string
+}
+
+// keysLikeStore is a memStore which supports listing keys by prefix.
+type keysLikeStore struct {
+	*memStore
+}
+
+func (s *keysLikeStore) KeysLike(ctx context.Context, req *state.KeysLikeRequest) (*state.KeysLikeResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	res := &state.KeysLikeResponse{}
+	for key := range s.data {
+		if strings.HasPrefix(key, strings.TrimSuffix(req.Pattern, "%")) {
+			res.Keys = append(res.Keys, key)
+		}
+	}
+	return res, nil
+}
+
+func newMemStore() *memStore {
+	return &memStore{
+		data:     make(map[string][]byte),
+		metadata: make(map[string]map[string]string),
+		failKeys: make(map[string]bool),
+	}
+}
+
+func (s *memStore) Features() []state.Feature {
+	return nil
+}
+
+func (s *memStore) Get(ctx context.Context, req *state.GetRequest) (*state.GetResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return &state.GetResponse{Data: s.data[req.Key]}, nil
+}
+
+func (s *memStore) Set(ctx context.Context, req *state.SetRequest) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.failKeys[req.Key] || (s.failPrefix != "" && strings.HasPrefix(req.Key, s.failPrefix)) {
+		return errors.New("write failed")
+	}
+	s.metadata[req.Key] = req.Metadata
+	switch v := req.Value.(type) {
+	case []byte:
+		s.data[req.Key] = v
+	default:
+		s.data[req.Key], _ = json.Marshal(v)
+	}
+	return nil
+}
+
+func (s *memStore) Delete(ctx context.Context, req *state.DeleteRequest) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.metadata[req.Key] = req.Metadata
+	delete(s.data, req.Key)
+	return nil
+}
+
+// written returns true if a key with the prefix was written or deleted.
+func (s *memStore) written(prefix string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for key := range s.metadata {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *memStore) get(key string) string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return string(s.data[key])
+}
+
+// tpcStore records the two-phase commit calls it receives.
+type tpcStore struct {
+	*memStore
+
+	failPrepare bool
+	calls       []string
+}
+
+func (s *tpcStore) Prepare(ctx context.Context, txID string, req *state.TransactionalStateRequest) error {
+	s.calls = append(s.calls, "prepare")
+	if s.failPrepare {
+		return errors.New("prepare failed")
+	}
+	return nil
+}
+
+func (s *tpcStore) Commit(ctx context.Context, txID string) error {
+	s.calls = append(s.calls, "commit")
+	return nil
+}
+
+func (s *tpcStore) Abort(ctx context.Context, txID string) error {
+	s.calls = append(s.calls, "abort")
+	return nil
+}
+
+func newCoordinator(stores map[string]state.Store, clock *clocktesting.FakeClock) *Coordinator {
+	return New(Options{
+		AppID: "app",
+		GetStateFn: func(name string) (state.Store, bool) {
+			s, ok := stores[name]
+			return s, ok
+		},
+		ListStateFn: func() map[string]state.Store { return stores },
+		Resiliency:  resiliency.New(nil),
+		Clock:       clock,
+	})
+}
+
+func upsert(storeName, key, value string) StoreRequest {
+	return StoreRequest{
+		StoreName: storeName,
+		Request: &state.TransactionalStateRequest{
+			Operations: []state.TransactionalStateOperation{
+				state.SetRequest{Key: key, Value: []byte(value)},
+			},
+		},
+	}
+}
+
+func TestExecute(t *testing.T) {
+	t.Run("unknown store", func(t *testing.T) {
+		c := newCoordinator(map[string]state.Store{}, clocktesting.NewFakeClock(time.Now()))
+		err := c.Execute(t.Context(), []StoreRequest{upsert("nope", "k", "v")})
+		require.ErrorIs(t, err, ErrStoreNotFound)
+	})
+
+	t.Run("saga", func(t *testing.T) {
+		s1, s2 := newMemStore(), &keysLikeStore{memStore: newMemStore()}
+		s2.data["b"] = []byte("old")
+		c := newCoordinator(map[string]state.Store{"s1": s1, "s2": s2}, clocktesting.NewFakeClock(time.Now()))
+
+		require.NoError(t, c.Execute(t.Context(), []StoreRequest{upsert("s1", "a", "1"), upsert("s2", "b", "2")}))
+		assert.Equal(t, "1", s1.get("a"))
+		assert.Equal(t, "2", s2.get("b"))
+		assert.Len(t, s1.data, 1)
+		assert.Len(t, s2.data, 1)
+		// The record was saved in the store supporting listing keys.
+		assert.False(t, s1.written(c.recordKey("")))
+		assert.True(t, s2.written(c.recordKey("")))
+	})
+
+	t.Run("saga compensates on failure", func(t *testing.T) {
+		s1, s2 := &keysLikeStore{memStore: newMemStore()}, newMemStore()
+		s1.data["a"] = []byte("old")
+		s2.failKeys["b"] = true
+		c := newCoordinator(map[string]state.Store{"s1": s1, "s2": s2}, clocktesting.NewFakeClock(time.Now()))
+
+		req := upsert("s1", "a", "1")
+		req.Request.Metadata = map[string]string{"partitionKey": "p1"}
+		req.Request.Operations = append(req.Request.Operations, state.SetRequest{Key: "new", Value: []byte("1")})
+		err := c.Execute(t.Context(), []StoreRequest{req, upsert("s2", "b", "2")})
+		require.Error(t, err)
+		assert.Equal(t, "old", s1.get("a"))
+		assert.NotContains(t, s1.data, "new")
+		assert.NotContains(t, s2.data, "b")
+		assert.Len(t, s1.data, 1)
+
+		// The keys are restored with the metadata of the request.
+		assert.Equal(t, map[string]string{"partitionKey": "p1"}, s1.metadata["a"])
+		assert.Equal(t, map[string]string{"partitionKey": "p1"}, s1.metadata["new"])
+	})
+
+	t.Run("saga without stores supporting listing keys", func(t *testing.T) {
+		s1, s2 := newMemStore(), newMemStore()
+		c := newCoordinator(map[string]state.Store{"s1": s1, "s2": s2}, clocktesting.NewFakeClock(time.Now()))
+
+		err := c.Execute(t.Context(), []StoreRequest{upsert("s1", "a", "1"), upsert("s2", "b", "2")})
+		require.ErrorIs(t, err, ErrNoRecordStore)
+		assert.Empty(t, s1.data)
+		assert.Empty(t, s2.data)
+	})
+
+	t.Run("saga fails when the record can't be saved", func(t *testing.T) {
+		s1, s2 := &keysLikeStore{memStore: newMemStore()}, newMemStore()
+		c := newCoordinator(map[string]state.Store{"s1": s1, "s2": s2}, clocktesting.NewFakeClock(time.Now()))
+		s1.failPrefix = c.recordKey("")
+
+		require.Error(t, c.Execute(t.Context(), []StoreRequest{upsert("s1", "a", "1"), upsert("s2", "b", "2")}))
+		assert.Empty(t, s1.data)
+		assert.Empty(t, s2.data)
+	})
+
+	t.Run("two-phase commit", func(t *testing.T) {
+		s1, s2 := &tpcStore{memStore: newMemStore()}, &tpcStore{memStore: newMemStore()}
+		c := newCoordinator(map[string]state.Store{"s1": s1, "s2": s2}, clocktesting.NewFakeClock(time.Now()))
+
+		require.NoError(t, c.Execute(t.Context(), []StoreRequest{upsert("s1", "a", "1"), upsert("s2", "b", "2")}))
+		assert.Equal(t, []string{"prepare", "commit"}, s1.calls)
+		assert.Equal(t, []string{"prepare", "commit"}, s2.calls)
+	})
+
+	t.Run("two-phase commit aborts prepared stores", func(t *testing.T) {
+		s1, s2 := &tpcStore{memStore: newMemStore()}, &tpcStore{memStore: newMemStore(), failPrepare: true}
+		c := newCoordinator(map[string]state.Store{"s1": s1, "s2": s2}, clocktesting.NewFakeClock(time.Now()))
+
+		require.Error(t, c.Execute(t.Context(), []StoreRequest{upsert("s1", "a", "1"), upsert("s2", "b", "2")}))
+		assert.Equal(t, []string{"prepare", "abort"}, s1.calls)
+		assert.Equal(t, []string{"prepare"}, s2.calls)
+	})
+}
+
+func TestRecover(t *testing.T) {
+	s1, s2 := &keysLikeStore{memStore: newMemStore()}, newMemStore()
+	s1.data["a"] = []byte("old")
+	clock := clocktesting.NewFakeClock(time.Now())
+	c := newCoordinator(map[string]state.Store{"s1": s1, "s2": s2}, clock)
+
+	// Simulate a saga interrupted after the first store was written.
+	rec := &sagaRecord{
+		ID:        "tx1",
+		StartedAt: clock.Now(),
+		Stores: []sagaStore{
+			{StoreName: "s1", Previous: []sagaValue{{Key: "a", Value: []byte("old"), Exists: true}}},
+			{StoreName: "s2", Previous: []sagaValue{{Key: "b"}}},
+		},
+	}
+	require.NoError(t, c.saveRecord(t.Context(), "s1", s1, rec))
+	s1.data["a"] = []byte("new")
+	s1.data["other"] = []byte("1")
+
+	ids, err := c.listRecords(t.Context(), s1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"tx1"}, ids)
+
+	t.Run("recent sagas are left alone", func(t *testing.T) {
+		require.NoError(t, c.Recover(t.Context()))
+		assert.Equal(t, "new", s1.get("a"))
+		assert.Contains(t, s1.data, c.recordKey("tx1"))
+	})
+
+	t.Run("stale sagas are compensated", func(t *testing.T) {
+		clock.Step(2 * staleSagaAge)
+		require.NoError(t, c.Recover(t.Context()))
+		assert.Equal(t, "old", s1.get("a"))
+		assert.NotContains(t, s1.data, c.recordKey("tx1"))
+	})
+}
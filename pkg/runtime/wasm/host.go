@@ -0,0 +1,129 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package wasm contains the host services that Dapr exposes to Wasm components.
+package wasm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/dapr/components-contrib/secretstores"
+	"github.com/dapr/components-contrib/state"
+	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+)
+
+// ErrNotInitialized is returned by the host functions when the host has not been initialized by the runtime.
+var ErrNotInitialized = errors.New("wasm host is not initialized")
+
+// DefaultHost is the host used by the Wasm components. It is initialized by the runtime.
+var DefaultHost = &Host{}
+
+// Host gives Wasm components access to Dapr building blocks, so they can make decisions using secrets and state.
+// Each component can only access the stores that it is granted.
+type Host struct {
+	lock      sync.RWMutex
+	compStore *compstore.ComponentStore
+	appID     string
+}
+
+// Init sets the component store and app ID used by the host.
+func (h *Host) Init(compStore *compstore.ComponentStore, appID string) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.compStore = compStore
+	h.appID = appID
+}
+
+// Grants are the stores that a Wasm component can access.
+type Grants struct {
+	SecretStores []string
+	StateStores  []string
+}
+
+// GetSecret returns the JSON-encoded secret with the given name from a secret store.
+// The scopes of the secret store apply to the Wasm components too.
+// It returns false if the secret does not exist.
+func (h *Host) GetSecret(ctx context.Context, grants Grants, storeName, name string) ([]byte, bool, error) {
+	if !slices.Contains(grants.SecretStores, storeName) {
+		return nil, false, fmt.Errorf("access to secret store '%s' is not granted", storeName)
+	}
+
+	h.lock.RLock()
+	compStore := h.compStore
+	h.lock.RUnlock()
+	if compStore == nil {
+		return nil, false, ErrNotInitialized
+	}
+
+	store, ok := compStore.GetSecretStore(storeName)
+	if !ok {
+		return nil, false, fmt.Errorf("secret store '%s' not found", storeName)
+	}
+	if config, ok := compStore.GetSecretsConfiguration(storeName); ok && !config.IsSecretAllowed(name) {
+		return nil, false, fmt.Errorf("access denied by policy to get '%s' from '%s'", name, storeName)
+	}
+
+	res, err := store.GetSecret(ctx, secretstores.GetSecretRequest{Name: name})
+	if err != nil {
+		return nil, false, err
+	}
+	if len(res.Data) == 0 {
+		return nil, false, nil
+	}
+
+	b, err := json.Marshal(res.Data)
+	if err != nil {
+		return nil, false, err
+	}
+	return b, true, nil
+}
+
+// GetState returns the value of a key of the app in a state store.
+// It returns false if the key does not exist.
+func (h *Host) GetState(ctx context.Context, grants Grants, storeName, key string) ([]byte, bool, error) {
+	if !slices.Contains(grants.StateStores, storeName) {
+		return nil, false, fmt.Errorf("access to state store '%s' is not granted", storeName)
+	}
+
+	h.lock.RLock()
+	compStore, appID := h.compStore, h.appID
+	h.lock.RUnlock()
+	if compStore == nil {
+		return nil, false, ErrNotInitialized
+	}
+
+	store, ok := compStore.GetStateStore(storeName)
+	if !ok {
+		return nil, false, fmt.Errorf("state store '%s' not found", storeName)
+	}
+
+	modifiedKey, err := stateLoader.GetModifiedStateKey(key, storeName, appID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	res, err := store.Get(ctx, &state.GetRequest{Key: modifiedKey})
+	if err != nil {
+		return nil, false, err
+	}
+	if res == nil || res.Data == nil {
+		return nil, false, nil
+	}
+	return res.Data, true, nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wasm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+func TestHost(t *testing.T) {
+	grants := Grants{
+		SecretStores: []string{"secrets", "scoped"},
+		StateStores:  []string{"state"},
+	}
+
+	t.Run("not initialized", func(t *testing.T) {
+		h := &Host{}
+		_, _, err := h.GetSecret(t.Context(), grants, "secrets", "good-key")
+		require.ErrorIs(t, err, ErrNotInitialized)
+		_, _, err = h.GetState(t.Context(), grants, "state", "key")
+		require.ErrorIs(t, err, ErrNotInitialized)
+	})
+
+	compStore := compstore.New()
+	compStore.AddSecretStore("secrets", daprt.FakeSecretStore{})
+	compStore.AddSecretStore("scoped", daprt.FakeSecretStore{})
+	compStore.AddSecretStore("notgranted", daprt.FakeSecretStore{})
+	compStore.AddSecretsConfiguration("scoped", config.SecretsScope{DefaultAccess: config.DenyAccess})
+	stateStore := daprt.NewFakeStateStore()
+	require.NoError(t, stateStore.Set(t.Context(), &state.SetRequest{Key: "myapp||key", Value: "value"}))
+	compStore.AddStateStore("state", stateStore)
+
+	h := &Host{}
+	h.Init(compStore, "myapp")
+
+	t.Run("get secret", func(t *testing.T) {
+		val, ok, err := h.GetSecret(t.Context(), grants, "secrets", "good-key")
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.JSONEq(t, `{"good-key":"life is good"}`, string(val))
+	})
+
+	t.Run("get secret that does not exist", func(t *testing.T) {
+		_, ok, err := h.GetSecret(t.Context(), grants, "secrets", "other-key")
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("get secret denied by scope", func(t *testing.T) {
+		_, _, err := h.GetSecret(t.Context(), grants, "scoped", "good-key")
+		require.ErrorContains(t, err, "access denied by policy")
+	})
+
+	t.Run("get secret from store not granted", func(t *testing.T) {
+		_, _, err := h.GetSecret(t.Context(), grants, "notgranted", "good-key")
+		require.ErrorContains(t, err, "not granted")
+	})
+
+	t.Run("get secret from store not found", func(t *testing.T) {
+		_, _, err := h.GetSecret(t.Context(), Grants{SecretStores: []string{"missing"}}, "missing", "good-key")
+		require.ErrorContains(t, err, "not found")
+	})
+
+	t.Run("get state of the app", func(t *testing.T) {
+		val, ok, err := h.GetState(t.Context(), grants, "state", "key")
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.JSONEq(t, `"value"`, string(val))
+	})
+
+	t.Run("get state that does not exist", func(t *testing.T) {
+		_, ok, err := h.GetState(t.Context(), grants, "state", "other-key")
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("get state from store not granted", func(t *testing.T) {
+		_, _, err := h.GetState(t.Context(), Grants{}, "state", "key")
+		require.ErrorContains(t, err, "not granted")
+	})
+}
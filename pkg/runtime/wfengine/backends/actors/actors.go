@@ -25,6 +25,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/cenkalti/backoff/v4"
@@ -40,15 +41,19 @@ import (
 	"github.com/dapr/dapr/pkg/actors/targets/workflow/executor"
 	"github.com/dapr/dapr/pkg/actors/targets/workflow/orchestrator"
 	"github.com/dapr/dapr/pkg/actors/targets/workflow/retentioner"
+	"github.com/dapr/dapr/pkg/actors/targets/workflow/starter"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/schedule"
 	"github.com/dapr/dapr/pkg/runtime/wfengine/state"
 	"github.com/dapr/dapr/pkg/runtime/wfengine/state/list"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/throttle"
 	"github.com/dapr/dapr/pkg/runtime/wfengine/todo"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/versioning"
 	"github.com/dapr/dapr/utils"
 	"github.com/dapr/durabletask-go/api"
 	"github.com/dapr/durabletask-go/api/protos"
@@ -67,6 +72,7 @@ const (
 	ActivityNameLabelKey    = "activity"
 	ExecutorNameLabelKey    = "executor"
 	RetentionerNameLabelKey = "retentioner"
+	StarterNameLabelKey     = "starter"
 	ActorTypePrefix         = "dapr.internal."
 )
 
@@ -84,6 +90,21 @@ type Options struct {
 	EnableClusteredDeployment bool
 
 	RetentionPolicy *config.WorkflowStateRetentionPolicy
+
+	// Versions are the latest versions of the workflows, by workflow name.
+	Versions map[string]string
+
+	// EventBufferTTL is how long external events raised to an instance which
+	// hasn't been started yet are retained.
+	EventBufferTTL time.Duration
+
+	// ActivityThrottle limits the concurrently running activities, by the
+	// name of their workflow.
+	ActivityThrottle *throttle.Throttle
+
+	// ChildWorkflowThrottle limits the concurrently running child workflow
+	// executions, of the app and by the name of their parent workflow.
+	ChildWorkflowThrottle *throttle.Throttle
 }
 
 type Actors struct {
@@ -92,6 +113,7 @@ type Actors struct {
 	workflowActorType    string
 	activityActorType    string
 	retentionerActorType string
+	starterActorType     string
 	executorActorType    string
 
 	enableClusteredDeployment bool
@@ -101,6 +123,10 @@ type Actors struct {
 	eventSink                 orchestrator.EventSink
 	compStore                 *compstore.ComponentStore
 	retentionPolicy           *config.WorkflowStateRetentionPolicy
+	versions                  map[string]string
+	eventBufferTTL            time.Duration
+	activityThrottle          *throttle.Throttle
+	childWorkflowThrottle     *throttle.Throttle
 
 	orchestrationWorkItemChan chan *backend.OrchestrationWorkItem
 	activityWorkItemChan      chan *backend.ActivityWorkItem
@@ -126,6 +152,7 @@ func New(opts Options) *Actors {
 		activityActorType:         todo.ActorTypePrefix + opts.Namespace + utils.DotDelimiter + opts.AppID + utils.DotDelimiter + ActivityNameLabelKey,
 		executorActorType:         todo.ActorTypePrefix + opts.Namespace + utils.DotDelimiter + opts.AppID + utils.DotDelimiter + ExecutorNameLabelKey,
 		retentionerActorType:      todo.ActorTypePrefix + opts.Namespace + utils.DotDelimiter + opts.AppID + utils.DotDelimiter + RetentionerNameLabelKey,
+		starterActorType:          todo.ActorTypePrefix + opts.Namespace + utils.DotDelimiter + opts.AppID + utils.DotDelimiter + StarterNameLabelKey,
 		actors:                    opts.Actors,
 		resiliency:                opts.Resiliency,
 		pendingTasksBackend:       pendingTasksBackend,
@@ -135,6 +162,10 @@ func New(opts Options) *Actors {
 		activityWorkItemChan:      make(chan *backend.ActivityWorkItem, 1),
 		eventSink:                 opts.EventSink,
 		retentionPolicy:           opts.RetentionPolicy,
+		versions:                  opts.Versions,
+		eventBufferTTL:            opts.EventBufferTTL,
+		activityThrottle:          opts.ActivityThrottle,
+		childWorkflowThrottle:     opts.ChildWorkflowThrottle,
 	}
 }
 
@@ -146,13 +177,16 @@ func (abe *Actors) RegisterActors(ctx context.Context) error {
 
 	actorTypeBuilder := common.NewActorTypeBuilder(abe.namespace)
 	oopts := orchestrator.Options{
-		AppID:              abe.appID,
-		WorkflowActorType:  abe.workflowActorType,
-		ActivityActorType:  abe.activityActorType,
-		Resiliency:         abe.resiliency,
-		Actors:             abe.actors,
-		RetentionActorType: abe.retentionerActorType,
-		RetentionPolicy:    abe.retentionPolicy,
+		AppID:                 abe.appID,
+		WorkflowActorType:     abe.workflowActorType,
+		ActivityActorType:     abe.activityActorType,
+		Resiliency:            abe.resiliency,
+		Actors:                abe.actors,
+		RetentionActorType:    abe.retentionerActorType,
+		RetentionPolicy:       abe.retentionPolicy,
+		Versions:              abe.versions,
+		EventBufferTTL:        abe.eventBufferTTL,
+		ChildWorkflowThrottle: abe.childWorkflowThrottle,
 		Scheduler: func(ctx context.Context, wi *backend.OrchestrationWorkItem) error {
 			log.Debugf("%s: scheduling workflow execution with durabletask engine", wi.InstanceID)
 			select {
@@ -170,6 +204,7 @@ func (abe *Actors) RegisterActors(ctx context.Context) error {
 		AppID:             abe.appID,
 		ActivityActorType: abe.activityActorType,
 		WorkflowActorType: abe.workflowActorType,
+		Throttle:          abe.activityThrottle,
 		Scheduler: func(ctx context.Context, wi *backend.ActivityWorkItem) error {
 			log.Debugf(
 				"%s: scheduling [%s#%d] activity execution with durabletask engine",
@@ -195,9 +230,14 @@ func (abe *Actors) RegisterActors(ctx context.Context) error {
 			WorkflowActorType: abe.workflowActorType,
 			ActorType:         abe.retentionerActorType,
 		},
+		Starter: starter.Options{
+			ActorType: abe.starterActorType,
+			Start:     abe.startScheduledOrchestration,
+		},
 		WorkflowActorType:  abe.workflowActorType,
 		ActivityActorType:  abe.activityActorType,
 		RetentionActorType: abe.retentionerActorType,
+		StarterActorType:   abe.starterActorType,
 		ExecutorActorType:  abe.executorActorType,
 	}
 
@@ -230,6 +270,7 @@ func (abe *Actors) UnRegisterActors(ctx context.Context) error {
 		abe.workflowActorType,
 		abe.activityActorType,
 		abe.retentionerActorType,
+		abe.starterActorType,
 	}
 	if abe.enableClusteredDeployment {
 		actorTypes = append(actorTypes, abe.executorActorType)
@@ -238,6 +279,68 @@ func (abe *Actors) UnRegisterActors(ctx context.Context) error {
 	return table.UnRegisterActorTypes(actorTypes...)
 }
 
+// ScheduleOrchestration creates or replaces the schedule with the given ID,
+// which starts a workflow instance from the request on each tick of the cron
+// expression. If dueTime is set, the schedule starts at that time.
+func (abe *Actors) ScheduleOrchestration(ctx context.Context, scheduleID string, cron string, dueTime *time.Time, req *protos.CreateInstanceRequest) error {
+	data, err := anypb.New(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal workflow schedule: %w", err)
+	}
+
+	reminders, err := abe.actors.Reminders(ctx)
+	if err != nil {
+		return err
+	}
+
+	reminder := &actorsapi.CreateReminderRequest{
+		Name:      schedule.ReminderName,
+		ActorType: abe.starterActorType,
+		ActorID:   scheduleID,
+		Data:      data,
+		Schedule:  cron,
+		Overwrite: ptr.Of(true),
+	}
+	if dueTime != nil {
+		reminder.DueTime = dueTime.UTC().Format(time.RFC3339)
+	}
+
+	return reminders.Create(ctx, reminder)
+}
+
+// DeleteOrchestrationSchedule deletes the schedule with the given ID. Workflow
+// instances already started by the schedule aren't affected.
+func (abe *Actors) DeleteOrchestrationSchedule(ctx context.Context, scheduleID string) error {
+	reminders, err := abe.actors.Reminders(ctx)
+	if err != nil {
+		return err
+	}
+
+	return reminders.Delete(ctx, &actorsapi.DeleteReminderRequest{
+		Name:      schedule.ReminderName,
+		ActorType: abe.starterActorType,
+		ActorID:   scheduleID,
+	})
+}
+
+// startScheduledOrchestration starts a workflow instance on a tick of its
+// schedule.
+func (abe *Actors) startScheduledOrchestration(ctx context.Context, req *protos.CreateInstanceRequest) error {
+	opts := []api.NewOrchestrationOptions{
+		api.WithInstanceID(api.InstanceID(req.GetInstanceId())),
+	}
+	if req.GetInput() != nil {
+		opts = append(opts, api.WithRawInput(req.GetInput()))
+	}
+	if req.GetOrchestrationIdReusePolicy() != nil {
+		opts = append(opts, api.WithOrchestrationIdReusePolicy(req.GetOrchestrationIdReusePolicy()))
+	}
+
+	ctx = versioning.WithVersion(ctx, req.GetVersion().GetValue())
+	_, err := backend.NewTaskHubClient(abe).ScheduleNewOrchestration(ctx, req.GetName(), opts...)
+	return err
+}
+
 // RerunWorkflowFromEvent implements backend.Backend and reruns a workflow from
 // a specific event ID.
 func (abe *Actors) RerunWorkflowFromEvent(ctx context.Context, req *backend.RerunWorkflowFromEventRequest) (api.InstanceID, error) {
@@ -280,6 +383,32 @@ func (abe *Actors) RerunWorkflowFromEvent(ctx context.Context, req *backend.Reru
 	return api.InstanceID(req.GetNewInstanceID()), nil
 }
 
+// RewindWorkflow rewinds a failed workflow instance to its last successful
+// activity and resumes it from there.
+func (abe *Actors) RewindWorkflow(ctx context.Context, id api.InstanceID, reason string) error {
+	return abe.callWorkflowActor(ctx, id, todo.RewindWorkflowMethod, []byte(reason))
+}
+
+// SetWorkflowCheckpoint sets the checkpoint of a workflow instance: the
+// instance is suspended before it schedules the activity or child workflow
+// with the given name.
+func (abe *Actors) SetWorkflowCheckpoint(ctx context.Context, id api.InstanceID, name string) error {
+	return abe.callWorkflowActor(ctx, id, todo.SetWorkflowCheckpointMethod, []byte(name))
+}
+
+func (abe *Actors) callWorkflowActor(ctx context.Context, id api.InstanceID, method string, data []byte) error {
+	router, err := abe.actors.Router(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := internalsv1pb.NewInternalInvokeRequest(method).
+		WithActor(abe.workflowActorType, string(id)).
+		WithData(data)
+	_, err = router.Call(ctx, req)
+	return err
+}
+
 // CreateOrchestrationInstance implements backend.Backend and creates a new workflow instance.
 //
 // Internally, creating a workflow instance also creates a new actor with the same ID. The create
@@ -293,6 +422,7 @@ func (abe *Actors) CreateOrchestrationInstance(ctx context.Context, e *backend.H
 		return errors.New("the ExecutionStartedEvent did not contain orchestration instance information")
 	} else {
 		workflowInstanceID = oi.GetInstanceId()
+		versioning.Stamp(es, versioning.FromContext(ctx))
 	}
 
 	policy := &api.OrchestrationIdReusePolicy{}
@@ -376,6 +506,44 @@ func (abe *Actors) GetOrchestrationMetadata(ctx context.Context, id api.Instance
 	}, nil
 }
 
+// GetOrchestrationVersion returns the version of the workflow definition a
+// workflow instance runs, which is recorded in its start event. It returns an
+// empty string if the instance isn't versioned.
+func (abe *Actors) GetOrchestrationVersion(ctx context.Context, id api.InstanceID) (string, error) {
+	state, err := abe.loadInternalState(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if state == nil {
+		return "", api.ErrInstanceNotFound
+	}
+
+	for _, e := range state.History {
+		if es := e.GetExecutionStarted(); es != nil {
+			return es.GetVersion().GetValue(), nil
+		}
+	}
+	for _, e := range state.Inbox {
+		if es := e.GetExecutionStarted(); es != nil {
+			return es.GetVersion().GetValue(), nil
+		}
+	}
+	return "", nil
+}
+
+// GetOrchestrationStateSize returns the number of bytes the state of a
+// workflow instance takes in the state store.
+func (abe *Actors) GetOrchestrationStateSize(ctx context.Context, id api.InstanceID) (int, error) {
+	state, err := abe.loadInternalState(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+	if state == nil {
+		return 0, api.ErrInstanceNotFound
+	}
+	return state.Size(), nil
+}
+
 // AbandonActivityWorkItem implements backend.Backend. It gets called by durabletask-go when there is
 // an unexpected failure in the workflow activity execution pipeline.
 func (*Actors) AbandonActivityWorkItem(ctx context.Context, wi *backend.ActivityWorkItem) error {
@@ -433,6 +601,36 @@ func (abe *Actors) AddNewOrchestrationEvent(ctx context.Context, id api.Instance
 	return nil
 }
 
+// AddNewOrchestrationEvents sends a batch of events to the workflow actor
+// identified by id, which adds them to its event inbox at once.
+func (abe *Actors) AddNewOrchestrationEvents(ctx context.Context, id api.InstanceID, events []*backend.HistoryEvent) error {
+	data, err := proto.Marshal(&backend.WorkflowState{Inbox: events})
+	if err != nil {
+		return err
+	}
+
+	req := internalsv1pb.
+		NewInternalInvokeRequest(todo.AddWorkflowEventsMethod).
+		WithActor(abe.workflowActorType, string(id)).
+		WithData(data).
+		WithContentType(invokev1.OctetStreamContentType)
+
+	router, err := abe.actors.Router(ctx)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	_, err = router.Call(ctx, req)
+	elapsed := diag.ElapsedSince(start)
+	if err != nil {
+		diag.DefaultWorkflowMonitoring.WorkflowOperationEvent(ctx, diag.AddEvent, diag.StatusFailed, elapsed)
+		return err
+	}
+	diag.DefaultWorkflowMonitoring.WorkflowOperationEvent(ctx, diag.AddEvent, diag.StatusSuccess, elapsed)
+	return nil
+}
+
 // CompleteActivityWorkItem implements backend.Backend
 func (*Actors) CompleteActivityWorkItem(ctx context.Context, wi *backend.ActivityWorkItem) error {
 	// Sending true signals the waiting activity actor to complete the execution normally.
@@ -677,6 +875,48 @@ func (abe *Actors) ListInstanceIDs(ctx context.Context, req *protos.ListInstance
 	}, nil
 }
 
+// ListWorkflows lists the workflow instances matching a filter, using the
+// index entries saved with the state of the instances.
+func (abe *Actors) ListWorkflows(ctx context.Context, req *list.ListWorkflowsRequest) (*list.ListWorkflowsResponse, error) {
+	ids, err := list.ListIndexedInstanceIDs(ctx, list.ListOptions{
+		ComponentStore:    abe.compStore,
+		Namespace:         abe.namespace,
+		AppID:             abe.appID,
+		PageSize:          req.PageSize,
+		ContinuationToken: req.ContinuationToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	astate, err := abe.actors.State(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &list.ListWorkflowsResponse{
+		Workflows:         make([]list.Workflow, 0, len(ids.Keys)),
+		ContinuationToken: ids.ContinuationToken,
+	}
+	for _, id := range ids.Keys {
+		entry, err := state.LoadIndexEntry(ctx, astate, id, state.Options{
+			AppID:             abe.appID,
+			WorkflowActorType: abe.workflowActorType,
+			ActivityActorType: abe.activityActorType,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to load index entry of workflow instance %s: %w", id, err)
+		}
+		// The instance may have been purged since its ID was listed.
+		if entry == nil || !req.Filter.Matches(entry) {
+			continue
+		}
+		resp.Workflows = append(resp.Workflows, list.Workflow{InstanceID: id, IndexEntry: *entry})
+	}
+
+	return resp, nil
+}
+
 func (abe *Actors) GetInstanceHistory(ctx context.Context, req *protos.GetInstanceHistoryRequest) (*protos.GetInstanceHistoryResponse, error) {
 	ss, err := abe.actors.State(ctx)
 	if err != nil {
@@ -744,7 +984,7 @@ func (abe *Actors) purgeWorkflowForce(ctx context.Context, id api.InstanceID) er
 		return err
 	}
 
-	sched, err := reminders.Scheduler()
+	sched, err := reminders.Store()
 	if err != nil {
 		return err
 	}
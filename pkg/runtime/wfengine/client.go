@@ -20,11 +20,17 @@ import (
 	"fmt"
 	"time"
 
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
 	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/api/protos"
 	"github.com/dapr/durabletask-go/backend"
 
 	"github.com/dapr/components-contrib/workflows"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/schedule"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/versioning"
 	"github.com/dapr/kit/logger"
+	"github.com/dapr/kit/ptr"
 )
 
 // Status values are defined at: https://github.com/dapr/durabletask-go/blob/119b361079c45e368f83b223888d56a436ac59b9/internal/protos/orchestrator_service.pb.go#L42-L64
@@ -42,6 +48,12 @@ var statusMap = map[int32]string{
 type client struct {
 	logger logger.Logger
 	client backend.TaskHubClient
+	// versions returns the version of the workflow definition a workflow
+	// instance runs.
+	versions func(ctx context.Context, id api.InstanceID) (string, error)
+	// schedules creates a schedule starting workflow instances on a cron
+	// expression.
+	schedules func(ctx context.Context, scheduleID string, cron string, dueTime *time.Time, req *protos.CreateInstanceRequest) error
 }
 
 func (c *client) Init(metadata workflows.Metadata) error {
@@ -68,16 +80,40 @@ func (c *client) Start(ctx context.Context, req *workflows.StartRequest) (*workf
 	}
 
 	// Start time is also optional and must be in the RFC3339 format (e.g. 2009-11-10T23:00:00Z).
+	var startAt *time.Time
 	if req.Options != nil {
 		if startTimeRFC3339, ok := req.Options["dapr.workflow.start_time"]; ok {
 			if startTime, err := time.Parse(time.RFC3339, startTimeRFC3339); err != nil {
 				return nil, errors.New(`start times must be in RFC3339 format (e.g. "2009-11-10T23:00:00Z")`)
 			} else {
-				opts = append(opts, api.WithStartTime(startTime))
+				startAt = &startTime
+			}
+		}
+		// Alternatively, a delay the workflow starts after (e.g. 10m).
+		if delay, ok := req.Options[schedule.StartDelayKey]; ok {
+			if startAt != nil {
+				return nil, errors.New("a start time and a start delay can't be both specified")
+			}
+			d, err := time.ParseDuration(delay)
+			if err != nil || d < 0 {
+				return nil, errors.New(`start delays must be positive durations (e.g. "10m")`)
 			}
+			startAt = ptr.Of(time.Now().Add(d))
 		}
 	}
 
+	if cron, ok := req.Options[schedule.CronKey]; ok {
+		return c.schedule(ctx, req, cron, startAt)
+	}
+
+	if startAt != nil {
+		opts = append(opts, api.WithStartTime(*startAt))
+	}
+
+	// The version is optional. If not specified, the instance starts on the
+	// latest version of the workflow.
+	ctx = versioning.WithVersion(ctx, req.Options[versioning.Key])
+
 	workflowID, err := c.client.ScheduleNewOrchestration(ctx, req.WorkflowName, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("unable to start workflow: %w", err)
@@ -90,6 +126,43 @@ func (c *client) Start(ctx context.Context, req *workflows.StartRequest) (*workf
 	return res, nil
 }
 
+// schedule creates a schedule starting the workflow on each tick of the cron
+// expression. The instance ID of the request is the ID of the schedule.
+func (c *client) schedule(ctx context.Context, req *workflows.StartRequest, cron string, startAt *time.Time) (*workflows.StartResponse, error) {
+	if c.schedules == nil {
+		return nil, errors.New("workflow schedules are not supported")
+	}
+	if req.InstanceID == nil || *req.InstanceID == "" {
+		return nil, errors.New("an instance ID is required to schedule a workflow")
+	}
+	if cron == "" {
+		return nil, errors.New("a workflow schedule can't be empty")
+	}
+
+	policy, err := schedule.ParseIDPolicy(req.Options[schedule.IDPolicyKey])
+	if err != nil {
+		return nil, err
+	}
+
+	creq := &protos.CreateInstanceRequest{
+		Name:  req.WorkflowName,
+		Input: req.WorkflowInput,
+	}
+	if v := req.Options[versioning.Key]; v != "" {
+		creq.Version = wrapperspb.String(v)
+	}
+	policy.Apply(creq, *req.InstanceID)
+
+	if err = c.schedules(ctx, *req.InstanceID, cron, startAt, creq); err != nil {
+		return nil, fmt.Errorf("unable to schedule workflow: %w", err)
+	}
+
+	c.logger.Debugf("Scheduled workflow '%s' with schedule ID '%s' on '%s'", req.WorkflowName, *req.InstanceID, cron)
+	return &workflows.StartResponse{
+		InstanceID: *req.InstanceID,
+	}, nil
+}
+
 func (c *client) Terminate(ctx context.Context, req *workflows.TerminateRequest) error {
 	if req.InstanceID == "" {
 		return errors.New("a workflow instance ID is required")
@@ -192,6 +265,16 @@ func (c *client) Get(ctx context.Context, req *workflows.GetRequest) (*workflows
 		res.Workflow.Properties["dapr.workflow.output"] = metadata.GetOutput().GetValue()
 	}
 
+	if c.versions != nil {
+		version, err := c.versions(ctx, api.InstanceID(req.InstanceID))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get workflow version for '%s': %w", req.InstanceID, err)
+		}
+		if version != "" {
+			res.Workflow.Properties[versioning.Key] = version
+		}
+	}
+
 	// Status-specific fields
 	if metadata.FailureDetails != nil {
 		res.Workflow.Properties["dapr.workflow.failure.error_type"] = metadata.GetFailureDetails().GetErrorType()
@@ -22,6 +22,7 @@ import (
 
 	"github.com/dapr/components-contrib/workflows"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/state/list"
 )
 
 type Fake struct {
@@ -31,6 +32,11 @@ type Fake struct {
 	waitForReadyFn       func(context.Context) error
 	clientFn             func() workflows.Workflow
 	runtimeMetadataFn    func() *runtimev1pb.MetadataWorkflows
+	listWorkflowsFn      func(context.Context, *list.ListWorkflowsRequest) (*list.ListWorkflowsResponse, error)
+	deleteScheduleFn     func(context.Context, string) error
+	raiseEventsFn        func(context.Context, string, []*workflows.RaiseEventRequest) error
+	rewindWorkflowFn     func(context.Context, string, string) error
+	pauseAtCheckpointFn  func(context.Context, string, string) error
 }
 
 func New() *Fake {
@@ -41,6 +47,13 @@ func New() *Fake {
 		waitForReadyFn:       func(context.Context) error { return nil },
 		clientFn:             func() workflows.Workflow { return NewClient() },
 		runtimeMetadataFn:    func() *runtimev1pb.MetadataWorkflows { return &runtimev1pb.MetadataWorkflows{} },
+		listWorkflowsFn: func(context.Context, *list.ListWorkflowsRequest) (*list.ListWorkflowsResponse, error) {
+			return &list.ListWorkflowsResponse{}, nil
+		},
+		deleteScheduleFn:    func(context.Context, string) error { return nil },
+		raiseEventsFn:       func(context.Context, string, []*workflows.RaiseEventRequest) error { return nil },
+		rewindWorkflowFn:    func(context.Context, string, string) error { return nil },
+		pauseAtCheckpointFn: func(context.Context, string, string) error { return nil },
 	}
 }
 
@@ -74,6 +87,31 @@ func (f *Fake) WithRuntimeMetadata(runtimeMetadataFn func() *runtimev1pb.Metadat
 	return f
 }
 
+func (f *Fake) WithListWorkflows(listWorkflowsFn func(context.Context, *list.ListWorkflowsRequest) (*list.ListWorkflowsResponse, error)) *Fake {
+	f.listWorkflowsFn = listWorkflowsFn
+	return f
+}
+
+func (f *Fake) WithDeleteWorkflowSchedule(deleteScheduleFn func(context.Context, string) error) *Fake {
+	f.deleteScheduleFn = deleteScheduleFn
+	return f
+}
+
+func (f *Fake) WithRaiseEvents(raiseEventsFn func(context.Context, string, []*workflows.RaiseEventRequest) error) *Fake {
+	f.raiseEventsFn = raiseEventsFn
+	return f
+}
+
+func (f *Fake) WithRewindWorkflow(rewindWorkflowFn func(context.Context, string, string) error) *Fake {
+	f.rewindWorkflowFn = rewindWorkflowFn
+	return f
+}
+
+func (f *Fake) WithPauseWorkflowAtCheckpoint(pauseAtCheckpointFn func(context.Context, string, string) error) *Fake {
+	f.pauseAtCheckpointFn = pauseAtCheckpointFn
+	return f
+}
+
 func (f *Fake) Run(ctx context.Context) error {
 	return f.runFn(ctx)
 }
@@ -101,3 +139,23 @@ func (f *Fake) ActivityActorType() string {
 func (f *Fake) RuntimeMetadata() *runtimev1pb.MetadataWorkflows {
 	return f.runtimeMetadataFn()
 }
+
+func (f *Fake) ListWorkflows(ctx context.Context, req *list.ListWorkflowsRequest) (*list.ListWorkflowsResponse, error) {
+	return f.listWorkflowsFn(ctx, req)
+}
+
+func (f *Fake) DeleteWorkflowSchedule(ctx context.Context, scheduleID string) error {
+	return f.deleteScheduleFn(ctx, scheduleID)
+}
+
+func (f *Fake) RaiseEvents(ctx context.Context, instanceID string, events []*workflows.RaiseEventRequest) error {
+	return f.raiseEventsFn(ctx, instanceID, events)
+}
+
+func (f *Fake) RewindWorkflow(ctx context.Context, instanceID string, reason string) error {
+	return f.rewindWorkflowFn(ctx, instanceID, reason)
+}
+
+func (f *Fake) PauseWorkflowAtCheckpoint(ctx context.Context, instanceID string, checkpoint string) error {
+	return f.pauseAtCheckpointFn(ctx, instanceID, checkpoint)
+}
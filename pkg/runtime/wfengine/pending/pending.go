@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pending
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/state/list"
+	"github.com/dapr/kit/logger"
+	"github.com/dapr/kit/ptr"
+)
+
+var log = logger.NewLogger("dapr.runtime.wfengine.pending")
+
+const (
+	defaultInterval = time.Minute
+	pageSize        = 100
+)
+
+// Backend is the workflow backend holding the workflow instances.
+type Backend interface {
+	ListWorkflows(ctx context.Context, req *list.ListWorkflowsRequest) (*list.ListWorkflowsResponse, error)
+}
+
+type Options struct {
+	Backend Backend
+}
+
+// Counter reports the number of pending workflow instances, which haven't
+// reached a terminal status, by workflow name. The instances are counted from
+// the workflow index saved with their state, so the count survives restarts
+// and includes the instances started by other replicas of the app.
+type Counter struct {
+	backend  Backend
+	interval time.Duration
+	record   func(ctx context.Context, workflowName string, count int64)
+
+	// reported are the workflow names reported in the last count, whose gauge
+	// is reset when they have no pending instances left.
+	reported map[string]struct{}
+
+	clock clock.WithTicker
+}
+
+func New(opts Options) *Counter {
+	return &Counter{
+		backend:  opts.Backend,
+		interval: defaultInterval,
+		record:   diag.DefaultWorkflowMonitoring.WorkflowsPending,
+		reported: make(map[string]struct{}),
+		clock:    clock.RealClock{},
+	}
+}
+
+func (c *Counter) Run(ctx context.Context) error {
+	ticker := c.clock.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.countAll(ctx); err != nil {
+			// State stores which can't list keys have no index to count from.
+			log.Debugf("Error counting pending workflow instances: %s", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C():
+		}
+	}
+}
+
+func (c *Counter) countAll(ctx context.Context) error {
+	counts := make(map[string]int64)
+	var token *string
+	for {
+		resp, err := c.backend.ListWorkflows(ctx, &list.ListWorkflowsRequest{
+			PageSize:          ptr.Of(uint32(pageSize)),
+			ContinuationToken: token,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list workflow instances: %w", err)
+		}
+
+		for _, wf := range resp.Workflows {
+			if !wfenginestate.IsTerminalRuntimeStatus(wf.RuntimeStatus) {
+				counts[wf.Name]++
+			}
+		}
+
+		if resp.ContinuationToken == nil || len(*resp.ContinuationToken) == 0 {
+			break
+		}
+		token = resp.ContinuationToken
+	}
+
+	for name := range c.reported {
+		if _, ok := counts[name]; !ok {
+			c.record(ctx, name, 0)
+		}
+	}
+	c.reported = make(map[string]struct{}, len(counts))
+	for name, count := range counts {
+		c.record(ctx, name, count)
+		c.reported[name] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pending
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/state/list"
+	"github.com/dapr/kit/ptr"
+)
+
+type fakeBackend struct {
+	pages [][]list.Workflow
+}
+
+func (f *fakeBackend) ListWorkflows(_ context.Context, req *list.ListWorkflowsRequest) (*list.ListWorkflowsResponse, error) {
+	page := 0
+	if req.ContinuationToken != nil {
+		page = int((*req.ContinuationToken)[0] - '0')
+	}
+	resp := &list.ListWorkflowsResponse{Workflows: f.pages[page]}
+	if page+1 < len(f.pages) {
+		resp.ContinuationToken = ptr.Of(string(rune('0' + page + 1)))
+	}
+	return resp, nil
+}
+
+func TestCountAll(t *testing.T) {
+	workflow := func(name, status string) list.Workflow {
+		return list.Workflow{
+			IndexEntry: wfenginestate.IndexEntry{Name: name, RuntimeStatus: status},
+		}
+	}
+
+	be := &fakeBackend{pages: [][]list.Workflow{
+		{
+			workflow("order", "RUNNING"),
+			workflow("order", "COMPLETED"),
+			workflow("payment", "PENDING"),
+		},
+		{
+			workflow("order", "SUSPENDED"),
+			workflow("payment", "FAILED"),
+			workflow("shipping", "RUNNING"),
+		},
+	}}
+
+	recorded := make(map[string]int64)
+	c := New(Options{Backend: be})
+	c.record = func(_ context.Context, name string, count int64) {
+		recorded[name] = count
+	}
+
+	require.NoError(t, c.countAll(t.Context()))
+	assert.Equal(t, map[string]int64{"order": 2, "payment": 1, "shipping": 1}, recorded)
+
+	t.Run("names without pending instances are reset", func(t *testing.T) {
+		be.pages = [][]list.Workflow{{
+			workflow("order", "RUNNING"),
+			workflow("shipping", "COMPLETED"),
+		}}
+
+		require.NoError(t, c.countAll(t.Context()))
+		assert.Equal(t, map[string]int64{"order": 1, "payment": 0, "shipping": 0}, recorded)
+	})
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reaper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/dapr/pkg/config"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/state/list"
+	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/backend"
+	"github.com/dapr/kit/logger"
+	"github.com/dapr/kit/ptr"
+)
+
+var log = logger.NewLogger("dapr.runtime.wfengine.reaper")
+
+const (
+	defaultInterval = time.Hour
+	pageSize        = 100
+)
+
+// Backend is the workflow backend holding the workflow instances.
+type Backend interface {
+	ListWorkflows(ctx context.Context, req *list.ListWorkflowsRequest) (*list.ListWorkflowsResponse, error)
+	GetOrchestrationStateSize(ctx context.Context, id api.InstanceID) (int, error)
+}
+
+type Options struct {
+	Policy  *config.WorkflowStateRetentionPolicy
+	Backend Backend
+	Client  backend.TaskHubClient
+}
+
+// Reaper enforces the workflow state retention policy in the background. It
+// purges the terminated workflow instances past their retention TTL, which
+// catches the instances without a retention reminder, like those which
+// reached a terminal state before the policy was set. It also terminates the
+// running workflow instances past the maximum history length.
+type Reaper struct {
+	policy   *config.WorkflowStateRetentionPolicy
+	backend  Backend
+	client   backend.TaskHubClient
+	interval time.Duration
+
+	clock clock.WithTicker
+}
+
+func New(opts Options) *Reaper {
+	interval := defaultInterval
+	if opts.Policy.ReapInterval != nil && *opts.Policy.ReapInterval > 0 {
+		interval = *opts.Policy.ReapInterval
+	}
+
+	return &Reaper{
+		policy:   opts.Policy,
+		backend:  opts.Backend,
+		client:   opts.Client,
+		interval: interval,
+		clock:    clock.RealClock{},
+	}
+}
+
+func (r *Reaper) Run(ctx context.Context) error {
+	log.Infof("Reaping workflow instances every %s", r.interval)
+
+	ticker := r.clock.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C():
+			if err := r.reapAll(ctx); err != nil {
+				log.Errorf("Error reaping workflow instances: %s", err)
+			}
+		}
+	}
+}
+
+func (r *Reaper) reapAll(ctx context.Context) error {
+	var token *string
+	for {
+		resp, err := r.backend.ListWorkflows(ctx, &list.ListWorkflowsRequest{
+			PageSize:          ptr.Of(uint32(pageSize)),
+			ContinuationToken: token,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list workflow instances: %w", err)
+		}
+
+		for _, wf := range resp.Workflows {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			r.reap(ctx, wf)
+		}
+
+		if resp.ContinuationToken == nil || len(*resp.ContinuationToken) == 0 {
+			return nil
+		}
+		token = resp.ContinuationToken
+	}
+}
+
+func (r *Reaper) reap(ctx context.Context, wf list.Workflow) {
+	id := api.InstanceID(wf.InstanceID)
+
+	if !wfenginestate.IsTerminalRuntimeStatus(wf.RuntimeStatus) {
+		maxLength := r.policy.MaxHistoryLength
+		if maxLength == nil || int64(wf.HistoryLength) <= *maxLength {
+			return
+		}
+
+		log.Infof("Terminating workflow instance %s with %d history events, exceeding the maximum of %d", id, wf.HistoryLength, *maxLength)
+		err := r.client.TerminateOrchestration(ctx, id,
+			api.WithOutput(fmt.Sprintf("workflow history length %d exceeds the maximum of %d events", wf.HistoryLength, *maxLength)),
+		)
+		if err != nil {
+			log.Warnf("Failed to terminate workflow instance %s: %s", id, err)
+			return
+		}
+		diag.DefaultWorkflowMonitoring.WorkflowHistoryCapped(ctx, wf.Name)
+		return
+	}
+
+	ttl, _ := r.policy.TTL(wf.RuntimeStatus)
+	if ttl == nil || r.clock.Since(wf.LastUpdatedAt) < *ttl {
+		return
+	}
+
+	size, err := r.backend.GetOrchestrationStateSize(ctx, id)
+	if err != nil {
+		if !errors.Is(err, api.ErrInstanceNotFound) {
+			log.Warnf("Failed to get the state size of workflow instance %s: %s", id, err)
+		}
+		return
+	}
+
+	log.Debugf("Purging workflow instance %s in status %s past its retention TTL of %s", id, wf.RuntimeStatus, *ttl)
+	if err = r.client.PurgeOrchestrationState(ctx, id); err != nil {
+		// The instance may have been purged by its retention reminder meanwhile.
+		if !errors.Is(err, api.ErrInstanceNotFound) {
+			log.Warnf("Failed to purge workflow instance %s: %s", id, err)
+		}
+		return
+	}
+	diag.DefaultWorkflowMonitoring.WorkflowReaped(ctx, wf.Name, strings.ToLower(wf.RuntimeStatus), int64(size))
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reaper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/dapr/pkg/config"
+	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/state/list"
+	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/backend"
+	"github.com/dapr/kit/ptr"
+)
+
+type fakeBackend struct {
+	pages [][]list.Workflow
+}
+
+func (f *fakeBackend) ListWorkflows(_ context.Context, req *list.ListWorkflowsRequest) (*list.ListWorkflowsResponse, error) {
+	page := 0
+	if req.ContinuationToken != nil {
+		page = int((*req.ContinuationToken)[0] - '0')
+	}
+	resp := &list.ListWorkflowsResponse{Workflows: f.pages[page]}
+	if page+1 < len(f.pages) {
+		resp.ContinuationToken = ptr.Of(string(rune('0' + page + 1)))
+	}
+	return resp, nil
+}
+
+func (f *fakeBackend) GetOrchestrationStateSize(context.Context, api.InstanceID) (int, error) {
+	return 10, nil
+}
+
+type fakeClient struct {
+	backend.TaskHubClient
+	purged     []api.InstanceID
+	terminated []api.InstanceID
+}
+
+func (f *fakeClient) PurgeOrchestrationState(_ context.Context, id api.InstanceID, _ ...api.PurgeOptions) error {
+	f.purged = append(f.purged, id)
+	return nil
+}
+
+func (f *fakeClient) TerminateOrchestration(_ context.Context, id api.InstanceID, _ ...api.TerminateOptions) error {
+	f.terminated = append(f.terminated, id)
+	return nil
+}
+
+func TestReapAll(t *testing.T) {
+	now := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	workflow := func(id, status string, age time.Duration, historyLength int) list.Workflow {
+		return list.Workflow{
+			InstanceID: id,
+			IndexEntry: wfenginestate.IndexEntry{
+				Name:          "order",
+				RuntimeStatus: status,
+				LastUpdatedAt: now.Add(-age),
+				HistoryLength: historyLength,
+			},
+		}
+	}
+
+	be := &fakeBackend{pages: [][]list.Workflow{
+		{
+			workflow("old-completed", "COMPLETED", 48*time.Hour, 5),
+			workflow("new-completed", "COMPLETED", time.Hour, 5),
+			workflow("old-failed", "FAILED", 48*time.Hour, 5),
+		},
+		{
+			workflow("old-terminated", "TERMINATED", 10*24*time.Hour, 5),
+			workflow("running", "RUNNING", 48*time.Hour, 5),
+			workflow("long-running", "RUNNING", time.Hour, 1000),
+		},
+	}}
+	client := new(fakeClient)
+
+	r := New(Options{
+		Policy: &config.WorkflowStateRetentionPolicy{
+			Completed:        ptr.Of(24 * time.Hour),
+			Terminated:       ptr.Of(7 * 24 * time.Hour),
+			MaxHistoryLength: ptr.Of(int64(100)),
+		},
+		Backend: be,
+		Client:  client,
+	})
+	r.clock = clocktesting.NewFakeClock(now)
+
+	require.NoError(t, r.reapAll(t.Context()))
+	assert.Equal(t, []api.InstanceID{"old-completed", "old-terminated"}, client.purged)
+	assert.Equal(t, []api.InstanceID{"long-running"}, client.terminated)
+}
+
+func TestNewInterval(t *testing.T) {
+	r := New(Options{Policy: &config.WorkflowStateRetentionPolicy{}})
+	assert.Equal(t, time.Hour, r.interval)
+
+	r = New(Options{Policy: &config.WorkflowStateRetentionPolicy{ReapInterval: ptr.Of(time.Minute)}})
+	assert.Equal(t, time.Minute, r.interval)
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/api/protos"
+)
+
+const (
+	// StartDelayKey is the option of the start workflow requests holding the
+	// delay the workflow instance starts after, e.g. "10m".
+	StartDelayKey = "dapr.workflow.start_delay"
+
+	// CronKey is the option of the start workflow requests holding the cron
+	// expression of the Scheduler service the workflow is started on, e.g.
+	// "0 0 * * * *" or "@every 1h". The instance ID of the request is the ID of
+	// the schedule.
+	CronKey = "dapr.workflow.schedule"
+
+	// IDPolicyKey is the option of the start workflow requests holding the
+	// IDPolicy of the instances started on a schedule.
+	IDPolicyKey = "dapr.workflow.schedule_id_policy"
+
+	// ReminderName is the name of the reminders of the workflow schedules.
+	ReminderName = "schedule"
+)
+
+// IDPolicy is the policy of the instance IDs of the workflow instances started
+// on a schedule, which deduplicates the instances.
+type IDPolicy string
+
+const (
+	// IDPolicyUnique starts every instance with a new ID, made of the ID of the
+	// schedule and the time the instance is started.
+	IDPolicyUnique IDPolicy = "unique"
+	// IDPolicySkipIfRunning starts every instance with the ID of the schedule,
+	// and skips starting an instance while the previous one is running.
+	IDPolicySkipIfRunning IDPolicy = "skip_if_running"
+	// IDPolicyTerminateIfRunning starts every instance with the ID of the
+	// schedule, and terminates the previous instance if it's still running.
+	IDPolicyTerminateIfRunning IDPolicy = "terminate_if_running"
+)
+
+// ParseIDPolicy parses an IDPolicy, which defaults to IDPolicyUnique.
+func ParseIDPolicy(v string) (IDPolicy, error) {
+	switch p := IDPolicy(v); p {
+	case "":
+		return IDPolicyUnique, nil
+	case IDPolicyUnique, IDPolicySkipIfRunning, IDPolicyTerminateIfRunning:
+		return p, nil
+	default:
+		return "", fmt.Errorf("invalid workflow schedule ID policy %q: must be one of %q, %q or %q", v, IDPolicyUnique, IDPolicySkipIfRunning, IDPolicyTerminateIfRunning)
+	}
+}
+
+// Apply sets the instance ID and the ID reuse policy of the request to create
+// the instances started on the schedule with the given ID.
+func (p IDPolicy) Apply(req *protos.CreateInstanceRequest, scheduleID string) {
+	if p == IDPolicyUnique {
+		req.InstanceId = ""
+		return
+	}
+
+	action := api.REUSE_ID_ACTION_IGNORE
+	if p == IDPolicyTerminateIfRunning {
+		action = api.REUSE_ID_ACTION_TERMINATE
+	}
+	req.InstanceId = scheduleID
+	req.OrchestrationIdReusePolicy = &protos.OrchestrationIdReusePolicy{
+		OperationStatus: []protos.OrchestrationStatus{
+			api.RUNTIME_STATUS_RUNNING,
+			api.RUNTIME_STATUS_PENDING,
+			api.RUNTIME_STATUS_SUSPENDED,
+		},
+		Action: action,
+	}
+}
+
+// InstanceID returns the ID of the instance started on the schedule with the
+// given ID at the given time.
+func InstanceID(req *protos.CreateInstanceRequest, scheduleID string, startedAt time.Time) string {
+	if id := req.GetInstanceId(); id != "" {
+		return id
+	}
+	return scheduleID + "-" + startedAt.UTC().Format("20060102150405")
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/api/protos"
+)
+
+func TestParseIDPolicy(t *testing.T) {
+	p, err := ParseIDPolicy("")
+	require.NoError(t, err)
+	assert.Equal(t, IDPolicyUnique, p)
+
+	p, err = ParseIDPolicy("terminate_if_running")
+	require.NoError(t, err)
+	assert.Equal(t, IDPolicyTerminateIfRunning, p)
+
+	_, err = ParseIDPolicy("sometimes")
+	require.Error(t, err)
+}
+
+func TestIDPolicyApply(t *testing.T) {
+	t.Run("unique", func(t *testing.T) {
+		req := &protos.CreateInstanceRequest{InstanceId: "abc"}
+		IDPolicyUnique.Apply(req, "nightly")
+		assert.Empty(t, req.GetInstanceId())
+		assert.Nil(t, req.GetOrchestrationIdReusePolicy())
+	})
+
+	t.Run("skip if running", func(t *testing.T) {
+		req := &protos.CreateInstanceRequest{}
+		IDPolicySkipIfRunning.Apply(req, "nightly")
+		assert.Equal(t, "nightly", req.GetInstanceId())
+		assert.Equal(t, api.REUSE_ID_ACTION_IGNORE, req.GetOrchestrationIdReusePolicy().GetAction())
+		assert.Contains(t, req.GetOrchestrationIdReusePolicy().GetOperationStatus(), api.RUNTIME_STATUS_RUNNING)
+	})
+
+	t.Run("terminate if running", func(t *testing.T) {
+		req := &protos.CreateInstanceRequest{}
+		IDPolicyTerminateIfRunning.Apply(req, "nightly")
+		assert.Equal(t, "nightly", req.GetInstanceId())
+		assert.Equal(t, api.REUSE_ID_ACTION_TERMINATE, req.GetOrchestrationIdReusePolicy().GetAction())
+	})
+}
+
+func TestInstanceID(t *testing.T) {
+	startedAt := time.Date(2026, 3, 1, 12, 30, 5, 0, time.FixedZone("CET", 3600))
+	assert.Equal(t, "nightly-20260301113005", InstanceID(&protos.CreateInstanceRequest{}, "nightly", startedAt))
+	assert.Equal(t, "abc", InstanceID(&protos.CreateInstanceRequest{InstanceId: "abc"}, "nightly", startedAt))
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dapr/dapr/pkg/actors/api"
+	"github.com/dapr/dapr/pkg/actors/state"
+	"github.com/dapr/durabletask-go/backend"
+)
+
+// EventBufferKey is the key of the external events raised to a workflow
+// instance before it's started.
+const EventBufferKey = "eventBuffer"
+
+// LoadEventBuffer loads the external events buffered for a workflow instance
+// which hasn't been started yet.
+func LoadEventBuffer(ctx context.Context, state state.Interface, actorID string, opts Options) ([]*backend.HistoryEvent, error) {
+	res, err := state.Get(ctx, &api.GetStateRequest{
+		ActorType: opts.WorkflowActorType,
+		ActorID:   actorID,
+		Key:       EventBufferKey,
+	}, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load workflow event buffer: %w", err)
+	}
+	if len(res.Data) == 0 {
+		return nil, nil
+	}
+
+	// The buffered events are saved as the inbox of a workflow state.
+	var buffer backend.WorkflowState
+	if err = proto.Unmarshal(res.Data, &buffer); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal workflow event buffer: %w", err)
+	}
+	return buffer.GetInbox(), nil
+}
+
+// GetEventBufferSaveRequest returns the request saving the buffered external
+// events of a workflow instance, or deleting them if there are none left.
+func GetEventBufferSaveRequest(actorID string, opts Options, events []*backend.HistoryEvent) (*api.TransactionalRequest, error) {
+	req := &api.TransactionalRequest{
+		ActorType: opts.WorkflowActorType,
+		ActorID:   actorID,
+	}
+
+	if len(events) == 0 {
+		req.Operations = []api.TransactionalOperation{{
+			Operation: api.Delete,
+			Request:   api.TransactionalDelete{Key: EventBufferKey},
+		}}
+		return req, nil
+	}
+
+	data, err := proto.Marshal(&backend.WorkflowState{Inbox: events})
+	if err != nil {
+		return nil, err
+	}
+	req.Operations = []api.TransactionalOperation{{
+		Operation: api.Upsert,
+		Request:   api.TransactionalUpsert{Key: EventBufferKey, Value: data},
+	}}
+	return req, nil
+}
+
+// ExpireBufferedEvents splits the buffered events into those raised within the
+// TTL and those which expired.
+func ExpireBufferedEvents(events []*backend.HistoryEvent, ttl time.Duration, now time.Time) (kept, expired []*backend.HistoryEvent) {
+	for _, e := range events {
+		if now.Sub(e.GetTimestamp().AsTime()) > ttl {
+			expired = append(expired, e)
+		} else {
+			kept = append(kept, e)
+		}
+	}
+	return kept, expired
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/dapr/dapr/pkg/actors/api"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+)
+
+func TestEventBuffer(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	raised := func(name string, at time.Time) *backend.HistoryEvent {
+		return &backend.HistoryEvent{
+			EventId:   -1,
+			Timestamp: timestamppb.New(at),
+			EventType: &protos.HistoryEvent_EventRaised{
+				EventRaised: &protos.EventRaisedEvent{Name: name},
+			},
+		}
+	}
+
+	t.Run("expire", func(t *testing.T) {
+		events := []*backend.HistoryEvent{
+			raised("old", now.Add(-2*time.Minute)),
+			raised("new", now.Add(-30*time.Second)),
+		}
+		kept, expired := ExpireBufferedEvents(events, time.Minute, now)
+		require.Len(t, kept, 1)
+		assert.Equal(t, "new", kept[0].GetEventRaised().GetName())
+		require.Len(t, expired, 1)
+		assert.Equal(t, "old", expired[0].GetEventRaised().GetName())
+	})
+
+	t.Run("save", func(t *testing.T) {
+		req, err := GetEventBufferSaveRequest("abc", Options{WorkflowActorType: "wf"}, []*backend.HistoryEvent{raised("a", now)})
+		require.NoError(t, err)
+		assert.Equal(t, "wf", req.ActorType)
+		assert.Equal(t, "abc", req.ActorID)
+		require.Len(t, req.Operations, 1)
+		upsert, ok := req.Operations[0].Request.(api.TransactionalUpsert)
+		require.True(t, ok)
+		assert.Equal(t, EventBufferKey, upsert.Key)
+
+		var buffer backend.WorkflowState
+		require.NoError(t, proto.Unmarshal(upsert.Value.([]byte), &buffer))
+		require.Len(t, buffer.GetInbox(), 1)
+		assert.Equal(t, "a", buffer.GetInbox()[0].GetEventRaised().GetName())
+	})
+
+	t.Run("save empty deletes the buffer", func(t *testing.T) {
+		req, err := GetEventBufferSaveRequest("abc", Options{WorkflowActorType: "wf"}, nil)
+		require.NoError(t, err)
+		require.Len(t, req.Operations, 1)
+		assert.Equal(t, api.Delete, req.Operations[0].Operation)
+		assert.Equal(t, api.TransactionalDelete{Key: EventBufferKey}, req.Operations[0].Request)
+	})
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dapr/dapr/pkg/actors/api"
+	"github.com/dapr/dapr/pkg/actors/state"
+	"github.com/dapr/durabletask-go/backend"
+)
+
+// CheckpointKey is the key of the checkpoint of a workflow instance.
+const CheckpointKey = "checkpoint"
+
+// Checkpoint is a named checkpoint a workflow instance pauses at. The instance
+// reaches the checkpoint when it schedules an activity named after it, and is
+// then suspended. The activities scheduled by the instance from then on are
+// held until it's resumed.
+type Checkpoint struct {
+	Name    string `json:"name"`
+	Reached bool   `json:"reached,omitempty"`
+	// Held are the TaskScheduled events of the held activities.
+	Held [][]byte `json:"held,omitempty"`
+}
+
+// Hold holds the activities of the given TaskScheduled events.
+func (c *Checkpoint) Hold(events []*backend.HistoryEvent) error {
+	for _, e := range events {
+		data, err := proto.Marshal(e)
+		if err != nil {
+			return err
+		}
+		c.Held = append(c.Held, data)
+	}
+	return nil
+}
+
+// HeldEvents returns the TaskScheduled events of the held activities.
+func (c *Checkpoint) HeldEvents() ([]*backend.HistoryEvent, error) {
+	events := make([]*backend.HistoryEvent, 0, len(c.Held))
+	for _, data := range c.Held {
+		var e backend.HistoryEvent
+		if err := proto.Unmarshal(data, &e); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal held activity: %w", err)
+		}
+		events = append(events, &e)
+	}
+	return events, nil
+}
+
+// LoadCheckpoint loads the checkpoint of a workflow instance. It returns nil
+// if the instance has no checkpoint.
+func LoadCheckpoint(ctx context.Context, state state.Interface, actorID string, opts Options) (*Checkpoint, error) {
+	res, err := state.Get(ctx, &api.GetStateRequest{
+		ActorType: opts.WorkflowActorType,
+		ActorID:   actorID,
+		Key:       CheckpointKey,
+	}, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load workflow checkpoint: %w", err)
+	}
+	if len(res.Data) == 0 {
+		return nil, nil
+	}
+
+	var checkpoint Checkpoint
+	if err = json.Unmarshal(res.Data, &checkpoint); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal workflow checkpoint: %w", err)
+	}
+	return &checkpoint, nil
+}
+
+// GetCheckpointSaveRequest returns the request saving the checkpoint of a
+// workflow instance, or deleting it if nil.
+func GetCheckpointSaveRequest(actorID string, opts Options, checkpoint *Checkpoint) (*api.TransactionalRequest, error) {
+	req := &api.TransactionalRequest{
+		ActorType: opts.WorkflowActorType,
+		ActorID:   actorID,
+	}
+
+	if checkpoint == nil {
+		req.Operations = []api.TransactionalOperation{{
+			Operation: api.Delete,
+			Request:   api.TransactionalDelete{Key: CheckpointKey},
+		}}
+		return req, nil
+	}
+
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	req.Operations = []api.TransactionalOperation{{
+		Operation: api.Upsert,
+		Request:   api.TransactionalUpsert{Key: CheckpointKey, Value: data},
+	}}
+	return req, nil
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/actors/api"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+)
+
+func TestCheckpoint(t *testing.T) {
+	scheduled := func(id int32, name string) *backend.HistoryEvent {
+		return &backend.HistoryEvent{
+			EventId: id,
+			EventType: &protos.HistoryEvent_TaskScheduled{
+				TaskScheduled: &protos.TaskScheduledEvent{Name: name},
+			},
+		}
+	}
+
+	t.Run("held activities", func(t *testing.T) {
+		checkpoint := &Checkpoint{Name: "approve"}
+		require.NoError(t, checkpoint.Hold([]*backend.HistoryEvent{scheduled(1, "approve"), scheduled(2, "notify")}))
+
+		held, err := checkpoint.HeldEvents()
+		require.NoError(t, err)
+		require.Len(t, held, 2)
+		assert.Equal(t, int32(1), held[0].GetEventId())
+		assert.Equal(t, "notify", held[1].GetTaskScheduled().GetName())
+	})
+
+	t.Run("save", func(t *testing.T) {
+		req, err := GetCheckpointSaveRequest("abc", Options{WorkflowActorType: "wf"}, &Checkpoint{Name: "approve", Reached: true})
+		require.NoError(t, err)
+		assert.Equal(t, "wf", req.ActorType)
+		assert.Equal(t, "abc", req.ActorID)
+		require.Len(t, req.Operations, 1)
+		upsert, ok := req.Operations[0].Request.(api.TransactionalUpsert)
+		require.True(t, ok)
+		assert.Equal(t, CheckpointKey, upsert.Key)
+
+		var checkpoint Checkpoint
+		require.NoError(t, json.Unmarshal(upsert.Value.([]byte), &checkpoint))
+		assert.Equal(t, "approve", checkpoint.Name)
+		assert.True(t, checkpoint.Reached)
+	})
+
+	t.Run("save nil deletes the checkpoint", func(t *testing.T) {
+		req, err := GetCheckpointSaveRequest("abc", Options{WorkflowActorType: "wf"}, nil)
+		require.NoError(t, err)
+		require.Len(t, req.Operations, 1)
+		assert.Equal(t, api.TransactionalDelete{Key: CheckpointKey}, req.Operations[0].Request)
+	})
+}
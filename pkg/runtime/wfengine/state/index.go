@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dapr/dapr/pkg/actors/api"
+	"github.com/dapr/dapr/pkg/actors/state"
+	"github.com/dapr/durabletask-go/backend"
+	"github.com/dapr/durabletask-go/backend/runtimestate"
+)
+
+// IndexKey is the key of the index entry of a workflow instance.
+const IndexKey = "index"
+
+// IndexEntry summarizes a workflow instance. It's saved with the state of the
+// instance, so instances can be listed and filtered without loading their
+// history.
+type IndexEntry struct {
+	Name          string            `json:"name"`
+	RuntimeStatus string            `json:"runtimeStatus"`
+	CreatedAt     time.Time         `json:"createdAt"`
+	LastUpdatedAt time.Time         `json:"lastUpdatedAt"`
+	Tags          map[string]string `json:"tags,omitempty"`
+	HistoryLength int               `json:"historyLength"`
+}
+
+// indexEntry returns the index entry of the workflow instance, or nil if the
+// instance hasn't received its start event yet.
+func (s *State) indexEntry(actorID string) *IndexEntry {
+	rs := runtimestate.NewOrchestrationRuntimeState(actorID, s.CustomStatus, s.History)
+	start, createdAt, lastUpdatedAt := rs.GetStartEvent(), rs.GetCreatedTime(), rs.GetLastUpdatedTime()
+	if start == nil {
+		// The instance is pending: its start event is still in the inbox.
+		for _, e := range s.Inbox {
+			if es := e.GetExecutionStarted(); es != nil {
+				start, createdAt, lastUpdatedAt = es, e.GetTimestamp(), e.GetTimestamp()
+				break
+			}
+		}
+	}
+	if start == nil {
+		return nil
+	}
+
+	return &IndexEntry{
+		Name:          start.GetName(),
+		RuntimeStatus: RuntimeStatusString(runtimestate.RuntimeStatus(rs)),
+		CreatedAt:     createdAt.AsTime(),
+		LastUpdatedAt: lastUpdatedAt.AsTime(),
+		Tags:          start.GetTags(),
+		HistoryLength: len(s.History),
+	}
+}
+
+// RuntimeStatusString returns the name of a runtime status as returned by the
+// workflow APIs, e.g. "RUNNING".
+func RuntimeStatusString(status backend.OrchestrationStatus) string {
+	return strings.TrimPrefix(status.String(), "ORCHESTRATION_STATUS_")
+}
+
+// IsTerminalRuntimeStatus returns true if the runtime status, as returned by
+// RuntimeStatusString, is the one of a workflow instance which has finished.
+func IsTerminalRuntimeStatus(status string) bool {
+	switch status {
+	case "COMPLETED", "FAILED", "TERMINATED", "CANCELED":
+		return true
+	default:
+		return false
+	}
+}
+
+// LoadIndexEntry loads the index entry of a workflow instance. It returns nil
+// if the instance has no index entry.
+func LoadIndexEntry(ctx context.Context, state state.Interface, actorID string, opts Options) (*IndexEntry, error) {
+	res, err := state.Get(ctx, &api.GetStateRequest{
+		ActorType: opts.WorkflowActorType,
+		ActorID:   actorID,
+		Key:       IndexKey,
+	}, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load workflow index entry: %w", err)
+	}
+	if len(res.Data) == 0 {
+		return nil, nil
+	}
+
+	var entry IndexEntry
+	if err = json.Unmarshal(res.Data, &entry); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal workflow index entry: %w", err)
+	}
+	return &entry, nil
+}
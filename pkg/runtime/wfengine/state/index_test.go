@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/dapr/dapr/pkg/actors/api"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+)
+
+func TestIndexEntry(t *testing.T) {
+	createdAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	started := &backend.HistoryEvent{
+		EventId:   -1,
+		Timestamp: timestamppb.New(createdAt),
+		EventType: &protos.HistoryEvent_ExecutionStarted{
+			ExecutionStarted: &protos.ExecutionStartedEvent{
+				Name: "order",
+				Tags: map[string]string{"team": "payments"},
+			},
+		},
+	}
+
+	indexEntry := func(t *testing.T, s *State) *IndexEntry {
+		t.Helper()
+		req, err := s.GetSaveRequest("abc")
+		require.NoError(t, err)
+		for _, op := range req.Operations {
+			if upsert, ok := op.Request.(api.TransactionalUpsert); ok && upsert.Key == IndexKey {
+				var entry IndexEntry
+				require.NoError(t, json.Unmarshal(upsert.Value.([]byte), &entry))
+				return &entry
+			}
+		}
+		return nil
+	}
+
+	t.Run("no start event", func(t *testing.T) {
+		assert.Nil(t, indexEntry(t, NewState(Options{})))
+	})
+
+	t.Run("pending instance", func(t *testing.T) {
+		s := NewState(Options{})
+		s.AddToInbox(started)
+		entry := indexEntry(t, s)
+		require.NotNil(t, entry)
+		assert.Equal(t, "order", entry.Name)
+		assert.Equal(t, "PENDING", entry.RuntimeStatus)
+		assert.Equal(t, createdAt, entry.CreatedAt)
+	})
+
+	t.Run("completed instance", func(t *testing.T) {
+		s := NewState(Options{})
+		s.AddToHistory(started)
+		s.AddToHistory(&backend.HistoryEvent{
+			EventId:   -1,
+			Timestamp: timestamppb.New(createdAt.Add(time.Minute)),
+			EventType: &protos.HistoryEvent_ExecutionCompleted{
+				ExecutionCompleted: &protos.ExecutionCompletedEvent{
+					OrchestrationStatus: protos.OrchestrationStatus_ORCHESTRATION_STATUS_COMPLETED,
+				},
+			},
+		})
+		entry := indexEntry(t, s)
+		require.NotNil(t, entry)
+		assert.Equal(t, "COMPLETED", entry.RuntimeStatus)
+		assert.Equal(t, createdAt, entry.CreatedAt)
+		assert.Equal(t, createdAt.Add(time.Minute), entry.LastUpdatedAt)
+		assert.Equal(t, map[string]string{"team": "payments"}, entry.Tags)
+	})
+
+	t.Run("purged with the instance", func(t *testing.T) {
+		s := NewState(Options{})
+		s.AddToHistory(started)
+		req, err := s.GetPurgeRequest("abc")
+		require.NoError(t, err)
+		assert.Contains(t, req.Operations, api.TransactionalOperation{
+			Operation: api.Delete,
+			Request:   api.TransactionalDelete{Key: IndexKey},
+		})
+	})
+}
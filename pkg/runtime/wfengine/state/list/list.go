@@ -21,6 +21,7 @@ import (
 
 	"github.com/dapr/components-contrib/state"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
+	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
 	"github.com/dapr/dapr/pkg/runtime/wfengine/todo"
 )
 
@@ -39,6 +40,16 @@ type ListInstanceIDsResult struct {
 }
 
 func ListInstanceIDs(ctx context.Context, opts ListOptions) (*ListInstanceIDsResult, error) {
+	return listInstanceIDs(ctx, opts, "metadata")
+}
+
+// ListIndexedInstanceIDs lists the IDs of the workflow instances which have an
+// index entry.
+func ListIndexedInstanceIDs(ctx context.Context, opts ListOptions) (*ListInstanceIDsResult, error) {
+	return listInstanceIDs(ctx, opts, wfenginestate.IndexKey)
+}
+
+func listInstanceIDs(ctx context.Context, opts ListOptions, stateKey string) (*ListInstanceIDsResult, error) {
 	store, _, ok := opts.ComponentStore.GetStateStoreActor()
 	if !ok {
 		return nil, errors.New("no state store with actor support found")
@@ -49,7 +60,7 @@ func ListInstanceIDs(ctx context.Context, opts ListOptions) (*ListInstanceIDsRes
 		return nil, fmt.Errorf("state store %T does not support listing keys", store)
 	}
 
-	like := opts.AppID + "||" + todo.ActorTypePrefix + opts.Namespace + "." + opts.AppID + ".workflow||%||metadata"
+	like := opts.AppID + "||" + todo.ActorTypePrefix + opts.Namespace + "." + opts.AppID + ".workflow||%||" + stateKey
 
 	resp, err := ks.KeysLike(ctx, &state.KeysLikeRequest{
 		Pattern:           like,
@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
+)
+
+// Filter selects workflow instances by their index entry. Unset fields match
+// all instances.
+type Filter struct {
+	// RuntimeStatuses matches instances with any of the runtime statuses, e.g.
+	// "RUNNING". The comparison is case-insensitive.
+	RuntimeStatuses []string
+	Name            string
+	CreatedAfter    *time.Time
+	CreatedBefore   *time.Time
+	// Tags matches instances which have all the tags.
+	Tags map[string]string
+}
+
+// Matches returns true if the index entry matches the filter.
+func (f Filter) Matches(entry *wfenginestate.IndexEntry) bool {
+	if len(f.RuntimeStatuses) > 0 && !slices.ContainsFunc(f.RuntimeStatuses, func(status string) bool {
+		return strings.EqualFold(status, entry.RuntimeStatus)
+	}) {
+		return false
+	}
+	if f.Name != "" && f.Name != entry.Name {
+		return false
+	}
+	if f.CreatedAfter != nil && !entry.CreatedAt.After(*f.CreatedAfter) {
+		return false
+	}
+	if f.CreatedBefore != nil && !entry.CreatedAt.Before(*f.CreatedBefore) {
+		return false
+	}
+	for k, v := range f.Tags {
+		if tv, ok := entry.Tags[k]; !ok || tv != v {
+			return false
+		}
+	}
+	return true
+}
+
+type ListWorkflowsRequest struct {
+	Filter Filter
+
+	PageSize          *uint32
+	ContinuationToken *string
+}
+
+// ListWorkflowsResponse is a page of the workflow instances matching a
+// filter. Instances are filtered after a page is read from the state store,
+// so a page may hold fewer instances than the page size, or none, while there
+// are more pages.
+type ListWorkflowsResponse struct {
+	Workflows         []Workflow
+	ContinuationToken *string
+}
+
+type Workflow struct {
+	InstanceID string
+	wfenginestate.IndexEntry
+}
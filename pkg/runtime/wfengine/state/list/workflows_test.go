@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
+	"github.com/dapr/kit/ptr"
+)
+
+func TestFilterMatches(t *testing.T) {
+	createdAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	entry := &wfenginestate.IndexEntry{
+		Name:          "order",
+		RuntimeStatus: "RUNNING",
+		CreatedAt:     createdAt,
+		Tags:          map[string]string{"team": "payments", "region": "eu"},
+	}
+
+	tests := map[string]struct {
+		filter Filter
+		exp    bool
+	}{
+		"empty filter": {
+			exp: true,
+		},
+		"status": {
+			filter: Filter{RuntimeStatuses: []string{"failed", "running"}},
+			exp:    true,
+		},
+		"other status": {
+			filter: Filter{RuntimeStatuses: []string{"FAILED"}},
+		},
+		"name": {
+			filter: Filter{Name: "order"},
+			exp:    true,
+		},
+		"other name": {
+			filter: Filter{Name: "refund"},
+		},
+		"created in range": {
+			filter: Filter{CreatedAfter: ptr.Of(createdAt.Add(-time.Hour)), CreatedBefore: ptr.Of(createdAt.Add(time.Hour))},
+			exp:    true,
+		},
+		"created before range": {
+			filter: Filter{CreatedAfter: ptr.Of(createdAt)},
+		},
+		"created after range": {
+			filter: Filter{CreatedBefore: ptr.Of(createdAt)},
+		},
+		"tags": {
+			filter: Filter{Tags: map[string]string{"team": "payments"}},
+			exp:    true,
+		},
+		"other tag value": {
+			filter: Filter{Tags: map[string]string{"team": "shipping"}},
+		},
+		"missing tag": {
+			filter: Filter{Tags: map[string]string{"team": "payments", "tier": "gold"}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.exp, test.filter.Matches(entry))
+		})
+	}
+}
@@ -181,6 +181,19 @@ func (s *State) GetSaveRequest(actorID string) (*api.TransactionalRequest, error
 		Request:   api.TransactionalUpsert{Key: metadataKey, Value: metaProto},
 	})
 
+	// The index entry is kept up to date with the state, so that instances can
+	// be listed without loading their history.
+	if entry := s.indexEntry(actorID); entry != nil {
+		entryJSON, err := json.Marshal(entry)
+		if err != nil {
+			return nil, err
+		}
+		req.Operations = append(req.Operations, api.TransactionalOperation{
+			Operation: api.Upsert,
+			Request:   api.TransactionalUpsert{Key: IndexKey, Value: entryJSON},
+		})
+	}
+
 	return req, nil
 }
 
@@ -373,8 +386,8 @@ func (s *State) GetPurgeRequest(actorID string) (*api.TransactionalRequest, erro
 	req := &api.TransactionalRequest{
 		ActorType: s.workflowActorType,
 		ActorID:   actorID,
-		// Initial capacity should be enough to contain the entire inbox, history, and custom status + metadata
-		Operations: make([]api.TransactionalOperation, 0, len(s.Inbox)+len(s.History)+2),
+		// Initial capacity should be enough to contain the entire inbox, history, and custom status + metadata + index
+		Operations: make([]api.TransactionalOperation, 0, len(s.Inbox)+len(s.History)+3),
 	}
 
 	// Inbox Purging
@@ -396,6 +409,10 @@ func (s *State) GetPurgeRequest(actorID string) (*api.TransactionalRequest, erro
 			Operation: api.Delete,
 			Request:   api.TransactionalDelete{Key: metadataKey},
 		},
+		api.TransactionalOperation{
+			Operation: api.Delete,
+			Request:   api.TransactionalDelete{Key: IndexKey},
+		},
 	)
 
 	return req, nil
@@ -422,6 +439,18 @@ func (s *State) FromWorkflowState(state *backend.WorkflowState) {
 	s.Generation = state.Generation
 }
 
+// Size returns the number of bytes the state takes in the state store.
+func (s *State) Size() int {
+	size := proto.Size(s.CustomStatus)
+	for _, e := range s.Inbox {
+		size += proto.Size(e)
+	}
+	for _, e := range s.History {
+		size += proto.Size(e)
+	}
+	return size
+}
+
 func getMultiEntryKeyName(prefix string, i uint64) string {
 	return fmt.Sprintf("%s-%06d", prefix, i)
 }
@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+)
+
+type Options struct {
+	// Max is the maximum number of concurrent executions of the app. Zero
+	// means no limit.
+	Max int
+
+	// MaxByWorkflow are the maximum numbers of concurrent executions, by
+	// workflow name. Zero means no limit.
+	MaxByWorkflow map[string]int
+}
+
+// Throttle caps the number of concurrent executions of the app, and of each
+// workflow, e.g. the activities of the instances of a workflow. A nil
+// Throttle doesn't limit anything.
+type Throttle struct {
+	app        chan struct{}
+	byWorkflow map[string]chan struct{}
+}
+
+// New returns a Throttle enforcing the given limits, or nil if there are no
+// limits.
+func New(opts Options) *Throttle {
+	t := &Throttle{
+		byWorkflow: make(map[string]chan struct{}),
+	}
+	if opts.Max > 0 {
+		t.app = make(chan struct{}, opts.Max)
+	}
+	for name, limit := range opts.MaxByWorkflow {
+		if limit > 0 {
+			t.byWorkflow[name] = make(chan struct{}, limit)
+		}
+	}
+
+	if t.app == nil && len(t.byWorkflow) == 0 {
+		return nil
+	}
+	return t
+}
+
+// LimitsWorkflows returns true if the executions of any workflow are limited.
+func (t *Throttle) LimitsWorkflows() bool {
+	return t != nil && len(t.byWorkflow) > 0
+}
+
+// Acquire blocks until an execution of the workflow can start within the
+// limits, or the context is done. The returned function must be called once
+// the execution completes.
+func (t *Throttle) Acquire(ctx context.Context, workflowName string) (func(), error) {
+	if t == nil {
+		return func() {}, nil
+	}
+
+	// The workflow slot is taken first, so executions waiting on the limit of
+	// their workflow don't hold slots of the app.
+	wf := t.byWorkflow[workflowName]
+	if err := acquire(ctx, wf); err != nil {
+		return nil, err
+	}
+	if err := acquire(ctx, t.app); err != nil {
+		release(wf)
+		return nil, err
+	}
+
+	return func() {
+		release(t.app)
+		release(wf)
+	}, nil
+}
+
+func acquire(ctx context.Context, slots chan struct{}) error {
+	if slots == nil {
+		return nil
+	}
+	select {
+	case slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func release(slots chan struct{}) {
+	if slots != nil {
+		<-slots
+	}
+}
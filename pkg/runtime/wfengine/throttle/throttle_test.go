@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThrottle(t *testing.T) {
+	t.Parallel()
+
+	blocked := func(t *testing.T, th *Throttle, name string) {
+		t.Helper()
+		ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+		defer cancel()
+		_, err := th.Acquire(ctx, name)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	}
+
+	t.Run("no limits", func(t *testing.T) {
+		th := New(Options{MaxByWorkflow: map[string]int{"wf": 0}})
+		assert.Nil(t, th)
+		assert.False(t, th.LimitsWorkflows())
+		release, err := th.Acquire(t.Context(), "wf")
+		require.NoError(t, err)
+		release()
+	})
+
+	t.Run("app limit", func(t *testing.T) {
+		th := New(Options{Max: 2})
+		assert.False(t, th.LimitsWorkflows())
+
+		r1, err := th.Acquire(t.Context(), "a")
+		require.NoError(t, err)
+		_, err = th.Acquire(t.Context(), "b")
+		require.NoError(t, err)
+		blocked(t, th, "c")
+
+		r1()
+		_, err = th.Acquire(t.Context(), "c")
+		require.NoError(t, err)
+	})
+
+	t.Run("workflow limit", func(t *testing.T) {
+		th := New(Options{MaxByWorkflow: map[string]int{"a": 1}})
+		assert.True(t, th.LimitsWorkflows())
+
+		r1, err := th.Acquire(t.Context(), "a")
+		require.NoError(t, err)
+		blocked(t, th, "a")
+
+		// Other workflows aren't limited.
+		for range 5 {
+			_, err = th.Acquire(t.Context(), "b")
+			require.NoError(t, err)
+		}
+
+		r1()
+		_, err = th.Acquire(t.Context(), "a")
+		require.NoError(t, err)
+	})
+
+	t.Run("waiting on the workflow limit doesn't hold app slots", func(t *testing.T) {
+		th := New(Options{Max: 2, MaxByWorkflow: map[string]int{"a": 1}})
+
+		_, err := th.Acquire(t.Context(), "a")
+		require.NoError(t, err)
+		blocked(t, th, "a")
+
+		_, err = th.Acquire(t.Context(), "b")
+		require.NoError(t, err)
+		blocked(t, th, "b")
+	})
+}
@@ -26,10 +26,13 @@ const (
 
 	CreateWorkflowInstanceMethod = "CreateWorkflowInstance"
 	AddWorkflowEventMethod       = "AddWorkflowEvent"
+	AddWorkflowEventsMethod      = "AddWorkflowEvents"
 	PurgeWorkflowStateMethod     = "PurgeWorkflowState"
 	WaitForRuntimeStatus         = "WaitForRuntimeStatus"
 	ForkWorkflowHistory          = "ForkWorkflowHistory"
 	RerunWorkflowInstance        = "RerunWorkflowInstance"
+	RewindWorkflowMethod         = "RewindWorkflow"
+	SetWorkflowCheckpointMethod  = "SetWorkflowCheckpoint"
 
 	MetadataActivityReminderDueTime = "dueTime"
 	MetadataPurgeRetentionCall      = "PurgeRetentionCall"
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wfengine
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	diagConsts "github.com/dapr/dapr/pkg/diagnostics/consts"
+	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/api/helpers"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+)
+
+// tracingExecutor adds the Dapr attributes to the workflow and activity spans
+// started by durabletask, which are in the context of the executions.
+type tracingExecutor struct {
+	backend.Executor
+
+	appID string
+}
+
+func (e *tracingExecutor) ExecuteOrchestrator(ctx context.Context, iid api.InstanceID, oldEvents []*protos.HistoryEvent, newEvents []*protos.HistoryEvent) (*protos.OrchestratorResponse, error) {
+	if span := trace.SpanFromContext(ctx); span.IsRecording() {
+		span.SetAttributes(
+			attribute.String(diagConsts.WorkflowAppIDSpanAttributeKey, e.appID),
+			attribute.String(diagConsts.WorkflowNewEventsSpanAttributeKey, helpers.HistoryListSummary(newEvents)),
+		)
+	}
+
+	return e.Executor.ExecuteOrchestrator(ctx, iid, oldEvents, newEvents)
+}
+
+func (e *tracingExecutor) ExecuteActivity(ctx context.Context, iid api.InstanceID, event *protos.HistoryEvent) (*protos.HistoryEvent, error) {
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return e.Executor.ExecuteActivity(ctx, iid, event)
+	}
+
+	span.SetAttributes(attribute.String(diagConsts.WorkflowAppIDSpanAttributeKey, e.appID))
+
+	// Activities failed by the app aren't errors of the execution, so
+	// durabletask doesn't mark their span.
+	res, err := e.Executor.ExecuteActivity(ctx, iid, event)
+	if fd := res.GetTaskFailed().GetFailureDetails(); fd != nil {
+		span.SetStatus(codes.Error, fd.GetErrorMessage())
+	}
+
+	return res, err
+}
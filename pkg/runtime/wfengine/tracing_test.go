@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wfengine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	diagConsts "github.com/dapr/dapr/pkg/diagnostics/consts"
+	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+)
+
+type fakeExecutor struct {
+	backend.Executor
+
+	activityResult *protos.HistoryEvent
+}
+
+func (*fakeExecutor) ExecuteOrchestrator(context.Context, api.InstanceID, []*protos.HistoryEvent, []*protos.HistoryEvent) (*protos.OrchestratorResponse, error) {
+	return &protos.OrchestratorResponse{}, nil
+}
+
+func (f *fakeExecutor) ExecuteActivity(context.Context, api.InstanceID, *protos.HistoryEvent) (*protos.HistoryEvent, error) {
+	return f.activityResult, nil
+}
+
+func TestTracingExecutor(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	defer func() { _ = tp.Shutdown(t.Context()) }()
+	tracer := tp.Tracer("test")
+
+	fake := &fakeExecutor{}
+	executor := &tracingExecutor{Executor: fake, appID: "myapp"}
+
+	t.Run("orchestrator span", func(t *testing.T) {
+		ctx, span := tracer.Start(t.Context(), "orchestration||mywf")
+		_, err := executor.ExecuteOrchestrator(ctx, "abc", nil, []*protos.HistoryEvent{
+			{EventType: &protos.HistoryEvent_TimerFired{TimerFired: &protos.TimerFiredEvent{}}},
+		})
+		require.NoError(t, err)
+		span.End()
+
+		ended := recorder.Ended()
+		got := ended[len(ended)-1]
+		assert.Contains(t, got.Attributes(), attribute.String(diagConsts.WorkflowAppIDSpanAttributeKey, "myapp"))
+		assert.Contains(t, got.Attributes(), attribute.String(diagConsts.WorkflowNewEventsSpanAttributeKey, "[TimerFired]"))
+	})
+
+	t.Run("failed activity span", func(t *testing.T) {
+		fake.activityResult = &protos.HistoryEvent{EventType: &protos.HistoryEvent_TaskFailed{
+			TaskFailed: &protos.TaskFailedEvent{FailureDetails: &protos.TaskFailureDetails{ErrorMessage: "boom"}},
+		}}
+		ctx, span := tracer.Start(t.Context(), "activity||myactivity")
+		_, err := executor.ExecuteActivity(ctx, "abc", &protos.HistoryEvent{})
+		require.NoError(t, err)
+		span.End()
+
+		ended := recorder.Ended()
+		got := ended[len(ended)-1]
+		assert.Contains(t, got.Attributes(), attribute.String(diagConsts.WorkflowAppIDSpanAttributeKey, "myapp"))
+		assert.Equal(t, codes.Error, got.Status().Code)
+		assert.Equal(t, "boom", got.Status().Description)
+	})
+
+	t.Run("completed activity span", func(t *testing.T) {
+		fake.activityResult = &protos.HistoryEvent{EventType: &protos.HistoryEvent_TaskCompleted{
+			TaskCompleted: &protos.TaskCompletedEvent{},
+		}}
+		ctx, span := tracer.Start(t.Context(), "activity||myactivity")
+		_, err := executor.ExecuteActivity(ctx, "abc", &protos.HistoryEvent{})
+		require.NoError(t, err)
+		span.End()
+
+		ended := recorder.Ended()
+		assert.Equal(t, codes.Unset, ended[len(ended)-1].Status().Code)
+	})
+
+	t.Run("untraced executions", func(t *testing.T) {
+		n := len(recorder.Ended())
+		_, err := executor.ExecuteOrchestrator(t.Context(), "abc", nil, nil)
+		require.NoError(t, err)
+		_, err = executor.ExecuteActivity(t.Context(), "abc", &protos.HistoryEvent{})
+		require.NoError(t, err)
+		assert.Len(t, recorder.Ended(), n)
+	})
+}
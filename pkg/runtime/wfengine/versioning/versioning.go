@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package versioning stamps the workflow instances with the version of the
+// workflow definition they start on. The version is recorded in the start
+// event of the instance, so that an instance replays on the version it
+// started on while new instances start on the latest version.
+package versioning
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/dapr/durabletask-go/api/protos"
+)
+
+// Key is the option of a workflow start request holding the version the
+// instance starts on, and the property of a workflow instance holding the
+// version it runs.
+const Key = "dapr.workflow.version"
+
+type versionKey struct{}
+
+// WithVersion returns a context starting workflow instances on the given
+// version.
+func WithVersion(ctx context.Context, version string) context.Context {
+	if version == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, versionKey{}, version)
+}
+
+// FromContext returns the version workflow instances started with the
+// context start on, or an empty string.
+func FromContext(ctx context.Context) string {
+	version, _ := ctx.Value(versionKey{}).(string)
+	return version
+}
+
+// Stamp sets the version of the instance started by the event, unless the
+// event already has one.
+func Stamp(es *protos.ExecutionStartedEvent, version string) {
+	if es == nil || version == "" || es.GetVersion().GetValue() != "" {
+		return
+	}
+	es.Version = wrapperspb.String(version)
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package versioning
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/dapr/durabletask-go/api/protos"
+)
+
+func TestVersion(t *testing.T) {
+	assert.Equal(t, "v2", FromContext(WithVersion(t.Context(), "v2")))
+	assert.Empty(t, FromContext(WithVersion(t.Context(), "")))
+
+	es := &protos.ExecutionStartedEvent{Name: "wf"}
+	Stamp(es, "")
+	assert.Nil(t, es.GetVersion())
+
+	Stamp(es, "v2")
+	assert.Equal(t, "v2", es.GetVersion().GetValue())
+
+	// The version an instance started on is kept.
+	Stamp(es, "v3")
+	assert.Equal(t, "v2", es.GetVersion().GetValue())
+
+	es = &protos.ExecutionStartedEvent{Name: "wf", Version: wrapperspb.String("")}
+	Stamp(es, "v3")
+	assert.Equal(t, "v3", es.GetVersion().GetValue())
+}
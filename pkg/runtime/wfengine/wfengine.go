@@ -23,16 +23,24 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/dapr/components-contrib/workflows"
 	"github.com/dapr/dapr/pkg/actors"
 	"github.com/dapr/dapr/pkg/actors/targets/workflow/orchestrator"
 	"github.com/dapr/dapr/pkg/config"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
 	"github.com/dapr/dapr/pkg/runtime/processor"
 	backendactors "github.com/dapr/dapr/pkg/runtime/wfengine/backends/actors"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/pending"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/reaper"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/state/list"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/throttle"
+	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/api/protos"
 	"github.com/dapr/durabletask-go/backend"
 	"github.com/dapr/kit/logger"
 )
@@ -47,6 +55,11 @@ type Interface interface {
 	RegisterGrpcServer(*grpc.Server)
 	Client() workflows.Workflow
 	RuntimeMetadata() *runtimev1pb.MetadataWorkflows
+	ListWorkflows(context.Context, *list.ListWorkflowsRequest) (*list.ListWorkflowsResponse, error)
+	DeleteWorkflowSchedule(ctx context.Context, scheduleID string) error
+	RaiseEvents(ctx context.Context, instanceID string, events []*workflows.RaiseEventRequest) error
+	RewindWorkflow(ctx context.Context, instanceID string, reason string) error
+	PauseWorkflowAtCheckpoint(ctx context.Context, instanceID string, checkpoint string) error
 
 	ActivityActorType() string
 }
@@ -72,14 +85,24 @@ type engine struct {
 	worker  backend.TaskHubWorker
 	backend *backendactors.Actors
 	client  workflows.Workflow
+	reaper  *reaper.Reaper
+	pending *pending.Counter
 
 	registerGrpcServerFn func(grpcServer grpc.ServiceRegistrar)
 }
 
 func New(opts Options) Interface {
 	var retPolicy *config.WorkflowStateRetentionPolicy
+	var versions map[string]string
+	var eventBufferTTL time.Duration
+	var activityThrottle, childWorkflowThrottle *throttle.Throttle
 	if opts.Spec != nil {
 		retPolicy = opts.Spec.StateRetentionPolicy
+		versions = opts.Spec.Versions
+		if opts.Spec.EventBufferTTL != nil {
+			eventBufferTTL = *opts.Spec.EventBufferTTL
+		}
+		activityThrottle, childWorkflowThrottle = newThrottles(opts.Spec)
 	}
 
 	// If no backend was initialized by the manager, create a backend backed by actors
@@ -92,6 +115,10 @@ func New(opts Options) Interface {
 		EnableClusteredDeployment: opts.EnableClusteredDeployment,
 		ComponentStore:            opts.ComponentStore,
 		RetentionPolicy:           retPolicy,
+		Versions:                  versions,
+		EventBufferTTL:            eventBufferTTL,
+		ActivityThrottle:          activityThrottle,
+		ChildWorkflowThrottle:     childWorkflowThrottle,
 	})
 
 	var getWorkItemsCount atomic.Int32
@@ -125,6 +152,7 @@ func New(opts Options) Interface {
 		}),
 		backend.WithStreamSendTimeout(time.Second*10),
 	)
+	executor = &tracingExecutor{Executor: executor, appID: opts.AppID}
 
 	var topts []backend.NewTaskWorkerOptions
 	if opts.Spec.GetMaxConcurrentWorkflowInvocations() != nil {
@@ -154,6 +182,16 @@ func New(opts Options) Interface {
 		topts...,
 	)
 	worker := backend.NewTaskHubWorker(abackend, oworker, aworker, wfBackendLogger)
+	taskHubClient := backend.NewTaskHubClient(abackend)
+
+	var wreaper *reaper.Reaper
+	if retPolicy != nil {
+		wreaper = reaper.New(reaper.Options{
+			Policy:  retPolicy,
+			Backend: abackend,
+			Client:  taskHubClient,
+		})
+	}
 
 	return &engine{
 		appID:                opts.AppID,
@@ -163,13 +201,37 @@ func New(opts Options) Interface {
 		backend:              abackend,
 		registerGrpcServerFn: registerGrpcServerFn,
 		getWorkItemsCount:    &getWorkItemsCount,
+		reaper:               wreaper,
+		pending:              pending.New(pending.Options{Backend: abackend}),
 		client: &client{
-			logger: wfBackendLogger,
-			client: backend.NewTaskHubClient(abackend),
+			logger:    wfBackendLogger,
+			client:    taskHubClient,
+			versions:  abackend.GetOrchestrationVersion,
+			schedules: abackend.ScheduleOrchestration,
 		},
 	}
 }
 
+// newThrottles returns the throttles enforcing the concurrency limits of the
+// activities and child workflows of the workflow spec. The app-wide limit of
+// the activities is enforced by the activity worker, as
+// maxConcurrentActivityInvocations.
+func newThrottles(spec *config.WorkflowSpec) (activities, children *throttle.Throttle) {
+	maxActivities := make(map[string]int, len(spec.Limits))
+	maxChildren := make(map[string]int, len(spec.Limits))
+	for name, limits := range spec.Limits {
+		maxActivities[name] = int(limits.MaxConcurrentActivities)
+		maxChildren[name] = int(limits.MaxConcurrentChildWorkflows)
+	}
+
+	activities = throttle.New(throttle.Options{MaxByWorkflow: maxActivities})
+	children = throttle.New(throttle.Options{
+		Max:           int(spec.MaxConcurrentChildWorkflows),
+		MaxByWorkflow: maxChildren,
+	})
+	return activities, children
+}
+
 func (wfe *engine) RegisterGrpcServer(server *grpc.Server) {
 	wfe.registerGrpcServerFn(server)
 }
@@ -187,7 +249,32 @@ func (wfe *engine) Run(ctx context.Context) error {
 	}
 
 	log.Info("Workflow engine started")
+
+	reaperDone := make(chan struct{})
+	go func() {
+		defer close(reaperDone)
+		if wfe.reaper == nil {
+			return
+		}
+		if err := wfe.reaper.Run(ctx); err != nil {
+			log.Errorf("Workflow reaper failed: %s", err)
+		}
+	}()
+
+	pendingDone := make(chan struct{})
+	go func() {
+		defer close(pendingDone)
+		if !diag.DefaultWorkflowMonitoring.IsEnabled() {
+			return
+		}
+		if err := wfe.pending.Run(ctx); err != nil {
+			log.Errorf("Workflow pending counter failed: %s", err)
+		}
+	}()
+
 	<-ctx.Done()
+	<-reaperDone
+	<-pendingDone
 
 	if err := wfe.worker.Shutdown(context.Background()); err != nil {
 		return fmt.Errorf("failed to shutdown the workflow worker: %w", err)
@@ -205,6 +292,49 @@ func (wfe *engine) ActivityActorType() string {
 	return wfe.backend.ActivityActorType()
 }
 
+func (wfe *engine) ListWorkflows(ctx context.Context, req *list.ListWorkflowsRequest) (*list.ListWorkflowsResponse, error) {
+	return wfe.backend.ListWorkflows(ctx, req)
+}
+
+// RaiseEvents raises a batch of external events to a workflow instance, which
+// are added to the instance at once.
+func (wfe *engine) RaiseEvents(ctx context.Context, instanceID string, events []*workflows.RaiseEventRequest) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	now := timestamppb.Now()
+	hevents := make([]*backend.HistoryEvent, len(events))
+	for i, e := range events {
+		hevents[i] = &protos.HistoryEvent{
+			EventId:   -1,
+			Timestamp: now,
+			EventType: &protos.HistoryEvent_EventRaised{
+				EventRaised: &protos.EventRaisedEvent{Name: e.EventName, Input: e.EventData},
+			},
+		}
+	}
+
+	return wfe.backend.AddNewOrchestrationEvents(ctx, api.InstanceID(instanceID), hevents)
+}
+
+func (wfe *engine) DeleteWorkflowSchedule(ctx context.Context, scheduleID string) error {
+	return wfe.backend.DeleteOrchestrationSchedule(ctx, scheduleID)
+}
+
+// RewindWorkflow rewinds a failed workflow instance to its last successful
+// activity and resumes it from there.
+func (wfe *engine) RewindWorkflow(ctx context.Context, instanceID string, reason string) error {
+	return wfe.backend.RewindWorkflow(ctx, api.InstanceID(instanceID), reason)
+}
+
+// PauseWorkflowAtCheckpoint suspends a workflow instance before it schedules
+// the activity or child workflow named after the checkpoint. The instance is
+// resumed with the resume workflow API.
+func (wfe *engine) PauseWorkflowAtCheckpoint(ctx context.Context, instanceID string, checkpoint string) error {
+	return wfe.backend.SetWorkflowCheckpoint(ctx, api.InstanceID(instanceID), checkpoint)
+}
+
 func (wfe *engine) RuntimeMetadata() *runtimev1pb.MetadataWorkflows {
 	return &runtimev1pb.MetadataWorkflows{
 		ConnectedWorkers: wfe.getWorkItemsCount.Load(),
@@ -0,0 +1,483 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package joboptions parses the options of a job configured by the metadata of
+// the request scheduling it, and applies the options the scheduler stores in
+// the fields of the job.
+package joboptions
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+	"github.com/dapr/kit/cron"
+	"github.com/dapr/kit/ptr"
+)
+
+// Request metadata keys configuring the failure policy of a job.
+const (
+	MetadataKeyFailureRetries          = "failureRetries"
+	MetadataKeyFailureRetryInterval    = "failureRetryInterval"
+	MetadataKeyFailureRetryBackoff     = "failureRetryBackoff"
+	MetadataKeyFailureRetryMaxInterval = "failureRetryMaxInterval"
+	MetadataKeyFailureAction           = "failureAction"
+	MetadataKeyDeadLetterPubsub        = "deadLetterPubsub"
+	MetadataKeyDeadLetterTopic         = "deadLetterTopic"
+)
+
+// Request metadata keys configuring when the scheduler triggers a job.
+const (
+	// MetadataKeyTimeZone is the IANA time zone the cron schedule of the job,
+	// and of its blackout windows, is evaluated in. Defaults to UTC.
+	MetadataKeyTimeZone = "timeZone"
+	// MetadataKeyJitter is the maximum random delay added to each trigger.
+	MetadataKeyJitter = "jitter"
+	// MetadataKeyBlackoutWindows is a JSON array of windows during which
+	// triggers of the job are skipped. See BlackoutWindow.
+	MetadataKeyBlackoutWindows = "blackoutWindows"
+)
+
+// Backoffs between the retries of a failure policy in the request metadata.
+const (
+	BackoffConstant    = "constant"
+	BackoffExponential = "exponential"
+)
+
+// Actions taken by a failure policy once the retries are exhausted, in the
+// request metadata.
+const (
+	// ActionFail reports the failure to the scheduler, which applies the
+	// failure policy of the job definition.
+	ActionFail = "fail"
+	// ActionSkip drops the trigger.
+	ActionSkip = "skip"
+	// ActionDeadLetter publishes the trigger to a pub/sub topic.
+	ActionDeadLetter = "deadletter"
+)
+
+var (
+	backoffs = map[string]commonv1pb.JobFailurePolicyDelivery_Backoff{
+		BackoffConstant:    commonv1pb.JobFailurePolicyDelivery_CONSTANT,
+		BackoffExponential: commonv1pb.JobFailurePolicyDelivery_EXPONENTIAL,
+	}
+	actions = map[string]commonv1pb.JobFailurePolicyDelivery_Action{
+		ActionFail:       commonv1pb.JobFailurePolicyDelivery_FAIL,
+		ActionSkip:       commonv1pb.JobFailurePolicyDelivery_SKIP,
+		ActionDeadLetter: commonv1pb.JobFailurePolicyDelivery_DEAD_LETTER,
+	}
+)
+
+// Defaults of the failure policy.
+const (
+	DefaultRetryInterval    = time.Second
+	DefaultRetryMaxInterval = time.Minute
+)
+
+// scheduleParser parses the cron schedules of jobs the same way the scheduler
+// does.
+var scheduleParser = cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// Options are the options of a job.
+type Options struct {
+	// FailurePolicy is applied by daprd when the app fails to process a
+	// trigger of the job. It's stored in the failure policy of the job.
+	FailurePolicy *commonv1pb.JobFailurePolicyDelivery
+
+	// TimeZone, Jitter and BlackoutWindows are applied by the scheduler when
+	// the job triggers. They're stored in the fields of the scheduler job.
+	TimeZone        string
+	Jitter          time.Duration
+	BlackoutWindows []BlackoutWindow
+}
+
+// BlackoutWindow is a window during which the triggers of a job are skipped.
+// It is either a one-off window between Start and End, or a recurring window
+// opening at the times of the cron Schedule and lasting Duration.
+type BlackoutWindow struct {
+	Start    *time.Time
+	End      *time.Time
+	Schedule string
+	Duration time.Duration
+}
+
+// ProtoOptions is implemented by the scheduler messages holding the options
+// applied by the scheduler when a job triggers.
+type ProtoOptions interface {
+	GetTimeZone() string
+	GetJitter() *durationpb.Duration
+	GetBlackoutWindows() []*schedulerv1pb.JobBlackoutWindow
+}
+
+// blackoutWindowMetadata is the representation of a blackout window in the
+// request metadata, using duration strings.
+type blackoutWindowMetadata struct {
+	Start    *time.Time `json:"start,omitempty"`
+	End      *time.Time `json:"end,omitempty"`
+	Schedule string     `json:"schedule,omitempty"`
+	Duration string     `json:"duration,omitempty"`
+}
+
+// FromMetadata returns the options configured by the metadata of a request, or
+// nil if there are none. Keys are case-insensitive, as the keys of gRPC
+// metadata are lowercase.
+func FromMetadata(md map[string]string) (*Options, error) {
+	lower := make(map[string]string, len(md))
+	for k, v := range md {
+		lower[strings.ToLower(k)] = v
+	}
+	get := func(key string) string {
+		return lower[strings.ToLower(key)]
+	}
+
+	policy, err := failurePolicyFromMetadata(get)
+	if err != nil {
+		return nil, err
+	}
+	opts := &Options{FailurePolicy: policy}
+	if err = opts.triggerOptionsFromMetadata(get); err != nil {
+		return nil, err
+	}
+
+	if opts.FailurePolicy == nil && !opts.hasTriggerOptions() {
+		return nil, nil
+	}
+	return opts, nil
+}
+
+// FromProto returns the options applied by the scheduler held by the fields
+// of a scheduler message, or nil if there are none.
+func FromProto(m ProtoOptions) (*Options, error) {
+	opts := &Options{
+		TimeZone: m.GetTimeZone(),
+		Jitter:   m.GetJitter().AsDuration(),
+	}
+	if opts.TimeZone != "" {
+		if _, err := time.LoadLocation(opts.TimeZone); err != nil {
+			return nil, fmt.Errorf("invalid time zone '%s': %w", opts.TimeZone, err)
+		}
+	}
+	if m.GetJitter() != nil && opts.Jitter <= 0 {
+		return nil, fmt.Errorf("invalid jitter '%s': must be a positive duration", opts.Jitter)
+	}
+	for i, w := range m.GetBlackoutWindows() {
+		window := BlackoutWindow{
+			Schedule: w.GetSchedule(),
+			Duration: w.GetDuration().AsDuration(),
+		}
+		if w.GetStart() != nil {
+			window.Start = ptr.Of(w.GetStart().AsTime())
+		}
+		if w.GetEnd() != nil {
+			window.End = ptr.Of(w.GetEnd().AsTime())
+		}
+		if err := opts.validateBlackoutWindow(window); err != nil {
+			return nil, fmt.Errorf("invalid blackout window %d: %w", i, err)
+		}
+		opts.BlackoutWindows = append(opts.BlackoutWindows, window)
+	}
+
+	if !opts.hasTriggerOptions() {
+		return nil, nil
+	}
+	return opts, nil
+}
+
+// SetJobFields sets the fields of the scheduler job holding the options
+// applied by the scheduler.
+func (o *Options) SetJobFields(job *schedulerv1pb.Job) {
+	if o == nil {
+		return
+	}
+	if o.TimeZone != "" {
+		job.TimeZone = ptr.Of(o.TimeZone)
+	}
+	if o.Jitter > 0 {
+		job.Jitter = durationpb.New(o.Jitter)
+	}
+	for _, w := range o.BlackoutWindows {
+		window := new(schedulerv1pb.JobBlackoutWindow)
+		if w.Start != nil && w.End != nil {
+			window.Start = timestamppb.New(*w.Start)
+			window.End = timestamppb.New(*w.End)
+		} else {
+			window.Schedule = ptr.Of(w.Schedule)
+			window.Duration = durationpb.New(w.Duration)
+		}
+		job.BlackoutWindows = append(job.BlackoutWindows, window)
+	}
+}
+
+func (o *Options) hasTriggerOptions() bool {
+	return o.TimeZone != "" || o.Jitter != 0 || len(o.BlackoutWindows) > 0
+}
+
+func (o *Options) triggerOptionsFromMetadata(get func(key string) string) error {
+	if v := get(MetadataKeyTimeZone); v != "" {
+		if _, err := time.LoadLocation(v); err != nil {
+			return fmt.Errorf("invalid %s '%s': %w", MetadataKeyTimeZone, v, err)
+		}
+		o.TimeZone = v
+	}
+
+	if v := get(MetadataKeyJitter); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return fmt.Errorf("invalid %s '%s': must be a positive duration", MetadataKeyJitter, v)
+		}
+		o.Jitter = d
+	}
+
+	if v := get(MetadataKeyBlackoutWindows); v != "" {
+		var windows []blackoutWindowMetadata
+		if err := json.Unmarshal([]byte(v), &windows); err != nil {
+			return fmt.Errorf("invalid %s: %w", MetadataKeyBlackoutWindows, err)
+		}
+		for i, w := range windows {
+			window, err := o.blackoutWindowFromMetadata(w)
+			if err != nil {
+				return fmt.Errorf("invalid %s[%d]: %w", MetadataKeyBlackoutWindows, i, err)
+			}
+			o.BlackoutWindows = append(o.BlackoutWindows, window)
+		}
+	}
+
+	return nil
+}
+
+func (o *Options) blackoutWindowFromMetadata(w blackoutWindowMetadata) (BlackoutWindow, error) {
+	window := BlackoutWindow{Start: w.Start, End: w.End, Schedule: w.Schedule}
+	if w.Duration != "" {
+		d, err := time.ParseDuration(w.Duration)
+		if err != nil || d <= 0 {
+			return BlackoutWindow{}, fmt.Errorf("invalid duration '%s': must be a positive duration", w.Duration)
+		}
+		window.Duration = d
+	}
+	if err := o.validateBlackoutWindow(window); err != nil {
+		return BlackoutWindow{}, err
+	}
+	return window, nil
+}
+
+func (o *Options) validateBlackoutWindow(w BlackoutWindow) error {
+	switch {
+	case w.Start != nil || w.End != nil:
+		if w.Start == nil || w.End == nil || w.Schedule != "" || w.Duration != 0 {
+			return errors.New("a one-off window must have only a start and an end")
+		}
+		if !w.End.After(*w.Start) {
+			return errors.New("end must be after start")
+		}
+		return nil
+
+	case w.Schedule != "":
+		if w.Duration <= 0 {
+			return errors.New("a recurring window must have a positive duration")
+		}
+		if _, err := scheduleParser.Parse(o.CronSchedule(w.Schedule)); err != nil {
+			return fmt.Errorf("invalid schedule '%s': %w", w.Schedule, err)
+		}
+		return nil
+
+	default:
+		return errors.New("a window must have either a start and an end, or a schedule and a duration")
+	}
+}
+
+func failurePolicyFromMetadata(get func(key string) string) (*commonv1pb.JobFailurePolicyDelivery, error) {
+	var found bool
+	for _, key := range []string{
+		MetadataKeyFailureRetries, MetadataKeyFailureRetryInterval, MetadataKeyFailureRetryBackoff,
+		MetadataKeyFailureRetryMaxInterval, MetadataKeyFailureAction, MetadataKeyDeadLetterPubsub, MetadataKeyDeadLetterTopic,
+	} {
+		if get(key) != "" {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, nil
+	}
+
+	policy := &commonv1pb.JobFailurePolicyDelivery{
+		Interval:         durationpb.New(DefaultRetryInterval),
+		MaxInterval:      durationpb.New(DefaultRetryMaxInterval),
+		DeadLetterPubsub: get(MetadataKeyDeadLetterPubsub),
+		DeadLetterTopic:  get(MetadataKeyDeadLetterTopic),
+	}
+	if v := get(MetadataKeyFailureRetries); v != "" {
+		retries, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s '%s': must be a non-negative integer", MetadataKeyFailureRetries, v)
+		}
+		policy.MaxRetries = uint32(retries)
+	}
+	for key, dst := range map[string]**durationpb.Duration{
+		MetadataKeyFailureRetryInterval:    &policy.Interval,
+		MetadataKeyFailureRetryMaxInterval: &policy.MaxInterval,
+	} {
+		if v := get(key); v != "" {
+			d, err := time.ParseDuration(v)
+			if err != nil || d <= 0 {
+				return nil, fmt.Errorf("invalid %s '%s': must be a positive duration", key, v)
+			}
+			*dst = durationpb.New(d)
+		}
+	}
+	if v := get(MetadataKeyFailureRetryBackoff); v != "" {
+		backoff, ok := backoffs[v]
+		if !ok {
+			return nil, fmt.Errorf("invalid %s '%s': must be '%s' or '%s'", MetadataKeyFailureRetryBackoff, v, BackoffConstant, BackoffExponential)
+		}
+		policy.Backoff = backoff
+	}
+	if v := get(MetadataKeyFailureAction); v != "" {
+		action, ok := actions[v]
+		if !ok {
+			return nil, fmt.Errorf("invalid %s '%s': must be '%s', '%s' or '%s'", MetadataKeyFailureAction, v, ActionFail, ActionSkip, ActionDeadLetter)
+		}
+		policy.Action = action
+	}
+	if policy.GetMaxInterval().AsDuration() < policy.GetInterval().AsDuration() {
+		policy.MaxInterval = policy.GetInterval()
+	}
+	if err := ValidateFailurePolicy(policy); err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// ValidateFailurePolicy returns an error if the failure policy applied by
+// daprd is invalid.
+func ValidateFailurePolicy(policy *commonv1pb.JobFailurePolicyDelivery) error {
+	if policy == nil {
+		return nil
+	}
+	if policy.GetInterval() != nil && policy.GetInterval().AsDuration() <= 0 {
+		return errors.New("invalid failure policy: the interval must be a positive duration")
+	}
+	if policy.GetMaxInterval() != nil && policy.GetMaxInterval().AsDuration() <= 0 {
+		return errors.New("invalid failure policy: the max interval must be a positive duration")
+	}
+	if _, ok := commonv1pb.JobFailurePolicyDelivery_Backoff_name[int32(policy.GetBackoff())]; !ok {
+		return fmt.Errorf("invalid failure policy: unknown backoff %d", policy.GetBackoff())
+	}
+	if _, ok := commonv1pb.JobFailurePolicyDelivery_Action_name[int32(policy.GetAction())]; !ok {
+		return fmt.Errorf("invalid failure policy: unknown action %d", policy.GetAction())
+	}
+	isDeadLetter := policy.GetAction() == commonv1pb.JobFailurePolicyDelivery_DEAD_LETTER
+	if isDeadLetter != (policy.GetDeadLetterPubsub() != "" && policy.GetDeadLetterTopic() != "") {
+		return fmt.Errorf("%s and %s are required by, and only allowed with, the '%s' %s", MetadataKeyDeadLetterPubsub, MetadataKeyDeadLetterTopic, ActionDeadLetter, MetadataKeyFailureAction)
+	}
+	return nil
+}
+
+// RetryDelay returns the delay before the retry of the failure policy with
+// the given number, starting at 1.
+func RetryDelay(policy *commonv1pb.JobFailurePolicyDelivery, retry int) time.Duration {
+	interval, maxInterval := DefaultRetryInterval, DefaultRetryMaxInterval
+	if policy.GetInterval() != nil {
+		interval = policy.GetInterval().AsDuration()
+	}
+	if policy.GetMaxInterval() != nil {
+		maxInterval = policy.GetMaxInterval().AsDuration()
+	}
+	if policy.GetBackoff() != commonv1pb.JobFailurePolicyDelivery_EXPONENTIAL || retry <= 1 {
+		return interval
+	}
+	delay := interval
+	for range retry - 1 {
+		delay *= 2
+		if delay >= maxInterval {
+			return max(maxInterval, interval)
+		}
+	}
+	return delay
+}
+
+// GetFailurePolicy returns the failure policy of the options, if any.
+func (o *Options) GetFailurePolicy() *commonv1pb.JobFailurePolicyDelivery {
+	if o == nil {
+		return nil
+	}
+	return o.FailurePolicy
+}
+
+// CronSchedule returns the cron schedule which evaluates the given schedule in
+// the time zone of the job.
+func (o *Options) CronSchedule(schedule string) string {
+	if o == nil || o.TimeZone == "" || hasTimeZone(schedule) {
+		return schedule
+	}
+	return "CRON_TZ=" + o.TimeZone + " " + schedule
+}
+
+// UserSchedule reverses CronSchedule, returning the schedule of the job as it
+// was defined.
+func (o *Options) UserSchedule(schedule string) string {
+	if o == nil || o.TimeZone == "" {
+		return schedule
+	}
+	return strings.TrimPrefix(schedule, "CRON_TZ="+o.TimeZone+" ")
+}
+
+func hasTimeZone(schedule string) bool {
+	return strings.HasPrefix(schedule, "TZ=") || strings.HasPrefix(schedule, "CRON_TZ=")
+}
+
+// JitterDelay returns a random delay to add to a trigger of the job, up to its
+// jitter.
+func (o *Options) JitterDelay() time.Duration {
+	if o == nil || o.Jitter <= 0 {
+		return 0
+	}
+	//nolint:gosec
+	return rand.N(o.Jitter)
+}
+
+// InBlackout returns whether the given time falls in one of the blackout
+// windows of the job.
+func (o *Options) InBlackout(t time.Time) bool {
+	if o == nil {
+		return false
+	}
+	for _, w := range o.BlackoutWindows {
+		if w.Start != nil && w.End != nil {
+			if !t.Before(*w.Start) && t.Before(*w.End) {
+				return true
+			}
+			continue
+		}
+
+		schedule, err := scheduleParser.Parse(o.CronSchedule(w.Schedule))
+		if err != nil {
+			continue
+		}
+		// The window is open if it opened within the last Duration.
+		if !schedule.Next(t.Add(-w.Duration)).After(t) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,279 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package joboptions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+	"github.com/dapr/kit/ptr"
+)
+
+func TestFromMetadata(t *testing.T) {
+	t.Run("no options", func(t *testing.T) {
+		opts, err := FromMetadata(map[string]string{"foo": "bar"})
+		require.NoError(t, err)
+		assert.Nil(t, opts)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		opts, err := FromMetadata(map[string]string{MetadataKeyFailureRetries: "3"})
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(&commonv1pb.JobFailurePolicyDelivery{
+			MaxRetries:  3,
+			Interval:    durationpb.New(DefaultRetryInterval),
+			Backoff:     commonv1pb.JobFailurePolicyDelivery_CONSTANT,
+			MaxInterval: durationpb.New(DefaultRetryMaxInterval),
+			Action:      commonv1pb.JobFailurePolicyDelivery_FAIL,
+		}, opts.GetFailurePolicy()))
+	})
+
+	t.Run("case-insensitive keys", func(t *testing.T) {
+		opts, err := FromMetadata(map[string]string{
+			"failureretries":       "2",
+			"failureretryinterval": "5s",
+			"failureretrybackoff":  BackoffExponential,
+			"failureaction":        ActionDeadLetter,
+			"deadletterpubsub":     "mypubsub",
+			"deadlettertopic":      "failed-jobs",
+		})
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(&commonv1pb.JobFailurePolicyDelivery{
+			MaxRetries:       2,
+			Interval:         durationpb.New(5 * time.Second),
+			Backoff:          commonv1pb.JobFailurePolicyDelivery_EXPONENTIAL,
+			MaxInterval:      durationpb.New(DefaultRetryMaxInterval),
+			Action:           commonv1pb.JobFailurePolicyDelivery_DEAD_LETTER,
+			DeadLetterPubsub: "mypubsub",
+			DeadLetterTopic:  "failed-jobs",
+		}, opts.GetFailurePolicy()))
+	})
+
+	t.Run("max interval is at least the interval", func(t *testing.T) {
+		opts, err := FromMetadata(map[string]string{
+			MetadataKeyFailureRetryInterval:    "10s",
+			MetadataKeyFailureRetryMaxInterval: "1s",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 10*time.Second, opts.GetFailurePolicy().GetMaxInterval().AsDuration())
+	})
+
+	for name, md := range map[string]map[string]string{
+		"negative retries":           {MetadataKeyFailureRetries: "-1"},
+		"invalid retries":            {MetadataKeyFailureRetries: "many"},
+		"invalid interval":           {MetadataKeyFailureRetryInterval: "soon"},
+		"zero max interval":          {MetadataKeyFailureRetryMaxInterval: "0s"},
+		"invalid backoff":            {MetadataKeyFailureRetryBackoff: "linear"},
+		"invalid action":             {MetadataKeyFailureAction: "retry"},
+		"dead letter missing":        {MetadataKeyFailureAction: ActionDeadLetter, MetadataKeyDeadLetterPubsub: "mypubsub"},
+		"dead letter without action": {MetadataKeyDeadLetterPubsub: "mypubsub", MetadataKeyDeadLetterTopic: "failed-jobs"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := FromMetadata(md)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestValidateFailurePolicy(t *testing.T) {
+	require.NoError(t, ValidateFailurePolicy(nil))
+	require.NoError(t, ValidateFailurePolicy(&commonv1pb.JobFailurePolicyDelivery{MaxRetries: 3}))
+
+	for name, policy := range map[string]*commonv1pb.JobFailurePolicyDelivery{
+		"zero interval":      {Interval: durationpb.New(0)},
+		"negative interval":  {MaxInterval: durationpb.New(-time.Second)},
+		"unknown backoff":    {Backoff: 5},
+		"unknown action":     {Action: 5},
+		"dead letter topic":  {DeadLetterPubsub: "mypubsub", DeadLetterTopic: "failed-jobs"},
+		"dead letter pubsub": {Action: commonv1pb.JobFailurePolicyDelivery_DEAD_LETTER, DeadLetterTopic: "failed-jobs"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Error(t, ValidateFailurePolicy(policy))
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		p := &commonv1pb.JobFailurePolicyDelivery{Backoff: commonv1pb.JobFailurePolicyDelivery_EXPONENTIAL}
+		assert.Equal(t, DefaultRetryInterval, RetryDelay(p, 1))
+		assert.Equal(t, DefaultRetryMaxInterval, RetryDelay(p, 100))
+	})
+
+	t.Run("constant", func(t *testing.T) {
+		p := &commonv1pb.JobFailurePolicyDelivery{Interval: durationpb.New(time.Second), MaxInterval: durationpb.New(time.Minute)}
+		for retry := 1; retry <= 5; retry++ {
+			assert.Equal(t, time.Second, RetryDelay(p, retry))
+		}
+	})
+
+	t.Run("exponential", func(t *testing.T) {
+		p := &commonv1pb.JobFailurePolicyDelivery{
+			Interval:    durationpb.New(time.Second),
+			Backoff:     commonv1pb.JobFailurePolicyDelivery_EXPONENTIAL,
+			MaxInterval: durationpb.New(5 * time.Second),
+		}
+		assert.Equal(t, time.Second, RetryDelay(p, 1))
+		assert.Equal(t, 2*time.Second, RetryDelay(p, 2))
+		assert.Equal(t, 4*time.Second, RetryDelay(p, 3))
+		assert.Equal(t, 5*time.Second, RetryDelay(p, 4))
+		assert.Equal(t, 5*time.Second, RetryDelay(p, 100))
+	})
+}
+
+func TestFromProto(t *testing.T) {
+	t.Run("without options", func(t *testing.T) {
+		opts, err := FromProto(new(schedulerv1pb.Job))
+		require.NoError(t, err)
+		assert.Nil(t, opts)
+	})
+
+	t.Run("the options set in the job fields are read back", func(t *testing.T) {
+		start := time.Date(2026, 12, 24, 0, 0, 0, 0, time.UTC)
+		end := start.Add(72 * time.Hour)
+		opts := &Options{
+			FailurePolicy: &commonv1pb.JobFailurePolicyDelivery{MaxRetries: 3},
+			TimeZone:      "Europe/Madrid",
+			Jitter:        time.Second,
+			BlackoutWindows: []BlackoutWindow{
+				{Start: &start, End: &end},
+				{Schedule: "0 0 2 * * SAT", Duration: 4 * time.Hour},
+			},
+		}
+
+		job := new(schedulerv1pb.Job)
+		opts.SetJobFields(job)
+		assert.Equal(t, "Europe/Madrid", job.GetTimeZone())
+		assert.Nil(t, job.GetFailurePolicy())
+
+		got, err := FromProto(job)
+		require.NoError(t, err)
+		opts.FailurePolicy = nil
+		assert.Equal(t, opts, got)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for name, job := range map[string]*schedulerv1pb.Job{
+			"time zone": {TimeZone: ptr.Of("Mars/Olympus")},
+			"jitter":    {Jitter: durationpb.New(-time.Second)},
+			"blackout window": {BlackoutWindows: []*schedulerv1pb.JobBlackoutWindow{
+				{Schedule: ptr.Of("0 0 2 * * SAT")},
+			}},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := FromProto(job)
+				require.Error(t, err)
+			})
+		}
+	})
+}
+
+func TestTriggerOptionsFromMetadata(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		opts, err := FromMetadata(map[string]string{
+			MetadataKeyTimeZone: "Europe/Madrid",
+			MetadataKeyJitter:   "30s",
+			MetadataKeyBlackoutWindows: `[
+				{"start": "2026-12-24T00:00:00Z", "end": "2026-12-27T00:00:00Z"},
+				{"schedule": "0 0 2 * * SAT", "duration": "4h"}
+			]`,
+		})
+		require.NoError(t, err)
+		assert.Nil(t, opts.GetFailurePolicy())
+		assert.Equal(t, "Europe/Madrid", opts.TimeZone)
+		assert.Equal(t, 30*time.Second, opts.Jitter)
+		require.Len(t, opts.BlackoutWindows, 2)
+		assert.Equal(t, time.Date(2026, time.December, 24, 0, 0, 0, 0, time.UTC), opts.BlackoutWindows[0].Start.UTC())
+		assert.Equal(t, BlackoutWindow{Schedule: "0 0 2 * * SAT", Duration: 4 * time.Hour}, opts.BlackoutWindows[1])
+	})
+
+	for name, md := range map[string]map[string]string{
+		"unknown time zone":     {MetadataKeyTimeZone: "Mars/Olympus_Mons"},
+		"invalid jitter":        {MetadataKeyJitter: "a bit"},
+		"negative jitter":       {MetadataKeyJitter: "-1s"},
+		"invalid windows":       {MetadataKeyBlackoutWindows: "tonight"},
+		"empty window":          {MetadataKeyBlackoutWindows: `[{}]`},
+		"window without end":    {MetadataKeyBlackoutWindows: `[{"start": "2026-12-24T00:00:00Z"}]`},
+		"window ending early":   {MetadataKeyBlackoutWindows: `[{"start": "2026-12-24T00:00:00Z", "end": "2026-12-23T00:00:00Z"}]`},
+		"mixed window":          {MetadataKeyBlackoutWindows: `[{"start": "2026-12-24T00:00:00Z", "end": "2026-12-27T00:00:00Z", "duration": "1h"}]`},
+		"window without length": {MetadataKeyBlackoutWindows: `[{"schedule": "@daily"}]`},
+		"window invalid cron":   {MetadataKeyBlackoutWindows: `[{"schedule": "every day", "duration": "1h"}]`},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := FromMetadata(md)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestSchedule(t *testing.T) {
+	var noOpts *Options
+	assert.Equal(t, "@daily", noOpts.CronSchedule("@daily"))
+	assert.Equal(t, "@daily", noOpts.UserSchedule("@daily"))
+
+	opts := &Options{TimeZone: "Asia/Tokyo"}
+	assert.Equal(t, "CRON_TZ=Asia/Tokyo 0 0 9 * * *", opts.CronSchedule("0 0 9 * * *"))
+	assert.Equal(t, "0 0 9 * * *", opts.UserSchedule(opts.CronSchedule("0 0 9 * * *")))
+	assert.Equal(t, "TZ=UTC @daily", opts.CronSchedule("TZ=UTC @daily"))
+	assert.Equal(t, "TZ=UTC @daily", opts.UserSchedule("TZ=UTC @daily"))
+}
+
+func TestJitterDelay(t *testing.T) {
+	var noOpts *Options
+	assert.Zero(t, noOpts.JitterDelay())
+	assert.Zero(t, (&Options{}).JitterDelay())
+
+	opts := &Options{Jitter: time.Second}
+	for range 100 {
+		d := opts.JitterDelay()
+		assert.GreaterOrEqual(t, d, time.Duration(0))
+		assert.Less(t, d, time.Second)
+	}
+}
+
+func TestInBlackout(t *testing.T) {
+	var noOpts *Options
+	assert.False(t, noOpts.InBlackout(time.Now()))
+
+	t.Run("one-off window", func(t *testing.T) {
+		start := time.Date(2026, time.December, 24, 0, 0, 0, 0, time.UTC)
+		end := start.Add(72 * time.Hour)
+		opts := &Options{BlackoutWindows: []BlackoutWindow{{Start: &start, End: &end}}}
+		assert.False(t, opts.InBlackout(start.Add(-time.Second)))
+		assert.True(t, opts.InBlackout(start))
+		assert.True(t, opts.InBlackout(start.Add(time.Hour)))
+		assert.False(t, opts.InBlackout(end))
+	})
+
+	t.Run("recurring window in the job time zone", func(t *testing.T) {
+		// Saturdays from 02:00 to 06:00 in Madrid, which is UTC+1 in January.
+		opts := &Options{
+			TimeZone:        "Europe/Madrid",
+			BlackoutWindows: []BlackoutWindow{{Schedule: "0 0 2 * * SAT", Duration: 4 * time.Hour}},
+		}
+		saturday := time.Date(2026, time.January, 17, 0, 0, 0, 0, time.UTC)
+		assert.False(t, opts.InBlackout(saturday.Add(30*time.Minute)))
+		assert.True(t, opts.InBlackout(saturday.Add(time.Hour)))
+		assert.True(t, opts.InBlackout(saturday.Add(4*time.Hour+59*time.Minute)))
+		assert.False(t, opts.InBlackout(saturday.Add(5*time.Hour)))
+		assert.False(t, opts.InBlackout(saturday.Add(25*time.Hour)))
+	})
+}
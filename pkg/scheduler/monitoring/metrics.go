@@ -49,12 +49,23 @@ var (
 		"scheduler/jobs_undelivered_total",
 		"The total number of undelivered jobs.",
 		stats.UnitDimensionless)
+	jobsSkippedTotal = stats.Int64(
+		"scheduler/jobs_skipped_total",
+		"The total number of job triggers skipped during a blackout window.",
+		stats.UnitDimensionless)
+	quotaExceededTotal = stats.Int64(
+		"scheduler/quota_exceeded_total",
+		"The total number of jobs and job triggers rejected for exceeding a quota.",
+		stats.UnitDimensionless)
 	triggerLatency = stats.Float64(
 		"scheduler/trigger_latency",
 		"The total time it takes to trigger a job from the scheduler service.",
 		stats.UnitMilliseconds)
 
-	tagType = tag.MustNewKey("type")
+	tagType      = tag.MustNewKey("type")
+	tagQuota     = tag.MustNewKey("quota")
+	tagNamespace = tag.MustNewKey("namespace")
+	tagAppID     = tag.MustNewKey("app_id")
 )
 
 var tagSidecarsConnected = utils.WithTags(sidecarsConnectedGauge.Name())
@@ -169,6 +180,38 @@ func RecordJobsUndeliveredCount(jobMetadata *schedulerv1pb.JobMetadata) {
 	stats.RecordWithTags(context.Background(), tag, jobsUndeliveredTotal.M(1))
 }
 
+var (
+	tagSkippedJob     = utils.WithTags(jobsSkippedTotal.Name(), tagType, "job")
+	tagSkippedActor   = utils.WithTags(jobsSkippedTotal.Name(), tagType, "actor")
+	tagSkippedUnknown = utils.WithTags(jobsSkippedTotal.Name(), tagType, "unknown")
+)
+
+// RecordJobsSkippedCount records the total number of job triggers skipped
+// during a blackout window
+func RecordJobsSkippedCount(jobMetadata *schedulerv1pb.JobMetadata) {
+	var tag []tag.Mutator
+	switch jobMetadata.GetTarget().GetType().(type) {
+	case *schedulerv1pb.JobTargetMetadata_Job:
+		tag = tagSkippedJob
+	case *schedulerv1pb.JobTargetMetadata_Actor:
+		tag = tagSkippedActor
+	default:
+		tag = tagSkippedUnknown
+	}
+
+	stats.RecordWithTags(context.Background(), tag, jobsSkippedTotal.M(1))
+}
+
+// RecordQuotaExceededCount records a job or job trigger of an app rejected for
+// exceeding the given quota
+func RecordQuotaExceededCount(jobMetadata *schedulerv1pb.JobMetadata, quota string) {
+	stats.RecordWithTags(
+		context.Background(),
+		utils.WithTags(quotaExceededTotal.Name(), tagQuota, quota, tagNamespace, jobMetadata.GetNamespace(), tagAppID, jobMetadata.GetAppId()),
+		quotaExceededTotal.M(1),
+	)
+}
+
 // InitMetrics initialize the scheduler service metrics.
 func InitMetrics() error {
 	err := view.Register(
@@ -178,6 +221,8 @@ func InitMetrics() error {
 		utils.NewMeasureView(triggerLatency, []tag.Key{tagType}, view.Distribution(0, 100, 500, 1000, 5000, 10000)),
 		utils.NewMeasureView(jobsFailedTotal, []tag.Key{tagType}, view.Count()),
 		utils.NewMeasureView(jobsUndeliveredTotal, []tag.Key{tagType}, view.Count()),
+		utils.NewMeasureView(jobsSkippedTotal, []tag.Key{tagType}, view.Count()),
+		utils.NewMeasureView(quotaExceededTotal, []tag.Key{tagQuota, tagNamespace, tagAppID}, view.Count()),
 	)
 
 	return err
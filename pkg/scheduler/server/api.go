@@ -17,18 +17,24 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
 	apierrors "github.com/diagridio/go-etcd-cron/api/errors"
 
 	"github.com/diagridio/go-etcd-cron/api"
+	clientv3 "go.etcd.io/etcd/client/v3"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
 	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+	"github.com/dapr/dapr/pkg/scheduler/joboptions"
 	"github.com/dapr/dapr/pkg/scheduler/monitoring"
+	schedcron "github.com/dapr/dapr/pkg/scheduler/server/internal/cron"
 	"github.com/dapr/dapr/pkg/scheduler/server/internal/serialize"
+	"github.com/dapr/kit/ptr"
 )
 
 func (s *Server) ScheduleJob(ctx context.Context, req *schedulerv1pb.ScheduleJobRequest) (*schedulerv1pb.ScheduleJobResponse, error) {
@@ -44,20 +50,57 @@ func (s *Server) ScheduleJob(ctx context.Context, req *schedulerv1pb.ScheduleJob
 
 	job := req.GetJob()
 
+	opts, err := joboptions.FromProto(job)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	meta, err := serialized.StoredMetadata(job)
+	if err != nil {
+		return nil, err
+	}
+
 	//nolint:protogetter
 	apiJob := &api.Job{
-		Schedule:      job.Schedule,
+		Schedule:      cronSchedule(job.Schedule, opts),
 		DueTime:       job.DueTime,
 		Ttl:           job.Ttl,
 		Repeats:       job.Repeats,
-		Metadata:      serialized.Metadata(),
+		Metadata:      meta,
 		Payload:       job.GetData(),
 		FailurePolicy: schedFPToCron(job.FailurePolicy),
 	}
 
-	if req.GetOverwrite() {
+	if s.quota.LimitsJobs(req.GetMetadata()) {
+		var release func()
+		release, err = s.checkJobsQuota(ctx, cron, serialized.Name(), req)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+	}
+
+	client, err := s.etcd.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	paused, err := getPausedJob(ctx, client, serialized.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case paused != nil && !req.GetOverwrite():
+		return nil, status.Errorf(codes.AlreadyExists, "job already exists and is paused: %s", req.GetName())
+	case paused != nil:
+		if err = deletePausedJobs(ctx, client, serialized.Name(), false); err != nil {
+			return nil, err
+		}
+		err = cron.Add(ctx, serialized.Name(), apiJob)
+	case req.GetOverwrite():
 		err = cron.Add(ctx, serialized.Name(), apiJob)
-	} else {
+	default:
 		err = cron.AddIfNotExists(ctx, serialized.Name(), apiJob)
 	}
 
@@ -92,6 +135,16 @@ func (s *Server) DeleteJob(ctx context.Context, req *schedulerv1pb.DeleteJobRequ
 		return nil, err
 	}
 
+	client, err := s.etcd.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = deletePausedJobs(ctx, client, job.Name(), false); err != nil {
+		log.Errorf("error deleting paused job %s: %s", job.Name(), err)
+		return nil, err
+	}
+
 	return &schedulerv1pb.DeleteJobResponse{}, nil
 }
 
@@ -112,20 +165,27 @@ func (s *Server) GetJob(ctx context.Context, req *schedulerv1pb.GetJobRequest) (
 		return nil, err
 	}
 
+	var paused bool
 	if job == nil {
-		return nil, status.Error(codes.NotFound, "job not found: "+req.GetName())
+		client, err := s.etcd.Client(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		job, err = getPausedJob(ctx, client, serialized.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		if job == nil {
+			return nil, status.Error(codes.NotFound, "job not found: "+req.GetName())
+		}
+		paused = true
 	}
 
 	return &schedulerv1pb.GetJobResponse{
-		//nolint:protogetter
-		Job: &schedulerv1pb.Job{
-			Schedule:      job.Schedule,
-			DueTime:       job.DueTime,
-			Ttl:           job.Ttl,
-			Repeats:       job.Repeats,
-			Data:          job.GetPayload(),
-			FailurePolicy: cronFPToSched(job.FailurePolicy),
-		},
+		Paused: paused,
+		Job:    schedJob(job),
 	}, nil
 }
 
@@ -145,26 +205,35 @@ func (s *Server) ListJobs(ctx context.Context, req *schedulerv1pb.ListJobsReques
 		return nil, fmt.Errorf("failed to query job list: %w", err)
 	}
 
-	jobs := make([]*schedulerv1pb.NamedJob, 0, len(list.GetJobs()))
+	client, err := s.etcd.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	paused, err := listPausedJobs(ctx, client, prefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query paused job list: %w", err)
+	}
+
+	named := make(map[string]*api.Job, len(list.GetJobs())+len(paused))
 	for _, job := range list.GetJobs() {
-		meta, err := serialize.MetadataFromKey(job.GetName())
+		named[job.GetName()] = job.GetJob()
+	}
+	maps.Copy(named, paused)
+
+	jobs := make([]*schedulerv1pb.NamedJob, 0, len(named))
+	for _, name := range slices.Sorted(maps.Keys(named)) {
+		meta, err := serialize.MetadataFromKey(name)
 		if err != nil {
 			return nil, fmt.Errorf("failed to parse job metadata: %w", err)
 		}
 
-		j := job.GetJob()
+		_, isPaused := paused[name]
 		jobs = append(jobs, &schedulerv1pb.NamedJob{
-			Name:     job.GetName()[strings.LastIndex(job.GetName(), "||")+2:],
+			Name:     name[strings.LastIndex(name, "||")+2:],
 			Metadata: meta,
-			//nolint:protogetter
-			Job: &schedulerv1pb.Job{
-				Schedule:      j.Schedule,
-				DueTime:       j.DueTime,
-				Ttl:           j.Ttl,
-				Repeats:       j.Repeats,
-				Data:          j.GetPayload(),
-				FailurePolicy: cronFPToSched(j.FailurePolicy),
-			},
+			Paused:   isPaused,
+			Job:      schedJob(named[name]),
 		})
 	}
 
@@ -221,6 +290,16 @@ func (s *Server) DeleteByMetadata(ctx context.Context, req *schedulerv1pb.Delete
 		return nil, err
 	}
 
+	client, err := s.etcd.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = deletePausedJobs(ctx, client, prefix, true); err != nil {
+		log.Errorf("Failed to delete paused jobs for metadata: %s", err)
+		return nil, err
+	}
+
 	return new(schedulerv1pb.DeleteByMetadataResponse), nil
 }
 
@@ -245,9 +324,113 @@ func (s *Server) DeleteByNamePrefix(ctx context.Context, req *schedulerv1pb.Dele
 		return nil, err
 	}
 
+	client, err := s.etcd.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = deletePausedJobs(ctx, client, prefix, true); err != nil {
+		log.Errorf("Failed to delete paused scheduler jobs for metadata: %s", err)
+		return nil, err
+	}
+
 	return new(schedulerv1pb.DeleteByNamePrefixResponse), nil
 }
 
+// checkJobsQuota returns an error if scheduling the job would exceed the
+// quota of jobs of its app or namespace. Overwriting an existing job doesn't
+// count as a new job. The returned function must be called once the job has
+// been scheduled.
+func (s *Server) checkJobsQuota(ctx context.Context, cron api.Interface, name string, req *schedulerv1pb.ScheduleJobRequest) (func(), error) {
+	if req.GetOverwrite() {
+		existing, err := cron.Get(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return func() {}, nil
+		}
+	}
+
+	client, err := s.etcd.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetOverwrite() {
+		paused, err := getPausedJob(ctx, client, name)
+		if err != nil {
+			return nil, err
+		}
+		if paused != nil {
+			return func() {}, nil
+		}
+	}
+
+	release, err := s.quota.CheckJobs(ctx, req.GetMetadata(), func(ctx context.Context, prefix string) (int64, error) {
+		resp, err := client.Get(ctx, schedcron.JobsKeyPrefix+prefix, clientv3.WithPrefix(), clientv3.WithCountOnly())
+		if err != nil {
+			return 0, err
+		}
+		pausedResp, err := client.Get(ctx, schedcron.PausedJobsKeyPrefix+prefix, clientv3.WithPrefix(), clientv3.WithCountOnly())
+		if err != nil {
+			return 0, err
+		}
+		return resp.Count + pausedResp.Count, nil
+	})
+	if err != nil {
+		log.Warnf("Rejecting job %s: %s", req.GetName(), err)
+	}
+	return release, err
+}
+
+// cronSchedule returns the schedule of a job evaluated in the time zone of the
+// job, if it has one.
+func cronSchedule(schedule *string, opts *joboptions.Options) *string {
+	if schedule == nil {
+		return nil
+	}
+	return ptr.Of(opts.CronSchedule(*schedule))
+}
+
+// schedJob returns a job of the cron library as it was defined, with the
+// options stored in the metadata of the job. The schedule is returned without
+// the time zone added by cronSchedule.
+func schedJob(job *api.Job) *schedulerv1pb.Job {
+	//nolint:protogetter
+	res := &schedulerv1pb.Job{
+		Schedule:      job.Schedule,
+		DueTime:       job.DueTime,
+		Ttl:           job.Ttl,
+		Repeats:       job.Repeats,
+		Data:          job.GetPayload(),
+		FailurePolicy: cronFPToSched(job.GetFailurePolicy()),
+	}
+
+	stored, err := serialize.StoredMetadataFromCron(job.GetMetadata())
+	if err != nil {
+		return res
+	}
+
+	if stored.GetDeliveryPolicy() != nil {
+		if res.FailurePolicy == nil {
+			res.FailurePolicy = new(commonv1pb.JobFailurePolicy)
+		}
+		res.FailurePolicy.Delivery = stored.GetDeliveryPolicy()
+	}
+
+	//nolint:protogetter
+	res.TimeZone = stored.TimeZone
+	res.Jitter = stored.GetJitter()
+	res.BlackoutWindows = stored.GetBlackoutWindows()
+	if res.Schedule != nil && res.TimeZone != nil { //nolint:protogetter
+		opts := &joboptions.Options{TimeZone: res.GetTimeZone()}
+		res.Schedule = ptr.Of(opts.UserSchedule(res.GetSchedule()))
+	}
+
+	return res
+}
+
 //nolint:protogetter
 func schedFPToCron(fp *commonv1pb.JobFailurePolicy) *api.FailurePolicy {
 	if fp == nil {
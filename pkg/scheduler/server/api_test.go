@@ -19,9 +19,16 @@ import (
 
 	"github.com/diagridio/go-etcd-cron/api"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+	"github.com/dapr/dapr/pkg/scheduler/joboptions"
 	"github.com/dapr/kit/ptr"
 )
 
@@ -228,3 +235,52 @@ func Test_cronFPToSched(t *testing.T) {
 		})
 	}
 }
+
+func Test_cronSchedule(t *testing.T) {
+	opts := &joboptions.Options{TimeZone: "Europe/Madrid"}
+	assert.Nil(t, cronSchedule(nil, opts))
+	assert.Equal(t, "@daily", *cronSchedule(ptr.Of("@daily"), nil))
+	assert.Equal(t, "CRON_TZ=Europe/Madrid @daily", *cronSchedule(ptr.Of("@daily"), opts))
+}
+
+func Test_schedJob(t *testing.T) {
+	data, err := anypb.New(wrapperspb.String("hello"))
+	require.NoError(t, err)
+
+	t.Run("without options", func(t *testing.T) {
+		meta, err := anypb.New(new(schedulerv1pb.JobMetadata))
+		require.NoError(t, err)
+
+		job := schedJob(&api.Job{Schedule: ptr.Of("@daily"), Metadata: meta, Payload: data})
+		assert.Equal(t, "@daily", job.GetSchedule())
+		assert.Same(t, data, job.GetData())
+		assert.Nil(t, job.GetFailurePolicy())
+		assert.Nil(t, job.TimeZone) //nolint:protogetter
+	})
+
+	t.Run("with the options stored in the metadata", func(t *testing.T) {
+		policy := &commonv1pb.JobFailurePolicyDelivery{MaxRetries: 3}
+		meta, err := anypb.New(&internalsv1pb.StoredJobMetadata{
+			Metadata:       new(schedulerv1pb.JobMetadata),
+			DeliveryPolicy: policy,
+			TimeZone:       ptr.Of("Europe/Madrid"),
+			Jitter:         durationpb.New(time.Second),
+		})
+		require.NoError(t, err)
+
+		job := schedJob(&api.Job{
+			Schedule: ptr.Of("CRON_TZ=Europe/Madrid @daily"),
+			Metadata: meta,
+			Payload:  data,
+			FailurePolicy: &api.FailurePolicy{
+				Policy: &api.FailurePolicy_Drop{Drop: new(api.FailurePolicyDrop)},
+			},
+		})
+		assert.Equal(t, "@daily", job.GetSchedule())
+		assert.Same(t, data, job.GetData())
+		assert.NotNil(t, job.GetFailurePolicy().GetDrop())
+		assert.True(t, proto.Equal(policy, job.GetFailurePolicy().GetDelivery()))
+		assert.Equal(t, "Europe/Madrid", job.GetTimeZone())
+		assert.Equal(t, time.Second, job.GetJitter().AsDuration())
+	})
+}
@@ -37,6 +37,8 @@ type Fake struct {
 	watchJobsFn          func(schedulerv1pb.Scheduler_WatchJobsServer) error
 	deleteByMetadataFn   func(ctx context.Context, req *schedulerv1pb.DeleteByMetadataRequest) (*schedulerv1pb.DeleteByMetadataResponse, error)
 	deleteByNamePrefixFn func(ctx context.Context, req *schedulerv1pb.DeleteByNamePrefixRequest) (*schedulerv1pb.DeleteByNamePrefixResponse, error)
+	pauseJobFn           func(ctx context.Context, req *schedulerv1pb.PauseJobRequest) (*schedulerv1pb.PauseJobResponse, error)
+	resumeJobFn          func(ctx context.Context, req *schedulerv1pb.ResumeJobRequest) (*schedulerv1pb.ResumeJobResponse, error)
 }
 
 func New(t *testing.T) *Fake {
@@ -70,6 +72,12 @@ func New(t *testing.T) *Fake {
 		deleteByNamePrefixFn: func(ctx context.Context, req *schedulerv1pb.DeleteByNamePrefixRequest) (*schedulerv1pb.DeleteByNamePrefixResponse, error) {
 			return nil, nil
 		},
+		pauseJobFn: func(ctx context.Context, req *schedulerv1pb.PauseJobRequest) (*schedulerv1pb.PauseJobResponse, error) {
+			return nil, nil
+		},
+		resumeJobFn: func(ctx context.Context, req *schedulerv1pb.ResumeJobRequest) (*schedulerv1pb.ResumeJobResponse, error) {
+			return nil, nil
+		},
 	}
 
 	server := grpc.NewServer()
@@ -143,6 +151,16 @@ func (f *Fake) WithDeleteByNamePrefix(fn func(ctx context.Context, req *schedule
 	return f
 }
 
+func (f *Fake) WithPauseJob(fn func(ctx context.Context, req *schedulerv1pb.PauseJobRequest) (*schedulerv1pb.PauseJobResponse, error)) *Fake {
+	f.pauseJobFn = fn
+	return f
+}
+
+func (f *Fake) WithResumeJob(fn func(ctx context.Context, req *schedulerv1pb.ResumeJobRequest) (*schedulerv1pb.ResumeJobResponse, error)) *Fake {
+	f.resumeJobFn = fn
+	return f
+}
+
 func (f *Fake) ScheduleJob(ctx context.Context, req *schedulerv1pb.ScheduleJobRequest) (*schedulerv1pb.ScheduleJobResponse, error) {
 	return f.scheduleJobFn(ctx, req)
 }
@@ -174,3 +192,11 @@ func (f *Fake) DeleteByMetadata(ctx context.Context, req *schedulerv1pb.DeleteBy
 func (f *Fake) DeleteByNamePrefix(ctx context.Context, req *schedulerv1pb.DeleteByNamePrefixRequest) (*schedulerv1pb.DeleteByNamePrefixResponse, error) {
 	return f.deleteByNamePrefixFn(ctx, req)
 }
+
+func (f *Fake) PauseJob(ctx context.Context, req *schedulerv1pb.PauseJobRequest) (*schedulerv1pb.PauseJobResponse, error) {
+	return f.pauseJobFn(ctx, req)
+}
+
+func (f *Fake) ResumeJob(ctx context.Context, req *schedulerv1pb.ResumeJobRequest) (*schedulerv1pb.ResumeJobResponse, error) {
+	return f.resumeJobFn(ctx, req)
+}
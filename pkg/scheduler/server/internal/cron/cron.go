@@ -29,9 +29,12 @@ import (
 	"github.com/dapr/dapr/pkg/healthz"
 	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+	"github.com/dapr/dapr/pkg/scheduler/joboptions"
 	"github.com/dapr/dapr/pkg/scheduler/monitoring"
 	"github.com/dapr/dapr/pkg/scheduler/server/internal/etcd"
 	"github.com/dapr/dapr/pkg/scheduler/server/internal/pool"
+	"github.com/dapr/dapr/pkg/scheduler/server/internal/quota"
+	"github.com/dapr/dapr/pkg/scheduler/server/internal/serialize"
 	"github.com/dapr/kit/concurrency"
 	"github.com/dapr/kit/events/broadcaster"
 	"github.com/dapr/kit/logger"
@@ -40,12 +43,26 @@ import (
 
 var log = logger.NewLogger("dapr.scheduler.server.cron")
 
+// namespace is the namespace of the keys of the cron library in Etcd.
+const namespace = "dapr"
+
+// JobsKeyPrefix is the prefix of the Etcd keys of the jobs, followed by the
+// name of the job.
+const JobsKeyPrefix = namespace + "/jobs/"
+
+// PausedJobsKeyPrefix is the prefix of the Etcd keys of the paused jobs,
+// followed by the name of the job. Paused jobs are removed from the cron
+// library, so they aren't triggered, and kept by the scheduler under these
+// keys until they are resumed.
+const PausedJobsKeyPrefix = namespace + "/pausedjobs/"
+
 type Options struct {
 	ID      string
 	Host    *schedulerv1pb.Host
 	Healthz healthz.Healthz
 	Etcd    etcd.Interface
 	Workers uint32
+	Quota   *quota.Quota
 }
 
 // Interface manages the cron framework, exposing a client to schedule jobs.
@@ -75,6 +92,7 @@ type cron struct {
 	currHosts       []*schedulerv1pb.Host
 	etcd            etcd.Interface
 	workers         uint32
+	quota           *quota.Quota
 
 	readyCh chan struct{}
 	closeCh chan struct{}
@@ -89,6 +107,7 @@ func New(opts Options) Interface {
 		readyCh:         make(chan struct{}),
 		closeCh:         make(chan struct{}),
 		etcd:            opts.Etcd,
+		quota:           opts.Quota,
 	}
 }
 
@@ -109,7 +128,7 @@ func (c *cron) Run(ctx context.Context) error {
 
 	c.etcdcron, err = etcdcron.New(etcdcron.Options{
 		Client:          client,
-		Namespace:       "dapr",
+		Namespace:       namespace,
 		ID:              c.id,
 		TriggerFn:       c.triggerHandler,
 		ReplicaData:     hostAny,
@@ -234,12 +253,13 @@ func (c *cron) HostsWatch(stream schedulerv1pb.Scheduler_WatchHostsServer) error
 func (c *cron) triggerHandler(req *api.TriggerRequest, fn func(*api.TriggerResponse)) {
 	log.Debugf("Triggering job: %s", req.GetName())
 
-	var meta schedulerv1pb.JobMetadata
-	if err := req.GetMetadata().UnmarshalTo(&meta); err != nil {
+	stored, err := serialize.StoredMetadataFromCron(req.GetMetadata())
+	if err != nil {
 		log.Errorf("Error unmarshalling metadata: %s", err)
 		fn(&api.TriggerResponse{Result: api.TriggerResponseResult_UNDELIVERABLE})
 		return
 	}
+	meta := stored.GetMetadata()
 
 	idx := strings.LastIndex(req.GetName(), "||")
 	if idx == -1 || len(req.GetName()) <= idx+2 {
@@ -248,12 +268,48 @@ func (c *cron) triggerHandler(req *api.TriggerRequest, fn func(*api.TriggerRespo
 		return
 	}
 
-	c.connectionPool.Trigger(&internalsv1pb.JobEvent{
-		Key:      req.GetName(),
-		Name:     req.GetName()[idx+2:],
-		Data:     req.GetPayload(),
-		Metadata: &meta,
-	}, c.respHandler(req.GetName(), &meta, fn))
+	// Options are validated when the job is scheduled.
+	opts, _ := joboptions.FromProto(stored)
+	if opts.InBlackout(time.Now()) {
+		log.Debugf("Skipping trigger of job %s in a blackout window", req.GetName())
+		monitoring.RecordJobsSkippedCount(meta)
+		fn(&api.TriggerResponse{Result: api.TriggerResponseResult_SUCCESS})
+		return
+	}
+
+	trigger := func() {
+		c.connectionPool.Trigger(&internalsv1pb.JobEvent{
+			Key:            req.GetName(),
+			Name:           req.GetName()[idx+2:],
+			Data:           req.GetPayload(),
+			Metadata:       meta,
+			DeliveryPolicy: stored.GetDeliveryPolicy(),
+		}, c.respHandler(req.GetName(), meta, fn))
+	}
+
+	// Triggers exceeding the trigger rate quota are deferred rather than
+	// failed, so they don't use up the retries of the failure policy.
+	delay := opts.JitterDelay()
+	if quotaDelay := c.quota.TriggerDelay(meta); quotaDelay > delay {
+		log.Debugf("Deferring trigger of job %s by %s to not exceed the trigger rate quota", req.GetName(), quotaDelay)
+		delay = quotaDelay
+	}
+	if delay <= 0 {
+		trigger()
+		return
+	}
+
+	log.Debugf("Delaying trigger of job %s by %s", req.GetName(), delay)
+	go func() {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+			trigger()
+		case <-c.closeCh:
+			fn(&api.TriggerResponse{Result: api.TriggerResponseResult_UNDELIVERABLE})
+		}
+	}()
 }
 
 func (c *cron) respHandler(name string, meta *schedulerv1pb.JobMetadata, fn func(*api.TriggerResponse)) func(api.TriggerResponseResult) {
@@ -118,10 +118,11 @@ func (s *stream) handleTriggerRequest(req *loops.TriggerRequest) {
 	s.inflight.Store(s.triggerIDx, req.ResultFn)
 
 	job := &schedulerv1pb.WatchJobsResponse{
-		Name:     req.Job.GetName(),
-		Id:       s.triggerIDx,
-		Data:     req.Job.GetData(),
-		Metadata: req.Job.GetMetadata(),
+		Name:           req.Job.GetName(),
+		Id:             s.triggerIDx,
+		Data:           req.Job.GetData(),
+		Metadata:       req.Job.GetMetadata(),
+		DeliveryPolicy: req.Job.GetDeliveryPolicy(),
 	}
 
 	if err := s.channel.Send(job); err != nil {
@@ -0,0 +1,207 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+	"github.com/dapr/dapr/pkg/scheduler/monitoring"
+	"github.com/dapr/dapr/pkg/scheduler/server/internal/serialize"
+)
+
+// Names of the quotas, as reported in metrics.
+const (
+	JobsPerApp              = "jobs_per_app"
+	JobsPerNamespace        = "jobs_per_namespace"
+	TriggerRatePerApp       = "trigger_rate_per_app"
+	TriggerRatePerNamespace = "trigger_rate_per_namespace"
+)
+
+type Options struct {
+	// MaxJobsPerApp and MaxJobsPerNamespace are the maximum number of jobs an
+	// app, or all the apps of a namespace, can have. 0 is unlimited.
+	MaxJobsPerApp       uint32
+	MaxJobsPerNamespace uint32
+
+	// MaxTriggerRatePerApp and MaxTriggerRatePerNamespace are the maximum
+	// number of triggers per second of the jobs of an app, or of all the apps
+	// of a namespace. 0 is unlimited.
+	MaxTriggerRatePerApp       float64
+	MaxTriggerRatePerNamespace float64
+}
+
+// CountFn returns the number of jobs with the given key prefix. It should
+// count the keys without reading the jobs.
+type CountFn func(ctx context.Context, prefix string) (int64, error)
+
+// Quota enforces the quotas of the apps and namespaces. Quotas only apply to
+// the jobs of the jobs API, and not to actor reminders which are managed by
+// the runtime. Trigger rates are enforced by each scheduler instance for the
+// jobs it triggers.
+type Quota struct {
+	maxJobsPerApp       int64
+	maxJobsPerNamespace int64
+
+	triggerRatePerApp       rate.Limit
+	triggerRatePerNamespace rate.Limit
+
+	lock              sync.Mutex
+	appLimiters       map[string]*rate.Limiter
+	namespaceLimiters map[string]*rate.Limiter
+	namespaceLocks    map[string]*namespaceLock
+}
+
+// namespaceLock serializes the scheduling of the new jobs of a namespace, so
+// concurrent requests can't all pass the check of the quota.
+type namespaceLock struct {
+	sync.Mutex
+	refs int
+}
+
+func New(opts Options) *Quota {
+	return &Quota{
+		maxJobsPerApp:           int64(opts.MaxJobsPerApp),
+		maxJobsPerNamespace:     int64(opts.MaxJobsPerNamespace),
+		triggerRatePerApp:       rate.Limit(opts.MaxTriggerRatePerApp),
+		triggerRatePerNamespace: rate.Limit(opts.MaxTriggerRatePerNamespace),
+		appLimiters:             make(map[string]*rate.Limiter),
+		namespaceLimiters:       make(map[string]*rate.Limiter),
+		namespaceLocks:          make(map[string]*namespaceLock),
+	}
+}
+
+// LimitsJobs returns whether the number of jobs is limited for the job with
+// the given metadata.
+func (q *Quota) LimitsJobs(meta *schedulerv1pb.JobMetadata) bool {
+	return q != nil && meta.GetTarget().GetJob() != nil &&
+		(q.maxJobsPerApp > 0 || q.maxJobsPerNamespace > 0)
+}
+
+// CheckJobs returns a ResourceExhausted error if a new job with the given
+// metadata would exceed the maximum number of jobs of its app or namespace.
+// Otherwise, the new jobs of the namespace can't be checked until the returned
+// function is called, once the job has been scheduled. Jobs scheduled
+// concurrently through other scheduler instances may still exceed the quota.
+func (q *Quota) CheckJobs(ctx context.Context, meta *schedulerv1pb.JobMetadata, count CountFn) (func(), error) {
+	if !q.LimitsJobs(meta) {
+		return func() {}, nil
+	}
+
+	release := q.lockNamespace(meta.GetNamespace())
+	if err := q.checkJobs(ctx, meta, count); err != nil {
+		release()
+		return nil, err
+	}
+	return release, nil
+}
+
+func (q *Quota) checkJobs(ctx context.Context, meta *schedulerv1pb.JobMetadata, count CountFn) error {
+	for _, limit := range []struct {
+		quota  string
+		max    int64
+		prefix string
+		owner  string
+	}{
+		{JobsPerApp, q.maxJobsPerApp, serialize.AppJobsPrefix(meta.GetNamespace(), meta.GetAppId()), "app " + meta.GetAppId()},
+		{JobsPerNamespace, q.maxJobsPerNamespace, serialize.AppJobsPrefix(meta.GetNamespace(), ""), "namespace " + meta.GetNamespace()},
+	} {
+		if limit.max == 0 {
+			continue
+		}
+
+		n, err := count(ctx, limit.prefix)
+		if err != nil {
+			return fmt.Errorf("failed to count jobs: %w", err)
+		}
+		if n >= limit.max {
+			monitoring.RecordQuotaExceededCount(meta, limit.quota)
+			return status.Errorf(codes.ResourceExhausted, "quota exceeded: %s has reached the maximum of %d jobs", limit.owner, limit.max)
+		}
+	}
+
+	return nil
+}
+
+// TriggerDelay reserves a trigger of the job with the given metadata, and
+// returns how long the trigger must be deferred to not exceed the trigger rate
+// of its app or namespace.
+func (q *Quota) TriggerDelay(meta *schedulerv1pb.JobMetadata) time.Duration {
+	if q == nil || meta.GetTarget().GetJob() == nil {
+		return 0
+	}
+
+	var delay time.Duration
+	if q.triggerRatePerApp > 0 {
+		key := meta.GetNamespace() + "||" + meta.GetAppId()
+		if d := q.limiter(q.appLimiters, key, q.triggerRatePerApp).Reserve().Delay(); d > 0 {
+			monitoring.RecordQuotaExceededCount(meta, TriggerRatePerApp)
+			delay = d
+		}
+	}
+
+	if q.triggerRatePerNamespace > 0 {
+		if d := q.limiter(q.namespaceLimiters, meta.GetNamespace(), q.triggerRatePerNamespace).Reserve().Delay(); d > 0 {
+			monitoring.RecordQuotaExceededCount(meta, TriggerRatePerNamespace)
+			delay = max(delay, d)
+		}
+	}
+
+	return delay
+}
+
+// lockNamespace locks the scheduling of the new jobs of the namespace, and
+// returns the function to unlock it.
+func (q *Quota) lockNamespace(namespace string) func() {
+	q.lock.Lock()
+	l, ok := q.namespaceLocks[namespace]
+	if !ok {
+		l = new(namespaceLock)
+		q.namespaceLocks[namespace] = l
+	}
+	l.refs++
+	q.lock.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+
+		q.lock.Lock()
+		defer q.lock.Unlock()
+		if l.refs--; l.refs == 0 {
+			delete(q.namespaceLocks, namespace)
+		}
+	}
+}
+
+func (q *Quota) limiter(limiters map[string]*rate.Limiter, key string, limit rate.Limit) *rate.Limiter {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	l, ok := limiters[key]
+	if !ok {
+		// Allow bursts of up to a second worth of triggers.
+		l = rate.NewLimiter(limit, int(math.Max(1, math.Ceil(float64(limit)))))
+		limiters[key] = l
+	}
+	return l
+}
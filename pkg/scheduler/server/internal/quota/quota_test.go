@@ -0,0 +1,205 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+)
+
+func jobMetadata(namespace, appID string) *schedulerv1pb.JobMetadata {
+	return &schedulerv1pb.JobMetadata{
+		Namespace: namespace,
+		AppId:     appID,
+		Target: &schedulerv1pb.JobTargetMetadata{
+			Type: &schedulerv1pb.JobTargetMetadata_Job{Job: new(schedulerv1pb.TargetJob)},
+		},
+	}
+}
+
+func reminderMetadata(namespace, appID string) *schedulerv1pb.JobMetadata {
+	return &schedulerv1pb.JobMetadata{
+		Namespace: namespace,
+		AppId:     appID,
+		Target: &schedulerv1pb.JobTargetMetadata{
+			Type: &schedulerv1pb.JobTargetMetadata_Actor{Actor: &schedulerv1pb.TargetActorReminder{Type: "myactor", Id: "1"}},
+		},
+	}
+}
+
+func TestCheckJobs(t *testing.T) {
+	counts := map[string]int64{
+		"app||default||app1||": 2,
+		"app||default||app2||": 1,
+		"app||default||":       3,
+	}
+	count := func(_ context.Context, prefix string) (int64, error) {
+		return counts[prefix], nil
+	}
+
+	t.Run("no quota", func(t *testing.T) {
+		var q *Quota
+		assert.False(t, q.LimitsJobs(jobMetadata("default", "app1")))
+		requireAllowed(t, q, jobMetadata("default", "app1"), count)
+
+		q = New(Options{})
+		assert.False(t, q.LimitsJobs(jobMetadata("default", "app1")))
+		requireAllowed(t, q, jobMetadata("default", "app1"), count)
+	})
+
+	t.Run("per app", func(t *testing.T) {
+		q := New(Options{MaxJobsPerApp: 2})
+		assert.True(t, q.LimitsJobs(jobMetadata("default", "app1")))
+
+		_, err := q.CheckJobs(t.Context(), jobMetadata("default", "app1"), count)
+		require.Error(t, err)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.Contains(t, err.Error(), "app app1")
+
+		requireAllowed(t, q, jobMetadata("default", "app2"), count)
+	})
+
+	t.Run("per namespace", func(t *testing.T) {
+		q := New(Options{MaxJobsPerApp: 10, MaxJobsPerNamespace: 3})
+		_, err := q.CheckJobs(t.Context(), jobMetadata("default", "app2"), count)
+		require.Error(t, err)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.Contains(t, err.Error(), "namespace default")
+
+		requireAllowed(t, q, jobMetadata("other", "app2"), count)
+	})
+
+	t.Run("actor reminders are not limited", func(t *testing.T) {
+		q := New(Options{MaxJobsPerApp: 1})
+		assert.False(t, q.LimitsJobs(reminderMetadata("default", "app1")))
+		requireAllowed(t, q, reminderMetadata("default", "app1"), count)
+	})
+
+	t.Run("count error", func(t *testing.T) {
+		q := New(Options{MaxJobsPerApp: 1})
+		_, err := q.CheckJobs(t.Context(), jobMetadata("default", "app1"), func(context.Context, string) (int64, error) {
+			return 0, errors.New("etcd unavailable")
+		})
+		require.ErrorContains(t, err, "etcd unavailable")
+	})
+}
+
+func requireAllowed(t *testing.T, q *Quota, meta *schedulerv1pb.JobMetadata, count CountFn) {
+	t.Helper()
+	release, err := q.CheckJobs(t.Context(), meta, count)
+	require.NoError(t, err)
+	release()
+}
+
+func TestCheckJobsSerialized(t *testing.T) {
+	q := New(Options{MaxJobsPerNamespace: 1})
+
+	var jobs atomic.Int64
+	count := func(context.Context, string) (int64, error) {
+		return jobs.Load(), nil
+	}
+
+	release, err := q.CheckJobs(t.Context(), jobMetadata("default", "app1"), count)
+	require.NoError(t, err)
+
+	// Jobs of other namespaces aren't blocked.
+	requireAllowed(t, q, jobMetadata("other", "app1"), count)
+
+	checked := make(chan error, 1)
+	go func() {
+		release, err := q.CheckJobs(t.Context(), jobMetadata("default", "app2"), count)
+		if err == nil {
+			release()
+		}
+		checked <- err
+	}()
+
+	select {
+	case err = <-checked:
+		require.Fail(t, "check not blocked until the job is scheduled", "error: %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	jobs.Add(1)
+	release()
+
+	select {
+	case err = <-checked:
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "check not unblocked")
+	}
+
+	assert.Empty(t, q.namespaceLocks)
+}
+
+func TestTriggerDelay(t *testing.T) {
+	t.Run("no quota", func(t *testing.T) {
+		var q *Quota
+		assert.Zero(t, q.TriggerDelay(jobMetadata("default", "app1")))
+
+		q = New(Options{})
+		for range 100 {
+			assert.Zero(t, q.TriggerDelay(jobMetadata("default", "app1")))
+		}
+	})
+
+	t.Run("per app", func(t *testing.T) {
+		q := New(Options{MaxTriggerRatePerApp: 0.001})
+		assert.Zero(t, q.TriggerDelay(jobMetadata("default", "app1")))
+		assert.Greater(t, q.TriggerDelay(jobMetadata("default", "app1")), 100*time.Second)
+		assert.Zero(t, q.TriggerDelay(jobMetadata("default", "app2")))
+		assert.Zero(t, q.TriggerDelay(jobMetadata("other", "app1")))
+	})
+
+	t.Run("per app allows bursts of a second", func(t *testing.T) {
+		q := New(Options{MaxTriggerRatePerApp: 3})
+		for range 3 {
+			assert.Zero(t, q.TriggerDelay(jobMetadata("default", "app1")))
+		}
+		assert.Positive(t, q.TriggerDelay(jobMetadata("default", "app1")))
+	})
+
+	t.Run("deferred triggers are spread out", func(t *testing.T) {
+		q := New(Options{MaxTriggerRatePerApp: 1})
+		assert.Zero(t, q.TriggerDelay(jobMetadata("default", "app1")))
+		first := q.TriggerDelay(jobMetadata("default", "app1"))
+		second := q.TriggerDelay(jobMetadata("default", "app1"))
+		assert.InDelta(t, time.Second, second-first, float64(100*time.Millisecond))
+	})
+
+	t.Run("per namespace", func(t *testing.T) {
+		q := New(Options{MaxTriggerRatePerNamespace: 0.001})
+		assert.Zero(t, q.TriggerDelay(jobMetadata("default", "app1")))
+		assert.Positive(t, q.TriggerDelay(jobMetadata("default", "app2")))
+		assert.Zero(t, q.TriggerDelay(jobMetadata("other", "app1")))
+	})
+
+	t.Run("actor reminders are not limited", func(t *testing.T) {
+		q := New(Options{MaxTriggerRatePerApp: 0.001})
+		for range 10 {
+			assert.Zero(t, q.TriggerDelay(reminderMetadata("default", "app1")))
+		}
+	})
+}
@@ -30,6 +30,15 @@ func PrefixesFromNamespace(namespace string) []string {
 	}
 }
 
+// AppJobsPrefix returns the key prefix of the app jobs of the given app, or of
+// all the apps in the namespace if appID is empty.
+func AppJobsPrefix(namespace, appID string) string {
+	if len(appID) == 0 {
+		return "app||" + namespace + "||"
+	}
+	return "app||" + namespace + "||" + appID + "||"
+}
+
 // MetadataFromKey returns the JobMetadata based on a raw job key.
 func MetadataFromKey(key string) (*schedulerv1pb.JobMetadata, error) {
 	seg := strings.Split(key, "||")
@@ -136,3 +136,10 @@ func Test_MetadataFromKey(t *testing.T) {
 		})
 	}
 }
+
+func Test_AppJobsPrefix(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "app||default||myapp||", AppJobsPrefix("default", "myapp"))
+	assert.Equal(t, "app||default||", AppJobsPrefix("default", ""))
+}
@@ -21,6 +21,7 @@ import (
 
 	"google.golang.org/protobuf/types/known/anypb"
 
+	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
 	"github.com/dapr/dapr/pkg/scheduler/server/internal/authz"
 	"github.com/dapr/dapr/pkg/security"
@@ -41,7 +42,7 @@ type Request interface {
 
 type Job struct {
 	name string
-	meta *anypb.Any
+	meta *schedulerv1pb.JobMetadata
 }
 
 func New(opts Options) *Serializer {
@@ -57,18 +58,13 @@ func (s *Serializer) FromRequest(ctx context.Context, req Request) (*Job, error)
 		return nil, err
 	}
 
-	meta, err := anypb.New(req.GetMetadata())
-	if err != nil {
-		return nil, err
-	}
-
 	name, err := buildJobName(req)
 	if err != nil {
 		return nil, err
 	}
 
 	return &Job{
-		meta: meta,
+		meta: req.GetMetadata(),
 		name: name,
 	}, nil
 }
@@ -129,8 +125,35 @@ func (j *Job) Name() string {
 	return j.name
 }
 
-func (j *Job) Metadata() *anypb.Any {
-	return j.meta
+// StoredMetadata returns the metadata to store with the job in the cron
+// library, holding the options of the job which the cron library has no
+// fields for.
+func (j *Job) StoredMetadata(job *schedulerv1pb.Job) (*anypb.Any, error) {
+	return anypb.New(&internalsv1pb.StoredJobMetadata{
+		Metadata:        j.meta,
+		DeliveryPolicy:  job.GetFailurePolicy().GetDelivery(),
+		TimeZone:        job.TimeZone, //nolint:protogetter
+		Jitter:          job.GetJitter(),
+		BlackoutWindows: job.GetBlackoutWindows(),
+	})
+}
+
+// StoredMetadataFromCron returns the metadata stored with a job in the cron
+// library. Jobs scheduled by older versions only store the job metadata.
+func StoredMetadataFromCron(meta *anypb.Any) (*internalsv1pb.StoredJobMetadata, error) {
+	var stored internalsv1pb.StoredJobMetadata
+	if meta.MessageIs(&stored) {
+		if err := meta.UnmarshalTo(&stored); err != nil {
+			return nil, err
+		}
+		return &stored, nil
+	}
+
+	var jobMeta schedulerv1pb.JobMetadata
+	if err := meta.UnmarshalTo(&jobMeta); err != nil {
+		return nil, err
+	}
+	return &internalsv1pb.StoredJobMetadata{Metadata: &jobMeta}, nil
 }
 
 func buildJobName(req Request) (string, error) {
@@ -15,10 +15,17 @@ package serialize
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
 
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
 	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+	"github.com/dapr/kit/ptr"
 )
 
 func Test_buildJobName(t *testing.T) {
@@ -103,3 +110,51 @@ func Test_buildJobName(t *testing.T) {
 		})
 	}
 }
+
+func Test_StoredMetadata(t *testing.T) {
+	t.Parallel()
+
+	meta := &schedulerv1pb.JobMetadata{
+		AppId:     "myapp",
+		Namespace: "default",
+		Target: &schedulerv1pb.JobTargetMetadata{
+			Type: &schedulerv1pb.JobTargetMetadata_Job{Job: new(schedulerv1pb.TargetJob)},
+		},
+	}
+
+	t.Run("with the options of the job", func(t *testing.T) {
+		t.Parallel()
+
+		policy := &commonv1pb.JobFailurePolicyDelivery{MaxRetries: 3}
+		windows := []*schedulerv1pb.JobBlackoutWindow{
+			{Schedule: ptr.Of("0 0 2 * * SAT"), Duration: durationpb.New(time.Hour)},
+		}
+		stored, err := (&Job{meta: meta}).StoredMetadata(&schedulerv1pb.Job{
+			FailurePolicy:   &commonv1pb.JobFailurePolicy{Delivery: policy},
+			TimeZone:        ptr.Of("Europe/Madrid"),
+			Jitter:          durationpb.New(time.Second),
+			BlackoutWindows: windows,
+		})
+		require.NoError(t, err)
+
+		got, err := StoredMetadataFromCron(stored)
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(meta, got.GetMetadata()))
+		assert.True(t, proto.Equal(policy, got.GetDeliveryPolicy()))
+		assert.Equal(t, "Europe/Madrid", got.GetTimeZone())
+		assert.Equal(t, time.Second, got.GetJitter().AsDuration())
+		assert.True(t, proto.Equal(windows[0], got.GetBlackoutWindows()[0]))
+	})
+
+	t.Run("job metadata of older versions", func(t *testing.T) {
+		t.Parallel()
+
+		stored, err := anypb.New(meta)
+		require.NoError(t, err)
+
+		got, err := StoredMetadataFromCron(stored)
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(meta, got.GetMetadata()))
+		assert.Nil(t, got.GetDeliveryPolicy())
+	})
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/diagridio/go-etcd-cron/api"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+	schedcron "github.com/dapr/dapr/pkg/scheduler/server/internal/cron"
+)
+
+// PauseJob removes the job from the cron library, so it isn't triggered, and
+// keeps it under the paused jobs prefix until it's resumed.
+func (s *Server) PauseJob(ctx context.Context, req *schedulerv1pb.PauseJobRequest) (*schedulerv1pb.PauseJobResponse, error) {
+	cron, err := s.cron.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := s.etcd.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	serialized, err := s.serializer.FromRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := cron.Get(ctx, serialized.Name())
+	if err != nil {
+		log.Errorf("error getting job %s: %s", serialized.Name(), err)
+		return nil, err
+	}
+
+	if job == nil {
+		paused, err := getPausedJob(ctx, client, serialized.Name())
+		if err != nil {
+			return nil, err
+		}
+		if paused != nil {
+			return nil, status.Error(codes.FailedPrecondition, "job is already paused: "+req.GetName())
+		}
+		return nil, status.Error(codes.NotFound, "job not found: "+req.GetName())
+	}
+
+	b, err := proto.Marshal(job)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = client.Put(ctx, schedcron.PausedJobsKeyPrefix+serialized.Name(), string(b)); err != nil {
+		log.Errorf("error pausing job %s: %s", serialized.Name(), err)
+		return nil, err
+	}
+
+	if err = cron.Delete(ctx, serialized.Name()); err != nil {
+		log.Errorf("error pausing job %s: %s", serialized.Name(), err)
+		return nil, err
+	}
+
+	return new(schedulerv1pb.PauseJobResponse), nil
+}
+
+// ResumeJob adds a paused job back to the cron library. The due time and TTL
+// of the job, when relative, are evaluated from the time it is resumed.
+func (s *Server) ResumeJob(ctx context.Context, req *schedulerv1pb.ResumeJobRequest) (*schedulerv1pb.ResumeJobResponse, error) {
+	cron, err := s.cron.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := s.etcd.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	serialized, err := s.serializer.FromRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := getPausedJob(ctx, client, serialized.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	if job == nil {
+		return nil, status.Error(codes.NotFound, "paused job not found: "+req.GetName())
+	}
+
+	if err = cron.Add(ctx, serialized.Name(), job); err != nil {
+		log.Errorf("error resuming job %s: %s", serialized.Name(), err)
+		return nil, err
+	}
+
+	if err = deletePausedJobs(ctx, client, serialized.Name(), false); err != nil {
+		log.Errorf("error resuming job %s: %s", serialized.Name(), err)
+		return nil, err
+	}
+
+	return new(schedulerv1pb.ResumeJobResponse), nil
+}
+
+// getPausedJob returns the paused job with the given name, or nil if there is
+// no such paused job.
+func getPausedJob(ctx context.Context, client *clientv3.Client, name string) (*api.Job, error) {
+	resp, err := client.Get(ctx, schedcron.PausedJobsKeyPrefix+name)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Kvs) == 0 {
+		return nil, nil
+	}
+
+	var job api.Job
+	if err = proto.Unmarshal(resp.Kvs[0].Value, &job); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal paused job %s: %w", name, err)
+	}
+
+	return &job, nil
+}
+
+// listPausedJobs returns the paused jobs whose names start with the prefix,
+// by name.
+func listPausedJobs(ctx context.Context, client *clientv3.Client, prefix string) (map[string]*api.Job, error) {
+	resp, err := client.Get(ctx, schedcron.PausedJobsKeyPrefix+prefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make(map[string]*api.Job, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		name := string(kv.Key)[len(schedcron.PausedJobsKeyPrefix):]
+		var job api.Job
+		if err = proto.Unmarshal(kv.Value, &job); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal paused job %s: %w", name, err)
+		}
+		jobs[name] = &job
+	}
+
+	return jobs, nil
+}
+
+// deletePausedJobs deletes the paused job with the given name or, if isPrefix
+// is true, the paused jobs whose names start with it.
+func deletePausedJobs(ctx context.Context, client *clientv3.Client, name string, isPrefix bool) error {
+	var opts []clientv3.OpOption
+	if isPrefix {
+		opts = append(opts, clientv3.WithPrefix())
+	}
+	_, err := client.Delete(ctx, schedcron.PausedJobsKeyPrefix+name, opts...)
+	return err
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/dapr/dapr/pkg/scheduler/server/internal/controller"
 	"github.com/dapr/dapr/pkg/scheduler/server/internal/cron"
 	"github.com/dapr/dapr/pkg/scheduler/server/internal/etcd"
+	"github.com/dapr/dapr/pkg/scheduler/server/internal/quota"
 	"github.com/dapr/dapr/pkg/scheduler/server/internal/serialize"
 	"github.com/dapr/dapr/pkg/security"
 	"github.com/dapr/dapr/utils"
@@ -52,6 +53,11 @@ type Options struct {
 
 	Workers uint32
 
+	MaxJobsPerApp              uint32
+	MaxJobsPerNamespace        uint32
+	MaxTriggerRatePerApp       float64
+	MaxTriggerRatePerNamespace float64
+
 	EtcdEmbed                      bool
 	EtcdDataDir                    string
 	EtcdName                       string
@@ -83,6 +89,7 @@ type Server struct {
 	sec        security.Handler
 	serializer *serialize.Serializer
 	cron       cron.Interface
+	quota      *quota.Quota
 	etcd       etcd.Interface
 	controller concurrency.Runner
 
@@ -138,12 +145,20 @@ func New(opts Options) (*Server, error) {
 		return nil, err
 	}
 
+	quota := quota.New(quota.Options{
+		MaxJobsPerApp:              opts.MaxJobsPerApp,
+		MaxJobsPerNamespace:        opts.MaxJobsPerNamespace,
+		MaxTriggerRatePerApp:       opts.MaxTriggerRatePerApp,
+		MaxTriggerRatePerNamespace: opts.MaxTriggerRatePerNamespace,
+	})
+
 	cron := cron.New(cron.Options{
 		ID:      opts.EtcdName,
 		Healthz: opts.Healthz,
 		Host:    &schedulerv1pb.Host{Address: broadcastAddr},
 		Etcd:    etcd,
 		Workers: opts.Workers,
+		Quota:   quota,
 	})
 
 	var ctrl concurrency.Runner
@@ -165,6 +180,7 @@ func New(opts Options) (*Server, error) {
 		sec:           opts.Security,
 		controller:    ctrl,
 		cron:          cron,
+		quota:         quota,
 		etcd:          etcd,
 		serializer: serialize.New(serialize.Options{
 			Security: opts.Security,
@@ -0,0 +1,164 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package apitoken holds the API tokens used to authenticate requests
+// between the app and Dapr, which can be rotated without restarting Dapr.
+package apitoken
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+
+	"github.com/dapr/kit/fswatcher"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.security.apitoken")
+
+// Tokens is a set of accepted API tokens.
+// The first token is the current one, which is used to authenticate outgoing requests.
+// A nil Tokens has no tokens, and authentication is disabled.
+type Tokens struct {
+	path   string
+	tokens atomic.Pointer[[]string]
+}
+
+// Static returns the given tokens, ignoring the empty ones.
+func Static(tokens ...string) *Tokens {
+	t := &Tokens{}
+	t.set(tokens)
+	return t
+}
+
+// FromEnv returns the tokens set in the environment variable, one per line.
+// If the "<envVar>_FILE" environment variable is set, the tokens are read from
+// that file instead, and are reloaded when the file changes.
+func FromEnv(envVar string) (*Tokens, error) {
+	path, ok := os.LookupEnv(envVar + "_FILE")
+	if !ok {
+		return Static(strings.Split(os.Getenv(envVar), "\n")...), nil
+	}
+	if path == "" {
+		return nil, fmt.Errorf("environment variable %s_FILE is set with an empty value", envVar)
+	}
+
+	t := &Tokens{path: path}
+	if err := t.load(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (t *Tokens) load() error {
+	b, err := os.ReadFile(t.path)
+	if err != nil {
+		return fmt.Errorf("failed to read API tokens from '%s': %w", t.path, err)
+	}
+	t.set(strings.Split(string(b), "\n"))
+	return nil
+}
+
+func (t *Tokens) set(tokens []string) {
+	list := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		if token = strings.TrimSpace(token); token != "" {
+			list = append(list, token)
+		}
+	}
+	t.tokens.Store(&list)
+}
+
+func (t *Tokens) list() []string {
+	if t == nil {
+		return nil
+	}
+	if l := t.tokens.Load(); l != nil {
+		return *l
+	}
+	return nil
+}
+
+// Enabled returns true if requests must be authenticated.
+// Tokens read from a file are always enabled, so an empty file does not
+// disable authentication while rotating the tokens.
+func (t *Tokens) Enabled() bool {
+	return t != nil && (t.path != "" || len(t.list()) > 0)
+}
+
+// Current returns the token used to authenticate outgoing requests, if any.
+func (t *Tokens) Current() string {
+	l := t.list()
+	if len(l) == 0 {
+		return ""
+	}
+	return l[0]
+}
+
+// Valid returns true if the token is one of the accepted tokens.
+func (t *Tokens) Valid(token string) bool {
+	if token == "" {
+		return false
+	}
+	var valid bool
+	for _, accepted := range t.list() {
+		// Compare all tokens in constant time
+		if subtle.ConstantTimeCompare([]byte(accepted), []byte(token)) == 1 {
+			valid = true
+		}
+	}
+	return valid
+}
+
+// Run reloads the tokens from the file when it changes, until the context is canceled.
+func (t *Tokens) Run(ctx context.Context) error {
+	if t == nil || t.path == "" {
+		<-ctx.Done()
+		return nil
+	}
+
+	// Watch the folder rather than the file, as files in Kubernetes volumes are replaced through symlinks
+	fs, err := fswatcher.New(fswatcher.Options{
+		Targets: []string{filepath.Dir(t.path)},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to watch API tokens file '%s': %w", t.path, err)
+	}
+
+	eventCh := make(chan struct{})
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- fs.Run(ctx, eventCh)
+	}()
+
+	for {
+		select {
+		case err := <-errCh:
+			if errors.Is(err, context.Canceled) {
+				return nil
+			}
+			return err
+		case <-eventCh:
+			if err := t.load(); err != nil {
+				log.Errorf("Failed to reload API tokens: %v", err)
+				continue
+			}
+			log.Infof("Reloaded API tokens from '%s'", t.path)
+		}
+	}
+}
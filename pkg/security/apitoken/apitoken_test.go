@@ -0,0 +1,130 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apitoken
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testEnvVar = "DAPR_TEST_API_TOKEN"
+
+func TestStatic(t *testing.T) {
+	t.Run("no tokens", func(t *testing.T) {
+		tokens := Static("", " ")
+		assert.False(t, tokens.Enabled())
+		assert.Empty(t, tokens.Current())
+		assert.False(t, tokens.Valid(""))
+	})
+
+	t.Run("multiple tokens", func(t *testing.T) {
+		tokens := Static("new", "old")
+		assert.True(t, tokens.Enabled())
+		assert.Equal(t, "new", tokens.Current())
+		assert.True(t, tokens.Valid("new"))
+		assert.True(t, tokens.Valid("old"))
+		assert.False(t, tokens.Valid("other"))
+		assert.False(t, tokens.Valid(""))
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		var tokens *Tokens
+		assert.False(t, tokens.Enabled())
+		assert.Empty(t, tokens.Current())
+		assert.False(t, tokens.Valid("new"))
+	})
+}
+
+func TestFromEnv(t *testing.T) {
+	t.Run("not set", func(t *testing.T) {
+		tokens, err := FromEnv(testEnvVar)
+		require.NoError(t, err)
+		assert.False(t, tokens.Enabled())
+	})
+
+	t.Run("from env var", func(t *testing.T) {
+		t.Setenv(testEnvVar, "new\nold")
+		tokens, err := FromEnv(testEnvVar)
+		require.NoError(t, err)
+		assert.Equal(t, "new", tokens.Current())
+		assert.True(t, tokens.Valid("old"))
+	})
+
+	t.Run("from file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "token")
+		require.NoError(t, os.WriteFile(path, []byte("new\n\nold\n"), 0o600))
+		t.Setenv(testEnvVar, "ignored")
+		t.Setenv(testEnvVar+"_FILE", path)
+
+		tokens, err := FromEnv(testEnvVar)
+		require.NoError(t, err)
+		assert.Equal(t, "new", tokens.Current())
+		assert.True(t, tokens.Valid("old"))
+		assert.False(t, tokens.Valid("ignored"))
+	})
+
+	t.Run("empty file keeps authentication enabled", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "token")
+		require.NoError(t, os.WriteFile(path, nil, 0o600))
+		t.Setenv(testEnvVar+"_FILE", path)
+
+		tokens, err := FromEnv(testEnvVar)
+		require.NoError(t, err)
+		assert.True(t, tokens.Enabled())
+		assert.False(t, tokens.Valid(""))
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		t.Setenv(testEnvVar+"_FILE", filepath.Join(t.TempDir(), "token"))
+		_, err := FromEnv(testEnvVar)
+		require.Error(t, err)
+	})
+}
+
+func TestRun(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("old"), 0o600))
+	t.Setenv(testEnvVar+"_FILE", path)
+
+	tokens, err := FromEnv(testEnvVar)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- tokens.Run(ctx)
+	}()
+
+	// Rotate the token, keeping the old one valid
+	// The file is written again until the watcher, which batches events for 500ms, has picked up the change
+	require.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.NoError(c, os.WriteFile(path, []byte("new\nold"), 0o600))
+		assert.Equal(c, "new", tokens.Current())
+	}, 10*time.Second, time.Second)
+	assert.True(t, tokens.Valid("old"))
+
+	cancel()
+	select {
+	case err := <-errCh:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timeout waiting for Run to return")
+	}
+}
@@ -18,11 +18,13 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"slices"
 	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/messages"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
@@ -51,6 +53,19 @@ func IsSSEGrpcRequest(r *internalv1pb.InternalInvokeRequest) (bool, http.Header)
 	return isSSE(&header), header
 }
 
+// IsSSEContentType returns true if the content type is an event stream.
+func IsSSEContentType(contentType string) bool {
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	return strings.EqualFold(strings.TrimSpace(mediaType), mimeEventStream)
+}
+
+// IsStreamingHttpResponse returns true if the response is an event stream or
+// is sent with chunked transfer encoding, so it should be forwarded to the
+// caller incrementally rather than buffered.
+func IsStreamingHttpResponse(res *http.Response) bool {
+	return IsSSEContentType(res.Header.Get(headerContentType)) || slices.Contains(res.TransferEncoding, "chunked")
+}
+
 func isSSE(header *http.Header) bool {
 	accept := header.Get("Accept")
 	return strings.EqualFold(strings.TrimSpace(accept), "text/event-stream")
@@ -71,23 +86,29 @@ func HandleSSEGrpcResponse(res *invokev1.InvokeMethodResponse) error {
 }
 
 func FlushSSEResponse(ctx context.Context, writer http.ResponseWriter, reader io.Reader) error {
-	flusher, ok := writer.(http.Flusher)
-	if !ok {
-		http.Error(writer, "Streaming unsupported!", http.StatusInternalServerError)
-		return nil
-	}
-
 	writer.Header().Set(headerContentType, mimeEventStream)
 	writer.Header().Set(headerCacheControl, cacheNoCache)
 	writer.Header().Set(headerConnection, connectionKeepAlive)
 
+	return StreamResponse(ctx, writer, reader)
+}
+
+// StreamResponse copies the reader to the writer, flushing each chunk as soon
+// as it's read so it's delivered to the caller without buffering.
+// The writer, or any writer it wraps, must support flushing.
+func StreamResponse(ctx context.Context, writer http.ResponseWriter, reader io.Reader) error {
+	if !CanFlush(writer) {
+		http.Error(writer, "Streaming unsupported!", http.StatusInternalServerError)
+		return nil
+	}
+	rc := http.NewResponseController(writer)
+
 	// Add defer close for streaming case
 	closer, ok := reader.(io.Closer)
 	if ok {
 		defer closer.Close()
 	}
 
-	// Stream SSE data in real-time
 	buf := make([]byte, 1024)
 	for {
 		if err := ctx.Err(); err != nil {
@@ -101,8 +122,11 @@ func FlushSSEResponse(ctx context.Context, writer http.ResponseWriter, reader io
 				// Client disconnected - break immediately
 				return err
 			}
-			// Flush immediately for SSE
-			flusher.Flush()
+			// Flush immediately so the chunk is not buffered
+			if err = rc.Flush(); err != nil {
+				return err
+			}
+			diag.DefaultHTTPMonitoring.ClientResponseChunkStreamed(ctx, int64(n))
 		}
 
 		if err == io.EOF {
@@ -114,3 +138,51 @@ func FlushSSEResponse(ctx context.Context, writer http.ResponseWriter, reader io
 	}
 	return nil
 }
+
+// StartEventStream sets the event stream headers and sends them to the caller,
+// so events can then be written with WriteEvent.
+func StartEventStream(writer http.ResponseWriter) error {
+	if !CanFlush(writer) {
+		return errors.New("streaming unsupported")
+	}
+
+	writer.Header().Set(headerContentType, mimeEventStream)
+	writer.Header().Set(headerCacheControl, cacheNoCache)
+	writer.Header().Set(headerConnection, connectionKeepAlive)
+	writer.WriteHeader(http.StatusOK)
+
+	return http.NewResponseController(writer).Flush()
+}
+
+// WriteEvent writes a single event with the given name and data to the
+// writer, and flushes it. Each line of data is sent in its own data field.
+func WriteEvent(writer http.ResponseWriter, event string, data []byte) error {
+	var b strings.Builder
+	if event != "" {
+		b.WriteString("event: " + event + "\n")
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		b.WriteString("data: " + line + "\n")
+	}
+	b.WriteString("\n")
+
+	if _, err := io.WriteString(writer, b.String()); err != nil {
+		return err
+	}
+
+	return http.NewResponseController(writer).Flush()
+}
+
+// CanFlush returns true if the writer, or any of the writers it wraps, can be flushed.
+func CanFlush(w http.ResponseWriter) bool {
+	for {
+		switch rw := w.(type) {
+		case http.Flusher, interface{ FlushError() error }:
+			return true
+		case interface{ Unwrap() http.ResponseWriter }:
+			w = rw.Unwrap()
+		default:
+			return false
+		}
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sse
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/responsewriter"
+)
+
+func TestIsStreamingHttpResponse(t *testing.T) {
+	assert.True(t, IsStreamingHttpResponse(&http.Response{Header: http.Header{"Content-Type": {"text/event-stream; charset=utf-8"}}}))
+	assert.True(t, IsStreamingHttpResponse(&http.Response{Header: http.Header{}, TransferEncoding: []string{"chunked"}}))
+	assert.False(t, IsStreamingHttpResponse(&http.Response{Header: http.Header{"Content-Type": {"application/json"}}}))
+}
+
+// noFlushWriter hides the Flush method of the wrapped writer
+type noFlushWriter struct {
+	http.ResponseWriter
+}
+
+func TestStreamResponse(t *testing.T) {
+	t.Run("flushes wrapped writers", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		w := responsewriter.NewResponseWriter(rec)
+
+		require.NoError(t, StreamResponse(t.Context(), w, strings.NewReader("data: hello\n\n")))
+		assert.True(t, rec.Flushed)
+		assert.Equal(t, "data: hello\n\n", rec.Body.String())
+	})
+
+	t.Run("streaming unsupported", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+
+		require.NoError(t, StreamResponse(t.Context(), noFlushWriter{rec}, strings.NewReader("data: hello\n\n")))
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+
+	t.Run("sse headers", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+
+		require.NoError(t, FlushSSEResponse(t.Context(), rec, strings.NewReader("data: hello\n\n")))
+		assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+		assert.Equal(t, "no-cache", rec.Header().Get("Cache-Control"))
+	})
+}
+
+func TestWriteEvent(t *testing.T) {
+	t.Run("writes headers and events", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		w := responsewriter.NewResponseWriter(rec)
+
+		require.NoError(t, StartEventStream(w))
+		assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		require.NoError(t, WriteEvent(w, "message", []byte("{\"a\":1}")))
+		require.NoError(t, WriteEvent(w, "", []byte("line1\nline2")))
+		assert.True(t, rec.Flushed)
+		assert.Equal(t, "event: message\ndata: {\"a\":1}\n\ndata: line1\ndata: line2\n\n", rec.Body.String())
+	})
+
+	t.Run("streaming unsupported", func(t *testing.T) {
+		require.Error(t, StartEventStream(noFlushWriter{httptest.NewRecorder()}))
+	})
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/components-contrib/lock"
+	lockLoader "github.com/dapr/dapr/pkg/components/lock"
+)
+
+var (
+	_ lock.Store         = (*FakeLockStore)(nil)
+	_ lockLoader.Renewer = (*FakeLockStore)(nil)
+)
+
+// FakeLockStore is an in-memory lock store, whose leases can be renewed.
+type FakeLockStore struct {
+	Clock clock.Clock
+
+	lock     sync.Mutex
+	leases   map[string]fakeLease
+	renewals int
+}
+
+type fakeLease struct {
+	owner     string
+	expiresAt time.Time
+}
+
+func (s *FakeLockStore) InitLockStore(context.Context, lock.Metadata) error {
+	return nil
+}
+
+func (s *FakeLockStore) TryLock(_ context.Context, req *lock.TryLockRequest) (*lock.TryLockResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if l, ok := s.lease(req.ResourceID); ok && l.owner != req.LockOwner {
+		return &lock.TryLockResponse{Success: false}, nil
+	}
+	s.leases[req.ResourceID] = fakeLease{
+		owner:     req.LockOwner,
+		expiresAt: s.now().Add(time.Duration(req.ExpiryInSeconds) * time.Second),
+	}
+	return &lock.TryLockResponse{Success: true}, nil
+}
+
+func (s *FakeLockStore) Unlock(_ context.Context, req *lock.UnlockRequest) (*lock.UnlockResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	l, ok := s.lease(req.ResourceID)
+	switch {
+	case !ok:
+		return &lock.UnlockResponse{Status: lock.LockDoesNotExist}, nil
+	case l.owner != req.LockOwner:
+		return &lock.UnlockResponse{Status: lock.LockBelongsToOthers}, nil
+	}
+	delete(s.leases, req.ResourceID)
+	return &lock.UnlockResponse{Status: lock.Success}, nil
+}
+
+func (s *FakeLockStore) RenewLock(_ context.Context, req *lockLoader.RenewLockRequest) (*lockLoader.RenewLockResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	l, ok := s.lease(req.ResourceID)
+	switch {
+	case !ok:
+		return &lockLoader.RenewLockResponse{Status: lock.LockDoesNotExist}, nil
+	case l.owner != req.LockOwner:
+		return &lockLoader.RenewLockResponse{Status: lock.LockBelongsToOthers}, nil
+	}
+	l.expiresAt = s.now().Add(time.Duration(req.ExpiryInSeconds) * time.Second)
+	s.leases[req.ResourceID] = l
+	s.renewals++
+	return &lockLoader.RenewLockResponse{Status: lock.Success}, nil
+}
+
+// Owner returns the owner of the lock, or an empty string if the lock isn't
+// held.
+func (s *FakeLockStore) Owner(resourceID string) string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	l, _ := s.lease(resourceID)
+	return l.owner
+}
+
+// RenewalCount returns the number of renewals of the leases.
+func (s *FakeLockStore) RenewalCount() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.renewals
+}
+
+func (s *FakeLockStore) Close() error {
+	return nil
+}
+
+// lease returns the lease of the lock if it hasn't expired. It must be called
+// with the lock held.
+func (s *FakeLockStore) lease(resourceID string) (fakeLease, bool) {
+	if s.leases == nil {
+		s.leases = make(map[string]fakeLease)
+	}
+	l, ok := s.leases[resourceID]
+	if !ok || !s.now().Before(l.expiresAt) {
+		delete(s.leases, resourceID)
+		return fakeLease{}, false
+	}
+	return l, true
+}
+
+func (s *FakeLockStore) now() time.Time {
+	if s.Clock == nil {
+		return time.Now()
+	}
+	return s.Clock.Now()
+}
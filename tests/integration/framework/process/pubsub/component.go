@@ -18,9 +18,13 @@ import (
 	"io"
 	"testing"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/dapr/components-contrib/metadata"
 	"github.com/dapr/components-contrib/pubsub"
 	compv1pb "github.com/dapr/dapr/pkg/proto/components/v1"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 )
 
 // component is an implementation of the pubsub pluggable component
@@ -118,6 +122,18 @@ func (c *component) Ping(ctx context.Context, req *compv1pb.PingRequest) (*compv
 	return new(compv1pb.PingResponse), nil
 }
 
+func (c *component) ListTopics(ctx context.Context, req *compv1pb.ListTopicsRequest) (*compv1pb.ListTopicsResponse, error) {
+	lister, ok := c.impl.(rtpubsub.TopicLister)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "the pubsub does not list topics")
+	}
+	topics, err := lister.ListTopics(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &compv1pb.ListTopicsResponse{Topics: topics}, nil
+}
+
 func (c *component) Close() error {
 	return c.impl.(io.Closer).Close()
 }
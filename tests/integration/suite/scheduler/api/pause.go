@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/process/scheduler"
+	"github.com/dapr/dapr/tests/integration/suite"
+	"github.com/dapr/kit/ptr"
+)
+
+func init() {
+	suite.Register(new(pause))
+}
+
+type pause struct {
+	scheduler *scheduler.Scheduler
+}
+
+func (p *pause) Setup(t *testing.T) []framework.Option {
+	p.scheduler = scheduler.New(t)
+
+	return []framework.Option{
+		framework.WithProcesses(p.scheduler),
+	}
+}
+
+func (p *pause) Run(t *testing.T, ctx context.Context) {
+	p.scheduler.WaitUntilRunning(t, ctx)
+
+	client := p.scheduler.Client(t, ctx)
+
+	metadata := &schedulerv1pb.JobMetadata{
+		AppId:     "foo",
+		Namespace: "default",
+		Target: &schedulerv1pb.JobTargetMetadata{
+			Type: &schedulerv1pb.JobTargetMetadata_Job{Job: new(schedulerv1pb.TargetJob)},
+		},
+	}
+
+	_, err := client.ScheduleJob(ctx, &schedulerv1pb.ScheduleJobRequest{
+		Name:     "test",
+		Job:      &schedulerv1pb.Job{Schedule: ptr.Of("@daily")},
+		Metadata: metadata,
+	})
+	require.NoError(t, err)
+
+	_, err = client.ResumeJob(ctx, &schedulerv1pb.ResumeJobRequest{Name: "test", Metadata: metadata})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = client.PauseJob(ctx, &schedulerv1pb.PauseJobRequest{Name: "test", Metadata: metadata})
+	require.NoError(t, err)
+	assert.Empty(t, p.scheduler.ListAllKeys(t, ctx, "dapr/jobs"))
+
+	_, err = client.PauseJob(ctx, &schedulerv1pb.PauseJobRequest{Name: "test", Metadata: metadata})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	resp, err := client.GetJob(ctx, &schedulerv1pb.GetJobRequest{Name: "test", Metadata: metadata})
+	require.NoError(t, err)
+	assert.True(t, resp.GetPaused())
+	assert.Equal(t, "@daily", resp.GetJob().GetSchedule())
+
+	list, err := client.ListJobs(ctx, &schedulerv1pb.ListJobsRequest{Metadata: metadata})
+	require.NoError(t, err)
+	require.Len(t, list.GetJobs(), 1)
+	assert.Equal(t, "test", list.GetJobs()[0].GetName())
+	assert.True(t, list.GetJobs()[0].GetPaused())
+
+	_, err = client.ScheduleJob(ctx, &schedulerv1pb.ScheduleJobRequest{
+		Name:     "test",
+		Job:      &schedulerv1pb.Job{Schedule: ptr.Of("@daily")},
+		Metadata: metadata,
+	})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	_, err = client.ResumeJob(ctx, &schedulerv1pb.ResumeJobRequest{Name: "test", Metadata: metadata})
+	require.NoError(t, err)
+	assert.Len(t, p.scheduler.ListAllKeys(t, ctx, "dapr/jobs"), 1)
+	assert.Empty(t, p.scheduler.ListAllKeys(t, ctx, "dapr/pausedjobs"))
+
+	resp, err = client.GetJob(ctx, &schedulerv1pb.GetJobRequest{Name: "test", Metadata: metadata})
+	require.NoError(t, err)
+	assert.False(t, resp.GetPaused())
+
+	_, err = client.PauseJob(ctx, &schedulerv1pb.PauseJobRequest{Name: "test", Metadata: metadata})
+	require.NoError(t, err)
+	_, err = client.DeleteJob(ctx, &schedulerv1pb.DeleteJobRequest{Name: "test", Metadata: metadata})
+	require.NoError(t, err)
+	assert.Empty(t, p.scheduler.ListAllKeys(t, ctx, "dapr/pausedjobs"))
+}